@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	commonconfig "github.com/rom8726/warden/internal/common/config"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/config"
+	"github.com/rom8726/warden/internal/envelope-consumer/services/deadletter"
+	"github.com/rom8726/warden/pkg/kafka"
+)
+
+// deadLetterGroupSuffix names the consumer group which offsets track the replayed dead-letter messages.
+const deadLetterGroupSuffix = "-dead-letter"
+
+var errListLimitReached = errors.New("list limit reached")
+
+var DeadLetterCmd = &cobra.Command{
+	Use:   "dead-letter",
+	Short: "Inspect and replay messages failed to be processed",
+}
+
+var deadLetterListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List dead-letter messages not replayed yet",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runDeadLetterListCommand(cmd.Context())
+	},
+}
+
+var deadLetterReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Send dead-letter messages not replayed yet back to the processing queues",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runDeadLetterReplayCommand(cmd.Context())
+	},
+}
+
+var deadLetterListLimit uint
+
+func init() {
+	DeadLetterCmd.PersistentFlags().StringVarP(
+		&envFile,
+		"env-file",
+		"e",
+		"",
+		"path to env file",
+	)
+	deadLetterListCmd.Flags().UintVarP(
+		&deadLetterListLimit,
+		"limit",
+		"l",
+		100,
+		"maximum number of messages to list, 0 lists all of them",
+	)
+
+	DeadLetterCmd.AddCommand(deadLetterListCmd)
+	DeadLetterCmd.AddCommand(deadLetterReplayCmd)
+}
+
+func runDeadLetterListCommand(ctx context.Context) error {
+	return withDeadLetterReplayer(ctx, func(replayer *deadletter.Replayer) error {
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "PARTITION/OFFSET\tFAILED AT\tSOURCE\tPROJECT\tATTEMPTS\tSIZE\tERROR")
+
+		var listed uint
+		err := replayer.List(ctx, func(entry deadletter.Entry) error {
+			if deadLetterListLimit > 0 && listed >= deadLetterListLimit {
+				return errListLimitReached
+			}
+			listed++
+
+			_, _ = fmt.Fprintf(writer, "%d/%d\t%s\t%s\t%d\t%d\t%d\t%s\n",
+				entry.Partition, entry.Offset, entry.FailedAt.Format(time.RFC3339), entry.Source,
+				entry.ProjectID, entry.Attempts, len(entry.Payload), entry.Error)
+
+			return nil
+		})
+		if err != nil && !errors.Is(err, errListLimitReached) {
+			return fmt.Errorf("list dead-letter messages: %w", err)
+		}
+
+		return writer.Flush()
+	})
+}
+
+func runDeadLetterReplayCommand(ctx context.Context) error {
+	return withDeadLetterReplayer(ctx, func(replayer *deadletter.Replayer) error {
+		replayed, err := replayer.Replay(ctx)
+		fmt.Printf("Replayed %d dead-letter messages\n", replayed)
+
+		if err != nil {
+			return fmt.Errorf("replay dead-letter messages: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func withDeadLetterReplayer(ctx context.Context, fn func(replayer *deadletter.Replayer) error) error {
+	cfg, err := config.New(envFile)
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	client, err := commonconfig.NewKafkaClient(&cfg.Kafka)
+	if err != nil {
+		return fmt.Errorf("create kafka client: %w", err)
+	}
+	defer client.Close()
+
+	reader, err := kafka.NewTopicReader(client, domain.DeadLetterTopic, cfg.Kafka.ConsumerGroupID+deadLetterGroupSuffix)
+	if err != nil {
+		return fmt.Errorf("create dead-letter reader: %w", err)
+	}
+
+	producer, err := kafka.NewSyncProducer(client)
+	if err != nil {
+		return fmt.Errorf("create kafka producer: %w", err)
+	}
+	defer producer.Close()
+
+	return fn(deadletter.NewReplayer(reader, producer))
+}
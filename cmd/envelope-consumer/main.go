@@ -17,6 +17,7 @@ var rootCmd = &cobra.Command{
 
 func main() {
 	rootCmd.AddCommand(ConsumerCmd)
+	rootCmd.AddCommand(DeadLetterCmd)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
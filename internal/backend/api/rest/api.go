@@ -29,6 +29,18 @@ type RestAPI struct {
 	settingsUseCase          contract.SettingsUseCase
 	userNotificationsUseCase contract.UserNotificationsUseCase
 	versionsUseCase          contract.VersionsUseCase
+	groupingRulesUseCase     contract.GroupingRulesUseCase
+	transactionsUseCase      contract.TransactionsUseCase
+	attachmentsUseCase       contract.AttachmentsUseCase
+	monitorsUseCase          contract.MonitorsUseCase
+	outcomesUseCase          contract.OutcomesUseCase
+	projectKeysUseCase       contract.ProjectKeysUseCase
+	inboundFiltersUseCase    contract.InboundFiltersUseCase
+	projectRateLimitsUseCase contract.ProjectRateLimitsUseCase
+	dataScrubbingUseCase     contract.DataScrubbingUseCase
+	environmentsUseCase      contract.EnvironmentsUseCase
+	issueActivityUseCase     contract.IssueActivityUseCase
+	issueBulkUseCase         contract.IssueBulkUseCase
 }
 
 func New(
@@ -45,6 +57,18 @@ func New(
 	settingsUseCase contract.SettingsUseCase,
 	userNotificationsUseCase contract.UserNotificationsUseCase,
 	versionsUseCase contract.VersionsUseCase,
+	groupingRulesUseCase contract.GroupingRulesUseCase,
+	transactionsUseCase contract.TransactionsUseCase,
+	attachmentsUseCase contract.AttachmentsUseCase,
+	monitorsUseCase contract.MonitorsUseCase,
+	outcomesUseCase contract.OutcomesUseCase,
+	projectKeysUseCase contract.ProjectKeysUseCase,
+	inboundFiltersUseCase contract.InboundFiltersUseCase,
+	projectRateLimitsUseCase contract.ProjectRateLimitsUseCase,
+	dataScrubbingUseCase contract.DataScrubbingUseCase,
+	environmentsUseCase contract.EnvironmentsUseCase,
+	issueActivityUseCase contract.IssueActivityUseCase,
+	issueBulkUseCase contract.IssueBulkUseCase,
 ) *RestAPI {
 	return &RestAPI{
 		config:                   config,
@@ -60,6 +84,18 @@ func New(
 		settingsUseCase:          settingsUseCase,
 		userNotificationsUseCase: userNotificationsUseCase,
 		versionsUseCase:          versionsUseCase,
+		groupingRulesUseCase:     groupingRulesUseCase,
+		transactionsUseCase:      transactionsUseCase,
+		attachmentsUseCase:       attachmentsUseCase,
+		monitorsUseCase:          monitorsUseCase,
+		outcomesUseCase:          outcomesUseCase,
+		projectKeysUseCase:       projectKeysUseCase,
+		inboundFiltersUseCase:    inboundFiltersUseCase,
+		projectRateLimitsUseCase: projectRateLimitsUseCase,
+		dataScrubbingUseCase:     dataScrubbingUseCase,
+		environmentsUseCase:      environmentsUseCase,
+		issueActivityUseCase:     issueActivityUseCase,
+		issueBulkUseCase:         issueBulkUseCase,
 	}
 }
 
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"mime"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) DownloadAttachment(
+	ctx context.Context,
+	params generatedapi.DownloadAttachmentParams,
+) (generatedapi.DownloadAttachmentRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	attachmentID := domain.AttachmentID(params.AttachmentID)
+
+	attachment, content, err := r.attachmentsUseCase.Open(ctx, projectID, attachmentID)
+	if err != nil {
+		slog.Error("open attachment failed", "error", err, "project_id", projectID, "attachment_id", attachmentID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("attachment not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	// The content is streamed after the handler returns, it's closed once the request is done.
+	context.AfterFunc(ctx, func() {
+		if err := content.Close(); err != nil {
+			slog.Error("close attachment content failed", "error", err, "attachment_id", attachmentID)
+		}
+	})
+
+	return &generatedapi.DownloadAttachmentOKHeaders{
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}),
+		Response:           generatedapi.DownloadAttachmentOK{Data: content},
+	}, nil
+}
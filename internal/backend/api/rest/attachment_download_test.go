@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_DownloadAttachment(t *testing.T) {
+	params := generatedapi.DownloadAttachmentParams{ProjectID: 1, AttachmentID: 7}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockAttachmentsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{attachmentsUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().Open(mock.Anything, domain.ProjectID(1), domain.AttachmentID(7)).Return(
+			domain.Attachment{ID: 7, Name: "app log.txt"},
+			io.NopCloser(strings.NewReader("content")),
+			nil,
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		resp, err := api.DownloadAttachment(ctx, params)
+		require.NoError(t, err)
+
+		download, ok := resp.(*generatedapi.DownloadAttachmentOKHeaders)
+		require.True(t, ok)
+		require.Equal(t, `attachment; filename="app log.txt"`, download.ContentDisposition)
+
+		content, err := io.ReadAll(download.Response)
+		require.NoError(t, err)
+		require.Equal(t, "content", string(content))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockAttachmentsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{attachmentsUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().Open(mock.Anything, domain.ProjectID(1), domain.AttachmentID(7)).
+			Return(domain.Attachment{}, nil, domain.ErrEntityNotFound)
+
+		resp, err := api.DownloadAttachment(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.DownloadAttachment(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) ListEventAttachments(
+	ctx context.Context,
+	params generatedapi.ListEventAttachmentsParams,
+) (generatedapi.ListEventAttachmentsRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	eventID := domain.EventID(params.EventID)
+
+	attachments, err := r.attachmentsUseCase.ListByEvent(ctx, projectID, eventID)
+	if err != nil {
+		slog.Error("list event attachments failed", "error", err, "project_id", projectID, "event_id", eventID)
+
+		return nil, err
+	}
+
+	resp := dto.MakeListEventAttachmentsResponse(attachments)
+
+	return &resp, nil
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) GetProjectEvent(
+	ctx context.Context,
+	params generatedapi.GetProjectEventParams,
+) (generatedapi.GetProjectEventRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("project not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	details, err := r.eventUseCase.GetEvent(ctx, projectID, domain.EventID(params.EventID))
+	if err != nil {
+		slog.Error("get event failed", "error", err, "project_id", projectID, "event_id", params.EventID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("event not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.MakeEventDetailsResponse(details)
+
+	return &resp, nil
+}
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_GetProjectEvent(t *testing.T) {
+	params := generatedapi.GetProjectEventParams{ProjectID: 1, EventID: "ev2"}
+
+	t.Run("success", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().GetEvent(mock.Anything, domain.ProjectID(1), domain.EventID("ev2")).
+			Return(domain.EventDetails{
+				Event:           domain.Event{ID: "ev2", ProjectID: 1},
+				IssueID:         7,
+				PreviousEventID: "ev1",
+				Data: domain.EventData{
+					Extra:      map[string]any{"order_id": 42},
+					Exceptions: []domain.ExceptionInfo{{Type: "ValueError", Value: "bad value"}},
+				},
+			}, nil)
+
+		resp, err := api.GetProjectEvent(context.Background(), params)
+		require.NoError(t, err)
+
+		detailsResp, ok := resp.(*generatedapi.EventDetailsResponse)
+		require.True(t, ok)
+		require.Equal(t, "ev2", detailsResp.Event.EventID)
+		require.Equal(t, generatedapi.NewOptUint(7), detailsResp.IssueID)
+		require.Equal(t, generatedapi.NewOptString("ev1"), detailsResp.PreviousEventID)
+		require.False(t, detailsResp.NextEventID.Set)
+		require.JSONEq(t, `42`, string(detailsResp.Extra["order_id"]))
+		require.Equal(t, []generatedapi.EventException{{Type: "ValueError", Value: "bad value"}}, detailsResp.Exceptions)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().GetEvent(mock.Anything, domain.ProjectID(1), domain.EventID("ev2")).
+			Return(domain.EventDetails{}, domain.ErrEntityNotFound)
+
+		resp, err := api.GetProjectEvent(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().GetEvent(mock.Anything, domain.ProjectID(1), domain.EventID("ev2")).
+			Return(domain.EventDetails{}, errors.New("clickhouse is down"))
+
+		resp, err := api.GetProjectEvent(context.Background(), params)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	})
+}
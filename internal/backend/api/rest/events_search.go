@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) SearchProjectEvents(
+	ctx context.Context,
+	params generatedapi.SearchProjectEventsParams,
+) (generatedapi.SearchProjectEventsRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("project not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	filter, err := dto.MakeEventSearchFilter(params)
+	if err != nil {
+		slog.Error("invalid event search", "error", err, "project_id", projectID)
+
+		return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+			Message: generatedapi.NewOptString(err.Error()),
+		}}, nil
+	}
+
+	page, err := r.eventUseCase.Search(ctx, &filter)
+	if err != nil {
+		slog.Error("search events failed", "error", err, "project_id", projectID)
+
+		return nil, err
+	}
+
+	resp := dto.MakeSearchEventsResponse(page)
+
+	return &resp, nil
+}
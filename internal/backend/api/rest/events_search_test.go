@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_SearchProjectEvents(t *testing.T) {
+	cursor := domain.EventCursor{Timestamp: time.Unix(1700000000, 0).UTC(), EventID: "ev2"}
+	params := generatedapi.SearchProjectEventsParams{
+		ProjectID: 1,
+		Query:     generatedapi.NewOptString("release:1.2.* tags[customer]:42"),
+		Cursor:    generatedapi.NewOptString(dto.EncodeEventCursor(cursor)),
+		Limit:     generatedapi.NewOptUint(1),
+	}
+	expectedFilter := domain.EventSearchFilter{
+		ProjectID: 1,
+		Query: domain.SearchQuery{
+			{Key: "release", Values: []string{"1.2.*"}},
+			{Key: "tags", Tag: "customer", Values: []string{"42"}},
+		},
+		Cursor: &cursor,
+		Limit:  1,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		nextCursor := domain.EventCursor{Timestamp: time.Unix(1699999999, 0).UTC(), EventID: "ev1"}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().Search(mock.Anything, &expectedFilter).Return(domain.EventSearchPage{
+			Events: []domain.Event{{
+				ID:        "ev1",
+				ProjectID: 1,
+				Timestamp: nextCursor.Timestamp,
+				Level:     domain.IssueLevelError,
+				Source:    domain.SourceEvent,
+			}},
+			NextCursor: &nextCursor,
+		}, nil)
+
+		resp, err := api.SearchProjectEvents(context.Background(), params)
+		require.NoError(t, err)
+
+		searchResp, ok := resp.(*generatedapi.SearchEventsResponse)
+		require.True(t, ok)
+		require.Len(t, searchResp.Events, 1)
+		require.Equal(t, "ev1", searchResp.Events[0].EventID)
+
+		decoded, err := dto.DecodeEventCursor(searchResp.NextCursor.Value)
+		require.NoError(t, err)
+		require.Equal(t, nextCursor, decoded)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.SearchProjectEvents(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+
+		invalidParams := params
+		invalidParams.Query = generatedapi.NewOptString("customer:42")
+
+		resp, err := api.SearchProjectEvents(context.Background(), invalidParams)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+
+		invalidParams := params
+		invalidParams.Cursor = generatedapi.NewOptString("not a cursor")
+
+		resp, err := api.SearchProjectEvents(context.Background(), invalidParams)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().Search(mock.Anything, &expectedFilter).
+			Return(domain.EventSearchPage{}, errors.New("clickhouse is down"))
+
+		resp, err := api.SearchProjectEvents(context.Background(), params)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	})
+}
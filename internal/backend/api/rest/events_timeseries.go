@@ -32,6 +32,9 @@ func (r *RestAPI) GetEventsTimeseries(
 		Levels:    nil,
 		GroupBy:   domain.EventTimeseriesGroupLevel,
 	}
+	if params.Environment.Set {
+		filter.Environment = &params.Environment.Value
+	}
 
 	list, err := r.eventUseCase.Timeseries(ctx, &filter)
 	if err != nil {
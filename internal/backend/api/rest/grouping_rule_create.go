@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) CreateGroupingRule(
+	ctx context.Context,
+	req *generatedapi.GroupingRuleRequest,
+	params generatedapi.CreateGroupingRuleParams,
+) (generatedapi.CreateGroupingRuleRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	rule, err := r.groupingRulesUseCase.Create(ctx, domain.GroupingRuleDTO{
+		ProjectID:  projectID,
+		Type:       domain.GroupingRuleType(req.Type),
+		Expression: req.Expression,
+	})
+	if err != nil {
+		slog.Error("create grouping rule failed", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrInvalidGroupingRule) {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.DomainGroupingRuleToAPI(rule)
+
+	return &resp, nil
+}
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_CreateGroupingRule(t *testing.T) {
+	req := &generatedapi.GroupingRuleRequest{
+		Type:       generatedapi.GroupingRuleTypeFingerprint,
+		Expression: "error.type:DatabaseError -> database-error",
+	}
+	params := generatedapi.CreateGroupingRuleParams{ProjectID: 1}
+	ruleDTO := domain.GroupingRuleDTO{
+		ProjectID:  1,
+		Type:       domain.GroupingRuleTypeFingerprint,
+		Expression: req.Expression,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockGroupingRulesUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{groupingRulesUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockUseCase.EXPECT().Create(mock.Anything, ruleDTO).Return(domain.GroupingRule{
+			ID:         3,
+			ProjectID:  1,
+			Type:       domain.GroupingRuleTypeFingerprint,
+			Expression: req.Expression,
+		}, nil)
+
+		resp, err := api.CreateGroupingRule(context.Background(), req, params)
+		require.NoError(t, err)
+
+		rule, ok := resp.(*generatedapi.GroupingRule)
+		require.True(t, ok)
+		require.Equal(t, uint(3), rule.ID)
+		require.Equal(t, generatedapi.GroupingRuleTypeFingerprint, rule.Type)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockGroupingRulesUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{groupingRulesUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockUseCase.EXPECT().Create(mock.Anything, ruleDTO).
+			Return(domain.GroupingRule{}, fmt.Errorf("%w: missing \"->\"", domain.ErrInvalidGroupingRule))
+
+		resp, err := api.CreateGroupingRule(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanManageProject(mock.Anything, domain.ProjectID(1), false).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.CreateGroupingRule(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) DeleteGroupingRule(
+	ctx context.Context,
+	params generatedapi.DeleteGroupingRuleParams,
+) (generatedapi.DeleteGroupingRuleRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+	ruleID := domain.GroupingRuleID(params.RuleID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	err := r.groupingRulesUseCase.Delete(ctx, projectID, ruleID)
+	if err != nil {
+		slog.Error("delete grouping rule failed", "error", err, "project_id", projectID, "rule_id", ruleID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	return &generatedapi.DeleteGroupingRuleNoContent{}, nil
+}
@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) UpdateGroupingRule(
+	ctx context.Context,
+	req *generatedapi.GroupingRuleRequest,
+	params generatedapi.UpdateGroupingRuleParams,
+) (generatedapi.UpdateGroupingRuleRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+	ruleID := domain.GroupingRuleID(params.RuleID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	rule, err := r.groupingRulesUseCase.Update(
+		ctx,
+		projectID,
+		ruleID,
+		domain.GroupingRuleType(req.Type),
+		req.Expression,
+	)
+	if err != nil {
+		slog.Error("update grouping rule failed", "error", err, "project_id", projectID, "rule_id", ruleID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrInvalidGroupingRule) {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.DomainGroupingRuleToAPI(rule)
+
+	return &resp, nil
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) ListGroupingRules(
+	ctx context.Context,
+	params generatedapi.ListGroupingRulesParams,
+) (generatedapi.ListGroupingRulesRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	rules, err := r.groupingRulesUseCase.List(ctx, projectID)
+	if err != nil {
+		slog.Error("list grouping rules failed", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.MakeListGroupingRulesResponse(rules)
+
+	return &resp, nil
+}
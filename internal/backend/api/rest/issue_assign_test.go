@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_AssignIssue(t *testing.T) {
+	params := generatedapi.AssignIssueParams{ProjectID: 1, IssueID: 123}
+
+	t.Run("assign user", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueUseCase: mockIssueUseCase, permissionsService: mockPermissionsService}
+
+		userID := domain.UserID(7)
+		mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(123)).Return(nil)
+		mockIssueUseCase.EXPECT().
+			Assign(mock.Anything, domain.IssueID(123), domain.IssueAssignee{UserID: &userID}).
+			Return(nil)
+
+		req := &generatedapi.IssueAssigneeRequest{UserID: generatedapi.NewOptNilUint(7)}
+		resp, err := api.AssignIssue(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.AssignIssueNoContent{}, resp)
+	})
+
+	t.Run("invalid assignee", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueUseCase: mockIssueUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(123)).Return(nil)
+		mockIssueUseCase.EXPECT().
+			Assign(mock.Anything, domain.IssueID(123), mock.Anything).
+			Return(domain.ErrInvalidAssignee)
+
+		req := &generatedapi.IssueAssigneeRequest{
+			UserID: generatedapi.NewOptNilUint(7),
+			TeamID: generatedapi.NewOptNilUint(3),
+		}
+		resp, err := api.AssignIssue(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.AssignIssue(context.Background(), &generatedapi.IssueAssigneeRequest{}, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+}
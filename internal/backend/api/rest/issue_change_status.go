@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 
+	"github.com/rom8726/warden/internal/backend/dto"
 	"github.com/rom8726/warden/internal/domain"
 	generatedapi "github.com/rom8726/warden/internal/generated/server"
 )
@@ -35,11 +36,41 @@ func (r *RestAPI) ChangeIssueStatus(
 		return nil, err
 	}
 
-	err := r.issueUseCase.ChangeStatus(ctx, issueID, domain.IssueStatus(req.Status))
+	resolvedInRelease := req.ResolvedInRelease.Set || req.ResolvedInNextRelease.Value
+
+	if req.IgnoreConditions.Set && req.Status != generatedapi.IssueStatusIgnored {
+		return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+			Message: generatedapi.NewOptString("ignore conditions are only allowed for ignored issues"),
+		}}, nil
+	}
+
+	if resolvedInRelease && req.Status != generatedapi.IssueStatusResolved {
+		return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+			Message: generatedapi.NewOptString("resolved in release is only allowed for resolved issues"),
+		}}, nil
+	}
+
+	var err error
+	switch {
+	case req.IgnoreConditions.Set:
+		err = r.issueUseCase.Ignore(ctx, issueID, dto.MakeIgnoreConditions(req.IgnoreConditions.Value))
+	case resolvedInRelease:
+		err = r.issueUseCase.ResolveInRelease(ctx, issueID, domain.IssueResolvedInRelease{
+			Version:     req.ResolvedInRelease.Value,
+			NextRelease: req.ResolvedInNextRelease.Value,
+		})
+	default:
+		err = r.issueUseCase.ChangeStatus(ctx, issueID, domain.IssueStatus(req.Status))
+	}
 	if err != nil {
 		slog.Error("change issue status failed", "error", err)
 
 		switch {
+		case errors.Is(err, domain.ErrInvalidIgnoreConditions),
+			errors.Is(err, domain.ErrInvalidResolvedRelease):
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
 		case errors.Is(err, domain.ErrEntityNotFound):
 			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
 				Message: generatedapi.NewOptString(err.Error()),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/rom8726/warden/internal/domain"
 	generatedapi "github.com/rom8726/warden/internal/generated/server"
@@ -235,4 +236,196 @@ func TestRestAPI_ChangeIssueStatus(t *testing.T) {
 		require.Nil(t, resp)
 		assert.Equal(t, unexpectedErr, err)
 	})
+
+	t.Run("ignore with conditions", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+
+		api := &RestAPI{
+			issueUseCase:       mockIssueUseCase,
+			permissionsService: mockPermissionsService,
+		}
+
+		params := generatedapi.ChangeIssueStatusParams{
+			IssueID: 123,
+		}
+
+		req := &generatedapi.ChangeIssueStatusReq{
+			Status: generatedapi.IssueStatusIgnored,
+			IgnoreConditions: generatedapi.NewOptIssueIgnoreConditions(generatedapi.IssueIgnoreConditions{
+				ForHours:  generatedapi.NewOptUint(24),
+				UserCount: generatedapi.NewOptUint(10),
+			}),
+		}
+
+		duration := 24 * time.Hour
+		userCount := uint(10)
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(nil)
+
+		mockIssueUseCase.EXPECT().
+			Ignore(mock.Anything, domain.IssueID(123), domain.IssueIgnoreConditions{
+				For:       &duration,
+				UserCount: &userCount,
+			}).
+			Return(nil)
+
+		resp, err := api.ChangeIssueStatus(context.Background(), req, params)
+
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ChangeIssueStatusNoContent{}, resp)
+	})
+
+	t.Run("ignore conditions with another status", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+
+		api := &RestAPI{
+			permissionsService: mockPermissionsService,
+		}
+
+		params := generatedapi.ChangeIssueStatusParams{
+			IssueID: 123,
+		}
+
+		req := &generatedapi.ChangeIssueStatusReq{
+			Status: generatedapi.IssueStatusResolved,
+			IgnoreConditions: generatedapi.NewOptIssueIgnoreConditions(generatedapi.IssueIgnoreConditions{
+				Count: generatedapi.NewOptUint(100),
+			}),
+		}
+
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(nil)
+
+		resp, err := api.ChangeIssueStatus(context.Background(), req, params)
+
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("invalid ignore conditions", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+
+		api := &RestAPI{
+			issueUseCase:       mockIssueUseCase,
+			permissionsService: mockPermissionsService,
+		}
+
+		params := generatedapi.ChangeIssueStatusParams{
+			IssueID: 123,
+		}
+
+		req := &generatedapi.ChangeIssueStatusReq{
+			Status: generatedapi.IssueStatusIgnored,
+			IgnoreConditions: generatedapi.NewOptIssueIgnoreConditions(generatedapi.IssueIgnoreConditions{
+				Until: generatedapi.NewOptDateTime(time.Now().Add(-time.Hour)),
+			}),
+		}
+
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(nil)
+
+		mockIssueUseCase.EXPECT().
+			Ignore(mock.Anything, domain.IssueID(123), mock.Anything).
+			Return(domain.ErrInvalidIgnoreConditions)
+
+		resp, err := api.ChangeIssueStatus(context.Background(), req, params)
+
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("resolve in next release", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+
+		api := &RestAPI{
+			issueUseCase:       mockIssueUseCase,
+			permissionsService: mockPermissionsService,
+		}
+
+		params := generatedapi.ChangeIssueStatusParams{
+			IssueID: 123,
+		}
+
+		req := &generatedapi.ChangeIssueStatusReq{
+			Status:                generatedapi.IssueStatusResolved,
+			ResolvedInNextRelease: generatedapi.NewOptBool(true),
+		}
+
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(nil)
+
+		mockIssueUseCase.EXPECT().
+			ResolveInRelease(mock.Anything, domain.IssueID(123), domain.IssueResolvedInRelease{NextRelease: true}).
+			Return(nil)
+
+		resp, err := api.ChangeIssueStatus(context.Background(), req, params)
+
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ChangeIssueStatusNoContent{}, resp)
+	})
+
+	t.Run("resolved in release with another status", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+
+		api := &RestAPI{
+			permissionsService: mockPermissionsService,
+		}
+
+		params := generatedapi.ChangeIssueStatusParams{
+			IssueID: 123,
+		}
+
+		req := &generatedapi.ChangeIssueStatusReq{
+			Status:            generatedapi.IssueStatusIgnored,
+			ResolvedInRelease: generatedapi.NewOptString("1.4.0"),
+		}
+
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(nil)
+
+		resp, err := api.ChangeIssueStatus(context.Background(), req, params)
+
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("invalid resolved in release", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+
+		api := &RestAPI{
+			issueUseCase:       mockIssueUseCase,
+			permissionsService: mockPermissionsService,
+		}
+
+		params := generatedapi.ChangeIssueStatusParams{
+			IssueID: 123,
+		}
+
+		req := &generatedapi.ChangeIssueStatusReq{
+			Status:            generatedapi.IssueStatusResolved,
+			ResolvedInRelease: generatedapi.NewOptString(" "),
+		}
+
+		mockPermissionsService.EXPECT().
+			CanManageIssue(mock.Anything, domain.IssueID(123)).
+			Return(nil)
+
+		mockIssueUseCase.EXPECT().
+			ResolveInRelease(mock.Anything, domain.IssueID(123), domain.IssueResolvedInRelease{Version: " "}).
+			Return(domain.ErrInvalidResolvedRelease)
+
+		resp, err := api.ChangeIssueStatus(context.Background(), req, params)
+
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
 }
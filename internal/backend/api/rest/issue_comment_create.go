@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) CreateProjectIssueComment(
+	ctx context.Context,
+	req *generatedapi.CreateIssueCommentRequest,
+	params generatedapi.CreateProjectIssueCommentParams,
+) (generatedapi.CreateProjectIssueCommentRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("project not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	comment, err := r.issueActivityUseCase.CreateComment(
+		ctx,
+		projectID,
+		dto.MakeIssueCommentDTO(domain.IssueID(params.IssueID), req),
+	)
+	if err != nil {
+		slog.Error("create issue comment failed", "error", err, "project_id", projectID, "issue_id", params.IssueID)
+
+		if errors.Is(err, domain.ErrInvalidIssueComment) {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("issue not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.DomainIssueCommentToAPI(comment)
+
+	return &resp, nil
+}
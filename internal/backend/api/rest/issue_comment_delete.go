@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) DeleteProjectIssueComment(
+	ctx context.Context,
+	params generatedapi.DeleteProjectIssueCommentParams,
+) (generatedapi.DeleteProjectIssueCommentRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("project not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	err := r.issueActivityUseCase.DeleteComment(
+		ctx,
+		projectID,
+		domain.IssueID(params.IssueID),
+		domain.IssueCommentID(params.CommentID),
+	)
+	if err != nil {
+		slog.Error("delete issue comment failed", "error", err, "project_id", projectID, "comment_id", params.CommentID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("only the author can delete the comment"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("comment not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	return &generatedapi.DeleteProjectIssueCommentNoContent{}, nil
+}
@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_CreateProjectIssueComment(t *testing.T) {
+	params := generatedapi.CreateProjectIssueCommentParams{ProjectID: 1, IssueID: 123}
+
+	t.Run("reply", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueActivityUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueActivityUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		parentID := domain.IssueCommentID(5)
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().
+			CreateComment(mock.Anything, domain.ProjectID(1), domain.IssueCommentDTO{
+				IssueID:  123,
+				ParentID: &parentID,
+				Body:     "@bob fixed in 1.2.0",
+			}).
+			Return(domain.IssueComment{ID: 6, IssueID: 123, ParentID: &parentID, Body: "@bob fixed in 1.2.0"}, nil)
+
+		req := &generatedapi.CreateIssueCommentRequest{
+			Body:     "@bob fixed in 1.2.0",
+			ParentID: generatedapi.NewOptUint(5),
+		}
+		resp, err := api.CreateProjectIssueComment(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.IssueComment{}, resp)
+
+		comment := resp.(*generatedapi.IssueComment)
+		require.Equal(t, uint(6), comment.ID)
+		require.Equal(t, generatedapi.NewOptUint(5), comment.ParentID)
+	})
+
+	t.Run("invalid comment", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueActivityUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueActivityUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().
+			CreateComment(mock.Anything, domain.ProjectID(1), mock.Anything).
+			Return(domain.IssueComment{}, domain.ErrInvalidIssueComment)
+
+		req := &generatedapi.CreateIssueCommentRequest{Body: " "}
+		resp, err := api.CreateProjectIssueComment(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.CreateProjectIssueComment(
+			context.Background(),
+			&generatedapi.CreateIssueCommentRequest{Body: "hello"},
+			params,
+		)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
+
+func TestRestAPI_DeleteProjectIssueComment(t *testing.T) {
+	params := generatedapi.DeleteProjectIssueCommentParams{ProjectID: 1, IssueID: 123, CommentID: 6}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueActivityUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueActivityUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().
+			DeleteComment(mock.Anything, domain.ProjectID(1), domain.IssueID(123), domain.IssueCommentID(6)).
+			Return(nil)
+
+		resp, err := api.DeleteProjectIssueComment(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.DeleteProjectIssueCommentNoContent{}, resp)
+	})
+
+	t.Run("not the author", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueActivityUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueActivityUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().
+			DeleteComment(mock.Anything, domain.ProjectID(1), domain.IssueID(123), domain.IssueCommentID(6)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.DeleteProjectIssueComment(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+
+	t.Run("comment not found", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueActivityUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueActivityUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().
+			DeleteComment(mock.Anything, domain.ProjectID(1), domain.IssueID(123), domain.IssueCommentID(6)).
+			Return(domain.ErrEntityNotFound)
+
+		resp, err := api.DeleteProjectIssueComment(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+}
+
+func TestRestAPI_ListProjectIssueActivity(t *testing.T) {
+	mockUseCase := mockcontract.NewMockIssueActivityUseCase(t)
+	mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+	api := &RestAPI{issueActivityUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+	status := domain.IssueStatusResolved
+	userID := domain.UserID(2)
+	mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+	mockUseCase.EXPECT().
+		ListActivities(mock.Anything, domain.ProjectID(1), &domain.IssueActivitiesFilter{
+			IssueID: 123,
+			PageNum: 2,
+			PerPage: 10,
+		}).
+		Return([]domain.IssueActivity{{
+			ID:       1,
+			IssueID:  123,
+			Type:     domain.IssueActivityStatusChanged,
+			UserID:   &userID,
+			Username: "bob",
+			Data:     domain.IssueActivityData{Status: status},
+		}}, 11, nil)
+
+	resp, err := api.ListProjectIssueActivity(context.Background(), generatedapi.ListProjectIssueActivityParams{
+		ProjectID: 1,
+		IssueID:   123,
+		Page:      generatedapi.NewOptUint(2),
+		PerPage:   generatedapi.NewOptUint(10),
+	})
+	require.NoError(t, err)
+	require.IsType(t, &generatedapi.IssueActivityListResponse{}, resp)
+
+	list := resp.(*generatedapi.IssueActivityListResponse)
+	require.Equal(t, uint(11), list.Total)
+	require.Len(t, list.Activities, 1)
+	require.Equal(t, generatedapi.IssueActivityTypeStatusChanged, list.Activities[0].Type)
+}
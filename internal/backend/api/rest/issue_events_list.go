@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) ListProjectIssueEvents(
+	ctx context.Context,
+	params generatedapi.ListProjectIssueEventsParams,
+) (generatedapi.ListProjectIssueEventsRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("project not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	filter := domain.IssueEventsFilter{
+		ProjectID: projectID,
+		IssueID:   domain.IssueID(params.IssueID),
+		Limit:     params.Limit.Or(0),
+	}
+	if params.Cursor.Set {
+		cursor, err := dto.DecodeEventCursor(params.Cursor.Value)
+		if err != nil {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		filter.Cursor = &cursor
+	}
+
+	page, err := r.eventUseCase.IssueEvents(ctx, &filter)
+	if err != nil {
+		slog.Error("list issue events failed", "error", err, "project_id", projectID, "issue_id", params.IssueID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("issue not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.MakeSearchEventsResponse(page)
+
+	return &resp, nil
+}
@@ -30,6 +30,9 @@ func (r *RestAPI) GetProjectIssueEventsTimeseries(
 		Levels:    nil,
 		GroupBy:   domain.EventTimeseriesGroupLevel,
 	}
+	if params.Environment.Set {
+		filter.Environment = &params.Environment.Value
+	}
 
 	list, err := r.eventUseCase.IssueTimeseries(ctx, &filter)
 	if err != nil {
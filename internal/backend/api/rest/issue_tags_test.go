@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_GetProjectIssueTags(t *testing.T) {
+	params := generatedapi.GetProjectIssueTagsParams{ProjectID: 1, IssueID: 7}
+	expectedFilter := &domain.IssueTagsFilter{ProjectID: 1, IssueID: 7}
+
+	t.Run("success", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		seen := time.Unix(1700000000, 0).UTC()
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().IssueTags(mock.Anything, expectedFilter).Return([]domain.IssueTag{{
+			Key:          "browser",
+			TotalEvents:  4,
+			UniqueValues: 2,
+			TopValues: []domain.IssueTagValue{
+				{Value: "Chrome", Count: 3, FirstSeen: seen, LastSeen: seen},
+				{Value: "Firefox", Count: 1, FirstSeen: seen, LastSeen: seen},
+			},
+		}}, nil)
+
+		resp, err := api.GetProjectIssueTags(context.Background(), params)
+		require.NoError(t, err)
+
+		tagsResp, ok := resp.(*generatedapi.IssueTagsResponse)
+		require.True(t, ok)
+		require.Len(t, tagsResp.Tags, 1)
+		require.Equal(t, "browser", tagsResp.Tags[0].Key)
+		require.Len(t, tagsResp.Tags[0].TopValues, 2)
+		require.InDelta(t, 75.0, tagsResp.Tags[0].TopValues[0].Percentage, 0.001)
+		require.InDelta(t, 25.0, tagsResp.Tags[0].TopValues[1].Percentage, 0.001)
+	})
+
+	t.Run("issue not found", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().IssueTags(mock.Anything, expectedFilter).Return(nil, domain.ErrEntityNotFound)
+
+		resp, err := api.GetProjectIssueTags(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+}
+
+func TestRestAPI_ListProjectIssueTagEvents(t *testing.T) {
+	params := generatedapi.ListProjectIssueTagEventsParams{ProjectID: 1, IssueID: 7, Key: "browser", Value: "Chrome"}
+
+	t.Run("success", func(t *testing.T) {
+		mockEventUseCase := mockcontract.NewMockEventUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{eventUseCase: mockEventUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockEventUseCase.EXPECT().IssueTagEvents(mock.Anything, &domain.IssueTagEventsFilter{
+			ProjectID: 1,
+			IssueID:   7,
+			Key:       "browser",
+			Value:     "Chrome",
+		}).Return(domain.EventSearchPage{Events: []domain.Event{{ID: "ev1", ProjectID: 1}}}, nil)
+
+		resp, err := api.ListProjectIssueTagEvents(context.Background(), params)
+		require.NoError(t, err)
+
+		eventsResp, ok := resp.(*generatedapi.SearchEventsResponse)
+		require.True(t, ok)
+		require.Len(t, eventsResp.Events, 1)
+		require.False(t, eventsResp.NextCursor.Set)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+
+		invalidParams := params
+		invalidParams.Cursor = generatedapi.NewOptString("not a cursor")
+
+		resp, err := api.ListProjectIssueTagEvents(context.Background(), invalidParams)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+}
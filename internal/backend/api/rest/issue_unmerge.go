@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) UnmergeIssue(
+	ctx context.Context,
+	req *generatedapi.UnmergeIssueRequest,
+	params generatedapi.UnmergeIssueParams,
+) (generatedapi.UnmergeIssueRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+	issueID := domain.IssueID(params.IssueID)
+
+	// Check if the user has permission to manage the issue
+	if err := r.permissionsService.CanManageIssue(ctx, issueID); err != nil {
+		slog.Error("permission denied", "error", err, "issue_id", issueID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("issue not found"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	issues, err := r.issueUseCase.Unmerge(ctx, projectID, issueID, req.Fingerprints)
+	if err != nil {
+		slog.Error("unmerge issue failed", "error", err, "issue_id", issueID)
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidIssueMerge):
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		case errors.Is(err, domain.ErrEntityNotFound):
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("issue not found"),
+			}}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	items := make([]generatedapi.Issue, len(issues))
+	for i := range issues {
+		items[i] = dto.DomainIssueToAPI(issues[i].Issue, issues[i].ProjectName, nil, nil, nil)
+	}
+
+	return &generatedapi.UnmergeIssueResponse{Issues: items}, nil
+}
@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) BulkUpdateIssues(
+	ctx context.Context,
+	req *generatedapi.IssueBulkRequest,
+	params generatedapi.BulkUpdateIssuesParams,
+) (generatedapi.BulkUpdateIssuesRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project, every issue is checked by the use case
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("project not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	operation, err := dto.MakeIssueBulkOperation(projectID, req)
+	if err != nil {
+		slog.Error("invalid issues query", "error", err)
+
+		return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+			Message: generatedapi.NewOptString(err.Error()),
+		}}, nil
+	}
+
+	result, err := r.issueBulkUseCase.Apply(ctx, operation)
+	if err != nil {
+		slog.Error("bulk issue operation failed", "error", err, "project_id", projectID, "action", req.Action)
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidBulkOperation),
+			errors.Is(err, domain.ErrInvalidIgnoreConditions),
+			errors.Is(err, domain.ErrInvalidResolvedRelease),
+			errors.Is(err, domain.ErrInvalidAssignee),
+			errors.Is(err, domain.ErrInvalidIssueMerge):
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		case errors.Is(err, domain.ErrEntityNotFound):
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("issue not found"),
+			}}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	resp := dto.DomainIssueBulkResultToAPI(result)
+
+	return &resp, nil
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_BulkUpdateIssues(t *testing.T) {
+	params := generatedapi.BulkUpdateIssuesParams{ProjectID: 1}
+
+	t.Run("resolve by filter", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueBulkUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueBulkUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		status := domain.IssueStatusUnresolved
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().
+			Apply(mock.Anything, mock.MatchedBy(func(operation domain.IssueBulkOperation) bool {
+				return operation.ProjectID == 1 &&
+					operation.Action == domain.IssueBulkActionResolve &&
+					operation.Filter != nil && *operation.Filter.Status == status &&
+					len(operation.Filter.Query) == 1 && operation.Filter.Query[0].Key == "release"
+			})).
+			Return(domain.IssueBulkResult{
+				Changed: []domain.IssueID{10, 11},
+				Denied:  []domain.IssueID{12},
+				HasMore: true,
+			}, nil)
+
+		req := &generatedapi.IssueBulkRequest{
+			Action: generatedapi.IssueBulkActionResolve,
+			Filter: generatedapi.NewOptIssueBulkFilter(generatedapi.IssueBulkFilter{
+				Query:  generatedapi.NewOptString("release:1.2.0"),
+				Status: generatedapi.NewOptIssueStatus(generatedapi.IssueStatusUnresolved),
+			}),
+		}
+		resp, err := api.BulkUpdateIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.Equal(t, &generatedapi.IssueBulkResponse{
+			Changed:   []uint{10, 11},
+			Unchanged: []uint{},
+			Denied:    []uint{12},
+			NotFound:  []uint{},
+			HasMore:   true,
+		}, resp)
+	})
+
+	t.Run("invalid operation", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueBulkUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueBulkUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().Apply(mock.Anything, mock.Anything).
+			Return(domain.IssueBulkResult{}, domain.ErrInvalidBulkOperation)
+
+		req := &generatedapi.IssueBulkRequest{Action: generatedapi.IssueBulkActionMerge, IssueIds: []uint{2}}
+		resp, err := api.BulkUpdateIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+
+		req := &generatedapi.IssueBulkRequest{
+			Action: generatedapi.IssueBulkActionDelete,
+			Filter: generatedapi.NewOptIssueBulkFilter(generatedapi.IssueBulkFilter{
+				Query: generatedapi.NewOptString("release:"),
+			}),
+		}
+		resp, err := api.BulkUpdateIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("primary issue not manageable", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueBulkUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueBulkUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().Apply(mock.Anything, mock.Anything).
+			Return(domain.IssueBulkResult{}, domain.ErrPermissionDenied)
+
+		req := &generatedapi.IssueBulkRequest{
+			Action:         generatedapi.IssueBulkActionMerge,
+			IssueIds:       []uint{2},
+			PrimaryIssueID: generatedapi.NewOptUint(1),
+		}
+		resp, err := api.BulkUpdateIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+
+	t.Run("project permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		req := &generatedapi.IssueBulkRequest{Action: generatedapi.IssueBulkActionDelete, IssueIds: []uint{2}}
+		resp, err := api.BulkUpdateIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
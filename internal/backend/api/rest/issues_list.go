@@ -12,9 +12,16 @@ func (r *RestAPI) ListIssues(
 	ctx context.Context,
 	params generatedapi.ListIssuesParams,
 ) (generatedapi.ListIssuesRes, error) {
-	filter := dto.MakeIssuesListFilter(params)
+	filter, err := dto.MakeIssuesListFilter(params)
+	if err != nil {
+		slog.Error("invalid issues query", "error", err)
+
+		return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+			Message: generatedapi.NewOptString(err.Error()),
+		}}, nil
+	}
 
-	list, total, err := r.issueUseCase.List(ctx, &filter)
+	list, total, truncated, err := r.issueUseCase.List(ctx, &filter)
 	if err != nil {
 		slog.Error("list issues failed", "error", err)
 
@@ -34,9 +41,10 @@ func (r *RestAPI) ListIssues(
 	}
 
 	return &generatedapi.ListIssuesResponse{
-		Issues:  issues,
-		Total:   uint(total),
-		Page:    params.Page,
-		PerPage: params.PerPage,
+		Issues:    issues,
+		Total:     uint(total),
+		Page:      params.Page,
+		PerPage:   params.PerPage,
+		Truncated: truncated,
 	}, nil
 }
@@ -29,7 +29,8 @@ func TestRestAPI_ListIssues(t *testing.T) {
 			PerPage: 10,
 		}
 
-		expectedFilter := dto.MakeIssuesListFilter(params)
+		expectedFilter, err := dto.MakeIssuesListFilter(params)
+		require.NoError(t, err)
 		expectedIssues := []domain.IssueExtended{
 			{
 				Issue: domain.Issue{
@@ -73,7 +74,7 @@ func TestRestAPI_ListIssues(t *testing.T) {
 
 		mockIssueUseCase.EXPECT().
 			List(mock.Anything, &expectedFilter).
-			Return(expectedIssues, expectedTotal, nil)
+			Return(expectedIssues, expectedTotal, false, nil)
 
 		resp, err := api.ListIssues(context.Background(), params)
 
@@ -117,13 +118,14 @@ func TestRestAPI_ListIssues(t *testing.T) {
 			PerPage: 10,
 		}
 
-		expectedFilter := dto.MakeIssuesListFilter(params)
+		expectedFilter, err := dto.MakeIssuesListFilter(params)
+		require.NoError(t, err)
 		expectedIssues := []domain.IssueExtended{}
 		expectedTotal := uint64(0)
 
 		mockIssueUseCase.EXPECT().
 			List(mock.Anything, &expectedFilter).
-			Return(expectedIssues, expectedTotal, nil)
+			Return(expectedIssues, expectedTotal, false, nil)
 
 		resp, err := api.ListIssues(context.Background(), params)
 
@@ -148,12 +150,13 @@ func TestRestAPI_ListIssues(t *testing.T) {
 			PerPage: 10,
 		}
 
-		expectedFilter := dto.MakeIssuesListFilter(params)
+		expectedFilter, err := dto.MakeIssuesListFilter(params)
+		require.NoError(t, err)
 		unexpectedErr := errors.New("database error")
 
 		mockIssueUseCase.EXPECT().
 			List(mock.Anything, &expectedFilter).
-			Return(nil, uint64(0), unexpectedErr)
+			Return(nil, uint64(0), false, unexpectedErr)
 
 		resp, err := api.ListIssues(context.Background(), params)
 
@@ -176,13 +179,14 @@ func TestRestAPI_ListIssues(t *testing.T) {
 			ProjectID: generatedapi.OptUint{Value: projectID, Set: true},
 		}
 
-		expectedFilter := dto.MakeIssuesListFilter(params)
+		expectedFilter, err := dto.MakeIssuesListFilter(params)
+		require.NoError(t, err)
 		expectedIssues := []domain.IssueExtended{}
 		expectedTotal := uint64(0)
 
 		mockIssueUseCase.EXPECT().
 			List(mock.Anything, &expectedFilter).
-			Return(expectedIssues, expectedTotal, nil)
+			Return(expectedIssues, expectedTotal, false, nil)
 
 		resp, err := api.ListIssues(context.Background(), params)
 
@@ -208,13 +212,14 @@ func TestRestAPI_ListIssues(t *testing.T) {
 			Status:  generatedapi.OptIssueStatus{Value: status, Set: true},
 		}
 
-		expectedFilter := dto.MakeIssuesListFilter(params)
+		expectedFilter, err := dto.MakeIssuesListFilter(params)
+		require.NoError(t, err)
 		var expectedIssues []domain.IssueExtended
 		expectedTotal := uint64(0)
 
 		mockIssueUseCase.EXPECT().
 			List(mock.Anything, &expectedFilter).
-			Return(expectedIssues, expectedTotal, nil)
+			Return(expectedIssues, expectedTotal, false, nil)
 
 		resp, err := api.ListIssues(context.Background(), params)
 
@@ -225,4 +230,50 @@ func TestRestAPI_ListIssues(t *testing.T) {
 		require.True(t, ok)
 		assert.Equal(t, domain.IssueStatusUnresolved, *expectedFilter.Status)
 	})
+
+	t.Run("truncated search", func(t *testing.T) {
+		mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+
+		api := &RestAPI{
+			issueUseCase: mockIssueUseCase,
+		}
+
+		params := generatedapi.ListIssuesParams{
+			Query:   generatedapi.NewOptString("environment:production"),
+			Page:    1,
+			PerPage: 10,
+		}
+
+		expectedFilter, err := dto.MakeIssuesListFilter(params)
+		require.NoError(t, err)
+
+		mockIssueUseCase.EXPECT().
+			List(mock.Anything, &expectedFilter).
+			Return([]domain.IssueExtended{}, uint64(0), true, nil)
+
+		resp, err := api.ListIssues(context.Background(), params)
+
+		require.NoError(t, err)
+		listResp, ok := resp.(*generatedapi.ListIssuesResponse)
+		require.True(t, ok)
+		assert.True(t, listResp.Truncated)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		api := &RestAPI{
+			issueUseCase: mockcontract.NewMockIssueUseCase(t),
+		}
+
+		params := generatedapi.ListIssuesParams{
+			Query:   generatedapi.NewOptString(`message:"timeout`),
+			Page:    1,
+			PerPage: 10,
+		}
+
+		resp, err := api.ListIssues(context.Background(), params)
+
+		require.NoError(t, err)
+		_, ok := resp.(*generatedapi.ErrorBadRequest)
+		require.True(t, ok)
+	})
 }
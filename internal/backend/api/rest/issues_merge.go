@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) MergeIssues(
+	ctx context.Context,
+	req *generatedapi.MergeIssuesRequest,
+	params generatedapi.MergeIssuesParams,
+) (generatedapi.MergeIssuesRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user has permission to manage issues of the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, true); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	primaryID := domain.IssueID(req.PrimaryIssueID)
+	issueIDs := make([]domain.IssueID, len(req.IssueIds))
+	for i, id := range req.IssueIds {
+		issueIDs[i] = domain.IssueID(id)
+	}
+
+	err := r.issueUseCase.Merge(ctx, projectID, primaryID, issueIDs)
+	if err != nil {
+		slog.Error("merge issues failed", "error", err, "project_id", projectID, "issue_id", primaryID)
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidIssueMerge):
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		case errors.Is(err, domain.ErrEntityNotFound):
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("issue not found"),
+			}}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	issue, err := r.issueUseCase.GetByIDWithChildren(ctx, primaryID)
+	if err != nil {
+		slog.Error("get issue failed", "error", err)
+
+		return nil, err
+	}
+
+	resp := dto.MakeIssueResponseWithEvent(issue)
+
+	return &resp, nil
+}
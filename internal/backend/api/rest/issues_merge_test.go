@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_MergeIssues(t *testing.T) {
+	req := &generatedapi.MergeIssuesRequest{PrimaryIssueID: 1, IssueIds: []uint{2, 3}}
+	params := generatedapi.MergeIssuesParams{ProjectID: 1}
+	issueIDs := []domain.IssueID{2, 3}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), true).Return(nil)
+		mockUseCase.EXPECT().Merge(mock.Anything, domain.ProjectID(1), domain.IssueID(1), issueIDs).Return(nil)
+		mockUseCase.EXPECT().GetByIDWithChildren(mock.Anything, domain.IssueID(1)).
+			Return(domain.IssueExtendedWithChildren{
+				Issue:              domain.Issue{ID: 1, ProjectID: 1, Source: domain.SourceEvent},
+				MergedFingerprints: []string{"fp-2", "fp-3"},
+			}, nil)
+
+		resp, err := api.MergeIssues(context.Background(), req, params)
+		require.NoError(t, err)
+
+		issue, ok := resp.(*generatedapi.IssueResponse)
+		require.True(t, ok)
+		require.Equal(t, uint(1), issue.Issue.ID)
+		require.Equal(t, []string{"fp-2", "fp-3"}, issue.MergedFingerprints)
+	})
+
+	t.Run("invalid merge", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), true).Return(nil)
+		mockUseCase.EXPECT().Merge(mock.Anything, domain.ProjectID(1), domain.IssueID(1), issueIDs).
+			Return(fmt.Errorf("%w: issue 1 cannot be merged into itself", domain.ErrInvalidIssueMerge))
+
+		resp, err := api.MergeIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("issue not found", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockIssueUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{issueUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), true).Return(nil)
+		mockUseCase.EXPECT().Merge(mock.Anything, domain.ProjectID(1), domain.IssueID(1), issueIDs).
+			Return(domain.ErrEntityNotFound)
+
+		resp, err := api.MergeIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanManageProject(mock.Anything, domain.ProjectID(1), true).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.MergeIssues(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
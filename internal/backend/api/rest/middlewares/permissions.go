@@ -16,6 +16,8 @@ import (
 const (
 	RecentKeyword = "recent"
 	issuesStr     = "issues"
+	mergeKeyword  = "merge"
+	bulkKeyword   = "bulk"
 )
 
 // ProjectAccess middleware checks if the user has access to the project.
@@ -143,7 +145,7 @@ func ProjectManagement(permissionsService contract.PermissionsService) func(http
 				return
 			}
 
-			isIssueManagement := len(parts) >= 7 && parts[5] == issuesStr && parts[7] == "change-status"
+			isIssueManagement := isIssueManagementPath(parts)
 
 			projectID, err := strconv.ParseUint(projectIDStr, 10, 64)
 			if err != nil {
@@ -214,6 +216,30 @@ func ProjectManagement(permissionsService contract.PermissionsService) func(http
 	}
 }
 
+// isIssueManagementPath reports whether the path changes issues of the project,
+// such requests are allowed to all the project team members.
+// Expected format: /api/v1/projects/{projectID}/issues/{issueID}/{action}.
+func isIssueManagementPath(parts []string) bool {
+	if len(parts) < 7 || parts[5] != issuesStr {
+		return false
+	}
+
+	if parts[6] == mergeKeyword || parts[6] == bulkKeyword {
+		return true
+	}
+
+	if len(parts) < 8 {
+		return false
+	}
+
+	switch parts[7] {
+	case "change-status", "unmerge", "assignee", "comments":
+		return true
+	default:
+		return false
+	}
+}
+
 // IssueAccess middleware checks if the user has access to the issue.
 func IssueAccess(permissionsService contract.PermissionsService) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -230,7 +256,11 @@ func IssueAccess(permissionsService contract.PermissionsService) func(http.Handl
 				}
 			}
 
-			if issueIDStr == "" || issueIDStr == RecentKeyword || issueIDStr == "timeseries" {
+			if issueIDStr == "" ||
+				issueIDStr == RecentKeyword ||
+				issueIDStr == "timeseries" ||
+				issueIDStr == mergeKeyword ||
+				issueIDStr == bulkKeyword {
 				next.ServeHTTP(writer, request)
 
 				return
@@ -323,7 +353,9 @@ func IssueManagement(permissionsService contract.PermissionsService) func(http.H
 
 			if issueIDStr == "" ||
 				issueIDStr == RecentKeyword ||
-				issueIDStr == "timeseries" {
+				issueIDStr == "timeseries" ||
+				issueIDStr == mergeKeyword ||
+				issueIDStr == bulkKeyword {
 				next.ServeHTTP(writer, request)
 
 				return
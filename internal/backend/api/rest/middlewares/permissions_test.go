@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/rom8726/warden/internal/backend/services/permissions"
 	wardencontext "github.com/rom8726/warden/internal/context"
 	"github.com/rom8726/warden/internal/domain"
 	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
@@ -244,6 +245,39 @@ func TestProjectManagement(t *testing.T) {
 			expectedStatus: http.StatusInternalServerError,
 			checkContext:   false,
 		},
+		{
+			name: "Issues merge is checked as issue management",
+			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
+				mockSvc.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(123), true).
+					Return(nil)
+			},
+			path:           "/api/v1/projects/123/issues/merge",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+			checkContext:   true,
+		},
+		{
+			name: "Bulk issue operations are checked as issue management",
+			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
+				mockSvc.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(123), true).
+					Return(nil)
+			},
+			path:           "/api/v1/projects/123/issues/bulk",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+			checkContext:   true,
+		},
+		{
+			name: "Issue assignment is checked as issue management",
+			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
+				mockSvc.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(123), true).
+					Return(nil)
+			},
+			path:           "/api/v1/projects/123/issues/456/assignee",
+			method:         http.MethodPut,
+			expectedStatus: http.StatusOK,
+			checkContext:   true,
+		},
 		{
 			name: "Successful management sets project ID in context",
 			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
@@ -333,6 +367,15 @@ func TestIssueAccess(t *testing.T) {
 			method:         http.MethodGet,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name: "Bulk issue operations bypass permission check",
+			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
+				// No expectations, every issue is checked by the use case
+			},
+			path:           "/api/v1/projects/123/issues/bulk",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name: "Invalid issue ID returns 400",
 			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
@@ -460,6 +503,15 @@ func TestIssueManagement(t *testing.T) {
 			method:         http.MethodPut,
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name: "Bulk issue operations bypass permission check",
+			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
+				// No expectations, every issue is checked by the use case
+			},
+			path:           "/api/v1/projects/123/issues/bulk",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name: "Invalid issue ID returns 400",
 			setupMock: func(mockSvc *mockcontract.MockPermissionsService) {
@@ -540,3 +592,94 @@ func TestIssueManagement(t *testing.T) {
 		})
 	}
 }
+
+func TestPermissionsChain_TeamMember(t *testing.T) {
+	t.Parallel()
+
+	teamID := domain.TeamID(1)
+	tests := []struct {
+		name           string
+		path           string
+		method         string
+		expectedStatus int
+	}{
+		{
+			name:           "member comments an issue",
+			path:           "/api/v1/projects/1/issues/2/comments",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "member deletes a comment",
+			path:           "/api/v1/projects/1/issues/2/comments/5",
+			method:         http.MethodDelete,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "member assigns an issue",
+			path:           "/api/v1/projects/1/issues/2/assignee",
+			method:         http.MethodPut,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "member changes issue status",
+			path:           "/api/v1/projects/1/issues/2/change-status",
+			method:         http.MethodPut,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "member applies a bulk issue operation",
+			path:           "/api/v1/projects/1/issues/bulk",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "member can't update the project",
+			path:           "/api/v1/projects/1",
+			method:         http.MethodPut,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockTeams := mockcontract.NewMockTeamsUseCase(t)
+			mockProjects := mockcontract.NewMockProjectsRepository(t)
+			mockIssues := mockcontract.NewMockIssuesRepository(t)
+
+			mockProjects.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+				Return(domain.Project{ID: 1, TeamID: &teamID}, nil)
+			mockIssues.EXPECT().GetByID(mock.Anything, domain.IssueID(2)).
+				Return(domain.Issue{ID: 2, ProjectID: 1}, nil).Maybe()
+			mockTeams.EXPECT().GetTeamsByUserID(mock.Anything, domain.UserID(3)).
+				Return([]domain.Team{{ID: teamID}}, nil).Maybe()
+			mockTeams.EXPECT().GetMembers(mock.Anything, teamID).
+				Return([]domain.TeamMember{
+					{TeamID: teamID, UserID: 2, Role: domain.RoleOwner},
+					{TeamID: teamID, UserID: 3, Role: domain.RoleMember},
+				}, nil)
+
+			permissionsService := permissions.New(mockTeams, mockProjects, mockIssues)
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := ProjectAccess(permissionsService)(
+				ProjectManagement(permissionsService)(
+					IssueAccess(permissionsService)(
+						IssueManagement(permissionsService)(testHandler),
+					),
+				),
+			)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req = req.WithContext(wardencontext.WithUserID(req.Context(), 3))
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
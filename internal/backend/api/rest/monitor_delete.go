@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) DeleteMonitor(
+	ctx context.Context,
+	params generatedapi.DeleteMonitorParams,
+) (generatedapi.DeleteMonitorRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+	monitorID := domain.MonitorID(params.MonitorID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	err := r.monitorsUseCase.Delete(ctx, projectID, monitorID)
+	if err != nil {
+		slog.Error("delete monitor failed", "error", err, "project_id", projectID, "monitor_id", monitorID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	return &generatedapi.DeleteMonitorNoContent{}, nil
+}
@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_GetMonitor(t *testing.T) {
+	params := generatedapi.GetMonitorParams{ProjectID: 1, MonitorID: 3}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockMonitorsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{monitorsUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		duration := 90 * time.Second
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().GetWithCheckIns(mock.Anything, domain.ProjectID(1), domain.MonitorID(3)).Return(
+			domain.MonitorWithCheckIns{
+				Monitor: domain.Monitor{
+					ID:            3,
+					ProjectID:     1,
+					Slug:          "nightly",
+					ScheduleType:  domain.MonitorScheduleCrontab,
+					CheckInMargin: 5 * time.Minute,
+					Status:        domain.MonitorStatusMissed,
+				},
+				CheckIns: []domain.MonitorCheckIn{
+					{ID: 10, Status: domain.CheckInStatusOK, Duration: &duration},
+					{ID: 9, Status: domain.CheckInStatusMissed},
+				},
+			},
+			nil,
+		)
+
+		resp, err := api.GetMonitor(context.Background(), params)
+		require.NoError(t, err)
+
+		monitor, ok := resp.(*generatedapi.MonitorWithCheckIns)
+		require.True(t, ok)
+		require.Equal(t, "nightly", monitor.Monitor.Slug)
+		require.Equal(t, uint(5), monitor.Monitor.CheckinMargin)
+		require.Equal(t, generatedapi.MonitorStatusMissed, monitor.Monitor.Status)
+		require.Len(t, monitor.CheckIns, 2)
+		require.InDelta(t, 90.0, monitor.CheckIns[0].Duration.Value, 0.001)
+		require.False(t, monitor.CheckIns[1].Duration.Set)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockMonitorsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{monitorsUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().GetWithCheckIns(mock.Anything, domain.ProjectID(1), domain.MonitorID(3)).
+			Return(domain.MonitorWithCheckIns{}, domain.ErrEntityNotFound)
+
+		resp, err := api.GetMonitor(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.GetMonitor(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
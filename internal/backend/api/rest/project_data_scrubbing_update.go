@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) UpdateProjectDataScrubbing(
+	ctx context.Context,
+	req *generatedapi.UpdateDataScrubbingRequest,
+	params generatedapi.UpdateProjectDataScrubbingParams,
+) (generatedapi.UpdateProjectDataScrubbingRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	scrubbing, err := r.dataScrubbingUseCase.Update(ctx, dto.DataScrubbingFromAPI(projectID, req))
+	if err != nil {
+		slog.Error("update project data scrubbing failed", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrInvalidDataScrubbing) {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.DomainDataScrubbingToAPI(scrubbing)
+
+	return &resp, nil
+}
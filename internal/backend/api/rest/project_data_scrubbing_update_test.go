@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/backend/usecases/projects"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_UpdateProjectDataScrubbing(t *testing.T) {
+	req := &generatedapi.UpdateDataScrubbingRequest{
+		DefaultScrubbers:   true,
+		SensitiveFields:    []string{"email"},
+		Patterns:           []string{},
+		AnonymizeIP:        true,
+		DropRequestCookies: true,
+		DropRequestData:    true,
+	}
+	params := generatedapi.UpdateProjectDataScrubbingParams{ProjectID: 1}
+	expectedScrubbing := domain.DataScrubbing{
+		ProjectID:          1,
+		DefaultScrubbers:   true,
+		SensitiveFields:    []string{"email"},
+		Patterns:           []string{},
+		AnonymizeIP:        true,
+		DropRequestCookies: true,
+		DropRequestData:    true,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockScrubbingUseCase := mockcontract.NewMockDataScrubbingUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{dataScrubbingUseCase: mockScrubbingUseCase, permissionsService: mockPermissionsService}
+
+		saved := expectedScrubbing
+		saved.UpdatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockScrubbingUseCase.EXPECT().Update(mock.Anything, expectedScrubbing).Return(saved, nil)
+
+		resp, err := api.UpdateProjectDataScrubbing(context.Background(), req, params)
+		require.NoError(t, err)
+
+		scrubbingResp, ok := resp.(*generatedapi.DataScrubbing)
+		require.True(t, ok)
+		require.Equal(t, uint(1), scrubbingResp.ProjectID)
+		require.True(t, scrubbingResp.DefaultScrubbers)
+		require.True(t, scrubbingResp.AnonymizeIP)
+		require.True(t, scrubbingResp.DropRequestCookies)
+		require.True(t, scrubbingResp.DropRequestData)
+		require.Equal(t, []string{"email"}, scrubbingResp.SensitiveFields)
+		require.Equal(t, []string{}, scrubbingResp.Patterns)
+		require.Contains(t, scrubbingResp.DefaultSensitiveFields, "password")
+		require.Equal(t, generatedapi.NewOptNilDateTime(saved.UpdatedAt), scrubbingResp.UpdatedAt)
+	})
+
+	testProjectSettingsUpdateErrors(t, projectSettingsUpdate{
+		newAPI: func(
+			t *testing.T,
+			permissionsService *mockcontract.MockPermissionsService,
+		) (*RestAPI, func(err error)) {
+			mockScrubbingUseCase := mockcontract.NewMockDataScrubbingUseCase(t)
+			api := &RestAPI{dataScrubbingUseCase: mockScrubbingUseCase, permissionsService: permissionsService}
+
+			return api, func(err error) {
+				mockScrubbingUseCase.EXPECT().Update(mock.Anything, expectedScrubbing).
+					Return(domain.DataScrubbing{}, err)
+			}
+		},
+		update: func(ctx context.Context, api *RestAPI) (any, error) {
+			return api.UpdateProjectDataScrubbing(ctx, req, params)
+		},
+		invalidErr: domain.ErrInvalidDataScrubbing,
+	})
+}
+
+func TestRestAPI_UpdateProjectDataScrubbing_Rules(t *testing.T) {
+	params := generatedapi.UpdateProjectDataScrubbingParams{ProjectID: 1}
+
+	t.Run("rules are normalized before saving", func(t *testing.T) {
+		mockScrubbingRepo := mockcontract.NewMockDataScrubbingRepository(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{
+			dataScrubbingUseCase: projects.NewDataScrubbingService(mockScrubbingRepo),
+			permissionsService:   mockPermissionsService,
+		}
+
+		expected := domain.DataScrubbing{
+			ProjectID:       1,
+			SensitiveFields: []string{"email", "X-Api-Key"},
+			Patterns:        []string{`\d{3}-\d{2}-\d{4}`},
+		}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockScrubbingRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.DataScrubbing{ProjectID: 1}, nil)
+		mockScrubbingRepo.EXPECT().Upsert(mock.Anything, expected).Return(expected, nil)
+
+		resp, err := api.UpdateProjectDataScrubbing(context.Background(), &generatedapi.UpdateDataScrubbingRequest{
+			SensitiveFields: []string{" email ", "", "X-Api-Key", "email"},
+			Patterns:        []string{` \d{3}-\d{2}-\d{4} `},
+		}, params)
+		require.NoError(t, err)
+
+		scrubbingResp, ok := resp.(*generatedapi.DataScrubbing)
+		require.True(t, ok)
+		require.Equal(t, expected.SensitiveFields, scrubbingResp.SensitiveFields)
+		require.Equal(t, expected.Patterns, scrubbingResp.Patterns)
+	})
+
+	invalidTests := []struct {
+		name    string
+		req     *generatedapi.UpdateDataScrubbingRequest
+		message string
+	}{
+		{
+			name:    "invalid pattern",
+			req:     &generatedapi.UpdateDataScrubbingRequest{Patterns: []string{"(unclosed"}},
+			message: `invalid data scrubbing: invalid pattern "(unclosed"`,
+		},
+		{
+			name:    "pattern matching empty strings",
+			req:     &generatedapi.UpdateDataScrubbingRequest{Patterns: []string{"a*"}},
+			message: `invalid data scrubbing: pattern "a*" matches empty strings`,
+		},
+		{
+			name:    "field of separators only",
+			req:     &generatedapi.UpdateDataScrubbingRequest{SensitiveFields: []string{"_-_"}},
+			message: `invalid data scrubbing: invalid sensitive field "_-_"`,
+		},
+	}
+
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+			api := &RestAPI{
+				dataScrubbingUseCase: projects.NewDataScrubbingService(mockcontract.NewMockDataScrubbingRepository(t)),
+				permissionsService:   mockPermissionsService,
+			}
+
+			mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+
+			resp, err := api.UpdateProjectDataScrubbing(context.Background(), tt.req, params)
+			require.NoError(t, err)
+
+			badRequest, ok := resp.(*generatedapi.ErrorBadRequest)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Error.Message.Value, tt.message)
+		})
+	}
+}
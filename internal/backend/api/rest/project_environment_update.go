@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) UpdateProjectEnvironment(
+	ctx context.Context,
+	req *generatedapi.UpdateEnvironmentRequest,
+	params generatedapi.UpdateProjectEnvironmentParams,
+) (generatedapi.UpdateProjectEnvironmentRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	err := r.environmentsUseCase.SetHidden(ctx, projectID, params.Name, req.Hidden)
+	if err != nil {
+		slog.Error("update project environment failed", "error", err,
+			"project_id", projectID, "environment", params.Name)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString("environment not found"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	return &generatedapi.UpdateProjectEnvironmentNoContent{}, nil
+}
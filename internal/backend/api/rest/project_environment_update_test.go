@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_UpdateProjectEnvironment(t *testing.T) {
+	req := &generatedapi.UpdateEnvironmentRequest{Hidden: true}
+	params := generatedapi.UpdateProjectEnvironmentParams{ProjectID: 1, Name: "staging"}
+
+	t.Run("success", func(t *testing.T) {
+		mockEnvironmentsUseCase := mockcontract.NewMockEnvironmentsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{environmentsUseCase: mockEnvironmentsUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockEnvironmentsUseCase.EXPECT().SetHidden(mock.Anything, domain.ProjectID(1), "staging", true).Return(nil)
+
+		resp, err := api.UpdateProjectEnvironment(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.UpdateProjectEnvironmentNoContent{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanManageProject(mock.Anything, domain.ProjectID(1), false).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.UpdateProjectEnvironment(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+
+	t.Run("environment not found", func(t *testing.T) {
+		mockEnvironmentsUseCase := mockcontract.NewMockEnvironmentsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{environmentsUseCase: mockEnvironmentsUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockEnvironmentsUseCase.EXPECT().SetHidden(mock.Anything, domain.ProjectID(1), "staging", true).
+			Return(domain.ErrEntityNotFound)
+
+		resp, err := api.UpdateProjectEnvironment(context.Background(), req, params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("unexpected error", func(t *testing.T) {
+		mockEnvironmentsUseCase := mockcontract.NewMockEnvironmentsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{environmentsUseCase: mockEnvironmentsUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockEnvironmentsUseCase.EXPECT().SetHidden(mock.Anything, domain.ProjectID(1), "staging", true).
+			Return(errors.New("db error"))
+
+		resp, err := api.UpdateProjectEnvironment(context.Background(), req, params)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	})
+}
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) ListProjectEnvironments(
+	ctx context.Context,
+	params generatedapi.ListProjectEnvironmentsParams,
+) (generatedapi.ListProjectEnvironmentsRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user has access to the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	environments, err := r.environmentsUseCase.List(ctx, projectID)
+	if err != nil {
+		slog.Error("list project environments failed", "error", err, "project_id", projectID)
+
+		return nil, err
+	}
+
+	resp := dto.MakeListEnvironmentsResponse(environments)
+
+	return &resp, nil
+}
@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) GetProjectGroupingConfig(
+	ctx context.Context,
+	params generatedapi.GetProjectGroupingConfigParams,
+) (generatedapi.GetProjectGroupingConfigRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user has access to the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	strategy, err := r.projectsUseCase.GetGroupingStrategy(ctx, projectID)
+	if err != nil {
+		slog.Error("get project grouping strategy failed", "error", err)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	return makeGroupingConfigResponse(strategy), nil
+}
+
+func makeGroupingConfigResponse(strategy domain.GroupingStrategy) *generatedapi.GroupingConfigResponse {
+	available := make([]generatedapi.GroupingStrategy, 0, len(domain.GroupingStrategies))
+	for _, item := range domain.GroupingStrategies {
+		available = append(available, generatedapi.GroupingStrategy(item))
+	}
+
+	return &generatedapi.GroupingConfigResponse{
+		Strategy:            generatedapi.GroupingStrategy(strategy),
+		AvailableStrategies: available,
+	}
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) UpdateProjectGroupingConfig(
+	ctx context.Context,
+	req *generatedapi.UpdateGroupingConfigRequest,
+	params generatedapi.UpdateProjectGroupingConfigParams,
+) (generatedapi.UpdateProjectGroupingConfigRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	strategy := domain.GroupingStrategy(req.Strategy)
+
+	err := r.projectsUseCase.UpdateGroupingStrategy(ctx, projectID, strategy)
+	if err != nil {
+		slog.Error("update project grouping strategy failed", "error", err)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrInvalidGroupingStrategy) {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	return makeGroupingConfigResponse(strategy), nil
+}
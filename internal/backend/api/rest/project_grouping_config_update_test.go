@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_UpdateProjectGroupingConfig(t *testing.T) {
+	req := &generatedapi.UpdateGroupingConfigRequest{Strategy: generatedapi.GroupingStrategyStacktraceV1}
+	params := generatedapi.UpdateProjectGroupingConfigParams{ProjectID: 1}
+
+	t.Run("success", func(t *testing.T) {
+		mockProjectsUseCase := mockcontract.NewMockProjectsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{projectsUseCase: mockProjectsUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockProjectsUseCase.EXPECT().
+			UpdateGroupingStrategy(mock.Anything, domain.ProjectID(1), domain.GroupingStrategyStacktraceV1).
+			Return(nil)
+
+		resp, err := api.UpdateProjectGroupingConfig(context.Background(), req, params)
+		require.NoError(t, err)
+
+		configResp, ok := resp.(*generatedapi.GroupingConfigResponse)
+		require.True(t, ok)
+		require.Equal(t, generatedapi.GroupingStrategyStacktraceV1, configResp.Strategy)
+		require.Len(t, configResp.AvailableStrategies, len(domain.GroupingStrategies))
+	})
+
+	testProjectSettingsUpdateErrors(t, projectSettingsUpdate{
+		newAPI: func(
+			t *testing.T,
+			permissionsService *mockcontract.MockPermissionsService,
+		) (*RestAPI, func(err error)) {
+			mockProjectsUseCase := mockcontract.NewMockProjectsUseCase(t)
+			api := &RestAPI{projectsUseCase: mockProjectsUseCase, permissionsService: permissionsService}
+
+			return api, func(err error) {
+				mockProjectsUseCase.EXPECT().
+					UpdateGroupingStrategy(mock.Anything, domain.ProjectID(1), domain.GroupingStrategyStacktraceV1).
+					Return(err)
+			}
+		},
+		update: func(ctx context.Context, api *RestAPI) (any, error) {
+			return api.UpdateProjectGroupingConfig(ctx, req, params)
+		},
+		invalidErr: domain.ErrInvalidGroupingStrategy,
+	})
+}
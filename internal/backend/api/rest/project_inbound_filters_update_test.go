@@ -0,0 +1,154 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/backend/usecases/projects"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_UpdateProjectInboundFilters(t *testing.T) {
+	req := &generatedapi.UpdateInboundFiltersRequest{
+		AllowedOrigins:  []string{"https://*.example.com"},
+		FilterLocalhost: true,
+		BlockedIPRanges: []string{"10.0.0.0/8"},
+		ErrorMessages:   []string{},
+		Releases:        []string{"*-dev"},
+	}
+	params := generatedapi.UpdateProjectInboundFiltersParams{ProjectID: 1}
+	expectedFilters := domain.InboundFilters{
+		ProjectID:       1,
+		AllowedOrigins:  []string{"https://*.example.com"},
+		Localhost:       true,
+		BlockedIPRanges: []string{"10.0.0.0/8"},
+		ErrorMessages:   []string{},
+		Releases:        []string{"*-dev"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockFiltersUseCase := mockcontract.NewMockInboundFiltersUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{inboundFiltersUseCase: mockFiltersUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockFiltersUseCase.EXPECT().Update(mock.Anything, expectedFilters).Return(expectedFilters, nil)
+
+		resp, err := api.UpdateProjectInboundFilters(context.Background(), req, params)
+		require.NoError(t, err)
+
+		filtersResp, ok := resp.(*generatedapi.InboundFilters)
+		require.True(t, ok)
+		require.Equal(t, uint(1), filtersResp.ProjectID)
+		require.True(t, filtersResp.FilterLocalhost)
+		require.Equal(t, []string{"*-dev"}, filtersResp.Releases)
+		require.False(t, filtersResp.UpdatedAt.IsSet())
+	})
+
+	testProjectSettingsUpdateErrors(t, projectSettingsUpdate{
+		newAPI: func(
+			t *testing.T,
+			permissionsService *mockcontract.MockPermissionsService,
+		) (*RestAPI, func(err error)) {
+			mockFiltersUseCase := mockcontract.NewMockInboundFiltersUseCase(t)
+			api := &RestAPI{inboundFiltersUseCase: mockFiltersUseCase, permissionsService: permissionsService}
+
+			return api, func(err error) {
+				mockFiltersUseCase.EXPECT().Update(mock.Anything, expectedFilters).
+					Return(domain.InboundFilters{}, err)
+			}
+		},
+		update: func(ctx context.Context, api *RestAPI) (any, error) {
+			return api.UpdateProjectInboundFilters(ctx, req, params)
+		},
+		invalidErr: domain.ErrInvalidInboundFilters,
+	})
+}
+
+func TestRestAPI_UpdateProjectInboundFilters_Semantics(t *testing.T) {
+	params := generatedapi.UpdateProjectInboundFiltersParams{ProjectID: 1}
+
+	t.Run("flags and normalized entries are saved", func(t *testing.T) {
+		mockFiltersRepo := mockcontract.NewMockInboundFiltersRepository(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{
+			inboundFiltersUseCase: projects.NewInboundFiltersService(mockFiltersRepo),
+			permissionsService:    mockPermissionsService,
+		}
+
+		expected := domain.InboundFilters{
+			ProjectID:       1,
+			AllowedOrigins:  []string{"https://example.com"},
+			WebCrawlers:     true,
+			LegacyBrowsers:  true,
+			BlockedIPRanges: []string{"10.0.0.0/8", "2001:db8::1"},
+			ErrorMessages:   []string{"*ResizeObserver*"},
+			Releases:        []string{},
+		}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockFiltersRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.InboundFilters{ProjectID: 1}, nil)
+		mockFiltersRepo.EXPECT().Upsert(mock.Anything, expected).Return(expected, nil)
+
+		resp, err := api.UpdateProjectInboundFilters(context.Background(), &generatedapi.UpdateInboundFiltersRequest{
+			AllowedOrigins:       []string{" https://example.com ", "https://example.com"},
+			FilterWebCrawlers:    true,
+			FilterLegacyBrowsers: true,
+			BlockedIPRanges:      []string{"10.0.0.0/8", " ", "2001:db8::1"},
+			ErrorMessages:        []string{"*ResizeObserver*", "*ResizeObserver*"},
+			Releases:             []string{""},
+		}, params)
+		require.NoError(t, err)
+
+		filtersResp, ok := resp.(*generatedapi.InboundFilters)
+		require.True(t, ok)
+		require.True(t, filtersResp.FilterWebCrawlers)
+		require.True(t, filtersResp.FilterLegacyBrowsers)
+		require.False(t, filtersResp.FilterLocalhost)
+		require.Equal(t, expected.AllowedOrigins, filtersResp.AllowedOrigins)
+		require.Equal(t, expected.BlockedIPRanges, filtersResp.BlockedIPRanges)
+		require.Equal(t, expected.ErrorMessages, filtersResp.ErrorMessages)
+		require.Equal(t, []string{}, filtersResp.Releases)
+	})
+
+	invalidTests := []struct {
+		name    string
+		req     *generatedapi.UpdateInboundFiltersRequest
+		message string
+	}{
+		{
+			name:    "invalid IP range",
+			req:     &generatedapi.UpdateInboundFiltersRequest{BlockedIPRanges: []string{"10.0.0.0/33"}},
+			message: `invalid inbound filters: invalid IP range "10.0.0.0/33"`,
+		},
+		{
+			name:    "host instead of IP",
+			req:     &generatedapi.UpdateInboundFiltersRequest{BlockedIPRanges: []string{"example.com"}},
+			message: `invalid inbound filters: invalid IP range "example.com"`,
+		},
+	}
+
+	for _, tt := range invalidTests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+			api := &RestAPI{
+				inboundFiltersUseCase: projects.NewInboundFiltersService(mockcontract.NewMockInboundFiltersRepository(t)),
+				permissionsService:    mockPermissionsService,
+			}
+
+			mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+
+			resp, err := api.UpdateProjectInboundFilters(context.Background(), tt.req, params)
+			require.NoError(t, err)
+
+			badRequest, ok := resp.(*generatedapi.ErrorBadRequest)
+			require.True(t, ok)
+			require.Contains(t, badRequest.Error.Message.Value, tt.message)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_RotateProjectKey(t *testing.T) {
+	params := generatedapi.RotateProjectKeyParams{ProjectID: 1, KeyID: 2}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockProjectKeysUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{projectKeysUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		rateLimit := uint(100)
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockUseCase.EXPECT().Rotate(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(2)).Return(domain.ProjectKey{
+			ID:        3,
+			ProjectID: 1,
+			Label:     "Frontend",
+			PublicKey: "new-public-key",
+			SecretKey: "new-secret-key",
+			IsActive:  true,
+			RateLimits: domain.RateLimits{
+				EventsPerMinute: &rateLimit,
+				Categories:      map[domain.DataCategory]uint{domain.DataCategoryTransaction: 500},
+			},
+			CreatedAt: time.Now(),
+		}, nil)
+
+		resp, err := api.RotateProjectKey(context.Background(), params)
+		require.NoError(t, err)
+
+		key, ok := resp.(*generatedapi.ProjectKey)
+		require.True(t, ok)
+		require.Equal(t, uint(3), key.ID)
+		require.Equal(t, "new-public-key", key.PublicKey)
+		require.Equal(t, generatedapi.NewOptNilUint(100), key.RateLimit)
+		require.Equal(t, generatedapi.NewOptUint(500), key.CategoryRateLimits.Transaction)
+		require.False(t, key.CategoryRateLimits.Error.Set)
+		require.False(t, key.LastUsedAt.Set)
+	})
+
+	t.Run("key not found", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockProjectKeysUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{projectKeysUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockUseCase.EXPECT().Rotate(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(2)).
+			Return(domain.ProjectKey{}, domain.ErrEntityNotFound)
+
+		resp, err := api.RotateProjectKey(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.RotateProjectKey(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
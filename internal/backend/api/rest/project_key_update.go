@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) UpdateProjectKey(
+	ctx context.Context,
+	req *generatedapi.UpdateProjectKeyRequest,
+	params generatedapi.UpdateProjectKeyParams,
+) (generatedapi.UpdateProjectKeyRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+	keyID := domain.ProjectKeyID(params.KeyID)
+
+	// Check if the user can manage the project
+	if err := r.permissionsService.CanManageProject(ctx, projectID, false); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	key, err := r.projectKeysUseCase.Update(
+		ctx,
+		projectID,
+		keyID,
+		req.Label,
+		req.IsActive,
+		dto.RateLimitsFromAPI(req.RateLimit, req.CategoryRateLimits),
+	)
+	if err != nil {
+		slog.Error("update project key failed", "error", err, "project_id", projectID, "key_id", keyID)
+
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return &generatedapi.ErrorNotFound{Error: generatedapi.ErrorNotFoundError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrInvalidRateLimits) {
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrLastActiveProjectKey) {
+			return &generatedapi.ErrorConflict{Error: generatedapi.ErrorConflictError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	resp := dto.DomainProjectKeyToAPI(key)
+
+	return &resp, nil
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_GetProjectOutcomes(t *testing.T) {
+	params := generatedapi.GetProjectOutcomesParams{ProjectID: 1, Interval: "24h", Granularity: "1h"}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockOutcomesUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{outcomesUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		period := domain.Period{Interval: 24 * time.Hour, Granularity: time.Hour}
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().ProjectOutcomes(mock.Anything, domain.ProjectID(1), period).Return(domain.ProjectOutcomes{
+			Totals: domain.OutcomeTotals{Accepted: 10, Filtered: 2},
+			Items: []domain.OutcomeStat{
+				{Outcome: domain.OutcomeAccepted, Category: domain.DataCategoryError, Quantity: 10},
+				{
+					Outcome:  domain.OutcomeClientDiscard,
+					Reason:   "sample_rate",
+					Category: domain.DataCategoryTransaction,
+					Quantity: 2,
+				},
+			},
+		}, nil)
+
+		resp, err := api.GetProjectOutcomes(context.Background(), params)
+		require.NoError(t, err)
+
+		outcomes, ok := resp.(*generatedapi.ProjectOutcomesResponse)
+		require.True(t, ok)
+		require.Equal(t, uint(10), outcomes.Totals.Accepted)
+		require.Equal(t, uint(2), outcomes.Totals.Filtered)
+		require.Len(t, outcomes.Items, 2)
+		require.Equal(t, generatedapi.OutcomeItemGroupFiltered, outcomes.Items[1].Group)
+		require.Equal(t, "24h", outcomes.Period.Interval)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+
+		resp, err := api.GetProjectOutcomes(context.Background(), generatedapi.GetProjectOutcomesParams{
+			ProjectID:   1,
+			Interval:    "24x",
+			Granularity: "1h",
+		})
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorBadRequest{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.GetProjectOutcomes(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_UpdateProjectRateLimits(t *testing.T) {
+	req := &generatedapi.UpdateProjectRateLimitsRequest{
+		RateLimit: generatedapi.NewOptNilUint(1000),
+		CategoryRateLimits: generatedapi.NewOptCategoryRateLimits(generatedapi.CategoryRateLimits{
+			Transaction: generatedapi.NewOptUint(200),
+		}),
+	}
+	params := generatedapi.UpdateProjectRateLimitsParams{ProjectID: 1}
+	eventsPerMinute := uint(1000)
+	expectedLimits := domain.RateLimits{
+		EventsPerMinute: &eventsPerMinute,
+		Categories:      map[domain.DataCategory]uint{domain.DataCategoryTransaction: 200},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRateLimitsUseCase := mockcontract.NewMockProjectRateLimitsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{projectRateLimitsUseCase: mockRateLimitsUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+		mockRateLimitsUseCase.EXPECT().Update(mock.Anything, domain.ProjectID(1), expectedLimits).
+			Return(domain.ProjectRateLimits{ProjectID: 1, RateLimits: expectedLimits}, nil)
+
+		resp, err := api.UpdateProjectRateLimits(context.Background(), req, params)
+		require.NoError(t, err)
+
+		limitsResp, ok := resp.(*generatedapi.ProjectRateLimits)
+		require.True(t, ok)
+		require.Equal(t, uint(1), limitsResp.ProjectID)
+		require.Equal(t, generatedapi.NewOptNilUint(1000), limitsResp.RateLimit)
+		require.Equal(t, generatedapi.NewOptUint(200), limitsResp.CategoryRateLimits.Transaction)
+		require.False(t, limitsResp.CategoryRateLimits.Error.IsSet())
+		require.False(t, limitsResp.UpdatedAt.IsSet())
+	})
+
+	testProjectSettingsUpdateErrors(t, projectSettingsUpdate{
+		newAPI: func(
+			t *testing.T,
+			permissionsService *mockcontract.MockPermissionsService,
+		) (*RestAPI, func(err error)) {
+			mockRateLimitsUseCase := mockcontract.NewMockProjectRateLimitsUseCase(t)
+			api := &RestAPI{projectRateLimitsUseCase: mockRateLimitsUseCase, permissionsService: permissionsService}
+
+			return api, func(err error) {
+				mockRateLimitsUseCase.EXPECT().Update(mock.Anything, domain.ProjectID(1), expectedLimits).
+					Return(domain.ProjectRateLimits{}, err)
+			}
+		},
+		update: func(ctx context.Context, api *RestAPI) (any, error) {
+			return api.UpdateProjectRateLimits(ctx, req, params)
+		},
+		invalidErr: domain.ErrInvalidRateLimits,
+	})
+}
+
+func TestRestAPI_UpdateProjectRateLimits_Unlimited(t *testing.T) {
+	mockRateLimitsUseCase := mockcontract.NewMockProjectRateLimitsUseCase(t)
+	mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+	api := &RestAPI{projectRateLimitsUseCase: mockRateLimitsUseCase, permissionsService: mockPermissionsService}
+
+	// Limits missing in the request are removed
+	mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+	mockRateLimitsUseCase.EXPECT().Update(mock.Anything, domain.ProjectID(1), domain.RateLimits{}).
+		Return(domain.ProjectRateLimits{ProjectID: 1}, nil)
+
+	resp, err := api.UpdateProjectRateLimits(
+		context.Background(),
+		&generatedapi.UpdateProjectRateLimitsRequest{},
+		generatedapi.UpdateProjectRateLimitsParams{ProjectID: 1},
+	)
+	require.NoError(t, err)
+
+	limitsResp, ok := resp.(*generatedapi.ProjectRateLimits)
+	require.True(t, ok)
+	require.False(t, limitsResp.RateLimit.IsSet())
+	require.Equal(t, generatedapi.CategoryRateLimits{}, limitsResp.CategoryRateLimits)
+}
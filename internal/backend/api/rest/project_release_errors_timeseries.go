@@ -28,6 +28,10 @@ func (r *RestAPI) GetProjectReleaseErrorsTimeseries(
 	if params.Level.Set {
 		levels = []domain.IssueLevel{domain.IssueLevel(params.Level.Value)}
 	}
+	var environment *string
+	if params.Environment.Set {
+		environment = &params.Environment.Value
+	}
 	groupBy := domain.EventTimeseriesGroupNone
 	if params.GroupBy.Set {
 		switch params.GroupBy.Value {
@@ -43,6 +47,7 @@ func (r *RestAPI) GetProjectReleaseErrorsTimeseries(
 		period.Interval,
 		period.Granularity,
 		levels,
+		environment,
 		groupBy,
 	)
 	if err != nil {
@@ -19,7 +19,7 @@ func (r *RestAPI) GetProjectReleaseSegments(
 	release := params.Release
 	segment := domain.SegmentName(params.Segment)
 
-	segments, err := r.analyticsUseCase.GetUserSegments(ctx, projectID, release)
+	segments, err := r.analyticsUseCase.GetUserSegments(ctx, projectID, release, params.Environment.Value)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrEntityNotFound):
@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+// projectSettingsUpdate describes a handler updating the settings of the project 1
+// for testProjectSettingsUpdateErrors.
+type projectSettingsUpdate struct {
+	// newAPI builds the api with a mock of the use case and returns a function
+	// setting up the update of the use case to fail with the error.
+	newAPI func(t *testing.T, permissionsService *mockcontract.MockPermissionsService) (*RestAPI, func(err error))
+	// update calls the handler.
+	update func(ctx context.Context, api *RestAPI) (any, error)
+	// invalidErr is the error of the use case reported as a bad request.
+	invalidErr error
+}
+
+// testProjectSettingsUpdateErrors checks the responses of the handler on the permission
+// and use case errors shared by the project settings endpoints.
+func testProjectSettingsUpdateErrors(t *testing.T, handler projectSettingsUpdate) {
+	t.Helper()
+
+	permissionTests := []struct {
+		name     string
+		err      error
+		expected any
+	}{
+		{name: "permission denied", err: domain.ErrPermissionDenied, expected: &generatedapi.ErrorPermissionDenied{}},
+		{name: "unauthorized", err: domain.ErrUserNotFound, expected: &generatedapi.ErrorUnauthorized{}},
+	}
+
+	for _, tt := range permissionTests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+			api, _ := handler.newAPI(t, mockPermissionsService)
+
+			mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(tt.err)
+
+			resp, err := handler.update(context.Background(), api)
+			require.NoError(t, err)
+			require.IsType(t, tt.expected, resp)
+		})
+	}
+
+	useCaseTests := []struct {
+		name     string
+		err      error
+		expected any
+	}{
+		{
+			name:     "invalid settings",
+			err:      fmt.Errorf("%w: details", handler.invalidErr),
+			expected: &generatedapi.ErrorBadRequest{},
+		},
+		{name: "project not found", err: domain.ErrEntityNotFound, expected: &generatedapi.ErrorNotFound{}},
+		{name: "unexpected error", err: errors.New("db error")},
+	}
+
+	for _, tt := range useCaseTests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+			api, failUpdate := handler.newAPI(t, mockPermissionsService)
+
+			mockPermissionsService.EXPECT().CanManageProject(mock.Anything, domain.ProjectID(1), false).Return(nil)
+			failUpdate(tt.err)
+
+			resp, err := handler.update(context.Background(), api)
+			if tt.expected == nil {
+				require.Error(t, err)
+				require.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, tt.expected, resp)
+		})
+	}
+}
@@ -47,6 +47,19 @@ func (r *RestAPI) GetProjectStats(
 		})
 	}
 
+	spikes := make([]generatedapi.ProjectSpike, 0, len(stats.Spikes))
+	for i := range stats.Spikes {
+		elem := stats.Spikes[i]
+		spikes = append(spikes, generatedapi.ProjectSpike{
+			ID:          uint(elem.ID),
+			StartedAt:   elem.StartedAt,
+			EndsAt:      elem.EndsAt,
+			BaselineRps: elem.BaselineRPS,
+			PeakRps:     elem.PeakRPS,
+			CapRps:      elem.CapRPS,
+		})
+	}
+
 	return &generatedapi.ProjectStatsResponse{
 		TotalIssues: stats.TotalIssues,
 		IssuesByLevel: generatedapi.ProjectStatsResponseIssuesByLevel{
@@ -58,5 +71,6 @@ func (r *RestAPI) GetProjectStats(
 			Debug:     stats.DebugIssues,
 		},
 		MostFrequentIssues: issues,
+		Spikes:             spikes,
 	}, nil
 }
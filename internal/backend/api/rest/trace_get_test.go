@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRestAPI_GetTrace(t *testing.T) {
+	params := generatedapi.GetTraceParams{ProjectID: 1, TraceID: "trace-1"}
+
+	t.Run("success", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockTransactionsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{transactionsUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		start := time.Now()
+		span := domain.Span{
+			TransactionID:  "tx-1",
+			SpanID:         "db",
+			ParentSpanID:   "root",
+			StartTimestamp: start.Add(10 * time.Millisecond),
+			Timestamp:      start.Add(30 * time.Millisecond),
+		}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().GetTrace(mock.Anything, domain.ProjectID(1), "trace-1").Return(domain.Trace{
+			TraceID:        "trace-1",
+			StartTimestamp: start,
+			Timestamp:      start.Add(50 * time.Millisecond),
+			Transactions: []domain.Transaction{
+				{ID: "tx-1", SpanID: "root", Name: "GET /", StartTimestamp: start, Timestamp: start.Add(50 * time.Millisecond)},
+			},
+			Spans: []domain.TraceSpan{{Span: span, Offset: 10 * time.Millisecond, Depth: 1}},
+		}, nil)
+
+		resp, err := api.GetTrace(context.Background(), params)
+		require.NoError(t, err)
+
+		trace, ok := resp.(*generatedapi.TraceResponse)
+		require.True(t, ok)
+		require.InDelta(t, 50.0, trace.DurationMs, 0.001)
+		require.Len(t, trace.Spans, 1)
+		require.InDelta(t, 20.0, trace.Spans[0].DurationMs, 0.001)
+		require.InDelta(t, 10.0, trace.Spans[0].OffsetMs, 0.001)
+		require.Equal(t, uint(1), trace.Spans[0].Depth)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockUseCase := mockcontract.NewMockTransactionsUseCase(t)
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{transactionsUseCase: mockUseCase, permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().CanAccessProject(mock.Anything, domain.ProjectID(1)).Return(nil)
+		mockUseCase.EXPECT().GetTrace(mock.Anything, domain.ProjectID(1), "trace-1").
+			Return(domain.Trace{}, domain.ErrEntityNotFound)
+
+		resp, err := api.GetTrace(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorNotFound{}, resp)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+		api := &RestAPI{permissionsService: mockPermissionsService}
+
+		mockPermissionsService.EXPECT().
+			CanAccessProject(mock.Anything, domain.ProjectID(1)).
+			Return(domain.ErrPermissionDenied)
+
+		resp, err := api.GetTrace(context.Background(), params)
+		require.NoError(t, err)
+		require.IsType(t, &generatedapi.ErrorPermissionDenied{}, resp)
+	})
+}
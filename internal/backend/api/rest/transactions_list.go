@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/dto"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func (r *RestAPI) ListProjectTransactions(
+	ctx context.Context,
+	params generatedapi.ListProjectTransactionsParams,
+) (generatedapi.ListProjectTransactionsRes, error) {
+	projectID := domain.ProjectID(params.ProjectID)
+
+	// Check if the user can access the project
+	if err := r.permissionsService.CanAccessProject(ctx, projectID); err != nil {
+		slog.Error("permission denied", "error", err, "project_id", projectID)
+
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return &generatedapi.ErrorPermissionDenied{Error: generatedapi.ErrorPermissionDeniedError{
+				Message: generatedapi.NewOptString("permission denied"),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString("unauthorized"),
+			}}, nil
+		}
+
+		return nil, err
+	}
+
+	interval, err := dto.ParseHumanDuration(params.Interval)
+	if err != nil {
+		slog.Error("invalid interval", "error", err)
+
+		return nil, err
+	}
+
+	filter := domain.TransactionsFilter{
+		ProjectID: projectID,
+		Interval:  interval,
+		Limit:     params.Limit.Or(0),
+	}
+	if params.Environment.Set {
+		filter.Environment = &params.Environment.Value
+	}
+	if params.Release.Set {
+		filter.Release = &params.Release.Value
+	}
+
+	stats, err := r.transactionsUseCase.Stats(ctx, &filter)
+	if err != nil {
+		slog.Error("list transactions failed", "error", err, "project_id", projectID)
+
+		return nil, err
+	}
+
+	resp := dto.MakeListTransactionsResponse(stats)
+
+	return &resp, nil
+}
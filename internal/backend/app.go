@@ -19,12 +19,19 @@ import (
 	"github.com/rom8726/warden/internal/backend/services/permissions"
 	"github.com/rom8726/warden/internal/backend/services/tokenizer"
 	"github.com/rom8726/warden/internal/backend/usecases/analytics"
+	attachmentsusecase "github.com/rom8726/warden/internal/backend/usecases/attachments"
 	eventsusecases "github.com/rom8726/warden/internal/backend/usecases/events"
+	groupingrulesusecase "github.com/rom8726/warden/internal/backend/usecases/groupingrules"
+	issueactivityusecase "github.com/rom8726/warden/internal/backend/usecases/issueactivity"
+	issuebulkusecase "github.com/rom8726/warden/internal/backend/usecases/issuebulk"
 	issuesusecases "github.com/rom8726/warden/internal/backend/usecases/issues"
+	monitorsusecase "github.com/rom8726/warden/internal/backend/usecases/monitors"
 	notificationsusecases "github.com/rom8726/warden/internal/backend/usecases/notifications"
+	outcomesusecase "github.com/rom8726/warden/internal/backend/usecases/outcomes"
 	projectsusecase "github.com/rom8726/warden/internal/backend/usecases/projects"
 	settingsusecase "github.com/rom8726/warden/internal/backend/usecases/settings"
 	teamsusecases "github.com/rom8726/warden/internal/backend/usecases/teams"
+	transactionsusecase "github.com/rom8726/warden/internal/backend/usecases/transactions"
 	usernotificationsusecase "github.com/rom8726/warden/internal/backend/usecases/usernotifications"
 	usersusecase "github.com/rom8726/warden/internal/backend/usecases/users"
 	versionsusecase "github.com/rom8726/warden/internal/backend/usecases/versions"
@@ -33,17 +40,32 @@ import (
 	"github.com/rom8726/warden/internal/domain"
 	generatedserver "github.com/rom8726/warden/internal/generated/server"
 	"github.com/rom8726/warden/internal/infra"
+	"github.com/rom8726/warden/internal/repository/attachments"
+	"github.com/rom8726/warden/internal/repository/datascrubbing"
+	"github.com/rom8726/warden/internal/repository/environments"
 	"github.com/rom8726/warden/internal/repository/events"
+	"github.com/rom8726/warden/internal/repository/groupingrules"
+	"github.com/rom8726/warden/internal/repository/inboundfilters"
+	"github.com/rom8726/warden/internal/repository/issueactivities"
+	"github.com/rom8726/warden/internal/repository/issuecomments"
+	"github.com/rom8726/warden/internal/repository/issuefingerprints"
 	"github.com/rom8726/warden/internal/repository/issuereleases"
 	"github.com/rom8726/warden/internal/repository/issues"
+	"github.com/rom8726/warden/internal/repository/issuesnoozes"
+	"github.com/rom8726/warden/internal/repository/monitors"
 	"github.com/rom8726/warden/internal/repository/notifications"
 	"github.com/rom8726/warden/internal/repository/notificationsqueue"
+	"github.com/rom8726/warden/internal/repository/outcomes"
+	"github.com/rom8726/warden/internal/repository/projectkeys"
+	"github.com/rom8726/warden/internal/repository/projectratelimits"
 	"github.com/rom8726/warden/internal/repository/projects"
+	"github.com/rom8726/warden/internal/repository/projectspikes"
 	"github.com/rom8726/warden/internal/repository/releases"
 	"github.com/rom8726/warden/internal/repository/releasestats"
 	"github.com/rom8726/warden/internal/repository/resolutions"
 	"github.com/rom8726/warden/internal/repository/settings"
 	"github.com/rom8726/warden/internal/repository/teams"
+	"github.com/rom8726/warden/internal/repository/transactions"
 	"github.com/rom8726/warden/internal/repository/usernotifications"
 	"github.com/rom8726/warden/internal/repository/users"
 	"github.com/rom8726/warden/internal/services/notification-channels/email"
@@ -52,6 +74,7 @@ import (
 	"github.com/rom8726/warden/internal/services/notification-channels/slack"
 	"github.com/rom8726/warden/internal/services/notification-channels/telegram"
 	"github.com/rom8726/warden/internal/services/notification-channels/webhook"
+	"github.com/rom8726/warden/pkg/blobstore"
 	"github.com/rom8726/warden/pkg/db"
 	"github.com/rom8726/warden/pkg/httpserver"
 	pkgmiddlewares "github.com/rom8726/warden/pkg/httpserver/middlewares"
@@ -172,6 +195,10 @@ func (app *App) registerComponents() {
 
 	// Kafka
 	eventsProducer := kafka.NewTopicProducer(kafka.NewNopKafkaProducer(), domain.EventsKafkaTopic)
+	transactionProducers := &transactions.Producers{
+		Transactions: kafka.NewNopTopicKafkaProducer(),
+		Spans:        kafka.NewNopTopicKafkaProducer(),
+	}
 
 	// Register ClickHouse connection
 	app.registerComponent(func() *infra.ClickHouseConnImpl {
@@ -180,6 +207,12 @@ func (app *App) registerComponents() {
 
 	// Register repositories
 	app.registerComponent(projects.New).Arg(app.PostgresPool)
+	app.registerComponent(projectkeys.New).Arg(app.PostgresPool)
+	app.registerComponent(inboundfilters.New).Arg(app.PostgresPool)
+	app.registerComponent(projectratelimits.New).Arg(app.PostgresPool)
+	app.registerComponent(projectspikes.New).Arg(app.PostgresPool)
+	app.registerComponent(datascrubbing.New).Arg(app.PostgresPool)
+	app.registerComponent(environments.New).Arg(app.PostgresPool)
 	app.registerComponent(events.New).Arg(eventsProducer)
 	app.registerComponent(users.New).Arg(app.PostgresPool)
 	app.registerComponent(issues.New).Arg(app.PostgresPool)
@@ -192,6 +225,18 @@ func (app *App) registerComponents() {
 	app.registerComponent(issuereleases.New).Arg(app.PostgresPool)
 	app.registerComponent(settings.New).Arg(app.PostgresPool)
 	app.registerComponent(usernotifications.New).Arg(app.PostgresPool)
+	app.registerComponent(groupingrules.New).Arg(app.PostgresPool)
+	app.registerComponent(issuefingerprints.New).Arg(app.PostgresPool)
+	app.registerComponent(issuecomments.New).Arg(app.PostgresPool)
+	app.registerComponent(issueactivities.New).Arg(app.PostgresPool)
+	app.registerComponent(issuesnoozes.New).Arg(app.PostgresPool)
+	app.registerComponent(transactions.New).Arg(transactionProducers)
+	app.registerComponent(attachments.New).Arg(app.PostgresPool)
+	app.registerComponent(monitors.New).Arg(app.PostgresPool)
+	app.registerComponent(outcomes.New)
+	app.registerComponent(func() (blobstore.Store, error) {
+		return commonconfig.NewBlobStore(&app.Config.Attachments)
+	})
 
 	// Register permissions service
 	app.registerComponent(permissions.New)
@@ -256,8 +301,20 @@ func (app *App) registerComponents() {
 	// Register use cases
 	app.registerComponent(eventsusecases.New)
 	app.registerComponent(issuesusecases.New)
+	app.registerComponent(issueactivityusecase.New)
+	app.registerComponent(issuebulkusecase.New)
 	app.registerComponent(teamsusecases.New)
 	app.registerComponent(projectsusecase.New)
+	app.registerComponent(projectsusecase.NewKeysService)
+	app.registerComponent(projectsusecase.NewInboundFiltersService)
+	app.registerComponent(projectsusecase.NewRateLimitsService)
+	app.registerComponent(projectsusecase.NewDataScrubbingService)
+	app.registerComponent(projectsusecase.NewEnvironmentsService)
+	app.registerComponent(groupingrulesusecase.New)
+	app.registerComponent(transactionsusecase.New)
+	app.registerComponent(attachmentsusecase.New)
+	app.registerComponent(monitorsusecase.New)
+	app.registerComponent(outcomesusecase.New)
 	app.registerComponent(notificationsusecases.New).Arg([]contract.NotificationChannel{
 		emailChannel,
 		mattermostChannel,
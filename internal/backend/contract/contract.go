@@ -4,6 +4,7 @@ package contract
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"time"
 
 	"github.com/rom8726/warden/internal/domain"
@@ -45,6 +46,7 @@ type UsersUseCase interface {
 
 type UsersRepository interface {
 	FetchByIDs(ctx context.Context, ids []domain.UserID) ([]domain.User, error)
+	FetchByUsernames(ctx context.Context, usernames []string) ([]domain.User, error)
 	Create(ctx context.Context, user domain.UserDTO) (domain.User, error)
 	GetByID(ctx context.Context, id domain.UserID) (domain.User, error)
 	GetByUsername(ctx context.Context, username string) (domain.User, error)
@@ -77,6 +79,20 @@ type EventUseCase interface {
 		ctx context.Context,
 		filter *domain.IssueEventsTimeseriesFilter,
 	) ([]domain.Timeseries, error)
+	// Search returns a page of the project events matching the search query, newest first.
+	Search(ctx context.Context, filter *domain.EventSearchFilter) (domain.EventSearchPage, error)
+	// IssueTags returns the distributions of the tags of the issue events.
+	IssueTags(ctx context.Context, filter *domain.IssueTagsFilter) ([]domain.IssueTag, error)
+	IssueTagEvents(ctx context.Context, filter *domain.IssueTagEventsFilter) (domain.EventSearchPage, error)
+	GetEvent(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID) (domain.EventDetails, error)
+	IssueEvents(ctx context.Context, filter *domain.IssueEventsFilter) (domain.EventSearchPage, error)
+	// IssueEdgeEvent returns the oldest or the latest event of the issue.
+	IssueEdgeEvent(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		issueID domain.IssueID,
+		oldest bool,
+	) (domain.EventDetails, error)
 }
 
 type EventRepository interface {
@@ -86,15 +102,20 @@ type EventRepository interface {
 	) ([]domain.Timeseries, error)
 	IssueTimeseries(
 		ctx context.Context,
-		fingerprint string, // from issue
+		fingerprints []string, // from issue, including merged ones
 		filter *domain.IssueEventsTimeseriesFilter,
 	) ([]domain.Timeseries, error)
 	FetchForIssue(
 		ctx context.Context,
 		projectID domain.ProjectID,
-		groupHash string,
+		groupHashes []string,
 		limit uint,
 	) ([]domain.Event, error)
+	FingerprintStats(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		fingerprints []string,
+	) (domain.FingerprintStats, error)
 	EventsByRelease(
 		ctx context.Context,
 		projectID domain.ProjectID,
@@ -111,13 +132,142 @@ type EventRepository interface {
 		ctx context.Context,
 		projectID domain.ProjectID,
 		release string,
+		environment string,
 		segment domain.SegmentName,
 	) (map[string]uint, error)
+	FetchRecent(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		since time.Time,
+		limit uint,
+	) ([]domain.Event, error)
+	Search(ctx context.Context, filter *domain.EventSearchFilter) (domain.EventSearchPage, error)
+	IssueTags(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		fingerprints []string, // from issue, including merged ones
+		keysLimit uint,
+		valuesLimit uint,
+	) ([]domain.IssueTag, error)
+	GetByID(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID) (domain.Event, error)
+	IssueEventNeighbours(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		fingerprints []string, // from issue, including merged ones
+		cursor domain.EventCursor,
+	) (prev, next domain.EventID, err error)
+	IssueEdgeEventID(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		fingerprints []string, // from issue, including merged ones
+		oldest bool,
+	) (domain.EventID, error)
+	SearchGroups(
+		ctx context.Context,
+		projectIDs []domain.ProjectID,
+		query domain.SearchQuery,
+		timeFrom time.Time,
+		timeTo time.Time,
+		limit uint,
+	) ([]domain.EventGroup, error)
 }
 
 type ResolutionsRepository interface {
 	Create(ctx context.Context, resolutionDTO domain.ResolutionDTO) (domain.Resolution, error)
 	GetByIssueID(ctx context.Context, issueID domain.IssueID) ([]domain.Resolution, error)
+	MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error
+	DeleteByIssues(ctx context.Context, issueIDs []domain.IssueID) error
+}
+
+type IssueActivitiesRepository interface {
+	Create(ctx context.Context, activity domain.IssueActivityDTO) error
+	ListByIssue(ctx context.Context, filter *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error)
+	MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error
+}
+
+type IssueCommentsRepository interface {
+	Create(ctx context.Context, comment domain.IssueCommentDTO) (domain.IssueComment, error)
+	GetByID(ctx context.Context, id domain.IssueCommentID) (domain.IssueComment, error)
+	ListByIssue(ctx context.Context, issueID domain.IssueID) ([]domain.IssueComment, error)
+	Delete(ctx context.Context, id domain.IssueCommentID) error
+	MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error
+}
+
+type IssueSnoozesRepository interface {
+	Upsert(
+		ctx context.Context,
+		issueID domain.IssueID,
+		conditions domain.IssueIgnoreConditions,
+		createdBy *domain.UserID,
+	) error
+	GetByIssue(ctx context.Context, issueID domain.IssueID) (domain.IssueSnooze, error)
+	Delete(ctx context.Context, issueID domain.IssueID) error
+}
+
+type IssueReleasesRepository interface {
+	Create(ctx context.Context, issueID domain.IssueID, releaseID domain.ReleaseID, firstSeenIn bool) error
+	MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error
+	Delete(ctx context.Context, issueID domain.IssueID, releaseIDs []domain.ReleaseID) error
+}
+
+type IssueFingerprintsRepository interface {
+	Create(ctx context.Context, projectID domain.ProjectID, fingerprint string, issueID domain.IssueID) error
+	Reassign(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error
+	ListByIssue(ctx context.Context, issueID domain.IssueID) ([]string, error)
+	Delete(ctx context.Context, issueID domain.IssueID, fingerprint string) error
+	GetIssueFingerprint(ctx context.Context, projectID domain.ProjectID, fingerprint string) (string, error)
+}
+
+type AttachmentsUseCase interface {
+	ListByEvent(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID) ([]domain.Attachment, error)
+	Open(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.AttachmentID,
+	) (domain.Attachment, io.ReadCloser, error)
+}
+
+type AttachmentsRepository interface {
+	GetByID(ctx context.Context, projectID domain.ProjectID, id domain.AttachmentID) (domain.Attachment, error)
+	ListByEventIDs(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		eventIDs []domain.EventID,
+	) ([]domain.Attachment, error)
+}
+
+type BlobStore interface {
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+type MonitorsUseCase interface {
+	List(ctx context.Context, projectID domain.ProjectID) ([]domain.Monitor, error)
+	GetWithCheckIns(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.MonitorID,
+	) (domain.MonitorWithCheckIns, error)
+	Delete(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) error
+}
+
+type MonitorsRepository interface {
+	ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Monitor, error)
+	GetByID(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) (domain.Monitor, error)
+	ListCheckIns(ctx context.Context, monitorID domain.MonitorID, limit uint) ([]domain.MonitorCheckIn, error)
+	Delete(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) error
+}
+
+// OutcomesUseCase reports what happened to the data received by a project.
+type OutcomesUseCase interface {
+	ProjectOutcomes(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		period domain.Period,
+	) (domain.ProjectOutcomes, error)
+}
+
+type OutcomesRepository interface {
+	Stats(ctx context.Context, projectID domain.ProjectID, period domain.Period) ([]domain.OutcomeStat, error)
 }
 
 type ProjectsUseCase interface {
@@ -133,6 +283,8 @@ type ProjectsUseCase interface {
 	RecentProjects(ctx context.Context) ([]domain.ProjectExtended, error)
 	UpdateInfo(ctx context.Context, id domain.ProjectID, name, description string) (domain.ProjectExtended, error)
 	ArchiveProject(ctx context.Context, id domain.ProjectID) error
+	GetGroupingStrategy(ctx context.Context, id domain.ProjectID) (domain.GroupingStrategy, error)
+	UpdateGroupingStrategy(ctx context.Context, id domain.ProjectID, strategy domain.GroupingStrategy) error
 }
 
 type ProjectsRepository interface {
@@ -141,18 +293,188 @@ type ProjectsRepository interface {
 	List(ctx context.Context) ([]domain.ProjectExtended, error)
 	RecentProjects(ctx context.Context, userID domain.UserID, limit uint) ([]domain.ProjectExtended, error)
 	Update(ctx context.Context, id domain.ProjectID, name, description string) error
+	UpdateGroupingStrategy(ctx context.Context, id domain.ProjectID, strategy domain.GroupingStrategy) error
+	UpdatePublicKey(ctx context.Context, id domain.ProjectID, publicKey string) error
 	Archive(ctx context.Context, id domain.ProjectID) error
 }
 
+// ProjectKeysUseCase manages the client keys (DSNs) of projects.
+type ProjectKeysUseCase interface {
+	List(ctx context.Context, projectID domain.ProjectID) ([]domain.ProjectKey, error)
+	Create(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		label string,
+		rateLimits domain.RateLimits,
+	) (domain.ProjectKey, error)
+	Update(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.ProjectKeyID,
+		label string,
+		isActive bool,
+		rateLimits domain.RateLimits,
+	) (domain.ProjectKey, error)
+	// Rotate issues a new key with the settings of the given one, the old key works until it's revoked.
+	Rotate(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) (domain.ProjectKey, error)
+	Revoke(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) error
+}
+
+type ProjectKeysRepository interface {
+	Create(ctx context.Context, keyDTO domain.ProjectKeyDTO) (domain.ProjectKey, error)
+	GetByID(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) (domain.ProjectKey, error)
+	ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.ProjectKey, error)
+	Update(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.ProjectKeyID,
+		label string,
+		isActive bool,
+		rateLimits domain.RateLimits,
+	) (domain.ProjectKey, error)
+	Delete(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) error
+}
+
+// InboundFiltersUseCase manages the allowed origins and inbound filters applied by the ingest servers.
+type InboundFiltersUseCase interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error)
+	Update(ctx context.Context, filters domain.InboundFilters) (domain.InboundFilters, error)
+}
+
+type InboundFiltersRepository interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error)
+	Upsert(ctx context.Context, filters domain.InboundFilters) (domain.InboundFilters, error)
+}
+
+// ProjectRateLimitsUseCase manages the rate limits of all client keys of a project together.
+type ProjectRateLimitsUseCase interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.ProjectRateLimits, error)
+	Update(ctx context.Context, projectID domain.ProjectID, limits domain.RateLimits) (domain.ProjectRateLimits, error)
+}
+
+type ProjectRateLimitsRepository interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.ProjectRateLimits, error)
+	Upsert(ctx context.Context, projectID domain.ProjectID, limits domain.RateLimits) (domain.ProjectRateLimits, error)
+}
+
+// DataScrubbingUseCase manages the sensitive data removed from events before they are stored.
+type DataScrubbingUseCase interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error)
+	Update(ctx context.Context, scrubbing domain.DataScrubbing) (domain.DataScrubbing, error)
+}
+
+type DataScrubbingRepository interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error)
+	Upsert(ctx context.Context, scrubbing domain.DataScrubbing) (domain.DataScrubbing, error)
+}
+
+// EnvironmentsUseCase manages the environments discovered from the events of projects.
+type EnvironmentsUseCase interface {
+	List(ctx context.Context, projectID domain.ProjectID) ([]domain.Environment, error)
+	SetHidden(ctx context.Context, projectID domain.ProjectID, name string, hidden bool) error
+}
+
+type EnvironmentsRepository interface {
+	ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Environment, error)
+	SetHidden(ctx context.Context, projectID domain.ProjectID, name string, hidden bool) error
+}
+
+type ProjectSpikesRepository interface {
+	ListByProject(ctx context.Context, projectID domain.ProjectID, since time.Time) ([]domain.ProjectSpike, error)
+}
+
+type GroupingRulesUseCase interface {
+	List(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error)
+	Create(ctx context.Context, ruleDTO domain.GroupingRuleDTO) (domain.GroupingRule, error)
+	Update(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.GroupingRuleID,
+		ruleType domain.GroupingRuleType,
+		expression string,
+	) (domain.GroupingRule, error)
+	Delete(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) error
+	Preview(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		ruleType domain.GroupingRuleType,
+		expression string,
+	) (domain.GroupingRulePreview, error)
+}
+
+type TransactionsUseCase interface {
+	Stats(ctx context.Context, filter *domain.TransactionsFilter) ([]domain.TransactionStats, error)
+	GetTrace(ctx context.Context, projectID domain.ProjectID, traceID string) (domain.Trace, error)
+}
+
+type TransactionsRepository interface {
+	Stats(ctx context.Context, filter *domain.TransactionsFilter) ([]domain.TransactionStats, error)
+	TraceTransactions(ctx context.Context, projectID domain.ProjectID, traceID string) ([]domain.Transaction, error)
+	TraceSpans(ctx context.Context, projectID domain.ProjectID, traceID string) ([]domain.Span, error)
+}
+
+type GroupingRulesRepository interface {
+	Create(ctx context.Context, ruleDTO domain.GroupingRuleDTO) (domain.GroupingRule, error)
+	GetByID(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) (domain.GroupingRule, error)
+	ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error)
+	Update(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.GroupingRuleID,
+		ruleType domain.GroupingRuleType,
+		expression string,
+	) (domain.GroupingRule, error)
+	Delete(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) error
+}
+
 type IssueUseCase interface {
 	GetByIDWithChildren(
 		ctx context.Context,
 		id domain.IssueID,
 	) (domain.IssueExtendedWithChildren, error)
-	List(ctx context.Context, filter *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, error)
+	// List returns a page of the issues and their total count, truncated is set when the issue search
+	// matched more event groups than are searched and the issues of the rest are missing.
+	List(
+		ctx context.Context,
+		filter *domain.ListIssuesFilter,
+	) (issues []domain.IssueExtended, total uint64, truncated bool, err error)
 	RecentIssues(ctx context.Context, limit uint) ([]domain.IssueExtended, error)
 	Timeseries(ctx context.Context, filter *domain.IssueTimeseriesFilter) ([]domain.Timeseries, error)
 	ChangeStatus(ctx context.Context, id domain.IssueID, status domain.IssueStatus) error
+	Ignore(ctx context.Context, id domain.IssueID, conditions domain.IssueIgnoreConditions) error
+	ResolveInRelease(ctx context.Context, id domain.IssueID, release domain.IssueResolvedInRelease) error
+	Assign(ctx context.Context, id domain.IssueID, assignee domain.IssueAssignee) error
+	Merge(ctx context.Context, projectID domain.ProjectID, primaryID domain.IssueID, issueIDs []domain.IssueID) error
+	Unmerge(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		id domain.IssueID,
+		fingerprints []string,
+	) ([]domain.IssueExtended, error)
+}
+
+type IssueBulkUseCase interface {
+	Apply(ctx context.Context, operation domain.IssueBulkOperation) (domain.IssueBulkResult, error)
+}
+
+type IssueActivityUseCase interface {
+	ListActivities(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		filter *domain.IssueActivitiesFilter,
+	) ([]domain.IssueActivity, uint64, error)
+	ListComments(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID) ([]domain.IssueComment, error)
+	CreateComment(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		comment domain.IssueCommentDTO,
+	) (domain.IssueComment, error)
+	DeleteComment(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		issueID domain.IssueID,
+		id domain.IssueCommentID,
+	) error
 }
 
 type TeamsUseCase interface {
@@ -288,6 +610,7 @@ type NotificationsQueueRepository interface {
 		issueID domain.IssueID,
 		level domain.IssueLevel,
 		isNew, wasReactivated bool,
+		environment string,
 	) error
 }
 
@@ -364,6 +687,7 @@ type ReleaseRepository interface {
 	GetByID(ctx context.Context, id domain.ReleaseID) (domain.Release, error)
 	GetByProjectAndVersion(ctx context.Context, projectID domain.ProjectID, version string) (domain.Release, error)
 	ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Release, error)
+	GetLatest(ctx context.Context, projectID domain.ProjectID) (domain.Release, error)
 }
 
 type ReleaseStatsRepository interface {
@@ -397,12 +721,14 @@ type AnalyticsUseCase interface {
 		release string,
 		period, granularity time.Duration,
 		levels []domain.IssueLevel,
+		environment *string,
 		groupBy domain.EventTimeseriesGroup,
 	) ([]domain.Timeseries, error)
 	GetUserSegments(
 		ctx context.Context,
 		projectID domain.ProjectID,
 		release string,
+		environment string,
 	) (domain.UserSegmentsAnalytics, error)
 }
 
@@ -447,7 +773,14 @@ type IssuesRepository interface {
 		filter *domain.IssueTimeseriesFilter,
 	) ([]domain.Timeseries, error)
 	UpdateStatus(ctx context.Context, issueID domain.IssueID, status domain.IssueStatus) error
+	SetResolvedInRelease(ctx context.Context, issueID domain.IssueID, release domain.IssueResolvedInRelease) error
+	UpdateAssignee(ctx context.Context, issueID domain.IssueID, assignee domain.IssueAssignee) error
 	MarkAsNotified(ctx context.Context, issueID domain.IssueID) error
+	MergeInto(ctx context.Context, primaryID domain.IssueID, issueIDs []domain.IssueID) error
+	Create(ctx context.Context, issue domain.Issue) (domain.IssueID, error)
+	SubtractEvents(ctx context.Context, issueID domain.IssueID, count uint) error
+	UpdateSeen(ctx context.Context, issueID domain.IssueID, firstSeen, lastSeen time.Time) error
+	Delete(ctx context.Context, issueIDs []domain.IssueID) error
 }
 
 type NotificationChannel interface {
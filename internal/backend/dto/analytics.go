@@ -16,6 +16,14 @@ func ToReleaseAnalyticsSummary(rel domain.Release, stats domain.ReleaseStats) ge
 		RegressionsTotal:       stats.RegressionsTotal,
 		ResolvedInVersionTotal: stats.ResolvedInVersionTotal,
 		UsersAffected:          stats.UsersAffected,
+		SessionsTotal:          toOptUInt(stats.SessionsTotal),
+		SessionsErrored:        toOptUInt(stats.SessionsErrored),
+		SessionsCrashed:        toOptUInt(stats.SessionsCrashed),
+		UsersTotal:             toOptUInt(stats.UsersTotal),
+		CrashFreeSessions:      toOptFloat64(stats.CrashFreeSessions),
+		CrashFreeUsers:         toOptFloat64(stats.CrashFreeUsers),
+		Adoption:               toOptFloat64(stats.Adoption),
+		AvgSessionDuration:     toOptFloat32(stats.AvgSessionDuration),
 	}
 }
 
@@ -51,6 +59,10 @@ func ToReleaseComparison(comp domain.ReleaseComparison) generatedapi.ReleaseComp
 			RegressionsTotal:       toOptUInt(comp.Delta["regressions_total"]),
 			ResolvedInVersionTotal: toOptUInt(comp.Delta["resolved_in_version_total"]),
 			UsersAffected:          toOptUInt(comp.Delta["users_affected"]),
+			SessionsTotal:          toOptUInt(comp.Delta["sessions_total"]),
+			CrashFreeSessions:      toOptRateDelta(comp.RateDelta, "crash_free_sessions"),
+			CrashFreeUsers:         toOptRateDelta(comp.RateDelta, "crash_free_users"),
+			Adoption:               toOptRateDelta(comp.RateDelta, "adoption"),
 		},
 	}
 }
@@ -70,6 +82,23 @@ func toOptFloat32(val *time.Duration) generatedapi.OptFloat32 {
 	return generatedapi.NewOptFloat32(float32(val.Seconds()))
 }
 
+func toOptFloat64(val *float64) generatedapi.OptFloat64 {
+	if val == nil {
+		return generatedapi.OptFloat64{}
+	}
+
+	return generatedapi.NewOptFloat64(*val)
+}
+
+func toOptRateDelta(delta map[string]float64, key string) generatedapi.OptFloat64 {
+	val, ok := delta[key]
+	if !ok {
+		return generatedapi.OptFloat64{}
+	}
+
+	return generatedapi.NewOptFloat64(val)
+}
+
 func toOptUInt(val uint) generatedapi.OptUint {
 	return generatedapi.NewOptUint(val)
 }
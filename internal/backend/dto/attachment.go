@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func MakeAttachment(attachment domain.Attachment) generatedapi.Attachment {
+	return generatedapi.Attachment{
+		ID:          attachment.ID.Uint(),
+		ProjectID:   attachment.ProjectID.Uint(),
+		EventID:     attachment.EventID.String(),
+		Name:        attachment.Name,
+		ContentType: attachment.ContentType,
+		Type:        attachment.Type,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func MakeListEventAttachmentsResponse(attachments []domain.Attachment) generatedapi.ListEventAttachmentsResponse {
+	items := make([]generatedapi.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		items = append(items, MakeAttachment(attachment))
+	}
+
+	return generatedapi.ListEventAttachmentsResponse{Items: items}
+}
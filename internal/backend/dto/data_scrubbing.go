@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/common/datascrubbing"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// DomainDataScrubbingToAPI converts domain.DataScrubbing to generatedapi.DataScrubbing.
+func DomainDataScrubbingToAPI(scrubbing domain.DataScrubbing) generatedapi.DataScrubbing {
+	item := generatedapi.DataScrubbing{
+		ProjectID:              scrubbing.ProjectID.Uint(),
+		DefaultScrubbers:       scrubbing.DefaultScrubbers,
+		SensitiveFields:        nonNilStrings(scrubbing.SensitiveFields),
+		Patterns:               nonNilStrings(scrubbing.Patterns),
+		AnonymizeIP:            scrubbing.AnonymizeIP,
+		DropRequestCookies:     scrubbing.DropRequestCookies,
+		DropRequestData:        scrubbing.DropRequestData,
+		DefaultSensitiveFields: datascrubbing.DefaultSensitiveKeys(),
+	}
+	if !scrubbing.UpdatedAt.IsZero() {
+		item.UpdatedAt = generatedapi.NewOptNilDateTime(scrubbing.UpdatedAt)
+	}
+
+	return item
+}
+
+// DataScrubbingFromAPI converts the update request to domain.DataScrubbing of the project.
+func DataScrubbingFromAPI(
+	projectID domain.ProjectID,
+	req *generatedapi.UpdateDataScrubbingRequest,
+) domain.DataScrubbing {
+	return domain.DataScrubbing{
+		ProjectID:          projectID,
+		DefaultScrubbers:   req.DefaultScrubbers,
+		SensitiveFields:    req.SensitiveFields,
+		Patterns:           req.Patterns,
+		AnonymizeIP:        req.AnonymizeIP,
+		DropRequestCookies: req.DropRequestCookies,
+		DropRequestData:    req.DropRequestData,
+	}
+}
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// MakeListEnvironmentsResponse converts the environments of a project to generatedapi.ListEnvironmentsResponse.
+func MakeListEnvironmentsResponse(environments []domain.Environment) generatedapi.ListEnvironmentsResponse {
+	items := make([]generatedapi.Environment, 0, len(environments))
+	for _, environment := range environments {
+		items = append(items, generatedapi.Environment{
+			Name:      environment.Name,
+			Hidden:    environment.Hidden,
+			FirstSeen: environment.FirstSeen,
+			LastSeen:  environment.LastSeen,
+		})
+	}
+
+	return generatedapi.ListEnvironmentsResponse{Environments: items}
+}
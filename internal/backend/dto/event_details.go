@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/go-faster/jx"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// MakeEventDetailsResponse converts domain.EventDetails to generatedapi.EventDetailsResponse.
+func MakeEventDetailsResponse(details domain.EventDetails) generatedapi.EventDetailsResponse {
+	resp := generatedapi.EventDetailsResponse{
+		Event:       DomainIssueEventToAPI(details.Event),
+		Breadcrumbs: make([]generatedapi.EventBreadcrumb, 0, len(details.Data.Breadcrumbs)),
+		Contexts:    rawJSONMap(details.Data.Contexts),
+		Extra:       rawJSONMap(details.Data.Extra),
+		Exceptions:  make([]generatedapi.EventException, 0, len(details.Data.Exceptions)),
+	}
+
+	if details.IssueID != 0 {
+		resp.IssueID = generatedapi.NewOptUint(details.IssueID.Uint())
+	}
+	if details.PreviousEventID != "" {
+		resp.PreviousEventID = generatedapi.NewOptString(details.PreviousEventID.String())
+	}
+	if details.NextEventID != "" {
+		resp.NextEventID = generatedapi.NewOptString(details.NextEventID.String())
+	}
+
+	for _, breadcrumb := range details.Data.Breadcrumbs {
+		item := generatedapi.EventBreadcrumb{
+			Type:     optString(breadcrumb.Type),
+			Category: optString(breadcrumb.Category),
+			Level:    optString(breadcrumb.Level),
+			Message:  optString(breadcrumb.Message),
+		}
+		if breadcrumb.Timestamp != nil {
+			item.Timestamp = generatedapi.NewOptDateTime(*breadcrumb.Timestamp)
+		}
+		if breadcrumb.Data != nil {
+			item.Data = generatedapi.NewOptEventBreadcrumbData(rawJSONMap(breadcrumb.Data))
+		}
+
+		resp.Breadcrumbs = append(resp.Breadcrumbs, item)
+	}
+
+	if details.Data.SDK != nil {
+		resp.Sdk = generatedapi.NewOptEventSDK(generatedapi.EventSDK{
+			Name:         details.Data.SDK.Name,
+			Version:      details.Data.SDK.Version,
+			Integrations: details.Data.SDK.Integrations,
+		})
+	}
+
+	for _, exception := range details.Data.Exceptions {
+		item := generatedapi.EventException{Type: exception.Type, Value: exception.Value}
+		if len(exception.Stacktrace) != 0 {
+			item.Stacktrace = generatedapi.NewOptString(string(exception.Stacktrace))
+		}
+
+		resp.Exceptions = append(resp.Exceptions, item)
+	}
+
+	return resp
+}
+
+// rawJSONMap converts decoded JSON object values back to raw JSON.
+func rawJSONMap(values map[string]any) map[string]jx.Raw {
+	result := make(map[string]jx.Raw, len(values))
+	for key, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+
+		result[key] = data
+	}
+
+	return result
+}
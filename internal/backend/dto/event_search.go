@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rom8726/warden/internal/common/searchquery"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// MakeEventSearchFilter converts generatedapi.SearchProjectEventsParams to domain.EventSearchFilter,
+// it fails with domain.ErrInvalidSearchQuery for invalid queries and cursors.
+func MakeEventSearchFilter(params generatedapi.SearchProjectEventsParams) (domain.EventSearchFilter, error) {
+	query, err := searchquery.Parse(params.Query.Or(""))
+	if err != nil {
+		return domain.EventSearchFilter{}, err
+	}
+
+	filter := domain.EventSearchFilter{
+		ProjectID: domain.ProjectID(params.ProjectID),
+		Query:     query,
+		TimeFrom:  params.TimeFrom.Or(time.Time{}),
+		TimeTo:    params.TimeTo.Or(time.Time{}),
+		Limit:     params.Limit.Or(0),
+	}
+
+	if params.Cursor.Set {
+		cursor, err := DecodeEventCursor(params.Cursor.Value)
+		if err != nil {
+			return domain.EventSearchFilter{}, err
+		}
+
+		filter.Cursor = &cursor
+	}
+
+	return filter, nil
+}
+
+// MakeSearchEventsResponse converts domain.EventSearchPage to generatedapi.SearchEventsResponse.
+func MakeSearchEventsResponse(page domain.EventSearchPage) generatedapi.SearchEventsResponse {
+	events := make([]generatedapi.IssueEvent, 0, len(page.Events))
+	for i := range page.Events {
+		events = append(events, DomainIssueEventToAPI(page.Events[i]))
+	}
+
+	resp := generatedapi.SearchEventsResponse{Events: events}
+	if page.NextCursor != nil {
+		resp.NextCursor = generatedapi.NewOptString(EncodeEventCursor(*page.NextCursor))
+	}
+
+	return resp
+}
+
+// EncodeEventCursor encodes the cursor into an opaque string.
+func EncodeEventCursor(cursor domain.EventCursor) string {
+	value := strconv.FormatInt(cursor.Timestamp.Unix(), 10) + ":" + string(cursor.EventID)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// DecodeEventCursor decodes the cursor encoded by EncodeEventCursor.
+func DecodeEventCursor(value string) (domain.EventCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return domain.EventCursor{}, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidSearchQuery)
+	}
+
+	timestamp, eventID, ok := strings.Cut(string(decoded), ":")
+	if !ok || eventID == "" {
+		return domain.EventCursor{}, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidSearchQuery)
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return domain.EventCursor{}, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidSearchQuery)
+	}
+
+	return domain.EventCursor{Timestamp: time.Unix(unix, 0).UTC(), EventID: domain.EventID(eventID)}, nil
+}
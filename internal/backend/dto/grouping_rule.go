@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// DomainGroupingRuleToAPI converts domain.GroupingRule to generatedapi.GroupingRule.
+func DomainGroupingRuleToAPI(rule domain.GroupingRule) generatedapi.GroupingRule {
+	return generatedapi.GroupingRule{
+		ID:         rule.ID.Uint(),
+		ProjectID:  rule.ProjectID.Uint(),
+		Type:       generatedapi.GroupingRuleType(rule.Type),
+		Expression: rule.Expression,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+func MakeListGroupingRulesResponse(rules []domain.GroupingRule) generatedapi.ListGroupingRulesResponse {
+	items := make([]generatedapi.GroupingRule, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, DomainGroupingRuleToAPI(rule))
+	}
+
+	return generatedapi.ListGroupingRulesResponse{GroupingRules: items}
+}
+
+func MakeGroupingRulePreviewResponse(preview domain.GroupingRulePreview) generatedapi.GroupingRulePreviewResponse {
+	issues := make([]generatedapi.GroupingRulePreviewIssue, 0, len(preview.Issues))
+	for _, issue := range preview.Issues {
+		item := generatedapi.GroupingRulePreviewIssue{
+			Fingerprint:     issue.Fingerprint,
+			NewFingerprints: issue.NewFingerprints,
+			MatchedEvents:   issue.MatchedEvents,
+		}
+		if issue.IssueID != nil {
+			item.IssueID = generatedapi.NewOptNilUint(issue.IssueID.Uint())
+			item.Title = generatedapi.NewOptString(issue.Title)
+		}
+
+		issues = append(issues, item)
+	}
+
+	return generatedapi.GroupingRulePreviewResponse{
+		ScannedEvents: preview.ScannedEvents,
+		MatchedEvents: preview.MatchedEvents,
+		Issues:        issues,
+	}
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// DomainInboundFiltersToAPI converts domain.InboundFilters to generatedapi.InboundFilters.
+func DomainInboundFiltersToAPI(filters domain.InboundFilters) generatedapi.InboundFilters {
+	item := generatedapi.InboundFilters{
+		ProjectID:            filters.ProjectID.Uint(),
+		AllowedOrigins:       nonNilStrings(filters.AllowedOrigins),
+		FilterWebCrawlers:    filters.WebCrawlers,
+		FilterLegacyBrowsers: filters.LegacyBrowsers,
+		FilterLocalhost:      filters.Localhost,
+		BlockedIPRanges:      nonNilStrings(filters.BlockedIPRanges),
+		ErrorMessages:        nonNilStrings(filters.ErrorMessages),
+		Releases:             nonNilStrings(filters.Releases),
+	}
+	if !filters.UpdatedAt.IsZero() {
+		item.UpdatedAt = generatedapi.NewOptNilDateTime(filters.UpdatedAt)
+	}
+
+	return item
+}
+
+// InboundFiltersFromAPI converts the update request to domain.InboundFilters of the project.
+func InboundFiltersFromAPI(
+	projectID domain.ProjectID,
+	req *generatedapi.UpdateInboundFiltersRequest,
+) domain.InboundFilters {
+	return domain.InboundFilters{
+		ProjectID:       projectID,
+		AllowedOrigins:  req.AllowedOrigins,
+		WebCrawlers:     req.FilterWebCrawlers,
+		LegacyBrowsers:  req.FilterLegacyBrowsers,
+		Localhost:       req.FilterLocalhost,
+		BlockedIPRanges: req.BlockedIPRanges,
+		ErrorMessages:   req.ErrorMessages,
+		Releases:        req.Releases,
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
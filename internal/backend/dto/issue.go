@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"github.com/rom8726/warden/internal/common/searchquery"
 	"github.com/rom8726/warden/internal/domain"
 	generatedapi "github.com/rom8726/warden/internal/generated/server"
 )
@@ -12,9 +13,16 @@ func DomainLevelToAPI(level domain.IssueLevel) generatedapi.IssueLevel {
 	return generatedapi.IssueLevel(level)
 }
 
-// MakeIssuesListFilter converts generatedapi.ListIssuesParams to domain.ListIssuesFilter.
-func MakeIssuesListFilter(params generatedapi.ListIssuesParams) domain.ListIssuesFilter {
+// MakeIssuesListFilter converts generatedapi.ListIssuesParams to domain.ListIssuesFilter,
+// it fails with domain.ErrInvalidSearchQuery for invalid queries.
+func MakeIssuesListFilter(params generatedapi.ListIssuesParams) (domain.ListIssuesFilter, error) {
+	query, err := issuesSearchQuery(params.Query, params.Environment)
+	if err != nil {
+		return domain.ListIssuesFilter{}, err
+	}
+
 	filter := domain.ListIssuesFilter{
+		Query:   query,
 		PerPage: params.PerPage,
 		PageNum: params.Page,
 		// Default sorting
@@ -34,6 +42,10 @@ func MakeIssuesListFilter(params generatedapi.ListIssuesParams) domain.ListIssue
 		status := domain.IssueStatus(params.Status.Value)
 		filter.Status = &status
 	}
+	if params.Assigned.Set {
+		assigned := domain.IssueAssignedFilter(params.Assigned.Value)
+		filter.Assigned = &assigned
+	}
 
 	// Handle sort_by parameter
 	if params.SortBy.Set {
@@ -52,7 +64,22 @@ func MakeIssuesListFilter(params generatedapi.ListIssuesParams) domain.ListIssue
 		filter.OrderAsc = params.SortOrder.Value == generatedapi.SortOrderAsc
 	}
 
-	return filter
+	return filter, nil
+}
+
+// issuesSearchQuery parses the issues search query, the environment filter is added as a search term
+// as issues are listed by their events of the environment.
+func issuesSearchQuery(query, environment generatedapi.OptString) (domain.SearchQuery, error) {
+	searchQuery, err := searchquery.Parse(query.Or(""))
+	if err != nil {
+		return nil, err
+	}
+
+	if environment.Set {
+		searchQuery = append(searchQuery, domain.SearchTerm{Key: "environment", Values: []string{environment.Value}})
+	}
+
+	return searchQuery, nil
 }
 
 // DomainIssueToAPI converts domain.Issue to generatedapi.Issue.
@@ -75,6 +102,26 @@ func DomainIssueToAPI(
 		resolvedByOpt.Set = true
 	}
 
+	var assignedUserID, assignedTeamID generatedapi.OptUint
+	if issue.Assignee.UserID != nil {
+		assignedUserID = generatedapi.NewOptUint(uint(*issue.Assignee.UserID))
+	}
+	if issue.Assignee.TeamID != nil {
+		assignedTeamID = generatedapi.NewOptUint(uint(*issue.Assignee.TeamID))
+	}
+
+	var escalating generatedapi.OptBool
+	if issue.Escalating {
+		escalating = generatedapi.NewOptBool(true)
+	}
+
+	var resolvedInRelease generatedapi.OptString
+	var resolvedInNextRelease generatedapi.OptBool
+	if issue.ResolvedInRelease != nil {
+		resolvedInRelease = optString(issue.ResolvedInRelease.Version)
+		resolvedInNextRelease = generatedapi.NewOptBool(issue.ResolvedInRelease.NextRelease)
+	}
+
 	return generatedapi.Issue{
 		ID:          uint(issue.ID),
 		ProjectID:   issue.ProjectID.Uint(),
@@ -90,14 +137,48 @@ func DomainIssueToAPI(
 		LastSeen:    issue.LastSeen,
 		ResolvedAt:  resolvedAtOpt,
 		ResolvedBy:  resolvedByOpt,
+
+		AssignedUserID: assignedUserID,
+		AssignedTeamID: assignedTeamID,
+		AssigneeName:   optString(issue.Assignee.Name),
+		Escalating:     escalating,
+
+		ResolvedInRelease:     resolvedInRelease,
+		ResolvedInNextRelease: resolvedInNextRelease,
+	}
+}
+
+// MakeIssueAssignee converts generatedapi.IssueAssigneeRequest to domain.IssueAssignee.
+func MakeIssueAssignee(req *generatedapi.IssueAssigneeRequest) domain.IssueAssignee {
+	var assignee domain.IssueAssignee
+	if userID, ok := req.UserID.Get(); ok {
+		id := domain.UserID(userID)
+		assignee.UserID = &id
 	}
+	if teamID, ok := req.TeamID.Get(); ok {
+		id := domain.TeamID(teamID)
+		assignee.TeamID = &id
+	}
+
+	return assignee
 }
 
 // MakeIssueResponseWithEvent converts domain.IssueExtendedWithChildren to generatedapi.IssueResponse.
 func MakeIssueResponseWithEvent(issue domain.IssueExtendedWithChildren) generatedapi.IssueResponse {
+	attachmentsByEvent := make(map[domain.EventID][]generatedapi.Attachment, len(issue.Attachments))
+	for _, attachment := range issue.Attachments {
+		attachmentsByEvent[attachment.EventID] = append(attachmentsByEvent[attachment.EventID], MakeAttachment(attachment))
+	}
+
 	events := make([]generatedapi.IssueEvent, len(issue.Events))
 	for i := range issue.Events {
 		events[i] = DomainIssueEventToAPI(issue.Events[i])
+		events[i].Attachments = attachmentsByEvent[issue.Events[i].ID]
+	}
+
+	var ignoreConditions generatedapi.OptIssueIgnoreConditions
+	if issue.IgnoreConditions != nil {
+		ignoreConditions = generatedapi.NewOptIssueIgnoreConditions(DomainIgnoreConditionsToAPI(*issue.IgnoreConditions))
 	}
 
 	return generatedapi.IssueResponse{
@@ -109,6 +190,50 @@ func MakeIssueResponseWithEvent(issue domain.IssueExtendedWithChildren) generate
 			issue.ResolvedBy,
 			issue.ResolvedByUsername,
 		),
-		Events: events,
+		Events:             events,
+		MergedFingerprints: issue.MergedFingerprints,
+		IgnoreConditions:   ignoreConditions,
+	}
+}
+
+// MakeIgnoreConditions converts generatedapi.IssueIgnoreConditions to domain.IssueIgnoreConditions.
+func MakeIgnoreConditions(conditions generatedapi.IssueIgnoreConditions) domain.IssueIgnoreConditions {
+	var result domain.IssueIgnoreConditions
+	if until, ok := conditions.Until.Get(); ok {
+		result.Until = &until
+	}
+	if hours, ok := conditions.ForHours.Get(); ok {
+		duration := time.Duration(hours) * time.Hour
+		result.For = &duration
+	}
+	if count, ok := conditions.Count.Get(); ok {
+		result.Count = &count
 	}
+	if userCount, ok := conditions.UserCount.Get(); ok {
+		result.UserCount = &userCount
+	}
+	if rate, ok := conditions.RatePerHour.Get(); ok {
+		result.RatePerHour = &rate
+	}
+
+	return result
+}
+
+// DomainIgnoreConditionsToAPI converts domain.IssueIgnoreConditions to generatedapi.IssueIgnoreConditions.
+func DomainIgnoreConditionsToAPI(conditions domain.IssueIgnoreConditions) generatedapi.IssueIgnoreConditions {
+	var result generatedapi.IssueIgnoreConditions
+	if conditions.Until != nil {
+		result.Until = generatedapi.NewOptDateTime(*conditions.Until)
+	}
+	if conditions.Count != nil {
+		result.Count = generatedapi.NewOptUint(*conditions.Count)
+	}
+	if conditions.UserCount != nil {
+		result.UserCount = generatedapi.NewOptUint(*conditions.UserCount)
+	}
+	if conditions.RatePerHour != nil {
+		result.RatePerHour = generatedapi.NewOptUint(*conditions.RatePerHour)
+	}
+
+	return result
 }
@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// MakeIssueActivitiesFilter converts generatedapi.ListProjectIssueActivityParams to domain.IssueActivitiesFilter.
+func MakeIssueActivitiesFilter(params generatedapi.ListProjectIssueActivityParams) domain.IssueActivitiesFilter {
+	return domain.IssueActivitiesFilter{
+		IssueID: domain.IssueID(params.IssueID),
+		PageNum: params.Page.Or(1),
+		PerPage: params.PerPage.Or(20),
+	}
+}
+
+// MakeIssueActivityListResponse converts issue activities to generatedapi.IssueActivityListResponse.
+func MakeIssueActivityListResponse(
+	activities []domain.IssueActivity,
+	total uint64,
+	filter domain.IssueActivitiesFilter,
+) generatedapi.IssueActivityListResponse {
+	items := make([]generatedapi.IssueActivity, 0, len(activities))
+	for i := range activities {
+		items = append(items, DomainIssueActivityToAPI(activities[i]))
+	}
+
+	return generatedapi.IssueActivityListResponse{
+		Activities: items,
+		Total:      uint(total),
+		Page:       filter.PageNum,
+		PerPage:    filter.PerPage,
+	}
+}
+
+// DomainIssueActivityToAPI converts domain.IssueActivity to generatedapi.IssueActivity.
+func DomainIssueActivityToAPI(activity domain.IssueActivity) generatedapi.IssueActivity {
+	result := generatedapi.IssueActivity{
+		ID:           uint(activity.ID),
+		Type:         generatedapi.IssueActivityType(activity.Type),
+		Username:     optString(activity.Username),
+		CreatedAt:    activity.CreatedAt,
+		AssigneeName: optString(activity.Data.AssigneeName),
+		Fingerprints: activity.Data.Fingerprints,
+	}
+
+	if activity.UserID != nil {
+		result.UserID = generatedapi.NewOptUint(uint(*activity.UserID))
+	}
+	if activity.Data.Status != "" {
+		result.Status = generatedapi.NewOptIssueStatus(generatedapi.IssueStatus(activity.Data.Status))
+	}
+	if activity.Data.AssignedUserID != nil {
+		result.AssignedUserID = generatedapi.NewOptUint(uint(*activity.Data.AssignedUserID))
+	}
+	if activity.Data.AssignedTeamID != nil {
+		result.AssignedTeamID = generatedapi.NewOptUint(uint(*activity.Data.AssignedTeamID))
+	}
+	if activity.Data.CommentID != nil {
+		result.CommentID = generatedapi.NewOptUint(uint(*activity.Data.CommentID))
+	}
+	if activity.Data.CommentBody != nil {
+		result.CommentBody = generatedapi.NewOptString(*activity.Data.CommentBody)
+	}
+
+	for _, id := range activity.Data.IssueIDs {
+		result.IssueIds = append(result.IssueIds, uint(id))
+	}
+
+	return result
+}
+
+// DomainIssueCommentToAPI converts domain.IssueComment to generatedapi.IssueComment.
+func DomainIssueCommentToAPI(comment domain.IssueComment) generatedapi.IssueComment {
+	result := generatedapi.IssueComment{
+		ID:        uint(comment.ID),
+		IssueID:   uint(comment.IssueID),
+		Username:  optString(comment.Username),
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+
+	if comment.ParentID != nil {
+		result.ParentID = generatedapi.NewOptUint(uint(*comment.ParentID))
+	}
+	if comment.UserID != nil {
+		result.UserID = generatedapi.NewOptUint(uint(*comment.UserID))
+	}
+
+	return result
+}
+
+// MakeIssueCommentsResponse converts issue comments to generatedapi.IssueCommentsResponse.
+func MakeIssueCommentsResponse(comments []domain.IssueComment) generatedapi.IssueCommentsResponse {
+	items := make([]generatedapi.IssueComment, 0, len(comments))
+	for i := range comments {
+		items = append(items, DomainIssueCommentToAPI(comments[i]))
+	}
+
+	return generatedapi.IssueCommentsResponse{Comments: items}
+}
+
+// MakeIssueCommentDTO converts generatedapi.CreateIssueCommentRequest to domain.IssueCommentDTO,
+// the author is set by the use case.
+func MakeIssueCommentDTO(issueID domain.IssueID, req *generatedapi.CreateIssueCommentRequest) domain.IssueCommentDTO {
+	comment := domain.IssueCommentDTO{
+		IssueID: issueID,
+		Body:    req.Body,
+	}
+
+	if parentID, ok := req.ParentID.Get(); ok {
+		id := domain.IssueCommentID(parentID)
+		comment.ParentID = &id
+	}
+
+	return comment
+}
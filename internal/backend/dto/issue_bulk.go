@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// MakeIssueBulkOperation converts generatedapi.IssueBulkRequest to domain.IssueBulkOperation.
+func MakeIssueBulkOperation(
+	projectID domain.ProjectID,
+	req *generatedapi.IssueBulkRequest,
+) (domain.IssueBulkOperation, error) {
+	operation := domain.IssueBulkOperation{
+		ProjectID:      projectID,
+		Action:         domain.IssueBulkAction(req.Action),
+		IssueIDs:       make([]domain.IssueID, 0, len(req.IssueIds)),
+		PrimaryIssueID: domain.IssueID(req.PrimaryIssueID.Or(0)),
+		Confirm:        req.Confirm.Or(false),
+	}
+
+	for _, id := range req.IssueIds {
+		operation.IssueIDs = append(operation.IssueIDs, domain.IssueID(id))
+	}
+
+	if filter, ok := req.Filter.Get(); ok {
+		query, err := issuesSearchQuery(filter.Query, filter.Environment)
+		if err != nil {
+			return domain.IssueBulkOperation{}, err
+		}
+
+		operation.Filter = &domain.ListIssuesFilter{Query: query}
+		if filter.Level.Set {
+			level := domain.IssueLevel(filter.Level.Value)
+			operation.Filter.Level = &level
+		}
+		if filter.Status.Set {
+			status := domain.IssueStatus(filter.Status.Value)
+			operation.Filter.Status = &status
+		}
+		if filter.Assigned.Set {
+			assigned := domain.IssueAssignedFilter(filter.Assigned.Value)
+			operation.Filter.Assigned = &assigned
+		}
+	}
+
+	if conditions, ok := req.IgnoreConditions.Get(); ok {
+		ignoreConditions := MakeIgnoreConditions(conditions)
+		operation.IgnoreConditions = &ignoreConditions
+	}
+
+	if req.ResolvedInRelease.Set || req.ResolvedInNextRelease.Value {
+		operation.ResolvedInRelease = &domain.IssueResolvedInRelease{
+			Version:     req.ResolvedInRelease.Value,
+			NextRelease: req.ResolvedInNextRelease.Value,
+		}
+	}
+
+	if assignee, ok := req.Assignee.Get(); ok {
+		operation.Assignee = MakeIssueAssignee(&assignee)
+	}
+
+	return operation, nil
+}
+
+// DomainIssueBulkResultToAPI converts domain.IssueBulkResult to generatedapi.IssueBulkResponse.
+func DomainIssueBulkResultToAPI(result domain.IssueBulkResult) generatedapi.IssueBulkResponse {
+	return generatedapi.IssueBulkResponse{
+		Changed:   issueIDsToAPI(result.Changed),
+		Unchanged: issueIDsToAPI(result.Unchanged),
+		Denied:    issueIDsToAPI(result.Denied),
+		NotFound:  issueIDsToAPI(result.NotFound),
+		HasMore:   result.HasMore,
+	}
+}
+
+func issueIDsToAPI(ids []domain.IssueID) []uint {
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, uint(id))
+	}
+
+	return result
+}
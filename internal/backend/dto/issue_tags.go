@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// MakeIssueTagsResponse converts the issue tags to generatedapi.IssueTagsResponse.
+func MakeIssueTagsResponse(tags []domain.IssueTag) generatedapi.IssueTagsResponse {
+	items := make([]generatedapi.IssueTag, 0, len(tags))
+	for _, tag := range tags {
+		values := make([]generatedapi.IssueTagValue, 0, len(tag.TopValues))
+		for _, value := range tag.TopValues {
+			values = append(values, generatedapi.IssueTagValue{
+				Value:      value.Value,
+				Count:      value.Count,
+				Percentage: tag.Percent(value),
+				FirstSeen:  value.FirstSeen,
+				LastSeen:   value.LastSeen,
+			})
+		}
+
+		items = append(items, generatedapi.IssueTag{
+			Key:          tag.Key,
+			TotalEvents:  tag.TotalEvents,
+			UniqueValues: tag.UniqueValues,
+			TopValues:    values,
+		})
+	}
+
+	return generatedapi.IssueTagsResponse{Tags: items}
+}
+
+// MakeIssueTagEventsFilter builds the issue tag drill-down filter from the request parameters.
+func MakeIssueTagEventsFilter(
+	params generatedapi.ListProjectIssueTagEventsParams,
+) (domain.IssueTagEventsFilter, error) {
+	filter := domain.IssueTagEventsFilter{
+		ProjectID: domain.ProjectID(params.ProjectID),
+		IssueID:   domain.IssueID(params.IssueID),
+		Key:       params.Key,
+		Value:     params.Value,
+		Limit:     params.Limit.Or(0),
+	}
+
+	if params.Cursor.Set {
+		cursor, err := DecodeEventCursor(params.Cursor.Value)
+		if err != nil {
+			return domain.IssueTagEventsFilter{}, err
+		}
+
+		filter.Cursor = &cursor
+	}
+
+	return filter, nil
+}
@@ -277,7 +277,8 @@ func TestMakeIssuesListFilter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := MakeIssuesListFilter(tt.params)
+			result, err := MakeIssuesListFilter(tt.params)
+			assert.NoError(t, err)
 
 			assert.Equal(t, tt.expected.PageNum, result.PageNum)
 			assert.Equal(t, tt.expected.PerPage, result.PerPage)
@@ -308,6 +309,117 @@ func TestMakeIssuesListFilter(t *testing.T) {
 	}
 }
 
+func TestMakeIssuesListFilter_Query(t *testing.T) {
+	filter, err := MakeIssuesListFilter(generatedapi.ListIssuesParams{
+		Query:   generatedapi.NewOptString("environment:production tags[customer]:42"),
+		Page:    1,
+		PerPage: 20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SearchQuery{
+		{Key: "environment", Values: []string{"production"}},
+		{Key: "tags", Tag: "customer", Values: []string{"42"}},
+	}, filter.Query)
+
+	_, err = MakeIssuesListFilter(generatedapi.ListIssuesParams{
+		Query:   generatedapi.NewOptString("customer:42"),
+		Page:    1,
+		PerPage: 20,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+}
+
+func TestMakeIssuesListFilter_Environment(t *testing.T) {
+	filter, err := MakeIssuesListFilter(generatedapi.ListIssuesParams{
+		Query:       generatedapi.NewOptString("release:1.2.*"),
+		Environment: generatedapi.NewOptString("production"),
+		Page:        1,
+		PerPage:     20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SearchQuery{
+		{Key: "release", Values: []string{"1.2.*"}},
+		{Key: "environment", Values: []string{"production"}},
+	}, filter.Query)
+}
+
+func TestMakeIssuesListFilter_Assigned(t *testing.T) {
+	filter, err := MakeIssuesListFilter(generatedapi.ListIssuesParams{
+		Assigned: generatedapi.NewOptIssueAssignedFilter(generatedapi.IssueAssignedFilterMyTeams),
+		Page:     1,
+		PerPage:  20,
+	})
+	assert.NoError(t, err)
+	if assert.NotNil(t, filter.Assigned) {
+		assert.Equal(t, domain.IssueAssignedToMyTeams, *filter.Assigned)
+	}
+}
+
+func TestDomainIssueToAPI_Assignee(t *testing.T) {
+	teamID := domain.TeamID(3)
+	issue := domain.Issue{ID: 1, Assignee: domain.IssueAssignee{TeamID: &teamID, Name: "backend"}}
+
+	result := DomainIssueToAPI(issue, "api", nil, nil, nil)
+	assert.False(t, result.AssignedUserID.Set)
+	assert.Equal(t, generatedapi.NewOptUint(3), result.AssignedTeamID)
+	assert.Equal(t, generatedapi.NewOptString("backend"), result.AssigneeName)
+}
+
+func TestDomainIssueToAPI_ResolvedInRelease(t *testing.T) {
+	issue := domain.Issue{ID: 1, Status: domain.IssueStatusResolved}
+
+	result := DomainIssueToAPI(issue, "api", nil, nil, nil)
+	assert.False(t, result.ResolvedInRelease.Set)
+	assert.False(t, result.ResolvedInNextRelease.Set)
+
+	issue.ResolvedInRelease = &domain.IssueResolvedInRelease{Version: "1.4.0", NextRelease: true}
+
+	result = DomainIssueToAPI(issue, "api", nil, nil, nil)
+	assert.Equal(t, generatedapi.NewOptString("1.4.0"), result.ResolvedInRelease)
+	assert.Equal(t, generatedapi.NewOptBool(true), result.ResolvedInNextRelease)
+}
+
+func TestMakeIgnoreConditions(t *testing.T) {
+	conditions := MakeIgnoreConditions(generatedapi.IssueIgnoreConditions{
+		ForHours:    generatedapi.NewOptUint(6),
+		RatePerHour: generatedapi.NewOptUint(100),
+	})
+
+	if assert.NotNil(t, conditions.For) {
+		assert.Equal(t, 6*time.Hour, *conditions.For)
+	}
+	if assert.NotNil(t, conditions.RatePerHour) {
+		assert.Equal(t, uint(100), *conditions.RatePerHour)
+	}
+	assert.Nil(t, conditions.Until)
+	assert.Nil(t, conditions.Count)
+	assert.Nil(t, conditions.UserCount)
+}
+
+func TestMakeIssueResponseWithEvent_Ignored(t *testing.T) {
+	until := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	count := uint(50)
+	issue := domain.IssueExtendedWithChildren{
+		Issue:            domain.Issue{ID: 1, Status: domain.IssueStatusIgnored},
+		IgnoreConditions: &domain.IssueIgnoreConditions{Until: &until, Count: &count},
+	}
+
+	result := MakeIssueResponseWithEvent(issue)
+	assert.False(t, result.Issue.Escalating.Set)
+	assert.Equal(t, generatedapi.NewOptIssueIgnoreConditions(generatedapi.IssueIgnoreConditions{
+		Until: generatedapi.NewOptDateTime(until),
+		Count: generatedapi.NewOptUint(50),
+	}), result.IgnoreConditions)
+
+	issue.Status = domain.IssueStatusUnresolved
+	issue.Escalating = true
+	issue.IgnoreConditions = nil
+
+	result = MakeIssueResponseWithEvent(issue)
+	assert.Equal(t, generatedapi.NewOptBool(true), result.Issue.Escalating)
+	assert.False(t, result.IgnoreConditions.Set)
+}
+
 func TestDomainIssueToAPI(t *testing.T) {
 	now := time.Now()
 	userID := domain.UserID(123)
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func MakeMonitor(monitor domain.Monitor) generatedapi.Monitor {
+	return generatedapi.Monitor{
+		ID:            monitor.ID.Uint(),
+		ProjectID:     monitor.ProjectID.Uint(),
+		Slug:          monitor.Slug,
+		ScheduleType:  generatedapi.MonitorScheduleType(monitor.ScheduleType),
+		Schedule:      monitor.Schedule,
+		Timezone:      monitor.Timezone,
+		CheckinMargin: uint(monitor.CheckInMargin / time.Minute),
+		MaxRuntime:    uint(monitor.MaxRuntime / time.Minute),
+		Status:        generatedapi.MonitorStatus(monitor.Status),
+		LastCheckInAt: makeOptNilDateTime(monitor.LastCheckInAt),
+		NextCheckInAt: makeOptNilDateTime(monitor.NextCheckInAt),
+		CreatedAt:     monitor.CreatedAt,
+	}
+}
+
+func MakeMonitorCheckIn(checkIn domain.MonitorCheckIn) generatedapi.MonitorCheckIn {
+	var duration generatedapi.OptNilFloat64
+	if checkIn.Duration != nil {
+		duration = generatedapi.NewOptNilFloat64(checkIn.Duration.Seconds())
+	}
+
+	return generatedapi.MonitorCheckIn{
+		ID:          checkIn.ID.Uint(),
+		CheckInID:   checkIn.CheckInID,
+		Status:      generatedapi.MonitorCheckInStatus(checkIn.Status),
+		Duration:    duration,
+		Environment: checkIn.Environment,
+		ExpectedAt:  makeOptNilDateTime(checkIn.ExpectedAt),
+		CreatedAt:   checkIn.CreatedAt,
+		UpdatedAt:   checkIn.UpdatedAt,
+	}
+}
+
+func MakeListMonitorsResponse(monitors []domain.Monitor) generatedapi.ListMonitorsResponse {
+	items := make([]generatedapi.Monitor, 0, len(monitors))
+	for _, monitor := range monitors {
+		items = append(items, MakeMonitor(monitor))
+	}
+
+	return generatedapi.ListMonitorsResponse{Items: items}
+}
+
+func MakeMonitorWithCheckIns(monitor domain.MonitorWithCheckIns) generatedapi.MonitorWithCheckIns {
+	checkIns := make([]generatedapi.MonitorCheckIn, 0, len(monitor.CheckIns))
+	for _, checkIn := range monitor.CheckIns {
+		checkIns = append(checkIns, MakeMonitorCheckIn(checkIn))
+	}
+
+	return generatedapi.MonitorWithCheckIns{
+		Monitor:  MakeMonitor(monitor.Monitor),
+		CheckIns: checkIns,
+	}
+}
+
+func makeOptNilDateTime(value *time.Time) generatedapi.OptNilDateTime {
+	if value == nil {
+		return generatedapi.OptNilDateTime{}
+	}
+
+	return generatedapi.NewOptNilDateTime(*value)
+}
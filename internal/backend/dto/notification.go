@@ -17,6 +17,8 @@ func DomainNotificationSettingToAPI(setting domain.NotificationSetting) generate
 		Enabled:   setting.Enabled,
 		CreatedAt: setting.CreatedAt,
 		UpdatedAt: setting.UpdatedAt,
+
+		RouteToAssignee: setting.RouteToAssignee,
 	}
 }
 
@@ -41,6 +43,12 @@ func DomainNotificationRuleToAPI(rule domain.NotificationRule) generatedapi.Noti
 		isRegression.Set = true
 	}
 
+	var environment generatedapi.OptNilString
+	if rule.Environment != nil {
+		environment.Value = *rule.Environment
+		environment.Set = true
+	}
+
 	return generatedapi.NotificationRule{
 		ID:                    uint(rule.ID),
 		NotificationSettingID: uint(rule.NotificationSetting),
@@ -48,6 +56,7 @@ func DomainNotificationRuleToAPI(rule domain.NotificationRule) generatedapi.Noti
 		Fingerprint:           fingerprint,
 		IsNewError:            isNewError,
 		IsRegression:          isRegression,
+		Environment:           environment,
 		CreatedAt:             rule.CreatedAt,
 	}
 }
@@ -62,6 +71,8 @@ func MakeNotificationSettingDTO(
 		Type:      domain.NotificationType(req.Type),
 		Config:    json.RawMessage(req.Config),
 		Enabled:   req.Enabled.Value,
+
+		RouteToAssignee: req.RouteToAssignee.Value,
 	}
 }
 
@@ -91,12 +102,18 @@ func MakeNotificationRuleDTO(
 		isRegression = &req.IsRegression.Value
 	}
 
+	var environment *string
+	if req.Environment.IsSet() && !req.Environment.IsNull() {
+		environment = &req.Environment.Value
+	}
+
 	return domain.NotificationRuleDTO{
 		NotificationSetting: settingID,
 		EventLevel:          eventLevel,
 		Fingerprint:         fingerprint,
 		IsNewError:          isNewError,
 		IsRegression:        isRegression,
+		Environment:         environment,
 	}
 }
 
@@ -118,6 +135,10 @@ func UpdateNotificationSettingFromRequest(
 		setting.Config = json.RawMessage(req.Config.Value)
 	}
 
+	if req.RouteToAssignee.Set {
+		setting.RouteToAssignee = req.RouteToAssignee.Value
+	}
+
 	return setting
 }
 
@@ -142,6 +163,15 @@ func UpdateNotificationRuleFromRequest(
 		rule.IsRegression = &req.IsRegression.Value
 	}
 
+	// Null environment makes the rule match all environments again
+	if req.Environment.IsSet() {
+		if req.Environment.IsNull() {
+			rule.Environment = nil
+		} else {
+			rule.Environment = &req.Environment.Value
+		}
+	}
+
 	return rule
 }
 
@@ -559,6 +559,30 @@ func TestUpdateNotificationRuleFromRequest(t *testing.T) {
 	}
 }
 
+func TestUpdateNotificationRuleFromRequest_Environment(t *testing.T) {
+	rule := domain.NotificationRule{ID: 1, EventLevel: domain.IssueLevelError}
+
+	rule = UpdateNotificationRuleFromRequest(rule, &generatedapi.UpdateNotificationRuleRequest{
+		Environment: generatedapi.NewOptNilString("production"),
+	})
+	if rule.Environment == nil || *rule.Environment != "production" {
+		t.Fatalf("expected production environment, got %v", rule.Environment)
+	}
+
+	rule = UpdateNotificationRuleFromRequest(rule, &generatedapi.UpdateNotificationRuleRequest{})
+	if rule.Environment == nil || *rule.Environment != "production" {
+		t.Fatalf("expected unchanged environment, got %v", rule.Environment)
+	}
+
+	var null generatedapi.OptNilString
+	null.SetToNull()
+
+	rule = UpdateNotificationRuleFromRequest(rule, &generatedapi.UpdateNotificationRuleRequest{Environment: null})
+	if rule.Environment != nil {
+		t.Fatalf("expected no environment, got %q", *rule.Environment)
+	}
+}
+
 func TestMakeListNotificationSettingsResponse(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func MakeOutcomeTotals(totals domain.OutcomeTotals) generatedapi.OutcomeTotals {
+	return generatedapi.OutcomeTotals{
+		Accepted:    totals.Accepted,
+		Filtered:    totals.Filtered,
+		RateLimited: totals.RateLimited,
+		Dropped:     totals.Dropped,
+	}
+}
+
+func MakeOutcomeItem(stat domain.OutcomeStat) generatedapi.OutcomeItem {
+	return generatedapi.OutcomeItem{
+		Outcome:  generatedapi.OutcomeItemOutcome(stat.Outcome),
+		Reason:   stat.Reason,
+		Category: string(stat.Category),
+		Group:    generatedapi.OutcomeItemGroup(domain.OutcomeGroupOf(stat.Outcome, stat.Reason)),
+		Quantity: stat.Quantity,
+	}
+}
+
+func MakeProjectOutcomesResponse(
+	outcomes domain.ProjectOutcomes,
+	interval, granularity string,
+) generatedapi.ProjectOutcomesResponse {
+	items := make([]generatedapi.OutcomeItem, 0, len(outcomes.Items))
+	for _, item := range outcomes.Items {
+		items = append(items, MakeOutcomeItem(item))
+	}
+
+	timeseries := make([]generatedapi.OutcomeBucket, 0, len(outcomes.Timeseries))
+	for _, bucket := range outcomes.Timeseries {
+		timeseries = append(timeseries, generatedapi.OutcomeBucket{
+			Accepted:    bucket.Accepted,
+			Filtered:    bucket.Filtered,
+			RateLimited: bucket.RateLimited,
+			Dropped:     bucket.Dropped,
+			Bucket:      bucket.Bucket,
+		})
+	}
+
+	return generatedapi.ProjectOutcomesResponse{
+		Period: generatedapi.Period{
+			Interval:    interval,
+			Granularity: granularity,
+		},
+		Totals:     MakeOutcomeTotals(outcomes.Totals),
+		Items:      items,
+		Timeseries: timeseries,
+	}
+}
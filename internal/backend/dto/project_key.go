@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// DomainProjectKeyToAPI converts domain.ProjectKey to generatedapi.ProjectKey.
+func DomainProjectKeyToAPI(key domain.ProjectKey) generatedapi.ProjectKey {
+	item := generatedapi.ProjectKey{
+		ID:                 key.ID.Uint(),
+		ProjectID:          key.ProjectID.Uint(),
+		Label:              key.Label,
+		PublicKey:          key.PublicKey,
+		SecretKey:          key.SecretKey,
+		IsActive:           key.IsActive,
+		CategoryRateLimits: categoryRateLimitsToAPI(key.RateLimits.Categories),
+		CreatedAt:          key.CreatedAt,
+		LastUsedAt:         makeOptNilDateTime(key.LastUsedAt),
+	}
+	if key.RateLimits.EventsPerMinute != nil {
+		item.RateLimit = generatedapi.NewOptNilUint(*key.RateLimits.EventsPerMinute)
+	}
+
+	return item
+}
+
+func MakeListProjectKeysResponse(keys []domain.ProjectKey) generatedapi.ListProjectKeysResponse {
+	items := make([]generatedapi.ProjectKey, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, DomainProjectKeyToAPI(key))
+	}
+
+	return generatedapi.ListProjectKeysResponse{Keys: items}
+}
@@ -0,0 +1,76 @@
+package dto
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+// RateLimitsFromAPI returns the rate limits of a request, the limits not set are unlimited.
+func RateLimitsFromAPI(
+	rateLimit generatedapi.OptNilUint,
+	categories generatedapi.OptCategoryRateLimits,
+) domain.RateLimits {
+	var limits domain.RateLimits
+	if value, ok := rateLimit.Get(); ok {
+		limits.EventsPerMinute = &value
+	}
+
+	apiCategories, ok := categories.Get()
+	if !ok {
+		return limits
+	}
+
+	limits.Categories = make(map[domain.DataCategory]uint)
+	for category, limit := range map[domain.DataCategory]generatedapi.OptUint{
+		domain.DataCategoryError:       apiCategories.Error,
+		domain.DataCategoryTransaction: apiCategories.Transaction,
+		domain.DataCategoryAttachment:  apiCategories.Attachment,
+		domain.DataCategorySession:     apiCategories.Session,
+		domain.DataCategoryMonitor:     apiCategories.Monitor,
+	} {
+		if value, ok := limit.Get(); ok {
+			limits.Categories[category] = value
+		}
+	}
+
+	return limits
+}
+
+// DomainProjectRateLimitsToAPI converts domain.ProjectRateLimits to generatedapi.ProjectRateLimits.
+func DomainProjectRateLimitsToAPI(limits domain.ProjectRateLimits) generatedapi.ProjectRateLimits {
+	item := generatedapi.ProjectRateLimits{
+		ProjectID:          limits.ProjectID.Uint(),
+		CategoryRateLimits: categoryRateLimitsToAPI(limits.Categories),
+	}
+	if limits.EventsPerMinute != nil {
+		item.RateLimit = generatedapi.NewOptNilUint(*limits.EventsPerMinute)
+	}
+	if !limits.UpdatedAt.IsZero() {
+		item.UpdatedAt = generatedapi.NewOptNilDateTime(limits.UpdatedAt)
+	}
+
+	return item
+}
+
+func categoryRateLimitsToAPI(categories map[domain.DataCategory]uint) generatedapi.CategoryRateLimits {
+	var item generatedapi.CategoryRateLimits
+	for category, limit := range categories {
+		value := generatedapi.NewOptUint(limit)
+
+		switch category {
+		case domain.DataCategoryError:
+			item.Error = value
+		case domain.DataCategoryTransaction:
+			item.Transaction = value
+		case domain.DataCategoryAttachment:
+			item.Attachment = value
+		case domain.DataCategorySession:
+			item.Session = value
+		case domain.DataCategoryMonitor:
+			item.Monitor = value
+		default:
+		}
+	}
+
+	return item
+}
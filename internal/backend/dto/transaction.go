@@ -0,0 +1,89 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/server"
+)
+
+func MakeListTransactionsResponse(stats []domain.TransactionStats) generatedapi.ListTransactionsResponse {
+	items := make([]generatedapi.TransactionSummary, 0, len(stats))
+	for _, elem := range stats {
+		items = append(items, generatedapi.TransactionSummary{
+			Name:        elem.Name,
+			Count:       elem.Count,
+			Throughput:  elem.Throughput,
+			FailureRate: elem.FailureRate,
+			P50Ms:       durationToMs(elem.P50),
+			P95Ms:       durationToMs(elem.P95),
+			P99Ms:       durationToMs(elem.P99),
+		})
+	}
+
+	return generatedapi.ListTransactionsResponse{Transactions: items}
+}
+
+func MakeTraceResponse(trace domain.Trace) generatedapi.TraceResponse {
+	transactions := make([]generatedapi.TraceTransaction, 0, len(trace.Transactions))
+	for _, transaction := range trace.Transactions {
+		transactions = append(transactions, generatedapi.TraceTransaction{
+			EventID:        transaction.ID.String(),
+			Name:           transaction.Name,
+			Op:             transaction.Op,
+			Status:         transaction.Status,
+			Platform:       generatedapi.NewOptString(transaction.Platform),
+			Environment:    generatedapi.NewOptString(transaction.Environment),
+			Release:        generatedapi.NewOptString(transaction.Release),
+			SpanID:         transaction.SpanID,
+			ParentSpanID:   optString(transaction.ParentSpanID),
+			StartTimestamp: transaction.StartTimestamp,
+			Timestamp:      transaction.Timestamp,
+			DurationMs:     durationToMs(transaction.Duration()),
+		})
+	}
+
+	spans := make([]generatedapi.TraceSpan, 0, len(trace.Spans))
+	for _, span := range trace.Spans {
+		item := generatedapi.TraceSpan{
+			SpanID:          span.SpanID,
+			ParentSpanID:    optString(span.ParentSpanID),
+			TransactionID:   span.TransactionID.String(),
+			TransactionName: optString(span.TransactionName),
+			Op:              span.Op,
+			Description:     span.Description,
+			Status:          span.Status,
+			StartTimestamp:  span.StartTimestamp,
+			Timestamp:       span.Timestamp,
+			DurationMs:      durationToMs(span.Duration()),
+			OffsetMs:        durationToMs(span.Offset),
+			Depth:           span.Depth,
+		}
+		if len(span.Tags) > 0 {
+			item.Tags = generatedapi.NewOptTraceSpanTags(span.Tags)
+		}
+
+		spans = append(spans, item)
+	}
+
+	return generatedapi.TraceResponse{
+		TraceID:        trace.TraceID,
+		StartTimestamp: trace.StartTimestamp,
+		Timestamp:      trace.Timestamp,
+		DurationMs:     durationToMs(trace.Duration()),
+		Transactions:   transactions,
+		Spans:          spans,
+	}
+}
+
+func optString(value string) generatedapi.OptString {
+	if value == "" {
+		return generatedapi.OptString{}
+	}
+
+	return generatedapi.NewOptString(value)
+}
+
+func durationToMs(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
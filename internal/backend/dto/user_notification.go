@@ -21,6 +21,12 @@ func UserNotificationToDTO(notification domain.UserNotification) (generatedapi.U
 		apiType = generatedapi.UserNotificationTypeRoleChanged
 	case domain.UserNotificationTypeIssueRegression:
 		apiType = generatedapi.UserNotificationTypeIssueRegression
+	case domain.UserNotificationTypeSpikeProtection:
+		apiType = generatedapi.UserNotificationTypeSpikeProtection
+	case domain.UserNotificationTypeIssueAssigned:
+		apiType = generatedapi.UserNotificationTypeIssueAssigned
+	case domain.UserNotificationTypeIssueMention:
+		apiType = generatedapi.UserNotificationTypeIssueMention
 	default:
 		apiType = generatedapi.UserNotificationTypeTeamAdded // fallback
 	}
@@ -43,6 +49,12 @@ func UserNotificationToDTO(notification domain.UserNotification) (generatedapi.U
 			concrete = notifContent.RoleChanged
 		case domain.UserNotificationTypeIssueRegression:
 			concrete = notifContent.IssueRegression
+		case domain.UserNotificationTypeSpikeProtection:
+			concrete = notifContent.SpikeProtection
+		case domain.UserNotificationTypeIssueAssigned:
+			concrete = notifContent.IssueAssigned
+		case domain.UserNotificationTypeIssueMention:
+			concrete = notifContent.IssueMention
 		default:
 			err := fmt.Errorf("unknown notification type: %s", notification.Type)
 
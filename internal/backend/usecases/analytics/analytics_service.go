@@ -84,23 +84,23 @@ func (s *AnalyticsService) GetReleaseDetails(
 	if err != nil {
 		return domain.ReleaseAnalyticsDetails{}, err
 	}
-	byPlatform, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, domain.SegmentNamePlatform)
+	byPlatform, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, "", domain.SegmentNamePlatform)
 	if err != nil {
 		return domain.ReleaseAnalyticsDetails{}, err
 	}
-	byBrowser, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, domain.SegmentNameBrowserName)
+	byBrowser, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, "", domain.SegmentNameBrowserName)
 	if err != nil {
 		return domain.ReleaseAnalyticsDetails{}, err
 	}
-	byOS, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, domain.SegmentNameOSName)
+	byOS, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, "", domain.SegmentNameOSName)
 	if err != nil {
 		return domain.ReleaseAnalyticsDetails{}, err
 	}
-	byDeviceArch, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, domain.SegmentNameDeviceArch)
+	byDeviceArch, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, "", domain.SegmentNameDeviceArch)
 	if err != nil {
 		return domain.ReleaseAnalyticsDetails{}, err
 	}
-	byRuntimeName, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, domain.SegmentNameRuntimeName)
+	byRuntimeName, err := s.eventRepo.AggregateBySegment(ctx, projectID, version, "", domain.SegmentNameRuntimeName)
 	if err != nil {
 		return domain.ReleaseAnalyticsDetails{}, err
 	}
@@ -139,26 +139,35 @@ func (s *AnalyticsService) CompareReleases(
 	delta["fixed_new_in_version_total"] = diffUint(targetStats.FixedNewInVersionTotal, baseStats.FixedNewInVersionTotal)
 	delta["fixed_old_in_version_total"] = diffUint(targetStats.FixedOldInVersionTotal, baseStats.FixedOldInVersionTotal)
 	delta["users_affected"] = diffUint(targetStats.UsersAffected, baseStats.UsersAffected)
+	delta["sessions_total"] = diffUint(targetStats.SessionsTotal, baseStats.SessionsTotal)
+
+	rateDelta := make(map[string]float64)
+	diffRate(rateDelta, "crash_free_sessions", targetStats.CrashFreeSessions, baseStats.CrashFreeSessions)
+	diffRate(rateDelta, "crash_free_users", targetStats.CrashFreeUsers, baseStats.CrashFreeUsers)
+	diffRate(rateDelta, "adoption", targetStats.Adoption, baseStats.Adoption)
 
 	return domain.ReleaseComparison{
 		BaseRelease:   baseStats,
 		TargetRelease: targetStats,
 		Delta:         delta,
+		RateDelta:     rateDelta,
 	}, nil
 }
 
-// GetErrorsByTime returns time series of errors for a release, with optional level and grouping.
+// GetErrorsByTime returns time series of errors for a release, with optional level, environment and grouping.
 func (s *AnalyticsService) GetErrorsByTime(
 	ctx context.Context,
 	projectID domain.ProjectID,
 	release string,
 	period, granularity time.Duration,
 	levels []domain.IssueLevel,
+	environment *string,
 	groupBy domain.EventTimeseriesGroup,
 ) ([]domain.Timeseries, error) {
 	filter := &domain.EventTimeseriesFilter{
-		ProjectID: &projectID,
-		Levels:    levels,
+		ProjectID:   &projectID,
+		Levels:      levels,
+		Environment: environment,
 		Period: domain.Period{
 			Interval:    period,
 			Granularity: granularity,
@@ -172,16 +181,19 @@ func (s *AnalyticsService) GetErrorsByTime(
 	return s.eventRepo.Timeseries(ctx, filter)
 }
 
-// GetUserSegments returns aggregations by platform, browser, OS for a release.
+// GetUserSegments returns aggregations by platform, browser, OS for a release,
+// the empty environment aggregates the events of all environments.
 func (s *AnalyticsService) GetUserSegments(
 	ctx context.Context,
 	projectID domain.ProjectID,
 	release string,
+	environment string,
 ) (domain.UserSegmentsAnalytics, error) {
 	platformRaw, err := s.eventRepo.AggregateBySegment(
 		ctx,
 		projectID,
 		release,
+		environment,
 		domain.SegmentNamePlatform,
 	)
 	if err != nil {
@@ -191,6 +203,7 @@ func (s *AnalyticsService) GetUserSegments(
 		ctx,
 		projectID,
 		release,
+		environment,
 		domain.SegmentNameBrowserName,
 	)
 	if err != nil {
@@ -200,6 +213,7 @@ func (s *AnalyticsService) GetUserSegments(
 		ctx,
 		projectID,
 		release,
+		environment,
 		domain.SegmentNameOSName,
 	)
 	if err != nil {
@@ -209,6 +223,7 @@ func (s *AnalyticsService) GetUserSegments(
 		ctx,
 		projectID,
 		release,
+		environment,
 		domain.SegmentNameDeviceArch,
 	)
 	if err != nil {
@@ -218,6 +233,7 @@ func (s *AnalyticsService) GetUserSegments(
 		ctx,
 		projectID,
 		release,
+		environment,
 		domain.SegmentNameRuntimeName,
 	)
 	if err != nil {
@@ -253,6 +269,15 @@ func (s *AnalyticsService) GetUserSegments(
 	}, nil
 }
 
+// diffRate stores the signed difference of the rates when both of them are known.
+func diffRate(delta map[string]float64, key string, target, base *float64) {
+	if target == nil || base == nil {
+		return
+	}
+
+	delta[key] = *target - *base
+}
+
 func diffUint(a, b uint) uint {
 	if a > b {
 		return a - b
@@ -318,6 +318,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNamePlatform,
 				).Return(byPlatform, nil)
 
@@ -325,6 +326,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameBrowserName,
 				).Return(byBrowser, nil)
 
@@ -332,6 +334,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameOSName,
 				).Return(byOS, nil)
 
@@ -339,6 +342,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameDeviceArch,
 				).Return(byDeviceArch, nil)
 
@@ -346,6 +350,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameRuntimeName,
 				).Return(byRuntimeName, nil)
 
@@ -448,6 +453,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNamePlatform,
 				).Return(byPlatform, nil)
 
@@ -455,6 +461,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameBrowserName,
 				).Return(byBrowser, nil)
 
@@ -462,6 +469,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameOSName,
 				).Return(byOS, nil)
 
@@ -469,6 +477,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameDeviceArch,
 				).Return(byDeviceArch, nil)
 
@@ -476,6 +485,7 @@ func TestGetReleaseDetails(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameRuntimeName,
 				).Return(byRuntimeName, nil)
 
@@ -641,6 +651,70 @@ func TestCompareReleases(t *testing.T) {
 					"fixed_new_in_version_total": 1,
 					"fixed_old_in_version_total": 2,
 					"users_affected":             5,
+					"sessions_total":             0,
+				},
+				RateDelta: map[string]float64{},
+			},
+			expectedError: false,
+		},
+		{
+			name: "With release health",
+			setupMocks: func(
+				mockReleaseStatsRepo *mockcontract.MockReleaseStatsRepository,
+			) {
+				mockReleaseStatsRepo.EXPECT().GetByProjectAndRelease(
+					mock.Anything,
+					domain.ProjectID(1),
+					"1.0.0",
+				).Return(domain.ReleaseStats{
+					Release:           "1.0.0",
+					SessionsTotal:     400,
+					CrashFreeSessions: ptrFloat64(0.75),
+					CrashFreeUsers:    ptrFloat64(0.5),
+				}, nil)
+
+				mockReleaseStatsRepo.EXPECT().GetByProjectAndRelease(
+					mock.Anything,
+					domain.ProjectID(1),
+					"1.1.0",
+				).Return(domain.ReleaseStats{
+					Release:           "1.1.0",
+					SessionsTotal:     100,
+					CrashFreeSessions: ptrFloat64(0.5),
+					CrashFreeUsers:    ptrFloat64(0.75),
+					Adoption:          ptrFloat64(0.25),
+				}, nil)
+			},
+			projectID:     domain.ProjectID(1),
+			baseVersion:   "1.0.0",
+			targetVersion: "1.1.0",
+			expectedComparison: domain.ReleaseComparison{
+				BaseRelease: domain.ReleaseStats{
+					Release:           "1.0.0",
+					SessionsTotal:     400,
+					CrashFreeSessions: ptrFloat64(0.75),
+					CrashFreeUsers:    ptrFloat64(0.5),
+				},
+				TargetRelease: domain.ReleaseStats{
+					Release:           "1.1.0",
+					SessionsTotal:     100,
+					CrashFreeSessions: ptrFloat64(0.5),
+					CrashFreeUsers:    ptrFloat64(0.75),
+					Adoption:          ptrFloat64(0.25),
+				},
+				Delta: map[string]uint{
+					"known_issues_total":         0,
+					"new_issues_total":           0,
+					"regressions_total":          0,
+					"resolved_in_version_total":  0,
+					"fixed_new_in_version_total": 0,
+					"fixed_old_in_version_total": 0,
+					"users_affected":             0,
+					"sessions_total":             300,
+				},
+				RateDelta: map[string]float64{
+					"crash_free_sessions": -0.25,
+					"crash_free_users":    0.25,
 				},
 			},
 			expectedError: false,
@@ -768,6 +842,7 @@ func TestGetUserSegments(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNamePlatform,
 				).Return(platform, nil)
 
@@ -775,6 +850,7 @@ func TestGetUserSegments(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameBrowserName,
 				).Return(browser, nil)
 
@@ -782,6 +858,7 @@ func TestGetUserSegments(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameOSName,
 				).Return(os, nil)
 
@@ -789,6 +866,7 @@ func TestGetUserSegments(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameDeviceArch,
 				).Return(deviceArch, nil)
 
@@ -796,6 +874,7 @@ func TestGetUserSegments(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNameRuntimeName,
 				).Return(runtimeName, nil)
 			},
@@ -838,6 +917,7 @@ func TestGetUserSegments(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentNamePlatform,
 				).Return(nil, errors.New("aggregation error"))
 			},
@@ -877,6 +957,7 @@ func TestGetUserSegments(t *testing.T) {
 				context.Background(),
 				tt.projectID,
 				tt.release,
+				"",
 			)
 
 			// Check result
@@ -906,6 +987,7 @@ func TestGetErrorsByTime(t *testing.T) {
 		period             time.Duration
 		granularity        time.Duration
 		levels             []domain.IssueLevel
+		environment        *string
 		groupBy            domain.EventTimeseriesGroup
 		expectedTimeseries []domain.Timeseries
 		expectedError      bool
@@ -937,7 +1019,8 @@ func TestGetErrorsByTime(t *testing.T) {
 							filter.Period.Interval == 24*time.Hour &&
 							filter.Period.Granularity == time.Hour &&
 							filter.GroupBy == domain.EventTimeseriesGroupLevel &&
-							filter.Release != nil && *filter.Release == "1.0.0"
+							filter.Release != nil && *filter.Release == "1.0.0" &&
+							filter.Environment != nil && *filter.Environment == "production"
 					}),
 				).Return(timeseries, nil)
 			},
@@ -946,6 +1029,7 @@ func TestGetErrorsByTime(t *testing.T) {
 			period:      24 * time.Hour,
 			granularity: time.Hour,
 			levels:      []domain.IssueLevel{domain.IssueLevelError, domain.IssueLevelWarning},
+			environment: ptrString("production"),
 			groupBy:     domain.EventTimeseriesGroupLevel,
 			expectedTimeseries: []domain.Timeseries{
 				{
@@ -978,7 +1062,7 @@ func TestGetErrorsByTime(t *testing.T) {
 					mock.Anything,
 					mock.MatchedBy(func(filter *domain.EventTimeseriesFilter) bool {
 						return filter.ProjectID != nil && *filter.ProjectID == domain.ProjectID(1) &&
-							filter.Release == nil
+							filter.Release == nil && filter.Environment == nil
 					}),
 				).Return(timeseries, nil)
 			},
@@ -1050,6 +1134,7 @@ func TestGetErrorsByTime(t *testing.T) {
 				tt.period,
 				tt.granularity,
 				tt.levels,
+				tt.environment,
 				tt.groupBy,
 			)
 
@@ -1111,3 +1196,11 @@ func TestDiffUint(t *testing.T) {
 		})
 	}
 }
+
+func ptrFloat64(val float64) *float64 {
+	return &val
+}
+
+func ptrString(val string) *string {
+	return &val
+}
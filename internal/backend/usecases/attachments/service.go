@@ -0,0 +1,60 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/blobstore"
+)
+
+type Service struct {
+	attachmentsRepo contract.AttachmentsRepository
+	blobStore       contract.BlobStore
+}
+
+func New(attachmentsRepo contract.AttachmentsRepository, blobStore contract.BlobStore) *Service {
+	return &Service{
+		attachmentsRepo: attachmentsRepo,
+		blobStore:       blobStore,
+	}
+}
+
+func (s *Service) ListByEvent(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	eventID domain.EventID,
+) ([]domain.Attachment, error) {
+	attachments, err := s.attachmentsRepo.ListByEventIDs(ctx, projectID, []domain.EventID{eventID})
+	if err != nil {
+		return nil, fmt.Errorf("list event attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// Open returns the attachment with its content, the caller must close the reader.
+func (s *Service) Open(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.AttachmentID,
+) (domain.Attachment, io.ReadCloser, error) {
+	attachment, err := s.attachmentsRepo.GetByID(ctx, projectID, id)
+	if err != nil {
+		return domain.Attachment{}, nil, fmt.Errorf("get attachment: %w", err)
+	}
+
+	content, err := s.blobStore.Get(ctx, attachment.BlobKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return domain.Attachment{}, nil, domain.ErrEntityNotFound
+		}
+
+		return domain.Attachment{}, nil, fmt.Errorf("get attachment blob: %w", err)
+	}
+
+	return attachment, content, nil
+}
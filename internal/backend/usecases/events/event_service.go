@@ -2,24 +2,41 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rom8726/warden/internal/backend/contract"
+	eventcommon "github.com/rom8726/warden/internal/common/event"
+	"github.com/rom8726/warden/internal/common/searchquery"
 	"github.com/rom8726/warden/internal/domain"
 )
 
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 100
+
+	defaultTagValuesLimit = 10
+	maxTagValuesLimit     = 100
+	// maxIssueTags limits the number of the tags of an issue, the tags of the fewest events are left out
+	maxIssueTags = 100
+)
+
 type EventService struct {
-	issueRepo contract.IssuesRepository
-	eventRepo contract.EventRepository
+	issueRepo             contract.IssuesRepository
+	eventRepo             contract.EventRepository
+	issueFingerprintsRepo contract.IssueFingerprintsRepository
 }
 
 func New(
 	issueRepo contract.IssuesRepository,
 	eventRepo contract.EventRepository,
+	issueFingerprintsRepo contract.IssueFingerprintsRepository,
 ) *EventService {
 	return &EventService{
-		issueRepo: issueRepo,
-		eventRepo: eventRepo,
+		issueRepo:             issueRepo,
+		eventRepo:             eventRepo,
+		issueFingerprintsRepo: issueFingerprintsRepo,
 	}
 }
 
@@ -39,5 +56,247 @@ func (s *EventService) IssueTimeseries(
 		return nil, fmt.Errorf("get issue: %w", err)
 	}
 
-	return s.eventRepo.IssueTimeseries(ctx, issue.Fingerprint, filter)
+	merged, err := s.issueFingerprintsRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list merged fingerprints: %w", err)
+	}
+
+	return s.eventRepo.IssueTimeseries(ctx, append([]string{issue.Fingerprint}, merged...), filter)
+}
+
+// Search returns a page of the project events matching the search query,
+// the last DefaultSearchPeriod is searched when no time range is given.
+func (s *EventService) Search(
+	ctx context.Context,
+	filter *domain.EventSearchFilter,
+) (domain.EventSearchPage, error) {
+	searchFilter := *filter
+	if searchFilter.TimeTo.IsZero() {
+		searchFilter.TimeTo = time.Now()
+	}
+	if searchFilter.TimeFrom.IsZero() {
+		searchFilter.TimeFrom = searchFilter.TimeTo.Add(-domain.DefaultSearchPeriod)
+	}
+
+	switch {
+	case searchFilter.Limit == 0:
+		searchFilter.Limit = defaultSearchLimit
+	case searchFilter.Limit > maxSearchLimit:
+		searchFilter.Limit = maxSearchLimit
+	}
+
+	page, err := s.eventRepo.Search(ctx, &searchFilter)
+	if err != nil {
+		return domain.EventSearchPage{}, fmt.Errorf("search events: %w", err)
+	}
+
+	return page, nil
+}
+
+// IssueTags returns the tags of the issue events with their most frequent values.
+func (s *EventService) IssueTags(ctx context.Context, filter *domain.IssueTagsFilter) ([]domain.IssueTag, error) {
+	fingerprints, err := s.issueFingerprints(ctx, filter.ProjectID, filter.IssueID)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesLimit := filter.ValuesLimit
+	switch {
+	case valuesLimit == 0:
+		valuesLimit = defaultTagValuesLimit
+	case valuesLimit > maxTagValuesLimit:
+		valuesLimit = maxTagValuesLimit
+	}
+
+	tags, err := s.eventRepo.IssueTags(ctx, filter.ProjectID, fingerprints, maxIssueTags, valuesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get issue tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// IssueTagEvents returns a page of the issue events having the tag value, newest first.
+func (s *EventService) IssueTagEvents(
+	ctx context.Context,
+	filter *domain.IssueTagEventsFilter,
+) (domain.EventSearchPage, error) {
+	fingerprints, err := s.issueFingerprints(ctx, filter.ProjectID, filter.IssueID)
+	if err != nil {
+		return domain.EventSearchPage{}, err
+	}
+
+	// All the stored events of the issue are searched
+	return s.Search(ctx, &domain.EventSearchFilter{
+		ProjectID: filter.ProjectID,
+		Query: domain.SearchQuery{
+			{Key: searchquery.TagsKey, Tag: filter.Key, Values: []string{filter.Value}},
+		},
+		GroupHashes: fingerprints,
+		TimeFrom:    time.Unix(0, 0),
+		Cursor:      filter.Cursor,
+		Limit:       filter.Limit,
+	})
+}
+
+// GetEvent returns the project event with its decoded payload and its neighbours in the issue.
+func (s *EventService) GetEvent(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	eventID domain.EventID,
+) (domain.EventDetails, error) {
+	event, err := s.eventRepo.GetByID(ctx, projectID, eventID)
+	if err != nil {
+		return domain.EventDetails{}, fmt.Errorf("get event: %w", err)
+	}
+
+	issue, err := s.eventIssue(ctx, &event)
+	switch {
+	case errors.Is(err, domain.ErrEntityNotFound):
+		// The issue is deleted, the event is still shown without the navigation
+		return makeEventDetails(event)
+	case err != nil:
+		return domain.EventDetails{}, err
+	}
+
+	fingerprints, err := s.withMergedFingerprints(ctx, issue)
+	if err != nil {
+		return domain.EventDetails{}, err
+	}
+
+	return s.issueEventDetails(ctx, event, issue.ID, fingerprints)
+}
+
+// IssueEvents returns a page of all stored events of the issue, newest first.
+func (s *EventService) IssueEvents(
+	ctx context.Context,
+	filter *domain.IssueEventsFilter,
+) (domain.EventSearchPage, error) {
+	fingerprints, err := s.issueFingerprints(ctx, filter.ProjectID, filter.IssueID)
+	if err != nil {
+		return domain.EventSearchPage{}, err
+	}
+
+	return s.Search(ctx, &domain.EventSearchFilter{
+		ProjectID:   filter.ProjectID,
+		GroupHashes: fingerprints,
+		TimeFrom:    time.Unix(0, 0),
+		Cursor:      filter.Cursor,
+		Limit:       filter.Limit,
+	})
+}
+
+func (s *EventService) IssueEdgeEvent(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	issueID domain.IssueID,
+	oldest bool,
+) (domain.EventDetails, error) {
+	fingerprints, err := s.issueFingerprints(ctx, projectID, issueID)
+	if err != nil {
+		return domain.EventDetails{}, err
+	}
+
+	eventID, err := s.eventRepo.IssueEdgeEventID(ctx, projectID, fingerprints, oldest)
+	if err != nil {
+		return domain.EventDetails{}, fmt.Errorf("get issue edge event: %w", err)
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, projectID, eventID)
+	if err != nil {
+		return domain.EventDetails{}, fmt.Errorf("get event: %w", err)
+	}
+
+	return s.issueEventDetails(ctx, event, issueID, fingerprints)
+}
+
+func (s *EventService) issueEventDetails(
+	ctx context.Context,
+	event domain.Event,
+	issueID domain.IssueID,
+	fingerprints []string,
+) (domain.EventDetails, error) {
+	details, err := makeEventDetails(event)
+	if err != nil {
+		return domain.EventDetails{}, err
+	}
+
+	details.IssueID = issueID
+	details.PreviousEventID, details.NextEventID, err = s.eventRepo.IssueEventNeighbours(
+		ctx,
+		event.ProjectID,
+		fingerprints,
+		domain.EventCursor{Timestamp: event.Timestamp, EventID: event.ID},
+	)
+	if err != nil {
+		return domain.EventDetails{}, fmt.Errorf("get event neighbours: %w", err)
+	}
+
+	return details, nil
+}
+
+// eventIssue returns the issue the event is grouped into, following merged fingerprints.
+func (s *EventService) eventIssue(ctx context.Context, event *domain.Event) (domain.Issue, error) {
+	fingerprint := event.GroupHash
+	issueFingerprint, err := s.issueFingerprintsRepo.GetIssueFingerprint(ctx, event.ProjectID, event.GroupHash)
+	switch {
+	case err == nil:
+		fingerprint = issueFingerprint
+	case !errors.Is(err, domain.ErrEntityNotFound):
+		return domain.Issue{}, fmt.Errorf("get issue fingerprint: %w", err)
+	}
+
+	issues, err := s.issueRepo.ListByFingerprints(ctx, []string{fingerprint})
+	if err != nil {
+		return domain.Issue{}, fmt.Errorf("list issues by fingerprint: %w", err)
+	}
+
+	for _, issue := range issues {
+		if issue.ProjectID == event.ProjectID {
+			return issue, nil
+		}
+	}
+
+	return domain.Issue{}, domain.ErrEntityNotFound
+}
+
+func makeEventDetails(event domain.Event) (domain.EventDetails, error) {
+	data, err := eventcommon.ParseEventData(event.Payload)
+	if err != nil {
+		return domain.EventDetails{}, fmt.Errorf("parse event data: %w", err)
+	}
+
+	// Events stored without the payload only keep the first exception
+	if len(data.Exceptions) == 0 {
+		data.Exceptions = event.ExceptionChain()
+	}
+
+	return domain.EventDetails{Event: event, Data: data}, nil
+}
+
+// issueFingerprints returns the fingerprints of the issue of the project, including the merged ones.
+func (s *EventService) issueFingerprints(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	issueID domain.IssueID,
+) ([]string, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("get issue: %w", err)
+	}
+
+	if issue.ProjectID != projectID {
+		return nil, fmt.Errorf("get issue: %w", domain.ErrEntityNotFound)
+	}
+
+	return s.withMergedFingerprints(ctx, issue)
+}
+
+func (s *EventService) withMergedFingerprints(ctx context.Context, issue domain.Issue) ([]string, error) {
+	merged, err := s.issueFingerprintsRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list merged fingerprints: %w", err)
+	}
+
+	return append([]string{issue.Fingerprint}, merged...), nil
 }
@@ -19,11 +19,13 @@ func TestNew(t *testing.T) {
 	// Create mocks
 	mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
 	mockEventRepo := mockcontract.NewMockEventRepository(t)
+	mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
 
 	// Create service
 	service := New(
 		mockIssueRepo,
 		mockEventRepo,
+		mockIssueFingerprintsRepo,
 	)
 	// Verify service was created correctly
 	require.NotNil(t, service)
@@ -122,6 +124,7 @@ func TestTimeseries(t *testing.T) {
 			service := New(
 				mockIssueRepo,
 				mockEventRepo,
+				mockcontract.NewMockIssueFingerprintsRepository(t),
 			)
 
 			// Call the method
@@ -151,9 +154,13 @@ func TestIssueTimeseries(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name          string
-		filter        *domain.IssueEventsTimeseriesFilter
-		setupMocks    func(mockIssueRepo *mockcontract.MockIssuesRepository, mockEventRepo *mockcontract.MockEventRepository)
+		name       string
+		filter     *domain.IssueEventsTimeseriesFilter
+		setupMocks func(
+			mockIssueRepo *mockcontract.MockIssuesRepository,
+			mockEventRepo *mockcontract.MockEventRepository,
+			mockIssueFingerprintsRepo *mockcontract.MockIssueFingerprintsRepository,
+		)
 		expected      []domain.Timeseries
 		expectedError bool
 		errorContains string
@@ -170,14 +177,26 @@ func TestIssueTimeseries(t *testing.T) {
 				Levels:    []domain.IssueLevel{domain.IssueLevelError},
 				GroupBy:   domain.EventTimeseriesGroupNone,
 			},
-			setupMocks: func(mockIssueRepo *mockcontract.MockIssuesRepository, mockEventRepo *mockcontract.MockEventRepository) {
+			setupMocks: func(
+				mockIssueRepo *mockcontract.MockIssuesRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				mockIssueFingerprintsRepo *mockcontract.MockIssueFingerprintsRepository,
+			) {
 				mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(123)).
 					Return(domain.Issue{
 						ID:          123,
 						Fingerprint: "test-fingerprint",
 					}, nil)
 
-				mockEventRepo.EXPECT().IssueTimeseries(mock.Anything, "test-fingerprint", mock.AnythingOfType("*domain.IssueEventsTimeseriesFilter")).
+				mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(123)).
+					Return([]string{"merged-fingerprint"}, nil)
+
+				mockEventRepo.EXPECT().
+					IssueTimeseries(
+						mock.Anything,
+						[]string{"test-fingerprint", "merged-fingerprint"},
+						mock.AnythingOfType("*domain.IssueEventsTimeseriesFilter"),
+					).
 					Return([]domain.Timeseries{
 						{
 							Name: "test",
@@ -213,7 +232,11 @@ func TestIssueTimeseries(t *testing.T) {
 				Levels:    []domain.IssueLevel{domain.IssueLevelError},
 				GroupBy:   domain.EventTimeseriesGroupNone,
 			},
-			setupMocks: func(mockIssueRepo *mockcontract.MockIssuesRepository, mockEventRepo *mockcontract.MockEventRepository) {
+			setupMocks: func(
+				mockIssueRepo *mockcontract.MockIssuesRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				mockIssueFingerprintsRepo *mockcontract.MockIssueFingerprintsRepository,
+			) {
 				mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(123)).
 					Return(domain.Issue{}, errors.New("issue not found"))
 			},
@@ -233,14 +256,25 @@ func TestIssueTimeseries(t *testing.T) {
 				Levels:    []domain.IssueLevel{domain.IssueLevelError},
 				GroupBy:   domain.EventTimeseriesGroupNone,
 			},
-			setupMocks: func(mockIssueRepo *mockcontract.MockIssuesRepository, mockEventRepo *mockcontract.MockEventRepository) {
+			setupMocks: func(
+				mockIssueRepo *mockcontract.MockIssuesRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				mockIssueFingerprintsRepo *mockcontract.MockIssueFingerprintsRepository,
+			) {
 				mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(123)).
 					Return(domain.Issue{
 						ID:          123,
 						Fingerprint: "test-fingerprint",
 					}, nil)
 
-				mockEventRepo.EXPECT().IssueTimeseries(mock.Anything, "test-fingerprint", mock.AnythingOfType("*domain.IssueEventsTimeseriesFilter")).
+				mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(123)).Return(nil, nil)
+
+				mockEventRepo.EXPECT().
+					IssueTimeseries(
+						mock.Anything,
+						[]string{"test-fingerprint"},
+						mock.AnythingOfType("*domain.IssueEventsTimeseriesFilter"),
+					).
 					Return(nil, errors.New("database error"))
 			},
 			expected:      nil,
@@ -256,14 +290,16 @@ func TestIssueTimeseries(t *testing.T) {
 			// Create mocks
 			mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
 			mockEventRepo := mockcontract.NewMockEventRepository(t)
+			mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
 
 			// Setup mocks
-			tt.setupMocks(mockIssueRepo, mockEventRepo)
+			tt.setupMocks(mockIssueRepo, mockEventRepo, mockIssueFingerprintsRepo)
 
 			// Create service
 			service := New(
 				mockIssueRepo,
 				mockEventRepo,
+				mockIssueFingerprintsRepo,
 			)
 
 			// Call the method
@@ -288,3 +324,254 @@ func TestIssueTimeseries(t *testing.T) {
 		})
 	}
 }
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		mockEventRepo := mockcontract.NewMockEventRepository(t)
+		service := New(
+			mockcontract.NewMockIssuesRepository(t),
+			mockEventRepo,
+			mockcontract.NewMockIssueFingerprintsRepository(t),
+		)
+
+		page := domain.EventSearchPage{Events: []domain.Event{{ID: "ev1"}}}
+		mockEventRepo.EXPECT().Search(mock.Anything, mock.MatchedBy(func(filter *domain.EventSearchFilter) bool {
+			return filter.ProjectID == 1 &&
+				filter.Limit == defaultSearchLimit &&
+				filter.TimeTo.Sub(filter.TimeFrom) == domain.DefaultSearchPeriod
+		})).Return(page, nil)
+
+		result, err := service.Search(context.Background(), &domain.EventSearchFilter{ProjectID: 1})
+		require.NoError(t, err)
+		require.Equal(t, page, result)
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		t.Parallel()
+
+		mockEventRepo := mockcontract.NewMockEventRepository(t)
+		service := New(
+			mockcontract.NewMockIssuesRepository(t),
+			mockEventRepo,
+			mockcontract.NewMockIssueFingerprintsRepository(t),
+		)
+
+		timeFrom := time.Now().Add(-time.Hour)
+		timeTo := time.Now()
+		mockEventRepo.EXPECT().Search(mock.Anything, &domain.EventSearchFilter{
+			ProjectID: 1,
+			TimeFrom:  timeFrom,
+			TimeTo:    timeTo,
+			Limit:     maxSearchLimit,
+		}).Return(domain.EventSearchPage{}, errors.New("clickhouse is down"))
+
+		_, err := service.Search(context.Background(), &domain.EventSearchFilter{
+			ProjectID: 1,
+			TimeFrom:  timeFrom,
+			TimeTo:    timeTo,
+			Limit:     1000,
+		})
+		require.ErrorContains(t, err, "clickhouse is down")
+	})
+}
+
+func TestIssueTags(t *testing.T) {
+	t.Parallel()
+
+	t.Run("values limit is capped", func(t *testing.T) {
+		t.Parallel()
+
+		mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+		mockEventRepo := mockcontract.NewMockEventRepository(t)
+		mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+		service := New(mockIssueRepo, mockEventRepo, mockIssueFingerprintsRepo)
+
+		tags := []domain.IssueTag{{Key: "browser", TotalEvents: 3, UniqueValues: 1}}
+		mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(7)).
+			Return(domain.Issue{ID: 7, ProjectID: 1, Fingerprint: "fp1"}, nil)
+		mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(7)).Return([]string{"fp2"}, nil)
+		mockEventRepo.EXPECT().
+			IssueTags(mock.Anything, domain.ProjectID(1), []string{"fp1", "fp2"}, uint(maxIssueTags), uint(maxTagValuesLimit)).
+			Return(tags, nil)
+
+		result, err := service.IssueTags(context.Background(), &domain.IssueTagsFilter{
+			ProjectID:   1,
+			IssueID:     7,
+			ValuesLimit: 1000,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tags, result)
+	})
+
+	t.Run("issue of another project", func(t *testing.T) {
+		t.Parallel()
+
+		mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+		service := New(
+			mockIssueRepo,
+			mockcontract.NewMockEventRepository(t),
+			mockcontract.NewMockIssueFingerprintsRepository(t),
+		)
+
+		mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(7)).
+			Return(domain.Issue{ID: 7, ProjectID: 2, Fingerprint: "fp1"}, nil)
+
+		_, err := service.IssueTags(context.Background(), &domain.IssueTagsFilter{ProjectID: 1, IssueID: 7})
+		require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	})
+}
+
+func TestIssueTagEvents(t *testing.T) {
+	t.Parallel()
+
+	mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+	mockEventRepo := mockcontract.NewMockEventRepository(t)
+	mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+	service := New(mockIssueRepo, mockEventRepo, mockIssueFingerprintsRepo)
+
+	page := domain.EventSearchPage{Events: []domain.Event{{ID: "ev1"}}}
+	mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(7)).
+		Return(domain.Issue{ID: 7, ProjectID: 1, Fingerprint: "fp1"}, nil)
+	mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(7)).Return(nil, nil)
+	mockEventRepo.EXPECT().Search(mock.Anything, mock.MatchedBy(func(filter *domain.EventSearchFilter) bool {
+		return filter.ProjectID == 1 &&
+			len(filter.GroupHashes) == 1 && filter.GroupHashes[0] == "fp1" &&
+			len(filter.Query) == 1 && filter.Query[0].Tag == "browser" && filter.Query[0].Values[0] == "Chrome" &&
+			filter.TimeFrom.Equal(time.Unix(0, 0)) &&
+			filter.Limit == defaultSearchLimit
+	})).Return(page, nil)
+
+	result, err := service.IssueTagEvents(context.Background(), &domain.IssueTagEventsFilter{
+		ProjectID: 1,
+		IssueID:   7,
+		Key:       "browser",
+		Value:     "Chrome",
+	})
+	require.NoError(t, err)
+	require.Equal(t, page, result)
+}
+
+func TestGetEvent(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Unix(1700000000, 0).UTC()
+	event := domain.Event{
+		ID:        "ev2",
+		ProjectID: 1,
+		GroupHash: "fp-merged",
+		Timestamp: timestamp,
+		Payload:   []byte(`{"breadcrumbs": [{"message": "started"}], "sdk": {"name": "sentry.go", "version": "0.30.0"}}`),
+	}
+
+	t.Run("with issue navigation", func(t *testing.T) {
+		t.Parallel()
+
+		mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+		mockEventRepo := mockcontract.NewMockEventRepository(t)
+		mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+		service := New(mockIssueRepo, mockEventRepo, mockIssueFingerprintsRepo)
+
+		mockEventRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.EventID("ev2")).Return(event, nil)
+		mockIssueFingerprintsRepo.EXPECT().GetIssueFingerprint(mock.Anything, domain.ProjectID(1), "fp-merged").
+			Return("fp1", nil)
+		mockIssueRepo.EXPECT().ListByFingerprints(mock.Anything, []string{"fp1"}).Return([]domain.Issue{
+			{ID: 3, ProjectID: 2, Fingerprint: "fp1"},
+			{ID: 7, ProjectID: 1, Fingerprint: "fp1"},
+		}, nil)
+		mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(7)).Return([]string{"fp-merged"}, nil)
+		mockEventRepo.EXPECT().IssueEventNeighbours(
+			mock.Anything,
+			domain.ProjectID(1),
+			[]string{"fp1", "fp-merged"},
+			domain.EventCursor{Timestamp: timestamp, EventID: "ev2"},
+		).Return("ev1", "ev3", nil)
+
+		details, err := service.GetEvent(context.Background(), 1, "ev2")
+		require.NoError(t, err)
+		require.Equal(t, domain.IssueID(7), details.IssueID)
+		require.Equal(t, domain.EventID("ev1"), details.PreviousEventID)
+		require.Equal(t, domain.EventID("ev3"), details.NextEventID)
+		require.Len(t, details.Data.Breadcrumbs, 1)
+		require.Equal(t, "sentry.go", details.Data.SDK.Name)
+	})
+
+	t.Run("issue is deleted", func(t *testing.T) {
+		t.Parallel()
+
+		mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+		mockEventRepo := mockcontract.NewMockEventRepository(t)
+		mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+		service := New(mockIssueRepo, mockEventRepo, mockIssueFingerprintsRepo)
+
+		exceptionType := "ValueError"
+		stored := domain.Event{
+			ID:            "ev2",
+			ProjectID:     1,
+			GroupHash:     "fp1",
+			ExceptionData: domain.ExceptionData{ExceptionType: &exceptionType},
+		}
+		mockEventRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.EventID("ev2")).Return(stored, nil)
+		mockIssueFingerprintsRepo.EXPECT().GetIssueFingerprint(mock.Anything, domain.ProjectID(1), "fp1").
+			Return("", domain.ErrEntityNotFound)
+		mockIssueRepo.EXPECT().ListByFingerprints(mock.Anything, []string{"fp1"}).Return(nil, nil)
+
+		details, err := service.GetEvent(context.Background(), 1, "ev2")
+		require.NoError(t, err)
+		require.Zero(t, details.IssueID)
+		require.Empty(t, details.NextEventID)
+		// Events stored without the payload fall back to the indexed exception
+		require.Equal(t, []domain.ExceptionInfo{{Type: "ValueError"}}, details.Data.Exceptions)
+	})
+
+	t.Run("event not found", func(t *testing.T) {
+		t.Parallel()
+
+		mockEventRepo := mockcontract.NewMockEventRepository(t)
+		service := New(
+			mockcontract.NewMockIssuesRepository(t),
+			mockEventRepo,
+			mockcontract.NewMockIssueFingerprintsRepository(t),
+		)
+
+		mockEventRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.EventID("ev2")).
+			Return(domain.Event{}, domain.ErrEntityNotFound)
+
+		_, err := service.GetEvent(context.Background(), 1, "ev2")
+		require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	})
+}
+
+func TestIssueEdgeEvent(t *testing.T) {
+	t.Parallel()
+
+	mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+	mockEventRepo := mockcontract.NewMockEventRepository(t)
+	mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+	service := New(mockIssueRepo, mockEventRepo, mockIssueFingerprintsRepo)
+
+	timestamp := time.Unix(1700000000, 0).UTC()
+	mockIssueRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(7)).
+		Return(domain.Issue{ID: 7, ProjectID: 1, Fingerprint: "fp1"}, nil)
+	mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(7)).Return(nil, nil)
+	mockEventRepo.EXPECT().IssueEdgeEventID(mock.Anything, domain.ProjectID(1), []string{"fp1"}, true).
+		Return(domain.EventID("ev1"), nil)
+	mockEventRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.EventID("ev1")).
+		Return(domain.Event{ID: "ev1", ProjectID: 1, GroupHash: "fp1", Timestamp: timestamp}, nil)
+	mockEventRepo.EXPECT().IssueEventNeighbours(
+		mock.Anything,
+		domain.ProjectID(1),
+		[]string{"fp1"},
+		domain.EventCursor{Timestamp: timestamp, EventID: "ev1"},
+	).Return("", "ev2", nil)
+
+	details, err := service.IssueEdgeEvent(context.Background(), 1, 7, true)
+	require.NoError(t, err)
+	require.Equal(t, domain.EventID("ev1"), details.Event.ID)
+	require.Equal(t, domain.IssueID(7), details.IssueID)
+	require.Empty(t, details.PreviousEventID)
+	require.Equal(t, domain.EventID("ev2"), details.NextEventID)
+}
@@ -0,0 +1,275 @@
+package groupingrules
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/common/groupingrules"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const (
+	previewPeriod      = 24 * time.Hour
+	previewEventsLimit = 1000
+)
+
+type Service struct {
+	rulesRepo   contract.GroupingRulesRepository
+	projectRepo contract.ProjectsRepository
+	eventRepo   contract.EventRepository
+	issueRepo   contract.IssuesRepository
+}
+
+func New(
+	rulesRepo contract.GroupingRulesRepository,
+	projectRepo contract.ProjectsRepository,
+	eventRepo contract.EventRepository,
+	issueRepo contract.IssuesRepository,
+) *Service {
+	return &Service{
+		rulesRepo:   rulesRepo,
+		projectRepo: projectRepo,
+		eventRepo:   eventRepo,
+		issueRepo:   issueRepo,
+	}
+}
+
+func (s *Service) List(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error) {
+	rules, err := s.rulesRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list grouping rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (s *Service) Create(ctx context.Context, ruleDTO domain.GroupingRuleDTO) (domain.GroupingRule, error) {
+	if err := groupingrules.Validate(ruleDTO.Type, ruleDTO.Expression); err != nil {
+		return domain.GroupingRule{}, err
+	}
+
+	if err := s.checkProjectStrategy(ctx, ruleDTO.ProjectID, ruleDTO.Type); err != nil {
+		return domain.GroupingRule{}, err
+	}
+
+	rule, err := s.rulesRepo.Create(ctx, ruleDTO)
+	if err != nil {
+		return domain.GroupingRule{}, fmt.Errorf("create grouping rule: %w", err)
+	}
+
+	slog.Info("grouping rule created", "project_id", rule.ProjectID, "rule_id", rule.ID, "type", rule.Type)
+
+	return rule, nil
+}
+
+func (s *Service) Update(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.GroupingRuleID,
+	ruleType domain.GroupingRuleType,
+	expression string,
+) (domain.GroupingRule, error) {
+	if err := groupingrules.Validate(ruleType, expression); err != nil {
+		return domain.GroupingRule{}, err
+	}
+
+	if err := s.checkProjectStrategy(ctx, projectID, ruleType); err != nil {
+		return domain.GroupingRule{}, err
+	}
+
+	rule, err := s.rulesRepo.Update(ctx, projectID, id, ruleType, expression)
+	if err != nil {
+		return domain.GroupingRule{}, fmt.Errorf("update grouping rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (s *Service) Delete(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) error {
+	if err := s.rulesRepo.Delete(ctx, projectID, id); err != nil {
+		return fmt.Errorf("delete grouping rule: %w", err)
+	}
+
+	return nil
+}
+
+// checkProjectStrategy rejects stack trace rules of projects grouping events with a strategy
+// that ignores them.
+func (s *Service) checkProjectStrategy(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	ruleType domain.GroupingRuleType,
+) error {
+	if ruleType != domain.GroupingRuleTypeStacktrace {
+		return nil
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+
+	strategy := project.GroupingStrategy
+	if !strategy.IsValid() {
+		strategy = domain.DefaultGroupingStrategy
+	}
+
+	return checkStrategy(strategy, ruleType)
+}
+
+// Preview evaluates a rule against the recent project events without saving it.
+// The rule is applied after the existing project rules, as it would be once created.
+func (s *Service) Preview(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	ruleType domain.GroupingRuleType,
+	expression string,
+) (domain.GroupingRulePreview, error) {
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return domain.GroupingRulePreview{}, fmt.Errorf("get project: %w", err)
+	}
+
+	rules, err := s.rulesRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return domain.GroupingRulePreview{}, fmt.Errorf("list grouping rules: %w", err)
+	}
+
+	strategy := project.GroupingStrategy
+	if !strategy.IsValid() {
+		strategy = domain.DefaultGroupingStrategy
+	}
+
+	if err := checkStrategy(strategy, ruleType); err != nil {
+		return domain.GroupingRulePreview{}, err
+	}
+
+	cfg, err := groupingrules.Compile(strategy, rules)
+	if err != nil {
+		slog.Warn("project has invalid grouping rules", "project_id", projectID, "error", err)
+	}
+
+	// Events assigned a fingerprint by an existing rule are not decided by the new one,
+	// which is applied after the existing rules.
+	existingRules := cfg.FingerprintRules
+
+	var matcher interface{ Match(ev *domain.Event) bool }
+	switch ruleType {
+	case domain.GroupingRuleTypeFingerprint:
+		rule, err := groupingrules.ParseFingerprintRule(expression)
+		if err != nil {
+			return domain.GroupingRulePreview{}, err
+		}
+
+		cfg.FingerprintRules = append(cfg.FingerprintRules, rule)
+		matcher = rule
+	case domain.GroupingRuleTypeStacktrace:
+		rule, err := groupingrules.ParseStacktraceRule(expression)
+		if err != nil {
+			return domain.GroupingRulePreview{}, err
+		}
+
+		cfg.FrameRules = append(cfg.FrameRules, rule)
+		matcher = rule
+	default:
+		return domain.GroupingRulePreview{}, fmt.Errorf("%w: unknown rule type %q", domain.ErrInvalidGroupingRule, ruleType)
+	}
+
+	events, err := s.eventRepo.FetchRecent(ctx, projectID, time.Now().Add(-previewPeriod), previewEventsLimit)
+	if err != nil {
+		return domain.GroupingRulePreview{}, fmt.Errorf("fetch recent events: %w", err)
+	}
+
+	preview := domain.GroupingRulePreview{ScannedEvents: uint(len(events))}
+	byFingerprint := make(map[string]*domain.GroupingRulePreviewIssue)
+	newFingerprints := make(map[string]map[string]struct{})
+
+	for i := range events {
+		ev := &events[i]
+		if !matcher.Match(ev) || hasFingerprintRule(existingRules, ev) {
+			continue
+		}
+
+		preview.MatchedEvents++
+
+		cfg.Apply(ev)
+		newFingerprint := ev.GroupingFingerprint()
+
+		item, ok := byFingerprint[ev.GroupHash]
+		if !ok {
+			item = &domain.GroupingRulePreviewIssue{Fingerprint: ev.GroupHash}
+			byFingerprint[ev.GroupHash] = item
+			newFingerprints[ev.GroupHash] = make(map[string]struct{})
+		}
+
+		item.MatchedEvents++
+		if _, seen := newFingerprints[ev.GroupHash][newFingerprint]; !seen {
+			newFingerprints[ev.GroupHash][newFingerprint] = struct{}{}
+			item.NewFingerprints = append(item.NewFingerprints, newFingerprint)
+		}
+	}
+
+	if len(byFingerprint) == 0 {
+		return preview, nil
+	}
+
+	fingerprints := make([]string, 0, len(byFingerprint))
+	for fingerprint := range byFingerprint {
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	issues, err := s.issueRepo.ListByFingerprints(ctx, fingerprints)
+	if err != nil {
+		return domain.GroupingRulePreview{}, fmt.Errorf("list issues by fingerprints: %w", err)
+	}
+
+	for _, issue := range issues {
+		item, ok := byFingerprint[issue.Fingerprint]
+		if !ok || issue.ProjectID != projectID {
+			continue
+		}
+
+		issueID := issue.ID
+		item.IssueID = &issueID
+		item.Title = issue.Title
+	}
+
+	preview.Issues = make([]domain.GroupingRulePreviewIssue, 0, len(byFingerprint))
+	for _, item := range byFingerprint {
+		preview.Issues = append(preview.Issues, *item)
+	}
+
+	sort.Slice(preview.Issues, func(i, j int) bool {
+		if preview.Issues[i].MatchedEvents != preview.Issues[j].MatchedEvents {
+			return preview.Issues[i].MatchedEvents > preview.Issues[j].MatchedEvents
+		}
+
+		return preview.Issues[i].Fingerprint < preview.Issues[j].Fingerprint
+	})
+
+	return preview, nil
+}
+
+func checkStrategy(strategy domain.GroupingStrategy, ruleType domain.GroupingRuleType) error {
+	if ruleType == domain.GroupingRuleTypeStacktrace && !strategy.SupportsFrameRules() {
+		return fmt.Errorf("%w: stack trace rules require the %q grouping strategy, the project uses %q",
+			domain.ErrInvalidGroupingRule, domain.GroupingStrategyStacktraceV1, strategy)
+	}
+
+	return nil
+}
+
+// hasFingerprintRule reports whether one of the fingerprint rules matches the event.
+func hasFingerprintRule(rules []domain.FingerprintRule, ev *domain.Event) bool {
+	for _, rule := range rules {
+		if _, ok := rule.Fingerprint(ev); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,415 @@
+package groupingrules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func exceptionEvent(groupHash, exType string) domain.Event {
+	return domain.Event{
+		ProjectID: 1,
+		GroupHash: groupHash,
+		Level:     domain.IssueLevelException,
+		Source:    domain.SourceException,
+		ExceptionData: domain.ExceptionData{
+			ExceptionType:       &exType,
+			ExceptionStacktrace: json.RawMessage(`{"frames":[{"module":"app/vendor/lib","function":"Do"}]}`),
+		},
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		ruleDTO    domain.GroupingRuleDTO
+		setupMocks func(
+			mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+		)
+		expectedRuleID domain.GroupingRuleID
+		expectedError  error
+		errorContains  string
+	}{
+		{
+			name: "Success",
+			ruleDTO: domain.GroupingRuleDTO{
+				ProjectID:  1,
+				Type:       domain.GroupingRuleTypeStacktrace,
+				Expression: "stack.module:vendor/** -app",
+			},
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				ruleDTO := domain.GroupingRuleDTO{
+					ProjectID:  1,
+					Type:       domain.GroupingRuleTypeStacktrace,
+					Expression: "stack.module:vendor/** -app",
+				}
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{
+					ID:               1,
+					GroupingStrategy: domain.GroupingStrategyStacktraceV1,
+				}, nil)
+				mockRulesRepo.EXPECT().Create(mock.Anything, ruleDTO).Return(domain.GroupingRule{
+					ID:         7,
+					ProjectID:  ruleDTO.ProjectID,
+					Type:       ruleDTO.Type,
+					Expression: ruleDTO.Expression,
+				}, nil)
+			},
+			expectedRuleID: 7,
+		},
+		{
+			name: "Invalid expression",
+			ruleDTO: domain.GroupingRuleDTO{
+				ProjectID:  1,
+				Type:       domain.GroupingRuleTypeFingerprint,
+				Expression: "error.type:DatabaseError",
+			},
+			setupMocks: func(
+				*mockcontract.MockGroupingRulesRepository,
+				*mockcontract.MockProjectsRepository,
+			) {
+			},
+			expectedError: domain.ErrInvalidGroupingRule,
+		},
+		{
+			name: "Stack trace rule of legacy project",
+			ruleDTO: domain.GroupingRuleDTO{
+				ProjectID:  1,
+				Type:       domain.GroupingRuleTypeStacktrace,
+				Expression: "stack.module:vendor/** -app",
+			},
+			setupMocks: func(
+				_ *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{
+					ID:               1,
+					GroupingStrategy: domain.GroupingStrategyLegacy,
+				}, nil)
+			},
+			expectedError: domain.ErrInvalidGroupingRule,
+			errorContains: "stacktrace:v1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			tt.setupMocks(mockRulesRepo, mockProjectRepo)
+
+			service := New(
+				mockRulesRepo,
+				mockProjectRepo,
+				mockcontract.NewMockEventRepository(t),
+				mockcontract.NewMockIssuesRepository(t),
+			)
+
+			rule, err := service.Create(context.Background(), tt.ruleDTO)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				if tt.errorContains != "" {
+					require.ErrorContains(t, err, tt.errorContains)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRuleID, rule.ID)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		ruleType   domain.GroupingRuleType
+		expression string
+		setupMocks func(
+			mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+		)
+		expectedError error
+	}{
+		{
+			name:       "Unknown rule type",
+			ruleType:   domain.GroupingRuleType("unknown"),
+			expression: "x -> y",
+			setupMocks: func(
+				*mockcontract.MockGroupingRulesRepository,
+				*mockcontract.MockProjectsRepository,
+			) {
+			},
+			expectedError: domain.ErrInvalidGroupingRule,
+		},
+		{
+			name:       "Rule not found",
+			ruleType:   domain.GroupingRuleTypeFingerprint,
+			expression: "type:X -> x",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				_ *mockcontract.MockProjectsRepository,
+			) {
+				mockRulesRepo.EXPECT().Update(
+					mock.Anything,
+					domain.ProjectID(1),
+					domain.GroupingRuleID(7),
+					domain.GroupingRuleTypeFingerprint,
+					"type:X -> x",
+				).Return(domain.GroupingRule{}, domain.ErrEntityNotFound)
+			},
+			expectedError: domain.ErrEntityNotFound,
+		},
+		{
+			// The project still groups events with the legacy strategy
+			name:       "Stack trace rule of legacy project",
+			ruleType:   domain.GroupingRuleTypeStacktrace,
+			expression: "stack.function:log.* -group",
+			setupMocks: func(
+				_ *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{ID: 1}, nil)
+			},
+			expectedError: domain.ErrInvalidGroupingRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			tt.setupMocks(mockRulesRepo, mockProjectRepo)
+
+			service := New(
+				mockRulesRepo,
+				mockProjectRepo,
+				mockcontract.NewMockEventRepository(t),
+				mockcontract.NewMockIssuesRepository(t),
+			)
+
+			_, err := service.Update(context.Background(), 1, 7, tt.ruleType, tt.expression)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestService_Preview(t *testing.T) {
+	t.Parallel()
+
+	issueID := domain.IssueID(10)
+	stacktraceProject := domain.Project{ID: 1, GroupingStrategy: domain.GroupingStrategyStacktraceV1}
+
+	tests := []struct {
+		name       string
+		ruleType   domain.GroupingRuleType
+		expression string
+		setupMocks func(
+			mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+			mockEventRepo *mockcontract.MockEventRepository,
+			mockIssueRepo *mockcontract.MockIssuesRepository,
+		)
+		expectedScanned uint
+		expectedMatched uint
+		// expectedIssues are compared without their new fingerprints
+		expectedIssues []domain.GroupingRulePreviewIssue
+		// expectedSameGroup is set when all issues are merged into a single new group
+		expectedSameGroup bool
+		expectedError     error
+		errorContains     string
+	}{
+		{
+			name:       "Fingerprint rule",
+			ruleType:   domain.GroupingRuleTypeFingerprint,
+			expression: "error.type:DatabaseError -> database-error",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				mockIssueRepo *mockcontract.MockIssuesRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(stacktraceProject, nil)
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+				mockEventRepo.EXPECT().
+					FetchRecent(mock.Anything, domain.ProjectID(1), mock.Anything, uint(previewEventsLimit)).
+					Return([]domain.Event{
+						exceptionEvent("fp-a", "DatabaseError"),
+						exceptionEvent("fp-a", "DatabaseError"),
+						exceptionEvent("fp-b", "DatabaseError"),
+						exceptionEvent("fp-c", "ValueError"),
+					}, nil)
+				mockIssueRepo.EXPECT().ListByFingerprints(mock.Anything, mock.Anything).Return([]domain.Issue{
+					{ID: 10, ProjectID: 1, Fingerprint: "fp-a", Title: "DatabaseError"},
+					{ID: 11, ProjectID: 2, Fingerprint: "fp-b", Title: "other project"},
+				}, nil)
+			},
+			expectedScanned: 4,
+			expectedMatched: 3,
+			expectedIssues: []domain.GroupingRulePreviewIssue{
+				{IssueID: &issueID, Title: "DatabaseError", Fingerprint: "fp-a", MatchedEvents: 2},
+				{Fingerprint: "fp-b", MatchedEvents: 1},
+			},
+			expectedSameGroup: true,
+		},
+		{
+			name:       "No matches",
+			ruleType:   domain.GroupingRuleTypeStacktrace,
+			expression: "stack.module:other/** -group",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				_ *mockcontract.MockIssuesRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(stacktraceProject, nil)
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+				mockEventRepo.EXPECT().FetchRecent(mock.Anything, domain.ProjectID(1), mock.Anything, mock.Anything).
+					Return([]domain.Event{exceptionEvent("fp-a", "ValueError")}, nil)
+			},
+			expectedScanned: 1,
+		},
+		{
+			name:       "Invalid rule",
+			ruleType:   domain.GroupingRuleTypeStacktrace,
+			expression: "-app",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				_ *mockcontract.MockEventRepository,
+				_ *mockcontract.MockIssuesRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(stacktraceProject, nil)
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+			},
+			expectedError: domain.ErrInvalidGroupingRule,
+		},
+		{
+			// Both events have a vendor frame, the ValueError is grouped by the existing rule
+			name:       "Events decided by an existing rule",
+			ruleType:   domain.GroupingRuleTypeFingerprint,
+			expression: "stack.module:app/vendor/** -> vendor",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				mockIssueRepo *mockcontract.MockIssuesRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(stacktraceProject, nil)
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.GroupingRule{
+					{ID: 1, ProjectID: 1, Type: domain.GroupingRuleTypeFingerprint, Expression: "error.type:ValueError -> value"},
+				}, nil)
+				mockEventRepo.EXPECT().FetchRecent(mock.Anything, domain.ProjectID(1), mock.Anything, mock.Anything).
+					Return([]domain.Event{
+						exceptionEvent("fp-a", "ValueError"),
+						exceptionEvent("fp-b", "DatabaseError"),
+					}, nil)
+				mockIssueRepo.EXPECT().ListByFingerprints(mock.Anything, []string{"fp-b"}).Return(nil, nil)
+			},
+			expectedScanned: 2,
+			expectedMatched: 1,
+			expectedIssues: []domain.GroupingRulePreviewIssue{
+				{Fingerprint: "fp-b", MatchedEvents: 1},
+			},
+			expectedSameGroup: true,
+		},
+		{
+			name:       "Stack trace rule of legacy project",
+			ruleType:   domain.GroupingRuleTypeStacktrace,
+			expression: "stack.module:vendor/** -app",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				_ *mockcontract.MockEventRepository,
+				_ *mockcontract.MockIssuesRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{ID: 1}, nil)
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+			},
+			expectedError: domain.ErrInvalidGroupingRule,
+		},
+		{
+			name:       "Events error",
+			ruleType:   domain.GroupingRuleTypeFingerprint,
+			expression: "type:X -> x",
+			setupMocks: func(
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				_ *mockcontract.MockIssuesRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{ID: 1}, nil)
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+				mockEventRepo.EXPECT().FetchRecent(mock.Anything, domain.ProjectID(1), mock.Anything, mock.Anything).
+					Return(nil, errors.New("clickhouse down"))
+			},
+			errorContains: "clickhouse down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			mockEventRepo := mockcontract.NewMockEventRepository(t)
+			mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+			tt.setupMocks(mockRulesRepo, mockProjectRepo, mockEventRepo, mockIssueRepo)
+
+			service := New(mockRulesRepo, mockProjectRepo, mockEventRepo, mockIssueRepo)
+
+			preview, err := service.Preview(context.Background(), 1, tt.ruleType, tt.expression)
+			if tt.expectedError != nil || tt.errorContains != "" {
+				if tt.expectedError != nil {
+					require.ErrorIs(t, err, tt.expectedError)
+				}
+				if tt.errorContains != "" {
+					require.ErrorContains(t, err, tt.errorContains)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedScanned, preview.ScannedEvents)
+			assert.Equal(t, tt.expectedMatched, preview.MatchedEvents)
+			require.Len(t, preview.Issues, len(tt.expectedIssues))
+
+			var newGroup []string
+			if tt.expectedSameGroup {
+				newGroup = preview.Issues[0].NewFingerprints
+				require.Len(t, newGroup, 1)
+			}
+
+			for i := range preview.Issues {
+				if tt.expectedSameGroup {
+					assert.Equal(t, newGroup, preview.Issues[i].NewFingerprints)
+				}
+
+				preview.Issues[i].NewFingerprints = nil
+				assert.Equal(t, tt.expectedIssues[i], preview.Issues[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package issueactivity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+// mentionRe matches @username mentions not preceded by a word character, e.g. not in emails.
+var mentionRe = regexp.MustCompile(`(?:^|[^\w@])@([\w.-]+)`)
+
+type Service struct {
+	txManager                db.TxManager
+	issuesRepo               contract.IssuesRepository
+	projectsRepo             contract.ProjectsRepository
+	usersRepo                contract.UsersRepository
+	teamsRepo                contract.TeamsRepository
+	issueCommentsRepo        contract.IssueCommentsRepository
+	issueActivitiesRepo      contract.IssueActivitiesRepository
+	userNotificationsUseCase contract.UserNotificationsUseCase
+}
+
+func New(
+	txManager db.TxManager,
+	issuesRepo contract.IssuesRepository,
+	projectsRepo contract.ProjectsRepository,
+	usersRepo contract.UsersRepository,
+	teamsRepo contract.TeamsRepository,
+	issueCommentsRepo contract.IssueCommentsRepository,
+	issueActivitiesRepo contract.IssueActivitiesRepository,
+	userNotificationsUseCase contract.UserNotificationsUseCase,
+) *Service {
+	return &Service{
+		txManager:                txManager,
+		issuesRepo:               issuesRepo,
+		projectsRepo:             projectsRepo,
+		usersRepo:                usersRepo,
+		teamsRepo:                teamsRepo,
+		issueCommentsRepo:        issueCommentsRepo,
+		issueActivitiesRepo:      issueActivitiesRepo,
+		userNotificationsUseCase: userNotificationsUseCase,
+	}
+}
+
+// ListActivities returns a page of the issue activity feed, newest first, with the total number of activities.
+func (s *Service) ListActivities(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	filter *domain.IssueActivitiesFilter,
+) ([]domain.IssueActivity, uint64, error) {
+	if _, err := s.getProjectIssue(ctx, projectID, filter.IssueID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.issueActivitiesRepo.ListByIssue(ctx, filter)
+}
+
+// ListComments returns all comments of the issue, the replies refer to their parent comments.
+func (s *Service) ListComments(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	issueID domain.IssueID,
+) ([]domain.IssueComment, error) {
+	if _, err := s.getProjectIssue(ctx, projectID, issueID); err != nil {
+		return nil, err
+	}
+
+	return s.issueCommentsRepo.ListByIssue(ctx, issueID)
+}
+
+// CreateComment adds a comment of the current user to the issue and notifies the users mentioned in it.
+func (s *Service) CreateComment(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	comment domain.IssueCommentDTO,
+) (domain.IssueComment, error) {
+	comment.Body = strings.TrimSpace(comment.Body)
+	if comment.Body == "" {
+		return domain.IssueComment{}, fmt.Errorf("%w: empty comment", domain.ErrInvalidIssueComment)
+	}
+
+	issue, err := s.getProjectIssue(ctx, projectID, comment.IssueID)
+	if err != nil {
+		return domain.IssueComment{}, err
+	}
+
+	if comment.ParentID != nil {
+		parent, err := s.issueCommentsRepo.GetByID(ctx, *comment.ParentID)
+		if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+			return domain.IssueComment{}, fmt.Errorf("get parent comment: %w", err)
+		}
+
+		if err != nil || parent.IssueID != issue.ID {
+			return domain.IssueComment{}, fmt.Errorf("%w: parent comment not found", domain.ErrInvalidIssueComment)
+		}
+	}
+
+	author, err := s.usersRepo.GetByID(ctx, wardencontext.UserID(ctx))
+	if err != nil {
+		return domain.IssueComment{}, fmt.Errorf("get current user: %w", err)
+	}
+	comment.UserID = author.ID
+
+	project, err := s.projectsRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return domain.IssueComment{}, fmt.Errorf("get project by ID: %w", err)
+	}
+
+	mentioned, err := s.mentionedUsers(ctx, project, comment.Body, author.ID)
+	if err != nil {
+		return domain.IssueComment{}, err
+	}
+
+	var created domain.IssueComment
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		created, err = s.issueCommentsRepo.Create(ctx, comment)
+		if err != nil {
+			return fmt.Errorf("create comment: %w", err)
+		}
+
+		err = s.issueActivitiesRepo.Create(ctx, domain.IssueActivityDTO{
+			IssueID: issue.ID,
+			Type:    domain.IssueActivityCommented,
+			UserID:  &author.ID,
+			Data:    domain.IssueActivityData{CommentID: &created.ID},
+		})
+		if err != nil {
+			return fmt.Errorf("add issue activity: %w", err)
+		}
+
+		content := domain.UserNotificationContent{
+			IssueMention: &domain.IssueMentionContent{
+				IssueID:        uint(issue.ID),
+				IssueTitle:     issue.Title,
+				ProjectID:      uint(project.ID),
+				ProjectName:    project.Name,
+				CommentID:      uint(created.ID),
+				AuthorUserID:   uint(author.ID),
+				AuthorUsername: author.Username,
+			},
+		}
+
+		for _, user := range mentioned {
+			err := s.userNotificationsUseCase.CreateNotification(
+				ctx,
+				user.ID,
+				domain.UserNotificationTypeIssueMention,
+				content,
+			)
+			if err != nil {
+				return fmt.Errorf("create user notification for user %d: %w", user.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.IssueComment{}, fmt.Errorf("create issue comment: %w", err)
+	}
+
+	return created, nil
+}
+
+// DeleteComment deletes a comment with its replies, only the author or a superuser may delete it.
+func (s *Service) DeleteComment(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	issueID domain.IssueID,
+	id domain.IssueCommentID,
+) error {
+	if _, err := s.getProjectIssue(ctx, projectID, issueID); err != nil {
+		return err
+	}
+
+	comment, err := s.issueCommentsRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get comment %d: %w", id, err)
+	}
+
+	if comment.IssueID != issueID {
+		return fmt.Errorf("get comment %d: %w", id, domain.ErrEntityNotFound)
+	}
+
+	user, err := s.usersRepo.GetByID(ctx, wardencontext.UserID(ctx))
+	if err != nil {
+		return fmt.Errorf("get current user: %w", err)
+	}
+
+	if !user.IsSuperuser && (comment.UserID == nil || *comment.UserID != user.ID) {
+		return fmt.Errorf("delete comment of another user: %w", domain.ErrPermissionDenied)
+	}
+
+	return s.issueCommentsRepo.Delete(ctx, id)
+}
+
+// mentionedUsers returns the users mentioned in the comment body who can access the project,
+// except the author.
+func (s *Service) mentionedUsers(
+	ctx context.Context,
+	project domain.Project,
+	body string,
+	authorID domain.UserID,
+) ([]domain.User, error) {
+	usernames := mentionedUsernames(body)
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.usersRepo.FetchByUsernames(ctx, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("fetch mentioned users: %w", err)
+	}
+
+	members := make(map[domain.UserID]struct{})
+	if project.TeamID != nil {
+		teamMembers, err := s.teamsRepo.GetMembers(ctx, *project.TeamID)
+		if err != nil {
+			return nil, fmt.Errorf("get project team members: %w", err)
+		}
+
+		for _, member := range teamMembers {
+			members[member.UserID] = struct{}{}
+		}
+	}
+
+	result := make([]domain.User, 0, len(users))
+	for _, user := range users {
+		if user.ID == authorID {
+			continue
+		}
+
+		if _, ok := members[user.ID]; project.TeamID != nil && !ok && !user.IsSuperuser {
+			continue
+		}
+
+		result = append(result, user)
+	}
+
+	return result, nil
+}
+
+func (s *Service) getProjectIssue(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.IssueID,
+) (domain.Issue, error) {
+	issue, err := s.issuesRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Issue{}, fmt.Errorf("get issue %d: %w", id, err)
+	}
+
+	if issue.ProjectID != projectID {
+		return domain.Issue{}, fmt.Errorf("get issue %d: %w", id, domain.ErrEntityNotFound)
+	}
+
+	return issue, nil
+}
+
+// mentionedUsernames returns the unique usernames mentioned in the text with @username.
+func mentionedUsernames(text string) []string {
+	matches := mentionRe.FindAllStringSubmatch(text, -1)
+	seen := make(map[string]struct{}, len(matches))
+	usernames := make([]string, 0, len(matches))
+	for _, match := range matches {
+		// A trailing dot ends the sentence rather than the username
+		username := strings.TrimRight(match[1], ".")
+		if username == "" {
+			continue
+		}
+
+		if _, ok := seen[username]; ok {
+			continue
+		}
+
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+	}
+
+	return usernames
+}
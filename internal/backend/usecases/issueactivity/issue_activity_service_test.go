@@ -0,0 +1,310 @@
+package issueactivity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+func TestService_CreateComment(t *testing.T) {
+	t.Parallel()
+
+	teamID := domain.TeamID(3)
+	parentID := domain.IssueCommentID(3)
+
+	tests := []struct {
+		name       string
+		projectID  domain.ProjectID
+		commentDTO domain.IssueCommentDTO
+		setupMocks func(
+			mockTxManager *mockdb.MockTxManager,
+			mockProjectsRepo *mockcontract.MockProjectsRepository,
+			mockUsersRepo *mockcontract.MockUsersRepository,
+			mockTeamsRepo *mockcontract.MockTeamsRepository,
+			mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+			mockActivitiesRepo *mockcontract.MockIssueActivitiesRepository,
+			mockUserNotifications *mockcontract.MockUserNotificationsUseCase,
+		)
+		expectedCommentID domain.IssueCommentID
+		expectedError     error
+	}{
+		{
+			name:      "Notifies mentioned project members",
+			projectID: 5,
+			commentDTO: domain.IssueCommentDTO{
+				IssueID: 10,
+				Body:    "  @bob @eve please look, cc @alice.  ",
+			},
+			setupMocks: func(
+				mockTxManager *mockdb.MockTxManager,
+				mockProjectsRepo *mockcontract.MockProjectsRepository,
+				mockUsersRepo *mockcontract.MockUsersRepository,
+				mockTeamsRepo *mockcontract.MockTeamsRepository,
+				mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+				mockActivitiesRepo *mockcontract.MockIssueActivitiesRepository,
+				mockUserNotifications *mockcontract.MockUserNotificationsUseCase,
+			) {
+				author := domain.User{ID: 1, Username: "alice"}
+				bob := domain.User{ID: 2, Username: "bob"}
+				outsider := domain.User{ID: 4, Username: "eve"}
+
+				mockUsersRepo.EXPECT().GetByID(mock.Anything, domain.UserID(1)).Return(author, nil)
+				mockProjectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(5)).
+					Return(domain.Project{ID: 5, Name: "api", TeamID: &teamID}, nil)
+				mockUsersRepo.EXPECT().FetchByUsernames(mock.Anything, []string{"bob", "eve", "alice"}).
+					Return([]domain.User{bob, outsider, author}, nil)
+				mockTeamsRepo.EXPECT().GetMembers(mock.Anything, teamID).
+					Return([]domain.TeamMember{{TeamID: 3, UserID: 1}, {TeamID: 3, UserID: 2}}, nil)
+				mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockCommentsRepo.EXPECT().Create(mock.Anything, domain.IssueCommentDTO{
+					IssueID: 10,
+					UserID:  1,
+					Body:    "@bob @eve please look, cc @alice.",
+				}).Return(domain.IssueComment{ID: 7, IssueID: 10, Body: "@bob @eve please look, cc @alice."}, nil)
+				mockActivitiesRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto domain.IssueActivityDTO) bool {
+					return dto.Type == domain.IssueActivityCommented &&
+						dto.Data.CommentID != nil && *dto.Data.CommentID == 7
+				})).Return(nil)
+				mockUserNotifications.EXPECT().CreateNotification(
+					mock.Anything,
+					bob.ID,
+					domain.UserNotificationTypeIssueMention,
+					domain.UserNotificationContent{IssueMention: &domain.IssueMentionContent{
+						IssueID:        10,
+						IssueTitle:     "panic",
+						ProjectID:      5,
+						ProjectName:    "api",
+						CommentID:      7,
+						AuthorUserID:   1,
+						AuthorUsername: "alice",
+					}},
+				).Return(nil)
+			},
+			expectedCommentID: 7,
+		},
+		{
+			name:       "Empty body",
+			projectID:  5,
+			commentDTO: domain.IssueCommentDTO{IssueID: 10, Body: " \n "},
+			setupMocks: func(
+				*mockdb.MockTxManager,
+				*mockcontract.MockProjectsRepository,
+				*mockcontract.MockUsersRepository,
+				*mockcontract.MockTeamsRepository,
+				*mockcontract.MockIssueCommentsRepository,
+				*mockcontract.MockIssueActivitiesRepository,
+				*mockcontract.MockUserNotificationsUseCase,
+			) {
+			},
+			expectedError: domain.ErrInvalidIssueComment,
+		},
+		{
+			name:      "Parent comment of another issue",
+			projectID: 5,
+			commentDTO: domain.IssueCommentDTO{
+				IssueID:  10,
+				ParentID: &parentID,
+				Body:     "reply",
+			},
+			setupMocks: func(
+				_ *mockdb.MockTxManager,
+				_ *mockcontract.MockProjectsRepository,
+				_ *mockcontract.MockUsersRepository,
+				_ *mockcontract.MockTeamsRepository,
+				mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+				_ *mockcontract.MockIssueActivitiesRepository,
+				_ *mockcontract.MockUserNotificationsUseCase,
+			) {
+				mockCommentsRepo.EXPECT().GetByID(mock.Anything, parentID).
+					Return(domain.IssueComment{ID: 3, IssueID: 11}, nil)
+			},
+			expectedError: domain.ErrInvalidIssueComment,
+		},
+		{
+			name:       "Issue of another project",
+			projectID:  6,
+			commentDTO: domain.IssueCommentDTO{IssueID: 10, Body: "hello"},
+			setupMocks: func(
+				*mockdb.MockTxManager,
+				*mockcontract.MockProjectsRepository,
+				*mockcontract.MockUsersRepository,
+				*mockcontract.MockTeamsRepository,
+				*mockcontract.MockIssueCommentsRepository,
+				*mockcontract.MockIssueActivitiesRepository,
+				*mockcontract.MockUserNotificationsUseCase,
+			) {
+			},
+			expectedError: domain.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockTxManager := mockdb.NewMockTxManager(t)
+			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
+			mockProjectsRepo := mockcontract.NewMockProjectsRepository(t)
+			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
+			mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
+			mockCommentsRepo := mockcontract.NewMockIssueCommentsRepository(t)
+			mockActivitiesRepo := mockcontract.NewMockIssueActivitiesRepository(t)
+			mockUserNotifications := mockcontract.NewMockUserNotificationsUseCase(t)
+
+			mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(10)).
+				Return(domain.Issue{ID: 10, ProjectID: 5, Title: "panic"}, nil).Maybe()
+			tt.setupMocks(mockTxManager, mockProjectsRepo, mockUsersRepo, mockTeamsRepo,
+				mockCommentsRepo, mockActivitiesRepo, mockUserNotifications)
+
+			service := New(mockTxManager, mockIssuesRepo, mockProjectsRepo, mockUsersRepo, mockTeamsRepo,
+				mockCommentsRepo, mockActivitiesRepo, mockUserNotifications)
+
+			ctx := wardencontext.WithUserID(context.Background(), 1)
+			comment, err := service.CreateComment(ctx, tt.projectID, tt.commentDTO)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCommentID, comment.ID)
+		})
+	}
+}
+
+func TestService_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	authorID := domain.UserID(2)
+
+	tests := []struct {
+		name       string
+		setupMocks func(
+			mockUsersRepo *mockcontract.MockUsersRepository,
+			mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+		)
+		expectedError error
+	}{
+		{
+			name: "Author deletes own comment",
+			setupMocks: func(
+				mockUsersRepo *mockcontract.MockUsersRepository,
+				mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+			) {
+				mockCommentsRepo.EXPECT().GetByID(mock.Anything, domain.IssueCommentID(7)).
+					Return(domain.IssueComment{ID: 7, IssueID: 10, UserID: &authorID}, nil)
+				mockUsersRepo.EXPECT().GetByID(mock.Anything, domain.UserID(1)).
+					Return(domain.User{ID: 2, Username: "bob"}, nil)
+				mockCommentsRepo.EXPECT().Delete(mock.Anything, domain.IssueCommentID(7)).Return(nil)
+			},
+		},
+		{
+			name: "Another user is denied",
+			setupMocks: func(
+				mockUsersRepo *mockcontract.MockUsersRepository,
+				mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+			) {
+				mockCommentsRepo.EXPECT().GetByID(mock.Anything, domain.IssueCommentID(7)).
+					Return(domain.IssueComment{ID: 7, IssueID: 10, UserID: &authorID}, nil)
+				mockUsersRepo.EXPECT().GetByID(mock.Anything, domain.UserID(1)).
+					Return(domain.User{ID: 1, Username: "alice"}, nil)
+			},
+			expectedError: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Superuser deletes any comment",
+			setupMocks: func(
+				mockUsersRepo *mockcontract.MockUsersRepository,
+				mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+			) {
+				mockCommentsRepo.EXPECT().GetByID(mock.Anything, domain.IssueCommentID(7)).
+					Return(domain.IssueComment{ID: 7, IssueID: 10, UserID: &authorID}, nil)
+				mockUsersRepo.EXPECT().GetByID(mock.Anything, domain.UserID(1)).
+					Return(domain.User{ID: 1, Username: "admin", IsSuperuser: true}, nil)
+				mockCommentsRepo.EXPECT().Delete(mock.Anything, domain.IssueCommentID(7)).Return(nil)
+			},
+		},
+		{
+			name: "Comment of another issue",
+			setupMocks: func(
+				_ *mockcontract.MockUsersRepository,
+				mockCommentsRepo *mockcontract.MockIssueCommentsRepository,
+			) {
+				mockCommentsRepo.EXPECT().GetByID(mock.Anything, domain.IssueCommentID(7)).
+					Return(domain.IssueComment{ID: 7, IssueID: 11, UserID: &authorID}, nil)
+			},
+			expectedError: domain.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
+			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
+			mockCommentsRepo := mockcontract.NewMockIssueCommentsRepository(t)
+
+			mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(10)).
+				Return(domain.Issue{ID: 10, ProjectID: 5, Title: "panic"}, nil).Maybe()
+			tt.setupMocks(mockUsersRepo, mockCommentsRepo)
+
+			service := New(
+				mockdb.NewMockTxManager(t),
+				mockIssuesRepo,
+				mockcontract.NewMockProjectsRepository(t),
+				mockUsersRepo,
+				mockcontract.NewMockTeamsRepository(t),
+				mockCommentsRepo,
+				mockcontract.NewMockIssueActivitiesRepository(t),
+				mockcontract.NewMockUserNotificationsUseCase(t),
+			)
+
+			ctx := wardencontext.WithUserID(context.Background(), 1)
+			err := service.DeleteComment(ctx, 5, 10, 7)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMentionedUsernames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "no mentions", text: "looks fine", want: []string{}},
+		{name: "start of text", text: "@bob check this", want: []string{"bob"}},
+		{name: "punctuation", text: "ping (@bob), @carol.", want: []string{"bob", "carol"}},
+		{name: "dotted username", text: "cc @john.doe please", want: []string{"john.doe"}},
+		{name: "duplicates", text: "@bob @bob", want: []string{"bob"}},
+		{name: "email is not a mention", text: "mail bob@example.com", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, mentionedUsernames(tt.text))
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package issuebulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Service struct {
+	txManager          db.TxManager
+	issueUseCase       contract.IssueUseCase
+	issuesRepo         contract.IssuesRepository
+	resolutionsRepo    contract.ResolutionsRepository
+	permissionsService contract.PermissionsService
+}
+
+func New(
+	txManager db.TxManager,
+	issueUseCase contract.IssueUseCase,
+	issuesRepo contract.IssuesRepository,
+	resolutionsRepo contract.ResolutionsRepository,
+	permissionsService contract.PermissionsService,
+) *Service {
+	return &Service{
+		txManager:          txManager,
+		issueUseCase:       issueUseCase,
+		issuesRepo:         issuesRepo,
+		resolutionsRepo:    resolutionsRepo,
+		permissionsService: permissionsService,
+	}
+}
+
+// Apply applies the operation to the issues the current user can manage in one transaction,
+// the issues not found, not manageable or already in the requested state are only reported.
+func (s *Service) Apply(
+	ctx context.Context,
+	operation domain.IssueBulkOperation,
+) (domain.IssueBulkResult, error) {
+	if err := validateOperation(operation); err != nil {
+		return domain.IssueBulkResult{}, err
+	}
+
+	if operation.Action == domain.IssueBulkActionMerge {
+		if err := s.permissionsService.CanManageIssue(ctx, operation.PrimaryIssueID); err != nil {
+			return domain.IssueBulkResult{}, fmt.Errorf("check primary issue: %w", err)
+		}
+	}
+
+	issues, result, err := s.targetIssues(ctx, operation)
+	if err != nil {
+		return domain.IssueBulkResult{}, err
+	}
+
+	issueIDs := make([]domain.IssueID, 0, len(issues))
+	for i := range issues {
+		issue := issues[i]
+
+		if operation.Action == domain.IssueBulkActionMerge && issue.ID == operation.PrimaryIssueID {
+			continue
+		}
+
+		err := s.permissionsService.CanManageIssue(ctx, issue.ID)
+		switch {
+		case errors.Is(err, domain.ErrPermissionDenied):
+			result.Denied = append(result.Denied, issue.ID)
+
+			continue
+		case errors.Is(err, domain.ErrEntityNotFound):
+			result.NotFound = append(result.NotFound, issue.ID)
+
+			continue
+		case err != nil:
+			return domain.IssueBulkResult{}, fmt.Errorf("check issue %d: %w", issue.ID, err)
+		}
+
+		if isUnchanged(operation, issue) {
+			result.Unchanged = append(result.Unchanged, issue.ID)
+
+			continue
+		}
+
+		issueIDs = append(issueIDs, issue.ID)
+	}
+
+	if len(issueIDs) == 0 {
+		return result, nil
+	}
+
+	// The issue use case joins the transaction, a failure of any issue rolls back the whole operation
+	err = s.txManager.RepeatableRead(ctx, func(ctx context.Context) error {
+		return s.apply(ctx, operation, issueIDs)
+	})
+	if err != nil {
+		return domain.IssueBulkResult{}, fmt.Errorf("apply bulk %s: %w", operation.Action, err)
+	}
+
+	result.Changed = issueIDs
+
+	slog.Info("bulk issue operation applied", "project_id", operation.ProjectID,
+		"action", operation.Action, "issue_ids", issueIDs)
+
+	return result, nil
+}
+
+// targetIssues returns the issues of the project given by IDs or matching the filter,
+// the issue IDs not found are reported in the result.
+func (s *Service) targetIssues(
+	ctx context.Context,
+	operation domain.IssueBulkOperation,
+) ([]domain.Issue, domain.IssueBulkResult, error) {
+	var result domain.IssueBulkResult
+
+	if operation.Filter != nil {
+		filter := *operation.Filter
+		filter.ProjectID = &operation.ProjectID
+		filter.PageNum = 1
+		filter.PerPage = domain.IssueBulkMaxIssues + 1
+
+		list, _, truncated, err := s.issueUseCase.List(ctx, &filter)
+		if err != nil {
+			return nil, result, fmt.Errorf("list issues: %w", err)
+		}
+
+		// The issues of the event groups left out of a truncated search are left for another run
+		result.HasMore = truncated
+		if len(list) > domain.IssueBulkMaxIssues {
+			list = list[:domain.IssueBulkMaxIssues]
+			result.HasMore = true
+		}
+
+		issues := make([]domain.Issue, 0, len(list))
+		for i := range list {
+			issues = append(issues, list[i].Issue)
+		}
+
+		return issues, result, nil
+	}
+
+	issueIDs := slices.Clone(operation.IssueIDs)
+	slices.Sort(issueIDs)
+	issueIDs = slices.Compact(issueIDs)
+
+	issues := make([]domain.Issue, 0, len(issueIDs))
+	for _, id := range issueIDs {
+		issue, err := s.issuesRepo.GetByID(ctx, id)
+		if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+			return nil, result, fmt.Errorf("get issue %d: %w", id, err)
+		}
+
+		if err != nil || issue.ProjectID != operation.ProjectID {
+			result.NotFound = append(result.NotFound, id)
+
+			continue
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, result, nil
+}
+
+func (s *Service) apply(ctx context.Context, operation domain.IssueBulkOperation, issueIDs []domain.IssueID) error {
+	switch operation.Action {
+	case domain.IssueBulkActionMerge:
+		return s.issueUseCase.Merge(ctx, operation.ProjectID, operation.PrimaryIssueID, issueIDs)
+	case domain.IssueBulkActionDelete:
+		if err := s.resolutionsRepo.DeleteByIssues(ctx, issueIDs); err != nil {
+			return fmt.Errorf("delete resolutions: %w", err)
+		}
+
+		if err := s.issuesRepo.Delete(ctx, issueIDs); err != nil {
+			return fmt.Errorf("delete issues: %w", err)
+		}
+
+		return nil
+	}
+
+	for _, id := range issueIDs {
+		var err error
+		switch operation.Action {
+		case domain.IssueBulkActionResolve:
+			if operation.ResolvedInRelease != nil {
+				err = s.issueUseCase.ResolveInRelease(ctx, id, *operation.ResolvedInRelease)
+			} else {
+				err = s.issueUseCase.ChangeStatus(ctx, id, domain.IssueStatusResolved)
+			}
+		case domain.IssueBulkActionIgnore:
+			var conditions domain.IssueIgnoreConditions
+			if operation.IgnoreConditions != nil {
+				conditions = *operation.IgnoreConditions
+			}
+
+			err = s.issueUseCase.Ignore(ctx, id, conditions)
+		case domain.IssueBulkActionUnresolve:
+			err = s.issueUseCase.ChangeStatus(ctx, id, domain.IssueStatusUnresolved)
+		case domain.IssueBulkActionAssign:
+			err = s.issueUseCase.Assign(ctx, id, operation.Assignee)
+		}
+		if err != nil {
+			return fmt.Errorf("issue %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+//nolint:gocyclo // flat checks of the action options
+func validateOperation(operation domain.IssueBulkOperation) error {
+	switch {
+	case len(operation.IssueIDs) == 0 && operation.Filter == nil:
+		return fmt.Errorf("%w: either issue IDs or a filter is required", domain.ErrInvalidBulkOperation)
+	case len(operation.IssueIDs) > 0 && operation.Filter != nil:
+		return fmt.Errorf("%w: either issue IDs or a filter can be set", domain.ErrInvalidBulkOperation)
+	case len(operation.IssueIDs) > domain.IssueBulkMaxIssues:
+		return fmt.Errorf("%w: at most %d issues can be changed at once",
+			domain.ErrInvalidBulkOperation, domain.IssueBulkMaxIssues)
+	}
+
+	action := operation.Action
+	switch {
+	case operation.IgnoreConditions != nil && action != domain.IssueBulkActionIgnore:
+		return fmt.Errorf("%w: ignore conditions are only allowed for the ignore action",
+			domain.ErrInvalidBulkOperation)
+	case operation.ResolvedInRelease != nil && action != domain.IssueBulkActionResolve:
+		return fmt.Errorf("%w: resolved in release is only allowed for the resolve action",
+			domain.ErrInvalidBulkOperation)
+	case (operation.Assignee.UserID != nil || operation.Assignee.TeamID != nil) &&
+		action != domain.IssueBulkActionAssign:
+		return fmt.Errorf("%w: assignee is only allowed for the assign action", domain.ErrInvalidBulkOperation)
+	case (operation.PrimaryIssueID != 0) != (action == domain.IssueBulkActionMerge):
+		return fmt.Errorf("%w: primary issue is required for the merge action and only allowed for it",
+			domain.ErrInvalidBulkOperation)
+	case operation.Confirm && (action != domain.IssueBulkActionDelete || operation.Filter == nil):
+		return fmt.Errorf("%w: confirm is only allowed for the delete action by filter", domain.ErrInvalidBulkOperation)
+	}
+
+	// Deleting can't be undone, a filter must narrow the issues down and be confirmed
+	if action == domain.IssueBulkActionDelete && operation.Filter != nil {
+		switch {
+		case !hasFilterConditions(operation.Filter):
+			return fmt.Errorf("%w: the delete action needs issue IDs or a filter with conditions",
+				domain.ErrInvalidBulkOperation)
+		case !operation.Confirm:
+			return fmt.Errorf("%w: the delete action by filter must be confirmed", domain.ErrInvalidBulkOperation)
+		}
+	}
+
+	switch action {
+	case domain.IssueBulkActionResolve, domain.IssueBulkActionIgnore, domain.IssueBulkActionUnresolve,
+		domain.IssueBulkActionAssign, domain.IssueBulkActionMerge, domain.IssueBulkActionDelete:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown action %q", domain.ErrInvalidBulkOperation, action)
+	}
+}
+
+func hasFilterConditions(filter *domain.ListIssuesFilter) bool {
+	return filter.Level != nil || filter.Status != nil || filter.Assigned != nil || len(filter.Query) > 0
+}
+
+// isUnchanged reports whether the issue already is in the state requested by the operation.
+func isUnchanged(operation domain.IssueBulkOperation, issue domain.Issue) bool {
+	switch operation.Action {
+	case domain.IssueBulkActionResolve:
+		return issue.Status == domain.IssueStatusResolved &&
+			operation.ResolvedInRelease == nil && issue.ResolvedInRelease == nil
+	case domain.IssueBulkActionIgnore:
+		// Ignoring without conditions keeps the conditions of an ignored issue
+		return issue.Status == domain.IssueStatusIgnored && operation.IgnoreConditions == nil
+	case domain.IssueBulkActionUnresolve:
+		return issue.Status == domain.IssueStatusUnresolved
+	case domain.IssueBulkActionAssign:
+		return sameID(issue.Assignee.UserID, operation.Assignee.UserID) &&
+			sameID(issue.Assignee.TeamID, operation.Assignee.TeamID)
+	default:
+		return false
+	}
+}
+
+func sameID[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
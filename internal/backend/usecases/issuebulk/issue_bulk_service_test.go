@@ -0,0 +1,384 @@
+package issuebulk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+func TestService_Apply(t *testing.T) {
+	t.Parallel()
+
+	level := domain.IssueLevelError
+	teamID := domain.TeamID(3)
+	errDB := errors.New("db is down")
+
+	ignoredIssues := make([]domain.IssueExtended, 0, domain.IssueBulkMaxIssues+1)
+	unchangedIDs := make([]domain.IssueID, 0, domain.IssueBulkMaxIssues)
+	for i := range domain.IssueBulkMaxIssues + 1 {
+		ignoredIssues = append(ignoredIssues, domain.IssueExtended{Issue: domain.Issue{
+			ID:        domain.IssueID(i + 1),
+			ProjectID: 5,
+			Status:    domain.IssueStatusIgnored,
+		}})
+		if i < domain.IssueBulkMaxIssues {
+			unchangedIDs = append(unchangedIDs, domain.IssueID(i+1))
+		}
+	}
+
+	tests := []struct {
+		name       string
+		operation  domain.IssueBulkOperation
+		setupMocks func(
+			mockTxManager *mockdb.MockTxManager,
+			mockIssueUseCase *mockcontract.MockIssueUseCase,
+			mockIssuesRepo *mockcontract.MockIssuesRepository,
+			mockResolutionsRepo *mockcontract.MockResolutionsRepository,
+			mockPermissionsService *mockcontract.MockPermissionsService,
+		)
+		expectedResult domain.IssueBulkResult
+		expectedError  error
+	}{
+		{
+			name: "Resolve by issue IDs",
+			operation: domain.IssueBulkOperation{
+				ProjectID: 5,
+				Action:    domain.IssueBulkActionResolve,
+				IssueIDs:  []domain.IssueID{4, 1, 2, 3, 7, 1},
+			},
+			setupMocks: func(
+				mockTxManager *mockdb.MockTxManager,
+				mockIssueUseCase *mockcontract.MockIssueUseCase,
+				mockIssuesRepo *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+					Return(domain.Issue{ID: 1, ProjectID: 5, Status: domain.IssueStatusUnresolved}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(2)).
+					Return(domain.Issue{ID: 2, ProjectID: 5, Status: domain.IssueStatusResolved}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(3)).
+					Return(domain.Issue{ID: 3, ProjectID: 6, Status: domain.IssueStatusUnresolved}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(4)).
+					Return(domain.Issue{ID: 4, ProjectID: 5, Status: domain.IssueStatusUnresolved}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(7)).
+					Return(domain.Issue{}, domain.ErrEntityNotFound)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(1)).Return(nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(2)).Return(nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(4)).
+					Return(domain.ErrPermissionDenied)
+				expectTx(mockTxManager)
+				mockIssueUseCase.EXPECT().ChangeStatus(mock.Anything, domain.IssueID(1), domain.IssueStatusResolved).
+					Return(nil)
+			},
+			expectedResult: domain.IssueBulkResult{
+				Changed:   []domain.IssueID{1},
+				Unchanged: []domain.IssueID{2},
+				Denied:    []domain.IssueID{4},
+				NotFound:  []domain.IssueID{3, 7},
+			},
+		},
+		{
+			name: "Ignore by filter over the limit",
+			operation: domain.IssueBulkOperation{
+				ProjectID: 5,
+				Action:    domain.IssueBulkActionIgnore,
+				Filter:    &domain.ListIssuesFilter{Level: &level},
+			},
+			setupMocks: func(
+				_ *mockdb.MockTxManager,
+				mockIssueUseCase *mockcontract.MockIssueUseCase,
+				_ *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				mockIssueUseCase.EXPECT().List(mock.Anything, mock.MatchedBy(func(filter *domain.ListIssuesFilter) bool {
+					return *filter.ProjectID == 5 && *filter.Level == level &&
+						filter.PageNum == 1 && filter.PerPage == domain.IssueBulkMaxIssues+1
+				})).Return(ignoredIssues, uint64(len(ignoredIssues)), false, nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, mock.Anything).
+					Return(nil).Times(domain.IssueBulkMaxIssues)
+			},
+			expectedResult: domain.IssueBulkResult{
+				Unchanged: unchangedIDs,
+				HasMore:   true,
+			},
+		},
+		{
+			name: "Merge skips the primary issue",
+			operation: domain.IssueBulkOperation{
+				ProjectID:      5,
+				Action:         domain.IssueBulkActionMerge,
+				IssueIDs:       []domain.IssueID{1, 2, 3},
+				PrimaryIssueID: 1,
+			},
+			setupMocks: func(
+				mockTxManager *mockdb.MockTxManager,
+				mockIssueUseCase *mockcontract.MockIssueUseCase,
+				mockIssuesRepo *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				// The primary issue is checked once, before the merged ones
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(1)).Return(nil).Once()
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(2)).Return(nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(3)).Return(nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+					Return(domain.Issue{ID: 1, ProjectID: 5}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(2)).
+					Return(domain.Issue{ID: 2, ProjectID: 5}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(3)).
+					Return(domain.Issue{ID: 3, ProjectID: 5}, nil)
+				expectTx(mockTxManager)
+				mockIssueUseCase.EXPECT().
+					Merge(mock.Anything, domain.ProjectID(5), domain.IssueID(1), []domain.IssueID{2, 3}).
+					Return(nil)
+			},
+			expectedResult: domain.IssueBulkResult{Changed: []domain.IssueID{2, 3}},
+		},
+		{
+			name: "Delete",
+			operation: domain.IssueBulkOperation{
+				ProjectID: 5,
+				Action:    domain.IssueBulkActionDelete,
+				IssueIDs:  []domain.IssueID{1},
+			},
+			setupMocks: func(
+				mockTxManager *mockdb.MockTxManager,
+				_ *mockcontract.MockIssueUseCase,
+				mockIssuesRepo *mockcontract.MockIssuesRepository,
+				mockResolutionsRepo *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+					Return(domain.Issue{ID: 1, ProjectID: 5}, nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(1)).Return(nil)
+				expectTx(mockTxManager)
+				mockResolutionsRepo.EXPECT().DeleteByIssues(mock.Anything, []domain.IssueID{1}).Return(nil)
+				mockIssuesRepo.EXPECT().Delete(mock.Anything, []domain.IssueID{1}).Return(nil)
+			},
+			expectedResult: domain.IssueBulkResult{Changed: []domain.IssueID{1}},
+		},
+		{
+			name: "Failure of an issue fails the operation",
+			operation: domain.IssueBulkOperation{
+				ProjectID: 5,
+				Action:    domain.IssueBulkActionAssign,
+				IssueIDs:  []domain.IssueID{1, 2},
+				Assignee:  domain.IssueAssignee{TeamID: &teamID},
+			},
+			setupMocks: func(
+				mockTxManager *mockdb.MockTxManager,
+				mockIssueUseCase *mockcontract.MockIssueUseCase,
+				mockIssuesRepo *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+					Return(domain.Issue{ID: 1, ProjectID: 5}, nil)
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(2)).
+					Return(domain.Issue{ID: 2, ProjectID: 5}, nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, mock.Anything).Return(nil)
+				expectTx(mockTxManager)
+				mockIssueUseCase.EXPECT().Assign(mock.Anything, domain.IssueID(1), domain.IssueAssignee{TeamID: &teamID}).
+					Return(nil)
+				mockIssueUseCase.EXPECT().Assign(mock.Anything, domain.IssueID(2), domain.IssueAssignee{TeamID: &teamID}).
+					Return(domain.ErrInvalidAssignee)
+			},
+			expectedError: domain.ErrInvalidAssignee,
+		},
+		{
+			name: "Primary issue not manageable",
+			operation: domain.IssueBulkOperation{
+				ProjectID:      5,
+				Action:         domain.IssueBulkActionMerge,
+				IssueIDs:       []domain.IssueID{2},
+				PrimaryIssueID: 1,
+			},
+			setupMocks: func(
+				_ *mockdb.MockTxManager,
+				_ *mockcontract.MockIssueUseCase,
+				_ *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(1)).
+					Return(domain.ErrPermissionDenied)
+			},
+			expectedError: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Permission check error",
+			operation: domain.IssueBulkOperation{
+				ProjectID: 5,
+				Action:    domain.IssueBulkActionUnresolve,
+				IssueIDs:  []domain.IssueID{1},
+			},
+			setupMocks: func(
+				_ *mockdb.MockTxManager,
+				_ *mockcontract.MockIssueUseCase,
+				mockIssuesRepo *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockResolutionsRepository,
+				mockPermissionsService *mockcontract.MockPermissionsService,
+			) {
+				mockIssuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+					Return(domain.Issue{ID: 1, ProjectID: 5}, nil)
+				mockPermissionsService.EXPECT().CanManageIssue(mock.Anything, domain.IssueID(1)).Return(errDB)
+			},
+			expectedError: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockTxManager := mockdb.NewMockTxManager(t)
+			mockIssueUseCase := mockcontract.NewMockIssueUseCase(t)
+			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
+			mockResolutionsRepo := mockcontract.NewMockResolutionsRepository(t)
+			mockPermissionsService := mockcontract.NewMockPermissionsService(t)
+			tt.setupMocks(mockTxManager, mockIssueUseCase, mockIssuesRepo, mockResolutionsRepo, mockPermissionsService)
+
+			service := New(mockTxManager, mockIssueUseCase, mockIssuesRepo, mockResolutionsRepo, mockPermissionsService)
+
+			result, err := service.Apply(context.Background(), tt.operation)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, result.Changed)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestValidateOperation(t *testing.T) {
+	t.Parallel()
+
+	userID := domain.UserID(2)
+	ids := []domain.IssueID{1}
+	resolved := domain.IssueStatusResolved
+
+	tests := []struct {
+		name      string
+		operation domain.IssueBulkOperation
+	}{
+		{
+			name:      "no issues",
+			operation: domain.IssueBulkOperation{Action: domain.IssueBulkActionResolve},
+		},
+		{
+			name: "issue IDs and filter",
+			operation: domain.IssueBulkOperation{
+				Action:   domain.IssueBulkActionResolve,
+				IssueIDs: ids,
+				Filter:   &domain.ListIssuesFilter{},
+			},
+		},
+		{
+			name: "too many issues",
+			operation: domain.IssueBulkOperation{
+				Action:   domain.IssueBulkActionResolve,
+				IssueIDs: make([]domain.IssueID, domain.IssueBulkMaxIssues+1),
+			},
+		},
+		{
+			name:      "unknown action",
+			operation: domain.IssueBulkOperation{Action: "archive", IssueIDs: ids},
+		},
+		{
+			name: "ignore conditions for resolve",
+			operation: domain.IssueBulkOperation{
+				Action:           domain.IssueBulkActionResolve,
+				IssueIDs:         ids,
+				IgnoreConditions: &domain.IssueIgnoreConditions{},
+			},
+		},
+		{
+			name: "assignee for ignore",
+			operation: domain.IssueBulkOperation{
+				Action:   domain.IssueBulkActionIgnore,
+				IssueIDs: ids,
+				Assignee: domain.IssueAssignee{UserID: &userID},
+			},
+		},
+		{
+			name:      "merge without primary issue",
+			operation: domain.IssueBulkOperation{Action: domain.IssueBulkActionMerge, IssueIDs: ids},
+		},
+		{
+			name: "primary issue for delete",
+			operation: domain.IssueBulkOperation{
+				Action:         domain.IssueBulkActionDelete,
+				IssueIDs:       ids,
+				PrimaryIssueID: 3,
+			},
+		},
+		{
+			name: "delete by an empty filter",
+			operation: domain.IssueBulkOperation{
+				Action:  domain.IssueBulkActionDelete,
+				Filter:  &domain.ListIssuesFilter{},
+				Confirm: true,
+			},
+		},
+		{
+			name: "delete by filter without confirm",
+			operation: domain.IssueBulkOperation{
+				Action: domain.IssueBulkActionDelete,
+				Filter: &domain.ListIssuesFilter{Status: &resolved},
+			},
+		},
+		{
+			name: "confirm for resolve",
+			operation: domain.IssueBulkOperation{
+				Action:  domain.IssueBulkActionResolve,
+				Filter:  &domain.ListIssuesFilter{Status: &resolved},
+				Confirm: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, validateOperation(tt.operation), domain.ErrInvalidBulkOperation)
+		})
+	}
+}
+
+func TestValidateOperation_DeleteByFilter(t *testing.T) {
+	t.Parallel()
+
+	status := domain.IssueStatusResolved
+
+	for _, filter := range []*domain.ListIssuesFilter{
+		{Status: &status},
+		{Query: domain.SearchQuery{{Key: "environment", Values: []string{"staging"}}}},
+	} {
+		require.NoError(t, validateOperation(domain.IssueBulkOperation{
+			Action:  domain.IssueBulkActionDelete,
+			Filter:  filter,
+			Confirm: true,
+		}))
+	}
+}
+
+func expectTx(mockTxManager *mockdb.MockTxManager) {
+	mockTxManager.EXPECT().RepeatableRead(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
@@ -0,0 +1,181 @@
+package issues
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+// Assign assigns the issue to a user or a team and notifies the new assignee.
+// An empty assignee unassigns the issue.
+func (s *Service) Assign(ctx context.Context, id domain.IssueID, assignee domain.IssueAssignee) error {
+	if assignee.UserID != nil && assignee.TeamID != nil {
+		return fmt.Errorf("%w: either a user or a team can be assigned", domain.ErrInvalidAssignee)
+	}
+
+	currentUserID := wardencontext.UserID(ctx)
+	currentUser, err := s.usersRepo.GetByID(ctx, currentUserID)
+	if err != nil {
+		return fmt.Errorf("get current user by ID: %w", err)
+	}
+
+	issue, err := s.issuesRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get issue by ID: %w", err)
+	}
+
+	project, err := s.projectsRepo.GetByID(ctx, issue.ProjectID)
+	if err != nil {
+		return fmt.Errorf("get project by ID: %w", err)
+	}
+
+	recipients, teamName, err := s.assigneeRecipients(ctx, project, assignee)
+	if err != nil {
+		return err
+	}
+
+	content := domain.UserNotificationContent{
+		IssueAssigned: &domain.IssueAssignedContent{
+			IssueID:            uint(issue.ID),
+			IssueTitle:         issue.Title,
+			ProjectID:          uint(project.ID),
+			ProjectName:        project.Name,
+			TeamName:           teamName,
+			AssignedByUserID:   uint(currentUser.ID),
+			AssignedByUsername: currentUser.Username,
+		},
+	}
+
+	err = db.RepeatableReadOrJoin(ctx, s.txManager, func(ctx context.Context) error {
+		if err := s.issuesRepo.UpdateAssignee(ctx, id, assignee); err != nil {
+			return fmt.Errorf("update issue assignee: %w", err)
+		}
+
+		err := s.addActivity(ctx, id, currentUserID, domain.IssueActivityAssigned, domain.IssueActivityData{
+			AssignedUserID: assignee.UserID,
+			AssignedTeamID: assignee.TeamID,
+		})
+		if err != nil {
+			return fmt.Errorf("add issue activity: %w", err)
+		}
+
+		for _, userID := range recipients {
+			if userID == currentUserID {
+				continue
+			}
+
+			err = s.userNotificationsUseCase.CreateNotification(
+				ctx,
+				userID,
+				domain.UserNotificationTypeIssueAssigned,
+				content,
+			)
+			if err != nil {
+				return fmt.Errorf("create user notification for user %d: %w", userID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("assign issue: %w", err)
+	}
+
+	return nil
+}
+
+// assigneeRecipients validates the assignee against the project and returns the users
+// to notify about the assignment along with the assigned team name.
+func (s *Service) assigneeRecipients(
+	ctx context.Context,
+	project domain.Project,
+	assignee domain.IssueAssignee,
+) ([]domain.UserID, string, error) {
+	switch {
+	case assignee.UserID != nil:
+		user, err := s.usersRepo.GetByID(ctx, *assignee.UserID)
+		if err != nil {
+			if errors.Is(err, domain.ErrEntityNotFound) {
+				return nil, "", fmt.Errorf("%w: user not found", domain.ErrInvalidAssignee)
+			}
+
+			return nil, "", fmt.Errorf("get assigned user: %w", err)
+		}
+
+		if project.TeamID != nil && !user.IsSuperuser {
+			members, err := s.teamsRepo.GetMembers(ctx, *project.TeamID)
+			if err != nil {
+				return nil, "", fmt.Errorf("get project team members: %w", err)
+			}
+
+			if !isTeamMember(members, user.ID) {
+				return nil, "", fmt.Errorf("%w: user has no access to the project", domain.ErrInvalidAssignee)
+			}
+		}
+
+		return []domain.UserID{user.ID}, "", nil
+	case assignee.TeamID != nil:
+		if project.TeamID != nil && *project.TeamID != *assignee.TeamID {
+			return nil, "", fmt.Errorf("%w: team has no access to the project", domain.ErrInvalidAssignee)
+		}
+
+		team, err := s.teamsRepo.GetByID(ctx, *assignee.TeamID)
+		if err != nil {
+			if errors.Is(err, domain.ErrEntityNotFound) {
+				return nil, "", fmt.Errorf("%w: team not found", domain.ErrInvalidAssignee)
+			}
+
+			return nil, "", fmt.Errorf("get assigned team: %w", err)
+		}
+
+		members, err := s.teamsRepo.GetMembers(ctx, team.ID)
+		if err != nil {
+			return nil, "", fmt.Errorf("get assigned team members: %w", err)
+		}
+
+		recipients := make([]domain.UserID, 0, len(members))
+		for _, member := range members {
+			recipients = append(recipients, member.UserID)
+		}
+
+		return recipients, team.Name, nil
+	default:
+		return nil, "", nil
+	}
+}
+
+// assigneeName returns the username of the assigned user or the name of the assigned team.
+func (s *Service) assigneeName(ctx context.Context, assignee domain.IssueAssignee) (string, error) {
+	switch {
+	case assignee.UserID != nil:
+		user, err := s.usersRepo.GetByID(ctx, *assignee.UserID)
+		if err != nil {
+			return "", fmt.Errorf("get assigned user: %w", err)
+		}
+
+		return user.Username, nil
+	case assignee.TeamID != nil:
+		team, err := s.teamsRepo.GetByID(ctx, *assignee.TeamID)
+		if err != nil {
+			return "", fmt.Errorf("get assigned team: %w", err)
+		}
+
+		return team.Name, nil
+	default:
+		return "", nil
+	}
+}
+
+func isTeamMember(members []domain.TeamMember, userID domain.UserID) bool {
+	for _, member := range members {
+		if member.UserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
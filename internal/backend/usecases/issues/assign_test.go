@@ -0,0 +1,134 @@
+package issues
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func (m serviceMocks) expectAssignActivity(assignee domain.IssueAssignee) {
+	userID := domain.UserID(1)
+	m.activitiesRepo.EXPECT().Create(mock.Anything, domain.IssueActivityDTO{
+		IssueID: 10,
+		Type:    domain.IssueActivityAssigned,
+		UserID:  &userID,
+		Data:    domain.IssueActivityData{AssignedUserID: assignee.UserID, AssignedTeamID: assignee.TeamID},
+	}).Return(nil)
+}
+
+func TestService_Assign(t *testing.T) {
+	t.Parallel()
+
+	ctx := wardencontext.WithUserID(context.Background(), 1)
+	projectTeamID := domain.TeamID(3)
+
+	t.Run("assign user", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, &projectTeamID)
+		userID := domain.UserID(2)
+		assignee := domain.IssueAssignee{UserID: &userID}
+
+		m.usersRepo.EXPECT().GetByID(mock.Anything, userID).Return(domain.User{ID: 2, Username: "bob"}, nil)
+		m.teamsRepo.EXPECT().GetMembers(mock.Anything, projectTeamID).
+			Return([]domain.TeamMember{{TeamID: 3, UserID: 1}, {TeamID: 3, UserID: 2}}, nil)
+		m.runTx()
+		m.issuesRepo.EXPECT().UpdateAssignee(mock.Anything, domain.IssueID(10), assignee).Return(nil)
+		m.expectAssignActivity(assignee)
+		m.userNotifications.EXPECT().CreateNotification(
+			mock.Anything,
+			userID,
+			domain.UserNotificationTypeIssueAssigned,
+			domain.UserNotificationContent{IssueAssigned: &domain.IssueAssignedContent{
+				IssueID:            10,
+				IssueTitle:         "panic",
+				ProjectID:          5,
+				ProjectName:        "api",
+				AssignedByUserID:   1,
+				AssignedByUsername: "alice",
+			}},
+		).Return(nil)
+
+		require.NoError(t, service.Assign(ctx, 10, assignee))
+	})
+
+	t.Run("assign team notifies members except the current user", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, &projectTeamID)
+		assignee := domain.IssueAssignee{TeamID: &projectTeamID}
+
+		m.teamsRepo.EXPECT().GetByID(mock.Anything, projectTeamID).Return(domain.Team{ID: 3, Name: "backend"}, nil)
+		m.teamsRepo.EXPECT().GetMembers(mock.Anything, projectTeamID).
+			Return([]domain.TeamMember{{TeamID: 3, UserID: 1}, {TeamID: 3, UserID: 4}}, nil)
+		m.runTx()
+		m.issuesRepo.EXPECT().UpdateAssignee(mock.Anything, domain.IssueID(10), assignee).Return(nil)
+		m.expectAssignActivity(assignee)
+		m.userNotifications.EXPECT().CreateNotification(
+			mock.Anything,
+			domain.UserID(4),
+			domain.UserNotificationTypeIssueAssigned,
+			mock.MatchedBy(func(content domain.UserNotificationContent) bool {
+				return content.IssueAssigned != nil && content.IssueAssigned.TeamName == "backend"
+			}),
+		).Return(nil).Once()
+
+		require.NoError(t, service.Assign(ctx, 10, assignee))
+	})
+
+	t.Run("unassign", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+		m.runTx()
+		m.issuesRepo.EXPECT().UpdateAssignee(mock.Anything, domain.IssueID(10), domain.IssueAssignee{}).Return(nil)
+		m.expectAssignActivity(domain.IssueAssignee{})
+
+		require.NoError(t, service.Assign(ctx, 10, domain.IssueAssignee{}))
+	})
+
+	t.Run("user without project access", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, &projectTeamID)
+		userID := domain.UserID(2)
+
+		m.usersRepo.EXPECT().GetByID(mock.Anything, userID).Return(domain.User{ID: 2}, nil)
+		m.teamsRepo.EXPECT().GetMembers(mock.Anything, projectTeamID).
+			Return([]domain.TeamMember{{TeamID: 3, UserID: 1}}, nil)
+
+		err := service.Assign(ctx, 10, domain.IssueAssignee{UserID: &userID})
+		require.ErrorIs(t, err, domain.ErrInvalidAssignee)
+	})
+
+	t.Run("team of another project", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, &projectTeamID)
+		teamID := domain.TeamID(8)
+
+		err := service.Assign(ctx, 10, domain.IssueAssignee{TeamID: &teamID})
+		require.ErrorIs(t, err, domain.ErrInvalidAssignee)
+	})
+
+	t.Run("user and team", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+		userID := domain.UserID(2)
+
+		err := service.Assign(ctx, 10, domain.IssueAssignee{UserID: &userID, TeamID: &projectTeamID})
+		require.ErrorIs(t, err, domain.ErrInvalidAssignee)
+	})
+}
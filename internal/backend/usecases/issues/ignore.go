@@ -0,0 +1,51 @@
+package issues
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// Ignore ignores the issue until one of the conditions is met, the scheduler then unignores it
+// and marks it as escalating. Empty conditions ignore the issue until its status is changed.
+func (s *Service) Ignore(ctx context.Context, id domain.IssueID, conditions domain.IssueIgnoreConditions) error {
+	if conditions.IsEmpty() {
+		return s.changeStatus(ctx, id, domain.IssueStatusIgnored, nil, nil)
+	}
+
+	if err := validateIgnoreConditions(conditions); err != nil {
+		return err
+	}
+
+	if conditions.For != nil {
+		until := time.Now().Add(*conditions.For)
+		conditions.Until = &until
+		conditions.For = nil
+	}
+
+	return s.changeStatus(ctx, id, domain.IssueStatusIgnored, &conditions, nil)
+}
+
+func validateIgnoreConditions(conditions domain.IssueIgnoreConditions) error {
+	if conditions.Until != nil && conditions.For != nil {
+		return fmt.Errorf("%w: either until or a duration can be set", domain.ErrInvalidIgnoreConditions)
+	}
+
+	if conditions.Until != nil && !conditions.Until.After(time.Now()) {
+		return fmt.Errorf("%w: until must be in the future", domain.ErrInvalidIgnoreConditions)
+	}
+
+	if conditions.For != nil && *conditions.For <= 0 {
+		return fmt.Errorf("%w: duration must be positive", domain.ErrInvalidIgnoreConditions)
+	}
+
+	for _, value := range []*uint{conditions.Count, conditions.UserCount, conditions.RatePerHour} {
+		if value != nil && *value == 0 {
+			return fmt.Errorf("%w: thresholds must be positive", domain.ErrInvalidIgnoreConditions)
+		}
+	}
+
+	return nil
+}
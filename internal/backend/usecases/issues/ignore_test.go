@@ -0,0 +1,96 @@
+package issues
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func (m serviceMocks) expectStatusChange(status domain.IssueStatus) {
+	m.runTx()
+	m.resolutionsRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto domain.ResolutionDTO) bool {
+		return dto.IssueID == 10 && dto.Status == status
+	})).Return(domain.Resolution{}, nil)
+	m.issuesRepo.EXPECT().UpdateStatus(mock.Anything, domain.IssueID(10), status).Return(nil)
+	m.activitiesRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dto domain.IssueActivityDTO) bool {
+		return dto.Type == domain.IssueActivityStatusChanged && dto.Data.Status == status
+	})).Return(nil)
+}
+
+func TestService_Ignore(t *testing.T) {
+	t.Parallel()
+
+	ctx := wardencontext.WithUserID(context.Background(), 1)
+
+	t.Run("for hours is saved as until", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+		duration := 2 * time.Hour
+		count := uint(100)
+		userID := domain.UserID(1)
+
+		m.expectStatusChange(domain.IssueStatusIgnored)
+		m.snoozesRepo.EXPECT().
+			Upsert(mock.Anything, domain.IssueID(10), mock.MatchedBy(func(c domain.IssueIgnoreConditions) bool {
+				return c.For == nil && c.Until != nil &&
+					time.Until(*c.Until) > time.Hour && *c.Count == 100
+			}), &userID).
+			Return(nil)
+
+		err := service.Ignore(ctx, 10, domain.IssueIgnoreConditions{For: &duration, Count: &count})
+		require.NoError(t, err)
+	})
+
+	t.Run("without conditions", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+		m.expectStatusChange(domain.IssueStatusIgnored)
+
+		require.NoError(t, service.Ignore(ctx, 10, domain.IssueIgnoreConditions{}))
+	})
+
+	t.Run("invalid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		past := time.Now().Add(-time.Hour)
+		future := time.Now().Add(time.Hour)
+		duration := time.Hour
+		zero := uint(0)
+
+		for _, conditions := range []domain.IssueIgnoreConditions{
+			{Until: &past},
+			{Until: &future, For: &duration},
+			{RatePerHour: &zero},
+		} {
+			service, m := newTestService(t)
+			m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+			err := service.Ignore(ctx, 10, conditions)
+			require.ErrorIs(t, err, domain.ErrInvalidIgnoreConditions)
+		}
+	})
+}
+
+func TestService_ChangeStatus_DeletesIgnoreConditions(t *testing.T) {
+	t.Parallel()
+
+	ctx := wardencontext.WithUserID(context.Background(), 1)
+	service, m := newTestService(t)
+	m.expectIssue(domain.IssueStatusIgnored, nil)
+
+	m.expectStatusChange(domain.IssueStatusUnresolved)
+	m.snoozesRepo.EXPECT().Delete(mock.Anything, domain.IssueID(10)).Return(nil)
+
+	require.NoError(t, service.ChangeStatus(ctx, 10, domain.IssueStatusUnresolved))
+}
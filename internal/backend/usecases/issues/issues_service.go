@@ -12,7 +12,11 @@ import (
 	"github.com/rom8726/warden/pkg/db"
 )
 
-const eventsLimitForIssue = 10
+const (
+	eventsLimitForIssue = 10
+	// maxSearchEventGroups limits the number of event groups the issues are searched by.
+	maxSearchEventGroups = 10000
+)
 
 type Service struct {
 	txManager                db.TxManager
@@ -24,6 +28,13 @@ type Service struct {
 	usersRepo                contract.UsersRepository
 	teamsRepo                contract.TeamsRepository
 	userNotificationsUseCase contract.UserNotificationsUseCase
+	issueReleasesRepo        contract.IssueReleasesRepository
+	issueFingerprintsRepo    contract.IssueFingerprintsRepository
+	releaseRepo              contract.ReleaseRepository
+	attachmentsRepo          contract.AttachmentsRepository
+	issueActivitiesRepo      contract.IssueActivitiesRepository
+	issueCommentsRepo        contract.IssueCommentsRepository
+	issueSnoozesRepo         contract.IssueSnoozesRepository
 }
 
 func New(
@@ -36,6 +47,13 @@ func New(
 	usersRepo contract.UsersRepository,
 	teamsRepo contract.TeamsRepository,
 	userNotificationsUseCase contract.UserNotificationsUseCase,
+	issueReleasesRepo contract.IssueReleasesRepository,
+	issueFingerprintsRepo contract.IssueFingerprintsRepository,
+	releaseRepo contract.ReleaseRepository,
+	attachmentsRepo contract.AttachmentsRepository,
+	issueActivitiesRepo contract.IssueActivitiesRepository,
+	issueCommentsRepo contract.IssueCommentsRepository,
+	issueSnoozesRepo contract.IssueSnoozesRepository,
 ) *Service {
 	return &Service{
 		txManager:                txManager,
@@ -47,11 +65,94 @@ func New(
 		usersRepo:                usersRepo,
 		teamsRepo:                teamsRepo,
 		userNotificationsUseCase: userNotificationsUseCase,
+		issueReleasesRepo:        issueReleasesRepo,
+		issueFingerprintsRepo:    issueFingerprintsRepo,
+		releaseRepo:              releaseRepo,
+		attachmentsRepo:          attachmentsRepo,
+		issueActivitiesRepo:      issueActivitiesRepo,
+		issueCommentsRepo:        issueCommentsRepo,
+		issueSnoozesRepo:         issueSnoozesRepo,
 	}
 }
 
-func (s *Service) List(ctx context.Context, filter *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, error) {
-	return s.issuesRepo.ListExtended(ctx, filter)
+func (s *Service) List(
+	ctx context.Context,
+	filter *domain.ListIssuesFilter,
+) ([]domain.IssueExtended, uint64, bool, error) {
+	if len(filter.Query) == 0 {
+		issues, total, err := s.issuesRepo.ListExtended(ctx, filter)
+
+		return issues, total, false, err
+	}
+
+	groups, err := s.searchEventGroups(ctx, filter)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	if len(groups) == 0 {
+		return nil, 0, false, nil
+	}
+
+	// One group more than searched by tells the search matched more groups than returned
+	truncated := len(groups) > maxSearchEventGroups
+	if truncated {
+		groups = groups[:maxSearchEventGroups]
+	}
+
+	searchFilter := *filter
+	searchFilter.EventGroups = groups
+
+	issues, total, err := s.issuesRepo.ListExtended(ctx, &searchFilter)
+
+	return issues, total, truncated, err
+}
+
+// searchEventGroups returns the event groups of the accessible projects with events matching the query,
+// the last DefaultSearchPeriod is searched when no time range is given. At most maxSearchEventGroups+1
+// groups are returned.
+func (s *Service) searchEventGroups(
+	ctx context.Context,
+	filter *domain.ListIssuesFilter,
+) ([]domain.EventGroup, error) {
+	var projectIDs []domain.ProjectID
+	if filter.ProjectID != nil {
+		projectIDs = []domain.ProjectID{*filter.ProjectID}
+	} else {
+		projects, err := s.projectsService.GetProjectsByUserID(
+			ctx,
+			wardencontext.UserID(ctx),
+			wardencontext.IsSuper(ctx),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get user projects: %w", err)
+		}
+
+		for _, project := range projects {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	timeTo := filter.TimeTo
+	if timeTo.IsZero() {
+		timeTo = time.Now()
+	}
+
+	timeFrom := filter.TimeFrom
+	if timeFrom.IsZero() {
+		timeFrom = timeTo.Add(-domain.DefaultSearchPeriod)
+	}
+
+	groups, err := s.eventsRepo.SearchGroups(ctx, projectIDs, filter.Query, timeFrom, timeTo, maxSearchEventGroups+1)
+	if err != nil {
+		return nil, fmt.Errorf("search event groups: %w", err)
+	}
+
+	return groups, nil
 }
 
 func (s *Service) RecentIssues(ctx context.Context, limit uint) ([]domain.IssueExtended, error) {
@@ -95,15 +196,50 @@ func (s *Service) GetByIDWithChildren(
 		return domain.IssueExtendedWithChildren{}, fmt.Errorf("get project by ID: %w", err)
 	}
 
-	events, err := s.eventsRepo.FetchForIssue(ctx, project.ID, issue.Fingerprint, eventsLimitForIssue)
+	issue.Assignee.Name, err = s.assigneeName(ctx, issue.Assignee)
+	if err != nil {
+		return domain.IssueExtendedWithChildren{}, fmt.Errorf("get issue assignee: %w", err)
+	}
+
+	merged, err := s.issueFingerprintsRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return domain.IssueExtendedWithChildren{}, fmt.Errorf("list merged fingerprints: %w", err)
+	}
+
+	fingerprints := append([]string{issue.Fingerprint}, merged...)
+	events, err := s.eventsRepo.FetchForIssue(ctx, project.ID, fingerprints, eventsLimitForIssue)
 	if err != nil {
 		return domain.IssueExtendedWithChildren{}, fmt.Errorf("fetch events for issue: %w", err)
 	}
 
+	eventIDs := make([]domain.EventID, 0, len(events))
+	for i := range events {
+		eventIDs = append(eventIDs, events[i].ID)
+	}
+
+	attachments, err := s.attachmentsRepo.ListByEventIDs(ctx, project.ID, eventIDs)
+	if err != nil {
+		return domain.IssueExtendedWithChildren{}, fmt.Errorf("list event attachments: %w", err)
+	}
+
+	var ignoreConditions *domain.IssueIgnoreConditions
+	if issue.Status == domain.IssueStatusIgnored {
+		snooze, err := s.issueSnoozesRepo.GetByIssue(ctx, issue.ID)
+		switch {
+		case err == nil:
+			ignoreConditions = &snooze.Conditions
+		case !errors.Is(err, domain.ErrEntityNotFound):
+			return domain.IssueExtendedWithChildren{}, fmt.Errorf("get ignore conditions: %w", err)
+		}
+	}
+
 	return domain.IssueExtendedWithChildren{
-		Issue:       issue,
-		ProjectName: project.Name,
-		Events:      events,
+		Issue:              issue,
+		ProjectName:        project.Name,
+		Events:             events,
+		MergedFingerprints: merged,
+		Attachments:        attachments,
+		IgnoreConditions:   ignoreConditions,
 	}, nil
 }
 
@@ -111,8 +247,21 @@ func (s *Service) Timeseries(ctx context.Context, filter *domain.IssueTimeseries
 	return s.issuesRepo.Timeseries(ctx, filter)
 }
 
-//nolint:gocyclo,nestif // need refactoring
 func (s *Service) ChangeStatus(ctx context.Context, id domain.IssueID, status domain.IssueStatus) error {
+	return s.changeStatus(ctx, id, status, nil, nil)
+}
+
+// changeStatus changes the issue status, the ignore conditions replace the ones of an ignored issue
+// and the release bounds the resolution of a resolved one.
+//
+//nolint:gocyclo,nestif // need refactoring
+func (s *Service) changeStatus(
+	ctx context.Context,
+	id domain.IssueID,
+	status domain.IssueStatus,
+	ignoreConditions *domain.IssueIgnoreConditions,
+	resolvedInRelease *domain.IssueResolvedInRelease,
+) error {
 	currentUserID := wardencontext.UserID(ctx)
 	user, err := s.usersRepo.GetByID(ctx, currentUserID)
 	if err != nil {
@@ -152,7 +301,7 @@ func (s *Service) ChangeStatus(ctx context.Context, id domain.IssueID, status do
 	// Check if this is a regression (resolved -> unresolved)
 	isRegression := issue.Status == domain.IssueStatusResolved && status == domain.IssueStatusUnresolved
 
-	err = s.txManager.RepeatableRead(ctx, func(ctx context.Context) error {
+	err = db.RepeatableReadOrJoin(ctx, s.txManager, func(ctx context.Context) error {
 		// Create a resolution record
 		resolutionDTO := domain.ResolutionDTO{
 			ProjectID:  issue.ProjectID,
@@ -173,6 +322,31 @@ func (s *Service) ChangeStatus(ctx context.Context, id domain.IssueID, status do
 			return fmt.Errorf("update issue status: %w", err)
 		}
 
+		if resolvedInRelease != nil {
+			if err := s.issuesRepo.SetResolvedInRelease(ctx, id, *resolvedInRelease); err != nil {
+				return fmt.Errorf("set resolved in release: %w", err)
+			}
+		}
+
+		err = s.addActivity(ctx, id, currentUserID, domain.IssueActivityStatusChanged, domain.IssueActivityData{
+			Status: status,
+		})
+		if err != nil {
+			return fmt.Errorf("add issue activity: %w", err)
+		}
+
+		switch {
+		case ignoreConditions != nil:
+			err = s.issueSnoozesRepo.Upsert(ctx, id, *ignoreConditions, &currentUserID)
+			if err != nil {
+				return fmt.Errorf("save ignore conditions: %w", err)
+			}
+		case issue.Status == domain.IssueStatusIgnored:
+			if err := s.issueSnoozesRepo.Delete(ctx, id); err != nil {
+				return fmt.Errorf("delete ignore conditions: %w", err)
+			}
+		}
+
 		// Create a notification for regression if applicable
 		if isRegression {
 			// Get team members for the project to notify them about regression
@@ -252,3 +426,19 @@ func (s *Service) getTeamMembersForProject(ctx context.Context, project domain.P
 
 	return teamMembers, nil
 }
+
+// addActivity adds an activity of the user to the issue activity feed.
+func (s *Service) addActivity(
+	ctx context.Context,
+	issueID domain.IssueID,
+	userID domain.UserID,
+	activityType domain.IssueActivityType,
+	data domain.IssueActivityData,
+) error {
+	return s.issueActivitiesRepo.Create(ctx, domain.IssueActivityDTO{
+		IssueID: issueID,
+		Type:    activityType,
+		UserID:  &userID,
+		Data:    data,
+	})
+}
@@ -3,6 +3,7 @@ package issues
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -28,6 +29,10 @@ func TestNew(t *testing.T) {
 	mockUsersRepo := mockcontract.NewMockUsersRepository(t)
 	mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
 	mockUserNotificationsUseCase := mockcontract.NewMockUserNotificationsUseCase(t)
+	mockIssueReleasesRepo := mockcontract.NewMockIssueReleasesRepository(t)
+	mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+	mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
+	mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
 
 	// Create service
 	service := New(
@@ -40,6 +45,13 @@ func TestNew(t *testing.T) {
 		mockUsersRepo,
 		mockTeamsRepo,
 		mockUserNotificationsUseCase,
+		mockIssueReleasesRepo,
+		mockIssueFingerprintsRepo,
+		mockReleaseRepo,
+		mockAttachmentsRepo,
+		mockcontract.NewMockIssueActivitiesRepository(t),
+		mockcontract.NewMockIssueCommentsRepository(t),
+		mockcontract.NewMockIssueSnoozesRepository(t),
 	)
 
 	// Verify service was created correctly
@@ -142,6 +154,10 @@ func TestList(t *testing.T) {
 			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
 			mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
 			mockUserNotificationsUseCase := mockcontract.NewMockUserNotificationsUseCase(t)
+			mockIssueReleasesRepo := mockcontract.NewMockIssueReleasesRepository(t)
+			mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+			mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
+			mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockIssuesRepo)
@@ -157,10 +173,17 @@ func TestList(t *testing.T) {
 				mockUsersRepo,
 				mockTeamsRepo,
 				mockUserNotificationsUseCase,
+				mockIssueReleasesRepo,
+				mockIssueFingerprintsRepo,
+				mockReleaseRepo,
+				mockAttachmentsRepo,
+				mockcontract.NewMockIssueActivitiesRepository(t),
+				mockcontract.NewMockIssueCommentsRepository(t),
+				mockcontract.NewMockIssueSnoozesRepository(t),
 			)
 
 			// Call the method
-			issues, count, err := service.List(context.Background(), tt.filter)
+			issues, count, truncated, err := service.List(context.Background(), tt.filter)
 
 			// Check results
 			if tt.expectedError {
@@ -175,10 +198,119 @@ func TestList(t *testing.T) {
 				require.Equal(t, tt.expectedIssues, issues)
 				require.Equal(t, tt.expectedCount, count)
 			}
+			require.False(t, truncated)
 		})
 	}
 }
 
+func TestList_Query(t *testing.T) {
+	t.Parallel()
+
+	query := domain.SearchQuery{{Key: "environment", Values: []string{"production"}}}
+
+	t.Run("issues of matching event groups", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+
+		ctx := wardencontext.WithUserID(context.Background(), 7)
+		groups := []domain.EventGroup{{ProjectID: 1, GroupHash: "fp1"}, {ProjectID: 2, GroupHash: "fp2"}}
+
+		m.projectsService.EXPECT().GetProjectsByUserID(mock.Anything, domain.UserID(7), false).
+			Return([]domain.ProjectExtended{{Project: domain.Project{ID: 1}}, {Project: domain.Project{ID: 2}}}, nil)
+		m.eventsRepo.EXPECT().SearchGroups(
+			mock.Anything,
+			[]domain.ProjectID{1, 2},
+			query,
+			mock.AnythingOfType("time.Time"),
+			mock.AnythingOfType("time.Time"),
+			uint(maxSearchEventGroups+1),
+		).RunAndReturn(func(
+			_ context.Context,
+			_ []domain.ProjectID,
+			_ domain.SearchQuery,
+			timeFrom time.Time,
+			timeTo time.Time,
+			_ uint,
+		) ([]domain.EventGroup, error) {
+			require.Equal(t, domain.DefaultSearchPeriod, timeTo.Sub(timeFrom))
+
+			return groups, nil
+		})
+		m.issuesRepo.EXPECT().ListExtended(mock.Anything, &domain.ListIssuesFilter{
+			Query:       query,
+			EventGroups: groups,
+			PageNum:     1,
+			PerPage:     10,
+		}).Return([]domain.IssueExtended{{Issue: domain.Issue{ID: 1}}}, uint64(1), nil)
+
+		issues, count, truncated, err := service.List(ctx, &domain.ListIssuesFilter{Query: query, PageNum: 1, PerPage: 10})
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		require.Equal(t, uint64(1), count)
+		require.False(t, truncated)
+	})
+
+	t.Run("too many matching event groups", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+
+		projectID := domain.ProjectID(1)
+		groups := make([]domain.EventGroup, 0, maxSearchEventGroups+1)
+		for i := range maxSearchEventGroups + 1 {
+			groups = append(groups, domain.EventGroup{ProjectID: projectID, GroupHash: fmt.Sprintf("fp%d", i)})
+		}
+
+		m.eventsRepo.EXPECT().SearchGroups(
+			mock.Anything,
+			[]domain.ProjectID{1},
+			query,
+			mock.AnythingOfType("time.Time"),
+			mock.AnythingOfType("time.Time"),
+			uint(maxSearchEventGroups+1),
+		).Return(groups, nil)
+		m.issuesRepo.EXPECT().ListExtended(mock.Anything, mock.MatchedBy(func(filter *domain.ListIssuesFilter) bool {
+			return len(filter.EventGroups) == maxSearchEventGroups
+		})).Return([]domain.IssueExtended{{Issue: domain.Issue{ID: 1}}}, uint64(1), nil)
+
+		issues, count, truncated, err := service.List(context.Background(), &domain.ListIssuesFilter{
+			ProjectID: &projectID,
+			Query:     query,
+		})
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		require.Equal(t, uint64(1), count)
+		require.True(t, truncated)
+	})
+
+	t.Run("no matching events", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+
+		projectID := domain.ProjectID(1)
+
+		m.eventsRepo.EXPECT().SearchGroups(
+			mock.Anything,
+			[]domain.ProjectID{1},
+			query,
+			mock.AnythingOfType("time.Time"),
+			mock.AnythingOfType("time.Time"),
+			uint(maxSearchEventGroups+1),
+		).Return(nil, nil)
+
+		issues, count, truncated, err := service.List(context.Background(), &domain.ListIssuesFilter{
+			ProjectID: &projectID,
+			Query:     query,
+		})
+		require.NoError(t, err)
+		require.Empty(t, issues)
+		require.Zero(t, count)
+		require.False(t, truncated)
+	})
+}
+
 func TestRecentIssues(t *testing.T) {
 	t.Parallel()
 
@@ -245,6 +377,10 @@ func TestRecentIssues(t *testing.T) {
 			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
 			mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
 			mockUserNotificationsUseCase := mockcontract.NewMockUserNotificationsUseCase(t)
+			mockIssueReleasesRepo := mockcontract.NewMockIssueReleasesRepository(t)
+			mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+			mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
+			mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockIssuesRepo)
@@ -260,6 +396,13 @@ func TestRecentIssues(t *testing.T) {
 				mockUsersRepo,
 				mockTeamsRepo,
 				mockUserNotificationsUseCase,
+				mockIssueReleasesRepo,
+				mockIssueFingerprintsRepo,
+				mockReleaseRepo,
+				mockAttachmentsRepo,
+				mockcontract.NewMockIssueActivitiesRepository(t),
+				mockcontract.NewMockIssueCommentsRepository(t),
+				mockcontract.NewMockIssueSnoozesRepository(t),
 			)
 
 			// Call the method
@@ -344,7 +487,8 @@ func TestGetByIDWithChildren(t *testing.T) {
 					}, nil)
 
 				// Setup events repo
-				mockEventsRepo.EXPECT().FetchForIssue(mock.Anything, domain.ProjectID(1), "test-fingerprint", uint(10)).
+				mockEventsRepo.EXPECT().
+					FetchForIssue(mock.Anything, domain.ProjectID(1), []string{"test-fingerprint"}, uint(10)).
 					Return([]domain.Event{
 						{
 							ID:        "event-1",
@@ -581,7 +725,8 @@ func TestGetByIDWithChildren(t *testing.T) {
 						Name: "Test Project",
 					}, nil)
 
-				mockEventsRepo.EXPECT().FetchForIssue(mock.Anything, domain.ProjectID(1), "test-fingerprint", uint(10)).
+				mockEventsRepo.EXPECT().
+					FetchForIssue(mock.Anything, domain.ProjectID(1), []string{"test-fingerprint"}, uint(10)).
 					Return(nil, errors.New("error fetching events"))
 			},
 			setupContext: func(ctx context.Context) context.Context {
@@ -607,6 +752,13 @@ func TestGetByIDWithChildren(t *testing.T) {
 			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
 			mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
 			mockUserNotificationsUseCase := mockcontract.NewMockUserNotificationsUseCase(t)
+			mockIssueReleasesRepo := mockcontract.NewMockIssueReleasesRepository(t)
+			mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+			mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
+			mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
+
+			mockIssueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+			mockAttachmentsRepo.EXPECT().ListByEventIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
 			// Setup mocks
 			tt.setupMocks(
@@ -628,6 +780,13 @@ func TestGetByIDWithChildren(t *testing.T) {
 				mockUsersRepo,
 				mockTeamsRepo,
 				mockUserNotificationsUseCase,
+				mockIssueReleasesRepo,
+				mockIssueFingerprintsRepo,
+				mockReleaseRepo,
+				mockAttachmentsRepo,
+				mockcontract.NewMockIssueActivitiesRepository(t),
+				mockcontract.NewMockIssueCommentsRepository(t),
+				mockcontract.NewMockIssueSnoozesRepository(t),
 			)
 
 			// Setup context
@@ -737,6 +896,10 @@ func TestTimeseries(t *testing.T) {
 			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
 			mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
 			mockUserNotificationsUseCase := mockcontract.NewMockUserNotificationsUseCase(t)
+			mockIssueReleasesRepo := mockcontract.NewMockIssueReleasesRepository(t)
+			mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+			mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
+			mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockIssuesRepo)
@@ -752,6 +915,13 @@ func TestTimeseries(t *testing.T) {
 				mockUsersRepo,
 				mockTeamsRepo,
 				mockUserNotificationsUseCase,
+				mockIssueReleasesRepo,
+				mockIssueFingerprintsRepo,
+				mockReleaseRepo,
+				mockAttachmentsRepo,
+				mockcontract.NewMockIssueActivitiesRepository(t),
+				mockcontract.NewMockIssueCommentsRepository(t),
+				mockcontract.NewMockIssueSnoozesRepository(t),
 			)
 
 			// Call the method
@@ -1194,6 +1364,11 @@ func TestChangeStatus(t *testing.T) {
 			mockUsersRepo := mockcontract.NewMockUsersRepository(t)
 			mockTeamsRepo := mockcontract.NewMockTeamsRepository(t)
 			mockUserNotificationsUseCase := mockcontract.NewMockUserNotificationsUseCase(t)
+			mockIssueReleasesRepo := mockcontract.NewMockIssueReleasesRepository(t)
+			mockIssueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+			mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
+			mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
+			mockIssueActivitiesRepo := mockcontract.NewMockIssueActivitiesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(
@@ -1204,6 +1379,13 @@ func TestChangeStatus(t *testing.T) {
 				mockResolutionsRepo,
 				mockProjectsRepo,
 			)
+			userID := domain.UserID(123)
+			mockIssueActivitiesRepo.EXPECT().Create(mock.Anything, domain.IssueActivityDTO{
+				IssueID: tt.issueID,
+				Type:    domain.IssueActivityStatusChanged,
+				UserID:  &userID,
+				Data:    domain.IssueActivityData{Status: tt.status},
+			}).Return(nil).Maybe()
 
 			// Create service
 			service := New(
@@ -1216,6 +1398,13 @@ func TestChangeStatus(t *testing.T) {
 				mockUsersRepo,
 				mockTeamsRepo,
 				mockUserNotificationsUseCase,
+				mockIssueReleasesRepo,
+				mockIssueFingerprintsRepo,
+				mockReleaseRepo,
+				mockAttachmentsRepo,
+				mockIssueActivitiesRepo,
+				mockcontract.NewMockIssueCommentsRepository(t),
+				mockcontract.NewMockIssueSnoozesRepository(t),
 			)
 
 			// Setup context
@@ -1236,3 +1425,87 @@ func TestChangeStatus(t *testing.T) {
 		})
 	}
 }
+
+// serviceMocks holds the mocked dependencies of the service created by newTestService.
+type serviceMocks struct {
+	txManager             *mockdb.MockTxManager
+	issuesRepo            *mockcontract.MockIssuesRepository
+	projectsRepo          *mockcontract.MockProjectsRepository
+	eventsRepo            *mockcontract.MockEventRepository
+	projectsService       *mockcontract.MockProjectsUseCase
+	resolutionsRepo       *mockcontract.MockResolutionsRepository
+	usersRepo             *mockcontract.MockUsersRepository
+	teamsRepo             *mockcontract.MockTeamsRepository
+	userNotifications     *mockcontract.MockUserNotificationsUseCase
+	issueReleasesRepo     *mockcontract.MockIssueReleasesRepository
+	issueFingerprintsRepo *mockcontract.MockIssueFingerprintsRepository
+	releaseRepo           *mockcontract.MockReleaseRepository
+	attachmentsRepo       *mockcontract.MockAttachmentsRepository
+	activitiesRepo        *mockcontract.MockIssueActivitiesRepository
+	commentsRepo          *mockcontract.MockIssueCommentsRepository
+	snoozesRepo           *mockcontract.MockIssueSnoozesRepository
+}
+
+func newTestService(t *testing.T) (*Service, serviceMocks) {
+	t.Helper()
+
+	m := serviceMocks{
+		txManager:             mockdb.NewMockTxManager(t),
+		issuesRepo:            mockcontract.NewMockIssuesRepository(t),
+		projectsRepo:          mockcontract.NewMockProjectsRepository(t),
+		eventsRepo:            mockcontract.NewMockEventRepository(t),
+		projectsService:       mockcontract.NewMockProjectsUseCase(t),
+		resolutionsRepo:       mockcontract.NewMockResolutionsRepository(t),
+		usersRepo:             mockcontract.NewMockUsersRepository(t),
+		teamsRepo:             mockcontract.NewMockTeamsRepository(t),
+		userNotifications:     mockcontract.NewMockUserNotificationsUseCase(t),
+		issueReleasesRepo:     mockcontract.NewMockIssueReleasesRepository(t),
+		issueFingerprintsRepo: mockcontract.NewMockIssueFingerprintsRepository(t),
+		releaseRepo:           mockcontract.NewMockReleaseRepository(t),
+		attachmentsRepo:       mockcontract.NewMockAttachmentsRepository(t),
+		activitiesRepo:        mockcontract.NewMockIssueActivitiesRepository(t),
+		commentsRepo:          mockcontract.NewMockIssueCommentsRepository(t),
+		snoozesRepo:           mockcontract.NewMockIssueSnoozesRepository(t),
+	}
+
+	service := New(
+		m.txManager,
+		m.issuesRepo,
+		m.projectsRepo,
+		m.eventsRepo,
+		m.projectsService,
+		m.resolutionsRepo,
+		m.usersRepo,
+		m.teamsRepo,
+		m.userNotifications,
+		m.issueReleasesRepo,
+		m.issueFingerprintsRepo,
+		m.releaseRepo,
+		m.attachmentsRepo,
+		m.activitiesRepo,
+		m.commentsRepo,
+		m.snoozesRepo,
+	)
+
+	return service, m
+}
+
+// runTx runs the function passed to the repeatable read transaction.
+func (m serviceMocks) runTx() {
+	m.txManager.EXPECT().RepeatableRead(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+// expectIssue sets up the issue 10 of the project 5 of the team changed by the user 1.
+func (m serviceMocks) expectIssue(status domain.IssueStatus, teamID *domain.TeamID) {
+	m.usersRepo.EXPECT().GetByID(mock.Anything, domain.UserID(1)).
+		Return(domain.User{ID: 1, Username: "alice"}, nil).Maybe()
+	m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(10)).
+		Return(domain.Issue{ID: 10, ProjectID: 5, Title: "panic", Status: status}, nil).Maybe()
+	m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(5)).
+		Return(domain.Project{ID: 5, Name: "api", TeamID: teamID}, nil).Maybe()
+	m.projectsService.EXPECT().GetProjectsByUserID(mock.Anything, domain.UserID(1), false).
+		Return([]domain.ProjectExtended{{Project: domain.Project{ID: 5, Name: "api", TeamID: teamID}}}, nil).Maybe()
+}
@@ -0,0 +1,327 @@
+package issues
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+// Merge moves the given issues into the primary one. Fingerprints of the merged issues are routed
+// to the primary issue, their counters, releases and resolution history are consolidated, and the
+// merged issues are deleted. Stored events keep their fingerprints, so a merge can be undone.
+func (s *Service) Merge(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	primaryID domain.IssueID,
+	issueIDs []domain.IssueID,
+) error {
+	issueIDs = uniqueIssueIDs(issueIDs)
+	if len(issueIDs) == 0 {
+		return fmt.Errorf("%w: no issues to merge", domain.ErrInvalidIssueMerge)
+	}
+
+	if _, err := s.getProjectIssue(ctx, projectID, primaryID); err != nil {
+		return err
+	}
+
+	issues := make([]domain.Issue, 0, len(issueIDs))
+	for _, id := range issueIDs {
+		if id == primaryID {
+			return fmt.Errorf("%w: issue %d cannot be merged into itself", domain.ErrInvalidIssueMerge, id)
+		}
+
+		issue, err := s.getProjectIssue(ctx, projectID, id)
+		if err != nil {
+			return err
+		}
+
+		issues = append(issues, issue)
+	}
+
+	currentUserID := wardencontext.UserID(ctx)
+	err := db.RepeatableReadOrJoin(ctx, s.txManager, func(ctx context.Context) error {
+		// Fingerprints merged into the secondary issues earlier follow them
+		if err := s.issueFingerprintsRepo.Reassign(ctx, issueIDs, primaryID); err != nil {
+			return fmt.Errorf("reassign merged fingerprints: %w", err)
+		}
+
+		for _, issue := range issues {
+			if err := s.issueFingerprintsRepo.Create(ctx, projectID, issue.Fingerprint, primaryID); err != nil {
+				return fmt.Errorf("create merged fingerprint: %w", err)
+			}
+		}
+
+		if err := s.issueReleasesRepo.MoveToIssue(ctx, issueIDs, primaryID); err != nil {
+			return fmt.Errorf("move issue releases: %w", err)
+		}
+
+		if err := s.resolutionsRepo.MoveToIssue(ctx, issueIDs, primaryID); err != nil {
+			return fmt.Errorf("move resolutions: %w", err)
+		}
+
+		if err := s.issueCommentsRepo.MoveToIssue(ctx, issueIDs, primaryID); err != nil {
+			return fmt.Errorf("move issue comments: %w", err)
+		}
+
+		if err := s.issueActivitiesRepo.MoveToIssue(ctx, issueIDs, primaryID); err != nil {
+			return fmt.Errorf("move issue activities: %w", err)
+		}
+
+		if err := s.issuesRepo.MergeInto(ctx, primaryID, issueIDs); err != nil {
+			return fmt.Errorf("merge issues: %w", err)
+		}
+
+		err := s.addActivity(ctx, primaryID, currentUserID, domain.IssueActivityMerged, domain.IssueActivityData{
+			IssueIDs: issueIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("add issue activity: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("merge issues: %w", err)
+	}
+
+	slog.Info("issues merged", "project_id", projectID, "primary_issue_id", primaryID, "issue_ids", issueIDs)
+
+	return nil
+}
+
+// Unmerge splits merged fingerprints out of an issue, each into its own issue.
+// Counters and releases of the new issues are rebuilt from the stored events, the issue keeps
+// only the releases the events of its remaining fingerprints were seen in.
+func (s *Service) Unmerge(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.IssueID,
+	fingerprints []string,
+) ([]domain.IssueExtended, error) {
+	if len(fingerprints) == 0 {
+		return nil, fmt.Errorf("%w: no fingerprints to unmerge", domain.ErrInvalidIssueMerge)
+	}
+
+	issue, err := s.getProjectIssue(ctx, projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectsRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("get project by ID: %w", err)
+	}
+
+	currentUserID := wardencontext.UserID(ctx)
+	result := make([]domain.IssueExtended, 0, len(fingerprints))
+	err = db.RepeatableReadOrJoin(ctx, s.txManager, func(ctx context.Context) error {
+		var splitReleases []string
+		for _, fingerprint := range fingerprints {
+			newIssue, releases, err := s.splitFingerprint(ctx, issue, fingerprint)
+			if err != nil {
+				return err
+			}
+
+			splitReleases = append(splitReleases, releases...)
+			result = append(result, domain.IssueExtended{Issue: newIssue, ProjectName: project.Name})
+		}
+
+		if err := s.refreshStats(ctx, issue, splitReleases); err != nil {
+			return err
+		}
+
+		err := s.addActivity(ctx, id, currentUserID, domain.IssueActivityUnmerged, domain.IssueActivityData{
+			Fingerprints: fingerprints,
+		})
+		if err != nil {
+			return fmt.Errorf("add issue activity: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unmerge issue: %w", err)
+	}
+
+	slog.Info("issue unmerged", "project_id", projectID, "issue_id", id, "fingerprints", fingerprints)
+
+	return result, nil
+}
+
+// splitFingerprint creates an issue of the fingerprint, the releases its events were seen in are returned.
+func (s *Service) splitFingerprint(
+	ctx context.Context,
+	issue domain.Issue,
+	fingerprint string,
+) (domain.Issue, []string, error) {
+	err := s.issueFingerprintsRepo.Delete(ctx, issue.ID, fingerprint)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return domain.Issue{}, nil, fmt.Errorf("%w: fingerprint %q is not merged into issue %d",
+				domain.ErrInvalidIssueMerge, fingerprint, issue.ID)
+		}
+
+		return domain.Issue{}, nil, fmt.Errorf("delete merged fingerprint: %w", err)
+	}
+
+	stats, err := s.eventsRepo.FingerprintStats(ctx, issue.ProjectID, []string{fingerprint})
+	if err != nil {
+		return domain.Issue{}, nil, fmt.Errorf("get fingerprint stats: %w", err)
+	}
+
+	newIssue := domain.Issue{
+		ProjectID:   issue.ProjectID,
+		Fingerprint: fingerprint,
+		Source:      issue.Source,
+		Status:      issue.Status,
+		Title:       issue.Title,
+		Level:       issue.Level,
+		Platform:    issue.Platform,
+		FirstSeen:   stats.FirstSeen,
+		LastSeen:    stats.LastSeen,
+		TotalEvents: stats.TotalEvents,
+	}
+
+	if stats.TotalEvents == 0 {
+		// Events of the fingerprint are already gone, keep the issue visible anyway
+		now := time.Now()
+		newIssue.FirstSeen, newIssue.LastSeen = now, now
+	}
+
+	events, err := s.eventsRepo.FetchForIssue(ctx, issue.ProjectID, []string{fingerprint}, 1)
+	if err != nil {
+		return domain.Issue{}, nil, fmt.Errorf("fetch latest event: %w", err)
+	}
+
+	if len(events) > 0 {
+		latest := events[0]
+		newIssue.Source = latest.Source
+		newIssue.Title = latest.Message
+		newIssue.Level = latest.Level
+		newIssue.Platform = latest.Platform
+	}
+
+	newIssue.ID, err = s.issuesRepo.Create(ctx, newIssue)
+	if err != nil {
+		return domain.Issue{}, nil, fmt.Errorf("create issue: %w", err)
+	}
+
+	if err := s.issuesRepo.SubtractEvents(ctx, issue.ID, stats.TotalEvents); err != nil {
+		return domain.Issue{}, nil, fmt.Errorf("subtract events: %w", err)
+	}
+
+	for _, version := range stats.Releases {
+		release, err := s.releaseRepo.GetByProjectAndVersion(ctx, issue.ProjectID, version)
+		if err != nil {
+			if errors.Is(err, domain.ErrEntityNotFound) {
+				continue
+			}
+
+			return domain.Issue{}, nil, fmt.Errorf("get release: %w", err)
+		}
+
+		err = s.issueReleasesRepo.Create(ctx, newIssue.ID, release.ID, version == stats.FirstRelease)
+		if err != nil {
+			return domain.Issue{}, nil, fmt.Errorf("create issue release: %w", err)
+		}
+	}
+
+	return newIssue, stats.Releases, nil
+}
+
+// refreshStats recomputes the first and the last seen timestamps of the issue from the events
+// of the fingerprints left in it, and removes the split releases none of those events were seen in.
+func (s *Service) refreshStats(ctx context.Context, issue domain.Issue, splitReleases []string) error {
+	merged, err := s.issueFingerprintsRepo.ListByIssue(ctx, issue.ID)
+	if err != nil {
+		return fmt.Errorf("list merged fingerprints: %w", err)
+	}
+
+	fingerprints := append([]string{issue.Fingerprint}, merged...)
+
+	stats, err := s.eventsRepo.FingerprintStats(ctx, issue.ProjectID, fingerprints)
+	if err != nil {
+		return fmt.Errorf("get fingerprint stats: %w", err)
+	}
+
+	// Events of the issue may be already gone, keep the timestamps then
+	if stats.TotalEvents > 0 {
+		if err := s.issuesRepo.UpdateSeen(ctx, issue.ID, stats.FirstSeen, stats.LastSeen); err != nil {
+			return fmt.Errorf("update seen: %w", err)
+		}
+	}
+
+	var releaseIDs []domain.ReleaseID
+	for _, version := range uniqueStrings(splitReleases) {
+		if slices.Contains(stats.Releases, version) {
+			continue
+		}
+
+		release, err := s.releaseRepo.GetByProjectAndVersion(ctx, issue.ProjectID, version)
+		if err != nil {
+			if errors.Is(err, domain.ErrEntityNotFound) {
+				continue
+			}
+
+			return fmt.Errorf("get release: %w", err)
+		}
+
+		releaseIDs = append(releaseIDs, release.ID)
+	}
+
+	if len(releaseIDs) == 0 {
+		return nil
+	}
+
+	if err := s.issueReleasesRepo.Delete(ctx, issue.ID, releaseIDs); err != nil {
+		return fmt.Errorf("delete issue releases: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) getProjectIssue(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.IssueID,
+) (domain.Issue, error) {
+	issue, err := s.issuesRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Issue{}, fmt.Errorf("get issue %d: %w", id, err)
+	}
+
+	if issue.ProjectID != projectID {
+		return domain.Issue{}, fmt.Errorf("get issue %d: %w", id, domain.ErrEntityNotFound)
+	}
+
+	return issue, nil
+}
+
+func uniqueStrings(values []string) []string {
+	result := slices.Clone(values)
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
+
+func uniqueIssueIDs(ids []domain.IssueID) []domain.IssueID {
+	seen := make(map[domain.IssueID]struct{}, len(ids))
+	result := make([]domain.IssueID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+
+	return result
+}
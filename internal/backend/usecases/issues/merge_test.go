@@ -0,0 +1,154 @@
+package issues
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestService_Merge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+			Return(domain.Issue{ID: 1, ProjectID: 1, Fingerprint: "fp-1"}, nil)
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(2)).
+			Return(domain.Issue{ID: 2, ProjectID: 1, Fingerprint: "fp-2"}, nil)
+		m.runTx()
+
+		secondaries := []domain.IssueID{2}
+		m.issueFingerprintsRepo.EXPECT().Reassign(mock.Anything, secondaries, domain.IssueID(1)).Return(nil)
+		m.issueFingerprintsRepo.EXPECT().Create(mock.Anything, domain.ProjectID(1), "fp-2", domain.IssueID(1)).Return(nil)
+		m.issueReleasesRepo.EXPECT().MoveToIssue(mock.Anything, secondaries, domain.IssueID(1)).Return(nil)
+		m.resolutionsRepo.EXPECT().MoveToIssue(mock.Anything, secondaries, domain.IssueID(1)).Return(nil)
+		m.commentsRepo.EXPECT().MoveToIssue(mock.Anything, secondaries, domain.IssueID(1)).Return(nil)
+		m.activitiesRepo.EXPECT().MoveToIssue(mock.Anything, secondaries, domain.IssueID(1)).Return(nil)
+		m.issuesRepo.EXPECT().MergeInto(mock.Anything, domain.IssueID(1), secondaries).Return(nil)
+		m.activitiesRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(activity domain.IssueActivityDTO) bool {
+			return activity.IssueID == 1 && activity.Type == domain.IssueActivityMerged
+		})).Return(nil)
+
+		err := service.Merge(context.Background(), 1, 1, []domain.IssueID{2, 2})
+		require.NoError(t, err)
+	})
+
+	t.Run("merge into itself", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+			Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+
+		err := service.Merge(context.Background(), 1, 1, []domain.IssueID{1})
+		require.ErrorIs(t, err, domain.ErrInvalidIssueMerge)
+	})
+
+	t.Run("issue from another project", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+			Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(3)).
+			Return(domain.Issue{ID: 3, ProjectID: 2}, nil)
+
+		err := service.Merge(context.Background(), 1, 1, []domain.IssueID{3})
+		require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	})
+
+	t.Run("no issues", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newTestService(t)
+
+		err := service.Merge(context.Background(), 1, 1, nil)
+		require.ErrorIs(t, err, domain.ErrInvalidIssueMerge)
+	})
+}
+
+func TestService_Unmerge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		firstSeen := time.Now().Add(-time.Hour)
+		lastSeen := time.Now()
+
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+			Return(domain.Issue{ID: 1, ProjectID: 1, Fingerprint: "fp-1", Status: domain.IssueStatusUnresolved}, nil)
+		m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+			Return(domain.Project{ID: 1, Name: "backend"}, nil)
+		m.runTx()
+
+		m.issueFingerprintsRepo.EXPECT().Delete(mock.Anything, domain.IssueID(1), "fp-2").Return(nil)
+		m.eventsRepo.EXPECT().FingerprintStats(mock.Anything, domain.ProjectID(1), []string{"fp-2"}).
+			Return(domain.FingerprintStats{
+				TotalEvents:  5,
+				FirstSeen:    firstSeen,
+				LastSeen:     lastSeen,
+				FirstRelease: "1.0.0",
+				Releases:     []string{"1.0.0", "1.1.0"},
+			}, nil)
+		m.eventsRepo.EXPECT().FetchForIssue(mock.Anything, domain.ProjectID(1), []string{"fp-2"}, uint(1)).
+			Return([]domain.Event{{Message: "boom", Level: domain.IssueLevelError, Source: domain.SourceEvent}}, nil)
+		m.issuesRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(issue domain.Issue) bool {
+			return issue.Fingerprint == "fp-2" && issue.TotalEvents == 5 && issue.Title == "boom"
+		})).Return(domain.IssueID(7), nil)
+		m.issuesRepo.EXPECT().SubtractEvents(mock.Anything, domain.IssueID(1), uint(5)).Return(nil)
+		m.releaseRepo.EXPECT().GetByProjectAndVersion(mock.Anything, domain.ProjectID(1), "1.0.0").
+			Return(domain.Release{ID: 11}, nil)
+		m.releaseRepo.EXPECT().GetByProjectAndVersion(mock.Anything, domain.ProjectID(1), "1.1.0").
+			Return(domain.Release{}, domain.ErrEntityNotFound)
+		m.issueReleasesRepo.EXPECT().Create(mock.Anything, domain.IssueID(7), domain.ReleaseID(11), true).Return(nil)
+		// The remaining fingerprints define the seen range and the releases of the primary issue
+		m.issueFingerprintsRepo.EXPECT().ListByIssue(mock.Anything, domain.IssueID(1)).Return([]string{"fp-3"}, nil)
+		m.eventsRepo.EXPECT().FingerprintStats(mock.Anything, domain.ProjectID(1), []string{"fp-1", "fp-3"}).
+			Return(domain.FingerprintStats{
+				TotalEvents:  3,
+				FirstSeen:    firstSeen.Add(time.Minute),
+				LastSeen:     lastSeen.Add(-time.Minute),
+				FirstRelease: "1.1.0",
+				Releases:     []string{"1.1.0"},
+			}, nil)
+		m.issuesRepo.EXPECT().
+			UpdateSeen(mock.Anything, domain.IssueID(1), firstSeen.Add(time.Minute), lastSeen.Add(-time.Minute)).
+			Return(nil)
+		m.issueReleasesRepo.EXPECT().Delete(mock.Anything, domain.IssueID(1), []domain.ReleaseID{11}).Return(nil)
+		m.activitiesRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(activity domain.IssueActivityDTO) bool {
+			return activity.IssueID == 1 && activity.Type == domain.IssueActivityUnmerged
+		})).Return(nil)
+
+		issues, err := service.Unmerge(context.Background(), 1, 1, []string{"fp-2"})
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		assert.Equal(t, domain.IssueID(7), issues[0].ID)
+		assert.Equal(t, "backend", issues[0].ProjectName)
+		assert.Equal(t, firstSeen, issues[0].FirstSeen)
+	})
+
+	t.Run("fingerprint is not merged", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.issuesRepo.EXPECT().GetByID(mock.Anything, domain.IssueID(1)).
+			Return(domain.Issue{ID: 1, ProjectID: 1}, nil)
+		m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+			Return(domain.Project{ID: 1}, nil)
+		m.runTx()
+		m.issueFingerprintsRepo.EXPECT().Delete(mock.Anything, domain.IssueID(1), "fp-x").Return(domain.ErrEntityNotFound)
+
+		_, err := service.Unmerge(context.Background(), 1, 1, []string{"fp-x"})
+		require.ErrorIs(t, err, domain.ErrInvalidIssueMerge)
+	})
+}
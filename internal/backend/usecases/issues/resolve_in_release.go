@@ -0,0 +1,56 @@
+package issues
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// ResolveInRelease resolves the issue in the release version or in the next release of the project,
+// events of the older releases don't reactivate it. The next release is any release newer
+// than the latest one at the resolve time. Only semver releases can be ordered, so a release not following
+// semver, or the next release of a project without semver releases, is rejected.
+func (s *Service) ResolveInRelease(
+	ctx context.Context,
+	id domain.IssueID,
+	release domain.IssueResolvedInRelease,
+) error {
+	release.Version = strings.TrimSpace(release.Version)
+
+	switch {
+	case release.NextRelease && release.Version != "":
+		return fmt.Errorf("%w: either a version or the next release can be set", domain.ErrInvalidResolvedRelease)
+	case !release.NextRelease && release.Version == "":
+		return fmt.Errorf("%w: version is required", domain.ErrInvalidResolvedRelease)
+	}
+
+	if release.NextRelease {
+		issue, err := s.issuesRepo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get issue by ID: %w", err)
+		}
+
+		latest, err := s.releaseRepo.GetLatest(ctx, issue.ProjectID)
+		if err != nil {
+			if errors.Is(err, domain.ErrEntityNotFound) {
+				return fmt.Errorf("%w: the project has no releases", domain.ErrInvalidResolvedRelease)
+			}
+
+			return fmt.Errorf("get latest release: %w", err)
+		}
+
+		if !domain.IsSemverRelease(latest.Version) {
+			return fmt.Errorf("%w: the latest release %q doesn't follow semver",
+				domain.ErrInvalidResolvedRelease, latest.Version)
+		}
+
+		release.Version = latest.Version
+	} else if !domain.IsSemverRelease(release.Version) {
+		return fmt.Errorf("%w: release %q doesn't follow semver", domain.ErrInvalidResolvedRelease, release.Version)
+	}
+
+	return s.changeStatus(ctx, id, domain.IssueStatusResolved, nil, &release)
+}
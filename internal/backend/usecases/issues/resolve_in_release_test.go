@@ -0,0 +1,96 @@
+package issues
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestService_ResolveInRelease(t *testing.T) {
+	t.Parallel()
+
+	ctx := wardencontext.WithUserID(context.Background(), 1)
+
+	t.Run("in version", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+		m.expectStatusChange(domain.IssueStatusResolved)
+		m.issuesRepo.EXPECT().
+			SetResolvedInRelease(mock.Anything, domain.IssueID(10), domain.IssueResolvedInRelease{Version: "1.4.0"}).
+			Return(nil)
+
+		err := service.ResolveInRelease(ctx, 10, domain.IssueResolvedInRelease{Version: " 1.4.0 "})
+		require.NoError(t, err)
+	})
+
+	t.Run("in next release stores the latest release", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+		m.releaseRepo.EXPECT().GetLatest(mock.Anything, domain.ProjectID(5)).
+			Return(domain.Release{ID: 3, ProjectID: 5, Version: "1.10.0"}, nil)
+		m.expectStatusChange(domain.IssueStatusResolved)
+		m.issuesRepo.EXPECT().
+			SetResolvedInRelease(mock.Anything, domain.IssueID(10), domain.IssueResolvedInRelease{
+				Version:     "1.10.0",
+				NextRelease: true,
+			}).
+			Return(nil)
+
+		err := service.ResolveInRelease(ctx, 10, domain.IssueResolvedInRelease{NextRelease: true})
+		require.NoError(t, err)
+	})
+
+	t.Run("in next release without releases", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+		m.releaseRepo.EXPECT().GetLatest(mock.Anything, domain.ProjectID(5)).
+			Return(domain.Release{}, domain.ErrEntityNotFound)
+
+		err := service.ResolveInRelease(ctx, 10, domain.IssueResolvedInRelease{NextRelease: true})
+		require.ErrorIs(t, err, domain.ErrInvalidResolvedRelease)
+	})
+
+	t.Run("in next release after a release not following semver", func(t *testing.T) {
+		t.Parallel()
+
+		service, m := newTestService(t)
+		m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+		m.releaseRepo.EXPECT().GetLatest(mock.Anything, domain.ProjectID(5)).
+			Return(domain.Release{ID: 3, ProjectID: 5, Version: "9f3c2a1d8e"}, nil)
+
+		err := service.ResolveInRelease(ctx, 10, domain.IssueResolvedInRelease{NextRelease: true})
+		require.ErrorIs(t, err, domain.ErrInvalidResolvedRelease)
+	})
+
+	t.Run("invalid release", func(t *testing.T) {
+		t.Parallel()
+
+		for _, release := range []domain.IssueResolvedInRelease{
+			{},
+			{Version: "  "},
+			{Version: "1.4.0", NextRelease: true},
+			{Version: "9f3c2a1d8e"},
+		} {
+			service, m := newTestService(t)
+			m.expectIssue(domain.IssueStatusUnresolved, nil)
+
+			err := service.ResolveInRelease(ctx, 10, release)
+			require.ErrorIs(t, err, domain.ErrInvalidResolvedRelease)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package monitors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const recentCheckInsLimit = 50
+
+type Service struct {
+	monitorsRepo contract.MonitorsRepository
+}
+
+func New(monitorsRepo contract.MonitorsRepository) *Service {
+	return &Service{
+		monitorsRepo: monitorsRepo,
+	}
+}
+
+func (s *Service) List(ctx context.Context, projectID domain.ProjectID) ([]domain.Monitor, error) {
+	monitors, err := s.monitorsRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list monitors: %w", err)
+	}
+
+	return monitors, nil
+}
+
+// GetWithCheckIns returns the monitor with its recent check-ins, newest first.
+func (s *Service) GetWithCheckIns(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.MonitorID,
+) (domain.MonitorWithCheckIns, error) {
+	monitor, err := s.monitorsRepo.GetByID(ctx, projectID, id)
+	if err != nil {
+		return domain.MonitorWithCheckIns{}, fmt.Errorf("get monitor: %w", err)
+	}
+
+	checkIns, err := s.monitorsRepo.ListCheckIns(ctx, id, recentCheckInsLimit)
+	if err != nil {
+		return domain.MonitorWithCheckIns{}, fmt.Errorf("list check-ins: %w", err)
+	}
+
+	return domain.MonitorWithCheckIns{
+		Monitor:  monitor,
+		CheckIns: checkIns,
+	}, nil
+}
+
+func (s *Service) Delete(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) error {
+	if err := s.monitorsRepo.Delete(ctx, projectID, id); err != nil {
+		return fmt.Errorf("delete monitor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package outcomes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// Outcomes are stored in hourly buckets, a finer granularity can't be served.
+const minGranularity = time.Hour
+
+type Service struct {
+	outcomesRepo contract.OutcomesRepository
+}
+
+func New(outcomesRepo contract.OutcomesRepository) *Service {
+	return &Service{
+		outcomesRepo: outcomesRepo,
+	}
+}
+
+func (s *Service) ProjectOutcomes(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	period domain.Period,
+) (domain.ProjectOutcomes, error) {
+	if period.Granularity < minGranularity {
+		period.Granularity = minGranularity
+	}
+
+	stats, err := s.outcomesRepo.Stats(ctx, projectID, period)
+	if err != nil {
+		return domain.ProjectOutcomes{}, fmt.Errorf("get outcomes stats: %w", err)
+	}
+
+	return buildProjectOutcomes(stats), nil
+}
+
+func buildProjectOutcomes(stats []domain.OutcomeStat) domain.ProjectOutcomes {
+	type itemKey struct {
+		outcome  domain.Outcome
+		reason   string
+		category domain.DataCategory
+	}
+
+	var result domain.ProjectOutcomes
+	itemIndexes := make(map[itemKey]int)
+	bucketIndexes := make(map[time.Time]int)
+
+	for _, stat := range stats {
+		result.Totals.Add(stat.Outcome, stat.Reason, stat.Quantity)
+
+		key := itemKey{outcome: stat.Outcome, reason: stat.Reason, category: stat.Category}
+		idx, ok := itemIndexes[key]
+		if !ok {
+			idx = len(result.Items)
+			itemIndexes[key] = idx
+			result.Items = append(result.Items, domain.OutcomeStat{
+				Outcome:  stat.Outcome,
+				Reason:   stat.Reason,
+				Category: stat.Category,
+			})
+		}
+		result.Items[idx].Quantity += stat.Quantity
+
+		bucketIdx, ok := bucketIndexes[stat.Bucket]
+		if !ok {
+			bucketIdx = len(result.Timeseries)
+			bucketIndexes[stat.Bucket] = bucketIdx
+			result.Timeseries = append(result.Timeseries, domain.OutcomeBucket{Bucket: stat.Bucket})
+		}
+		result.Timeseries[bucketIdx].Add(stat.Outcome, stat.Reason, stat.Quantity)
+	}
+
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		return result.Items[i].Quantity > result.Items[j].Quantity
+	})
+	sort.Slice(result.Timeseries, func(i, j int) bool {
+		return result.Timeseries[i].Bucket.Before(result.Timeseries[j].Bucket)
+	})
+
+	return result
+}
@@ -0,0 +1,104 @@
+package outcomes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestService_ProjectOutcomes(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2025, 6, 16, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	t.Run("aggregates totals, items and timeseries", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockOutcomesRepository(t)
+		service := New(repo)
+
+		period := domain.Period{Interval: 24 * time.Hour, Granularity: time.Hour}
+		repo.EXPECT().Stats(mock.Anything, domain.ProjectID(1), period).Return([]domain.OutcomeStat{
+			{Bucket: first, Outcome: domain.OutcomeAccepted, Category: domain.DataCategoryError, Quantity: 10},
+			{Bucket: second, Outcome: domain.OutcomeAccepted, Category: domain.DataCategoryError, Quantity: 5},
+			{
+				Bucket:   second,
+				Outcome:  domain.OutcomeRateLimited,
+				Reason:   domain.OutcomeReasonProjectRateLimit,
+				Category: domain.DataCategoryEnvelope,
+				Quantity: 3,
+			},
+			{
+				Bucket:   first,
+				Outcome:  domain.OutcomeClientDiscard,
+				Reason:   "sample_rate",
+				Category: domain.DataCategoryTransaction,
+				Quantity: 2,
+			},
+			{
+				Bucket:   first,
+				Outcome:  domain.OutcomeClientDiscard,
+				Reason:   "queue_overflow",
+				Category: domain.DataCategoryError,
+				Quantity: 1,
+			},
+		}, nil)
+
+		result, err := service.ProjectOutcomes(context.Background(), 1, period)
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.OutcomeTotals{Accepted: 15, Filtered: 2, RateLimited: 3, Dropped: 1}, result.Totals)
+
+		require.Len(t, result.Items, 4)
+		assert.Equal(t, domain.OutcomeAccepted, result.Items[0].Outcome)
+		assert.Equal(t, uint(15), result.Items[0].Quantity)
+
+		require.Len(t, result.Timeseries, 2)
+		assert.Equal(t, first, result.Timeseries[0].Bucket)
+		assert.Equal(t, domain.OutcomeTotals{Accepted: 10, Filtered: 2, Dropped: 1}, result.Timeseries[0].OutcomeTotals)
+		assert.Equal(t, domain.OutcomeTotals{Accepted: 5, RateLimited: 3}, result.Timeseries[1].OutcomeTotals)
+	})
+
+	t.Run("granularity is not finer than stored buckets", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockOutcomesRepository(t)
+		service := New(repo)
+
+		repo.EXPECT().Stats(mock.Anything, domain.ProjectID(1), domain.Period{
+			Interval:    time.Hour,
+			Granularity: time.Hour,
+		}).Return(nil, nil)
+
+		result, err := service.ProjectOutcomes(context.Background(), 1, domain.Period{
+			Interval:    time.Hour,
+			Granularity: 5 * time.Minute,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockOutcomesRepository(t)
+		service := New(repo)
+
+		repo.EXPECT().Stats(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := service.ProjectOutcomes(context.Background(), 1, domain.Period{
+			Interval:    time.Hour,
+			Granularity: time.Hour,
+		})
+		require.ErrorContains(t, err, "db error")
+	})
+}
@@ -0,0 +1,69 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/common/datascrubbing"
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// DataScrubbingService manages the data scrubbing of projects. The envelope consumers reload
+// the settings of a project with the rest of its processing settings.
+type DataScrubbingService struct {
+	scrubbingRepo contract.DataScrubbingRepository
+}
+
+func NewDataScrubbingService(scrubbingRepo contract.DataScrubbingRepository) *DataScrubbingService {
+	return &DataScrubbingService{
+		scrubbingRepo: scrubbingRepo,
+	}
+}
+
+func (s *DataScrubbingService) Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error) {
+	scrubbing, err := s.scrubbingRepo.Get(ctx, projectID)
+	if err != nil {
+		return domain.DataScrubbing{}, fmt.Errorf("get data scrubbing: %w", err)
+	}
+
+	return scrubbing, nil
+}
+
+func (s *DataScrubbingService) Update(
+	ctx context.Context,
+	scrubbing domain.DataScrubbing,
+) (domain.DataScrubbing, error) {
+	scrubbing.SensitiveFields = normalizePatterns(scrubbing.SensitiveFields)
+	scrubbing.Patterns = normalizePatterns(scrubbing.Patterns)
+
+	if err := datascrubbing.Validate(scrubbing); err != nil {
+		return domain.DataScrubbing{}, err
+	}
+
+	// Fails for unknown and archived projects
+	if _, err := s.scrubbingRepo.Get(ctx, scrubbing.ProjectID); err != nil {
+		return domain.DataScrubbing{}, fmt.Errorf("get data scrubbing: %w", err)
+	}
+
+	saved, err := s.scrubbingRepo.Upsert(ctx, scrubbing)
+	if err != nil {
+		return domain.DataScrubbing{}, fmt.Errorf("save data scrubbing: %w", err)
+	}
+
+	// Changes of the data scrubbing are kept in the logs for audits
+	slog.Info("project data scrubbing changed",
+		"project_id", saved.ProjectID,
+		"user_id", wardencontext.UserID(ctx),
+		"default_scrubbers", saved.DefaultScrubbers,
+		"sensitive_fields", saved.SensitiveFields,
+		"patterns", len(saved.Patterns),
+		"anonymize_ip", saved.AnonymizeIP,
+		"drop_request_cookies", saved.DropRequestCookies,
+		"drop_request_data", saved.DropRequestData,
+	)
+
+	return saved, nil
+}
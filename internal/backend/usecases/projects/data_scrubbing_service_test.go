@@ -0,0 +1,64 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestDataScrubbingService_Update(t *testing.T) {
+	t.Parallel()
+
+	scrubbingRepo := mockcontract.NewMockDataScrubbingRepository(t)
+	service := NewDataScrubbingService(scrubbingRepo)
+
+	expected := domain.DataScrubbing{
+		ProjectID:        1,
+		DefaultScrubbers: true,
+		SensitiveFields:  []string{"email"},
+		Patterns:         []string{`\d{3}-\d{2}-\d{4}`},
+		AnonymizeIP:      true,
+	}
+
+	scrubbingRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.DataScrubbing{ProjectID: 1}, nil)
+	scrubbingRepo.EXPECT().Upsert(mock.Anything, expected).Return(expected, nil)
+
+	scrubbing, err := service.Update(context.Background(), domain.DataScrubbing{
+		ProjectID:        1,
+		DefaultScrubbers: true,
+		SensitiveFields:  []string{" email ", "", "email"},
+		Patterns:         []string{`\d{3}-\d{2}-\d{4}`},
+		AnonymizeIP:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, scrubbing)
+}
+
+func TestDataScrubbingService_UpdateInvalid(t *testing.T) {
+	t.Parallel()
+
+	service := NewDataScrubbingService(mockcontract.NewMockDataScrubbingRepository(t))
+
+	_, err := service.Update(context.Background(), domain.DataScrubbing{
+		ProjectID: 1,
+		Patterns:  []string{"(unclosed"},
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidDataScrubbing)
+}
+
+func TestDataScrubbingService_UpdateUnknownProject(t *testing.T) {
+	t.Parallel()
+
+	scrubbingRepo := mockcontract.NewMockDataScrubbingRepository(t)
+	service := NewDataScrubbingService(scrubbingRepo)
+
+	scrubbingRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.DataScrubbing{}, domain.ErrEntityNotFound)
+
+	_, err := service.Update(context.Background(), domain.DataScrubbing{ProjectID: 1})
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
@@ -0,0 +1,45 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// EnvironmentsService manages the environments of projects, the envelope consumers register them
+// from the ingested events.
+type EnvironmentsService struct {
+	environmentsRepo contract.EnvironmentsRepository
+}
+
+func NewEnvironmentsService(environmentsRepo contract.EnvironmentsRepository) *EnvironmentsService {
+	return &EnvironmentsService{
+		environmentsRepo: environmentsRepo,
+	}
+}
+
+func (s *EnvironmentsService) List(ctx context.Context, projectID domain.ProjectID) ([]domain.Environment, error) {
+	environments, err := s.environmentsRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list environments: %w", err)
+	}
+
+	return environments, nil
+}
+
+// SetHidden hides the environment from the environment selectors or shows it again,
+// events of hidden environments are still ingested.
+func (s *EnvironmentsService) SetHidden(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	name string,
+	hidden bool,
+) error {
+	if err := s.environmentsRepo.SetHidden(ctx, projectID, name, hidden); err != nil {
+		return fmt.Errorf("set environment hidden: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/common/inboundfilters"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// InboundFiltersService manages the allowed origins and inbound filters of projects. The ingest servers
+// drop changed filters from their caches on the notifications sent by the database.
+type InboundFiltersService struct {
+	filtersRepo contract.InboundFiltersRepository
+}
+
+func NewInboundFiltersService(filtersRepo contract.InboundFiltersRepository) *InboundFiltersService {
+	return &InboundFiltersService{
+		filtersRepo: filtersRepo,
+	}
+}
+
+func (s *InboundFiltersService) Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error) {
+	filters, err := s.filtersRepo.Get(ctx, projectID)
+	if err != nil {
+		return domain.InboundFilters{}, fmt.Errorf("get inbound filters: %w", err)
+	}
+
+	return filters, nil
+}
+
+func (s *InboundFiltersService) Update(
+	ctx context.Context,
+	filters domain.InboundFilters,
+) (domain.InboundFilters, error) {
+	filters.AllowedOrigins = normalizePatterns(filters.AllowedOrigins)
+	filters.BlockedIPRanges = normalizePatterns(filters.BlockedIPRanges)
+	filters.ErrorMessages = normalizePatterns(filters.ErrorMessages)
+	filters.Releases = normalizePatterns(filters.Releases)
+
+	if err := inboundfilters.Validate(filters); err != nil {
+		return domain.InboundFilters{}, err
+	}
+
+	// Fails for unknown and archived projects
+	if _, err := s.filtersRepo.Get(ctx, filters.ProjectID); err != nil {
+		return domain.InboundFilters{}, fmt.Errorf("get inbound filters: %w", err)
+	}
+
+	saved, err := s.filtersRepo.Upsert(ctx, filters)
+	if err != nil {
+		return domain.InboundFilters{}, fmt.Errorf("save inbound filters: %w", err)
+	}
+
+	return saved, nil
+}
+
+// normalizePatterns trims the entries and drops empty and duplicate ones.
+func normalizePatterns(values []string) []string {
+	result := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if _, ok := seen[value]; ok {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+
+	return result
+}
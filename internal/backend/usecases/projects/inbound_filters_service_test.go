@@ -0,0 +1,62 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestInboundFiltersService_Update(t *testing.T) {
+	t.Parallel()
+
+	filtersRepo := mockcontract.NewMockInboundFiltersRepository(t)
+	service := NewInboundFiltersService(filtersRepo)
+
+	expected := domain.InboundFilters{
+		ProjectID:       1,
+		AllowedOrigins:  []string{"https://example.com"},
+		BlockedIPRanges: []string{},
+		ErrorMessages:   []string{"*ResizeObserver*"},
+		Releases:        []string{},
+	}
+
+	filtersRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.InboundFilters{ProjectID: 1}, nil)
+	filtersRepo.EXPECT().Upsert(mock.Anything, expected).Return(expected, nil)
+
+	filters, err := service.Update(context.Background(), domain.InboundFilters{
+		ProjectID:      1,
+		AllowedOrigins: []string{" https://example.com ", "", "https://example.com"},
+		ErrorMessages:  []string{"*ResizeObserver*"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, filters)
+}
+
+func TestInboundFiltersService_UpdateInvalid(t *testing.T) {
+	t.Parallel()
+
+	service := NewInboundFiltersService(mockcontract.NewMockInboundFiltersRepository(t))
+
+	_, err := service.Update(context.Background(), domain.InboundFilters{
+		ProjectID:       1,
+		BlockedIPRanges: []string{"not an IP"},
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidInboundFilters)
+}
+
+func TestInboundFiltersService_UpdateUnknownProject(t *testing.T) {
+	t.Parallel()
+
+	filtersRepo := mockcontract.NewMockInboundFiltersRepository(t)
+	service := NewInboundFiltersService(filtersRepo)
+
+	filtersRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.InboundFilters{}, domain.ErrEntityNotFound)
+
+	_, err := service.Update(context.Background(), domain.InboundFilters{ProjectID: 1})
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
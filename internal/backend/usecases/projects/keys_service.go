@@ -0,0 +1,256 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+// KeysService manages the client keys of projects. The ingest servers drop changed keys
+// from their caches on the notifications sent by the database.
+type KeysService struct {
+	keysRepo    contract.ProjectKeysRepository
+	projectRepo contract.ProjectsRepository
+	txManager   db.TxManager
+}
+
+func NewKeysService(
+	keysRepo contract.ProjectKeysRepository,
+	projectRepo contract.ProjectsRepository,
+	txManager db.TxManager,
+) *KeysService {
+	return &KeysService{
+		keysRepo:    keysRepo,
+		projectRepo: projectRepo,
+		txManager:   txManager,
+	}
+}
+
+func (s *KeysService) List(ctx context.Context, projectID domain.ProjectID) ([]domain.ProjectKey, error) {
+	keys, err := s.keysRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list project keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (s *KeysService) Create(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	label string,
+	rateLimits domain.RateLimits,
+) (domain.ProjectKey, error) {
+	if err := rateLimits.Validate(); err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	if _, err := s.projectRepo.GetByID(ctx, projectID); err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("get project: %w", err)
+	}
+
+	key, err := s.createKey(ctx, projectID, label, rateLimits)
+	if err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	slog.Info("project key created", "project_id", projectID, "key_id", key.ID)
+
+	return key, nil
+}
+
+// Update changes the key, a deactivated key stops working like a revoked one. The project DSN is moved
+// to another active key when it used the deactivated key, the last active key can't be deactivated.
+func (s *KeysService) Update(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.ProjectKeyID,
+	label string,
+	isActive bool,
+	rateLimits domain.RateLimits,
+) (domain.ProjectKey, error) {
+	if err := rateLimits.Validate(); err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	var key domain.ProjectKey
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, err := s.keysRepo.GetByID(ctx, projectID, id)
+		if err != nil {
+			return fmt.Errorf("get project key: %w", err)
+		}
+
+		var replacement *domain.ProjectKey
+		if current.IsActive && !isActive {
+			replacement, err = s.replacementKey(ctx, projectID, current)
+			if err != nil {
+				return err
+			}
+		}
+
+		key, err = s.keysRepo.Update(ctx, projectID, id, label, isActive, rateLimits)
+		if err != nil {
+			return fmt.Errorf("update project key: %w", err)
+		}
+
+		if replacement == nil {
+			return nil
+		}
+
+		return s.replaceProjectDSN(ctx, projectID, current.PublicKey, replacement.PublicKey)
+	})
+	if err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	return key, nil
+}
+
+// Rotate issues a new key with the label and the rate limits of the given one. The old key keeps working
+// until it's revoked, so the deployed clients can be moved to the new key first.
+func (s *KeysService) Rotate(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.ProjectKeyID,
+) (domain.ProjectKey, error) {
+	var newKey domain.ProjectKey
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		oldKey, err := s.keysRepo.GetByID(ctx, projectID, id)
+		if err != nil {
+			return fmt.Errorf("get project key: %w", err)
+		}
+
+		newKey, err = s.createKey(ctx, projectID, oldKey.Label, oldKey.RateLimits)
+		if err != nil {
+			return err
+		}
+
+		return s.replaceProjectDSN(ctx, projectID, oldKey.PublicKey, newKey.PublicKey)
+	})
+	if err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	slog.Info("project key rotated", "project_id", projectID, "key_id", id, "new_key_id", newKey.ID)
+
+	return newKey, nil
+}
+
+// Revoke deletes the key, the clients using it are rejected right away. The last active key
+// can't be revoked, the project DSN would point to a rejected key, it has to be rotated first.
+func (s *KeysService) Revoke(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) error {
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		key, err := s.keysRepo.GetByID(ctx, projectID, id)
+		if err != nil {
+			return fmt.Errorf("get project key: %w", err)
+		}
+
+		replacement, err := s.replacementKey(ctx, projectID, key)
+		if err != nil {
+			return err
+		}
+
+		if err := s.keysRepo.Delete(ctx, projectID, id); err != nil {
+			return fmt.Errorf("delete project key: %w", err)
+		}
+
+		if replacement == nil {
+			return nil
+		}
+
+		return s.replaceProjectDSN(ctx, projectID, key.PublicKey, replacement.PublicKey)
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("project key revoked", "project_id", projectID, "key_id", id)
+
+	return nil
+}
+
+func (s *KeysService) createKey(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	label string,
+	rateLimits domain.RateLimits,
+) (domain.ProjectKey, error) {
+	publicKey, secretKey, err := generateKeyPair()
+	if err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	key, err := s.keysRepo.Create(ctx, domain.ProjectKeyDTO{
+		ProjectID:  projectID,
+		Label:      label,
+		PublicKey:  publicKey,
+		SecretKey:  secretKey,
+		RateLimits: rateLimits,
+	})
+	if err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("create project key: %w", err)
+	}
+
+	return key, nil
+}
+
+// replacementKey returns another active key of the project, the project DSN is moved to it when the key
+// stops working. Without one, the last active key and a key still used by the project DSN are kept.
+func (s *KeysService) replacementKey(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	key domain.ProjectKey,
+) (*domain.ProjectKey, error) {
+	keys, err := s.keysRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("list project keys: %w", err)
+	}
+
+	for i := range keys {
+		if keys[i].ID != key.ID && keys[i].IsActive {
+			return &keys[i], nil
+		}
+	}
+
+	if key.IsActive {
+		return nil, fmt.Errorf("%w: rotate the key instead", domain.ErrLastActiveProjectKey)
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+
+	if project.PublicKey == key.PublicKey {
+		return nil, fmt.Errorf("%w: the project DSN uses the key, rotate it instead", domain.ErrLastActiveProjectKey)
+	}
+
+	return nil, nil
+}
+
+// replaceProjectDSN moves the DSN shown for the project to another key if it used the old one.
+func (s *KeysService) replaceProjectDSN(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	oldPublicKey string,
+	newPublicKey string,
+) error {
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+
+	if project.PublicKey != oldPublicKey {
+		return nil
+	}
+
+	if err := s.projectRepo.UpdatePublicKey(ctx, projectID, newPublicKey); err != nil {
+		return fmt.Errorf("update project public key: %w", err)
+	}
+
+	return nil
+}
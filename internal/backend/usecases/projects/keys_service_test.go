@@ -0,0 +1,390 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+func TestKeysService_Create(t *testing.T) {
+	t.Parallel()
+
+	rateLimit := uint(60)
+	rateLimits := domain.RateLimits{
+		EventsPerMinute: &rateLimit,
+		Categories:      map[domain.DataCategory]uint{domain.DataCategoryAttachment: 10},
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(
+			mockKeysRepo *mockcontract.MockProjectKeysRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+		)
+		rateLimits    domain.RateLimits
+		expectedKeyID domain.ProjectKeyID
+		expectedError error
+	}{
+		{
+			name: "Success",
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{ID: 1}, nil)
+				mockKeysRepo.EXPECT().Create(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, keyDTO domain.ProjectKeyDTO) (domain.ProjectKey, error) {
+						require.Equal(t, "Frontend", keyDTO.Label)
+						require.Equal(t, rateLimits, keyDTO.RateLimits)
+						require.Len(t, keyDTO.PublicKey, 64)
+						require.Len(t, keyDTO.SecretKey, 64)
+						require.NotEqual(t, keyDTO.PublicKey, keyDTO.SecretKey)
+
+						return domain.ProjectKey{ID: 1, ProjectID: keyDTO.ProjectID, PublicKey: keyDTO.PublicKey}, nil
+					})
+			},
+			rateLimits:    rateLimits,
+			expectedKeyID: 1,
+		},
+		{
+			name: "Invalid rate limits",
+			setupMocks: func(
+				*mockcontract.MockProjectKeysRepository,
+				*mockcontract.MockProjectsRepository,
+			) {
+			},
+			rateLimits: domain.RateLimits{
+				Categories: map[domain.DataCategory]uint{"profile": 10},
+			},
+			expectedError: domain.ErrInvalidRateLimits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockKeysRepo := mockcontract.NewMockProjectKeysRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			tt.setupMocks(mockKeysRepo, mockProjectRepo)
+
+			service := NewKeysService(mockKeysRepo, mockProjectRepo, newReadCommittedTxManager(t))
+
+			key, err := service.Create(context.Background(), domain.ProjectID(1), "Frontend", tt.rateLimits)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeyID, key.ID)
+		})
+	}
+}
+
+func TestKeysService_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		isActive   bool
+		setupMocks func(
+			mockKeysRepo *mockcontract.MockProjectKeysRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+		)
+		expectedError error
+	}{
+		{
+			name:     "Rename",
+			isActive: true,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				_ *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "only", IsActive: true}, nil)
+				mockKeysRepo.EXPECT().
+					Update(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1), "Backend", true, domain.RateLimits{}).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, Label: "Backend", IsActive: true}, nil)
+			},
+		},
+		{
+			name:     "Project DSN moves to another active key",
+			isActive: false,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "leaked", IsActive: true}, nil)
+				mockKeysRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.ProjectKey{
+					{ID: 1, ProjectID: 1, PublicKey: "leaked", IsActive: true},
+					{ID: 2, ProjectID: 1, PublicKey: "active", IsActive: true},
+				}, nil)
+				mockKeysRepo.EXPECT().
+					Update(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1), "Backend", false, domain.RateLimits{}).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, Label: "Backend"}, nil)
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, PublicKey: "leaked"}, nil)
+				mockProjectRepo.EXPECT().UpdatePublicKey(mock.Anything, domain.ProjectID(1), "active").Return(nil)
+			},
+		},
+		{
+			name:     "Last active key",
+			isActive: false,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				_ *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "only", IsActive: true}, nil)
+				mockKeysRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.ProjectKey{
+					{ID: 1, ProjectID: 1, PublicKey: "only", IsActive: true},
+					{ID: 2, ProjectID: 1, PublicKey: "disabled", IsActive: false},
+				}, nil)
+			},
+			expectedError: domain.ErrLastActiveProjectKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockKeysRepo := mockcontract.NewMockProjectKeysRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			tt.setupMocks(mockKeysRepo, mockProjectRepo)
+
+			service := NewKeysService(mockKeysRepo, mockProjectRepo, newReadCommittedTxManager(t))
+
+			key, err := service.Update(context.Background(), domain.ProjectID(1), domain.ProjectKeyID(1),
+				"Backend", tt.isActive, domain.RateLimits{})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.isActive, key.IsActive)
+		})
+	}
+}
+
+func TestKeysService_Rotate(t *testing.T) {
+	t.Parallel()
+
+	rateLimit := uint(60)
+	oldKey := domain.ProjectKey{
+		ID:         1,
+		ProjectID:  1,
+		Label:      "Backend",
+		PublicKey:  "old",
+		RateLimits: domain.RateLimits{EventsPerMinute: &rateLimit},
+	}
+
+	expectNewKey := func(mockKeysRepo *mockcontract.MockProjectKeysRepository) {
+		mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).Return(oldKey, nil)
+		mockKeysRepo.EXPECT().Create(mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, keyDTO domain.ProjectKeyDTO) (domain.ProjectKey, error) {
+				require.Equal(t, oldKey.Label, keyDTO.Label)
+				require.Equal(t, oldKey.RateLimits, keyDTO.RateLimits)
+
+				return domain.ProjectKey{ID: 2, ProjectID: 1, Label: keyDTO.Label, PublicKey: "new"}, nil
+			})
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(
+			mockKeysRepo *mockcontract.MockProjectKeysRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+		)
+		expectedKeyID domain.ProjectKeyID
+		expectedError error
+	}{
+		{
+			name: "Project DSN moves to the new key",
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				expectNewKey(mockKeysRepo)
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, PublicKey: "old"}, nil)
+				mockProjectRepo.EXPECT().UpdatePublicKey(mock.Anything, domain.ProjectID(1), "new").Return(nil)
+			},
+			expectedKeyID: 2,
+		},
+		{
+			name: "Project DSN uses another key",
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				expectNewKey(mockKeysRepo)
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, PublicKey: "other"}, nil)
+			},
+			expectedKeyID: 2,
+		},
+		{
+			name: "Key not found",
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				_ *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).
+					Return(domain.ProjectKey{}, domain.ErrEntityNotFound)
+			},
+			expectedError: domain.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockKeysRepo := mockcontract.NewMockProjectKeysRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			tt.setupMocks(mockKeysRepo, mockProjectRepo)
+
+			service := NewKeysService(mockKeysRepo, mockProjectRepo, newReadCommittedTxManager(t))
+
+			key, err := service.Rotate(context.Background(), domain.ProjectID(1), domain.ProjectKeyID(1))
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeyID, key.ID)
+		})
+	}
+}
+
+func TestKeysService_Revoke(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		keyID      domain.ProjectKeyID
+		setupMocks func(
+			mockKeysRepo *mockcontract.MockProjectKeysRepository,
+			mockProjectRepo *mockcontract.MockProjectsRepository,
+		)
+		expectedError error
+	}{
+		{
+			name:  "Project DSN moves to another active key",
+			keyID: 1,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "leaked", IsActive: true}, nil)
+				mockKeysRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.ProjectKey{
+					{ID: 1, ProjectID: 1, PublicKey: "leaked", IsActive: true},
+					{ID: 2, ProjectID: 1, PublicKey: "disabled", IsActive: false},
+					{ID: 3, ProjectID: 1, PublicKey: "active", IsActive: true},
+				}, nil)
+				mockKeysRepo.EXPECT().Delete(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).Return(nil)
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, PublicKey: "leaked"}, nil)
+				mockProjectRepo.EXPECT().UpdatePublicKey(mock.Anything, domain.ProjectID(1), "active").Return(nil)
+			},
+		},
+		{
+			name:  "Last active key",
+			keyID: 1,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				_ *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(1)).
+					Return(domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "only", IsActive: true}, nil)
+				mockKeysRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.ProjectKey{
+					{ID: 1, ProjectID: 1, PublicKey: "only", IsActive: true},
+					{ID: 2, ProjectID: 1, PublicKey: "disabled", IsActive: false},
+				}, nil)
+			},
+			expectedError: domain.ErrLastActiveProjectKey,
+		},
+		{
+			name:  "Inactive key",
+			keyID: 2,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(2)).
+					Return(domain.ProjectKey{ID: 2, ProjectID: 1, PublicKey: "disabled"}, nil)
+				mockKeysRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.ProjectKey{
+					{ID: 2, ProjectID: 1, PublicKey: "disabled", IsActive: false},
+				}, nil)
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, PublicKey: "other"}, nil)
+				mockKeysRepo.EXPECT().Delete(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(2)).Return(nil)
+			},
+		},
+		{
+			name:  "Inactive key used by the project DSN",
+			keyID: 2,
+			setupMocks: func(
+				mockKeysRepo *mockcontract.MockProjectKeysRepository,
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+			) {
+				mockKeysRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1), domain.ProjectKeyID(2)).
+					Return(domain.ProjectKey{ID: 2, ProjectID: 1, PublicKey: "disabled"}, nil)
+				mockKeysRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.ProjectKey{
+					{ID: 2, ProjectID: 1, PublicKey: "disabled", IsActive: false},
+				}, nil)
+				mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, PublicKey: "disabled"}, nil)
+			},
+			expectedError: domain.ErrLastActiveProjectKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockKeysRepo := mockcontract.NewMockProjectKeysRepository(t)
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			tt.setupMocks(mockKeysRepo, mockProjectRepo)
+
+			service := NewKeysService(mockKeysRepo, mockProjectRepo, newReadCommittedTxManager(t))
+
+			err := service.Revoke(context.Background(), domain.ProjectID(1), tt.keyID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func newReadCommittedTxManager(t *testing.T) *mockdb.MockTxManager {
+	t.Helper()
+
+	txManager := mockdb.NewMockTxManager(t)
+	txManager.EXPECT().ReadCommitted(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+
+	return txManager
+}
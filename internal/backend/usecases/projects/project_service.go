@@ -21,17 +21,23 @@ type ProjectService struct {
 	projectRepo      contract.ProjectsRepository
 	issuesRepository contract.IssuesRepository
 	teamsUseCase     contract.TeamsUseCase
+	spikesRepo       contract.ProjectSpikesRepository
+	rulesRepo        contract.GroupingRulesRepository
 }
 
 func New(
 	projectRepo contract.ProjectsRepository,
 	issuesRepository contract.IssuesRepository,
 	teamsUseCase contract.TeamsUseCase,
+	spikesRepo contract.ProjectSpikesRepository,
+	rulesRepo contract.GroupingRulesRepository,
 ) *ProjectService {
 	return &ProjectService{
 		projectRepo:      projectRepo,
 		issuesRepository: issuesRepository,
 		teamsUseCase:     teamsUseCase,
+		spikesRepo:       spikesRepo,
+		rulesRepo:        rulesRepo,
 	}
 }
 
@@ -66,9 +72,9 @@ func (s *ProjectService) CreateProject(
 	name, description string,
 	teamID *domain.TeamID,
 ) (domain.Project, error) {
-	publicKey, err := generateRandomKey(32)
+	publicKey, secretKey, err := generateKeyPair()
 	if err != nil {
-		return domain.Project{}, fmt.Errorf("generate public key: %w", err)
+		return domain.Project{}, err
 	}
 
 	if teamID != nil {
@@ -82,6 +88,7 @@ func (s *ProjectService) CreateProject(
 		Name:        name,
 		Description: description,
 		PublicKey:   publicKey,
+		SecretKey:   secretKey,
 		TeamID:      teamID,
 	}
 
@@ -171,6 +178,11 @@ func (s *ProjectService) GeneralStats(
 		return domain.GeneralProjectStats{}, fmt.Errorf("get most frequent issues: %w", err)
 	}
 
+	spikes, err := s.spikesRepo.ListByProject(ctx, id, time.Now().Add(-period))
+	if err != nil {
+		return domain.GeneralProjectStats{}, fmt.Errorf("list project spikes: %w", err)
+	}
+
 	totalCnt := uint(0)
 	for _, cnt := range counters {
 		totalCnt += uint(cnt)
@@ -185,6 +197,7 @@ func (s *ProjectService) GeneralStats(
 		DebugIssues:        uint(counters[domain.IssueLevelDebug]),
 		ExceptionIssues:    uint(counters[domain.IssueLevelException]),
 		MostFrequentIssues: mostFrequestIssues,
+		Spikes:             spikes,
 	}, nil
 }
 
@@ -251,6 +264,73 @@ func (s *ProjectService) ArchiveProject(ctx context.Context, id domain.ProjectID
 	return nil
 }
 
+func (s *ProjectService) GetGroupingStrategy(
+	ctx context.Context,
+	id domain.ProjectID,
+) (domain.GroupingStrategy, error) {
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if !project.GroupingStrategy.IsValid() {
+		return domain.DefaultGroupingStrategy, nil
+	}
+
+	return project.GroupingStrategy, nil
+}
+
+// UpdateGroupingStrategy switches the project grouping strategy.
+// Only new events are affected, existing issues keep their fingerprints.
+func (s *ProjectService) UpdateGroupingStrategy(
+	ctx context.Context,
+	id domain.ProjectID,
+	strategy domain.GroupingStrategy,
+) error {
+	if !strategy.IsValid() {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidGroupingStrategy, strategy)
+	}
+
+	if !strategy.SupportsFrameRules() {
+		rules, err := s.rulesRepo.ListByProject(ctx, id)
+		if err != nil {
+			return fmt.Errorf("list grouping rules: %w", err)
+		}
+
+		// Stack trace rules would silently stop working
+		for _, rule := range rules {
+			if rule.Type == domain.GroupingRuleTypeStacktrace {
+				return fmt.Errorf("%w: %q ignores the stack trace rules of the project, delete them first",
+					domain.ErrInvalidGroupingStrategy, strategy)
+			}
+		}
+	}
+
+	err := s.projectRepo.UpdateGroupingStrategy(ctx, id, strategy)
+	if err != nil {
+		return fmt.Errorf("failed to update grouping strategy: %w", err)
+	}
+
+	slog.Info("project grouping strategy changed", "project_id", id, "strategy", strategy)
+
+	return nil
+}
+
+// generateKeyPair generates the public and the secret keys of a project DSN.
+func generateKeyPair() (publicKey, secretKey string, err error) {
+	publicKey, err = generateRandomKey(32)
+	if err != nil {
+		return "", "", fmt.Errorf("generate public key: %w", err)
+	}
+
+	secretKey, err = generateRandomKey(32)
+	if err != nil {
+		return "", "", fmt.Errorf("generate secret key: %w", err)
+	}
+
+	return publicKey, secretKey, nil
+}
+
 func generateRandomKey(length int) (string, error) {
 	bytes := make([]byte, length)
 	if _, err := rand.Read(bytes); err != nil {
@@ -20,9 +20,10 @@ func TestNew(t *testing.T) {
 	mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 	mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 	mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+	mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 	// Create service
-	service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+	service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 	// Verify service was created correctly
 	require.NotNil(t, service)
@@ -100,12 +101,13 @@ func TestGetProject(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockProjectRepo)
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			project, err := service.GetProject(context.Background(), tt.projectID)
@@ -265,12 +267,13 @@ func TestGetProjectExtended(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockProjectRepo, mockTeamsUseCase)
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			projectExtended, err := service.GetProjectExtended(context.Background(), tt.projectID)
@@ -325,6 +328,7 @@ func TestCreateProject(t *testing.T) {
 					require.Equal(t, "Test Project", projectDTO.Name)
 					require.Equal(t, "Some description", projectDTO.Description)
 					require.NotEmpty(t, projectDTO.PublicKey)
+					require.NotEmpty(t, projectDTO.SecretKey)
 					require.Equal(t, &teamID, projectDTO.TeamID)
 				}).Return(domain.ProjectID(1), nil)
 			},
@@ -349,6 +353,7 @@ func TestCreateProject(t *testing.T) {
 					require.Equal(t, "Personal Project", projectDTO.Name)
 					require.Equal(t, "Some description", projectDTO.Description)
 					require.NotEmpty(t, projectDTO.PublicKey)
+					require.NotEmpty(t, projectDTO.SecretKey)
 					require.Nil(t, projectDTO.TeamID)
 				}).Return(domain.ProjectID(2), nil)
 			},
@@ -399,12 +404,13 @@ func TestCreateProject(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockProjectRepo, mockTeamsUseCase)
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			project, err := service.CreateProject(context.Background(), tt.projectName, tt.description, tt.teamID)
@@ -524,12 +530,13 @@ func TestList(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockProjectRepo)
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			projects, err := service.List(context.Background())
@@ -780,12 +787,13 @@ func TestGetProjectsByUserID(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockProjectRepo, mockTeamsUseCase)
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			projects, err := service.GetProjectsByUserID(context.Background(), tt.userID, tt.isSuperuser)
@@ -1025,12 +1033,13 @@ func TestUpdateInfo(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockProjectRepo, mockTeamsUseCase)
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			projectExtended, err := service.UpdateInfo(context.Background(), tt.projectID, tt.newName, tt.newDescription)
@@ -1049,6 +1058,125 @@ func TestUpdateInfo(t *testing.T) {
 	}
 }
 
+func TestUpdateGroupingStrategy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*mockcontract.MockProjectsRepository, *mockcontract.MockGroupingRulesRepository)
+		strategy      domain.GroupingStrategy
+		expectedError error
+		errorContains string
+	}{
+		{
+			name: "Success",
+			setupMocks: func(mockProjectRepo *mockcontract.MockProjectsRepository, _ *mockcontract.MockGroupingRulesRepository) {
+				mockProjectRepo.EXPECT().UpdateGroupingStrategy(
+					mock.Anything,
+					domain.ProjectID(1),
+					domain.GroupingStrategyStacktraceV1,
+				).Return(nil)
+			},
+			strategy: domain.GroupingStrategyStacktraceV1,
+		},
+		{
+			name: "Success - Legacy without stack trace rules",
+			setupMocks: func(
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+			) {
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.GroupingRule{
+					{ID: 1, ProjectID: 1, Type: domain.GroupingRuleTypeFingerprint, Expression: "error.type:X -> x"},
+				}, nil)
+				mockProjectRepo.EXPECT().UpdateGroupingStrategy(
+					mock.Anything,
+					domain.ProjectID(1),
+					domain.GroupingStrategyLegacy,
+				).Return(nil)
+			},
+			strategy: domain.GroupingStrategyLegacy,
+		},
+		{
+			name:          "Error - Invalid strategy",
+			setupMocks:    func(*mockcontract.MockProjectsRepository, *mockcontract.MockGroupingRulesRepository) {},
+			strategy:      domain.GroupingStrategy("stacktrace:v9"),
+			expectedError: domain.ErrInvalidGroupingStrategy,
+		},
+		{
+			name: "Error - Legacy with stack trace rules",
+			setupMocks: func(_ *mockcontract.MockProjectsRepository, mockRulesRepo *mockcontract.MockGroupingRulesRepository) {
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.GroupingRule{
+					{ID: 1, ProjectID: 1, Type: domain.GroupingRuleTypeStacktrace, Expression: "stack.module:vendor/** -app"},
+				}, nil)
+			},
+			strategy:      domain.GroupingStrategyLegacy,
+			expectedError: domain.ErrInvalidGroupingStrategy,
+			errorContains: "stack trace rules",
+		},
+		{
+			name: "Error - Project not found",
+			setupMocks: func(
+				mockProjectRepo *mockcontract.MockProjectsRepository,
+				mockRulesRepo *mockcontract.MockGroupingRulesRepository,
+			) {
+				mockRulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+				mockProjectRepo.EXPECT().UpdateGroupingStrategy(
+					mock.Anything,
+					domain.ProjectID(1),
+					domain.GroupingStrategyLegacy,
+				).Return(domain.ErrEntityNotFound)
+			},
+			strategy:      domain.GroupingStrategyLegacy,
+			expectedError: domain.ErrEntityNotFound,
+			errorContains: "failed to update grouping strategy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			mockRulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+			tt.setupMocks(mockProjectRepo, mockRulesRepo)
+
+			service := New(mockProjectRepo, mockcontract.NewMockIssuesRepository(t), mockcontract.NewMockTeamsUseCase(t),
+				mockcontract.NewMockProjectSpikesRepository(t), mockRulesRepo)
+
+			err := service.UpdateGroupingStrategy(context.Background(), 1, tt.strategy)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				if tt.errorContains != "" {
+					require.Contains(t, err.Error(), tt.errorContains)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetGroupingStrategy(t *testing.T) {
+	t.Parallel()
+
+	mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+	mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+		Return(domain.Project{ID: 1, GroupingStrategy: domain.GroupingStrategyStacktraceV1}, nil)
+	mockProjectRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(2)).
+		Return(domain.Project{ID: 2}, nil)
+
+	service := New(mockProjectRepo, mockcontract.NewMockIssuesRepository(t), mockcontract.NewMockTeamsUseCase(t),
+		mockcontract.NewMockProjectSpikesRepository(t), nil)
+
+	strategy, err := service.GetGroupingStrategy(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, domain.GroupingStrategyStacktraceV1, strategy)
+
+	strategy, err = service.GetGroupingStrategy(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, domain.DefaultGroupingStrategy, strategy)
+}
+
 func TestGeneralStats(t *testing.T) {
 	t.Parallel()
 
@@ -1138,6 +1266,9 @@ func TestGeneralStats(t *testing.T) {
 						ProjectName: "Test Project",
 					},
 				},
+				Spikes: []domain.ProjectSpike{
+					{ID: 1, ProjectID: 1, BaselineRPS: 5, PeakRPS: 500, CapRPS: 50},
+				},
 			},
 			expectedError: false,
 		},
@@ -1203,12 +1334,17 @@ func TestGeneralStats(t *testing.T) {
 			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
 			mockTeamsUseCase := mockcontract.NewMockTeamsUseCase(t)
+			mockSpikesRepo := mockcontract.NewMockProjectSpikesRepository(t)
 
 			// Setup mocks
 			tt.setupMocks(mockIssuesRepo, mockProjectRepo)
+			if !tt.expectedError {
+				mockSpikesRepo.EXPECT().ListByProject(mock.Anything, tt.projectID, mock.Anything).
+					Return(tt.expectedStats.Spikes, nil)
+			}
 
 			// Create service
-			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase)
+			service := New(mockProjectRepo, mockIssuesRepo, mockTeamsUseCase, mockSpikesRepo, nil)
 
 			// Call method
 			stats, err := service.GeneralStats(context.Background(), tt.projectID, tt.period)
@@ -0,0 +1,52 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// RateLimitsService manages the rate limits shared by all client keys of projects. The ingest servers
+// drop changed limits from their caches on the notifications sent by the database.
+type RateLimitsService struct {
+	rateLimitsRepo contract.ProjectRateLimitsRepository
+}
+
+func NewRateLimitsService(rateLimitsRepo contract.ProjectRateLimitsRepository) *RateLimitsService {
+	return &RateLimitsService{
+		rateLimitsRepo: rateLimitsRepo,
+	}
+}
+
+func (s *RateLimitsService) Get(ctx context.Context, projectID domain.ProjectID) (domain.ProjectRateLimits, error) {
+	limits, err := s.rateLimitsRepo.Get(ctx, projectID)
+	if err != nil {
+		return domain.ProjectRateLimits{}, fmt.Errorf("get project rate limits: %w", err)
+	}
+
+	return limits, nil
+}
+
+func (s *RateLimitsService) Update(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	limits domain.RateLimits,
+) (domain.ProjectRateLimits, error) {
+	if err := limits.Validate(); err != nil {
+		return domain.ProjectRateLimits{}, err
+	}
+
+	// Fails for unknown and archived projects
+	if _, err := s.rateLimitsRepo.Get(ctx, projectID); err != nil {
+		return domain.ProjectRateLimits{}, fmt.Errorf("get project rate limits: %w", err)
+	}
+
+	saved, err := s.rateLimitsRepo.Upsert(ctx, projectID, limits)
+	if err != nil {
+		return domain.ProjectRateLimits{}, fmt.Errorf("save project rate limits: %w", err)
+	}
+
+	return saved, nil
+}
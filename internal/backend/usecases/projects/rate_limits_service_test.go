@@ -0,0 +1,56 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestRateLimitsService_Update(t *testing.T) {
+	t.Parallel()
+
+	rateLimitsRepo := mockcontract.NewMockProjectRateLimitsRepository(t)
+	service := NewRateLimitsService(rateLimitsRepo)
+
+	eventsPerMinute := uint(600)
+	limits := domain.RateLimits{
+		EventsPerMinute: &eventsPerMinute,
+		Categories:      map[domain.DataCategory]uint{domain.DataCategoryError: 100},
+	}
+	expected := domain.ProjectRateLimits{ProjectID: 1, RateLimits: limits}
+
+	rateLimitsRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.ProjectRateLimits{ProjectID: 1}, nil)
+	rateLimitsRepo.EXPECT().Upsert(mock.Anything, domain.ProjectID(1), limits).Return(expected, nil)
+
+	saved, err := service.Update(context.Background(), domain.ProjectID(1), limits)
+	require.NoError(t, err)
+	require.Equal(t, expected, saved)
+}
+
+func TestRateLimitsService_UpdateInvalid(t *testing.T) {
+	t.Parallel()
+
+	service := NewRateLimitsService(mockcontract.NewMockProjectRateLimitsRepository(t))
+
+	zero := uint(0)
+	_, err := service.Update(context.Background(), domain.ProjectID(1), domain.RateLimits{EventsPerMinute: &zero})
+	require.ErrorIs(t, err, domain.ErrInvalidRateLimits)
+}
+
+func TestRateLimitsService_UpdateUnknownProject(t *testing.T) {
+	t.Parallel()
+
+	rateLimitsRepo := mockcontract.NewMockProjectRateLimitsRepository(t)
+	service := NewRateLimitsService(rateLimitsRepo)
+
+	rateLimitsRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).
+		Return(domain.ProjectRateLimits{}, domain.ErrEntityNotFound)
+
+	_, err := service.Update(context.Background(), domain.ProjectID(1), domain.RateLimits{})
+	require.ErrorIs(t, err, domain.ErrEntityNotFound)
+}
@@ -0,0 +1,143 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/rom8726/warden/internal/backend/contract"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const defaultStatsLimit = 100
+
+type Service struct {
+	transactionsRepo contract.TransactionsRepository
+}
+
+func New(transactionsRepo contract.TransactionsRepository) *Service {
+	return &Service{
+		transactionsRepo: transactionsRepo,
+	}
+}
+
+func (s *Service) Stats(ctx context.Context, filter *domain.TransactionsFilter) ([]domain.TransactionStats, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultStatsLimit
+	}
+
+	stats, err := s.transactionsRepo.Stats(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get transactions stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetTrace returns the trace with its spans ordered as a waterfall: every span follows its parent.
+func (s *Service) GetTrace(ctx context.Context, projectID domain.ProjectID, traceID string) (domain.Trace, error) {
+	transactions, err := s.transactionsRepo.TraceTransactions(ctx, projectID, traceID)
+	if err != nil {
+		return domain.Trace{}, fmt.Errorf("get trace transactions: %w", err)
+	}
+
+	spans, err := s.transactionsRepo.TraceSpans(ctx, projectID, traceID)
+	if err != nil {
+		return domain.Trace{}, fmt.Errorf("get trace spans: %w", err)
+	}
+
+	if len(transactions) == 0 && len(spans) == 0 {
+		return domain.Trace{}, domain.ErrEntityNotFound
+	}
+
+	return buildTrace(traceID, transactions, spans), nil
+}
+
+func buildTrace(traceID string, transactions []domain.Transaction, spans []domain.Span) domain.Trace {
+	transactionNames := make(map[domain.EventID]string, len(transactions))
+	nodes := make([]domain.Span, 0, len(transactions)+len(spans))
+
+	// The root span of a transaction is the transaction itself
+	for i := range transactions {
+		transaction := transactions[i]
+		transactionNames[transaction.ID] = transaction.Name
+		nodes = append(nodes, domain.Span{
+			ProjectID:      transaction.ProjectID,
+			TransactionID:  transaction.ID,
+			TraceID:        transaction.TraceID,
+			SpanID:         transaction.SpanID,
+			ParentSpanID:   transaction.ParentSpanID,
+			Op:             transaction.Op,
+			Description:    transaction.Name,
+			Status:         transaction.Status,
+			Tags:           transaction.Tags,
+			StartTimestamp: transaction.StartTimestamp,
+			Timestamp:      transaction.Timestamp,
+		})
+	}
+	nodes = append(nodes, spans...)
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].StartTimestamp.Before(nodes[j].StartTimestamp)
+	})
+
+	trace := domain.Trace{
+		TraceID:        traceID,
+		StartTimestamp: nodes[0].StartTimestamp,
+		Transactions:   transactions,
+		Spans:          make([]domain.TraceSpan, 0, len(nodes)),
+	}
+
+	known := make(map[string]struct{}, len(nodes))
+	for i := range nodes {
+		known[nodes[i].SpanID] = struct{}{}
+		if nodes[i].Timestamp.After(trace.Timestamp) {
+			trace.Timestamp = nodes[i].Timestamp
+		}
+	}
+
+	var roots []int
+	children := make(map[string][]int, len(nodes))
+	for i := range nodes {
+		parentID := nodes[i].ParentSpanID
+		if _, ok := known[parentID]; !ok || parentID == nodes[i].SpanID {
+			// Parents from other services may be missing, such spans start their own subtree
+			roots = append(roots, i)
+
+			continue
+		}
+		children[parentID] = append(children[parentID], i)
+	}
+
+	visited := make(map[int]struct{}, len(nodes))
+	var walk func(idx int, depth uint)
+	walk = func(idx int, depth uint) {
+		if _, ok := visited[idx]; ok {
+			return
+		}
+		visited[idx] = struct{}{}
+
+		node := nodes[idx]
+		trace.Spans = append(trace.Spans, domain.TraceSpan{
+			Span:            node,
+			TransactionName: transactionNames[node.TransactionID],
+			Offset:          node.StartTimestamp.Sub(trace.StartTimestamp),
+			Depth:           depth,
+		})
+
+		for _, child := range children[node.SpanID] {
+			walk(child, depth+1)
+		}
+	}
+
+	for _, root := range roots {
+		walk(root, 0)
+	}
+
+	// Spans of a parent cycle are not reachable from any root
+	for i := range nodes {
+		walk(i, 0)
+	}
+
+	return trace
+}
@@ -0,0 +1,104 @@
+package transactions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/backend/contract"
+)
+
+func TestService_Stats(t *testing.T) {
+	t.Parallel()
+
+	repo := mockcontract.NewMockTransactionsRepository(t)
+	service := New(repo)
+
+	filter := &domain.TransactionsFilter{ProjectID: 1, Interval: time.Hour}
+	repo.EXPECT().Stats(mock.Anything, mock.MatchedBy(func(f *domain.TransactionsFilter) bool {
+		return f.Limit == defaultStatsLimit
+	})).Return([]domain.TransactionStats{{Name: "GET /users", Count: 10}}, nil)
+
+	stats, err := service.Stats(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "GET /users", stats[0].Name)
+}
+
+func TestService_GetTrace(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 6, 16, 10, 0, 0, 0, time.UTC)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+
+	t.Run("waterfall", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockTransactionsRepository(t)
+		service := New(repo)
+
+		repo.EXPECT().TraceTransactions(mock.Anything, domain.ProjectID(1), "trace-1").Return([]domain.Transaction{
+			{ID: "tx-api", TraceID: "trace-1", SpanID: "api", Name: "GET /users", StartTimestamp: at(0), Timestamp: at(100)},
+			{
+				ID: "tx-auth", TraceID: "trace-1", SpanID: "auth", ParentSpanID: "http",
+				Name: "auth.Check", StartTimestamp: at(20), Timestamp: at(40),
+			},
+		}, nil)
+		repo.EXPECT().TraceSpans(mock.Anything, domain.ProjectID(1), "trace-1").Return([]domain.Span{
+			{TransactionID: "tx-api", SpanID: "db", ParentSpanID: "api", StartTimestamp: at(50), Timestamp: at(90)},
+			{TransactionID: "tx-api", SpanID: "http", ParentSpanID: "api", StartTimestamp: at(10), Timestamp: at(45)},
+			{TransactionID: "tx-auth", SpanID: "cache", ParentSpanID: "auth", StartTimestamp: at(25), Timestamp: at(120)},
+		}, nil)
+
+		trace, err := service.GetTrace(context.Background(), 1, "trace-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, start, trace.StartTimestamp)
+		assert.Equal(t, 120*time.Millisecond, trace.Duration())
+
+		order := make([]string, 0, len(trace.Spans))
+		depths := make([]uint, 0, len(trace.Spans))
+		for _, span := range trace.Spans {
+			order = append(order, span.SpanID)
+			depths = append(depths, span.Depth)
+		}
+		assert.Equal(t, []string{"api", "http", "auth", "cache", "db"}, order)
+		assert.Equal(t, []uint{0, 1, 2, 3, 1}, depths)
+
+		cache := trace.Spans[3]
+		assert.Equal(t, 25*time.Millisecond, cache.Offset)
+		assert.Equal(t, "auth.Check", cache.TransactionName)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockTransactionsRepository(t)
+		service := New(repo)
+
+		repo.EXPECT().TraceTransactions(mock.Anything, domain.ProjectID(1), "trace-2").Return(nil, nil)
+		repo.EXPECT().TraceSpans(mock.Anything, domain.ProjectID(1), "trace-2").Return(nil, nil)
+
+		_, err := service.GetTrace(context.Background(), 1, "trace-2")
+		require.ErrorIs(t, err, domain.ErrEntityNotFound)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockTransactionsRepository(t)
+		service := New(repo)
+
+		repo.EXPECT().TraceTransactions(mock.Anything, domain.ProjectID(1), "trace-3").
+			Return(nil, errors.New("clickhouse down"))
+
+		_, err := service.GetTrace(context.Background(), 1, "trace-3")
+		require.ErrorContains(t, err, "clickhouse down")
+	})
+}
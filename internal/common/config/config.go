@@ -16,6 +16,7 @@ import (
 
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/infra"
+	"github.com/rom8726/warden/pkg/blobstore"
 	"github.com/rom8726/warden/pkg/kafka"
 )
 
@@ -184,6 +185,52 @@ type Notificator struct {
 	WorkerCount int `default:"4" envconfig:"WORKER_COUNT"`
 }
 
+// DeadLetter holds retries configuration of the queue messages failed to be processed.
+type DeadLetter struct {
+	MaxAttempts     uint          `default:"3"   envconfig:"MAX_ATTEMPTS"`
+	RetryBackoff    time.Duration `default:"1s"  envconfig:"RETRY_BACKOFF"`
+	MaxRetryBackoff time.Duration `default:"30s" envconfig:"MAX_RETRY_BACKOFF"`
+}
+
+// Events holds the configuration of stored events.
+type Events struct {
+	// Raw payloads over MaxPayloadSize bytes aren't stored with the event, they could exceed the Kafka
+	// message size. 0 stores all payloads.
+	MaxPayloadSize int `default:"262144" envconfig:"MAX_PAYLOAD_SIZE"` // 256 KB
+}
+
+// Attachments holds event attachments storage configuration.
+type Attachments struct {
+	Storage      string        `default:"fs"                      envconfig:"STORAGE"` // fs or s3
+	Dir          string        `default:"/opt/warden/attachments" envconfig:"DIR"`
+	S3Endpoint   string        `envconfig:"S3_ENDPOINT"`
+	S3Region     string        `default:"us-east-1"               envconfig:"S3_REGION"`
+	S3Bucket     string        `envconfig:"S3_BUCKET"`
+	S3AccessKey  string        `envconfig:"S3_ACCESS_KEY"`
+	S3SecretKey  string        `envconfig:"S3_SECRET_KEY"`
+	MaxSize      int64         `default:"104857600"               envconfig:"MAX_SIZE"`      // 100 MB
+	ProjectQuota int64         `default:"1073741824"              envconfig:"PROJECT_QUOTA"` // 1 GB
+	Retention    time.Duration `default:"720h"                    envconfig:"RETENTION"`
+}
+
+//nolint:ireturn // it's ok here
+func NewBlobStore(cfg *Attachments) (blobstore.Store, error) {
+	switch cfg.Storage {
+	case "fs":
+		return blobstore.NewFS(cfg.Dir)
+	case "s3":
+		return blobstore.NewS3(blobstore.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKey,
+			SecretAccessKey: cfg.S3SecretKey,
+		})
+	default:
+		return nil, fmt.Errorf("unknown attachments storage %q", cfg.Storage)
+	}
+}
+
 func NewPostgresConnPool(ctx context.Context, cfg *Postgres) (*pgxpool.Pool, error) {
 	pgCfg, err := pgxpool.ParseConfig(cfg.ConnStringWithPoolSize())
 	if err != nil {
@@ -265,9 +312,24 @@ func NewClickHouseClient(_ context.Context, cfg *ClickHouse) (infra.ClickHouseCo
 }
 
 func NewKafka(_ context.Context, cfg *Kafka) (sarama.Client, *kafka.Producer, error) {
+	client, err := NewKafkaClient(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asyncProducer, err := kafka.NewProducer(cfg.Brokers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("new async producer: %w", err)
+	}
+
+	return client, asyncProducer, nil
+}
+
+// NewKafkaClient creates a Kafka client, its producers wait for all in-sync replicas.
+func NewKafkaClient(cfg *Kafka) (sarama.Client, error) {
 	version, err := sarama.ParseKafkaVersion(cfg.Version)
 	if err != nil {
-		return nil, nil, fmt.Errorf("parse kafka version: %w", err)
+		return nil, fmt.Errorf("parse kafka version: %w", err)
 	}
 
 	saramaConfig := sarama.NewConfig()
@@ -281,15 +343,10 @@ func NewKafka(_ context.Context, cfg *Kafka) (sarama.Client, *kafka.Producer, er
 
 	client, err := sarama.NewClient(cfg.Brokers, saramaConfig)
 	if err != nil {
-		return nil, nil, fmt.Errorf("new client: %w", err)
-	}
-
-	asyncProducer, err := kafka.NewProducer(cfg.Brokers)
-	if err != nil {
-		return nil, nil, fmt.Errorf("new async producer: %w", err)
+		return nil, fmt.Errorf("new client: %w", err)
 	}
 
-	return client, asyncProducer, nil
+	return client, nil
 }
 
 // CreateKafkaTopics creates Kafka topics for events and exceptions.
@@ -305,6 +362,26 @@ func CreateKafkaTopics(brokers []string) error {
 			partitions:        8,
 			replicationFactor: 1,
 		},
+		{
+			name:              domain.TransactionsKafkaTopic,
+			partitions:        8,
+			replicationFactor: 1,
+		},
+		{
+			name:              domain.SpansKafkaTopic,
+			partitions:        8,
+			replicationFactor: 1,
+		},
+		{
+			name:              domain.SessionsKafkaTopic,
+			partitions:        8,
+			replicationFactor: 1,
+		},
+		{
+			name:              domain.OutcomesKafkaTopic,
+			partitions:        8,
+			replicationFactor: 1,
+		},
 		// Envelope topics
 		{
 			name:              domain.EnvelopeTopicHigh,
@@ -336,6 +413,11 @@ func CreateKafkaTopics(brokers []string) error {
 			partitions:        8,
 			replicationFactor: 1,
 		},
+		{
+			name:              domain.DeadLetterTopic,
+			partitions:        1,
+			replicationFactor: 1,
+		},
 	}
 
 	// Create topic requests
@@ -0,0 +1,493 @@
+package datascrubbing
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// Filtered replaces the removed values.
+const Filtered = "[Filtered]"
+
+// maxPatterns limits the number of sensitive fields and patterns of a project.
+const maxPatterns = 100
+
+// defaultSensitiveKeys are words of keys whose values are always sensitive,
+// they are compared in lower case without separators, see isSensitiveKey.
+var defaultSensitiveKeys = []string{
+	"password", "passwd", "pwd", "secret", "apikey", "auth", "authorization", "credentials", "token", "privatekey",
+	"sessionid", "csrf", "xsrf", "cookie", "creditcard", "cardnumber", "cvv",
+}
+
+// creditCardCandidate matches sequences of 13-19 digits optionally separated by spaces or dashes,
+// the candidates are checked with the Luhn algorithm.
+var creditCardCandidate = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+
+// pairKeys are keys of strings of URL encoded pairs, values of sensitive pairs are removed.
+var pairKeys = map[string]string{
+	"query_string": "&",
+	"cookies":      ";",
+	"cookie":       ";",
+}
+
+// urlKeys are keys of URLs and of span descriptions which may contain URLs,
+// values of sensitive pairs of their query strings are removed.
+var urlKeys = map[string]struct{}{
+	"url":         {},
+	"description": {},
+}
+
+// ipHeaders are request headers which carry client IP addresses.
+var ipHeaders = map[string]struct{}{
+	"xforwardedfor":  {},
+	"xrealip":        {},
+	"forwarded":      {},
+	"cfconnectingip": {},
+	"trueclientip":   {},
+}
+
+// DefaultSensitiveKeys returns the words of keys whose values are removed by the default scrubbers.
+func DefaultSensitiveKeys() []string {
+	return slices.Clone(defaultSensitiveKeys)
+}
+
+// Scrubber removes sensitive data from the events of a project.
+type Scrubber struct {
+	sensitiveKeys      []string
+	patterns           []*regexp.Regexp
+	creditCards        bool
+	anonymizeIP        bool
+	dropRequestCookies bool
+	dropRequestData    bool
+}
+
+// Validate checks the sensitive fields and patterns of the data scrubbing.
+func Validate(scrubbing domain.DataScrubbing) error {
+	_, err := Compile(scrubbing)
+
+	return err
+}
+
+// Compile validates the data scrubbing and prepares it to be applied to events.
+func Compile(scrubbing domain.DataScrubbing) (*Scrubber, error) {
+	if len(scrubbing.SensitiveFields) > maxPatterns {
+		return nil, fmt.Errorf("%w: too many sensitive fields, max %d", domain.ErrInvalidDataScrubbing, maxPatterns)
+	}
+
+	if len(scrubbing.Patterns) > maxPatterns {
+		return nil, fmt.Errorf("%w: too many patterns, max %d", domain.ErrInvalidDataScrubbing, maxPatterns)
+	}
+
+	var sensitiveKeys []string
+	if scrubbing.DefaultScrubbers {
+		sensitiveKeys = append(sensitiveKeys, defaultSensitiveKeys...)
+	}
+
+	for _, field := range scrubbing.SensitiveFields {
+		key := normalizeKey(field)
+		if key == "" {
+			return nil, fmt.Errorf("%w: invalid sensitive field %q", domain.ErrInvalidDataScrubbing, field)
+		}
+
+		sensitiveKeys = append(sensitiveKeys, key)
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(scrubbing.Patterns))
+	for _, pattern := range scrubbing.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid pattern %q: %w", domain.ErrInvalidDataScrubbing, pattern, err)
+		}
+
+		if re.MatchString("") {
+			return nil, fmt.Errorf("%w: pattern %q matches empty strings", domain.ErrInvalidDataScrubbing, pattern)
+		}
+
+		patterns = append(patterns, re)
+	}
+
+	return &Scrubber{
+		sensitiveKeys:      sensitiveKeys,
+		patterns:           patterns,
+		creditCards:        scrubbing.DefaultScrubbers,
+		anonymizeIP:        scrubbing.AnonymizeIP,
+		dropRequestCookies: scrubbing.DropRequestCookies,
+		dropRequestData:    scrubbing.DropRequestData,
+	}, nil
+}
+
+// Scrub removes the sensitive data from the event payload in place, the nil scrubber leaves it as is.
+func (s *Scrubber) Scrub(eventData map[string]any) {
+	if s == nil {
+		return
+	}
+
+	if request, ok := eventData["request"].(map[string]any); ok {
+		s.scrubRequest(request)
+	}
+
+	if s.anonymizeIP {
+		if user, ok := eventData["user"].(map[string]any); ok {
+			anonymizeValue(user, "ip_address")
+		}
+	}
+
+	s.scrubMap(eventData)
+}
+
+func (s *Scrubber) scrubRequest(request map[string]any) {
+	if s.dropRequestCookies {
+		delete(request, "cookies")
+		removeHeaders(request, func(name string) bool {
+			key := normalizeKey(name)
+
+			return key == "cookie" || key == "setcookie"
+		})
+	}
+
+	if s.dropRequestData {
+		delete(request, "data")
+	}
+
+	// Headers, cookies and query strings may be sent as lists of [name, value] pairs,
+	// their names aren't map keys, so the sensitive ones are handled here
+	for _, key := range []string{"headers", "cookies", "query_string"} {
+		if pairs, ok := request[key].([]any); ok {
+			s.scrubPairList(pairs)
+		}
+	}
+
+	if !s.anonymizeIP {
+		return
+	}
+
+	if env, ok := request["env"].(map[string]any); ok {
+		anonymizeValue(env, "REMOTE_ADDR")
+	}
+
+	anonymizeValue(request, "ip_address")
+
+	updateHeaders(request, func(name, value string) string {
+		if _, ok := ipHeaders[normalizeKey(name)]; ok {
+			return anonymizeIPs(value)
+		}
+
+		return value
+	})
+}
+
+// scrubPairList removes the values of the sensitive [name, value] pairs.
+func (s *Scrubber) scrubPairList(pairs []any) {
+	for _, raw := range pairs {
+		pair, name, value, ok := stringPair(raw)
+		if !ok {
+			continue
+		}
+
+		if s.isSensitiveKey(name) {
+			pair[1] = Filtered
+
+			continue
+		}
+
+		if sep, ok := pairKeys[strings.ToLower(name)]; ok {
+			pair[1] = s.scrubPairs(value, sep)
+		}
+	}
+}
+
+func (s *Scrubber) scrubMap(data map[string]any) {
+	for key, value := range data {
+		if value == nil {
+			continue
+		}
+
+		if s.isSensitiveKey(key) {
+			data[key] = Filtered
+
+			continue
+		}
+
+		if str, ok := value.(string); ok {
+			if sep, ok := pairKeys[strings.ToLower(key)]; ok {
+				str = s.scrubPairs(str, sep)
+			}
+
+			if _, ok := urlKeys[strings.ToLower(key)]; ok {
+				str = s.scrubURLQuery(str)
+			}
+
+			data[key] = s.scrubString(str)
+
+			continue
+		}
+
+		data[key] = s.scrubValue(value)
+	}
+}
+
+func (s *Scrubber) scrubValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		s.scrubMap(typed)
+	case []any:
+		for i := range typed {
+			typed[i] = s.scrubValue(typed[i])
+		}
+	case string:
+		return s.scrubString(typed)
+	}
+
+	return value
+}
+
+// scrubString removes the matches of the patterns and credit card numbers from the string.
+func (s *Scrubber) scrubString(value string) string {
+	for _, pattern := range s.patterns {
+		value = pattern.ReplaceAllString(value, Filtered)
+	}
+
+	if s.creditCards {
+		value = creditCardCandidate.ReplaceAllStringFunc(value, func(match string) string {
+			if isCreditCardNumber(match) {
+				return Filtered
+			}
+
+			return match
+		})
+	}
+
+	return value
+}
+
+// scrubPairs removes the values of sensitive pairs of query strings and cookie headers.
+func (s *Scrubber) scrubPairs(value, sep string) string {
+	pairs := strings.Split(value, sep)
+	for i, pair := range pairs {
+		name, _, ok := strings.Cut(pair, "=")
+		if ok && s.isSensitiveKey(strings.TrimSpace(name)) {
+			pairs[i] = name + "=" + Filtered
+		}
+	}
+
+	return strings.Join(pairs, sep)
+}
+
+// scrubURLQuery removes the values of sensitive pairs of the first query string of the value,
+// the query string ends with the fragment or a whitespace, e.g. in "GET /path?token=abc HTTP/1.1".
+func (s *Scrubber) scrubURLQuery(value string) string {
+	start := strings.IndexByte(value, '?')
+	if start < 0 {
+		return value
+	}
+
+	start++
+	end := strings.IndexAny(value[start:], "# \t\n")
+	if end < 0 {
+		end = len(value)
+	} else {
+		end += start
+	}
+
+	return value[:start] + s.scrubPairs(value[start:end], "&") + value[end:]
+}
+
+// isSensitiveKey checks whether a sensitive key, or its plural, is a run of whole words of the key,
+// so "auth" matches "auth_token" and "X-Auth" but not "author" or "oauth_provider",
+// and "apikey" matches "X-Api-Key" and "apiKey".
+func (s *Scrubber) isSensitiveKey(key string) bool {
+	if len(s.sensitiveKeys) == 0 {
+		return false
+	}
+
+	words := keyWords(key)
+	for i := range words {
+		var run string
+		for _, word := range words[i:] {
+			run += word
+			for _, sensitive := range s.sensitiveKeys {
+				if run == sensitive || run == sensitive+"s" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// keyWords splits the key into lower case words on separators and camelCase boundaries,
+// e.g. "X-Api-Key" and "xApiKey" into "x", "api" and "key", and "APIKey" into "api" and "key".
+func keyWords(key string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+
+	runes := []rune(strings.TrimSpace(key))
+	for i, r := range runes {
+		switch r {
+		case '-', '_', '.', ' ':
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = word[:0]
+			}
+
+			continue
+		}
+
+		if unicode.IsUpper(r) && len(word) > 0 {
+			prev := runes[i-1]
+			acronymEnd := unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || acronymEnd {
+				words = append(words, string(word))
+				word = word[:0]
+			}
+		}
+
+		word = append(word, unicode.ToLower(r))
+	}
+
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	return words
+}
+
+// normalizeKey lowers the key and strips separators, so "X-Api-Key" and "api_key" match "apikey".
+func normalizeKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', '.', ' ':
+			return -1
+		default:
+			return r
+		}
+	}, strings.ToLower(strings.TrimSpace(key)))
+}
+
+// isCreditCardNumber checks the digits of the candidate with the Luhn algorithm.
+func isCreditCardNumber(candidate string) bool {
+	var sum, count int
+	for i := len(candidate) - 1; i >= 0; i-- {
+		ch := candidate[i]
+		if ch < '0' || ch > '9' {
+			continue
+		}
+
+		digit := int(ch - '0')
+		if count%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		count++
+	}
+
+	return count >= 13 && count <= 19 && sum%10 == 0
+}
+
+// removeHeaders removes the request headers sent as a map or as a list of [name, value] pairs.
+func removeHeaders(request map[string]any, remove func(name string) bool) {
+	switch headers := request["headers"].(type) {
+	case map[string]any:
+		for name := range headers {
+			if remove(name) {
+				delete(headers, name)
+			}
+		}
+	case []any:
+		request["headers"] = slices.DeleteFunc(headers, func(raw any) bool {
+			_, name, _, ok := stringPair(raw)
+
+			return ok && remove(name)
+		})
+	}
+}
+
+// updateHeaders replaces the string values of the request headers sent as a map
+// or as a list of [name, value] pairs.
+func updateHeaders(request map[string]any, update func(name, value string) string) {
+	switch headers := request["headers"].(type) {
+	case map[string]any:
+		for name, raw := range headers {
+			if value, ok := raw.(string); ok {
+				headers[name] = update(name, value)
+			}
+		}
+	case []any:
+		for _, raw := range headers {
+			if pair, name, value, ok := stringPair(raw); ok {
+				pair[1] = update(name, value)
+			}
+		}
+	}
+}
+
+// stringPair returns the pair and its name and value when raw is a [name, value] pair of strings.
+func stringPair(raw any) (pair []any, name, value string, ok bool) {
+	pair, ok = raw.([]any)
+	if !ok || len(pair) != 2 {
+		return nil, "", "", false
+	}
+
+	name, nameOK := pair[0].(string)
+	value, valueOK := pair[1].(string)
+
+	return pair, name, value, nameOK && valueOK
+}
+
+// anonymizeValue anonymizes the IP addresses of the string value of the key.
+func anonymizeValue(data map[string]any, key string) {
+	if value, ok := data[key].(string); ok {
+		data[key] = anonymizeIPs(value)
+	}
+}
+
+// anonymizeIPs anonymizes every IP address of a comma separated list, e.g. the X-Forwarded-For header.
+func anonymizeIPs(value string) string {
+	parts := strings.Split(value, ",")
+	for i, part := range parts {
+		trimmed := strings.TrimSpace(part)
+
+		addr, err := netip.ParseAddr(trimmed)
+		if err != nil {
+			// Forwarded header elements and addresses with ports
+			if addrPort, err := netip.ParseAddrPort(trimmed); err == nil {
+				addr = addrPort.Addr()
+			} else {
+				parts[i] = strings.Replace(part, trimmed, Filtered, 1)
+
+				continue
+			}
+		}
+
+		parts[i] = strings.Replace(part, trimmed, AnonymizeIP(addr).String(), 1)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// AnonymizeIP zeroes the last octet of IPv4 addresses and the last 80 bits of IPv6 addresses.
+func AnonymizeIP(addr netip.Addr) netip.Addr {
+	bits := 24
+	if addr.Is6() && !addr.Is4In6() {
+		bits = 48
+	}
+
+	prefix, err := addr.Unmap().Prefix(bits)
+	if err != nil {
+		return addr
+	}
+
+	return prefix.Addr()
+}
@@ -0,0 +1,291 @@
+package datascrubbing
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func parseEvent(t *testing.T, data string) map[string]any {
+	t.Helper()
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal([]byte(data), &event))
+
+	return event
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		scrubbing domain.DataScrubbing
+	}{
+		{name: "empty field", scrubbing: domain.DataScrubbing{SensitiveFields: []string{" _ "}}},
+		{name: "invalid pattern", scrubbing: domain.DataScrubbing{Patterns: []string{"("}}},
+		{name: "pattern matching empty strings", scrubbing: domain.DataScrubbing{Patterns: []string{"a*"}}},
+		{name: "too many fields", scrubbing: domain.DataScrubbing{SensitiveFields: make([]string, maxPatterns+1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Compile(tt.scrubbing)
+			require.ErrorIs(t, err, domain.ErrInvalidDataScrubbing)
+		})
+	}
+}
+
+func TestScrubber_DefaultScrubbers(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{DefaultScrubbers: true})
+	require.NoError(t, err)
+
+	event := parseEvent(t, `{
+		"event_id": "9ec79c33ec9942ab8353589fcb2e04dc",
+		"message": "payment failed for card 4111 1111 1111 1111, order 1234567890123",
+		"request": {
+			"url": "https://example.com/login",
+			"query_string": "next=/home&access_token=abc123",
+			"headers": {"Authorization": "Bearer abc", "X-Api-Key": "k", "User-Agent": "curl/8.0"},
+			"cookies": "sessionid=abc; theme=dark",
+			"data": {"username": "john", "password": "hunter2"}
+		},
+		"extra": {"db": {"mysql_pwd": "root"}, "tokens": ["a", "b"]},
+		"exception": {"values": [{"type": "Error", "stacktrace": {"frames": [{"vars": {"client_secret": "s"}}]}}]}
+	}`)
+
+	scrubber.Scrub(event)
+
+	request := event["request"].(map[string]any)
+	headers := request["headers"].(map[string]any)
+	require.Equal(t, Filtered, headers["Authorization"])
+	require.Equal(t, Filtered, headers["X-Api-Key"])
+	require.Equal(t, "curl/8.0", headers["User-Agent"])
+	require.Equal(t, "next=/home&access_token="+Filtered, request["query_string"])
+	require.Equal(t, Filtered, request["cookies"])
+	require.Equal(t, "john", request["data"].(map[string]any)["username"])
+	require.Equal(t, Filtered, request["data"].(map[string]any)["password"])
+
+	extra := event["extra"].(map[string]any)
+	require.Equal(t, Filtered, extra["db"].(map[string]any)["mysql_pwd"])
+	require.Equal(t, Filtered, extra["tokens"])
+
+	frames := event["exception"].(map[string]any)["values"].([]any)[0].(map[string]any)["stacktrace"].(map[string]any)
+	vars := frames["frames"].([]any)[0].(map[string]any)["vars"].(map[string]any)
+	require.Equal(t, Filtered, vars["client_secret"])
+
+	// Only numbers passing the Luhn check are credit cards
+	require.Equal(t, "payment failed for card "+Filtered+", order 1234567890123", event["message"])
+	require.Equal(t, "9ec79c33ec9942ab8353589fcb2e04dc", event["event_id"])
+}
+
+func TestScrubber_DefaultSensitiveKeys(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{DefaultScrubbers: true})
+	require.NoError(t, err)
+
+	tests := []struct {
+		key       string
+		sensitive bool
+	}{
+		{key: "auth", sensitive: true},
+		{key: "Authorization", sensitive: true},
+		{key: "X-Auth-Token", sensitive: true},
+		{key: "authToken", sensitive: true},
+		{key: "author", sensitive: false},
+		{key: "authority", sensitive: false},
+		{key: "oauth_provider", sensitive: false},
+		{key: "oauth2Token", sensitive: true},
+		{key: "apiKey", sensitive: true},
+		{key: "APIKey", sensitive: true},
+		{key: "X-Api-Key", sensitive: true},
+		{key: "api.key", sensitive: true},
+		{key: "access_token", sensitive: true},
+		{key: "tokens", sensitive: true},
+		{key: "tokenizer", sensitive: false},
+		{key: "mysql_pwd", sensitive: true},
+		{key: "session_id", sensitive: true},
+		{key: "creditCardNumber", sensitive: true},
+		{key: "username", sensitive: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.sensitive, scrubber.isSensitiveKey(tt.key))
+		})
+	}
+}
+
+func TestScrubber_CustomFieldsAndPatterns(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{
+		SensitiveFields: []string{"email", "Phone-Number"},
+		Patterns:        []string{`[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}`},
+	})
+	require.NoError(t, err)
+
+	event := parseEvent(t, `{
+		"message": "user john@example.com signed up",
+		"user": {"id": "42", "email": "john@example.com"},
+		"extra": {"phone_number": "+1 555 0100", "password": "kept without the default scrubbers"}
+	}`)
+
+	scrubber.Scrub(event)
+
+	require.Equal(t, "user "+Filtered+" signed up", event["message"])
+	require.Equal(t, "42", event["user"].(map[string]any)["id"])
+	require.Equal(t, Filtered, event["user"].(map[string]any)["email"])
+	require.Equal(t, Filtered, event["extra"].(map[string]any)["phone_number"])
+	require.Equal(t, "kept without the default scrubbers", event["extra"].(map[string]any)["password"])
+}
+
+func TestScrubber_RequestAndIPs(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{
+		AnonymizeIP:        true,
+		DropRequestCookies: true,
+		DropRequestData:    true,
+	})
+	require.NoError(t, err)
+
+	event := parseEvent(t, `{
+		"request": {
+			"cookies": {"theme": "dark"},
+			"data": "a=1",
+			"headers": {"Cookie": "theme=dark", "X-Forwarded-For": "203.0.113.195, 2001:db8:85a3::8a2e:370:7334"},
+			"env": {"REMOTE_ADDR": "198.51.100.7"}
+		},
+		"user": {"ip_address": "192.0.2.33"}
+	}`)
+
+	scrubber.Scrub(event)
+
+	request := event["request"].(map[string]any)
+	require.NotContains(t, request, "cookies")
+	require.NotContains(t, request, "data")
+	require.NotContains(t, request["headers"], "Cookie")
+	require.Equal(t, "203.0.113.0, 2001:db8:85a3::", request["headers"].(map[string]any)["X-Forwarded-For"])
+	require.Equal(t, "198.51.100.0", request["env"].(map[string]any)["REMOTE_ADDR"])
+	require.Equal(t, "192.0.2.0", event["user"].(map[string]any)["ip_address"])
+}
+
+func TestScrubber_RequestPairs(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{DefaultScrubbers: true, AnonymizeIP: true})
+	require.NoError(t, err)
+
+	event := parseEvent(t, `{
+		"request": {
+			"headers": [
+				["Authorization", "Bearer abc"],
+				["X-Forwarded-For", "203.0.113.195"],
+				["User-Agent", "curl/8.0"]
+			],
+			"cookies": [["sessionid", "abc"], ["theme", "dark"]],
+			"query_string": [["access_token", "abc123"], ["next", "/home"]]
+		}
+	}`)
+
+	scrubber.Scrub(event)
+
+	request := event["request"].(map[string]any)
+	require.Equal(t, []any{
+		[]any{"Authorization", Filtered},
+		[]any{"X-Forwarded-For", "203.0.113.0"},
+		[]any{"User-Agent", "curl/8.0"},
+	}, request["headers"])
+	// "cookies" is a sensitive key itself like in the map form
+	require.Equal(t, Filtered, request["cookies"])
+	require.Equal(t, []any{[]any{"access_token", Filtered}, []any{"next", "/home"}}, request["query_string"])
+}
+
+func TestScrubber_RequestPairsCustomFields(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{
+		SensitiveFields:    []string{"x_tenant", "sessionid"},
+		DropRequestCookies: true,
+	})
+	require.NoError(t, err)
+
+	event := parseEvent(t, `{
+		"request": {
+			"query_string": [["sessionid", "abc"], ["page", "2"]],
+			"headers": [
+				["Cookie", "theme=dark"],
+				["Set-Cookie", "sessionid=abc"],
+				["X-Tenant", "acme"],
+				["Referer", "https://example.com/?x_tenant=acme&page=2"]
+			]
+		}
+	}`)
+
+	scrubber.Scrub(event)
+
+	require.Equal(t, []any{
+		[]any{"X-Tenant", Filtered},
+		[]any{"Referer", "https://example.com/?x_tenant=acme&page=2"},
+	}, event["request"].(map[string]any)["headers"])
+	require.Equal(t, []any{[]any{"sessionid", Filtered}, []any{"page", "2"}},
+		event["request"].(map[string]any)["query_string"])
+}
+
+func TestScrubber_Transaction(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := Compile(domain.DataScrubbing{DefaultScrubbers: true})
+	require.NoError(t, err)
+
+	event := parseEvent(t, `{
+		"type": "transaction",
+		"transaction": "/checkout",
+		"tags": {"api_key": "k", "region": "eu"},
+		"spans": [
+			{"op": "http.client", "description": "GET https://api.example.com/pay?access_token=abc&id=7#top"},
+			{"op": "db.query", "description": "SELECT * FROM cards WHERE number = '4111 1111 1111 1111'",
+			 "data": {"db.password": "hunter2"}}
+		]
+	}`)
+
+	scrubber.Scrub(event)
+
+	require.Equal(t, map[string]any{"api_key": Filtered, "region": "eu"}, event["tags"])
+
+	spans := event["spans"].([]any)
+	require.Equal(t, "GET https://api.example.com/pay?access_token="+Filtered+"&id=7#top",
+		spans[0].(map[string]any)["description"])
+	require.Equal(t, "SELECT * FROM cards WHERE number = '"+Filtered+"'", spans[1].(map[string]any)["description"])
+	require.Equal(t, Filtered, spans[1].(map[string]any)["data"].(map[string]any)["db.password"])
+}
+
+func TestScrubber_Nil(t *testing.T) {
+	t.Parallel()
+
+	var scrubber *Scrubber
+
+	event := map[string]any{"password": "hunter2"}
+	scrubber.Scrub(event)
+	require.Equal(t, "hunter2", event["password"])
+}
+
+func TestAnonymizeIP(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "10.1.2.0", AnonymizeIP(netip.MustParseAddr("10.1.2.3")).String())
+	require.Equal(t, "10.1.2.0", AnonymizeIP(netip.MustParseAddr("::ffff:10.1.2.3")).String())
+	require.Equal(t, "2001:db8:1::", AnonymizeIP(netip.MustParseAddr("2001:db8:1:2::1")).String())
+}
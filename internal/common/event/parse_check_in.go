@@ -0,0 +1,97 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rom8726/warden/internal/common/monitors"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// ParseCheckIn parses a check_in envelope item payload.
+func ParseCheckIn(data map[string]any) (domain.CheckIn, error) {
+	checkIn := domain.CheckIn{
+		ID:          extractString(data, "check_in_id"),
+		MonitorSlug: extractString(data, "monitor_slug"),
+		Status:      domain.CheckInStatus(extractString(data, "status")),
+		Environment: extractEnvironment(data),
+	}
+
+	if checkIn.MonitorSlug == "" {
+		return domain.CheckIn{}, errors.New("monitor_slug is required")
+	}
+
+	if !domain.IsValidReportedCheckInStatus(checkIn.Status) {
+		return domain.CheckIn{}, fmt.Errorf("invalid status %q", checkIn.Status)
+	}
+
+	// Check-ins without an id can't be finished later, so they are standalone
+	if checkIn.ID == "" {
+		checkIn.ID = uuid.NewString()
+	}
+
+	if duration := extractSeconds(data, "duration"); duration > 0 {
+		checkIn.Duration = &duration
+	}
+
+	if configRaw, ok := data["monitor_config"].(map[string]any); ok {
+		config, err := parseMonitorConfig(configRaw)
+		if err != nil {
+			return domain.CheckIn{}, fmt.Errorf("parse monitor_config: %w", err)
+		}
+
+		checkIn.MonitorConfig = &config
+	}
+
+	return checkIn, nil
+}
+
+func parseMonitorConfig(data map[string]any) (domain.MonitorConfig, error) {
+	config := domain.MonitorConfig{
+		Timezone:      extractString(data, "timezone"),
+		CheckInMargin: extractMinutes(data, "checkin_margin", domain.DefaultMonitorCheckInMargin),
+		MaxRuntime:    extractMinutes(data, "max_runtime", domain.DefaultMonitorMaxRuntime),
+	}
+
+	if config.Timezone == "" {
+		config.Timezone = domain.DefaultMonitorTimezone
+	}
+
+	schedule, ok := data["schedule"].(map[string]any)
+	if !ok {
+		return domain.MonitorConfig{}, errors.New("schedule is required")
+	}
+
+	config.ScheduleType = domain.MonitorScheduleType(extractString(schedule, "type"))
+	switch config.ScheduleType {
+	case domain.MonitorScheduleCrontab:
+		config.Schedule = extractString(schedule, "value")
+	case domain.MonitorScheduleInterval:
+		value := extractCount(schedule, "value")
+		if value == 0 {
+			return domain.MonitorConfig{}, errors.New("schedule value must be positive")
+		}
+
+		config.Schedule = monitors.IntervalSchedule(value, extractString(schedule, "unit"))
+	default:
+		return domain.MonitorConfig{}, fmt.Errorf("invalid schedule type %q", config.ScheduleType)
+	}
+
+	if config.Schedule == "" {
+		return domain.MonitorConfig{}, errors.New("schedule value is required")
+	}
+
+	return config, nil
+}
+
+func extractMinutes(data map[string]any, key string, defaultValue time.Duration) time.Duration {
+	value := extractCount(data, key)
+	if value == 0 {
+		return defaultValue
+	}
+
+	return time.Duration(value) * time.Minute
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParseCheckIn(t *testing.T) {
+	const payload = `{
+  "check_in_id": "c-1",
+  "monitor_slug": "nightly-report",
+  "status": "ok",
+  "duration": 12.5,
+  "environment": "production",
+  "monitor_config": {
+    "schedule": {"type": "interval", "value": 2, "unit": "hour"},
+    "checkin_margin": 5,
+    "timezone": "Europe/Berlin"
+  }
+}`
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payload), &data))
+
+	checkIn, err := ParseCheckIn(data)
+	require.NoError(t, err)
+
+	require.Equal(t, "c-1", checkIn.ID)
+	require.Equal(t, "nightly-report", checkIn.MonitorSlug)
+	require.Equal(t, domain.CheckInStatusOK, checkIn.Status)
+	require.Equal(t, "production", checkIn.Environment)
+	require.NotNil(t, checkIn.Duration)
+	require.Equal(t, 12500*time.Millisecond, *checkIn.Duration)
+
+	require.NotNil(t, checkIn.MonitorConfig)
+	require.Equal(t, domain.MonitorConfig{
+		ScheduleType:  domain.MonitorScheduleInterval,
+		Schedule:      "2 hour",
+		Timezone:      "Europe/Berlin",
+		CheckInMargin: 5 * time.Minute,
+		MaxRuntime:    domain.DefaultMonitorMaxRuntime,
+	}, *checkIn.MonitorConfig)
+}
+
+func TestParseCheckIn_WithoutID(t *testing.T) {
+	checkIn, err := ParseCheckIn(map[string]any{"monitor_slug": "job", "status": "in_progress"})
+	require.NoError(t, err)
+	require.NotEmpty(t, checkIn.ID)
+	require.Nil(t, checkIn.Duration)
+	require.Nil(t, checkIn.MonitorConfig)
+}
+
+func TestParseCheckIn_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]any
+	}{
+		{
+			name: "missing slug",
+			data: map[string]any{"status": "ok"},
+		},
+		{
+			name: "status set by the server",
+			data: map[string]any{"monitor_slug": "job", "status": "missed"},
+		},
+		{
+			name: "unknown schedule type",
+			data: map[string]any{
+				"monitor_slug":   "job",
+				"status":         "ok",
+				"monitor_config": map[string]any{"schedule": map[string]any{"type": "rrule", "value": "x"}},
+			},
+		},
+		{
+			name: "empty crontab",
+			data: map[string]any{
+				"monitor_slug":   "job",
+				"status":         "ok",
+				"monitor_config": map[string]any{"schedule": map[string]any{"type": "crontab"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCheckIn(tt.data)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// clientReportLists are the lists of discarded data in client_report items,
+// older SDKs split discarded_events by the kind of the drop.
+var clientReportLists = []string{
+	"discarded_events",
+	"rate_limited_events",
+	"filtered_events",
+	"filtered_sampling_events",
+}
+
+// ParseClientReport parses a client_report item payload, counters without a reason or a category are skipped.
+func ParseClientReport(data map[string]any) []domain.ClientDiscard {
+	var discards []domain.ClientDiscard
+	for _, list := range clientReportLists {
+		items, _ := data[list].([]any)
+		for _, itemRaw := range items {
+			item, ok := itemRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			discard := domain.ClientDiscard{
+				Reason:   extractString(item, "reason"),
+				Category: domain.DataCategory(extractString(item, "category")),
+				Quantity: extractCount(item, "quantity"),
+			}
+			if discard.Reason == "" || discard.Category == "" || discard.Quantity == 0 {
+				continue
+			}
+
+			discards = append(discards, discard)
+		}
+	}
+
+	return discards
+}
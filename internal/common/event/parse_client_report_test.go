@@ -0,0 +1,34 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParseClientReport(t *testing.T) {
+	const payload = `{
+  "timestamp": 1718532000.5,
+  "discarded_events": [
+    {"reason": "queue_overflow", "category": "error", "quantity": 23},
+    {"reason": "sample_rate", "category": "transaction", "quantity": 5},
+    {"reason": "before_send", "category": "error", "quantity": 0},
+    {"category": "error", "quantity": 1}
+  ],
+  "rate_limited_events": [
+    {"reason": "ratelimit_backoff", "category": "session", "quantity": 2}
+  ]
+}`
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payload), &data))
+
+	require.Equal(t, []domain.ClientDiscard{
+		{Reason: "queue_overflow", Category: domain.DataCategoryError, Quantity: 23},
+		{Reason: "sample_rate", Category: domain.DataCategoryTransaction, Quantity: 5},
+		{Reason: "ratelimit_backoff", Category: domain.DataCategorySession, Quantity: 2},
+	}, ParseClientReport(data))
+}
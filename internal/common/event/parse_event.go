@@ -12,7 +12,11 @@ import (
 
 const unknownKeyword = "unknown"
 
-func ParseEvent(eventData map[string]any, projectID domain.ProjectID) (domain.Event, error) {
+func ParseEvent(
+	eventData map[string]any,
+	projectID domain.ProjectID,
+	groupingConfig domain.GroupingConfig,
+) (domain.Event, error) {
 	// Extract event ID
 	eventIDRaw, ok := eventData["event_id"]
 	if !ok {
@@ -44,6 +48,12 @@ func ParseEvent(eventData map[string]any, projectID domain.ProjectID) (domain.Ev
 	// Extract release
 	release := extractRelease(eventData)
 
+	// Extract transaction name
+	transaction := extractTransaction(eventData)
+
+	// Extract SDK-supplied grouping fingerprint
+	fingerprint := extractFingerprint(eventData)
+
 	// Convert the event data to JSON
 	rawData, err := json.Marshal(eventData)
 	if err != nil {
@@ -52,6 +62,7 @@ func ParseEvent(eventData map[string]any, projectID domain.ProjectID) (domain.Ev
 
 	source := domain.SourceEvent
 	var exceptionData domain.ExceptionData
+	var exceptions []domain.ExceptionInfo
 	if _, ok := eventData["exception"]; ok { //nolint:nestif // need refactoring
 		if level != domain.IssueLevelFatal {
 			source = domain.SourceException
@@ -71,6 +82,15 @@ func ParseEvent(eventData map[string]any, projectID domain.ProjectID) (domain.Ev
 				message = value.Value
 			}
 		}
+
+		exceptions = make([]domain.ExceptionInfo, 0, len(exValues))
+		for _, value := range exValues {
+			exceptions = append(exceptions, domain.ExceptionInfo{
+				Type:       value.Type,
+				Value:      value.Value,
+				Stacktrace: value.Stacktrace,
+			})
+		}
 	}
 
 	// ------------------------------------------------------------------
@@ -102,13 +122,19 @@ func ParseEvent(eventData map[string]any, projectID domain.ProjectID) (domain.Ev
 		ServerName:          serverName,
 		Release:             release,
 		Environment:         environment,
+		Transaction:         transaction,
+		Fingerprint:         fingerprint,
+		Exceptions:          exceptions,
 		ExceptionData:       exceptionData,
 		EventRequestContext: reqCtx,
 		EventUserData:       userCtx,
 		EventRuntimeContext: runtimeCtx,
 	}
 
-	event.GroupHash = event.FullFingerprint()
+	// Project grouping rules, may override the SDK-supplied fingerprint
+	groupingConfig.Apply(&event)
+
+	event.GroupHash = event.GroupingFingerprint()
 
 	return event, nil
 }
@@ -439,3 +465,33 @@ func extractRelease(eventData map[string]any) string {
 
 	return unknownKeyword
 }
+
+func extractTransaction(eventData map[string]any) string {
+	if transactionRaw, ok := eventData["transaction"]; ok && transactionRaw != nil {
+		return strings.TrimSpace(fmt.Sprint(transactionRaw))
+	}
+
+	return ""
+}
+
+// extractFingerprint extracts the SDK-supplied fingerprint (scope.SetFingerprint).
+func extractFingerprint(eventData map[string]any) []string {
+	fingerprintRaw, ok := eventData["fingerprint"].([]any)
+	if !ok {
+		return nil
+	}
+
+	fingerprint := make([]string, 0, len(fingerprintRaw))
+	for _, component := range fingerprintRaw {
+		if component == nil {
+			continue
+		}
+		fingerprint = append(fingerprint, fmt.Sprint(component))
+	}
+
+	if len(fingerprint) == 0 {
+		return nil
+	}
+
+	return fingerprint
+}
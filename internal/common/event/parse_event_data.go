@@ -0,0 +1,94 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// ParseEventData decodes the breadcrumbs, contexts, extra data, SDK info and exceptions
+// of a stored event payload.
+func ParseEventData(payload json.RawMessage) (domain.EventData, error) {
+	if len(payload) == 0 {
+		return domain.EventData{}, nil
+	}
+
+	var eventData map[string]any
+	if err := json.Unmarshal(payload, &eventData); err != nil {
+		return domain.EventData{}, fmt.Errorf("unmarshal event payload: %w", err)
+	}
+
+	exValues, err := extractExceptionValues(eventData)
+	if err != nil {
+		return domain.EventData{}, fmt.Errorf("extract exception values: %w", err)
+	}
+
+	data := domain.EventData{
+		Breadcrumbs: extractBreadcrumbs(eventData),
+		SDK:         extractSDK(eventData),
+	}
+	data.Contexts, _ = eventData["contexts"].(map[string]any)
+	data.Extra, _ = eventData["extra"].(map[string]any)
+
+	for _, value := range exValues {
+		data.Exceptions = append(data.Exceptions, domain.ExceptionInfo(value))
+	}
+
+	return data, nil
+}
+
+// extractBreadcrumbs supports both {"values": [...]} and the legacy plain list.
+func extractBreadcrumbs(eventData map[string]any) []domain.Breadcrumb {
+	var items []any
+	switch raw := eventData["breadcrumbs"].(type) {
+	case map[string]any:
+		items, _ = raw["values"].([]any)
+	case []any:
+		items = raw
+	}
+
+	breadcrumbs := make([]domain.Breadcrumb, 0, len(items))
+	for _, item := range items {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		breadcrumb := domain.Breadcrumb{
+			Type:     extractString(itemMap, "type"),
+			Category: extractString(itemMap, "category"),
+			Level:    extractString(itemMap, "level"),
+			Message:  extractString(itemMap, "message"),
+		}
+		breadcrumb.Data, _ = itemMap["data"].(map[string]any)
+		if timestamp, ok := parseTimestamp(itemMap["timestamp"]); ok {
+			breadcrumb.Timestamp = &timestamp
+		}
+
+		breadcrumbs = append(breadcrumbs, breadcrumb)
+	}
+
+	return breadcrumbs
+}
+
+func extractSDK(eventData map[string]any) *domain.SDKInfo {
+	sdkRaw, ok := eventData["sdk"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	sdk := &domain.SDKInfo{
+		Name:    extractString(sdkRaw, "name"),
+		Version: extractString(sdkRaw, "version"),
+	}
+
+	integrations, _ := sdkRaw["integrations"].([]any)
+	for _, integration := range integrations {
+		if name, ok := integration.(string); ok {
+			sdk.Integrations = append(sdk.Integrations, name)
+		}
+	}
+
+	return sdk
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParseEventData(t *testing.T) {
+	const payload = `{
+  "event_id": "ev-1",
+  "exception": {"values": [
+    {"type": "ValueError", "value": "bad value", "stacktrace": {"frames": [{"function": "parse"}]}},
+    {"type": "HTTPError", "value": "request failed"}
+  ]},
+  "breadcrumbs": {"values": [
+    {"timestamp": 1750068000.5, "type": "http", "category": "httplib", "data": {"status_code": 500}},
+    {"timestamp": "2025-06-16T10:00:01Z", "level": "info", "message": "user clicked"},
+    "junk"
+  ]},
+  "contexts": {"os": {"name": "Linux"}},
+  "extra": {"order_id": 42},
+  "sdk": {"name": "sentry.python", "version": "2.1.0", "integrations": ["django", 1]}
+}`
+
+	data, err := ParseEventData([]byte(payload))
+	require.NoError(t, err)
+
+	require.Len(t, data.Exceptions, 2)
+	require.Equal(t, "ValueError", data.Exceptions[0].Type)
+	require.JSONEq(t, `{"frames": [{"function": "parse"}]}`, string(data.Exceptions[0].Stacktrace))
+	require.Equal(t, "HTTPError", data.Exceptions[1].Type)
+	require.Nil(t, data.Exceptions[1].Stacktrace)
+
+	require.Len(t, data.Breadcrumbs, 2)
+	require.Equal(t, time.Unix(1750068000, int64(500*time.Millisecond)).UTC(), *data.Breadcrumbs[0].Timestamp)
+	require.Equal(t, "httplib", data.Breadcrumbs[0].Category)
+	require.Equal(t, map[string]any{"status_code": float64(500)}, data.Breadcrumbs[0].Data)
+	require.Equal(t, "user clicked", data.Breadcrumbs[1].Message)
+
+	require.Equal(t, map[string]any{"os": map[string]any{"name": "Linux"}}, data.Contexts)
+	require.Equal(t, map[string]any{"order_id": float64(42)}, data.Extra)
+	require.Equal(t, &domain.SDKInfo{Name: "sentry.python", Version: "2.1.0", Integrations: []string{"django"}}, data.SDK)
+}
+
+func TestParseEventData_Empty(t *testing.T) {
+	data, err := ParseEventData(nil)
+	require.NoError(t, err)
+	require.Equal(t, domain.EventData{}, data)
+
+	data, err = ParseEventData([]byte(`{"breadcrumbs": [{"message": "legacy"}]}`))
+	require.NoError(t, err)
+	require.Len(t, data.Breadcrumbs, 1)
+	require.Equal(t, "legacy", data.Breadcrumbs[0].Message)
+	require.Nil(t, data.SDK)
+}
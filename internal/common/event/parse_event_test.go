@@ -7,11 +7,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/rom8726/warden/internal/common/groupingrules"
 	"github.com/rom8726/warden/internal/domain"
 )
 
 func TestParseEvent(t *testing.T) {
 	projectID := domain.ProjectID(1)
+	defaultCfg := domain.GroupingConfig{Strategy: domain.DefaultGroupingStrategy}
 
 	tests := []struct {
 		name      string
@@ -130,6 +132,54 @@ func TestParseEvent(t *testing.T) {
 				require.Empty(t, event.Tags) // should handle gracefully
 			},
 		},
+		{
+			name: "sdk fingerprint and transaction",
+			eventData: map[string]any{
+				"event_id":    "888",
+				"message":     "user 42 not found",
+				"transaction": "/users/{id}",
+				"fingerprint": []any{"{{ default }}", "users", 1, nil},
+			},
+			wantErr: false,
+			checks: func(t *testing.T, event domain.Event) {
+				require.Equal(t, "/users/{id}", event.Transaction)
+				require.Equal(t, []string{"{{ default }}", "users", "1"}, event.Fingerprint)
+				require.Equal(t, event.GroupingFingerprint(), event.GroupHash)
+				require.NotEqual(t, event.FullFingerprint(), event.GroupHash)
+			},
+		},
+		{
+			name: "malformed fingerprint field",
+			eventData: map[string]any{
+				"event_id":    "889",
+				"fingerprint": "not a list",
+			},
+			wantErr: false,
+			checks: func(t *testing.T, event domain.Event) {
+				require.Empty(t, event.Fingerprint)
+				require.Equal(t, event.FullFingerprint(), event.GroupHash)
+			},
+		},
+		{
+			name: "chained exceptions",
+			eventData: map[string]any{
+				"event_id": "890",
+				"exception": map[string]any{
+					"values": []any{
+						map[string]any{"type": "OSError", "value": "disk full"},
+						map[string]any{"type": "RuntimeError", "value": "save failed"},
+					},
+				},
+			},
+			wantErr: false,
+			checks: func(t *testing.T, event domain.Event) {
+				require.Len(t, event.Exceptions, 2)
+				require.Equal(t, "OSError", event.Exceptions[0].Type)
+				require.Equal(t, "RuntimeError", event.Exceptions[1].Type)
+				require.Equal(t, "OSError", *event.ExceptionType)
+				require.Equal(t, domain.DefaultGroupingStrategy, event.GroupingStrategy)
+			},
+		},
 		{
 			name: "missing optional fields",
 			eventData: map[string]any{
@@ -145,7 +195,7 @@ func TestParseEvent(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			event, err := ParseEvent(tt.eventData, projectID)
+			event, err := ParseEvent(tt.eventData, projectID, defaultCfg)
 			if tt.wantErr {
 				require.Error(t, err)
 			} else {
@@ -158,6 +208,76 @@ func TestParseEvent(t *testing.T) {
 	}
 }
 
+func TestParseEvent_StacktraceGrouping(t *testing.T) {
+	newEventData := func(id, value string, lineno int) map[string]any {
+		return map[string]any{
+			"event_id": id,
+			"exception": map[string]any{
+				"values": []any{
+					map[string]any{
+						"type":  "NotFoundError",
+						"value": value,
+						"stacktrace": map[string]any{
+							"frames": []any{
+								map[string]any{"module": "app.users", "function": "get", "lineno": lineno, "in_app": true},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	stacktraceCfg := domain.GroupingConfig{Strategy: domain.GroupingStrategyStacktraceV1}
+	legacyCfg := domain.GroupingConfig{Strategy: domain.GroupingStrategyLegacy}
+
+	ev1, err := ParseEvent(newEventData("1", "user 123 not found", 10), 1, stacktraceCfg)
+	require.NoError(t, err)
+	ev2, err := ParseEvent(newEventData("2", "user 456 not found", 12), 1, stacktraceCfg)
+	require.NoError(t, err)
+	require.Equal(t, ev1.GroupHash, ev2.GroupHash)
+
+	legacy1, err := ParseEvent(newEventData("1", "user 123 not found", 10), 1, legacyCfg)
+	require.NoError(t, err)
+	legacy2, err := ParseEvent(newEventData("2", "user 456 not found", 12), 1, legacyCfg)
+	require.NoError(t, err)
+	require.NotEqual(t, legacy1.GroupHash, legacy2.GroupHash)
+}
+
+func TestParseEvent_GroupingRules(t *testing.T) {
+	eventData := func(id, exType string) map[string]any {
+		return map[string]any{
+			"event_id":    id,
+			"fingerprint": []any{"sdk-" + id},
+			"exception": map[string]any{
+				"values": []any{
+					map[string]any{"type": exType, "value": "connection refused"},
+				},
+			},
+		}
+	}
+
+	cfg, err := groupingrules.Compile(domain.GroupingStrategyStacktraceV1, []domain.GroupingRule{{
+		ID:         1,
+		Type:       domain.GroupingRuleTypeFingerprint,
+		Expression: "error.type:DatabaseError -> database-error",
+	}})
+	require.NoError(t, err)
+
+	// The server-side rule takes precedence over the SDK fingerprint.
+	ev1, err := ParseEvent(eventData("1", "DatabaseError"), 1, cfg)
+	require.NoError(t, err)
+	ev2, err := ParseEvent(eventData("2", "DatabaseError"), 1, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"database-error"}, ev1.Fingerprint)
+	require.Equal(t, ev1.GroupHash, ev2.GroupHash)
+
+	// Events not matched by any rule keep the SDK fingerprint.
+	ev3, err := ParseEvent(eventData("3", "ValueError"), 1, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sdk-3"}, ev3.Fingerprint)
+}
+
 func TestParseEvent2(t *testing.T) {
 	projectID := domain.ProjectID(42)
 
@@ -247,7 +367,7 @@ func TestParseEvent2(t *testing.T) {
 	tsStr := "2025-06-07T11:59:59Z"
 	ts, _ := time.Parse(time.RFC3339, tsStr)
 
-	event, err := ParseEvent(eventData, projectID)
+	event, err := ParseEvent(eventData, projectID, domain.GroupingConfig{Strategy: domain.DefaultGroupingStrategy})
 	require.NoError(t, err)
 
 	// Simple scalar fields
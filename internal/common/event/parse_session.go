@@ -0,0 +1,126 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// sessionAggregateStatuses maps counters of a session aggregate bucket to session statuses.
+var sessionAggregateStatuses = []string{
+	domain.SessionStatusExited,
+	domain.SessionStatusErrored,
+	domain.SessionStatusCrashed,
+	domain.SessionStatusAbnormal,
+}
+
+// ParseSession parses a session envelope item payload.
+func ParseSession(projectID domain.ProjectID, data map[string]any) (domain.SessionUpdate, error) {
+	attrs, _ := data["attrs"].(map[string]any)
+
+	release := extractString(attrs, "release")
+	if release == "" {
+		return domain.SessionUpdate{}, errors.New("attrs.release is required")
+	}
+
+	started, ok := parseTimestamp(data["started"])
+	if !ok {
+		return domain.SessionUpdate{}, errors.New("started is required")
+	}
+
+	timestamp, ok := parseTimestamp(data["timestamp"])
+	if !ok {
+		timestamp = started
+	}
+
+	status := extractString(data, "status")
+	if status == "" {
+		status = domain.SessionStatusOK
+	}
+
+	init, _ := data["init"].(bool)
+
+	return domain.SessionUpdate{
+		ProjectID:   projectID,
+		SessionID:   extractString(data, "sid"),
+		DistinctID:  extractString(data, "did"),
+		Status:      status,
+		Init:        init,
+		Errors:      extractCount(data, "errors"),
+		Duration:    extractSeconds(data, "duration"),
+		Release:     release,
+		Environment: extractEnvironment(attrs),
+		Started:     started,
+		Timestamp:   timestamp,
+		Quantity:    1,
+	}, nil
+}
+
+// ParseSessionAggregates parses a sessions envelope item payload sent by server-mode SDKs.
+// Every non-empty status counter of an aggregate bucket becomes a separate update.
+func ParseSessionAggregates(projectID domain.ProjectID, data map[string]any) ([]domain.SessionUpdate, error) {
+	attrs, _ := data["attrs"].(map[string]any)
+
+	release := extractString(attrs, "release")
+	if release == "" {
+		return nil, errors.New("attrs.release is required")
+	}
+
+	environment := extractEnvironment(attrs)
+
+	aggregates, _ := data["aggregates"].([]any)
+	updates := make([]domain.SessionUpdate, 0, len(aggregates))
+	for i, aggregateRaw := range aggregates {
+		aggregate, ok := aggregateRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		started, ok := parseTimestamp(aggregate["started"])
+		if !ok {
+			return nil, fmt.Errorf("aggregate %d: started is required", i)
+		}
+
+		for _, status := range sessionAggregateStatuses {
+			quantity := extractCount(aggregate, status)
+			if quantity == 0 {
+				continue
+			}
+
+			updates = append(updates, domain.SessionUpdate{
+				ProjectID:   projectID,
+				DistinctID:  extractString(aggregate, "did"),
+				Status:      status,
+				Init:        true,
+				Release:     release,
+				Environment: environment,
+				Started:     started,
+				Timestamp:   started,
+				Quantity:    quantity,
+			})
+		}
+	}
+
+	return updates, nil
+}
+
+func extractCount(data map[string]any, key string) uint {
+	value, ok := data[key].(float64)
+	if !ok || value <= 0 {
+		return 0
+	}
+
+	return uint(value)
+}
+
+func extractSeconds(data map[string]any, key string) time.Duration {
+	value, ok := data[key].(float64)
+	if !ok || value <= 0 {
+		return 0
+	}
+
+	return time.Duration(math.Round(value * float64(time.Second)))
+}
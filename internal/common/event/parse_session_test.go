@@ -0,0 +1,85 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParseSession(t *testing.T) {
+	const payload = `{
+  "sid": "7c7b6585f901405c8c5d96c8c2c6e4e1",
+  "did": "user-42",
+  "init": true,
+  "started": "2025-06-16T10:00:00Z",
+  "timestamp": "2025-06-16T10:01:30.5Z",
+  "duration": 90.5,
+  "status": "crashed",
+  "errors": 2,
+  "attrs": {"release": "1.2.0", "environment": "production"}
+}`
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payload), &data))
+
+	session, err := ParseSession(1, data)
+	require.NoError(t, err)
+
+	require.Equal(t, domain.ProjectID(1), session.ProjectID)
+	require.Equal(t, "7c7b6585f901405c8c5d96c8c2c6e4e1", session.SessionID)
+	require.Equal(t, "user-42", session.DistinctID)
+	require.Equal(t, domain.SessionStatusCrashed, session.Status)
+	require.True(t, session.Init)
+	require.Equal(t, uint(2), session.Errors)
+	require.Equal(t, 90500*time.Millisecond, session.Duration)
+	require.Equal(t, "1.2.0", session.Release)
+	require.Equal(t, "production", session.Environment)
+	require.Equal(t, time.Date(2025, 6, 16, 10, 0, 0, 0, time.UTC), session.Started)
+	require.Equal(t, uint(1), session.Quantity)
+
+	delete(data, "status")
+	session, err = ParseSession(1, data)
+	require.NoError(t, err)
+	require.Equal(t, domain.SessionStatusOK, session.Status)
+
+	delete(data, "attrs")
+	_, err = ParseSession(1, data)
+	require.Error(t, err)
+}
+
+func TestParseSessionAggregates(t *testing.T) {
+	const payload = `{
+  "aggregates": [
+    {"started": "2025-06-16T10:00:00Z", "exited": 10, "errored": 2},
+    {"started": "2025-06-16T10:01:00Z", "did": "user-42", "crashed": 1}
+  ],
+  "attrs": {"release": "1.2.0"}
+}`
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payload), &data))
+
+	updates, err := ParseSessionAggregates(1, data)
+	require.NoError(t, err)
+	require.Len(t, updates, 3)
+
+	require.Equal(t, domain.SessionStatusExited, updates[0].Status)
+	require.Equal(t, uint(10), updates[0].Quantity)
+	require.True(t, updates[0].Init)
+	require.Equal(t, "1.2.0", updates[0].Release)
+
+	require.Equal(t, domain.SessionStatusErrored, updates[1].Status)
+	require.Equal(t, uint(2), updates[1].Quantity)
+
+	require.Equal(t, domain.SessionStatusCrashed, updates[2].Status)
+	require.Equal(t, "user-42", updates[2].DistinctID)
+	require.Equal(t, time.Date(2025, 6, 16, 10, 1, 0, 0, time.UTC), updates[2].Started)
+
+	data["aggregates"] = []any{map[string]any{"exited": 1.0}}
+	_, err = ParseSessionAggregates(1, data)
+	require.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// ParseTransaction parses a transaction envelope item payload.
+func ParseTransaction(projectID domain.ProjectID, data map[string]any) (domain.Transaction, error) {
+	eventID := extractString(data, "event_id")
+	if eventID == "" {
+		return domain.Transaction{}, errors.New("event_id is required")
+	}
+
+	timestamp, ok := parseTimestamp(data["timestamp"])
+	if !ok {
+		return domain.Transaction{}, errors.New("timestamp is required")
+	}
+
+	startTimestamp, ok := parseTimestamp(data["start_timestamp"])
+	if !ok {
+		return domain.Transaction{}, errors.New("start_timestamp is required")
+	}
+
+	traceCtx, _ := data["contexts"].(map[string]any)
+	traceCtx, _ = traceCtx["trace"].(map[string]any)
+
+	traceID := extractString(traceCtx, "trace_id")
+	if traceID == "" {
+		return domain.Transaction{}, errors.New("contexts.trace.trace_id is required")
+	}
+
+	transaction := domain.Transaction{
+		ID:             domain.EventID(eventID),
+		ProjectID:      projectID,
+		TraceID:        traceID,
+		SpanID:         extractString(traceCtx, "span_id"),
+		ParentSpanID:   extractString(traceCtx, "parent_span_id"),
+		Name:           extractTransaction(data),
+		Op:             extractString(traceCtx, "op"),
+		Status:         extractString(traceCtx, "status"),
+		Platform:       extractPlatform(data),
+		Environment:    extractEnvironment(data),
+		Release:        extractRelease(data),
+		Tags:           extractTags(data),
+		StartTimestamp: startTimestamp,
+		Timestamp:      timestamp,
+	}
+
+	if transaction.Status == "" {
+		transaction.Status = domain.SpanStatusUnknown
+	}
+
+	spansRaw, _ := data["spans"].([]any)
+	transaction.Spans = make([]domain.Span, 0, len(spansRaw))
+	for i, spanRaw := range spansRaw {
+		spanMap, ok := spanRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		span, err := parseSpan(transaction, spanMap)
+		if err != nil {
+			return domain.Transaction{}, fmt.Errorf("parse span %d: %w", i, err)
+		}
+
+		transaction.Spans = append(transaction.Spans, span)
+	}
+
+	return transaction, nil
+}
+
+func parseSpan(transaction domain.Transaction, data map[string]any) (domain.Span, error) {
+	spanID := extractString(data, "span_id")
+	if spanID == "" {
+		return domain.Span{}, errors.New("span_id is required")
+	}
+
+	startTimestamp, ok := parseTimestamp(data["start_timestamp"])
+	if !ok {
+		return domain.Span{}, errors.New("start_timestamp is required")
+	}
+
+	// Unfinished spans have no end timestamp
+	timestamp, ok := parseTimestamp(data["timestamp"])
+	if !ok {
+		timestamp = startTimestamp
+	}
+
+	traceID := extractString(data, "trace_id")
+	if traceID == "" {
+		traceID = transaction.TraceID
+	}
+
+	parentSpanID := extractString(data, "parent_span_id")
+	if parentSpanID == "" {
+		parentSpanID = transaction.SpanID
+	}
+
+	status := extractString(data, "status")
+	if status == "" {
+		status = domain.SpanStatusUnknown
+	}
+
+	return domain.Span{
+		ProjectID:      transaction.ProjectID,
+		TransactionID:  transaction.ID,
+		TraceID:        traceID,
+		SpanID:         spanID,
+		ParentSpanID:   parentSpanID,
+		Op:             extractString(data, "op"),
+		Description:    extractString(data, "description"),
+		Status:         status,
+		Tags:           extractTags(data),
+		StartTimestamp: startTimestamp,
+		Timestamp:      timestamp,
+	}, nil
+}
+
+// parseTimestamp parses a timestamp sent either as unix seconds with a fraction or as an RFC3339 string.
+func parseTimestamp(raw any) (time.Time, bool) {
+	switch value := raw.(type) {
+	case float64:
+		sec, frac := math.Modf(value)
+
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), true
+	case string:
+		ts, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		return ts.UTC(), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func extractString(data map[string]any, key string) string {
+	value, ok := data[key]
+	if !ok || value == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(fmt.Sprint(value))
+}
@@ -0,0 +1,104 @@
+package event
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParseTransaction(t *testing.T) {
+	const payload = `{
+  "event_id": "tx-1",
+  "type": "transaction",
+  "transaction": "GET /users",
+  "platform": "go",
+  "environment": "production",
+  "release": "1.2.0",
+  "start_timestamp": 1750068000.25,
+  "timestamp": "2025-06-16T10:00:01.5Z",
+  "contexts": {
+    "trace": {"trace_id": "trace-1", "span_id": "root", "op": "http.server", "status": "internal_error"}
+  },
+  "spans": [
+    {
+      "span_id": "db-1",
+      "op": "db.sql.query",
+      "description": "SELECT * FROM users",
+      "start_timestamp": 1750068000.5,
+      "timestamp": 1750068001.0,
+      "status": "ok"
+    },
+    {"span_id": "cache-1", "parent_span_id": "db-1", "start_timestamp": 1750068000.6}
+  ]
+}`
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(payload), &data))
+
+	transaction, err := ParseTransaction(1, data)
+	require.NoError(t, err)
+
+	start := time.Date(2025, 6, 16, 10, 0, 0, 250_000_000, time.UTC)
+	require.Equal(t, domain.EventID("tx-1"), transaction.ID)
+	require.Equal(t, "trace-1", transaction.TraceID)
+	require.Equal(t, "GET /users", transaction.Name)
+	require.Equal(t, "http.server", transaction.Op)
+	require.Equal(t, "internal_error", transaction.Status)
+	require.Equal(t, "1.2.0", transaction.Release)
+	require.Equal(t, start, transaction.StartTimestamp)
+	require.Equal(t, 1250*time.Millisecond, transaction.Duration())
+
+	require.Len(t, transaction.Spans, 2)
+
+	db := transaction.Spans[0]
+	require.Equal(t, "root", db.ParentSpanID)
+	require.Equal(t, "trace-1", db.TraceID)
+	require.Equal(t, domain.EventID("tx-1"), db.TransactionID)
+	require.Equal(t, 500*time.Millisecond, db.Duration())
+
+	cache := transaction.Spans[1]
+	require.Equal(t, "db-1", cache.ParentSpanID)
+	require.Equal(t, domain.SpanStatusUnknown, cache.Status)
+	require.Zero(t, cache.Duration())
+}
+
+func TestParseTransaction_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]any
+	}{
+		{
+			name: "missing trace context",
+			data: map[string]any{"event_id": "1", "timestamp": 2.0, "start_timestamp": 1.0},
+		},
+		{
+			name: "missing start timestamp",
+			data: map[string]any{
+				"event_id":  "1",
+				"timestamp": 2.0,
+				"contexts":  map[string]any{"trace": map[string]any{"trace_id": "t"}},
+			},
+		},
+		{
+			name: "span without id",
+			data: map[string]any{
+				"event_id":        "1",
+				"timestamp":       2.0,
+				"start_timestamp": 1.0,
+				"contexts":        map[string]any{"trace": map[string]any{"trace_id": "t"}},
+				"spans":           []any{map[string]any{"start_timestamp": 1.0}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTransaction(1, tt.data)
+			require.Error(t, err)
+		})
+	}
+}
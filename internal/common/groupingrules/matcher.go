@@ -0,0 +1,218 @@
+package groupingrules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// Matcher keys.
+const (
+	KeyErrorType     = "error.type"
+	KeyErrorValue    = "error.value"
+	KeyMessage       = "message"
+	KeyLevel         = "level"
+	KeyPlatform      = "platform"
+	KeyRelease       = "release"
+	KeyEnvironment   = "environment"
+	KeyTransaction   = "transaction"
+	KeyStackModule   = "stack.module"
+	KeyStackFunction = "stack.function"
+	KeyStackAbsPath  = "stack.abs_path"
+	KeyStackPackage  = "stack.package"
+
+	tagKeyPrefix = "tags."
+)
+
+var keyAliases = map[string]string{
+	"type":     KeyErrorType,
+	"value":    KeyErrorValue,
+	"module":   KeyStackModule,
+	"function": KeyStackFunction,
+	"path":     KeyStackAbsPath,
+	"package":  KeyStackPackage,
+}
+
+var eventKeys = map[string]struct{}{
+	KeyErrorType:   {},
+	KeyErrorValue:  {},
+	KeyMessage:     {},
+	KeyLevel:       {},
+	KeyPlatform:    {},
+	KeyRelease:     {},
+	KeyEnvironment: {},
+	KeyTransaction: {},
+}
+
+var frameKeys = map[string]struct{}{
+	KeyStackModule:   {},
+	KeyStackFunction: {},
+	KeyStackAbsPath:  {},
+	KeyStackPackage:  {},
+}
+
+// pathKeys are matched like paths: "*" does not cross "/" while "**" does.
+var pathKeys = map[string]struct{}{
+	KeyStackModule:  {},
+	KeyStackAbsPath: {},
+	KeyStackPackage: {},
+}
+
+// matcher is a single `[!]key:glob` condition of a rule.
+type matcher struct {
+	key     string
+	pattern *regexp.Regexp
+	negated bool
+}
+
+func parseMatcher(token string) (matcher, error) {
+	var m matcher
+	if strings.HasPrefix(token, "!") {
+		m.negated = true
+		token = token[1:]
+	}
+
+	key, pattern, ok := strings.Cut(token, ":")
+	if !ok || key == "" {
+		return matcher{}, fmt.Errorf("invalid matcher %q, expected key:value", token)
+	}
+
+	pattern = unquote(pattern)
+	if pattern == "" {
+		return matcher{}, fmt.Errorf("empty value for matcher %q", key)
+	}
+
+	if alias, ok := keyAliases[key]; ok {
+		key = alias
+	}
+
+	_, isEventKey := eventKeys[key]
+	_, isFrameKey := frameKeys[key]
+	if !isEventKey && !isFrameKey && !strings.HasPrefix(key, tagKeyPrefix) {
+		return matcher{}, fmt.Errorf("unknown matcher key %q", key)
+	}
+
+	_, isPath := pathKeys[key]
+	m.key = key
+	m.pattern = globToRegexp(pattern, isPath)
+
+	return m, nil
+}
+
+func (m matcher) isFrameMatcher() bool {
+	_, ok := frameKeys[m.key]
+
+	return ok
+}
+
+func (m matcher) matchValues(values ...string) bool {
+	matched := false
+	for _, value := range values {
+		if m.pattern.MatchString(value) {
+			matched = true
+
+			break
+		}
+	}
+
+	return matched != m.negated
+}
+
+func (m matcher) matchEvent(ev *domain.Event) bool {
+	switch m.key {
+	case KeyErrorType, KeyErrorValue:
+		chain := ev.ExceptionChain()
+		values := make([]string, 0, len(chain))
+		for _, exception := range chain {
+			if m.key == KeyErrorType {
+				values = append(values, exception.Type)
+			} else {
+				values = append(values, exception.Value)
+			}
+		}
+
+		return m.matchValues(values...)
+	case KeyMessage:
+		return m.matchValues(ev.Message)
+	case KeyLevel:
+		return m.matchValues(string(ev.Level))
+	case KeyPlatform:
+		return m.matchValues(ev.Platform)
+	case KeyRelease:
+		return m.matchValues(ev.Release)
+	case KeyEnvironment:
+		return m.matchValues(ev.Environment)
+	case KeyTransaction:
+		return m.matchValues(ev.Transaction)
+	default:
+		tag, ok := ev.Tags[strings.TrimPrefix(m.key, tagKeyPrefix)]
+		if !ok {
+			return m.negated
+		}
+
+		return m.matchValues(tag)
+	}
+}
+
+func (m matcher) matchFrame(frame *domain.StackFrame) bool {
+	switch m.key {
+	case KeyStackModule:
+		return m.matchValues(frame.ModuleName())
+	case KeyStackFunction:
+		return m.matchValues(frame.Function)
+	case KeyStackAbsPath:
+		if frame.AbsPath != "" {
+			return m.matchValues(frame.AbsPath, frame.Filename)
+		}
+
+		return m.matchValues(frame.Filename)
+	case KeyStackPackage:
+		return m.matchValues(frame.Package)
+	default:
+		return false
+	}
+}
+
+func matchFrameAll(matchers []matcher, frame *domain.StackFrame) bool {
+	for _, m := range matchers {
+		if !m.matchFrame(frame) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// globToRegexp converts a glob pattern to an anchored regular expression.
+// "**" matches any characters, "*" any characters (except "/" for paths), "?" a single character.
+func globToRegexp(glob string, isPath bool) *regexp.Regexp {
+	star := ".*"
+	if isPath {
+		star = "[^/]*"
+	}
+
+	var buf strings.Builder
+	buf.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				buf.WriteString(".*")
+				i++
+			} else {
+				buf.WriteString(star)
+			}
+		case '?':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	buf.WriteString("$")
+
+	return regexp.MustCompile(buf.String())
+}
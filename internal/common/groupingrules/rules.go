@@ -0,0 +1,338 @@
+// Package groupingrules parses and evaluates per-project grouping rules.
+//
+// Fingerprint rules assign a fingerprint to matching events:
+//
+//	error.type:DatabaseError -> database-error
+//	error.value:"connection refused" stack.module:app/db/** -> db-down {{ transaction }}
+//
+// Stack trace rules change how matching frames take part in grouping:
+//
+//	stack.module:vendor/** -app
+//	stack.function:log.* -group
+//
+// Matchers are `key:glob` pairs, all of them must match; a leading "!" negates a matcher.
+package groupingrules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/fingerprinter"
+)
+
+const fingerprintArrow = "->"
+
+// Frame actions of stack trace rules.
+const (
+	ActionAppInclude   = "+app"
+	ActionAppExclude   = "-app"
+	ActionGroupInclude = "+group"
+	ActionGroupExclude = "-group"
+)
+
+var ErrInvalidRule = domain.ErrInvalidGroupingRule
+
+// FingerprintRule assigns a fingerprint to events matched by all of its matchers.
+type FingerprintRule struct {
+	eventMatchers []matcher
+	frameMatchers []matcher
+	fingerprint   []string
+}
+
+// StacktraceRule applies actions to stack frames matched by all of its matchers.
+type StacktraceRule struct {
+	matchers []matcher
+	actions  []string
+}
+
+var (
+	_ domain.FingerprintRule = (*FingerprintRule)(nil)
+	_ domain.FrameRule       = (*StacktraceRule)(nil)
+)
+
+// Validate checks that the expression is a valid rule of the given type.
+func Validate(ruleType domain.GroupingRuleType, expression string) error {
+	switch ruleType {
+	case domain.GroupingRuleTypeFingerprint:
+		_, err := ParseFingerprintRule(expression)
+
+		return err
+	case domain.GroupingRuleTypeStacktrace:
+		_, err := ParseStacktraceRule(expression)
+
+		return err
+	default:
+		return fmt.Errorf("%w: unknown rule type %q", ErrInvalidRule, ruleType)
+	}
+}
+
+// Compile builds the project grouping configuration. Invalid rules are skipped
+// and reported in the returned error, valid rules are kept in their order.
+func Compile(strategy domain.GroupingStrategy, rules []domain.GroupingRule) (domain.GroupingConfig, error) {
+	cfg := domain.GroupingConfig{Strategy: strategy}
+
+	var errs []error
+	for _, rule := range rules {
+		switch rule.Type {
+		case domain.GroupingRuleTypeFingerprint:
+			parsed, err := ParseFingerprintRule(rule.Expression)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("rule %d: %w", rule.ID, err))
+
+				continue
+			}
+
+			cfg.FingerprintRules = append(cfg.FingerprintRules, parsed)
+		case domain.GroupingRuleTypeStacktrace:
+			parsed, err := ParseStacktraceRule(rule.Expression)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("rule %d: %w", rule.ID, err))
+
+				continue
+			}
+
+			cfg.FrameRules = append(cfg.FrameRules, parsed)
+		default:
+			errs = append(errs, fmt.Errorf("rule %d: %w: unknown rule type %q", rule.ID, ErrInvalidRule, rule.Type))
+		}
+	}
+
+	return cfg, errors.Join(errs...)
+}
+
+// ParseFingerprintRule parses `matchers -> fingerprint components`.
+func ParseFingerprintRule(expression string) (*FingerprintRule, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	arrowIdx := -1
+	for i, token := range tokens {
+		if token == fingerprintArrow {
+			arrowIdx = i
+
+			break
+		}
+	}
+
+	if arrowIdx < 0 {
+		return nil, fmt.Errorf("%w: missing %q", ErrInvalidRule, fingerprintArrow)
+	}
+	if arrowIdx == 0 {
+		return nil, fmt.Errorf("%w: no matchers", ErrInvalidRule)
+	}
+	if arrowIdx == len(tokens)-1 {
+		return nil, fmt.Errorf("%w: empty fingerprint", ErrInvalidRule)
+	}
+
+	rule := &FingerprintRule{}
+	for _, token := range tokens[:arrowIdx] {
+		m, err := parseMatcher(token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+
+		if m.isFrameMatcher() {
+			rule.frameMatchers = append(rule.frameMatchers, m)
+		} else {
+			rule.eventMatchers = append(rule.eventMatchers, m)
+		}
+	}
+
+	for _, token := range tokens[arrowIdx+1:] {
+		if token == fingerprintArrow {
+			return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidRule, fingerprintArrow)
+		}
+
+		if strings.HasPrefix(token, "{{") {
+			if name, ok := fingerprinter.ParseVariable(token); !ok || !fingerprinter.IsSupportedVariable(name) {
+				return nil, fmt.Errorf("%w: unknown variable %q", ErrInvalidRule, token)
+			}
+		}
+
+		rule.fingerprint = append(rule.fingerprint, unquote(token))
+	}
+
+	return rule, nil
+}
+
+// ParseStacktraceRule parses `matchers actions`.
+func ParseStacktraceRule(expression string) (*StacktraceRule, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &StacktraceRule{}
+	for _, token := range tokens {
+		switch token {
+		case ActionAppInclude, ActionAppExclude, ActionGroupInclude, ActionGroupExclude:
+			rule.actions = append(rule.actions, token)
+
+			continue
+		}
+
+		if len(rule.actions) > 0 {
+			return nil, fmt.Errorf("%w: matcher %q after actions", ErrInvalidRule, token)
+		}
+
+		m, err := parseMatcher(token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRule, err)
+		}
+
+		if !m.isFrameMatcher() {
+			return nil, fmt.Errorf("%w: only stack.* matchers are allowed in stack trace rules", ErrInvalidRule)
+		}
+
+		rule.matchers = append(rule.matchers, m)
+	}
+
+	if len(rule.matchers) == 0 {
+		return nil, fmt.Errorf("%w: no matchers", ErrInvalidRule)
+	}
+	if len(rule.actions) == 0 {
+		return nil, fmt.Errorf("%w: no actions, expected one of +app, -app, +group, -group", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+// Fingerprint returns the rule fingerprint when the event matches.
+func (r *FingerprintRule) Fingerprint(ev *domain.Event) ([]string, bool) {
+	if !r.Match(ev) {
+		return nil, false
+	}
+
+	fingerprint := make([]string, len(r.fingerprint))
+	copy(fingerprint, r.fingerprint)
+
+	return fingerprint, true
+}
+
+// Match reports whether the event matches all rule matchers. Stack matchers
+// must be satisfied by a single frame of any exception.
+func (r *FingerprintRule) Match(ev *domain.Event) bool {
+	for _, m := range r.eventMatchers {
+		if !m.matchEvent(ev) {
+			return false
+		}
+	}
+
+	if len(r.frameMatchers) == 0 {
+		return true
+	}
+
+	for _, exception := range ev.ExceptionChain() {
+		stacktrace := ev.Stacktrace(exception.Stacktrace)
+		for i := range stacktrace.Frames {
+			if matchFrameAll(r.frameMatchers, &stacktrace.Frames[i]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Match reports whether any frame of the event matches the rule.
+func (r *StacktraceRule) Match(ev *domain.Event) bool {
+	for _, exception := range ev.ExceptionChain() {
+		stacktrace := domain.ParseStacktrace(exception.Stacktrace)
+		for i := range stacktrace.Frames {
+			if matchFrameAll(r.matchers, &stacktrace.Frames[i]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ApplyFrame applies the rule actions to a matching frame and reports whether it matched.
+func (r *StacktraceRule) ApplyFrame(frame *domain.StackFrame) bool {
+	if !matchFrameAll(r.matchers, frame) {
+		return false
+	}
+
+	for _, action := range r.actions {
+		switch action {
+		case ActionAppInclude, ActionAppExclude:
+			inApp := action == ActionAppInclude
+			frame.InApp = &inApp
+		case ActionGroupInclude:
+			frame.ExcludeFromGroup = false
+		case ActionGroupExclude:
+			frame.ExcludeFromGroup = true
+		}
+	}
+
+	return true
+}
+
+// tokenize splits an expression by whitespace, keeping quoted values and {{ variables }} whole.
+func tokenize(expression string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		quote   byte
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(expression); i++ {
+		ch := expression[i]
+
+		switch {
+		case quote != 0:
+			current.WriteByte(ch)
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+			current.WriteByte(ch)
+		case strings.HasPrefix(expression[i:], "{{"):
+			end := strings.Index(expression[i:], "}}")
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unclosed variable", ErrInvalidRule)
+			}
+
+			flush()
+			tokens = append(tokens, expression[i:i+end+2])
+			i += end + 1
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			flush()
+		default:
+			current.WriteByte(ch)
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("%w: unclosed quote", ErrInvalidRule)
+	}
+
+	flush()
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", ErrInvalidRule)
+	}
+
+	return tokens, nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
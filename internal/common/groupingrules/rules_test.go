@@ -0,0 +1,197 @@
+package groupingrules
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func newEvent(exType, exValue, stacktrace string) *domain.Event {
+	return &domain.Event{
+		Level:       domain.IssueLevelException,
+		Source:      domain.SourceException,
+		Platform:    "go",
+		Environment: "production",
+		Tags:        map[string]string{"region": "eu-west-1"},
+		Exceptions: []domain.ExceptionInfo{{
+			Type:       exType,
+			Value:      exValue,
+			Stacktrace: json.RawMessage(stacktrace),
+		}},
+	}
+}
+
+const stacktrace = `{"frames":[
+	{"module":"app/vendor/github.com/lib/pq","function":"Query","in_app":true},
+	{"module":"app/orders","function":"Create","in_app":true}
+]}`
+
+func TestParseFingerprintRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{name: "simple", expression: "error.type:DatabaseError -> database-error"},
+		{name: "quoted value", expression: `error.value:"connection refused" -> db-down`},
+		{name: "variables", expression: "type:*Error -> {{ default }} {{ transaction }}"},
+		{name: "negation and tags", expression: "!environment:dev tags.region:eu-* -> eu-errors"},
+		{name: "missing arrow", expression: "error.type:DatabaseError database-error", wantErr: true},
+		{name: "no matchers", expression: "-> database-error", wantErr: true},
+		{name: "empty fingerprint", expression: "error.type:DatabaseError ->", wantErr: true},
+		{name: "unknown key", expression: "foo:bar -> x", wantErr: true},
+		{name: "unknown variable", expression: "type:X -> {{ unknown }}", wantErr: true},
+		{name: "unclosed quote", expression: `error.value:"oops -> x`, wantErr: true},
+		{name: "empty", expression: "   ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFingerprintRule(tt.expression)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidRule)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFingerprintRule_Fingerprint(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		event      *domain.Event
+		want       []string
+		matched    bool
+	}{
+		{
+			name:       "error type",
+			expression: "error.type:DatabaseError -> database-error",
+			event:      newEvent("DatabaseError", "connection refused", stacktrace),
+			want:       []string{"database-error"},
+			matched:    true,
+		},
+		{
+			name:       "error type mismatch",
+			expression: "error.type:DatabaseError -> database-error",
+			event:      newEvent("ValueError", "connection refused", stacktrace),
+		},
+		{
+			name:       "glob value",
+			expression: `error.value:"connection *" -> conn {{ default }}`,
+			event:      newEvent("DatabaseError", "connection refused", stacktrace),
+			want:       []string{"conn", "{{ default }}"},
+			matched:    true,
+		},
+		{
+			name:       "frame matchers on one frame",
+			expression: "stack.module:app/orders stack.function:Create -> orders-create",
+			event:      newEvent("DatabaseError", "x", stacktrace),
+			want:       []string{"orders-create"},
+			matched:    true,
+		},
+		{
+			name:       "frame matchers on different frames",
+			expression: "stack.module:app/orders stack.function:Query -> nope",
+			event:      newEvent("DatabaseError", "x", stacktrace),
+		},
+		{
+			name:       "negated environment and tag",
+			expression: "!environment:dev tags.region:eu-* -> eu",
+			event:      newEvent("DatabaseError", "x", stacktrace),
+			want:       []string{"eu"},
+			matched:    true,
+		},
+		{
+			name:       "missing tag",
+			expression: "tags.customer:acme -> acme",
+			event:      newEvent("DatabaseError", "x", stacktrace),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseFingerprintRule(tt.expression)
+			require.NoError(t, err)
+
+			got, matched := rule.Fingerprint(tt.event)
+			assert.Equal(t, tt.matched, matched)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseStacktraceRule(t *testing.T) {
+	_, err := ParseStacktraceRule("stack.module:vendor/** -app")
+	require.NoError(t, err)
+
+	_, err = ParseStacktraceRule("function:log.* -group +app")
+	require.NoError(t, err)
+
+	for _, expression := range []string{
+		"stack.module:vendor/**",
+		"-app",
+		"error.type:X -app",
+		"-app stack.module:vendor/**",
+	} {
+		_, err = ParseStacktraceRule(expression)
+		require.ErrorIs(t, err, ErrInvalidRule, expression)
+	}
+}
+
+func TestStacktraceRule_ApplyFrame(t *testing.T) {
+	rule, err := ParseStacktraceRule("stack.module:**/vendor/** -app -group")
+	require.NoError(t, err)
+
+	vendored := domain.StackFrame{Module: "app/vendor/github.com/lib/pq", Function: "Query"}
+	require.True(t, rule.ApplyFrame(&vendored))
+	require.NotNil(t, vendored.InApp)
+	assert.False(t, *vendored.InApp)
+	assert.True(t, vendored.ExcludeFromGroup)
+
+	own := domain.StackFrame{Module: "app/orders", Function: "Create"}
+	require.False(t, rule.ApplyFrame(&own))
+	assert.Nil(t, own.InApp)
+	assert.False(t, own.ExcludeFromGroup)
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	assert.True(t, globToRegexp("vendor/**", true).MatchString("vendor/a/b"))
+	assert.False(t, globToRegexp("vendor/*", true).MatchString("vendor/a/b"))
+	assert.True(t, globToRegexp("vendor/*", false).MatchString("vendor/a/b"))
+	assert.True(t, globToRegexp("Http?Error", false).MatchString("Http4Error"))
+	assert.False(t, globToRegexp("a.b", false).MatchString("axb"))
+}
+
+func TestCompile(t *testing.T) {
+	rules := []domain.GroupingRule{
+		{ID: 1, Type: domain.GroupingRuleTypeStacktrace, Expression: "stack.module:**/vendor/** -app"},
+		{ID: 2, Type: domain.GroupingRuleTypeFingerprint, Expression: "broken"},
+		{ID: 3, Type: domain.GroupingRuleTypeFingerprint, Expression: "error.type:DatabaseError -> database-error"},
+	}
+
+	cfg, err := Compile(domain.GroupingStrategyStacktraceV1, rules)
+	require.ErrorIs(t, err, ErrInvalidRule)
+	require.ErrorContains(t, err, "rule 2")
+	require.Len(t, cfg.FrameRules, 1)
+	require.Len(t, cfg.FingerprintRules, 1)
+
+	ev1 := newEvent("DatabaseError", "connection refused", stacktrace)
+	cfg.Apply(ev1)
+	assert.Equal(t, []string{"database-error"}, ev1.Fingerprint)
+
+	// The vendored frame no longer takes part in grouping.
+	ev2 := newEvent("ValueError", "x", stacktrace)
+	ev3 := newEvent("ValueError", "x", `{"frames":[
+		{"module":"app/vendor/github.com/other","function":"Do","in_app":true},
+		{"module":"app/orders","function":"Create","in_app":true}
+	]}`)
+	cfg.Apply(ev2)
+	cfg.Apply(ev3)
+	assert.Equal(t, ev2.GroupingFingerprint(), ev3.GroupingFingerprint())
+}
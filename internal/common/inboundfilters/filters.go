@@ -0,0 +1,383 @@
+package inboundfilters
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// maxPatterns limits the number of entries of every list of the filters.
+const maxPatterns = 100
+
+// webCrawlers matches user agents of known web crawlers and uptime bots.
+var webCrawlers = regexp.MustCompile(`(?i)(` +
+	`googlebot|mediapartners-google|adsbot-google|bingbot|bingpreview|baiduspider|yandex(bot|images)|` +
+	`duckduckbot|slurp|sogou|exabot|facebot|facebookexternalhit|ia_archiver|twitterbot|applebot|` +
+	`petalbot|semrushbot|ahrefsbot|mj12bot|dotbot|pingdom|lytics|uptimerobot|statuscake|site24x7|` +
+	`headlesschrome|phantomjs|crawler|spider[/\s);]|bots?[/\s);]` +
+	`)`)
+
+// legacyBrowsers matches user agents of browsers too old to be supported:
+// Internet Explorer, Opera Mini and Presto, Android < 4 and Safari < 6.
+var legacyBrowsers = regexp.MustCompile(`(?i)(` +
+	`msie [0-9]|trident/|opera mini|presto/|android [1-3]\.|version/[1-5]\.[0-9.]+ (mobile/\S+ )?safari/` +
+	`)`)
+
+// Filters are compiled inbound filters of a project.
+type Filters struct {
+	origins         []*regexp.Regexp
+	webCrawlers     bool
+	legacyBrowsers  bool
+	localhost       bool
+	blockedIPRanges []netip.Prefix
+	errorMessages   []*regexp.Regexp
+	releases        []*regexp.Regexp
+}
+
+// Validate checks the patterns and IP ranges of the filters.
+func Validate(filters domain.InboundFilters) error {
+	_, err := Compile(filters)
+
+	return err
+}
+
+// Compile validates the filters and prepares them for matching.
+func Compile(filters domain.InboundFilters) (*Filters, error) {
+	origins, err := compilePatterns("allowed origin", filters.AllowedOrigins)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMessages, err := compilePatterns("error message", filters.ErrorMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := compilePatterns("release", filters.Releases)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filters.BlockedIPRanges) > maxPatterns {
+		return nil, fmt.Errorf("%w: too many IP ranges, max %d", domain.ErrInvalidInboundFilters, maxPatterns)
+	}
+
+	ipRanges := make([]netip.Prefix, 0, len(filters.BlockedIPRanges))
+	for _, value := range filters.BlockedIPRanges {
+		prefix, err := ParseIPRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid IP range %q", domain.ErrInvalidInboundFilters, value)
+		}
+
+		ipRanges = append(ipRanges, prefix)
+	}
+
+	return &Filters{
+		origins:         origins,
+		webCrawlers:     filters.WebCrawlers,
+		legacyBrowsers:  filters.LegacyBrowsers,
+		localhost:       filters.Localhost,
+		blockedIPRanges: ipRanges,
+		errorMessages:   errorMessages,
+		releases:        releases,
+	}, nil
+}
+
+// HasAllowedOrigins reports whether the browser requests are limited to the allowed origins.
+func (f *Filters) HasAllowedOrigins() bool {
+	return len(f.origins) > 0
+}
+
+// OriginAllowed checks the Origin, or the Referer when there is no Origin, against the allowed origins.
+// Requests without both headers are not sent by browsers and are always allowed.
+func (f *Filters) OriginAllowed(origin, referer string) bool {
+	if len(f.origins) == 0 {
+		return true
+	}
+
+	if origin == "" {
+		origin = referer
+	}
+
+	if origin == "" {
+		return true
+	}
+
+	parsed, err := url.Parse(origin)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+
+	fullOrigin := strings.ToLower(parsed.Scheme + "://" + parsed.Host)
+	host := strings.ToLower(parsed.Host)
+	hostname := strings.ToLower(parsed.Hostname())
+
+	for _, pattern := range f.origins {
+		if pattern.MatchString(fullOrigin) || pattern.MatchString(host) || pattern.MatchString(hostname) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterRequest checks the user agent and the IP address of the client sending the request.
+func (f *Filters) FilterRequest(userAgent, clientIP string) (domain.InboundFilterReason, bool) {
+	if reason, ok := f.filterUserAgent(userAgent); ok {
+		return reason, true
+	}
+
+	if f.ipBlocked(clientIP) {
+		return domain.InboundFilterIPAddress, true
+	}
+
+	return "", false
+}
+
+// HasEventFilters reports whether the filters need event payloads to be inspected.
+func (f *Filters) HasEventFilters() bool {
+	return f.webCrawlers || f.legacyBrowsers || f.localhost || len(f.blockedIPRanges) > 0 ||
+		len(f.errorMessages) > 0 || len(f.releases) > 0
+}
+
+// FilterEvent checks the event or transaction payload, the user agent and the IP address
+// are taken from the request and the user interfaces of the event.
+func (f *Filters) FilterEvent(data map[string]any) (domain.InboundFilterReason, bool) {
+	request, _ := data["request"].(map[string]any)
+	user, _ := data["user"].(map[string]any)
+	userIP := stringValue(user, "ip_address")
+
+	if reason, ok := f.filterUserAgent(requestHeader(request, "User-Agent")); ok {
+		return reason, true
+	}
+
+	if f.localhost && (isLocalhostIP(userIP) || isLocalhostURL(stringValue(request, "url"))) {
+		return domain.InboundFilterLocalhost, true
+	}
+
+	if f.ipBlocked(userIP) {
+		return domain.InboundFilterIPAddress, true
+	}
+
+	if len(f.releases) > 0 {
+		if release := strings.TrimSpace(stringValue(data, "release")); release != "" && matchAny(f.releases, release) {
+			return domain.InboundFilterRelease, true
+		}
+	}
+
+	if len(f.errorMessages) > 0 && matchAny(f.errorMessages, eventMessages(data)...) {
+		return domain.InboundFilterErrorMessage, true
+	}
+
+	return "", false
+}
+
+func (f *Filters) filterUserAgent(userAgent string) (domain.InboundFilterReason, bool) {
+	if userAgent == "" {
+		return "", false
+	}
+
+	if f.webCrawlers && webCrawlers.MatchString(userAgent) {
+		return domain.InboundFilterWebCrawlers, true
+	}
+
+	if f.legacyBrowsers && legacyBrowsers.MatchString(userAgent) {
+		return domain.InboundFilterLegacyBrowsers, true
+	}
+
+	return "", false
+}
+
+func (f *Filters) ipBlocked(value string) bool {
+	if len(f.blockedIPRanges) == 0 || value == "" {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range f.blockedIPRanges {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseIPRange parses a CIDR range or a single IP address.
+func ParseIPRange(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	addr = addr.Unmap()
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func compilePatterns(kind string, patterns []string) ([]*regexp.Regexp, error) {
+	if len(patterns) > maxPatterns {
+		return nil, fmt.Errorf("%w: too many %s patterns, max %d", domain.ErrInvalidInboundFilters, kind, maxPatterns)
+	}
+
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return nil, fmt.Errorf("%w: empty %s pattern", domain.ErrInvalidInboundFilters, kind)
+		}
+
+		compiled = append(compiled, globToRegexp(pattern))
+	}
+
+	return compiled, nil
+}
+
+// globToRegexp converts a glob pattern to an anchored case-insensitive regular expression.
+// "*" matches any characters and "?" a single character.
+func globToRegexp(glob string) *regexp.Regexp {
+	var buf strings.Builder
+	buf.WriteString("(?is)^")
+
+	for _, char := range glob {
+		switch char {
+		case '*':
+			buf.WriteString(".*")
+		case '?':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+
+	buf.WriteString("$")
+
+	return regexp.MustCompile(buf.String())
+}
+
+func matchAny(patterns []*regexp.Regexp, values ...string) bool {
+	for _, value := range values {
+		for _, pattern := range patterns {
+			if pattern.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// eventMessages returns the message and the "type: value" of every exception of the event.
+func eventMessages(data map[string]any) []string {
+	var messages []string
+
+	switch message := data["message"].(type) {
+	case string:
+		messages = append(messages, message)
+	case map[string]any:
+		messages = append(messages, stringValue(message, "formatted"), stringValue(message, "message"))
+	}
+
+	if logEntry, ok := data["logentry"].(map[string]any); ok {
+		messages = append(messages, stringValue(logEntry, "formatted"), stringValue(logEntry, "message"))
+	}
+
+	var exceptions []any
+	switch exception := data["exception"].(type) {
+	case map[string]any:
+		exceptions, _ = exception["values"].([]any)
+	case []any:
+		exceptions = exception
+	}
+
+	for _, raw := range exceptions {
+		exception, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		exceptionType := stringValue(exception, "type")
+		exceptionValue := stringValue(exception, "value")
+		messages = append(messages, exceptionValue)
+		if exceptionType != "" {
+			messages = append(messages, exceptionType+": "+exceptionValue)
+		}
+	}
+
+	return messages
+}
+
+func requestHeader(request map[string]any, name string) string {
+	switch headers := request["headers"].(type) {
+	case map[string]any:
+		for key, value := range headers {
+			if strings.EqualFold(key, name) {
+				str, _ := value.(string)
+
+				return str
+			}
+		}
+	case []any:
+		// Headers sent as a list of [name, value] pairs
+		for _, raw := range headers {
+			pair, ok := raw.([]any)
+			if !ok || len(pair) != 2 {
+				continue
+			}
+
+			if key, _ := pair[0].(string); strings.EqualFold(key, name) {
+				str, _ := pair[1].(string)
+
+				return str
+			}
+		}
+	}
+
+	return ""
+}
+
+func isLocalhostIP(value string) bool {
+	addr, err := netip.ParseAddr(value)
+
+	return err == nil && addr.Unmap().IsLoopback()
+}
+
+func isLocalhostURL(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	hostname := strings.ToLower(parsed.Hostname())
+
+	return hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") || isLocalhostIP(hostname)
+}
+
+func stringValue(data map[string]any, key string) string {
+	value, _ := data[key].(string)
+
+	return value
+}
@@ -0,0 +1,172 @@
+package inboundfilters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const (
+	chromeUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 " +
+		"(KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	googlebotUserAgent = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+	ie11UserAgent      = "Mozilla/5.0 (Windows NT 10.0; Trident/7.0; rv:11.0) like Gecko"
+)
+
+func TestCompile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		filters domain.InboundFilters
+	}{
+		{name: "empty origin", filters: domain.InboundFilters{AllowedOrigins: []string{" "}}},
+		{name: "invalid IP range", filters: domain.InboundFilters{BlockedIPRanges: []string{"10.0.0.0/33"}}},
+		{name: "invalid IP", filters: domain.InboundFilters{BlockedIPRanges: []string{"example.com"}}},
+		{name: "too many releases", filters: domain.InboundFilters{Releases: make([]string, maxPatterns+1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Compile(tt.filters)
+			require.ErrorIs(t, err, domain.ErrInvalidInboundFilters)
+		})
+	}
+}
+
+func TestFilters_OriginAllowed(t *testing.T) {
+	t.Parallel()
+
+	filters, err := Compile(domain.InboundFilters{
+		AllowedOrigins: []string{"https://app.example.com", "*.example.org", "localhost:3000"},
+	})
+	require.NoError(t, err)
+
+	require.True(t, filters.OriginAllowed("https://app.example.com", ""))
+	require.True(t, filters.OriginAllowed("", "https://shop.example.org/cart?id=1"))
+	require.True(t, filters.OriginAllowed("http://localhost:3000", ""))
+	require.True(t, filters.OriginAllowed("", ""), "non-browser requests are allowed")
+	require.False(t, filters.OriginAllowed("http://app.example.com", ""))
+	require.False(t, filters.OriginAllowed("https://evil.com", "https://app.example.com/"))
+	require.False(t, filters.OriginAllowed("null", ""))
+
+	noOrigins, err := Compile(domain.InboundFilters{})
+	require.NoError(t, err)
+	require.True(t, noOrigins.OriginAllowed("https://evil.com", ""))
+}
+
+func TestFilters_FilterRequest(t *testing.T) {
+	t.Parallel()
+
+	filters, err := Compile(domain.InboundFilters{
+		WebCrawlers:     true,
+		LegacyBrowsers:  true,
+		BlockedIPRanges: []string{"10.0.0.0/8", "2001:db8::1"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		userAgent string
+		clientIP  string
+		expected  domain.InboundFilterReason
+	}{
+		{name: "regular browser", userAgent: chromeUserAgent, clientIP: "192.168.1.1"},
+		{name: "crawler", userAgent: googlebotUserAgent, expected: domain.InboundFilterWebCrawlers},
+		{name: "legacy browser", userAgent: ie11UserAgent, expected: domain.InboundFilterLegacyBrowsers},
+		{name: "blocked range", clientIP: "10.1.2.3", expected: domain.InboundFilterIPAddress},
+		{name: "blocked address", clientIP: "2001:db8::1", expected: domain.InboundFilterIPAddress},
+		{name: "mapped IPv4", clientIP: "::ffff:10.0.0.1", expected: domain.InboundFilterIPAddress},
+		{name: "invalid IP", clientIP: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, filtered := filters.FilterRequest(tt.userAgent, tt.clientIP)
+			require.Equal(t, tt.expected != "", filtered)
+			require.Equal(t, tt.expected, reason)
+		})
+	}
+}
+
+func TestFilters_FilterEvent(t *testing.T) {
+	t.Parallel()
+
+	filters, err := Compile(domain.InboundFilters{
+		WebCrawlers:   true,
+		Localhost:     true,
+		ErrorMessages: []string{"*ResizeObserver loop*", "ChunkLoadError: *"},
+		Releases:      []string{"*-dev"},
+	})
+	require.NoError(t, err)
+	require.True(t, filters.HasEventFilters())
+
+	tests := []struct {
+		name     string
+		event    map[string]any
+		expected domain.InboundFilterReason
+	}{
+		{
+			name:  "regular event",
+			event: map[string]any{"message": "boom", "release": "1.0.0"},
+		},
+		{
+			name:     "message",
+			event:    map[string]any{"message": "resizeobserver loop limit exceeded"},
+			expected: domain.InboundFilterErrorMessage,
+		},
+		{
+			name: "exception type and value",
+			event: map[string]any{"exception": map[string]any{"values": []any{
+				map[string]any{"type": "ChunkLoadError", "value": "Loading chunk 7 failed"},
+			}}},
+			expected: domain.InboundFilterErrorMessage,
+		},
+		{
+			name:     "release",
+			event:    map[string]any{"release": "2.0.0-dev"},
+			expected: domain.InboundFilterRelease,
+		},
+		{
+			name:     "localhost url",
+			event:    map[string]any{"request": map[string]any{"url": "http://localhost:8080/page"}},
+			expected: domain.InboundFilterLocalhost,
+		},
+		{
+			name:     "localhost user ip",
+			event:    map[string]any{"user": map[string]any{"ip_address": "127.0.0.1"}},
+			expected: domain.InboundFilterLocalhost,
+		},
+		{
+			name: "crawler request header",
+			event: map[string]any{"request": map[string]any{
+				"headers": []any{[]any{"user-agent", googlebotUserAgent}},
+			}},
+			expected: domain.InboundFilterWebCrawlers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, filtered := filters.FilterEvent(tt.event)
+			require.Equal(t, tt.expected != "", filtered)
+			require.Equal(t, tt.expected, reason)
+		})
+	}
+}
+
+func TestFilters_NoEventFilters(t *testing.T) {
+	t.Parallel()
+
+	filters, err := Compile(domain.InboundFilters{AllowedOrigins: []string{"https://example.com"}})
+	require.NoError(t, err)
+	require.False(t, filters.HasEventFilters())
+}
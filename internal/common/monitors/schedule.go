@@ -0,0 +1,96 @@
+package monitors
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// IntervalSchedule formats an interval schedule, unit is one of minute, hour, day, week, month or year.
+func IntervalSchedule(value uint, unit string) string {
+	return fmt.Sprintf("%d %s", value, unit)
+}
+
+// ValidateConfig checks that the schedule and the timezone of the monitor config can be evaluated.
+func ValidateConfig(config domain.MonitorConfig) error {
+	_, err := NextCheckIn(domain.Monitor{
+		ScheduleType: config.ScheduleType,
+		Schedule:     config.Schedule,
+		Timezone:     config.Timezone,
+	}, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidMonitorConfig, err)
+	}
+
+	return nil
+}
+
+// NextCheckIn returns the time the next check-in of the monitor is expected at after the given time.
+func NextCheckIn(monitor domain.Monitor, after time.Time) (time.Time, error) {
+	switch monitor.ScheduleType {
+	case domain.MonitorScheduleCrontab:
+		return nextCrontab(monitor.Schedule, monitor.Timezone, after)
+	case domain.MonitorScheduleInterval:
+		return nextInterval(monitor.Schedule, after)
+	default:
+		return time.Time{}, fmt.Errorf("unknown schedule type %q", monitor.ScheduleType)
+	}
+}
+
+func nextCrontab(expression, timezone string, after time.Time) (time.Time, error) {
+	if timezone == "" {
+		timezone = domain.DefaultMonitorTimezone
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return time.Time{}, fmt.Errorf("load timezone: %w", err)
+	}
+
+	schedule, err := cron.ParseStandard("CRON_TZ=" + timezone + " " + expression)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse crontab: %w", err)
+	}
+
+	next := schedule.Next(after)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("crontab %q never fires", expression)
+	}
+
+	return next.UTC(), nil
+}
+
+func nextInterval(interval string, after time.Time) (time.Time, error) {
+	valueStr, unit, ok := strings.Cut(interval, " ")
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid interval %q", interval)
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil || value <= 0 {
+		return time.Time{}, fmt.Errorf("invalid interval value %q", valueStr)
+	}
+
+	after = after.UTC()
+
+	switch unit {
+	case "minute":
+		return after.Add(time.Duration(value) * time.Minute), nil
+	case "hour":
+		return after.Add(time.Duration(value) * time.Hour), nil
+	case "day":
+		return after.AddDate(0, 0, value), nil
+	case "week":
+		return after.AddDate(0, 0, 7*value), nil
+	case "month":
+		return after.AddDate(0, value, 0), nil
+	case "year":
+		return after.AddDate(value, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid interval unit %q", unit)
+	}
+}
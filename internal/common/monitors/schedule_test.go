@@ -0,0 +1,78 @@
+package monitors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestNextCheckIn(t *testing.T) {
+	after := time.Date(2025, 3, 30, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		monitor  domain.Monitor
+		expected time.Time
+	}{
+		{
+			name:     "crontab",
+			monitor:  domain.Monitor{ScheduleType: domain.MonitorScheduleCrontab, Schedule: "0 * * * *"},
+			expected: time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "crontab in timezone",
+			monitor: domain.Monitor{
+				ScheduleType: domain.MonitorScheduleCrontab,
+				Schedule:     "0 9 * * *",
+				Timezone:     "Europe/Berlin",
+			},
+			expected: time.Date(2025, 3, 30, 7, 0, 0, 0, time.UTC), // CEST since 30 March
+		},
+		{
+			name:     "crontab descriptor",
+			monitor:  domain.Monitor{ScheduleType: domain.MonitorScheduleCrontab, Schedule: "@daily"},
+			expected: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "interval",
+			monitor:  domain.Monitor{ScheduleType: domain.MonitorScheduleInterval, Schedule: "90 minute"},
+			expected: time.Date(2025, 3, 30, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "interval in months",
+			monitor:  domain.Monitor{ScheduleType: domain.MonitorScheduleInterval, Schedule: "1 month"},
+			expected: time.Date(2025, 4, 30, 0, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := NextCheckIn(tt.monitor, after)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, next)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	invalid := []domain.MonitorConfig{
+		{ScheduleType: domain.MonitorScheduleCrontab, Schedule: "61 * * * *"},
+		{ScheduleType: domain.MonitorScheduleCrontab, Schedule: "0 * * * *", Timezone: "Mars/Olympus"},
+		{ScheduleType: domain.MonitorScheduleInterval, Schedule: "0 hour"},
+		{ScheduleType: domain.MonitorScheduleInterval, Schedule: "1 fortnight"},
+		{ScheduleType: "rrule", Schedule: "x"},
+	}
+
+	for _, config := range invalid {
+		require.ErrorIs(t, ValidateConfig(config), domain.ErrInvalidMonitorConfig, config.Schedule)
+	}
+
+	require.NoError(t, ValidateConfig(domain.MonitorConfig{
+		ScheduleType: domain.MonitorScheduleCrontab,
+		Schedule:     "*/5 * * * *",
+		Timezone:     "America/New_York",
+	}))
+}
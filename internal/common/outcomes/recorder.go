@@ -0,0 +1,133 @@
+package outcomes
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/rom8726/di"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/kafka"
+)
+
+const (
+	flushInterval   = 10 * time.Second
+	timestampLayout = "2006-01-02 15:04:05.000"
+)
+
+var _ di.Servicer = (*Recorder)(nil)
+
+type key struct {
+	projectID domain.ProjectID
+	outcome   domain.Outcome
+	reason    string
+	category  domain.DataCategory
+}
+
+type outcomeModel struct {
+	ProjectID uint32 `json:"project_id"`
+	Outcome   string `json:"outcome"`
+	Reason    string `json:"reason"`
+	Category  string `json:"category"`
+	Timestamp string `json:"timestamp"`
+	Quantity  uint64 `json:"quantity"`
+}
+
+// Recorder counts outcomes in memory and periodically sends them to ClickHouse through Kafka,
+// so counting accepted data doesn't add a Kafka message per event.
+type Recorder struct {
+	producer kafka.DataProducer
+
+	mu      sync.Mutex
+	pending map[key]uint
+
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func NewRecorder(producer *kafka.TopicProducer) *Recorder {
+	return &Recorder{
+		producer: producer,
+		pending:  make(map[key]uint),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// Record counts the quantity of data with the outcome, it never blocks on Kafka.
+func (r *Recorder) Record(
+	projectID domain.ProjectID,
+	outcome domain.Outcome,
+	reason string,
+	category domain.DataCategory,
+	quantity uint,
+) {
+	if quantity == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	r.pending[key{projectID: projectID, outcome: outcome, reason: reason, category: category}] += quantity
+	r.mu.Unlock()
+}
+
+func (r *Recorder) Start(context.Context) error {
+	go func() {
+		defer close(r.stopped)
+
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.done:
+				return
+			case <-ticker.C:
+				r.flush(context.Background())
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop sends the outcomes counted since the last flush.
+func (r *Recorder) Stop(ctx context.Context) error {
+	close(r.done)
+	<-r.stopped
+
+	r.flush(ctx)
+
+	return nil
+}
+
+func (r *Recorder) flush(ctx context.Context) {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[key]uint, len(pending))
+	r.mu.Unlock()
+
+	timestamp := time.Now().UTC().Format(timestampLayout)
+	for k, quantity := range pending {
+		data, err := json.Marshal(outcomeModel{
+			ProjectID: uint32(k.projectID),
+			Outcome:   string(k.outcome),
+			Reason:    k.reason,
+			Category:  string(k.category),
+			Timestamp: timestamp,
+			Quantity:  uint64(quantity),
+		})
+		if err != nil {
+			slog.Error("Failed to marshal outcome", "error", err)
+
+			continue
+		}
+
+		if err := r.producer.Produce(ctx, data); err != nil {
+			slog.Error("Failed to produce outcome", "error", err, "outcome", k.outcome, "project_id", k.projectID)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package outcomes
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type producerStub struct {
+	mu       sync.Mutex
+	messages [][]byte
+}
+
+func (p *producerStub) Produce(_ context.Context, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, data)
+
+	return nil
+}
+
+func TestRecorder_FlushOnStop(t *testing.T) {
+	producer := &producerStub{}
+	recorder := &Recorder{
+		producer: producer,
+		pending:  make(map[key]uint),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+
+	require.NoError(t, recorder.Start(context.Background()))
+
+	recorder.Record(1, domain.OutcomeAccepted, "", domain.DataCategoryError, 2)
+	recorder.Record(1, domain.OutcomeAccepted, "", domain.DataCategoryError, 3)
+	recorder.Record(2, domain.OutcomeClientDiscard, "queue_overflow", domain.DataCategoryTransaction, 7)
+	recorder.Record(2, domain.OutcomeInvalid, domain.OutcomeReasonExpired, domain.DataCategoryEnvelope, 0)
+
+	require.NoError(t, recorder.Stop(context.Background()))
+	require.Len(t, producer.messages, 2)
+
+	quantities := make(map[string]uint64)
+	for _, message := range producer.messages {
+		var model outcomeModel
+		require.NoError(t, json.Unmarshal(message, &model))
+		require.NotEmpty(t, model.Timestamp)
+		quantities[model.Outcome+"/"+model.Category] = model.Quantity
+	}
+
+	require.Equal(t, map[string]uint64{
+		"accepted/error":             5,
+		"client_discard/transaction": 7,
+	}, quantities)
+}
@@ -0,0 +1,419 @@
+package searchquery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const (
+	// maxTerms limits the number of terms of a query.
+	maxTerms = 20
+	// maxValueLength limits the length of every searched value.
+	maxValueLength = 256
+
+	// TagsKey is the key of "tags[name]" terms.
+	TagsKey = "tags"
+)
+
+// columns maps the search keys to the expressions of the ClickHouse events table,
+// nullable columns are compared as empty strings.
+var columns = map[string]string{
+	"event_id":        "event_id",
+	"message":         "message",
+	"level":           "level",
+	"platform":        "platform",
+	"source":          "source",
+	"environment":     "environment",
+	"release":         "ifNull(release, '')",
+	"server_name":     "server_name",
+	"exception_type":  "ifNull(exception_type, '')",
+	"exception_value": "ifNull(exception_value, '')",
+	"user.id":         "ifNull(user_id, '')",
+	"user.email":      "ifNull(user_email, '')",
+	"user.ip":         "ifNull(request_ip, '')",
+	"http.method":     "ifNull(request_method, '')",
+	"http.url":        "ifNull(request_url, '')",
+	"os.name":         "ifNull(os_name, '')",
+	"os.version":      "ifNull(os_version, '')",
+	"browser.name":    "ifNull(browser_name, '')",
+	"browser.version": "ifNull(browser_version, '')",
+	"runtime.name":    "ifNull(runtime_name, '')",
+	"runtime.version": "ifNull(runtime_version, '')",
+	"device.arch":     "ifNull(device_arch, '')",
+}
+
+// aliases are the Sentry names of the search keys.
+var aliases = map[string]string{
+	"id":            "event_id",
+	"error.type":    "exception_type",
+	"error.value":   "exception_value",
+	"server":        "server_name",
+	"user.username": "user.id",
+	"url":           "http.url",
+}
+
+// tagKey matches "tags[name]" keys, tag names are any characters but brackets.
+var tagKey = regexp.MustCompile(`^tags\[([^\[\]]+)\]$`)
+
+// searchKey matches the keys which are looked up in columns and aliases.
+var searchKey = regexp.MustCompile(`^[a-z][a-z0-9_.]*$`)
+
+// likeEscaper escapes the LIKE wildcards of searched values, "*" is turned into "%" after escaping.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Parse parses a query like `release:1.2.* !environment:[staging,dev] tags[customer]:42 timeout`.
+// Terms are separated by spaces and must all match: "key:value" compares the key with the value,
+// "key:[a,b]" with any of the values, "!" negates the term and other words search the message.
+// Values are quoted with double quotes to contain spaces, "*" matches any characters.
+func Parse(query string) (domain.SearchQuery, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	if len(tokens) > maxTerms {
+		return nil, fmt.Errorf("%w: too many terms, max %d", domain.ErrInvalidSearchQuery, maxTerms)
+	}
+
+	terms := make(domain.SearchQuery, 0, len(tokens))
+	for _, token := range tokens {
+		term, err := parseTerm(token)
+		if err != nil {
+			return nil, err
+		}
+
+		terms = append(terms, term)
+	}
+
+	return terms, nil
+}
+
+// ClickHouseCondition builds the parameterized condition of the query over the events table,
+// the empty query matches all events.
+func ClickHouseCondition(query domain.SearchQuery) (string, []any, error) {
+	if len(query) == 0 {
+		return "1", nil, nil
+	}
+
+	var (
+		conditions = make([]string, 0, len(query))
+		args       []any
+	)
+
+	for _, term := range query {
+		condition, termArgs, err := termCondition(term)
+		if err != nil {
+			return "", nil, err
+		}
+
+		conditions = append(conditions, condition)
+		args = append(args, termArgs...)
+	}
+
+	return strings.Join(conditions, " AND "), args, nil
+}
+
+func termCondition(term domain.SearchTerm) (string, []any, error) {
+	if len(term.Values) == 0 {
+		return "", nil, fmt.Errorf("%w: no values of %q", domain.ErrInvalidSearchQuery, term.Key)
+	}
+
+	var (
+		expr     string
+		exprArgs []any
+	)
+
+	switch term.Key {
+	case "":
+		// The free text search is a case-insensitive substring match
+		expr = "message"
+	case TagsKey:
+		expr = "tags[?]"
+		exprArgs = []any{term.Tag}
+	default:
+		var ok bool
+		if expr, ok = columns[term.Key]; !ok {
+			return "", nil, fmt.Errorf("%w: unknown key %q", domain.ErrInvalidSearchQuery, term.Key)
+		}
+	}
+
+	var (
+		matches = make([]string, 0, len(term.Values))
+		args    []any
+		exact   []string
+	)
+
+	for _, value := range term.Values {
+		switch {
+		case term.Key == "":
+			matches = append(matches, expr+" ILIKE ?")
+			args = append(args, exprArgs...)
+			args = append(args, "%"+likePattern(value)+"%")
+		case strings.Contains(value, "*"):
+			matches = append(matches, expr+" LIKE ?")
+			args = append(args, exprArgs...)
+			args = append(args, likePattern(value))
+		default:
+			exact = append(exact, value)
+		}
+	}
+
+	switch len(exact) {
+	case 0:
+	case 1:
+		matches = append(matches, expr+" = ?")
+		args = append(args, exprArgs...)
+		args = append(args, exact[0])
+	default:
+		matches = append(matches, "has(?, "+expr+")")
+		args = append(args, exact)
+		args = append(args, exprArgs...)
+	}
+
+	condition := "(" + strings.Join(matches, " OR ") + ")"
+	if term.Negated {
+		condition = "NOT " + condition
+	}
+
+	return condition, args, nil
+}
+
+func likePattern(value string) string {
+	return strings.ReplaceAll(likeEscaper.Replace(value), "*", "%")
+}
+
+func parseTerm(token string) (domain.SearchTerm, error) {
+	var term domain.SearchTerm
+
+	if rest, ok := strings.CutPrefix(token, "!"); ok && rest != "" {
+		term.Negated = true
+		token = rest
+	}
+
+	key, value, ok := cutKey(token)
+	if !ok {
+		text, err := unquote(token)
+		if err != nil {
+			return domain.SearchTerm{}, err
+		}
+
+		term.Values = []string{text}
+
+		return term, validateValues(term.Values)
+	}
+
+	switch {
+	case tagKey.MatchString(key):
+		term.Key = TagsKey
+		term.Tag = tagKey.FindStringSubmatch(key)[1]
+	default:
+		if alias, ok := aliases[key]; ok {
+			key = alias
+		}
+
+		if _, ok := columns[key]; !ok {
+			return domain.SearchTerm{}, fmt.Errorf("%w: unknown key %q", domain.ErrInvalidSearchQuery, key)
+		}
+
+		term.Key = key
+	}
+
+	values, err := parseValues(value)
+	if err != nil {
+		return domain.SearchTerm{}, err
+	}
+
+	term.Values = values
+
+	return term, validateValues(term.Values)
+}
+
+// cutKey splits the "key:value" token, tokens without a valid key are free text.
+func cutKey(token string) (string, string, bool) {
+	key, value, ok := strings.Cut(token, ":")
+	if !ok {
+		return "", "", false
+	}
+
+	// Tag names are case-sensitive
+	if tagKey.MatchString(key) {
+		return key, value, true
+	}
+
+	key = strings.ToLower(key)
+	if !searchKey.MatchString(key) {
+		return "", "", false
+	}
+
+	return key, value, true
+}
+
+// parseValues parses a single value or a "[a,b]" list of values.
+func parseValues(value string) ([]string, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%w: empty value", domain.ErrInvalidSearchQuery)
+	}
+
+	list, ok := strings.CutPrefix(value, "[")
+	if !ok {
+		unquoted, err := unquote(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{unquoted}, nil
+	}
+
+	list, ok = strings.CutSuffix(list, "]")
+	if !ok {
+		return nil, fmt.Errorf("%w: unterminated list %q", domain.ErrInvalidSearchQuery, value)
+	}
+
+	items, err := splitList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		unquoted, err := unquote(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+
+		if unquoted == "" && !strings.HasPrefix(strings.TrimSpace(item), `"`) {
+			return nil, fmt.Errorf("%w: empty value in list %q", domain.ErrInvalidSearchQuery, value)
+		}
+
+		values = append(values, unquoted)
+	}
+
+	return values, nil
+}
+
+func validateValues(values []string) error {
+	for _, value := range values {
+		if len(value) > maxValueLength {
+			return fmt.Errorf("%w: value is longer than %d", domain.ErrInvalidSearchQuery, maxValueLength)
+		}
+	}
+
+	return nil
+}
+
+// tokenize splits the query by spaces outside of double quotes.
+func tokenize(query string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		quoted  bool
+		escaped bool
+	)
+
+	for _, r := range query {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && unicode.IsSpace(r):
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+
+			continue
+		}
+
+		current.WriteRune(r)
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("%w: unterminated quote", domain.ErrInvalidSearchQuery)
+	}
+
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens, nil
+}
+
+// splitList splits the items of a list by commas outside of double quotes.
+func splitList(list string) ([]string, error) {
+	var (
+		items   []string
+		start   int
+		quoted  bool
+		escaped bool
+	)
+
+	for i, r := range list {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && r == ',':
+			items = append(items, list[start:i])
+			start = i + 1
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("%w: unterminated quote", domain.ErrInvalidSearchQuery)
+	}
+
+	return append(items, list[start:]), nil
+}
+
+// unquote strips the double quotes of a quoted value and its backslash escapes.
+func unquote(value string) (string, error) {
+	inner, ok := strings.CutPrefix(value, `"`)
+	if !ok {
+		if strings.Contains(value, `"`) {
+			return "", fmt.Errorf("%w: misplaced quote in %q", domain.ErrInvalidSearchQuery, value)
+		}
+
+		return value, nil
+	}
+
+	inner, ok = strings.CutSuffix(inner, `"`)
+	if !ok {
+		return "", fmt.Errorf("%w: unterminated quote in %q", domain.ErrInvalidSearchQuery, value)
+	}
+
+	var (
+		result  strings.Builder
+		escaped bool
+	)
+
+	for _, r := range inner {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+
+			continue
+		case r == '"':
+			return "", fmt.Errorf("%w: misplaced quote in %q", domain.ErrInvalidSearchQuery, value)
+		}
+
+		result.WriteRune(r)
+	}
+
+	return result.String(), nil
+}
@@ -0,0 +1,89 @@
+package searchquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	query, err := Parse(`release:1.2.* environment:production user.email:*@acme.com tags[customer]:42 ` +
+		`error.type:TimeoutError !level:[debug,info] "connection reset" message:"read \"tcp\""`)
+	require.NoError(t, err)
+
+	require.Equal(t, domain.SearchQuery{
+		{Key: "release", Values: []string{"1.2.*"}},
+		{Key: "environment", Values: []string{"production"}},
+		{Key: "user.email", Values: []string{"*@acme.com"}},
+		{Key: TagsKey, Tag: "customer", Values: []string{"42"}},
+		{Key: "exception_type", Values: []string{"TimeoutError"}},
+		{Key: "level", Values: []string{"debug", "info"}, Negated: true},
+		{Values: []string{"connection reset"}},
+		{Key: "message", Values: []string{`read "tcp"`}},
+	}, query)
+}
+
+func TestParse_Empty(t *testing.T) {
+	t.Parallel()
+
+	query, err := Parse("  ")
+	require.NoError(t, err)
+	require.Empty(t, query)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown key", query: "customer:42"},
+		{name: "empty value", query: "release:"},
+		{name: "unterminated quote", query: `message:"timeout`},
+		{name: "unterminated list", query: "level:[error,fatal"},
+		{name: "empty list item", query: "level:[error,]"},
+		{name: "misplaced quote", query: `release:1."2"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tt.query)
+			require.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+		})
+	}
+}
+
+func TestClickHouseCondition(t *testing.T) {
+	t.Parallel()
+
+	query, err := Parse(`release:1.2.* environment:[production,staging] !tags[customer]:42 100%_done`)
+	require.NoError(t, err)
+
+	condition, args, err := ClickHouseCondition(query)
+	require.NoError(t, err)
+
+	require.Equal(t, "(ifNull(release, '') LIKE ?) AND (has(?, environment)) AND "+
+		"NOT (tags[?] = ?) AND (message ILIKE ?)", condition)
+	require.Equal(t, []any{
+		"1.2.%",
+		[]string{"production", "staging"},
+		"customer", "42",
+		`%100\%\_done%`,
+	}, args)
+}
+
+func TestClickHouseCondition_Empty(t *testing.T) {
+	t.Parallel()
+
+	condition, args, err := ClickHouseCondition(nil)
+	require.NoError(t, err)
+	require.Equal(t, "1", condition)
+	require.Empty(t, args)
+}
@@ -4,6 +4,7 @@ package context
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/rom8726/warden/internal/domain"
 )
@@ -15,8 +16,16 @@ const (
 	ctxKeyUserID    contextKey = "user_id"
 	ctxKeyIsSuper   contextKey = "is_superuser"
 	ctxRawRequest   contextKey = "raw_request"
+	ctxProjectKey   contextKey = "project_key"
+	ctxRateLimits   contextKey = "rate_limits"
 )
 
+// rateLimitReport collects the rate limits exceeded while handling a request.
+type rateLimitReport struct {
+	mu     sync.Mutex
+	limits []domain.ActiveRateLimit
+}
+
 func WithProjectID(ctx context.Context, id domain.ProjectID) context.Context {
 	return context.WithValue(ctx, ctxKeyProjectID, id)
 }
@@ -55,3 +64,44 @@ func WithRawRequest(ctx context.Context, req *http.Request) context.Context {
 func RawRequest(ctx context.Context) *http.Request {
 	return ctx.Value(ctxRawRequest).(*http.Request)
 }
+
+func WithProjectKey(ctx context.Context, key domain.ProjectKey) context.Context {
+	return context.WithValue(ctx, ctxProjectKey, key)
+}
+
+// ProjectKey returns the client key the request is authenticated with.
+func ProjectKey(ctx context.Context) (domain.ProjectKey, bool) {
+	key, ok := ctx.Value(ctxProjectKey).(domain.ProjectKey)
+
+	return key, ok
+}
+
+// WithRateLimitReport prepares the context to collect the rate limits exceeded by the request.
+func WithRateLimitReport(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxRateLimits, &rateLimitReport{})
+}
+
+// ReportRateLimit adds the exceeded rate limit to the report of the request, if any.
+func ReportRateLimit(ctx context.Context, limit domain.ActiveRateLimit) {
+	report, ok := ctx.Value(ctxRateLimits).(*rateLimitReport)
+	if !ok {
+		return
+	}
+
+	report.mu.Lock()
+	report.limits = append(report.limits, limit)
+	report.mu.Unlock()
+}
+
+// ReportedRateLimits returns the rate limits exceeded by the request.
+func ReportedRateLimits(ctx context.Context) []domain.ActiveRateLimit {
+	report, ok := ctx.Value(ctxRateLimits).(*rateLimitReport)
+	if !ok {
+		return nil
+	}
+
+	report.mu.Lock()
+	defer report.mu.Unlock()
+
+	return append([]domain.ActiveRateLimit(nil), report.limits...)
+}
@@ -3,6 +3,7 @@ package context
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -211,3 +212,22 @@ func TestIsSuper(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitReport(t *testing.T) {
+	t.Parallel()
+
+	limit := domain.ActiveRateLimit{
+		RetryAfter: time.Minute,
+		Categories: []domain.DataCategory{domain.DataCategoryError},
+		Scope:      domain.RateLimitScopeKey,
+		Reason:     domain.OutcomeReasonKeyRateLimit,
+	}
+
+	// Limits reported without a report are ignored
+	ReportRateLimit(context.Background(), limit)
+	require.Empty(t, ReportedRateLimits(context.Background()))
+
+	ctx := WithRateLimitReport(context.Background())
+	ReportRateLimit(ctx, limit)
+	require.Equal(t, []domain.ActiveRateLimit{limit}, ReportedRateLimits(ctx))
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+)
+
+type AttachmentID uint
+
+func (id AttachmentID) Uint() uint {
+	return uint(id)
+}
+
+// Attachment types sent by SDKs in the attachment item header.
+const (
+	AttachmentTypeDefault       = "event.attachment"
+	AttachmentTypeMinidump      = "event.minidump"
+	AttachmentTypeViewHierarchy = "event.view_hierarchy"
+
+	DefaultAttachmentContentType = "application/octet-stream"
+)
+
+// Attachment is a file sent along with an event, its content is kept in the blob store.
+type Attachment struct {
+	ID          AttachmentID
+	ProjectID   ProjectID
+	EventID     EventID
+	Name        string
+	ContentType string
+	Type        string
+	Size        uint
+	BlobKey     string
+	CreatedAt   time.Time
+}
+
+type AttachmentDTO struct {
+	ProjectID   ProjectID
+	EventID     EventID
+	Name        string
+	ContentType string
+	Type        string
+	Size        uint
+	BlobKey     string
+}
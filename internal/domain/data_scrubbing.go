@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+)
+
+// DataScrubbing is the per-project configuration of sensitive data removed from events
+// by the envelope consumer before they are stored.
+type DataScrubbing struct {
+	ProjectID ProjectID
+	// DefaultScrubbers removes values of well-known sensitive keys (passwords, secrets, tokens,
+	// authorization headers, cookies) and credit card numbers.
+	DefaultScrubbers bool
+	// SensitiveFields are additional field names, values of keys containing them are removed.
+	SensitiveFields []string
+	// Patterns are regular expressions, matching parts of string values are removed.
+	Patterns []string
+	// AnonymizeIP zeroes the host part of client IP addresses.
+	AnonymizeIP bool
+	// DropRequestCookies never stores the cookies of the request.
+	DropRequestCookies bool
+	// DropRequestData never stores the body of the request.
+	DropRequestData bool
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+)
+
+// DeadLetterSource is the queue a dead-letter message was consumed from.
+type DeadLetterSource string
+
+const (
+	DeadLetterSourceEnvelope   DeadLetterSource = "envelope"
+	DeadLetterSourceStoreEvent DeadLetterSource = "store_event"
+)
+
+// DeadLetterMessage is a queue message which failed to be processed, it keeps the original message to be replayed.
+type DeadLetterMessage struct {
+	Source    DeadLetterSource `json:"source"`
+	ProjectID ProjectID        `json:"project_id"` // 0 if the message can't be read
+	Error     string           `json:"error"`
+	Attempts  uint             `json:"attempts"`
+	FailedAt  time.Time        `json:"failed_at"`
+	Payload   []byte           `json:"payload"`
+}
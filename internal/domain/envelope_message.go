@@ -87,6 +87,22 @@ func (em *EnvelopeMessage) IncrementRetry() {
 	em.Processing.RetryCount++
 }
 
+// EnvelopeItemsError is returned when some items of an envelope failed to be processed.
+// Envelope holds the envelope header with the failed items only, the processed items are left out
+// so processing it again doesn't store them twice.
+type EnvelopeItemsError struct {
+	Envelope []byte
+	Err      error
+}
+
+func (e *EnvelopeItemsError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *EnvelopeItemsError) Unwrap() error {
+	return e.Err
+}
+
 // generateEnvelopeID generates unique ID for envelope.
 func generateEnvelopeID() string {
 	return time.Now().Format("20060102150405") + "-" + randomString(8)
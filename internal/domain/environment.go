@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+)
+
+// Environment is an environment of project events, like "production" or "staging",
+// discovered from the ingested events.
+type Environment struct {
+	ProjectID ProjectID
+	Name      string
+	// Hidden environments are still ingested but hidden from the environment selectors.
+	Hidden    bool
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
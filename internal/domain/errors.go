@@ -18,10 +18,36 @@ var (
 	ErrUserNotFound          = errors.New("user not found")
 	ErrNoEnvelope            = errors.New("empty envelope")
 	ErrInvalidEnvelopeHeader = errors.New("invalid envelope header")
+	ErrInvalidEvent          = errors.New("invalid event")
 	ErrInvalid2FACode        = errors.New("invalid 2FA code")
 	ErrInvalidEmailCode      = errors.New("invalid email code")
 	ErrTwoFARequired         = errors.New("2FA required")
 	ErrTooMany2FAAttempts    = errors.New("too many 2FA attempts, try later")
 	ErrLastOwner             = errors.New("cannot leave team as the last owner")
 	ErrTeamHasProjects       = errors.New("team is attached to one or more projects")
+
+	ErrInvalidGroupingStrategy = errors.New("invalid grouping strategy")
+	ErrInvalidGroupingRule     = errors.New("invalid grouping rule")
+	ErrInvalidIssueMerge       = errors.New("invalid issue merge")
+
+	ErrAttachmentTooLarge      = errors.New("attachment is too large")
+	ErrAttachmentQuotaExceeded = errors.New("attachments quota exceeded")
+
+	ErrInvalidCheckIn       = errors.New("invalid check-in")
+	ErrInvalidMonitorConfig = errors.New("invalid monitor config")
+	ErrMonitorNotFound      = errors.New("monitor not found")
+
+	ErrInvalidProjectKey       = errors.New("invalid or unauthorized key")
+	ErrLastActiveProjectKey    = errors.New("last active project key")
+	ErrProjectKeyRateLimited   = errors.New("project key rate limit exceeded")
+	ErrInvalidInboundFilters   = errors.New("invalid inbound filters")
+	ErrInvalidRateLimits       = errors.New("invalid rate limits")
+	ErrRateLimited             = errors.New("rate limit exceeded")
+	ErrInvalidDataScrubbing    = errors.New("invalid data scrubbing")
+	ErrInvalidSearchQuery      = errors.New("invalid search query")
+	ErrInvalidAssignee         = errors.New("invalid issue assignee")
+	ErrInvalidIssueComment     = errors.New("invalid issue comment")
+	ErrInvalidIgnoreConditions = errors.New("invalid ignore conditions")
+	ErrInvalidResolvedRelease  = errors.New("invalid resolved in release")
+	ErrInvalidBulkOperation    = errors.New("invalid bulk operation")
 )
@@ -25,6 +25,15 @@ type Event struct {
 	ServerName  string
 	Environment string
 	Release     string
+	Transaction string
+	// Fingerprint is the SDK-supplied grouping fingerprint, may contain template variables.
+	Fingerprint []string
+	// GroupingStrategy is the project strategy used for the default fingerprint.
+	GroupingStrategy GroupingStrategy
+	// Exceptions is the full exception chain, ExceptionData holds the first one.
+	Exceptions []ExceptionInfo
+	// FrameRules are the project stack trace rules applied to frames before grouping.
+	FrameRules []FrameRule
 
 	ExceptionData
 	EventRequestContext
@@ -74,6 +83,83 @@ func (ev *Event) FullFingerprint() string {
 	return fingerprinter.SHA1FromStrings(*ev.ExceptionType, *ev.ExceptionValue, string(stacktraceData))
 }
 
+// GroupingFingerprint returns the hash used to group the event into an issue.
+// An SDK-supplied fingerprint takes precedence over the built-in algorithm.
+func (ev *Event) GroupingFingerprint() string {
+	if len(ev.Fingerprint) == 0 {
+		return ev.DefaultFingerprint()
+	}
+
+	parts, onlyDefault := fingerprinter.ExpandTemplate(ev.Fingerprint, ev.fingerprintVariable)
+	if onlyDefault {
+		// ["{{ default }}"] must keep grouping events into the same issues as before.
+		return ev.DefaultFingerprint()
+	}
+
+	return fingerprinter.SHA1FromStrings(parts...)
+}
+
+func (ev *Event) fingerprintVariable(name string) (string, bool) {
+	switch name {
+	case fingerprinter.VarDefault:
+		return ev.DefaultFingerprint(), true
+	case fingerprinter.VarType:
+		if ev.ExceptionType != nil && *ev.ExceptionType != "" {
+			return *ev.ExceptionType, true
+		}
+
+		return "<no-type>", true
+	case fingerprinter.VarFunction:
+		frame, ok := ev.Stacktrace(ev.ExceptionStacktrace).MostRelevantFrame()
+		if ok && frame.Function != "" {
+			return frame.Function, true
+		}
+
+		return "<no-function>", true
+	case fingerprinter.VarModule:
+		frame, ok := ev.Stacktrace(ev.ExceptionStacktrace).MostRelevantFrame()
+		if ok && frame.ModuleName() != "" {
+			return frame.ModuleName(), true
+		}
+
+		return "<no-module>", true
+	case fingerprinter.VarTransaction:
+		if ev.Transaction != "" {
+			return ev.Transaction, true
+		}
+
+		return "<no-transaction>", true
+	default:
+		return "", false
+	}
+}
+
+// Stacktrace decodes a raw stacktrace of the event and applies the project frame rules.
+func (ev *Event) Stacktrace(data json.RawMessage) Stacktrace {
+	stacktrace := ParseStacktrace(data)
+	stacktrace.ApplyRules(ev.FrameRules)
+
+	return stacktrace
+}
+
+// ExceptionChain returns all exceptions of the event. Events read back from storage
+// only keep the first exception.
+func (ev *Event) ExceptionChain() []ExceptionInfo {
+	if len(ev.Exceptions) > 0 || ev.ExceptionType == nil {
+		return ev.Exceptions
+	}
+
+	exception := ExceptionInfo{
+		Type:       *ev.ExceptionType,
+		Stacktrace: ev.ExceptionStacktrace,
+	}
+	if ev.ExceptionValue != nil {
+		exception.Value = *ev.ExceptionValue
+	}
+
+	return []ExceptionInfo{exception}
+}
+
 func (id EventID) String() string {
 	return string(id)
 }
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+)
+
+// EventDetails is a single event with its decoded payload and its neighbours in the issue.
+type EventDetails struct {
+	Event Event
+	Data  EventData
+	// IssueID is zero when the issue of the event is gone
+	IssueID IssueID
+	// PreviousEventID is the older event of the issue, empty for the oldest one
+	PreviousEventID EventID
+	// NextEventID is the newer event of the issue, empty for the latest one
+	NextEventID EventID
+}
+
+// EventData is the decoded event payload beyond the indexed event fields.
+type EventData struct {
+	Breadcrumbs []Breadcrumb
+	Contexts    map[string]any
+	Extra       map[string]any
+	SDK         *SDKInfo
+	// Exceptions is the full exception chain, the way the SDK sent it
+	Exceptions []ExceptionInfo
+}
+
+type Breadcrumb struct {
+	Timestamp *time.Time
+	Type      string
+	Category  string
+	Level     string
+	Message   string
+	Data      map[string]any
+}
+
+type SDKInfo struct {
+	Name         string
+	Version      string
+	Integrations []string
+}
+
+// IssueEventsFilter selects the events of an issue, newest first.
+type IssueEventsFilter struct {
+	ProjectID ProjectID
+	IssueID   IssueID
+	Cursor    *EventCursor
+	Limit     uint
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/rom8726/warden/pkg/fingerprinter"
 )
 
 func TestEvent_FullFingerprint(t *testing.T) {
@@ -31,3 +33,72 @@ func TestEvent_FullFingerprint(t *testing.T) {
 		assert.Equal(t, "a3da4ed0ab522704072d2ffef63601ad54fbde89", ev.FullFingerprint())
 	})
 }
+
+func TestEvent_GroupingFingerprint(t *testing.T) {
+	exType := "DatabaseError"
+	exValue := "connection refused"
+	base := Event{
+		Level:       IssueLevelException,
+		Source:      SourceException,
+		Platform:    "python",
+		Transaction: "/api/orders",
+		ExceptionData: ExceptionData{
+			ExceptionType:  &exType,
+			ExceptionValue: &exValue,
+			ExceptionStacktrace: []byte(`{"frames":[
+				{"module":"app.orders","function":"create","in_app":true},
+				{"module":"sqlalchemy.engine","function":"connect","in_app":false}
+			]}`),
+		},
+	}
+
+	t.Run("no fingerprint falls back to default", func(t *testing.T) {
+		ev := base
+		assert.Equal(t, ev.FullFingerprint(), ev.GroupingFingerprint())
+	})
+
+	t.Run("only default keeps default grouping", func(t *testing.T) {
+		ev := base
+		ev.Fingerprint = []string{"{{ default }}"}
+		assert.Equal(t, ev.FullFingerprint(), ev.GroupingFingerprint())
+	})
+
+	t.Run("custom fingerprint ignores message", func(t *testing.T) {
+		ev1 := base
+		ev1.Fingerprint = []string{"database-error"}
+
+		otherValue := "timeout"
+		ev2 := base
+		ev2.ExceptionValue = &otherValue
+		ev2.Fingerprint = []string{"database-error"}
+
+		assert.Equal(t, ev1.GroupingFingerprint(), ev2.GroupingFingerprint())
+		assert.NotEqual(t, ev1.FullFingerprint(), ev1.GroupingFingerprint())
+	})
+
+	t.Run("default with extra component splits issue", func(t *testing.T) {
+		ev1 := base
+		ev1.Fingerprint = []string{"{{ default }}", "tenant-a"}
+		ev2 := base
+		ev2.Fingerprint = []string{"{{ default }}", "tenant-b"}
+
+		assert.NotEqual(t, ev1.GroupingFingerprint(), ev2.GroupingFingerprint())
+	})
+
+	t.Run("variables are expanded", func(t *testing.T) {
+		ev := base
+		ev.Fingerprint = []string{"{{ type }}", "{{ function }}", "{{ module }}", "{{ transaction }}"}
+		assert.Equal(t,
+			fingerprinter.SHA1FromStrings("DatabaseError", "create", "app.orders", "/api/orders"),
+			ev.GroupingFingerprint(),
+		)
+	})
+
+	t.Run("missing values use placeholders", func(t *testing.T) {
+		ev := Event{Source: SourceEvent, Message: "hello", Fingerprint: []string{"{{ type }}", "{{ transaction }}"}}
+		assert.Equal(t,
+			fingerprinter.SHA1FromStrings("<no-type>", "<no-transaction>"),
+			ev.GroupingFingerprint(),
+		)
+	})
+}
@@ -16,10 +16,15 @@ type ListIssuesFilter struct {
 	ProjectID *ProjectID
 	Level     *IssueLevel
 	Status    *IssueStatus
+	Assigned  *IssueAssignedFilter
 	TimeFrom  time.Time
 	TimeTo    time.Time
 	OrderBy   OrderByColumn // total_events by default
 	OrderAsc  bool          // ASC / DESC, DESC by default
 	PageNum   uint          // from 1
 	PerPage   uint          // records limit
+	// Query filters issues by their events, see SearchQuery
+	Query SearchQuery
+	// EventGroups are the event groups matching the Query, resolved by the use case
+	EventGroups []EventGroup
 }
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/rom8726/warden/pkg/fingerprinter"
+)
+
+// GroupingStrategy is a versioned algorithm used to compute the default fingerprint of an event.
+// Projects keep their strategy until it is switched explicitly, so existing issues are not re-split.
+type GroupingStrategy string
+
+const (
+	// GroupingStrategyLegacy hashes the exception value and the whole serialized stacktrace.
+	GroupingStrategyLegacy GroupingStrategy = "legacy"
+	// GroupingStrategyStacktraceV1 hashes exception types and in-app frames (module, function and
+	// normalized context line), ignoring line numbers and dynamic values in messages.
+	GroupingStrategyStacktraceV1 GroupingStrategy = "stacktrace:v1"
+
+	DefaultGroupingStrategy = GroupingStrategyLegacy
+)
+
+// GroupingStrategies lists all supported strategies, oldest first.
+var GroupingStrategies = []GroupingStrategy{
+	GroupingStrategyLegacy,
+	GroupingStrategyStacktraceV1,
+}
+
+// ExceptionInfo is a single exception of a (possibly chained) exception list.
+type ExceptionInfo struct {
+	Type       string
+	Value      string
+	Stacktrace json.RawMessage
+}
+
+func (s GroupingStrategy) IsValid() bool {
+	for _, strategy := range GroupingStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsFrameRules reports whether stack trace grouping rules change the fingerprints of the strategy.
+// The legacy strategy hashes the whole serialized stacktrace and ignores the frame rules.
+func (s GroupingStrategy) SupportsFrameRules() bool {
+	return s == GroupingStrategyStacktraceV1
+}
+
+func (s GroupingStrategy) String() string {
+	return string(s)
+}
+
+// DefaultFingerprint returns the built-in fingerprint of the event for its grouping strategy.
+func (ev *Event) DefaultFingerprint() string {
+	if ev.GroupingStrategy == GroupingStrategyStacktraceV1 {
+		return ev.StacktraceFingerprint()
+	}
+
+	return ev.FullFingerprint()
+}
+
+// StacktraceFingerprint computes the fingerprint of the stacktrace:v1 strategy.
+// Every exception of the chain contributes its type and in-app frames. Exceptions without
+// frames contribute the normalized value, and plain message events the normalized message.
+func (ev *Event) StacktraceFingerprint() string {
+	exceptions := ev.ExceptionChain()
+	if len(exceptions) == 0 {
+		return fingerprinter.SHA1FromStrings(
+			fingerprinter.NormalizeMessage(ev.Message),
+			string(ev.Level),
+			ev.Platform,
+		)
+	}
+
+	components := make([]string, 0, len(exceptions)*4)
+	for _, exception := range exceptions {
+		components = append(components, exceptionComponents(exception, ev.Stacktrace(exception.Stacktrace))...)
+	}
+
+	return fingerprinter.SHA1FromStrings(components...)
+}
+
+func exceptionComponents(exception ExceptionInfo, stacktrace Stacktrace) []string {
+	components := []string{exception.Type}
+
+	frames := stacktrace.InAppFrames()
+	if len(frames) == 0 {
+		// No application code in the stacktrace, group by the system frames.
+		frames = stacktrace.Frames
+	}
+
+	for _, frame := range frames {
+		if frame.ExcludeFromGroup {
+			continue
+		}
+
+		if component := frame.groupingComponent(); component != "" {
+			components = append(components, component)
+		}
+	}
+
+	if len(components) == 1 {
+		components = append(components, fingerprinter.NormalizeMessage(exception.Value))
+	}
+
+	return components
+}
+
+// groupingComponent returns the frame identity that does not depend on line numbers:
+// module (or file without query string), function and whitespace-normalized source line.
+func (f StackFrame) groupingComponent() string {
+	module := f.Module
+	if module == "" {
+		module, _, _ = strings.Cut(f.Filename, "?")
+	}
+
+	if module == "" && f.Function == "" {
+		return ""
+	}
+
+	return module + ":" + f.Function + ":" + fingerprinter.NormalizeWhitespace(f.ContextLine)
+}
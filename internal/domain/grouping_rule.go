@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"time"
+)
+
+type GroupingRuleID uint
+
+// GroupingRuleType is the kind of server-side grouping rule.
+type GroupingRuleType string
+
+const (
+	// GroupingRuleTypeFingerprint assigns a fingerprint to matching events,
+	// e.g. `error.type:DatabaseError -> database-error`.
+	GroupingRuleTypeFingerprint GroupingRuleType = "fingerprint"
+	// GroupingRuleTypeStacktrace changes how matching frames are used for grouping,
+	// e.g. `stack.module:vendor/** -app`.
+	GroupingRuleTypeStacktrace GroupingRuleType = "stacktrace"
+)
+
+// GroupingRule is a per-project grouping rule. Rules are applied in creation order.
+type GroupingRule struct {
+	ID         GroupingRuleID
+	ProjectID  ProjectID
+	Type       GroupingRuleType
+	Expression string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type GroupingRuleDTO struct {
+	ProjectID  ProjectID
+	Type       GroupingRuleType
+	Expression string
+}
+
+// GroupingRulePreview shows which recent events and issues a rule would affect.
+type GroupingRulePreview struct {
+	ScannedEvents uint
+	MatchedEvents uint
+	Issues        []GroupingRulePreviewIssue
+}
+
+type GroupingRulePreviewIssue struct {
+	IssueID         *IssueID
+	Title           string
+	Fingerprint     string
+	NewFingerprints []string
+	MatchedEvents   uint
+}
+
+// FingerprintRule returns the fingerprint for an event it matches.
+type FingerprintRule interface {
+	Fingerprint(ev *Event) ([]string, bool)
+}
+
+// FrameRule modifies a stack frame before it is used for grouping.
+type FrameRule interface {
+	ApplyFrame(frame *StackFrame) bool
+}
+
+// GroupingConfig is the compiled grouping configuration of a project.
+type GroupingConfig struct {
+	Strategy         GroupingStrategy
+	FingerprintRules []FingerprintRule
+	FrameRules       []FrameRule
+}
+
+func (t GroupingRuleType) IsValid() bool {
+	switch t {
+	case GroupingRuleTypeFingerprint, GroupingRuleTypeStacktrace:
+		return true
+	default:
+		return false
+	}
+}
+
+func (id GroupingRuleID) Uint() uint {
+	return uint(id)
+}
+
+// Apply attaches the configuration to the event and assigns the fingerprint of the first
+// matching fingerprint rule, which takes precedence over the SDK-supplied one.
+func (cfg GroupingConfig) Apply(ev *Event) {
+	ev.GroupingStrategy = cfg.Strategy
+	ev.FrameRules = cfg.FrameRules
+
+	for _, rule := range cfg.FingerprintRules {
+		if fingerprint, ok := rule.Fingerprint(ev); ok {
+			ev.Fingerprint = fingerprint
+
+			return
+		}
+	}
+}
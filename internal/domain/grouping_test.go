@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupingStrategy_IsValid(t *testing.T) {
+	assert.True(t, GroupingStrategyLegacy.IsValid())
+	assert.True(t, GroupingStrategyStacktraceV1.IsValid())
+	assert.False(t, GroupingStrategy("stacktrace:v0").IsValid())
+	assert.False(t, GroupingStrategy("").IsValid())
+}
+
+func TestEvent_StacktraceFingerprint(t *testing.T) {
+	newEvent := func(value string, stacktrace string) Event {
+		return Event{
+			Level:            IssueLevelException,
+			Source:           SourceException,
+			Platform:         "go",
+			GroupingStrategy: GroupingStrategyStacktraceV1,
+			Exceptions: []ExceptionInfo{{
+				Type:       "*errors.errorString",
+				Value:      value,
+				Stacktrace: json.RawMessage(stacktrace),
+			}},
+		}
+	}
+
+	const stacktrace = `{"frames":[
+		{"module":"runtime","function":"goexit","lineno":1700,"in_app":false},
+		{"module":"app/users","function":"Get","lineno":42,"context_line":"  return nil, err","in_app":true}
+	]}`
+	const movedStacktrace = `{"frames":[
+		{"module":"runtime","function":"goexit","lineno":1701,"in_app":false},
+		{"module":"app/users","function":"Get","lineno":57,"context_line":"return nil,   err","in_app":true}
+	]}`
+
+	t.Run("line numbers and values are ignored", func(t *testing.T) {
+		ev1 := newEvent("user 123 not found", stacktrace)
+		ev2 := newEvent("user 456 not found", movedStacktrace)
+		assert.Equal(t, ev1.StacktraceFingerprint(), ev2.StacktraceFingerprint())
+		assert.Equal(t, ev1.StacktraceFingerprint(), ev1.GroupingFingerprint())
+	})
+
+	t.Run("different function splits groups", func(t *testing.T) {
+		ev1 := newEvent("user 123 not found", stacktrace)
+		ev2 := newEvent("user 123 not found", `{"frames":[
+			{"module":"app/users","function":"List","context_line":"return nil, err","in_app":true}
+		]}`)
+		assert.NotEqual(t, ev1.StacktraceFingerprint(), ev2.StacktraceFingerprint())
+	})
+
+	t.Run("system frames are ignored when in-app frames exist", func(t *testing.T) {
+		ev1 := newEvent("boom", stacktrace)
+		ev2 := newEvent("boom", `{"frames":[
+			{"module":"net/http","function":"serve","in_app":false},
+			{"module":"app/users","function":"Get","context_line":"return nil, err","in_app":true}
+		]}`)
+		assert.Equal(t, ev1.StacktraceFingerprint(), ev2.StacktraceFingerprint())
+	})
+
+	t.Run("no frames uses normalized value", func(t *testing.T) {
+		ev1 := newEvent("timeout after 30s on 10.0.0.1", "")
+		ev2 := newEvent("timeout after 15s on 10.0.0.2", "")
+		ev3 := newEvent("connection refused", "")
+		assert.Equal(t, ev1.StacktraceFingerprint(), ev2.StacktraceFingerprint())
+		assert.NotEqual(t, ev1.StacktraceFingerprint(), ev3.StacktraceFingerprint())
+	})
+
+	t.Run("chained exceptions are considered", func(t *testing.T) {
+		ev1 := newEvent("boom", stacktrace)
+		ev2 := newEvent("boom", stacktrace)
+		ev2.Exceptions = append(ev2.Exceptions, ExceptionInfo{Type: "ValueError", Value: "bad value 42"})
+		assert.NotEqual(t, ev1.StacktraceFingerprint(), ev2.StacktraceFingerprint())
+	})
+
+	t.Run("message event", func(t *testing.T) {
+		ev1 := Event{Source: SourceEvent, Level: IssueLevelError, Message: "retry 1 of 3 failed"}
+		ev2 := Event{Source: SourceEvent, Level: IssueLevelError, Message: "retry 2 of 3 failed"}
+		assert.Equal(t, ev1.StacktraceFingerprint(), ev2.StacktraceFingerprint())
+	})
+
+	t.Run("legacy strategy keeps full fingerprint", func(t *testing.T) {
+		exType := "*errors.errorString"
+		exValue := "user 123 not found"
+		ev := newEvent(exValue, stacktrace)
+		ev.GroupingStrategy = GroupingStrategyLegacy
+		ev.ExceptionType = &exType
+		ev.ExceptionValue = &exValue
+		ev.ExceptionStacktrace = json.RawMessage(stacktrace)
+		assert.Equal(t, ev.FullFingerprint(), ev.GroupingFingerprint())
+	})
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+)
+
+// ProjectFiltersChangedChannel is the Postgres notification channel of changed inbound filters,
+// the payload is the project ID.
+const ProjectFiltersChangedChannel = "project_filters_changed"
+
+// InboundFilters are per-project rules applied by the ingest servers before data is queued.
+type InboundFilters struct {
+	ProjectID ProjectID
+	// AllowedOrigins is the Origin/Referer allow-list of browser requests, e.g. "https://*.example.com".
+	// An empty list allows all origins.
+	AllowedOrigins []string
+	WebCrawlers    bool
+	LegacyBrowsers bool
+	Localhost      bool
+	// BlockedIPRanges are client IP addresses and CIDR ranges.
+	BlockedIPRanges []string
+	// ErrorMessages are glob patterns of error messages, e.g. "*ResizeObserver loop*".
+	ErrorMessages []string
+	// Releases are glob patterns of releases, e.g. "*-dev".
+	Releases  []string
+	UpdatedAt time.Time
+}
+
+// InboundFilterReason is the reason data was dropped by an inbound filter,
+// it's recorded as the reason of the filtered outcome.
+type InboundFilterReason string
+
+const (
+	InboundFilterWebCrawlers    InboundFilterReason = "web_crawlers"
+	InboundFilterLegacyBrowsers InboundFilterReason = "legacy_browsers"
+	InboundFilterLocalhost      InboundFilterReason = "localhost"
+	InboundFilterIPAddress      InboundFilterReason = "ip_address"
+	InboundFilterErrorMessage   InboundFilterReason = "error_message"
+	InboundFilterRelease        InboundFilterReason = "release_version"
+)
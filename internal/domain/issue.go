@@ -11,6 +11,8 @@ type IssueSource string
 const (
 	SourceEvent     IssueSource = "event"
 	SourceException IssueSource = "exception"
+	SourceMonitor   IssueSource = "monitor"
+	SourceSpike     IssueSource = "spike"
 )
 
 // IssueStatus represents the status of a resolution.
@@ -48,8 +50,73 @@ type Issue struct {
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 	LastNotificationAt *time.Time
+	Assignee           IssueAssignee
+	// Escalating is set when an ignored issue came back because one of its ignore conditions was met
+	Escalating bool
+	// ResolvedInRelease is set for issues resolved in a release
+	ResolvedInRelease *IssueResolvedInRelease
 }
 
+// IssueResolvedInRelease bounds the resolution of an issue to a release: events of releases older
+// than the fix don't reactivate the issue.
+type IssueResolvedInRelease struct {
+	// Version of the fix release following semver, for the next release it's the latest release
+	// at the resolve time
+	Version     string
+	NextRelease bool
+}
+
+// IssueAssignee is the user or the team owning an issue, both IDs are nil for unassigned issues.
+type IssueAssignee struct {
+	UserID *UserID
+	TeamID *TeamID
+	// Name is the username or the team name, only filled when listing issues
+	Name string
+}
+
+// IssueIgnoreConditions end ignoring an issue when any of them is met,
+// an issue ignored without conditions stays ignored until its status is changed.
+type IssueIgnoreConditions struct {
+	Until *time.Time
+	// For is converted to Until when the issue is ignored
+	For *time.Duration
+	// Count of events since the issue was ignored
+	Count *uint
+	// UserCount of users affected since the issue was ignored
+	UserCount *uint
+	// RatePerHour of events in the last hour
+	RatePerHour *uint
+}
+
+// IssueSnooze is an ignored issue with its ignore conditions.
+type IssueSnooze struct {
+	IssueID      IssueID
+	ProjectID    ProjectID
+	Level        IssueLevel
+	Fingerprints []string
+	Conditions   IssueIgnoreConditions
+	// EventsBase is the total events of the issue when it was ignored
+	EventsBase  uint
+	TotalEvents uint
+	CreatedBy   *UserID
+	CreatedAt   time.Time
+}
+
+// IssueSnoozeCursor points to the last snooze of a page, the next page starts after it.
+type IssueSnoozeCursor struct {
+	CreatedAt time.Time
+	IssueID   IssueID
+}
+
+// IssueAssignedFilter selects issues by their assignee relative to the current user.
+type IssueAssignedFilter string
+
+const (
+	IssueAssignedToMe      IssueAssignedFilter = "me"
+	IssueAssignedToMyTeams IssueAssignedFilter = "my_teams"
+	IssueUnassigned        IssueAssignedFilter = "unassigned"
+)
+
 type IssueExtended struct {
 	Issue
 	ProjectName        string
@@ -66,6 +133,8 @@ type IssueDTO struct {
 	Title       string
 	Level       IssueLevel
 	Platform    string
+	// ReleaseID is the release of the event, zero for issues not coming from events
+	ReleaseID ReleaseID
 }
 
 type IssueExtendedWithChildren struct {
@@ -75,6 +144,29 @@ type IssueExtendedWithChildren struct {
 	ResolvedByUsername *string
 	ResolvedAt         *time.Time
 	Events             []Event
+	// MergedFingerprints are fingerprints of other issues merged into this one.
+	MergedFingerprints []string
+	// Attachments of the listed events.
+	Attachments []Attachment
+	// IgnoreConditions of an ignored issue, nil when it's ignored until its status is changed.
+	IgnoreConditions *IssueIgnoreConditions
+}
+
+// FingerprintStats summarizes the stored events of a single fingerprint.
+type FingerprintStats struct {
+	TotalEvents  uint
+	FirstSeen    time.Time
+	LastSeen     time.Time
+	FirstRelease string
+	Releases     []string
+}
+
+func (a IssueAssignee) IsSet() bool {
+	return a.UserID != nil || a.TeamID != nil
+}
+
+func (c IssueIgnoreConditions) IsEmpty() bool {
+	return c.Until == nil && c.For == nil && c.Count == nil && c.UserCount == nil && c.RatePerHour == nil
 }
 
 func (id IssueID) Uint() uint {
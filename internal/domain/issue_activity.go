@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+)
+
+type IssueActivityID uint
+
+// IssueActivityType represents the kind of issue activity feed entry.
+type IssueActivityType string
+
+const (
+	IssueActivityFirstSeen     IssueActivityType = "first_seen"
+	IssueActivityRegression    IssueActivityType = "regression"
+	IssueActivityStatusChanged IssueActivityType = "status_changed"
+	IssueActivityAssigned      IssueActivityType = "assigned"
+	IssueActivityMerged        IssueActivityType = "merged"
+	IssueActivityUnmerged      IssueActivityType = "unmerged"
+	IssueActivityCommented     IssueActivityType = "commented"
+	IssueActivityEscalating    IssueActivityType = "escalating"
+)
+
+// IssueActivity is an entry of the issue activity feed, UserID is empty for activities of the system.
+type IssueActivity struct {
+	ID        IssueActivityID
+	IssueID   IssueID
+	Type      IssueActivityType
+	UserID    *UserID
+	Username  string
+	Data      IssueActivityData
+	CreatedAt time.Time
+}
+
+// IssueActivityData holds the details of an activity, the set fields depend on the activity type.
+// AssigneeName and CommentBody are resolved when the activities are listed.
+type IssueActivityData struct {
+	Status         IssueStatus     `json:"status,omitempty"`
+	AssignedUserID *UserID         `json:"assigned_user_id,omitempty"`
+	AssignedTeamID *TeamID         `json:"assigned_team_id,omitempty"`
+	AssigneeName   string          `json:"-"`
+	IssueIDs       []IssueID       `json:"issue_ids,omitempty"`
+	Fingerprints   []string        `json:"fingerprints,omitempty"`
+	CommentID      *IssueCommentID `json:"comment_id,omitempty"`
+	CommentBody    *string         `json:"-"`
+}
+
+type IssueActivityDTO struct {
+	IssueID IssueID
+	Type    IssueActivityType
+	UserID  *UserID
+	Data    IssueActivityData
+}
+
+type IssueActivitiesFilter struct {
+	IssueID IssueID
+	PageNum uint
+	PerPage uint
+}
+
+type IssueCommentID uint
+
+// IssueComment represents a comment on an issue, replies refer to the comment they answer with ParentID.
+type IssueComment struct {
+	ID        IssueCommentID
+	IssueID   IssueID
+	ParentID  *IssueCommentID
+	UserID    *UserID
+	Username  string
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type IssueCommentDTO struct {
+	IssueID  IssueID
+	ParentID *IssueCommentID
+	UserID   UserID
+	Body     string
+}
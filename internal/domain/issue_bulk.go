@@ -0,0 +1,46 @@
+package domain
+
+// IssueBulkMaxIssues limits the number of issues changed by one bulk operation.
+const IssueBulkMaxIssues = 1000
+
+type IssueBulkAction string
+
+const (
+	IssueBulkActionResolve   IssueBulkAction = "resolve"
+	IssueBulkActionIgnore    IssueBulkAction = "ignore"
+	IssueBulkActionUnresolve IssueBulkAction = "unresolve"
+	IssueBulkActionAssign    IssueBulkAction = "assign"
+	IssueBulkActionMerge     IssueBulkAction = "merge"
+	IssueBulkActionDelete    IssueBulkAction = "delete"
+)
+
+// IssueBulkOperation is an action applied to the issues of a project given either by IDs or by a filter.
+type IssueBulkOperation struct {
+	ProjectID ProjectID
+	Action    IssueBulkAction
+	IssueIDs  []IssueID
+	Filter    *ListIssuesFilter
+	// IgnoreConditions of the ignore action, ignore until the status is changed when nil
+	IgnoreConditions *IssueIgnoreConditions
+	// ResolvedInRelease of the resolve action, resolve without a release when nil
+	ResolvedInRelease *IssueResolvedInRelease
+	// Assignee of the assign action, empty unassigns the issues
+	Assignee IssueAssignee
+	// PrimaryIssueID is the issue the others are merged into by the merge action
+	PrimaryIssueID IssueID
+	// Confirm confirms the delete action by filter, deleting by IDs doesn't need it
+	Confirm bool
+}
+
+// IssueBulkResult sums up a bulk operation by issue IDs.
+type IssueBulkResult struct {
+	Changed []IssueID
+	// Unchanged issues already were in the requested state
+	Unchanged []IssueID
+	// Denied issues can't be managed by the current user
+	Denied   []IssueID
+	NotFound []IssueID
+	// HasMore is set when more than IssueBulkMaxIssues issues match the filter,
+	// the rest is left for another run
+	HasMore bool
+}
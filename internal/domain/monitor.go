@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type (
+	MonitorID        uint
+	MonitorCheckInID uint
+)
+
+func (id MonitorID) Uint() uint {
+	return uint(id)
+}
+
+func (id MonitorCheckInID) Uint() uint {
+	return uint(id)
+}
+
+type MonitorScheduleType string
+
+const (
+	MonitorScheduleCrontab  MonitorScheduleType = "crontab"
+	MonitorScheduleInterval MonitorScheduleType = "interval"
+)
+
+// MonitorStatus is the status of the last check-in of a monitor.
+type MonitorStatus string
+
+const (
+	MonitorStatusOK      MonitorStatus = "ok"
+	MonitorStatusError   MonitorStatus = "error"
+	MonitorStatusMissed  MonitorStatus = "missed"
+	MonitorStatusTimeout MonitorStatus = "timeout"
+)
+
+type CheckInStatus string
+
+const (
+	CheckInStatusInProgress CheckInStatus = "in_progress"
+	CheckInStatusOK         CheckInStatus = "ok"
+	CheckInStatusError      CheckInStatus = "error"
+	CheckInStatusMissed     CheckInStatus = "missed"
+	CheckInStatusTimeout    CheckInStatus = "timeout"
+)
+
+// IsValidReportedCheckInStatus reports whether a check-in with the status can be sent by a client,
+// missed and timed out check-ins are detected by Warden.
+func IsValidReportedCheckInStatus(status CheckInStatus) bool {
+	switch status {
+	case CheckInStatusInProgress, CheckInStatusOK, CheckInStatusError:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	DefaultMonitorTimezone      = "UTC"
+	DefaultMonitorCheckInMargin = time.Minute
+	DefaultMonitorMaxRuntime    = 30 * time.Minute
+)
+
+// Monitor is a cron monitor of a periodic job, the job reports its runs as check-ins.
+type Monitor struct {
+	ID            MonitorID
+	ProjectID     ProjectID
+	Slug          string
+	ScheduleType  MonitorScheduleType
+	Schedule      string // crontab expression or interval like "30 minute"
+	Timezone      string
+	CheckInMargin time.Duration
+	MaxRuntime    time.Duration
+	Status        MonitorStatus
+	LastCheckInAt *time.Time
+	NextCheckInAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IncidentIssue returns the issue a failed, missed or timed out check-in of the monitor is reported as.
+func (m Monitor) IncidentIssue(status CheckInStatus) IssueDTO {
+	var title string
+	switch status {
+	case CheckInStatusMissed:
+		title = fmt.Sprintf("Monitor %s missed a check-in", m.Slug)
+	case CheckInStatusTimeout:
+		title = fmt.Sprintf("Monitor %s check-in timed out", m.Slug)
+	default:
+		title = fmt.Sprintf("Monitor %s check-in failed", m.Slug)
+	}
+
+	return IssueDTO{
+		ProjectID:   m.ProjectID,
+		Fingerprint: fmt.Sprintf("monitor:%s:%s", m.Slug, status),
+		Source:      SourceMonitor,
+		Status:      IssueStatusUnresolved,
+		Title:       title,
+		Level:       IssueLevelError,
+		Platform:    "other",
+	}
+}
+
+// MonitorConfig is the monitor definition sent along with a check-in, it creates or updates the monitor.
+type MonitorConfig struct {
+	ScheduleType  MonitorScheduleType
+	Schedule      string
+	Timezone      string
+	CheckInMargin time.Duration
+	MaxRuntime    time.Duration
+}
+
+type MonitorDTO struct {
+	ProjectID     ProjectID
+	Slug          string
+	ScheduleType  MonitorScheduleType
+	Schedule      string
+	Timezone      string
+	CheckInMargin time.Duration
+	MaxRuntime    time.Duration
+}
+
+// MonitorCheckIn is a single run of a monitored job.
+type MonitorCheckIn struct {
+	ID          MonitorCheckInID
+	MonitorID   MonitorID
+	CheckInID   string // sent by the client to finish an in progress check-in
+	Status      CheckInStatus
+	Duration    *time.Duration
+	Environment string
+	ExpectedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type MonitorCheckInDTO struct {
+	MonitorID   MonitorID
+	CheckInID   string
+	Status      CheckInStatus
+	Duration    *time.Duration
+	Environment string
+	ExpectedAt  *time.Time
+}
+
+// CheckIn is a check_in envelope item.
+type CheckIn struct {
+	ID            string
+	MonitorSlug   string
+	Status        CheckInStatus
+	Duration      *time.Duration
+	Environment   string
+	MonitorConfig *MonitorConfig
+}
+
+// TimedOutCheckIn is an in progress check-in that exceeded the max runtime of its monitor.
+type TimedOutCheckIn struct {
+	CheckIn MonitorCheckIn
+	Monitor Monitor
+}
+
+type MonitorWithCheckIns struct {
+	Monitor
+	CheckIns []MonitorCheckIn
+}
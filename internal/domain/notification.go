@@ -38,9 +38,11 @@ type NotificationSetting struct {
 	Type      NotificationType
 	Config    json.RawMessage
 	Enabled   bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Rules     []NotificationRule
+	// RouteToAssignee emails the alerts of assigned issues to the assignee instead of the channel
+	RouteToAssignee bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Rules           []NotificationRule
 }
 
 // NotificationRule represents a rule for when to send notifications.
@@ -51,14 +53,17 @@ type NotificationRule struct {
 	Fingerprint         *string
 	IsNewError          *bool
 	IsRegression        *bool
-	CreatedAt           time.Time
+	// Environment limits the rule to the events of the environment, nil matches all environments.
+	Environment *string
+	CreatedAt   time.Time
 }
 
 type NotificationSettingDTO struct {
-	ProjectID ProjectID
-	Type      NotificationType
-	Config    json.RawMessage
-	Enabled   bool
+	ProjectID       ProjectID
+	Type            NotificationType
+	Config          json.RawMessage
+	Enabled         bool
+	RouteToAssignee bool
 }
 
 type NotificationRuleDTO struct {
@@ -67,6 +72,7 @@ type NotificationRuleDTO struct {
 	Fingerprint         *string
 	IsNewError          *bool
 	IsRegression        *bool
+	Environment         *string
 }
 
 type Notification struct {
@@ -76,11 +82,13 @@ type Notification struct {
 	Level          IssueLevel
 	IsNew          bool
 	WasReactivated bool
-	SentAt         *time.Time
-	Status         NotificationStatus
-	FailReason     *string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// Environment is the environment of the event which triggered the notification.
+	Environment string
+	SentAt      *time.Time
+	Status      NotificationStatus
+	FailReason  *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type NotificationWithSettings struct {
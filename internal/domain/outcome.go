@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"time"
+)
+
+// Outcome is what happened to the data sent by an SDK.
+type Outcome string
+
+const (
+	OutcomeAccepted    Outcome = "accepted"
+	OutcomeFiltered    Outcome = "filtered"
+	OutcomeRateLimited Outcome = "rate_limited"
+	OutcomeInvalid     Outcome = "invalid"
+	// OutcomeClientDiscard is data dropped by the SDK itself and reported in client_report items.
+	OutcomeClientDiscard Outcome = "client_discard"
+)
+
+// DataCategory is the kind of the data an outcome is counted for.
+type DataCategory string
+
+const (
+	DataCategoryError       DataCategory = "error"
+	DataCategoryTransaction DataCategory = "transaction"
+	DataCategorySession     DataCategory = "session"
+	DataCategoryAttachment  DataCategory = "attachment"
+	DataCategoryMonitor     DataCategory = "monitor"
+	// DataCategoryEnvelope is a whole envelope dropped before its items are read.
+	DataCategoryEnvelope DataCategory = "envelope"
+)
+
+// Reasons of the outcomes recorded by Warden.
+const (
+	OutcomeReasonProjectRateLimit = "project_rate_limit"
+	OutcomeReasonKeyRateLimit     = "key_rate_limit"
+	OutcomeReasonExpired          = "expired"
+	OutcomeReasonInvalidMessage   = "invalid_message"
+	OutcomeReasonInvalidEnvelope  = "invalid_envelope"
+	OutcomeReasonInvalidPayload   = "invalid_payload"
+	OutcomeReasonProjectNotFound  = "project_not_found"
+	OutcomeReasonProcessingError  = "processing_error"
+	OutcomeReasonTooLarge         = "too_large"
+	OutcomeReasonQuota            = "quota"
+	OutcomeReasonCORS             = "cors"
+	OutcomeReasonSpikeProtection  = "spike_protection"
+)
+
+// OutcomeGroup is the accepted, filtered, rate limited or dropped breakdown of outcomes.
+type OutcomeGroup string
+
+const (
+	OutcomeGroupAccepted    OutcomeGroup = "accepted"
+	OutcomeGroupFiltered    OutcomeGroup = "filtered"
+	OutcomeGroupRateLimited OutcomeGroup = "rate_limited"
+	OutcomeGroupDropped     OutcomeGroup = "dropped"
+)
+
+// OutcomeGroupOf classifies the outcome, client discards are classified by the SDK reason.
+func OutcomeGroupOf(outcome Outcome, reason string) OutcomeGroup {
+	switch outcome {
+	case OutcomeAccepted:
+		return OutcomeGroupAccepted
+	case OutcomeFiltered:
+		return OutcomeGroupFiltered
+	case OutcomeRateLimited:
+		return OutcomeGroupRateLimited
+	case OutcomeClientDiscard:
+		switch reason {
+		case "ratelimit_backoff":
+			return OutcomeGroupRateLimited
+		case "sample_rate", "before_send", "event_processor":
+			return OutcomeGroupFiltered
+		default:
+			return OutcomeGroupDropped
+		}
+	default:
+		return OutcomeGroupDropped
+	}
+}
+
+// ClientDiscard is a counter of a client_report item.
+type ClientDiscard struct {
+	Reason   string
+	Category DataCategory
+	Quantity uint
+}
+
+// OutcomeStat is the quantity of data with the same outcome, reason and category within a time bucket.
+type OutcomeStat struct {
+	Bucket   time.Time
+	Outcome  Outcome
+	Reason   string
+	Category DataCategory
+	Quantity uint
+}
+
+type OutcomeTotals struct {
+	Accepted    uint
+	Filtered    uint
+	RateLimited uint
+	Dropped     uint
+}
+
+// Add counts the quantity in the group of the outcome.
+func (t *OutcomeTotals) Add(outcome Outcome, reason string, quantity uint) {
+	switch OutcomeGroupOf(outcome, reason) {
+	case OutcomeGroupAccepted:
+		t.Accepted += quantity
+	case OutcomeGroupFiltered:
+		t.Filtered += quantity
+	case OutcomeGroupRateLimited:
+		t.RateLimited += quantity
+	case OutcomeGroupDropped:
+		t.Dropped += quantity
+	}
+}
+
+type OutcomeBucket struct {
+	Bucket time.Time
+	OutcomeTotals
+}
+
+// ProjectOutcomes is the breakdown of the data received by a project within a period.
+type ProjectOutcomes struct {
+	Totals     OutcomeTotals
+	Items      []OutcomeStat // summed over the period, Bucket is not set
+	Timeseries []OutcomeBucket
+}
@@ -16,6 +16,8 @@ type Project struct {
 	TeamID      *TeamID
 	CreatedAt   time.Time
 	ArchivedAt  *time.Time
+
+	GroupingStrategy GroupingStrategy
 }
 
 type ProjectExtended struct {
@@ -27,6 +29,7 @@ type ProjectDTO struct {
 	Name        string
 	Description string
 	PublicKey   string
+	SecretKey   string
 	TeamID      *TeamID
 }
 
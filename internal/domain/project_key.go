@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+)
+
+// ProjectKeysChangedChannel is the Postgres notification channel of changed and revoked project keys,
+// the payload is "<project_id>:<public_key>".
+const ProjectKeysChangedChannel = "project_keys_changed"
+
+type ProjectKeyID uint
+
+func (id ProjectKeyID) Uint() uint {
+	return uint(id)
+}
+
+// ProjectKey is a client key (DSN) of a project. A project may have several keys,
+// so a leaked key can be rotated without breaking the deployed clients at once.
+type ProjectKey struct {
+	ID         ProjectKeyID
+	ProjectID  ProjectID
+	Label      string
+	PublicKey  string
+	SecretKey  string
+	IsActive   bool
+	RateLimits RateLimits
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+}
+
+type ProjectKeyDTO struct {
+	ProjectID  ProjectID
+	Label      string
+	PublicKey  string
+	SecretKey  string
+	RateLimits RateLimits
+}
+
+// DefaultProjectKeyLabel is the label of the key created along with a project.
+const DefaultProjectKeyLabel = "Default"
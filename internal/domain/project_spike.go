@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+)
+
+type ProjectSpikeID uint
+
+// ProjectSpike is a window of time the ingest of a project was capped by the spike protection
+// after its volume suddenly exceeded the usual one.
+type ProjectSpike struct {
+	ID        ProjectSpikeID
+	ProjectID ProjectID
+	StartedAt time.Time
+	EndsAt    time.Time
+	// BaselineRPS is the usual requests per second of the project before the spike.
+	BaselineRPS uint64
+	PeakRPS     uint64
+	// CapRPS is the requests per second accepted during the spike.
+	CapRPS uint64
+}
+
+type ProjectSpikeDTO struct {
+	ProjectID   ProjectID
+	StartedAt   time.Time
+	EndsAt      time.Time
+	BaselineRPS uint64
+	PeakRPS     uint64
+	CapRPS      uint64
+}
+
+// IncidentIssue returns the issue the spikes of the project are reported as.
+func (s ProjectSpike) IncidentIssue() IssueDTO {
+	return IssueDTO{
+		ProjectID:   s.ProjectID,
+		Fingerprint: "spike_protection",
+		Source:      SourceSpike,
+		Status:      IssueStatusUnresolved,
+		Title:       "Ingest capped by the spike protection",
+		Level:       IssueLevelWarning,
+		Platform:    "other",
+	}
+}
@@ -9,4 +9,5 @@ type GeneralProjectStats struct {
 	DebugIssues        uint
 	ExceptionIssues    uint
 	MostFrequentIssues []IssueExtended
+	Spikes             []ProjectSpike
 }
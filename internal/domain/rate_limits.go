@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProjectRateLimitsChangedChannel is the Postgres notification channel of changed project rate limits,
+// the payload is the project ID.
+const ProjectRateLimitsChangedChannel = "project_rate_limits_changed"
+
+// RateLimitCategories are the data categories quotas can be set for.
+var RateLimitCategories = []DataCategory{
+	DataCategoryError,
+	DataCategoryTransaction,
+	DataCategoryAttachment,
+	DataCategorySession,
+	DataCategoryMonitor,
+}
+
+// RateLimits are per-minute quotas of the data accepted by the ingest servers.
+type RateLimits struct {
+	// EventsPerMinute limits the requests of all categories, nil is unlimited.
+	EventsPerMinute *uint
+	// Categories limit the items of a data category per minute, e.g. errors, transactions or attachments.
+	Categories map[DataCategory]uint
+}
+
+// IsZero reports whether there are no limits.
+func (l RateLimits) IsZero() bool {
+	return l.EventsPerMinute == nil && len(l.Categories) == 0
+}
+
+func (l RateLimits) Validate() error {
+	if l.EventsPerMinute != nil && *l.EventsPerMinute == 0 {
+		return fmt.Errorf("%w: rate limit must be positive", ErrInvalidRateLimits)
+	}
+
+	for category, limit := range l.Categories {
+		if !isRateLimitCategory(category) {
+			return fmt.Errorf("%w: unknown category %q", ErrInvalidRateLimits, category)
+		}
+
+		if limit == 0 {
+			return fmt.Errorf("%w: %s rate limit must be positive", ErrInvalidRateLimits, category)
+		}
+	}
+
+	return nil
+}
+
+func isRateLimitCategory(category DataCategory) bool {
+	for _, item := range RateLimitCategories {
+		if item == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProjectRateLimits are the rate limits of all client keys of a project together.
+type ProjectRateLimits struct {
+	ProjectID ProjectID
+	RateLimits
+	UpdatedAt time.Time
+}
+
+// RateLimitScope is the entity a quota is counted for.
+type RateLimitScope string
+
+const (
+	RateLimitScopeProject RateLimitScope = "project"
+	RateLimitScopeKey     RateLimitScope = "key"
+)
+
+// ActiveRateLimit is a quota exceeded by a request, SDKs are told to back off from sending
+// the categories until RetryAfter passes.
+type ActiveRateLimit struct {
+	RetryAfter time.Duration
+	// Categories are the limited data categories, empty for all categories.
+	Categories []DataCategory
+	Scope      RateLimitScope
+	Reason     string
+}
@@ -1,6 +1,14 @@
 package domain
 
-import "time"
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// semverRelease matches the versions the release_version_key SQL function orders, a package prefix
+// (pkg@1.2.3) and build metadata are stripped before matching.
+var semverRelease = regexp.MustCompile(`^[vV]?\d{1,10}(\.\d{1,10})?(\.\d{1,10})?(-[0-9A-Za-z.-]+)?$`)
 
 type ReleaseID uint
 
@@ -19,3 +27,15 @@ type ReleaseDTO struct {
 	Version     string
 	Description string
 }
+
+// IsSemverRelease reports whether the release version follows semver, only such releases can be ordered
+// to decide whether an event comes from a release older than the one an issue is resolved in.
+func IsSemverRelease(version string) bool {
+	if i := strings.LastIndex(version, "@"); i >= 0 {
+		version = version[i+1:]
+	}
+
+	version, _, _ = strings.Cut(version, "+")
+
+	return semverRelease.MatchString(version)
+}
@@ -22,12 +22,22 @@ type ReleaseStats struct {
 
 	SeverityDistribution map[string]uint
 	UsersAffected        uint
+
+	SessionsTotal      uint
+	SessionsErrored    uint
+	SessionsCrashed    uint
+	UsersTotal         uint
+	CrashFreeSessions  *float64 // from 0 to 1, nil without sessions
+	CrashFreeUsers     *float64 // from 0 to 1, nil without sessions
+	Adoption           *float64 // share of the project sessions over the last day, from 0 to 1
+	AvgSessionDuration *time.Duration
 }
 
 type ReleaseComparison struct {
 	BaseRelease   ReleaseStats
 	TargetRelease ReleaseStats
 	Delta         map[string]uint
+	RateDelta     map[string]float64 // target minus base, only for rates known in both releases
 }
 
 type ReleaseAnalyticsDetails struct {
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSemverRelease(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{version: "1.4.0", expected: true},
+		{version: "v2.0.0-rc.1", expected: true},
+		{version: "app@1.4.0", expected: true},
+		{version: "my@scope@3.1+build.5", expected: true},
+		{version: "7", expected: true},
+		{version: "", expected: false},
+		{version: "9f3c2a1d8e", expected: false},
+		{version: "release-2024-01", expected: false},
+		{version: "1.2.3.4", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsSemverRelease(tt.version))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+)
+
+// DefaultSearchPeriod is the time range events are searched in when no range is given.
+const DefaultSearchPeriod = 14 * 24 * time.Hour
+
+// SearchTerm is a single condition of an event search query, e.g. `!environment:[staging,dev]`.
+type SearchTerm struct {
+	// Key is the searched field, empty for the free text search over the message
+	Key string
+	// Tag is the tag name of "tags[name]" keys
+	Tag string
+	// Values of which any matches, "*" in a value matches any characters
+	Values  []string
+	Negated bool
+}
+
+// SearchQuery is a parsed event search query, all of its terms must match.
+type SearchQuery []SearchTerm
+
+// EventCursor points to the last event of a search page, the next page starts after it.
+type EventCursor struct {
+	Timestamp time.Time
+	EventID   EventID
+}
+
+type EventSearchFilter struct {
+	ProjectID ProjectID
+	Query     SearchQuery
+	// GroupHashes limits the search to the events of an issue, all events are searched when empty
+	GroupHashes []string
+	TimeFrom    time.Time
+	TimeTo      time.Time
+	Cursor      *EventCursor
+	Limit       uint
+}
+
+// EventSearchPage holds the found events, newest first.
+type EventSearchPage struct {
+	Events []Event
+	// NextCursor is set when there are more events
+	NextCursor *EventCursor
+}
+
+// EventGroup identifies the events of a project grouped into an issue.
+type EventGroup struct {
+	ProjectID ProjectID
+	GroupHash string
+}
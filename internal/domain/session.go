@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+)
+
+// Session statuses of the release health protocol.
+const (
+	SessionStatusOK       = "ok"
+	SessionStatusExited   = "exited"
+	SessionStatusCrashed  = "crashed"
+	SessionStatusAbnormal = "abnormal"
+	SessionStatusErrored  = "errored" // only used by session aggregates
+)
+
+// SessionUpdate is a single session update or a bucket of pre-aggregated sessions.
+type SessionUpdate struct {
+	ProjectID   ProjectID
+	SessionID   string
+	DistinctID  string
+	Status      string
+	Init        bool // the first update of a session, counts the session itself
+	Errors      uint
+	Duration    time.Duration
+	Release     string
+	Environment string
+	Started     time.Time
+	Timestamp   time.Time
+	Quantity    uint // number of sessions the update stands for, more than one for aggregates
+}
+
+// ReleaseHealth contains session counts of a release.
+type ReleaseHealth struct {
+	Release         string
+	SessionsTotal   uint
+	SessionsErrored uint
+	SessionsCrashed uint // crashed and abnormal sessions
+	UsersTotal      uint
+	UsersCrashed    uint
+	RecentSessions  uint // started within the adoption window
+	AvgDuration     *time.Duration
+}
+
+// CrashFreeSessions returns the share of sessions without a crash, from 0 to 1.
+func (h ReleaseHealth) CrashFreeSessions() *float64 {
+	return crashFreeRate(h.SessionsCrashed, h.SessionsTotal)
+}
+
+// CrashFreeUsers returns the share of users who did not experience a crash, from 0 to 1.
+func (h ReleaseHealth) CrashFreeUsers() *float64 {
+	return crashFreeRate(h.UsersCrashed, h.UsersTotal)
+}
+
+func crashFreeRate(crashed, total uint) *float64 {
+	if total == 0 {
+		return nil
+	}
+
+	rate := 1 - float64(min(crashed, total))/float64(total)
+
+	return &rate
+}
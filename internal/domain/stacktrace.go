@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"encoding/json"
+)
+
+// StackFrame is a single frame of a Sentry stacktrace.
+type StackFrame struct {
+	Filename    string `json:"filename"`
+	AbsPath     string `json:"abs_path"`
+	Function    string `json:"function"`
+	Module      string `json:"module"`
+	Package     string `json:"package"`
+	ContextLine string `json:"context_line"`
+	InApp       *bool  `json:"in_app"`
+
+	// ExcludeFromGroup is set by stack trace rules (`-group`), it is never sent by SDKs.
+	ExcludeFromGroup bool `json:"-"`
+}
+
+// Stacktrace is a Sentry stacktrace. Frames are ordered from the oldest call to the crashing one.
+type Stacktrace struct {
+	Frames []StackFrame `json:"frames"`
+}
+
+// ParseStacktrace decodes a raw stacktrace. A malformed stacktrace is treated as empty.
+func ParseStacktrace(data json.RawMessage) Stacktrace {
+	var stacktrace Stacktrace
+	if len(data) == 0 {
+		return stacktrace
+	}
+
+	if err := json.Unmarshal(data, &stacktrace); err != nil {
+		return Stacktrace{}
+	}
+
+	return stacktrace
+}
+
+// ApplyRules applies frame rules to every frame, later rules override earlier ones.
+func (st Stacktrace) ApplyRules(rules []FrameRule) {
+	for i := range st.Frames {
+		for _, rule := range rules {
+			rule.ApplyFrame(&st.Frames[i])
+		}
+	}
+}
+
+// IsInApp reports whether the frame belongs to the application code.
+func (f StackFrame) IsInApp() bool {
+	return f.InApp != nil && *f.InApp
+}
+
+// ModuleName returns the frame module, falling back to the file name.
+func (f StackFrame) ModuleName() string {
+	if f.Module != "" {
+		return f.Module
+	}
+
+	return f.Filename
+}
+
+// InAppFrames returns the frames that belong to the application code and are not excluded from grouping.
+func (st Stacktrace) InAppFrames() []StackFrame {
+	var frames []StackFrame
+	for _, frame := range st.Frames {
+		if frame.IsInApp() && !frame.ExcludeFromGroup {
+			frames = append(frames, frame)
+		}
+	}
+
+	return frames
+}
+
+// MostRelevantFrame returns the crashing frame, preferring application code.
+func (st Stacktrace) MostRelevantFrame() (StackFrame, bool) {
+	for i := len(st.Frames) - 1; i >= 0; i-- {
+		if st.Frames[i].IsInApp() {
+			return st.Frames[i], true
+		}
+	}
+
+	if len(st.Frames) == 0 {
+		return StackFrame{}, false
+	}
+
+	return st.Frames[len(st.Frames)-1], true
+}
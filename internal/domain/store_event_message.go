@@ -50,3 +50,18 @@ func (sem *StoreEventMessage) SetPriority(priority int) {
 func (sem *StoreEventMessage) SetWorkerPool(pool string) {
 	sem.Processing.WorkerPool = pool
 }
+
+// EventStoreError is returned when the issue of an event is committed but the event failed to be stored.
+// Only the event is to be stored again, processing it again would count it twice in its issue.
+type EventStoreError struct {
+	Event Event
+	Err   error
+}
+
+func (e *EventStoreError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *EventStoreError) Unwrap() error {
+	return e.Err
+}
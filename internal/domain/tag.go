@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"time"
+)
+
+// IssueTag is the distribution of the values of an event tag over the events of an issue.
+type IssueTag struct {
+	Key string
+	// TotalEvents is the number of the issue events having the tag
+	TotalEvents  uint
+	UniqueValues uint
+	// TopValues are the most frequent values, the most frequent first
+	TopValues []IssueTagValue
+}
+
+type IssueTagValue struct {
+	Value     string
+	Count     uint
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// Percent returns the share of the events having the value among the events having the tag.
+func (t IssueTag) Percent(value IssueTagValue) float64 {
+	if t.TotalEvents == 0 {
+		return 0
+	}
+
+	return float64(value.Count) * 100 / float64(t.TotalEvents)
+}
+
+type IssueTagsFilter struct {
+	ProjectID ProjectID
+	IssueID   IssueID
+	// ValuesLimit is the number of the top values of every tag
+	ValuesLimit uint
+}
+
+// IssueTagEventsFilter selects the events of an issue having the tag value, newest first.
+type IssueTagEventsFilter struct {
+	ProjectID ProjectID
+	IssueID   IssueID
+	Key       string
+	Value     string
+	Cursor    *EventCursor
+	Limit     uint
+}
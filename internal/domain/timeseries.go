@@ -38,9 +38,10 @@ const (
 type EventTimeseriesFilter struct {
 	Period Period
 
-	ProjectID *ProjectID
-	Levels    []IssueLevel
-	Release   *string
+	ProjectID   *ProjectID
+	Levels      []IssueLevel
+	Release     *string
+	Environment *string
 
 	GroupBy EventTimeseriesGroup
 }
@@ -54,10 +55,11 @@ const (
 )
 
 type IssueEventsTimeseriesFilter struct {
-	Period    Period
-	ProjectID ProjectID
-	IssueID   IssueID
-	Levels    []IssueLevel
+	Period      Period
+	ProjectID   ProjectID
+	IssueID     IssueID
+	Levels      []IssueLevel
+	Environment *string
 
 	GroupBy EventTimeseriesGroup
 }
@@ -8,8 +8,14 @@ const (
 	StoreEventTopicHigh   = "store-event.high"   // High priority
 	StoreEventTopicNormal = "store-event.normal" // Normal priority
 	StoreEventTopicLow    = "store-event.low"    // Low priority
+
+	DeadLetterTopic = "dead-letter" // Messages failed to be processed
 )
 
 const (
-	EventsKafkaTopic = "clickhouse.events"
+	EventsKafkaTopic       = "clickhouse.events"
+	TransactionsKafkaTopic = "clickhouse.transactions"
+	SpansKafkaTopic        = "clickhouse.spans"
+	SessionsKafkaTopic     = "clickhouse.sessions"
+	OutcomesKafkaTopic     = "clickhouse.outcomes"
 )
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+)
+
+// Span statuses that are not counted as failures, the rest are.
+const (
+	SpanStatusOK        = "ok"
+	SpanStatusCancelled = "cancelled"
+	SpanStatusUnknown   = "unknown"
+)
+
+// Transaction is a performance monitoring transaction: the root span of a service operation.
+type Transaction struct {
+	ID             EventID
+	ProjectID      ProjectID
+	TraceID        string
+	SpanID         string
+	ParentSpanID   string
+	Name           string
+	Op             string
+	Status         string
+	Platform       string
+	Environment    string
+	Release        string
+	Tags           map[string]string
+	StartTimestamp time.Time
+	Timestamp      time.Time
+	Spans          []Span
+}
+
+func (t Transaction) Duration() time.Duration {
+	return t.Timestamp.Sub(t.StartTimestamp)
+}
+
+// Span is a unit of work inside a transaction.
+type Span struct {
+	ProjectID      ProjectID
+	TransactionID  EventID
+	TraceID        string
+	SpanID         string
+	ParentSpanID   string
+	Op             string
+	Description    string
+	Status         string
+	Tags           map[string]string
+	StartTimestamp time.Time
+	Timestamp      time.Time
+}
+
+func (s Span) Duration() time.Duration {
+	return s.Timestamp.Sub(s.StartTimestamp)
+}
+
+// IsFailedSpanStatus reports whether a transaction with the status counts towards the failure rate.
+func IsFailedSpanStatus(status string) bool {
+	switch status {
+	case SpanStatusOK, SpanStatusCancelled, SpanStatusUnknown, "":
+		return false
+	default:
+		return true
+	}
+}
+
+type TransactionsFilter struct {
+	ProjectID   ProjectID
+	Interval    time.Duration
+	Environment *string
+	Release     *string
+	Limit       uint
+}
+
+// TransactionStats contains latency, throughput and failure rate of a transaction name.
+type TransactionStats struct {
+	Name        string
+	Count       uint
+	Throughput  float64 // transactions per minute
+	FailureRate float64 // from 0 to 1
+	P50         time.Duration
+	P95         time.Duration
+	P99         time.Duration
+}
+
+// Trace contains all transactions and spans of a single trace.
+type Trace struct {
+	TraceID        string
+	StartTimestamp time.Time
+	Timestamp      time.Time
+	Transactions   []Transaction
+	Spans          []TraceSpan
+}
+
+func (t Trace) Duration() time.Duration {
+	return t.Timestamp.Sub(t.StartTimestamp)
+}
+
+// TraceSpan is a span positioned in the trace waterfall.
+type TraceSpan struct {
+	Span
+	TransactionName string
+	Offset          time.Duration // from the trace start
+	Depth           uint
+}
@@ -15,6 +15,9 @@ const (
 	UserNotificationTypeTeamRemoved     UserNotificationType = "team_removed"
 	UserNotificationTypeRoleChanged     UserNotificationType = "role_changed"
 	UserNotificationTypeIssueRegression UserNotificationType = "issue_regression"
+	UserNotificationTypeSpikeProtection UserNotificationType = "spike_protection"
+	UserNotificationTypeIssueAssigned   UserNotificationType = "issue_assigned"
+	UserNotificationTypeIssueMention    UserNotificationType = "issue_mention"
 )
 
 // UserNotification represents a user notification.
@@ -35,6 +38,9 @@ type UserNotificationContent struct {
 	TeamRemoved     *TeamRemovedContent     `json:"team_removed,omitempty"`
 	RoleChanged     *RoleChangedContent     `json:"role_changed,omitempty"`
 	IssueRegression *IssueRegressionContent `json:"issue_regression,omitempty"`
+	SpikeProtection *SpikeProtectionContent `json:"spike_protection,omitempty"`
+	IssueAssigned   *IssueAssignedContent   `json:"issue_assigned,omitempty"`
+	IssueMention    *IssueMentionContent    `json:"issue_mention,omitempty"`
 }
 
 // TeamAddedContent represents content for team added notifications.
@@ -73,3 +79,36 @@ type IssueRegressionContent struct {
 	ResolvedAt    string `json:"resolved_at"`
 	ReactivatedAt string `json:"reactivated_at"`
 }
+
+// SpikeProtectionContent represents content for notifications about project ingest capped during a spike.
+type SpikeProtectionContent struct {
+	ProjectID   uint   `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	BaselineRPS uint64 `json:"baseline_rps"`
+	PeakRPS     uint64 `json:"peak_rps"`
+	CapRPS      uint64 `json:"cap_rps"`
+	StartedAt   string `json:"started_at"`
+	EndsAt      string `json:"ends_at"`
+}
+
+// IssueAssignedContent represents content for notifications about an issue assigned to the user or their team.
+type IssueAssignedContent struct {
+	IssueID            uint   `json:"issue_id"`
+	IssueTitle         string `json:"issue_title"`
+	ProjectID          uint   `json:"project_id"`
+	ProjectName        string `json:"project_name"`
+	TeamName           string `json:"team_name,omitempty"`
+	AssignedByUserID   uint   `json:"assigned_by_user_id"`
+	AssignedByUsername string `json:"assigned_by_username"`
+}
+
+// IssueMentionContent represents content for notifications about the user mentioned in an issue comment.
+type IssueMentionContent struct {
+	IssueID        uint   `json:"issue_id"`
+	IssueTitle     string `json:"issue_title"`
+	ProjectID      uint   `json:"project_id"`
+	ProjectName    string `json:"project_name"`
+	CommentID      uint   `json:"comment_id"`
+	AuthorUserID   uint   `json:"author_user_id"`
+	AuthorUsername string `json:"author_username"`
+}
@@ -13,24 +13,42 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	commonconfig "github.com/rom8726/warden/internal/common/config"
+	"github.com/rom8726/warden/internal/common/outcomes"
 	"github.com/rom8726/warden/internal/common/techserver"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/envelope-consumer/config"
 	"github.com/rom8726/warden/internal/envelope-consumer/contract"
 	cacheservice "github.com/rom8726/warden/internal/envelope-consumer/services/cache"
 	"github.com/rom8726/warden/internal/envelope-consumer/services/cachemanager"
+	"github.com/rom8726/warden/internal/envelope-consumer/services/deadletter"
 	"github.com/rom8726/warden/internal/envelope-consumer/services/envelopequeueprocessor"
+	environmentsservice "github.com/rom8726/warden/internal/envelope-consumer/services/environments"
+	"github.com/rom8726/warden/internal/envelope-consumer/services/projectsettings"
 	"github.com/rom8726/warden/internal/envelope-consumer/services/storeeventqueueprocessor"
+	attachmentsusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/attachments"
 	envelopeusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/envelope"
 	eventsusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/events"
+	monitorsusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/monitors"
+	sessionsusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/sessions"
 	storeeventusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/storeevent"
+	transactionsusecase "github.com/rom8726/warden/internal/envelope-consumer/usecases/transactions"
 	"github.com/rom8726/warden/internal/infra"
+	"github.com/rom8726/warden/internal/repository/attachments"
+	"github.com/rom8726/warden/internal/repository/datascrubbing"
+	"github.com/rom8726/warden/internal/repository/environments"
 	"github.com/rom8726/warden/internal/repository/events"
+	"github.com/rom8726/warden/internal/repository/groupingrules"
+	"github.com/rom8726/warden/internal/repository/issuefingerprints"
 	"github.com/rom8726/warden/internal/repository/issuereleases"
 	"github.com/rom8726/warden/internal/repository/issues"
+	"github.com/rom8726/warden/internal/repository/monitors"
 	"github.com/rom8726/warden/internal/repository/notificationsqueue"
+	"github.com/rom8726/warden/internal/repository/projects"
 	"github.com/rom8726/warden/internal/repository/releases"
+	"github.com/rom8726/warden/internal/repository/sessions"
+	"github.com/rom8726/warden/internal/repository/transactions"
 	"github.com/rom8726/warden/internal/services/storeeventqueueproducer"
+	"github.com/rom8726/warden/pkg/blobstore"
 	"github.com/rom8726/warden/pkg/db"
 	"github.com/rom8726/warden/pkg/kafka"
 )
@@ -158,6 +176,13 @@ func (app *App) registerComponents() error {
 
 	// Kafka
 	eventProducer := kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.EventsKafkaTopic)
+	transactionProducers := &transactions.Producers{
+		Transactions: kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.TransactionsKafkaTopic),
+		Spans:        kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.SpansKafkaTopic),
+	}
+	sessionsProducer := kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.SessionsKafkaTopic)
+	outcomesProducer := kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.OutcomesKafkaTopic)
+	deadLetterProducer := kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.DeadLetterTopic)
 
 	// for /envelope
 	envelopeHighConsumer, err := kafka.NewConsumer(
@@ -221,11 +246,36 @@ func (app *App) registerComponents() error {
 	app.registerComponent(releases.New).Arg(app.PostgresPool)
 	app.registerComponent(notificationsqueue.New).Arg(app.PostgresPool)
 	app.registerComponent(issuereleases.New).Arg(app.PostgresPool)
+	app.registerComponent(projects.New).Arg(app.PostgresPool)
+	app.registerComponent(groupingrules.New).Arg(app.PostgresPool)
+	app.registerComponent(datascrubbing.New).Arg(app.PostgresPool)
+	app.registerComponent(issuefingerprints.New).Arg(app.PostgresPool)
+	app.registerComponent(environments.New).Arg(app.PostgresPool)
+	app.registerComponent(transactions.New).Arg(transactionProducers)
+	app.registerComponent(sessions.New).Arg(sessionsProducer)
+	app.registerComponent(attachments.New).Arg(app.PostgresPool)
+	app.registerComponent(monitors.New).Arg(app.PostgresPool)
+
+	// Register attachments blob store
+	app.registerComponent(func() (blobstore.Store, error) {
+		return commonconfig.NewBlobStore(&app.Config.Attachments)
+	})
+
+	// Register project settings
+	app.registerComponent(projectsettings.New)
+	app.registerComponent(environmentsservice.New)
+
+	// Register outcomes recorder
+	app.registerComponent(outcomes.NewRecorder).Arg(outcomesProducer)
 
 	// Register use cases
 	app.registerComponent(envelopeusecase.New)
-	app.registerComponent(eventsusecase.New)
+	app.registerComponent(eventsusecase.New).Arg(&app.Config.Events)
 	app.registerComponent(storeeventusecase.New)
+	app.registerComponent(transactionsusecase.New)
+	app.registerComponent(sessionsusecase.New)
+	app.registerComponent(attachmentsusecase.New).Arg(&app.Config.Attachments)
+	app.registerComponent(monitorsusecase.New)
 
 	// Register services
 	var topicProducerCreator *kafka.TopicProducerCreator
@@ -234,6 +284,7 @@ func (app *App) registerComponents() error {
 	}
 
 	app.registerComponent(storeeventqueueproducer.New).Arg(topicProducerCreator)
+	app.registerComponent(deadletter.New).Arg(deadLetterProducer).Arg(&app.Config.DeadLetter)
 	app.registerComponent(envelopequeueprocessor.New).Arg([]contract.DataConsumer{
 		envelopeHighConsumer,
 		envelopeNormalConsumer,
@@ -14,13 +14,16 @@ const (
 )
 
 type Config struct {
-	Logger     commonconfig.Logger      `envconfig:"LOGGER"`
-	TechServer commonconfig.Server      `envconfig:"TECH_SERVER"`
-	Postgres   commonconfig.Postgres    `envconfig:"POSTGRES"`
-	Kafka      commonconfig.Kafka       `envconfig:"KAFKA"`
-	ClickHouse commonconfig.ClickHouse  `envconfig:"CLICKHOUSE"`
-	Redis      commonconfig.Redis       `envconfig:"REDIS"`
-	Cache      commonconfig.CacheConfig `envconfig:"CACHE"`
+	Logger      commonconfig.Logger      `envconfig:"LOGGER"`
+	TechServer  commonconfig.Server      `envconfig:"TECH_SERVER"`
+	Postgres    commonconfig.Postgres    `envconfig:"POSTGRES"`
+	Kafka       commonconfig.Kafka       `envconfig:"KAFKA"`
+	ClickHouse  commonconfig.ClickHouse  `envconfig:"CLICKHOUSE"`
+	Redis       commonconfig.Redis       `envconfig:"REDIS"`
+	Cache       commonconfig.CacheConfig `envconfig:"CACHE"`
+	Attachments commonconfig.Attachments `envconfig:"ATTACHMENTS"`
+	DeadLetter  commonconfig.DeadLetter  `envconfig:"DEAD_LETTER"`
+	Events      commonconfig.Events      `envconfig:"EVENTS"`
 }
 
 func New(filePath string) (*Config, error) {
@@ -2,7 +2,10 @@ package contract
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/rom8726/warden/internal/common/datascrubbing"
 	"github.com/rom8726/warden/internal/domain"
 )
 
@@ -10,6 +13,35 @@ type EnvelopeUseCase interface {
 	ProcessEnvelopeFromBytes(ctx context.Context, projectID domain.ProjectID, data []byte) error
 }
 
+// OutcomeRecorder counts what happened to the received data.
+type OutcomeRecorder interface {
+	Record(
+		projectID domain.ProjectID,
+		outcome domain.Outcome,
+		reason string,
+		category domain.DataCategory,
+		quantity uint,
+	)
+}
+
+// DeadLetterService retries queue messages failed to be processed and dead-letters the ones still failing.
+type DeadLetterService interface {
+	Retry(
+		ctx context.Context,
+		source domain.DeadLetterSource,
+		process func(ctx context.Context) error,
+		retryable func(err error) bool,
+	) (uint, error)
+	Publish(
+		ctx context.Context,
+		source domain.DeadLetterSource,
+		projectID domain.ProjectID,
+		payload []byte,
+		cause error,
+		attempts uint,
+	) error
+}
+
 type DataConsumer interface {
 	Consume(ctx context.Context) <-chan []byte
 	Close() error
@@ -30,12 +62,103 @@ type EventUseCase interface {
 		projectID domain.ProjectID,
 		eventData map[string]any,
 	) (domain.EventID, error)
+	StoreEvent(ctx context.Context, event *domain.Event) error
+}
+
+// TransactionUseCase handles performance monitoring transactions.
+type TransactionUseCase interface {
+	ProcessTransaction(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		data map[string]any,
+	) (domain.EventID, error)
+}
+
+type TransactionsRepository interface {
+	Store(ctx context.Context, transaction *domain.Transaction) error
+}
+
+// SessionUseCase handles release health sessions.
+type SessionUseCase interface {
+	ProcessSession(ctx context.Context, projectID domain.ProjectID, data map[string]any) error
+	ProcessSessionAggregates(ctx context.Context, projectID domain.ProjectID, data map[string]any) error
+}
+
+type SessionsRepository interface {
+	Store(ctx context.Context, updates []domain.SessionUpdate) error
+}
+
+// AttachmentUseCase handles event attachments and minidumps.
+type AttachmentUseCase interface {
+	StoreAttachment(ctx context.Context, attachmentDTO domain.AttachmentDTO, data []byte) (domain.Attachment, error)
+}
+
+type AttachmentsRepository interface {
+	Create(ctx context.Context, attachmentDTO domain.AttachmentDTO) (domain.Attachment, error)
+	TotalSizeByProject(ctx context.Context, projectID domain.ProjectID) (uint, error)
+}
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, data io.Reader, size int64) error
+	Delete(ctx context.Context, key string) error
+}
+
+// MonitorUseCase handles cron monitor check-ins.
+type MonitorUseCase interface {
+	ProcessCheckIn(ctx context.Context, projectID domain.ProjectID, data map[string]any) error
+}
+
+type MonitorsRepository interface {
+	Upsert(ctx context.Context, monitorDTO domain.MonitorDTO) (domain.Monitor, error)
+	GetBySlug(ctx context.Context, projectID domain.ProjectID, slug string) (domain.Monitor, error)
+	UpdateState(
+		ctx context.Context,
+		id domain.MonitorID,
+		status domain.MonitorStatus,
+		lastCheckInAt *time.Time,
+		nextCheckInAt time.Time,
+	) error
+	UpsertCheckIn(ctx context.Context, checkInDTO domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error)
 }
 
 type IssuesRepository interface {
 	UpsertIssue(ctx context.Context, issue domain.IssueDTO) (domain.IssueUpsertResult, error)
 }
 
+// IssueFingerprintsRepository resolves fingerprints merged into another issue.
+type IssueFingerprintsRepository interface {
+	GetIssueFingerprint(ctx context.Context, projectID domain.ProjectID, fingerprint string) (string, error)
+}
+
+type ProjectsRepository interface {
+	GetByID(ctx context.Context, id domain.ProjectID) (domain.Project, error)
+}
+
+type GroupingRulesRepository interface {
+	ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error)
+}
+
+type DataScrubbingRepository interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error)
+}
+
+// ProjectSettingsService provides per-project processing settings.
+type ProjectSettingsService interface {
+	GetProject(ctx context.Context, projectID domain.ProjectID) (domain.Project, error)
+	GroupingConfig(ctx context.Context, projectID domain.ProjectID) (domain.GroupingConfig, error)
+	// DataScrubber returns the compiled data scrubbing applied to events before they are stored.
+	DataScrubber(ctx context.Context, projectID domain.ProjectID) (*datascrubbing.Scrubber, error)
+}
+
+// EnvironmentsService discovers the environments of project events.
+type EnvironmentsService interface {
+	Touch(ctx context.Context, projectID domain.ProjectID, name string) error
+}
+
+type EnvironmentsRepository interface {
+	Touch(ctx context.Context, projectID domain.ProjectID, name string, seenAt time.Time) error
+}
+
 type EventRepository interface {
 	StoreWithFingerprints(ctx context.Context, event *domain.Event) error
 }
@@ -54,6 +177,7 @@ type NotificationsQueueRepository interface {
 		issueID domain.IssueID,
 		level domain.IssueLevel,
 		isNew, wasReactivated bool,
+		environment string,
 	) error
 }
 
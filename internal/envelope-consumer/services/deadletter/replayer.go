@@ -0,0 +1,123 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/kafka"
+)
+
+// envelopeReplayDeadline is the time a replayed envelope message has to be processed within.
+const envelopeReplayDeadline = 15 * time.Minute
+
+// TopicReader reads the messages of the dead-letter topic which are not replayed yet.
+type TopicReader interface {
+	Pending(ctx context.Context, fn func(kafka.Message) error) error
+	Consume(ctx context.Context, fn func(kafka.Message) error) error
+}
+
+// Entry is a dead-letter message with its position in the dead-letter topic.
+type Entry struct {
+	Partition int32
+	Offset    int64
+	domain.DeadLetterMessage
+}
+
+// Replayer lists dead-letter messages and sends them back to the queues they were consumed from.
+type Replayer struct {
+	reader   TopicReader
+	producer kafka.KafkaProducer
+	now      func() time.Time
+}
+
+func NewReplayer(reader TopicReader, producer kafka.KafkaProducer) *Replayer {
+	return &Replayer{
+		reader:   reader,
+		producer: producer,
+		now:      time.Now,
+	}
+}
+
+// List calls fn for every dead-letter message not replayed yet.
+func (r *Replayer) List(ctx context.Context, fn func(Entry) error) error {
+	return r.reader.Pending(ctx, func(msg kafka.Message) error {
+		entry, err := decodeEntry(msg)
+		if err != nil {
+			slog.Warn("Skip malformed dead-letter message", "error", err)
+
+			return nil
+		}
+
+		return fn(entry)
+	})
+}
+
+// Replay sends every dead-letter message not replayed yet to the normal priority queue of its source.
+// It returns the number of replayed messages, the replayed and the malformed messages are not listed anymore.
+func (r *Replayer) Replay(ctx context.Context) (uint, error) {
+	var replayed uint
+	err := r.reader.Consume(ctx, func(msg kafka.Message) error {
+		entry, err := decodeEntry(msg)
+		if err != nil {
+			slog.Warn("Skip malformed dead-letter message", "error", err)
+
+			return nil
+		}
+
+		topic, payload, err := r.replayMessage(&entry.DeadLetterMessage)
+		if err != nil {
+			slog.Warn("Skip dead-letter message", "partition", entry.Partition, "offset", entry.Offset, "error", err)
+
+			return nil
+		}
+
+		if err := r.producer.Produce(ctx, topic, payload); err != nil {
+			return fmt.Errorf("replay dead-letter message %d/%d: %w", entry.Partition, entry.Offset, err)
+		}
+
+		replayed++
+
+		return nil
+	})
+
+	return replayed, err
+}
+
+// replayMessage returns the topic and the payload to replay the message with.
+// Envelope messages get a new deadline, otherwise they are expired by the time they are replayed.
+func (r *Replayer) replayMessage(msg *domain.DeadLetterMessage) (string, []byte, error) {
+	switch msg.Source {
+	case domain.DeadLetterSourceEnvelope:
+		var envelopeMsg domain.EnvelopeMessage
+		if err := json.Unmarshal(msg.Payload, &envelopeMsg); err != nil {
+			// The message is replayed as is to be dead-lettered again
+			return domain.EnvelopeTopicNormal, msg.Payload, nil //nolint:nilerr // it's ok here
+		}
+
+		envelopeMsg.SetDeadline(r.now().Add(envelopeReplayDeadline))
+
+		payload, err := json.Marshal(&envelopeMsg)
+		if err != nil {
+			return "", nil, fmt.Errorf("marshal envelope message: %w", err)
+		}
+
+		return domain.EnvelopeTopicNormal, payload, nil
+	case domain.DeadLetterSourceStoreEvent:
+		return domain.StoreEventTopicNormal, msg.Payload, nil
+	default:
+		return "", nil, fmt.Errorf("unknown source %q", msg.Source)
+	}
+}
+
+func decodeEntry(msg kafka.Message) (Entry, error) {
+	entry := Entry{Partition: msg.Partition, Offset: msg.Offset}
+	if err := json.Unmarshal(msg.Value, &entry.DeadLetterMessage); err != nil {
+		return Entry{}, fmt.Errorf("unmarshal dead-letter message %d/%d: %w", msg.Partition, msg.Offset, err)
+	}
+
+	return entry, nil
+}
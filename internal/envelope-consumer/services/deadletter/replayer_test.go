@@ -0,0 +1,148 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/kafka"
+)
+
+type readerStub struct {
+	messages  []kafka.Message
+	committed []int64
+}
+
+func (r *readerStub) Pending(_ context.Context, fn func(kafka.Message) error) error {
+	for _, msg := range r.messages {
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *readerStub) Consume(_ context.Context, fn func(kafka.Message) error) error {
+	for _, msg := range r.messages {
+		if err := fn(msg); err != nil {
+			return err
+		}
+		r.committed = append(r.committed, msg.Offset)
+	}
+
+	return nil
+}
+
+type topicMessage struct {
+	topic string
+	data  []byte
+}
+
+type kafkaProducerStub struct {
+	messages []topicMessage
+	err      error
+}
+
+func (p *kafkaProducerStub) Produce(_ context.Context, topic string, data []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, topicMessage{topic: topic, data: data})
+
+	return nil
+}
+
+func deadLetterValue(t *testing.T, source domain.DeadLetterSource, payload []byte) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(domain.DeadLetterMessage{
+		Source:    source,
+		ProjectID: 1,
+		Error:     "postgres is down",
+		Attempts:  3,
+		Payload:   payload,
+	})
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestReplayer_List(t *testing.T) {
+	t.Parallel()
+
+	reader := &readerStub{messages: []kafka.Message{
+		{Offset: 0, Value: deadLetterValue(t, domain.DeadLetterSourceStoreEvent, []byte(`{}`))},
+		{Offset: 1, Value: []byte("not a dead-letter message")},
+	}}
+	replayer := NewReplayer(reader, &kafkaProducerStub{})
+
+	var entries []Entry
+	err := replayer.List(context.Background(), func(entry Entry) error {
+		entries = append(entries, entry)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, domain.DeadLetterSourceStoreEvent, entries[0].Source)
+	assert.Equal(t, "postgres is down", entries[0].Error)
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 16, 10, 0, 0, 0, time.UTC)
+	expired := domain.NewEnvelopeMessage(1, []byte("{}"))
+	expired.SetDeadline(now.Add(-time.Hour))
+	envelopePayload, err := json.Marshal(expired)
+	require.NoError(t, err)
+
+	t.Run("replays to source queues", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &readerStub{messages: []kafka.Message{
+			{Offset: 0, Value: deadLetterValue(t, domain.DeadLetterSourceEnvelope, envelopePayload)},
+			{Offset: 1, Value: deadLetterValue(t, domain.DeadLetterSourceStoreEvent, []byte(`{"event_id":"1"}`))},
+			{Offset: 2, Value: deadLetterValue(t, "unknown", []byte(`{}`))},
+		}}
+		producer := &kafkaProducerStub{}
+		replayer := NewReplayer(reader, producer)
+		replayer.now = func() time.Time { return now }
+
+		replayed, err := replayer.Replay(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), replayed)
+		assert.Equal(t, []int64{0, 1, 2}, reader.committed)
+
+		require.Len(t, producer.messages, 2)
+		assert.Equal(t, domain.EnvelopeTopicNormal, producer.messages[0].topic)
+		assert.Equal(t, domain.StoreEventTopicNormal, producer.messages[1].topic)
+		assert.JSONEq(t, `{"event_id":"1"}`, string(producer.messages[1].data))
+
+		var replayedEnvelope domain.EnvelopeMessage
+		require.NoError(t, json.Unmarshal(producer.messages[0].data, &replayedEnvelope))
+		assert.Equal(t, expired.EnvelopeID, replayedEnvelope.EnvelopeID)
+		assert.Equal(t, now.Add(envelopeReplayDeadline).Unix(), replayedEnvelope.Processing.Deadline)
+	})
+
+	t.Run("produce error stops replay", func(t *testing.T) {
+		t.Parallel()
+
+		reader := &readerStub{messages: []kafka.Message{
+			{Offset: 0, Value: deadLetterValue(t, domain.DeadLetterSourceStoreEvent, []byte(`{}`))},
+		}}
+		replayer := NewReplayer(reader, &kafkaProducerStub{err: errors.New("kafka is down")})
+
+		replayed, err := replayer.Replay(context.Background())
+		require.ErrorContains(t, err, "kafka is down")
+		assert.Zero(t, replayed)
+		assert.Empty(t, reader.committed)
+	})
+}
@@ -0,0 +1,98 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	commonconfig "github.com/rom8726/warden/internal/common/config"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/kafka"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+// Service retries queue messages failed to be processed and publishes the ones still failing
+// to the dead-letter topic to be replayed later.
+type Service struct {
+	producer        kafka.DataProducer
+	maxAttempts     uint
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+}
+
+func New(producer *kafka.TopicProducer, cfg *commonconfig.DeadLetter) *Service {
+	return &Service{
+		producer:        producer,
+		maxAttempts:     max(cfg.MaxAttempts, 1),
+		retryBackoff:    cfg.RetryBackoff,
+		maxRetryBackoff: cfg.MaxRetryBackoff,
+	}
+}
+
+// Retry runs process until it succeeds, fails with an error rejected by retryable or the attempts are exhausted.
+// The backoff between attempts is doubled after each of them. A nil retryable retries any error.
+func (s *Service) Retry(
+	ctx context.Context,
+	source domain.DeadLetterSource,
+	process func(ctx context.Context) error,
+	retryable func(err error) bool,
+) (uint, error) {
+	backoff := s.retryBackoff
+
+	var attempts uint
+	for {
+		attempts++
+
+		err := process(ctx)
+		if err == nil || attempts >= s.maxAttempts || (retryable != nil && !retryable(err)) {
+			return attempts, err
+		}
+
+		metrics.ProcessingRetries.WithLabelValues(string(source)).Inc()
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return attempts, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if s.maxRetryBackoff > 0 && backoff > s.maxRetryBackoff {
+			backoff = s.maxRetryBackoff
+		}
+	}
+}
+
+// Publish sends the original queue message with the processing error to the dead-letter topic.
+func (s *Service) Publish(
+	ctx context.Context,
+	source domain.DeadLetterSource,
+	projectID domain.ProjectID,
+	payload []byte,
+	cause error,
+	attempts uint,
+) error {
+	data, err := json.Marshal(domain.DeadLetterMessage{
+		Source:    source,
+		ProjectID: projectID,
+		Error:     cause.Error(),
+		Attempts:  attempts,
+		FailedAt:  time.Now().UTC(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal dead-letter message: %w", err)
+	}
+
+	if err := s.producer.Produce(ctx, data); err != nil {
+		return fmt.Errorf("produce dead-letter message: %w", err)
+	}
+
+	metrics.DeadLetterMessages.WithLabelValues(string(source)).Inc()
+
+	return nil
+}
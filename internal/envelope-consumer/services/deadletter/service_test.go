@@ -0,0 +1,118 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type producerStub struct {
+	messages [][]byte
+	err      error
+}
+
+func (p *producerStub) Produce(_ context.Context, data []byte) error {
+	p.messages = append(p.messages, data)
+
+	return p.err
+}
+
+func newTestService(producer *producerStub) *Service {
+	return &Service{
+		producer:        producer,
+		maxAttempts:     3,
+		retryBackoff:    time.Millisecond,
+		maxRetryBackoff: 2 * time.Millisecond,
+	}
+}
+
+func TestService_Retry(t *testing.T) {
+	t.Parallel()
+
+	errTemporary := errors.New("postgres is down")
+
+	t.Run("succeeds after failures", func(t *testing.T) {
+		t.Parallel()
+
+		service := newTestService(&producerStub{})
+
+		var calls int
+		attempts, err := service.Retry(context.Background(), domain.DeadLetterSourceEnvelope,
+			func(context.Context) error {
+				calls++
+				if calls < 3 {
+					return errTemporary
+				}
+
+				return nil
+			}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint(3), attempts)
+	})
+
+	t.Run("attempts are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		service := newTestService(&producerStub{})
+
+		attempts, err := service.Retry(context.Background(), domain.DeadLetterSourceStoreEvent,
+			func(context.Context) error { return errTemporary }, nil)
+		require.ErrorIs(t, err, errTemporary)
+		assert.Equal(t, uint(3), attempts)
+	})
+
+	t.Run("not retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		service := newTestService(&producerStub{})
+
+		attempts, err := service.Retry(context.Background(), domain.DeadLetterSourceEnvelope,
+			func(context.Context) error { return domain.ErrInvalidEnvelopeHeader },
+			func(err error) bool { return !errors.Is(err, domain.ErrInvalidEnvelopeHeader) })
+		require.ErrorIs(t, err, domain.ErrInvalidEnvelopeHeader)
+		assert.Equal(t, uint(1), attempts)
+	})
+
+	t.Run("canceled context stops retries", func(t *testing.T) {
+		t.Parallel()
+
+		service := newTestService(&producerStub{})
+		service.retryBackoff = time.Hour
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		attempts, err := service.Retry(ctx, domain.DeadLetterSourceEnvelope,
+			func(context.Context) error { return errTemporary }, nil)
+		require.ErrorIs(t, err, errTemporary)
+		assert.Equal(t, uint(1), attempts)
+	})
+}
+
+func TestService_Publish(t *testing.T) {
+	t.Parallel()
+
+	producer := &producerStub{}
+	service := newTestService(producer)
+
+	err := service.Publish(context.Background(), domain.DeadLetterSourceEnvelope, 7, []byte(`{"data":"x"}`),
+		errors.New("clickhouse is down"), 3)
+	require.NoError(t, err)
+	require.Len(t, producer.messages, 1)
+
+	var msg domain.DeadLetterMessage
+	require.NoError(t, json.Unmarshal(producer.messages[0], &msg))
+	assert.Equal(t, domain.DeadLetterSourceEnvelope, msg.Source)
+	assert.Equal(t, domain.ProjectID(7), msg.ProjectID)
+	assert.Equal(t, "clickhouse is down", msg.Error)
+	assert.Equal(t, uint(3), msg.Attempts)
+	assert.JSONEq(t, `{"data":"x"}`, string(msg.Payload))
+	assert.False(t, msg.FailedAt.IsZero())
+}
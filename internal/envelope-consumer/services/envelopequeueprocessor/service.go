@@ -1,8 +1,10 @@
 package envelopequeueprocessor
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"time"
 
@@ -17,6 +19,8 @@ var _ di.Servicer = (*Service)(nil)
 
 type Service struct {
 	envelopeUseCase contract.EnvelopeUseCase
+	outcomes        contract.OutcomeRecorder
+	deadLetter      contract.DeadLetterService
 	consumers       []contract.DataConsumer
 	ctx             context.Context
 	cancel          context.CancelFunc
@@ -25,12 +29,16 @@ type Service struct {
 // New creates new envelope consumer.
 func New(
 	envelopeUseCase contract.EnvelopeUseCase,
+	outcomes contract.OutcomeRecorder,
+	deadLetter contract.DeadLetterService,
 	consumers []contract.DataConsumer,
 ) (*Service, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Service{
 		envelopeUseCase: envelopeUseCase,
+		outcomes:        outcomes,
+		deadLetter:      deadLetter,
 		consumers:       consumers,
 		ctx:             ctx,
 		cancel:          cancel,
@@ -91,6 +99,9 @@ func (s *Service) processMessage(data []byte) {
 	if err := json.Unmarshal(data, &envelopeMsg); err != nil {
 		slog.Error("Failed to unmarshal envelope message", "error", err)
 		metrics.EnvelopeProcessingErrors.WithLabelValues("unmarshal_error").Inc()
+		// The project is unknown, so the envelope is counted for project 0
+		s.outcomes.Record(0, domain.OutcomeInvalid, domain.OutcomeReasonInvalidMessage, domain.DataCategoryEnvelope, 1)
+		s.publishDeadLetter(0, data, err, 1)
 
 		return
 	}
@@ -102,19 +113,41 @@ func (s *Service) processMessage(data []byte) {
 			"project_id", envelopeMsg.ProjectID,
 		)
 		metrics.EnvelopeProcessingErrors.WithLabelValues("expired").Inc()
+		s.outcomes.Record(envelopeMsg.ProjectID, domain.OutcomeInvalid, domain.OutcomeReasonExpired,
+			domain.DataCategoryEnvelope, 1)
 
 		return
 	}
 
-	// Process envelope through a use case
-	if err := s.envelopeUseCase.ProcessEnvelopeFromBytes(s.ctx, envelopeMsg.ProjectID, envelopeMsg.Data); err != nil {
+	// Process envelope through a use case, failed envelopes are retried and then dead-lettered.
+	// Only the failed items are processed again, the stored ones would be counted twice.
+	envelopeData := envelopeMsg.Data
+	attempts, err := s.deadLetter.Retry(s.ctx, domain.DeadLetterSourceEnvelope, func(ctx context.Context) error {
+		err := s.envelopeUseCase.ProcessEnvelopeFromBytes(ctx, envelopeMsg.ProjectID, envelopeData)
+
+		var itemsErr *domain.EnvelopeItemsError
+		if errors.As(err, &itemsErr) {
+			envelopeData = itemsErr.Envelope
+		}
+
+		return err
+	}, isRetryable)
+	if err != nil {
 		slog.Error("Failed to process envelope",
 			"envelope_id", envelopeMsg.EnvelopeID,
 			"project_id", envelopeMsg.ProjectID,
+			"attempts", attempts,
 			"error", err,
 		)
 		metrics.EnvelopeProcessingErrors.WithLabelValues("processing_error").Inc()
 
+		reason := domain.OutcomeReasonProcessingError
+		if !isRetryable(err) {
+			reason = domain.OutcomeReasonInvalidEnvelope
+		}
+		s.outcomes.Record(envelopeMsg.ProjectID, domain.OutcomeInvalid, reason, domain.DataCategoryEnvelope, 1)
+		s.publishDeadLetter(envelopeMsg.ProjectID, failedMessage(data, envelopeMsg, envelopeData), err, attempts)
+
 		return
 	}
 
@@ -129,3 +162,33 @@ func (s *Service) processMessage(data []byte) {
 		"size_bytes", len(envelopeMsg.Data),
 	)
 }
+
+func (s *Service) publishDeadLetter(projectID domain.ProjectID, data []byte, cause error, attempts uint) {
+	err := s.deadLetter.Publish(s.ctx, domain.DeadLetterSourceEnvelope, projectID, data, cause, attempts)
+	if err != nil {
+		slog.Error("Failed to publish envelope to dead-letter topic", "project_id", projectID, "error", err)
+	}
+}
+
+// failedMessage returns the envelope message to be dead-lettered, it holds only the items still failing
+// when others were stored.
+func failedMessage(data []byte, envelopeMsg domain.EnvelopeMessage, envelopeData []byte) []byte {
+	if bytes.Equal(envelopeData, envelopeMsg.Data) {
+		return data
+	}
+
+	envelopeMsg.Data = envelopeData
+	failed, err := json.Marshal(envelopeMsg)
+	if err != nil {
+		slog.Error("Failed to marshal failed envelope items, dead-lettering the whole envelope", "error", err)
+
+		return data
+	}
+
+	return failed
+}
+
+// isRetryable reports whether processing the envelope again can succeed, malformed envelopes never do.
+func isRetryable(err error) bool {
+	return !errors.Is(err, domain.ErrNoEnvelope) && !errors.Is(err, domain.ErrInvalidEnvelopeHeader)
+}
@@ -0,0 +1,67 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/contract"
+)
+
+// DefaultTouchInterval is how often the last seen time of an environment is updated,
+// so that every event does not write to the database.
+const DefaultTouchInterval = time.Minute
+
+type environmentKey struct {
+	projectID domain.ProjectID
+	name      string
+}
+
+// Service registers the environments of the processed events.
+type Service struct {
+	environmentsRepo contract.EnvironmentsRepository
+	interval         time.Duration
+
+	mu      sync.Mutex
+	touched map[environmentKey]time.Time
+}
+
+// Ensure Service implements contract.EnvironmentsService.
+var _ contract.EnvironmentsService = (*Service)(nil)
+
+func New(environmentsRepo contract.EnvironmentsRepository) *Service {
+	return &Service{
+		environmentsRepo: environmentsRepo,
+		interval:         DefaultTouchInterval,
+		touched:          make(map[environmentKey]time.Time),
+	}
+}
+
+// Touch registers the environment of the project as seen now,
+// environments touched within the interval are skipped.
+func (s *Service) Touch(ctx context.Context, projectID domain.ProjectID, name string) error {
+	now := time.Now()
+	key := environmentKey{projectID: projectID, name: name}
+
+	s.mu.Lock()
+	if touchedAt, ok := s.touched[key]; ok && now.Sub(touchedAt) < s.interval {
+		s.mu.Unlock()
+
+		return nil
+	}
+	s.touched[key] = now
+	s.mu.Unlock()
+
+	if err := s.environmentsRepo.Touch(ctx, projectID, name, now); err != nil {
+		// Retry with the next event
+		s.mu.Lock()
+		delete(s.touched, key)
+		s.mu.Unlock()
+
+		return fmt.Errorf("touch environment %q of project %d: %w", name, projectID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package environments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/envelope-consumer/contract"
+)
+
+func TestService_Touch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("touched once within the interval", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockEnvironmentsRepository(t)
+		repo.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "production", mock.Anything).Return(nil).Once()
+		repo.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "staging", mock.Anything).Return(nil).Once()
+		repo.EXPECT().Touch(mock.Anything, domain.ProjectID(2), "production", mock.Anything).Return(nil).Once()
+
+		service := New(repo)
+
+		require.NoError(t, service.Touch(context.Background(), 1, "production"))
+		require.NoError(t, service.Touch(context.Background(), 1, "production"))
+		require.NoError(t, service.Touch(context.Background(), 1, "staging"))
+		require.NoError(t, service.Touch(context.Background(), 2, "production"))
+	})
+
+	t.Run("touched again after the interval", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockEnvironmentsRepository(t)
+		repo.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "production", mock.Anything).Return(nil).Twice()
+
+		service := New(repo)
+		service.interval = 0
+
+		require.NoError(t, service.Touch(context.Background(), 1, "production"))
+		require.NoError(t, service.Touch(context.Background(), 1, "production"))
+	})
+
+	t.Run("retried after an error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mockcontract.NewMockEnvironmentsRepository(t)
+		repo.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "production", mock.Anything).
+			Return(errors.New("db error")).Once()
+		repo.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "production", mock.Anything).Return(nil).Once()
+
+		service := New(repo)
+
+		require.Error(t, service.Touch(context.Background(), 1, "production"))
+		require.NoError(t, service.Touch(context.Background(), 1, "production"))
+	})
+}
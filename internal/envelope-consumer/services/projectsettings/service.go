@@ -0,0 +1,152 @@
+package projectsettings
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/rom8726/warden/internal/common/datascrubbing"
+	"github.com/rom8726/warden/internal/common/groupingrules"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/contract"
+)
+
+// DefaultTTL is how long project settings are cached before being reloaded,
+// so that changes made in the backend are picked up without a restart.
+const DefaultTTL = 30 * time.Second
+
+type cachedSettings struct {
+	project        domain.Project
+	groupingConfig domain.GroupingConfig
+	dataScrubber   *datascrubbing.Scrubber
+	expiresAt      time.Time
+}
+
+// Service provides per-project processing settings with a short-lived cache.
+type Service struct {
+	projectsRepo      contract.ProjectsRepository
+	groupingRulesRepo contract.GroupingRulesRepository
+	dataScrubbingRepo contract.DataScrubbingRepository
+	ttl               time.Duration
+
+	mu       sync.RWMutex
+	settings map[domain.ProjectID]cachedSettings
+}
+
+// Ensure Service implements contract.ProjectSettingsService.
+var _ contract.ProjectSettingsService = (*Service)(nil)
+
+func New(
+	projectsRepo contract.ProjectsRepository,
+	groupingRulesRepo contract.GroupingRulesRepository,
+	dataScrubbingRepo contract.DataScrubbingRepository,
+) *Service {
+	return &Service{
+		projectsRepo:      projectsRepo,
+		groupingRulesRepo: groupingRulesRepo,
+		dataScrubbingRepo: dataScrubbingRepo,
+		ttl:               DefaultTTL,
+		settings:          make(map[domain.ProjectID]cachedSettings),
+	}
+}
+
+// GetProject returns the project, loading it from the repository when it is not cached or expired.
+func (s *Service) GetProject(ctx context.Context, projectID domain.ProjectID) (domain.Project, error) {
+	settings, err := s.get(ctx, projectID)
+	if err != nil {
+		return domain.Project{}, err
+	}
+
+	return settings.project, nil
+}
+
+// GroupingConfig returns the grouping strategy and the compiled grouping rules of the project.
+func (s *Service) GroupingConfig(ctx context.Context, projectID domain.ProjectID) (domain.GroupingConfig, error) {
+	settings, err := s.get(ctx, projectID)
+	if err != nil {
+		return domain.GroupingConfig{}, err
+	}
+
+	return settings.groupingConfig, nil
+}
+
+// DataScrubber returns the compiled data scrubbing of the project.
+func (s *Service) DataScrubber(ctx context.Context, projectID domain.ProjectID) (*datascrubbing.Scrubber, error) {
+	settings, err := s.get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings.dataScrubber, nil
+}
+
+func (s *Service) get(ctx context.Context, projectID domain.ProjectID) (cachedSettings, error) {
+	now := time.Now()
+
+	s.mu.RLock()
+	cached, ok := s.settings[projectID]
+	s.mu.RUnlock()
+
+	if ok && now.Before(cached.expiresAt) {
+		return cached, nil
+	}
+
+	settings, err := s.load(ctx, projectID)
+	if err != nil {
+		return cachedSettings{}, err
+	}
+
+	settings.expiresAt = now.Add(s.ttl)
+
+	s.mu.Lock()
+	s.settings[projectID] = settings
+	s.mu.Unlock()
+
+	return settings, nil
+}
+
+func (s *Service) load(ctx context.Context, projectID domain.ProjectID) (cachedSettings, error) {
+	project, err := s.projectsRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return cachedSettings{}, fmt.Errorf("get project %d: %w", projectID, err)
+	}
+
+	rules, err := s.groupingRulesRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return cachedSettings{}, fmt.Errorf("list grouping rules of project %d: %w", projectID, err)
+	}
+
+	strategy := project.GroupingStrategy
+	if !strategy.IsValid() {
+		strategy = domain.DefaultGroupingStrategy
+	}
+
+	groupingConfig, err := groupingrules.Compile(strategy, rules)
+	if err != nil {
+		// Rules are validated on save, keep processing events with the valid ones.
+		slog.Error("invalid grouping rules", "project_id", projectID, "error", err)
+	}
+
+	scrubbing, err := s.dataScrubbingRepo.Get(ctx, projectID)
+	if err != nil {
+		return cachedSettings{}, fmt.Errorf("get data scrubbing of project %d: %w", projectID, err)
+	}
+
+	dataScrubber, err := datascrubbing.Compile(scrubbing)
+	if err != nil {
+		// The settings are validated on save, never store events unscrubbed because of them.
+		slog.Error("invalid data scrubbing", "project_id", projectID, "error", err)
+
+		scrubbing.SensitiveFields = nil
+		scrubbing.Patterns = nil
+		dataScrubber, _ = datascrubbing.Compile(scrubbing)
+	}
+
+	return cachedSettings{
+		project:        project,
+		groupingConfig: groupingConfig,
+		dataScrubber:   dataScrubber,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package projectsettings
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/common/datascrubbing"
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/envelope-consumer/contract"
+)
+
+func newDataScrubbingRepo(t *testing.T) *mockcontract.MockDataScrubbingRepository {
+	t.Helper()
+
+	repo := mockcontract.NewMockDataScrubbingRepository(t)
+	repo.EXPECT().Get(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error) {
+			return domain.DataScrubbing{ProjectID: projectID, DefaultScrubbers: true}, nil
+		}).Maybe()
+
+	return repo
+}
+
+func TestService_GroupingConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cached between calls", func(t *testing.T) {
+		t.Parallel()
+
+		projectsRepo := mockcontract.NewMockProjectsRepository(t)
+		projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{
+			ID:               1,
+			GroupingStrategy: domain.GroupingStrategyStacktraceV1,
+		}, nil).Once()
+		rulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+		rulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return([]domain.GroupingRule{
+			{ID: 1, Type: domain.GroupingRuleTypeFingerprint, Expression: "error.type:DatabaseError -> database"},
+			{ID: 2, Type: domain.GroupingRuleTypeStacktrace, Expression: "stack.module:vendor/** -app"},
+		}, nil).Once()
+
+		srv := New(projectsRepo, rulesRepo, newDataScrubbingRepo(t))
+		for range 3 {
+			cfg, err := srv.GroupingConfig(context.Background(), 1)
+			require.NoError(t, err)
+			require.Equal(t, domain.GroupingStrategyStacktraceV1, cfg.Strategy)
+			require.Len(t, cfg.FingerprintRules, 1)
+			require.Len(t, cfg.FrameRules, 1)
+		}
+	})
+
+	t.Run("reloaded after ttl", func(t *testing.T) {
+		t.Parallel()
+
+		projectsRepo := mockcontract.NewMockProjectsRepository(t)
+		projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{
+			ID:               1,
+			GroupingStrategy: domain.GroupingStrategyLegacy,
+		}, nil).Twice()
+		rulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+		rulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil).Twice()
+
+		srv := New(projectsRepo, rulesRepo, newDataScrubbingRepo(t))
+		srv.ttl = time.Nanosecond
+
+		_, err := srv.GroupingConfig(context.Background(), 1)
+		require.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		_, err = srv.GroupingConfig(context.Background(), 1)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown strategy and invalid rules fall back", func(t *testing.T) {
+		t.Parallel()
+
+		projectsRepo := mockcontract.NewMockProjectsRepository(t)
+		projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(2)).Return(domain.Project{ID: 2}, nil)
+		rulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+		rulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(2)).Return([]domain.GroupingRule{
+			{ID: 1, Type: domain.GroupingRuleTypeFingerprint, Expression: "broken"},
+		}, nil)
+
+		cfg, err := New(projectsRepo, rulesRepo, newDataScrubbingRepo(t)).GroupingConfig(context.Background(), 2)
+		require.NoError(t, err)
+		require.Equal(t, domain.DefaultGroupingStrategy, cfg.Strategy)
+		require.Empty(t, cfg.FingerprintRules)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		projectsRepo := mockcontract.NewMockProjectsRepository(t)
+		projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(3)).
+			Return(domain.Project{}, errors.New("db error"))
+
+		_, err := New(projectsRepo, mockcontract.NewMockGroupingRulesRepository(t), newDataScrubbingRepo(t)).
+			GroupingConfig(context.Background(), 3)
+		require.ErrorContains(t, err, "db error")
+	})
+}
+
+func TestService_DataScrubber(t *testing.T) {
+	t.Parallel()
+
+	t.Run("compiled settings", func(t *testing.T) {
+		t.Parallel()
+
+		projectsRepo := mockcontract.NewMockProjectsRepository(t)
+		projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).Return(domain.Project{ID: 1}, nil)
+		rulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+		rulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(nil, nil)
+		scrubbingRepo := mockcontract.NewMockDataScrubbingRepository(t)
+		scrubbingRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).Return(domain.DataScrubbing{
+			ProjectID:       1,
+			SensitiveFields: []string{"email"},
+		}, nil)
+
+		scrubber, err := New(projectsRepo, rulesRepo, scrubbingRepo).DataScrubber(context.Background(), 1)
+		require.NoError(t, err)
+
+		event := map[string]any{"user": map[string]any{"email": "john@example.com"}}
+		scrubber.Scrub(event)
+		require.Equal(t, datascrubbing.Filtered, event["user"].(map[string]any)["email"])
+	})
+
+	t.Run("invalid settings keep the default scrubbers", func(t *testing.T) {
+		t.Parallel()
+
+		projectsRepo := mockcontract.NewMockProjectsRepository(t)
+		projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(2)).Return(domain.Project{ID: 2}, nil)
+		rulesRepo := mockcontract.NewMockGroupingRulesRepository(t)
+		rulesRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(2)).Return(nil, nil)
+		scrubbingRepo := mockcontract.NewMockDataScrubbingRepository(t)
+		scrubbingRepo.EXPECT().Get(mock.Anything, domain.ProjectID(2)).Return(domain.DataScrubbing{
+			ProjectID:        2,
+			DefaultScrubbers: true,
+			Patterns:         []string{"("},
+		}, nil)
+
+		scrubber, err := New(projectsRepo, rulesRepo, scrubbingRepo).DataScrubber(context.Background(), 2)
+		require.NoError(t, err)
+
+		event := map[string]any{"password": "hunter2"}
+		scrubber.Scrub(event)
+		require.Equal(t, datascrubbing.Filtered, event["password"])
+	})
+}
@@ -3,6 +3,7 @@ package storeeventqueueprocessor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"time"
 
@@ -17,6 +18,8 @@ var _ di.Servicer = (*Service)(nil)
 
 type Service struct {
 	eventUseCase contract.EventUseCase
+	outcomes     contract.OutcomeRecorder
+	deadLetter   contract.DeadLetterService
 	consumers    []contract.DataConsumer
 	ctx          context.Context
 	cancel       context.CancelFunc
@@ -25,12 +28,16 @@ type Service struct {
 // New creates new store event consumer.
 func New(
 	eventUseCase contract.EventUseCase,
+	outcomes contract.OutcomeRecorder,
+	deadLetter contract.DeadLetterService,
 	consumers []contract.DataConsumer,
 ) (*Service, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Service{
 		eventUseCase: eventUseCase,
+		outcomes:     outcomes,
+		deadLetter:   deadLetter,
 		consumers:    consumers,
 		ctx:          ctx,
 		cancel:       cancel,
@@ -93,23 +100,64 @@ func (s *Service) processMessage(data []byte) {
 	if err := json.Unmarshal(data, &storeEventMsg); err != nil {
 		slog.Error("Failed to unmarshal store event message", "error", err)
 		metrics.StoreEventProcessingErrors.WithLabelValues("unmarshal_error").Inc()
+		// The project is unknown, so the event is counted for project 0
+		s.outcomes.Record(0, domain.OutcomeInvalid, domain.OutcomeReasonInvalidMessage, domain.DataCategoryError, 1)
+		s.publishDeadLetter(0, data, err, 1)
 
 		return
 	}
 
-	// Process store event through a use case
-	eventID, err := s.eventUseCase.ProcessEvent(s.ctx, storeEventMsg.ProjectID, storeEventMsg.EventData)
+	// Process store event through a use case, failed events are retried and then dead-lettered.
+	// Once the issue of the event is committed only the event is stored again, it would be counted twice.
+	var (
+		eventID  domain.EventID
+		storeErr *domain.EventStoreError
+	)
+	attempts, err := s.deadLetter.Retry(s.ctx, domain.DeadLetterSourceStoreEvent, func(ctx context.Context) error {
+		if storeErr != nil {
+			if err := s.eventUseCase.StoreEvent(ctx, &storeErr.Event); err != nil {
+				return err
+			}
+
+			eventID = storeErr.Event.ID
+
+			return nil
+		}
+
+		var err error
+		eventID, err = s.eventUseCase.ProcessEvent(ctx, storeEventMsg.ProjectID, storeEventMsg.EventData)
+		errors.As(err, &storeErr)
+
+		return err
+	}, isRetryable)
 	if err != nil {
 		slog.Error("Failed to process store event",
 			"event_id", storeEventMsg.EventID,
 			"project_id", storeEventMsg.ProjectID,
+			"attempts", attempts,
 			"error", err,
 		)
 		metrics.StoreEventProcessingErrors.WithLabelValues("processing_error").Inc()
 
+		// Invalid events and events of deleted projects never succeed, they are not dead-lettered
+		switch {
+		case errors.Is(err, domain.ErrInvalidEvent):
+			s.outcomes.Record(storeEventMsg.ProjectID, domain.OutcomeInvalid, domain.OutcomeReasonInvalidPayload,
+				domain.DataCategoryError, 1)
+		case errors.Is(err, domain.ErrEntityNotFound):
+			s.outcomes.Record(storeEventMsg.ProjectID, domain.OutcomeInvalid, domain.OutcomeReasonProjectNotFound,
+				domain.DataCategoryError, 1)
+		default:
+			s.outcomes.Record(storeEventMsg.ProjectID, domain.OutcomeInvalid, domain.OutcomeReasonProcessingError,
+				domain.DataCategoryError, 1)
+			s.publishDeadLetter(storeEventMsg.ProjectID, data, err, attempts)
+		}
+
 		return
 	}
 
+	s.outcomes.Record(storeEventMsg.ProjectID, domain.OutcomeAccepted, "", domain.DataCategoryError, 1)
+
 	// Success processing metrics
 	duration := time.Since(start)
 	metrics.StoreEventProcessingDuration.WithLabelValues("process").Observe(duration.Seconds())
@@ -120,3 +168,16 @@ func (s *Service) processMessage(data []byte) {
 		"duration_ms", duration.Milliseconds(),
 	)
 }
+
+func (s *Service) publishDeadLetter(projectID domain.ProjectID, data []byte, cause error, attempts uint) {
+	err := s.deadLetter.Publish(s.ctx, domain.DeadLetterSourceStoreEvent, projectID, data, cause, attempts)
+	if err != nil {
+		slog.Error("Failed to publish store event to dead-letter topic", "project_id", projectID, "error", err)
+	}
+}
+
+// isRetryable reports whether processing the event again can succeed, invalid events and events
+// of deleted projects never do.
+func isRetryable(err error) bool {
+	return !errors.Is(err, domain.ErrInvalidEvent) && !errors.Is(err, domain.ErrEntityNotFound)
+}
@@ -51,7 +51,10 @@ func (srv *Service) Provide(ctx context.Context, event *domain.Event) error {
 	}
 
 	keys := []string{throttle.EventsCountMapKey(event.ProjectID), throttle.EventsIndexSetKey(event.ProjectID)}
-	fingerPrint := event.FullFingerprint()
+	fingerPrint := event.GroupHash
+	if fingerPrint == "" {
+		fingerPrint = event.GroupingFingerprint()
+	}
 	ttl := int(srv.ttl.Seconds())
 
 	return srv.script.Run(ctx, srv.redisClient, keys, fingerPrint, ttl).Err()
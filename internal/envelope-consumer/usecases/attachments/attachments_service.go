@@ -0,0 +1,85 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	commonconfig "github.com/rom8726/warden/internal/common/config"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/contract"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+type AttachmentService struct {
+	attachmentsRepo contract.AttachmentsRepository
+	blobStore       contract.BlobStore
+	maxSize         uint
+	projectQuota    uint
+}
+
+func New(
+	config *commonconfig.Attachments,
+	attachmentsRepo contract.AttachmentsRepository,
+	blobStore contract.BlobStore,
+) *AttachmentService {
+	return &AttachmentService{
+		attachmentsRepo: attachmentsRepo,
+		blobStore:       blobStore,
+		maxSize:         uint(max(config.MaxSize, 0)),
+		projectQuota:    uint(max(config.ProjectQuota, 0)),
+	}
+}
+
+// StoreAttachment writes the attachment content to the blob store and links it to the event.
+// Attachments over the size limit or the project quota are rejected.
+func (s *AttachmentService) StoreAttachment(
+	ctx context.Context,
+	attachmentDTO domain.AttachmentDTO,
+	data []byte,
+) (domain.Attachment, error) {
+	start := time.Now()
+	projectIDStr := attachmentDTO.ProjectID.String()
+
+	attachmentDTO.Size = uint(len(data))
+	if attachmentDTO.Size > s.maxSize {
+		metrics.AttachmentsRejected.WithLabelValues(projectIDStr, "too_large").Inc()
+
+		return domain.Attachment{}, domain.ErrAttachmentTooLarge
+	}
+
+	used, err := s.attachmentsRepo.TotalSizeByProject(ctx, attachmentDTO.ProjectID)
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("get project attachments size: %w", err)
+	}
+
+	if used+attachmentDTO.Size > s.projectQuota {
+		metrics.AttachmentsRejected.WithLabelValues(projectIDStr, "quota_exceeded").Inc()
+
+		return domain.Attachment{}, domain.ErrAttachmentQuotaExceeded
+	}
+
+	attachmentDTO.BlobKey = fmt.Sprintf("%s/%s/%s", projectIDStr, attachmentDTO.EventID, uuid.NewString())
+
+	err = s.blobStore.Put(ctx, attachmentDTO.BlobKey, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("put attachment blob: %w", err)
+	}
+
+	attachment, err := s.attachmentsRepo.Create(ctx, attachmentDTO)
+	if err != nil {
+		if err := s.blobStore.Delete(ctx, attachmentDTO.BlobKey); err != nil {
+			slog.Error("failed to delete orphan attachment blob", "key", attachmentDTO.BlobKey, "error", err)
+		}
+
+		return domain.Attachment{}, fmt.Errorf("create attachment: %w", err)
+	}
+
+	metrics.ProcessingTime.WithLabelValues("attachment").Observe(time.Since(start).Seconds())
+
+	return attachment, nil
+}
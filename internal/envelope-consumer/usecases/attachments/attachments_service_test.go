@@ -0,0 +1,117 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/rom8726/warden/internal/common/config"
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/envelope-consumer/contract"
+)
+
+func TestStoreAttachment(t *testing.T) {
+	t.Parallel()
+
+	config := &commonconfig.Attachments{MaxSize: 10, ProjectQuota: 100}
+	attachmentDTO := domain.AttachmentDTO{
+		ProjectID:   1,
+		EventID:     "evt-1",
+		Name:        "app.log",
+		ContentType: "text/plain",
+		Type:        domain.AttachmentTypeDefault,
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		setupMocks func(
+			mockAttachmentsRepo *mockcontract.MockAttachmentsRepository,
+			mockBlobStore *mockcontract.MockBlobStore,
+		)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			data: []byte("hello"),
+			setupMocks: func(
+				mockAttachmentsRepo *mockcontract.MockAttachmentsRepository,
+				mockBlobStore *mockcontract.MockBlobStore,
+			) {
+				mockAttachmentsRepo.EXPECT().TotalSizeByProject(mock.Anything, domain.ProjectID(1)).Return(90, nil)
+				mockBlobStore.EXPECT().
+					Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+						return strings.HasPrefix(key, "1/evt-1/")
+					}), mock.Anything, int64(5)).
+					Return(nil)
+				mockAttachmentsRepo.EXPECT().
+					Create(mock.Anything, mock.MatchedBy(func(dto domain.AttachmentDTO) bool {
+						return dto.Size == 5 && dto.BlobKey != "" && dto.Name == "app.log"
+					})).
+					Return(domain.Attachment{ID: 1}, nil)
+			},
+		},
+		{
+			name: "Too large",
+			data: []byte("hello world"),
+			setupMocks: func(
+				*mockcontract.MockAttachmentsRepository,
+				*mockcontract.MockBlobStore,
+			) {
+			},
+			expectedError: domain.ErrAttachmentTooLarge,
+		},
+		{
+			name: "Quota exceeded",
+			data: []byte("hello"),
+			setupMocks: func(
+				mockAttachmentsRepo *mockcontract.MockAttachmentsRepository,
+				_ *mockcontract.MockBlobStore,
+			) {
+				mockAttachmentsRepo.EXPECT().TotalSizeByProject(mock.Anything, domain.ProjectID(1)).Return(96, nil)
+			},
+			expectedError: domain.ErrAttachmentQuotaExceeded,
+		},
+		{
+			name: "Blob is deleted when the row is not created",
+			data: []byte("hello"),
+			setupMocks: func(
+				mockAttachmentsRepo *mockcontract.MockAttachmentsRepository,
+				mockBlobStore *mockcontract.MockBlobStore,
+			) {
+				mockAttachmentsRepo.EXPECT().TotalSizeByProject(mock.Anything, domain.ProjectID(1)).Return(0, nil)
+				mockBlobStore.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything, int64(5)).Return(nil)
+				mockAttachmentsRepo.EXPECT().Create(mock.Anything, mock.Anything).
+					Return(domain.Attachment{}, errors.New("db error"))
+				mockBlobStore.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedError: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockAttachmentsRepo := mockcontract.NewMockAttachmentsRepository(t)
+			mockBlobStore := mockcontract.NewMockBlobStore(t)
+			tt.setupMocks(mockAttachmentsRepo, mockBlobStore)
+
+			service := New(config, mockAttachmentsRepo, mockBlobStore)
+
+			attachment, err := service.StoreAttachment(context.Background(), attachmentDTO, tt.data)
+			if tt.expectedError != nil {
+				require.ErrorContains(t, err, tt.expectedError.Error())
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, domain.AttachmentID(1), attachment.ID)
+		})
+	}
+}
@@ -2,27 +2,46 @@ package envelope
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"strings"
 	"time"
 
+	eventcommon "github.com/rom8726/warden/internal/common/event"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/envelope-consumer/contract"
 	"github.com/rom8726/warden/pkg/metrics"
 )
 
 type EnvelopeService struct {
-	eventUseCase contract.StoreEventUseCase
+	eventUseCase       contract.StoreEventUseCase
+	transactionUseCase contract.TransactionUseCase
+	sessionUseCase     contract.SessionUseCase
+	attachmentUseCase  contract.AttachmentUseCase
+	monitorUseCase     contract.MonitorUseCase
+	outcomes           contract.OutcomeRecorder
 }
 
 func New(
 	eventUseCase contract.StoreEventUseCase,
+	transactionUseCase contract.TransactionUseCase,
+	sessionUseCase contract.SessionUseCase,
+	attachmentUseCase contract.AttachmentUseCase,
+	monitorUseCase contract.MonitorUseCase,
+	outcomes contract.OutcomeRecorder,
 ) *EnvelopeService {
 	return &EnvelopeService{
-		eventUseCase: eventUseCase,
+		eventUseCase:       eventUseCase,
+		transactionUseCase: transactionUseCase,
+		sessionUseCase:     sessionUseCase,
+		attachmentUseCase:  attachmentUseCase,
+		monitorUseCase:     monitorUseCase,
+		outcomes:           outcomes,
 	}
 }
 
@@ -31,22 +50,16 @@ func (s *EnvelopeService) ProcessEnvelopeFromBytes(ctx context.Context, projectI
 	start := time.Now()
 	projectIDStr := projectID.String()
 
-	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	reader := bufio.NewReader(bytes.NewReader(data))
 
 	// The first line is the envelope header
-	if !scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			slog.Error("Error reading envelope header", "error", err)
-
-			return fmt.Errorf("error reading envelope header: %w", err)
-		}
-
+	headerLine, ok := readLine(reader)
+	if !ok {
 		slog.Error("Empty envelope")
 
 		return domain.ErrNoEnvelope
 	}
 
-	headerLine := scanner.Text()
 	var envelopeHeader map[string]any
 	if err := json.Unmarshal([]byte(headerLine), &envelopeHeader); err != nil {
 		slog.Error("Failed to parse envelope header", "error", err)
@@ -56,13 +69,16 @@ func (s *EnvelopeService) ProcessEnvelopeFromBytes(ctx context.Context, projectI
 
 	slog.Debug("Processing envelope from Kafka", "project_id", projectID, "header", envelopeHeader)
 
+	// Items failed to be stored fail the envelope to be retried, malformed items are skipped
+	var failed failedItems
+
 	// Process each item in the envelope
 	for {
 		// Read item header
-		if !scanner.Scan() {
+		itemHeaderLine, ok := readLine(reader)
+		if !ok {
 			break // End of envelope
 		}
-		itemHeaderLine := scanner.Text()
 
 		var itemHeader map[string]any
 		if err := json.Unmarshal([]byte(itemHeaderLine), &itemHeader); err != nil {
@@ -88,11 +104,28 @@ func (s *EnvelopeService) ProcessEnvelopeFromBytes(ctx context.Context, projectI
 		}
 		itemLength := int(itemLengthRaw)
 
+		// Attachments are binary, their payload is read byte exact
+		if itemType == "attachment" {
+			data, ok := s.readAttachment(projectID, reader, itemLength)
+			if !ok {
+				continue
+			}
+
+			if err := s.processAttachment(ctx, projectID, envelopeHeader, itemHeader, data); err != nil {
+				failed.add(itemHeaderLine, string(data)+"\n", fmt.Errorf("process attachment: %w", err))
+			}
+
+			continue
+		}
+
 		// Read item payload
 		var payloadBuilder strings.Builder
 		bytesRead := 0
-		for bytesRead < itemLength && scanner.Scan() {
-			line := scanner.Text()
+		for bytesRead < itemLength {
+			line, ok := readLine(reader)
+			if !ok {
+				break
+			}
 			payloadBuilder.WriteString(line)
 			payloadBuilder.WriteString("\n")
 			bytesRead += len(line) + 1 // +1 for a newline
@@ -111,15 +144,17 @@ func (s *EnvelopeService) ProcessEnvelopeFromBytes(ctx context.Context, projectI
 			if err := json.Unmarshal([]byte(payload), &eventData); err != nil {
 				slog.Error("Failed to parse event data", "error", err)
 				metrics.ValidationErrors.WithLabelValues("invalid_json").Inc()
+				s.recordInvalid(projectID, domain.OutcomeReasonInvalidPayload, domain.DataCategoryError)
 
 				continue
 			}
 
-			// Process the event
+			// Process the event, it's counted as accepted once it's stored
 			eventID, err := s.eventUseCase.StoreEvent(ctx, projectID, eventData)
 			if err != nil {
 				slog.Error("Failed to process event", "error", err)
 				metrics.ValidationErrors.WithLabelValues("process_event").Inc()
+				failed.add(itemHeaderLine, payload, fmt.Errorf("process event: %w", err))
 
 				continue
 			}
@@ -127,19 +162,230 @@ func (s *EnvelopeService) ProcessEnvelopeFromBytes(ctx context.Context, projectI
 			metrics.EventsProcessed.WithLabelValues(projectIDStr).Inc()
 			slog.Debug("Event processed successfully", "event_id", eventID)
 
+		case "transaction":
+			metrics.TransactionsReceived.WithLabelValues(projectIDStr).Inc()
+
+			var transactionData map[string]any
+			if err := json.Unmarshal([]byte(payload), &transactionData); err != nil {
+				slog.Error("Failed to parse transaction data", "error", err)
+				metrics.ValidationErrors.WithLabelValues("invalid_json").Inc()
+				s.recordInvalid(projectID, domain.OutcomeReasonInvalidPayload, domain.DataCategoryTransaction)
+
+				continue
+			}
+
+			transactionID, err := s.transactionUseCase.ProcessTransaction(ctx, projectID, transactionData)
+			if err != nil {
+				slog.Error("Failed to process transaction", "error", err)
+				metrics.ValidationErrors.WithLabelValues("process_transaction").Inc()
+				failed.add(itemHeaderLine, payload, fmt.Errorf("process transaction: %w", err))
+
+				continue
+			}
+
+			metrics.TransactionsProcessed.WithLabelValues(projectIDStr).Inc()
+			s.outcomes.Record(projectID, domain.OutcomeAccepted, "", domain.DataCategoryTransaction, 1)
+			slog.Debug("Transaction processed successfully", "event_id", transactionID)
+
+		case "session", "sessions":
+			metrics.SessionsReceived.WithLabelValues(projectIDStr).Inc()
+
+			var sessionData map[string]any
+			if err := json.Unmarshal([]byte(payload), &sessionData); err != nil {
+				slog.Error("Failed to parse session data", "error", err)
+				metrics.ValidationErrors.WithLabelValues("invalid_json").Inc()
+				s.recordInvalid(projectID, domain.OutcomeReasonInvalidPayload, domain.DataCategorySession)
+
+				continue
+			}
+
+			var err error
+			if itemType == "session" {
+				err = s.sessionUseCase.ProcessSession(ctx, projectID, sessionData)
+			} else {
+				err = s.sessionUseCase.ProcessSessionAggregates(ctx, projectID, sessionData)
+			}
+			if err != nil {
+				slog.Error("Failed to process session", "type", itemType, "error", err)
+				metrics.ValidationErrors.WithLabelValues("process_session").Inc()
+				failed.add(itemHeaderLine, payload, fmt.Errorf("process %s: %w", itemType, err))
+
+				continue
+			}
+
+			metrics.SessionsProcessed.WithLabelValues(projectIDStr).Inc()
+			s.outcomes.Record(projectID, domain.OutcomeAccepted, "", domain.DataCategorySession, 1)
+
+		case "check_in":
+			metrics.CheckInsReceived.WithLabelValues(projectIDStr).Inc()
+
+			var checkInData map[string]any
+			if err := json.Unmarshal([]byte(payload), &checkInData); err != nil {
+				slog.Error("Failed to parse check-in data", "error", err)
+				metrics.ValidationErrors.WithLabelValues("invalid_json").Inc()
+				s.recordInvalid(projectID, domain.OutcomeReasonInvalidPayload, domain.DataCategoryMonitor)
+
+				continue
+			}
+
+			if err := s.monitorUseCase.ProcessCheckIn(ctx, projectID, checkInData); err != nil {
+				slog.Error("Failed to process check-in", "error", err)
+				metrics.ValidationErrors.WithLabelValues("process_check_in").Inc()
+				failed.add(itemHeaderLine, payload, fmt.Errorf("process check-in: %w", err))
+
+				continue
+			}
+
+			metrics.CheckInsProcessed.WithLabelValues(projectIDStr).Inc()
+			s.outcomes.Record(projectID, domain.OutcomeAccepted, "", domain.DataCategoryMonitor, 1)
+
+		case "client_report":
+			var reportData map[string]any
+			if err := json.Unmarshal([]byte(payload), &reportData); err != nil {
+				slog.Error("Failed to parse client report", "error", err)
+				metrics.ValidationErrors.WithLabelValues("invalid_json").Inc()
+
+				continue
+			}
+
+			for _, discard := range eventcommon.ParseClientReport(reportData) {
+				s.outcomes.Record(projectID, domain.OutcomeClientDiscard, discard.Reason, discard.Category, discard.Quantity)
+			}
+
 		default:
 			slog.Info("Skipping unsupported item type", "type", itemType)
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		slog.Error("Error reading envelope", "error", err)
+	// Track processing time
+	metrics.ProcessingTime.WithLabelValues("envelope").Observe(time.Since(start).Seconds())
+
+	return failed.err(headerLine)
+}
+
+// failedItems collects the items failed to be stored, they are kept as envelope items to be processed again.
+type failedItems struct {
+	items bytes.Buffer
+	errs  []error
+}
+
+func (f *failedItems) add(itemHeader, payload string, err error) {
+	f.items.WriteString(itemHeader)
+	f.items.WriteString("\n")
+	f.items.WriteString(payload)
+	f.errs = append(f.errs, err)
+}
+
+// err returns an EnvelopeItemsError with the envelope of the failed items, nil when no item failed.
+func (f *failedItems) err(envelopeHeader string) error {
+	if len(f.errs) == 0 {
+		return nil
+	}
+
+	envelope := make([]byte, 0, len(envelopeHeader)+1+f.items.Len())
+	envelope = append(envelope, envelopeHeader...)
+	envelope = append(envelope, '\n')
+	envelope = append(envelope, f.items.Bytes()...)
 
-		return fmt.Errorf("error reading envelope: %w", err)
+	return &domain.EnvelopeItemsError{Envelope: envelope, Err: errors.Join(f.errs...)}
+}
+
+// readAttachment reads the payload of an attachment item, ok is false when the payload is truncated.
+func (s *EnvelopeService) readAttachment(
+	projectID domain.ProjectID,
+	reader *bufio.Reader,
+	length int,
+) ([]byte, bool) {
+	data := make([]byte, length)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		slog.Error("Failed to read attachment payload", "error", err)
+		metrics.ValidationErrors.WithLabelValues("invalid_attachment").Inc()
+		s.recordInvalid(projectID, domain.OutcomeReasonInvalidPayload, domain.DataCategoryAttachment)
+
+		return nil, false
 	}
 
-	// Track processing time
-	metrics.ProcessingTime.WithLabelValues("envelope").Observe(time.Since(start).Seconds())
+	// The payload is followed by an optional newline
+	if next, err := reader.Peek(1); err == nil && next[0] == '\n' {
+		_, _ = reader.Discard(1)
+	}
+
+	return data, true
+}
+
+// processAttachment stores an attachment item, it is linked to the event of the envelope.
+// The returned error is the one of an attachment failed to be stored, it's worth processing again.
+func (s *EnvelopeService) processAttachment(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	envelopeHeader map[string]any,
+	itemHeader map[string]any,
+	data []byte,
+) error {
+	metrics.AttachmentsReceived.WithLabelValues(projectID.String()).Inc()
+
+	eventID, _ := envelopeHeader["event_id"].(string)
+	if eventID == "" {
+		slog.Error("Attachment envelope missing event_id")
+		metrics.ValidationErrors.WithLabelValues("invalid_attachment").Inc()
+		s.recordInvalid(projectID, domain.OutcomeReasonInvalidPayload, domain.DataCategoryAttachment)
+
+		return nil
+	}
+
+	attachmentDTO := domain.AttachmentDTO{
+		ProjectID:   projectID,
+		EventID:     domain.EventID(eventID),
+		Name:        headerString(itemHeader, "filename", "attachment"),
+		ContentType: headerString(itemHeader, "content_type", domain.DefaultAttachmentContentType),
+		Type:        headerString(itemHeader, "attachment_type", domain.AttachmentTypeDefault),
+	}
+
+	attachment, err := s.attachmentUseCase.StoreAttachment(ctx, attachmentDTO, data)
+	if err != nil {
+		slog.Error("Failed to process attachment", "event_id", eventID, "error", err)
+
+		switch {
+		case errors.Is(err, domain.ErrAttachmentQuotaExceeded):
+			s.outcomes.Record(projectID, domain.OutcomeRateLimited, domain.OutcomeReasonQuota,
+				domain.DataCategoryAttachment, 1)
+		case errors.Is(err, domain.ErrAttachmentTooLarge):
+			s.recordInvalid(projectID, domain.OutcomeReasonTooLarge, domain.DataCategoryAttachment)
+		default:
+			metrics.ValidationErrors.WithLabelValues("process_attachment").Inc()
+
+			return err
+		}
+
+		return nil
+	}
+
+	s.outcomes.Record(projectID, domain.OutcomeAccepted, "", domain.DataCategoryAttachment, 1)
+
+	slog.Debug("Attachment processed successfully", "event_id", eventID, "attachment_id", attachment.ID)
 
 	return nil
 }
+
+func (s *EnvelopeService) recordInvalid(projectID domain.ProjectID, reason string, category domain.DataCategory) {
+	s.outcomes.Record(projectID, domain.OutcomeInvalid, reason, category, 1)
+}
+
+// readLine reads the next envelope line without the line break, ok is false at the end of the envelope.
+func readLine(reader *bufio.Reader) (string, bool) {
+	line, err := reader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", false
+	}
+
+	return strings.TrimRight(line, "\r\n"), true
+}
+
+func headerString(header map[string]any, key, defaultValue string) string {
+	value, ok := header[key].(string)
+	if !ok || value == "" {
+		return defaultValue
+	}
+
+	return value
+}
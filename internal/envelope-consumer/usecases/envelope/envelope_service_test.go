@@ -3,6 +3,7 @@ package envelope
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -19,7 +20,14 @@ func TestNew(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Verify the service was created correctly
 	require.NotNil(t, service)
@@ -37,7 +45,14 @@ func TestProcessEnvelopeFromBytes_Success(t *testing.T) {
 		Return(domain.EventID("event-123"), nil)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data
 	envelopeData := `{"version": "1.0"}
@@ -61,7 +76,14 @@ func TestProcessEnvelopeFromBytes_EmptyEnvelope(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Call ProcessEnvelopeFromBytes with empty data
 	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte{})
@@ -81,7 +103,14 @@ func TestProcessEnvelopeFromBytes_InvalidHeader(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with an invalid header
 	envelopeData := `invalid json
@@ -106,7 +135,14 @@ func TestProcessEnvelopeFromBytes_InvalidItemHeader(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with an invalid item header
 	envelopeData := `{"version": "1.0"}
@@ -130,7 +166,14 @@ func TestProcessEnvelopeFromBytes_MissingTypeField(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with a missing type field
 	envelopeData := `{"version": "1.0"}
@@ -154,7 +197,14 @@ func TestProcessEnvelopeFromBytes_MissingLengthField(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with missing length field
 	envelopeData := `{"version": "1.0"}
@@ -178,7 +228,14 @@ func TestProcessEnvelopeFromBytes_InvalidEventData(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with invalid event data
 	envelopeData := `{"version": "1.0"}
@@ -207,7 +264,14 @@ func TestProcessEnvelopeFromBytes_ProcessEventError(t *testing.T) {
 		Return(domain.EventID(""), expectedError)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data
 	envelopeData := `{"version": "1.0"}
@@ -217,13 +281,75 @@ func TestProcessEnvelopeFromBytes_ProcessEventError(t *testing.T) {
 	// Call ProcessEnvelopeFromBytes
 	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
 
-	// Verify no error (process event errors are logged but don't fail the envelope)
-	require.NoError(t, err)
+	// Verify the error fails the envelope to be retried
+	require.ErrorIs(t, err, expectedError)
 
 	// Verify the mock was called
 	mockEventUseCase.AssertExpectations(t)
 }
 
+func TestProcessEnvelopeFromBytes_FailedItemsOnly(t *testing.T) {
+	t.Parallel()
+
+	// Create mocks
+	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
+	mockTransactionUseCase := mockcontract.NewMockTransactionUseCase(t)
+
+	// The first event is stored, the transaction and the second event fail
+	expectedError := errors.New("store error")
+	mockEventUseCase.EXPECT().StoreEvent(mock.Anything, domain.ProjectID(1), map[string]any{"data": "test1"}).
+		Return(domain.EventID("event-1"), nil).Once()
+	mockEventUseCase.EXPECT().StoreEvent(mock.Anything, domain.ProjectID(1), map[string]any{"data": "test2"}).
+		Return(domain.EventID(""), expectedError).Once()
+	mockTransactionUseCase.EXPECT().
+		ProcessTransaction(mock.Anything, domain.ProjectID(1), map[string]any{"transaction": "GET /"}).
+		Return(domain.EventID(""), expectedError).Once()
+
+	// Create a new EnvelopeService
+	service := New(
+		mockEventUseCase,
+		mockTransactionUseCase,
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
+
+	// Create envelope data with multiple items
+	envelopeData := `{"version": "1.0"}
+{"type": "event", "length": 17}
+{"data": "test1"}
+{"type": "transaction", "length": 24}
+{"transaction": "GET /"}
+{"type": "event", "length": 17}
+{"data": "test2"}`
+
+	// Call ProcessEnvelopeFromBytes
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+	require.ErrorIs(t, err, expectedError)
+
+	// Verify the envelope to retry has the failed items only
+	var itemsErr *domain.EnvelopeItemsError
+	require.ErrorAs(t, err, &itemsErr)
+	require.Equal(t, `{"version": "1.0"}
+{"type": "transaction", "length": 24}
+{"transaction": "GET /"}
+{"type": "event", "length": 17}
+{"data": "test2"}
+`, string(itemsErr.Envelope))
+
+	// Processing it again doesn't store the first event twice
+	mockEventUseCase.EXPECT().StoreEvent(mock.Anything, domain.ProjectID(1), map[string]any{"data": "test2"}).
+		Return(domain.EventID("event-2"), nil).Once()
+	mockTransactionUseCase.EXPECT().
+		ProcessTransaction(mock.Anything, domain.ProjectID(1), map[string]any{"transaction": "GET /"}).
+		Return(domain.EventID("tx-1"), nil).Once()
+
+	err = service.ProcessEnvelopeFromBytes(context.Background(), 1, itemsErr.Envelope)
+	require.NoError(t, err)
+	mockEventUseCase.AssertNumberOfCalls(t, "StoreEvent", 3)
+}
+
 func TestProcessEnvelopeFromBytes_UnsupportedItemType(t *testing.T) {
 	t.Parallel()
 
@@ -231,7 +357,14 @@ func TestProcessEnvelopeFromBytes_UnsupportedItemType(t *testing.T) {
 	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with an unsupported item type
 	envelopeData := `{"version": "1.0"}
@@ -261,7 +394,14 @@ func TestProcessEnvelopeFromBytes_MultipleItems(t *testing.T) {
 		Return(domain.EventID("event-2"), nil).Once()
 
 	// Create a new EnvelopeService
-	service := New(mockEventUseCase)
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
 
 	// Create envelope data with multiple events
 	envelopeData := `{"version": "1.0"}
@@ -280,3 +420,251 @@ func TestProcessEnvelopeFromBytes_MultipleItems(t *testing.T) {
 	mockEventUseCase.AssertExpectations(t)
 	mockEventUseCase.AssertNumberOfCalls(t, "StoreEvent", 2)
 }
+
+func TestProcessEnvelopeFromBytes_Transaction(t *testing.T) {
+	t.Parallel()
+
+	// Create mocks
+	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
+	mockTransactionUseCase := mockcontract.NewMockTransactionUseCase(t)
+
+	// Set up the mock to return success when ProcessTransaction is called
+	mockTransactionUseCase.EXPECT().
+		ProcessTransaction(mock.Anything, domain.ProjectID(1), map[string]any{"transaction": "GET /"}).
+		Return(domain.EventID("tx-1"), nil)
+
+	// Create a new EnvelopeService
+	service := New(
+		mockEventUseCase,
+		mockTransactionUseCase,
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
+
+	// Create envelope data with a transaction
+	envelopeData := `{"version": "1.0"}
+{"type": "transaction", "length": 24}
+{"transaction": "GET /"}`
+
+	// Call ProcessEnvelopeFromBytes
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+
+	// Verify no error
+	require.NoError(t, err)
+
+	// Verify the events use case was not called
+	mockEventUseCase.AssertNotCalled(t, "StoreEvent")
+}
+
+func TestProcessEnvelopeFromBytes_Sessions(t *testing.T) {
+	t.Parallel()
+
+	// Create mocks
+	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
+	mockSessionUseCase := mockcontract.NewMockSessionUseCase(t)
+
+	// Set up the mock to return success for both session item types
+	mockSessionUseCase.EXPECT().
+		ProcessSession(mock.Anything, domain.ProjectID(1), map[string]any{"status": "ok"}).
+		Return(nil)
+	mockSessionUseCase.EXPECT().
+		ProcessSessionAggregates(mock.Anything, domain.ProjectID(1), map[string]any{"aggregates": []any{}}).
+		Return(nil)
+
+	// Create a new EnvelopeService
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockSessionUseCase,
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
+
+	// Create envelope data with a session update and session aggregates
+	envelopeData := `{"version": "1.0"}
+{"type": "session", "length": 16}
+{"status": "ok"}
+{"type": "sessions", "length": 19}
+{"aggregates": []}`
+
+	// Call ProcessEnvelopeFromBytes
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+
+	// Verify no error
+	require.NoError(t, err)
+
+	// Verify the events use case was not called
+	mockEventUseCase.AssertNotCalled(t, "StoreEvent")
+}
+
+func TestProcessEnvelopeFromBytes_Attachment(t *testing.T) {
+	t.Parallel()
+
+	// Create mocks
+	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
+	mockAttachmentUseCase := mockcontract.NewMockAttachmentUseCase(t)
+
+	// Binary payload with line breaks must be passed through byte-exact
+	payload := []byte("line1\r\n\x00\nline2")
+
+	mockEventUseCase.EXPECT().
+		StoreEvent(mock.Anything, domain.ProjectID(1), map[string]any{"message": "boom"}).
+		Return("evt-1", nil)
+	mockAttachmentUseCase.EXPECT().
+		StoreAttachment(mock.Anything, domain.AttachmentDTO{
+			ProjectID:   1,
+			EventID:     "evt-1",
+			Name:        "minidump.dmp",
+			ContentType: domain.DefaultAttachmentContentType,
+			Type:        domain.AttachmentTypeMinidump,
+		}, payload).
+		Return(domain.Attachment{ID: 1}, nil)
+
+	// Create a new EnvelopeService
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockAttachmentUseCase,
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
+
+	// Create envelope data with an attachment between two items
+	envelopeData := `{"event_id": "evt-1"}
+{"type": "attachment", "length": 14, "filename": "minidump.dmp", "attachment_type": "event.minidump"}
+` + string(payload) + `
+{"type": "event", "length": 19}
+{"message": "boom"}`
+
+	// Call ProcessEnvelopeFromBytes
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+
+	// Verify no error
+	require.NoError(t, err)
+}
+
+func TestProcessEnvelopeFromBytes_AttachmentStoreError(t *testing.T) {
+	t.Parallel()
+
+	// Create mocks
+	mockEventUseCase := mockcontract.NewMockStoreEventUseCase(t)
+	mockAttachmentUseCase := mockcontract.NewMockAttachmentUseCase(t)
+
+	payload := []byte("line1\r\n\x00\nline2")
+	attachmentDTO := domain.AttachmentDTO{
+		ProjectID:   1,
+		EventID:     "evt-1",
+		Name:        "minidump.dmp",
+		ContentType: domain.DefaultAttachmentContentType,
+		Type:        domain.AttachmentTypeMinidump,
+	}
+
+	// The event is stored, the attachment fails
+	expectedError := errors.New("blob store unavailable")
+	mockEventUseCase.EXPECT().
+		StoreEvent(mock.Anything, domain.ProjectID(1), map[string]any{"message": "boom"}).
+		Return("evt-1", nil).Once()
+	mockAttachmentUseCase.EXPECT().StoreAttachment(mock.Anything, attachmentDTO, payload).
+		Return(domain.Attachment{}, expectedError).Once()
+
+	// Create a new EnvelopeService
+	service := New(
+		mockEventUseCase,
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockAttachmentUseCase,
+		mockcontract.NewMockMonitorUseCase(t),
+		newOutcomeRecorder(t),
+	)
+
+	envelopeData := `{"event_id": "evt-1"}
+{"type": "event", "length": 19}
+{"message": "boom"}
+{"type": "attachment", "length": 14, "filename": "minidump.dmp", "attachment_type": "event.minidump"}
+` + string(payload)
+
+	// Call ProcessEnvelopeFromBytes
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+	require.ErrorIs(t, err, expectedError)
+
+	// Verify the envelope to retry has the attachment only
+	var itemsErr *domain.EnvelopeItemsError
+	require.ErrorAs(t, err, &itemsErr)
+	require.Equal(t, `{"event_id": "evt-1"}
+{"type": "attachment", "length": 14, "filename": "minidump.dmp", "attachment_type": "event.minidump"}
+`+string(payload)+"\n", string(itemsErr.Envelope))
+
+	// Processing it again stores the attachment without the event
+	mockAttachmentUseCase.EXPECT().StoreAttachment(mock.Anything, attachmentDTO, payload).
+		Return(domain.Attachment{ID: 1}, nil).Once()
+
+	err = service.ProcessEnvelopeFromBytes(context.Background(), 1, itemsErr.Envelope)
+	require.NoError(t, err)
+	mockEventUseCase.AssertNumberOfCalls(t, "StoreEvent", 1)
+}
+
+func TestProcessEnvelopeFromBytes_CheckIn(t *testing.T) {
+	t.Parallel()
+
+	mockMonitorUseCase := mockcontract.NewMockMonitorUseCase(t)
+	mockMonitorUseCase.EXPECT().
+		ProcessCheckIn(mock.Anything, domain.ProjectID(1), mock.MatchedBy(func(data map[string]any) bool {
+			return data["monitor_slug"] == "nightly" && data["status"] == "ok"
+		})).
+		Return(nil)
+
+	service := New(
+		mockcontract.NewMockStoreEventUseCase(t),
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockMonitorUseCase,
+		newOutcomeRecorder(t),
+	)
+
+	envelopeData := `{}
+{"type": "check_in", "length": 43}
+{"monitor_slug": "nightly", "status": "ok"}`
+
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+	require.NoError(t, err)
+}
+
+func TestProcessEnvelopeFromBytes_ClientReport(t *testing.T) {
+	t.Parallel()
+
+	mockOutcomes := mockcontract.NewMockOutcomeRecorder(t)
+	mockOutcomes.EXPECT().
+		Record(domain.ProjectID(1), domain.OutcomeClientDiscard, "queue_overflow", domain.DataCategoryError, uint(23)).
+		Return()
+
+	service := New(
+		mockcontract.NewMockStoreEventUseCase(t),
+		mockcontract.NewMockTransactionUseCase(t),
+		mockcontract.NewMockSessionUseCase(t),
+		mockcontract.NewMockAttachmentUseCase(t),
+		mockcontract.NewMockMonitorUseCase(t),
+		mockOutcomes,
+	)
+
+	report := `{"discarded_events":[{"reason":"queue_overflow","category":"error","quantity":23}]}`
+	envelopeData := "{}\n" + `{"type":"client_report","length":` + strconv.Itoa(len(report)) + "}\n" + report
+
+	err := service.ProcessEnvelopeFromBytes(context.Background(), 1, []byte(envelopeData))
+	require.NoError(t, err)
+}
+
+// newOutcomeRecorder returns a recorder mock accepting any outcome.
+func newOutcomeRecorder(t *testing.T) *mockcontract.MockOutcomeRecorder {
+	t.Helper()
+
+	recorder := mockcontract.NewMockOutcomeRecorder(t)
+	recorder.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return().Maybe()
+
+	return recorder
+}
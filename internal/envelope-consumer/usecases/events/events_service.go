@@ -2,10 +2,13 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"time"
 
+	commonconfig "github.com/rom8726/warden/internal/common/config"
 	eventcommon "github.com/rom8726/warden/internal/common/event"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/envelope-consumer/contract"
@@ -21,6 +24,10 @@ type EventService struct {
 	notificationsQueueRepo contract.NotificationsQueueRepository
 	issueReleasesRepo      contract.IssueReleasesRepository
 	cacheService           contract.CacheService
+	projectSettings        contract.ProjectSettingsService
+	issueFingerprintsRepo  contract.IssueFingerprintsRepository
+	environments           contract.EnvironmentsService
+	maxPayloadSize         int
 }
 
 func New(
@@ -31,6 +38,10 @@ func New(
 	notificationsQueueRepo contract.NotificationsQueueRepository,
 	issueReleasesRepo contract.IssueReleasesRepository,
 	cacheService contract.CacheService,
+	projectSettings contract.ProjectSettingsService,
+	issueFingerprintsRepo contract.IssueFingerprintsRepository,
+	environments contract.EnvironmentsService,
+	cfg *commonconfig.Events,
 ) *EventService {
 	return &EventService{
 		txManager:              txManager,
@@ -40,10 +51,15 @@ func New(
 		notificationsQueueRepo: notificationsQueueRepo,
 		issueReleasesRepo:      issueReleasesRepo,
 		cacheService:           cacheService,
+		projectSettings:        projectSettings,
+		issueFingerprintsRepo:  issueFingerprintsRepo,
+		environments:           environments,
+		maxPayloadSize:         cfg.MaxPayloadSize,
 	}
 }
 
 // ProcessEvent processes an event from the Sentry SDK.
+// The event is stored once its issue is committed, an EventStoreError is returned when storing it failed.
 //
 //nolint:gocyclo // need refactoring
 func (s *EventService) ProcessEvent(
@@ -55,25 +71,55 @@ func (s *EventService) ProcessEvent(
 	start := time.Now()
 	projectIDStr := strconv.FormatUint(uint64(projectID), 10)
 
-	event, err := eventcommon.ParseEvent(eventData, projectID)
+	// Project grouping strategy and rules are applied before the issue is upserted
+	groupingConfig, err := s.projectSettings.GroupingConfig(ctx, projectID)
 	if err != nil {
-		return "", fmt.Errorf("parse event: %w", err)
+		return "", fmt.Errorf("get grouping config: %w", err)
+	}
+
+	// Sensitive data is removed before anything of the event is stored
+	dataScrubber, err := s.projectSettings.DataScrubber(ctx, projectID)
+	if err != nil {
+		return "", fmt.Errorf("get data scrubber: %w", err)
+	}
+
+	dataScrubber.Scrub(eventData)
+
+	event, err := eventcommon.ParseEvent(eventData, projectID, groupingConfig)
+	if err != nil {
+		return "", fmt.Errorf("parse event: %w: %w", domain.ErrInvalidEvent, err)
+	}
+
+	// The event is stored without its raw payload when the payload could exceed the Kafka message size
+	if s.maxPayloadSize > 0 && len(event.Payload) > s.maxPayloadSize {
+		slog.Warn("event payload is too large to be stored, dropping it",
+			"project_id", projectID, "event_id", event.ID, "size", len(event.Payload))
+
+		event.Payload = nil
 	}
 
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		// Store the event with fingerprints
-		if err := s.eventRepo.StoreWithFingerprints(ctx, &event); err != nil {
-			return fmt.Errorf("store event: %w", err)
+		// Fingerprints merged into another issue are routed to it
+		issueFingerprint, err := s.issueFingerprint(ctx, projectID, event.GroupHash)
+		if err != nil {
+			return err
+		}
+
+		// The release is created before the issue, it decides whether a resolved issue is reactivated
+		releaseID, err := s.cacheService.GetOrCreateRelease(ctx, projectID, event.Release, s.releaseRepo)
+		if err != nil {
+			return fmt.Errorf("get or create release: %w", err)
 		}
 
 		issue := domain.IssueDTO{
 			ProjectID:   projectID,
-			Fingerprint: event.GroupHash,
+			Fingerprint: issueFingerprint,
 			Source:      event.Source,
 			Status:      domain.IssueStatusUnresolved,
 			Title:       event.Message,
 			Level:       event.Level,
 			Platform:    event.Platform,
+			ReleaseID:   releaseID,
 		}
 
 		// Start timing for UpsertIssue operation
@@ -96,12 +142,6 @@ func (s *EventService) ProcessEvent(
 		}
 		metrics.UpsertIssueTotal.WithLabelValues(projectIDStr, status).Inc()
 
-		// Create or get a release using cache
-		releaseID, err := s.cacheService.GetOrCreateRelease(ctx, projectID, event.Release, s.releaseRepo)
-		if err != nil {
-			return fmt.Errorf("get or create release: %w", err)
-		}
-
 		// Create or get issue_release using cache
 		err = s.cacheService.GetOrCreateIssueRelease(
 			ctx,
@@ -121,6 +161,7 @@ func (s *EventService) ProcessEvent(
 				upsertRes.ID,
 				issue.Level,
 				upsertRes.IsNew, upsertRes.WasReactivated,
+				event.Environment,
 			)
 			if err != nil {
 				return fmt.Errorf("add notification: %w", err)
@@ -130,12 +171,52 @@ func (s *EventService) ProcessEvent(
 		return nil
 	})
 	if err != nil {
-		return "", fmt.Errorf("store event and issue: %w", err)
+		return "", fmt.Errorf("store issue: %w", err)
+	}
+
+	// The event is stored even if its environment is not registered
+	if event.Environment != "" {
+		if err := s.environments.Touch(ctx, projectID, event.Environment); err != nil {
+			slog.Error("touch environment failed", "error", err, "project_id", projectID)
+		}
+	}
+
+	// The event is produced to ClickHouse after the issue is committed, a failed issue never stores it twice
+	if err := s.StoreEvent(ctx, &event); err != nil {
+		return "", &domain.EventStoreError{Event: event, Err: err}
 	}
 
 	// Record overall processing time
 	metrics.ProcessingTime.WithLabelValues("event").Observe(time.Since(start).Seconds())
-	metrics.EventsProcessed.WithLabelValues(projectIDStr).Inc()
 
 	return event.ID, nil
 }
+
+// StoreEvent stores an event whose issue is already committed.
+func (s *EventService) StoreEvent(ctx context.Context, event *domain.Event) error {
+	if err := s.eventRepo.StoreWithFingerprints(ctx, event); err != nil {
+		return fmt.Errorf("store event: %w", err)
+	}
+
+	metrics.EventsProcessed.WithLabelValues(event.ProjectID.String()).Inc()
+
+	return nil
+}
+
+// issueFingerprint returns the fingerprint of the issue the event belongs to.
+func (s *EventService) issueFingerprint(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprint string,
+) (string, error) {
+	issueFingerprint, err := s.issueFingerprintsRepo.GetIssueFingerprint(ctx, projectID, fingerprint)
+	if err != nil {
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return fingerprint, nil
+		}
+
+		return "", fmt.Errorf("get merged issue fingerprint: %w", err)
+	}
+
+	return issueFingerprint, nil
+}
@@ -3,12 +3,15 @@ package events
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/rom8726/warden/internal/common/config"
+	"github.com/rom8726/warden/internal/common/datascrubbing"
 	"github.com/rom8726/warden/internal/domain"
 	mockcontract "github.com/rom8726/warden/test_mocks/internal_/envelope-consumer/contract"
 	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
@@ -25,6 +28,8 @@ func TestNew(t *testing.T) {
 	mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
 	mockIssueReleaseRepo := mockcontract.NewMockIssueReleasesRepository(t)
 	cacheService := mockcontract.NewMockCacheService(t)
+	projectSettings := mockcontract.NewMockProjectSettingsService(t)
+	issueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
 
 	// Create service
 	service := New(
@@ -35,6 +40,10 @@ func TestNew(t *testing.T) {
 		mockNotificationsQueueRepo,
 		mockIssueReleaseRepo,
 		cacheService,
+		projectSettings,
+		issueFingerprintsRepo,
+		mockcontract.NewMockEnvironmentsService(t),
+		&commonconfig.Events{MaxPayloadSize: 1 << 20},
 	)
 	// Verify service was created correctly
 	require.NotNil(t, service)
@@ -208,9 +217,10 @@ func TestProcessEvent(t *testing.T) {
 		{
 			name: "New issue triggers notification",
 			eventData: map[string]any{
-				"event_id": "test-event-id",
-				"message":  "Test message",
-				"level":    "error",
+				"event_id":    "test-event-id",
+				"message":     "Test message",
+				"level":       "error",
+				"environment": "staging",
 			},
 			setupMocks: func(
 				mockTxManager *mockdb.MockTxManager,
@@ -260,7 +270,8 @@ func TestProcessEvent(t *testing.T) {
 					domain.IssueID(123),
 					domain.IssueLevel("error"),
 					true,
-					false).
+					false,
+					"staging").
 					Return(nil)
 			},
 			expectedEventID: "test-event-id",
@@ -281,11 +292,18 @@ func TestProcessEvent(t *testing.T) {
 				mockIssueReleaseRepo *mockcontract.MockIssueReleasesRepository,
 				mockCacheService *mockcontract.MockCacheService,
 			) {
-				// Setup transaction to return error
+				// The issue is committed before the event is stored
 				mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.AnythingOfType("func(context.Context) error")).
-					Run(func(ctx context.Context, fn func(context.Context) error) {
-						_ = fn(ctx)
-					}).Return(errors.New("store event and issue: store event: database error"))
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockIssueRepo.EXPECT().UpsertIssue(mock.Anything, mock.AnythingOfType("domain.IssueDTO")).
+					Return(domain.IssueUpsertResult{ID: 123}, nil)
+				mockCacheService.EXPECT().GetOrCreateRelease(mock.Anything, domain.ProjectID(1), "unknown", mock.Anything).
+					Return(domain.ReleaseID(1), nil)
+				mockCacheService.EXPECT().
+					GetOrCreateIssueRelease(mock.Anything, domain.IssueID(123), domain.ReleaseID(1), false, mock.Anything).
+					Return(nil)
 
 				// Setup event repo to return error
 				mockEventRepo.EXPECT().StoreWithFingerprints(mock.Anything, mock.AnythingOfType("*domain.Event")).
@@ -293,7 +311,36 @@ func TestProcessEvent(t *testing.T) {
 			},
 			expectedEventID:     "",
 			expectedError:       true,
-			expectedErrorString: "store event and issue: store event and issue: store event: database error",
+			expectedErrorString: "store event: database error",
+		},
+		{
+			name: "Error storing issue",
+			eventData: map[string]any{
+				"event_id": "test-event-id",
+				"message":  "Test message",
+			},
+			setupMocks: func(
+				mockTxManager *mockdb.MockTxManager,
+				mockIssueRepo *mockcontract.MockIssuesRepository,
+				mockEventRepo *mockcontract.MockEventRepository,
+				mockNotificationsQueueRepo *mockcontract.MockNotificationsQueueRepository,
+				mockReleaseRepo *mockcontract.MockReleaseRepository,
+				mockIssueReleaseRepo *mockcontract.MockIssueReleasesRepository,
+				mockCacheService *mockcontract.MockCacheService,
+			) {
+				// The event is not stored when its issue failed
+				mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+					RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockCacheService.EXPECT().GetOrCreateRelease(mock.Anything, domain.ProjectID(1), "unknown", mock.Anything).
+					Return(domain.ReleaseID(1), nil)
+				mockIssueRepo.EXPECT().UpsertIssue(mock.Anything, mock.AnythingOfType("domain.IssueDTO")).
+					Return(domain.IssueUpsertResult{}, errors.New("database error"))
+			},
+			expectedEventID:     "",
+			expectedError:       true,
+			expectedErrorString: "store issue: upsert issue: database error",
 		},
 	}
 
@@ -309,6 +356,23 @@ func TestProcessEvent(t *testing.T) {
 			mockReleaseRepo := mockcontract.NewMockReleaseRepository(t)
 			mockIssueReleaseRepo := mockcontract.NewMockIssueReleasesRepository(t)
 			cacheService := mockcontract.NewMockCacheService(t)
+			projectSettings := mockcontract.NewMockProjectSettingsService(t)
+			projectSettings.EXPECT().
+				GroupingConfig(mock.Anything, domain.ProjectID(1)).
+				Return(domain.GroupingConfig{Strategy: domain.DefaultGroupingStrategy}, nil)
+			projectSettings.EXPECT().
+				DataScrubber(mock.Anything, domain.ProjectID(1)).
+				Return(nil, nil)
+			issueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+			issueFingerprintsRepo.EXPECT().
+				GetIssueFingerprint(mock.Anything, domain.ProjectID(1), mock.Anything).
+				Return("", domain.ErrEntityNotFound).
+				Maybe()
+			environments := mockcontract.NewMockEnvironmentsService(t)
+			environments.EXPECT().
+				Touch(mock.Anything, domain.ProjectID(1), mock.AnythingOfType("string")).
+				Return(nil).
+				Maybe()
 
 			// Setup mocks
 			tt.setupMocks(
@@ -330,6 +394,10 @@ func TestProcessEvent(t *testing.T) {
 				mockNotificationsQueueRepo,
 				mockIssueReleaseRepo,
 				cacheService,
+				projectSettings,
+				issueFingerprintsRepo,
+				environments,
+				&commonconfig.Events{MaxPayloadSize: 1 << 20},
 			)
 
 			// Call the method
@@ -349,3 +417,226 @@ func TestProcessEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessEvent_GroupingConfigError(t *testing.T) {
+	t.Parallel()
+
+	projectSettings := mockcontract.NewMockProjectSettingsService(t)
+	projectSettings.EXPECT().
+		GroupingConfig(mock.Anything, domain.ProjectID(1)).
+		Return(domain.GroupingConfig{}, errors.New("project not found"))
+
+	service := New(
+		mockdb.NewMockTxManager(t),
+		mockcontract.NewMockIssuesRepository(t),
+		mockcontract.NewMockEventRepository(t),
+		mockcontract.NewMockReleaseRepository(t),
+		mockcontract.NewMockNotificationsQueueRepository(t),
+		mockcontract.NewMockIssueReleasesRepository(t),
+		mockcontract.NewMockCacheService(t),
+		projectSettings,
+		mockcontract.NewMockIssueFingerprintsRepository(t),
+		mockcontract.NewMockEnvironmentsService(t),
+		&commonconfig.Events{MaxPayloadSize: 1 << 20},
+	)
+
+	eventID, err := service.ProcessEvent(context.Background(), 1, map[string]any{"event_id": "1"})
+	require.ErrorContains(t, err, "get grouping config: project not found")
+	require.Empty(t, eventID)
+}
+
+func TestProcessEvent_MergedFingerprint(t *testing.T) {
+	t.Parallel()
+
+	mockTxManager := mockdb.NewMockTxManager(t)
+	mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+	mockEventRepo := mockcontract.NewMockEventRepository(t)
+	cacheService := mockcontract.NewMockCacheService(t)
+	projectSettings := mockcontract.NewMockProjectSettingsService(t)
+	issueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+	environments := mockcontract.NewMockEnvironmentsService(t)
+
+	projectSettings.EXPECT().
+		GroupingConfig(mock.Anything, domain.ProjectID(1)).
+		Return(domain.GroupingConfig{Strategy: domain.DefaultGroupingStrategy}, nil)
+	projectSettings.EXPECT().
+		DataScrubber(mock.Anything, domain.ProjectID(1)).
+		Return(nil, nil)
+	mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	mockEventRepo.EXPECT().StoreWithFingerprints(mock.Anything, mock.AnythingOfType("*domain.Event")).Return(nil)
+
+	// The event fingerprint was merged into another issue
+	issueFingerprintsRepo.EXPECT().
+		GetIssueFingerprint(mock.Anything, domain.ProjectID(1), mock.AnythingOfType("string")).
+		Return("primary-fingerprint", nil)
+	mockIssueRepo.EXPECT().
+		UpsertIssue(mock.Anything, mock.MatchedBy(func(issue domain.IssueDTO) bool {
+			return issue.Fingerprint == "primary-fingerprint" && issue.ReleaseID == 1
+		})).
+		Return(domain.IssueUpsertResult{ID: 10}, nil)
+	cacheService.EXPECT().GetOrCreateRelease(mock.Anything, domain.ProjectID(1), "unknown", mock.Anything).
+		Return(domain.ReleaseID(1), nil)
+	cacheService.EXPECT().GetOrCreateIssueRelease(mock.Anything, domain.IssueID(10), domain.ReleaseID(1), false, mock.Anything).
+		Return(nil)
+
+	// Events without an environment are stored in the "unknown" one
+	environments.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "unknown").Return(nil)
+
+	service := New(
+		mockTxManager,
+		mockIssueRepo,
+		mockEventRepo,
+		mockcontract.NewMockReleaseRepository(t),
+		mockcontract.NewMockNotificationsQueueRepository(t),
+		mockcontract.NewMockIssueReleasesRepository(t),
+		cacheService,
+		projectSettings,
+		issueFingerprintsRepo,
+		environments,
+		&commonconfig.Events{MaxPayloadSize: 1 << 20},
+	)
+
+	eventID, err := service.ProcessEvent(context.Background(), 1, map[string]any{
+		"event_id": "test-event-id",
+		"message":  "Test message",
+		"level":    "warning",
+	})
+	require.NoError(t, err)
+	require.Equal(t, domain.EventID("test-event-id"), eventID)
+}
+
+func TestProcessEvent_ScrubsSensitiveData(t *testing.T) {
+	t.Parallel()
+
+	scrubber, err := datascrubbing.Compile(domain.DataScrubbing{DefaultScrubbers: true, DropRequestCookies: true})
+	require.NoError(t, err)
+
+	mockTxManager := mockdb.NewMockTxManager(t)
+	mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+	mockEventRepo := mockcontract.NewMockEventRepository(t)
+	cacheService := mockcontract.NewMockCacheService(t)
+	projectSettings := mockcontract.NewMockProjectSettingsService(t)
+	issueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+	environments := mockcontract.NewMockEnvironmentsService(t)
+
+	projectSettings.EXPECT().
+		GroupingConfig(mock.Anything, domain.ProjectID(1)).
+		Return(domain.GroupingConfig{Strategy: domain.DefaultGroupingStrategy}, nil)
+	projectSettings.EXPECT().
+		DataScrubber(mock.Anything, domain.ProjectID(1)).
+		Return(scrubber, nil)
+	mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	mockEventRepo.EXPECT().
+		StoreWithFingerprints(mock.Anything, mock.MatchedBy(func(event *domain.Event) bool {
+			return event.RequestCookies == nil &&
+				event.RequestHeaders["Authorization"] == datascrubbing.Filtered &&
+				!strings.Contains(string(event.Payload), "hunter2")
+		})).
+		Return(nil)
+	issueFingerprintsRepo.EXPECT().
+		GetIssueFingerprint(mock.Anything, domain.ProjectID(1), mock.AnythingOfType("string")).
+		Return("", domain.ErrEntityNotFound)
+	mockIssueRepo.EXPECT().UpsertIssue(mock.Anything, mock.Anything).
+		Return(domain.IssueUpsertResult{ID: 10}, nil)
+	cacheService.EXPECT().GetOrCreateRelease(mock.Anything, domain.ProjectID(1), "unknown", mock.Anything).
+		Return(domain.ReleaseID(1), nil)
+	cacheService.EXPECT().
+		GetOrCreateIssueRelease(mock.Anything, domain.IssueID(10), domain.ReleaseID(1), false, mock.Anything).
+		Return(nil)
+
+	environments.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "unknown").Return(nil)
+
+	service := New(
+		mockTxManager,
+		mockIssueRepo,
+		mockEventRepo,
+		mockcontract.NewMockReleaseRepository(t),
+		mockcontract.NewMockNotificationsQueueRepository(t),
+		mockcontract.NewMockIssueReleasesRepository(t),
+		cacheService,
+		projectSettings,
+		issueFingerprintsRepo,
+		environments,
+		&commonconfig.Events{MaxPayloadSize: 1 << 20},
+	)
+
+	_, err = service.ProcessEvent(context.Background(), 1, map[string]any{
+		"event_id": "test-event-id",
+		"message":  "Login failed",
+		"level":    "warning",
+		"request": map[string]any{
+			"cookies": "sessionid=abc",
+			"headers": map[string]any{"Authorization": "Bearer abc"},
+			"data":    map[string]any{"password": "hunter2"},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestProcessEvent_DropsTooLargePayload(t *testing.T) {
+	t.Parallel()
+
+	mockTxManager := mockdb.NewMockTxManager(t)
+	mockIssueRepo := mockcontract.NewMockIssuesRepository(t)
+	mockEventRepo := mockcontract.NewMockEventRepository(t)
+	cacheService := mockcontract.NewMockCacheService(t)
+	projectSettings := mockcontract.NewMockProjectSettingsService(t)
+	issueFingerprintsRepo := mockcontract.NewMockIssueFingerprintsRepository(t)
+	environments := mockcontract.NewMockEnvironmentsService(t)
+
+	projectSettings.EXPECT().
+		GroupingConfig(mock.Anything, domain.ProjectID(1)).
+		Return(domain.GroupingConfig{Strategy: domain.DefaultGroupingStrategy}, nil)
+	projectSettings.EXPECT().
+		DataScrubber(mock.Anything, domain.ProjectID(1)).
+		Return(nil, nil)
+	mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	mockEventRepo.EXPECT().
+		StoreWithFingerprints(mock.Anything, mock.MatchedBy(func(event *domain.Event) bool {
+			return event.Payload == nil && event.Message == "Large event"
+		})).
+		Return(nil)
+	issueFingerprintsRepo.EXPECT().
+		GetIssueFingerprint(mock.Anything, domain.ProjectID(1), mock.AnythingOfType("string")).
+		Return("", domain.ErrEntityNotFound)
+	mockIssueRepo.EXPECT().UpsertIssue(mock.Anything, mock.Anything).
+		Return(domain.IssueUpsertResult{ID: 10}, nil)
+	cacheService.EXPECT().GetOrCreateRelease(mock.Anything, domain.ProjectID(1), "unknown", mock.Anything).
+		Return(domain.ReleaseID(1), nil)
+	cacheService.EXPECT().
+		GetOrCreateIssueRelease(mock.Anything, domain.IssueID(10), domain.ReleaseID(1), false, mock.Anything).
+		Return(nil)
+
+	environments.EXPECT().Touch(mock.Anything, domain.ProjectID(1), "unknown").Return(nil)
+
+	service := New(
+		mockTxManager,
+		mockIssueRepo,
+		mockEventRepo,
+		mockcontract.NewMockReleaseRepository(t),
+		mockcontract.NewMockNotificationsQueueRepository(t),
+		mockcontract.NewMockIssueReleasesRepository(t),
+		cacheService,
+		projectSettings,
+		issueFingerprintsRepo,
+		environments,
+		&commonconfig.Events{MaxPayloadSize: 1024},
+	)
+
+	_, err := service.ProcessEvent(context.Background(), 1, map[string]any{
+		"event_id": "test-event-id",
+		"message":  "Large event",
+		"level":    "error",
+		"extra":    map[string]any{"blob": strings.Repeat("a", 2048)},
+	})
+	require.NoError(t, err)
+}
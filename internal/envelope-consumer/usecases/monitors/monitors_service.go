@@ -0,0 +1,175 @@
+package monitors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	eventcommon "github.com/rom8726/warden/internal/common/event"
+	commonmonitors "github.com/rom8726/warden/internal/common/monitors"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/contract"
+	"github.com/rom8726/warden/pkg/db"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+type MonitorService struct {
+	txManager              db.TxManager
+	monitorsRepo           contract.MonitorsRepository
+	issuesRepo             contract.IssuesRepository
+	notificationsQueueRepo contract.NotificationsQueueRepository
+}
+
+func New(
+	txManager db.TxManager,
+	monitorsRepo contract.MonitorsRepository,
+	issuesRepo contract.IssuesRepository,
+	notificationsQueueRepo contract.NotificationsQueueRepository,
+) *MonitorService {
+	return &MonitorService{
+		txManager:              txManager,
+		monitorsRepo:           monitorsRepo,
+		issuesRepo:             issuesRepo,
+		notificationsQueueRepo: notificationsQueueRepo,
+	}
+}
+
+// ProcessCheckIn stores a check-in of a cron monitor and raises an incident if the job failed.
+// The monitor is created or updated when the check-in carries a monitor config.
+func (s *MonitorService) ProcessCheckIn(ctx context.Context, projectID domain.ProjectID, data map[string]any) error {
+	start := time.Now()
+
+	checkIn, err := eventcommon.ParseCheckIn(data)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidCheckIn, err)
+	}
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		monitor, err := s.getOrUpsertMonitor(ctx, projectID, checkIn)
+		if err != nil {
+			return err
+		}
+
+		stored, err := s.monitorsRepo.UpsertCheckIn(ctx, domain.MonitorCheckInDTO{
+			MonitorID:   monitor.ID,
+			CheckInID:   checkIn.ID,
+			Status:      checkIn.Status,
+			Duration:    checkIn.Duration,
+			Environment: checkIn.Environment,
+			ExpectedAt:  monitor.NextCheckInAt,
+		})
+		if err != nil {
+			return fmt.Errorf("upsert check-in: %w", err)
+		}
+
+		// The check-in was already finished, e.g. it timed out before the job reported its result
+		if stored.Status != checkIn.Status {
+			slog.Warn("Check-in is already finished",
+				"monitor", monitor.Slug, "check_in_id", checkIn.ID, "status", stored.Status)
+
+			return nil
+		}
+
+		nextCheckInAt, err := commonmonitors.NextCheckIn(monitor, stored.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("next check-in: %w", err)
+		}
+
+		status := monitor.Status
+		switch checkIn.Status {
+		case domain.CheckInStatusOK:
+			status = domain.MonitorStatusOK
+		case domain.CheckInStatusError:
+			status = domain.MonitorStatusError
+		case domain.CheckInStatusInProgress, domain.CheckInStatusMissed, domain.CheckInStatusTimeout:
+		}
+
+		lastCheckInAt := stored.UpdatedAt
+		err = s.monitorsRepo.UpdateState(ctx, monitor.ID, status, &lastCheckInAt, nextCheckInAt)
+		if err != nil {
+			return fmt.Errorf("update monitor state: %w", err)
+		}
+
+		if checkIn.Status == domain.CheckInStatusError {
+			return s.raiseIncident(ctx, monitor, checkIn.Status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.ProcessingTime.WithLabelValues("check_in").Observe(time.Since(start).Seconds())
+
+	return nil
+}
+
+func (s *MonitorService) getOrUpsertMonitor(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	checkIn domain.CheckIn,
+) (domain.Monitor, error) {
+	if checkIn.MonitorConfig == nil {
+		monitor, err := s.monitorsRepo.GetBySlug(ctx, projectID, checkIn.MonitorSlug)
+		if err != nil {
+			if errors.Is(err, domain.ErrEntityNotFound) {
+				return domain.Monitor{}, fmt.Errorf("%w: %s", domain.ErrMonitorNotFound, checkIn.MonitorSlug)
+			}
+
+			return domain.Monitor{}, fmt.Errorf("get monitor: %w", err)
+		}
+
+		return monitor, nil
+	}
+
+	config := *checkIn.MonitorConfig
+	if err := commonmonitors.ValidateConfig(config); err != nil {
+		return domain.Monitor{}, err
+	}
+
+	monitor, err := s.monitorsRepo.Upsert(ctx, domain.MonitorDTO{
+		ProjectID:     projectID,
+		Slug:          checkIn.MonitorSlug,
+		ScheduleType:  config.ScheduleType,
+		Schedule:      config.Schedule,
+		Timezone:      config.Timezone,
+		CheckInMargin: config.CheckInMargin,
+		MaxRuntime:    config.MaxRuntime,
+	})
+	if err != nil {
+		return domain.Monitor{}, fmt.Errorf("upsert monitor: %w", err)
+	}
+
+	return monitor, nil
+}
+
+// raiseIncident reports the check-in as an issue of the monitor, so it's notified like other issues.
+func (s *MonitorService) raiseIncident(ctx context.Context, monitor domain.Monitor, status domain.CheckInStatus) error {
+	issue := monitor.IncidentIssue(status)
+
+	upsertRes, err := s.issuesRepo.UpsertIssue(ctx, issue)
+	if err != nil {
+		return fmt.Errorf("upsert issue: %w", err)
+	}
+
+	metrics.MonitorIncidents.WithLabelValues(monitor.ProjectID.String(), string(status)).Inc()
+
+	if upsertRes.IsNew || upsertRes.WasReactivated {
+		err := s.notificationsQueueRepo.AddNotification(
+			ctx,
+			monitor.ProjectID,
+			upsertRes.ID,
+			issue.Level,
+			upsertRes.IsNew, upsertRes.WasReactivated,
+			"",
+		)
+		if err != nil {
+			return fmt.Errorf("add notification: %w", err)
+		}
+	}
+
+	return nil
+}
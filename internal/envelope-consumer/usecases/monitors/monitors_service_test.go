@@ -0,0 +1,159 @@
+package monitors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/envelope-consumer/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+func TestProcessCheckIn(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 5, 0, time.UTC)
+	monitor := domain.Monitor{
+		ID:           1,
+		ProjectID:    1,
+		Slug:         "nightly",
+		ScheduleType: domain.MonitorScheduleCrontab,
+		Schedule:     "0 * * * *",
+		Timezone:     "UTC",
+		Status:       domain.MonitorStatusOK,
+	}
+	nextCheckInAt := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		data       map[string]any
+		setupMocks func(
+			mockMonitorsRepo *mockcontract.MockMonitorsRepository,
+			mockIssuesRepo *mockcontract.MockIssuesRepository,
+			mockNotificationsQueueRepo *mockcontract.MockNotificationsQueueRepository,
+		)
+		expectedError error
+	}{
+		{
+			name: "OK check-in",
+			data: map[string]any{"monitor_slug": "nightly", "status": "ok"},
+			setupMocks: func(
+				mockMonitorsRepo *mockcontract.MockMonitorsRepository,
+				_ *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockNotificationsQueueRepository,
+			) {
+				mockMonitorsRepo.EXPECT().GetBySlug(mock.Anything, domain.ProjectID(1), "nightly").Return(monitor, nil)
+				mockMonitorsRepo.EXPECT().UpsertCheckIn(mock.Anything, mock.Anything).
+					Return(domain.MonitorCheckIn{Status: domain.CheckInStatusOK, CreatedAt: createdAt}, nil)
+				mockMonitorsRepo.EXPECT().
+					UpdateState(mock.Anything, domain.MonitorID(1), domain.MonitorStatusOK, mock.Anything, nextCheckInAt).
+					Return(nil)
+			},
+		},
+		{
+			name: "Error check-in raises an incident",
+			data: map[string]any{"monitor_slug": "nightly", "status": "error"},
+			setupMocks: func(
+				mockMonitorsRepo *mockcontract.MockMonitorsRepository,
+				mockIssuesRepo *mockcontract.MockIssuesRepository,
+				mockNotificationsQueueRepo *mockcontract.MockNotificationsQueueRepository,
+			) {
+				mockMonitorsRepo.EXPECT().GetBySlug(mock.Anything, domain.ProjectID(1), "nightly").Return(monitor, nil)
+				mockMonitorsRepo.EXPECT().UpsertCheckIn(mock.Anything, mock.Anything).
+					Return(domain.MonitorCheckIn{Status: domain.CheckInStatusError, CreatedAt: createdAt}, nil)
+				mockMonitorsRepo.EXPECT().
+					UpdateState(mock.Anything, domain.MonitorID(1), domain.MonitorStatusError, mock.Anything, nextCheckInAt).
+					Return(nil)
+				mockIssuesRepo.EXPECT().
+					UpsertIssue(mock.Anything, mock.MatchedBy(func(issue domain.IssueDTO) bool {
+						return issue.Source == domain.SourceMonitor && issue.Fingerprint == "monitor:nightly:error"
+					})).
+					Return(domain.IssueUpsertResult{ID: 10, IsNew: true}, nil)
+				mockNotificationsQueueRepo.EXPECT().
+					AddNotification(mock.Anything, domain.ProjectID(1), domain.IssueID(10), domain.IssueLevelError, true, false, "").
+					Return(nil)
+			},
+		},
+		{
+			name: "Monitor config upserts the monitor",
+			data: map[string]any{
+				"monitor_slug": "nightly",
+				"status":       "in_progress",
+				"monitor_config": map[string]any{
+					"schedule": map[string]any{"type": "crontab", "value": "0 * * * *"},
+				},
+			},
+			setupMocks: func(
+				mockMonitorsRepo *mockcontract.MockMonitorsRepository,
+				_ *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockNotificationsQueueRepository,
+			) {
+				mockMonitorsRepo.EXPECT().
+					Upsert(mock.Anything, mock.MatchedBy(func(dto domain.MonitorDTO) bool {
+						return dto.Slug == "nightly" && dto.Schedule == "0 * * * *"
+					})).
+					Return(monitor, nil)
+				mockMonitorsRepo.EXPECT().UpsertCheckIn(mock.Anything, mock.Anything).
+					Return(domain.MonitorCheckIn{Status: domain.CheckInStatusInProgress, CreatedAt: createdAt}, nil)
+				mockMonitorsRepo.EXPECT().
+					UpdateState(mock.Anything, domain.MonitorID(1), domain.MonitorStatusOK, mock.Anything, nextCheckInAt).
+					Return(nil)
+			},
+		},
+		{
+			name: "Unknown monitor",
+			data: map[string]any{"monitor_slug": "unknown", "status": "ok"},
+			setupMocks: func(
+				mockMonitorsRepo *mockcontract.MockMonitorsRepository,
+				_ *mockcontract.MockIssuesRepository,
+				_ *mockcontract.MockNotificationsQueueRepository,
+			) {
+				mockMonitorsRepo.EXPECT().GetBySlug(mock.Anything, domain.ProjectID(1), "unknown").
+					Return(domain.Monitor{}, domain.ErrEntityNotFound)
+			},
+			expectedError: domain.ErrMonitorNotFound,
+		},
+		{
+			name: "Invalid status",
+			data: map[string]any{"monitor_slug": "nightly", "status": "missed"},
+			setupMocks: func(
+				*mockcontract.MockMonitorsRepository,
+				*mockcontract.MockIssuesRepository,
+				*mockcontract.MockNotificationsQueueRepository,
+			) {
+			},
+			expectedError: domain.ErrInvalidCheckIn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockTxManager := mockdb.NewMockTxManager(t)
+			mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Maybe()
+			mockMonitorsRepo := mockcontract.NewMockMonitorsRepository(t)
+			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
+			mockNotificationsQueueRepo := mockcontract.NewMockNotificationsQueueRepository(t)
+			tt.setupMocks(mockMonitorsRepo, mockIssuesRepo, mockNotificationsQueueRepo)
+
+			service := New(mockTxManager, mockMonitorsRepo, mockIssuesRepo, mockNotificationsQueueRepo)
+
+			err := service.ProcessCheckIn(context.Background(), 1, tt.data)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
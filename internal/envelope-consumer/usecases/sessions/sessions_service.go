@@ -0,0 +1,66 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventcommon "github.com/rom8726/warden/internal/common/event"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/contract"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+type SessionService struct {
+	sessionsRepo contract.SessionsRepository
+}
+
+func New(sessionsRepo contract.SessionsRepository) *SessionService {
+	return &SessionService{
+		sessionsRepo: sessionsRepo,
+	}
+}
+
+// ProcessSession parses a single session update and stores it for release health.
+func (s *SessionService) ProcessSession(ctx context.Context, projectID domain.ProjectID, data map[string]any) error {
+	start := time.Now()
+
+	update, err := eventcommon.ParseSession(projectID, data)
+	if err != nil {
+		return fmt.Errorf("parse session: %w", err)
+	}
+
+	if err := s.sessionsRepo.Store(ctx, []domain.SessionUpdate{update}); err != nil {
+		return fmt.Errorf("store session: %w", err)
+	}
+
+	metrics.ProcessingTime.WithLabelValues("session").Observe(time.Since(start).Seconds())
+
+	return nil
+}
+
+// ProcessSessionAggregates parses pre-aggregated sessions and stores them for release health.
+func (s *SessionService) ProcessSessionAggregates(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	data map[string]any,
+) error {
+	start := time.Now()
+
+	updates, err := eventcommon.ParseSessionAggregates(projectID, data)
+	if err != nil {
+		return fmt.Errorf("parse session aggregates: %w", err)
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	if err := s.sessionsRepo.Store(ctx, updates); err != nil {
+		return fmt.Errorf("store session aggregates: %w", err)
+	}
+
+	metrics.ProcessingTime.WithLabelValues("sessions").Observe(time.Since(start).Seconds())
+
+	return nil
+}
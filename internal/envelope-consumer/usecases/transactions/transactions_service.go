@@ -0,0 +1,57 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventcommon "github.com/rom8726/warden/internal/common/event"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/envelope-consumer/contract"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+type TransactionService struct {
+	transactionsRepo contract.TransactionsRepository
+	projectSettings  contract.ProjectSettingsService
+}
+
+func New(
+	transactionsRepo contract.TransactionsRepository,
+	projectSettings contract.ProjectSettingsService,
+) *TransactionService {
+	return &TransactionService{
+		transactionsRepo: transactionsRepo,
+		projectSettings:  projectSettings,
+	}
+}
+
+// ProcessTransaction parses a transaction with its spans and stores it for performance monitoring.
+func (s *TransactionService) ProcessTransaction(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	data map[string]any,
+) (domain.EventID, error) {
+	start := time.Now()
+
+	// Tags, span descriptions and data carry the same sensitive data as events do
+	dataScrubber, err := s.projectSettings.DataScrubber(ctx, projectID)
+	if err != nil {
+		return "", fmt.Errorf("get data scrubber: %w", err)
+	}
+
+	dataScrubber.Scrub(data)
+
+	transaction, err := eventcommon.ParseTransaction(projectID, data)
+	if err != nil {
+		return "", fmt.Errorf("parse transaction: %w", err)
+	}
+
+	if err := s.transactionsRepo.Store(ctx, &transaction); err != nil {
+		return "", fmt.Errorf("store transaction: %w", err)
+	}
+
+	metrics.ProcessingTime.WithLabelValues("transaction").Observe(time.Since(start).Seconds())
+
+	return transaction.ID, nil
+}
@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type CronCheckInRes interface {
+	cronCheckInRes()
+}
+
 type ReceiveEnvelopeRes interface {
 	receiveEnvelopeRes()
 }
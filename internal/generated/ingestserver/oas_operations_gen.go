@@ -6,6 +6,7 @@ package api
 type OperationName = string
 
 const (
+	CronCheckInOperation     OperationName = "CronCheckIn"
 	ReceiveEnvelopeOperation OperationName = "ReceiveEnvelope"
 	StoreEventOperation      OperationName = "StoreEvent"
 )
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 )
 
@@ -13,6 +14,100 @@ func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Ref: #/components/schemas/CronCheckInRequest
+type CronCheckInRequest struct {
+	// Schedule of the monitor, the same as monitor_config of the check_in envelope item.
+	MonitorConfig OptCronCheckInRequestMonitorConfig `json:"monitor_config"`
+}
+
+// GetMonitorConfig returns the value of MonitorConfig.
+func (s *CronCheckInRequest) GetMonitorConfig() OptCronCheckInRequestMonitorConfig {
+	return s.MonitorConfig
+}
+
+// SetMonitorConfig sets the value of MonitorConfig.
+func (s *CronCheckInRequest) SetMonitorConfig(val OptCronCheckInRequestMonitorConfig) {
+	s.MonitorConfig = val
+}
+
+// Schedule of the monitor, the same as monitor_config of the check_in envelope item.
+type CronCheckInRequestMonitorConfig map[string]jx.Raw
+
+func (s *CronCheckInRequestMonitorConfig) init() CronCheckInRequestMonitorConfig {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/CronCheckInResponse
+type CronCheckInResponse struct {
+	// Id of the check-in, pass it as check_in_id to finish an in progress check-in.
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *CronCheckInResponse) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *CronCheckInResponse) SetID(val string) {
+	s.ID = val
+}
+
+func (*CronCheckInResponse) cronCheckInRes() {}
+
+type CronCheckInStatus string
+
+const (
+	CronCheckInStatusInProgress CronCheckInStatus = "in_progress"
+	CronCheckInStatusOk         CronCheckInStatus = "ok"
+	CronCheckInStatusError      CronCheckInStatus = "error"
+)
+
+// AllValues returns all CronCheckInStatus values.
+func (CronCheckInStatus) AllValues() []CronCheckInStatus {
+	return []CronCheckInStatus{
+		CronCheckInStatusInProgress,
+		CronCheckInStatusOk,
+		CronCheckInStatusError,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CronCheckInStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CronCheckInStatusInProgress:
+		return []byte(s), nil
+	case CronCheckInStatusOk:
+		return []byte(s), nil
+	case CronCheckInStatusError:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CronCheckInStatus) UnmarshalText(data []byte) error {
+	switch CronCheckInStatus(data) {
+	case CronCheckInStatusInProgress:
+		*s = CronCheckInStatusInProgress
+		return nil
+	case CronCheckInStatusOk:
+		*s = CronCheckInStatusOk
+		return nil
+	case CronCheckInStatusError:
+		*s = CronCheckInStatusError
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/Error
 type Error struct {
 	Error ErrorError `json:"error"`
@@ -44,6 +139,7 @@ func (s *ErrorBadRequest) SetError(val ErrorBadRequestError) {
 	s.Error = val
 }
 
+func (*ErrorBadRequest) cronCheckInRes()     {}
 func (*ErrorBadRequest) receiveEnvelopeRes() {}
 
 type ErrorBadRequestError struct {
@@ -100,6 +196,176 @@ func (s *ErrorStatusCode) SetResponse(val Error) {
 	s.Response = val
 }
 
+// Merged schema.
+// Ref: #/components/schemas/ErrorUnauthorized
+type ErrorUnauthorized struct {
+	Error ErrorUnauthorizedError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *ErrorUnauthorized) GetError() ErrorUnauthorizedError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *ErrorUnauthorized) SetError(val ErrorUnauthorizedError) {
+	s.Error = val
+}
+
+func (*ErrorUnauthorized) cronCheckInRes() {}
+
+type ErrorUnauthorizedError struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorUnauthorizedError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorUnauthorizedError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// NewOptCronCheckInRequest returns new OptCronCheckInRequest with value set to v.
+func NewOptCronCheckInRequest(v CronCheckInRequest) OptCronCheckInRequest {
+	return OptCronCheckInRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCronCheckInRequest is optional CronCheckInRequest.
+type OptCronCheckInRequest struct {
+	Value CronCheckInRequest
+	Set   bool
+}
+
+// IsSet returns true if OptCronCheckInRequest was set.
+func (o OptCronCheckInRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCronCheckInRequest) Reset() {
+	var v CronCheckInRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCronCheckInRequest) SetTo(v CronCheckInRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCronCheckInRequest) Get() (v CronCheckInRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCronCheckInRequest) Or(d CronCheckInRequest) CronCheckInRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCronCheckInRequestMonitorConfig returns new OptCronCheckInRequestMonitorConfig with value set to v.
+func NewOptCronCheckInRequestMonitorConfig(v CronCheckInRequestMonitorConfig) OptCronCheckInRequestMonitorConfig {
+	return OptCronCheckInRequestMonitorConfig{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCronCheckInRequestMonitorConfig is optional CronCheckInRequestMonitorConfig.
+type OptCronCheckInRequestMonitorConfig struct {
+	Value CronCheckInRequestMonitorConfig
+	Set   bool
+}
+
+// IsSet returns true if OptCronCheckInRequestMonitorConfig was set.
+func (o OptCronCheckInRequestMonitorConfig) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCronCheckInRequestMonitorConfig) Reset() {
+	var v CronCheckInRequestMonitorConfig
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCronCheckInRequestMonitorConfig) SetTo(v CronCheckInRequestMonitorConfig) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCronCheckInRequestMonitorConfig) Get() (v CronCheckInRequestMonitorConfig, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCronCheckInRequestMonitorConfig) Or(d CronCheckInRequestMonitorConfig) CronCheckInRequestMonitorConfig {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -190,6 +456,20 @@ func (s *SentryAuth) SetAPIKey(val string) {
 	s.APIKey = val
 }
 
+type SentryKey struct {
+	APIKey string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *SentryKey) GetAPIKey() string {
+	return s.APIKey
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *SentryKey) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
 type StoreEventReq map[string]jx.Raw
 
 func (s *StoreEventReq) init() StoreEventReq {
@@ -15,7 +15,11 @@ import (
 // SecurityHandler is handler for security parameters.
 type SecurityHandler interface {
 	// HandleSentryAuth handles sentry_auth security.
+	// Sentry auth header with the public key and, for legacy DSNs, the secret key.
 	HandleSentryAuth(ctx context.Context, operationName OperationName, t SentryAuth) (context.Context, error)
+	// HandleSentryKey handles sentry_key security.
+	// Public key of the DSN, used by browser and CSP clients that can't set headers.
+	HandleSentryKey(ctx context.Context, operationName OperationName, t SentryKey) (context.Context, error)
 }
 
 func findAuthorization(h http.Header, prefix string) (string, bool) {
@@ -49,11 +53,32 @@ func (s *Server) securitySentryAuth(ctx context.Context, operationName Operation
 	}
 	return rctx, true, err
 }
+func (s *Server) securitySentryKey(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t SentryKey
+	const parameterName = "sentry_key"
+	q := req.URL.Query()
+	if !q.Has(parameterName) {
+		return ctx, false, nil
+	}
+	value := q.Get(parameterName)
+	t.APIKey = value
+	rctx, err := s.sec.HandleSentryKey(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
 
 // SecuritySource is provider of security values (tokens, passwords, etc.).
 type SecuritySource interface {
 	// SentryAuth provides sentry_auth security value.
+	// Sentry auth header with the public key and, for legacy DSNs, the secret key.
 	SentryAuth(ctx context.Context, operationName OperationName) (SentryAuth, error)
+	// SentryKey provides sentry_key security value.
+	// Public key of the DSN, used by browser and CSP clients that can't set headers.
+	SentryKey(ctx context.Context, operationName OperationName) (SentryKey, error)
 }
 
 func (s *Client) securitySentryAuth(ctx context.Context, operationName OperationName, req *http.Request) error {
@@ -64,3 +89,13 @@ func (s *Client) securitySentryAuth(ctx context.Context, operationName Operation
 	req.Header.Set("X-Sentry-Auth", t.APIKey)
 	return nil
 }
+func (s *Client) securitySentryKey(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.SentryKey(ctx, operationName)
+	if err != nil {
+		return errors.Wrap(err, "security source \"SentryKey\"")
+	}
+	q := req.URL.Query()
+	q.Set("sentry_key", t.APIKey)
+	req.URL.RawQuery = q.Encode()
+	return nil
+}
@@ -8,6 +8,13 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// CronCheckIn implements CronCheckIn operation.
+	//
+	// Plain HTTP alternative to the check_in envelope item for jobs without a Sentry SDK.
+	// The monitor is created or updated when the body contains a monitor config.
+	//
+	// POST /api/{project_id}/cron/{monitor_slug}/{sentry_key}/
+	CronCheckIn(ctx context.Context, req OptCronCheckInRequest, params CronCheckInParams) (CronCheckInRes, error)
 	// ReceiveEnvelope implements ReceiveEnvelope operation.
 	//
 	// Accept an envelope containing multiple parts of data.
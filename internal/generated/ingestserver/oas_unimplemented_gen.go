@@ -13,6 +13,16 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// CronCheckIn implements CronCheckIn operation.
+//
+// Plain HTTP alternative to the check_in envelope item for jobs without a Sentry SDK.
+// The monitor is created or updated when the body contains a monitor config.
+//
+// POST /api/{project_id}/cron/{monitor_slug}/{sentry_key}/
+func (UnimplementedHandler) CronCheckIn(ctx context.Context, req OptCronCheckInRequest, params CronCheckInParams) (r CronCheckInRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReceiveEnvelope implements ReceiveEnvelope operation.
 //
 // Accept an envelope containing multiple parts of data.
@@ -0,0 +1,20 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+import (
+	"github.com/go-faster/errors"
+)
+
+func (s CronCheckInStatus) Validate() error {
+	switch s {
+	case "in_progress":
+		return nil
+	case "ok":
+		return nil
+	case "error":
+		return nil
+	default:
+		return errors.Errorf("invalid value: %v", s)
+	}
+}
@@ -47,6 +47,22 @@ type Invoker interface {
 	//
 	// DELETE /api/v1/projects/{project_id}
 	ArchiveProject(ctx context.Context, params ArchiveProjectParams) (ArchiveProjectRes, error)
+	// AssignIssue invokes AssignIssue operation.
+	//
+	// Assign issue to a user or a team.
+	//
+	// PUT /api/v1/projects/{project_id}/issues/{issue_id}/assignee
+	AssignIssue(ctx context.Context, request *IssueAssigneeRequest, params AssignIssueParams) (AssignIssueRes, error)
+	// BulkUpdateIssues invokes BulkUpdateIssues operation.
+	//
+	// Resolves, ignores, unresolves, assigns, merges or deletes the issues given by IDs or matching a
+	// filter
+	// in one transaction. Issues the user can't manage, missing issues and issues already in the
+	// requested
+	// state are reported and left as they are.
+	//
+	// POST /api/v1/projects/{project_id}/issues/bulk
+	BulkUpdateIssues(ctx context.Context, request *IssueBulkRequest, params BulkUpdateIssuesParams) (BulkUpdateIssuesRes, error)
 	// ChangeIssueStatus invokes changeIssueStatus operation.
 	//
 	// Change issue status.
@@ -77,6 +93,12 @@ type Invoker interface {
 	//
 	// POST /api/v1/users/me/2fa/confirm
 	Confirm2FA(ctx context.Context, request *TwoFAConfirmRequest) (Confirm2FARes, error)
+	// CreateGroupingRule invokes CreateGroupingRule operation.
+	//
+	// Applies to new events only, existing issues keep their fingerprints.
+	//
+	// POST /api/v1/projects/{project_id}/grouping-rules
+	CreateGroupingRule(ctx context.Context, request *GroupingRuleRequest, params CreateGroupingRuleParams) (CreateGroupingRuleRes, error)
 	// CreateNotificationRule invokes CreateNotificationRule operation.
 	//
 	// Create a new notification rule.
@@ -89,6 +111,18 @@ type Invoker interface {
 	//
 	// POST /api/v1/projects/{project_id}/notification-settings
 	CreateNotificationSetting(ctx context.Context, request *CreateNotificationSettingRequest, params CreateNotificationSettingParams) (CreateNotificationSettingRes, error)
+	// CreateProjectIssueComment invokes CreateProjectIssueComment operation.
+	//
+	// Users with access to the project mentioned with @username are notified.
+	//
+	// POST /api/v1/projects/{project_id}/issues/{issue_id}/comments
+	CreateProjectIssueComment(ctx context.Context, request *CreateIssueCommentRequest, params CreateProjectIssueCommentParams) (CreateProjectIssueCommentRes, error)
+	// CreateProjectKey invokes CreateProjectKey operation.
+	//
+	// Create a project client key.
+	//
+	// POST /api/v1/projects/{project_id}/keys
+	CreateProjectKey(ctx context.Context, request *ProjectKeyRequest, params CreateProjectKeyParams) (CreateProjectKeyRes, error)
 	// CreateTeam invokes CreateTeam operation.
 	//
 	// Create a new team.
@@ -101,6 +135,18 @@ type Invoker interface {
 	//
 	// POST /api/v1/users
 	CreateUser(ctx context.Context, request *CreateUserRequest) (CreateUserRes, error)
+	// DeleteGroupingRule invokes DeleteGroupingRule operation.
+	//
+	// Delete a grouping rule.
+	//
+	// DELETE /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+	DeleteGroupingRule(ctx context.Context, params DeleteGroupingRuleParams) (DeleteGroupingRuleRes, error)
+	// DeleteMonitor invokes DeleteMonitor operation.
+	//
+	// Delete cron monitor.
+	//
+	// DELETE /api/v1/projects/{project_id}/monitors/{monitor_id}
+	DeleteMonitor(ctx context.Context, params DeleteMonitorParams) (DeleteMonitorRes, error)
 	// DeleteNotificationRule invokes DeleteNotificationRule operation.
 	//
 	// Delete a notification rule.
@@ -113,6 +159,12 @@ type Invoker interface {
 	//
 	// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}
 	DeleteNotificationSetting(ctx context.Context, params DeleteNotificationSettingParams) (DeleteNotificationSettingRes, error)
+	// DeleteProjectIssueComment invokes DeleteProjectIssueComment operation.
+	//
+	// Delete issue comment.
+	//
+	// DELETE /api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}
+	DeleteProjectIssueComment(ctx context.Context, params DeleteProjectIssueCommentParams) (DeleteProjectIssueCommentRes, error)
 	// DeleteTeam invokes DeleteTeam operation.
 	//
 	// Delete a team.
@@ -131,6 +183,12 @@ type Invoker interface {
 	//
 	// POST /api/v1/users/me/2fa/disable
 	Disable2FA(ctx context.Context, request *TwoFADisableRequest) (Disable2FARes, error)
+	// DownloadAttachment invokes DownloadAttachment operation.
+	//
+	// Download attachment.
+	//
+	// GET /api/v1/projects/{project_id}/attachments/{attachment_id}/download
+	DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (DownloadAttachmentRes, error)
 	// ForgotPassword invokes ForgotPassword operation.
 	//
 	// Request a password reset.
@@ -161,6 +219,12 @@ type Invoker interface {
 	//
 	// GET /api/v1/issues/timeseries
 	GetIssuesTimeseries(ctx context.Context, params GetIssuesTimeseriesParams) (GetIssuesTimeseriesRes, error)
+	// GetMonitor invokes GetMonitor operation.
+	//
+	// Get cron monitor with recent check-ins.
+	//
+	// GET /api/v1/projects/{project_id}/monitors/{monitor_id}
+	GetMonitor(ctx context.Context, params GetMonitorParams) (GetMonitorRes, error)
 	// GetNotificationRule invokes GetNotificationRule operation.
 	//
 	// Get a specific notification rule.
@@ -179,18 +243,72 @@ type Invoker interface {
 	//
 	// GET /api/v1/projects/{project_id}
 	GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error)
+	// GetProjectDataScrubbing invokes GetProjectDataScrubbing operation.
+	//
+	// Get project data scrubbing.
+	//
+	// GET /api/v1/projects/{project_id}/data-scrubbing
+	GetProjectDataScrubbing(ctx context.Context, params GetProjectDataScrubbingParams) (GetProjectDataScrubbingRes, error)
+	// GetProjectEvent invokes GetProjectEvent operation.
+	//
+	// Get event.
+	//
+	// GET /api/v1/projects/{project_id}/events/{event_id}
+	GetProjectEvent(ctx context.Context, params GetProjectEventParams) (GetProjectEventRes, error)
+	// GetProjectGroupingConfig invokes GetProjectGroupingConfig operation.
+	//
+	// Get project grouping configuration.
+	//
+	// GET /api/v1/projects/{project_id}/grouping-config
+	GetProjectGroupingConfig(ctx context.Context, params GetProjectGroupingConfigParams) (GetProjectGroupingConfigRes, error)
+	// GetProjectInboundFilters invokes GetProjectInboundFilters operation.
+	//
+	// Get project inbound filters.
+	//
+	// GET /api/v1/projects/{project_id}/inbound-filters
+	GetProjectInboundFilters(ctx context.Context, params GetProjectInboundFiltersParams) (GetProjectInboundFiltersRes, error)
 	// GetProjectIssueEventsTimeseries invokes GetProjectIssueEventsTimeseries operation.
 	//
 	// Get timeseries of events for a specific issue inside a project.
 	//
 	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries
 	GetProjectIssueEventsTimeseries(ctx context.Context, params GetProjectIssueEventsTimeseriesParams) (GetProjectIssueEventsTimeseriesRes, error)
+	// GetProjectIssueLatestEvent invokes GetProjectIssueLatestEvent operation.
+	//
+	// Get the latest issue event.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/latest
+	GetProjectIssueLatestEvent(ctx context.Context, params GetProjectIssueLatestEventParams) (GetProjectIssueLatestEventRes, error)
+	// GetProjectIssueOldestEvent invokes GetProjectIssueOldestEvent operation.
+	//
+	// Get the oldest issue event.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/oldest
+	GetProjectIssueOldestEvent(ctx context.Context, params GetProjectIssueOldestEventParams) (GetProjectIssueOldestEventRes, error)
+	// GetProjectIssueTags invokes GetProjectIssueTags operation.
+	//
+	// Get tags of issue events.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags
+	GetProjectIssueTags(ctx context.Context, params GetProjectIssueTagsParams) (GetProjectIssueTagsRes, error)
 	// GetProjectIssueTimeseries invokes GetProjectIssueTimeseries operation.
 	//
 	// Get timeseries for a specific issue inside a project.
 	//
 	// GET /api/v1/projects/{project_id}/issues/{issue_id}/timeseries
 	GetProjectIssueTimeseries(ctx context.Context, params GetProjectIssueTimeseriesParams) (GetProjectIssueTimeseriesRes, error)
+	// GetProjectOutcomes invokes GetProjectOutcomes operation.
+	//
+	// Outcomes are stored hourly, a granularity finer than 1h is rounded up.
+	//
+	// GET /api/v1/projects/{project_id}/outcomes
+	GetProjectOutcomes(ctx context.Context, params GetProjectOutcomesParams) (GetProjectOutcomesRes, error)
+	// GetProjectRateLimits invokes GetProjectRateLimits operation.
+	//
+	// Get project rate limits.
+	//
+	// GET /api/v1/projects/{project_id}/rate-limits
+	GetProjectRateLimits(ctx context.Context, params GetProjectRateLimitsParams) (GetProjectRateLimitsRes, error)
 	// GetProjectReleaseAnalyticsDetails invokes GetProjectReleaseAnalyticsDetails operation.
 	//
 	// Get analytics details for a specific release.
@@ -239,6 +357,12 @@ type Invoker interface {
 	//
 	// GET /api/v1/teams/{team_id}
 	GetTeam(ctx context.Context, params GetTeamParams) (GetTeamRes, error)
+	// GetTrace invokes GetTrace operation.
+	//
+	// Get trace.
+	//
+	// GET /api/v1/projects/{project_id}/traces/{trace_id}
+	GetTrace(ctx context.Context, params GetTraceParams) (GetTraceRes, error)
 	// GetUnreadNotificationsCount invokes GetUnreadNotificationsCount operation.
 	//
 	// Get unread notifications count.
@@ -258,12 +382,30 @@ type Invoker interface {
 	//
 	// GET /api/v1/versions
 	GetVersions(ctx context.Context) (GetVersionsRes, error)
+	// ListEventAttachments invokes ListEventAttachments operation.
+	//
+	// List event attachments.
+	//
+	// GET /api/v1/projects/{project_id}/events/{event_id}/attachments
+	ListEventAttachments(ctx context.Context, params ListEventAttachmentsParams) (ListEventAttachmentsRes, error)
+	// ListGroupingRules invokes ListGroupingRules operation.
+	//
+	// List project grouping rules.
+	//
+	// GET /api/v1/projects/{project_id}/grouping-rules
+	ListGroupingRules(ctx context.Context, params ListGroupingRulesParams) (ListGroupingRulesRes, error)
 	// ListIssues invokes ListIssues operation.
 	//
 	// Get all issues across all projects.
 	//
 	// GET /api/v1/issues
 	ListIssues(ctx context.Context, params ListIssuesParams) (ListIssuesRes, error)
+	// ListMonitors invokes ListMonitors operation.
+	//
+	// List project cron monitors.
+	//
+	// GET /api/v1/projects/{project_id}/monitors
+	ListMonitors(ctx context.Context, params ListMonitorsParams) (ListMonitorsRes, error)
 	// ListNotificationRules invokes ListNotificationRules operation.
 	//
 	// List all notification rules for notification settings of project.
@@ -276,6 +418,48 @@ type Invoker interface {
 	//
 	// GET /api/v1/projects/{project_id}/notification-settings
 	ListNotificationSettings(ctx context.Context, params ListNotificationSettingsParams) (ListNotificationSettingsRes, error)
+	// ListProjectEnvironments invokes ListProjectEnvironments operation.
+	//
+	// Environments are discovered from the ingested events.
+	//
+	// GET /api/v1/projects/{project_id}/environments
+	ListProjectEnvironments(ctx context.Context, params ListProjectEnvironmentsParams) (ListProjectEnvironmentsRes, error)
+	// ListProjectIssueActivity invokes ListProjectIssueActivity operation.
+	//
+	// First seen, regressions, status and assignment changes, merges and comments of the issue.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/activity
+	ListProjectIssueActivity(ctx context.Context, params ListProjectIssueActivityParams) (ListProjectIssueActivityRes, error)
+	// ListProjectIssueComments invokes ListProjectIssueComments operation.
+	//
+	// List issue comments.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/comments
+	ListProjectIssueComments(ctx context.Context, params ListProjectIssueCommentsParams) (ListProjectIssueCommentsRes, error)
+	// ListProjectIssueEvents invokes ListProjectIssueEvents operation.
+	//
+	// List issue events.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events
+	ListProjectIssueEvents(ctx context.Context, params ListProjectIssueEventsParams) (ListProjectIssueEventsRes, error)
+	// ListProjectIssueTagEvents invokes ListProjectIssueTagEvents operation.
+	//
+	// List issue events with a tag value.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events
+	ListProjectIssueTagEvents(ctx context.Context, params ListProjectIssueTagEventsParams) (ListProjectIssueTagEventsRes, error)
+	// ListProjectKeys invokes ListProjectKeys operation.
+	//
+	// List project client keys.
+	//
+	// GET /api/v1/projects/{project_id}/keys
+	ListProjectKeys(ctx context.Context, params ListProjectKeysParams) (ListProjectKeysRes, error)
+	// ListProjectTransactions invokes ListProjectTransactions operation.
+	//
+	// List project transactions.
+	//
+	// GET /api/v1/projects/{project_id}/transactions
+	ListProjectTransactions(ctx context.Context, params ListProjectTransactionsParams) (ListProjectTransactionsRes, error)
 	// ListProjects invokes ListProjects operation.
 	//
 	// Get projects list.
@@ -318,6 +502,19 @@ type Invoker interface {
 	//
 	// PUT /api/v1/notifications/{notification_id}/read
 	MarkNotificationAsRead(ctx context.Context, params MarkNotificationAsReadParams) (MarkNotificationAsReadRes, error)
+	// MergeIssues invokes MergeIssues operation.
+	//
+	// Merges the given issues into the primary issue. Events of merged issues are grouped into the
+	// primary issue.
+	//
+	// POST /api/v1/projects/{project_id}/issues/merge
+	MergeIssues(ctx context.Context, request *MergeIssuesRequest, params MergeIssuesParams) (MergeIssuesRes, error)
+	// PreviewGroupingRule invokes PreviewGroupingRule operation.
+	//
+	// Evaluates the rule against the events of the last 24 hours without saving it.
+	//
+	// POST /api/v1/projects/{project_id}/grouping-rules/preview
+	PreviewGroupingRule(ctx context.Context, request *GroupingRuleRequest, params PreviewGroupingRuleParams) (PreviewGroupingRuleRes, error)
 	// RecentProjectsList invokes RecentProjectsList operation.
 	//
 	// Get recent projects list.
@@ -355,6 +552,25 @@ type Invoker interface {
 	//
 	// POST /api/v1/auth/reset-password
 	ResetPassword(ctx context.Context, request *ResetPasswordRequest) (ResetPasswordRes, error)
+	// RevokeProjectKey invokes RevokeProjectKey operation.
+	//
+	// Revoke a project client key.
+	//
+	// DELETE /api/v1/projects/{project_id}/keys/{key_id}
+	RevokeProjectKey(ctx context.Context, params RevokeProjectKeyParams) (RevokeProjectKeyRes, error)
+	// RotateProjectKey invokes RotateProjectKey operation.
+	//
+	// Issues a new key with the label and the rate limit of the given one. The old key keeps working
+	// until it is revoked.
+	//
+	// POST /api/v1/projects/{project_id}/keys/{key_id}/rotate
+	RotateProjectKey(ctx context.Context, params RotateProjectKeyParams) (RotateProjectKeyRes, error)
+	// SearchProjectEvents invokes SearchProjectEvents operation.
+	//
+	// Search project events.
+	//
+	// GET /api/v1/projects/{project_id}/events
+	SearchProjectEvents(ctx context.Context, params SearchProjectEventsParams) (SearchProjectEventsRes, error)
 	// Send2FACode invokes send2FACode operation.
 	//
 	// Send 2FA email code for disable/reset.
@@ -385,6 +601,18 @@ type Invoker interface {
 	//
 	// POST /api/v1/users/me/2fa/setup
 	Setup2FA(ctx context.Context) (Setup2FARes, error)
+	// UnmergeIssue invokes UnmergeIssue operation.
+	//
+	// Splits merged fingerprints out of the issue into separate issues.
+	//
+	// POST /api/v1/projects/{project_id}/issues/{issue_id}/unmerge
+	UnmergeIssue(ctx context.Context, request *UnmergeIssueRequest, params UnmergeIssueParams) (UnmergeIssueRes, error)
+	// UpdateGroupingRule invokes UpdateGroupingRule operation.
+	//
+	// Update a grouping rule.
+	//
+	// PUT /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+	UpdateGroupingRule(ctx context.Context, request *GroupingRuleRequest, params UpdateGroupingRuleParams) (UpdateGroupingRuleRes, error)
 	// UpdateNotificationRule invokes UpdateNotificationRule operation.
 	//
 	// Update a notification rule.
@@ -403,6 +631,45 @@ type Invoker interface {
 	//
 	// PUT /api/v1/projects/{project_id}
 	UpdateProject(ctx context.Context, request *UpdateProjectRequest, params UpdateProjectParams) (UpdateProjectRes, error)
+	// UpdateProjectDataScrubbing invokes UpdateProjectDataScrubbing operation.
+	//
+	// Sensitive data is removed from events before they are stored, changes are applied to new events
+	// within a minute.
+	//
+	// PUT /api/v1/projects/{project_id}/data-scrubbing
+	UpdateProjectDataScrubbing(ctx context.Context, request *UpdateDataScrubbingRequest, params UpdateProjectDataScrubbingParams) (UpdateProjectDataScrubbingRes, error)
+	// UpdateProjectEnvironment invokes UpdateProjectEnvironment operation.
+	//
+	// Update project environment.
+	//
+	// PUT /api/v1/projects/{project_id}/environments/{name}
+	UpdateProjectEnvironment(ctx context.Context, request *UpdateEnvironmentRequest, params UpdateProjectEnvironmentParams) (UpdateProjectEnvironmentRes, error)
+	// UpdateProjectGroupingConfig invokes UpdateProjectGroupingConfig operation.
+	//
+	// Applies to new events only, existing issues keep their fingerprints.
+	//
+	// PUT /api/v1/projects/{project_id}/grouping-config
+	UpdateProjectGroupingConfig(ctx context.Context, request *UpdateGroupingConfigRequest, params UpdateProjectGroupingConfigParams) (UpdateProjectGroupingConfigRes, error)
+	// UpdateProjectInboundFilters invokes UpdateProjectInboundFilters operation.
+	//
+	// Filters are applied by the ingest servers before data is queued, dropped data is counted in the
+	// project outcomes.
+	//
+	// PUT /api/v1/projects/{project_id}/inbound-filters
+	UpdateProjectInboundFilters(ctx context.Context, request *UpdateInboundFiltersRequest, params UpdateProjectInboundFiltersParams) (UpdateProjectInboundFiltersRes, error)
+	// UpdateProjectKey invokes UpdateProjectKey operation.
+	//
+	// Disabled keys are rejected by the ingest servers until they are enabled again.
+	//
+	// PUT /api/v1/projects/{project_id}/keys/{key_id}
+	UpdateProjectKey(ctx context.Context, request *UpdateProjectKeyRequest, params UpdateProjectKeyParams) (UpdateProjectKeyRes, error)
+	// UpdateProjectRateLimits invokes UpdateProjectRateLimits operation.
+	//
+	// The limits are shared by all client keys of the project, data over the limits is rejected by the
+	// ingest servers.
+	//
+	// PUT /api/v1/projects/{project_id}/rate-limits
+	UpdateProjectRateLimits(ctx context.Context, request *UpdateProjectRateLimitsRequest, params UpdateProjectRateLimitsParams) (UpdateProjectRateLimitsRes, error)
 	// UserChangeMyPassword invokes userChangeMyPassword operation.
 	//
 	// Change my password.
@@ -824,21 +1091,21 @@ func (c *Client) sendArchiveProject(ctx context.Context, params ArchiveProjectPa
 	return result, nil
 }
 
-// ChangeIssueStatus invokes changeIssueStatus operation.
+// AssignIssue invokes AssignIssue operation.
 //
-// Change issue status.
+// Assign issue to a user or a team.
 //
-// PUT /api/v1/projects/{project_id}/issues/{issue_id}/change-status
-func (c *Client) ChangeIssueStatus(ctx context.Context, request *ChangeIssueStatusReq, params ChangeIssueStatusParams) (ChangeIssueStatusRes, error) {
-	res, err := c.sendChangeIssueStatus(ctx, request, params)
+// PUT /api/v1/projects/{project_id}/issues/{issue_id}/assignee
+func (c *Client) AssignIssue(ctx context.Context, request *IssueAssigneeRequest, params AssignIssueParams) (AssignIssueRes, error) {
+	res, err := c.sendAssignIssue(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssueStatusReq, params ChangeIssueStatusParams) (res ChangeIssueStatusRes, err error) {
+func (c *Client) sendAssignIssue(ctx context.Context, request *IssueAssigneeRequest, params AssignIssueParams) (res AssignIssueRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("changeIssueStatus"),
+		otelogen.OperationID("AssignIssue"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/change-status"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/assignee"),
 	}
 
 	// Run stopwatch.
@@ -853,7 +1120,7 @@ func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssue
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ChangeIssueStatusOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AssignIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -909,7 +1176,7 @@ func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssue
 		}
 		pathParts[3] = encoded
 	}
-	pathParts[4] = "/change-status"
+	pathParts[4] = "/assignee"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -917,7 +1184,7 @@ func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssue
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeChangeIssueStatusRequest(request, r); err != nil {
+	if err := encodeAssignIssueRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -926,7 +1193,7 @@ func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssue
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ChangeIssueStatusOperation, r); {
+			switch err := c.securityBearerAuth(ctx, AssignIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -962,7 +1229,7 @@ func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssue
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeChangeIssueStatusResponse(resp)
+	result, err := decodeAssignIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -970,21 +1237,25 @@ func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssue
 	return result, nil
 }
 
-// ChangeTeamMemberRole invokes ChangeTeamMemberRole operation.
+// BulkUpdateIssues invokes BulkUpdateIssues operation.
 //
-// Change team member role.
+// Resolves, ignores, unresolves, assigns, merges or deletes the issues given by IDs or matching a
+// filter
+// in one transaction. Issues the user can't manage, missing issues and issues already in the
+// requested
+// state are reported and left as they are.
 //
-// PUT /api/v1/teams/{team_id}/members/{user_id}/role
-func (c *Client) ChangeTeamMemberRole(ctx context.Context, request *ChangeTeamMemberRoleRequest, params ChangeTeamMemberRoleParams) (ChangeTeamMemberRoleRes, error) {
-	res, err := c.sendChangeTeamMemberRole(ctx, request, params)
+// POST /api/v1/projects/{project_id}/issues/bulk
+func (c *Client) BulkUpdateIssues(ctx context.Context, request *IssueBulkRequest, params BulkUpdateIssuesParams) (BulkUpdateIssuesRes, error) {
+	res, err := c.sendBulkUpdateIssues(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTeamMemberRoleRequest, params ChangeTeamMemberRoleParams) (res ChangeTeamMemberRoleRes, err error) {
+func (c *Client) sendBulkUpdateIssues(ctx context.Context, request *IssueBulkRequest, params BulkUpdateIssuesParams) (res BulkUpdateIssuesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ChangeTeamMemberRole"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}/role"),
+		otelogen.OperationID("BulkUpdateIssues"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/bulk"),
 	}
 
 	// Run stopwatch.
@@ -999,7 +1270,7 @@ func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ChangeTeamMemberRoleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, BulkUpdateIssuesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1016,17 +1287,17 @@ func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
-	pathParts[0] = "/api/v1/teams/"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "team_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.TeamID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1036,34 +1307,15 @@ func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTe
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/members/"
-	{
-		// Encode "user_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.UserID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/role"
+	pathParts[2] = "/issues/bulk"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeChangeTeamMemberRoleRequest(request, r); err != nil {
+	if err := encodeBulkUpdateIssuesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1072,7 +1324,7 @@ func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ChangeTeamMemberRoleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, BulkUpdateIssuesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1108,7 +1360,7 @@ func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeChangeTeamMemberRoleResponse(resp)
+	result, err := decodeBulkUpdateIssuesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1116,21 +1368,21 @@ func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTe
 	return result, nil
 }
 
-// CheckTeamExists invokes CheckTeamExists operation.
+// ChangeIssueStatus invokes changeIssueStatus operation.
 //
-// Check if a team exists by name.
+// Change issue status.
 //
-// GET /api/v1/teams/check-exists/{team_name}
-func (c *Client) CheckTeamExists(ctx context.Context, params CheckTeamExistsParams) (CheckTeamExistsRes, error) {
-	res, err := c.sendCheckTeamExists(ctx, params)
+// PUT /api/v1/projects/{project_id}/issues/{issue_id}/change-status
+func (c *Client) ChangeIssueStatus(ctx context.Context, request *ChangeIssueStatusReq, params ChangeIssueStatusParams) (ChangeIssueStatusRes, error) {
+	res, err := c.sendChangeIssueStatus(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExistsParams) (res CheckTeamExistsRes, err error) {
+func (c *Client) sendChangeIssueStatus(ctx context.Context, request *ChangeIssueStatusReq, params ChangeIssueStatusParams) (res ChangeIssueStatusRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CheckTeamExists"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/check-exists/{team_name}"),
+		otelogen.OperationID("changeIssueStatus"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/change-status"),
 	}
 
 	// Run stopwatch.
@@ -1145,7 +1397,7 @@ func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExists
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CheckTeamExistsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ChangeIssueStatusOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1162,17 +1414,17 @@ func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExists
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/teams/check-exists/"
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "team_name" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_name",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.TeamName))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1182,20 +1434,43 @@ func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExists
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/change-status"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeChangeIssueStatusRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CheckTeamExistsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ChangeIssueStatusOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1231,7 +1506,7 @@ func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExists
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCheckTeamExistsResponse(resp)
+	result, err := decodeChangeIssueStatusResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1239,21 +1514,21 @@ func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExists
 	return result, nil
 }
 
-// CompareProjectReleasesAnalytics invokes CompareProjectReleasesAnalytics operation.
+// ChangeTeamMemberRole invokes ChangeTeamMemberRole operation.
 //
-// Compare two releases analytics.
+// Change team member role.
 //
-// POST /api/v1/projects/{project_id}/analytics/releases/compare
-func (c *Client) CompareProjectReleasesAnalytics(ctx context.Context, request *CompareProjectReleasesAnalyticsReq, params CompareProjectReleasesAnalyticsParams) (CompareProjectReleasesAnalyticsRes, error) {
-	res, err := c.sendCompareProjectReleasesAnalytics(ctx, request, params)
+// PUT /api/v1/teams/{team_id}/members/{user_id}/role
+func (c *Client) ChangeTeamMemberRole(ctx context.Context, request *ChangeTeamMemberRoleRequest, params ChangeTeamMemberRoleParams) (ChangeTeamMemberRoleRes, error) {
+	res, err := c.sendChangeTeamMemberRole(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, request *CompareProjectReleasesAnalyticsReq, params CompareProjectReleasesAnalyticsParams) (res CompareProjectReleasesAnalyticsRes, err error) {
+func (c *Client) sendChangeTeamMemberRole(ctx context.Context, request *ChangeTeamMemberRoleRequest, params ChangeTeamMemberRoleParams) (res ChangeTeamMemberRoleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CompareProjectReleasesAnalytics"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/compare"),
+		otelogen.OperationID("ChangeTeamMemberRole"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}/role"),
 	}
 
 	// Run stopwatch.
@@ -1268,7 +1543,7 @@ func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, reques
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CompareProjectReleasesAnalyticsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ChangeTeamMemberRoleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1285,17 +1560,17 @@ func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, reques
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/projects/"
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/teams/"
 	{
-		// Encode "project_id" parameter.
+		// Encode "team_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
+			Param:   "team_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
+			return e.EncodeValue(conv.UintToString(params.TeamID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1305,15 +1580,34 @@ func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, reques
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/analytics/releases/compare"
+	pathParts[2] = "/members/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/role"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCompareProjectReleasesAnalyticsRequest(request, r); err != nil {
+	if err := encodeChangeTeamMemberRoleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1322,7 +1616,7 @@ func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, reques
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CompareProjectReleasesAnalyticsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ChangeTeamMemberRoleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1358,7 +1652,7 @@ func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, reques
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCompareProjectReleasesAnalyticsResponse(resp)
+	result, err := decodeChangeTeamMemberRoleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1366,21 +1660,21 @@ func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, reques
 	return result, nil
 }
 
-// Confirm2FA invokes Confirm2FA operation.
+// CheckTeamExists invokes CheckTeamExists operation.
 //
-// Approve enable 2FA (code from app).
+// Check if a team exists by name.
 //
-// POST /api/v1/users/me/2fa/confirm
-func (c *Client) Confirm2FA(ctx context.Context, request *TwoFAConfirmRequest) (Confirm2FARes, error) {
-	res, err := c.sendConfirm2FA(ctx, request)
+// GET /api/v1/teams/check-exists/{team_name}
+func (c *Client) CheckTeamExists(ctx context.Context, params CheckTeamExistsParams) (CheckTeamExistsRes, error) {
+	res, err := c.sendCheckTeamExists(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendConfirm2FA(ctx context.Context, request *TwoFAConfirmRequest) (res Confirm2FARes, err error) {
+func (c *Client) sendCheckTeamExists(ctx context.Context, params CheckTeamExistsParams) (res CheckTeamExistsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Confirm2FA"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/confirm"),
+		otelogen.OperationID("CheckTeamExists"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/check-exists/{team_name}"),
 	}
 
 	// Run stopwatch.
@@ -1395,7 +1689,7 @@ func (c *Client) sendConfirm2FA(ctx context.Context, request *TwoFAConfirmReques
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, Confirm2FAOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CheckTeamExistsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1412,25 +1706,40 @@ func (c *Client) sendConfirm2FA(ctx context.Context, request *TwoFAConfirmReques
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users/me/2fa/confirm"
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/teams/check-exists/"
+	{
+		// Encode "team_name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "team_name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.TeamName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeConfirm2FARequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, Confirm2FAOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CheckTeamExistsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1466,7 +1775,7 @@ func (c *Client) sendConfirm2FA(ctx context.Context, request *TwoFAConfirmReques
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeConfirm2FAResponse(resp)
+	result, err := decodeCheckTeamExistsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1474,21 +1783,21 @@ func (c *Client) sendConfirm2FA(ctx context.Context, request *TwoFAConfirmReques
 	return result, nil
 }
 
-// CreateNotificationRule invokes CreateNotificationRule operation.
+// CompareProjectReleasesAnalytics invokes CompareProjectReleasesAnalytics operation.
 //
-// Create a new notification rule.
+// Compare two releases analytics.
 //
-// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
-func (c *Client) CreateNotificationRule(ctx context.Context, request *CreateNotificationRuleRequest, params CreateNotificationRuleParams) (CreateNotificationRuleRes, error) {
-	res, err := c.sendCreateNotificationRule(ctx, request, params)
+// POST /api/v1/projects/{project_id}/analytics/releases/compare
+func (c *Client) CompareProjectReleasesAnalytics(ctx context.Context, request *CompareProjectReleasesAnalyticsReq, params CompareProjectReleasesAnalyticsParams) (CompareProjectReleasesAnalyticsRes, error) {
+	res, err := c.sendCompareProjectReleasesAnalytics(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateNotificationRule(ctx context.Context, request *CreateNotificationRuleRequest, params CreateNotificationRuleParams) (res CreateNotificationRuleRes, err error) {
+func (c *Client) sendCompareProjectReleasesAnalytics(ctx context.Context, request *CompareProjectReleasesAnalyticsReq, params CompareProjectReleasesAnalyticsParams) (res CompareProjectReleasesAnalyticsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateNotificationRule"),
+		otelogen.OperationID("CompareProjectReleasesAnalytics"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/compare"),
 	}
 
 	// Run stopwatch.
@@ -1503,7 +1812,7 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CompareProjectReleasesAnalyticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1520,7 +1829,7 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
+	var pathParts [3]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -1540,26 +1849,7 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
-	{
-		// Encode "setting_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/rules"
+	pathParts[2] = "/analytics/releases/compare"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1567,7 +1857,7 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateNotificationRuleRequest(request, r); err != nil {
+	if err := encodeCompareProjectReleasesAnalyticsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1576,7 +1866,7 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateNotificationRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CompareProjectReleasesAnalyticsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1612,7 +1902,7 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateNotificationRuleResponse(resp)
+	result, err := decodeCompareProjectReleasesAnalyticsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1620,21 +1910,21 @@ func (c *Client) sendCreateNotificationRule(ctx context.Context, request *Create
 	return result, nil
 }
 
-// CreateNotificationSetting invokes CreateNotificationSetting operation.
+// Confirm2FA invokes Confirm2FA operation.
 //
-// Create a new notification setting.
+// Approve enable 2FA (code from app).
 //
-// POST /api/v1/projects/{project_id}/notification-settings
-func (c *Client) CreateNotificationSetting(ctx context.Context, request *CreateNotificationSettingRequest, params CreateNotificationSettingParams) (CreateNotificationSettingRes, error) {
-	res, err := c.sendCreateNotificationSetting(ctx, request, params)
+// POST /api/v1/users/me/2fa/confirm
+func (c *Client) Confirm2FA(ctx context.Context, request *TwoFAConfirmRequest) (Confirm2FARes, error) {
+	res, err := c.sendConfirm2FA(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *CreateNotificationSettingRequest, params CreateNotificationSettingParams) (res CreateNotificationSettingRes, err error) {
+func (c *Client) sendConfirm2FA(ctx context.Context, request *TwoFAConfirmRequest) (res Confirm2FARes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateNotificationSetting"),
+		otelogen.OperationID("Confirm2FA"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/confirm"),
 	}
 
 	// Run stopwatch.
@@ -1649,7 +1939,7 @@ func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *Cre
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationSettingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, Confirm2FAOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1666,27 +1956,8 @@ func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *Cre
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/projects/"
-	{
-		// Encode "project_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/notification-settings"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users/me/2fa/confirm"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1694,7 +1965,7 @@ func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *Cre
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateNotificationSettingRequest(request, r); err != nil {
+	if err := encodeConfirm2FARequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1703,7 +1974,7 @@ func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *Cre
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateNotificationSettingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, Confirm2FAOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1739,7 +2010,7 @@ func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *Cre
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateNotificationSettingResponse(resp)
+	result, err := decodeConfirm2FAResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1747,21 +2018,21 @@ func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *Cre
 	return result, nil
 }
 
-// CreateTeam invokes CreateTeam operation.
+// CreateGroupingRule invokes CreateGroupingRule operation.
 //
-// Create a new team.
+// Applies to new events only, existing issues keep their fingerprints.
 //
-// POST /api/v1/teams
-func (c *Client) CreateTeam(ctx context.Context, request *CreateTeamRequest) (CreateTeamRes, error) {
-	res, err := c.sendCreateTeam(ctx, request)
+// POST /api/v1/projects/{project_id}/grouping-rules
+func (c *Client) CreateGroupingRule(ctx context.Context, request *GroupingRuleRequest, params CreateGroupingRuleParams) (CreateGroupingRuleRes, error) {
+	res, err := c.sendCreateGroupingRule(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest) (res CreateTeamRes, err error) {
+func (c *Client) sendCreateGroupingRule(ctx context.Context, request *GroupingRuleRequest, params CreateGroupingRuleParams) (res CreateGroupingRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateTeam"),
+		otelogen.OperationID("CreateGroupingRule"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/teams"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules"),
 	}
 
 	// Run stopwatch.
@@ -1776,7 +2047,7 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateTeamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateGroupingRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1793,8 +2064,27 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/teams"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-rules"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1802,7 +2092,7 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateTeamRequest(request, r); err != nil {
+	if err := encodeCreateGroupingRuleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1811,7 +2101,7 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateTeamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateGroupingRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1847,7 +2137,7 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateTeamResponse(resp)
+	result, err := decodeCreateGroupingRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1855,21 +2145,21 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest)
 	return result, nil
 }
 
-// CreateUser invokes CreateUser operation.
+// CreateNotificationRule invokes CreateNotificationRule operation.
 //
-// Create a new user (superuser only).
+// Create a new notification rule.
 //
-// POST /api/v1/users
-func (c *Client) CreateUser(ctx context.Context, request *CreateUserRequest) (CreateUserRes, error) {
-	res, err := c.sendCreateUser(ctx, request)
+// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
+func (c *Client) CreateNotificationRule(ctx context.Context, request *CreateNotificationRuleRequest, params CreateNotificationRuleParams) (CreateNotificationRuleRes, error) {
+	res, err := c.sendCreateNotificationRule(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest) (res CreateUserRes, err error) {
+func (c *Client) sendCreateNotificationRule(ctx context.Context, request *CreateNotificationRuleRequest, params CreateNotificationRuleParams) (res CreateNotificationRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateUser"),
+		otelogen.OperationID("CreateNotificationRule"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
 	}
 
 	// Run stopwatch.
@@ -1884,7 +2174,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1901,8 +2191,46 @@ func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users"
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/rules"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1910,7 +2238,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateUserRequest(request, r); err != nil {
+	if err := encodeCreateNotificationRuleRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1919,7 +2247,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateNotificationRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1955,7 +2283,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateUserResponse(resp)
+	result, err := decodeCreateNotificationRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1963,21 +2291,21 @@ func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest)
 	return result, nil
 }
 
-// DeleteNotificationRule invokes DeleteNotificationRule operation.
+// CreateNotificationSetting invokes CreateNotificationSetting operation.
 //
-// Delete a notification rule.
+// Create a new notification setting.
 //
-// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
-func (c *Client) DeleteNotificationRule(ctx context.Context, params DeleteNotificationRuleParams) (DeleteNotificationRuleRes, error) {
-	res, err := c.sendDeleteNotificationRule(ctx, params)
+// POST /api/v1/projects/{project_id}/notification-settings
+func (c *Client) CreateNotificationSetting(ctx context.Context, request *CreateNotificationSettingRequest, params CreateNotificationSettingParams) (CreateNotificationSettingRes, error) {
+	res, err := c.sendCreateNotificationSetting(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNotificationRuleParams) (res DeleteNotificationRuleRes, err error) {
+func (c *Client) sendCreateNotificationSetting(ctx context.Context, request *CreateNotificationSettingRequest, params CreateNotificationSettingParams) (res CreateNotificationSettingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteNotificationRule"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+		otelogen.OperationID("CreateNotificationSetting"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
 	}
 
 	// Run stopwatch.
@@ -1992,7 +2320,7 @@ func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateNotificationSettingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2009,7 +2337,7 @@ func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNo
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
+	var pathParts [3]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -2029,58 +2357,24 @@ func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
-	{
-		// Encode "setting_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/rules/"
-	{
-		// Encode "rule_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "rule_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.RuleID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
+	pathParts[2] = "/notification-settings"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateNotificationSettingRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteNotificationRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateNotificationSettingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2116,7 +2410,7 @@ func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteNotificationRuleResponse(resp)
+	result, err := decodeCreateNotificationSettingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2124,21 +2418,21 @@ func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNo
 	return result, nil
 }
 
-// DeleteNotificationSetting invokes DeleteNotificationSetting operation.
+// CreateProjectIssueComment invokes CreateProjectIssueComment operation.
 //
-// Delete a notification setting.
+// Users with access to the project mentioned with @username are notified.
 //
-// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}
-func (c *Client) DeleteNotificationSetting(ctx context.Context, params DeleteNotificationSettingParams) (DeleteNotificationSettingRes, error) {
-	res, err := c.sendDeleteNotificationSetting(ctx, params)
+// POST /api/v1/projects/{project_id}/issues/{issue_id}/comments
+func (c *Client) CreateProjectIssueComment(ctx context.Context, request *CreateIssueCommentRequest, params CreateProjectIssueCommentParams) (CreateProjectIssueCommentRes, error) {
+	res, err := c.sendCreateProjectIssueComment(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params DeleteNotificationSettingParams) (res DeleteNotificationSettingRes, err error) {
+func (c *Client) sendCreateProjectIssueComment(ctx context.Context, request *CreateIssueCommentRequest, params CreateProjectIssueCommentParams) (res CreateProjectIssueCommentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteNotificationSetting"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+		otelogen.OperationID("CreateProjectIssueComment"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/comments"),
 	}
 
 	// Run stopwatch.
@@ -2153,7 +2447,7 @@ func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params Delet
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationSettingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateProjectIssueCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2170,7 +2464,7 @@ func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params Delet
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [5]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -2190,16 +2484,16 @@ func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params Delet
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
+	pathParts[2] = "/issues/"
 	{
-		// Encode "setting_id" parameter.
+		// Encode "issue_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
+			Param:   "issue_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
+			return e.EncodeValue(conv.UintToString(params.IssueID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2209,20 +2503,24 @@ func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params Delet
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/comments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateProjectIssueCommentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteNotificationSettingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateProjectIssueCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2258,7 +2556,7 @@ func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params Delet
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteNotificationSettingResponse(resp)
+	result, err := decodeCreateProjectIssueCommentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2266,21 +2564,21 @@ func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params Delet
 	return result, nil
 }
 
-// DeleteTeam invokes DeleteTeam operation.
+// CreateProjectKey invokes CreateProjectKey operation.
 //
-// Delete a team.
+// Create a project client key.
 //
-// DELETE /api/v1/teams/{team_id}
-func (c *Client) DeleteTeam(ctx context.Context, params DeleteTeamParams) (DeleteTeamRes, error) {
-	res, err := c.sendDeleteTeam(ctx, params)
+// POST /api/v1/projects/{project_id}/keys
+func (c *Client) CreateProjectKey(ctx context.Context, request *ProjectKeyRequest, params CreateProjectKeyParams) (CreateProjectKeyRes, error) {
+	res, err := c.sendCreateProjectKey(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (res DeleteTeamRes, err error) {
+func (c *Client) sendCreateProjectKey(ctx context.Context, request *ProjectKeyRequest, params CreateProjectKeyParams) (res CreateProjectKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteTeam"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
+		otelogen.OperationID("CreateProjectKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys"),
 	}
 
 	// Run stopwatch.
@@ -2295,7 +2593,7 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTeamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateProjectKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2312,17 +2610,17 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/teams/"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "team_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.TeamID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2332,20 +2630,24 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/keys"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateProjectKeyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteTeamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateProjectKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2381,7 +2683,7 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteTeamResponse(resp)
+	result, err := decodeCreateProjectKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2389,21 +2691,21 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 	return result, nil
 }
 
-// DeleteUser invokes DeleteUser operation.
+// CreateTeam invokes CreateTeam operation.
 //
-// Delete a user (superuser only, cannot delete superusers).
+// Create a new team.
 //
-// DELETE /api/v1/users/{user_id}
-func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error) {
-	res, err := c.sendDeleteUser(ctx, params)
+// POST /api/v1/teams
+func (c *Client) CreateTeam(ctx context.Context, request *CreateTeamRequest) (CreateTeamRes, error) {
+	res, err := c.sendCreateTeam(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (res DeleteUserRes, err error) {
+func (c *Client) sendCreateTeam(ctx context.Context, request *CreateTeamRequest) (res CreateTeamRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteUser"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}"),
+		otelogen.OperationID("CreateTeam"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/teams"),
 	}
 
 	// Run stopwatch.
@@ -2418,7 +2720,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2435,40 +2737,25 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/users/"
-	{
-		// Encode "user_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.UserID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/teams"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateTeamRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateTeamOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2504,7 +2791,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteUserResponse(resp)
+	result, err := decodeCreateTeamResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2512,21 +2799,21 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	return result, nil
 }
 
-// Disable2FA invokes Disable2FA operation.
+// CreateUser invokes CreateUser operation.
 //
-// Disable 2FA (using email-confirmation).
+// Create a new user (superuser only).
 //
-// POST /api/v1/users/me/2fa/disable
-func (c *Client) Disable2FA(ctx context.Context, request *TwoFADisableRequest) (Disable2FARes, error) {
-	res, err := c.sendDisable2FA(ctx, request)
+// POST /api/v1/users
+func (c *Client) CreateUser(ctx context.Context, request *CreateUserRequest) (CreateUserRes, error) {
+	res, err := c.sendCreateUser(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableRequest) (res Disable2FARes, err error) {
+func (c *Client) sendCreateUser(ctx context.Context, request *CreateUserRequest) (res CreateUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Disable2FA"),
+		otelogen.OperationID("CreateUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/disable"),
+		semconv.HTTPRouteKey.String("/api/v1/users"),
 	}
 
 	// Run stopwatch.
@@ -2541,7 +2828,7 @@ func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableReques
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, Disable2FAOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2559,7 +2846,7 @@ func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableReques
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/users/me/2fa/disable"
+	pathParts[0] = "/api/v1/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2567,7 +2854,7 @@ func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableReques
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDisable2FARequest(request, r); err != nil {
+	if err := encodeCreateUserRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -2576,7 +2863,7 @@ func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableReques
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, Disable2FAOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2612,7 +2899,7 @@ func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableReques
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDisable2FAResponse(resp)
+	result, err := decodeCreateUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2620,21 +2907,21 @@ func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableReques
 	return result, nil
 }
 
-// ForgotPassword invokes ForgotPassword operation.
+// DeleteGroupingRule invokes DeleteGroupingRule operation.
 //
-// Request a password reset.
+// Delete a grouping rule.
 //
-// POST /api/v1/auth/forgot-password
-func (c *Client) ForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (ForgotPasswordRes, error) {
-	res, err := c.sendForgotPassword(ctx, request)
+// DELETE /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+func (c *Client) DeleteGroupingRule(ctx context.Context, params DeleteGroupingRuleParams) (DeleteGroupingRuleRes, error) {
+	res, err := c.sendDeleteGroupingRule(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (res ForgotPasswordRes, err error) {
+func (c *Client) sendDeleteGroupingRule(ctx context.Context, params DeleteGroupingRuleParams) (res DeleteGroupingRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ForgotPassword"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/forgot-password"),
+		otelogen.OperationID("DeleteGroupingRule"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules/{rule_id}"),
 	}
 
 	// Run stopwatch.
@@ -2649,7 +2936,7 @@ func (c *Client) sendForgotPassword(ctx context.Context, request *ForgotPassword
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ForgotPasswordOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteGroupingRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2666,28 +2953,95 @@ func (c *Client) sendForgotPassword(ctx context.Context, request *ForgotPassword
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/forgot-password"
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-rules/"
+	{
+		// Encode "rule_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "rule_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.RuleID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeForgotPasswordRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, DeleteGroupingRuleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeForgotPasswordResponse(resp)
+	result, err := decodeDeleteGroupingRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2695,21 +3049,21 @@ func (c *Client) sendForgotPassword(ctx context.Context, request *ForgotPassword
 	return result, nil
 }
 
-// GetCurrentUser invokes GetCurrentUser operation.
+// DeleteMonitor invokes DeleteMonitor operation.
 //
-// Get current user information.
+// Delete cron monitor.
 //
-// GET /api/v1/users/me
-func (c *Client) GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error) {
-	res, err := c.sendGetCurrentUser(ctx)
+// DELETE /api/v1/projects/{project_id}/monitors/{monitor_id}
+func (c *Client) DeleteMonitor(ctx context.Context, params DeleteMonitorParams) (DeleteMonitorRes, error) {
+	res, err := c.sendDeleteMonitor(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes, err error) {
+func (c *Client) sendDeleteMonitor(ctx context.Context, params DeleteMonitorParams) (res DeleteMonitorRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetCurrentUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me"),
+		otelogen.OperationID("DeleteMonitor"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/monitors/{monitor_id}"),
 	}
 
 	// Run stopwatch.
@@ -2724,7 +3078,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteMonitorOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2741,12 +3095,49 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users/me"
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/monitors/"
+	{
+		// Encode "monitor_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "monitor_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.MonitorID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2756,7 +3147,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetCurrentUserOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteMonitorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2792,7 +3183,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCurrentUserResponse(resp)
+	result, err := decodeDeleteMonitorResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2800,21 +3191,21 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	return result, nil
 }
 
-// GetEventsTimeseries invokes GetEventsTimeseries operation.
+// DeleteNotificationRule invokes DeleteNotificationRule operation.
 //
-// Get events timeseries.
+// Delete a notification rule.
 //
-// GET /api/v1/events/timeseries
-func (c *Client) GetEventsTimeseries(ctx context.Context, params GetEventsTimeseriesParams) (GetEventsTimeseriesRes, error) {
-	res, err := c.sendGetEventsTimeseries(ctx, params)
+// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
+func (c *Client) DeleteNotificationRule(ctx context.Context, params DeleteNotificationRuleParams) (DeleteNotificationRuleRes, error) {
+	res, err := c.sendDeleteNotificationRule(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTimeseriesParams) (res GetEventsTimeseriesRes, err error) {
+func (c *Client) sendDeleteNotificationRule(ctx context.Context, params DeleteNotificationRuleParams) (res DeleteNotificationRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetEventsTimeseries"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/events/timeseries"),
+		otelogen.OperationID("DeleteNotificationRule"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
 	}
 
 	// Run stopwatch.
@@ -2829,7 +3220,7 @@ func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetEventsTimeseriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2846,61 +3237,68 @@ func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTi
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/events/timeseries"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [6]string
+	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "project_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ProjectID.Get(); ok {
-				return e.EncodeValue(conv.UintToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/notification-settings/"
 	{
-		// Encode "interval" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "interval",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Interval))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
+	pathParts[4] = "/rules/"
 	{
-		// Encode "granularity" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "granularity",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "rule_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "rule_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.RuleID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Granularity))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[5] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2910,7 +3308,7 @@ func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTi
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetEventsTimeseriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteNotificationRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2946,7 +3344,7 @@ func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetEventsTimeseriesResponse(resp)
+	result, err := decodeDeleteNotificationRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2954,21 +3352,21 @@ func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTi
 	return result, nil
 }
 
-// GetIssue invokes GetIssue operation.
+// DeleteNotificationSetting invokes DeleteNotificationSetting operation.
 //
-// Get details of a specific issue.
+// Delete a notification setting.
 //
-// GET /api/v1/projects/{project_id}/issues/{issue_id}
-func (c *Client) GetIssue(ctx context.Context, params GetIssueParams) (GetIssueRes, error) {
-	res, err := c.sendGetIssue(ctx, params)
+// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}
+func (c *Client) DeleteNotificationSetting(ctx context.Context, params DeleteNotificationSettingParams) (DeleteNotificationSettingRes, error) {
+	res, err := c.sendDeleteNotificationSetting(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res GetIssueRes, err error) {
+func (c *Client) sendDeleteNotificationSetting(ctx context.Context, params DeleteNotificationSettingParams) (res DeleteNotificationSettingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetIssue"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}"),
+		otelogen.OperationID("DeleteNotificationSetting"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
 	}
 
 	// Run stopwatch.
@@ -2983,7 +3381,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIssueOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteNotificationSettingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3020,16 +3418,16 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res G
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/issues/"
+	pathParts[2] = "/notification-settings/"
 	{
-		// Encode "issue_id" parameter.
+		// Encode "setting_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issue_id",
+			Param:   "setting_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.IssueID))
+			return e.EncodeValue(conv.UintToString(params.SettingID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3042,7 +3440,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res G
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3052,7 +3450,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res G
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetIssueOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteNotificationSettingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3088,7 +3486,7 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res G
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIssueResponse(resp)
+	result, err := decodeDeleteNotificationSettingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3096,21 +3494,21 @@ func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res G
 	return result, nil
 }
 
-// GetIssuesTimeseries invokes GetIssuesTimeseries operation.
+// DeleteProjectIssueComment invokes DeleteProjectIssueComment operation.
 //
-// Get issues timeseries.
+// Delete issue comment.
 //
-// GET /api/v1/issues/timeseries
-func (c *Client) GetIssuesTimeseries(ctx context.Context, params GetIssuesTimeseriesParams) (GetIssuesTimeseriesRes, error) {
-	res, err := c.sendGetIssuesTimeseries(ctx, params)
+// DELETE /api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}
+func (c *Client) DeleteProjectIssueComment(ctx context.Context, params DeleteProjectIssueCommentParams) (DeleteProjectIssueCommentRes, error) {
+	res, err := c.sendDeleteProjectIssueComment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTimeseriesParams) (res GetIssuesTimeseriesRes, err error) {
+func (c *Client) sendDeleteProjectIssueComment(ctx context.Context, params DeleteProjectIssueCommentParams) (res DeleteProjectIssueCommentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetIssuesTimeseries"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/issues/timeseries"),
+		otelogen.OperationID("DeleteProjectIssueComment"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}"),
 	}
 
 	// Run stopwatch.
@@ -3125,7 +3523,7 @@ func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetIssuesTimeseriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteProjectIssueCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3142,61 +3540,68 @@ func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTi
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/issues/timeseries"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [6]string
+	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "project_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ProjectID.Get(); ok {
-				return e.EncodeValue(conv.UintToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/issues/"
 	{
-		// Encode "interval" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "interval",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Interval))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
+	pathParts[4] = "/comments/"
 	{
-		// Encode "granularity" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "granularity",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "comment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "comment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.CommentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Granularity))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[5] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3206,7 +3611,7 @@ func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTi
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetIssuesTimeseriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteProjectIssueCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3242,7 +3647,7 @@ func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetIssuesTimeseriesResponse(resp)
+	result, err := decodeDeleteProjectIssueCommentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3250,21 +3655,21 @@ func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTi
 	return result, nil
 }
 
-// GetNotificationRule invokes GetNotificationRule operation.
+// DeleteTeam invokes DeleteTeam operation.
 //
-// Get a specific notification rule.
+// Delete a team.
 //
-// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
-func (c *Client) GetNotificationRule(ctx context.Context, params GetNotificationRuleParams) (GetNotificationRuleRes, error) {
-	res, err := c.sendGetNotificationRule(ctx, params)
+// DELETE /api/v1/teams/{team_id}
+func (c *Client) DeleteTeam(ctx context.Context, params DeleteTeamParams) (DeleteTeamRes, error) {
+	res, err := c.sendDeleteTeam(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotificationRuleParams) (res GetNotificationRuleRes, err error) {
+func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (res DeleteTeamRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetNotificationRule"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+		otelogen.OperationID("DeleteTeam"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
 	}
 
 	// Run stopwatch.
@@ -3279,7 +3684,7 @@ func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotifica
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetNotificationRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3296,17 +3701,17 @@ func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotifica
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
-	pathParts[0] = "/api/v1/projects/"
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/teams/"
 	{
-		// Encode "project_id" parameter.
+		// Encode "team_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
+			Param:   "team_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
+			return e.EncodeValue(conv.UintToString(params.TeamID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3316,48 +3721,10 @@ func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotifica
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
-	{
-		// Encode "setting_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/rules/"
-	{
-		// Encode "rule_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "rule_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.RuleID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3367,7 +3734,7 @@ func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotifica
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetNotificationRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteTeamOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3403,7 +3770,7 @@ func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotifica
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetNotificationRuleResponse(resp)
+	result, err := decodeDeleteTeamResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3411,21 +3778,21 @@ func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotifica
 	return result, nil
 }
 
-// GetNotificationSetting invokes GetNotificationSetting operation.
+// DeleteUser invokes DeleteUser operation.
 //
-// Get a specific notification setting.
+// Delete a user (superuser only, cannot delete superusers).
 //
-// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}
-func (c *Client) GetNotificationSetting(ctx context.Context, params GetNotificationSettingParams) (GetNotificationSettingRes, error) {
-	res, err := c.sendGetNotificationSetting(ctx, params)
+// DELETE /api/v1/users/{user_id}
+func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error) {
+	res, err := c.sendDeleteUser(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotificationSettingParams) (res GetNotificationSettingRes, err error) {
+func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (res DeleteUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetNotificationSetting"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+		otelogen.OperationID("DeleteUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}"),
 	}
 
 	// Run stopwatch.
@@ -3440,7 +3807,7 @@ func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotif
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetNotificationSettingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3457,17 +3824,17 @@ func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotif
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/api/v1/projects/"
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/users/"
 	{
-		// Encode "project_id" parameter.
+		// Encode "user_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
+			Param:   "user_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
+			return e.EncodeValue(conv.UintToString(params.UserID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3477,29 +3844,10 @@ func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotif
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
-	{
-		// Encode "setting_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -3509,7 +3857,7 @@ func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotif
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetNotificationSettingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3545,7 +3893,7 @@ func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotif
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetNotificationSettingResponse(resp)
+	result, err := decodeDeleteUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3553,21 +3901,21 @@ func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotif
 	return result, nil
 }
 
-// GetProject invokes GetProject operation.
+// Disable2FA invokes Disable2FA operation.
 //
-// Get project details.
+// Disable 2FA (using email-confirmation).
 //
-// GET /api/v1/projects/{project_id}
-func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error) {
-	res, err := c.sendGetProject(ctx, params)
+// POST /api/v1/users/me/2fa/disable
+func (c *Client) Disable2FA(ctx context.Context, request *TwoFADisableRequest) (Disable2FARes, error) {
+	res, err := c.sendDisable2FA(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res GetProjectRes, err error) {
+func (c *Client) sendDisable2FA(ctx context.Context, request *TwoFADisableRequest) (res Disable2FARes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProject"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
+		otelogen.OperationID("Disable2FA"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/disable"),
 	}
 
 	// Run stopwatch.
@@ -3582,7 +3930,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, Disable2FAOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3599,40 +3947,25 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/projects/"
-	{
-		// Encode "project_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users/me/2fa/disable"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDisable2FARequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectOperation, r); {
+			switch err := c.securityBearerAuth(ctx, Disable2FAOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3668,7 +4001,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectResponse(resp)
+	result, err := decodeDisable2FAResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3676,21 +4009,21 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	return result, nil
 }
 
-// GetProjectIssueEventsTimeseries invokes GetProjectIssueEventsTimeseries operation.
+// DownloadAttachment invokes DownloadAttachment operation.
 //
-// Get timeseries of events for a specific issue inside a project.
+// Download attachment.
 //
-// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries
-func (c *Client) GetProjectIssueEventsTimeseries(ctx context.Context, params GetProjectIssueEventsTimeseriesParams) (GetProjectIssueEventsTimeseriesRes, error) {
-	res, err := c.sendGetProjectIssueEventsTimeseries(ctx, params)
+// GET /api/v1/projects/{project_id}/attachments/{attachment_id}/download
+func (c *Client) DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (DownloadAttachmentRes, error) {
+	res, err := c.sendDownloadAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params GetProjectIssueEventsTimeseriesParams) (res GetProjectIssueEventsTimeseriesRes, err error) {
+func (c *Client) sendDownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (res DownloadAttachmentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectIssueEventsTimeseries"),
+		otelogen.OperationID("DownloadAttachment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/attachments/{attachment_id}/download"),
 	}
 
 	// Run stopwatch.
@@ -3705,7 +4038,7 @@ func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueEventsTimeseriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DownloadAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3742,16 +4075,16 @@ func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/issues/"
+	pathParts[2] = "/attachments/"
 	{
-		// Encode "issue_id" parameter.
+		// Encode "attachment_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issue_id",
+			Param:   "attachment_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.IssueID))
+			return e.EncodeValue(conv.UintToString(params.AttachmentID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3761,41 +4094,9 @@ func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params
 		}
 		pathParts[3] = encoded
 	}
-	pathParts[4] = "/events/timeseries"
+	pathParts[4] = "/download"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "interval" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "interval",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Interval))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "granularity" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "granularity",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Granularity))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -3807,7 +4108,7 @@ func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectIssueEventsTimeseriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DownloadAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3843,7 +4144,7 @@ func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectIssueEventsTimeseriesResponse(resp)
+	result, err := decodeDownloadAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3851,21 +4152,21 @@ func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params
 	return result, nil
 }
 
-// GetProjectIssueTimeseries invokes GetProjectIssueTimeseries operation.
+// ForgotPassword invokes ForgotPassword operation.
 //
-// Get timeseries for a specific issue inside a project.
+// Request a password reset.
 //
-// GET /api/v1/projects/{project_id}/issues/{issue_id}/timeseries
-func (c *Client) GetProjectIssueTimeseries(ctx context.Context, params GetProjectIssueTimeseriesParams) (GetProjectIssueTimeseriesRes, error) {
-	res, err := c.sendGetProjectIssueTimeseries(ctx, params)
+// POST /api/v1/auth/forgot-password
+func (c *Client) ForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (ForgotPasswordRes, error) {
+	res, err := c.sendForgotPassword(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetProjectIssueTimeseries(ctx context.Context, params GetProjectIssueTimeseriesParams) (res GetProjectIssueTimeseriesRes, err error) {
+func (c *Client) sendForgotPassword(ctx context.Context, request *ForgotPasswordRequest) (res ForgotPasswordRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectIssueTimeseries"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/timeseries"),
+		otelogen.OperationID("ForgotPassword"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/forgot-password"),
 	}
 
 	// Run stopwatch.
@@ -3880,7 +4181,7 @@ func (c *Client) sendGetProjectIssueTimeseries(ctx context.Context, params GetPr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueTimeseriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ForgotPasswordOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3897,117 +4198,17 @@ func (c *Client) sendGetProjectIssueTimeseries(ctx context.Context, params GetPr
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
-	pathParts[0] = "/api/v1/projects/"
-	{
-		// Encode "project_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/issues/"
-	{
-		// Encode "issue_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "issue_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.IssueID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/timeseries"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/forgot-password"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "interval" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "interval",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Interval))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "granularity" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "granularity",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Granularity))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectIssueTimeseriesOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
+	if err := encodeForgotPasswordRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
 	}
 
 	stage = "SendRequest"
@@ -4018,7 +4219,7 @@ func (c *Client) sendGetProjectIssueTimeseries(ctx context.Context, params GetPr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectIssueTimeseriesResponse(resp)
+	result, err := decodeForgotPasswordResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4026,21 +4227,21 @@ func (c *Client) sendGetProjectIssueTimeseries(ctx context.Context, params GetPr
 	return result, nil
 }
 
-// GetProjectReleaseAnalyticsDetails invokes GetProjectReleaseAnalyticsDetails operation.
+// GetCurrentUser invokes GetCurrentUser operation.
 //
-// Get analytics details for a specific release.
+// Get current user information.
 //
-// GET /api/v1/projects/{project_id}/analytics/releases/{version}
-func (c *Client) GetProjectReleaseAnalyticsDetails(ctx context.Context, params GetProjectReleaseAnalyticsDetailsParams) (GetProjectReleaseAnalyticsDetailsRes, error) {
-	res, err := c.sendGetProjectReleaseAnalyticsDetails(ctx, params)
+// GET /api/v1/users/me
+func (c *Client) GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error) {
+	res, err := c.sendGetCurrentUser(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, params GetProjectReleaseAnalyticsDetailsParams) (res GetProjectReleaseAnalyticsDetailsRes, err error) {
+func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleaseAnalyticsDetails"),
+		otelogen.OperationID("GetCurrentUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/{version}"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me"),
 	}
 
 	// Run stopwatch.
@@ -4055,7 +4256,7 @@ func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, para
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleaseAnalyticsDetailsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4072,45 +4273,8 @@ func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, para
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/api/v1/projects/"
-	{
-		// Encode "project_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/analytics/releases/"
-	{
-		// Encode "version" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "version",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Version))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users/me"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4124,7 +4288,7 @@ func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, para
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectReleaseAnalyticsDetailsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetCurrentUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4160,7 +4324,7 @@ func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, para
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectReleaseAnalyticsDetailsResponse(resp)
+	result, err := decodeGetCurrentUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4168,21 +4332,21 @@ func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, para
 	return result, nil
 }
 
-// GetProjectReleaseErrorsTimeseries invokes GetProjectReleaseErrorsTimeseries operation.
+// GetEventsTimeseries invokes GetEventsTimeseries operation.
 //
-// Get errors timeseries for a release.
+// Get events timeseries.
 //
-// GET /api/v1/projects/{project_id}/analytics/traffic
-func (c *Client) GetProjectReleaseErrorsTimeseries(ctx context.Context, params GetProjectReleaseErrorsTimeseriesParams) (GetProjectReleaseErrorsTimeseriesRes, error) {
-	res, err := c.sendGetProjectReleaseErrorsTimeseries(ctx, params)
+// GET /api/v1/events/timeseries
+func (c *Client) GetEventsTimeseries(ctx context.Context, params GetEventsTimeseriesParams) (GetEventsTimeseriesRes, error) {
+	res, err := c.sendGetEventsTimeseries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, params GetProjectReleaseErrorsTimeseriesParams) (res GetProjectReleaseErrorsTimeseriesRes, err error) {
+func (c *Client) sendGetEventsTimeseries(ctx context.Context, params GetEventsTimeseriesParams) (res GetEventsTimeseriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleaseErrorsTimeseries"),
+		otelogen.OperationID("GetEventsTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/traffic"),
+		semconv.HTTPRouteKey.String("/api/v1/events/timeseries"),
 	}
 
 	// Run stopwatch.
@@ -4197,7 +4361,7 @@ func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, para
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleaseErrorsTimeseriesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetEventsTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4214,56 +4378,23 @@ func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, para
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/projects/"
-	{
-		// Encode "project_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/analytics/traffic"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/events/timeseries"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "release" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "release",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Release))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "level" parameter.
+		// Encode "project_id" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "level",
+			Name:    "project_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Level.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
+			if val, ok := params.ProjectID.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -4271,46 +4402,46 @@ func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, para
 		}
 	}
 	{
-		// Encode "group_by" parameter.
+		// Encode "interval" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "group_by",
+			Name:    "interval",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.GroupBy.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.Interval))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "interval" parameter.
+		// Encode "granularity" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "interval",
+			Name:    "granularity",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Interval))
+			return e.EncodeValue(conv.StringToString(params.Granularity))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "granularity" parameter.
+		// Encode "environment" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "granularity",
+			Name:    "environment",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Granularity))
+			if val, ok := params.Environment.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -4328,7 +4459,7 @@ func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, para
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectReleaseErrorsTimeseriesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetEventsTimeseriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4364,7 +4495,7 @@ func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, para
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectReleaseErrorsTimeseriesResponse(resp)
+	result, err := decodeGetEventsTimeseriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4372,21 +4503,21 @@ func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, para
 	return result, nil
 }
 
-// GetProjectReleaseSegments invokes GetProjectReleaseSegments operation.
+// GetIssue invokes GetIssue operation.
 //
-// Get user segments analytics for a release.
+// Get details of a specific issue.
 //
-// GET /api/v1/projects/{project_id}/analytics/segments
-func (c *Client) GetProjectReleaseSegments(ctx context.Context, params GetProjectReleaseSegmentsParams) (GetProjectReleaseSegmentsRes, error) {
-	res, err := c.sendGetProjectReleaseSegments(ctx, params)
+// GET /api/v1/projects/{project_id}/issues/{issue_id}
+func (c *Client) GetIssue(ctx context.Context, params GetIssueParams) (GetIssueRes, error) {
+	res, err := c.sendGetIssue(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetProjectReleaseSegmentsParams) (res GetProjectReleaseSegmentsRes, err error) {
+func (c *Client) sendGetIssue(ctx context.Context, params GetIssueParams) (res GetIssueRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleaseSegments"),
+		otelogen.OperationID("GetIssue"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/segments"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}"),
 	}
 
 	// Run stopwatch.
@@ -4401,7 +4532,7 @@ func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetPr
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleaseSegmentsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4418,7 +4549,7 @@ func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetPr
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [4]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -4438,40 +4569,26 @@ func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetPr
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/analytics/segments"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "release" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "release",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Release))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	pathParts[2] = "/issues/"
 	{
-		// Encode "segment" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "segment",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(string(params.Segment)))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4484,7 +4601,7 @@ func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetPr
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectReleaseSegmentsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetIssueOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4520,7 +4637,7 @@ func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetPr
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectReleaseSegmentsResponse(resp)
+	result, err := decodeGetIssueResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4528,21 +4645,21 @@ func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetPr
 	return result, nil
 }
 
-// GetProjectReleasesAnalytics invokes GetProjectReleasesAnalytics operation.
+// GetIssuesTimeseries invokes GetIssuesTimeseries operation.
 //
-// Get analytics summary for all releases in project.
+// Get issues timeseries.
 //
-// GET /api/v1/projects/{project_id}/analytics/releases
-func (c *Client) GetProjectReleasesAnalytics(ctx context.Context, params GetProjectReleasesAnalyticsParams) (GetProjectReleasesAnalyticsRes, error) {
-	res, err := c.sendGetProjectReleasesAnalytics(ctx, params)
+// GET /api/v1/issues/timeseries
+func (c *Client) GetIssuesTimeseries(ctx context.Context, params GetIssuesTimeseriesParams) (GetIssuesTimeseriesRes, error) {
+	res, err := c.sendGetIssuesTimeseries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params GetProjectReleasesAnalyticsParams) (res GetProjectReleasesAnalyticsRes, err error) {
+func (c *Client) sendGetIssuesTimeseries(ctx context.Context, params GetIssuesTimeseriesParams) (res GetIssuesTimeseriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleasesAnalytics"),
+		otelogen.OperationID("GetIssuesTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases"),
+		semconv.HTTPRouteKey.String("/api/v1/issues/timeseries"),
 	}
 
 	// Run stopwatch.
@@ -4557,7 +4674,7 @@ func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params Get
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleasesAnalyticsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetIssuesTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4574,28 +4691,58 @@ func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params Get
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/projects/"
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/issues/timeseries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
 		// Encode "project_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.ProjectID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "project_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ProjectID.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/analytics/releases"
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Interval))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "granularity" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Granularity))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4608,7 +4755,7 @@ func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params Get
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectReleasesAnalyticsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetIssuesTimeseriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4644,7 +4791,7 @@ func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params Get
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectReleasesAnalyticsResponse(resp)
+	result, err := decodeGetIssuesTimeseriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4652,21 +4799,21 @@ func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params Get
 	return result, nil
 }
 
-// GetProjectStats invokes GetProjectStats operation.
+// GetMonitor invokes GetMonitor operation.
 //
-// Get project statistics.
+// Get cron monitor with recent check-ins.
 //
-// GET /api/v1/projects/{project_id}/stats
-func (c *Client) GetProjectStats(ctx context.Context, params GetProjectStatsParams) (GetProjectStatsRes, error) {
-	res, err := c.sendGetProjectStats(ctx, params)
+// GET /api/v1/projects/{project_id}/monitors/{monitor_id}
+func (c *Client) GetMonitor(ctx context.Context, params GetMonitorParams) (GetMonitorRes, error) {
+	res, err := c.sendGetMonitor(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStatsParams) (res GetProjectStatsRes, err error) {
+func (c *Client) sendGetMonitor(ctx context.Context, params GetMonitorParams) (res GetMonitorRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectStats"),
+		otelogen.OperationID("GetMonitor"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/stats"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/monitors/{monitor_id}"),
 	}
 
 	// Run stopwatch.
@@ -4681,7 +4828,7 @@ func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStats
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectStatsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMonitorOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4698,7 +4845,7 @@ func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStats
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [4]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -4718,26 +4865,26 @@ func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStats
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/stats"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	pathParts[2] = "/monitors/"
 	{
-		// Encode "period" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "period",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "monitor_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "monitor_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.MonitorID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(string(params.Period)))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4750,7 +4897,7 @@ func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStats
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectStatsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetMonitorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4786,7 +4933,7 @@ func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStats
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectStatsResponse(resp)
+	result, err := decodeGetMonitorResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4794,21 +4941,21 @@ func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStats
 	return result, nil
 }
 
-// GetProjectTeam invokes GetProjectTeam operation.
+// GetNotificationRule invokes GetNotificationRule operation.
 //
-// Get project team.
+// Get a specific notification rule.
 //
-// GET /api/v1/projects/{project_id}/team
-func (c *Client) GetProjectTeam(ctx context.Context, params GetProjectTeamParams) (GetProjectTeamRes, error) {
-	res, err := c.sendGetProjectTeam(ctx, params)
+// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
+func (c *Client) GetNotificationRule(ctx context.Context, params GetNotificationRuleParams) (GetNotificationRuleRes, error) {
+	res, err := c.sendGetNotificationRule(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamParams) (res GetProjectTeamRes, err error) {
+func (c *Client) sendGetNotificationRule(ctx context.Context, params GetNotificationRuleParams) (res GetNotificationRuleRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectTeam"),
+		otelogen.OperationID("GetNotificationRule"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/team"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
 	}
 
 	// Run stopwatch.
@@ -4823,7 +4970,7 @@ func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectTeamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetNotificationRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4840,7 +4987,7 @@ func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [6]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -4860,7 +5007,44 @@ func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/team"
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/rules/"
+	{
+		// Encode "rule_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "rule_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.RuleID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -4874,7 +5058,7 @@ func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamPa
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectTeamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetNotificationRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4910,7 +5094,7 @@ func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectTeamResponse(resp)
+	result, err := decodeGetNotificationRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4918,21 +5102,21 @@ func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamPa
 	return result, nil
 }
 
-// GetRecentIssues invokes GetRecentIssues operation.
+// GetNotificationSetting invokes GetNotificationSetting operation.
 //
-// Get recent issues across all projects.
+// Get a specific notification setting.
 //
-// GET /api/v1/issues/recent
-func (c *Client) GetRecentIssues(ctx context.Context, params GetRecentIssuesParams) (GetRecentIssuesRes, error) {
-	res, err := c.sendGetRecentIssues(ctx, params)
+// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}
+func (c *Client) GetNotificationSetting(ctx context.Context, params GetNotificationSettingParams) (GetNotificationSettingRes, error) {
+	res, err := c.sendGetNotificationSetting(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssuesParams) (res GetRecentIssuesRes, err error) {
+func (c *Client) sendGetNotificationSetting(ctx context.Context, params GetNotificationSettingParams) (res GetNotificationSettingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetRecentIssues"),
+		otelogen.OperationID("GetNotificationSetting"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/issues/recent"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
 	}
 
 	// Run stopwatch.
@@ -4947,7 +5131,7 @@ func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssues
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetRecentIssuesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetNotificationSettingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -4964,27 +5148,46 @@ func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssues
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/issues/recent"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.UintToString(params.Limit))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -4997,7 +5200,7 @@ func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssues
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetRecentIssuesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetNotificationSettingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5033,7 +5236,7 @@ func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssues
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetRecentIssuesResponse(resp)
+	result, err := decodeGetNotificationSettingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5041,21 +5244,21 @@ func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssues
 	return result, nil
 }
 
-// GetTeam invokes GetTeam operation.
+// GetProject invokes GetProject operation.
 //
-// Get team details.
+// Get project details.
 //
-// GET /api/v1/teams/{team_id}
-func (c *Client) GetTeam(ctx context.Context, params GetTeamParams) (GetTeamRes, error) {
-	res, err := c.sendGetTeam(ctx, params)
+// GET /api/v1/projects/{project_id}
+func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error) {
+	res, err := c.sendGetProject(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res GetTeamRes, err error) {
+func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res GetProjectRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetTeam"),
+		otelogen.OperationID("GetProject"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
 	}
 
 	// Run stopwatch.
@@ -5070,7 +5273,7 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res Get
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetTeamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5088,16 +5291,16 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res Get
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/v1/teams/"
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "team_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.TeamID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5120,7 +5323,7 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res Get
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetTeamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5156,7 +5359,7 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res Get
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetTeamResponse(resp)
+	result, err := decodeGetProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5164,21 +5367,21 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res Get
 	return result, nil
 }
 
-// GetUnreadNotificationsCount invokes GetUnreadNotificationsCount operation.
+// GetProjectDataScrubbing invokes GetProjectDataScrubbing operation.
 //
-// Get unread notifications count.
+// Get project data scrubbing.
 //
-// GET /api/v1/notifications/unread-count
-func (c *Client) GetUnreadNotificationsCount(ctx context.Context) (GetUnreadNotificationsCountRes, error) {
-	res, err := c.sendGetUnreadNotificationsCount(ctx)
+// GET /api/v1/projects/{project_id}/data-scrubbing
+func (c *Client) GetProjectDataScrubbing(ctx context.Context, params GetProjectDataScrubbingParams) (GetProjectDataScrubbingRes, error) {
+	res, err := c.sendGetProjectDataScrubbing(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUnreadNotificationsCountRes, err error) {
+func (c *Client) sendGetProjectDataScrubbing(ctx context.Context, params GetProjectDataScrubbingParams) (res GetProjectDataScrubbingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetUnreadNotificationsCount"),
+		otelogen.OperationID("GetProjectDataScrubbing"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications/unread-count"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/data-scrubbing"),
 	}
 
 	// Run stopwatch.
@@ -5193,7 +5396,7 @@ func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUn
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUnreadNotificationsCountOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectDataScrubbingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5210,8 +5413,27 @@ func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUn
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/notifications/unread-count"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/data-scrubbing"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5225,7 +5447,7 @@ func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUn
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetUnreadNotificationsCountOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectDataScrubbingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5261,7 +5483,7 @@ func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUn
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUnreadNotificationsCountResponse(resp)
+	result, err := decodeGetProjectDataScrubbingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5269,21 +5491,21 @@ func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUn
 	return result, nil
 }
 
-// GetUserNotifications invokes GetUserNotifications operation.
+// GetProjectEvent invokes GetProjectEvent operation.
 //
-// Get user notifications.
+// Get event.
 //
-// GET /api/v1/notifications
-func (c *Client) GetUserNotifications(ctx context.Context, params GetUserNotificationsParams) (GetUserNotificationsRes, error) {
-	res, err := c.sendGetUserNotifications(ctx, params)
+// GET /api/v1/projects/{project_id}/events/{event_id}
+func (c *Client) GetProjectEvent(ctx context.Context, params GetProjectEventParams) (GetProjectEventRes, error) {
+	res, err := c.sendGetProjectEvent(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNotificationsParams) (res GetUserNotificationsRes, err error) {
+func (c *Client) sendGetProjectEvent(ctx context.Context, params GetProjectEventParams) (res GetProjectEventRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetUserNotifications"),
+		otelogen.OperationID("GetProjectEvent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/events/{event_id}"),
 	}
 
 	// Run stopwatch.
@@ -5298,7 +5520,7 @@ func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNot
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetUserNotificationsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectEventOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5315,47 +5537,46 @@ func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNot
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/notifications"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.UintToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/events/"
 	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "event_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "event_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.EventID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.UintToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[3] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -5368,7 +5589,7 @@ func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNot
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetUserNotificationsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectEventOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5404,7 +5625,7 @@ func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNot
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetUserNotificationsResponse(resp)
+	result, err := decodeGetProjectEventResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5412,22 +5633,21 @@ func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNot
 	return result, nil
 }
 
-// GetVersions invokes GetVersions operation.
+// GetProjectGroupingConfig invokes GetProjectGroupingConfig operation.
 //
-// Returns versions and build times of all Warden system components by collecting data from their
-// tech servers.
+// Get project grouping configuration.
 //
-// GET /api/v1/versions
-func (c *Client) GetVersions(ctx context.Context) (GetVersionsRes, error) {
-	res, err := c.sendGetVersions(ctx)
+// GET /api/v1/projects/{project_id}/grouping-config
+func (c *Client) GetProjectGroupingConfig(ctx context.Context, params GetProjectGroupingConfigParams) (GetProjectGroupingConfigRes, error) {
+	res, err := c.sendGetProjectGroupingConfig(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err error) {
+func (c *Client) sendGetProjectGroupingConfig(ctx context.Context, params GetProjectGroupingConfigParams) (res GetProjectGroupingConfigRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetVersions"),
+		otelogen.OperationID("GetProjectGroupingConfig"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/versions"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-config"),
 	}
 
 	// Run stopwatch.
@@ -5442,7 +5662,7 @@ func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetVersionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectGroupingConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5459,8 +5679,27 @@ func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/versions"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-config"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5469,6 +5708,39 @@ func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err e
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectGroupingConfigOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -5477,7 +5749,7 @@ func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetVersionsResponse(resp)
+	result, err := decodeGetProjectGroupingConfigResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5485,21 +5757,21 @@ func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err e
 	return result, nil
 }
 
-// ListIssues invokes ListIssues operation.
+// GetProjectInboundFilters invokes GetProjectInboundFilters operation.
 //
-// Get all issues across all projects.
+// Get project inbound filters.
 //
-// GET /api/v1/issues
-func (c *Client) ListIssues(ctx context.Context, params ListIssuesParams) (ListIssuesRes, error) {
-	res, err := c.sendListIssues(ctx, params)
+// GET /api/v1/projects/{project_id}/inbound-filters
+func (c *Client) GetProjectInboundFilters(ctx context.Context, params GetProjectInboundFiltersParams) (GetProjectInboundFiltersRes, error) {
+	res, err := c.sendGetProjectInboundFilters(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (res ListIssuesRes, err error) {
+func (c *Client) sendGetProjectInboundFilters(ctx context.Context, params GetProjectInboundFiltersParams) (res GetProjectInboundFiltersRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListIssues"),
+		otelogen.OperationID("GetProjectInboundFilters"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/issues"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/inbound-filters"),
 	}
 
 	// Run stopwatch.
@@ -5514,7 +5786,7 @@ func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListIssuesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectInboundFiltersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5531,126 +5803,28 @@ func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/issues"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "level" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "level",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Level.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "status" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Status.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "project_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ProjectID.Get(); ok {
-				return e.EncodeValue(conv.UintToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "per_page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "per_page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.UintToString(params.PerPage))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.UintToString(params.Page))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sort_by" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sort_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SortBy.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "sort_order" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "sort_order",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.SortOrder.Get(); ok {
-				return e.EncodeValue(conv.StringToString(string(val)))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/inbound-filters"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -5663,7 +5837,7 @@ func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListIssuesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectInboundFiltersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5699,7 +5873,7 @@ func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListIssuesResponse(resp)
+	result, err := decodeGetProjectInboundFiltersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5707,21 +5881,21 @@ func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (r
 	return result, nil
 }
 
-// ListNotificationRules invokes ListNotificationRules operation.
+// GetProjectIssueEventsTimeseries invokes GetProjectIssueEventsTimeseries operation.
 //
-// List all notification rules for notification settings of project.
+// Get timeseries of events for a specific issue inside a project.
 //
-// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
-func (c *Client) ListNotificationRules(ctx context.Context, params ListNotificationRulesParams) (ListNotificationRulesRes, error) {
-	res, err := c.sendListNotificationRules(ctx, params)
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries
+func (c *Client) GetProjectIssueEventsTimeseries(ctx context.Context, params GetProjectIssueEventsTimeseriesParams) (GetProjectIssueEventsTimeseriesRes, error) {
+	res, err := c.sendGetProjectIssueEventsTimeseries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotificationRulesParams) (res ListNotificationRulesRes, err error) {
+func (c *Client) sendGetProjectIssueEventsTimeseries(ctx context.Context, params GetProjectIssueEventsTimeseriesParams) (res GetProjectIssueEventsTimeseriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListNotificationRules"),
+		otelogen.OperationID("GetProjectIssueEventsTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries"),
 	}
 
 	// Run stopwatch.
@@ -5736,7 +5910,7 @@ func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotif
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationRulesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueEventsTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5773,16 +5947,16 @@ func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotif
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
+	pathParts[2] = "/issues/"
 	{
-		// Encode "setting_id" parameter.
+		// Encode "issue_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
+			Param:   "issue_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
+			return e.EncodeValue(conv.UintToString(params.IssueID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -5792,9 +5966,58 @@ func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotif
 		}
 		pathParts[3] = encoded
 	}
-	pathParts[4] = "/rules"
+	pathParts[4] = "/events/timeseries"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Interval))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "granularity" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Granularity))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "environment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Environment.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -5806,7 +6029,7 @@ func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotif
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListNotificationRulesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectIssueEventsTimeseriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5842,7 +6065,7 @@ func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotif
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListNotificationRulesResponse(resp)
+	result, err := decodeGetProjectIssueEventsTimeseriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5850,21 +6073,21 @@ func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotif
 	return result, nil
 }
 
-// ListNotificationSettings invokes ListNotificationSettings operation.
+// GetProjectIssueLatestEvent invokes GetProjectIssueLatestEvent operation.
 //
-// List all notification settings for a project.
+// Get the latest issue event.
 //
-// GET /api/v1/projects/{project_id}/notification-settings
-func (c *Client) ListNotificationSettings(ctx context.Context, params ListNotificationSettingsParams) (ListNotificationSettingsRes, error) {
-	res, err := c.sendListNotificationSettings(ctx, params)
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/latest
+func (c *Client) GetProjectIssueLatestEvent(ctx context.Context, params GetProjectIssueLatestEventParams) (GetProjectIssueLatestEventRes, error) {
+	res, err := c.sendGetProjectIssueLatestEvent(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNotificationSettingsParams) (res ListNotificationSettingsRes, err error) {
+func (c *Client) sendGetProjectIssueLatestEvent(ctx context.Context, params GetProjectIssueLatestEventParams) (res GetProjectIssueLatestEventRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListNotificationSettings"),
+		otelogen.OperationID("GetProjectIssueLatestEvent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/latest"),
 	}
 
 	// Run stopwatch.
@@ -5879,7 +6102,7 @@ func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationSettingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueLatestEventOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -5896,7 +6119,7 @@ func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNo
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [5]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -5916,7 +6139,26 @@ func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings"
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/events/latest"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -5930,7 +6172,7 @@ func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNo
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListNotificationSettingsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectIssueLatestEventOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5966,7 +6208,7 @@ func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListNotificationSettingsResponse(resp)
+	result, err := decodeGetProjectIssueLatestEventResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5974,21 +6216,21 @@ func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNo
 	return result, nil
 }
 
-// ListProjects invokes ListProjects operation.
+// GetProjectIssueOldestEvent invokes GetProjectIssueOldestEvent operation.
 //
-// Get projects list.
+// Get the oldest issue event.
 //
-// GET /api/v1/projects
-func (c *Client) ListProjects(ctx context.Context) (ListProjectsRes, error) {
-	res, err := c.sendListProjects(ctx)
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/oldest
+func (c *Client) GetProjectIssueOldestEvent(ctx context.Context, params GetProjectIssueOldestEventParams) (GetProjectIssueOldestEventRes, error) {
+	res, err := c.sendGetProjectIssueOldestEvent(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err error) {
+func (c *Client) sendGetProjectIssueOldestEvent(ctx context.Context, params GetProjectIssueOldestEventParams) (res GetProjectIssueOldestEventRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListProjects"),
+		otelogen.OperationID("GetProjectIssueOldestEvent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/oldest"),
 	}
 
 	// Run stopwatch.
@@ -6003,7 +6245,7 @@ func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueOldestEventOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6020,8 +6262,46 @@ func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/projects"
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/events/oldest"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -6035,7 +6315,7 @@ func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListProjectsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectIssueOldestEventOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6071,7 +6351,7 @@ func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListProjectsResponse(resp)
+	result, err := decodeGetProjectIssueOldestEventResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6079,21 +6359,21 @@ func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err
 	return result, nil
 }
 
-// ListTeams invokes ListTeams operation.
+// GetProjectIssueTags invokes GetProjectIssueTags operation.
 //
-// List all teams.
+// Get tags of issue events.
 //
-// GET /api/v1/teams
-func (c *Client) ListTeams(ctx context.Context) (ListTeamsRes, error) {
-	res, err := c.sendListTeams(ctx)
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags
+func (c *Client) GetProjectIssueTags(ctx context.Context, params GetProjectIssueTagsParams) (GetProjectIssueTagsRes, error) {
+	res, err := c.sendGetProjectIssueTags(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error) {
+func (c *Client) sendGetProjectIssueTags(ctx context.Context, params GetProjectIssueTagsParams) (res GetProjectIssueTagsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListTeams"),
+		otelogen.OperationID("GetProjectIssueTags"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/teams"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/tags"),
 	}
 
 	// Run stopwatch.
@@ -6108,7 +6388,7 @@ func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTeamsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6125,10 +6405,69 @@ func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/teams"
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tags"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -6140,7 +6479,7 @@ func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTeamsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectIssueTagsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6176,7 +6515,7 @@ func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTeamsResponse(resp)
+	result, err := decodeGetProjectIssueTagsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6184,21 +6523,21 @@ func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error
 	return result, nil
 }
 
-// ListUsers invokes ListUsers operation.
+// GetProjectIssueTimeseries invokes GetProjectIssueTimeseries operation.
 //
-// List all users (superuser only).
+// Get timeseries for a specific issue inside a project.
 //
-// GET /api/v1/users
-func (c *Client) ListUsers(ctx context.Context) (ListUsersRes, error) {
-	res, err := c.sendListUsers(ctx)
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/timeseries
+func (c *Client) GetProjectIssueTimeseries(ctx context.Context, params GetProjectIssueTimeseriesParams) (GetProjectIssueTimeseriesRes, error) {
+	res, err := c.sendGetProjectIssueTimeseries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListUsers(ctx context.Context) (res ListUsersRes, err error) {
+func (c *Client) sendGetProjectIssueTimeseries(ctx context.Context, params GetProjectIssueTimeseriesParams) (res GetProjectIssueTimeseriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListUsers"),
+		otelogen.OperationID("GetProjectIssueTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/users"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/timeseries"),
 	}
 
 	// Run stopwatch.
@@ -6213,7 +6552,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res ListUsersRes, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectIssueTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6230,28 +6569,98 @@ func (c *Client) sendListUsers(ctx context.Context) (res ListUsersRes, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/timeseries"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Interval))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "granularity" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Granularity))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectIssueTimeseriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
 			}
 		}
 
@@ -6281,7 +6690,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res ListUsersRes, err error
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUsersResponse(resp)
+	result, err := decodeGetProjectIssueTimeseriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6289,21 +6698,21 @@ func (c *Client) sendListUsers(ctx context.Context) (res ListUsersRes, err error
 	return result, nil
 }
 
-// ListUsersForTeam invokes ListUsersForTeam operation.
+// GetProjectOutcomes invokes GetProjectOutcomes operation.
 //
-// List all users for team admin.
+// Outcomes are stored hourly, a granularity finer than 1h is rounded up.
 //
-// GET /api/v1/users/team/{team_id}/list
-func (c *Client) ListUsersForTeam(ctx context.Context, params ListUsersForTeamParams) (ListUsersForTeamRes, error) {
-	res, err := c.sendListUsersForTeam(ctx, params)
+// GET /api/v1/projects/{project_id}/outcomes
+func (c *Client) GetProjectOutcomes(ctx context.Context, params GetProjectOutcomesParams) (GetProjectOutcomesRes, error) {
+	res, err := c.sendGetProjectOutcomes(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTeamParams) (res ListUsersForTeamRes, err error) {
+func (c *Client) sendGetProjectOutcomes(ctx context.Context, params GetProjectOutcomesParams) (res GetProjectOutcomesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListUsersForTeam"),
+		otelogen.OperationID("GetProjectOutcomes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/users/team/{team_id}/list"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/outcomes"),
 	}
 
 	// Run stopwatch.
@@ -6318,7 +6727,7 @@ func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersForTeamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOutcomesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6336,16 +6745,16 @@ func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/api/v1/users/team/"
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "team_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.TeamID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6355,9 +6764,41 @@ func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTe
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/list"
+	pathParts[2] = "/outcomes"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Interval))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "granularity" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Granularity))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -6369,7 +6810,7 @@ func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListUsersForTeamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectOutcomesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6405,7 +6846,7 @@ func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListUsersForTeamResponse(resp)
+	result, err := decodeGetProjectOutcomesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6413,21 +6854,21 @@ func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTe
 	return result, nil
 }
 
-// Login invokes Login operation.
+// GetProjectRateLimits invokes GetProjectRateLimits operation.
 //
-// Authenticate user and get access token.
+// Get project rate limits.
 //
-// POST /api/v1/auth/login
-func (c *Client) Login(ctx context.Context, request *LoginRequest) (LoginRes, error) {
-	res, err := c.sendLogin(ctx, request)
+// GET /api/v1/projects/{project_id}/rate-limits
+func (c *Client) GetProjectRateLimits(ctx context.Context, params GetProjectRateLimitsParams) (GetProjectRateLimitsRes, error) {
+	res, err := c.sendGetProjectRateLimits(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res LoginRes, err error) {
+func (c *Client) sendGetProjectRateLimits(ctx context.Context, params GetProjectRateLimitsParams) (res GetProjectRateLimitsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Login"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/login"),
+		otelogen.OperationID("GetProjectRateLimits"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/rate-limits"),
 	}
 
 	// Run stopwatch.
@@ -6442,7 +6883,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectRateLimitsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6459,17 +6900,66 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/login"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/rate-limits"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeLoginRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectRateLimitsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -6480,7 +6970,7 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLoginResponse(resp)
+	result, err := decodeGetProjectRateLimitsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6488,21 +6978,21 @@ func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res Logi
 	return result, nil
 }
 
-// MarkAllNotificationsAsRead invokes MarkAllNotificationsAsRead operation.
+// GetProjectReleaseAnalyticsDetails invokes GetProjectReleaseAnalyticsDetails operation.
 //
-// Mark all notifications as read.
+// Get analytics details for a specific release.
 //
-// PUT /api/v1/notifications/read-all
-func (c *Client) MarkAllNotificationsAsRead(ctx context.Context) (MarkAllNotificationsAsReadRes, error) {
-	res, err := c.sendMarkAllNotificationsAsRead(ctx)
+// GET /api/v1/projects/{project_id}/analytics/releases/{version}
+func (c *Client) GetProjectReleaseAnalyticsDetails(ctx context.Context, params GetProjectReleaseAnalyticsDetailsParams) (GetProjectReleaseAnalyticsDetailsRes, error) {
+	res, err := c.sendGetProjectReleaseAnalyticsDetails(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMarkAllNotificationsAsRead(ctx context.Context) (res MarkAllNotificationsAsReadRes, err error) {
+func (c *Client) sendGetProjectReleaseAnalyticsDetails(ctx context.Context, params GetProjectReleaseAnalyticsDetailsParams) (res GetProjectReleaseAnalyticsDetailsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("MarkAllNotificationsAsRead"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications/read-all"),
+		otelogen.OperationID("GetProjectReleaseAnalyticsDetails"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/{version}"),
 	}
 
 	// Run stopwatch.
@@ -6517,7 +7007,7 @@ func (c *Client) sendMarkAllNotificationsAsRead(ctx context.Context) (res MarkAl
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MarkAllNotificationsAsReadOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleaseAnalyticsDetailsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6534,29 +7024,66 @@ func (c *Client) sendMarkAllNotificationsAsRead(ctx context.Context) (res MarkAl
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/notifications/read-all"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MarkAllNotificationsAsReadOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/analytics/releases/"
+	{
+		// Encode "version" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "version",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Version))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectReleaseAnalyticsDetailsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
 		}
 
 		if ok := func() bool {
@@ -6585,7 +7112,7 @@ func (c *Client) sendMarkAllNotificationsAsRead(ctx context.Context) (res MarkAl
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMarkAllNotificationsAsReadResponse(resp)
+	result, err := decodeGetProjectReleaseAnalyticsDetailsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6593,21 +7120,21 @@ func (c *Client) sendMarkAllNotificationsAsRead(ctx context.Context) (res MarkAl
 	return result, nil
 }
 
-// MarkNotificationAsRead invokes MarkNotificationAsRead operation.
+// GetProjectReleaseErrorsTimeseries invokes GetProjectReleaseErrorsTimeseries operation.
 //
-// Mark notification as read.
+// Get errors timeseries for a release.
 //
-// PUT /api/v1/notifications/{notification_id}/read
-func (c *Client) MarkNotificationAsRead(ctx context.Context, params MarkNotificationAsReadParams) (MarkNotificationAsReadRes, error) {
-	res, err := c.sendMarkNotificationAsRead(ctx, params)
+// GET /api/v1/projects/{project_id}/analytics/traffic
+func (c *Client) GetProjectReleaseErrorsTimeseries(ctx context.Context, params GetProjectReleaseErrorsTimeseriesParams) (GetProjectReleaseErrorsTimeseriesRes, error) {
+	res, err := c.sendGetProjectReleaseErrorsTimeseries(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNotificationAsReadParams) (res MarkNotificationAsReadRes, err error) {
+func (c *Client) sendGetProjectReleaseErrorsTimeseries(ctx context.Context, params GetProjectReleaseErrorsTimeseriesParams) (res GetProjectReleaseErrorsTimeseriesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("MarkNotificationAsRead"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications/{notification_id}/read"),
+		otelogen.OperationID("GetProjectReleaseErrorsTimeseries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/traffic"),
 	}
 
 	// Run stopwatch.
@@ -6622,7 +7149,7 @@ func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNoti
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, MarkNotificationAsReadOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleaseErrorsTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6640,16 +7167,16 @@ func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNoti
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/api/v1/notifications/"
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "notification_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "notification_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.NotificationID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6659,11 +7186,108 @@ func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNoti
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/read"
+	pathParts[2] = "/analytics/traffic"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "release" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "release",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Release))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "level" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "level",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Level.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "group_by" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "group_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.GroupBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Interval))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "granularity" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Granularity))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "environment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Environment.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -6673,7 +7297,7 @@ func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNoti
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, MarkNotificationAsReadOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectReleaseErrorsTimeseriesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -6709,7 +7333,7 @@ func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNoti
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeMarkNotificationAsReadResponse(resp)
+	result, err := decodeGetProjectReleaseErrorsTimeseriesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6717,21 +7341,21 @@ func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNoti
 	return result, nil
 }
 
-// RecentProjectsList invokes RecentProjectsList operation.
+// GetProjectReleaseSegments invokes GetProjectReleaseSegments operation.
 //
-// Get recent projects list.
+// Get user segments analytics for a release.
 //
-// GET /api/v1/projects/recent
-func (c *Client) RecentProjectsList(ctx context.Context) (RecentProjectsListRes, error) {
-	res, err := c.sendRecentProjectsList(ctx)
+// GET /api/v1/projects/{project_id}/analytics/segments
+func (c *Client) GetProjectReleaseSegments(ctx context.Context, params GetProjectReleaseSegmentsParams) (GetProjectReleaseSegmentsRes, error) {
+	res, err := c.sendGetProjectReleaseSegments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRecentProjectsList(ctx context.Context) (res RecentProjectsListRes, err error) {
+func (c *Client) sendGetProjectReleaseSegments(ctx context.Context, params GetProjectReleaseSegmentsParams) (res GetProjectReleaseSegmentsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("RecentProjectsList"),
+		otelogen.OperationID("GetProjectReleaseSegments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/recent"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/segments"),
 	}
 
 	// Run stopwatch.
@@ -6746,7 +7370,7 @@ func (c *Client) sendRecentProjectsList(ctx context.Context) (res RecentProjects
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RecentProjectsListOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleaseSegmentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6763,33 +7387,101 @@ func (c *Client) sendRecentProjectsList(ctx context.Context) (res RecentProjects
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/projects/recent"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/analytics/segments"
+	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RecentProjectsListOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"BearerAuth\"")
-			}
+		// Encode "release" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "release",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Release))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "segment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "segment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(string(params.Segment)))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "environment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Environment.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectReleaseSegmentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 			} {
@@ -6814,7 +7506,7 @@ func (c *Client) sendRecentProjectsList(ctx context.Context) (res RecentProjects
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRecentProjectsListResponse(resp)
+	result, err := decodeGetProjectReleaseSegmentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6822,21 +7514,21 @@ func (c *Client) sendRecentProjectsList(ctx context.Context) (res RecentProjects
 	return result, nil
 }
 
-// RefreshToken invokes RefreshToken operation.
+// GetProjectReleasesAnalytics invokes GetProjectReleasesAnalytics operation.
 //
-// Refresh access token.
+// Get analytics summary for all releases in project.
 //
-// POST /api/v1/auth/refresh
-func (c *Client) RefreshToken(ctx context.Context, request *RefreshTokenRequest) (RefreshTokenRes, error) {
-	res, err := c.sendRefreshToken(ctx, request)
+// GET /api/v1/projects/{project_id}/analytics/releases
+func (c *Client) GetProjectReleasesAnalytics(ctx context.Context, params GetProjectReleasesAnalyticsParams) (GetProjectReleasesAnalyticsRes, error) {
+	res, err := c.sendGetProjectReleasesAnalytics(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequest) (res RefreshTokenRes, err error) {
+func (c *Client) sendGetProjectReleasesAnalytics(ctx context.Context, params GetProjectReleasesAnalyticsParams) (res GetProjectReleasesAnalyticsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("RefreshToken"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/refresh"),
+		otelogen.OperationID("GetProjectReleasesAnalytics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases"),
 	}
 
 	// Run stopwatch.
@@ -6851,7 +7543,7 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequ
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RefreshTokenOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectReleasesAnalyticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6868,17 +7560,66 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequ
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/refresh"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/analytics/releases"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRefreshTokenRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectReleasesAnalyticsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -6889,7 +7630,7 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequ
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRefreshTokenResponse(resp)
+	result, err := decodeGetProjectReleasesAnalyticsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6897,28 +7638,21 @@ func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequ
 	return result, nil
 }
 
-// RemoveTeamMember invokes RemoveTeamMember operation.
+// GetProjectStats invokes GetProjectStats operation.
 //
-// Removes a user from a team. If user_id matches the current user's id, the operation is treated as
-// self-leave (the user leaves the team).
-// Scenarios:
-// - Admin/owner removes another user
-// - User removes themselves (leave)
-// Restrictions:
-// - A user cannot leave the team if they are the only owner
-// - A superuser cannot leave the team if restricted by security policy.
+// Get project statistics.
 //
-// DELETE /api/v1/teams/{team_id}/members/{user_id}
-func (c *Client) RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) (RemoveTeamMemberRes, error) {
-	res, err := c.sendRemoveTeamMember(ctx, params)
+// GET /api/v1/projects/{project_id}/stats
+func (c *Client) GetProjectStats(ctx context.Context, params GetProjectStatsParams) (GetProjectStatsRes, error) {
+	res, err := c.sendGetProjectStats(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) (res RemoveTeamMemberRes, err error) {
+func (c *Client) sendGetProjectStats(ctx context.Context, params GetProjectStatsParams) (res GetProjectStatsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("RemoveTeamMember"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}"),
+		otelogen.OperationID("GetProjectStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/stats"),
 	}
 
 	// Run stopwatch.
@@ -6933,7 +7667,7 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveTeamMemberOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectStatsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -6950,17 +7684,17 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/api/v1/teams/"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "team_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.TeamID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -6970,29 +7704,29 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/members/"
+	pathParts[2] = "/stats"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "user_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.UserID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "period" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(string(params.Period)))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[3] = encoded
 	}
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -7002,7 +7736,7 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, RemoveTeamMemberOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectStatsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7038,7 +7772,7 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveTeamMemberResponse(resp)
+	result, err := decodeGetProjectStatsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7046,21 +7780,21 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 	return result, nil
 }
 
-// Reset2FA invokes Reset2FA operation.
+// GetProjectTeam invokes GetProjectTeam operation.
 //
-// Reset/generate secret 2FA (using email-confirmation).
+// Get project team.
 //
-// POST /api/v1/users/me/2fa/reset
-func (c *Client) Reset2FA(ctx context.Context, request *TwoFAResetRequest) (Reset2FARes, error) {
-	res, err := c.sendReset2FA(ctx, request)
+// GET /api/v1/projects/{project_id}/team
+func (c *Client) GetProjectTeam(ctx context.Context, params GetProjectTeamParams) (GetProjectTeamRes, error) {
+	res, err := c.sendGetProjectTeam(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendReset2FA(ctx context.Context, request *TwoFAResetRequest) (res Reset2FARes, err error) {
+func (c *Client) sendGetProjectTeam(ctx context.Context, params GetProjectTeamParams) (res GetProjectTeamRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Reset2FA"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/reset"),
+		otelogen.OperationID("GetProjectTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/team"),
 	}
 
 	// Run stopwatch.
@@ -7075,7 +7809,7 @@ func (c *Client) sendReset2FA(ctx context.Context, request *TwoFAResetRequest) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, Reset2FAOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7092,25 +7826,41 @@ func (c *Client) sendReset2FA(ctx context.Context, request *TwoFAResetRequest) (
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users/me/2fa/reset"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeReset2FARequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
-
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, Reset2FAOperation, r); {
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/team"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetProjectTeamOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7146,7 +7896,7 @@ func (c *Client) sendReset2FA(ctx context.Context, request *TwoFAResetRequest) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeReset2FAResponse(resp)
+	result, err := decodeGetProjectTeamResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7154,21 +7904,21 @@ func (c *Client) sendReset2FA(ctx context.Context, request *TwoFAResetRequest) (
 	return result, nil
 }
 
-// ResetPassword invokes ResetPassword operation.
+// GetRecentIssues invokes GetRecentIssues operation.
 //
-// Reset password using token.
+// Get recent issues across all projects.
 //
-// POST /api/v1/auth/reset-password
-func (c *Client) ResetPassword(ctx context.Context, request *ResetPasswordRequest) (ResetPasswordRes, error) {
-	res, err := c.sendResetPassword(ctx, request)
+// GET /api/v1/issues/recent
+func (c *Client) GetRecentIssues(ctx context.Context, params GetRecentIssuesParams) (GetRecentIssuesRes, error) {
+	res, err := c.sendGetRecentIssues(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendResetPassword(ctx context.Context, request *ResetPasswordRequest) (res ResetPasswordRes, err error) {
+func (c *Client) sendGetRecentIssues(ctx context.Context, params GetRecentIssuesParams) (res GetRecentIssuesRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ResetPassword"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/reset-password"),
+		otelogen.OperationID("GetRecentIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/issues/recent"),
 	}
 
 	// Run stopwatch.
@@ -7183,7 +7933,7 @@ func (c *Client) sendResetPassword(ctx context.Context, request *ResetPasswordRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ResetPasswordOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetRecentIssuesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7201,16 +7951,64 @@ func (c *Client) sendResetPassword(ctx context.Context, request *ResetPasswordRe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/v1/auth/reset-password"
+	pathParts[0] = "/api/v1/issues/recent"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.UintToString(params.Limit))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeResetPasswordRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetRecentIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -7221,7 +8019,7 @@ func (c *Client) sendResetPassword(ctx context.Context, request *ResetPasswordRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeResetPasswordResponse(resp)
+	result, err := decodeGetRecentIssuesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7229,21 +8027,21 @@ func (c *Client) sendResetPassword(ctx context.Context, request *ResetPasswordRe
 	return result, nil
 }
 
-// Send2FACode invokes send2FACode operation.
+// GetTeam invokes GetTeam operation.
 //
-// Send 2FA email code for disable/reset.
+// Get team details.
 //
-// POST /api/v1/users/me/2fa/send_code
-func (c *Client) Send2FACode(ctx context.Context) (Send2FACodeRes, error) {
-	res, err := c.sendSend2FACode(ctx)
+// GET /api/v1/teams/{team_id}
+func (c *Client) GetTeam(ctx context.Context, params GetTeamParams) (GetTeamRes, error) {
+	res, err := c.sendGetTeam(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendSend2FACode(ctx context.Context) (res Send2FACodeRes, err error) {
+func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res GetTeamRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("send2FACode"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/send_code"),
+		otelogen.OperationID("GetTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
 	}
 
 	// Run stopwatch.
@@ -7258,7 +8056,7 @@ func (c *Client) sendSend2FACode(ctx context.Context) (res Send2FACodeRes, err e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, Send2FACodeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7275,16 +8073,67 @@ func (c *Client) sendSend2FACode(ctx context.Context) (res Send2FACodeRes, err e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users/me/2fa/send_code"
+	var pathParts [2]string
+	pathParts[0] = "/api/v1/teams/"
+	{
+		// Encode "team_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "team_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.TeamID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTeamOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -7293,7 +8142,7 @@ func (c *Client) sendSend2FACode(ctx context.Context) (res Send2FACodeRes, err e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSend2FACodeResponse(resp)
+	result, err := decodeGetTeamResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7301,21 +8150,5725 @@ func (c *Client) sendSend2FACode(ctx context.Context) (res Send2FACodeRes, err e
 	return result, nil
 }
 
-// SendTestNotification invokes sendTestNotification operation.
+// GetTrace invokes GetTrace operation.
 //
-// Send test notification.
+// Get trace.
 //
-// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/test
-func (c *Client) SendTestNotification(ctx context.Context, params SendTestNotificationParams) (SendTestNotificationRes, error) {
-	res, err := c.sendSendTestNotification(ctx, params)
+// GET /api/v1/projects/{project_id}/traces/{trace_id}
+func (c *Client) GetTrace(ctx context.Context, params GetTraceParams) (GetTraceRes, error) {
+	res, err := c.sendGetTrace(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTrace(ctx context.Context, params GetTraceParams) (res GetTraceRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetTrace"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/traces/{trace_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTraceOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/traces/"
+	{
+		// Encode "trace_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "trace_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.TraceID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetTraceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetTraceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUnreadNotificationsCount invokes GetUnreadNotificationsCount operation.
+//
+// Get unread notifications count.
+//
+// GET /api/v1/notifications/unread-count
+func (c *Client) GetUnreadNotificationsCount(ctx context.Context) (GetUnreadNotificationsCountRes, error) {
+	res, err := c.sendGetUnreadNotificationsCount(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetUnreadNotificationsCount(ctx context.Context) (res GetUnreadNotificationsCountRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetUnreadNotificationsCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications/unread-count"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUnreadNotificationsCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/notifications/unread-count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetUnreadNotificationsCountOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetUnreadNotificationsCountResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUserNotifications invokes GetUserNotifications operation.
+//
+// Get user notifications.
+//
+// GET /api/v1/notifications
+func (c *Client) GetUserNotifications(ctx context.Context, params GetUserNotificationsParams) (GetUserNotificationsRes, error) {
+	res, err := c.sendGetUserNotifications(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetUserNotifications(ctx context.Context, params GetUserNotificationsParams) (res GetUserNotificationsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetUserNotifications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserNotificationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/notifications"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetUserNotificationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetUserNotificationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetVersions invokes GetVersions operation.
+//
+// Returns versions and build times of all Warden system components by collecting data from their
+// tech servers.
+//
+// GET /api/v1/versions
+func (c *Client) GetVersions(ctx context.Context) (GetVersionsRes, error) {
+	res, err := c.sendGetVersions(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetVersions(ctx context.Context) (res GetVersionsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetVersions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/versions"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetVersionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/versions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetVersionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListEventAttachments invokes ListEventAttachments operation.
+//
+// List event attachments.
+//
+// GET /api/v1/projects/{project_id}/events/{event_id}/attachments
+func (c *Client) ListEventAttachments(ctx context.Context, params ListEventAttachmentsParams) (ListEventAttachmentsRes, error) {
+	res, err := c.sendListEventAttachments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListEventAttachments(ctx context.Context, params ListEventAttachmentsParams) (res ListEventAttachmentsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListEventAttachments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/events/{event_id}/attachments"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListEventAttachmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/events/"
+	{
+		// Encode "event_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "event_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.EventID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/attachments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListEventAttachmentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListEventAttachmentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListGroupingRules invokes ListGroupingRules operation.
+//
+// List project grouping rules.
+//
+// GET /api/v1/projects/{project_id}/grouping-rules
+func (c *Client) ListGroupingRules(ctx context.Context, params ListGroupingRulesParams) (ListGroupingRulesRes, error) {
+	res, err := c.sendListGroupingRules(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListGroupingRules(ctx context.Context, params ListGroupingRulesParams) (res ListGroupingRulesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListGroupingRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListGroupingRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-rules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListGroupingRulesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListGroupingRulesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListIssues invokes ListIssues operation.
+//
+// Get all issues across all projects.
+//
+// GET /api/v1/issues
+func (c *Client) ListIssues(ctx context.Context, params ListIssuesParams) (ListIssuesRes, error) {
+	res, err := c.sendListIssues(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListIssues(ctx context.Context, params ListIssuesParams) (res ListIssuesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/issues"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/issues"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Query.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "environment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Environment.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "level" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "level",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Level.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "assigned" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "assigned",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Assigned.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "project_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "project_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ProjectID.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "per_page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.UintToString(params.PerPage))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.UintToString(params.Page))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort_by" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortBy.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort_order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort_order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortOrder.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListIssuesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListMonitors invokes ListMonitors operation.
+//
+// List project cron monitors.
+//
+// GET /api/v1/projects/{project_id}/monitors
+func (c *Client) ListMonitors(ctx context.Context, params ListMonitorsParams) (ListMonitorsRes, error) {
+	res, err := c.sendListMonitors(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListMonitors(ctx context.Context, params ListMonitorsParams) (res ListMonitorsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListMonitors"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/monitors"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListMonitorsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/monitors"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListMonitorsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListMonitorsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListNotificationRules invokes ListNotificationRules operation.
+//
+// List all notification rules for notification settings of project.
+//
+// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
+func (c *Client) ListNotificationRules(ctx context.Context, params ListNotificationRulesParams) (ListNotificationRulesRes, error) {
+	res, err := c.sendListNotificationRules(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListNotificationRules(ctx context.Context, params ListNotificationRulesParams) (res ListNotificationRulesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListNotificationRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/rules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListNotificationRulesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListNotificationRulesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListNotificationSettings invokes ListNotificationSettings operation.
+//
+// List all notification settings for a project.
+//
+// GET /api/v1/projects/{project_id}/notification-settings
+func (c *Client) ListNotificationSettings(ctx context.Context, params ListNotificationSettingsParams) (ListNotificationSettingsRes, error) {
+	res, err := c.sendListNotificationSettings(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListNotificationSettings(ctx context.Context, params ListNotificationSettingsParams) (res ListNotificationSettingsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListNotificationSettings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListNotificationSettingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/notification-settings"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListNotificationSettingsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListNotificationSettingsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectEnvironments invokes ListProjectEnvironments operation.
+//
+// Environments are discovered from the ingested events.
+//
+// GET /api/v1/projects/{project_id}/environments
+func (c *Client) ListProjectEnvironments(ctx context.Context, params ListProjectEnvironmentsParams) (ListProjectEnvironmentsRes, error) {
+	res, err := c.sendListProjectEnvironments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectEnvironments(ctx context.Context, params ListProjectEnvironmentsParams) (res ListProjectEnvironmentsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectEnvironments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/environments"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectEnvironmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/environments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectEnvironmentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectEnvironmentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectIssueActivity invokes ListProjectIssueActivity operation.
+//
+// First seen, regressions, status and assignment changes, merges and comments of the issue.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/activity
+func (c *Client) ListProjectIssueActivity(ctx context.Context, params ListProjectIssueActivityParams) (ListProjectIssueActivityRes, error) {
+	res, err := c.sendListProjectIssueActivity(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectIssueActivity(ctx context.Context, params ListProjectIssueActivityParams) (res ListProjectIssueActivityRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueActivity"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/activity"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectIssueActivityOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/activity"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "per_page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PerPage.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectIssueActivityOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectIssueActivityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectIssueComments invokes ListProjectIssueComments operation.
+//
+// List issue comments.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/comments
+func (c *Client) ListProjectIssueComments(ctx context.Context, params ListProjectIssueCommentsParams) (ListProjectIssueCommentsRes, error) {
+	res, err := c.sendListProjectIssueComments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectIssueComments(ctx context.Context, params ListProjectIssueCommentsParams) (res ListProjectIssueCommentsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueComments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/comments"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectIssueCommentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/comments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectIssueCommentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectIssueCommentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectIssueEvents invokes ListProjectIssueEvents operation.
+//
+// List issue events.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events
+func (c *Client) ListProjectIssueEvents(ctx context.Context, params ListProjectIssueEventsParams) (ListProjectIssueEventsRes, error) {
+	res, err := c.sendListProjectIssueEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectIssueEvents(ctx context.Context, params ListProjectIssueEventsParams) (res ListProjectIssueEventsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectIssueEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectIssueEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectIssueEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectIssueTagEvents invokes ListProjectIssueTagEvents operation.
+//
+// List issue events with a tag value.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events
+func (c *Client) ListProjectIssueTagEvents(ctx context.Context, params ListProjectIssueTagEventsParams) (ListProjectIssueTagEventsRes, error) {
+	res, err := c.sendListProjectIssueTagEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectIssueTagEvents(ctx context.Context, params ListProjectIssueTagEventsParams) (res ListProjectIssueTagEventsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueTagEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectIssueTagEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [7]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tags/"
+	{
+		// Encode "key" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Key))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "value" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "value",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Value))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectIssueTagEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectIssueTagEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectKeys invokes ListProjectKeys operation.
+//
+// List project client keys.
+//
+// GET /api/v1/projects/{project_id}/keys
+func (c *Client) ListProjectKeys(ctx context.Context, params ListProjectKeysParams) (ListProjectKeysRes, error) {
+	res, err := c.sendListProjectKeys(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectKeys(ctx context.Context, params ListProjectKeysParams) (res ListProjectKeysRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectKeys"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectKeysOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/keys"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectKeysOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectKeysResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectTransactions invokes ListProjectTransactions operation.
+//
+// List project transactions.
+//
+// GET /api/v1/projects/{project_id}/transactions
+func (c *Client) ListProjectTransactions(ctx context.Context, params ListProjectTransactionsParams) (ListProjectTransactionsRes, error) {
+	res, err := c.sendListProjectTransactions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectTransactions(ctx context.Context, params ListProjectTransactionsParams) (res ListProjectTransactionsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectTransactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/transactions"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectTransactionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/transactions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "interval" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Interval))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "environment" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Environment.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "release" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "release",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Release.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectTransactionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectTransactionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjects invokes ListProjects operation.
+//
+// Get projects list.
+//
+// GET /api/v1/projects
+func (c *Client) ListProjects(ctx context.Context) (ListProjectsRes, error) {
+	res, err := c.sendListProjects(ctx)
+	return res, err
+}
+
+func (c *Client) sendListProjects(ctx context.Context) (res ListProjectsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjects"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/projects"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTeams invokes ListTeams operation.
+//
+// List all teams.
+//
+// GET /api/v1/teams
+func (c *Client) ListTeams(ctx context.Context) (ListTeamsRes, error) {
+	res, err := c.sendListTeams(ctx)
+	return res, err
+}
+
+func (c *Client) sendListTeams(ctx context.Context) (res ListTeamsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListTeams"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/teams"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTeamsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/teams"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTeamsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTeamsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUsers invokes ListUsers operation.
+//
+// List all users (superuser only).
+//
+// GET /api/v1/users
+func (c *Client) ListUsers(ctx context.Context) (ListUsersRes, error) {
+	res, err := c.sendListUsers(ctx)
+	return res, err
+}
+
+func (c *Client) sendListUsers(ctx context.Context) (res ListUsersRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/users"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUsersForTeam invokes ListUsersForTeam operation.
+//
+// List all users for team admin.
+//
+// GET /api/v1/users/team/{team_id}/list
+func (c *Client) ListUsersForTeam(ctx context.Context, params ListUsersForTeamParams) (ListUsersForTeamRes, error) {
+	res, err := c.sendListUsersForTeam(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListUsersForTeam(ctx context.Context, params ListUsersForTeamParams) (res ListUsersForTeamRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListUsersForTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/users/team/{team_id}/list"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersForTeamOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/users/team/"
+	{
+		// Encode "team_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "team_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.TeamID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListUsersForTeamOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListUsersForTeamResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Login invokes Login operation.
+//
+// Authenticate user and get access token.
+//
+// POST /api/v1/auth/login
+func (c *Client) Login(ctx context.Context, request *LoginRequest) (LoginRes, error) {
+	res, err := c.sendLogin(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLogin(ctx context.Context, request *LoginRequest) (res LoginRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/login"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, LoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MarkAllNotificationsAsRead invokes MarkAllNotificationsAsRead operation.
+//
+// Mark all notifications as read.
+//
+// PUT /api/v1/notifications/read-all
+func (c *Client) MarkAllNotificationsAsRead(ctx context.Context) (MarkAllNotificationsAsReadRes, error) {
+	res, err := c.sendMarkAllNotificationsAsRead(ctx)
+	return res, err
+}
+
+func (c *Client) sendMarkAllNotificationsAsRead(ctx context.Context) (res MarkAllNotificationsAsReadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MarkAllNotificationsAsRead"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications/read-all"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MarkAllNotificationsAsReadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/notifications/read-all"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MarkAllNotificationsAsReadOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMarkAllNotificationsAsReadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MarkNotificationAsRead invokes MarkNotificationAsRead operation.
+//
+// Mark notification as read.
+//
+// PUT /api/v1/notifications/{notification_id}/read
+func (c *Client) MarkNotificationAsRead(ctx context.Context, params MarkNotificationAsReadParams) (MarkNotificationAsReadRes, error) {
+	res, err := c.sendMarkNotificationAsRead(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendMarkNotificationAsRead(ctx context.Context, params MarkNotificationAsReadParams) (res MarkNotificationAsReadRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MarkNotificationAsRead"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications/{notification_id}/read"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MarkNotificationAsReadOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/notifications/"
+	{
+		// Encode "notification_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "notification_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.NotificationID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/read"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MarkNotificationAsReadOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMarkNotificationAsReadResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// MergeIssues invokes MergeIssues operation.
+//
+// Merges the given issues into the primary issue. Events of merged issues are grouped into the
+// primary issue.
+//
+// POST /api/v1/projects/{project_id}/issues/merge
+func (c *Client) MergeIssues(ctx context.Context, request *MergeIssuesRequest, params MergeIssuesParams) (MergeIssuesRes, error) {
+	res, err := c.sendMergeIssues(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendMergeIssues(ctx context.Context, request *MergeIssuesRequest, params MergeIssuesParams) (res MergeIssuesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MergeIssues"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/merge"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, MergeIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/merge"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeMergeIssuesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, MergeIssuesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeMergeIssuesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PreviewGroupingRule invokes PreviewGroupingRule operation.
+//
+// Evaluates the rule against the events of the last 24 hours without saving it.
+//
+// POST /api/v1/projects/{project_id}/grouping-rules/preview
+func (c *Client) PreviewGroupingRule(ctx context.Context, request *GroupingRuleRequest, params PreviewGroupingRuleParams) (PreviewGroupingRuleRes, error) {
+	res, err := c.sendPreviewGroupingRule(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPreviewGroupingRule(ctx context.Context, request *GroupingRuleRequest, params PreviewGroupingRuleParams) (res PreviewGroupingRuleRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("PreviewGroupingRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules/preview"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PreviewGroupingRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-rules/preview"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePreviewGroupingRuleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PreviewGroupingRuleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePreviewGroupingRuleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RecentProjectsList invokes RecentProjectsList operation.
+//
+// Get recent projects list.
+//
+// GET /api/v1/projects/recent
+func (c *Client) RecentProjectsList(ctx context.Context) (RecentProjectsListRes, error) {
+	res, err := c.sendRecentProjectsList(ctx)
+	return res, err
+}
+
+func (c *Client) sendRecentProjectsList(ctx context.Context) (res RecentProjectsListRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RecentProjectsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/recent"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RecentProjectsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/projects/recent"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RecentProjectsListOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRecentProjectsListResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RefreshToken invokes RefreshToken operation.
+//
+// Refresh access token.
+//
+// POST /api/v1/auth/refresh
+func (c *Client) RefreshToken(ctx context.Context, request *RefreshTokenRequest) (RefreshTokenRes, error) {
+	res, err := c.sendRefreshToken(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRefreshToken(ctx context.Context, request *RefreshTokenRequest) (res RefreshTokenRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RefreshToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/refresh"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RefreshTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRefreshTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRefreshTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RemoveTeamMember invokes RemoveTeamMember operation.
+//
+// Removes a user from a team. If user_id matches the current user's id, the operation is treated as
+// self-leave (the user leaves the team).
+// Scenarios:
+// - Admin/owner removes another user
+// - User removes themselves (leave)
+// Restrictions:
+// - A user cannot leave the team if they are the only owner
+// - A superuser cannot leave the team if restricted by security policy.
+//
+// DELETE /api/v1/teams/{team_id}/members/{user_id}
+func (c *Client) RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) (RemoveTeamMemberRes, error) {
+	res, err := c.sendRemoveTeamMember(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) (res RemoveTeamMemberRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RemoveTeamMember"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveTeamMemberOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/teams/"
+	{
+		// Encode "team_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "team_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.TeamID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/members/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RemoveTeamMemberOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRemoveTeamMemberResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Reset2FA invokes Reset2FA operation.
+//
+// Reset/generate secret 2FA (using email-confirmation).
+//
+// POST /api/v1/users/me/2fa/reset
+func (c *Client) Reset2FA(ctx context.Context, request *TwoFAResetRequest) (Reset2FARes, error) {
+	res, err := c.sendReset2FA(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendReset2FA(ctx context.Context, request *TwoFAResetRequest) (res Reset2FARes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Reset2FA"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/reset"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, Reset2FAOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users/me/2fa/reset"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeReset2FARequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, Reset2FAOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReset2FAResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ResetPassword invokes ResetPassword operation.
+//
+// Reset password using token.
+//
+// POST /api/v1/auth/reset-password
+func (c *Client) ResetPassword(ctx context.Context, request *ResetPasswordRequest) (ResetPasswordRes, error) {
+	res, err := c.sendResetPassword(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendResetPassword(ctx context.Context, request *ResetPasswordRequest) (res ResetPasswordRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ResetPassword"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/reset-password"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ResetPasswordOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/auth/reset-password"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeResetPasswordRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeResetPasswordResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokeProjectKey invokes RevokeProjectKey operation.
+//
+// Revoke a project client key.
+//
+// DELETE /api/v1/projects/{project_id}/keys/{key_id}
+func (c *Client) RevokeProjectKey(ctx context.Context, params RevokeProjectKeyParams) (RevokeProjectKeyRes, error) {
+	res, err := c.sendRevokeProjectKey(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRevokeProjectKey(ctx context.Context, params RevokeProjectKeyParams) (res RevokeProjectKeyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevokeProjectKey"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys/{key_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RevokeProjectKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/keys/"
+	{
+		// Encode "key_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.KeyID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RevokeProjectKeyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRevokeProjectKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RotateProjectKey invokes RotateProjectKey operation.
+//
+// Issues a new key with the label and the rate limit of the given one. The old key keeps working
+// until it is revoked.
+//
+// POST /api/v1/projects/{project_id}/keys/{key_id}/rotate
+func (c *Client) RotateProjectKey(ctx context.Context, params RotateProjectKeyParams) (RotateProjectKeyRes, error) {
+	res, err := c.sendRotateProjectKey(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRotateProjectKey(ctx context.Context, params RotateProjectKeyParams) (res RotateProjectKeyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RotateProjectKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys/{key_id}/rotate"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RotateProjectKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/keys/"
+	{
+		// Encode "key_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "key_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.KeyID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/rotate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RotateProjectKeyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRotateProjectKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchProjectEvents invokes SearchProjectEvents operation.
+//
+// Search project events.
+//
+// GET /api/v1/projects/{project_id}/events
+func (c *Client) SearchProjectEvents(ctx context.Context, params SearchProjectEventsParams) (SearchProjectEventsRes, error) {
+	res, err := c.sendSearchProjectEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearchProjectEvents(ctx context.Context, params SearchProjectEventsParams) (res SearchProjectEventsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SearchProjectEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/events"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchProjectEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Query.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "time_from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "time_from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TimeFrom.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "time_to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "time_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TimeTo.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.UintToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SearchProjectEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchProjectEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Send2FACode invokes send2FACode operation.
+//
+// Send 2FA email code for disable/reset.
+//
+// POST /api/v1/users/me/2fa/send_code
+func (c *Client) Send2FACode(ctx context.Context) (Send2FACodeRes, error) {
+	res, err := c.sendSend2FACode(ctx)
+	return res, err
+}
+
+func (c *Client) sendSend2FACode(ctx context.Context) (res Send2FACodeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("send2FACode"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/send_code"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, Send2FACodeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users/me/2fa/send_code"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSend2FACodeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SendTestNotification invokes sendTestNotification operation.
+//
+// Send test notification.
+//
+// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/test
+func (c *Client) SendTestNotification(ctx context.Context, params SendTestNotificationParams) (SendTestNotificationRes, error) {
+	res, err := c.sendSendTestNotification(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNotificationParams) (res SendTestNotificationRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("sendTestNotification"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/test"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SendTestNotificationOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/test"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSendTestNotificationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetSuperuserStatus invokes SetSuperuserStatus operation.
+//
+// Set or unset superuser status (superuser only, cannot modify admin user).
+//
+// PUT /api/v1/users/{user_id}/superuser
+func (c *Client) SetSuperuserStatus(ctx context.Context, request *SetSuperuserStatusRequest, params SetSuperuserStatusParams) (SetSuperuserStatusRes, error) {
+	res, err := c.sendSetSuperuserStatus(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperuserStatusRequest, params SetSuperuserStatusParams) (res SetSuperuserStatusRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetSuperuserStatus"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/superuser"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SetSuperuserStatusOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/users/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/superuser"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetSuperuserStatusRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SetSuperuserStatusOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetSuperuserStatusResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SetUserActiveStatus invokes SetUserActiveStatus operation.
+//
+// Set or unset user active status (superuser only).
+//
+// PUT /api/v1/users/{user_id}/active
+func (c *Client) SetUserActiveStatus(ctx context.Context, request *SetUserActiveStatusRequest, params SetUserActiveStatusParams) (SetUserActiveStatusRes, error) {
+	res, err := c.sendSetUserActiveStatus(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserActiveStatusRequest, params SetUserActiveStatusParams) (res SetUserActiveStatusRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetUserActiveStatus"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/active"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SetUserActiveStatusOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/users/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/active"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSetUserActiveStatusRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SetUserActiveStatusOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetUserActiveStatusResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Setup2FA invokes Setup2FA operation.
+//
+// Begin setup 2FA (generate secret and QR-code).
+//
+// POST /api/v1/users/me/2fa/setup
+func (c *Client) Setup2FA(ctx context.Context) (Setup2FARes, error) {
+	res, err := c.sendSetup2FA(ctx)
+	return res, err
+}
+
+func (c *Client) sendSetup2FA(ctx context.Context) (res Setup2FARes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Setup2FA"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/setup"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, Setup2FAOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/users/me/2fa/setup"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, Setup2FAOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSetup2FAResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UnmergeIssue invokes UnmergeIssue operation.
+//
+// Splits merged fingerprints out of the issue into separate issues.
+//
+// POST /api/v1/projects/{project_id}/issues/{issue_id}/unmerge
+func (c *Client) UnmergeIssue(ctx context.Context, request *UnmergeIssueRequest, params UnmergeIssueParams) (UnmergeIssueRes, error) {
+	res, err := c.sendUnmergeIssue(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUnmergeIssue(ctx context.Context, request *UnmergeIssueRequest, params UnmergeIssueParams) (res UnmergeIssueRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UnmergeIssue"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/unmerge"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UnmergeIssueOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/issues/"
+	{
+		// Encode "issue_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "issue_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.IssueID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/unmerge"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUnmergeIssueRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UnmergeIssueOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUnmergeIssueResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateGroupingRule invokes UpdateGroupingRule operation.
+//
+// Update a grouping rule.
+//
+// PUT /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+func (c *Client) UpdateGroupingRule(ctx context.Context, request *GroupingRuleRequest, params UpdateGroupingRuleParams) (UpdateGroupingRuleRes, error) {
+	res, err := c.sendUpdateGroupingRule(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateGroupingRule(ctx context.Context, request *GroupingRuleRequest, params UpdateGroupingRuleParams) (res UpdateGroupingRuleRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateGroupingRule"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules/{rule_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateGroupingRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-rules/"
+	{
+		// Encode "rule_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "rule_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.RuleID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateGroupingRuleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateGroupingRuleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateGroupingRuleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateNotificationRule invokes UpdateNotificationRule operation.
+//
+// Update a notification rule.
+//
+// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
+func (c *Client) UpdateNotificationRule(ctx context.Context, request *UpdateNotificationRuleRequest, params UpdateNotificationRuleParams) (UpdateNotificationRuleRes, error) {
+	res, err := c.sendUpdateNotificationRule(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *UpdateNotificationRuleRequest, params UpdateNotificationRuleParams) (res UpdateNotificationRuleRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateNotificationRule"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateNotificationRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/rules/"
+	{
+		// Encode "rule_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "rule_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.RuleID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateNotificationRuleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateNotificationRuleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateNotificationRuleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateNotificationSetting invokes UpdateNotificationSetting operation.
+//
+// Update a notification setting.
+//
+// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}
+func (c *Client) UpdateNotificationSetting(ctx context.Context, request *UpdateNotificationSettingRequest, params UpdateNotificationSettingParams) (UpdateNotificationSettingRes, error) {
+	res, err := c.sendUpdateNotificationSetting(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *UpdateNotificationSettingRequest, params UpdateNotificationSettingParams) (res UpdateNotificationSettingRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateNotificationSetting"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateNotificationSettingOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/notification-settings/"
+	{
+		// Encode "setting_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "setting_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.SettingID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateNotificationSettingRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateNotificationSettingOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateNotificationSettingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateProject invokes UpdateProject operation.
+//
+// Update project name and description.
+//
+// PUT /api/v1/projects/{project_id}
+func (c *Client) UpdateProject(ctx context.Context, request *UpdateProjectRequest, params UpdateProjectParams) (UpdateProjectRes, error) {
+	res, err := c.sendUpdateProject(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNotificationParams) (res SendTestNotificationRes, err error) {
+func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRequest, params UpdateProjectParams) (res UpdateProjectRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendTestNotification"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/test"),
+		otelogen.OperationID("UpdateProject"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
 	}
 
 	// Run stopwatch.
@@ -7330,7 +13883,7 @@ func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SendTestNotificationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7347,7 +13900,7 @@ func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNo
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [5]string
+	var pathParts [2]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -7367,33 +13920,49 @@ func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
-	{
-		// Encode "setting_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/test"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateProjectRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UpdateProjectOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -7403,7 +13972,7 @@ func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSendTestNotificationResponse(resp)
+	result, err := decodeUpdateProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7411,21 +13980,22 @@ func (c *Client) sendSendTestNotification(ctx context.Context, params SendTestNo
 	return result, nil
 }
 
-// SetSuperuserStatus invokes SetSuperuserStatus operation.
+// UpdateProjectDataScrubbing invokes UpdateProjectDataScrubbing operation.
 //
-// Set or unset superuser status (superuser only, cannot modify admin user).
+// Sensitive data is removed from events before they are stored, changes are applied to new events
+// within a minute.
 //
-// PUT /api/v1/users/{user_id}/superuser
-func (c *Client) SetSuperuserStatus(ctx context.Context, request *SetSuperuserStatusRequest, params SetSuperuserStatusParams) (SetSuperuserStatusRes, error) {
-	res, err := c.sendSetSuperuserStatus(ctx, request, params)
+// PUT /api/v1/projects/{project_id}/data-scrubbing
+func (c *Client) UpdateProjectDataScrubbing(ctx context.Context, request *UpdateDataScrubbingRequest, params UpdateProjectDataScrubbingParams) (UpdateProjectDataScrubbingRes, error) {
+	res, err := c.sendUpdateProjectDataScrubbing(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperuserStatusRequest, params SetSuperuserStatusParams) (res SetSuperuserStatusRes, err error) {
+func (c *Client) sendUpdateProjectDataScrubbing(ctx context.Context, request *UpdateDataScrubbingRequest, params UpdateProjectDataScrubbingParams) (res UpdateProjectDataScrubbingRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetSuperuserStatus"),
+		otelogen.OperationID("UpdateProjectDataScrubbing"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/superuser"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/data-scrubbing"),
 	}
 
 	// Run stopwatch.
@@ -7440,7 +14010,7 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SetSuperuserStatusOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectDataScrubbingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7458,16 +14028,16 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/api/v1/users/"
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "user_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.UserID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -7477,7 +14047,7 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/superuser"
+	pathParts[2] = "/data-scrubbing"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7485,7 +14055,7 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetSuperuserStatusRequest(request, r); err != nil {
+	if err := encodeUpdateProjectDataScrubbingRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7494,7 +14064,7 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SetSuperuserStatusOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProjectDataScrubbingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7530,7 +14100,7 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetSuperuserStatusResponse(resp)
+	result, err := decodeUpdateProjectDataScrubbingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7538,21 +14108,21 @@ func (c *Client) sendSetSuperuserStatus(ctx context.Context, request *SetSuperus
 	return result, nil
 }
 
-// SetUserActiveStatus invokes SetUserActiveStatus operation.
+// UpdateProjectEnvironment invokes UpdateProjectEnvironment operation.
 //
-// Set or unset user active status (superuser only).
+// Update project environment.
 //
-// PUT /api/v1/users/{user_id}/active
-func (c *Client) SetUserActiveStatus(ctx context.Context, request *SetUserActiveStatusRequest, params SetUserActiveStatusParams) (SetUserActiveStatusRes, error) {
-	res, err := c.sendSetUserActiveStatus(ctx, request, params)
+// PUT /api/v1/projects/{project_id}/environments/{name}
+func (c *Client) UpdateProjectEnvironment(ctx context.Context, request *UpdateEnvironmentRequest, params UpdateProjectEnvironmentParams) (UpdateProjectEnvironmentRes, error) {
+	res, err := c.sendUpdateProjectEnvironment(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserActiveStatusRequest, params SetUserActiveStatusParams) (res SetUserActiveStatusRes, err error) {
+func (c *Client) sendUpdateProjectEnvironment(ctx context.Context, request *UpdateEnvironmentRequest, params UpdateProjectEnvironmentParams) (res UpdateProjectEnvironmentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetUserActiveStatus"),
+		otelogen.OperationID("UpdateProjectEnvironment"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/active"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/environments/{name}"),
 	}
 
 	// Run stopwatch.
@@ -7567,7 +14137,7 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SetUserActiveStatusOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectEnvironmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7584,17 +14154,17 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/api/v1/users/"
+	var pathParts [4]string
+	pathParts[0] = "/api/v1/projects/"
 	{
-		// Encode "user_id" parameter.
+		// Encode "project_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
+			Param:   "project_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.UserID))
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -7604,7 +14174,25 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/active"
+	pathParts[2] = "/environments/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7612,7 +14200,7 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSetUserActiveStatusRequest(request, r); err != nil {
+	if err := encodeUpdateProjectEnvironmentRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7621,7 +14209,7 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SetUserActiveStatusOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProjectEnvironmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7657,7 +14245,7 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetUserActiveStatusResponse(resp)
+	result, err := decodeUpdateProjectEnvironmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7665,21 +14253,21 @@ func (c *Client) sendSetUserActiveStatus(ctx context.Context, request *SetUserAc
 	return result, nil
 }
 
-// Setup2FA invokes Setup2FA operation.
+// UpdateProjectGroupingConfig invokes UpdateProjectGroupingConfig operation.
 //
-// Begin setup 2FA (generate secret and QR-code).
+// Applies to new events only, existing issues keep their fingerprints.
 //
-// POST /api/v1/users/me/2fa/setup
-func (c *Client) Setup2FA(ctx context.Context) (Setup2FARes, error) {
-	res, err := c.sendSetup2FA(ctx)
+// PUT /api/v1/projects/{project_id}/grouping-config
+func (c *Client) UpdateProjectGroupingConfig(ctx context.Context, request *UpdateGroupingConfigRequest, params UpdateProjectGroupingConfigParams) (UpdateProjectGroupingConfigRes, error) {
+	res, err := c.sendUpdateProjectGroupingConfig(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSetup2FA(ctx context.Context) (res Setup2FARes, err error) {
+func (c *Client) sendUpdateProjectGroupingConfig(ctx context.Context, request *UpdateGroupingConfigRequest, params UpdateProjectGroupingConfigParams) (res UpdateProjectGroupingConfigRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Setup2FA"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/setup"),
+		otelogen.OperationID("UpdateProjectGroupingConfig"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-config"),
 	}
 
 	// Run stopwatch.
@@ -7694,7 +14282,7 @@ func (c *Client) sendSetup2FA(ctx context.Context) (res Setup2FARes, err error)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, Setup2FAOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectGroupingConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7711,22 +14299,44 @@ func (c *Client) sendSetup2FA(ctx context.Context) (res Setup2FARes, err error)
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/users/me/2fa/setup"
+	var pathParts [3]string
+	pathParts[0] = "/api/v1/projects/"
+	{
+		// Encode "project_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UintToString(params.ProjectID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/grouping-config"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateProjectGroupingConfigRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, Setup2FAOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProjectGroupingConfigOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7762,7 +14372,7 @@ func (c *Client) sendSetup2FA(ctx context.Context) (res Setup2FARes, err error)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSetup2FAResponse(resp)
+	result, err := decodeUpdateProjectGroupingConfigResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7770,21 +14380,22 @@ func (c *Client) sendSetup2FA(ctx context.Context) (res Setup2FARes, err error)
 	return result, nil
 }
 
-// UpdateNotificationRule invokes UpdateNotificationRule operation.
+// UpdateProjectInboundFilters invokes UpdateProjectInboundFilters operation.
 //
-// Update a notification rule.
+// Filters are applied by the ingest servers before data is queued, dropped data is counted in the
+// project outcomes.
 //
-// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
-func (c *Client) UpdateNotificationRule(ctx context.Context, request *UpdateNotificationRuleRequest, params UpdateNotificationRuleParams) (UpdateNotificationRuleRes, error) {
-	res, err := c.sendUpdateNotificationRule(ctx, request, params)
+// PUT /api/v1/projects/{project_id}/inbound-filters
+func (c *Client) UpdateProjectInboundFilters(ctx context.Context, request *UpdateInboundFiltersRequest, params UpdateProjectInboundFiltersParams) (UpdateProjectInboundFiltersRes, error) {
+	res, err := c.sendUpdateProjectInboundFilters(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *UpdateNotificationRuleRequest, params UpdateNotificationRuleParams) (res UpdateNotificationRuleRes, err error) {
+func (c *Client) sendUpdateProjectInboundFilters(ctx context.Context, request *UpdateInboundFiltersRequest, params UpdateProjectInboundFiltersParams) (res UpdateProjectInboundFiltersRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateNotificationRule"),
+		otelogen.OperationID("UpdateProjectInboundFilters"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/inbound-filters"),
 	}
 
 	// Run stopwatch.
@@ -7799,7 +14410,7 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateNotificationRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectInboundFiltersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -7816,7 +14427,7 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [6]string
+	var pathParts [3]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -7836,44 +14447,7 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
-	{
-		// Encode "setting_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
-	pathParts[4] = "/rules/"
-	{
-		// Encode "rule_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "rule_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.RuleID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[5] = encoded
-	}
+	pathParts[2] = "/inbound-filters"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -7881,7 +14455,7 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateNotificationRuleRequest(request, r); err != nil {
+	if err := encodeUpdateProjectInboundFiltersRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -7890,7 +14464,7 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateNotificationRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProjectInboundFiltersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -7926,7 +14500,7 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateNotificationRuleResponse(resp)
+	result, err := decodeUpdateProjectInboundFiltersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -7934,21 +14508,21 @@ func (c *Client) sendUpdateNotificationRule(ctx context.Context, request *Update
 	return result, nil
 }
 
-// UpdateNotificationSetting invokes UpdateNotificationSetting operation.
+// UpdateProjectKey invokes UpdateProjectKey operation.
 //
-// Update a notification setting.
+// Disabled keys are rejected by the ingest servers until they are enabled again.
 //
-// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}
-func (c *Client) UpdateNotificationSetting(ctx context.Context, request *UpdateNotificationSettingRequest, params UpdateNotificationSettingParams) (UpdateNotificationSettingRes, error) {
-	res, err := c.sendUpdateNotificationSetting(ctx, request, params)
+// PUT /api/v1/projects/{project_id}/keys/{key_id}
+func (c *Client) UpdateProjectKey(ctx context.Context, request *UpdateProjectKeyRequest, params UpdateProjectKeyParams) (UpdateProjectKeyRes, error) {
+	res, err := c.sendUpdateProjectKey(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *UpdateNotificationSettingRequest, params UpdateNotificationSettingParams) (res UpdateNotificationSettingRes, err error) {
+func (c *Client) sendUpdateProjectKey(ctx context.Context, request *UpdateProjectKeyRequest, params UpdateProjectKeyParams) (res UpdateProjectKeyRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateNotificationSetting"),
+		otelogen.OperationID("UpdateProjectKey"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys/{key_id}"),
 	}
 
 	// Run stopwatch.
@@ -7963,7 +14537,7 @@ func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *Upd
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateNotificationSettingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8000,16 +14574,16 @@ func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *Upd
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/notification-settings/"
+	pathParts[2] = "/keys/"
 	{
-		// Encode "setting_id" parameter.
+		// Encode "key_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "setting_id",
+			Param:   "key_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UintToString(params.SettingID))
+			return e.EncodeValue(conv.UintToString(params.KeyID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -8026,7 +14600,7 @@ func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *Upd
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateNotificationSettingRequest(request, r); err != nil {
+	if err := encodeUpdateProjectKeyRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -8035,7 +14609,7 @@ func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *Upd
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateNotificationSettingOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProjectKeyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8071,7 +14645,7 @@ func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *Upd
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateNotificationSettingResponse(resp)
+	result, err := decodeUpdateProjectKeyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -8079,21 +14653,22 @@ func (c *Client) sendUpdateNotificationSetting(ctx context.Context, request *Upd
 	return result, nil
 }
 
-// UpdateProject invokes UpdateProject operation.
+// UpdateProjectRateLimits invokes UpdateProjectRateLimits operation.
 //
-// Update project name and description.
+// The limits are shared by all client keys of the project, data over the limits is rejected by the
+// ingest servers.
 //
-// PUT /api/v1/projects/{project_id}
-func (c *Client) UpdateProject(ctx context.Context, request *UpdateProjectRequest, params UpdateProjectParams) (UpdateProjectRes, error) {
-	res, err := c.sendUpdateProject(ctx, request, params)
+// PUT /api/v1/projects/{project_id}/rate-limits
+func (c *Client) UpdateProjectRateLimits(ctx context.Context, request *UpdateProjectRateLimitsRequest, params UpdateProjectRateLimitsParams) (UpdateProjectRateLimitsRes, error) {
+	res, err := c.sendUpdateProjectRateLimits(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRequest, params UpdateProjectParams) (res UpdateProjectRes, err error) {
+func (c *Client) sendUpdateProjectRateLimits(ctx context.Context, request *UpdateProjectRateLimitsRequest, params UpdateProjectRateLimitsParams) (res UpdateProjectRateLimitsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateProject"),
+		otelogen.OperationID("UpdateProjectRateLimits"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/rate-limits"),
 	}
 
 	// Run stopwatch.
@@ -8108,7 +14683,7 @@ func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateProjectRateLimitsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -8125,7 +14700,7 @@ func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/api/v1/projects/"
 	{
 		// Encode "project_id" parameter.
@@ -8145,6 +14720,7 @@ func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRe
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/rate-limits"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -8152,7 +14728,7 @@ func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateProjectRequest(request, r); err != nil {
+	if err := encodeUpdateProjectRateLimitsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -8161,7 +14737,7 @@ func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, UpdateProjectOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateProjectRateLimitsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -8197,7 +14773,7 @@ func (c *Client) sendUpdateProject(ctx context.Context, request *UpdateProjectRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeUpdateProjectResponse(resp)
+	result, err := decodeUpdateProjectRateLimitsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
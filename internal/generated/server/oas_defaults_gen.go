@@ -8,4 +8,8 @@ func (s *CreateNotificationSettingRequest) setDefaults() {
 		val := bool(true)
 		s.Enabled.SetTo(val)
 	}
+	{
+		val := bool(false)
+		s.RouteToAssignee.SetTo(val)
+	}
 }
@@ -630,22 +630,22 @@ func (s *Server) handleArchiveProjectRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleChangeIssueStatusRequest handles changeIssueStatus operation.
+// handleAssignIssueRequest handles AssignIssue operation.
 //
-// Change issue status.
+// Assign issue to a user or a team.
 //
-// PUT /api/v1/projects/{project_id}/issues/{issue_id}/change-status
-func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/issues/{issue_id}/assignee
+func (s *Server) handleAssignIssueRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("changeIssueStatus"),
+		otelogen.OperationID("AssignIssue"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/change-status"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/assignee"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangeIssueStatusOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AssignIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -700,15 +700,15 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ChangeIssueStatusOperation,
-			ID:   "changeIssueStatus",
+			Name: AssignIssueOperation,
+			ID:   "AssignIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ChangeIssueStatusOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, AssignIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -750,7 +750,7 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeChangeIssueStatusParams(args, argsEscaped, r)
+	params, err := decodeAssignIssueParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -760,7 +760,7 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeChangeIssueStatusRequest(r)
+	request, close, err := s.decodeAssignIssueRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -776,13 +776,13 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 		}
 	}()
 
-	var response ChangeIssueStatusRes
+	var response AssignIssueRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ChangeIssueStatusOperation,
-			OperationSummary: "Change issue status",
-			OperationID:      "changeIssueStatus",
+			OperationName:    AssignIssueOperation,
+			OperationSummary: "Assign issue to a user or a team",
+			OperationID:      "AssignIssue",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -798,9 +798,9 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 		}
 
 		type (
-			Request  = *ChangeIssueStatusReq
-			Params   = ChangeIssueStatusParams
-			Response = ChangeIssueStatusRes
+			Request  = *IssueAssigneeRequest
+			Params   = AssignIssueParams
+			Response = AssignIssueRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -809,14 +809,14 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackChangeIssueStatusParams,
+			unpackAssignIssueParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ChangeIssueStatus(ctx, request, params)
+				response, err = s.h.AssignIssue(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ChangeIssueStatus(ctx, request, params)
+		response, err = s.h.AssignIssue(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -835,7 +835,7 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeChangeIssueStatusResponse(response, w, span); err != nil {
+	if err := encodeAssignIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -844,22 +844,26 @@ func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool
 	}
 }
 
-// handleChangeTeamMemberRoleRequest handles ChangeTeamMemberRole operation.
+// handleBulkUpdateIssuesRequest handles BulkUpdateIssues operation.
 //
-// Change team member role.
+// Resolves, ignores, unresolves, assigns, merges or deletes the issues given by IDs or matching a
+// filter
+// in one transaction. Issues the user can't manage, missing issues and issues already in the
+// requested
+// state are reported and left as they are.
 //
-// PUT /api/v1/teams/{team_id}/members/{user_id}/role
-func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/projects/{project_id}/issues/bulk
+func (s *Server) handleBulkUpdateIssuesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ChangeTeamMemberRole"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}/role"),
+		otelogen.OperationID("BulkUpdateIssues"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/bulk"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangeTeamMemberRoleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BulkUpdateIssuesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -914,15 +918,15 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ChangeTeamMemberRoleOperation,
-			ID:   "ChangeTeamMemberRole",
+			Name: BulkUpdateIssuesOperation,
+			ID:   "BulkUpdateIssues",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ChangeTeamMemberRoleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, BulkUpdateIssuesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -964,7 +968,7 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeChangeTeamMemberRoleParams(args, argsEscaped, r)
+	params, err := decodeBulkUpdateIssuesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -974,7 +978,7 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeChangeTeamMemberRoleRequest(r)
+	request, close, err := s.decodeBulkUpdateIssuesRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -990,31 +994,27 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 		}
 	}()
 
-	var response ChangeTeamMemberRoleRes
+	var response BulkUpdateIssuesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ChangeTeamMemberRoleOperation,
-			OperationSummary: "Change team member role",
-			OperationID:      "ChangeTeamMemberRole",
+			OperationName:    BulkUpdateIssuesOperation,
+			OperationSummary: "Change issues in bulk",
+			OperationID:      "BulkUpdateIssues",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "team_id",
-					In:   "path",
-				}: params.TeamID,
-				{
-					Name: "user_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.UserID,
+				}: params.ProjectID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *ChangeTeamMemberRoleRequest
-			Params   = ChangeTeamMemberRoleParams
-			Response = ChangeTeamMemberRoleRes
+			Request  = *IssueBulkRequest
+			Params   = BulkUpdateIssuesParams
+			Response = BulkUpdateIssuesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1023,14 +1023,14 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackChangeTeamMemberRoleParams,
+			unpackBulkUpdateIssuesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ChangeTeamMemberRole(ctx, request, params)
+				response, err = s.h.BulkUpdateIssues(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ChangeTeamMemberRole(ctx, request, params)
+		response, err = s.h.BulkUpdateIssues(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1049,7 +1049,7 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 		return
 	}
 
-	if err := encodeChangeTeamMemberRoleResponse(response, w, span); err != nil {
+	if err := encodeBulkUpdateIssuesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1058,22 +1058,22 @@ func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped b
 	}
 }
 
-// handleCheckTeamExistsRequest handles CheckTeamExists operation.
+// handleChangeIssueStatusRequest handles changeIssueStatus operation.
 //
-// Check if a team exists by name.
+// Change issue status.
 //
-// GET /api/v1/teams/check-exists/{team_name}
-func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/issues/{issue_id}/change-status
+func (s *Server) handleChangeIssueStatusRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CheckTeamExists"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/check-exists/{team_name}"),
+		otelogen.OperationID("changeIssueStatus"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/change-status"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CheckTeamExistsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangeIssueStatusOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1128,15 +1128,15 @@ func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CheckTeamExistsOperation,
-			ID:   "CheckTeamExists",
+			Name: ChangeIssueStatusOperation,
+			ID:   "changeIssueStatus",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CheckTeamExistsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ChangeIssueStatusOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1178,7 +1178,7 @@ func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeCheckTeamExistsParams(args, argsEscaped, r)
+	params, err := decodeChangeIssueStatusParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1188,28 +1188,47 @@ func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeChangeIssueStatusRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response CheckTeamExistsRes
+	var response ChangeIssueStatusRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CheckTeamExistsOperation,
-			OperationSummary: "Check if a team exists by name",
-			OperationID:      "CheckTeamExists",
-			Body:             nil,
+			OperationName:    ChangeIssueStatusOperation,
+			OperationSummary: "Change issue status",
+			OperationID:      "changeIssueStatus",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "team_name",
+					Name: "project_id",
 					In:   "path",
-				}: params.TeamName,
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = CheckTeamExistsParams
-			Response = CheckTeamExistsRes
+			Request  = *ChangeIssueStatusReq
+			Params   = ChangeIssueStatusParams
+			Response = ChangeIssueStatusRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1218,14 +1237,14 @@ func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackCheckTeamExistsParams,
+			unpackChangeIssueStatusParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CheckTeamExists(ctx, params)
+				response, err = s.h.ChangeIssueStatus(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CheckTeamExists(ctx, params)
+		response, err = s.h.ChangeIssueStatus(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1244,7 +1263,7 @@ func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeCheckTeamExistsResponse(response, w, span); err != nil {
+	if err := encodeChangeIssueStatusResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1253,22 +1272,22 @@ func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleCompareProjectReleasesAnalyticsRequest handles CompareProjectReleasesAnalytics operation.
+// handleChangeTeamMemberRoleRequest handles ChangeTeamMemberRole operation.
 //
-// Compare two releases analytics.
+// Change team member role.
 //
-// POST /api/v1/projects/{project_id}/analytics/releases/compare
-func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/teams/{team_id}/members/{user_id}/role
+func (s *Server) handleChangeTeamMemberRoleRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CompareProjectReleasesAnalytics"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/compare"),
+		otelogen.OperationID("ChangeTeamMemberRole"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}/role"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CompareProjectReleasesAnalyticsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangeTeamMemberRoleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1323,15 +1342,15 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CompareProjectReleasesAnalyticsOperation,
-			ID:   "CompareProjectReleasesAnalytics",
+			Name: ChangeTeamMemberRoleOperation,
+			ID:   "ChangeTeamMemberRole",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CompareProjectReleasesAnalyticsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, ChangeTeamMemberRoleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1373,7 +1392,7 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 			return
 		}
 	}
-	params, err := decodeCompareProjectReleasesAnalyticsParams(args, argsEscaped, r)
+	params, err := decodeChangeTeamMemberRoleParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1383,7 +1402,7 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCompareProjectReleasesAnalyticsRequest(r)
+	request, close, err := s.decodeChangeTeamMemberRoleRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1399,27 +1418,31 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 		}
 	}()
 
-	var response CompareProjectReleasesAnalyticsRes
+	var response ChangeTeamMemberRoleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CompareProjectReleasesAnalyticsOperation,
-			OperationSummary: "Compare two releases analytics",
-			OperationID:      "CompareProjectReleasesAnalytics",
+			OperationName:    ChangeTeamMemberRoleOperation,
+			OperationSummary: "Change team member role",
+			OperationID:      "ChangeTeamMemberRole",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "project_id",
+					Name: "team_id",
 					In:   "path",
-				}: params.ProjectID,
+				}: params.TeamID,
+				{
+					Name: "user_id",
+					In:   "path",
+				}: params.UserID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CompareProjectReleasesAnalyticsReq
-			Params   = CompareProjectReleasesAnalyticsParams
-			Response = CompareProjectReleasesAnalyticsRes
+			Request  = *ChangeTeamMemberRoleRequest
+			Params   = ChangeTeamMemberRoleParams
+			Response = ChangeTeamMemberRoleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1428,14 +1451,14 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 		](
 			m,
 			mreq,
-			unpackCompareProjectReleasesAnalyticsParams,
+			unpackChangeTeamMemberRoleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CompareProjectReleasesAnalytics(ctx, request, params)
+				response, err = s.h.ChangeTeamMemberRole(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CompareProjectReleasesAnalytics(ctx, request, params)
+		response, err = s.h.ChangeTeamMemberRole(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1454,7 +1477,7 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 		return
 	}
 
-	if err := encodeCompareProjectReleasesAnalyticsResponse(response, w, span); err != nil {
+	if err := encodeChangeTeamMemberRoleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1463,22 +1486,22 @@ func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, ar
 	}
 }
 
-// handleConfirm2FARequest handles Confirm2FA operation.
+// handleCheckTeamExistsRequest handles CheckTeamExists operation.
 //
-// Approve enable 2FA (code from app).
+// Check if a team exists by name.
 //
-// POST /api/v1/users/me/2fa/confirm
-func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/teams/check-exists/{team_name}
+func (s *Server) handleCheckTeamExistsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Confirm2FA"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/confirm"),
+		otelogen.OperationID("CheckTeamExists"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/check-exists/{team_name}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), Confirm2FAOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CheckTeamExistsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1533,15 +1556,15 @@ func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: Confirm2FAOperation,
-			ID:   "Confirm2FA",
+			Name: CheckTeamExistsOperation,
+			ID:   "CheckTeamExists",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, Confirm2FAOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CheckTeamExistsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1583,38 +1606,38 @@ func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeConfirm2FARequest(r)
+	params, err := decodeCheckTeamExistsParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response Confirm2FARes
+	var response CheckTeamExistsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    Confirm2FAOperation,
-			OperationSummary: "Approve enable 2FA (code from app)",
-			OperationID:      "Confirm2FA",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    CheckTeamExistsOperation,
+			OperationSummary: "Check if a team exists by name",
+			OperationID:      "CheckTeamExists",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "team_name",
+					In:   "path",
+				}: params.TeamName,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *TwoFAConfirmRequest
-			Params   = struct{}
-			Response = Confirm2FARes
+			Request  = struct{}
+			Params   = CheckTeamExistsParams
+			Response = CheckTeamExistsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1623,14 +1646,14 @@ func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackCheckTeamExistsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Confirm2FA(ctx, request)
+				response, err = s.h.CheckTeamExists(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Confirm2FA(ctx, request)
+		response, err = s.h.CheckTeamExists(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1649,7 +1672,7 @@ func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeConfirm2FAResponse(response, w, span); err != nil {
+	if err := encodeCheckTeamExistsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1658,22 +1681,22 @@ func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCreateNotificationRuleRequest handles CreateNotificationRule operation.
+// handleCompareProjectReleasesAnalyticsRequest handles CompareProjectReleasesAnalytics operation.
 //
-// Create a new notification rule.
+// Compare two releases analytics.
 //
-// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
-func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
-	statusWriter := &codeRecorder{ResponseWriter: w}
+// POST /api/v1/projects/{project_id}/analytics/releases/compare
+func (s *Server) handleCompareProjectReleasesAnalyticsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateNotificationRule"),
+		otelogen.OperationID("CompareProjectReleasesAnalytics"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/compare"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CompareProjectReleasesAnalyticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1728,15 +1751,15 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateNotificationRuleOperation,
-			ID:   "CreateNotificationRule",
+			Name: CompareProjectReleasesAnalyticsOperation,
+			ID:   "CompareProjectReleasesAnalytics",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CompareProjectReleasesAnalyticsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1778,7 +1801,7 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeCreateNotificationRuleParams(args, argsEscaped, r)
+	params, err := decodeCompareProjectReleasesAnalyticsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1788,7 +1811,7 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCreateNotificationRuleRequest(r)
+	request, close, err := s.decodeCompareProjectReleasesAnalyticsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1804,31 +1827,27 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 		}
 	}()
 
-	var response CreateNotificationRuleRes
+	var response CompareProjectReleasesAnalyticsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateNotificationRuleOperation,
-			OperationSummary: "Create a new notification rule",
-			OperationID:      "CreateNotificationRule",
+			OperationName:    CompareProjectReleasesAnalyticsOperation,
+			OperationSummary: "Compare two releases analytics",
+			OperationID:      "CompareProjectReleasesAnalytics",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
-				{
-					Name: "setting_id",
-					In:   "path",
-				}: params.SettingID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CreateNotificationRuleRequest
-			Params   = CreateNotificationRuleParams
-			Response = CreateNotificationRuleRes
+			Request  = *CompareProjectReleasesAnalyticsReq
+			Params   = CompareProjectReleasesAnalyticsParams
+			Response = CompareProjectReleasesAnalyticsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1837,14 +1856,14 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackCreateNotificationRuleParams,
+			unpackCompareProjectReleasesAnalyticsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateNotificationRule(ctx, request, params)
+				response, err = s.h.CompareProjectReleasesAnalytics(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateNotificationRule(ctx, request, params)
+		response, err = s.h.CompareProjectReleasesAnalytics(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1863,7 +1882,7 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 		return
 	}
 
-	if err := encodeCreateNotificationRuleResponse(response, w, span); err != nil {
+	if err := encodeCompareProjectReleasesAnalyticsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1872,22 +1891,22 @@ func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped
 	}
 }
 
-// handleCreateNotificationSettingRequest handles CreateNotificationSetting operation.
+// handleConfirm2FARequest handles Confirm2FA operation.
 //
-// Create a new notification setting.
+// Approve enable 2FA (code from app).
 //
-// POST /api/v1/projects/{project_id}/notification-settings
-func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/users/me/2fa/confirm
+func (s *Server) handleConfirm2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateNotificationSetting"),
+		otelogen.OperationID("Confirm2FA"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/confirm"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationSettingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), Confirm2FAOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1942,15 +1961,15 @@ func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateNotificationSettingOperation,
-			ID:   "CreateNotificationSetting",
+			Name: Confirm2FAOperation,
+			ID:   "Confirm2FA",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationSettingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, Confirm2FAOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1992,17 +2011,7 @@ func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeCreateNotificationSettingParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	request, close, err := s.decodeCreateNotificationSettingRequest(r)
+	request, close, err := s.decodeConfirm2FARequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2018,27 +2027,22 @@ func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEsca
 		}
 	}()
 
-	var response CreateNotificationSettingRes
+	var response Confirm2FARes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateNotificationSettingOperation,
-			OperationSummary: "Create a new notification setting",
-			OperationID:      "CreateNotificationSetting",
+			OperationName:    Confirm2FAOperation,
+			OperationSummary: "Approve enable 2FA (code from app)",
+			OperationID:      "Confirm2FA",
 			Body:             request,
-			Params: middleware.Parameters{
-				{
-					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *CreateNotificationSettingRequest
-			Params   = CreateNotificationSettingParams
-			Response = CreateNotificationSettingRes
+			Request  = *TwoFAConfirmRequest
+			Params   = struct{}
+			Response = Confirm2FARes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2047,14 +2051,14 @@ func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackCreateNotificationSettingParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateNotificationSetting(ctx, request, params)
+				response, err = s.h.Confirm2FA(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateNotificationSetting(ctx, request, params)
+		response, err = s.h.Confirm2FA(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2073,7 +2077,7 @@ func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeCreateNotificationSettingResponse(response, w, span); err != nil {
+	if err := encodeConfirm2FAResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2082,22 +2086,22 @@ func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEsca
 	}
 }
 
-// handleCreateTeamRequest handles CreateTeam operation.
+// handleCreateGroupingRuleRequest handles CreateGroupingRule operation.
 //
-// Create a new team.
+// Applies to new events only, existing issues keep their fingerprints.
 //
-// POST /api/v1/teams
-func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/projects/{project_id}/grouping-rules
+func (s *Server) handleCreateGroupingRuleRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateTeam"),
+		otelogen.OperationID("CreateGroupingRule"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/teams"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateTeamOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateGroupingRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2152,15 +2156,15 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateTeamOperation,
-			ID:   "CreateTeam",
+			Name: CreateGroupingRuleOperation,
+			ID:   "CreateGroupingRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateTeamOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateGroupingRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2202,7 +2206,17 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeCreateTeamRequest(r)
+	params, err := decodeCreateGroupingRuleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCreateGroupingRuleRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2218,22 +2232,27 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response CreateTeamRes
+	var response CreateGroupingRuleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateTeamOperation,
-			OperationSummary: "Create a new team",
-			OperationID:      "CreateTeam",
+			OperationName:    CreateGroupingRuleOperation,
+			OperationSummary: "Create a grouping rule",
+			OperationID:      "CreateGroupingRule",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *CreateTeamRequest
-			Params   = struct{}
-			Response = CreateTeamRes
+			Request  = *GroupingRuleRequest
+			Params   = CreateGroupingRuleParams
+			Response = CreateGroupingRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2242,14 +2261,14 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackCreateGroupingRuleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateTeam(ctx, request)
+				response, err = s.h.CreateGroupingRule(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateTeam(ctx, request)
+		response, err = s.h.CreateGroupingRule(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2268,7 +2287,7 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateTeamResponse(response, w, span); err != nil {
+	if err := encodeCreateGroupingRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2277,22 +2296,22 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCreateUserRequest handles CreateUser operation.
+// handleCreateNotificationRuleRequest handles CreateNotificationRule operation.
 //
-// Create a new user (superuser only).
+// Create a new notification rule.
 //
-// POST /api/v1/users
-func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
+func (s *Server) handleCreateNotificationRuleRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("CreateUser"),
+		otelogen.OperationID("CreateNotificationRule"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2347,15 +2366,15 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateUserOperation,
-			ID:   "CreateUser",
+			Name: CreateNotificationRuleOperation,
+			ID:   "CreateNotificationRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, CreateUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2397,7 +2416,17 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeCreateUserRequest(r)
+	params, err := decodeCreateNotificationRuleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCreateNotificationRuleRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2413,22 +2442,31 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response CreateUserRes
+	var response CreateNotificationRuleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateUserOperation,
-			OperationSummary: "Create a new user (superuser only)",
-			OperationID:      "CreateUser",
+			OperationName:    CreateNotificationRuleOperation,
+			OperationSummary: "Create a new notification rule",
+			OperationID:      "CreateNotificationRule",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *CreateUserRequest
-			Params   = struct{}
-			Response = CreateUserRes
+			Request  = *CreateNotificationRuleRequest
+			Params   = CreateNotificationRuleParams
+			Response = CreateNotificationRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2437,14 +2475,14 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackCreateNotificationRuleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateUser(ctx, request)
+				response, err = s.h.CreateNotificationRule(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateUser(ctx, request)
+		response, err = s.h.CreateNotificationRule(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2463,7 +2501,7 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateUserResponse(response, w, span); err != nil {
+	if err := encodeCreateNotificationRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2472,22 +2510,22 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeleteNotificationRuleRequest handles DeleteNotificationRule operation.
+// handleCreateNotificationSettingRequest handles CreateNotificationSetting operation.
 //
-// Delete a notification rule.
+// Create a new notification setting.
 //
-// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
-func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/projects/{project_id}/notification-settings
+func (s *Server) handleCreateNotificationSettingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteNotificationRule"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+		otelogen.OperationID("CreateNotificationSetting"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateNotificationSettingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2542,15 +2580,15 @@ func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteNotificationRuleOperation,
-			ID:   "DeleteNotificationRule",
+			Name: CreateNotificationSettingOperation,
+			ID:   "CreateNotificationSetting",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateNotificationSettingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2592,7 +2630,7 @@ func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeDeleteNotificationRuleParams(args, argsEscaped, r)
+	params, err := decodeCreateNotificationSettingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2602,36 +2640,43 @@ func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateNotificationSettingRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteNotificationRuleRes
+	var response CreateNotificationSettingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteNotificationRuleOperation,
-			OperationSummary: "Delete a notification rule",
-			OperationID:      "DeleteNotificationRule",
-			Body:             nil,
+			OperationName:    CreateNotificationSettingOperation,
+			OperationSummary: "Create a new notification setting",
+			OperationID:      "CreateNotificationSetting",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
-				{
-					Name: "setting_id",
-					In:   "path",
-				}: params.SettingID,
-				{
-					Name: "rule_id",
-					In:   "path",
-				}: params.RuleID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteNotificationRuleParams
-			Response = DeleteNotificationRuleRes
+			Request  = *CreateNotificationSettingRequest
+			Params   = CreateNotificationSettingParams
+			Response = CreateNotificationSettingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2640,14 +2685,14 @@ func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackDeleteNotificationRuleParams,
+			unpackCreateNotificationSettingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteNotificationRule(ctx, params)
+				response, err = s.h.CreateNotificationSetting(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteNotificationRule(ctx, params)
+		response, err = s.h.CreateNotificationSetting(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2666,7 +2711,7 @@ func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped
 		return
 	}
 
-	if err := encodeDeleteNotificationRuleResponse(response, w, span); err != nil {
+	if err := encodeCreateNotificationSettingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2675,22 +2720,22 @@ func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped
 	}
 }
 
-// handleDeleteNotificationSettingRequest handles DeleteNotificationSetting operation.
+// handleCreateProjectIssueCommentRequest handles CreateProjectIssueComment operation.
 //
-// Delete a notification setting.
+// Users with access to the project mentioned with @username are notified.
 //
-// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}
-func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/projects/{project_id}/issues/{issue_id}/comments
+func (s *Server) handleCreateProjectIssueCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteNotificationSetting"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+		otelogen.OperationID("CreateProjectIssueComment"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/comments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationSettingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateProjectIssueCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2745,15 +2790,15 @@ func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteNotificationSettingOperation,
-			ID:   "DeleteNotificationSetting",
+			Name: CreateProjectIssueCommentOperation,
+			ID:   "CreateProjectIssueComment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationSettingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateProjectIssueCommentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2795,7 +2840,7 @@ func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeDeleteNotificationSettingParams(args, argsEscaped, r)
+	params, err := decodeCreateProjectIssueCommentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2805,32 +2850,47 @@ func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEsca
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateProjectIssueCommentRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteNotificationSettingRes
+	var response CreateProjectIssueCommentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteNotificationSettingOperation,
-			OperationSummary: "Delete a notification setting",
-			OperationID:      "DeleteNotificationSetting",
-			Body:             nil,
+			OperationName:    CreateProjectIssueCommentOperation,
+			OperationSummary: "Add issue comment",
+			OperationID:      "CreateProjectIssueComment",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "setting_id",
+					Name: "issue_id",
 					In:   "path",
-				}: params.SettingID,
+				}: params.IssueID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteNotificationSettingParams
-			Response = DeleteNotificationSettingRes
+			Request  = *CreateIssueCommentRequest
+			Params   = CreateProjectIssueCommentParams
+			Response = CreateProjectIssueCommentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2839,14 +2899,14 @@ func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackDeleteNotificationSettingParams,
+			unpackCreateProjectIssueCommentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteNotificationSetting(ctx, params)
+				response, err = s.h.CreateProjectIssueComment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteNotificationSetting(ctx, params)
+		response, err = s.h.CreateProjectIssueComment(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2865,7 +2925,7 @@ func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEsca
 		return
 	}
 
-	if err := encodeDeleteNotificationSettingResponse(response, w, span); err != nil {
+	if err := encodeCreateProjectIssueCommentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2874,22 +2934,22 @@ func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEsca
 	}
 }
 
-// handleDeleteTeamRequest handles DeleteTeam operation.
+// handleCreateProjectKeyRequest handles CreateProjectKey operation.
 //
-// Delete a team.
+// Create a project client key.
 //
-// DELETE /api/v1/teams/{team_id}
-func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/projects/{project_id}/keys
+func (s *Server) handleCreateProjectKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteTeam"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
+		otelogen.OperationID("CreateProjectKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteTeamOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateProjectKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2944,15 +3004,15 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteTeamOperation,
-			ID:   "DeleteTeam",
+			Name: CreateProjectKeyOperation,
+			ID:   "CreateProjectKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTeamOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateProjectKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2994,7 +3054,7 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteTeamParams(args, argsEscaped, r)
+	params, err := decodeCreateProjectKeyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3004,28 +3064,43 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateProjectKeyRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteTeamRes
+	var response CreateProjectKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteTeamOperation,
-			OperationSummary: "Delete a team",
-			OperationID:      "DeleteTeam",
-			Body:             nil,
+			OperationName:    CreateProjectKeyOperation,
+			OperationSummary: "Create a project client key",
+			OperationID:      "CreateProjectKey",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "team_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.TeamID,
+				}: params.ProjectID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteTeamParams
-			Response = DeleteTeamRes
+			Request  = *ProjectKeyRequest
+			Params   = CreateProjectKeyParams
+			Response = CreateProjectKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3034,14 +3109,14 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteTeamParams,
+			unpackCreateProjectKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteTeam(ctx, params)
+				response, err = s.h.CreateProjectKey(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteTeam(ctx, params)
+		response, err = s.h.CreateProjectKey(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3060,7 +3135,7 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteTeamResponse(response, w, span); err != nil {
+	if err := encodeCreateProjectKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3069,22 +3144,22 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeleteUserRequest handles DeleteUser operation.
+// handleCreateTeamRequest handles CreateTeam operation.
 //
-// Delete a user (superuser only, cannot delete superusers).
+// Create a new team.
 //
-// DELETE /api/v1/users/{user_id}
-func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/teams
+func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("DeleteUser"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}"),
+		otelogen.OperationID("CreateTeam"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/teams"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3139,15 +3214,15 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteUserOperation,
-			ID:   "DeleteUser",
+			Name: CreateTeamOperation,
+			ID:   "CreateTeam",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, DeleteUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateTeamOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3189,38 +3264,38 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteUserParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateTeamRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteUserRes
+	var response CreateTeamRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteUserOperation,
-			OperationSummary: "Delete a user (superuser only, cannot delete superusers)",
-			OperationID:      "DeleteUser",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "user_id",
-					In:   "path",
-				}: params.UserID,
-			},
-			Raw: r,
+			OperationName:    CreateTeamOperation,
+			OperationSummary: "Create a new team",
+			OperationID:      "CreateTeam",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteUserParams
-			Response = DeleteUserRes
+			Request  = *CreateTeamRequest
+			Params   = struct{}
+			Response = CreateTeamRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3229,14 +3304,14 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteUserParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteUser(ctx, params)
+				response, err = s.h.CreateTeam(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteUser(ctx, params)
+		response, err = s.h.CreateTeam(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3255,7 +3330,7 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteUserResponse(response, w, span); err != nil {
+	if err := encodeCreateTeamResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3264,22 +3339,22 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDisable2FARequest handles Disable2FA operation.
+// handleCreateUserRequest handles CreateUser operation.
 //
-// Disable 2FA (using email-confirmation).
+// Create a new user (superuser only).
 //
-// POST /api/v1/users/me/2fa/disable
-func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/users
+func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Disable2FA"),
+		otelogen.OperationID("CreateUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/disable"),
+		semconv.HTTPRouteKey.String("/api/v1/users"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), Disable2FAOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3334,15 +3409,15 @@ func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: Disable2FAOperation,
-			ID:   "Disable2FA",
+			Name: CreateUserOperation,
+			ID:   "CreateUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, Disable2FAOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3384,7 +3459,7 @@ func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeDisable2FARequest(r)
+	request, close, err := s.decodeCreateUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -3400,22 +3475,22 @@ func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response Disable2FARes
+	var response CreateUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    Disable2FAOperation,
-			OperationSummary: "Disable 2FA (using email-confirmation)",
-			OperationID:      "Disable2FA",
+			OperationName:    CreateUserOperation,
+			OperationSummary: "Create a new user (superuser only)",
+			OperationID:      "CreateUser",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *TwoFADisableRequest
+			Request  = *CreateUserRequest
 			Params   = struct{}
-			Response = Disable2FARes
+			Response = CreateUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3426,12 +3501,12 @@ func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Disable2FA(ctx, request)
+				response, err = s.h.CreateUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Disable2FA(ctx, request)
+		response, err = s.h.CreateUser(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3450,7 +3525,7 @@ func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDisable2FAResponse(response, w, span); err != nil {
+	if err := encodeCreateUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3459,22 +3534,22 @@ func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleForgotPasswordRequest handles ForgotPassword operation.
+// handleDeleteGroupingRuleRequest handles DeleteGroupingRule operation.
 //
-// Request a password reset.
+// Delete a grouping rule.
 //
-// POST /api/v1/auth/forgot-password
-func (s *Server) handleForgotPasswordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+func (s *Server) handleDeleteGroupingRuleRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ForgotPassword"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/forgot-password"),
+		otelogen.OperationID("DeleteGroupingRule"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules/{rule_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ForgotPasswordOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteGroupingRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3529,42 +3604,92 @@ func (s *Server) handleForgotPasswordRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ForgotPasswordOperation,
-			ID:   "ForgotPassword",
+			Name: DeleteGroupingRuleOperation,
+			ID:   "DeleteGroupingRule",
 		}
 	)
-	request, close, err := s.decodeForgotPasswordRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
-
-	var response ForgotPasswordRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    ForgotPasswordOperation,
-			OperationSummary: "Request a password reset",
-			OperationID:      "ForgotPassword",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteGroupingRuleOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeDeleteGroupingRuleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteGroupingRuleRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteGroupingRuleOperation,
+			OperationSummary: "Delete a grouping rule",
+			OperationID:      "DeleteGroupingRule",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "rule_id",
+					In:   "path",
+				}: params.RuleID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *ForgotPasswordRequest
-			Params   = struct{}
-			Response = ForgotPasswordRes
+			Request  = struct{}
+			Params   = DeleteGroupingRuleParams
+			Response = DeleteGroupingRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3573,14 +3698,14 @@ func (s *Server) handleForgotPasswordRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteGroupingRuleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ForgotPassword(ctx, request)
+				response, err = s.h.DeleteGroupingRule(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ForgotPassword(ctx, request)
+		response, err = s.h.DeleteGroupingRule(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3599,7 +3724,7 @@ func (s *Server) handleForgotPasswordRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeForgotPasswordResponse(response, w, span); err != nil {
+	if err := encodeDeleteGroupingRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3608,22 +3733,22 @@ func (s *Server) handleForgotPasswordRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetCurrentUserRequest handles GetCurrentUser operation.
+// handleDeleteMonitorRequest handles DeleteMonitor operation.
 //
-// Get current user information.
+// Delete cron monitor.
 //
-// GET /api/v1/users/me
-func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/projects/{project_id}/monitors/{monitor_id}
+func (s *Server) handleDeleteMonitorRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetCurrentUser"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me"),
+		otelogen.OperationID("DeleteMonitor"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/monitors/{monitor_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteMonitorOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3678,15 +3803,15 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentUserOperation,
-			ID:   "GetCurrentUser",
+			Name: DeleteMonitorOperation,
+			ID:   "DeleteMonitor",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteMonitorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3728,23 +3853,42 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	params, err := decodeDeleteMonitorParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response GetCurrentUserRes
+	var response DeleteMonitorRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentUserOperation,
-			OperationSummary: "Get current user information",
-			OperationID:      "GetCurrentUser",
+			OperationName:    DeleteMonitorOperation,
+			OperationSummary: "Delete cron monitor",
+			OperationID:      "DeleteMonitor",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "monitor_id",
+					In:   "path",
+				}: params.MonitorID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetCurrentUserRes
+			Params   = DeleteMonitorParams
+			Response = DeleteMonitorRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3753,14 +3897,14 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteMonitorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentUser(ctx)
+				response, err = s.h.DeleteMonitor(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentUser(ctx)
+		response, err = s.h.DeleteMonitor(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3779,7 +3923,7 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
+	if err := encodeDeleteMonitorResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3788,22 +3932,22 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetEventsTimeseriesRequest handles GetEventsTimeseries operation.
+// handleDeleteNotificationRuleRequest handles DeleteNotificationRule operation.
 //
-// Get events timeseries.
+// Delete a notification rule.
 //
-// GET /api/v1/events/timeseries
-func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
+func (s *Server) handleDeleteNotificationRuleRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetEventsTimeseries"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/events/timeseries"),
+		otelogen.OperationID("DeleteNotificationRule"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetEventsTimeseriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3858,15 +4002,15 @@ func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetEventsTimeseriesOperation,
-			ID:   "GetEventsTimeseries",
+			Name: DeleteNotificationRuleOperation,
+			ID:   "DeleteNotificationRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetEventsTimeseriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3908,7 +4052,7 @@ func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetEventsTimeseriesParams(args, argsEscaped, r)
+	params, err := decodeDeleteNotificationRuleParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3919,35 +4063,35 @@ func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	var response GetEventsTimeseriesRes
+	var response DeleteNotificationRuleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetEventsTimeseriesOperation,
-			OperationSummary: "Get events timeseries",
-			OperationID:      "GetEventsTimeseries",
+			OperationName:    DeleteNotificationRuleOperation,
+			OperationSummary: "Delete a notification rule",
+			OperationID:      "DeleteNotificationRule",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
-					In:   "query",
+					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "interval",
-					In:   "query",
-				}: params.Interval,
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
 				{
-					Name: "granularity",
-					In:   "query",
-				}: params.Granularity,
+					Name: "rule_id",
+					In:   "path",
+				}: params.RuleID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetEventsTimeseriesParams
-			Response = GetEventsTimeseriesRes
+			Params   = DeleteNotificationRuleParams
+			Response = DeleteNotificationRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3956,14 +4100,14 @@ func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetEventsTimeseriesParams,
+			unpackDeleteNotificationRuleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetEventsTimeseries(ctx, params)
+				response, err = s.h.DeleteNotificationRule(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetEventsTimeseries(ctx, params)
+		response, err = s.h.DeleteNotificationRule(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -3982,7 +4126,7 @@ func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetEventsTimeseriesResponse(response, w, span); err != nil {
+	if err := encodeDeleteNotificationRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3991,22 +4135,22 @@ func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetIssueRequest handles GetIssue operation.
+// handleDeleteNotificationSettingRequest handles DeleteNotificationSetting operation.
 //
-// Get details of a specific issue.
+// Delete a notification setting.
 //
-// GET /api/v1/projects/{project_id}/issues/{issue_id}
-func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}
+func (s *Server) handleDeleteNotificationSettingRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetIssue"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}"),
+		otelogen.OperationID("DeleteNotificationSetting"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssueOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteNotificationSettingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4061,15 +4205,15 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIssueOperation,
-			ID:   "GetIssue",
+			Name: DeleteNotificationSettingOperation,
+			ID:   "DeleteNotificationSetting",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetIssueOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteNotificationSettingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4111,7 +4255,7 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	params, err := decodeGetIssueParams(args, argsEscaped, r)
+	params, err := decodeDeleteNotificationSettingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4122,13 +4266,13 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 		return
 	}
 
-	var response GetIssueRes
+	var response DeleteNotificationSettingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIssueOperation,
-			OperationSummary: "Get details of a specific issue",
-			OperationID:      "GetIssue",
+			OperationName:    DeleteNotificationSettingOperation,
+			OperationSummary: "Delete a notification setting",
+			OperationID:      "DeleteNotificationSetting",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -4136,17 +4280,17 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "issue_id",
+					Name: "setting_id",
 					In:   "path",
-				}: params.IssueID,
+				}: params.SettingID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetIssueParams
-			Response = GetIssueRes
+			Params   = DeleteNotificationSettingParams
+			Response = DeleteNotificationSettingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4155,14 +4299,14 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			unpackGetIssueParams,
+			unpackDeleteNotificationSettingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIssue(ctx, params)
+				response, err = s.h.DeleteNotificationSetting(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIssue(ctx, params)
+		response, err = s.h.DeleteNotificationSetting(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4181,7 +4325,7 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeGetIssueResponse(response, w, span); err != nil {
+	if err := encodeDeleteNotificationSettingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4190,22 +4334,22 @@ func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.
 	}
 }
 
-// handleGetIssuesTimeseriesRequest handles GetIssuesTimeseries operation.
+// handleDeleteProjectIssueCommentRequest handles DeleteProjectIssueComment operation.
 //
-// Get issues timeseries.
+// Delete issue comment.
 //
-// GET /api/v1/issues/timeseries
-func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}
+func (s *Server) handleDeleteProjectIssueCommentRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetIssuesTimeseries"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/issues/timeseries"),
+		otelogen.OperationID("DeleteProjectIssueComment"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssuesTimeseriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteProjectIssueCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4260,15 +4404,15 @@ func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIssuesTimeseriesOperation,
-			ID:   "GetIssuesTimeseries",
+			Name: DeleteProjectIssueCommentOperation,
+			ID:   "DeleteProjectIssueComment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetIssuesTimeseriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteProjectIssueCommentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4310,7 +4454,7 @@ func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetIssuesTimeseriesParams(args, argsEscaped, r)
+	params, err := decodeDeleteProjectIssueCommentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4321,35 +4465,35 @@ func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	var response GetIssuesTimeseriesRes
+	var response DeleteProjectIssueCommentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIssuesTimeseriesOperation,
-			OperationSummary: "Get issues timeseries",
-			OperationID:      "GetIssuesTimeseries",
+			OperationName:    DeleteProjectIssueCommentOperation,
+			OperationSummary: "Delete issue comment",
+			OperationID:      "DeleteProjectIssueComment",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
-					In:   "query",
+					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "interval",
-					In:   "query",
-				}: params.Interval,
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
 				{
-					Name: "granularity",
-					In:   "query",
-				}: params.Granularity,
+					Name: "comment_id",
+					In:   "path",
+				}: params.CommentID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetIssuesTimeseriesParams
-			Response = GetIssuesTimeseriesRes
+			Params   = DeleteProjectIssueCommentParams
+			Response = DeleteProjectIssueCommentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4358,14 +4502,14 @@ func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetIssuesTimeseriesParams,
+			unpackDeleteProjectIssueCommentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIssuesTimeseries(ctx, params)
+				response, err = s.h.DeleteProjectIssueComment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIssuesTimeseries(ctx, params)
+		response, err = s.h.DeleteProjectIssueComment(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4384,7 +4528,7 @@ func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetIssuesTimeseriesResponse(response, w, span); err != nil {
+	if err := encodeDeleteProjectIssueCommentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4393,22 +4537,22 @@ func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleGetNotificationRuleRequest handles GetNotificationRule operation.
+// handleDeleteTeamRequest handles DeleteTeam operation.
 //
-// Get a specific notification rule.
+// Delete a team.
 //
-// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
-func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/teams/{team_id}
+func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetNotificationRule"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+		otelogen.OperationID("DeleteTeam"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetNotificationRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4463,15 +4607,15 @@ func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetNotificationRuleOperation,
-			ID:   "GetNotificationRule",
+			Name: DeleteTeamOperation,
+			ID:   "DeleteTeam",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetNotificationRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTeamOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4513,7 +4657,7 @@ func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeGetNotificationRuleParams(args, argsEscaped, r)
+	params, err := decodeDeleteTeamParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4524,35 +4668,27 @@ func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bo
 		return
 	}
 
-	var response GetNotificationRuleRes
+	var response DeleteTeamRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetNotificationRuleOperation,
-			OperationSummary: "Get a specific notification rule",
-			OperationID:      "GetNotificationRule",
+			OperationName:    DeleteTeamOperation,
+			OperationSummary: "Delete a team",
+			OperationID:      "DeleteTeam",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-				{
-					Name: "setting_id",
-					In:   "path",
-				}: params.SettingID,
-				{
-					Name: "rule_id",
+					Name: "team_id",
 					In:   "path",
-				}: params.RuleID,
+				}: params.TeamID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetNotificationRuleParams
-			Response = GetNotificationRuleRes
+			Params   = DeleteTeamParams
+			Response = DeleteTeamRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4561,14 +4697,14 @@ func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetNotificationRuleParams,
+			unpackDeleteTeamParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNotificationRule(ctx, params)
+				response, err = s.h.DeleteTeam(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNotificationRule(ctx, params)
+		response, err = s.h.DeleteTeam(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4587,7 +4723,7 @@ func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetNotificationRuleResponse(response, w, span); err != nil {
+	if err := encodeDeleteTeamResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4596,22 +4732,22 @@ func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bo
 	}
 }
 
-// handleGetNotificationSettingRequest handles GetNotificationSetting operation.
+// handleDeleteUserRequest handles DeleteUser operation.
 //
-// Get a specific notification setting.
+// Delete a user (superuser only, cannot delete superusers).
 //
-// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}
-func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /api/v1/users/{user_id}
+func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetNotificationSetting"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+		otelogen.OperationID("DeleteUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetNotificationSettingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4666,15 +4802,15 @@ func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetNotificationSettingOperation,
-			ID:   "GetNotificationSetting",
+			Name: DeleteUserOperation,
+			ID:   "DeleteUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetNotificationSettingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4716,7 +4852,7 @@ func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetNotificationSettingParams(args, argsEscaped, r)
+	params, err := decodeDeleteUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4727,31 +4863,27 @@ func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped
 		return
 	}
 
-	var response GetNotificationSettingRes
+	var response DeleteUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetNotificationSettingOperation,
-			OperationSummary: "Get a specific notification setting",
-			OperationID:      "GetNotificationSetting",
+			OperationName:    DeleteUserOperation,
+			OperationSummary: "Delete a user (superuser only, cannot delete superusers)",
+			OperationID:      "DeleteUser",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-				{
-					Name: "setting_id",
+					Name: "user_id",
 					In:   "path",
-				}: params.SettingID,
+				}: params.UserID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetNotificationSettingParams
-			Response = GetNotificationSettingRes
+			Params   = DeleteUserParams
+			Response = DeleteUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4760,14 +4892,14 @@ func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetNotificationSettingParams,
+			unpackDeleteUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNotificationSetting(ctx, params)
+				response, err = s.h.DeleteUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNotificationSetting(ctx, params)
+		response, err = s.h.DeleteUser(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4786,7 +4918,7 @@ func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetNotificationSettingResponse(response, w, span); err != nil {
+	if err := encodeDeleteUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4795,22 +4927,22 @@ func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped
 	}
 }
 
-// handleGetProjectRequest handles GetProject operation.
+// handleDisable2FARequest handles Disable2FA operation.
 //
-// Get project details.
+// Disable 2FA (using email-confirmation).
 //
-// GET /api/v1/projects/{project_id}
-func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/users/me/2fa/disable
+func (s *Server) handleDisable2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProject"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
+		otelogen.OperationID("Disable2FA"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/disable"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), Disable2FAOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4865,15 +4997,15 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectOperation,
-			ID:   "GetProject",
+			Name: Disable2FAOperation,
+			ID:   "Disable2FA",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, Disable2FAOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4915,38 +5047,38 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeGetProjectParams(args, argsEscaped, r)
+	request, close, err := s.decodeDisable2FARequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetProjectRes
+	var response Disable2FARes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectOperation,
-			OperationSummary: "Get project details",
-			OperationID:      "GetProject",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-			},
-			Raw: r,
+			OperationName:    Disable2FAOperation,
+			OperationSummary: "Disable 2FA (using email-confirmation)",
+			OperationID:      "Disable2FA",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetProjectParams
-			Response = GetProjectRes
+			Request  = *TwoFADisableRequest
+			Params   = struct{}
+			Response = Disable2FARes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4955,14 +5087,14 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetProjectParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProject(ctx, params)
+				response, err = s.h.Disable2FA(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProject(ctx, params)
+		response, err = s.h.Disable2FA(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -4981,7 +5113,7 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetProjectResponse(response, w, span); err != nil {
+	if err := encodeDisable2FAResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4990,22 +5122,22 @@ func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetProjectIssueEventsTimeseriesRequest handles GetProjectIssueEventsTimeseries operation.
+// handleDownloadAttachmentRequest handles DownloadAttachment operation.
 //
-// Get timeseries of events for a specific issue inside a project.
+// Download attachment.
 //
-// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries
-func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/attachments/{attachment_id}/download
+func (s *Server) handleDownloadAttachmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectIssueEventsTimeseries"),
+		otelogen.OperationID("DownloadAttachment"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/attachments/{attachment_id}/download"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueEventsTimeseriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5060,15 +5192,15 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectIssueEventsTimeseriesOperation,
-			ID:   "GetProjectIssueEventsTimeseries",
+			Name: DownloadAttachmentOperation,
+			ID:   "DownloadAttachment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueEventsTimeseriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, DownloadAttachmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5110,7 +5242,7 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 			return
 		}
 	}
-	params, err := decodeGetProjectIssueEventsTimeseriesParams(args, argsEscaped, r)
+	params, err := decodeDownloadAttachmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5121,13 +5253,13 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 		return
 	}
 
-	var response GetProjectIssueEventsTimeseriesRes
+	var response DownloadAttachmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectIssueEventsTimeseriesOperation,
-			OperationSummary: "Get timeseries of events for a specific issue inside a project",
-			OperationID:      "GetProjectIssueEventsTimeseries",
+			OperationName:    DownloadAttachmentOperation,
+			OperationSummary: "Download attachment",
+			OperationID:      "DownloadAttachment",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -5135,25 +5267,17 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "issue_id",
+					Name: "attachment_id",
 					In:   "path",
-				}: params.IssueID,
-				{
-					Name: "interval",
-					In:   "query",
-				}: params.Interval,
-				{
-					Name: "granularity",
-					In:   "query",
-				}: params.Granularity,
+				}: params.AttachmentID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectIssueEventsTimeseriesParams
-			Response = GetProjectIssueEventsTimeseriesRes
+			Params   = DownloadAttachmentParams
+			Response = DownloadAttachmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5162,14 +5286,14 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 		](
 			m,
 			mreq,
-			unpackGetProjectIssueEventsTimeseriesParams,
+			unpackDownloadAttachmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectIssueEventsTimeseries(ctx, params)
+				response, err = s.h.DownloadAttachment(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectIssueEventsTimeseries(ctx, params)
+		response, err = s.h.DownloadAttachment(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5188,7 +5312,7 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 		return
 	}
 
-	if err := encodeGetProjectIssueEventsTimeseriesResponse(response, w, span); err != nil {
+	if err := encodeDownloadAttachmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5197,22 +5321,22 @@ func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, ar
 	}
 }
 
-// handleGetProjectIssueTimeseriesRequest handles GetProjectIssueTimeseries operation.
+// handleForgotPasswordRequest handles ForgotPassword operation.
 //
-// Get timeseries for a specific issue inside a project.
+// Request a password reset.
 //
-// GET /api/v1/projects/{project_id}/issues/{issue_id}/timeseries
-func (s *Server) handleGetProjectIssueTimeseriesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /api/v1/auth/forgot-password
+func (s *Server) handleForgotPasswordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectIssueTimeseries"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/timeseries"),
+		otelogen.OperationID("ForgotPassword"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/forgot-password"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueTimeseriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ForgotPasswordOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5267,100 +5391,42 @@ func (s *Server) handleGetProjectIssueTimeseriesRequest(args [2]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectIssueTimeseriesOperation,
-			ID:   "GetProjectIssueTimeseries",
+			Name: ForgotPasswordOperation,
+			ID:   "ForgotPassword",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueTimeseriesOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "BearerAuth",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-					defer recordError("Security:BearerAuth", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
-				defer recordError("Security", err)
-			}
-			return
-		}
-	}
-	params, err := decodeGetProjectIssueTimeseriesParams(args, argsEscaped, r)
+	request, close, err := s.decodeForgotPasswordRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetProjectIssueTimeseriesRes
+	var response ForgotPasswordRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectIssueTimeseriesOperation,
-			OperationSummary: "Get timeseries for a specific issue inside a project",
-			OperationID:      "GetProjectIssueTimeseries",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-				{
-					Name: "issue_id",
-					In:   "path",
-				}: params.IssueID,
-				{
-					Name: "interval",
-					In:   "query",
-				}: params.Interval,
-				{
-					Name: "granularity",
-					In:   "query",
-				}: params.Granularity,
-			},
-			Raw: r,
+			OperationName:    ForgotPasswordOperation,
+			OperationSummary: "Request a password reset",
+			OperationID:      "ForgotPassword",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetProjectIssueTimeseriesParams
-			Response = GetProjectIssueTimeseriesRes
+			Request  = *ForgotPasswordRequest
+			Params   = struct{}
+			Response = ForgotPasswordRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5369,14 +5435,14 @@ func (s *Server) handleGetProjectIssueTimeseriesRequest(args [2]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetProjectIssueTimeseriesParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectIssueTimeseries(ctx, params)
+				response, err = s.h.ForgotPassword(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectIssueTimeseries(ctx, params)
+		response, err = s.h.ForgotPassword(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5395,7 +5461,7 @@ func (s *Server) handleGetProjectIssueTimeseriesRequest(args [2]string, argsEsca
 		return
 	}
 
-	if err := encodeGetProjectIssueTimeseriesResponse(response, w, span); err != nil {
+	if err := encodeForgotPasswordResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5404,22 +5470,22 @@ func (s *Server) handleGetProjectIssueTimeseriesRequest(args [2]string, argsEsca
 	}
 }
 
-// handleGetProjectReleaseAnalyticsDetailsRequest handles GetProjectReleaseAnalyticsDetails operation.
+// handleGetCurrentUserRequest handles GetCurrentUser operation.
 //
-// Get analytics details for a specific release.
+// Get current user information.
 //
-// GET /api/v1/projects/{project_id}/analytics/releases/{version}
-func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/users/me
+func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleaseAnalyticsDetails"),
+		otelogen.OperationID("GetCurrentUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/{version}"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleaseAnalyticsDetailsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5474,15 +5540,15 @@ func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectReleaseAnalyticsDetailsOperation,
-			ID:   "GetProjectReleaseAnalyticsDetails",
+			Name: GetCurrentUserOperation,
+			ID:   "GetCurrentUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleaseAnalyticsDetailsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCurrentUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5524,42 +5590,23 @@ func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string,
 			return
 		}
 	}
-	params, err := decodeGetProjectReleaseAnalyticsDetailsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response GetProjectReleaseAnalyticsDetailsRes
+	var response GetCurrentUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectReleaseAnalyticsDetailsOperation,
-			OperationSummary: "Get analytics details for a specific release",
-			OperationID:      "GetProjectReleaseAnalyticsDetails",
+			OperationName:    GetCurrentUserOperation,
+			OperationSummary: "Get current user information",
+			OperationID:      "GetCurrentUser",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-				{
-					Name: "version",
-					In:   "path",
-				}: params.Version,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectReleaseAnalyticsDetailsParams
-			Response = GetProjectReleaseAnalyticsDetailsRes
+			Params   = struct{}
+			Response = GetCurrentUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5568,14 +5615,14 @@ func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string,
 		](
 			m,
 			mreq,
-			unpackGetProjectReleaseAnalyticsDetailsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectReleaseAnalyticsDetails(ctx, params)
+				response, err = s.h.GetCurrentUser(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectReleaseAnalyticsDetails(ctx, params)
+		response, err = s.h.GetCurrentUser(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5594,7 +5641,7 @@ func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string,
 		return
 	}
 
-	if err := encodeGetProjectReleaseAnalyticsDetailsResponse(response, w, span); err != nil {
+	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5603,22 +5650,22 @@ func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string,
 	}
 }
 
-// handleGetProjectReleaseErrorsTimeseriesRequest handles GetProjectReleaseErrorsTimeseries operation.
+// handleGetEventsTimeseriesRequest handles GetEventsTimeseries operation.
 //
-// Get errors timeseries for a release.
+// Get events timeseries.
 //
-// GET /api/v1/projects/{project_id}/analytics/traffic
-func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/events/timeseries
+func (s *Server) handleGetEventsTimeseriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleaseErrorsTimeseries"),
+		otelogen.OperationID("GetEventsTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/traffic"),
+		semconv.HTTPRouteKey.String("/api/v1/events/timeseries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleaseErrorsTimeseriesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetEventsTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5673,15 +5720,15 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectReleaseErrorsTimeseriesOperation,
-			ID:   "GetProjectReleaseErrorsTimeseries",
+			Name: GetEventsTimeseriesOperation,
+			ID:   "GetEventsTimeseries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleaseErrorsTimeseriesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetEventsTimeseriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5723,7 +5770,7 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 			return
 		}
 	}
-	params, err := decodeGetProjectReleaseErrorsTimeseriesParams(args, argsEscaped, r)
+	params, err := decodeGetEventsTimeseriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5734,31 +5781,19 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 		return
 	}
 
-	var response GetProjectReleaseErrorsTimeseriesRes
+	var response GetEventsTimeseriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectReleaseErrorsTimeseriesOperation,
-			OperationSummary: "Get errors timeseries for a release",
-			OperationID:      "GetProjectReleaseErrorsTimeseries",
+			OperationName:    GetEventsTimeseriesOperation,
+			OperationSummary: "Get events timeseries",
+			OperationID:      "GetEventsTimeseries",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
-					In:   "path",
-				}: params.ProjectID,
-				{
-					Name: "release",
-					In:   "query",
-				}: params.Release,
-				{
-					Name: "level",
-					In:   "query",
-				}: params.Level,
-				{
-					Name: "group_by",
 					In:   "query",
-				}: params.GroupBy,
+				}: params.ProjectID,
 				{
 					Name: "interval",
 					In:   "query",
@@ -5767,14 +5802,18 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 					Name: "granularity",
 					In:   "query",
 				}: params.Granularity,
+				{
+					Name: "environment",
+					In:   "query",
+				}: params.Environment,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectReleaseErrorsTimeseriesParams
-			Response = GetProjectReleaseErrorsTimeseriesRes
+			Params   = GetEventsTimeseriesParams
+			Response = GetEventsTimeseriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5783,14 +5822,14 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 		](
 			m,
 			mreq,
-			unpackGetProjectReleaseErrorsTimeseriesParams,
+			unpackGetEventsTimeseriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectReleaseErrorsTimeseries(ctx, params)
+				response, err = s.h.GetEventsTimeseries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectReleaseErrorsTimeseries(ctx, params)
+		response, err = s.h.GetEventsTimeseries(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -5809,7 +5848,7 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 		return
 	}
 
-	if err := encodeGetProjectReleaseErrorsTimeseriesResponse(response, w, span); err != nil {
+	if err := encodeGetEventsTimeseriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5818,22 +5857,22 @@ func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string,
 	}
 }
 
-// handleGetProjectReleaseSegmentsRequest handles GetProjectReleaseSegments operation.
+// handleGetIssueRequest handles GetIssue operation.
 //
-// Get user segments analytics for a release.
+// Get details of a specific issue.
 //
-// GET /api/v1/projects/{project_id}/analytics/segments
-func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/issues/{issue_id}
+func (s *Server) handleGetIssueRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleaseSegments"),
+		otelogen.OperationID("GetIssue"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/segments"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleaseSegmentsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssueOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5888,15 +5927,15 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectReleaseSegmentsOperation,
-			ID:   "GetProjectReleaseSegments",
+			Name: GetIssueOperation,
+			ID:   "GetIssue",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleaseSegmentsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetIssueOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5938,7 +5977,7 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeGetProjectReleaseSegmentsParams(args, argsEscaped, r)
+	params, err := decodeGetIssueParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5949,13 +5988,13 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 		return
 	}
 
-	var response GetProjectReleaseSegmentsRes
+	var response GetIssueRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectReleaseSegmentsOperation,
-			OperationSummary: "Get user segments analytics for a release",
-			OperationID:      "GetProjectReleaseSegments",
+			OperationName:    GetIssueOperation,
+			OperationSummary: "Get details of a specific issue",
+			OperationID:      "GetIssue",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -5963,21 +6002,17 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "release",
-					In:   "query",
-				}: params.Release,
-				{
-					Name: "segment",
-					In:   "query",
-				}: params.Segment,
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectReleaseSegmentsParams
-			Response = GetProjectReleaseSegmentsRes
+			Params   = GetIssueParams
+			Response = GetIssueRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5986,14 +6021,14 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetProjectReleaseSegmentsParams,
+			unpackGetIssueParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectReleaseSegments(ctx, params)
+				response, err = s.h.GetIssue(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectReleaseSegments(ctx, params)
+		response, err = s.h.GetIssue(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6012,7 +6047,7 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetProjectReleaseSegmentsResponse(response, w, span); err != nil {
+	if err := encodeGetIssueResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6021,22 +6056,22 @@ func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetProjectReleasesAnalyticsRequest handles GetProjectReleasesAnalytics operation.
+// handleGetIssuesTimeseriesRequest handles GetIssuesTimeseries operation.
 //
-// Get analytics summary for all releases in project.
+// Get issues timeseries.
 //
-// GET /api/v1/projects/{project_id}/analytics/releases
-func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/issues/timeseries
+func (s *Server) handleGetIssuesTimeseriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectReleasesAnalytics"),
+		otelogen.OperationID("GetIssuesTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases"),
+		semconv.HTTPRouteKey.String("/api/v1/issues/timeseries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleasesAnalyticsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetIssuesTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6091,15 +6126,15 @@ func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectReleasesAnalyticsOperation,
-			ID:   "GetProjectReleasesAnalytics",
+			Name: GetIssuesTimeseriesOperation,
+			ID:   "GetIssuesTimeseries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleasesAnalyticsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetIssuesTimeseriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6141,7 +6176,7 @@ func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEs
 			return
 		}
 	}
-	params, err := decodeGetProjectReleasesAnalyticsParams(args, argsEscaped, r)
+	params, err := decodeGetIssuesTimeseriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6152,27 +6187,35 @@ func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEs
 		return
 	}
 
-	var response GetProjectReleasesAnalyticsRes
+	var response GetIssuesTimeseriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectReleasesAnalyticsOperation,
-			OperationSummary: "Get analytics summary for all releases in project",
-			OperationID:      "GetProjectReleasesAnalytics",
+			OperationName:    GetIssuesTimeseriesOperation,
+			OperationSummary: "Get issues timeseries",
+			OperationID:      "GetIssuesTimeseries",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
-					In:   "path",
+					In:   "query",
 				}: params.ProjectID,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "granularity",
+					In:   "query",
+				}: params.Granularity,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectReleasesAnalyticsParams
-			Response = GetProjectReleasesAnalyticsRes
+			Params   = GetIssuesTimeseriesParams
+			Response = GetIssuesTimeseriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6181,14 +6224,14 @@ func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackGetProjectReleasesAnalyticsParams,
+			unpackGetIssuesTimeseriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectReleasesAnalytics(ctx, params)
+				response, err = s.h.GetIssuesTimeseries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectReleasesAnalytics(ctx, params)
+		response, err = s.h.GetIssuesTimeseries(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6207,7 +6250,7 @@ func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeGetProjectReleasesAnalyticsResponse(response, w, span); err != nil {
+	if err := encodeGetIssuesTimeseriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6216,22 +6259,22 @@ func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEs
 	}
 }
 
-// handleGetProjectStatsRequest handles GetProjectStats operation.
+// handleGetMonitorRequest handles GetMonitor operation.
 //
-// Get project statistics.
+// Get cron monitor with recent check-ins.
 //
-// GET /api/v1/projects/{project_id}/stats
-func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/monitors/{monitor_id}
+func (s *Server) handleGetMonitorRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectStats"),
+		otelogen.OperationID("GetMonitor"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/stats"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/monitors/{monitor_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectStatsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMonitorOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6286,15 +6329,15 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectStatsOperation,
-			ID:   "GetProjectStats",
+			Name: GetMonitorOperation,
+			ID:   "GetMonitor",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectStatsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMonitorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6336,7 +6379,7 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetProjectStatsParams(args, argsEscaped, r)
+	params, err := decodeGetMonitorParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6347,13 +6390,13 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response GetProjectStatsRes
+	var response GetMonitorRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectStatsOperation,
-			OperationSummary: "Get project statistics",
-			OperationID:      "GetProjectStats",
+			OperationName:    GetMonitorOperation,
+			OperationSummary: "Get cron monitor with recent check-ins",
+			OperationID:      "GetMonitor",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -6361,17 +6404,17 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "period",
-					In:   "query",
-				}: params.Period,
+					Name: "monitor_id",
+					In:   "path",
+				}: params.MonitorID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectStatsParams
-			Response = GetProjectStatsRes
+			Params   = GetMonitorParams
+			Response = GetMonitorRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6380,14 +6423,14 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetProjectStatsParams,
+			unpackGetMonitorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectStats(ctx, params)
+				response, err = s.h.GetMonitor(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectStats(ctx, params)
+		response, err = s.h.GetMonitor(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6406,7 +6449,7 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetProjectStatsResponse(response, w, span); err != nil {
+	if err := encodeGetMonitorResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6415,22 +6458,22 @@ func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetProjectTeamRequest handles GetProjectTeam operation.
+// handleGetNotificationRuleRequest handles GetNotificationRule operation.
 //
-// Get project team.
+// Get a specific notification rule.
 //
-// GET /api/v1/projects/{project_id}/team
-func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
+func (s *Server) handleGetNotificationRuleRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetProjectTeam"),
+		otelogen.OperationID("GetNotificationRule"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/team"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectTeamOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetNotificationRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6485,15 +6528,15 @@ func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetProjectTeamOperation,
-			ID:   "GetProjectTeam",
+			Name: GetNotificationRuleOperation,
+			ID:   "GetNotificationRule",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectTeamOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetNotificationRuleOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6535,7 +6578,7 @@ func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetProjectTeamParams(args, argsEscaped, r)
+	params, err := decodeGetNotificationRuleParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6546,27 +6589,35 @@ func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response GetProjectTeamRes
+	var response GetNotificationRuleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetProjectTeamOperation,
-			OperationSummary: "Get project team",
-			OperationID:      "GetProjectTeam",
+			OperationName:    GetNotificationRuleOperation,
+			OperationSummary: "Get a specific notification rule",
+			OperationID:      "GetNotificationRule",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
+				{
+					Name: "rule_id",
+					In:   "path",
+				}: params.RuleID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetProjectTeamParams
-			Response = GetProjectTeamRes
+			Params   = GetNotificationRuleParams
+			Response = GetNotificationRuleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6575,14 +6626,14 @@ func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetProjectTeamParams,
+			unpackGetNotificationRuleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetProjectTeam(ctx, params)
+				response, err = s.h.GetNotificationRule(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetProjectTeam(ctx, params)
+		response, err = s.h.GetNotificationRule(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6601,7 +6652,7 @@ func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetProjectTeamResponse(response, w, span); err != nil {
+	if err := encodeGetNotificationRuleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6610,22 +6661,22 @@ func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetRecentIssuesRequest handles GetRecentIssues operation.
+// handleGetNotificationSettingRequest handles GetNotificationSetting operation.
 //
-// Get recent issues across all projects.
+// Get a specific notification setting.
 //
-// GET /api/v1/issues/recent
-func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}
+func (s *Server) handleGetNotificationSettingRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetRecentIssues"),
+		otelogen.OperationID("GetNotificationSetting"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/issues/recent"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecentIssuesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetNotificationSettingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6680,15 +6731,15 @@ func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetRecentIssuesOperation,
-			ID:   "GetRecentIssues",
+			Name: GetNotificationSettingOperation,
+			ID:   "GetNotificationSetting",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetRecentIssuesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetNotificationSettingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6730,7 +6781,7 @@ func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeGetRecentIssuesParams(args, argsEscaped, r)
+	params, err := decodeGetNotificationSettingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6741,27 +6792,31 @@ func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	var response GetRecentIssuesRes
+	var response GetNotificationSettingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetRecentIssuesOperation,
-			OperationSummary: "Get recent issues across all projects",
-			OperationID:      "GetRecentIssues",
+			OperationName:    GetNotificationSettingOperation,
+			OperationSummary: "Get a specific notification setting",
+			OperationID:      "GetNotificationSetting",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRecentIssuesParams
-			Response = GetRecentIssuesRes
+			Params   = GetNotificationSettingParams
+			Response = GetNotificationSettingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6770,14 +6825,14 @@ func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetRecentIssuesParams,
+			unpackGetNotificationSettingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRecentIssues(ctx, params)
+				response, err = s.h.GetNotificationSetting(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRecentIssues(ctx, params)
+		response, err = s.h.GetNotificationSetting(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6796,7 +6851,7 @@ func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetRecentIssuesResponse(response, w, span); err != nil {
+	if err := encodeGetNotificationSettingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6805,22 +6860,22 @@ func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetTeamRequest handles GetTeam operation.
+// handleGetProjectRequest handles GetProject operation.
 //
-// Get team details.
+// Get project details.
 //
-// GET /api/v1/teams/{team_id}
-func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}
+func (s *Server) handleGetProjectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetTeam"),
+		otelogen.OperationID("GetProject"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTeamOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6875,15 +6930,15 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTeamOperation,
-			ID:   "GetTeam",
+			Name: GetProjectOperation,
+			ID:   "GetProject",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetTeamOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6925,7 +6980,7 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetTeamParams(args, argsEscaped, r)
+	params, err := decodeGetProjectParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6936,27 +6991,27 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	var response GetTeamRes
+	var response GetProjectRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTeamOperation,
-			OperationSummary: "Get team details",
-			OperationID:      "GetTeam",
+			OperationName:    GetProjectOperation,
+			OperationSummary: "Get project details",
+			OperationID:      "GetProject",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "team_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.TeamID,
+				}: params.ProjectID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetTeamParams
-			Response = GetTeamRes
+			Params   = GetProjectParams
+			Response = GetProjectRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6965,14 +7020,14 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetTeamParams,
+			unpackGetProjectParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTeam(ctx, params)
+				response, err = s.h.GetProject(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTeam(ctx, params)
+		response, err = s.h.GetProject(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -6991,7 +7046,7 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetTeamResponse(response, w, span); err != nil {
+	if err := encodeGetProjectResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7000,22 +7055,22 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetUnreadNotificationsCountRequest handles GetUnreadNotificationsCount operation.
+// handleGetProjectDataScrubbingRequest handles GetProjectDataScrubbing operation.
 //
-// Get unread notifications count.
+// Get project data scrubbing.
 //
-// GET /api/v1/notifications/unread-count
-func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/data-scrubbing
+func (s *Server) handleGetProjectDataScrubbingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetUnreadNotificationsCount"),
+		otelogen.OperationID("GetProjectDataScrubbing"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications/unread-count"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/data-scrubbing"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUnreadNotificationsCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectDataScrubbingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7070,15 +7125,15 @@ func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEs
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUnreadNotificationsCountOperation,
-			ID:   "GetUnreadNotificationsCount",
+			Name: GetProjectDataScrubbingOperation,
+			ID:   "GetProjectDataScrubbing",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetUnreadNotificationsCountOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectDataScrubbingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7120,23 +7175,38 @@ func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEs
 			return
 		}
 	}
+	params, err := decodeGetProjectDataScrubbingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response GetUnreadNotificationsCountRes
+	var response GetProjectDataScrubbingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUnreadNotificationsCountOperation,
-			OperationSummary: "Get unread notifications count",
-			OperationID:      "GetUnreadNotificationsCount",
+			OperationName:    GetProjectDataScrubbingOperation,
+			OperationSummary: "Get project data scrubbing",
+			OperationID:      "GetProjectDataScrubbing",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetUnreadNotificationsCountRes
+			Params   = GetProjectDataScrubbingParams
+			Response = GetProjectDataScrubbingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7145,14 +7215,14 @@ func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEs
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectDataScrubbingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUnreadNotificationsCount(ctx)
+				response, err = s.h.GetProjectDataScrubbing(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUnreadNotificationsCount(ctx)
+		response, err = s.h.GetProjectDataScrubbing(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7171,7 +7241,7 @@ func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEs
 		return
 	}
 
-	if err := encodeGetUnreadNotificationsCountResponse(response, w, span); err != nil {
+	if err := encodeGetProjectDataScrubbingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7180,22 +7250,22 @@ func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEs
 	}
 }
 
-// handleGetUserNotificationsRequest handles GetUserNotifications operation.
+// handleGetProjectEventRequest handles GetProjectEvent operation.
 //
-// Get user notifications.
+// Get event.
 //
-// GET /api/v1/notifications
-func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/events/{event_id}
+func (s *Server) handleGetProjectEventRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetUserNotifications"),
+		otelogen.OperationID("GetProjectEvent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/events/{event_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserNotificationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectEventOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7250,15 +7320,15 @@ func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserNotificationsOperation,
-			ID:   "GetUserNotifications",
+			Name: GetProjectEventOperation,
+			ID:   "GetProjectEvent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, GetUserNotificationsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectEventOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7300,7 +7370,7 @@ func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetUserNotificationsParams(args, argsEscaped, r)
+	params, err := decodeGetProjectEventParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7311,31 +7381,31 @@ func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	var response GetUserNotificationsRes
+	var response GetProjectEventRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserNotificationsOperation,
-			OperationSummary: "Get user notifications",
-			OperationID:      "GetUserNotifications",
+			OperationName:    GetProjectEventOperation,
+			OperationSummary: "Get event",
+			OperationID:      "GetProjectEvent",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
 				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+					Name: "event_id",
+					In:   "path",
+				}: params.EventID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserNotificationsParams
-			Response = GetUserNotificationsRes
+			Params   = GetProjectEventParams
+			Response = GetProjectEventRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7344,14 +7414,14 @@ func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetUserNotificationsParams,
+			unpackGetProjectEventParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserNotifications(ctx, params)
+				response, err = s.h.GetProjectEvent(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserNotifications(ctx, params)
+		response, err = s.h.GetProjectEvent(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7370,7 +7440,7 @@ func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetUserNotificationsResponse(response, w, span); err != nil {
+	if err := encodeGetProjectEventResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7379,23 +7449,22 @@ func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetVersionsRequest handles GetVersions operation.
+// handleGetProjectGroupingConfigRequest handles GetProjectGroupingConfig operation.
 //
-// Returns versions and build times of all Warden system components by collecting data from their
-// tech servers.
+// Get project grouping configuration.
 //
-// GET /api/v1/versions
-func (s *Server) handleGetVersionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/grouping-config
+func (s *Server) handleGetProjectGroupingConfigRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("GetVersions"),
+		otelogen.OperationID("GetProjectGroupingConfig"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/versions"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-config"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVersionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectGroupingConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7448,25 +7517,90 @@ func (s *Server) handleGetVersionsRequest(args [0]string, argsEscaped bool, w ht
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetProjectGroupingConfigOperation,
+			ID:   "GetProjectGroupingConfig",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectGroupingConfigOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
 
-	var response GetVersionsRes
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetProjectGroupingConfigParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetProjectGroupingConfigRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetVersionsOperation,
-			OperationSummary: "Get versions of all system components",
-			OperationID:      "GetVersions",
+			OperationName:    GetProjectGroupingConfigOperation,
+			OperationSummary: "Get project grouping configuration",
+			OperationID:      "GetProjectGroupingConfig",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetVersionsRes
+			Params   = GetProjectGroupingConfigParams
+			Response = GetProjectGroupingConfigRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7475,14 +7609,14 @@ func (s *Server) handleGetVersionsRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectGroupingConfigParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetVersions(ctx)
+				response, err = s.h.GetProjectGroupingConfig(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetVersions(ctx)
+		response, err = s.h.GetProjectGroupingConfig(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7501,7 +7635,7 @@ func (s *Server) handleGetVersionsRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetVersionsResponse(response, w, span); err != nil {
+	if err := encodeGetProjectGroupingConfigResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7510,22 +7644,22 @@ func (s *Server) handleGetVersionsRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleListIssuesRequest handles ListIssues operation.
+// handleGetProjectInboundFiltersRequest handles GetProjectInboundFilters operation.
 //
-// Get all issues across all projects.
+// Get project inbound filters.
 //
-// GET /api/v1/issues
-func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/inbound-filters
+func (s *Server) handleGetProjectInboundFiltersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListIssues"),
+		otelogen.OperationID("GetProjectInboundFilters"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/issues"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/inbound-filters"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListIssuesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectInboundFiltersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7580,15 +7714,15 @@ func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListIssuesOperation,
-			ID:   "ListIssues",
+			Name: GetProjectInboundFiltersOperation,
+			ID:   "GetProjectInboundFilters",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListIssuesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectInboundFiltersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7630,7 +7764,7 @@ func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeListIssuesParams(args, argsEscaped, r)
+	params, err := decodeGetProjectInboundFiltersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7641,51 +7775,27 @@ func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	var response ListIssuesRes
+	var response GetProjectInboundFiltersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListIssuesOperation,
-			OperationSummary: "Get all issues across all projects",
-			OperationID:      "ListIssues",
+			OperationName:    GetProjectInboundFiltersOperation,
+			OperationSummary: "Get project inbound filters",
+			OperationID:      "GetProjectInboundFilters",
 			Body:             nil,
 			Params: middleware.Parameters{
-				{
-					Name: "level",
-					In:   "query",
-				}: params.Level,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
 				{
 					Name: "project_id",
-					In:   "query",
+					In:   "path",
 				}: params.ProjectID,
-				{
-					Name: "per_page",
-					In:   "query",
-				}: params.PerPage,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
-				{
-					Name: "sort_by",
-					In:   "query",
-				}: params.SortBy,
-				{
-					Name: "sort_order",
-					In:   "query",
-				}: params.SortOrder,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListIssuesParams
-			Response = ListIssuesRes
+			Params   = GetProjectInboundFiltersParams
+			Response = GetProjectInboundFiltersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7694,14 +7804,14 @@ func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackListIssuesParams,
+			unpackGetProjectInboundFiltersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListIssues(ctx, params)
+				response, err = s.h.GetProjectInboundFilters(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListIssues(ctx, params)
+		response, err = s.h.GetProjectInboundFilters(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7720,7 +7830,7 @@ func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeListIssuesResponse(response, w, span); err != nil {
+	if err := encodeGetProjectInboundFiltersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7729,22 +7839,22 @@ func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleListNotificationRulesRequest handles ListNotificationRules operation.
+// handleGetProjectIssueEventsTimeseriesRequest handles GetProjectIssueEventsTimeseries operation.
 //
-// List all notification rules for notification settings of project.
+// Get timeseries of events for a specific issue inside a project.
 //
-// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
-func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries
+func (s *Server) handleGetProjectIssueEventsTimeseriesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListNotificationRules"),
+		otelogen.OperationID("GetProjectIssueEventsTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationRulesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueEventsTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7799,15 +7909,15 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListNotificationRulesOperation,
-			ID:   "ListNotificationRules",
+			Name: GetProjectIssueEventsTimeseriesOperation,
+			ID:   "GetProjectIssueEventsTimeseries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationRulesOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueEventsTimeseriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7849,7 +7959,7 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeListNotificationRulesParams(args, argsEscaped, r)
+	params, err := decodeGetProjectIssueEventsTimeseriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7860,13 +7970,13 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 		return
 	}
 
-	var response ListNotificationRulesRes
+	var response GetProjectIssueEventsTimeseriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListNotificationRulesOperation,
-			OperationSummary: "List all notification rules for notification settings of project",
-			OperationID:      "ListNotificationRules",
+			OperationName:    GetProjectIssueEventsTimeseriesOperation,
+			OperationSummary: "Get timeseries of events for a specific issue inside a project",
+			OperationID:      "GetProjectIssueEventsTimeseries",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -7874,17 +7984,29 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "setting_id",
+					Name: "issue_id",
 					In:   "path",
-				}: params.SettingID,
+				}: params.IssueID,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "granularity",
+					In:   "query",
+				}: params.Granularity,
+				{
+					Name: "environment",
+					In:   "query",
+				}: params.Environment,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListNotificationRulesParams
-			Response = ListNotificationRulesRes
+			Params   = GetProjectIssueEventsTimeseriesParams
+			Response = GetProjectIssueEventsTimeseriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7893,14 +8015,14 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackListNotificationRulesParams,
+			unpackGetProjectIssueEventsTimeseriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListNotificationRules(ctx, params)
+				response, err = s.h.GetProjectIssueEventsTimeseries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListNotificationRules(ctx, params)
+		response, err = s.h.GetProjectIssueEventsTimeseries(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -7919,7 +8041,7 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 		return
 	}
 
-	if err := encodeListNotificationRulesResponse(response, w, span); err != nil {
+	if err := encodeGetProjectIssueEventsTimeseriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7928,22 +8050,22 @@ func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped
 	}
 }
 
-// handleListNotificationSettingsRequest handles ListNotificationSettings operation.
+// handleGetProjectIssueLatestEventRequest handles GetProjectIssueLatestEvent operation.
 //
-// List all notification settings for a project.
+// Get the latest issue event.
 //
-// GET /api/v1/projects/{project_id}/notification-settings
-func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/latest
+func (s *Server) handleGetProjectIssueLatestEventRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListNotificationSettings"),
+		otelogen.OperationID("GetProjectIssueLatestEvent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/latest"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationSettingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueLatestEventOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7998,15 +8120,15 @@ func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListNotificationSettingsOperation,
-			ID:   "ListNotificationSettings",
+			Name: GetProjectIssueLatestEventOperation,
+			ID:   "GetProjectIssueLatestEvent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationSettingsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueLatestEventOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8048,7 +8170,7 @@ func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeListNotificationSettingsParams(args, argsEscaped, r)
+	params, err := decodeGetProjectIssueLatestEventParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8059,27 +8181,31 @@ func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscap
 		return
 	}
 
-	var response ListNotificationSettingsRes
+	var response GetProjectIssueLatestEventRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListNotificationSettingsOperation,
-			OperationSummary: "List all notification settings for a project",
-			OperationID:      "ListNotificationSettings",
+			OperationName:    GetProjectIssueLatestEventOperation,
+			OperationSummary: "Get the latest issue event",
+			OperationID:      "GetProjectIssueLatestEvent",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListNotificationSettingsParams
-			Response = ListNotificationSettingsRes
+			Params   = GetProjectIssueLatestEventParams
+			Response = GetProjectIssueLatestEventRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8088,14 +8214,14 @@ func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackListNotificationSettingsParams,
+			unpackGetProjectIssueLatestEventParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListNotificationSettings(ctx, params)
+				response, err = s.h.GetProjectIssueLatestEvent(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListNotificationSettings(ctx, params)
+		response, err = s.h.GetProjectIssueLatestEvent(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8114,7 +8240,7 @@ func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeListNotificationSettingsResponse(response, w, span); err != nil {
+	if err := encodeGetProjectIssueLatestEventResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8123,22 +8249,22 @@ func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscap
 	}
 }
 
-// handleListProjectsRequest handles ListProjects operation.
+// handleGetProjectIssueOldestEventRequest handles GetProjectIssueOldestEvent operation.
 //
-// Get projects list.
+// Get the oldest issue event.
 //
-// GET /api/v1/projects
-func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/oldest
+func (s *Server) handleGetProjectIssueOldestEventRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListProjects"),
+		otelogen.OperationID("GetProjectIssueOldestEvent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events/oldest"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueOldestEventOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8193,15 +8319,15 @@ func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListProjectsOperation,
-			ID:   "ListProjects",
+			Name: GetProjectIssueOldestEventOperation,
+			ID:   "GetProjectIssueOldestEvent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueOldestEventOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8243,23 +8369,42 @@ func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
+	params, err := decodeGetProjectIssueOldestEventParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response ListProjectsRes
+	var response GetProjectIssueOldestEventRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListProjectsOperation,
-			OperationSummary: "Get projects list",
-			OperationID:      "ListProjects",
+			OperationName:    GetProjectIssueOldestEventOperation,
+			OperationSummary: "Get the oldest issue event",
+			OperationID:      "GetProjectIssueOldestEvent",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = ListProjectsRes
+			Params   = GetProjectIssueOldestEventParams
+			Response = GetProjectIssueOldestEventRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8268,14 +8413,14 @@ func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectIssueOldestEventParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListProjects(ctx)
+				response, err = s.h.GetProjectIssueOldestEvent(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListProjects(ctx)
+		response, err = s.h.GetProjectIssueOldestEvent(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8294,7 +8439,7 @@ func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeListProjectsResponse(response, w, span); err != nil {
+	if err := encodeGetProjectIssueOldestEventResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8303,22 +8448,22 @@ func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleListTeamsRequest handles ListTeams operation.
+// handleGetProjectIssueTagsRequest handles GetProjectIssueTags operation.
 //
-// List all teams.
+// Get tags of issue events.
 //
-// GET /api/v1/teams
-func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags
+func (s *Server) handleGetProjectIssueTagsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListTeams"),
+		otelogen.OperationID("GetProjectIssueTags"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/teams"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/tags"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTeamsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueTagsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8373,15 +8518,15 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTeamsOperation,
-			ID:   "ListTeams",
+			Name: GetProjectIssueTagsOperation,
+			ID:   "GetProjectIssueTags",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListTeamsOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueTagsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8423,23 +8568,46 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeGetProjectIssueTagsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response ListTeamsRes
+	var response GetProjectIssueTagsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTeamsOperation,
-			OperationSummary: "List all teams",
-			OperationID:      "ListTeams",
+			OperationName:    GetProjectIssueTagsOperation,
+			OperationSummary: "Get tags of issue events",
+			OperationID:      "GetProjectIssueTags",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = ListTeamsRes
+			Params   = GetProjectIssueTagsParams
+			Response = GetProjectIssueTagsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8448,14 +8616,14 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectIssueTagsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTeams(ctx)
+				response, err = s.h.GetProjectIssueTags(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTeams(ctx)
+		response, err = s.h.GetProjectIssueTags(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8474,7 +8642,7 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListTeamsResponse(response, w, span); err != nil {
+	if err := encodeGetProjectIssueTagsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8483,22 +8651,22 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleListUsersRequest handles ListUsers operation.
+// handleGetProjectIssueTimeseriesRequest handles GetProjectIssueTimeseries operation.
 //
-// List all users (superuser only).
+// Get timeseries for a specific issue inside a project.
 //
-// GET /api/v1/users
-func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/timeseries
+func (s *Server) handleGetProjectIssueTimeseriesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListUsers"),
+		otelogen.OperationID("GetProjectIssueTimeseries"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/users"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/timeseries"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectIssueTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8553,15 +8721,15 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListUsersOperation,
-			ID:   "ListUsers",
+			Name: GetProjectIssueTimeseriesOperation,
+			ID:   "GetProjectIssueTimeseries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectIssueTimeseriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8603,23 +8771,50 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeGetProjectIssueTimeseriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response ListUsersRes
+	var response GetProjectIssueTimeseriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListUsersOperation,
-			OperationSummary: "List all users (superuser only)",
-			OperationID:      "ListUsers",
+			OperationName:    GetProjectIssueTimeseriesOperation,
+			OperationSummary: "Get timeseries for a specific issue inside a project",
+			OperationID:      "GetProjectIssueTimeseries",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "granularity",
+					In:   "query",
+				}: params.Granularity,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = ListUsersRes
+			Params   = GetProjectIssueTimeseriesParams
+			Response = GetProjectIssueTimeseriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8628,14 +8823,14 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectIssueTimeseriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUsers(ctx)
+				response, err = s.h.GetProjectIssueTimeseries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUsers(ctx)
+		response, err = s.h.GetProjectIssueTimeseries(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8654,7 +8849,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListUsersResponse(response, w, span); err != nil {
+	if err := encodeGetProjectIssueTimeseriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8663,22 +8858,22 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleListUsersForTeamRequest handles ListUsersForTeam operation.
+// handleGetProjectOutcomesRequest handles GetProjectOutcomes operation.
 //
-// List all users for team admin.
+// Outcomes are stored hourly, a granularity finer than 1h is rounded up.
 //
-// GET /api/v1/users/team/{team_id}/list
-func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/outcomes
+func (s *Server) handleGetProjectOutcomesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ListUsersForTeam"),
+		otelogen.OperationID("GetProjectOutcomes"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/users/team/{team_id}/list"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/outcomes"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersForTeamOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectOutcomesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8733,15 +8928,15 @@ func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListUsersForTeamOperation,
-			ID:   "ListUsersForTeam",
+			Name: GetProjectOutcomesOperation,
+			ID:   "GetProjectOutcomes",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersForTeamOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectOutcomesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8783,7 +8978,7 @@ func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeListUsersForTeamParams(args, argsEscaped, r)
+	params, err := decodeGetProjectOutcomesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8794,27 +8989,35 @@ func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response ListUsersForTeamRes
+	var response GetProjectOutcomesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListUsersForTeamOperation,
-			OperationSummary: "List all users for team admin",
-			OperationID:      "ListUsersForTeam",
+			OperationName:    GetProjectOutcomesOperation,
+			OperationSummary: "Get outcomes of the data received by a project",
+			OperationID:      "GetProjectOutcomes",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "team_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.TeamID,
+				}: params.ProjectID,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "granularity",
+					In:   "query",
+				}: params.Granularity,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListUsersForTeamParams
-			Response = ListUsersForTeamRes
+			Params   = GetProjectOutcomesParams
+			Response = GetProjectOutcomesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8823,14 +9026,14 @@ func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackListUsersForTeamParams,
+			unpackGetProjectOutcomesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUsersForTeam(ctx, params)
+				response, err = s.h.GetProjectOutcomes(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUsersForTeam(ctx, params)
+		response, err = s.h.GetProjectOutcomes(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8849,7 +9052,7 @@ func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListUsersForTeamResponse(response, w, span); err != nil {
+	if err := encodeGetProjectOutcomesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8858,22 +9061,22 @@ func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleLoginRequest handles Login operation.
+// handleGetProjectRateLimitsRequest handles GetProjectRateLimits operation.
 //
-// Authenticate user and get access token.
+// Get project rate limits.
 //
-// POST /api/v1/auth/login
-func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/rate-limits
+func (s *Server) handleGetProjectRateLimitsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Login"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/login"),
+		otelogen.OperationID("GetProjectRateLimits"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/rate-limits"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectRateLimitsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8928,42 +9131,88 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LoginOperation,
-			ID:   "Login",
+			Name: GetProjectRateLimitsOperation,
+			ID:   "GetProjectRateLimits",
 		}
 	)
-	request, close, err := s.decodeLoginRequest(r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectRateLimitsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetProjectRateLimitsParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response LoginRes
+	var response GetProjectRateLimitsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LoginOperation,
-			OperationSummary: "Authenticate user and get access token",
-			OperationID:      "Login",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    GetProjectRateLimitsOperation,
+			OperationSummary: "Get project rate limits",
+			OperationID:      "GetProjectRateLimits",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *LoginRequest
-			Params   = struct{}
-			Response = LoginRes
+			Request  = struct{}
+			Params   = GetProjectRateLimitsParams
+			Response = GetProjectRateLimitsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8972,14 +9221,14 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectRateLimitsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Login(ctx, request)
+				response, err = s.h.GetProjectRateLimits(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Login(ctx, request)
+		response, err = s.h.GetProjectRateLimits(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -8998,7 +9247,7 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 		return
 	}
 
-	if err := encodeLoginResponse(response, w, span); err != nil {
+	if err := encodeGetProjectRateLimitsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9007,22 +9256,22 @@ func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.Res
 	}
 }
 
-// handleMarkAllNotificationsAsReadRequest handles MarkAllNotificationsAsRead operation.
+// handleGetProjectReleaseAnalyticsDetailsRequest handles GetProjectReleaseAnalyticsDetails operation.
 //
-// Mark all notifications as read.
+// Get analytics details for a specific release.
 //
-// PUT /api/v1/notifications/read-all
-func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/analytics/releases/{version}
+func (s *Server) handleGetProjectReleaseAnalyticsDetailsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("MarkAllNotificationsAsRead"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications/read-all"),
+		otelogen.OperationID("GetProjectReleaseAnalyticsDetails"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases/{version}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MarkAllNotificationsAsReadOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleaseAnalyticsDetailsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9077,15 +9326,15 @@ func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MarkAllNotificationsAsReadOperation,
-			ID:   "MarkAllNotificationsAsRead",
+			Name: GetProjectReleaseAnalyticsDetailsOperation,
+			ID:   "GetProjectReleaseAnalyticsDetails",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MarkAllNotificationsAsReadOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleaseAnalyticsDetailsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9127,23 +9376,42 @@ func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEsc
 			return
 		}
 	}
+	params, err := decodeGetProjectReleaseAnalyticsDetailsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response MarkAllNotificationsAsReadRes
+	var response GetProjectReleaseAnalyticsDetailsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MarkAllNotificationsAsReadOperation,
-			OperationSummary: "Mark all notifications as read",
-			OperationID:      "MarkAllNotificationsAsRead",
+			OperationName:    GetProjectReleaseAnalyticsDetailsOperation,
+			OperationSummary: "Get analytics details for a specific release",
+			OperationID:      "GetProjectReleaseAnalyticsDetails",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "version",
+					In:   "path",
+				}: params.Version,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = MarkAllNotificationsAsReadRes
+			Params   = GetProjectReleaseAnalyticsDetailsParams
+			Response = GetProjectReleaseAnalyticsDetailsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9152,14 +9420,14 @@ func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEsc
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectReleaseAnalyticsDetailsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MarkAllNotificationsAsRead(ctx)
+				response, err = s.h.GetProjectReleaseAnalyticsDetails(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MarkAllNotificationsAsRead(ctx)
+		response, err = s.h.GetProjectReleaseAnalyticsDetails(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9178,7 +9446,7 @@ func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEsc
 		return
 	}
 
-	if err := encodeMarkAllNotificationsAsReadResponse(response, w, span); err != nil {
+	if err := encodeGetProjectReleaseAnalyticsDetailsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9187,22 +9455,22 @@ func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEsc
 	}
 }
 
-// handleMarkNotificationAsReadRequest handles MarkNotificationAsRead operation.
+// handleGetProjectReleaseErrorsTimeseriesRequest handles GetProjectReleaseErrorsTimeseries operation.
 //
-// Mark notification as read.
+// Get errors timeseries for a release.
 //
-// PUT /api/v1/notifications/{notification_id}/read
-func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/analytics/traffic
+func (s *Server) handleGetProjectReleaseErrorsTimeseriesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("MarkNotificationAsRead"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/notifications/{notification_id}/read"),
+		otelogen.OperationID("GetProjectReleaseErrorsTimeseries"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/traffic"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), MarkNotificationAsReadOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleaseErrorsTimeseriesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9257,15 +9525,15 @@ func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MarkNotificationAsReadOperation,
-			ID:   "MarkNotificationAsRead",
+			Name: GetProjectReleaseErrorsTimeseriesOperation,
+			ID:   "GetProjectReleaseErrorsTimeseries",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, MarkNotificationAsReadOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleaseErrorsTimeseriesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9307,7 +9575,7 @@ func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeMarkNotificationAsReadParams(args, argsEscaped, r)
+	params, err := decodeGetProjectReleaseErrorsTimeseriesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9318,27 +9586,51 @@ func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response MarkNotificationAsReadRes
+	var response GetProjectReleaseErrorsTimeseriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MarkNotificationAsReadOperation,
-			OperationSummary: "Mark notification as read",
-			OperationID:      "MarkNotificationAsRead",
+			OperationName:    GetProjectReleaseErrorsTimeseriesOperation,
+			OperationSummary: "Get errors timeseries for a release",
+			OperationID:      "GetProjectReleaseErrorsTimeseries",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "notification_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.NotificationID,
+				}: params.ProjectID,
+				{
+					Name: "release",
+					In:   "query",
+				}: params.Release,
+				{
+					Name: "level",
+					In:   "query",
+				}: params.Level,
+				{
+					Name: "group_by",
+					In:   "query",
+				}: params.GroupBy,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "granularity",
+					In:   "query",
+				}: params.Granularity,
+				{
+					Name: "environment",
+					In:   "query",
+				}: params.Environment,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = MarkNotificationAsReadParams
-			Response = MarkNotificationAsReadRes
+			Params   = GetProjectReleaseErrorsTimeseriesParams
+			Response = GetProjectReleaseErrorsTimeseriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9347,14 +9639,14 @@ func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackMarkNotificationAsReadParams,
+			unpackGetProjectReleaseErrorsTimeseriesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MarkNotificationAsRead(ctx, params)
+				response, err = s.h.GetProjectReleaseErrorsTimeseries(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MarkNotificationAsRead(ctx, params)
+		response, err = s.h.GetProjectReleaseErrorsTimeseries(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9373,7 +9665,7 @@ func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeMarkNotificationAsReadResponse(response, w, span); err != nil {
+	if err := encodeGetProjectReleaseErrorsTimeseriesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9382,22 +9674,22 @@ func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleRecentProjectsListRequest handles RecentProjectsList operation.
+// handleGetProjectReleaseSegmentsRequest handles GetProjectReleaseSegments operation.
 //
-// Get recent projects list.
+// Get user segments analytics for a release.
 //
-// GET /api/v1/projects/recent
-func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/analytics/segments
+func (s *Server) handleGetProjectReleaseSegmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("RecentProjectsList"),
+		otelogen.OperationID("GetProjectReleaseSegments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/recent"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/segments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RecentProjectsListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleaseSegmentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9452,15 +9744,15 @@ func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RecentProjectsListOperation,
-			ID:   "RecentProjectsList",
+			Name: GetProjectReleaseSegmentsOperation,
+			ID:   "GetProjectReleaseSegments",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RecentProjectsListOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleaseSegmentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9502,23 +9794,50 @@ func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped boo
 			return
 		}
 	}
+	params, err := decodeGetProjectReleaseSegmentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response RecentProjectsListRes
+	var response GetProjectReleaseSegmentsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RecentProjectsListOperation,
-			OperationSummary: "Get recent projects list",
-			OperationID:      "RecentProjectsList",
+			OperationName:    GetProjectReleaseSegmentsOperation,
+			OperationSummary: "Get user segments analytics for a release",
+			OperationID:      "GetProjectReleaseSegments",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "release",
+					In:   "query",
+				}: params.Release,
+				{
+					Name: "segment",
+					In:   "query",
+				}: params.Segment,
+				{
+					Name: "environment",
+					In:   "query",
+				}: params.Environment,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = RecentProjectsListRes
+			Params   = GetProjectReleaseSegmentsParams
+			Response = GetProjectReleaseSegmentsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9527,14 +9846,14 @@ func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectReleaseSegmentsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RecentProjectsList(ctx)
+				response, err = s.h.GetProjectReleaseSegments(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RecentProjectsList(ctx)
+		response, err = s.h.GetProjectReleaseSegments(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9553,7 +9872,7 @@ func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeRecentProjectsListResponse(response, w, span); err != nil {
+	if err := encodeGetProjectReleaseSegmentsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9562,22 +9881,22 @@ func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleRefreshTokenRequest handles RefreshToken operation.
+// handleGetProjectReleasesAnalyticsRequest handles GetProjectReleasesAnalytics operation.
 //
-// Refresh access token.
+// Get analytics summary for all releases in project.
 //
-// POST /api/v1/auth/refresh
-func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/analytics/releases
+func (s *Server) handleGetProjectReleasesAnalyticsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("RefreshToken"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/refresh"),
+		otelogen.OperationID("GetProjectReleasesAnalytics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/analytics/releases"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RefreshTokenOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectReleasesAnalyticsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9632,42 +9951,88 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RefreshTokenOperation,
-			ID:   "RefreshToken",
+			Name: GetProjectReleasesAnalyticsOperation,
+			ID:   "GetProjectReleasesAnalytics",
 		}
 	)
-	request, close, err := s.decodeRefreshTokenRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectReleasesAnalyticsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
 
-	var response RefreshTokenRes
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetProjectReleasesAnalyticsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetProjectReleasesAnalyticsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RefreshTokenOperation,
-			OperationSummary: "Refresh access token",
-			OperationID:      "RefreshToken",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    GetProjectReleasesAnalyticsOperation,
+			OperationSummary: "Get analytics summary for all releases in project",
+			OperationID:      "GetProjectReleasesAnalytics",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RefreshTokenRequest
-			Params   = struct{}
-			Response = RefreshTokenRes
+			Request  = struct{}
+			Params   = GetProjectReleasesAnalyticsParams
+			Response = GetProjectReleasesAnalyticsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9676,14 +10041,14 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectReleasesAnalyticsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RefreshToken(ctx, request)
+				response, err = s.h.GetProjectReleasesAnalytics(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RefreshToken(ctx, request)
+		response, err = s.h.GetProjectReleasesAnalytics(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9702,7 +10067,7 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeRefreshTokenResponse(response, w, span); err != nil {
+	if err := encodeGetProjectReleasesAnalyticsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9711,29 +10076,22 @@ func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleRemoveTeamMemberRequest handles RemoveTeamMember operation.
+// handleGetProjectStatsRequest handles GetProjectStats operation.
 //
-// Removes a user from a team. If user_id matches the current user's id, the operation is treated as
-// self-leave (the user leaves the team).
-// Scenarios:
-// - Admin/owner removes another user
-// - User removes themselves (leave)
-// Restrictions:
-// - A user cannot leave the team if they are the only owner
-// - A superuser cannot leave the team if restricted by security policy.
+// Get project statistics.
 //
-// DELETE /api/v1/teams/{team_id}/members/{user_id}
-func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/stats
+func (s *Server) handleGetProjectStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("RemoveTeamMember"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}"),
+		otelogen.OperationID("GetProjectStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/stats"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveTeamMemberOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectStatsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9788,15 +10146,15 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveTeamMemberOperation,
-			ID:   "RemoveTeamMember",
+			Name: GetProjectStatsOperation,
+			ID:   "GetProjectStats",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, RemoveTeamMemberOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectStatsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9838,7 +10196,7 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeRemoveTeamMemberParams(args, argsEscaped, r)
+	params, err := decodeGetProjectStatsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9849,31 +10207,31 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	var response RemoveTeamMemberRes
+	var response GetProjectStatsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveTeamMemberOperation,
-			OperationSummary: "Remove a user from a team (or self-leave)",
-			OperationID:      "RemoveTeamMember",
+			OperationName:    GetProjectStatsOperation,
+			OperationSummary: "Get project statistics",
+			OperationID:      "GetProjectStats",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "team_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.TeamID,
+				}: params.ProjectID,
 				{
-					Name: "user_id",
-					In:   "path",
-				}: params.UserID,
+					Name: "period",
+					In:   "query",
+				}: params.Period,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = RemoveTeamMemberParams
-			Response = RemoveTeamMemberRes
+			Params   = GetProjectStatsParams
+			Response = GetProjectStatsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9882,14 +10240,14 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackRemoveTeamMemberParams,
+			unpackGetProjectStatsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RemoveTeamMember(ctx, params)
+				response, err = s.h.GetProjectStats(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RemoveTeamMember(ctx, params)
+		response, err = s.h.GetProjectStats(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -9908,7 +10266,7 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeRemoveTeamMemberResponse(response, w, span); err != nil {
+	if err := encodeGetProjectStatsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9917,22 +10275,22 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 	}
 }
 
-// handleReset2FARequest handles Reset2FA operation.
+// handleGetProjectTeamRequest handles GetProjectTeam operation.
 //
-// Reset/generate secret 2FA (using email-confirmation).
+// Get project team.
 //
-// POST /api/v1/users/me/2fa/reset
-func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/team
+func (s *Server) handleGetProjectTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Reset2FA"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/reset"),
+		otelogen.OperationID("GetProjectTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/team"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), Reset2FAOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetProjectTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9987,15 +10345,15 @@ func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: Reset2FAOperation,
-			ID:   "Reset2FA",
+			Name: GetProjectTeamOperation,
+			ID:   "GetProjectTeam",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, Reset2FAOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, GetProjectTeamOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10037,38 +10395,38 @@ func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
-	request, close, err := s.decodeReset2FARequest(r)
+	params, err := decodeGetProjectTeamParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response Reset2FARes
+	var response GetProjectTeamRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    Reset2FAOperation,
-			OperationSummary: "Reset/generate secret 2FA (using email-confirmation)",
-			OperationID:      "Reset2FA",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    GetProjectTeamOperation,
+			OperationSummary: "Get project team",
+			OperationID:      "GetProjectTeam",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *TwoFAResetRequest
-			Params   = struct{}
-			Response = Reset2FARes
+			Request  = struct{}
+			Params   = GetProjectTeamParams
+			Response = GetProjectTeamRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10077,14 +10435,14 @@ func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetProjectTeamParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Reset2FA(ctx, request)
+				response, err = s.h.GetProjectTeam(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Reset2FA(ctx, request)
+		response, err = s.h.GetProjectTeam(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10103,7 +10461,7 @@ func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeReset2FAResponse(response, w, span); err != nil {
+	if err := encodeGetProjectTeamResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10112,22 +10470,22 @@ func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleResetPasswordRequest handles ResetPassword operation.
+// handleGetRecentIssuesRequest handles GetRecentIssues operation.
 //
-// Reset password using token.
+// Get recent issues across all projects.
 //
-// POST /api/v1/auth/reset-password
-func (s *Server) handleResetPasswordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/issues/recent
+func (s *Server) handleGetRecentIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("ResetPassword"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/auth/reset-password"),
+		otelogen.OperationID("GetRecentIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/issues/recent"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ResetPasswordOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetRecentIssuesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10182,42 +10540,88 @@ func (s *Server) handleResetPasswordRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ResetPasswordOperation,
-			ID:   "ResetPassword",
+			Name: GetRecentIssuesOperation,
+			ID:   "GetRecentIssues",
 		}
 	)
-	request, close, err := s.decodeResetPasswordRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
-
-	var response ResetPasswordRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRecentIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetRecentIssuesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetRecentIssuesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ResetPasswordOperation,
-			OperationSummary: "Reset password using token",
-			OperationID:      "ResetPassword",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    GetRecentIssuesOperation,
+			OperationSummary: "Get recent issues across all projects",
+			OperationID:      "GetRecentIssues",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *ResetPasswordRequest
-			Params   = struct{}
-			Response = ResetPasswordRes
+			Request  = struct{}
+			Params   = GetRecentIssuesParams
+			Response = GetRecentIssuesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10226,14 +10630,14 @@ func (s *Server) handleResetPasswordRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetRecentIssuesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ResetPassword(ctx, request)
+				response, err = s.h.GetRecentIssues(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ResetPassword(ctx, request)
+		response, err = s.h.GetRecentIssues(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10252,7 +10656,7 @@ func (s *Server) handleResetPasswordRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeResetPasswordResponse(response, w, span); err != nil {
+	if err := encodeGetRecentIssuesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10261,22 +10665,22 @@ func (s *Server) handleResetPasswordRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleSend2FACodeRequest handles send2FACode operation.
+// handleGetTeamRequest handles GetTeam operation.
 //
-// Send 2FA email code for disable/reset.
+// Get team details.
 //
-// POST /api/v1/users/me/2fa/send_code
-func (s *Server) handleSend2FACodeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/teams/{team_id}
+func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("send2FACode"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/send_code"),
+		otelogen.OperationID("GetTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), Send2FACodeOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTeamOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10329,25 +10733,90 @@ func (s *Server) handleSend2FACodeRequest(args [0]string, argsEscaped bool, w ht
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTeamOperation,
+			ID:   "GetTeam",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTeamOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
 
-	var response Send2FACodeRes
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetTeamParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTeamRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    Send2FACodeOperation,
-			OperationSummary: "Send 2FA email code for disable/reset",
-			OperationID:      "send2FACode",
+			OperationName:    GetTeamOperation,
+			OperationSummary: "Get team details",
+			OperationID:      "GetTeam",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "team_id",
+					In:   "path",
+				}: params.TeamID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = Send2FACodeRes
+			Params   = GetTeamParams
+			Response = GetTeamRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10356,14 +10825,14 @@ func (s *Server) handleSend2FACodeRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetTeamParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Send2FACode(ctx)
+				response, err = s.h.GetTeam(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Send2FACode(ctx)
+		response, err = s.h.GetTeam(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10382,7 +10851,7 @@ func (s *Server) handleSend2FACodeRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeSend2FACodeResponse(response, w, span); err != nil {
+	if err := encodeGetTeamResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10391,22 +10860,22 @@ func (s *Server) handleSend2FACodeRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleSendTestNotificationRequest handles sendTestNotification operation.
+// handleGetTraceRequest handles GetTrace operation.
 //
-// Send test notification.
+// Get trace.
 //
-// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/test
-func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /api/v1/projects/{project_id}/traces/{trace_id}
+func (s *Server) handleGetTraceRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendTestNotification"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/test"),
+		otelogen.OperationID("GetTrace"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/traces/{trace_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SendTestNotificationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTraceOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10450,22 +10919,8336 @@ func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped b
 			if code >= 100 && code < 500 {
 				span.SetStatus(codes.Error, stage)
 			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTraceOperation,
+			ID:   "GetTrace",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTraceOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetTraceParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTraceRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTraceOperation,
+			OperationSummary: "Get trace",
+			OperationID:      "GetTrace",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "trace_id",
+					In:   "path",
+				}: params.TraceID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTraceParams
+			Response = GetTraceRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTraceParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTrace(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTrace(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetTraceResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUnreadNotificationsCountRequest handles GetUnreadNotificationsCount operation.
+//
+// Get unread notifications count.
+//
+// GET /api/v1/notifications/unread-count
+func (s *Server) handleGetUnreadNotificationsCountRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetUnreadNotificationsCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications/unread-count"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUnreadNotificationsCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUnreadNotificationsCountOperation,
+			ID:   "GetUnreadNotificationsCount",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUnreadNotificationsCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response GetUnreadNotificationsCountRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUnreadNotificationsCountOperation,
+			OperationSummary: "Get unread notifications count",
+			OperationID:      "GetUnreadNotificationsCount",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetUnreadNotificationsCountRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUnreadNotificationsCount(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUnreadNotificationsCount(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetUnreadNotificationsCountResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserNotificationsRequest handles GetUserNotifications operation.
+//
+// Get user notifications.
+//
+// GET /api/v1/notifications
+func (s *Server) handleGetUserNotificationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetUserNotifications"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserNotificationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserNotificationsOperation,
+			ID:   "GetUserNotifications",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUserNotificationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetUserNotificationsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetUserNotificationsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserNotificationsOperation,
+			OperationSummary: "Get user notifications",
+			OperationID:      "GetUserNotifications",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserNotificationsParams
+			Response = GetUserNotificationsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserNotificationsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserNotifications(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserNotifications(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetUserNotificationsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetVersionsRequest handles GetVersions operation.
+//
+// Returns versions and build times of all Warden system components by collecting data from their
+// tech servers.
+//
+// GET /api/v1/versions
+func (s *Server) handleGetVersionsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetVersions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/versions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVersionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response GetVersionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetVersionsOperation,
+			OperationSummary: "Get versions of all system components",
+			OperationID:      "GetVersions",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetVersionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetVersions(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetVersions(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetVersionsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListEventAttachmentsRequest handles ListEventAttachments operation.
+//
+// List event attachments.
+//
+// GET /api/v1/projects/{project_id}/events/{event_id}/attachments
+func (s *Server) handleListEventAttachmentsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListEventAttachments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/events/{event_id}/attachments"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListEventAttachmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListEventAttachmentsOperation,
+			ID:   "ListEventAttachments",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListEventAttachmentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListEventAttachmentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListEventAttachmentsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListEventAttachmentsOperation,
+			OperationSummary: "List event attachments",
+			OperationID:      "ListEventAttachments",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "event_id",
+					In:   "path",
+				}: params.EventID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListEventAttachmentsParams
+			Response = ListEventAttachmentsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListEventAttachmentsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListEventAttachments(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListEventAttachments(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListEventAttachmentsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListGroupingRulesRequest handles ListGroupingRules operation.
+//
+// List project grouping rules.
+//
+// GET /api/v1/projects/{project_id}/grouping-rules
+func (s *Server) handleListGroupingRulesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListGroupingRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListGroupingRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListGroupingRulesOperation,
+			ID:   "ListGroupingRules",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListGroupingRulesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListGroupingRulesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListGroupingRulesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListGroupingRulesOperation,
+			OperationSummary: "List project grouping rules",
+			OperationID:      "ListGroupingRules",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListGroupingRulesParams
+			Response = ListGroupingRulesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListGroupingRulesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListGroupingRules(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListGroupingRules(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListGroupingRulesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListIssuesRequest handles ListIssues operation.
+//
+// Get all issues across all projects.
+//
+// GET /api/v1/issues
+func (s *Server) handleListIssuesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListIssues"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/issues"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListIssuesOperation,
+			ID:   "ListIssues",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListIssuesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListIssuesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListIssuesOperation,
+			OperationSummary: "Get all issues across all projects",
+			OperationID:      "ListIssues",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "query",
+					In:   "query",
+				}: params.Query,
+				{
+					Name: "environment",
+					In:   "query",
+				}: params.Environment,
+				{
+					Name: "level",
+					In:   "query",
+				}: params.Level,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "assigned",
+					In:   "query",
+				}: params.Assigned,
+				{
+					Name: "project_id",
+					In:   "query",
+				}: params.ProjectID,
+				{
+					Name: "per_page",
+					In:   "query",
+				}: params.PerPage,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "sort_by",
+					In:   "query",
+				}: params.SortBy,
+				{
+					Name: "sort_order",
+					In:   "query",
+				}: params.SortOrder,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListIssuesParams
+			Response = ListIssuesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListIssuesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListIssues(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListIssues(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListIssuesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListMonitorsRequest handles ListMonitors operation.
+//
+// List project cron monitors.
+//
+// GET /api/v1/projects/{project_id}/monitors
+func (s *Server) handleListMonitorsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListMonitors"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/monitors"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListMonitorsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListMonitorsOperation,
+			ID:   "ListMonitors",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListMonitorsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListMonitorsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListMonitorsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListMonitorsOperation,
+			OperationSummary: "List project cron monitors",
+			OperationID:      "ListMonitors",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListMonitorsParams
+			Response = ListMonitorsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListMonitorsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListMonitors(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListMonitors(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListMonitorsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListNotificationRulesRequest handles ListNotificationRules operation.
+//
+// List all notification rules for notification settings of project.
+//
+// GET /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules
+func (s *Server) handleListNotificationRulesRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListNotificationRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListNotificationRulesOperation,
+			ID:   "ListNotificationRules",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationRulesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListNotificationRulesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListNotificationRulesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListNotificationRulesOperation,
+			OperationSummary: "List all notification rules for notification settings of project",
+			OperationID:      "ListNotificationRules",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListNotificationRulesParams
+			Response = ListNotificationRulesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListNotificationRulesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListNotificationRules(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListNotificationRules(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListNotificationRulesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListNotificationSettingsRequest handles ListNotificationSettings operation.
+//
+// List all notification settings for a project.
+//
+// GET /api/v1/projects/{project_id}/notification-settings
+func (s *Server) handleListNotificationSettingsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListNotificationSettings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListNotificationSettingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListNotificationSettingsOperation,
+			ID:   "ListNotificationSettings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListNotificationSettingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListNotificationSettingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListNotificationSettingsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListNotificationSettingsOperation,
+			OperationSummary: "List all notification settings for a project",
+			OperationID:      "ListNotificationSettings",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListNotificationSettingsParams
+			Response = ListNotificationSettingsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListNotificationSettingsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListNotificationSettings(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListNotificationSettings(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListNotificationSettingsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectEnvironmentsRequest handles ListProjectEnvironments operation.
+//
+// Environments are discovered from the ingested events.
+//
+// GET /api/v1/projects/{project_id}/environments
+func (s *Server) handleListProjectEnvironmentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectEnvironments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/environments"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectEnvironmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectEnvironmentsOperation,
+			ID:   "ListProjectEnvironments",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectEnvironmentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectEnvironmentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectEnvironmentsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectEnvironmentsOperation,
+			OperationSummary: "List project environments",
+			OperationID:      "ListProjectEnvironments",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectEnvironmentsParams
+			Response = ListProjectEnvironmentsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectEnvironmentsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectEnvironments(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectEnvironments(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectEnvironmentsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectIssueActivityRequest handles ListProjectIssueActivity operation.
+//
+// First seen, regressions, status and assignment changes, merges and comments of the issue.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/activity
+func (s *Server) handleListProjectIssueActivityRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueActivity"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/activity"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectIssueActivityOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectIssueActivityOperation,
+			ID:   "ListProjectIssueActivity",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectIssueActivityOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectIssueActivityParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectIssueActivityRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectIssueActivityOperation,
+			OperationSummary: "List issue activity",
+			OperationID:      "ListProjectIssueActivity",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "per_page",
+					In:   "query",
+				}: params.PerPage,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectIssueActivityParams
+			Response = ListProjectIssueActivityRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectIssueActivityParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectIssueActivity(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectIssueActivity(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectIssueActivityResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectIssueCommentsRequest handles ListProjectIssueComments operation.
+//
+// List issue comments.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/comments
+func (s *Server) handleListProjectIssueCommentsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueComments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/comments"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectIssueCommentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectIssueCommentsOperation,
+			ID:   "ListProjectIssueComments",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectIssueCommentsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectIssueCommentsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectIssueCommentsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectIssueCommentsOperation,
+			OperationSummary: "List issue comments",
+			OperationID:      "ListProjectIssueComments",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectIssueCommentsParams
+			Response = ListProjectIssueCommentsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectIssueCommentsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectIssueComments(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectIssueComments(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectIssueCommentsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectIssueEventsRequest handles ListProjectIssueEvents operation.
+//
+// List issue events.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events
+func (s *Server) handleListProjectIssueEventsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/events"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectIssueEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectIssueEventsOperation,
+			ID:   "ListProjectIssueEvents",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectIssueEventsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectIssueEventsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectIssueEventsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectIssueEventsOperation,
+			OperationSummary: "List issue events",
+			OperationID:      "ListProjectIssueEvents",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectIssueEventsParams
+			Response = ListProjectIssueEventsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectIssueEventsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectIssueEvents(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectIssueEvents(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectIssueEventsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectIssueTagEventsRequest handles ListProjectIssueTagEvents operation.
+//
+// List issue events with a tag value.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events
+func (s *Server) handleListProjectIssueTagEventsRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectIssueTagEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectIssueTagEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectIssueTagEventsOperation,
+			ID:   "ListProjectIssueTagEvents",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectIssueTagEventsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectIssueTagEventsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectIssueTagEventsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectIssueTagEventsOperation,
+			OperationSummary: "List issue events with a tag value",
+			OperationID:      "ListProjectIssueTagEvents",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+				{
+					Name: "key",
+					In:   "path",
+				}: params.Key,
+				{
+					Name: "value",
+					In:   "query",
+				}: params.Value,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectIssueTagEventsParams
+			Response = ListProjectIssueTagEventsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectIssueTagEventsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectIssueTagEvents(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectIssueTagEvents(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectIssueTagEventsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectKeysRequest handles ListProjectKeys operation.
+//
+// List project client keys.
+//
+// GET /api/v1/projects/{project_id}/keys
+func (s *Server) handleListProjectKeysRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectKeys"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectKeysOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectKeysOperation,
+			ID:   "ListProjectKeys",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectKeysOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectKeysParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectKeysRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectKeysOperation,
+			OperationSummary: "List project client keys",
+			OperationID:      "ListProjectKeys",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectKeysParams
+			Response = ListProjectKeysRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectKeysParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectKeys(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectKeys(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectKeysResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectTransactionsRequest handles ListProjectTransactions operation.
+//
+// List project transactions.
+//
+// GET /api/v1/projects/{project_id}/transactions
+func (s *Server) handleListProjectTransactionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjectTransactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/transactions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectTransactionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectTransactionsOperation,
+			ID:   "ListProjectTransactions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectTransactionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListProjectTransactionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListProjectTransactionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectTransactionsOperation,
+			OperationSummary: "List project transactions",
+			OperationID:      "ListProjectTransactions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "interval",
+					In:   "query",
+				}: params.Interval,
+				{
+					Name: "environment",
+					In:   "query",
+				}: params.Environment,
+				{
+					Name: "release",
+					In:   "query",
+				}: params.Release,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListProjectTransactionsParams
+			Response = ListProjectTransactionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListProjectTransactionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjectTransactions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjectTransactions(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectTransactionsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListProjectsRequest handles ListProjects operation.
+//
+// Get projects list.
+//
+// GET /api/v1/projects
+func (s *Server) handleListProjectsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListProjects"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListProjectsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListProjectsOperation,
+			ID:   "ListProjects",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListProjectsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response ListProjectsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListProjectsOperation,
+			OperationSummary: "Get projects list",
+			OperationID:      "ListProjects",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListProjectsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListProjects(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListProjects(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListProjectsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTeamsRequest handles ListTeams operation.
+//
+// List all teams.
+//
+// GET /api/v1/teams
+func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListTeams"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/teams"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListTeamsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTeamsOperation,
+			ID:   "ListTeams",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListTeamsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response ListTeamsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTeamsOperation,
+			OperationSummary: "List all teams",
+			OperationID:      "ListTeams",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListTeamsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTeams(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTeams(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListTeamsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListUsersRequest handles ListUsers operation.
+//
+// List all users (superuser only).
+//
+// GET /api/v1/users
+func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/users"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListUsersOperation,
+			ID:   "ListUsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response ListUsersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListUsersOperation,
+			OperationSummary: "List all users (superuser only)",
+			OperationID:      "ListUsers",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListUsersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListUsers(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListUsers(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListUsersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListUsersForTeamRequest handles ListUsersForTeam operation.
+//
+// List all users for team admin.
+//
+// GET /api/v1/users/team/{team_id}/list
+func (s *Server) handleListUsersForTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ListUsersForTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/users/team/{team_id}/list"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListUsersForTeamOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListUsersForTeamOperation,
+			ID:   "ListUsersForTeam",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ListUsersForTeamOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListUsersForTeamParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListUsersForTeamRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListUsersForTeamOperation,
+			OperationSummary: "List all users for team admin",
+			OperationID:      "ListUsersForTeam",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "team_id",
+					In:   "path",
+				}: params.TeamID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListUsersForTeamParams
+			Response = ListUsersForTeamRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListUsersForTeamParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListUsersForTeam(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListUsersForTeam(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListUsersForTeamResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoginRequest handles Login operation.
+//
+// Authenticate user and get access token.
+//
+// POST /api/v1/auth/login
+func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/login"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LoginOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: LoginOperation,
+			ID:   "Login",
+		}
+	)
+	request, close, err := s.decodeLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response LoginRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    LoginOperation,
+			OperationSummary: "Authenticate user and get access token",
+			OperationID:      "Login",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *LoginRequest
+			Params   = struct{}
+			Response = LoginRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Login(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Login(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeLoginResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMarkAllNotificationsAsReadRequest handles MarkAllNotificationsAsRead operation.
+//
+// Mark all notifications as read.
+//
+// PUT /api/v1/notifications/read-all
+func (s *Server) handleMarkAllNotificationsAsReadRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MarkAllNotificationsAsRead"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications/read-all"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MarkAllNotificationsAsReadOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MarkAllNotificationsAsReadOperation,
+			ID:   "MarkAllNotificationsAsRead",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MarkAllNotificationsAsReadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response MarkAllNotificationsAsReadRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MarkAllNotificationsAsReadOperation,
+			OperationSummary: "Mark all notifications as read",
+			OperationID:      "MarkAllNotificationsAsRead",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = MarkAllNotificationsAsReadRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MarkAllNotificationsAsRead(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MarkAllNotificationsAsRead(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMarkAllNotificationsAsReadResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMarkNotificationAsReadRequest handles MarkNotificationAsRead operation.
+//
+// Mark notification as read.
+//
+// PUT /api/v1/notifications/{notification_id}/read
+func (s *Server) handleMarkNotificationAsReadRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MarkNotificationAsRead"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/notifications/{notification_id}/read"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MarkNotificationAsReadOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MarkNotificationAsReadOperation,
+			ID:   "MarkNotificationAsRead",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MarkNotificationAsReadOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeMarkNotificationAsReadParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response MarkNotificationAsReadRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MarkNotificationAsReadOperation,
+			OperationSummary: "Mark notification as read",
+			OperationID:      "MarkNotificationAsRead",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "notification_id",
+					In:   "path",
+				}: params.NotificationID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = MarkNotificationAsReadParams
+			Response = MarkNotificationAsReadRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackMarkNotificationAsReadParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MarkNotificationAsRead(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MarkNotificationAsRead(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMarkNotificationAsReadResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMergeIssuesRequest handles MergeIssues operation.
+//
+// Merges the given issues into the primary issue. Events of merged issues are grouped into the
+// primary issue.
+//
+// POST /api/v1/projects/{project_id}/issues/merge
+func (s *Server) handleMergeIssuesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("MergeIssues"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/merge"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), MergeIssuesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MergeIssuesOperation,
+			ID:   "MergeIssues",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MergeIssuesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeMergeIssuesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeMergeIssuesRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response MergeIssuesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MergeIssuesOperation,
+			OperationSummary: "Merge issues",
+			OperationID:      "MergeIssues",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *MergeIssuesRequest
+			Params   = MergeIssuesParams
+			Response = MergeIssuesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackMergeIssuesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MergeIssues(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MergeIssues(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeMergeIssuesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePreviewGroupingRuleRequest handles PreviewGroupingRule operation.
+//
+// Evaluates the rule against the events of the last 24 hours without saving it.
+//
+// POST /api/v1/projects/{project_id}/grouping-rules/preview
+func (s *Server) handlePreviewGroupingRuleRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("PreviewGroupingRule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules/preview"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PreviewGroupingRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PreviewGroupingRuleOperation,
+			ID:   "PreviewGroupingRule",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PreviewGroupingRuleOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodePreviewGroupingRuleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodePreviewGroupingRuleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PreviewGroupingRuleRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PreviewGroupingRuleOperation,
+			OperationSummary: "Preview a grouping rule",
+			OperationID:      "PreviewGroupingRule",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *GroupingRuleRequest
+			Params   = PreviewGroupingRuleParams
+			Response = PreviewGroupingRuleRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPreviewGroupingRuleParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PreviewGroupingRule(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PreviewGroupingRule(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodePreviewGroupingRuleResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRecentProjectsListRequest handles RecentProjectsList operation.
+//
+// Get recent projects list.
+//
+// GET /api/v1/projects/recent
+func (s *Server) handleRecentProjectsListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RecentProjectsList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/recent"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RecentProjectsListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RecentProjectsListOperation,
+			ID:   "RecentProjectsList",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RecentProjectsListOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response RecentProjectsListRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RecentProjectsListOperation,
+			OperationSummary: "Get recent projects list",
+			OperationID:      "RecentProjectsList",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = RecentProjectsListRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RecentProjectsList(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RecentProjectsList(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRecentProjectsListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRefreshTokenRequest handles RefreshToken operation.
+//
+// Refresh access token.
+//
+// POST /api/v1/auth/refresh
+func (s *Server) handleRefreshTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RefreshToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/refresh"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RefreshTokenOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RefreshTokenOperation,
+			ID:   "RefreshToken",
+		}
+	)
+	request, close, err := s.decodeRefreshTokenRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response RefreshTokenRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RefreshTokenOperation,
+			OperationSummary: "Refresh access token",
+			OperationID:      "RefreshToken",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *RefreshTokenRequest
+			Params   = struct{}
+			Response = RefreshTokenRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RefreshToken(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RefreshToken(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRefreshTokenResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRemoveTeamMemberRequest handles RemoveTeamMember operation.
+//
+// Removes a user from a team. If user_id matches the current user's id, the operation is treated as
+// self-leave (the user leaves the team).
+// Scenarios:
+// - Admin/owner removes another user
+// - User removes themselves (leave)
+// Restrictions:
+// - A user cannot leave the team if they are the only owner
+// - A superuser cannot leave the team if restricted by security policy.
+//
+// DELETE /api/v1/teams/{team_id}/members/{user_id}
+func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RemoveTeamMember"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/teams/{team_id}/members/{user_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveTeamMemberOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RemoveTeamMemberOperation,
+			ID:   "RemoveTeamMember",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RemoveTeamMemberOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRemoveTeamMemberParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response RemoveTeamMemberRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RemoveTeamMemberOperation,
+			OperationSummary: "Remove a user from a team (or self-leave)",
+			OperationID:      "RemoveTeamMember",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "team_id",
+					In:   "path",
+				}: params.TeamID,
+				{
+					Name: "user_id",
+					In:   "path",
+				}: params.UserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RemoveTeamMemberParams
+			Response = RemoveTeamMemberRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRemoveTeamMemberParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RemoveTeamMember(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RemoveTeamMember(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRemoveTeamMemberResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleReset2FARequest handles Reset2FA operation.
+//
+// Reset/generate secret 2FA (using email-confirmation).
+//
+// POST /api/v1/users/me/2fa/reset
+func (s *Server) handleReset2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Reset2FA"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/reset"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), Reset2FAOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: Reset2FAOperation,
+			ID:   "Reset2FA",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, Reset2FAOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	request, close, err := s.decodeReset2FARequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response Reset2FARes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    Reset2FAOperation,
+			OperationSummary: "Reset/generate secret 2FA (using email-confirmation)",
+			OperationID:      "Reset2FA",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *TwoFAResetRequest
+			Params   = struct{}
+			Response = Reset2FARes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Reset2FA(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Reset2FA(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeReset2FAResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleResetPasswordRequest handles ResetPassword operation.
+//
+// Reset password using token.
+//
+// POST /api/v1/auth/reset-password
+func (s *Server) handleResetPasswordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ResetPassword"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/auth/reset-password"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ResetPasswordOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ResetPasswordOperation,
+			ID:   "ResetPassword",
+		}
+	)
+	request, close, err := s.decodeResetPasswordRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ResetPasswordRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ResetPasswordOperation,
+			OperationSummary: "Reset password using token",
+			OperationID:      "ResetPassword",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ResetPasswordRequest
+			Params   = struct{}
+			Response = ResetPasswordRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ResetPassword(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ResetPassword(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeResetPasswordResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRevokeProjectKeyRequest handles RevokeProjectKey operation.
+//
+// Revoke a project client key.
+//
+// DELETE /api/v1/projects/{project_id}/keys/{key_id}
+func (s *Server) handleRevokeProjectKeyRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RevokeProjectKey"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys/{key_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeProjectKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RevokeProjectKeyOperation,
+			ID:   "RevokeProjectKey",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RevokeProjectKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRevokeProjectKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response RevokeProjectKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RevokeProjectKeyOperation,
+			OperationSummary: "Revoke a project client key",
+			OperationID:      "RevokeProjectKey",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "key_id",
+					In:   "path",
+				}: params.KeyID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RevokeProjectKeyParams
+			Response = RevokeProjectKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRevokeProjectKeyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RevokeProjectKey(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RevokeProjectKey(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRevokeProjectKeyResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRotateProjectKeyRequest handles RotateProjectKey operation.
+//
+// Issues a new key with the label and the rate limit of the given one. The old key keeps working
+// until it is revoked.
+//
+// POST /api/v1/projects/{project_id}/keys/{key_id}/rotate
+func (s *Server) handleRotateProjectKeyRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RotateProjectKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys/{key_id}/rotate"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RotateProjectKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RotateProjectKeyOperation,
+			ID:   "RotateProjectKey",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RotateProjectKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRotateProjectKeyParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response RotateProjectKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RotateProjectKeyOperation,
+			OperationSummary: "Rotate a project client key",
+			OperationID:      "RotateProjectKey",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "key_id",
+					In:   "path",
+				}: params.KeyID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RotateProjectKeyParams
+			Response = RotateProjectKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRotateProjectKeyParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RotateProjectKey(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RotateProjectKey(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRotateProjectKeyResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSearchProjectEventsRequest handles SearchProjectEvents operation.
+//
+// Search project events.
+//
+// GET /api/v1/projects/{project_id}/events
+func (s *Server) handleSearchProjectEventsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SearchProjectEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/events"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SearchProjectEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SearchProjectEventsOperation,
+			ID:   "SearchProjectEvents",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SearchProjectEventsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSearchProjectEventsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response SearchProjectEventsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SearchProjectEventsOperation,
+			OperationSummary: "Search project events",
+			OperationID:      "SearchProjectEvents",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "query",
+					In:   "query",
+				}: params.Query,
+				{
+					Name: "time_from",
+					In:   "query",
+				}: params.TimeFrom,
+				{
+					Name: "time_to",
+					In:   "query",
+				}: params.TimeTo,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SearchProjectEventsParams
+			Response = SearchProjectEventsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSearchProjectEventsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SearchProjectEvents(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SearchProjectEvents(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSearchProjectEventsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSend2FACodeRequest handles send2FACode operation.
+//
+// Send 2FA email code for disable/reset.
+//
+// POST /api/v1/users/me/2fa/send_code
+func (s *Server) handleSend2FACodeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("send2FACode"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/send_code"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), Send2FACodeOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response Send2FACodeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    Send2FACodeOperation,
+			OperationSummary: "Send 2FA email code for disable/reset",
+			OperationID:      "send2FACode",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = Send2FACodeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Send2FACode(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Send2FACode(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSend2FACodeResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSendTestNotificationRequest handles sendTestNotification operation.
+//
+// Send test notification.
+//
+// POST /api/v1/projects/{project_id}/notification-settings/{setting_id}/test
+func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("sendTestNotification"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/test"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SendTestNotificationOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SendTestNotificationOperation,
+			ID:   "sendTestNotification",
+		}
+	)
+	params, err := decodeSendTestNotificationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response SendTestNotificationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SendTestNotificationOperation,
+			OperationSummary: "Send test notification",
+			OperationID:      "sendTestNotification",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SendTestNotificationParams
+			Response = SendTestNotificationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSendTestNotificationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SendTestNotification(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SendTestNotification(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSendTestNotificationResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetSuperuserStatusRequest handles SetSuperuserStatus operation.
+//
+// Set or unset superuser status (superuser only, cannot modify admin user).
+//
+// PUT /api/v1/users/{user_id}/superuser
+func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetSuperuserStatus"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/superuser"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetSuperuserStatusOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SetSuperuserStatusOperation,
+			ID:   "SetSuperuserStatus",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SetSuperuserStatusOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSetSuperuserStatusParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSetSuperuserStatusRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SetSuperuserStatusRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SetSuperuserStatusOperation,
+			OperationSummary: "Set or unset superuser status (superuser only, cannot modify admin user)",
+			OperationID:      "SetSuperuserStatus",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "user_id",
+					In:   "path",
+				}: params.UserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *SetSuperuserStatusRequest
+			Params   = SetSuperuserStatusParams
+			Response = SetSuperuserStatusRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSetSuperuserStatusParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SetSuperuserStatus(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SetSuperuserStatus(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSetSuperuserStatusResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetUserActiveStatusRequest handles SetUserActiveStatus operation.
+//
+// Set or unset user active status (superuser only).
+//
+// PUT /api/v1/users/{user_id}/active
+func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("SetUserActiveStatus"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/active"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetUserActiveStatusOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SetUserActiveStatusOperation,
+			ID:   "SetUserActiveStatus",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SetUserActiveStatusOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSetUserActiveStatusParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSetUserActiveStatusRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response SetUserActiveStatusRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SetUserActiveStatusOperation,
+			OperationSummary: "Set or unset user active status (superuser only)",
+			OperationID:      "SetUserActiveStatus",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "user_id",
+					In:   "path",
+				}: params.UserID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *SetUserActiveStatusRequest
+			Params   = SetUserActiveStatusParams
+			Response = SetUserActiveStatusRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSetUserActiveStatusParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SetUserActiveStatus(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SetUserActiveStatus(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSetUserActiveStatusResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSetup2FARequest handles Setup2FA operation.
+//
+// Begin setup 2FA (generate secret and QR-code).
+//
+// POST /api/v1/users/me/2fa/setup
+func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("Setup2FA"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/setup"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), Setup2FAOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: Setup2FAOperation,
+			ID:   "Setup2FA",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, Setup2FAOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+
+	var response Setup2FARes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    Setup2FAOperation,
+			OperationSummary: "Begin setup 2FA (generate secret and QR-code)",
+			OperationID:      "Setup2FA",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = Setup2FARes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Setup2FA(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Setup2FA(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSetup2FAResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUnmergeIssueRequest handles UnmergeIssue operation.
+//
+// Splits merged fingerprints out of the issue into separate issues.
+//
+// POST /api/v1/projects/{project_id}/issues/{issue_id}/unmerge
+func (s *Server) handleUnmergeIssueRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UnmergeIssue"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/issues/{issue_id}/unmerge"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UnmergeIssueOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UnmergeIssueOperation,
+			ID:   "UnmergeIssue",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UnmergeIssueOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUnmergeIssueParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUnmergeIssueRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UnmergeIssueRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UnmergeIssueOperation,
+			OperationSummary: "Unmerge issue",
+			OperationID:      "UnmergeIssue",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "issue_id",
+					In:   "path",
+				}: params.IssueID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UnmergeIssueRequest
+			Params   = UnmergeIssueParams
+			Response = UnmergeIssueRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUnmergeIssueParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UnmergeIssue(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UnmergeIssue(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUnmergeIssueResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateGroupingRuleRequest handles UpdateGroupingRule operation.
+//
+// Update a grouping rule.
+//
+// PUT /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+func (s *Server) handleUpdateGroupingRuleRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateGroupingRule"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-rules/{rule_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateGroupingRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateGroupingRuleOperation,
+			ID:   "UpdateGroupingRule",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateGroupingRuleOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateGroupingRuleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateGroupingRuleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UpdateGroupingRuleRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateGroupingRuleOperation,
+			OperationSummary: "Update a grouping rule",
+			OperationID:      "UpdateGroupingRule",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "rule_id",
+					In:   "path",
+				}: params.RuleID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *GroupingRuleRequest
+			Params   = UpdateGroupingRuleParams
+			Response = UpdateGroupingRuleRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateGroupingRuleParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateGroupingRule(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateGroupingRule(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateGroupingRuleResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateNotificationRuleRequest handles UpdateNotificationRule operation.
+//
+// Update a notification rule.
+//
+// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
+func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateNotificationRule"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationRuleOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateNotificationRuleOperation,
+			ID:   "UpdateNotificationRule",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateNotificationRuleOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateNotificationRuleParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateNotificationRuleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UpdateNotificationRuleRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateNotificationRuleOperation,
+			OperationSummary: "Update a notification rule",
+			OperationID:      "UpdateNotificationRule",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
+				{
+					Name: "rule_id",
+					In:   "path",
+				}: params.RuleID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UpdateNotificationRuleRequest
+			Params   = UpdateNotificationRuleParams
+			Response = UpdateNotificationRuleRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateNotificationRuleParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateNotificationRule(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateNotificationRule(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateNotificationRuleResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateNotificationSettingRequest handles UpdateNotificationSetting operation.
+//
+// Update a notification setting.
+//
+// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}
+func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateNotificationSetting"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationSettingOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateNotificationSettingOperation,
+			ID:   "UpdateNotificationSetting",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateNotificationSettingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateNotificationSettingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateNotificationSettingRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UpdateNotificationSettingRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateNotificationSettingOperation,
+			OperationSummary: "Update a notification setting",
+			OperationID:      "UpdateNotificationSetting",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+				{
+					Name: "setting_id",
+					In:   "path",
+				}: params.SettingID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UpdateNotificationSettingRequest
+			Params   = UpdateNotificationSettingParams
+			Response = UpdateNotificationSettingRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateNotificationSettingParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateNotificationSetting(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateNotificationSetting(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w, span); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w, span); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateNotificationSettingResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateProjectRequest handles UpdateProject operation.
+//
+// Update project name and description.
+//
+// PUT /api/v1/projects/{project_id}
+func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UpdateProject"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateProjectOperation,
+			ID:   "UpdateProject",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+					defer recordError("Security:BearerAuth", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w, span); encodeErr != nil {
+				defer recordError("Security", err)
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: SendTestNotificationOperation,
-			ID:   "sendTestNotification",
+			return
 		}
-	)
-	params, err := decodeSendTestNotificationParams(args, argsEscaped, r)
+	}
+	params, err := decodeUpdateProjectParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10475,32 +19258,43 @@ func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped b
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeUpdateProjectRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response SendTestNotificationRes
+	var response UpdateProjectRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SendTestNotificationOperation,
-			OperationSummary: "Send test notification",
-			OperationID:      "sendTestNotification",
-			Body:             nil,
+			OperationName:    UpdateProjectOperation,
+			OperationSummary: "Update project name and description",
+			OperationID:      "UpdateProject",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
-				{
-					Name: "setting_id",
-					In:   "path",
-				}: params.SettingID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SendTestNotificationParams
-			Response = SendTestNotificationRes
+			Request  = *UpdateProjectRequest
+			Params   = UpdateProjectParams
+			Response = UpdateProjectRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10509,14 +19303,14 @@ func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackSendTestNotificationParams,
+			unpackUpdateProjectParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SendTestNotification(ctx, params)
+				response, err = s.h.UpdateProject(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SendTestNotification(ctx, params)
+		response, err = s.h.UpdateProject(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10535,7 +19329,7 @@ func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped b
 		return
 	}
 
-	if err := encodeSendTestNotificationResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10544,22 +19338,23 @@ func (s *Server) handleSendTestNotificationRequest(args [2]string, argsEscaped b
 	}
 }
 
-// handleSetSuperuserStatusRequest handles SetSuperuserStatus operation.
+// handleUpdateProjectDataScrubbingRequest handles UpdateProjectDataScrubbing operation.
 //
-// Set or unset superuser status (superuser only, cannot modify admin user).
+// Sensitive data is removed from events before they are stored, changes are applied to new events
+// within a minute.
 //
-// PUT /api/v1/users/{user_id}/superuser
-func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/data-scrubbing
+func (s *Server) handleUpdateProjectDataScrubbingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetSuperuserStatus"),
+		otelogen.OperationID("UpdateProjectDataScrubbing"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/superuser"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/data-scrubbing"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SetSuperuserStatusOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectDataScrubbingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10614,15 +19409,15 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SetSuperuserStatusOperation,
-			ID:   "SetSuperuserStatus",
+			Name: UpdateProjectDataScrubbingOperation,
+			ID:   "UpdateProjectDataScrubbing",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SetSuperuserStatusOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectDataScrubbingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10664,7 +19459,7 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeSetSuperuserStatusParams(args, argsEscaped, r)
+	params, err := decodeUpdateProjectDataScrubbingParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10674,7 +19469,7 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeSetSuperuserStatusRequest(r)
+	request, close, err := s.decodeUpdateProjectDataScrubbingRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10690,27 +19485,27 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 		}
 	}()
 
-	var response SetSuperuserStatusRes
+	var response UpdateProjectDataScrubbingRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SetSuperuserStatusOperation,
-			OperationSummary: "Set or unset superuser status (superuser only, cannot modify admin user)",
-			OperationID:      "SetSuperuserStatus",
+			OperationName:    UpdateProjectDataScrubbingOperation,
+			OperationSummary: "Update project data scrubbing",
+			OperationID:      "UpdateProjectDataScrubbing",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "user_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.UserID,
+				}: params.ProjectID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *SetSuperuserStatusRequest
-			Params   = SetSuperuserStatusParams
-			Response = SetSuperuserStatusRes
+			Request  = *UpdateDataScrubbingRequest
+			Params   = UpdateProjectDataScrubbingParams
+			Response = UpdateProjectDataScrubbingRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10719,14 +19514,14 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackSetSuperuserStatusParams,
+			unpackUpdateProjectDataScrubbingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetSuperuserStatus(ctx, request, params)
+				response, err = s.h.UpdateProjectDataScrubbing(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetSuperuserStatus(ctx, request, params)
+		response, err = s.h.UpdateProjectDataScrubbing(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10745,7 +19540,7 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeSetSuperuserStatusResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectDataScrubbingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10754,22 +19549,22 @@ func (s *Server) handleSetSuperuserStatusRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleSetUserActiveStatusRequest handles SetUserActiveStatus operation.
+// handleUpdateProjectEnvironmentRequest handles UpdateProjectEnvironment operation.
 //
-// Set or unset user active status (superuser only).
+// Update project environment.
 //
-// PUT /api/v1/users/{user_id}/active
-func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/environments/{name}
+func (s *Server) handleUpdateProjectEnvironmentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("SetUserActiveStatus"),
+		otelogen.OperationID("UpdateProjectEnvironment"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/users/{user_id}/active"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/environments/{name}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SetUserActiveStatusOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectEnvironmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10824,15 +19619,15 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SetUserActiveStatusOperation,
-			ID:   "SetUserActiveStatus",
+			Name: UpdateProjectEnvironmentOperation,
+			ID:   "UpdateProjectEnvironment",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, SetUserActiveStatusOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectEnvironmentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10874,7 +19669,7 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 			return
 		}
 	}
-	params, err := decodeSetUserActiveStatusParams(args, argsEscaped, r)
+	params, err := decodeUpdateProjectEnvironmentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10884,7 +19679,7 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeSetUserActiveStatusRequest(r)
+	request, close, err := s.decodeUpdateProjectEnvironmentRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10900,27 +19695,31 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 		}
 	}()
 
-	var response SetUserActiveStatusRes
+	var response UpdateProjectEnvironmentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SetUserActiveStatusOperation,
-			OperationSummary: "Set or unset user active status (superuser only)",
-			OperationID:      "SetUserActiveStatus",
+			OperationName:    UpdateProjectEnvironmentOperation,
+			OperationSummary: "Update project environment",
+			OperationID:      "UpdateProjectEnvironment",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "user_id",
+					Name: "project_id",
 					In:   "path",
-				}: params.UserID,
+				}: params.ProjectID,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *SetUserActiveStatusRequest
-			Params   = SetUserActiveStatusParams
-			Response = SetUserActiveStatusRes
+			Request  = *UpdateEnvironmentRequest
+			Params   = UpdateProjectEnvironmentParams
+			Response = UpdateProjectEnvironmentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10929,14 +19728,14 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackSetUserActiveStatusParams,
+			unpackUpdateProjectEnvironmentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SetUserActiveStatus(ctx, request, params)
+				response, err = s.h.UpdateProjectEnvironment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SetUserActiveStatus(ctx, request, params)
+		response, err = s.h.UpdateProjectEnvironment(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -10955,7 +19754,7 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeSetUserActiveStatusResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectEnvironmentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10964,22 +19763,22 @@ func (s *Server) handleSetUserActiveStatusRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleSetup2FARequest handles Setup2FA operation.
+// handleUpdateProjectGroupingConfigRequest handles UpdateProjectGroupingConfig operation.
 //
-// Begin setup 2FA (generate secret and QR-code).
+// Applies to new events only, existing issues keep their fingerprints.
 //
-// POST /api/v1/users/me/2fa/setup
-func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/grouping-config
+func (s *Server) handleUpdateProjectGroupingConfigRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("Setup2FA"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/api/v1/users/me/2fa/setup"),
+		otelogen.OperationID("UpdateProjectGroupingConfig"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/grouping-config"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), Setup2FAOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectGroupingConfigOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11034,15 +19833,15 @@ func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: Setup2FAOperation,
-			ID:   "Setup2FA",
+			Name: UpdateProjectGroupingConfigOperation,
+			ID:   "UpdateProjectGroupingConfig",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, Setup2FAOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectGroupingConfigOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11084,23 +19883,53 @@ func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.
 			return
 		}
 	}
+	params, err := decodeUpdateProjectGroupingConfigParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateProjectGroupingConfigRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response Setup2FARes
+	var response UpdateProjectGroupingConfigRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    Setup2FAOperation,
-			OperationSummary: "Begin setup 2FA (generate secret and QR-code)",
-			OperationID:      "Setup2FA",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    UpdateProjectGroupingConfigOperation,
+			OperationSummary: "Switch project grouping strategy",
+			OperationID:      "UpdateProjectGroupingConfig",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "project_id",
+					In:   "path",
+				}: params.ProjectID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = Setup2FARes
+			Request  = *UpdateGroupingConfigRequest
+			Params   = UpdateProjectGroupingConfigParams
+			Response = UpdateProjectGroupingConfigRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11109,14 +19938,14 @@ func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateProjectGroupingConfigParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Setup2FA(ctx)
+				response, err = s.h.UpdateProjectGroupingConfig(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Setup2FA(ctx)
+		response, err = s.h.UpdateProjectGroupingConfig(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11135,7 +19964,7 @@ func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 
-	if err := encodeSetup2FAResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectGroupingConfigResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11144,22 +19973,23 @@ func (s *Server) handleSetup2FARequest(args [0]string, argsEscaped bool, w http.
 	}
 }
 
-// handleUpdateNotificationRuleRequest handles UpdateNotificationRule operation.
+// handleUpdateProjectInboundFiltersRequest handles UpdateProjectInboundFilters operation.
 //
-// Update a notification rule.
+// Filters are applied by the ingest servers before data is queued, dropped data is counted in the
+// project outcomes.
 //
-// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}
-func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/inbound-filters
+func (s *Server) handleUpdateProjectInboundFiltersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateNotificationRule"),
+		otelogen.OperationID("UpdateProjectInboundFilters"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}/rules/{rule_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/inbound-filters"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationRuleOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectInboundFiltersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11214,15 +20044,15 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateNotificationRuleOperation,
-			ID:   "UpdateNotificationRule",
+			Name: UpdateProjectInboundFiltersOperation,
+			ID:   "UpdateProjectInboundFilters",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateNotificationRuleOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectInboundFiltersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11264,7 +20094,7 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeUpdateNotificationRuleParams(args, argsEscaped, r)
+	params, err := decodeUpdateProjectInboundFiltersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11274,7 +20104,7 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUpdateNotificationRuleRequest(r)
+	request, close, err := s.decodeUpdateProjectInboundFiltersRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11290,35 +20120,27 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 		}
 	}()
 
-	var response UpdateNotificationRuleRes
+	var response UpdateProjectInboundFiltersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateNotificationRuleOperation,
-			OperationSummary: "Update a notification rule",
-			OperationID:      "UpdateNotificationRule",
+			OperationName:    UpdateProjectInboundFiltersOperation,
+			OperationSummary: "Update project inbound filters",
+			OperationID:      "UpdateProjectInboundFilters",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "project_id",
 					In:   "path",
 				}: params.ProjectID,
-				{
-					Name: "setting_id",
-					In:   "path",
-				}: params.SettingID,
-				{
-					Name: "rule_id",
-					In:   "path",
-				}: params.RuleID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateNotificationRuleRequest
-			Params   = UpdateNotificationRuleParams
-			Response = UpdateNotificationRuleRes
+			Request  = *UpdateInboundFiltersRequest
+			Params   = UpdateProjectInboundFiltersParams
+			Response = UpdateProjectInboundFiltersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11327,14 +20149,14 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackUpdateNotificationRuleParams,
+			unpackUpdateProjectInboundFiltersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateNotificationRule(ctx, request, params)
+				response, err = s.h.UpdateProjectInboundFilters(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateNotificationRule(ctx, request, params)
+		response, err = s.h.UpdateProjectInboundFilters(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11353,7 +20175,7 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 		return
 	}
 
-	if err := encodeUpdateNotificationRuleResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectInboundFiltersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11362,22 +20184,22 @@ func (s *Server) handleUpdateNotificationRuleRequest(args [3]string, argsEscaped
 	}
 }
 
-// handleUpdateNotificationSettingRequest handles UpdateNotificationSetting operation.
+// handleUpdateProjectKeyRequest handles UpdateProjectKey operation.
 //
-// Update a notification setting.
+// Disabled keys are rejected by the ingest servers until they are enabled again.
 //
-// PUT /api/v1/projects/{project_id}/notification-settings/{setting_id}
-func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/keys/{key_id}
+func (s *Server) handleUpdateProjectKeyRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateNotificationSetting"),
+		otelogen.OperationID("UpdateProjectKey"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/notification-settings/{setting_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/keys/{key_id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationSettingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11432,15 +20254,15 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateNotificationSettingOperation,
-			ID:   "UpdateNotificationSetting",
+			Name: UpdateProjectKeyOperation,
+			ID:   "UpdateProjectKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateNotificationSettingOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11482,7 +20304,7 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeUpdateNotificationSettingParams(args, argsEscaped, r)
+	params, err := decodeUpdateProjectKeyParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11492,7 +20314,7 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUpdateNotificationSettingRequest(r)
+	request, close, err := s.decodeUpdateProjectKeyRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11508,13 +20330,13 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 		}
 	}()
 
-	var response UpdateNotificationSettingRes
+	var response UpdateProjectKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateNotificationSettingOperation,
-			OperationSummary: "Update a notification setting",
-			OperationID:      "UpdateNotificationSetting",
+			OperationName:    UpdateProjectKeyOperation,
+			OperationSummary: "Update a project client key",
+			OperationID:      "UpdateProjectKey",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -11522,17 +20344,17 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 					In:   "path",
 				}: params.ProjectID,
 				{
-					Name: "setting_id",
+					Name: "key_id",
 					In:   "path",
-				}: params.SettingID,
+				}: params.KeyID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateNotificationSettingRequest
-			Params   = UpdateNotificationSettingParams
-			Response = UpdateNotificationSettingRes
+			Request  = *UpdateProjectKeyRequest
+			Params   = UpdateProjectKeyParams
+			Response = UpdateProjectKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11541,14 +20363,14 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackUpdateNotificationSettingParams,
+			unpackUpdateProjectKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateNotificationSetting(ctx, request, params)
+				response, err = s.h.UpdateProjectKey(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateNotificationSetting(ctx, request, params)
+		response, err = s.h.UpdateProjectKey(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11567,7 +20389,7 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 		return
 	}
 
-	if err := encodeUpdateNotificationSettingResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11576,22 +20398,23 @@ func (s *Server) handleUpdateNotificationSettingRequest(args [2]string, argsEsca
 	}
 }
 
-// handleUpdateProjectRequest handles UpdateProject operation.
+// handleUpdateProjectRateLimitsRequest handles UpdateProjectRateLimits operation.
 //
-// Update project name and description.
+// The limits are shared by all client keys of the project, data over the limits is rejected by the
+// ingest servers.
 //
-// PUT /api/v1/projects/{project_id}
-func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /api/v1/projects/{project_id}/rate-limits
+func (s *Server) handleUpdateProjectRateLimitsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("UpdateProject"),
+		otelogen.OperationID("UpdateProjectRateLimits"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}"),
+		semconv.HTTPRouteKey.String("/api/v1/projects/{project_id}/rate-limits"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateProjectRateLimitsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11646,15 +20469,15 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateProjectOperation,
-			ID:   "UpdateProject",
+			Name: UpdateProjectRateLimitsOperation,
+			ID:   "UpdateProjectRateLimits",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectOperation, r)
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateProjectRateLimitsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11696,7 +20519,7 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeUpdateProjectParams(args, argsEscaped, r)
+	params, err := decodeUpdateProjectRateLimitsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11706,7 +20529,7 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUpdateProjectRequest(r)
+	request, close, err := s.decodeUpdateProjectRateLimitsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11722,13 +20545,13 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response UpdateProjectRes
+	var response UpdateProjectRateLimitsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateProjectOperation,
-			OperationSummary: "Update project name and description",
-			OperationID:      "UpdateProject",
+			OperationName:    UpdateProjectRateLimitsOperation,
+			OperationSummary: "Update project rate limits",
+			OperationID:      "UpdateProjectRateLimits",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -11740,9 +20563,9 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = *UpdateProjectRequest
-			Params   = UpdateProjectParams
-			Response = UpdateProjectRes
+			Request  = *UpdateProjectRateLimitsRequest
+			Params   = UpdateProjectRateLimitsParams
+			Response = UpdateProjectRateLimitsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11751,14 +20574,14 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackUpdateProjectParams,
+			unpackUpdateProjectRateLimitsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateProject(ctx, request, params)
+				response, err = s.h.UpdateProjectRateLimits(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateProject(ctx, request, params)
+		response, err = s.h.UpdateProjectRateLimits(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -11777,7 +20600,7 @@ func (s *Server) handleUpdateProjectRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeUpdateProjectResponse(response, w, span); err != nil {
+	if err := encodeUpdateProjectRateLimitsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
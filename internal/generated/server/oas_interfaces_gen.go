@@ -13,6 +13,14 @@ type ArchiveProjectRes interface {
 	archiveProjectRes()
 }
 
+type AssignIssueRes interface {
+	assignIssueRes()
+}
+
+type BulkUpdateIssuesRes interface {
+	bulkUpdateIssuesRes()
+}
+
 type ChangeIssueStatusRes interface {
 	changeIssueStatusRes()
 }
@@ -33,6 +41,10 @@ type Confirm2FARes interface {
 	confirm2FARes()
 }
 
+type CreateGroupingRuleRes interface {
+	createGroupingRuleRes()
+}
+
 type CreateNotificationRuleRes interface {
 	createNotificationRuleRes()
 }
@@ -41,6 +53,14 @@ type CreateNotificationSettingRes interface {
 	createNotificationSettingRes()
 }
 
+type CreateProjectIssueCommentRes interface {
+	createProjectIssueCommentRes()
+}
+
+type CreateProjectKeyRes interface {
+	createProjectKeyRes()
+}
+
 type CreateTeamRes interface {
 	createTeamRes()
 }
@@ -49,6 +69,14 @@ type CreateUserRes interface {
 	createUserRes()
 }
 
+type DeleteGroupingRuleRes interface {
+	deleteGroupingRuleRes()
+}
+
+type DeleteMonitorRes interface {
+	deleteMonitorRes()
+}
+
 type DeleteNotificationRuleRes interface {
 	deleteNotificationRuleRes()
 }
@@ -57,6 +85,10 @@ type DeleteNotificationSettingRes interface {
 	deleteNotificationSettingRes()
 }
 
+type DeleteProjectIssueCommentRes interface {
+	deleteProjectIssueCommentRes()
+}
+
 type DeleteTeamRes interface {
 	deleteTeamRes()
 }
@@ -69,6 +101,10 @@ type Disable2FARes interface {
 	disable2FARes()
 }
 
+type DownloadAttachmentRes interface {
+	downloadAttachmentRes()
+}
+
 type ForgotPasswordRes interface {
 	forgotPasswordRes()
 }
@@ -89,6 +125,10 @@ type GetIssuesTimeseriesRes interface {
 	getIssuesTimeseriesRes()
 }
 
+type GetMonitorRes interface {
+	getMonitorRes()
+}
+
 type GetNotificationRuleRes interface {
 	getNotificationRuleRes()
 }
@@ -97,14 +137,50 @@ type GetNotificationSettingRes interface {
 	getNotificationSettingRes()
 }
 
+type GetProjectDataScrubbingRes interface {
+	getProjectDataScrubbingRes()
+}
+
+type GetProjectEventRes interface {
+	getProjectEventRes()
+}
+
+type GetProjectGroupingConfigRes interface {
+	getProjectGroupingConfigRes()
+}
+
+type GetProjectInboundFiltersRes interface {
+	getProjectInboundFiltersRes()
+}
+
 type GetProjectIssueEventsTimeseriesRes interface {
 	getProjectIssueEventsTimeseriesRes()
 }
 
+type GetProjectIssueLatestEventRes interface {
+	getProjectIssueLatestEventRes()
+}
+
+type GetProjectIssueOldestEventRes interface {
+	getProjectIssueOldestEventRes()
+}
+
+type GetProjectIssueTagsRes interface {
+	getProjectIssueTagsRes()
+}
+
 type GetProjectIssueTimeseriesRes interface {
 	getProjectIssueTimeseriesRes()
 }
 
+type GetProjectOutcomesRes interface {
+	getProjectOutcomesRes()
+}
+
+type GetProjectRateLimitsRes interface {
+	getProjectRateLimitsRes()
+}
+
 type GetProjectReleaseAnalyticsDetailsRes interface {
 	getProjectReleaseAnalyticsDetailsRes()
 }
@@ -141,6 +217,10 @@ type GetTeamRes interface {
 	getTeamRes()
 }
 
+type GetTraceRes interface {
+	getTraceRes()
+}
+
 type GetUnreadNotificationsCountRes interface {
 	getUnreadNotificationsCountRes()
 }
@@ -153,10 +233,22 @@ type GetVersionsRes interface {
 	getVersionsRes()
 }
 
+type ListEventAttachmentsRes interface {
+	listEventAttachmentsRes()
+}
+
+type ListGroupingRulesRes interface {
+	listGroupingRulesRes()
+}
+
 type ListIssuesRes interface {
 	listIssuesRes()
 }
 
+type ListMonitorsRes interface {
+	listMonitorsRes()
+}
+
 type ListNotificationRulesRes interface {
 	listNotificationRulesRes()
 }
@@ -165,6 +257,34 @@ type ListNotificationSettingsRes interface {
 	listNotificationSettingsRes()
 }
 
+type ListProjectEnvironmentsRes interface {
+	listProjectEnvironmentsRes()
+}
+
+type ListProjectIssueActivityRes interface {
+	listProjectIssueActivityRes()
+}
+
+type ListProjectIssueCommentsRes interface {
+	listProjectIssueCommentsRes()
+}
+
+type ListProjectIssueEventsRes interface {
+	listProjectIssueEventsRes()
+}
+
+type ListProjectIssueTagEventsRes interface {
+	listProjectIssueTagEventsRes()
+}
+
+type ListProjectKeysRes interface {
+	listProjectKeysRes()
+}
+
+type ListProjectTransactionsRes interface {
+	listProjectTransactionsRes()
+}
+
 type ListProjectsRes interface {
 	listProjectsRes()
 }
@@ -193,6 +313,14 @@ type MarkNotificationAsReadRes interface {
 	markNotificationAsReadRes()
 }
 
+type MergeIssuesRes interface {
+	mergeIssuesRes()
+}
+
+type PreviewGroupingRuleRes interface {
+	previewGroupingRuleRes()
+}
+
 type RecentProjectsListRes interface {
 	recentProjectsListRes()
 }
@@ -213,6 +341,18 @@ type ResetPasswordRes interface {
 	resetPasswordRes()
 }
 
+type RevokeProjectKeyRes interface {
+	revokeProjectKeyRes()
+}
+
+type RotateProjectKeyRes interface {
+	rotateProjectKeyRes()
+}
+
+type SearchProjectEventsRes interface {
+	searchProjectEventsRes()
+}
+
 type Send2FACodeRes interface {
 	send2FACodeRes()
 }
@@ -233,6 +373,14 @@ type Setup2FARes interface {
 	setup2FARes()
 }
 
+type UnmergeIssueRes interface {
+	unmergeIssueRes()
+}
+
+type UpdateGroupingRuleRes interface {
+	updateGroupingRuleRes()
+}
+
 type UpdateNotificationRuleRes interface {
 	updateNotificationRuleRes()
 }
@@ -241,6 +389,30 @@ type UpdateNotificationSettingRes interface {
 	updateNotificationSettingRes()
 }
 
+type UpdateProjectDataScrubbingRes interface {
+	updateProjectDataScrubbingRes()
+}
+
+type UpdateProjectEnvironmentRes interface {
+	updateProjectEnvironmentRes()
+}
+
+type UpdateProjectGroupingConfigRes interface {
+	updateProjectGroupingConfigRes()
+}
+
+type UpdateProjectInboundFiltersRes interface {
+	updateProjectInboundFiltersRes()
+}
+
+type UpdateProjectKeyRes interface {
+	updateProjectKeyRes()
+}
+
+type UpdateProjectRateLimitsRes interface {
+	updateProjectRateLimitsRes()
+}
+
 type UpdateProjectRes interface {
 	updateProjectRes()
 }
@@ -298,54 +298,175 @@ func (s *AddTeamMemberRequestRole) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *ChangeIssueStatusReq) Encode(e *jx.Encoder) {
+func (s *Attachment) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChangeIssueStatusReq) encodeFields(e *jx.Encoder) {
+func (s *Attachment) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("event_id")
+		e.Str(s.EventID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("content_type")
+		e.Str(s.ContentType)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		e.FieldStart("size")
+		e.UInt(s.Size)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 }
 
-var jsonFieldsNameOfChangeIssueStatusReq = [1]string{
-	0: "status",
+var jsonFieldsNameOfAttachment = [8]string{
+	0: "id",
+	1: "project_id",
+	2: "event_id",
+	3: "name",
+	4: "content_type",
+	5: "type",
+	6: "size",
+	7: "created_at",
 }
 
-// Decode decodes ChangeIssueStatusReq from json.
-func (s *ChangeIssueStatusReq) Decode(d *jx.Decoder) error {
+// Decode decodes Attachment from json.
+func (s *Attachment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChangeIssueStatusReq to nil")
+		return errors.New("invalid: unable to decode Attachment to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "status":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "project_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "event_id":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.EventID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "content_type":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.ContentType = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content_type\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "size":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.UInt()
+				s.Size = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChangeIssueStatusReq")
+		return errors.Wrap(err, "decode Attachment")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b11111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -357,8 +478,8 @@ func (s *ChangeIssueStatusReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfChangeIssueStatusReq) {
-					name = jsonFieldsNameOfChangeIssueStatusReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAttachment) {
+					name = jsonFieldsNameOfAttachment[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -379,139 +500,415 @@ func (s *ChangeIssueStatusReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChangeIssueStatusReq) MarshalJSON() ([]byte, error) {
+func (s *Attachment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChangeIssueStatusReq) UnmarshalJSON(data []byte) error {
+func (s *Attachment) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ChangeTeamMemberRoleRequest) Encode(e *jx.Encoder) {
+func (s *CategoryRateLimits) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ChangeTeamMemberRoleRequest) encodeFields(e *jx.Encoder) {
+func (s *CategoryRateLimits) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("role")
-		s.Role.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		if s.Transaction.Set {
+			e.FieldStart("transaction")
+			s.Transaction.Encode(e)
+		}
+	}
+	{
+		if s.Attachment.Set {
+			e.FieldStart("attachment")
+			s.Attachment.Encode(e)
+		}
+	}
+	{
+		if s.Session.Set {
+			e.FieldStart("session")
+			s.Session.Encode(e)
+		}
+	}
+	{
+		if s.Monitor.Set {
+			e.FieldStart("monitor")
+			s.Monitor.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfChangeTeamMemberRoleRequest = [1]string{
-	0: "role",
+var jsonFieldsNameOfCategoryRateLimits = [5]string{
+	0: "error",
+	1: "transaction",
+	2: "attachment",
+	3: "session",
+	4: "monitor",
 }
 
-// Decode decodes ChangeTeamMemberRoleRequest from json.
-func (s *ChangeTeamMemberRoleRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CategoryRateLimits from json.
+func (s *CategoryRateLimits) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ChangeTeamMemberRoleRequest to nil")
+		return errors.New("invalid: unable to decode CategoryRateLimits to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "role":
-			requiredBitSet[0] |= 1 << 0
+		case "error":
 			if err := func() error {
-				if err := s.Role.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "transaction":
+			if err := func() error {
+				s.Transaction.Reset()
+				if err := s.Transaction.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"transaction\"")
+			}
+		case "attachment":
+			if err := func() error {
+				s.Attachment.Reset()
+				if err := s.Attachment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attachment\"")
+			}
+		case "session":
+			if err := func() error {
+				s.Session.Reset()
+				if err := s.Session.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"session\"")
+			}
+		case "monitor":
+			if err := func() error {
+				s.Monitor.Reset()
+				if err := s.Monitor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"monitor\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ChangeTeamMemberRoleRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfChangeTeamMemberRoleRequest) {
-					name = jsonFieldsNameOfChangeTeamMemberRoleRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode CategoryRateLimits")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ChangeTeamMemberRoleRequest) MarshalJSON() ([]byte, error) {
+func (s *CategoryRateLimits) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ChangeTeamMemberRoleRequest) UnmarshalJSON(data []byte) error {
+func (s *CategoryRateLimits) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ChangeTeamMemberRoleRequestRole as json.
-func (s ChangeTeamMemberRoleRequestRole) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *ChangeIssueStatusReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ChangeTeamMemberRoleRequestRole from json.
-func (s *ChangeTeamMemberRoleRequestRole) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ChangeTeamMemberRoleRequestRole to nil")
+// encodeFields encodes fields.
+func (s *ChangeIssueStatusReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
 	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+	{
+		if s.IgnoreConditions.Set {
+			e.FieldStart("ignore_conditions")
+			s.IgnoreConditions.Encode(e)
+		}
 	}
-	// Try to use constant string.
-	switch ChangeTeamMemberRoleRequestRole(v) {
-	case ChangeTeamMemberRoleRequestRoleOwner:
-		*s = ChangeTeamMemberRoleRequestRoleOwner
-	case ChangeTeamMemberRoleRequestRoleAdmin:
-		*s = ChangeTeamMemberRoleRequestRoleAdmin
-	case ChangeTeamMemberRoleRequestRoleMember:
-		*s = ChangeTeamMemberRoleRequestRoleMember
-	default:
-		*s = ChangeTeamMemberRoleRequestRole(v)
+	{
+		if s.ResolvedInRelease.Set {
+			e.FieldStart("resolved_in_release")
+			s.ResolvedInRelease.Encode(e)
+		}
 	}
-
-	return nil
+	{
+		if s.ResolvedInNextRelease.Set {
+			e.FieldStart("resolved_in_next_release")
+			s.ResolvedInNextRelease.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfChangeIssueStatusReq = [4]string{
+	0: "status",
+	1: "ignore_conditions",
+	2: "resolved_in_release",
+	3: "resolved_in_next_release",
+}
+
+// Decode decodes ChangeIssueStatusReq from json.
+func (s *ChangeIssueStatusReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChangeIssueStatusReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "status":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "ignore_conditions":
+			if err := func() error {
+				s.IgnoreConditions.Reset()
+				if err := s.IgnoreConditions.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ignore_conditions\"")
+			}
+		case "resolved_in_release":
+			if err := func() error {
+				s.ResolvedInRelease.Reset()
+				if err := s.ResolvedInRelease.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_release\"")
+			}
+		case "resolved_in_next_release":
+			if err := func() error {
+				s.ResolvedInNextRelease.Reset()
+				if err := s.ResolvedInNextRelease.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_next_release\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ChangeIssueStatusReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfChangeIssueStatusReq) {
+					name = jsonFieldsNameOfChangeIssueStatusReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ChangeIssueStatusReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ChangeIssueStatusReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ChangeTeamMemberRoleRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ChangeTeamMemberRoleRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("role")
+		s.Role.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfChangeTeamMemberRoleRequest = [1]string{
+	0: "role",
+}
+
+// Decode decodes ChangeTeamMemberRoleRequest from json.
+func (s *ChangeTeamMemberRoleRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChangeTeamMemberRoleRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "role":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ChangeTeamMemberRoleRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfChangeTeamMemberRoleRequest) {
+					name = jsonFieldsNameOfChangeTeamMemberRoleRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ChangeTeamMemberRoleRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ChangeTeamMemberRoleRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ChangeTeamMemberRoleRequestRole as json.
+func (s ChangeTeamMemberRoleRequestRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ChangeTeamMemberRoleRequestRole from json.
+func (s *ChangeTeamMemberRoleRequestRole) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ChangeTeamMemberRoleRequestRole to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ChangeTeamMemberRoleRequestRole(v) {
+	case ChangeTeamMemberRoleRequestRoleOwner:
+		*s = ChangeTeamMemberRoleRequestRoleOwner
+	case ChangeTeamMemberRoleRequestRoleAdmin:
+		*s = ChangeTeamMemberRoleRequestRoleAdmin
+	case ChangeTeamMemberRoleRequestRoleMember:
+		*s = ChangeTeamMemberRoleRequestRoleMember
+	default:
+		*s = ChangeTeamMemberRoleRequestRole(v)
+	}
+
+	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
@@ -939,60 +1336,180 @@ func (s *ComponentVersionStatus) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateNotificationRuleRequest) Encode(e *jx.Encoder) {
+func (s *CreateIssueCommentRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateNotificationRuleRequest) encodeFields(e *jx.Encoder) {
+func (s *CreateIssueCommentRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.EventLevel.Set {
-			e.FieldStart("event_level")
-			s.EventLevel.Encode(e)
-		}
-	}
-	{
-		if s.Fingerprint.Set {
-			e.FieldStart("fingerprint")
-			s.Fingerprint.Encode(e)
-		}
-	}
-	{
-		if s.IsNewError.Set {
-			e.FieldStart("is_new_error")
-			s.IsNewError.Encode(e)
-		}
+		e.FieldStart("body")
+		e.Str(s.Body)
 	}
 	{
-		if s.IsRegression.Set {
-			e.FieldStart("is_regression")
-			s.IsRegression.Encode(e)
+		if s.ParentID.Set {
+			e.FieldStart("parent_id")
+			s.ParentID.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCreateNotificationRuleRequest = [4]string{
-	0: "event_level",
-	1: "fingerprint",
-	2: "is_new_error",
-	3: "is_regression",
+var jsonFieldsNameOfCreateIssueCommentRequest = [2]string{
+	0: "body",
+	1: "parent_id",
 }
 
-// Decode decodes CreateNotificationRuleRequest from json.
-func (s *CreateNotificationRuleRequest) Decode(d *jx.Decoder) error {
+// Decode decodes CreateIssueCommentRequest from json.
+func (s *CreateIssueCommentRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateNotificationRuleRequest to nil")
+		return errors.New("invalid: unable to decode CreateIssueCommentRequest to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "event_level":
+		case "body":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.EventLevel.Reset()
-				if err := s.EventLevel.Decode(d); err != nil {
-					return err
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		case "parent_id":
+			if err := func() error {
+				s.ParentID.Reset()
+				if err := s.ParentID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateIssueCommentRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateIssueCommentRequest) {
+					name = jsonFieldsNameOfCreateIssueCommentRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateIssueCommentRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateIssueCommentRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateNotificationRuleRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateNotificationRuleRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.EventLevel.Set {
+			e.FieldStart("event_level")
+			s.EventLevel.Encode(e)
+		}
+	}
+	{
+		if s.Fingerprint.Set {
+			e.FieldStart("fingerprint")
+			s.Fingerprint.Encode(e)
+		}
+	}
+	{
+		if s.IsNewError.Set {
+			e.FieldStart("is_new_error")
+			s.IsNewError.Encode(e)
+		}
+	}
+	{
+		if s.IsRegression.Set {
+			e.FieldStart("is_regression")
+			s.IsRegression.Encode(e)
+		}
+	}
+	{
+		if s.Environment.Set {
+			e.FieldStart("environment")
+			s.Environment.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreateNotificationRuleRequest = [5]string{
+	0: "event_level",
+	1: "fingerprint",
+	2: "is_new_error",
+	3: "is_regression",
+	4: "environment",
+}
+
+// Decode decodes CreateNotificationRuleRequest from json.
+func (s *CreateNotificationRuleRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateNotificationRuleRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "event_level":
+			if err := func() error {
+				s.EventLevel.Reset()
+				if err := s.EventLevel.Decode(d); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
@@ -1028,6 +1545,16 @@ func (s *CreateNotificationRuleRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"is_regression\"")
 			}
+		case "environment":
+			if err := func() error {
+				s.Environment.Reset()
+				if err := s.Environment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -1075,12 +1602,19 @@ func (s *CreateNotificationSettingRequest) encodeFields(e *jx.Encoder) {
 			s.Enabled.Encode(e)
 		}
 	}
+	{
+		if s.RouteToAssignee.Set {
+			e.FieldStart("route_to_assignee")
+			s.RouteToAssignee.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCreateNotificationSettingRequest = [3]string{
+var jsonFieldsNameOfCreateNotificationSettingRequest = [4]string{
 	0: "type",
 	1: "config",
 	2: "enabled",
+	3: "route_to_assignee",
 }
 
 // Decode decodes CreateNotificationSettingRequest from json.
@@ -1125,6 +1659,16 @@ func (s *CreateNotificationSettingRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"enabled\"")
 			}
+		case "route_to_assignee":
+			if err := func() error {
+				s.RouteToAssignee.Reset()
+				if err := s.RouteToAssignee.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"route_to_assignee\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -1613,148 +2157,229 @@ func (s *CreateUserResponse) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *Error) Encode(e *jx.Encoder) {
+func (s *DataScrubbing) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Error) encodeFields(e *jx.Encoder) {
+func (s *DataScrubbing) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("default_scrubbers")
+		e.Bool(s.DefaultScrubbers)
+	}
+	{
+		e.FieldStart("sensitive_fields")
+		e.ArrStart()
+		for _, elem := range s.SensitiveFields {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("patterns")
+		e.ArrStart()
+		for _, elem := range s.Patterns {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("anonymize_ip")
+		e.Bool(s.AnonymizeIP)
+	}
+	{
+		e.FieldStart("drop_request_cookies")
+		e.Bool(s.DropRequestCookies)
+	}
+	{
+		e.FieldStart("drop_request_data")
+		e.Bool(s.DropRequestData)
+	}
+	{
+		e.FieldStart("default_sensitive_fields")
+		e.ArrStart()
+		for _, elem := range s.DefaultSensitiveFields {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
 }
 
-var jsonFieldsNameOfError = [1]string{
-	0: "error",
+var jsonFieldsNameOfDataScrubbing = [9]string{
+	0: "project_id",
+	1: "default_scrubbers",
+	2: "sensitive_fields",
+	3: "patterns",
+	4: "anonymize_ip",
+	5: "drop_request_cookies",
+	6: "drop_request_data",
+	7: "default_sensitive_fields",
+	8: "updated_at",
 }
 
-// Decode decodes Error from json.
-func (s *Error) Decode(d *jx.Decoder) error {
+// Decode decodes DataScrubbing from json.
+func (s *DataScrubbing) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Error to nil")
+		return errors.New("invalid: unable to decode DataScrubbing to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "error":
+		case "project_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"project_id\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Error")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfError) {
-					name = jsonFieldsNameOfError[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "default_scrubbers":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.DefaultScrubbers = bool(v)
+				if err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"default_scrubbers\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Error) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *Error2FARequired) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Error2FARequired) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("error")
-		s.Error.Encode(e)
-	}
-}
-
-var jsonFieldsNameOfError2FARequired = [1]string{
-	0: "error",
-}
-
-// Decode decodes Error2FARequired from json.
-func (s *Error2FARequired) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Error2FARequired to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "error":
-			requiredBitSet[0] |= 1 << 0
+		case "sensitive_fields":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Error.Decode(d); err != nil {
+				s.SensitiveFields = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.SensitiveFields = append(s.SensitiveFields, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"sensitive_fields\"")
+			}
+		case "patterns":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Patterns = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Patterns = append(s.Patterns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patterns\"")
+			}
+		case "anonymize_ip":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.AnonymizeIP = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"anonymize_ip\"")
+			}
+		case "drop_request_cookies":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.DropRequestCookies = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"drop_request_cookies\"")
+			}
+		case "drop_request_data":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Bool()
+				s.DropRequestData = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"drop_request_data\"")
+			}
+		case "default_sensitive_fields":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				s.DefaultSensitiveFields = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.DefaultSensitiveFields = append(s.DefaultSensitiveFields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"default_sensitive_fields\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Error2FARequired")
+		return errors.Wrap(err, "decode DataScrubbing")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1766,8 +2391,8 @@ func (s *Error2FARequired) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfError2FARequired) {
-					name = jsonFieldsNameOfError2FARequired[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfDataScrubbing) {
+					name = jsonFieldsNameOfDataScrubbing[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1788,103 +2413,120 @@ func (s *Error2FARequired) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Error2FARequired) MarshalJSON() ([]byte, error) {
+func (s *DataScrubbing) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error2FARequired) UnmarshalJSON(data []byte) error {
+func (s *DataScrubbing) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Error2FARequiredError) Encode(e *jx.Encoder) {
+func (s *Environment) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Error2FARequiredError) encodeFields(e *jx.Encoder) {
+func (s *Environment) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Str(s.Code)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("session_id")
-		e.Str(s.SessionID)
+		e.FieldStart("hidden")
+		e.Bool(s.Hidden)
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("first_seen")
+		json.EncodeDateTime(e, s.FirstSeen)
+	}
+	{
+		e.FieldStart("last_seen")
+		json.EncodeDateTime(e, s.LastSeen)
 	}
 }
 
-var jsonFieldsNameOfError2FARequiredError = [3]string{
-	0: "code",
-	1: "session_id",
-	2: "message",
+var jsonFieldsNameOfEnvironment = [4]string{
+	0: "name",
+	1: "hidden",
+	2: "first_seen",
+	3: "last_seen",
 }
 
-// Decode decodes Error2FARequiredError from json.
-func (s *Error2FARequiredError) Decode(d *jx.Decoder) error {
+// Decode decodes Environment from json.
+func (s *Environment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Error2FARequiredError to nil")
+		return errors.New("invalid: unable to decode Environment to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Code = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "session_id":
+		case "hidden":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.SessionID = string(v)
+				v, err := d.Bool()
+				s.Hidden = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"session_id\"")
+				return errors.Wrap(err, "decode field \"hidden\"")
 			}
-		case "message":
+		case "first_seen":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.FirstSeen = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"first_seen\"")
+			}
+		case "last_seen":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.LastSeen = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_seen\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Error2FARequiredError")
+		return errors.Wrap(err, "decode Environment")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1896,8 +2538,8 @@ func (s *Error2FARequiredError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfError2FARequiredError) {
-					name = jsonFieldsNameOfError2FARequiredError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfEnvironment) {
+					name = jsonFieldsNameOfEnvironment[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1918,41 +2560,41 @@ func (s *Error2FARequiredError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Error2FARequiredError) MarshalJSON() ([]byte, error) {
+func (s *Environment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error2FARequiredError) UnmarshalJSON(data []byte) error {
+func (s *Environment) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorBadRequest) Encode(e *jx.Encoder) {
+func (s *Error) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorBadRequest) encodeFields(e *jx.Encoder) {
+func (s *Error) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorBadRequest = [1]string{
+var jsonFieldsNameOfError = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorBadRequest from json.
-func (s *ErrorBadRequest) Decode(d *jx.Decoder) error {
+// Decode decodes Error from json.
+func (s *Error) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorBadRequest to nil")
+		return errors.New("invalid: unable to decode Error to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -1973,7 +2615,7 @@ func (s *ErrorBadRequest) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorBadRequest")
+		return errors.Wrap(err, "decode Error")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1990,8 +2632,8 @@ func (s *ErrorBadRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorBadRequest) {
-					name = jsonFieldsNameOfErrorBadRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfError) {
+					name = jsonFieldsNameOfError[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2012,114 +2654,180 @@ func (s *ErrorBadRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorBadRequest) MarshalJSON() ([]byte, error) {
+func (s *Error) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorBadRequest) UnmarshalJSON(data []byte) error {
+func (s *Error) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorBadRequestError) Encode(e *jx.Encoder) {
+func (s *Error2FARequired) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorBadRequestError) encodeFields(e *jx.Encoder) {
+func (s *Error2FARequired) encodeFields(e *jx.Encoder) {
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
+		e.FieldStart("error")
+		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorBadRequestError = [1]string{
-	0: "message",
+var jsonFieldsNameOfError2FARequired = [1]string{
+	0: "error",
 }
 
-// Decode decodes ErrorBadRequestError from json.
-func (s *ErrorBadRequestError) Decode(d *jx.Decoder) error {
+// Decode decodes Error2FARequired from json.
+func (s *Error2FARequired) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorBadRequestError to nil")
+		return errors.New("invalid: unable to decode Error2FARequired to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "message":
+		case "error":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorBadRequestError")
+		return errors.Wrap(err, "decode Error2FARequired")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfError2FARequired) {
+					name = jsonFieldsNameOfError2FARequired[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorBadRequestError) MarshalJSON() ([]byte, error) {
+func (s *Error2FARequired) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorBadRequestError) UnmarshalJSON(data []byte) error {
+func (s *Error2FARequired) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorError) Encode(e *jx.Encoder) {
+func (s *Error2FARequiredError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorError) encodeFields(e *jx.Encoder) {
+func (s *Error2FARequiredError) encodeFields(e *jx.Encoder) {
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
+		e.FieldStart("code")
+		e.Str(s.Code)
+	}
+	{
+		e.FieldStart("session_id")
+		e.Str(s.SessionID)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
 	}
 }
 
-var jsonFieldsNameOfErrorError = [1]string{
-	0: "message",
+var jsonFieldsNameOfError2FARequiredError = [3]string{
+	0: "code",
+	1: "session_id",
+	2: "message",
 }
 
-// Decode decodes ErrorError from json.
-func (s *ErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes Error2FARequiredError from json.
+func (s *Error2FARequiredError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorError to nil")
+		return errors.New("invalid: unable to decode Error2FARequiredError to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
+		case "code":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "session_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.SessionID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"session_id\"")
+			}
 		case "message":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
@@ -2131,48 +2839,80 @@ func (s *ErrorError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorError")
+		return errors.Wrap(err, "decode Error2FARequiredError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfError2FARequiredError) {
+					name = jsonFieldsNameOfError2FARequiredError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorError) MarshalJSON() ([]byte, error) {
+func (s *Error2FARequiredError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorError) UnmarshalJSON(data []byte) error {
+func (s *Error2FARequiredError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorInternalServerError) Encode(e *jx.Encoder) {
+func (s *ErrorBadRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorInternalServerError) encodeFields(e *jx.Encoder) {
+func (s *ErrorBadRequest) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorInternalServerError = [1]string{
+var jsonFieldsNameOfErrorBadRequest = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorInternalServerError from json.
-func (s *ErrorInternalServerError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorBadRequest from json.
+func (s *ErrorBadRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorInternalServerError to nil")
+		return errors.New("invalid: unable to decode ErrorBadRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2193,7 +2933,7 @@ func (s *ErrorInternalServerError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorInternalServerError")
+		return errors.Wrap(err, "decode ErrorBadRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2210,8 +2950,8 @@ func (s *ErrorInternalServerError) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorInternalServerError) {
-					name = jsonFieldsNameOfErrorInternalServerError[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorBadRequest) {
+					name = jsonFieldsNameOfErrorBadRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2232,27 +2972,27 @@ func (s *ErrorInternalServerError) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorInternalServerError) MarshalJSON() ([]byte, error) {
+func (s *ErrorBadRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorInternalServerError) UnmarshalJSON(data []byte) error {
+func (s *ErrorBadRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorInternalServerErrorError) Encode(e *jx.Encoder) {
+func (s *ErrorBadRequestError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorInternalServerErrorError) encodeFields(e *jx.Encoder) {
+func (s *ErrorBadRequestError) encodeFields(e *jx.Encoder) {
 	{
 		if s.Message.Set {
 			e.FieldStart("message")
@@ -2261,14 +3001,14 @@ func (s *ErrorInternalServerErrorError) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfErrorInternalServerErrorError = [1]string{
+var jsonFieldsNameOfErrorBadRequestError = [1]string{
 	0: "message",
 }
 
-// Decode decodes ErrorInternalServerErrorError from json.
-func (s *ErrorInternalServerErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorBadRequestError from json.
+func (s *ErrorBadRequestError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorInternalServerErrorError to nil")
+		return errors.New("invalid: unable to decode ErrorBadRequestError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -2288,48 +3028,48 @@ func (s *ErrorInternalServerErrorError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorInternalServerErrorError")
+		return errors.Wrap(err, "decode ErrorBadRequestError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorInternalServerErrorError) MarshalJSON() ([]byte, error) {
+func (s *ErrorBadRequestError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorInternalServerErrorError) UnmarshalJSON(data []byte) error {
+func (s *ErrorBadRequestError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorInvalidCredentials) Encode(e *jx.Encoder) {
+func (s *ErrorConflict) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorInvalidCredentials) encodeFields(e *jx.Encoder) {
+func (s *ErrorConflict) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorInvalidCredentials = [1]string{
+var jsonFieldsNameOfErrorConflict = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorInvalidCredentials from json.
-func (s *ErrorInvalidCredentials) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorConflict from json.
+func (s *ErrorConflict) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorInvalidCredentials to nil")
+		return errors.New("invalid: unable to decode ErrorConflict to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2350,7 +3090,7 @@ func (s *ErrorInvalidCredentials) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorInvalidCredentials")
+		return errors.Wrap(err, "decode ErrorConflict")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2367,8 +3107,8 @@ func (s *ErrorInvalidCredentials) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorInvalidCredentials) {
-					name = jsonFieldsNameOfErrorInvalidCredentials[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorConflict) {
+					name = jsonFieldsNameOfErrorConflict[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2389,27 +3129,27 @@ func (s *ErrorInvalidCredentials) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorInvalidCredentials) MarshalJSON() ([]byte, error) {
+func (s *ErrorConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorInvalidCredentials) UnmarshalJSON(data []byte) error {
+func (s *ErrorConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorInvalidCredentialsError) Encode(e *jx.Encoder) {
+func (s *ErrorConflictError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorInvalidCredentialsError) encodeFields(e *jx.Encoder) {
+func (s *ErrorConflictError) encodeFields(e *jx.Encoder) {
 	{
 		if s.Message.Set {
 			e.FieldStart("message")
@@ -2418,14 +3158,14 @@ func (s *ErrorInvalidCredentialsError) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfErrorInvalidCredentialsError = [1]string{
+var jsonFieldsNameOfErrorConflictError = [1]string{
 	0: "message",
 }
 
-// Decode decodes ErrorInvalidCredentialsError from json.
-func (s *ErrorInvalidCredentialsError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorConflictError from json.
+func (s *ErrorConflictError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorInvalidCredentialsError to nil")
+		return errors.New("invalid: unable to decode ErrorConflictError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -2445,48 +3185,111 @@ func (s *ErrorInvalidCredentialsError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorInvalidCredentialsError")
+		return errors.Wrap(err, "decode ErrorConflictError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorInvalidCredentialsError) MarshalJSON() ([]byte, error) {
+func (s *ErrorConflictError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorInvalidCredentialsError) UnmarshalJSON(data []byte) error {
+func (s *ErrorConflictError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorNotFound) Encode(e *jx.Encoder) {
+func (s *ErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorNotFound) encodeFields(e *jx.Encoder) {
+func (s *ErrorError) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfErrorError = [1]string{
+	0: "message",
+}
+
+// Decode decodes ErrorError from json.
+func (s *ErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErrorError to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorError")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ErrorInternalServerError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ErrorInternalServerError) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorNotFound = [1]string{
+var jsonFieldsNameOfErrorInternalServerError = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorNotFound from json.
-func (s *ErrorNotFound) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorInternalServerError from json.
+func (s *ErrorInternalServerError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorNotFound to nil")
+		return errors.New("invalid: unable to decode ErrorInternalServerError to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2507,7 +3310,7 @@ func (s *ErrorNotFound) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorNotFound")
+		return errors.Wrap(err, "decode ErrorInternalServerError")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2524,8 +3327,8 @@ func (s *ErrorNotFound) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorNotFound) {
-					name = jsonFieldsNameOfErrorNotFound[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorInternalServerError) {
+					name = jsonFieldsNameOfErrorInternalServerError[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2546,27 +3349,27 @@ func (s *ErrorNotFound) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorNotFound) MarshalJSON() ([]byte, error) {
+func (s *ErrorInternalServerError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorNotFound) UnmarshalJSON(data []byte) error {
+func (s *ErrorInternalServerError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorNotFoundError) Encode(e *jx.Encoder) {
+func (s *ErrorInternalServerErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorNotFoundError) encodeFields(e *jx.Encoder) {
+func (s *ErrorInternalServerErrorError) encodeFields(e *jx.Encoder) {
 	{
 		if s.Message.Set {
 			e.FieldStart("message")
@@ -2575,14 +3378,14 @@ func (s *ErrorNotFoundError) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfErrorNotFoundError = [1]string{
+var jsonFieldsNameOfErrorInternalServerErrorError = [1]string{
 	0: "message",
 }
 
-// Decode decodes ErrorNotFoundError from json.
-func (s *ErrorNotFoundError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorInternalServerErrorError from json.
+func (s *ErrorInternalServerErrorError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorNotFoundError to nil")
+		return errors.New("invalid: unable to decode ErrorInternalServerErrorError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -2602,48 +3405,48 @@ func (s *ErrorNotFoundError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorNotFoundError")
+		return errors.Wrap(err, "decode ErrorInternalServerErrorError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorNotFoundError) MarshalJSON() ([]byte, error) {
+func (s *ErrorInternalServerErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorNotFoundError) UnmarshalJSON(data []byte) error {
+func (s *ErrorInternalServerErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorPermissionDenied) Encode(e *jx.Encoder) {
+func (s *ErrorInvalidCredentials) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorPermissionDenied) encodeFields(e *jx.Encoder) {
+func (s *ErrorInvalidCredentials) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorPermissionDenied = [1]string{
+var jsonFieldsNameOfErrorInvalidCredentials = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorPermissionDenied from json.
-func (s *ErrorPermissionDenied) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorInvalidCredentials from json.
+func (s *ErrorInvalidCredentials) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorPermissionDenied to nil")
+		return errors.New("invalid: unable to decode ErrorInvalidCredentials to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2664,7 +3467,7 @@ func (s *ErrorPermissionDenied) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorPermissionDenied")
+		return errors.Wrap(err, "decode ErrorInvalidCredentials")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2681,8 +3484,8 @@ func (s *ErrorPermissionDenied) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorPermissionDenied) {
-					name = jsonFieldsNameOfErrorPermissionDenied[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorInvalidCredentials) {
+					name = jsonFieldsNameOfErrorInvalidCredentials[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2703,27 +3506,27 @@ func (s *ErrorPermissionDenied) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorPermissionDenied) MarshalJSON() ([]byte, error) {
+func (s *ErrorInvalidCredentials) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorPermissionDenied) UnmarshalJSON(data []byte) error {
+func (s *ErrorInvalidCredentials) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorPermissionDeniedError) Encode(e *jx.Encoder) {
+func (s *ErrorInvalidCredentialsError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorPermissionDeniedError) encodeFields(e *jx.Encoder) {
+func (s *ErrorInvalidCredentialsError) encodeFields(e *jx.Encoder) {
 	{
 		if s.Message.Set {
 			e.FieldStart("message")
@@ -2732,14 +3535,14 @@ func (s *ErrorPermissionDeniedError) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfErrorPermissionDeniedError = [1]string{
+var jsonFieldsNameOfErrorInvalidCredentialsError = [1]string{
 	0: "message",
 }
 
-// Decode decodes ErrorPermissionDeniedError from json.
-func (s *ErrorPermissionDeniedError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorInvalidCredentialsError from json.
+func (s *ErrorInvalidCredentialsError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorPermissionDeniedError to nil")
+		return errors.New("invalid: unable to decode ErrorInvalidCredentialsError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -2759,48 +3562,48 @@ func (s *ErrorPermissionDeniedError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorPermissionDeniedError")
+		return errors.Wrap(err, "decode ErrorInvalidCredentialsError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorPermissionDeniedError) MarshalJSON() ([]byte, error) {
+func (s *ErrorInvalidCredentialsError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorPermissionDeniedError) UnmarshalJSON(data []byte) error {
+func (s *ErrorInvalidCredentialsError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorTooManyRequests) Encode(e *jx.Encoder) {
+func (s *ErrorNotFound) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorTooManyRequests) encodeFields(e *jx.Encoder) {
+func (s *ErrorNotFound) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorTooManyRequests = [1]string{
+var jsonFieldsNameOfErrorNotFound = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorTooManyRequests from json.
-func (s *ErrorTooManyRequests) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorNotFound from json.
+func (s *ErrorNotFound) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorTooManyRequests to nil")
+		return errors.New("invalid: unable to decode ErrorNotFound to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2821,7 +3624,7 @@ func (s *ErrorTooManyRequests) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorTooManyRequests")
+		return errors.Wrap(err, "decode ErrorNotFound")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2838,8 +3641,8 @@ func (s *ErrorTooManyRequests) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorTooManyRequests) {
-					name = jsonFieldsNameOfErrorTooManyRequests[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorNotFound) {
+					name = jsonFieldsNameOfErrorNotFound[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2860,27 +3663,27 @@ func (s *ErrorTooManyRequests) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorTooManyRequests) MarshalJSON() ([]byte, error) {
+func (s *ErrorNotFound) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorTooManyRequests) UnmarshalJSON(data []byte) error {
+func (s *ErrorNotFound) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorTooManyRequestsError) Encode(e *jx.Encoder) {
+func (s *ErrorNotFoundError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorTooManyRequestsError) encodeFields(e *jx.Encoder) {
+func (s *ErrorNotFoundError) encodeFields(e *jx.Encoder) {
 	{
 		if s.Message.Set {
 			e.FieldStart("message")
@@ -2889,14 +3692,14 @@ func (s *ErrorTooManyRequestsError) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfErrorTooManyRequestsError = [1]string{
+var jsonFieldsNameOfErrorNotFoundError = [1]string{
 	0: "message",
 }
 
-// Decode decodes ErrorTooManyRequestsError from json.
-func (s *ErrorTooManyRequestsError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorNotFoundError from json.
+func (s *ErrorNotFoundError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorTooManyRequestsError to nil")
+		return errors.New("invalid: unable to decode ErrorNotFoundError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -2916,48 +3719,48 @@ func (s *ErrorTooManyRequestsError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorTooManyRequestsError")
+		return errors.Wrap(err, "decode ErrorNotFoundError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorTooManyRequestsError) MarshalJSON() ([]byte, error) {
+func (s *ErrorNotFoundError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorTooManyRequestsError) UnmarshalJSON(data []byte) error {
+func (s *ErrorNotFoundError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorUnauthorized) Encode(e *jx.Encoder) {
+func (s *ErrorPermissionDenied) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorUnauthorized) encodeFields(e *jx.Encoder) {
+func (s *ErrorPermissionDenied) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("error")
 		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfErrorUnauthorized = [1]string{
+var jsonFieldsNameOfErrorPermissionDenied = [1]string{
 	0: "error",
 }
 
-// Decode decodes ErrorUnauthorized from json.
-func (s *ErrorUnauthorized) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorPermissionDenied from json.
+func (s *ErrorPermissionDenied) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorUnauthorized to nil")
+		return errors.New("invalid: unable to decode ErrorPermissionDenied to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -2978,7 +3781,7 @@ func (s *ErrorUnauthorized) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorUnauthorized")
+		return errors.Wrap(err, "decode ErrorPermissionDenied")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2995,8 +3798,8 @@ func (s *ErrorUnauthorized) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfErrorUnauthorized) {
-					name = jsonFieldsNameOfErrorUnauthorized[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorPermissionDenied) {
+					name = jsonFieldsNameOfErrorPermissionDenied[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3017,27 +3820,27 @@ func (s *ErrorUnauthorized) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorUnauthorized) MarshalJSON() ([]byte, error) {
+func (s *ErrorPermissionDenied) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorUnauthorized) UnmarshalJSON(data []byte) error {
+func (s *ErrorPermissionDenied) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ErrorUnauthorizedError) Encode(e *jx.Encoder) {
+func (s *ErrorPermissionDeniedError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ErrorUnauthorizedError) encodeFields(e *jx.Encoder) {
+func (s *ErrorPermissionDeniedError) encodeFields(e *jx.Encoder) {
 	{
 		if s.Message.Set {
 			e.FieldStart("message")
@@ -3046,14 +3849,14 @@ func (s *ErrorUnauthorizedError) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfErrorUnauthorizedError = [1]string{
+var jsonFieldsNameOfErrorPermissionDeniedError = [1]string{
 	0: "message",
 }
 
-// Decode decodes ErrorUnauthorizedError from json.
-func (s *ErrorUnauthorizedError) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorPermissionDeniedError from json.
+func (s *ErrorPermissionDeniedError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ErrorUnauthorizedError to nil")
+		return errors.New("invalid: unable to decode ErrorPermissionDeniedError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -3073,71 +3876,69 @@ func (s *ErrorUnauthorizedError) Decode(d *jx.Decoder) error {
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ErrorUnauthorizedError")
+		return errors.Wrap(err, "decode ErrorPermissionDeniedError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ErrorUnauthorizedError) MarshalJSON() ([]byte, error) {
+func (s *ErrorPermissionDeniedError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ErrorUnauthorizedError) UnmarshalJSON(data []byte) error {
+func (s *ErrorPermissionDeniedError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ForgotPasswordRequest) Encode(e *jx.Encoder) {
+func (s *ErrorTooManyRequests) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ForgotPasswordRequest) encodeFields(e *jx.Encoder) {
+func (s *ErrorTooManyRequests) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("email")
-		e.Str(s.Email)
+		e.FieldStart("error")
+		s.Error.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfForgotPasswordRequest = [1]string{
-	0: "email",
+var jsonFieldsNameOfErrorTooManyRequests = [1]string{
+	0: "error",
 }
 
-// Decode decodes ForgotPasswordRequest from json.
-func (s *ForgotPasswordRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ErrorTooManyRequests from json.
+func (s *ErrorTooManyRequests) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ForgotPasswordRequest to nil")
+		return errors.New("invalid: unable to decode ErrorTooManyRequests to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "email":
+		case "error":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Email = string(v)
-				if err != nil {
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ForgotPasswordRequest")
+		return errors.Wrap(err, "decode ErrorTooManyRequests")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -3154,8 +3955,8 @@ func (s *ForgotPasswordRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfForgotPasswordRequest) {
-					name = jsonFieldsNameOfForgotPasswordRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorTooManyRequests) {
+					name = jsonFieldsNameOfErrorTooManyRequests[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3176,335 +3977,130 @@ func (s *ForgotPasswordRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ForgotPasswordRequest) MarshalJSON() ([]byte, error) {
+func (s *ErrorTooManyRequests) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ForgotPasswordRequest) UnmarshalJSON(data []byte) error {
+func (s *ErrorTooManyRequests) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetProjectReleasesAnalyticsOKApplicationJSON as json.
-func (s GetProjectReleasesAnalyticsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []ReleaseAnalyticsSummary(s)
+// Encode implements json.Marshaler.
+func (s *ErrorTooManyRequestsError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *ErrorTooManyRequestsError) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
 	}
-	e.ArrEnd()
 }
 
-// Decode decodes GetProjectReleasesAnalyticsOKApplicationJSON from json.
-func (s *GetProjectReleasesAnalyticsOKApplicationJSON) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfErrorTooManyRequestsError = [1]string{
+	0: "message",
+}
+
+// Decode decodes ErrorTooManyRequestsError from json.
+func (s *ErrorTooManyRequestsError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetProjectReleasesAnalyticsOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode ErrorTooManyRequestsError to nil")
 	}
-	var unwrapped []ReleaseAnalyticsSummary
-	if err := func() error {
-		unwrapped = make([]ReleaseAnalyticsSummary, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem ReleaseAnalyticsSummary
-			if err := elem.Decode(d); err != nil {
-				return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
 			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorTooManyRequestsError")
 	}
-	*s = GetProjectReleasesAnalyticsOKApplicationJSON(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s GetProjectReleasesAnalyticsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *ErrorTooManyRequestsError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetProjectReleasesAnalyticsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *ErrorTooManyRequestsError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Issue) Encode(e *jx.Encoder) {
+func (s *ErrorUnauthorized) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Issue) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.UInt(s.ID)
-	}
-	{
-		e.FieldStart("project_id")
-		e.UInt(s.ProjectID)
-	}
+func (s *ErrorUnauthorized) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("source")
-		s.Source.Encode(e)
+		e.FieldStart("error")
+		s.Error.Encode(e)
 	}
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
+}
+
+var jsonFieldsNameOfErrorUnauthorized = [1]string{
+	0: "error",
+}
+
+// Decode decodes ErrorUnauthorized from json.
+func (s *ErrorUnauthorized) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErrorUnauthorized to nil")
 	}
-	{
-		e.FieldStart("project_name")
-		e.Str(s.ProjectName)
-	}
-	{
-		e.FieldStart("title")
-		e.Str(s.Title)
-	}
-	{
-		e.FieldStart("message")
-		e.Str(s.Message)
-	}
-	{
-		e.FieldStart("level")
-		s.Level.Encode(e)
-	}
-	{
-		e.FieldStart("platform")
-		e.Str(s.Platform)
-	}
-	{
-		e.FieldStart("count")
-		e.UInt(s.Count)
-	}
-	{
-		e.FieldStart("first_seen")
-		json.EncodeDateTime(e, s.FirstSeen)
-	}
-	{
-		e.FieldStart("last_seen")
-		json.EncodeDateTime(e, s.LastSeen)
-	}
-	{
-		if s.ResolvedAt.Set {
-			e.FieldStart("resolved_at")
-			s.ResolvedAt.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.ResolvedBy.Set {
-			e.FieldStart("resolved_by")
-			s.ResolvedBy.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfIssue = [14]string{
-	0:  "id",
-	1:  "project_id",
-	2:  "source",
-	3:  "status",
-	4:  "project_name",
-	5:  "title",
-	6:  "message",
-	7:  "level",
-	8:  "platform",
-	9:  "count",
-	10: "first_seen",
-	11: "last_seen",
-	12: "resolved_at",
-	13: "resolved_by",
-}
-
-// Decode decodes Issue from json.
-func (s *Issue) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Issue to nil")
-	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "error":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.UInt()
-				s.ID = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "project_id":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.UInt()
-				s.ProjectID = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"project_id\"")
-			}
-		case "source":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Source.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "project_name":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.ProjectName = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"project_name\"")
-			}
-		case "title":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.Title = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
-			}
-		case "message":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
-			}
-		case "level":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				if err := s.Level.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"level\"")
-			}
-		case "platform":
-			requiredBitSet[1] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Platform = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"platform\"")
-			}
-		case "count":
-			requiredBitSet[1] |= 1 << 1
-			if err := func() error {
-				v, err := d.UInt()
-				s.Count = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"count\"")
-			}
-		case "first_seen":
-			requiredBitSet[1] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.FirstSeen = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"first_seen\"")
-			}
-		case "last_seen":
-			requiredBitSet[1] |= 1 << 3
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.LastSeen = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_seen\"")
-			}
-		case "resolved_at":
-			if err := func() error {
-				s.ResolvedAt.Reset()
-				if err := s.ResolvedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"resolved_at\"")
-			}
-		case "resolved_by":
-			if err := func() error {
-				s.ResolvedBy.Reset()
-				if err := s.ResolvedBy.Decode(d); err != nil {
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"resolved_by\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Issue")
+		return errors.Wrap(err, "decode ErrorUnauthorized")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00001111,
+	for i, mask := range [1]uint8{
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3516,8 +4112,8 @@ func (s *Issue) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIssue) {
-					name = jsonFieldsNameOfIssue[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfErrorUnauthorized) {
+					name = jsonFieldsNameOfErrorUnauthorized[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3538,613 +4134,878 @@ func (s *Issue) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Issue) MarshalJSON() ([]byte, error) {
+func (s *ErrorUnauthorized) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Issue) UnmarshalJSON(data []byte) error {
+func (s *ErrorUnauthorized) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *IssueEvent) Encode(e *jx.Encoder) {
+func (s *ErrorUnauthorizedError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *IssueEvent) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("event_id")
-		e.Str(s.EventID)
-	}
-	{
-		e.FieldStart("timestamp")
-		json.EncodeDateTime(e, s.Timestamp)
-	}
+func (s *ErrorUnauthorizedError) encodeFields(e *jx.Encoder) {
 	{
-		if s.GroupHash.Set {
-			e.FieldStart("group_hash")
-			s.GroupHash.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
 		}
 	}
-	{
-		e.FieldStart("project_id")
-		e.UInt(s.ProjectID)
+}
+
+var jsonFieldsNameOfErrorUnauthorizedError = [1]string{
+	0: "message",
+}
+
+// Decode decodes ErrorUnauthorizedError from json.
+func (s *ErrorUnauthorizedError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ErrorUnauthorizedError to nil")
 	}
-	{
-		e.FieldStart("level")
-		s.Level.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ErrorUnauthorizedError")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ErrorUnauthorizedError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ErrorUnauthorizedError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventBreadcrumb) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventBreadcrumb) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("source")
-		s.Source.Encode(e)
+		if s.Timestamp.Set {
+			e.FieldStart("timestamp")
+			s.Timestamp.Encode(e, json.EncodeDateTime)
+		}
 	}
 	{
-		e.FieldStart("platform")
-		e.Str(s.Platform)
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		if s.Category.Set {
+			e.FieldStart("category")
+			s.Category.Encode(e)
+		}
 	}
 	{
-		if s.Payload.Set {
-			e.FieldStart("payload")
-			s.Payload.Encode(e)
+		if s.Level.Set {
+			e.FieldStart("level")
+			s.Level.Encode(e)
 		}
 	}
 	{
-		if s.Tags.Set {
-			e.FieldStart("tags")
-			s.Tags.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.ServerName.Set {
-			e.FieldStart("server_name")
-			s.ServerName.Encode(e)
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
 		}
 	}
-	{
-		if s.Environment.Set {
-			e.FieldStart("environment")
-			s.Environment.Encode(e)
+}
+
+var jsonFieldsNameOfEventBreadcrumb = [6]string{
+	0: "timestamp",
+	1: "type",
+	2: "category",
+	3: "level",
+	4: "message",
+	5: "data",
+}
+
+// Decode decodes EventBreadcrumb from json.
+func (s *EventBreadcrumb) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventBreadcrumb to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "timestamp":
+			if err := func() error {
+				s.Timestamp.Reset()
+				if err := s.Timestamp.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timestamp\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "category":
+			if err := func() error {
+				s.Category.Reset()
+				if err := s.Category.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category\"")
+			}
+		case "level":
+			if err := func() error {
+				s.Level.Reset()
+				if err := s.Level.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"level\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "data":
+			if err := func() error {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventBreadcrumb")
 	}
-	{
-		if s.Release.Set {
-			e.FieldStart("release")
-			s.Release.Encode(e)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventBreadcrumb) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventBreadcrumb) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s EventBreadcrumbData) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s EventBreadcrumbData) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
 		}
 	}
-	{
-		if s.ExceptionType.Set {
-			e.FieldStart("exception_type")
-			s.ExceptionType.Encode(e)
+}
+
+// Decode decodes EventBreadcrumbData from json.
+func (s *EventBreadcrumbData) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventBreadcrumbData to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventBreadcrumbData")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventBreadcrumbData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventBreadcrumbData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventDetailsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventDetailsResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.ExceptionValue.Set {
-			e.FieldStart("exception_value")
-			s.ExceptionValue.Encode(e)
-		}
+		e.FieldStart("event")
+		s.Event.Encode(e)
 	}
 	{
-		if s.ExceptionStacktrace.Set {
-			e.FieldStart("exception_stacktrace")
-			s.ExceptionStacktrace.Encode(e)
+		if s.IssueID.Set {
+			e.FieldStart("issue_id")
+			s.IssueID.Encode(e)
 		}
 	}
 	{
-		if s.RequestURL.Set {
-			e.FieldStart("request_url")
-			s.RequestURL.Encode(e)
+		if s.PreviousEventID.Set {
+			e.FieldStart("previous_event_id")
+			s.PreviousEventID.Encode(e)
 		}
 	}
 	{
-		if s.RequestMethod.Set {
-			e.FieldStart("request_method")
-			s.RequestMethod.Encode(e)
+		if s.NextEventID.Set {
+			e.FieldStart("next_event_id")
+			s.NextEventID.Encode(e)
 		}
 	}
 	{
-		if s.RequestQuery.Set {
-			e.FieldStart("request_query")
-			s.RequestQuery.Encode(e)
+		e.FieldStart("breadcrumbs")
+		e.ArrStart()
+		for _, elem := range s.Breadcrumbs {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 	{
-		if s.RequestHeaders.Set {
-			e.FieldStart("request_headers")
-			s.RequestHeaders.Encode(e)
-		}
+		e.FieldStart("contexts")
+		s.Contexts.Encode(e)
 	}
 	{
-		if s.RequestData.Set {
-			e.FieldStart("request_data")
-			s.RequestData.Encode(e)
-		}
+		e.FieldStart("extra")
+		s.Extra.Encode(e)
 	}
 	{
-		if s.RequestCookies.Set {
-			e.FieldStart("request_cookies")
-			s.RequestCookies.Encode(e)
+		if s.Sdk.Set {
+			e.FieldStart("sdk")
+			s.Sdk.Encode(e)
 		}
 	}
 	{
-		if s.RequestIP.Set {
-			e.FieldStart("request_ip")
-			s.RequestIP.Encode(e)
-		}
-	}
-	{
-		if s.UserAgent.Set {
-			e.FieldStart("user_agent")
-			s.UserAgent.Encode(e)
-		}
-	}
-	{
-		if s.UserID.Set {
-			e.FieldStart("user_id")
-			s.UserID.Encode(e)
-		}
-	}
-	{
-		if s.UserEmail.Set {
-			e.FieldStart("user_email")
-			s.UserEmail.Encode(e)
-		}
-	}
-	{
-		if s.RuntimeName.Set {
-			e.FieldStart("runtime_name")
-			s.RuntimeName.Encode(e)
-		}
-	}
-	{
-		if s.RuntimeVersion.Set {
-			e.FieldStart("runtime_version")
-			s.RuntimeVersion.Encode(e)
-		}
-	}
-	{
-		if s.OsName.Set {
-			e.FieldStart("os_name")
-			s.OsName.Encode(e)
-		}
-	}
-	{
-		if s.OsVersion.Set {
-			e.FieldStart("os_version")
-			s.OsVersion.Encode(e)
-		}
-	}
-	{
-		if s.BrowserName.Set {
-			e.FieldStart("browser_name")
-			s.BrowserName.Encode(e)
-		}
-	}
-	{
-		if s.BrowserVersion.Set {
-			e.FieldStart("browser_version")
-			s.BrowserVersion.Encode(e)
-		}
-	}
-	{
-		if s.DeviceArch.Set {
-			e.FieldStart("device_arch")
-			s.DeviceArch.Encode(e)
+		e.FieldStart("exceptions")
+		e.ArrStart()
+		for _, elem := range s.Exceptions {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfIssueEvent = [33]string{
-	0:  "event_id",
-	1:  "timestamp",
-	2:  "group_hash",
-	3:  "project_id",
-	4:  "level",
-	5:  "source",
-	6:  "platform",
-	7:  "message",
-	8:  "payload",
-	9:  "tags",
-	10: "server_name",
-	11: "environment",
-	12: "release",
-	13: "exception_type",
-	14: "exception_value",
-	15: "exception_stacktrace",
-	16: "request_url",
-	17: "request_method",
-	18: "request_query",
-	19: "request_headers",
-	20: "request_data",
-	21: "request_cookies",
-	22: "request_ip",
-	23: "user_agent",
-	24: "user_id",
-	25: "user_email",
-	26: "runtime_name",
-	27: "runtime_version",
-	28: "os_name",
-	29: "os_version",
-	30: "browser_name",
-	31: "browser_version",
-	32: "device_arch",
+var jsonFieldsNameOfEventDetailsResponse = [9]string{
+	0: "event",
+	1: "issue_id",
+	2: "previous_event_id",
+	3: "next_event_id",
+	4: "breadcrumbs",
+	5: "contexts",
+	6: "extra",
+	7: "sdk",
+	8: "exceptions",
 }
 
-// Decode decodes IssueEvent from json.
-func (s *IssueEvent) Decode(d *jx.Decoder) error {
+// Decode decodes EventDetailsResponse from json.
+func (s *EventDetailsResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IssueEvent to nil")
+		return errors.New("invalid: unable to decode EventDetailsResponse to nil")
 	}
-	var requiredBitSet [5]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "event_id":
+		case "event":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.EventID = string(v)
-				if err != nil {
+				if err := s.Event.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_id\"")
+				return errors.Wrap(err, "decode field \"event\"")
 			}
-		case "timestamp":
-			requiredBitSet[0] |= 1 << 1
+		case "issue_id":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.Timestamp = v
-				if err != nil {
+				s.IssueID.Reset()
+				if err := s.IssueID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"timestamp\"")
+				return errors.Wrap(err, "decode field \"issue_id\"")
 			}
-		case "group_hash":
+		case "previous_event_id":
 			if err := func() error {
-				s.GroupHash.Reset()
-				if err := s.GroupHash.Decode(d); err != nil {
+				s.PreviousEventID.Reset()
+				if err := s.PreviousEventID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"group_hash\"")
+				return errors.Wrap(err, "decode field \"previous_event_id\"")
 			}
-		case "project_id":
-			requiredBitSet[0] |= 1 << 3
+		case "next_event_id":
 			if err := func() error {
-				v, err := d.UInt()
-				s.ProjectID = uint(v)
-				if err != nil {
+				s.NextEventID.Reset()
+				if err := s.NextEventID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"project_id\"")
+				return errors.Wrap(err, "decode field \"next_event_id\"")
 			}
-		case "level":
+		case "breadcrumbs":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				if err := s.Level.Decode(d); err != nil {
+				s.Breadcrumbs = make([]EventBreadcrumb, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EventBreadcrumb
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Breadcrumbs = append(s.Breadcrumbs, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"level\"")
+				return errors.Wrap(err, "decode field \"breadcrumbs\"")
 			}
-		case "source":
+		case "contexts":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
-				if err := s.Source.Decode(d); err != nil {
+				if err := s.Contexts.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
+				return errors.Wrap(err, "decode field \"contexts\"")
 			}
-		case "platform":
+		case "extra":
 			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := d.Str()
-				s.Platform = string(v)
-				if err != nil {
+				if err := s.Extra.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"platform\"")
+				return errors.Wrap(err, "decode field \"extra\"")
 			}
-		case "message":
-			requiredBitSet[0] |= 1 << 7
+		case "sdk":
 			if err := func() error {
-				v, err := d.Str()
-				s.Message = string(v)
-				if err != nil {
+				s.Sdk.Reset()
+				if err := s.Sdk.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"sdk\"")
 			}
-		case "payload":
+		case "exceptions":
+			requiredBitSet[1] |= 1 << 0
 			if err := func() error {
-				s.Payload.Reset()
-				if err := s.Payload.Decode(d); err != nil {
+				s.Exceptions = make([]EventException, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EventException
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Exceptions = append(s.Exceptions, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"payload\"")
+				return errors.Wrap(err, "decode field \"exceptions\"")
 			}
-		case "tags":
-			if err := func() error {
-				s.Tags.Reset()
-				if err := s.Tags.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventDetailsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01110001,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEventDetailsResponse) {
+					name = jsonFieldsNameOfEventDetailsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tags\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "server_name":
-			if err := func() error {
-				s.ServerName.Reset()
-				if err := s.ServerName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"server_name\"")
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventDetailsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventDetailsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s EventDetailsResponseContexts) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s EventDetailsResponseContexts) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes EventDetailsResponseContexts from json.
+func (s *EventDetailsResponseContexts) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventDetailsResponseContexts to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		case "environment":
-			if err := func() error {
-				s.Environment.Reset()
-				if err := s.Environment.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"environment\"")
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventDetailsResponseContexts")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventDetailsResponseContexts) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventDetailsResponseContexts) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s EventDetailsResponseExtra) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s EventDetailsResponseExtra) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes EventDetailsResponseExtra from json.
+func (s *EventDetailsResponseExtra) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventDetailsResponseExtra to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
 			}
-		case "release":
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventDetailsResponseExtra")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s EventDetailsResponseExtra) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventDetailsResponseExtra) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventException) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventException) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		e.FieldStart("value")
+		e.Str(s.Value)
+	}
+	{
+		if s.Stacktrace.Set {
+			e.FieldStart("stacktrace")
+			s.Stacktrace.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfEventException = [3]string{
+	0: "type",
+	1: "value",
+	2: "stacktrace",
+}
+
+// Decode decodes EventException from json.
+func (s *EventException) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventException to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "type":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Release.Reset()
-				if err := s.Release.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"release\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "exception_type":
+		case "value":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.ExceptionType.Reset()
-				if err := s.ExceptionType.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Value = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"exception_type\"")
+				return errors.Wrap(err, "decode field \"value\"")
 			}
-		case "exception_value":
+		case "stacktrace":
 			if err := func() error {
-				s.ExceptionValue.Reset()
-				if err := s.ExceptionValue.Decode(d); err != nil {
+				s.Stacktrace.Reset()
+				if err := s.Stacktrace.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"exception_value\"")
+				return errors.Wrap(err, "decode field \"stacktrace\"")
 			}
-		case "exception_stacktrace":
-			if err := func() error {
-				s.ExceptionStacktrace.Reset()
-				if err := s.ExceptionStacktrace.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventException")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfEventException) {
+					name = jsonFieldsNameOfEventException[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"exception_stacktrace\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		case "request_url":
-			if err := func() error {
-				s.RequestURL.Reset()
-				if err := s.RequestURL.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_url\"")
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventException) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventException) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventSDK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventSDK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("version")
+		e.Str(s.Version)
+	}
+	{
+		if s.Integrations != nil {
+			e.FieldStart("integrations")
+			e.ArrStart()
+			for _, elem := range s.Integrations {
+				e.Str(elem)
 			}
-		case "request_method":
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfEventSDK = [3]string{
+	0: "name",
+	1: "version",
+	2: "integrations",
+}
+
+// Decode decodes EventSDK from json.
+func (s *EventSDK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventSDK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.RequestMethod.Reset()
-				if err := s.RequestMethod.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_method\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "request_query":
+		case "version":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.RequestQuery.Reset()
-				if err := s.RequestQuery.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Version = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_query\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
-		case "request_headers":
+		case "integrations":
 			if err := func() error {
-				s.RequestHeaders.Reset()
-				if err := s.RequestHeaders.Decode(d); err != nil {
+				s.Integrations = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Integrations = append(s.Integrations, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_headers\"")
-			}
-		case "request_data":
-			if err := func() error {
-				s.RequestData.Reset()
-				if err := s.RequestData.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_data\"")
-			}
-		case "request_cookies":
-			if err := func() error {
-				s.RequestCookies.Reset()
-				if err := s.RequestCookies.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_cookies\"")
-			}
-		case "request_ip":
-			if err := func() error {
-				s.RequestIP.Reset()
-				if err := s.RequestIP.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"request_ip\"")
-			}
-		case "user_agent":
-			if err := func() error {
-				s.UserAgent.Reset()
-				if err := s.UserAgent.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_agent\"")
-			}
-		case "user_id":
-			if err := func() error {
-				s.UserID.Reset()
-				if err := s.UserID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_id\"")
-			}
-		case "user_email":
-			if err := func() error {
-				s.UserEmail.Reset()
-				if err := s.UserEmail.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_email\"")
-			}
-		case "runtime_name":
-			if err := func() error {
-				s.RuntimeName.Reset()
-				if err := s.RuntimeName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"runtime_name\"")
-			}
-		case "runtime_version":
-			if err := func() error {
-				s.RuntimeVersion.Reset()
-				if err := s.RuntimeVersion.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"runtime_version\"")
-			}
-		case "os_name":
-			if err := func() error {
-				s.OsName.Reset()
-				if err := s.OsName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"os_name\"")
-			}
-		case "os_version":
-			if err := func() error {
-				s.OsVersion.Reset()
-				if err := s.OsVersion.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"os_version\"")
-			}
-		case "browser_name":
-			if err := func() error {
-				s.BrowserName.Reset()
-				if err := s.BrowserName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"browser_name\"")
-			}
-		case "browser_version":
-			if err := func() error {
-				s.BrowserVersion.Reset()
-				if err := s.BrowserVersion.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"browser_version\"")
-			}
-		case "device_arch":
-			if err := func() error {
-				s.DeviceArch.Reset()
-				if err := s.DeviceArch.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"device_arch\"")
+				return errors.Wrap(err, "decode field \"integrations\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IssueEvent")
+		return errors.Wrap(err, "decode EventSDK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [5]uint8{
-		0b11111011,
-		0b00000000,
-		0b00000000,
-		0b00000000,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4156,8 +5017,8 @@ func (s *IssueEvent) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIssueEvent) {
-					name = jsonFieldsNameOfIssueEvent[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfEventSDK) {
+					name = jsonFieldsNameOfEventSDK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4178,327 +5039,240 @@ func (s *IssueEvent) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IssueEvent) MarshalJSON() ([]byte, error) {
+func (s *EventSDK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueEvent) UnmarshalJSON(data []byte) error {
+func (s *EventSDK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s IssueEventPayload) Encode(e *jx.Encoder) {
+func (s *ForgotPasswordRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s IssueEventPayload) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// encodeFields encodes fields.
+func (s *ForgotPasswordRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
 	}
 }
 
-// Decode decodes IssueEventPayload from json.
-func (s *IssueEventPayload) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfForgotPasswordRequest = [1]string{
+	0: "email",
+}
+
+// Decode decodes ForgotPasswordRequest from json.
+func (s *ForgotPasswordRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IssueEventPayload to nil")
+		return errors.New("invalid: unable to decode ForgotPasswordRequest to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IssueEventPayload")
+		return errors.Wrap(err, "decode ForgotPasswordRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfForgotPasswordRequest) {
+					name = jsonFieldsNameOfForgotPasswordRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s IssueEventPayload) MarshalJSON() ([]byte, error) {
+func (s *ForgotPasswordRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueEventPayload) UnmarshalJSON(data []byte) error {
+func (s *ForgotPasswordRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s IssueEventRequestHeaders) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s IssueEventRequestHeaders) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
+// Encode encodes GetProjectReleasesAnalyticsOKApplicationJSON as json.
+func (s GetProjectReleasesAnalyticsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []ReleaseAnalyticsSummary(s)
 
-		e.Str(elem)
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
 }
 
-// Decode decodes IssueEventRequestHeaders from json.
-func (s *IssueEventRequestHeaders) Decode(d *jx.Decoder) error {
+// Decode decodes GetProjectReleasesAnalyticsOKApplicationJSON from json.
+func (s *GetProjectReleasesAnalyticsOKApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IssueEventRequestHeaders to nil")
+		return errors.New("invalid: unable to decode GetProjectReleasesAnalyticsOKApplicationJSON to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode IssueEventRequestHeaders")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s IssueEventRequestHeaders) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueEventRequestHeaders) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s IssueEventTags) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s IssueEventTags) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Str(elem)
-	}
-}
-
-// Decode decodes IssueEventTags from json.
-func (s *IssueEventTags) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode IssueEventTags to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
+	var unwrapped []ReleaseAnalyticsSummary
+	if err := func() error {
+		unwrapped = make([]ReleaseAnalyticsSummary, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem ReleaseAnalyticsSummary
+			if err := elem.Decode(d); err != nil {
 				return err
 			}
+			unwrapped = append(unwrapped, elem)
 			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		}); err != nil {
+			return err
 		}
-		m[string(k)] = elem
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode IssueEventTags")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s IssueEventTags) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueEventTags) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes IssueLevel as json.
-func (s IssueLevel) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes IssueLevel from json.
-func (s *IssueLevel) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode IssueLevel to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch IssueLevel(v) {
-	case IssueLevelFatal:
-		*s = IssueLevelFatal
-	case IssueLevelException:
-		*s = IssueLevelException
-	case IssueLevelError:
-		*s = IssueLevelError
-	case IssueLevelWarning:
-		*s = IssueLevelWarning
-	case IssueLevelInfo:
-		*s = IssueLevelInfo
-	case IssueLevelDebug:
-		*s = IssueLevelDebug
-	default:
-		*s = IssueLevel(v)
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = GetProjectReleasesAnalyticsOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s IssueLevel) MarshalJSON() ([]byte, error) {
+func (s GetProjectReleasesAnalyticsOKApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueLevel) UnmarshalJSON(data []byte) error {
+func (s *GetProjectReleasesAnalyticsOKApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *IssueResponse) Encode(e *jx.Encoder) {
+func (s *GroupingConfigResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *IssueResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("source")
-		s.Source.Encode(e)
-	}
+func (s *GroupingConfigResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("issue")
-		s.Issue.Encode(e)
+		e.FieldStart("strategy")
+		s.Strategy.Encode(e)
 	}
 	{
-		e.FieldStart("events")
+		e.FieldStart("available_strategies")
 		e.ArrStart()
-		for _, elem := range s.Events {
+		for _, elem := range s.AvailableStrategies {
 			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfIssueResponse = [3]string{
-	0: "source",
-	1: "issue",
-	2: "events",
+var jsonFieldsNameOfGroupingConfigResponse = [2]string{
+	0: "strategy",
+	1: "available_strategies",
 }
 
-// Decode decodes IssueResponse from json.
-func (s *IssueResponse) Decode(d *jx.Decoder) error {
+// Decode decodes GroupingConfigResponse from json.
+func (s *GroupingConfigResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IssueResponse to nil")
+		return errors.New("invalid: unable to decode GroupingConfigResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "source":
+		case "strategy":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Source.Decode(d); err != nil {
+				if err := s.Strategy.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"source\"")
+				return errors.Wrap(err, "decode field \"strategy\"")
 			}
-		case "issue":
+		case "available_strategies":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Issue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"issue\"")
-			}
-		case "events":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				s.Events = make([]IssueEvent, 0)
+				s.AvailableStrategies = make([]GroupingStrategy, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem IssueEvent
+					var elem GroupingStrategy
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Events = append(s.Events, elem)
+					s.AvailableStrategies = append(s.AvailableStrategies, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"events\"")
+				return errors.Wrap(err, "decode field \"available_strategies\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IssueResponse")
+		return errors.Wrap(err, "decode GroupingConfigResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4510,8 +5284,8 @@ func (s *IssueResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIssueResponse) {
-					name = jsonFieldsNameOfIssueResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGroupingConfigResponse) {
+					name = jsonFieldsNameOfGroupingConfigResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4532,109 +5306,27 @@ func (s *IssueResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IssueResponse) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueResponse) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes IssueSource as json.
-func (s IssueSource) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes IssueSource from json.
-func (s *IssueSource) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode IssueSource to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch IssueSource(v) {
-	case IssueSourceEvent:
-		*s = IssueSourceEvent
-	case IssueSourceException:
-		*s = IssueSourceException
-	default:
-		*s = IssueSource(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s IssueSource) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueSource) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes IssueStatus as json.
-func (s IssueStatus) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes IssueStatus from json.
-func (s *IssueStatus) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode IssueStatus to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch IssueStatus(v) {
-	case IssueStatusResolved:
-		*s = IssueStatusResolved
-	case IssueStatusUnresolved:
-		*s = IssueStatusUnresolved
-	case IssueStatusIgnored:
-		*s = IssueStatusIgnored
-	default:
-		*s = IssueStatus(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s IssueStatus) MarshalJSON() ([]byte, error) {
+func (s *GroupingConfigResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueStatus) UnmarshalJSON(data []byte) error {
+func (s *GroupingConfigResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *IssueSummary) Encode(e *jx.Encoder) {
+func (s *GroupingRule) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *IssueSummary) encodeFields(e *jx.Encoder) {
+func (s *GroupingRule) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("id")
 		e.UInt(s.ID)
@@ -4644,36 +5336,36 @@ func (s *IssueSummary) encodeFields(e *jx.Encoder) {
 		e.UInt(s.ProjectID)
 	}
 	{
-		e.FieldStart("title")
-		e.Str(s.Title)
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		e.FieldStart("level")
-		s.Level.Encode(e)
+		e.FieldStart("expression")
+		e.Str(s.Expression)
 	}
 	{
-		e.FieldStart("count")
-		e.UInt(s.Count)
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		e.FieldStart("last_seen")
-		json.EncodeDateTime(e, s.LastSeen)
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
 	}
 }
 
-var jsonFieldsNameOfIssueSummary = [6]string{
+var jsonFieldsNameOfGroupingRule = [6]string{
 	0: "id",
 	1: "project_id",
-	2: "title",
-	3: "level",
-	4: "count",
-	5: "last_seen",
+	2: "type",
+	3: "expression",
+	4: "created_at",
+	5: "updated_at",
 }
 
-// Decode decodes IssueSummary from json.
-func (s *IssueSummary) Decode(d *jx.Decoder) error {
+// Decode decodes GroupingRule from json.
+func (s *GroupingRule) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode IssueSummary to nil")
+		return errors.New("invalid: unable to decode GroupingRule to nil")
 	}
 	var requiredBitSet [1]uint8
 
@@ -4703,58 +5395,58 @@ func (s *IssueSummary) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"project_id\"")
 			}
-		case "title":
+		case "type":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Title = string(v)
-				if err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "level":
+		case "expression":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				if err := s.Level.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Expression = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"level\"")
+				return errors.Wrap(err, "decode field \"expression\"")
 			}
-		case "count":
+		case "created_at":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				v, err := d.UInt()
-				s.Count = uint(v)
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"count\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "last_seen":
+		case "updated_at":
 			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := json.DecodeDateTime(d)
-				s.LastSeen = v
+				s.UpdatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"last_seen\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode IssueSummary")
+		return errors.Wrap(err, "decode GroupingRule")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4771,8 +5463,8 @@ func (s *IssueSummary) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfIssueSummary) {
-					name = jsonFieldsNameOfIssueSummary[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGroupingRule) {
+					name = jsonFieldsNameOfGroupingRule[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4793,79 +5485,149 @@ func (s *IssueSummary) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *IssueSummary) MarshalJSON() ([]byte, error) {
+func (s *GroupingRule) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *IssueSummary) UnmarshalJSON(data []byte) error {
+func (s *GroupingRule) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ListIssueSummariesResponse) Encode(e *jx.Encoder) {
+func (s *GroupingRulePreviewIssue) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ListIssueSummariesResponse) encodeFields(e *jx.Encoder) {
+func (s *GroupingRulePreviewIssue) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("issues")
+		if s.IssueID.Set {
+			e.FieldStart("issue_id")
+			s.IssueID.Encode(e)
+		}
+	}
+	{
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("fingerprint")
+		e.Str(s.Fingerprint)
+	}
+	{
+		e.FieldStart("new_fingerprints")
 		e.ArrStart()
-		for _, elem := range s.Issues {
-			elem.Encode(e)
+		for _, elem := range s.NewFingerprints {
+			e.Str(elem)
 		}
 		e.ArrEnd()
 	}
+	{
+		e.FieldStart("matched_events")
+		e.UInt(s.MatchedEvents)
+	}
 }
 
-var jsonFieldsNameOfListIssueSummariesResponse = [1]string{
-	0: "issues",
+var jsonFieldsNameOfGroupingRulePreviewIssue = [5]string{
+	0: "issue_id",
+	1: "title",
+	2: "fingerprint",
+	3: "new_fingerprints",
+	4: "matched_events",
 }
 
-// Decode decodes ListIssueSummariesResponse from json.
-func (s *ListIssueSummariesResponse) Decode(d *jx.Decoder) error {
+// Decode decodes GroupingRulePreviewIssue from json.
+func (s *GroupingRulePreviewIssue) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListIssueSummariesResponse to nil")
+		return errors.New("invalid: unable to decode GroupingRulePreviewIssue to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "issues":
-			requiredBitSet[0] |= 1 << 0
+		case "issue_id":
 			if err := func() error {
-				s.Issues = make([]IssueSummary, 0)
+				s.IssueID.Reset()
+				if err := s.IssueID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issue_id\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "fingerprint":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Fingerprint = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fingerprint\"")
+			}
+		case "new_fingerprints":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.NewFingerprints = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem IssueSummary
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.Issues = append(s.Issues, elem)
+					s.NewFingerprints = append(s.NewFingerprints, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"issues\"")
+				return errors.Wrap(err, "decode field \"new_fingerprints\"")
+			}
+		case "matched_events":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt()
+				s.MatchedEvents = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"matched_events\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ListIssueSummariesResponse")
+		return errors.Wrap(err, "decode GroupingRulePreviewIssue")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00011100,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4877,8 +5639,8 @@ func (s *ListIssueSummariesResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfListIssueSummariesResponse) {
-					name = jsonFieldsNameOfListIssueSummariesResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGroupingRulePreviewIssue) {
+					name = jsonFieldsNameOfGroupingRulePreviewIssue[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4899,27 +5661,35 @@ func (s *ListIssueSummariesResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListIssueSummariesResponse) MarshalJSON() ([]byte, error) {
+func (s *GroupingRulePreviewIssue) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListIssueSummariesResponse) UnmarshalJSON(data []byte) error {
+func (s *GroupingRulePreviewIssue) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ListIssuesResponse) Encode(e *jx.Encoder) {
+func (s *GroupingRulePreviewResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ListIssuesResponse) encodeFields(e *jx.Encoder) {
+func (s *GroupingRulePreviewResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("scanned_events")
+		e.UInt(s.ScannedEvents)
+	}
+	{
+		e.FieldStart("matched_events")
+		e.UInt(s.MatchedEvents)
+	}
 	{
 		e.FieldStart("issues")
 		e.ArrStart()
@@ -4928,101 +5698,76 @@ func (s *ListIssuesResponse) encodeFields(e *jx.Encoder) {
 		}
 		e.ArrEnd()
 	}
-	{
-		e.FieldStart("total")
-		e.UInt(s.Total)
-	}
-	{
-		e.FieldStart("page")
-		e.UInt(s.Page)
-	}
-	{
-		e.FieldStart("per_page")
-		e.UInt(s.PerPage)
-	}
 }
 
-var jsonFieldsNameOfListIssuesResponse = [4]string{
-	0: "issues",
-	1: "total",
-	2: "page",
-	3: "per_page",
+var jsonFieldsNameOfGroupingRulePreviewResponse = [3]string{
+	0: "scanned_events",
+	1: "matched_events",
+	2: "issues",
 }
 
-// Decode decodes ListIssuesResponse from json.
-func (s *ListIssuesResponse) Decode(d *jx.Decoder) error {
+// Decode decodes GroupingRulePreviewResponse from json.
+func (s *GroupingRulePreviewResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListIssuesResponse to nil")
+		return errors.New("invalid: unable to decode GroupingRulePreviewResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "issues":
+		case "scanned_events":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				s.Issues = make([]Issue, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Issue
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Issues = append(s.Issues, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"issues\"")
-			}
-		case "total":
-			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.UInt()
-				s.Total = uint(v)
+				s.ScannedEvents = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total\"")
+				return errors.Wrap(err, "decode field \"scanned_events\"")
 			}
-		case "page":
-			requiredBitSet[0] |= 1 << 2
+		case "matched_events":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.UInt()
-				s.Page = uint(v)
+				s.MatchedEvents = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"page\"")
+				return errors.Wrap(err, "decode field \"matched_events\"")
 			}
-		case "per_page":
-			requiredBitSet[0] |= 1 << 3
+		case "issues":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.UInt()
-				s.PerPage = uint(v)
-				if err != nil {
+				s.Issues = make([]GroupingRulePreviewIssue, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem GroupingRulePreviewIssue
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Issues = append(s.Issues, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"per_page\"")
+				return errors.Wrap(err, "decode field \"issues\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ListIssuesResponse")
+		return errors.Wrap(err, "decode GroupingRulePreviewResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5034,8 +5779,8 @@ func (s *ListIssuesResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfListIssuesResponse) {
-					name = jsonFieldsNameOfListIssuesResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGroupingRulePreviewResponse) {
+					name = jsonFieldsNameOfGroupingRulePreviewResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5056,79 +5801,84 @@ func (s *ListIssuesResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListIssuesResponse) MarshalJSON() ([]byte, error) {
+func (s *GroupingRulePreviewResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListIssuesResponse) UnmarshalJSON(data []byte) error {
+func (s *GroupingRulePreviewResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ListNotificationRulesResponse) Encode(e *jx.Encoder) {
+func (s *GroupingRuleRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ListNotificationRulesResponse) encodeFields(e *jx.Encoder) {
+func (s *GroupingRuleRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("notification_rules")
-		e.ArrStart()
-		for _, elem := range s.NotificationRules {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		e.FieldStart("expression")
+		e.Str(s.Expression)
 	}
 }
 
-var jsonFieldsNameOfListNotificationRulesResponse = [1]string{
-	0: "notification_rules",
+var jsonFieldsNameOfGroupingRuleRequest = [2]string{
+	0: "type",
+	1: "expression",
 }
 
-// Decode decodes ListNotificationRulesResponse from json.
-func (s *ListNotificationRulesResponse) Decode(d *jx.Decoder) error {
+// Decode decodes GroupingRuleRequest from json.
+func (s *GroupingRuleRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListNotificationRulesResponse to nil")
+		return errors.New("invalid: unable to decode GroupingRuleRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "notification_rules":
+		case "type":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.NotificationRules = make([]NotificationRule, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NotificationRule
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.NotificationRules = append(s.NotificationRules, elem)
-					return nil
-				}); err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notification_rules\"")
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "expression":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Expression = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expression\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ListNotificationRulesResponse")
+		return errors.Wrap(err, "decode GroupingRuleRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5140,8 +5890,8 @@ func (s *ListNotificationRulesResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfListNotificationRulesResponse) {
-					name = jsonFieldsNameOfListNotificationRulesResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfGroupingRuleRequest) {
+					name = jsonFieldsNameOfGroupingRuleRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5162,79 +5912,334 @@ func (s *ListNotificationRulesResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListNotificationRulesResponse) MarshalJSON() ([]byte, error) {
+func (s *GroupingRuleRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListNotificationRulesResponse) UnmarshalJSON(data []byte) error {
+func (s *GroupingRuleRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *ListNotificationSettingsResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes GroupingRuleType as json.
+func (s GroupingRuleType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// encodeFields encodes fields.
-func (s *ListNotificationSettingsResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("notification_settings")
+// Decode decodes GroupingRuleType from json.
+func (s *GroupingRuleType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GroupingRuleType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch GroupingRuleType(v) {
+	case GroupingRuleTypeFingerprint:
+		*s = GroupingRuleTypeFingerprint
+	case GroupingRuleTypeStacktrace:
+		*s = GroupingRuleTypeStacktrace
+	default:
+		*s = GroupingRuleType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GroupingRuleType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GroupingRuleType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GroupingStrategy as json.
+func (s GroupingStrategy) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes GroupingStrategy from json.
+func (s *GroupingStrategy) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GroupingStrategy to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch GroupingStrategy(v) {
+	case GroupingStrategyLegacy:
+		*s = GroupingStrategyLegacy
+	case GroupingStrategyStacktraceV1:
+		*s = GroupingStrategyStacktraceV1
+	default:
+		*s = GroupingStrategy(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GroupingStrategy) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GroupingStrategy) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *InboundFilters) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *InboundFilters) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("allowed_origins")
 		e.ArrStart()
-		for _, elem := range s.NotificationSettings {
-			elem.Encode(e)
+		for _, elem := range s.AllowedOrigins {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("filter_web_crawlers")
+		e.Bool(s.FilterWebCrawlers)
+	}
+	{
+		e.FieldStart("filter_legacy_browsers")
+		e.Bool(s.FilterLegacyBrowsers)
+	}
+	{
+		e.FieldStart("filter_localhost")
+		e.Bool(s.FilterLocalhost)
+	}
+	{
+		e.FieldStart("blocked_ip_ranges")
+		e.ArrStart()
+		for _, elem := range s.BlockedIPRanges {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("error_messages")
+		e.ArrStart()
+		for _, elem := range s.ErrorMessages {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("releases")
+		e.ArrStart()
+		for _, elem := range s.Releases {
+			e.Str(elem)
 		}
 		e.ArrEnd()
 	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
 }
 
-var jsonFieldsNameOfListNotificationSettingsResponse = [1]string{
-	0: "notification_settings",
+var jsonFieldsNameOfInboundFilters = [9]string{
+	0: "project_id",
+	1: "allowed_origins",
+	2: "filter_web_crawlers",
+	3: "filter_legacy_browsers",
+	4: "filter_localhost",
+	5: "blocked_ip_ranges",
+	6: "error_messages",
+	7: "releases",
+	8: "updated_at",
 }
 
-// Decode decodes ListNotificationSettingsResponse from json.
-func (s *ListNotificationSettingsResponse) Decode(d *jx.Decoder) error {
+// Decode decodes InboundFilters from json.
+func (s *InboundFilters) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ListNotificationSettingsResponse to nil")
+		return errors.New("invalid: unable to decode InboundFilters to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "notification_settings":
+		case "project_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.NotificationSettings = make([]NotificationSetting, 0)
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "allowed_origins":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.AllowedOrigins = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NotificationSetting
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.NotificationSettings = append(s.NotificationSettings, elem)
+					s.AllowedOrigins = append(s.AllowedOrigins, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notification_settings\"")
+				return errors.Wrap(err, "decode field \"allowed_origins\"")
+			}
+		case "filter_web_crawlers":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.FilterWebCrawlers = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filter_web_crawlers\"")
+			}
+		case "filter_legacy_browsers":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.FilterLegacyBrowsers = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filter_legacy_browsers\"")
+			}
+		case "filter_localhost":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.FilterLocalhost = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filter_localhost\"")
+			}
+		case "blocked_ip_ranges":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.BlockedIPRanges = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.BlockedIPRanges = append(s.BlockedIPRanges, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"blocked_ip_ranges\"")
+			}
+		case "error_messages":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.ErrorMessages = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.ErrorMessages = append(s.ErrorMessages, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error_messages\"")
+			}
+		case "releases":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				s.Releases = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Releases = append(s.Releases, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"releases\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ListNotificationSettingsResponse")
+		return errors.Wrap(err, "decode InboundFilters")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5246,8 +6251,8 @@ func (s *ListNotificationSettingsResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfListNotificationSettingsResponse) {
-					name = jsonFieldsNameOfListNotificationSettingsResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfInboundFilters) {
+					name = jsonFieldsNameOfInboundFilters[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5268,383 +6273,388 @@ func (s *ListNotificationSettingsResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ListNotificationSettingsResponse) MarshalJSON() ([]byte, error) {
+func (s *InboundFilters) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListNotificationSettingsResponse) UnmarshalJSON(data []byte) error {
+func (s *InboundFilters) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ListProjectsResponse as json.
-func (s ListProjectsResponse) Encode(e *jx.Encoder) {
-	unwrapped := []Project(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+// Encode implements json.Marshaler.
+func (s *Issue) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes ListProjectsResponse from json.
-func (s *ListProjectsResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListProjectsResponse to nil")
+// encodeFields encodes fields.
+func (s *Issue) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
 	}
-	var unwrapped []Project
-	if err := func() error {
-		unwrapped = make([]Project, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Project
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
 	}
-	*s = ListProjectsResponse(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListProjectsResponse) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListProjectsResponse) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ListTeamsResponse as json.
-func (s ListTeamsResponse) Encode(e *jx.Encoder) {
-	unwrapped := []Team(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+	{
+		e.FieldStart("source")
+		s.Source.Encode(e)
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes ListTeamsResponse from json.
-func (s *ListTeamsResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListTeamsResponse to nil")
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
 	}
-	var unwrapped []Team
-	if err := func() error {
-		unwrapped = make([]Team, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Team
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+	{
+		e.FieldStart("project_name")
+		e.Str(s.ProjectName)
+	}
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+	{
+		e.FieldStart("level")
+		s.Level.Encode(e)
+	}
+	{
+		e.FieldStart("platform")
+		e.Str(s.Platform)
+	}
+	{
+		e.FieldStart("count")
+		e.UInt(s.Count)
+	}
+	{
+		e.FieldStart("first_seen")
+		json.EncodeDateTime(e, s.FirstSeen)
+	}
+	{
+		e.FieldStart("last_seen")
+		json.EncodeDateTime(e, s.LastSeen)
+	}
+	{
+		if s.ResolvedAt.Set {
+			e.FieldStart("resolved_at")
+			s.ResolvedAt.Encode(e, json.EncodeDateTime)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = ListTeamsResponse(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListTeamsResponse) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListTeamsResponse) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ListUsersResponse as json.
-func (s ListUsersResponse) Encode(e *jx.Encoder) {
-	unwrapped := []User(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+	{
+		if s.ResolvedBy.Set {
+			e.FieldStart("resolved_by")
+			s.ResolvedBy.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes ListUsersResponse from json.
-func (s *ListUsersResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListUsersResponse to nil")
+	{
+		if s.AssignedUserID.Set {
+			e.FieldStart("assigned_user_id")
+			s.AssignedUserID.Encode(e)
+		}
 	}
-	var unwrapped []User
-	if err := func() error {
-		unwrapped = make([]User, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem User
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
+	{
+		if s.AssignedTeamID.Set {
+			e.FieldStart("assigned_team_id")
+			s.AssignedTeamID.Encode(e)
 		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
 	}
-	*s = ListUsersResponse(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ListUsersResponse) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListUsersResponse) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *LoginRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *LoginRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("username")
-		e.Str(s.Username)
+		if s.AssigneeName.Set {
+			e.FieldStart("assignee_name")
+			s.AssigneeName.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		if s.Escalating.Set {
+			e.FieldStart("escalating")
+			s.Escalating.Encode(e)
+		}
+	}
+	{
+		if s.ResolvedInRelease.Set {
+			e.FieldStart("resolved_in_release")
+			s.ResolvedInRelease.Encode(e)
+		}
+	}
+	{
+		if s.ResolvedInNextRelease.Set {
+			e.FieldStart("resolved_in_next_release")
+			s.ResolvedInNextRelease.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfLoginRequest = [2]string{
-	0: "username",
-	1: "password",
+var jsonFieldsNameOfIssue = [20]string{
+	0:  "id",
+	1:  "project_id",
+	2:  "source",
+	3:  "status",
+	4:  "project_name",
+	5:  "title",
+	6:  "message",
+	7:  "level",
+	8:  "platform",
+	9:  "count",
+	10: "first_seen",
+	11: "last_seen",
+	12: "resolved_at",
+	13: "resolved_by",
+	14: "assigned_user_id",
+	15: "assigned_team_id",
+	16: "assignee_name",
+	17: "escalating",
+	18: "resolved_in_release",
+	19: "resolved_in_next_release",
 }
 
-// Decode decodes LoginRequest from json.
-func (s *LoginRequest) Decode(d *jx.Decoder) error {
+// Decode decodes Issue from json.
+func (s *Issue) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LoginRequest to nil")
+		return errors.New("invalid: unable to decode Issue to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [3]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "username":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
+				v, err := d.UInt()
+				s.ID = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "password":
+		case "project_id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"project_id\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode LoginRequest")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginRequest) {
-					name = jsonFieldsNameOfLoginRequest[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "source":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Source.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *LoginRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *LoginResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *LoginResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("access_token")
-		e.Str(s.AccessToken)
-	}
-	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
-	}
-	{
-		e.FieldStart("expires_in")
-		e.Int(s.ExpiresIn)
-	}
-	{
-		e.FieldStart("is_tmp_password")
-		e.Bool(s.IsTmpPassword)
-	}
-}
-
-var jsonFieldsNameOfLoginResponse = [4]string{
-	0: "access_token",
-	1: "refresh_token",
-	2: "expires_in",
-	3: "is_tmp_password",
-}
-
-// Decode decodes LoginResponse from json.
-func (s *LoginResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode LoginResponse to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "access_token":
-			requiredBitSet[0] |= 1 << 0
+		case "status":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "project_name":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
 				v, err := d.Str()
-				s.AccessToken = string(v)
+				s.ProjectName = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_token\"")
+				return errors.Wrap(err, "decode field \"project_name\"")
 			}
-		case "refresh_token":
-			requiredBitSet[0] |= 1 << 1
+		case "title":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := d.Str()
-				s.RefreshToken = string(v)
+				s.Title = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "expires_in":
-			requiredBitSet[0] |= 1 << 2
+		case "message":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				v, err := d.Int()
-				s.ExpiresIn = int(v)
+				v, err := d.Str()
+				s.Message = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expires_in\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
-		case "is_tmp_password":
-			requiredBitSet[0] |= 1 << 3
+		case "level":
+			requiredBitSet[0] |= 1 << 7
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsTmpPassword = bool(v)
+				if err := s.Level.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"level\"")
+			}
+		case "platform":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Platform = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_tmp_password\"")
+				return errors.Wrap(err, "decode field \"platform\"")
+			}
+		case "count":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Count = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "first_seen":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.FirstSeen = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"first_seen\"")
+			}
+		case "last_seen":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.LastSeen = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_seen\"")
+			}
+		case "resolved_at":
+			if err := func() error {
+				s.ResolvedAt.Reset()
+				if err := s.ResolvedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_at\"")
+			}
+		case "resolved_by":
+			if err := func() error {
+				s.ResolvedBy.Reset()
+				if err := s.ResolvedBy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_by\"")
+			}
+		case "assigned_user_id":
+			if err := func() error {
+				s.AssignedUserID.Reset()
+				if err := s.AssignedUserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"assigned_user_id\"")
+			}
+		case "assigned_team_id":
+			if err := func() error {
+				s.AssignedTeamID.Reset()
+				if err := s.AssignedTeamID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"assigned_team_id\"")
+			}
+		case "assignee_name":
+			if err := func() error {
+				s.AssigneeName.Reset()
+				if err := s.AssigneeName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"assignee_name\"")
+			}
+		case "escalating":
+			if err := func() error {
+				s.Escalating.Reset()
+				if err := s.Escalating.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"escalating\"")
+			}
+		case "resolved_in_release":
+			if err := func() error {
+				s.ResolvedInRelease.Reset()
+				if err := s.ResolvedInRelease.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_release\"")
+			}
+		case "resolved_in_next_release":
+			if err := func() error {
+				s.ResolvedInNextRelease.Reset()
+				if err := s.ResolvedInNextRelease.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_next_release\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode LoginResponse")
+		return errors.Wrap(err, "decode Issue")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
+	for i, mask := range [3]uint8{
+		0b11111111,
 		0b00001111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5656,8 +6666,8 @@ func (s *LoginResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfLoginResponse) {
-					name = jsonFieldsNameOfLoginResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfIssue) {
+					name = jsonFieldsNameOfIssue[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5678,129 +6688,131 @@ func (s *LoginResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LoginResponse) MarshalJSON() ([]byte, error) {
+func (s *Issue) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LoginResponse) UnmarshalJSON(data []byte) error {
+func (s *Issue) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NotificationChannelType as json.
-func (s NotificationChannelType) Encode(e *jx.Encoder) {
-	e.Str(string(s))
+// Encode implements json.Marshaler.
+func (s *IssueActivity) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes NotificationChannelType from json.
-func (s *NotificationChannelType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode NotificationChannelType to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *IssueActivity) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
 	}
-	// Try to use constant string.
-	switch NotificationChannelType(v) {
-	case NotificationChannelTypeEmail:
-		*s = NotificationChannelTypeEmail
-	case NotificationChannelTypeTelegram:
-		*s = NotificationChannelTypeTelegram
-	case NotificationChannelTypeSlack:
-		*s = NotificationChannelTypeSlack
-	case NotificationChannelTypeMattermost:
-		*s = NotificationChannelTypeMattermost
-	case NotificationChannelTypeWebhook:
-		*s = NotificationChannelTypeWebhook
-	case NotificationChannelTypePachca:
-		*s = NotificationChannelTypePachca
-	default:
-		*s = NotificationChannelType(v)
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NotificationChannelType) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationChannelType) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *NotificationRule) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *NotificationRule) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.UInt(s.ID)
+		if s.UserID.Set {
+			e.FieldStart("user_id")
+			s.UserID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("notification_setting_id")
-		e.UInt(s.NotificationSettingID)
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
+		}
 	}
 	{
-		if s.EventLevel.Set {
-			e.FieldStart("event_level")
-			s.EventLevel.Encode(e)
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.Fingerprint.Set {
-			e.FieldStart("fingerprint")
-			s.Fingerprint.Encode(e)
+		if s.AssignedUserID.Set {
+			e.FieldStart("assigned_user_id")
+			s.AssignedUserID.Encode(e)
 		}
 	}
 	{
-		if s.IsNewError.Set {
-			e.FieldStart("is_new_error")
-			s.IsNewError.Encode(e)
+		if s.AssignedTeamID.Set {
+			e.FieldStart("assigned_team_id")
+			s.AssignedTeamID.Encode(e)
 		}
 	}
 	{
-		if s.IsRegression.Set {
-			e.FieldStart("is_regression")
-			s.IsRegression.Encode(e)
+		if s.AssigneeName.Set {
+			e.FieldStart("assignee_name")
+			s.AssigneeName.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		if s.IssueIds != nil {
+			e.FieldStart("issue_ids")
+			e.ArrStart()
+			for _, elem := range s.IssueIds {
+				e.UInt(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Fingerprints != nil {
+			e.FieldStart("fingerprints")
+			e.ArrStart()
+			for _, elem := range s.Fingerprints {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.CommentID.Set {
+			e.FieldStart("comment_id")
+			s.CommentID.Encode(e)
+		}
+	}
+	{
+		if s.CommentBody.Set {
+			e.FieldStart("comment_body")
+			s.CommentBody.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfNotificationRule = [7]string{
-	0: "id",
-	1: "notification_setting_id",
-	2: "event_level",
-	3: "fingerprint",
-	4: "is_new_error",
-	5: "is_regression",
-	6: "created_at",
-}
-
-// Decode decodes NotificationRule from json.
-func (s *NotificationRule) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfIssueActivity = [13]string{
+	0:  "id",
+	1:  "type",
+	2:  "user_id",
+	3:  "username",
+	4:  "created_at",
+	5:  "status",
+	6:  "assigned_user_id",
+	7:  "assigned_team_id",
+	8:  "assignee_name",
+	9:  "issue_ids",
+	10: "fingerprints",
+	11: "comment_id",
+	12: "comment_body",
+}
+
+// Decode decodes IssueActivity from json.
+func (s *IssueActivity) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotificationRule to nil")
+		return errors.New("invalid: unable to decode IssueActivity to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -5816,81 +6828,158 @@ func (s *NotificationRule) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "notification_setting_id":
+		case "type":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.UInt()
-				s.NotificationSettingID = uint(v)
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "user_id":
+			if err := func() error {
+				s.UserID.Reset()
+				if err := s.UserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_id\"")
+			}
+		case "username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"notification_setting_id\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "event_level":
+		case "status":
 			if err := func() error {
-				s.EventLevel.Reset()
-				if err := s.EventLevel.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_level\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "fingerprint":
+		case "assigned_user_id":
 			if err := func() error {
-				s.Fingerprint.Reset()
-				if err := s.Fingerprint.Decode(d); err != nil {
+				s.AssignedUserID.Reset()
+				if err := s.AssignedUserID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fingerprint\"")
+				return errors.Wrap(err, "decode field \"assigned_user_id\"")
 			}
-		case "is_new_error":
+		case "assigned_team_id":
 			if err := func() error {
-				s.IsNewError.Reset()
-				if err := s.IsNewError.Decode(d); err != nil {
+				s.AssignedTeamID.Reset()
+				if err := s.AssignedTeamID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_new_error\"")
+				return errors.Wrap(err, "decode field \"assigned_team_id\"")
 			}
-		case "is_regression":
+		case "assignee_name":
 			if err := func() error {
-				s.IsRegression.Reset()
-				if err := s.IsRegression.Decode(d); err != nil {
+				s.AssigneeName.Reset()
+				if err := s.AssigneeName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_regression\"")
+				return errors.Wrap(err, "decode field \"assignee_name\"")
 			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 6
+		case "issue_ids":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
+				s.IssueIds = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.IssueIds = append(s.IssueIds, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"issue_ids\"")
+			}
+		case "fingerprints":
+			if err := func() error {
+				s.Fingerprints = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Fingerprints = append(s.Fingerprints, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fingerprints\"")
+			}
+		case "comment_id":
+			if err := func() error {
+				s.CommentID.Reset()
+				if err := s.CommentID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"comment_id\"")
+			}
+		case "comment_body":
+			if err := func() error {
+				s.CommentBody.Reset()
+				if err := s.CommentBody.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"comment_body\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotificationRule")
+		return errors.Wrap(err, "decode IssueActivity")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b01000011,
+	for i, mask := range [2]uint8{
+		0b00010011,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5902,8 +6991,8 @@ func (s *NotificationRule) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfNotificationRule) {
-					name = jsonFieldsNameOfNotificationRule[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfIssueActivity) {
+					name = jsonFieldsNameOfIssueActivity[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5924,171 +7013,130 @@ func (s *NotificationRule) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NotificationRule) MarshalJSON() ([]byte, error) {
+func (s *IssueActivity) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationRule) UnmarshalJSON(data []byte) error {
+func (s *IssueActivity) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NotificationSetting) Encode(e *jx.Encoder) {
+func (s *IssueActivityListResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NotificationSetting) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.UInt(s.ID)
-	}
-	{
-		e.FieldStart("project_id")
-		e.UInt(s.ProjectID)
-	}
-	{
-		e.FieldStart("type")
-		e.Str(s.Type)
-	}
+func (s *IssueActivityListResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("config")
-		e.Str(s.Config)
+		e.FieldStart("activities")
+		e.ArrStart()
+		for _, elem := range s.Activities {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("enabled")
-		e.Bool(s.Enabled)
+		e.FieldStart("total")
+		e.UInt(s.Total)
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		e.FieldStart("page")
+		e.UInt(s.Page)
 	}
 	{
-		e.FieldStart("updated_at")
-		json.EncodeDateTime(e, s.UpdatedAt)
+		e.FieldStart("per_page")
+		e.UInt(s.PerPage)
 	}
 }
 
-var jsonFieldsNameOfNotificationSetting = [7]string{
-	0: "id",
-	1: "project_id",
-	2: "type",
-	3: "config",
-	4: "enabled",
-	5: "created_at",
-	6: "updated_at",
+var jsonFieldsNameOfIssueActivityListResponse = [4]string{
+	0: "activities",
+	1: "total",
+	2: "page",
+	3: "per_page",
 }
 
-// Decode decodes NotificationSetting from json.
-func (s *NotificationSetting) Decode(d *jx.Decoder) error {
+// Decode decodes IssueActivityListResponse from json.
+func (s *IssueActivityListResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NotificationSetting to nil")
+		return errors.New("invalid: unable to decode IssueActivityListResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "activities":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.UInt()
-				s.ID = uint(v)
-				if err != nil {
+				s.Activities = make([]IssueActivity, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueActivity
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Activities = append(s.Activities, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"activities\"")
 			}
-		case "project_id":
+		case "total":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.UInt()
-				s.ProjectID = uint(v)
+				s.Total = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"project_id\"")
+				return errors.Wrap(err, "decode field \"total\"")
 			}
-		case "type":
+		case "page":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Type = string(v)
+				v, err := d.UInt()
+				s.Page = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"page\"")
 			}
-		case "config":
+		case "per_page":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Str()
-				s.Config = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"config\"")
-			}
-		case "enabled":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Bool()
-				s.Enabled = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
-			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "updated_at":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.UpdatedAt = v
+				v, err := d.UInt()
+				s.PerPage = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"updated_at\"")
+				return errors.Wrap(err, "decode field \"per_page\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NotificationSetting")
+		return errors.Wrap(err, "decode IssueActivityListResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6100,8 +7148,8 @@ func (s *NotificationSetting) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfNotificationSetting) {
-					name = jsonFieldsNameOfNotificationSetting[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfIssueActivityListResponse) {
+					name = jsonFieldsNameOfIssueActivityListResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6122,735 +7170,10593 @@ func (s *NotificationSetting) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NotificationSetting) MarshalJSON() ([]byte, error) {
+func (s *IssueActivityListResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NotificationSetting) UnmarshalJSON(data []byte) error {
+func (s *IssueActivityListResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Bool(bool(o.Value))
+// Encode encodes IssueActivityType as json.
+func (s IssueActivityType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+// Decode decodes IssueActivityType from json.
+func (s *IssueActivityType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueActivityType to nil")
 	}
-	o.Set = true
-	v, err := d.Bool()
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = bool(v)
+	// Try to use constant string.
+	switch IssueActivityType(v) {
+	case IssueActivityTypeFirstSeen:
+		*s = IssueActivityTypeFirstSeen
+	case IssueActivityTypeRegression:
+		*s = IssueActivityTypeRegression
+	case IssueActivityTypeStatusChanged:
+		*s = IssueActivityTypeStatusChanged
+	case IssueActivityTypeAssigned:
+		*s = IssueActivityTypeAssigned
+	case IssueActivityTypeMerged:
+		*s = IssueActivityTypeMerged
+	case IssueActivityTypeUnmerged:
+		*s = IssueActivityTypeUnmerged
+	case IssueActivityTypeCommented:
+		*s = IssueActivityTypeCommented
+	case IssueActivityTypeEscalating:
+		*s = IssueActivityTypeEscalating
+	default:
+		*s = IssueActivityType(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s IssueActivityType) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *IssueActivityType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
-	}
-	format(e, o.Value)
+// Encode encodes IssueAssignedFilter as json.
+func (s IssueAssignedFilter) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+// Decode decodes IssueAssignedFilter from json.
+func (s *IssueAssignedFilter) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueAssignedFilter to nil")
 	}
-	o.Set = true
-	v, err := format(d)
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = v
+	// Try to use constant string.
+	switch IssueAssignedFilter(v) {
+	case IssueAssignedFilterMe:
+		*s = IssueAssignedFilterMe
+	case IssueAssignedFilterMyTeams:
+		*s = IssueAssignedFilterMyTeams
+	case IssueAssignedFilterUnassigned:
+		*s = IssueAssignedFilterUnassigned
+	default:
+		*s = IssueAssignedFilter(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
+func (s IssueAssignedFilter) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
+	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+func (s *IssueAssignedFilter) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+	return s.Decode(d)
 }
 
-// Encode encodes float32 as json.
-func (o OptFloat32) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Float32(float32(o.Value))
+// Encode implements json.Marshaler.
+func (s *IssueAssigneeRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes float32 from json.
-func (o *OptFloat32) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat32 to nil")
+// encodeFields encodes fields.
+func (s *IssueAssigneeRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.UserID.Set {
+			e.FieldStart("user_id")
+			s.UserID.Encode(e)
+		}
 	}
-	o.Set = true
-	v, err := d.Float32()
-	if err != nil {
-		return err
+	{
+		if s.TeamID.Set {
+			e.FieldStart("team_id")
+			s.TeamID.Encode(e)
+		}
 	}
-	o.Value = float32(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat32) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat32) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfIssueAssigneeRequest = [2]string{
+	0: "user_id",
+	1: "team_id",
 }
 
-// Encode encodes IssueEventPayload as json.
-func (o OptIssueEventPayload) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes IssueAssigneeRequest from json.
+func (s *IssueAssigneeRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueAssigneeRequest to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes IssueEventPayload from json.
-func (o *OptIssueEventPayload) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptIssueEventPayload to nil")
-	}
-	o.Set = true
-	o.Value = make(IssueEventPayload)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "user_id":
+			if err := func() error {
+				s.UserID.Reset()
+				if err := s.UserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_id\"")
+			}
+		case "team_id":
+			if err := func() error {
+				s.TeamID.Reset()
+				if err := s.TeamID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"team_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueAssigneeRequest")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptIssueEventPayload) MarshalJSON() ([]byte, error) {
+func (s *IssueAssigneeRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptIssueEventPayload) UnmarshalJSON(data []byte) error {
+func (s *IssueAssigneeRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes IssueEventTags as json.
-func (o OptIssueEventTags) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode encodes IssueBulkAction as json.
+func (s IssueBulkAction) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes IssueEventTags from json.
-func (o *OptIssueEventTags) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptIssueEventTags to nil")
+// Decode decodes IssueBulkAction from json.
+func (s *IssueBulkAction) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueBulkAction to nil")
 	}
-	o.Set = true
-	o.Value = make(IssueEventTags)
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch IssueBulkAction(v) {
+	case IssueBulkActionResolve:
+		*s = IssueBulkActionResolve
+	case IssueBulkActionIgnore:
+		*s = IssueBulkActionIgnore
+	case IssueBulkActionUnresolve:
+		*s = IssueBulkActionUnresolve
+	case IssueBulkActionAssign:
+		*s = IssueBulkActionAssign
+	case IssueBulkActionMerge:
+		*s = IssueBulkActionMerge
+	case IssueBulkActionDelete:
+		*s = IssueBulkActionDelete
+	default:
+		*s = IssueBulkAction(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptIssueEventTags) MarshalJSON() ([]byte, error) {
+func (s IssueBulkAction) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptIssueEventTags) UnmarshalJSON(data []byte) error {
+func (s *IssueBulkAction) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes IssueLevel as json.
-func (o OptIssueLevel) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Str(string(o.Value))
+// Encode implements json.Marshaler.
+func (s *IssueBulkFilter) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes IssueLevel from json.
-func (o *OptIssueLevel) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptIssueLevel to nil")
+// encodeFields encodes fields.
+func (s *IssueBulkFilter) encodeFields(e *jx.Encoder) {
+	{
+		if s.Query.Set {
+			e.FieldStart("query")
+			s.Query.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Environment.Set {
+			e.FieldStart("environment")
+			s.Environment.Encode(e)
+		}
+	}
+	{
+		if s.Level.Set {
+			e.FieldStart("level")
+			s.Level.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
+		}
+	}
+	{
+		if s.Assigned.Set {
+			e.FieldStart("assigned")
+			s.Assigned.Encode(e)
+		}
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptIssueLevel) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+var jsonFieldsNameOfIssueBulkFilter = [5]string{
+	0: "query",
+	1: "environment",
+	2: "level",
+	3: "status",
+	4: "assigned",
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptIssueLevel) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+// Decode decodes IssueBulkFilter from json.
+func (s *IssueBulkFilter) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueBulkFilter to nil")
+	}
 
-// Encode encodes bool as json.
-func (o OptNilBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Bool(bool(o.Value))
-}
-
-// Decode decodes bool from json.
-func (o *OptNilBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilBool to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "query":
+			if err := func() error {
+				s.Query.Reset()
+				if err := s.Query.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"query\"")
+			}
+		case "environment":
+			if err := func() error {
+				s.Environment.Reset()
+				if err := s.Environment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
+		case "level":
+			if err := func() error {
+				s.Level.Reset()
+				if err := s.Level.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"level\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "assigned":
+			if err := func() error {
+				s.Assigned.Reset()
+				if err := s.Assigned.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"assigned\"")
+			}
+		default:
+			return d.Skip()
 		}
-
-		var v bool
-		o.Value = v
-		o.Set = true
-		o.Null = true
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueBulkFilter")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Bool()
-	if err != nil {
-		return err
-	}
-	o.Value = bool(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilBool) MarshalJSON() ([]byte, error) {
+func (s *IssueBulkFilter) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilBool) UnmarshalJSON(data []byte) error {
+func (s *IssueBulkFilter) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes IssueEventRequestHeaders as json.
-func (o OptNilIssueEventRequestHeaders) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *IssueBulkRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueBulkRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("action")
+		s.Action.Encode(e)
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.IssueIds != nil {
+			e.FieldStart("issue_ids")
+			e.ArrStart()
+			for _, elem := range s.IssueIds {
+				e.UInt(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Filter.Set {
+			e.FieldStart("filter")
+			s.Filter.Encode(e)
+		}
+	}
+	{
+		if s.IgnoreConditions.Set {
+			e.FieldStart("ignore_conditions")
+			s.IgnoreConditions.Encode(e)
+		}
+	}
+	{
+		if s.ResolvedInRelease.Set {
+			e.FieldStart("resolved_in_release")
+			s.ResolvedInRelease.Encode(e)
+		}
+	}
+	{
+		if s.ResolvedInNextRelease.Set {
+			e.FieldStart("resolved_in_next_release")
+			s.ResolvedInNextRelease.Encode(e)
+		}
+	}
+	{
+		if s.Assignee.Set {
+			e.FieldStart("assignee")
+			s.Assignee.Encode(e)
+		}
+	}
+	{
+		if s.PrimaryIssueID.Set {
+			e.FieldStart("primary_issue_id")
+			s.PrimaryIssueID.Encode(e)
+		}
+	}
+	{
+		if s.Confirm.Set {
+			e.FieldStart("confirm")
+			s.Confirm.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes IssueEventRequestHeaders from json.
-func (o *OptNilIssueEventRequestHeaders) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilIssueEventRequestHeaders to nil")
+var jsonFieldsNameOfIssueBulkRequest = [9]string{
+	0: "action",
+	1: "issue_ids",
+	2: "filter",
+	3: "ignore_conditions",
+	4: "resolved_in_release",
+	5: "resolved_in_next_release",
+	6: "assignee",
+	7: "primary_issue_id",
+	8: "confirm",
+}
+
+// Decode decodes IssueBulkRequest from json.
+func (s *IssueBulkRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueBulkRequest to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
+	var requiredBitSet [2]uint8
 
-		var v IssueEventRequestHeaders
-		o.Value = v
-		o.Set = true
-		o.Null = true
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "action":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Action.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action\"")
+			}
+		case "issue_ids":
+			if err := func() error {
+				s.IssueIds = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.IssueIds = append(s.IssueIds, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issue_ids\"")
+			}
+		case "filter":
+			if err := func() error {
+				s.Filter.Reset()
+				if err := s.Filter.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filter\"")
+			}
+		case "ignore_conditions":
+			if err := func() error {
+				s.IgnoreConditions.Reset()
+				if err := s.IgnoreConditions.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ignore_conditions\"")
+			}
+		case "resolved_in_release":
+			if err := func() error {
+				s.ResolvedInRelease.Reset()
+				if err := s.ResolvedInRelease.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_release\"")
+			}
+		case "resolved_in_next_release":
+			if err := func() error {
+				s.ResolvedInNextRelease.Reset()
+				if err := s.ResolvedInNextRelease.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_next_release\"")
+			}
+		case "assignee":
+			if err := func() error {
+				s.Assignee.Reset()
+				if err := s.Assignee.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"assignee\"")
+			}
+		case "primary_issue_id":
+			if err := func() error {
+				s.PrimaryIssueID.Reset()
+				if err := s.PrimaryIssueID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"primary_issue_id\"")
+			}
+		case "confirm":
+			if err := func() error {
+				s.Confirm.Reset()
+				if err := s.Confirm.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"confirm\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueBulkRequest")
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make(IssueEventRequestHeaders)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000001,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueBulkRequest) {
+					name = jsonFieldsNameOfIssueBulkRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilIssueEventRequestHeaders) MarshalJSON() ([]byte, error) {
+func (s *IssueBulkRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilIssueEventRequestHeaders) UnmarshalJSON(data []byte) error {
+func (s *IssueBulkRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptNilString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes string from json.
-func (o *OptNilString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilString to nil")
+// Encode implements json.Marshaler.
+func (s *IssueBulkResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueBulkResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("changed")
+		e.ArrStart()
+		for _, elem := range s.Changed {
+			e.UInt(elem)
+		}
+		e.ArrEnd()
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		e.FieldStart("unchanged")
+		e.ArrStart()
+		for _, elem := range s.Unchanged {
+			e.UInt(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("denied")
+		e.ArrStart()
+		for _, elem := range s.Denied {
+			e.UInt(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("not_found")
+		e.ArrStart()
+		for _, elem := range s.NotFound {
+			e.UInt(elem)
 		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("has_more")
+		e.Bool(s.HasMore)
+	}
+}
 
-		var v string
-		o.Value = v
-		o.Set = true
-		o.Null = true
+var jsonFieldsNameOfIssueBulkResponse = [5]string{
+	0: "changed",
+	1: "unchanged",
+	2: "denied",
+	3: "not_found",
+	4: "has_more",
+}
+
+// Decode decodes IssueBulkResponse from json.
+func (s *IssueBulkResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueBulkResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "changed":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Changed = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.Changed = append(s.Changed, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"changed\"")
+			}
+		case "unchanged":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.Unchanged = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.Unchanged = append(s.Unchanged, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unchanged\"")
+			}
+		case "denied":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Denied = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.Denied = append(s.Denied, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"denied\"")
+			}
+		case "not_found":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.NotFound = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.NotFound = append(s.NotFound, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"not_found\"")
+			}
+		case "has_more":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.HasMore = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"has_more\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueBulkResponse")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Str()
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueBulkResponse) {
+					name = jsonFieldsNameOfIssueBulkResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueBulkResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueBulkResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueComment) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueComment) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("issue_id")
+		e.UInt(s.IssueID)
+	}
+	{
+		if s.ParentID.Set {
+			e.FieldStart("parent_id")
+			s.ParentID.Encode(e)
+		}
+	}
+	{
+		if s.UserID.Set {
+			e.FieldStart("user_id")
+			s.UserID.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfIssueComment = [8]string{
+	0: "id",
+	1: "issue_id",
+	2: "parent_id",
+	3: "user_id",
+	4: "username",
+	5: "body",
+	6: "created_at",
+	7: "updated_at",
+}
+
+// Decode decodes IssueComment from json.
+func (s *IssueComment) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueComment to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "issue_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.IssueID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issue_id\"")
+			}
+		case "parent_id":
+			if err := func() error {
+				s.ParentID.Reset()
+				if err := s.ParentID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_id\"")
+			}
+		case "user_id":
+			if err := func() error {
+				s.UserID.Reset()
+				if err := s.UserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_id\"")
+			}
+		case "username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "body":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueComment")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11100011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueComment) {
+					name = jsonFieldsNameOfIssueComment[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueComment) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueComment) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueCommentsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueCommentsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("comments")
+		e.ArrStart()
+		for _, elem := range s.Comments {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfIssueCommentsResponse = [1]string{
+	0: "comments",
+}
+
+// Decode decodes IssueCommentsResponse from json.
+func (s *IssueCommentsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueCommentsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "comments":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Comments = make([]IssueComment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueComment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Comments = append(s.Comments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"comments\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueCommentsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueCommentsResponse) {
+					name = jsonFieldsNameOfIssueCommentsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueCommentsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueCommentsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueEvent) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueEvent) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("event_id")
+		e.Str(s.EventID)
+	}
+	{
+		e.FieldStart("timestamp")
+		json.EncodeDateTime(e, s.Timestamp)
+	}
+	{
+		if s.GroupHash.Set {
+			e.FieldStart("group_hash")
+			s.GroupHash.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("level")
+		s.Level.Encode(e)
+	}
+	{
+		e.FieldStart("source")
+		s.Source.Encode(e)
+	}
+	{
+		e.FieldStart("platform")
+		e.Str(s.Platform)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+	{
+		if s.Payload.Set {
+			e.FieldStart("payload")
+			s.Payload.Encode(e)
+		}
+	}
+	{
+		if s.Tags.Set {
+			e.FieldStart("tags")
+			s.Tags.Encode(e)
+		}
+	}
+	{
+		if s.ServerName.Set {
+			e.FieldStart("server_name")
+			s.ServerName.Encode(e)
+		}
+	}
+	{
+		if s.Environment.Set {
+			e.FieldStart("environment")
+			s.Environment.Encode(e)
+		}
+	}
+	{
+		if s.Release.Set {
+			e.FieldStart("release")
+			s.Release.Encode(e)
+		}
+	}
+	{
+		if s.ExceptionType.Set {
+			e.FieldStart("exception_type")
+			s.ExceptionType.Encode(e)
+		}
+	}
+	{
+		if s.ExceptionValue.Set {
+			e.FieldStart("exception_value")
+			s.ExceptionValue.Encode(e)
+		}
+	}
+	{
+		if s.ExceptionStacktrace.Set {
+			e.FieldStart("exception_stacktrace")
+			s.ExceptionStacktrace.Encode(e)
+		}
+	}
+	{
+		if s.RequestURL.Set {
+			e.FieldStart("request_url")
+			s.RequestURL.Encode(e)
+		}
+	}
+	{
+		if s.RequestMethod.Set {
+			e.FieldStart("request_method")
+			s.RequestMethod.Encode(e)
+		}
+	}
+	{
+		if s.RequestQuery.Set {
+			e.FieldStart("request_query")
+			s.RequestQuery.Encode(e)
+		}
+	}
+	{
+		if s.RequestHeaders.Set {
+			e.FieldStart("request_headers")
+			s.RequestHeaders.Encode(e)
+		}
+	}
+	{
+		if s.RequestData.Set {
+			e.FieldStart("request_data")
+			s.RequestData.Encode(e)
+		}
+	}
+	{
+		if s.RequestCookies.Set {
+			e.FieldStart("request_cookies")
+			s.RequestCookies.Encode(e)
+		}
+	}
+	{
+		if s.RequestIP.Set {
+			e.FieldStart("request_ip")
+			s.RequestIP.Encode(e)
+		}
+	}
+	{
+		if s.UserAgent.Set {
+			e.FieldStart("user_agent")
+			s.UserAgent.Encode(e)
+		}
+	}
+	{
+		if s.UserID.Set {
+			e.FieldStart("user_id")
+			s.UserID.Encode(e)
+		}
+	}
+	{
+		if s.UserEmail.Set {
+			e.FieldStart("user_email")
+			s.UserEmail.Encode(e)
+		}
+	}
+	{
+		if s.RuntimeName.Set {
+			e.FieldStart("runtime_name")
+			s.RuntimeName.Encode(e)
+		}
+	}
+	{
+		if s.RuntimeVersion.Set {
+			e.FieldStart("runtime_version")
+			s.RuntimeVersion.Encode(e)
+		}
+	}
+	{
+		if s.OsName.Set {
+			e.FieldStart("os_name")
+			s.OsName.Encode(e)
+		}
+	}
+	{
+		if s.OsVersion.Set {
+			e.FieldStart("os_version")
+			s.OsVersion.Encode(e)
+		}
+	}
+	{
+		if s.BrowserName.Set {
+			e.FieldStart("browser_name")
+			s.BrowserName.Encode(e)
+		}
+	}
+	{
+		if s.BrowserVersion.Set {
+			e.FieldStart("browser_version")
+			s.BrowserVersion.Encode(e)
+		}
+	}
+	{
+		if s.DeviceArch.Set {
+			e.FieldStart("device_arch")
+			s.DeviceArch.Encode(e)
+		}
+	}
+	{
+		if s.Attachments != nil {
+			e.FieldStart("attachments")
+			e.ArrStart()
+			for _, elem := range s.Attachments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfIssueEvent = [34]string{
+	0:  "event_id",
+	1:  "timestamp",
+	2:  "group_hash",
+	3:  "project_id",
+	4:  "level",
+	5:  "source",
+	6:  "platform",
+	7:  "message",
+	8:  "payload",
+	9:  "tags",
+	10: "server_name",
+	11: "environment",
+	12: "release",
+	13: "exception_type",
+	14: "exception_value",
+	15: "exception_stacktrace",
+	16: "request_url",
+	17: "request_method",
+	18: "request_query",
+	19: "request_headers",
+	20: "request_data",
+	21: "request_cookies",
+	22: "request_ip",
+	23: "user_agent",
+	24: "user_id",
+	25: "user_email",
+	26: "runtime_name",
+	27: "runtime_version",
+	28: "os_name",
+	29: "os_version",
+	30: "browser_name",
+	31: "browser_version",
+	32: "device_arch",
+	33: "attachments",
+}
+
+// Decode decodes IssueEvent from json.
+func (s *IssueEvent) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueEvent to nil")
+	}
+	var requiredBitSet [5]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "event_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.EventID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_id\"")
+			}
+		case "timestamp":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Timestamp = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timestamp\"")
+			}
+		case "group_hash":
+			if err := func() error {
+				s.GroupHash.Reset()
+				if err := s.GroupHash.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"group_hash\"")
+			}
+		case "project_id":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "level":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Level.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"level\"")
+			}
+		case "source":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.Source.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "platform":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Str()
+				s.Platform = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"platform\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "payload":
+			if err := func() error {
+				s.Payload.Reset()
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"payload\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "server_name":
+			if err := func() error {
+				s.ServerName.Reset()
+				if err := s.ServerName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"server_name\"")
+			}
+		case "environment":
+			if err := func() error {
+				s.Environment.Reset()
+				if err := s.Environment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
+		case "release":
+			if err := func() error {
+				s.Release.Reset()
+				if err := s.Release.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"release\"")
+			}
+		case "exception_type":
+			if err := func() error {
+				s.ExceptionType.Reset()
+				if err := s.ExceptionType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exception_type\"")
+			}
+		case "exception_value":
+			if err := func() error {
+				s.ExceptionValue.Reset()
+				if err := s.ExceptionValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exception_value\"")
+			}
+		case "exception_stacktrace":
+			if err := func() error {
+				s.ExceptionStacktrace.Reset()
+				if err := s.ExceptionStacktrace.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exception_stacktrace\"")
+			}
+		case "request_url":
+			if err := func() error {
+				s.RequestURL.Reset()
+				if err := s.RequestURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_url\"")
+			}
+		case "request_method":
+			if err := func() error {
+				s.RequestMethod.Reset()
+				if err := s.RequestMethod.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_method\"")
+			}
+		case "request_query":
+			if err := func() error {
+				s.RequestQuery.Reset()
+				if err := s.RequestQuery.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_query\"")
+			}
+		case "request_headers":
+			if err := func() error {
+				s.RequestHeaders.Reset()
+				if err := s.RequestHeaders.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_headers\"")
+			}
+		case "request_data":
+			if err := func() error {
+				s.RequestData.Reset()
+				if err := s.RequestData.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_data\"")
+			}
+		case "request_cookies":
+			if err := func() error {
+				s.RequestCookies.Reset()
+				if err := s.RequestCookies.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_cookies\"")
+			}
+		case "request_ip":
+			if err := func() error {
+				s.RequestIP.Reset()
+				if err := s.RequestIP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"request_ip\"")
+			}
+		case "user_agent":
+			if err := func() error {
+				s.UserAgent.Reset()
+				if err := s.UserAgent.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_agent\"")
+			}
+		case "user_id":
+			if err := func() error {
+				s.UserID.Reset()
+				if err := s.UserID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_id\"")
+			}
+		case "user_email":
+			if err := func() error {
+				s.UserEmail.Reset()
+				if err := s.UserEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_email\"")
+			}
+		case "runtime_name":
+			if err := func() error {
+				s.RuntimeName.Reset()
+				if err := s.RuntimeName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"runtime_name\"")
+			}
+		case "runtime_version":
+			if err := func() error {
+				s.RuntimeVersion.Reset()
+				if err := s.RuntimeVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"runtime_version\"")
+			}
+		case "os_name":
+			if err := func() error {
+				s.OsName.Reset()
+				if err := s.OsName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"os_name\"")
+			}
+		case "os_version":
+			if err := func() error {
+				s.OsVersion.Reset()
+				if err := s.OsVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"os_version\"")
+			}
+		case "browser_name":
+			if err := func() error {
+				s.BrowserName.Reset()
+				if err := s.BrowserName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"browser_name\"")
+			}
+		case "browser_version":
+			if err := func() error {
+				s.BrowserVersion.Reset()
+				if err := s.BrowserVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"browser_version\"")
+			}
+		case "device_arch":
+			if err := func() error {
+				s.DeviceArch.Reset()
+				if err := s.DeviceArch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"device_arch\"")
+			}
+		case "attachments":
+			if err := func() error {
+				s.Attachments = make([]Attachment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Attachment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Attachments = append(s.Attachments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attachments\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueEvent")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [5]uint8{
+		0b11111011,
+		0b00000000,
+		0b00000000,
+		0b00000000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueEvent) {
+					name = jsonFieldsNameOfIssueEvent[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueEvent) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueEvent) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s IssueEventPayload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s IssueEventPayload) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes IssueEventPayload from json.
+func (s *IssueEventPayload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueEventPayload to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueEventPayload")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s IssueEventPayload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueEventPayload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s IssueEventRequestHeaders) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s IssueEventRequestHeaders) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes IssueEventRequestHeaders from json.
+func (s *IssueEventRequestHeaders) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueEventRequestHeaders to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueEventRequestHeaders")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s IssueEventRequestHeaders) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueEventRequestHeaders) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s IssueEventTags) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s IssueEventTags) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes IssueEventTags from json.
+func (s *IssueEventTags) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueEventTags to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueEventTags")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s IssueEventTags) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueEventTags) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueIgnoreConditions) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueIgnoreConditions) encodeFields(e *jx.Encoder) {
+	{
+		if s.Until.Set {
+			e.FieldStart("until")
+			s.Until.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.ForHours.Set {
+			e.FieldStart("for_hours")
+			s.ForHours.Encode(e)
+		}
+	}
+	{
+		if s.Count.Set {
+			e.FieldStart("count")
+			s.Count.Encode(e)
+		}
+	}
+	{
+		if s.UserCount.Set {
+			e.FieldStart("user_count")
+			s.UserCount.Encode(e)
+		}
+	}
+	{
+		if s.RatePerHour.Set {
+			e.FieldStart("rate_per_hour")
+			s.RatePerHour.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfIssueIgnoreConditions = [5]string{
+	0: "until",
+	1: "for_hours",
+	2: "count",
+	3: "user_count",
+	4: "rate_per_hour",
+}
+
+// Decode decodes IssueIgnoreConditions from json.
+func (s *IssueIgnoreConditions) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueIgnoreConditions to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "until":
+			if err := func() error {
+				s.Until.Reset()
+				if err := s.Until.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"until\"")
+			}
+		case "for_hours":
+			if err := func() error {
+				s.ForHours.Reset()
+				if err := s.ForHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"for_hours\"")
+			}
+		case "count":
+			if err := func() error {
+				s.Count.Reset()
+				if err := s.Count.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "user_count":
+			if err := func() error {
+				s.UserCount.Reset()
+				if err := s.UserCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_count\"")
+			}
+		case "rate_per_hour":
+			if err := func() error {
+				s.RatePerHour.Reset()
+				if err := s.RatePerHour.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_per_hour\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueIgnoreConditions")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueIgnoreConditions) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueIgnoreConditions) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueLevel as json.
+func (s IssueLevel) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes IssueLevel from json.
+func (s *IssueLevel) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueLevel to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch IssueLevel(v) {
+	case IssueLevelFatal:
+		*s = IssueLevelFatal
+	case IssueLevelException:
+		*s = IssueLevelException
+	case IssueLevelError:
+		*s = IssueLevelError
+	case IssueLevelWarning:
+		*s = IssueLevelWarning
+	case IssueLevelInfo:
+		*s = IssueLevelInfo
+	case IssueLevelDebug:
+		*s = IssueLevelDebug
+	default:
+		*s = IssueLevel(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s IssueLevel) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueLevel) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("source")
+		s.Source.Encode(e)
+	}
+	{
+		e.FieldStart("issue")
+		s.Issue.Encode(e)
+	}
+	{
+		e.FieldStart("events")
+		e.ArrStart()
+		for _, elem := range s.Events {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.MergedFingerprints != nil {
+			e.FieldStart("merged_fingerprints")
+			e.ArrStart()
+			for _, elem := range s.MergedFingerprints {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.IgnoreConditions.Set {
+			e.FieldStart("ignore_conditions")
+			s.IgnoreConditions.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfIssueResponse = [5]string{
+	0: "source",
+	1: "issue",
+	2: "events",
+	3: "merged_fingerprints",
+	4: "ignore_conditions",
+}
+
+// Decode decodes IssueResponse from json.
+func (s *IssueResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "source":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Source.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"source\"")
+			}
+		case "issue":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Issue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issue\"")
+			}
+		case "events":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Events = make([]IssueEvent, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueEvent
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Events = append(s.Events, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"events\"")
+			}
+		case "merged_fingerprints":
+			if err := func() error {
+				s.MergedFingerprints = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MergedFingerprints = append(s.MergedFingerprints, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"merged_fingerprints\"")
+			}
+		case "ignore_conditions":
+			if err := func() error {
+				s.IgnoreConditions.Reset()
+				if err := s.IgnoreConditions.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ignore_conditions\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueResponse) {
+					name = jsonFieldsNameOfIssueResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueSource as json.
+func (s IssueSource) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes IssueSource from json.
+func (s *IssueSource) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueSource to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch IssueSource(v) {
+	case IssueSourceEvent:
+		*s = IssueSourceEvent
+	case IssueSourceException:
+		*s = IssueSourceException
+	case IssueSourceMonitor:
+		*s = IssueSourceMonitor
+	case IssueSourceSpike:
+		*s = IssueSourceSpike
+	default:
+		*s = IssueSource(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s IssueSource) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueSource) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueStatus as json.
+func (s IssueStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes IssueStatus from json.
+func (s *IssueStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch IssueStatus(v) {
+	case IssueStatusResolved:
+		*s = IssueStatusResolved
+	case IssueStatusUnresolved:
+		*s = IssueStatusUnresolved
+	case IssueStatusIgnored:
+		*s = IssueStatusIgnored
+	default:
+		*s = IssueStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s IssueStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueSummary) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueSummary) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("title")
+		e.Str(s.Title)
+	}
+	{
+		e.FieldStart("level")
+		s.Level.Encode(e)
+	}
+	{
+		e.FieldStart("count")
+		e.UInt(s.Count)
+	}
+	{
+		e.FieldStart("last_seen")
+		json.EncodeDateTime(e, s.LastSeen)
+	}
+}
+
+var jsonFieldsNameOfIssueSummary = [6]string{
+	0: "id",
+	1: "project_id",
+	2: "title",
+	3: "level",
+	4: "count",
+	5: "last_seen",
+}
+
+// Decode decodes IssueSummary from json.
+func (s *IssueSummary) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueSummary to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "project_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "title":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Title = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "level":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Level.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"level\"")
+			}
+		case "count":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt()
+				s.Count = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "last_seen":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.LastSeen = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_seen\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueSummary")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueSummary) {
+					name = jsonFieldsNameOfIssueSummary[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueSummary) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueSummary) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueTag) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueTag) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("key")
+		e.Str(s.Key)
+	}
+	{
+		e.FieldStart("total_events")
+		e.UInt(s.TotalEvents)
+	}
+	{
+		e.FieldStart("unique_values")
+		e.UInt(s.UniqueValues)
+	}
+	{
+		e.FieldStart("top_values")
+		e.ArrStart()
+		for _, elem := range s.TopValues {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfIssueTag = [4]string{
+	0: "key",
+	1: "total_events",
+	2: "unique_values",
+	3: "top_values",
+}
+
+// Decode decodes IssueTag from json.
+func (s *IssueTag) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueTag to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "key":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Key = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"key\"")
+			}
+		case "total_events":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.TotalEvents = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total_events\"")
+			}
+		case "unique_values":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.UInt()
+				s.UniqueValues = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unique_values\"")
+			}
+		case "top_values":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.TopValues = make([]IssueTagValue, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueTagValue
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.TopValues = append(s.TopValues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"top_values\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueTag")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueTag) {
+					name = jsonFieldsNameOfIssueTag[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueTag) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueTag) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueTagValue) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueTagValue) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("value")
+		e.Str(s.Value)
+	}
+	{
+		e.FieldStart("count")
+		e.UInt(s.Count)
+	}
+	{
+		e.FieldStart("percentage")
+		e.Float64(s.Percentage)
+	}
+	{
+		e.FieldStart("first_seen")
+		json.EncodeDateTime(e, s.FirstSeen)
+	}
+	{
+		e.FieldStart("last_seen")
+		json.EncodeDateTime(e, s.LastSeen)
+	}
+}
+
+var jsonFieldsNameOfIssueTagValue = [5]string{
+	0: "value",
+	1: "count",
+	2: "percentage",
+	3: "first_seen",
+	4: "last_seen",
+}
+
+// Decode decodes IssueTagValue from json.
+func (s *IssueTagValue) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueTagValue to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "value":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Value = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "count":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Count = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "percentage":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Percentage = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"percentage\"")
+			}
+		case "first_seen":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.FirstSeen = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"first_seen\"")
+			}
+		case "last_seen":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.LastSeen = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_seen\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueTagValue")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueTagValue) {
+					name = jsonFieldsNameOfIssueTagValue[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueTagValue) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueTagValue) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *IssueTagsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueTagsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("tags")
+		e.ArrStart()
+		for _, elem := range s.Tags {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfIssueTagsResponse = [1]string{
+	0: "tags",
+}
+
+// Decode decodes IssueTagsResponse from json.
+func (s *IssueTagsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueTagsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "tags":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Tags = make([]IssueTag, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueTag
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Tags = append(s.Tags, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueTagsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfIssueTagsResponse) {
+					name = jsonFieldsNameOfIssueTagsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueTagsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueTagsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListEnvironmentsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListEnvironmentsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("environments")
+		e.ArrStart()
+		for _, elem := range s.Environments {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListEnvironmentsResponse = [1]string{
+	0: "environments",
+}
+
+// Decode decodes ListEnvironmentsResponse from json.
+func (s *ListEnvironmentsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListEnvironmentsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "environments":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Environments = make([]Environment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Environment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Environments = append(s.Environments, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environments\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListEnvironmentsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListEnvironmentsResponse) {
+					name = jsonFieldsNameOfListEnvironmentsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListEnvironmentsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListEnvironmentsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListEventAttachmentsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListEventAttachmentsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListEventAttachmentsResponse = [1]string{
+	0: "items",
+}
+
+// Decode decodes ListEventAttachmentsResponse from json.
+func (s *ListEventAttachmentsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListEventAttachmentsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "items":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Items = make([]Attachment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Attachment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"items\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListEventAttachmentsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListEventAttachmentsResponse) {
+					name = jsonFieldsNameOfListEventAttachmentsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListEventAttachmentsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListEventAttachmentsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListGroupingRulesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListGroupingRulesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("grouping_rules")
+		e.ArrStart()
+		for _, elem := range s.GroupingRules {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListGroupingRulesResponse = [1]string{
+	0: "grouping_rules",
+}
+
+// Decode decodes ListGroupingRulesResponse from json.
+func (s *ListGroupingRulesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListGroupingRulesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "grouping_rules":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.GroupingRules = make([]GroupingRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem GroupingRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.GroupingRules = append(s.GroupingRules, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"grouping_rules\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListGroupingRulesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListGroupingRulesResponse) {
+					name = jsonFieldsNameOfListGroupingRulesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListGroupingRulesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListGroupingRulesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListIssueSummariesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListIssueSummariesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("issues")
+		e.ArrStart()
+		for _, elem := range s.Issues {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListIssueSummariesResponse = [1]string{
+	0: "issues",
+}
+
+// Decode decodes ListIssueSummariesResponse from json.
+func (s *ListIssueSummariesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListIssueSummariesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "issues":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Issues = make([]IssueSummary, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueSummary
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Issues = append(s.Issues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issues\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListIssueSummariesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListIssueSummariesResponse) {
+					name = jsonFieldsNameOfListIssueSummariesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListIssueSummariesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListIssueSummariesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListIssuesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListIssuesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("issues")
+		e.ArrStart()
+		for _, elem := range s.Issues {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("total")
+		e.UInt(s.Total)
+	}
+	{
+		e.FieldStart("page")
+		e.UInt(s.Page)
+	}
+	{
+		e.FieldStart("per_page")
+		e.UInt(s.PerPage)
+	}
+	{
+		e.FieldStart("truncated")
+		e.Bool(s.Truncated)
+	}
+}
+
+var jsonFieldsNameOfListIssuesResponse = [5]string{
+	0: "issues",
+	1: "total",
+	2: "page",
+	3: "per_page",
+	4: "truncated",
+}
+
+// Decode decodes ListIssuesResponse from json.
+func (s *ListIssuesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListIssuesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "issues":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Issues = make([]Issue, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Issue
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Issues = append(s.Issues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issues\"")
+			}
+		case "total":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Total = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total\"")
+			}
+		case "page":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.UInt()
+				s.Page = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"page\"")
+			}
+		case "per_page":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt()
+				s.PerPage = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"per_page\"")
+			}
+		case "truncated":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Truncated = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"truncated\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListIssuesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListIssuesResponse) {
+					name = jsonFieldsNameOfListIssuesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListIssuesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListIssuesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListMonitorsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListMonitorsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListMonitorsResponse = [1]string{
+	0: "items",
+}
+
+// Decode decodes ListMonitorsResponse from json.
+func (s *ListMonitorsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListMonitorsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "items":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Items = make([]Monitor, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Monitor
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"items\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListMonitorsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListMonitorsResponse) {
+					name = jsonFieldsNameOfListMonitorsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListMonitorsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListMonitorsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListNotificationRulesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListNotificationRulesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("notification_rules")
+		e.ArrStart()
+		for _, elem := range s.NotificationRules {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListNotificationRulesResponse = [1]string{
+	0: "notification_rules",
+}
+
+// Decode decodes ListNotificationRulesResponse from json.
+func (s *ListNotificationRulesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListNotificationRulesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "notification_rules":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.NotificationRules = make([]NotificationRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NotificationRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.NotificationRules = append(s.NotificationRules, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_rules\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListNotificationRulesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListNotificationRulesResponse) {
+					name = jsonFieldsNameOfListNotificationRulesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListNotificationRulesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListNotificationRulesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListNotificationSettingsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListNotificationSettingsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("notification_settings")
+		e.ArrStart()
+		for _, elem := range s.NotificationSettings {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListNotificationSettingsResponse = [1]string{
+	0: "notification_settings",
+}
+
+// Decode decodes ListNotificationSettingsResponse from json.
+func (s *ListNotificationSettingsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListNotificationSettingsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "notification_settings":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.NotificationSettings = make([]NotificationSetting, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NotificationSetting
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.NotificationSettings = append(s.NotificationSettings, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_settings\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListNotificationSettingsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListNotificationSettingsResponse) {
+					name = jsonFieldsNameOfListNotificationSettingsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListNotificationSettingsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListNotificationSettingsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListProjectKeysResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListProjectKeysResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("keys")
+		e.ArrStart()
+		for _, elem := range s.Keys {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListProjectKeysResponse = [1]string{
+	0: "keys",
+}
+
+// Decode decodes ListProjectKeysResponse from json.
+func (s *ListProjectKeysResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListProjectKeysResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "keys":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Keys = make([]ProjectKey, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectKey
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Keys = append(s.Keys, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"keys\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListProjectKeysResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListProjectKeysResponse) {
+					name = jsonFieldsNameOfListProjectKeysResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListProjectKeysResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListProjectKeysResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListProjectsResponse as json.
+func (s ListProjectsResponse) Encode(e *jx.Encoder) {
+	unwrapped := []Project(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListProjectsResponse from json.
+func (s *ListProjectsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListProjectsResponse to nil")
+	}
+	var unwrapped []Project
+	if err := func() error {
+		unwrapped = make([]Project, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Project
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListProjectsResponse(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListProjectsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListProjectsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListTeamsResponse as json.
+func (s ListTeamsResponse) Encode(e *jx.Encoder) {
+	unwrapped := []Team(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListTeamsResponse from json.
+func (s *ListTeamsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListTeamsResponse to nil")
+	}
+	var unwrapped []Team
+	if err := func() error {
+		unwrapped = make([]Team, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Team
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListTeamsResponse(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListTeamsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListTeamsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ListTransactionsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ListTransactionsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("transactions")
+		e.ArrStart()
+		for _, elem := range s.Transactions {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfListTransactionsResponse = [1]string{
+	0: "transactions",
+}
+
+// Decode decodes ListTransactionsResponse from json.
+func (s *ListTransactionsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListTransactionsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "transactions":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Transactions = make([]TransactionSummary, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TransactionSummary
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Transactions = append(s.Transactions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"transactions\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ListTransactionsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfListTransactionsResponse) {
+					name = jsonFieldsNameOfListTransactionsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ListTransactionsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListTransactionsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListUsersResponse as json.
+func (s ListUsersResponse) Encode(e *jx.Encoder) {
+	unwrapped := []User(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListUsersResponse from json.
+func (s *ListUsersResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListUsersResponse to nil")
+	}
+	var unwrapped []User
+	if err := func() error {
+		unwrapped = make([]User, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem User
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListUsersResponse(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListUsersResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListUsersResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+}
+
+var jsonFieldsNameOfLoginRequest = [2]string{
+	0: "username",
+	1: "password",
+}
+
+// Decode decodes LoginRequest from json.
+func (s *LoginRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "username":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginRequest) {
+					name = jsonFieldsNameOfLoginRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LoginResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LoginResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("access_token")
+		e.Str(s.AccessToken)
+	}
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+	{
+		e.FieldStart("expires_in")
+		e.Int(s.ExpiresIn)
+	}
+	{
+		e.FieldStart("is_tmp_password")
+		e.Bool(s.IsTmpPassword)
+	}
+}
+
+var jsonFieldsNameOfLoginResponse = [4]string{
+	0: "access_token",
+	1: "refresh_token",
+	2: "expires_in",
+	3: "is_tmp_password",
+}
+
+// Decode decodes LoginResponse from json.
+func (s *LoginResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LoginResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "access_token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AccessToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"access_token\"")
+			}
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		case "expires_in":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.ExpiresIn = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expires_in\"")
+			}
+		case "is_tmp_password":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsTmpPassword = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_tmp_password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LoginResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLoginResponse) {
+					name = jsonFieldsNameOfLoginResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LoginResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LoginResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MergeIssuesRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MergeIssuesRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("primary_issue_id")
+		e.UInt(s.PrimaryIssueID)
+	}
+	{
+		e.FieldStart("issue_ids")
+		e.ArrStart()
+		for _, elem := range s.IssueIds {
+			e.UInt(elem)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfMergeIssuesRequest = [2]string{
+	0: "primary_issue_id",
+	1: "issue_ids",
+}
+
+// Decode decodes MergeIssuesRequest from json.
+func (s *MergeIssuesRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MergeIssuesRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "primary_issue_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.PrimaryIssueID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"primary_issue_id\"")
+			}
+		case "issue_ids":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.IssueIds = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.IssueIds = append(s.IssueIds, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issue_ids\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MergeIssuesRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMergeIssuesRequest) {
+					name = jsonFieldsNameOfMergeIssuesRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MergeIssuesRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MergeIssuesRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Monitor) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Monitor) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("slug")
+		e.Str(s.Slug)
+	}
+	{
+		e.FieldStart("schedule_type")
+		s.ScheduleType.Encode(e)
+	}
+	{
+		e.FieldStart("schedule")
+		e.Str(s.Schedule)
+	}
+	{
+		e.FieldStart("timezone")
+		e.Str(s.Timezone)
+	}
+	{
+		e.FieldStart("checkin_margin")
+		e.UInt(s.CheckinMargin)
+	}
+	{
+		e.FieldStart("max_runtime")
+		e.UInt(s.MaxRuntime)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.LastCheckInAt.Set {
+			e.FieldStart("last_check_in_at")
+			s.LastCheckInAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.NextCheckInAt.Set {
+			e.FieldStart("next_check_in_at")
+			s.NextCheckInAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfMonitor = [12]string{
+	0:  "id",
+	1:  "project_id",
+	2:  "slug",
+	3:  "schedule_type",
+	4:  "schedule",
+	5:  "timezone",
+	6:  "checkin_margin",
+	7:  "max_runtime",
+	8:  "status",
+	9:  "last_check_in_at",
+	10: "next_check_in_at",
+	11: "created_at",
+}
+
+// Decode decodes Monitor from json.
+func (s *Monitor) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Monitor to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "project_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "slug":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Slug = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slug\"")
+			}
+		case "schedule_type":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.ScheduleType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"schedule_type\"")
+			}
+		case "schedule":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Schedule = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"schedule\"")
+			}
+		case "timezone":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.Timezone = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timezone\"")
+			}
+		case "checkin_margin":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.UInt()
+				s.CheckinMargin = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"checkin_margin\"")
+			}
+		case "max_runtime":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.UInt()
+				s.MaxRuntime = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_runtime\"")
+			}
+		case "status":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "last_check_in_at":
+			if err := func() error {
+				s.LastCheckInAt.Reset()
+				if err := s.LastCheckInAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_check_in_at\"")
+			}
+		case "next_check_in_at":
+			if err := func() error {
+				s.NextCheckInAt.Reset()
+				if err := s.NextCheckInAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"next_check_in_at\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Monitor")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00001001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMonitor) {
+					name = jsonFieldsNameOfMonitor[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Monitor) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Monitor) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MonitorCheckIn) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MonitorCheckIn) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("check_in_id")
+		e.Str(s.CheckInID)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Duration.Set {
+			e.FieldStart("duration")
+			s.Duration.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("environment")
+		e.Str(s.Environment)
+	}
+	{
+		if s.ExpectedAt.Set {
+			e.FieldStart("expected_at")
+			s.ExpectedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfMonitorCheckIn = [8]string{
+	0: "id",
+	1: "check_in_id",
+	2: "status",
+	3: "duration",
+	4: "environment",
+	5: "expected_at",
+	6: "created_at",
+	7: "updated_at",
+}
+
+// Decode decodes MonitorCheckIn from json.
+func (s *MonitorCheckIn) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MonitorCheckIn to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "check_in_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.CheckInID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"check_in_id\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "duration":
+			if err := func() error {
+				s.Duration.Reset()
+				if err := s.Duration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration\"")
+			}
+		case "environment":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Environment = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
+		case "expected_at":
+			if err := func() error {
+				s.ExpectedAt.Reset()
+				if err := s.ExpectedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expected_at\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MonitorCheckIn")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11010111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMonitorCheckIn) {
+					name = jsonFieldsNameOfMonitorCheckIn[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MonitorCheckIn) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MonitorCheckIn) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MonitorCheckInStatus as json.
+func (s MonitorCheckInStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MonitorCheckInStatus from json.
+func (s *MonitorCheckInStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MonitorCheckInStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MonitorCheckInStatus(v) {
+	case MonitorCheckInStatusInProgress:
+		*s = MonitorCheckInStatusInProgress
+	case MonitorCheckInStatusOk:
+		*s = MonitorCheckInStatusOk
+	case MonitorCheckInStatusError:
+		*s = MonitorCheckInStatusError
+	case MonitorCheckInStatusMissed:
+		*s = MonitorCheckInStatusMissed
+	case MonitorCheckInStatusTimeout:
+		*s = MonitorCheckInStatusTimeout
+	default:
+		*s = MonitorCheckInStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MonitorCheckInStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MonitorCheckInStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MonitorScheduleType as json.
+func (s MonitorScheduleType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MonitorScheduleType from json.
+func (s *MonitorScheduleType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MonitorScheduleType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MonitorScheduleType(v) {
+	case MonitorScheduleTypeCrontab:
+		*s = MonitorScheduleTypeCrontab
+	case MonitorScheduleTypeInterval:
+		*s = MonitorScheduleTypeInterval
+	default:
+		*s = MonitorScheduleType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MonitorScheduleType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MonitorScheduleType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes MonitorStatus as json.
+func (s MonitorStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes MonitorStatus from json.
+func (s *MonitorStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MonitorStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch MonitorStatus(v) {
+	case MonitorStatusOk:
+		*s = MonitorStatusOk
+	case MonitorStatusError:
+		*s = MonitorStatusError
+	case MonitorStatusMissed:
+		*s = MonitorStatusMissed
+	case MonitorStatusTimeout:
+		*s = MonitorStatusTimeout
+	default:
+		*s = MonitorStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s MonitorStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MonitorStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MonitorWithCheckIns) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MonitorWithCheckIns) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("monitor")
+		s.Monitor.Encode(e)
+	}
+	{
+		e.FieldStart("check_ins")
+		e.ArrStart()
+		for _, elem := range s.CheckIns {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfMonitorWithCheckIns = [2]string{
+	0: "monitor",
+	1: "check_ins",
+}
+
+// Decode decodes MonitorWithCheckIns from json.
+func (s *MonitorWithCheckIns) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MonitorWithCheckIns to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "monitor":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Monitor.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"monitor\"")
+			}
+		case "check_ins":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.CheckIns = make([]MonitorCheckIn, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem MonitorCheckIn
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.CheckIns = append(s.CheckIns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"check_ins\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MonitorWithCheckIns")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMonitorWithCheckIns) {
+					name = jsonFieldsNameOfMonitorWithCheckIns[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MonitorWithCheckIns) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MonitorWithCheckIns) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NotificationChannelType as json.
+func (s NotificationChannelType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes NotificationChannelType from json.
+func (s *NotificationChannelType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationChannelType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch NotificationChannelType(v) {
+	case NotificationChannelTypeEmail:
+		*s = NotificationChannelTypeEmail
+	case NotificationChannelTypeTelegram:
+		*s = NotificationChannelTypeTelegram
+	case NotificationChannelTypeSlack:
+		*s = NotificationChannelTypeSlack
+	case NotificationChannelTypeMattermost:
+		*s = NotificationChannelTypeMattermost
+	case NotificationChannelTypeWebhook:
+		*s = NotificationChannelTypeWebhook
+	case NotificationChannelTypePachca:
+		*s = NotificationChannelTypePachca
+	default:
+		*s = NotificationChannelType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NotificationChannelType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationChannelType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationRule) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationRule) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("notification_setting_id")
+		e.UInt(s.NotificationSettingID)
+	}
+	{
+		if s.EventLevel.Set {
+			e.FieldStart("event_level")
+			s.EventLevel.Encode(e)
+		}
+	}
+	{
+		if s.Fingerprint.Set {
+			e.FieldStart("fingerprint")
+			s.Fingerprint.Encode(e)
+		}
+	}
+	{
+		if s.IsNewError.Set {
+			e.FieldStart("is_new_error")
+			s.IsNewError.Encode(e)
+		}
+	}
+	{
+		if s.IsRegression.Set {
+			e.FieldStart("is_regression")
+			s.IsRegression.Encode(e)
+		}
+	}
+	{
+		if s.Environment.Set {
+			e.FieldStart("environment")
+			s.Environment.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfNotificationRule = [8]string{
+	0: "id",
+	1: "notification_setting_id",
+	2: "event_level",
+	3: "fingerprint",
+	4: "is_new_error",
+	5: "is_regression",
+	6: "environment",
+	7: "created_at",
+}
+
+// Decode decodes NotificationRule from json.
+func (s *NotificationRule) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationRule to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "notification_setting_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.NotificationSettingID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"notification_setting_id\"")
+			}
+		case "event_level":
+			if err := func() error {
+				s.EventLevel.Reset()
+				if err := s.EventLevel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_level\"")
+			}
+		case "fingerprint":
+			if err := func() error {
+				s.Fingerprint.Reset()
+				if err := s.Fingerprint.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fingerprint\"")
+			}
+		case "is_new_error":
+			if err := func() error {
+				s.IsNewError.Reset()
+				if err := s.IsNewError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_new_error\"")
+			}
+		case "is_regression":
+			if err := func() error {
+				s.IsRegression.Reset()
+				if err := s.IsRegression.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_regression\"")
+			}
+		case "environment":
+			if err := func() error {
+				s.Environment.Reset()
+				if err := s.Environment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationRule")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b10000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationRule) {
+					name = jsonFieldsNameOfNotificationRule[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationRule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationRule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NotificationSetting) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NotificationSetting) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("type")
+		e.Str(s.Type)
+	}
+	{
+		e.FieldStart("config")
+		e.Str(s.Config)
+	}
+	{
+		e.FieldStart("enabled")
+		e.Bool(s.Enabled)
+	}
+	{
+		e.FieldStart("route_to_assignee")
+		e.Bool(s.RouteToAssignee)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("updated_at")
+		json.EncodeDateTime(e, s.UpdatedAt)
+	}
+}
+
+var jsonFieldsNameOfNotificationSetting = [8]string{
+	0: "id",
+	1: "project_id",
+	2: "type",
+	3: "config",
+	4: "enabled",
+	5: "route_to_assignee",
+	6: "created_at",
+	7: "updated_at",
+}
+
+// Decode decodes NotificationSetting from json.
+func (s *NotificationSetting) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NotificationSetting to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "project_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Type = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "config":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Config = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"config\"")
+			}
+		case "enabled":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.Enabled = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "route_to_assignee":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.RouteToAssignee = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"route_to_assignee\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "updated_at":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.UpdatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NotificationSetting")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b11111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfNotificationSetting) {
+					name = jsonFieldsNameOfNotificationSetting[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NotificationSetting) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NotificationSetting) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CategoryRateLimits as json.
+func (o OptCategoryRateLimits) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CategoryRateLimits from json.
+func (o *OptCategoryRateLimits) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCategoryRateLimits to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCategoryRateLimits) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCategoryRateLimits) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes EventBreadcrumbData as json.
+func (o OptEventBreadcrumbData) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EventBreadcrumbData from json.
+func (o *OptEventBreadcrumbData) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEventBreadcrumbData to nil")
+	}
+	o.Set = true
+	o.Value = make(EventBreadcrumbData)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEventBreadcrumbData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEventBreadcrumbData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes EventSDK as json.
+func (o OptEventSDK) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EventSDK from json.
+func (o *OptEventSDK) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEventSDK to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEventSDK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEventSDK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float32 as json.
+func (o OptFloat32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float32(float32(o.Value))
+}
+
+// Decode decodes float32 from json.
+func (o *OptFloat32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat32 to nil")
+	}
+	o.Set = true
+	v, err := d.Float32()
+	if err != nil {
+		return err
+	}
+	o.Value = float32(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat32) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat32) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat64 to nil")
+	}
+	o.Set = true
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueAssignedFilter as json.
+func (o OptIssueAssignedFilter) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes IssueAssignedFilter from json.
+func (o *OptIssueAssignedFilter) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueAssignedFilter to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueAssignedFilter) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueAssignedFilter) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueAssigneeRequest as json.
+func (o OptIssueAssigneeRequest) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes IssueAssigneeRequest from json.
+func (o *OptIssueAssigneeRequest) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueAssigneeRequest to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueAssigneeRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueAssigneeRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueBulkFilter as json.
+func (o OptIssueBulkFilter) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes IssueBulkFilter from json.
+func (o *OptIssueBulkFilter) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueBulkFilter to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueBulkFilter) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueBulkFilter) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueEventPayload as json.
+func (o OptIssueEventPayload) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes IssueEventPayload from json.
+func (o *OptIssueEventPayload) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueEventPayload to nil")
+	}
+	o.Set = true
+	o.Value = make(IssueEventPayload)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueEventPayload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueEventPayload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueEventTags as json.
+func (o OptIssueEventTags) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes IssueEventTags from json.
+func (o *OptIssueEventTags) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueEventTags to nil")
+	}
+	o.Set = true
+	o.Value = make(IssueEventTags)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueEventTags) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueEventTags) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueIgnoreConditions as json.
+func (o OptIssueIgnoreConditions) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes IssueIgnoreConditions from json.
+func (o *OptIssueIgnoreConditions) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueIgnoreConditions to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueIgnoreConditions) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueIgnoreConditions) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueLevel as json.
+func (o OptIssueLevel) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes IssueLevel from json.
+func (o *OptIssueLevel) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueLevel to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueLevel) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueLevel) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueStatus as json.
+func (o OptIssueStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes IssueStatus from json.
+func (o *OptIssueStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptIssueStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptIssueStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptIssueStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptNilBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptNilBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilBool to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v bool
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v time.Time
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes float64 as json.
+func (o OptNilFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptNilFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilFloat64 to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v float64
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes IssueEventRequestHeaders as json.
+func (o OptNilIssueEventRequestHeaders) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes IssueEventRequestHeaders from json.
+func (o *OptNilIssueEventRequestHeaders) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilIssueEventRequestHeaders to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v IssueEventRequestHeaders
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	o.Value = make(IssueEventRequestHeaders)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilIssueEventRequestHeaders) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilIssueEventRequestHeaders) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptNilString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptNilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilString to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v string
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uint as json.
+func (o OptNilUint) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.UInt(uint(o.Value))
+}
+
+// Decode decodes uint from json.
+func (o *OptNilUint) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilUint to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v uint
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.UInt()
+	if err != nil {
+		return err
+	}
+	o.Value = uint(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilUint) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilUint) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReleaseAnalyticsDetailsSegmentsBrowserName as json.
+func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ReleaseAnalyticsDetailsSegmentsBrowserName from json.
+func (o *OptReleaseAnalyticsDetailsSegmentsBrowserName) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsBrowserName to nil")
+	}
+	o.Set = true
+	o.Value = make(ReleaseAnalyticsDetailsSegmentsBrowserName)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReleaseAnalyticsDetailsSegmentsBrowserName) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReleaseAnalyticsDetailsSegmentsBrowserName) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReleaseAnalyticsDetailsSegmentsDeviceArch as json.
+func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ReleaseAnalyticsDetailsSegmentsDeviceArch from json.
+func (o *OptReleaseAnalyticsDetailsSegmentsDeviceArch) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsDeviceArch to nil")
+	}
+	o.Set = true
+	o.Value = make(ReleaseAnalyticsDetailsSegmentsDeviceArch)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReleaseAnalyticsDetailsSegmentsDeviceArch) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReleaseAnalyticsDetailsSegmentsDeviceArch) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReleaseAnalyticsDetailsSegmentsOsName as json.
+func (o OptReleaseAnalyticsDetailsSegmentsOsName) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ReleaseAnalyticsDetailsSegmentsOsName from json.
+func (o *OptReleaseAnalyticsDetailsSegmentsOsName) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsOsName to nil")
+	}
+	o.Set = true
+	o.Value = make(ReleaseAnalyticsDetailsSegmentsOsName)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReleaseAnalyticsDetailsSegmentsOsName) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReleaseAnalyticsDetailsSegmentsOsName) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReleaseAnalyticsDetailsSegmentsPlatform as json.
+func (o OptReleaseAnalyticsDetailsSegmentsPlatform) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ReleaseAnalyticsDetailsSegmentsPlatform from json.
+func (o *OptReleaseAnalyticsDetailsSegmentsPlatform) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsPlatform to nil")
+	}
+	o.Set = true
+	o.Value = make(ReleaseAnalyticsDetailsSegmentsPlatform)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReleaseAnalyticsDetailsSegmentsPlatform) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReleaseAnalyticsDetailsSegmentsPlatform) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ReleaseAnalyticsDetailsSegmentsRuntimeName as json.
+func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ReleaseAnalyticsDetailsSegmentsRuntimeName from json.
+func (o *OptReleaseAnalyticsDetailsSegmentsRuntimeName) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsRuntimeName to nil")
+	}
+	o.Set = true
+	o.Value = make(ReleaseAnalyticsDetailsSegmentsRuntimeName)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptReleaseAnalyticsDetailsSegmentsRuntimeName) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptReleaseAnalyticsDetailsSegmentsRuntimeName) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TraceSpanTags as json.
+func (o OptTraceSpanTags) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes TraceSpanTags from json.
+func (o *OptTraceSpanTags) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTraceSpanTags to nil")
+	}
+	o.Set = true
+	o.Value = make(TraceSpanTags)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTraceSpanTags) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTraceSpanTags) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uint as json.
+func (o OptUint) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.UInt(uint(o.Value))
+}
+
+// Decode decodes uint from json.
+func (o *OptUint) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUint to nil")
+	}
+	o.Set = true
+	v, err := d.UInt()
+	if err != nil {
+		return err
+	}
+	o.Value = uint(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUint) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUint) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *OutcomeBucket) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OutcomeBucket) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("accepted")
+		e.UInt(s.Accepted)
+	}
+	{
+		e.FieldStart("filtered")
+		e.UInt(s.Filtered)
+	}
+	{
+		e.FieldStart("rate_limited")
+		e.UInt(s.RateLimited)
+	}
+	{
+		e.FieldStart("dropped")
+		e.UInt(s.Dropped)
+	}
+	{
+		e.FieldStart("bucket")
+		json.EncodeDateTime(e, s.Bucket)
+	}
+}
+
+var jsonFieldsNameOfOutcomeBucket = [5]string{
+	0: "accepted",
+	1: "filtered",
+	2: "rate_limited",
+	3: "dropped",
+	4: "bucket",
+}
+
+// Decode decodes OutcomeBucket from json.
+func (s *OutcomeBucket) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OutcomeBucket to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "accepted":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.Accepted = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"accepted\"")
+			}
+		case "filtered":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Filtered = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filtered\"")
+			}
+		case "rate_limited":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.UInt()
+				s.RateLimited = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limited\"")
+			}
+		case "dropped":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt()
+				s.Dropped = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dropped\"")
+			}
+		case "bucket":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Bucket = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bucket\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode OutcomeBucket")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOutcomeBucket) {
+					name = jsonFieldsNameOfOutcomeBucket[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *OutcomeBucket) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OutcomeBucket) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *OutcomeItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OutcomeItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("outcome")
+		s.Outcome.Encode(e)
+	}
+	{
+		e.FieldStart("reason")
+		e.Str(s.Reason)
+	}
+	{
+		e.FieldStart("category")
+		e.Str(s.Category)
+	}
+	{
+		e.FieldStart("group")
+		s.Group.Encode(e)
+	}
+	{
+		e.FieldStart("quantity")
+		e.UInt(s.Quantity)
+	}
+}
+
+var jsonFieldsNameOfOutcomeItem = [5]string{
+	0: "outcome",
+	1: "reason",
+	2: "category",
+	3: "group",
+	4: "quantity",
+}
+
+// Decode decodes OutcomeItem from json.
+func (s *OutcomeItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OutcomeItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "outcome":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Outcome.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"outcome\"")
+			}
+		case "reason":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Reason = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reason\"")
+			}
+		case "category":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Category = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category\"")
+			}
+		case "group":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				if err := s.Group.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"group\"")
+			}
+		case "quantity":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt()
+				s.Quantity = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"quantity\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode OutcomeItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOutcomeItem) {
+					name = jsonFieldsNameOfOutcomeItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *OutcomeItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OutcomeItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes OutcomeItemGroup as json.
+func (s OutcomeItemGroup) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes OutcomeItemGroup from json.
+func (s *OutcomeItemGroup) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OutcomeItemGroup to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch OutcomeItemGroup(v) {
+	case OutcomeItemGroupAccepted:
+		*s = OutcomeItemGroupAccepted
+	case OutcomeItemGroupFiltered:
+		*s = OutcomeItemGroupFiltered
+	case OutcomeItemGroupRateLimited:
+		*s = OutcomeItemGroupRateLimited
+	case OutcomeItemGroupDropped:
+		*s = OutcomeItemGroupDropped
+	default:
+		*s = OutcomeItemGroup(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OutcomeItemGroup) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OutcomeItemGroup) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes OutcomeItemOutcome as json.
+func (s OutcomeItemOutcome) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes OutcomeItemOutcome from json.
+func (s *OutcomeItemOutcome) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OutcomeItemOutcome to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch OutcomeItemOutcome(v) {
+	case OutcomeItemOutcomeAccepted:
+		*s = OutcomeItemOutcomeAccepted
+	case OutcomeItemOutcomeFiltered:
+		*s = OutcomeItemOutcomeFiltered
+	case OutcomeItemOutcomeRateLimited:
+		*s = OutcomeItemOutcomeRateLimited
+	case OutcomeItemOutcomeInvalid:
+		*s = OutcomeItemOutcomeInvalid
+	case OutcomeItemOutcomeClientDiscard:
+		*s = OutcomeItemOutcomeClientDiscard
+	default:
+		*s = OutcomeItemOutcome(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OutcomeItemOutcome) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OutcomeItemOutcome) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *OutcomeTotals) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OutcomeTotals) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("accepted")
+		e.UInt(s.Accepted)
+	}
+	{
+		e.FieldStart("filtered")
+		e.UInt(s.Filtered)
+	}
+	{
+		e.FieldStart("rate_limited")
+		e.UInt(s.RateLimited)
+	}
+	{
+		e.FieldStart("dropped")
+		e.UInt(s.Dropped)
+	}
+}
+
+var jsonFieldsNameOfOutcomeTotals = [4]string{
+	0: "accepted",
+	1: "filtered",
+	2: "rate_limited",
+	3: "dropped",
+}
+
+// Decode decodes OutcomeTotals from json.
+func (s *OutcomeTotals) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OutcomeTotals to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "accepted":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.Accepted = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"accepted\"")
+			}
+		case "filtered":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Filtered = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filtered\"")
+			}
+		case "rate_limited":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.UInt()
+				s.RateLimited = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limited\"")
+			}
+		case "dropped":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt()
+				s.Dropped = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dropped\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode OutcomeTotals")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOutcomeTotals) {
+					name = jsonFieldsNameOfOutcomeTotals[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *OutcomeTotals) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OutcomeTotals) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Period) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Period) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("interval")
+		e.Str(s.Interval)
+	}
+	{
+		e.FieldStart("granularity")
+		e.Str(s.Granularity)
+	}
+}
+
+var jsonFieldsNameOfPeriod = [2]string{
+	0: "interval",
+	1: "granularity",
+}
+
+// Decode decodes Period from json.
+func (s *Period) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Period to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "interval":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Interval = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"interval\"")
+			}
+		case "granularity":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Granularity = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"granularity\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Period")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPeriod) {
+					name = jsonFieldsNameOfPeriod[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Period) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Period) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Project) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Project) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
+	{
+		if s.TeamID.Set {
+			e.FieldStart("team_id")
+			s.TeamID.Encode(e)
+		}
+	}
+	{
+		if s.TeamName.Set {
+			e.FieldStart("team_name")
+			s.TeamName.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("public_key")
+		e.Str(s.PublicKey)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+}
+
+var jsonFieldsNameOfProject = [7]string{
+	0: "id",
+	1: "name",
+	2: "description",
+	3: "team_id",
+	4: "team_name",
+	5: "public_key",
+	6: "created_at",
+}
+
+// Decode decodes Project from json.
+func (s *Project) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Project to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Description = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "team_id":
+			if err := func() error {
+				s.TeamID.Reset()
+				if err := s.TeamID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"team_id\"")
+			}
+		case "team_name":
+			if err := func() error {
+				s.TeamName.Reset()
+				if err := s.TeamName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"team_name\"")
+			}
+		case "public_key":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.PublicKey = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"public_key\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Project")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01100111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProject) {
+					name = jsonFieldsNameOfProject[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Project) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Project) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectKey) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectKey) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		e.FieldStart("label")
+		e.Str(s.Label)
+	}
+	{
+		e.FieldStart("public_key")
+		e.Str(s.PublicKey)
+	}
+	{
+		e.FieldStart("secret_key")
+		e.Str(s.SecretKey)
+	}
+	{
+		e.FieldStart("is_active")
+		e.Bool(s.IsActive)
+	}
+	{
+		if s.RateLimit.Set {
+			e.FieldStart("rate_limit")
+			s.RateLimit.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("category_rate_limits")
+		s.CategoryRateLimits.Encode(e)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		if s.LastUsedAt.Set {
+			e.FieldStart("last_used_at")
+			s.LastUsedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfProjectKey = [10]string{
+	0: "id",
+	1: "project_id",
+	2: "label",
+	3: "public_key",
+	4: "secret_key",
+	5: "is_active",
+	6: "rate_limit",
+	7: "category_rate_limits",
+	8: "created_at",
+	9: "last_used_at",
+}
+
+// Decode decodes ProjectKey from json.
+func (s *ProjectKey) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectKey to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "project_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "label":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Label = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"label\"")
+			}
+		case "public_key":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.PublicKey = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"public_key\"")
+			}
+		case "secret_key":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.SecretKey = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"secret_key\"")
+			}
+		case "is_active":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsActive = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_active\"")
+			}
+		case "rate_limit":
+			if err := func() error {
+				s.RateLimit.Reset()
+				if err := s.RateLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limit\"")
+			}
+		case "category_rate_limits":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				if err := s.CategoryRateLimits.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category_rate_limits\"")
+			}
+		case "created_at":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "last_used_at":
+			if err := func() error {
+				s.LastUsedAt.Reset()
+				if err := s.LastUsedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"last_used_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectKey")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b10111111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectKey) {
+					name = jsonFieldsNameOfProjectKey[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectKey) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectKey) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectKeyRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectKeyRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("label")
+		e.Str(s.Label)
+	}
+	{
+		if s.RateLimit.Set {
+			e.FieldStart("rate_limit")
+			s.RateLimit.Encode(e)
+		}
+	}
+	{
+		if s.CategoryRateLimits.Set {
+			e.FieldStart("category_rate_limits")
+			s.CategoryRateLimits.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfProjectKeyRequest = [3]string{
+	0: "label",
+	1: "rate_limit",
+	2: "category_rate_limits",
+}
+
+// Decode decodes ProjectKeyRequest from json.
+func (s *ProjectKeyRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectKeyRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "label":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Label = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"label\"")
+			}
+		case "rate_limit":
+			if err := func() error {
+				s.RateLimit.Reset()
+				if err := s.RateLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limit\"")
+			}
+		case "category_rate_limits":
+			if err := func() error {
+				s.CategoryRateLimits.Reset()
+				if err := s.CategoryRateLimits.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category_rate_limits\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectKeyRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectKeyRequest) {
+					name = jsonFieldsNameOfProjectKeyRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectKeyRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectKeyRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectOutcomesResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectOutcomesResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("period")
+		s.Period.Encode(e)
+	}
+	{
+		e.FieldStart("totals")
+		s.Totals.Encode(e)
+	}
+	{
+		e.FieldStart("items")
+		e.ArrStart()
+		for _, elem := range s.Items {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("timeseries")
+		e.ArrStart()
+		for _, elem := range s.Timeseries {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectOutcomesResponse = [4]string{
+	0: "period",
+	1: "totals",
+	2: "items",
+	3: "timeseries",
+}
+
+// Decode decodes ProjectOutcomesResponse from json.
+func (s *ProjectOutcomesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectOutcomesResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "period":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Period.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"period\"")
+			}
+		case "totals":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Totals.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"totals\"")
+			}
+		case "items":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Items = make([]OutcomeItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OutcomeItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Items = append(s.Items, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"items\"")
+			}
+		case "timeseries":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Timeseries = make([]OutcomeBucket, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem OutcomeBucket
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Timeseries = append(s.Timeseries, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timeseries\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectOutcomesResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectOutcomesResponse) {
+					name = jsonFieldsNameOfProjectOutcomesResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectOutcomesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectOutcomesResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectRateLimits) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectRateLimits) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("project_id")
+		e.UInt(s.ProjectID)
+	}
+	{
+		if s.RateLimit.Set {
+			e.FieldStart("rate_limit")
+			s.RateLimit.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("category_rate_limits")
+		s.CategoryRateLimits.Encode(e)
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfProjectRateLimits = [4]string{
+	0: "project_id",
+	1: "rate_limit",
+	2: "category_rate_limits",
+	3: "updated_at",
+}
+
+// Decode decodes ProjectRateLimits from json.
+func (s *ProjectRateLimits) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectRateLimits to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "project_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ProjectID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project_id\"")
+			}
+		case "rate_limit":
+			if err := func() error {
+				s.RateLimit.Reset()
+				if err := s.RateLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limit\"")
+			}
+		case "category_rate_limits":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.CategoryRateLimits.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category_rate_limits\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectRateLimits")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectRateLimits) {
+					name = jsonFieldsNameOfProjectRateLimits[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectRateLimits) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectRateLimits) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("project")
+		s.Project.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfProjectResponse = [1]string{
+	0: "project",
+}
+
+// Decode decodes ProjectResponse from json.
+func (s *ProjectResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "project":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Project.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"project\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectResponse) {
+					name = jsonFieldsNameOfProjectResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectSpike) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectSpike) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.UInt(s.ID)
+	}
+	{
+		e.FieldStart("started_at")
+		json.EncodeDateTime(e, s.StartedAt)
+	}
+	{
+		e.FieldStart("ends_at")
+		json.EncodeDateTime(e, s.EndsAt)
+	}
+	{
+		e.FieldStart("baseline_rps")
+		e.UInt64(s.BaselineRps)
+	}
+	{
+		e.FieldStart("peak_rps")
+		e.UInt64(s.PeakRps)
+	}
+	{
+		e.FieldStart("cap_rps")
+		e.UInt64(s.CapRps)
+	}
+}
+
+var jsonFieldsNameOfProjectSpike = [6]string{
+	0: "id",
+	1: "started_at",
+	2: "ends_at",
+	3: "baseline_rps",
+	4: "peak_rps",
+	5: "cap_rps",
+}
+
+// Decode decodes ProjectSpike from json.
+func (s *ProjectSpike) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectSpike to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "started_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"started_at\"")
+			}
+		case "ends_at":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndsAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ends_at\"")
+			}
+		case "baseline_rps":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt64()
+				s.BaselineRps = uint64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"baseline_rps\"")
+			}
+		case "peak_rps":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt64()
+				s.PeakRps = uint64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"peak_rps\"")
+			}
+		case "cap_rps":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.UInt64()
+				s.CapRps = uint64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cap_rps\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectSpike")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectSpike) {
+					name = jsonFieldsNameOfProjectSpike[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectSpike) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectSpike) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectStatsResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectStatsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("total_issues")
+		e.UInt(s.TotalIssues)
+	}
+	{
+		e.FieldStart("issues_by_level")
+		s.IssuesByLevel.Encode(e)
+	}
+	{
+		e.FieldStart("most_frequent_issues")
+		e.ArrStart()
+		for _, elem := range s.MostFrequentIssues {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("spikes")
+		e.ArrStart()
+		for _, elem := range s.Spikes {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfProjectStatsResponse = [4]string{
+	0: "total_issues",
+	1: "issues_by_level",
+	2: "most_frequent_issues",
+	3: "spikes",
+}
+
+// Decode decodes ProjectStatsResponse from json.
+func (s *ProjectStatsResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectStatsResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "total_issues":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.TotalIssues = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total_issues\"")
+			}
+		case "issues_by_level":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.IssuesByLevel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issues_by_level\"")
+			}
+		case "most_frequent_issues":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.MostFrequentIssues = make([]IssueSummary, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueSummary
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MostFrequentIssues = append(s.MostFrequentIssues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"most_frequent_issues\"")
+			}
+		case "spikes":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Spikes = make([]ProjectSpike, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ProjectSpike
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Spikes = append(s.Spikes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spikes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectStatsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectStatsResponse) {
+					name = jsonFieldsNameOfProjectStatsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectStatsResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectStatsResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProjectStatsResponseIssuesByLevel) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProjectStatsResponseIssuesByLevel) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("fatal")
+		e.UInt(s.Fatal)
+	}
+	{
+		e.FieldStart("exception")
+		e.UInt(s.Exception)
+	}
+	{
+		e.FieldStart("error")
+		e.UInt(s.Error)
+	}
+	{
+		e.FieldStart("warning")
+		e.UInt(s.Warning)
+	}
+	{
+		e.FieldStart("info")
+		e.UInt(s.Info)
+	}
+	{
+		e.FieldStart("debug")
+		e.UInt(s.Debug)
+	}
+}
+
+var jsonFieldsNameOfProjectStatsResponseIssuesByLevel = [6]string{
+	0: "fatal",
+	1: "exception",
+	2: "error",
+	3: "warning",
+	4: "info",
+	5: "debug",
+}
+
+// Decode decodes ProjectStatsResponseIssuesByLevel from json.
+func (s *ProjectStatsResponseIssuesByLevel) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProjectStatsResponseIssuesByLevel to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "fatal":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.Fatal = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fatal\"")
+			}
+		case "exception":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Exception = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exception\"")
+			}
+		case "error":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.UInt()
+				s.Error = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "warning":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt()
+				s.Warning = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"warning\"")
+			}
+		case "info":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt()
+				s.Info = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"info\"")
+			}
+		case "debug":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.UInt()
+				s.Debug = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"debug\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProjectStatsResponseIssuesByLevel")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProjectStatsResponseIssuesByLevel) {
+					name = jsonFieldsNameOfProjectStatsResponseIssuesByLevel[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProjectStatsResponseIssuesByLevel) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProjectStatsResponseIssuesByLevel) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RefreshTokenRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RefreshTokenRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+}
+
+var jsonFieldsNameOfRefreshTokenRequest = [1]string{
+	0: "refresh_token",
+}
+
+// Decode decodes RefreshTokenRequest from json.
+func (s *RefreshTokenRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshTokenRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RefreshTokenRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshTokenRequest) {
+					name = jsonFieldsNameOfRefreshTokenRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshTokenRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshTokenRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RefreshTokenResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RefreshTokenResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("access_token")
+		e.Str(s.AccessToken)
+	}
+	{
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
+	}
+	{
+		e.FieldStart("expires_in")
+		e.Int(s.ExpiresIn)
+	}
+}
+
+var jsonFieldsNameOfRefreshTokenResponse = [3]string{
+	0: "access_token",
+	1: "refresh_token",
+	2: "expires_in",
+}
+
+// Decode decodes RefreshTokenResponse from json.
+func (s *RefreshTokenResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RefreshTokenResponse to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "access_token":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AccessToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"access_token\"")
+			}
+		case "refresh_token":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.RefreshToken = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"refresh_token\"")
+			}
+		case "expires_in":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.ExpiresIn = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expires_in\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RefreshTokenResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfRefreshTokenResponse) {
+					name = jsonFieldsNameOfRefreshTokenResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RefreshTokenResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RefreshTokenResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReleaseAnalyticsDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReleaseAnalyticsDetails) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("version")
+		e.Str(s.Version)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("stats")
+		s.Stats.Encode(e)
+	}
+	{
+		e.FieldStart("top_issues")
+		e.ArrStart()
+		for _, elem := range s.TopIssues {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("severity_distribution")
+		s.SeverityDistribution.Encode(e)
+	}
+	{
+		e.FieldStart("fix_time")
+		s.FixTime.Encode(e)
+	}
+	{
+		e.FieldStart("segments")
+		s.Segments.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfReleaseAnalyticsDetails = [7]string{
+	0: "version",
+	1: "created_at",
+	2: "stats",
+	3: "top_issues",
+	4: "severity_distribution",
+	5: "fix_time",
+	6: "segments",
+}
+
+// Decode decodes ReleaseAnalyticsDetails from json.
+func (s *ReleaseAnalyticsDetails) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetails to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "version":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Version = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "stats":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Stats.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"stats\"")
+			}
+		case "top_issues":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.TopIssues = make([]IssueSummary, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueSummary
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.TopIssues = append(s.TopIssues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"top_issues\"")
+			}
+		case "severity_distribution":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.SeverityDistribution.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"severity_distribution\"")
+			}
+		case "fix_time":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				if err := s.FixTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fix_time\"")
+			}
+		case "segments":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.Segments.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"segments\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetails")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b01111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReleaseAnalyticsDetails) {
+					name = jsonFieldsNameOfReleaseAnalyticsDetails[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ReleaseAnalyticsDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ReleaseAnalyticsDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ReleaseAnalyticsDetailsFixTime) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReleaseAnalyticsDetailsFixTime) encodeFields(e *jx.Encoder) {
+	{
+		if s.Avg.Set {
+			e.FieldStart("avg")
+			s.Avg.Encode(e)
+		}
+	}
+	{
+		if s.Median.Set {
+			e.FieldStart("median")
+			s.Median.Encode(e)
+		}
+	}
+	{
+		if s.P95.Set {
+			e.FieldStart("p95")
+			s.P95.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfReleaseAnalyticsDetailsFixTime = [3]string{
+	0: "avg",
+	1: "median",
+	2: "p95",
+}
+
+// Decode decodes ReleaseAnalyticsDetailsFixTime from json.
+func (s *ReleaseAnalyticsDetailsFixTime) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsFixTime to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "avg":
+			if err := func() error {
+				s.Avg.Reset()
+				if err := s.Avg.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avg\"")
+			}
+		case "median":
+			if err := func() error {
+				s.Median.Reset()
+				if err := s.Median.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"median\"")
+			}
+		case "p95":
+			if err := func() error {
+				s.P95.Reset()
+				if err := s.P95.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"p95\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsFixTime")
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilString) MarshalJSON() ([]byte, error) {
+func (s *ReleaseAnalyticsDetailsFixTime) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilString) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsFixTime) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes uint as json.
-func (o OptNilUint) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *ReleaseAnalyticsDetailsSegments) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReleaseAnalyticsDetailsSegments) encodeFields(e *jx.Encoder) {
+	{
+		if s.Platform.Set {
+			e.FieldStart("platform")
+			s.Platform.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.BrowserName.Set {
+			e.FieldStart("browser_name")
+			s.BrowserName.Encode(e)
+		}
+	}
+	{
+		if s.OsName.Set {
+			e.FieldStart("os_name")
+			s.OsName.Encode(e)
+		}
+	}
+	{
+		if s.DeviceArch.Set {
+			e.FieldStart("device_arch")
+			s.DeviceArch.Encode(e)
+		}
+	}
+	{
+		if s.RuntimeName.Set {
+			e.FieldStart("runtime_name")
+			s.RuntimeName.Encode(e)
+		}
 	}
-	e.UInt(uint(o.Value))
 }
 
-// Decode decodes uint from json.
-func (o *OptNilUint) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilUint to nil")
+var jsonFieldsNameOfReleaseAnalyticsDetailsSegments = [5]string{
+	0: "platform",
+	1: "browser_name",
+	2: "os_name",
+	3: "device_arch",
+	4: "runtime_name",
+}
+
+// Decode decodes ReleaseAnalyticsDetailsSegments from json.
+func (s *ReleaseAnalyticsDetailsSegments) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegments to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
 
-		var v uint
-		o.Value = v
-		o.Set = true
-		o.Null = true
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "platform":
+			if err := func() error {
+				s.Platform.Reset()
+				if err := s.Platform.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"platform\"")
+			}
+		case "browser_name":
+			if err := func() error {
+				s.BrowserName.Reset()
+				if err := s.BrowserName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"browser_name\"")
+			}
+		case "os_name":
+			if err := func() error {
+				s.OsName.Reset()
+				if err := s.OsName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"os_name\"")
+			}
+		case "device_arch":
+			if err := func() error {
+				s.DeviceArch.Reset()
+				if err := s.DeviceArch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"device_arch\"")
+			}
+		case "runtime_name":
+			if err := func() error {
+				s.RuntimeName.Reset()
+				if err := s.RuntimeName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"runtime_name\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegments")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.UInt()
-	if err != nil {
-		return err
-	}
-	o.Value = uint(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilUint) MarshalJSON() ([]byte, error) {
+func (s *ReleaseAnalyticsDetailsSegments) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilUint) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSegments) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ReleaseAnalyticsDetailsSegmentsBrowserName as json.
-func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsBrowserName) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsBrowserName) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.UInt(elem)
 	}
-	o.Value.Encode(e)
 }
 
 // Decode decodes ReleaseAnalyticsDetailsSegmentsBrowserName from json.
-func (o *OptReleaseAnalyticsDetailsSegmentsBrowserName) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsBrowserName to nil")
+func (s *ReleaseAnalyticsDetailsSegmentsBrowserName) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsBrowserName to nil")
 	}
-	o.Set = true
-	o.Value = make(ReleaseAnalyticsDetailsSegmentsBrowserName)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsBrowserName")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptReleaseAnalyticsDetailsSegmentsBrowserName) MarshalJSON() ([]byte, error) {
+func (s ReleaseAnalyticsDetailsSegmentsBrowserName) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptReleaseAnalyticsDetailsSegmentsBrowserName) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSegmentsBrowserName) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ReleaseAnalyticsDetailsSegmentsDeviceArch as json.
-func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsDeviceArch) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsDeviceArch) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.UInt(elem)
 	}
-	o.Value.Encode(e)
 }
 
 // Decode decodes ReleaseAnalyticsDetailsSegmentsDeviceArch from json.
-func (o *OptReleaseAnalyticsDetailsSegmentsDeviceArch) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsDeviceArch to nil")
+func (s *ReleaseAnalyticsDetailsSegmentsDeviceArch) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsDeviceArch to nil")
 	}
-	o.Set = true
-	o.Value = make(ReleaseAnalyticsDetailsSegmentsDeviceArch)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsDeviceArch")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptReleaseAnalyticsDetailsSegmentsDeviceArch) MarshalJSON() ([]byte, error) {
+func (s ReleaseAnalyticsDetailsSegmentsDeviceArch) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptReleaseAnalyticsDetailsSegmentsDeviceArch) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSegmentsDeviceArch) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ReleaseAnalyticsDetailsSegmentsOsName as json.
-func (o OptReleaseAnalyticsDetailsSegmentsOsName) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsOsName) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsOsName) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.UInt(elem)
 	}
-	o.Value.Encode(e)
 }
 
 // Decode decodes ReleaseAnalyticsDetailsSegmentsOsName from json.
-func (o *OptReleaseAnalyticsDetailsSegmentsOsName) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsOsName to nil")
+func (s *ReleaseAnalyticsDetailsSegmentsOsName) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsOsName to nil")
 	}
-	o.Set = true
-	o.Value = make(ReleaseAnalyticsDetailsSegmentsOsName)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsOsName")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptReleaseAnalyticsDetailsSegmentsOsName) MarshalJSON() ([]byte, error) {
+func (s ReleaseAnalyticsDetailsSegmentsOsName) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptReleaseAnalyticsDetailsSegmentsOsName) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSegmentsOsName) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ReleaseAnalyticsDetailsSegmentsPlatform as json.
-func (o OptReleaseAnalyticsDetailsSegmentsPlatform) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsPlatform) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsPlatform) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.UInt(elem)
 	}
-	o.Value.Encode(e)
 }
 
 // Decode decodes ReleaseAnalyticsDetailsSegmentsPlatform from json.
-func (o *OptReleaseAnalyticsDetailsSegmentsPlatform) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsPlatform to nil")
+func (s *ReleaseAnalyticsDetailsSegmentsPlatform) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsPlatform to nil")
 	}
-	o.Set = true
-	o.Value = make(ReleaseAnalyticsDetailsSegmentsPlatform)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsPlatform")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptReleaseAnalyticsDetailsSegmentsPlatform) MarshalJSON() ([]byte, error) {
+func (s ReleaseAnalyticsDetailsSegmentsPlatform) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptReleaseAnalyticsDetailsSegmentsPlatform) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSegmentsPlatform) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes ReleaseAnalyticsDetailsSegmentsRuntimeName as json.
-func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsRuntimeName) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSegmentsRuntimeName) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.UInt(elem)
 	}
-	o.Value.Encode(e)
 }
 
 // Decode decodes ReleaseAnalyticsDetailsSegmentsRuntimeName from json.
-func (o *OptReleaseAnalyticsDetailsSegmentsRuntimeName) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptReleaseAnalyticsDetailsSegmentsRuntimeName to nil")
+func (s *ReleaseAnalyticsDetailsSegmentsRuntimeName) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsRuntimeName to nil")
 	}
-	o.Set = true
-	o.Value = make(ReleaseAnalyticsDetailsSegmentsRuntimeName)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsRuntimeName")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptReleaseAnalyticsDetailsSegmentsRuntimeName) MarshalJSON() ([]byte, error) {
+func (s ReleaseAnalyticsDetailsSegmentsRuntimeName) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptReleaseAnalyticsDetailsSegmentsRuntimeName) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSegmentsRuntimeName) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSeverityDistribution) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ReleaseAnalyticsDetailsSeverityDistribution) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.UInt(elem)
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
+// Decode decodes ReleaseAnalyticsDetailsSeverityDistribution from json.
+func (s *ReleaseAnalyticsDetailsSeverityDistribution) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSeverityDistribution to nil")
 	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSeverityDistribution")
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s ReleaseAnalyticsDetailsSeverityDistribution) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsDetailsSeverityDistribution) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes uint as json.
-func (o OptUint) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *ReleaseAnalyticsSummary) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ReleaseAnalyticsSummary) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("version")
+		e.Str(s.Version)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("known_issues_total")
+		e.UInt(s.KnownIssuesTotal)
+	}
+	{
+		e.FieldStart("new_issues_total")
+		e.UInt(s.NewIssuesTotal)
+	}
+	{
+		e.FieldStart("regressions_total")
+		e.UInt(s.RegressionsTotal)
+	}
+	{
+		e.FieldStart("resolved_in_version_total")
+		e.UInt(s.ResolvedInVersionTotal)
+	}
+	{
+		e.FieldStart("users_affected")
+		e.UInt(s.UsersAffected)
+	}
+	{
+		if s.SessionsTotal.Set {
+			e.FieldStart("sessions_total")
+			s.SessionsTotal.Encode(e)
+		}
+	}
+	{
+		if s.SessionsErrored.Set {
+			e.FieldStart("sessions_errored")
+			s.SessionsErrored.Encode(e)
+		}
+	}
+	{
+		if s.SessionsCrashed.Set {
+			e.FieldStart("sessions_crashed")
+			s.SessionsCrashed.Encode(e)
+		}
+	}
+	{
+		if s.UsersTotal.Set {
+			e.FieldStart("users_total")
+			s.UsersTotal.Encode(e)
+		}
+	}
+	{
+		if s.CrashFreeSessions.Set {
+			e.FieldStart("crash_free_sessions")
+			s.CrashFreeSessions.Encode(e)
+		}
+	}
+	{
+		if s.CrashFreeUsers.Set {
+			e.FieldStart("crash_free_users")
+			s.CrashFreeUsers.Encode(e)
+		}
+	}
+	{
+		if s.Adoption.Set {
+			e.FieldStart("adoption")
+			s.Adoption.Encode(e)
+		}
+	}
+	{
+		if s.AvgSessionDuration.Set {
+			e.FieldStart("avg_session_duration")
+			s.AvgSessionDuration.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfReleaseAnalyticsSummary = [15]string{
+	0:  "version",
+	1:  "created_at",
+	2:  "known_issues_total",
+	3:  "new_issues_total",
+	4:  "regressions_total",
+	5:  "resolved_in_version_total",
+	6:  "users_affected",
+	7:  "sessions_total",
+	8:  "sessions_errored",
+	9:  "sessions_crashed",
+	10: "users_total",
+	11: "crash_free_sessions",
+	12: "crash_free_users",
+	13: "adoption",
+	14: "avg_session_duration",
+}
+
+// Decode decodes ReleaseAnalyticsSummary from json.
+func (s *ReleaseAnalyticsSummary) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ReleaseAnalyticsSummary to nil")
 	}
-	e.UInt(uint(o.Value))
-}
+	var requiredBitSet [2]uint8
 
-// Decode decodes uint from json.
-func (o *OptUint) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptUint to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "version":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Version = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "known_issues_total":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.UInt()
+				s.KnownIssuesTotal = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"known_issues_total\"")
+			}
+		case "new_issues_total":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.UInt()
+				s.NewIssuesTotal = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_issues_total\"")
+			}
+		case "regressions_total":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt()
+				s.RegressionsTotal = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"regressions_total\"")
+			}
+		case "resolved_in_version_total":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.UInt()
+				s.ResolvedInVersionTotal = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resolved_in_version_total\"")
+			}
+		case "users_affected":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.UInt()
+				s.UsersAffected = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"users_affected\"")
+			}
+		case "sessions_total":
+			if err := func() error {
+				s.SessionsTotal.Reset()
+				if err := s.SessionsTotal.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sessions_total\"")
+			}
+		case "sessions_errored":
+			if err := func() error {
+				s.SessionsErrored.Reset()
+				if err := s.SessionsErrored.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sessions_errored\"")
+			}
+		case "sessions_crashed":
+			if err := func() error {
+				s.SessionsCrashed.Reset()
+				if err := s.SessionsCrashed.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sessions_crashed\"")
+			}
+		case "users_total":
+			if err := func() error {
+				s.UsersTotal.Reset()
+				if err := s.UsersTotal.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"users_total\"")
+			}
+		case "crash_free_sessions":
+			if err := func() error {
+				s.CrashFreeSessions.Reset()
+				if err := s.CrashFreeSessions.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"crash_free_sessions\"")
+			}
+		case "crash_free_users":
+			if err := func() error {
+				s.CrashFreeUsers.Reset()
+				if err := s.CrashFreeUsers.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"crash_free_users\"")
+			}
+		case "adoption":
+			if err := func() error {
+				s.Adoption.Reset()
+				if err := s.Adoption.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"adoption\"")
+			}
+		case "avg_session_duration":
+			if err := func() error {
+				s.AvgSessionDuration.Reset()
+				if err := s.AvgSessionDuration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"avg_session_duration\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseAnalyticsSummary")
 	}
-	o.Set = true
-	v, err := d.UInt()
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b01111111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfReleaseAnalyticsSummary) {
+					name = jsonFieldsNameOfReleaseAnalyticsSummary[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Value = uint(v)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptUint) MarshalJSON() ([]byte, error) {
+func (s *ReleaseAnalyticsSummary) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptUint) UnmarshalJSON(data []byte) error {
+func (s *ReleaseAnalyticsSummary) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Period) Encode(e *jx.Encoder) {
+func (s *ReleaseComparison) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Period) encodeFields(e *jx.Encoder) {
+func (s *ReleaseComparison) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("interval")
-		e.Str(s.Interval)
+		e.FieldStart("base")
+		s.Base.Encode(e)
 	}
 	{
-		e.FieldStart("granularity")
-		e.Str(s.Granularity)
+		e.FieldStart("target")
+		s.Target.Encode(e)
+	}
+	{
+		e.FieldStart("delta")
+		s.Delta.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfPeriod = [2]string{
-	0: "interval",
-	1: "granularity",
+var jsonFieldsNameOfReleaseComparison = [3]string{
+	0: "base",
+	1: "target",
+	2: "delta",
 }
 
-// Decode decodes Period from json.
-func (s *Period) Decode(d *jx.Decoder) error {
+// Decode decodes ReleaseComparison from json.
+func (s *ReleaseComparison) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Period to nil")
+		return errors.New("invalid: unable to decode ReleaseComparison to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "interval":
+		case "base":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Interval = string(v)
-				if err != nil {
+				if err := s.Base.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"interval\"")
+				return errors.Wrap(err, "decode field \"base\"")
 			}
-		case "granularity":
+		case "target":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Granularity = string(v)
-				if err != nil {
+				if err := s.Target.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"granularity\"")
+				return errors.Wrap(err, "decode field \"target\"")
+			}
+		case "delta":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Delta.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"delta\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Period")
+		return errors.Wrap(err, "decode ReleaseComparison")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -6862,8 +17768,8 @@ func (s *Period) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPeriod) {
-					name = jsonFieldsNameOfPeriod[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfReleaseComparison) {
+					name = jsonFieldsNameOfReleaseComparison[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -6884,403 +17790,283 @@ func (s *Period) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Period) MarshalJSON() ([]byte, error) {
+func (s *ReleaseComparison) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Period) UnmarshalJSON(data []byte) error {
+func (s *ReleaseComparison) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Project) Encode(e *jx.Encoder) {
+func (s *ReleaseComparisonDelta) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Project) encodeFields(e *jx.Encoder) {
+func (s *ReleaseComparisonDelta) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.UInt(s.ID)
+		if s.KnownIssuesTotal.Set {
+			e.FieldStart("known_issues_total")
+			s.KnownIssuesTotal.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		if s.NewIssuesTotal.Set {
+			e.FieldStart("new_issues_total")
+			s.NewIssuesTotal.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("description")
-		e.Str(s.Description)
+		if s.RegressionsTotal.Set {
+			e.FieldStart("regressions_total")
+			s.RegressionsTotal.Encode(e)
+		}
 	}
 	{
-		if s.TeamID.Set {
-			e.FieldStart("team_id")
-			s.TeamID.Encode(e)
+		if s.ResolvedInVersionTotal.Set {
+			e.FieldStart("resolved_in_version_total")
+			s.ResolvedInVersionTotal.Encode(e)
 		}
 	}
 	{
-		if s.TeamName.Set {
-			e.FieldStart("team_name")
-			s.TeamName.Encode(e)
+		if s.UsersAffected.Set {
+			e.FieldStart("users_affected")
+			s.UsersAffected.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("public_key")
-		e.Str(s.PublicKey)
+		if s.SessionsTotal.Set {
+			e.FieldStart("sessions_total")
+			s.SessionsTotal.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
+		if s.CrashFreeSessions.Set {
+			e.FieldStart("crash_free_sessions")
+			s.CrashFreeSessions.Encode(e)
+		}
+	}
+	{
+		if s.CrashFreeUsers.Set {
+			e.FieldStart("crash_free_users")
+			s.CrashFreeUsers.Encode(e)
+		}
+	}
+	{
+		if s.Adoption.Set {
+			e.FieldStart("adoption")
+			s.Adoption.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfProject = [7]string{
-	0: "id",
-	1: "name",
-	2: "description",
-	3: "team_id",
-	4: "team_name",
-	5: "public_key",
-	6: "created_at",
+var jsonFieldsNameOfReleaseComparisonDelta = [9]string{
+	0: "known_issues_total",
+	1: "new_issues_total",
+	2: "regressions_total",
+	3: "resolved_in_version_total",
+	4: "users_affected",
+	5: "sessions_total",
+	6: "crash_free_sessions",
+	7: "crash_free_users",
+	8: "adoption",
 }
 
-// Decode decodes Project from json.
-func (s *Project) Decode(d *jx.Decoder) error {
+// Decode decodes ReleaseComparisonDelta from json.
+func (s *ReleaseComparisonDelta) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Project to nil")
+		return errors.New("invalid: unable to decode ReleaseComparisonDelta to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "known_issues_total":
 			if err := func() error {
-				v, err := d.UInt()
-				s.ID = uint(v)
-				if err != nil {
+				s.KnownIssuesTotal.Reset()
+				if err := s.KnownIssuesTotal.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"known_issues_total\"")
 			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
+		case "new_issues_total":
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				s.NewIssuesTotal.Reset()
+				if err := s.NewIssuesTotal.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"new_issues_total\"")
 			}
-		case "description":
-			requiredBitSet[0] |= 1 << 2
+		case "regressions_total":
 			if err := func() error {
-				v, err := d.Str()
-				s.Description = string(v)
-				if err != nil {
+				s.RegressionsTotal.Reset()
+				if err := s.RegressionsTotal.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"regressions_total\"")
 			}
-		case "team_id":
+		case "resolved_in_version_total":
 			if err := func() error {
-				s.TeamID.Reset()
-				if err := s.TeamID.Decode(d); err != nil {
+				s.ResolvedInVersionTotal.Reset()
+				if err := s.ResolvedInVersionTotal.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"team_id\"")
+				return errors.Wrap(err, "decode field \"resolved_in_version_total\"")
 			}
-		case "team_name":
+		case "users_affected":
 			if err := func() error {
-				s.TeamName.Reset()
-				if err := s.TeamName.Decode(d); err != nil {
+				s.UsersAffected.Reset()
+				if err := s.UsersAffected.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"team_name\"")
+				return errors.Wrap(err, "decode field \"users_affected\"")
 			}
-		case "public_key":
-			requiredBitSet[0] |= 1 << 5
+		case "sessions_total":
 			if err := func() error {
-				v, err := d.Str()
-				s.PublicKey = string(v)
-				if err != nil {
+				s.SessionsTotal.Reset()
+				if err := s.SessionsTotal.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"public_key\"")
+				return errors.Wrap(err, "decode field \"sessions_total\"")
 			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 6
+		case "crash_free_sessions":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
+				s.CrashFreeSessions.Reset()
+				if err := s.CrashFreeSessions.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"crash_free_sessions\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Project")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b01100111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfProject) {
-					name = jsonFieldsNameOfProject[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "crash_free_users":
+			if err := func() error {
+				s.CrashFreeUsers.Reset()
+				if err := s.CrashFreeUsers.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"crash_free_users\"")
 			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Project) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Project) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ProjectResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ProjectResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("project")
-		s.Project.Encode(e)
-	}
-}
-
-var jsonFieldsNameOfProjectResponse = [1]string{
-	0: "project",
-}
-
-// Decode decodes ProjectResponse from json.
-func (s *ProjectResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ProjectResponse to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "project":
-			requiredBitSet[0] |= 1 << 0
+		case "adoption":
 			if err := func() error {
-				if err := s.Project.Decode(d); err != nil {
+				s.Adoption.Reset()
+				if err := s.Adoption.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"project\"")
+				return errors.Wrap(err, "decode field \"adoption\"")
 			}
 		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ProjectResponse")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectResponse) {
-					name = jsonFieldsNameOfProjectResponse[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
+			return d.Skip()
 		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseComparisonDelta")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectResponse) MarshalJSON() ([]byte, error) {
+func (s *ReleaseComparisonDelta) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectResponse) UnmarshalJSON(data []byte) error {
+func (s *ReleaseComparisonDelta) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectStatsResponse) Encode(e *jx.Encoder) {
+func (s *ReleaseSegmentsResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ProjectStatsResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("total_issues")
-		e.UInt(s.TotalIssues)
-	}
+func (s *ReleaseSegmentsResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("issues_by_level")
-		s.IssuesByLevel.Encode(e)
+		e.FieldStart("segment")
+		e.Str(s.Segment)
 	}
 	{
-		e.FieldStart("most_frequent_issues")
-		e.ArrStart()
-		for _, elem := range s.MostFrequentIssues {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("values")
+		s.Values.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfProjectStatsResponse = [3]string{
-	0: "total_issues",
-	1: "issues_by_level",
-	2: "most_frequent_issues",
+var jsonFieldsNameOfReleaseSegmentsResponse = [2]string{
+	0: "segment",
+	1: "values",
 }
 
-// Decode decodes ProjectStatsResponse from json.
-func (s *ProjectStatsResponse) Decode(d *jx.Decoder) error {
+// Decode decodes ReleaseSegmentsResponse from json.
+func (s *ReleaseSegmentsResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectStatsResponse to nil")
+		return errors.New("invalid: unable to decode ReleaseSegmentsResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "total_issues":
+		case "segment":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.UInt()
-				s.TotalIssues = uint(v)
+				v, err := d.Str()
+				s.Segment = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total_issues\"")
+				return errors.Wrap(err, "decode field \"segment\"")
 			}
-		case "issues_by_level":
+		case "values":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.IssuesByLevel.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"issues_by_level\"")
-			}
-		case "most_frequent_issues":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				s.MostFrequentIssues = make([]IssueSummary, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem IssueSummary
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.MostFrequentIssues = append(s.MostFrequentIssues, elem)
-					return nil
-				}); err != nil {
+				if err := s.Values.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"most_frequent_issues\"")
+				return errors.Wrap(err, "decode field \"values\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ProjectStatsResponse")
+		return errors.Wrap(err, "decode ReleaseSegmentsResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7292,8 +18078,8 @@ func (s *ProjectStatsResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectStatsResponse) {
-					name = jsonFieldsNameOfProjectStatsResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfReleaseSegmentsResponse) {
+					name = jsonFieldsNameOfReleaseSegmentsResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7314,250 +18100,142 @@ func (s *ProjectStatsResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectStatsResponse) MarshalJSON() ([]byte, error) {
+func (s *ReleaseSegmentsResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectStatsResponse) UnmarshalJSON(data []byte) error {
+func (s *ReleaseSegmentsResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ProjectStatsResponseIssuesByLevel) Encode(e *jx.Encoder) {
+func (s ReleaseSegmentsResponseValues) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *ProjectStatsResponseIssuesByLevel) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("fatal")
-		e.UInt(s.Fatal)
-	}
-	{
-		e.FieldStart("exception")
-		e.UInt(s.Exception)
-	}
-	{
-		e.FieldStart("error")
-		e.UInt(s.Error)
-	}
-	{
-		e.FieldStart("warning")
-		e.UInt(s.Warning)
-	}
-	{
-		e.FieldStart("info")
-		e.UInt(s.Info)
-	}
-	{
-		e.FieldStart("debug")
-		e.UInt(s.Debug)
-	}
-}
+// encodeFields implements json.Marshaler.
+func (s ReleaseSegmentsResponseValues) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfProjectStatsResponseIssuesByLevel = [6]string{
-	0: "fatal",
-	1: "exception",
-	2: "error",
-	3: "warning",
-	4: "info",
-	5: "debug",
+		e.UInt(elem)
+	}
 }
 
-// Decode decodes ProjectStatsResponseIssuesByLevel from json.
-func (s *ProjectStatsResponseIssuesByLevel) Decode(d *jx.Decoder) error {
+// Decode decodes ReleaseSegmentsResponseValues from json.
+func (s *ReleaseSegmentsResponseValues) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ProjectStatsResponseIssuesByLevel to nil")
+		return errors.New("invalid: unable to decode ReleaseSegmentsResponseValues to nil")
 	}
-	var requiredBitSet [1]uint8
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "fatal":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.UInt()
-				s.Fatal = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fatal\"")
-			}
-		case "exception":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.UInt()
-				s.Exception = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"exception\"")
-			}
-		case "error":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.UInt()
-				s.Error = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
-			}
-		case "warning":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.UInt()
-				s.Warning = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"warning\"")
-			}
-		case "info":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.UInt()
-				s.Info = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"info\"")
-			}
-		case "debug":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.UInt()
-				s.Debug = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"debug\"")
+		var elem uint
+		if err := func() error {
+			v, err := d.UInt()
+			elem = uint(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ProjectStatsResponseIssuesByLevel")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00111111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfProjectStatsResponseIssuesByLevel) {
-					name = jsonFieldsNameOfProjectStatsResponseIssuesByLevel[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+	}); err != nil {
+		return errors.Wrap(err, "decode ReleaseSegmentsResponseValues")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ProjectStatsResponseIssuesByLevel) MarshalJSON() ([]byte, error) {
+func (s ReleaseSegmentsResponseValues) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ProjectStatsResponseIssuesByLevel) UnmarshalJSON(data []byte) error {
+func (s *ReleaseSegmentsResponseValues) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RefreshTokenRequest) Encode(e *jx.Encoder) {
+func (s *ResetPasswordRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RefreshTokenRequest) encodeFields(e *jx.Encoder) {
+func (s *ResetPasswordRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
+		e.FieldStart("token")
+		e.Str(s.Token)
+	}
+	{
+		e.FieldStart("new_password")
+		e.Str(s.NewPassword)
 	}
 }
 
-var jsonFieldsNameOfRefreshTokenRequest = [1]string{
-	0: "refresh_token",
+var jsonFieldsNameOfResetPasswordRequest = [2]string{
+	0: "token",
+	1: "new_password",
 }
 
-// Decode decodes RefreshTokenRequest from json.
-func (s *RefreshTokenRequest) Decode(d *jx.Decoder) error {
+// Decode decodes ResetPasswordRequest from json.
+func (s *ResetPasswordRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RefreshTokenRequest to nil")
+		return errors.New("invalid: unable to decode ResetPasswordRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "refresh_token":
+		case "token":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.RefreshToken = string(v)
+				s.Token = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
+				return errors.Wrap(err, "decode field \"token\"")
+			}
+		case "new_password":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.NewPassword = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new_password\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RefreshTokenRequest")
+		return errors.Wrap(err, "decode ResetPasswordRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7569,8 +18247,8 @@ func (s *RefreshTokenRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRefreshTokenRequest) {
-					name = jsonFieldsNameOfRefreshTokenRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfResetPasswordRequest) {
+					name = jsonFieldsNameOfResetPasswordRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7591,103 +18269,96 @@ func (s *RefreshTokenRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshTokenRequest) MarshalJSON() ([]byte, error) {
+func (s *ResetPasswordRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshTokenRequest) UnmarshalJSON(data []byte) error {
+func (s *ResetPasswordRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RefreshTokenResponse) Encode(e *jx.Encoder) {
+func (s *SearchEventsResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RefreshTokenResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("access_token")
-		e.Str(s.AccessToken)
-	}
+func (s *SearchEventsResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
+		e.FieldStart("events")
+		e.ArrStart()
+		for _, elem := range s.Events {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("expires_in")
-		e.Int(s.ExpiresIn)
+		if s.NextCursor.Set {
+			e.FieldStart("next_cursor")
+			s.NextCursor.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfRefreshTokenResponse = [3]string{
-	0: "access_token",
-	1: "refresh_token",
-	2: "expires_in",
+var jsonFieldsNameOfSearchEventsResponse = [2]string{
+	0: "events",
+	1: "next_cursor",
 }
 
-// Decode decodes RefreshTokenResponse from json.
-func (s *RefreshTokenResponse) Decode(d *jx.Decoder) error {
+// Decode decodes SearchEventsResponse from json.
+func (s *SearchEventsResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RefreshTokenResponse to nil")
+		return errors.New("invalid: unable to decode SearchEventsResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "access_token":
+		case "events":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.AccessToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_token\"")
-			}
-		case "refresh_token":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
-				if err != nil {
+				s.Events = make([]IssueEvent, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueEvent
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Events = append(s.Events, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
+				return errors.Wrap(err, "decode field \"events\"")
 			}
-		case "expires_in":
-			requiredBitSet[0] |= 1 << 2
+		case "next_cursor":
 			if err := func() error {
-				v, err := d.Int()
-				s.ExpiresIn = int(v)
-				if err != nil {
+				s.NextCursor.Reset()
+				if err := s.NextCursor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expires_in\"")
+				return errors.Wrap(err, "decode field \"next_cursor\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RefreshTokenResponse")
+		return errors.Wrap(err, "decode SearchEventsResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7699,8 +18370,8 @@ func (s *RefreshTokenResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfRefreshTokenResponse) {
-					name = jsonFieldsNameOfRefreshTokenResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSearchEventsResponse) {
+					name = jsonFieldsNameOfSearchEventsResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7721,173 +18392,69 @@ func (s *RefreshTokenResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RefreshTokenResponse) MarshalJSON() ([]byte, error) {
+func (s *SearchEventsResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RefreshTokenResponse) UnmarshalJSON(data []byte) error {
+func (s *SearchEventsResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ReleaseAnalyticsDetails) Encode(e *jx.Encoder) {
+func (s *SetSuperuserStatusRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ReleaseAnalyticsDetails) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("version")
-		e.Str(s.Version)
-	}
-	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
-	{
-		e.FieldStart("stats")
-		s.Stats.Encode(e)
-	}
-	{
-		e.FieldStart("top_issues")
-		e.ArrStart()
-		for _, elem := range s.TopIssues {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
-	}
-	{
-		e.FieldStart("severity_distribution")
-		s.SeverityDistribution.Encode(e)
-	}
-	{
-		e.FieldStart("fix_time")
-		s.FixTime.Encode(e)
-	}
+func (s *SetSuperuserStatusRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("segments")
-		s.Segments.Encode(e)
-	}
-}
-
-var jsonFieldsNameOfReleaseAnalyticsDetails = [7]string{
-	0: "version",
-	1: "created_at",
-	2: "stats",
-	3: "top_issues",
-	4: "severity_distribution",
-	5: "fix_time",
-	6: "segments",
-}
-
-// Decode decodes ReleaseAnalyticsDetails from json.
-func (s *ReleaseAnalyticsDetails) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetails to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "version":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Str()
-				s.Version = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"version\"")
-			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "stats":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				if err := s.Stats.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"stats\"")
-			}
-		case "top_issues":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				s.TopIssues = make([]IssueSummary, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem IssueSummary
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.TopIssues = append(s.TopIssues, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"top_issues\"")
-			}
-		case "severity_distribution":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				if err := s.SeverityDistribution.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"severity_distribution\"")
-			}
-		case "fix_time":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				if err := s.FixTime.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fix_time\"")
-			}
-		case "segments":
-			requiredBitSet[0] |= 1 << 6
+		e.FieldStart("is_superuser")
+		e.Bool(s.IsSuperuser)
+	}
+}
+
+var jsonFieldsNameOfSetSuperuserStatusRequest = [1]string{
+	0: "is_superuser",
+}
+
+// Decode decodes SetSuperuserStatusRequest from json.
+func (s *SetSuperuserStatusRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SetSuperuserStatusRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "is_superuser":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Segments.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.IsSuperuser = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segments\"")
+				return errors.Wrap(err, "decode field \"is_superuser\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetails")
+		return errors.Wrap(err, "decode SetSuperuserStatusRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -7899,8 +18466,8 @@ func (s *ReleaseAnalyticsDetails) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfReleaseAnalyticsDetails) {
-					name = jsonFieldsNameOfReleaseAnalyticsDetails[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSetSuperuserStatusRequest) {
+					name = jsonFieldsNameOfSetSuperuserStatusRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -7921,735 +18488,709 @@ func (s *ReleaseAnalyticsDetails) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseAnalyticsDetails) MarshalJSON() ([]byte, error) {
+func (s *SetSuperuserStatusRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetails) UnmarshalJSON(data []byte) error {
+func (s *SetSuperuserStatusRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ReleaseAnalyticsDetailsFixTime) Encode(e *jx.Encoder) {
+func (s *SetUserActiveStatusRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ReleaseAnalyticsDetailsFixTime) encodeFields(e *jx.Encoder) {
-	{
-		if s.Avg.Set {
-			e.FieldStart("avg")
-			s.Avg.Encode(e)
-		}
-	}
-	{
-		if s.Median.Set {
-			e.FieldStart("median")
-			s.Median.Encode(e)
-		}
-	}
+func (s *SetUserActiveStatusRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.P95.Set {
-			e.FieldStart("p95")
-			s.P95.Encode(e)
-		}
+		e.FieldStart("is_active")
+		e.Bool(s.IsActive)
 	}
 }
 
-var jsonFieldsNameOfReleaseAnalyticsDetailsFixTime = [3]string{
-	0: "avg",
-	1: "median",
-	2: "p95",
+var jsonFieldsNameOfSetUserActiveStatusRequest = [1]string{
+	0: "is_active",
 }
 
-// Decode decodes ReleaseAnalyticsDetailsFixTime from json.
-func (s *ReleaseAnalyticsDetailsFixTime) Decode(d *jx.Decoder) error {
+// Decode decodes SetUserActiveStatusRequest from json.
+func (s *SetUserActiveStatusRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsFixTime to nil")
+		return errors.New("invalid: unable to decode SetUserActiveStatusRequest to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "avg":
-			if err := func() error {
-				s.Avg.Reset()
-				if err := s.Avg.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"avg\"")
-			}
-		case "median":
-			if err := func() error {
-				s.Median.Reset()
-				if err := s.Median.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"median\"")
-			}
-		case "p95":
+		case "is_active":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.P95.Reset()
-				if err := s.P95.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.IsActive = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"p95\"")
+				return errors.Wrap(err, "decode field \"is_active\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsFixTime")
+		return errors.Wrap(err, "decode SetUserActiveStatusRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSetUserActiveStatusRequest) {
+					name = jsonFieldsNameOfSetUserActiveStatusRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseAnalyticsDetailsFixTime) MarshalJSON() ([]byte, error) {
+func (s *SetUserActiveStatusRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsFixTime) UnmarshalJSON(data []byte) error {
+func (s *SetUserActiveStatusRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ReleaseAnalyticsDetailsSegments) Encode(e *jx.Encoder) {
+func (s *Team) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ReleaseAnalyticsDetailsSegments) encodeFields(e *jx.Encoder) {
-	{
-		if s.Platform.Set {
-			e.FieldStart("platform")
-			s.Platform.Encode(e)
-		}
-	}
+func (s *Team) encodeFields(e *jx.Encoder) {
 	{
-		if s.BrowserName.Set {
-			e.FieldStart("browser_name")
-			s.BrowserName.Encode(e)
-		}
+		e.FieldStart("id")
+		e.UInt(s.ID)
 	}
 	{
-		if s.OsName.Set {
-			e.FieldStart("os_name")
-			s.OsName.Encode(e)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.DeviceArch.Set {
-			e.FieldStart("device_arch")
-			s.DeviceArch.Encode(e)
-		}
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
 	}
 	{
-		if s.RuntimeName.Set {
-			e.FieldStart("runtime_name")
-			s.RuntimeName.Encode(e)
+		e.FieldStart("members")
+		e.ArrStart()
+		for _, elem := range s.Members {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfReleaseAnalyticsDetailsSegments = [5]string{
-	0: "platform",
-	1: "browser_name",
-	2: "os_name",
-	3: "device_arch",
-	4: "runtime_name",
+var jsonFieldsNameOfTeam = [4]string{
+	0: "id",
+	1: "name",
+	2: "created_at",
+	3: "members",
 }
 
-// Decode decodes ReleaseAnalyticsDetailsSegments from json.
-func (s *ReleaseAnalyticsDetailsSegments) Decode(d *jx.Decoder) error {
+// Decode decodes Team from json.
+func (s *Team) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegments to nil")
+		return errors.New("invalid: unable to decode Team to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "platform":
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Platform.Reset()
-				if err := s.Platform.Decode(d); err != nil {
+				v, err := d.UInt()
+				s.ID = uint(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"platform\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "browser_name":
+		case "name":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.BrowserName.Reset()
-				if err := s.BrowserName.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"browser_name\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "os_name":
+		case "created_at":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.OsName.Reset()
-				if err := s.OsName.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"os_name\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "device_arch":
+		case "members":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.DeviceArch.Reset()
-				if err := s.DeviceArch.Decode(d); err != nil {
+				s.Members = make([]TeamMember, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TeamMember
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Members = append(s.Members, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"device_arch\"")
+				return errors.Wrap(err, "decode field \"members\"")
 			}
-		case "runtime_name":
-			if err := func() error {
-				s.RuntimeName.Reset()
-				if err := s.RuntimeName.Decode(d); err != nil {
-					return err
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Team")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTeam) {
+					name = jsonFieldsNameOfTeam[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"runtime_name\"")
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		default:
-			return d.Skip()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegments")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseAnalyticsDetailsSegments) MarshalJSON() ([]byte, error) {
+func (s *Team) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSegments) UnmarshalJSON(data []byte) error {
+func (s *Team) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsBrowserName) Encode(e *jx.Encoder) {
+func (s *TeamExistsResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsBrowserName) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.UInt(elem)
+// encodeFields encodes fields.
+func (s *TeamExistsResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("exists")
+		e.Bool(s.Exists)
 	}
 }
 
-// Decode decodes ReleaseAnalyticsDetailsSegmentsBrowserName from json.
-func (s *ReleaseAnalyticsDetailsSegmentsBrowserName) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTeamExistsResponse = [1]string{
+	0: "exists",
+}
+
+// Decode decodes TeamExistsResponse from json.
+func (s *TeamExistsResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsBrowserName to nil")
+		return errors.New("invalid: unable to decode TeamExistsResponse to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
-		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "exists":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Exists = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"exists\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsBrowserName")
+		return errors.Wrap(err, "decode TeamExistsResponse")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTeamExistsResponse) {
+					name = jsonFieldsNameOfTeamExistsResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsBrowserName) MarshalJSON() ([]byte, error) {
+func (s *TeamExistsResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSegmentsBrowserName) UnmarshalJSON(data []byte) error {
+func (s *TeamExistsResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsDeviceArch) Encode(e *jx.Encoder) {
+func (s *TeamMember) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsDeviceArch) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.UInt(elem)
+// encodeFields encodes fields.
+func (s *TeamMember) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("user_id")
+		e.UInt(s.UserID)
+	}
+	{
+		e.FieldStart("role")
+		s.Role.Encode(e)
 	}
 }
 
-// Decode decodes ReleaseAnalyticsDetailsSegmentsDeviceArch from json.
-func (s *ReleaseAnalyticsDetailsSegmentsDeviceArch) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTeamMember = [2]string{
+	0: "user_id",
+	1: "role",
+}
+
+// Decode decodes TeamMember from json.
+func (s *TeamMember) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsDeviceArch to nil")
+		return errors.New("invalid: unable to decode TeamMember to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
-		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "user_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.UInt()
+				s.UserID = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"user_id\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		case "role":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsDeviceArch")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsDeviceArch) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSegmentsDeviceArch) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsOsName) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsOsName) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.UInt(elem)
-	}
-}
-
-// Decode decodes ReleaseAnalyticsDetailsSegmentsOsName from json.
-func (s *ReleaseAnalyticsDetailsSegmentsOsName) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsOsName to nil")
+		return errors.Wrap(err, "decode TeamMember")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
-		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
-			if err != nil {
-				return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTeamMember) {
+					name = jsonFieldsNameOfTeamMember[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsOsName")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsOsName) MarshalJSON() ([]byte, error) {
+func (s *TeamMember) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSegmentsOsName) UnmarshalJSON(data []byte) error {
+func (s *TeamMember) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsPlatform) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsPlatform) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.UInt(elem)
-	}
+// Encode encodes TeamMemberRole as json.
+func (s TeamMemberRole) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes ReleaseAnalyticsDetailsSegmentsPlatform from json.
-func (s *ReleaseAnalyticsDetailsSegmentsPlatform) Decode(d *jx.Decoder) error {
+// Decode decodes TeamMemberRole from json.
+func (s *TeamMemberRole) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsPlatform to nil")
+		return errors.New("invalid: unable to decode TeamMemberRole to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
-		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsPlatform")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TeamMemberRole(v) {
+	case TeamMemberRoleOwner:
+		*s = TeamMemberRoleOwner
+	case TeamMemberRoleAdmin:
+		*s = TeamMemberRoleAdmin
+	case TeamMemberRoleMember:
+		*s = TeamMemberRoleMember
+	default:
+		*s = TeamMemberRole(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsPlatform) MarshalJSON() ([]byte, error) {
+func (s TeamMemberRole) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSegmentsPlatform) UnmarshalJSON(data []byte) error {
+func (s *TeamMemberRole) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsRuntimeName) Encode(e *jx.Encoder) {
+func (s *TeamResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsRuntimeName) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.UInt(elem)
+// encodeFields encodes fields.
+func (s *TeamResponse) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("team")
+		s.Team.Encode(e)
 	}
 }
 
-// Decode decodes ReleaseAnalyticsDetailsSegmentsRuntimeName from json.
-func (s *ReleaseAnalyticsDetailsSegmentsRuntimeName) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTeamResponse = [1]string{
+	0: "team",
+}
+
+// Decode decodes TeamResponse from json.
+func (s *TeamResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSegmentsRuntimeName to nil")
+		return errors.New("invalid: unable to decode TeamResponse to nil")
 	}
-	m := s.init()
+	var requiredBitSet [1]uint8
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
-		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "team":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Team.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"team\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSegmentsRuntimeName")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseAnalyticsDetailsSegmentsRuntimeName) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSegmentsRuntimeName) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSeverityDistribution) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s ReleaseAnalyticsDetailsSeverityDistribution) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.UInt(elem)
-	}
-}
-
-// Decode decodes ReleaseAnalyticsDetailsSeverityDistribution from json.
-func (s *ReleaseAnalyticsDetailsSeverityDistribution) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsDetailsSeverityDistribution to nil")
+		return errors.Wrap(err, "decode TeamResponse")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
-		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
-			if err != nil {
-				return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfTeamResponse) {
+					name = jsonFieldsNameOfTeamResponse[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsDetailsSeverityDistribution")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseAnalyticsDetailsSeverityDistribution) MarshalJSON() ([]byte, error) {
+func (s *TeamResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsDetailsSeverityDistribution) UnmarshalJSON(data []byte) error {
+func (s *TeamResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ReleaseAnalyticsSummary) Encode(e *jx.Encoder) {
+func (s *TimeseriesData) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ReleaseAnalyticsSummary) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("version")
-		e.Str(s.Version)
-	}
-	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
-	{
-		e.FieldStart("known_issues_total")
-		e.UInt(s.KnownIssuesTotal)
-	}
-	{
-		e.FieldStart("new_issues_total")
-		e.UInt(s.NewIssuesTotal)
-	}
+func (s *TimeseriesData) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("regressions_total")
-		e.UInt(s.RegressionsTotal)
+		e.FieldStart("period")
+		s.Period.Encode(e)
 	}
 	{
-		e.FieldStart("resolved_in_version_total")
-		e.UInt(s.ResolvedInVersionTotal)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("users_affected")
-		e.UInt(s.UsersAffected)
+		e.FieldStart("occurrences")
+		e.ArrStart()
+		for _, elem := range s.Occurrences {
+			e.UInt(elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfReleaseAnalyticsSummary = [7]string{
-	0: "version",
-	1: "created_at",
-	2: "known_issues_total",
-	3: "new_issues_total",
-	4: "regressions_total",
-	5: "resolved_in_version_total",
-	6: "users_affected",
+var jsonFieldsNameOfTimeseriesData = [3]string{
+	0: "period",
+	1: "name",
+	2: "occurrences",
 }
 
-// Decode decodes ReleaseAnalyticsSummary from json.
-func (s *ReleaseAnalyticsSummary) Decode(d *jx.Decoder) error {
+// Decode decodes TimeseriesData from json.
+func (s *TimeseriesData) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseAnalyticsSummary to nil")
+		return errors.New("invalid: unable to decode TimeseriesData to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "version":
+		case "period":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Version = string(v)
-				if err != nil {
+				if err := s.Period.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"version\"")
+				return errors.Wrap(err, "decode field \"period\"")
 			}
-		case "created_at":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "known_issues_total":
+		case "occurrences":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.UInt()
-				s.KnownIssuesTotal = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"known_issues_total\"")
-			}
-		case "new_issues_total":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.UInt()
-				s.NewIssuesTotal = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"new_issues_total\"")
-			}
-		case "regressions_total":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.UInt()
-				s.RegressionsTotal = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"regressions_total\"")
-			}
-		case "resolved_in_version_total":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.UInt()
-				s.ResolvedInVersionTotal = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"resolved_in_version_total\"")
-			}
-		case "users_affected":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				v, err := d.UInt()
-				s.UsersAffected = uint(v)
-				if err != nil {
+				s.Occurrences = make([]uint, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem uint
+					v, err := d.UInt()
+					elem = uint(v)
+					if err != nil {
+						return err
+					}
+					s.Occurrences = append(s.Occurrences, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users_affected\"")
+				return errors.Wrap(err, "decode field \"occurrences\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseAnalyticsSummary")
+		return errors.Wrap(err, "decode TimeseriesData")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -8661,8 +19202,8 @@ func (s *ReleaseAnalyticsSummary) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfReleaseAnalyticsSummary) {
-					name = jsonFieldsNameOfReleaseAnalyticsSummary[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTimeseriesData) {
+					name = jsonFieldsNameOfTimeseriesData[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8683,97 +19224,224 @@ func (s *ReleaseAnalyticsSummary) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseAnalyticsSummary) MarshalJSON() ([]byte, error) {
+func (s *TimeseriesData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseAnalyticsSummary) UnmarshalJSON(data []byte) error {
+func (s *TimeseriesData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TimeseriesResponse as json.
+func (s TimeseriesResponse) Encode(e *jx.Encoder) {
+	unwrapped := []TimeseriesData(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes TimeseriesResponse from json.
+func (s *TimeseriesResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TimeseriesResponse to nil")
+	}
+	var unwrapped []TimeseriesData
+	if err := func() error {
+		unwrapped = make([]TimeseriesData, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem TimeseriesData
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = TimeseriesResponse(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TimeseriesResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TimeseriesResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ReleaseComparison) Encode(e *jx.Encoder) {
+func (s *TraceResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ReleaseComparison) encodeFields(e *jx.Encoder) {
+func (s *TraceResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("base")
-		s.Base.Encode(e)
+		e.FieldStart("trace_id")
+		e.Str(s.TraceID)
 	}
 	{
-		e.FieldStart("target")
-		s.Target.Encode(e)
+		e.FieldStart("start_timestamp")
+		json.EncodeDateTime(e, s.StartTimestamp)
 	}
 	{
-		e.FieldStart("delta")
-		s.Delta.Encode(e)
+		e.FieldStart("timestamp")
+		json.EncodeDateTime(e, s.Timestamp)
+	}
+	{
+		e.FieldStart("duration_ms")
+		e.Float64(s.DurationMs)
+	}
+	{
+		e.FieldStart("transactions")
+		e.ArrStart()
+		for _, elem := range s.Transactions {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("spans")
+		e.ArrStart()
+		for _, elem := range s.Spans {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfReleaseComparison = [3]string{
-	0: "base",
-	1: "target",
-	2: "delta",
+var jsonFieldsNameOfTraceResponse = [6]string{
+	0: "trace_id",
+	1: "start_timestamp",
+	2: "timestamp",
+	3: "duration_ms",
+	4: "transactions",
+	5: "spans",
 }
 
-// Decode decodes ReleaseComparison from json.
-func (s *ReleaseComparison) Decode(d *jx.Decoder) error {
+// Decode decodes TraceResponse from json.
+func (s *TraceResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseComparison to nil")
+		return errors.New("invalid: unable to decode TraceResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "base":
+		case "trace_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Base.Decode(d); err != nil {
+				v, err := d.Str()
+				s.TraceID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"base\"")
+				return errors.Wrap(err, "decode field \"trace_id\"")
 			}
-		case "target":
+		case "start_timestamp":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Target.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.StartTimestamp = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"target\"")
+				return errors.Wrap(err, "decode field \"start_timestamp\"")
 			}
-		case "delta":
+		case "timestamp":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Delta.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.Timestamp = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"delta\"")
+				return errors.Wrap(err, "decode field \"timestamp\"")
+			}
+		case "duration_ms":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.DurationMs = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
+			}
+		case "transactions":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.Transactions = make([]TraceTransaction, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TraceTransaction
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Transactions = append(s.Transactions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"transactions\"")
+			}
+		case "spans":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Spans = make([]TraceSpan, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TraceSpan
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Spans = append(s.Spans, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spans\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseComparison")
+		return errors.Wrap(err, "decode TraceResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -8785,8 +19453,8 @@ func (s *ReleaseComparison) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfReleaseComparison) {
-					name = jsonFieldsNameOfReleaseComparison[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTraceResponse) {
+					name = jsonFieldsNameOfTraceResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -8807,215 +19475,274 @@ func (s *ReleaseComparison) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseComparison) MarshalJSON() ([]byte, error) {
+func (s *TraceResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseComparison) UnmarshalJSON(data []byte) error {
+func (s *TraceResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ReleaseComparisonDelta) Encode(e *jx.Encoder) {
+func (s *TraceSpan) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ReleaseComparisonDelta) encodeFields(e *jx.Encoder) {
+func (s *TraceSpan) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("span_id")
+		e.Str(s.SpanID)
+	}
+	{
+		if s.ParentSpanID.Set {
+			e.FieldStart("parent_span_id")
+			s.ParentSpanID.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("transaction_id")
+		e.Str(s.TransactionID)
+	}
+	{
+		if s.TransactionName.Set {
+			e.FieldStart("transaction_name")
+			s.TransactionName.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("op")
+		e.Str(s.Op)
+	}
+	{
+		e.FieldStart("description")
+		e.Str(s.Description)
+	}
 	{
-		if s.KnownIssuesTotal.Set {
-			e.FieldStart("known_issues_total")
-			s.KnownIssuesTotal.Encode(e)
-		}
+		e.FieldStart("status")
+		e.Str(s.Status)
 	}
 	{
-		if s.NewIssuesTotal.Set {
-			e.FieldStart("new_issues_total")
-			s.NewIssuesTotal.Encode(e)
+		if s.Tags.Set {
+			e.FieldStart("tags")
+			s.Tags.Encode(e)
 		}
 	}
 	{
-		if s.RegressionsTotal.Set {
-			e.FieldStart("regressions_total")
-			s.RegressionsTotal.Encode(e)
-		}
+		e.FieldStart("start_timestamp")
+		json.EncodeDateTime(e, s.StartTimestamp)
 	}
 	{
-		if s.ResolvedInVersionTotal.Set {
-			e.FieldStart("resolved_in_version_total")
-			s.ResolvedInVersionTotal.Encode(e)
-		}
+		e.FieldStart("timestamp")
+		json.EncodeDateTime(e, s.Timestamp)
 	}
 	{
-		if s.UsersAffected.Set {
-			e.FieldStart("users_affected")
-			s.UsersAffected.Encode(e)
-		}
+		e.FieldStart("duration_ms")
+		e.Float64(s.DurationMs)
+	}
+	{
+		e.FieldStart("offset_ms")
+		e.Float64(s.OffsetMs)
+	}
+	{
+		e.FieldStart("depth")
+		e.UInt(s.Depth)
 	}
 }
 
-var jsonFieldsNameOfReleaseComparisonDelta = [5]string{
-	0: "known_issues_total",
-	1: "new_issues_total",
-	2: "regressions_total",
-	3: "resolved_in_version_total",
-	4: "users_affected",
+var jsonFieldsNameOfTraceSpan = [13]string{
+	0:  "span_id",
+	1:  "parent_span_id",
+	2:  "transaction_id",
+	3:  "transaction_name",
+	4:  "op",
+	5:  "description",
+	6:  "status",
+	7:  "tags",
+	8:  "start_timestamp",
+	9:  "timestamp",
+	10: "duration_ms",
+	11: "offset_ms",
+	12: "depth",
 }
 
-// Decode decodes ReleaseComparisonDelta from json.
-func (s *ReleaseComparisonDelta) Decode(d *jx.Decoder) error {
+// Decode decodes TraceSpan from json.
+func (s *TraceSpan) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseComparisonDelta to nil")
+		return errors.New("invalid: unable to decode TraceSpan to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "known_issues_total":
+		case "span_id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.KnownIssuesTotal.Reset()
-				if err := s.KnownIssuesTotal.Decode(d); err != nil {
+				v, err := d.Str()
+				s.SpanID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"known_issues_total\"")
+				return errors.Wrap(err, "decode field \"span_id\"")
 			}
-		case "new_issues_total":
+		case "parent_span_id":
 			if err := func() error {
-				s.NewIssuesTotal.Reset()
-				if err := s.NewIssuesTotal.Decode(d); err != nil {
+				s.ParentSpanID.Reset()
+				if err := s.ParentSpanID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"new_issues_total\"")
+				return errors.Wrap(err, "decode field \"parent_span_id\"")
 			}
-		case "regressions_total":
+		case "transaction_id":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.RegressionsTotal.Reset()
-				if err := s.RegressionsTotal.Decode(d); err != nil {
+				v, err := d.Str()
+				s.TransactionID = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"regressions_total\"")
+				return errors.Wrap(err, "decode field \"transaction_id\"")
 			}
-		case "resolved_in_version_total":
+		case "transaction_name":
 			if err := func() error {
-				s.ResolvedInVersionTotal.Reset()
-				if err := s.ResolvedInVersionTotal.Decode(d); err != nil {
+				s.TransactionName.Reset()
+				if err := s.TransactionName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"resolved_in_version_total\"")
+				return errors.Wrap(err, "decode field \"transaction_name\"")
 			}
-		case "users_affected":
+		case "op":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.UsersAffected.Reset()
-				if err := s.UsersAffected.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Op = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"users_affected\"")
+				return errors.Wrap(err, "decode field \"op\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseComparisonDelta")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseComparisonDelta) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseComparisonDelta) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *ReleaseSegmentsResponse) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *ReleaseSegmentsResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("segment")
-		e.Str(s.Segment)
-	}
-	{
-		e.FieldStart("values")
-		s.Values.Encode(e)
-	}
-}
-
-var jsonFieldsNameOfReleaseSegmentsResponse = [2]string{
-	0: "segment",
-	1: "values",
-}
-
-// Decode decodes ReleaseSegmentsResponse from json.
-func (s *ReleaseSegmentsResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseSegmentsResponse to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "segment":
-			requiredBitSet[0] |= 1 << 0
+		case "description":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := d.Str()
-				s.Segment = string(v)
+				s.Description = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"segment\"")
+				return errors.Wrap(err, "decode field \"description\"")
 			}
-		case "values":
-			requiredBitSet[0] |= 1 << 1
+		case "status":
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
-				if err := s.Values.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Status = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"values\"")
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "start_timestamp":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTimestamp = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_timestamp\"")
+			}
+		case "timestamp":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Timestamp = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timestamp\"")
+			}
+		case "duration_ms":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.DurationMs = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
+			}
+		case "offset_ms":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.OffsetMs = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"offset_ms\"")
+			}
+		case "depth":
+			requiredBitSet[1] |= 1 << 4
+			if err := func() error {
+				v, err := d.UInt()
+				s.Depth = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"depth\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseSegmentsResponse")
+		return errors.Wrap(err, "decode TraceSpan")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b01110101,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9027,8 +19754,8 @@ func (s *ReleaseSegmentsResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfReleaseSegmentsResponse) {
-					name = jsonFieldsNameOfReleaseSegmentsResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTraceSpan) {
+					name = jsonFieldsNameOfTraceSpan[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9049,45 +19776,45 @@ func (s *ReleaseSegmentsResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ReleaseSegmentsResponse) MarshalJSON() ([]byte, error) {
+func (s *TraceSpan) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseSegmentsResponse) UnmarshalJSON(data []byte) error {
+func (s *TraceSpan) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s ReleaseSegmentsResponseValues) Encode(e *jx.Encoder) {
+func (s TraceSpanTags) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s ReleaseSegmentsResponseValues) encodeFields(e *jx.Encoder) {
+func (s TraceSpanTags) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
-		e.UInt(elem)
+		e.Str(elem)
 	}
 }
 
-// Decode decodes ReleaseSegmentsResponseValues from json.
-func (s *ReleaseSegmentsResponseValues) Decode(d *jx.Decoder) error {
+// Decode decodes TraceSpanTags from json.
+func (s *TraceSpanTags) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ReleaseSegmentsResponseValues to nil")
+		return errors.New("invalid: unable to decode TraceSpanTags to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem uint
+		var elem string
 		if err := func() error {
-			v, err := d.UInt()
-			elem = uint(v)
+			v, err := d.Str()
+			elem = string(v)
 			if err != nil {
 				return err
 			}
@@ -9098,93 +19825,264 @@ func (s *ReleaseSegmentsResponseValues) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ReleaseSegmentsResponseValues")
+		return errors.Wrap(err, "decode TraceSpanTags")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ReleaseSegmentsResponseValues) MarshalJSON() ([]byte, error) {
+func (s TraceSpanTags) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ReleaseSegmentsResponseValues) UnmarshalJSON(data []byte) error {
+func (s *TraceSpanTags) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ResetPasswordRequest) Encode(e *jx.Encoder) {
+func (s *TraceTransaction) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ResetPasswordRequest) encodeFields(e *jx.Encoder) {
+func (s *TraceTransaction) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("event_id")
+		e.Str(s.EventID)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("op")
+		e.Str(s.Op)
+	}
+	{
+		e.FieldStart("status")
+		e.Str(s.Status)
+	}
+	{
+		if s.Platform.Set {
+			e.FieldStart("platform")
+			s.Platform.Encode(e)
+		}
+	}
+	{
+		if s.Environment.Set {
+			e.FieldStart("environment")
+			s.Environment.Encode(e)
+		}
+	}
+	{
+		if s.Release.Set {
+			e.FieldStart("release")
+			s.Release.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("span_id")
+		e.Str(s.SpanID)
+	}
 	{
-		e.FieldStart("token")
-		e.Str(s.Token)
+		if s.ParentSpanID.Set {
+			e.FieldStart("parent_span_id")
+			s.ParentSpanID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("new_password")
-		e.Str(s.NewPassword)
+		e.FieldStart("start_timestamp")
+		json.EncodeDateTime(e, s.StartTimestamp)
+	}
+	{
+		e.FieldStart("timestamp")
+		json.EncodeDateTime(e, s.Timestamp)
+	}
+	{
+		e.FieldStart("duration_ms")
+		e.Float64(s.DurationMs)
 	}
 }
 
-var jsonFieldsNameOfResetPasswordRequest = [2]string{
-	0: "token",
-	1: "new_password",
-}
-
-// Decode decodes ResetPasswordRequest from json.
-func (s *ResetPasswordRequest) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfTraceTransaction = [12]string{
+	0:  "event_id",
+	1:  "name",
+	2:  "op",
+	3:  "status",
+	4:  "platform",
+	5:  "environment",
+	6:  "release",
+	7:  "span_id",
+	8:  "parent_span_id",
+	9:  "start_timestamp",
+	10: "timestamp",
+	11: "duration_ms",
+}
+
+// Decode decodes TraceTransaction from json.
+func (s *TraceTransaction) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ResetPasswordRequest to nil")
+		return errors.New("invalid: unable to decode TraceTransaction to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "token":
+		case "event_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Token = string(v)
+				s.EventID = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"token\"")
+				return errors.Wrap(err, "decode field \"event_id\"")
 			}
-		case "new_password":
+		case "name":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.NewPassword = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"new_password\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "op":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Op = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"op\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Status = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "platform":
+			if err := func() error {
+				s.Platform.Reset()
+				if err := s.Platform.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"platform\"")
+			}
+		case "environment":
+			if err := func() error {
+				s.Environment.Reset()
+				if err := s.Environment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
+		case "release":
+			if err := func() error {
+				s.Release.Reset()
+				if err := s.Release.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"release\"")
+			}
+		case "span_id":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Str()
+				s.SpanID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"span_id\"")
+			}
+		case "parent_span_id":
+			if err := func() error {
+				s.ParentSpanID.Reset()
+				if err := s.ParentSpanID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent_span_id\"")
+			}
+		case "start_timestamp":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTimestamp = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_timestamp\"")
+			}
+		case "timestamp":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.Timestamp = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"timestamp\"")
+			}
+		case "duration_ms":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.DurationMs = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_ms\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ResetPasswordRequest")
+		return errors.Wrap(err, "decode TraceTransaction")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000011,
+	for i, mask := range [2]uint8{
+		0b10001111,
+		0b00001110,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9196,8 +20094,8 @@ func (s *ResetPasswordRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfResetPasswordRequest) {
-					name = jsonFieldsNameOfResetPasswordRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTraceTransaction) {
+					name = jsonFieldsNameOfTraceTransaction[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9218,69 +20116,171 @@ func (s *ResetPasswordRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ResetPasswordRequest) MarshalJSON() ([]byte, error) {
+func (s *TraceTransaction) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ResetPasswordRequest) UnmarshalJSON(data []byte) error {
+func (s *TraceTransaction) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SetSuperuserStatusRequest) Encode(e *jx.Encoder) {
+func (s *TransactionSummary) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SetSuperuserStatusRequest) encodeFields(e *jx.Encoder) {
+func (s *TransactionSummary) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("is_superuser")
-		e.Bool(s.IsSuperuser)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("count")
+		e.UInt(s.Count)
+	}
+	{
+		e.FieldStart("throughput")
+		e.Float64(s.Throughput)
+	}
+	{
+		e.FieldStart("failure_rate")
+		e.Float64(s.FailureRate)
+	}
+	{
+		e.FieldStart("p50_ms")
+		e.Float64(s.P50Ms)
+	}
+	{
+		e.FieldStart("p95_ms")
+		e.Float64(s.P95Ms)
+	}
+	{
+		e.FieldStart("p99_ms")
+		e.Float64(s.P99Ms)
 	}
 }
 
-var jsonFieldsNameOfSetSuperuserStatusRequest = [1]string{
-	0: "is_superuser",
+var jsonFieldsNameOfTransactionSummary = [7]string{
+	0: "name",
+	1: "count",
+	2: "throughput",
+	3: "failure_rate",
+	4: "p50_ms",
+	5: "p95_ms",
+	6: "p99_ms",
 }
 
-// Decode decodes SetSuperuserStatusRequest from json.
-func (s *SetSuperuserStatusRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TransactionSummary from json.
+func (s *TransactionSummary) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SetSuperuserStatusRequest to nil")
+		return errors.New("invalid: unable to decode TransactionSummary to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "is_superuser":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsSuperuser = bool(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_superuser\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "count":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.UInt()
+				s.Count = uint(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"count\"")
+			}
+		case "throughput":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Throughput = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"throughput\"")
+			}
+		case "failure_rate":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.FailureRate = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"failure_rate\"")
+			}
+		case "p50_ms":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.P50Ms = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"p50_ms\"")
+			}
+		case "p95_ms":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.P95Ms = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"p95_ms\"")
+			}
+		case "p99_ms":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.P99Ms = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"p99_ms\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SetSuperuserStatusRequest")
+		return errors.Wrap(err, "decode TransactionSummary")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9292,8 +20292,8 @@ func (s *SetSuperuserStatusRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSetSuperuserStatusRequest) {
-					name = jsonFieldsNameOfSetSuperuserStatusRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTransactionSummary) {
+					name = jsonFieldsNameOfTransactionSummary[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9314,64 +20314,64 @@ func (s *SetSuperuserStatusRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SetSuperuserStatusRequest) MarshalJSON() ([]byte, error) {
+func (s *TransactionSummary) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SetSuperuserStatusRequest) UnmarshalJSON(data []byte) error {
+func (s *TransactionSummary) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SetUserActiveStatusRequest) Encode(e *jx.Encoder) {
+func (s *TwoFAConfirmRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SetUserActiveStatusRequest) encodeFields(e *jx.Encoder) {
+func (s *TwoFAConfirmRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("is_active")
-		e.Bool(s.IsActive)
+		e.FieldStart("code")
+		e.Str(s.Code)
 	}
 }
 
-var jsonFieldsNameOfSetUserActiveStatusRequest = [1]string{
-	0: "is_active",
+var jsonFieldsNameOfTwoFAConfirmRequest = [1]string{
+	0: "code",
 }
 
-// Decode decodes SetUserActiveStatusRequest from json.
-func (s *SetUserActiveStatusRequest) Decode(d *jx.Decoder) error {
+// Decode decodes TwoFAConfirmRequest from json.
+func (s *TwoFAConfirmRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SetUserActiveStatusRequest to nil")
+		return errors.New("invalid: unable to decode TwoFAConfirmRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "is_active":
+		case "code":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.IsActive = bool(v)
+				v, err := d.Str()
+				s.Code = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"is_active\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SetUserActiveStatusRequest")
+		return errors.Wrap(err, "decode TwoFAConfirmRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -9388,8 +20388,8 @@ func (s *SetUserActiveStatusRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSetUserActiveStatusRequest) {
-					name = jsonFieldsNameOfSetUserActiveStatusRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTwoFAConfirmRequest) {
+					name = jsonFieldsNameOfTwoFAConfirmRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9410,130 +20410,69 @@ func (s *SetUserActiveStatusRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SetUserActiveStatusRequest) MarshalJSON() ([]byte, error) {
+func (s *TwoFAConfirmRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SetUserActiveStatusRequest) UnmarshalJSON(data []byte) error {
+func (s *TwoFAConfirmRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Team) Encode(e *jx.Encoder) {
+func (s *TwoFADisableRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Team) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.UInt(s.ID)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("created_at")
-		json.EncodeDateTime(e, s.CreatedAt)
-	}
+func (s *TwoFADisableRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("members")
-		e.ArrStart()
-		for _, elem := range s.Members {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("email_code")
+		e.Str(s.EmailCode)
 	}
 }
 
-var jsonFieldsNameOfTeam = [4]string{
-	0: "id",
-	1: "name",
-	2: "created_at",
-	3: "members",
+var jsonFieldsNameOfTwoFADisableRequest = [1]string{
+	0: "email_code",
 }
 
-// Decode decodes Team from json.
-func (s *Team) Decode(d *jx.Decoder) error {
+// Decode decodes TwoFADisableRequest from json.
+func (s *TwoFADisableRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Team to nil")
+		return errors.New("invalid: unable to decode TwoFADisableRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "email_code":
 			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.UInt()
-				s.ID = uint(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "created_at":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.CreatedAt = v
+				s.EmailCode = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
-			}
-		case "members":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				s.Members = make([]TeamMember, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem TeamMember
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Members = append(s.Members, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"members\"")
+				return errors.Wrap(err, "decode field \"email_code\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Team")
+		return errors.Wrap(err, "decode TwoFADisableRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9545,8 +20484,8 @@ func (s *Team) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTeam) {
-					name = jsonFieldsNameOfTeam[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTwoFADisableRequest) {
+					name = jsonFieldsNameOfTwoFADisableRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9567,64 +20506,64 @@ func (s *Team) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Team) MarshalJSON() ([]byte, error) {
+func (s *TwoFADisableRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Team) UnmarshalJSON(data []byte) error {
+func (s *TwoFADisableRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TeamExistsResponse) Encode(e *jx.Encoder) {
+func (s *TwoFAResetRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TeamExistsResponse) encodeFields(e *jx.Encoder) {
+func (s *TwoFAResetRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("exists")
-		e.Bool(s.Exists)
+		e.FieldStart("email_code")
+		e.Str(s.EmailCode)
 	}
 }
 
-var jsonFieldsNameOfTeamExistsResponse = [1]string{
-	0: "exists",
+var jsonFieldsNameOfTwoFAResetRequest = [1]string{
+	0: "email_code",
 }
 
-// Decode decodes TeamExistsResponse from json.
-func (s *TeamExistsResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TwoFAResetRequest from json.
+func (s *TwoFAResetRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TeamExistsResponse to nil")
+		return errors.New("invalid: unable to decode TwoFAResetRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "exists":
+		case "email_code":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Exists = bool(v)
+				v, err := d.Str()
+				s.EmailCode = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"exists\"")
+				return errors.Wrap(err, "decode field \"email_code\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TeamExistsResponse")
+		return errors.Wrap(err, "decode TwoFAResetRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -9641,8 +20580,8 @@ func (s *TeamExistsResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTeamExistsResponse) {
-					name = jsonFieldsNameOfTeamExistsResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTwoFAResetRequest) {
+					name = jsonFieldsNameOfTwoFAResetRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9663,84 +20602,103 @@ func (s *TeamExistsResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TeamExistsResponse) MarshalJSON() ([]byte, error) {
+func (s *TwoFAResetRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TeamExistsResponse) UnmarshalJSON(data []byte) error {
+func (s *TwoFAResetRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TeamMember) Encode(e *jx.Encoder) {
+func (s *TwoFASetupResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TeamMember) encodeFields(e *jx.Encoder) {
+func (s *TwoFASetupResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("user_id")
-		e.UInt(s.UserID)
+		e.FieldStart("secret")
+		e.Str(s.Secret)
 	}
 	{
-		e.FieldStart("role")
-		s.Role.Encode(e)
+		e.FieldStart("qr_url")
+		e.Str(s.QrURL)
+	}
+	{
+		e.FieldStart("qr_image")
+		e.Str(s.QrImage)
 	}
 }
 
-var jsonFieldsNameOfTeamMember = [2]string{
-	0: "user_id",
-	1: "role",
+var jsonFieldsNameOfTwoFASetupResponse = [3]string{
+	0: "secret",
+	1: "qr_url",
+	2: "qr_image",
 }
 
-// Decode decodes TeamMember from json.
-func (s *TeamMember) Decode(d *jx.Decoder) error {
+// Decode decodes TwoFASetupResponse from json.
+func (s *TwoFASetupResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TeamMember to nil")
+		return errors.New("invalid: unable to decode TwoFASetupResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "user_id":
+		case "secret":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.UInt()
-				s.UserID = uint(v)
+				v, err := d.Str()
+				s.Secret = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"user_id\"")
+				return errors.Wrap(err, "decode field \"secret\"")
 			}
-		case "role":
+		case "qr_url":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Role.Decode(d); err != nil {
+				v, err := d.Str()
+				s.QrURL = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"qr_url\"")
+			}
+		case "qr_image":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.QrImage = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"qr_image\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TeamMember")
+		return errors.Wrap(err, "decode TwoFASetupResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9752,8 +20710,8 @@ func (s *TeamMember) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTeamMember) {
-					name = jsonFieldsNameOfTeamMember[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTwoFASetupResponse) {
+					name = jsonFieldsNameOfTwoFASetupResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9774,109 +20732,86 @@ func (s *TeamMember) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TeamMember) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TeamMember) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TeamMemberRole as json.
-func (s TeamMemberRole) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes TeamMemberRole from json.
-func (s *TeamMemberRole) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TeamMemberRole to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch TeamMemberRole(v) {
-	case TeamMemberRoleOwner:
-		*s = TeamMemberRoleOwner
-	case TeamMemberRoleAdmin:
-		*s = TeamMemberRoleAdmin
-	case TeamMemberRoleMember:
-		*s = TeamMemberRoleMember
-	default:
-		*s = TeamMemberRole(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TeamMemberRole) MarshalJSON() ([]byte, error) {
+func (s *TwoFASetupResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TeamMemberRole) UnmarshalJSON(data []byte) error {
+func (s *TwoFASetupResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TeamResponse) Encode(e *jx.Encoder) {
+func (s *TwoFAVerifyRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TeamResponse) encodeFields(e *jx.Encoder) {
+func (s *TwoFAVerifyRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("team")
-		s.Team.Encode(e)
+		e.FieldStart("code")
+		e.Str(s.Code)
+	}
+	{
+		e.FieldStart("session_id")
+		e.Str(s.SessionID)
 	}
 }
 
-var jsonFieldsNameOfTeamResponse = [1]string{
-	0: "team",
+var jsonFieldsNameOfTwoFAVerifyRequest = [2]string{
+	0: "code",
+	1: "session_id",
 }
 
-// Decode decodes TeamResponse from json.
-func (s *TeamResponse) Decode(d *jx.Decoder) error {
+// Decode decodes TwoFAVerifyRequest from json.
+func (s *TwoFAVerifyRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TeamResponse to nil")
+		return errors.New("invalid: unable to decode TwoFAVerifyRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "team":
+		case "code":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Team.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Code = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"team\"")
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "session_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.SessionID = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"session_id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TeamResponse")
+		return errors.Wrap(err, "decode TwoFAVerifyRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -9888,8 +20823,8 @@ func (s *TeamResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTeamResponse) {
-					name = jsonFieldsNameOfTeamResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTwoFAVerifyRequest) {
+					name = jsonFieldsNameOfTwoFAVerifyRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -9910,108 +20845,98 @@ func (s *TeamResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TeamResponse) MarshalJSON() ([]byte, error) {
+func (s *TwoFAVerifyRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TeamResponse) UnmarshalJSON(data []byte) error {
+func (s *TwoFAVerifyRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TimeseriesData) Encode(e *jx.Encoder) {
+func (s *TwoFAVerifyResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TimeseriesData) encodeFields(e *jx.Encoder) {
+func (s *TwoFAVerifyResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("period")
-		s.Period.Encode(e)
+		e.FieldStart("access_token")
+		e.Str(s.AccessToken)
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("refresh_token")
+		e.Str(s.RefreshToken)
 	}
 	{
-		e.FieldStart("occurrences")
-		e.ArrStart()
-		for _, elem := range s.Occurrences {
-			e.UInt(elem)
-		}
-		e.ArrEnd()
+		e.FieldStart("expires_in")
+		e.Int(s.ExpiresIn)
 	}
 }
 
-var jsonFieldsNameOfTimeseriesData = [3]string{
-	0: "period",
-	1: "name",
-	2: "occurrences",
+var jsonFieldsNameOfTwoFAVerifyResponse = [3]string{
+	0: "access_token",
+	1: "refresh_token",
+	2: "expires_in",
 }
 
-// Decode decodes TimeseriesData from json.
-func (s *TimeseriesData) Decode(d *jx.Decoder) error {
+// Decode decodes TwoFAVerifyResponse from json.
+func (s *TwoFAVerifyResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TimeseriesData to nil")
+		return errors.New("invalid: unable to decode TwoFAVerifyResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "period":
+		case "access_token":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Period.Decode(d); err != nil {
+				v, err := d.Str()
+				s.AccessToken = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"period\"")
+				return errors.Wrap(err, "decode field \"access_token\"")
 			}
-		case "name":
+		case "refresh_token":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				v, err := d.Str()
-				s.Name = string(v)
+				s.RefreshToken = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"refresh_token\"")
 			}
-		case "occurrences":
+		case "expires_in":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Occurrences = make([]uint, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem uint
-					v, err := d.UInt()
-					elem = uint(v)
-					if err != nil {
-						return err
-					}
-					s.Occurrences = append(s.Occurrences, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.ExpiresIn = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"occurrences\"")
+				return errors.Wrap(err, "decode field \"expires_in\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TimeseriesData")
+		return errors.Wrap(err, "decode TwoFAVerifyResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -10028,8 +20953,8 @@ func (s *TimeseriesData) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTimeseriesData) {
-					name = jsonFieldsNameOfTimeseriesData[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfTwoFAVerifyResponse) {
+					name = jsonFieldsNameOfTwoFAVerifyResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10050,114 +20975,76 @@ func (s *TimeseriesData) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TimeseriesData) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TimeseriesData) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes TimeseriesResponse as json.
-func (s TimeseriesResponse) Encode(e *jx.Encoder) {
-	unwrapped := []TimeseriesData(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes TimeseriesResponse from json.
-func (s *TimeseriesResponse) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode TimeseriesResponse to nil")
-	}
-	var unwrapped []TimeseriesData
-	if err := func() error {
-		unwrapped = make([]TimeseriesData, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem TimeseriesData
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = TimeseriesResponse(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s TimeseriesResponse) MarshalJSON() ([]byte, error) {
+func (s *TwoFAVerifyResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TimeseriesResponse) UnmarshalJSON(data []byte) error {
+func (s *TwoFAVerifyResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TwoFAConfirmRequest) Encode(e *jx.Encoder) {
+func (s *UnmergeIssueRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TwoFAConfirmRequest) encodeFields(e *jx.Encoder) {
+func (s *UnmergeIssueRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("code")
-		e.Str(s.Code)
+		e.FieldStart("fingerprints")
+		e.ArrStart()
+		for _, elem := range s.Fingerprints {
+			e.Str(elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTwoFAConfirmRequest = [1]string{
-	0: "code",
+var jsonFieldsNameOfUnmergeIssueRequest = [1]string{
+	0: "fingerprints",
 }
 
-// Decode decodes TwoFAConfirmRequest from json.
-func (s *TwoFAConfirmRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UnmergeIssueRequest from json.
+func (s *UnmergeIssueRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TwoFAConfirmRequest to nil")
+		return errors.New("invalid: unable to decode UnmergeIssueRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "fingerprints":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Code = string(v)
-				if err != nil {
+				s.Fingerprints = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Fingerprints = append(s.Fingerprints, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"fingerprints\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TwoFAConfirmRequest")
+		return errors.Wrap(err, "decode UnmergeIssueRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -10174,8 +21061,8 @@ func (s *TwoFAConfirmRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTwoFAConfirmRequest) {
-					name = jsonFieldsNameOfTwoFAConfirmRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUnmergeIssueRequest) {
+					name = jsonFieldsNameOfUnmergeIssueRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10196,64 +21083,74 @@ func (s *TwoFAConfirmRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TwoFAConfirmRequest) MarshalJSON() ([]byte, error) {
+func (s *UnmergeIssueRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TwoFAConfirmRequest) UnmarshalJSON(data []byte) error {
+func (s *UnmergeIssueRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TwoFADisableRequest) Encode(e *jx.Encoder) {
+func (s *UnmergeIssueResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TwoFADisableRequest) encodeFields(e *jx.Encoder) {
+func (s *UnmergeIssueResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("email_code")
-		e.Str(s.EmailCode)
+		e.FieldStart("issues")
+		e.ArrStart()
+		for _, elem := range s.Issues {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfTwoFADisableRequest = [1]string{
-	0: "email_code",
+var jsonFieldsNameOfUnmergeIssueResponse = [1]string{
+	0: "issues",
 }
 
-// Decode decodes TwoFADisableRequest from json.
-func (s *TwoFADisableRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UnmergeIssueResponse from json.
+func (s *UnmergeIssueResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TwoFADisableRequest to nil")
+		return errors.New("invalid: unable to decode UnmergeIssueResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "email_code":
+		case "issues":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.EmailCode = string(v)
-				if err != nil {
+				s.Issues = make([]Issue, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Issue
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Issues = append(s.Issues, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email_code\"")
+				return errors.Wrap(err, "decode field \"issues\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TwoFADisableRequest")
+		return errors.Wrap(err, "decode UnmergeIssueResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -10270,8 +21167,8 @@ func (s *TwoFADisableRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTwoFADisableRequest) {
-					name = jsonFieldsNameOfTwoFADisableRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUnmergeIssueResponse) {
+					name = jsonFieldsNameOfUnmergeIssueResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10292,64 +21189,64 @@ func (s *TwoFADisableRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TwoFADisableRequest) MarshalJSON() ([]byte, error) {
+func (s *UnmergeIssueResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TwoFADisableRequest) UnmarshalJSON(data []byte) error {
+func (s *UnmergeIssueResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TwoFAResetRequest) Encode(e *jx.Encoder) {
+func (s *UnreadCountResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TwoFAResetRequest) encodeFields(e *jx.Encoder) {
+func (s *UnreadCountResponse) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("email_code")
-		e.Str(s.EmailCode)
+		e.FieldStart("count")
+		e.UInt(s.Count)
 	}
 }
 
-var jsonFieldsNameOfTwoFAResetRequest = [1]string{
-	0: "email_code",
+var jsonFieldsNameOfUnreadCountResponse = [1]string{
+	0: "count",
 }
 
-// Decode decodes TwoFAResetRequest from json.
-func (s *TwoFAResetRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UnreadCountResponse from json.
+func (s *UnreadCountResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TwoFAResetRequest to nil")
+		return errors.New("invalid: unable to decode UnreadCountResponse to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "email_code":
+		case "count":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.EmailCode = string(v)
+				v, err := d.UInt()
+				s.Count = uint(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"email_code\"")
+				return errors.Wrap(err, "decode field \"count\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TwoFAResetRequest")
+		return errors.Wrap(err, "decode UnreadCountResponse")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -10366,8 +21263,8 @@ func (s *TwoFAResetRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTwoFAResetRequest) {
-					name = jsonFieldsNameOfTwoFAResetRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUnreadCountResponse) {
+					name = jsonFieldsNameOfUnreadCountResponse[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10388,103 +21285,178 @@ func (s *TwoFAResetRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TwoFAResetRequest) MarshalJSON() ([]byte, error) {
+func (s *UnreadCountResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TwoFAResetRequest) UnmarshalJSON(data []byte) error {
+func (s *UnreadCountResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TwoFASetupResponse) Encode(e *jx.Encoder) {
+func (s *UpdateDataScrubbingRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TwoFASetupResponse) encodeFields(e *jx.Encoder) {
+func (s *UpdateDataScrubbingRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("secret")
-		e.Str(s.Secret)
+		e.FieldStart("default_scrubbers")
+		e.Bool(s.DefaultScrubbers)
 	}
 	{
-		e.FieldStart("qr_url")
-		e.Str(s.QrURL)
+		e.FieldStart("sensitive_fields")
+		e.ArrStart()
+		for _, elem := range s.SensitiveFields {
+			e.Str(elem)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("qr_image")
-		e.Str(s.QrImage)
+		e.FieldStart("patterns")
+		e.ArrStart()
+		for _, elem := range s.Patterns {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("anonymize_ip")
+		e.Bool(s.AnonymizeIP)
+	}
+	{
+		e.FieldStart("drop_request_cookies")
+		e.Bool(s.DropRequestCookies)
+	}
+	{
+		e.FieldStart("drop_request_data")
+		e.Bool(s.DropRequestData)
 	}
 }
 
-var jsonFieldsNameOfTwoFASetupResponse = [3]string{
-	0: "secret",
-	1: "qr_url",
-	2: "qr_image",
+var jsonFieldsNameOfUpdateDataScrubbingRequest = [6]string{
+	0: "default_scrubbers",
+	1: "sensitive_fields",
+	2: "patterns",
+	3: "anonymize_ip",
+	4: "drop_request_cookies",
+	5: "drop_request_data",
 }
 
-// Decode decodes TwoFASetupResponse from json.
-func (s *TwoFASetupResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateDataScrubbingRequest from json.
+func (s *UpdateDataScrubbingRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TwoFASetupResponse to nil")
+		return errors.New("invalid: unable to decode UpdateDataScrubbingRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "secret":
+		case "default_scrubbers":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Secret = string(v)
+				v, err := d.Bool()
+				s.DefaultScrubbers = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"secret\"")
+				return errors.Wrap(err, "decode field \"default_scrubbers\"")
 			}
-		case "qr_url":
+		case "sensitive_fields":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.QrURL = string(v)
-				if err != nil {
+				s.SensitiveFields = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.SensitiveFields = append(s.SensitiveFields, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"qr_url\"")
+				return errors.Wrap(err, "decode field \"sensitive_fields\"")
 			}
-		case "qr_image":
+		case "patterns":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.QrImage = string(v)
+				s.Patterns = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Patterns = append(s.Patterns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"patterns\"")
+			}
+		case "anonymize_ip":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.AnonymizeIP = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"qr_image\"")
+				return errors.Wrap(err, "decode field \"anonymize_ip\"")
+			}
+		case "drop_request_cookies":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Bool()
+				s.DropRequestCookies = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"drop_request_cookies\"")
+			}
+		case "drop_request_data":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.DropRequestData = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"drop_request_data\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TwoFASetupResponse")
+		return errors.Wrap(err, "decode UpdateDataScrubbingRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10496,8 +21468,8 @@ func (s *TwoFASetupResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTwoFASetupResponse) {
-					name = jsonFieldsNameOfTwoFASetupResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUpdateDataScrubbingRequest) {
+					name = jsonFieldsNameOfUpdateDataScrubbingRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10518,86 +21490,69 @@ func (s *TwoFASetupResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TwoFASetupResponse) MarshalJSON() ([]byte, error) {
+func (s *UpdateDataScrubbingRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TwoFASetupResponse) UnmarshalJSON(data []byte) error {
+func (s *UpdateDataScrubbingRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TwoFAVerifyRequest) Encode(e *jx.Encoder) {
+func (s *UpdateEnvironmentRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TwoFAVerifyRequest) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("code")
-		e.Str(s.Code)
-	}
+func (s *UpdateEnvironmentRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("session_id")
-		e.Str(s.SessionID)
+		e.FieldStart("hidden")
+		e.Bool(s.Hidden)
 	}
 }
 
-var jsonFieldsNameOfTwoFAVerifyRequest = [2]string{
-	0: "code",
-	1: "session_id",
+var jsonFieldsNameOfUpdateEnvironmentRequest = [1]string{
+	0: "hidden",
 }
 
-// Decode decodes TwoFAVerifyRequest from json.
-func (s *TwoFAVerifyRequest) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateEnvironmentRequest from json.
+func (s *UpdateEnvironmentRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TwoFAVerifyRequest to nil")
+		return errors.New("invalid: unable to decode UpdateEnvironmentRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "hidden":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Code = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
-			}
-		case "session_id":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.SessionID = string(v)
+				v, err := d.Bool()
+				s.Hidden = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"session_id\"")
+				return errors.Wrap(err, "decode field \"hidden\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TwoFAVerifyRequest")
+		return errors.Wrap(err, "decode UpdateEnvironmentRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10609,8 +21564,8 @@ func (s *TwoFAVerifyRequest) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTwoFAVerifyRequest) {
-					name = jsonFieldsNameOfTwoFAVerifyRequest[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUpdateEnvironmentRequest) {
+					name = jsonFieldsNameOfUpdateEnvironmentRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10631,103 +21586,67 @@ func (s *TwoFAVerifyRequest) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TwoFAVerifyRequest) MarshalJSON() ([]byte, error) {
+func (s *UpdateEnvironmentRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TwoFAVerifyRequest) UnmarshalJSON(data []byte) error {
+func (s *UpdateEnvironmentRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *TwoFAVerifyResponse) Encode(e *jx.Encoder) {
+func (s *UpdateGroupingConfigRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *TwoFAVerifyResponse) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("access_token")
-		e.Str(s.AccessToken)
-	}
-	{
-		e.FieldStart("refresh_token")
-		e.Str(s.RefreshToken)
-	}
+func (s *UpdateGroupingConfigRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("expires_in")
-		e.Int(s.ExpiresIn)
+		e.FieldStart("strategy")
+		s.Strategy.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfTwoFAVerifyResponse = [3]string{
-	0: "access_token",
-	1: "refresh_token",
-	2: "expires_in",
+var jsonFieldsNameOfUpdateGroupingConfigRequest = [1]string{
+	0: "strategy",
 }
 
-// Decode decodes TwoFAVerifyResponse from json.
-func (s *TwoFAVerifyResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateGroupingConfigRequest from json.
+func (s *UpdateGroupingConfigRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TwoFAVerifyResponse to nil")
+		return errors.New("invalid: unable to decode UpdateGroupingConfigRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "access_token":
+		case "strategy":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.AccessToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"access_token\"")
-			}
-		case "refresh_token":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.RefreshToken = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"refresh_token\"")
-			}
-		case "expires_in":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Int()
-				s.ExpiresIn = int(v)
-				if err != nil {
+				if err := s.Strategy.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"expires_in\"")
+				return errors.Wrap(err, "decode field \"strategy\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode TwoFAVerifyResponse")
+		return errors.Wrap(err, "decode UpdateGroupingConfigRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10739,8 +21658,8 @@ func (s *TwoFAVerifyResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfTwoFAVerifyResponse) {
-					name = jsonFieldsNameOfTwoFAVerifyResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUpdateGroupingConfigRequest) {
+					name = jsonFieldsNameOfUpdateGroupingConfigRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10761,69 +21680,219 @@ func (s *TwoFAVerifyResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *TwoFAVerifyResponse) MarshalJSON() ([]byte, error) {
+func (s *UpdateGroupingConfigRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TwoFAVerifyResponse) UnmarshalJSON(data []byte) error {
+func (s *UpdateGroupingConfigRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *UnreadCountResponse) Encode(e *jx.Encoder) {
+func (s *UpdateInboundFiltersRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *UnreadCountResponse) encodeFields(e *jx.Encoder) {
+func (s *UpdateInboundFiltersRequest) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("count")
-		e.UInt(s.Count)
+		e.FieldStart("allowed_origins")
+		e.ArrStart()
+		for _, elem := range s.AllowedOrigins {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("filter_web_crawlers")
+		e.Bool(s.FilterWebCrawlers)
+	}
+	{
+		e.FieldStart("filter_legacy_browsers")
+		e.Bool(s.FilterLegacyBrowsers)
+	}
+	{
+		e.FieldStart("filter_localhost")
+		e.Bool(s.FilterLocalhost)
+	}
+	{
+		e.FieldStart("blocked_ip_ranges")
+		e.ArrStart()
+		for _, elem := range s.BlockedIPRanges {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("error_messages")
+		e.ArrStart()
+		for _, elem := range s.ErrorMessages {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("releases")
+		e.ArrStart()
+		for _, elem := range s.Releases {
+			e.Str(elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfUnreadCountResponse = [1]string{
-	0: "count",
+var jsonFieldsNameOfUpdateInboundFiltersRequest = [7]string{
+	0: "allowed_origins",
+	1: "filter_web_crawlers",
+	2: "filter_legacy_browsers",
+	3: "filter_localhost",
+	4: "blocked_ip_ranges",
+	5: "error_messages",
+	6: "releases",
 }
 
-// Decode decodes UnreadCountResponse from json.
-func (s *UnreadCountResponse) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateInboundFiltersRequest from json.
+func (s *UpdateInboundFiltersRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UnreadCountResponse to nil")
+		return errors.New("invalid: unable to decode UpdateInboundFiltersRequest to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "count":
+		case "allowed_origins":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.UInt()
-				s.Count = uint(v)
+				s.AllowedOrigins = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowedOrigins = append(s.AllowedOrigins, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"allowed_origins\"")
+			}
+		case "filter_web_crawlers":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.FilterWebCrawlers = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filter_web_crawlers\"")
+			}
+		case "filter_legacy_browsers":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Bool()
+				s.FilterLegacyBrowsers = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"count\"")
+				return errors.Wrap(err, "decode field \"filter_legacy_browsers\"")
+			}
+		case "filter_localhost":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.FilterLocalhost = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"filter_localhost\"")
+			}
+		case "blocked_ip_ranges":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				s.BlockedIPRanges = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.BlockedIPRanges = append(s.BlockedIPRanges, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"blocked_ip_ranges\"")
+			}
+		case "error_messages":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.ErrorMessages = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.ErrorMessages = append(s.ErrorMessages, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error_messages\"")
+			}
+		case "releases":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				s.Releases = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Releases = append(s.Releases, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"releases\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UnreadCountResponse")
+		return errors.Wrap(err, "decode UpdateInboundFiltersRequest")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b01111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -10835,8 +21904,8 @@ func (s *UnreadCountResponse) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfUnreadCountResponse) {
-					name = jsonFieldsNameOfUnreadCountResponse[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfUpdateInboundFiltersRequest) {
+					name = jsonFieldsNameOfUpdateInboundFiltersRequest[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -10857,14 +21926,14 @@ func (s *UnreadCountResponse) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UnreadCountResponse) MarshalJSON() ([]byte, error) {
+func (s *UpdateInboundFiltersRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UnreadCountResponse) UnmarshalJSON(data []byte) error {
+func (s *UpdateInboundFiltersRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -10902,13 +21971,20 @@ func (s *UpdateNotificationRuleRequest) encodeFields(e *jx.Encoder) {
 			s.IsRegression.Encode(e)
 		}
 	}
+	{
+		if s.Environment.Set {
+			e.FieldStart("environment")
+			s.Environment.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateNotificationRuleRequest = [4]string{
+var jsonFieldsNameOfUpdateNotificationRuleRequest = [5]string{
 	0: "event_level",
 	1: "fingerprint",
 	2: "is_new_error",
 	3: "is_regression",
+	4: "environment",
 }
 
 // Decode decodes UpdateNotificationRuleRequest from json.
@@ -10959,6 +22035,16 @@ func (s *UpdateNotificationRuleRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"is_regression\"")
 			}
+		case "environment":
+			if err := func() error {
+				s.Environment.Reset()
+				if err := s.Environment.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"environment\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -11010,12 +22096,19 @@ func (s *UpdateNotificationSettingRequest) encodeFields(e *jx.Encoder) {
 			s.Enabled.Encode(e)
 		}
 	}
+	{
+		if s.RouteToAssignee.Set {
+			e.FieldStart("route_to_assignee")
+			s.RouteToAssignee.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateNotificationSettingRequest = [3]string{
+var jsonFieldsNameOfUpdateNotificationSettingRequest = [4]string{
 	0: "type",
 	1: "config",
 	2: "enabled",
+	3: "route_to_assignee",
 }
 
 // Decode decodes UpdateNotificationSettingRequest from json.
@@ -11056,6 +22149,16 @@ func (s *UpdateNotificationSettingRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"enabled\"")
 			}
+		case "route_to_assignee":
+			if err := func() error {
+				s.RouteToAssignee.Reset()
+				if err := s.RouteToAssignee.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"route_to_assignee\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -11080,6 +22183,233 @@ func (s *UpdateNotificationSettingRequest) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *UpdateProjectKeyRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UpdateProjectKeyRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("label")
+		e.Str(s.Label)
+	}
+	{
+		e.FieldStart("is_active")
+		e.Bool(s.IsActive)
+	}
+	{
+		if s.RateLimit.Set {
+			e.FieldStart("rate_limit")
+			s.RateLimit.Encode(e)
+		}
+	}
+	{
+		if s.CategoryRateLimits.Set {
+			e.FieldStart("category_rate_limits")
+			s.CategoryRateLimits.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUpdateProjectKeyRequest = [4]string{
+	0: "label",
+	1: "is_active",
+	2: "rate_limit",
+	3: "category_rate_limits",
+}
+
+// Decode decodes UpdateProjectKeyRequest from json.
+func (s *UpdateProjectKeyRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateProjectKeyRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "label":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Label = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"label\"")
+			}
+		case "is_active":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Bool()
+				s.IsActive = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_active\"")
+			}
+		case "rate_limit":
+			if err := func() error {
+				s.RateLimit.Reset()
+				if err := s.RateLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limit\"")
+			}
+		case "category_rate_limits":
+			if err := func() error {
+				s.CategoryRateLimits.Reset()
+				if err := s.CategoryRateLimits.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category_rate_limits\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateProjectKeyRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUpdateProjectKeyRequest) {
+					name = jsonFieldsNameOfUpdateProjectKeyRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateProjectKeyRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateProjectKeyRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UpdateProjectRateLimitsRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UpdateProjectRateLimitsRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.RateLimit.Set {
+			e.FieldStart("rate_limit")
+			s.RateLimit.Encode(e)
+		}
+	}
+	{
+		if s.CategoryRateLimits.Set {
+			e.FieldStart("category_rate_limits")
+			s.CategoryRateLimits.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUpdateProjectRateLimitsRequest = [2]string{
+	0: "rate_limit",
+	1: "category_rate_limits",
+}
+
+// Decode decodes UpdateProjectRateLimitsRequest from json.
+func (s *UpdateProjectRateLimitsRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateProjectRateLimitsRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "rate_limit":
+			if err := func() error {
+				s.RateLimit.Reset()
+				if err := s.RateLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rate_limit\"")
+			}
+		case "category_rate_limits":
+			if err := func() error {
+				s.CategoryRateLimits.Reset()
+				if err := s.CategoryRateLimits.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"category_rate_limits\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateProjectRateLimitsRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateProjectRateLimitsRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateProjectRateLimitsRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *UpdateProjectRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -11747,6 +23077,12 @@ func (s *UserNotificationType) Decode(d *jx.Decoder) error {
 		*s = UserNotificationTypeRoleChanged
 	case UserNotificationTypeIssueRegression:
 		*s = UserNotificationTypeIssueRegression
+	case UserNotificationTypeSpikeProtection:
+		*s = UserNotificationTypeSpikeProtection
+	case UserNotificationTypeIssueAssigned:
+		*s = UserNotificationTypeIssueAssigned
+	case UserNotificationTypeIssueMention:
+		*s = UserNotificationTypeIssueMention
 	default:
 		*s = UserNotificationType(v)
 	}
@@ -9,30 +9,49 @@ const (
 	AddProjectOperation                        OperationName = "AddProject"
 	AddTeamMemberOperation                     OperationName = "AddTeamMember"
 	ArchiveProjectOperation                    OperationName = "ArchiveProject"
+	AssignIssueOperation                       OperationName = "AssignIssue"
+	BulkUpdateIssuesOperation                  OperationName = "BulkUpdateIssues"
 	ChangeIssueStatusOperation                 OperationName = "ChangeIssueStatus"
 	ChangeTeamMemberRoleOperation              OperationName = "ChangeTeamMemberRole"
 	CheckTeamExistsOperation                   OperationName = "CheckTeamExists"
 	CompareProjectReleasesAnalyticsOperation   OperationName = "CompareProjectReleasesAnalytics"
 	Confirm2FAOperation                        OperationName = "Confirm2FA"
+	CreateGroupingRuleOperation                OperationName = "CreateGroupingRule"
 	CreateNotificationRuleOperation            OperationName = "CreateNotificationRule"
 	CreateNotificationSettingOperation         OperationName = "CreateNotificationSetting"
+	CreateProjectIssueCommentOperation         OperationName = "CreateProjectIssueComment"
+	CreateProjectKeyOperation                  OperationName = "CreateProjectKey"
 	CreateTeamOperation                        OperationName = "CreateTeam"
 	CreateUserOperation                        OperationName = "CreateUser"
+	DeleteGroupingRuleOperation                OperationName = "DeleteGroupingRule"
+	DeleteMonitorOperation                     OperationName = "DeleteMonitor"
 	DeleteNotificationRuleOperation            OperationName = "DeleteNotificationRule"
 	DeleteNotificationSettingOperation         OperationName = "DeleteNotificationSetting"
+	DeleteProjectIssueCommentOperation         OperationName = "DeleteProjectIssueComment"
 	DeleteTeamOperation                        OperationName = "DeleteTeam"
 	DeleteUserOperation                        OperationName = "DeleteUser"
 	Disable2FAOperation                        OperationName = "Disable2FA"
+	DownloadAttachmentOperation                OperationName = "DownloadAttachment"
 	ForgotPasswordOperation                    OperationName = "ForgotPassword"
 	GetCurrentUserOperation                    OperationName = "GetCurrentUser"
 	GetEventsTimeseriesOperation               OperationName = "GetEventsTimeseries"
 	GetIssueOperation                          OperationName = "GetIssue"
 	GetIssuesTimeseriesOperation               OperationName = "GetIssuesTimeseries"
+	GetMonitorOperation                        OperationName = "GetMonitor"
 	GetNotificationRuleOperation               OperationName = "GetNotificationRule"
 	GetNotificationSettingOperation            OperationName = "GetNotificationSetting"
 	GetProjectOperation                        OperationName = "GetProject"
+	GetProjectDataScrubbingOperation           OperationName = "GetProjectDataScrubbing"
+	GetProjectEventOperation                   OperationName = "GetProjectEvent"
+	GetProjectGroupingConfigOperation          OperationName = "GetProjectGroupingConfig"
+	GetProjectInboundFiltersOperation          OperationName = "GetProjectInboundFilters"
 	GetProjectIssueEventsTimeseriesOperation   OperationName = "GetProjectIssueEventsTimeseries"
+	GetProjectIssueLatestEventOperation        OperationName = "GetProjectIssueLatestEvent"
+	GetProjectIssueOldestEventOperation        OperationName = "GetProjectIssueOldestEvent"
+	GetProjectIssueTagsOperation               OperationName = "GetProjectIssueTags"
 	GetProjectIssueTimeseriesOperation         OperationName = "GetProjectIssueTimeseries"
+	GetProjectOutcomesOperation                OperationName = "GetProjectOutcomes"
+	GetProjectRateLimitsOperation              OperationName = "GetProjectRateLimits"
 	GetProjectReleaseAnalyticsDetailsOperation OperationName = "GetProjectReleaseAnalyticsDetails"
 	GetProjectReleaseErrorsTimeseriesOperation OperationName = "GetProjectReleaseErrorsTimeseries"
 	GetProjectReleaseSegmentsOperation         OperationName = "GetProjectReleaseSegments"
@@ -41,12 +60,23 @@ const (
 	GetProjectTeamOperation                    OperationName = "GetProjectTeam"
 	GetRecentIssuesOperation                   OperationName = "GetRecentIssues"
 	GetTeamOperation                           OperationName = "GetTeam"
+	GetTraceOperation                          OperationName = "GetTrace"
 	GetUnreadNotificationsCountOperation       OperationName = "GetUnreadNotificationsCount"
 	GetUserNotificationsOperation              OperationName = "GetUserNotifications"
 	GetVersionsOperation                       OperationName = "GetVersions"
+	ListEventAttachmentsOperation              OperationName = "ListEventAttachments"
+	ListGroupingRulesOperation                 OperationName = "ListGroupingRules"
 	ListIssuesOperation                        OperationName = "ListIssues"
+	ListMonitorsOperation                      OperationName = "ListMonitors"
 	ListNotificationRulesOperation             OperationName = "ListNotificationRules"
 	ListNotificationSettingsOperation          OperationName = "ListNotificationSettings"
+	ListProjectEnvironmentsOperation           OperationName = "ListProjectEnvironments"
+	ListProjectIssueActivityOperation          OperationName = "ListProjectIssueActivity"
+	ListProjectIssueCommentsOperation          OperationName = "ListProjectIssueComments"
+	ListProjectIssueEventsOperation            OperationName = "ListProjectIssueEvents"
+	ListProjectIssueTagEventsOperation         OperationName = "ListProjectIssueTagEvents"
+	ListProjectKeysOperation                   OperationName = "ListProjectKeys"
+	ListProjectTransactionsOperation           OperationName = "ListProjectTransactions"
 	ListProjectsOperation                      OperationName = "ListProjects"
 	ListTeamsOperation                         OperationName = "ListTeams"
 	ListUsersOperation                         OperationName = "ListUsers"
@@ -54,19 +84,32 @@ const (
 	LoginOperation                             OperationName = "Login"
 	MarkAllNotificationsAsReadOperation        OperationName = "MarkAllNotificationsAsRead"
 	MarkNotificationAsReadOperation            OperationName = "MarkNotificationAsRead"
+	MergeIssuesOperation                       OperationName = "MergeIssues"
+	PreviewGroupingRuleOperation               OperationName = "PreviewGroupingRule"
 	RecentProjectsListOperation                OperationName = "RecentProjectsList"
 	RefreshTokenOperation                      OperationName = "RefreshToken"
 	RemoveTeamMemberOperation                  OperationName = "RemoveTeamMember"
 	Reset2FAOperation                          OperationName = "Reset2FA"
 	ResetPasswordOperation                     OperationName = "ResetPassword"
+	RevokeProjectKeyOperation                  OperationName = "RevokeProjectKey"
+	RotateProjectKeyOperation                  OperationName = "RotateProjectKey"
+	SearchProjectEventsOperation               OperationName = "SearchProjectEvents"
 	Send2FACodeOperation                       OperationName = "Send2FACode"
 	SendTestNotificationOperation              OperationName = "SendTestNotification"
 	SetSuperuserStatusOperation                OperationName = "SetSuperuserStatus"
 	SetUserActiveStatusOperation               OperationName = "SetUserActiveStatus"
 	Setup2FAOperation                          OperationName = "Setup2FA"
+	UnmergeIssueOperation                      OperationName = "UnmergeIssue"
+	UpdateGroupingRuleOperation                OperationName = "UpdateGroupingRule"
 	UpdateNotificationRuleOperation            OperationName = "UpdateNotificationRule"
 	UpdateNotificationSettingOperation         OperationName = "UpdateNotificationSetting"
 	UpdateProjectOperation                     OperationName = "UpdateProject"
+	UpdateProjectDataScrubbingOperation        OperationName = "UpdateProjectDataScrubbing"
+	UpdateProjectEnvironmentOperation          OperationName = "UpdateProjectEnvironment"
+	UpdateProjectGroupingConfigOperation       OperationName = "UpdateProjectGroupingConfig"
+	UpdateProjectInboundFiltersOperation       OperationName = "UpdateProjectInboundFilters"
+	UpdateProjectKeyOperation                  OperationName = "UpdateProjectKey"
+	UpdateProjectRateLimitsOperation           OperationName = "UpdateProjectRateLimits"
 	UserChangeMyPasswordOperation              OperationName = "UserChangeMyPassword"
 	Verify2FAOperation                         OperationName = "Verify2FA"
 )
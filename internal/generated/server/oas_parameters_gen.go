@@ -5,6 +5,7 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 
@@ -145,6 +146,189 @@ func decodeArchiveProjectParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
+// AssignIssueParams is parameters of AssignIssue operation.
+type AssignIssueParams struct {
+	ProjectID uint
+	IssueID   uint
+}
+
+func unpackAssignIssueParams(packed middleware.Parameters) (params AssignIssueParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeAssignIssueParams(args [2]string, argsEscaped bool, r *http.Request) (params AssignIssueParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// BulkUpdateIssuesParams is parameters of BulkUpdateIssues operation.
+type BulkUpdateIssuesParams struct {
+	ProjectID uint
+}
+
+func unpackBulkUpdateIssuesParams(packed middleware.Parameters) (params BulkUpdateIssuesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeBulkUpdateIssuesParams(args [1]string, argsEscaped bool, r *http.Request) (params BulkUpdateIssuesParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // ChangeIssueStatusParams is parameters of changeIssueStatus operation.
 type ChangeIssueStatusParams struct {
 	ProjectID uint
@@ -511,6 +695,71 @@ func decodeCompareProjectReleasesAnalyticsParams(args [1]string, argsEscaped boo
 	return params, nil
 }
 
+// CreateGroupingRuleParams is parameters of CreateGroupingRule operation.
+type CreateGroupingRuleParams struct {
+	ProjectID uint
+}
+
+func unpackCreateGroupingRuleParams(packed middleware.Parameters) (params CreateGroupingRuleParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeCreateGroupingRuleParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateGroupingRuleParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // CreateNotificationRuleParams is parameters of CreateNotificationRule operation.
 type CreateNotificationRuleParams struct {
 	ProjectID uint
@@ -694,14 +943,13 @@ func decodeCreateNotificationSettingParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// DeleteNotificationRuleParams is parameters of DeleteNotificationRule operation.
-type DeleteNotificationRuleParams struct {
+// CreateProjectIssueCommentParams is parameters of CreateProjectIssueComment operation.
+type CreateProjectIssueCommentParams struct {
 	ProjectID uint
-	SettingID uint
-	RuleID    uint
+	IssueID   uint
 }
 
-func unpackDeleteNotificationRuleParams(packed middleware.Parameters) (params DeleteNotificationRuleParams) {
+func unpackCreateProjectIssueCommentParams(packed middleware.Parameters) (params CreateProjectIssueCommentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -711,22 +959,15 @@ func unpackDeleteNotificationRuleParams(packed middleware.Parameters) (params De
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
-			In:   "path",
-		}
-		params.SettingID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "rule_id",
+			Name: "issue_id",
 			In:   "path",
 		}
-		params.RuleID = packed[key].(uint)
+		params.IssueID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *http.Request) (params DeleteNotificationRuleParams, _ error) {
+func decodeCreateProjectIssueCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params CreateProjectIssueCommentParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -772,7 +1013,7 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Decode path: setting_id.
+	// Decode path: issue_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -784,7 +1025,7 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "issue_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -801,7 +1042,7 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.SettingID = c
+				params.IssueID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -812,16 +1053,36 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "issue_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: rule_id.
+	return params, nil
+}
+
+// CreateProjectKeyParams is parameters of CreateProjectKey operation.
+type CreateProjectKeyParams struct {
+	ProjectID uint
+}
+
+func unpackCreateProjectKeyParams(packed middleware.Parameters) (params CreateProjectKeyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeCreateProjectKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateProjectKeyParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -829,7 +1090,7 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "rule_id",
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -846,7 +1107,7 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.RuleID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -857,7 +1118,7 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "rule_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -865,13 +1126,13 @@ func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// DeleteNotificationSettingParams is parameters of DeleteNotificationSetting operation.
-type DeleteNotificationSettingParams struct {
+// DeleteGroupingRuleParams is parameters of DeleteGroupingRule operation.
+type DeleteGroupingRuleParams struct {
 	ProjectID uint
-	SettingID uint
+	RuleID    uint
 }
 
-func unpackDeleteNotificationSettingParams(packed middleware.Parameters) (params DeleteNotificationSettingParams) {
+func unpackDeleteGroupingRuleParams(packed middleware.Parameters) (params DeleteGroupingRuleParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -881,15 +1142,15 @@ func unpackDeleteNotificationSettingParams(packed middleware.Parameters) (params
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
+			Name: "rule_id",
 			In:   "path",
 		}
-		params.SettingID = packed[key].(uint)
+		params.RuleID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteNotificationSettingParams, _ error) {
+func decodeDeleteGroupingRuleParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteGroupingRuleParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -935,7 +1196,7 @@ func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *
 			Err:  err,
 		}
 	}
-	// Decode path: setting_id.
+	// Decode path: rule_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -947,7 +1208,7 @@ func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "rule_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -964,7 +1225,7 @@ func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.SettingID = c
+				params.RuleID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -975,7 +1236,7 @@ func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "rule_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -983,24 +1244,32 @@ func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// DeleteTeamParams is parameters of DeleteTeam operation.
-type DeleteTeamParams struct {
-	TeamID uint
+// DeleteMonitorParams is parameters of DeleteMonitor operation.
+type DeleteMonitorParams struct {
+	ProjectID uint
+	MonitorID uint
 }
 
-func unpackDeleteTeamParams(packed middleware.Parameters) (params DeleteTeamParams) {
+func unpackDeleteMonitorParams(packed middleware.Parameters) (params DeleteMonitorParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "team_id",
+			Name: "project_id",
 			In:   "path",
 		}
-		params.TeamID = packed[key].(uint)
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "monitor_id",
+			In:   "path",
+		}
+		params.MonitorID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeDeleteTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTeamParams, _ error) {
-	// Decode path: team_id.
+func decodeDeleteMonitorParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteMonitorParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1012,7 +1281,7 @@ func decodeDeleteTeamParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "team_id",
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1029,7 +1298,7 @@ func decodeDeleteTeamParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.TeamID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1040,36 +1309,16 @@ func decodeDeleteTeamParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "team_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DeleteUserParams is parameters of DeleteUser operation.
-type DeleteUserParams struct {
-	UserID uint
-}
-
-func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "user_id",
-			In:   "path",
-		}
-		params.UserID = packed[key].(uint)
-	}
-	return params
-}
-
-func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
-	// Decode path: user_id.
+	// Decode path: monitor_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1077,7 +1326,7 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "user_id",
+				Param:   "monitor_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1094,7 +1343,7 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.UserID = c
+				params.MonitorID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1105,7 +1354,7 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "user_id",
+			Name: "monitor_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1113,120 +1362,114 @@ func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// GetEventsTimeseriesParams is parameters of GetEventsTimeseries operation.
-type GetEventsTimeseriesParams struct {
-	ProjectID   OptUint
-	Interval    string
-	Granularity string
+// DeleteNotificationRuleParams is parameters of DeleteNotificationRule operation.
+type DeleteNotificationRuleParams struct {
+	ProjectID uint
+	SettingID uint
+	RuleID    uint
 }
 
-func unpackGetEventsTimeseriesParams(packed middleware.Parameters) (params GetEventsTimeseriesParams) {
+func unpackDeleteNotificationRuleParams(packed middleware.Parameters) (params DeleteNotificationRuleParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ProjectID = v.(OptUint)
+			In:   "path",
 		}
+		params.ProjectID = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "interval",
-			In:   "query",
+			Name: "setting_id",
+			In:   "path",
 		}
-		params.Interval = packed[key].(string)
+		params.SettingID = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "granularity",
-			In:   "query",
+			Name: "rule_id",
+			In:   "path",
 		}
-		params.Granularity = packed[key].(string)
+		params.RuleID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetEventsTimeseriesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetEventsTimeseriesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: project_id.
+func decodeDeleteNotificationRuleParams(args [3]string, argsEscaped bool, r *http.Request) (params DeleteNotificationRuleParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "project_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotProjectIDVal uint
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToUint(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotProjectIDVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUint(val)
+				if err != nil {
 					return err
 				}
-				params.ProjectID.SetTo(paramsDotProjectIDVal)
+
+				params.ProjectID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
 			Name: "project_id",
-			In:   "query",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: interval.
+	// Decode path: setting_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "interval",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "setting_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Interval = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    0,
-					MaxLengthSet: false,
-					Email:        false,
-					Hostname:     false,
-					Regex:        regexMap["^\\d+(m|h|d)$"],
-				}).Validate(string(params.Interval)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.SettingID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1237,48 +1480,41 @@ func decodeGetEventsTimeseriesParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "interval",
-			In:   "query",
+			Name: "setting_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: granularity.
+	// Decode path: rule_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "granularity",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "rule_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Granularity = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    0,
-					MaxLengthSet: false,
-					Email:        false,
-					Hostname:     false,
-					Regex:        regexMap["^\\d+(m|h|d)$"],
-				}).Validate(string(params.Granularity)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.RuleID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1289,21 +1525,21 @@ func decodeGetEventsTimeseriesParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "granularity",
-			In:   "query",
+			Name: "rule_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetIssueParams is parameters of GetIssue operation.
-type GetIssueParams struct {
+// DeleteNotificationSettingParams is parameters of DeleteNotificationSetting operation.
+type DeleteNotificationSettingParams struct {
 	ProjectID uint
-	IssueID   uint
+	SettingID uint
 }
 
-func unpackGetIssueParams(packed middleware.Parameters) (params GetIssueParams) {
+func unpackDeleteNotificationSettingParams(packed middleware.Parameters) (params DeleteNotificationSettingParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -1313,15 +1549,15 @@ func unpackGetIssueParams(packed middleware.Parameters) (params GetIssueParams)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "issue_id",
+			Name: "setting_id",
 			In:   "path",
 		}
-		params.IssueID = packed[key].(uint)
+		params.SettingID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (params GetIssueParams, _ error) {
+func decodeDeleteNotificationSettingParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteNotificationSettingParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -1367,7 +1603,7 @@ func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (pa
 			Err:  err,
 		}
 	}
-	// Decode path: issue_id.
+	// Decode path: setting_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -1379,7 +1615,7 @@ func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issue_id",
+				Param:   "setting_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1396,7 +1632,7 @@ func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				params.IssueID = c
+				params.SettingID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1407,7 +1643,7 @@ func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issue_id",
+			Name: "setting_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1415,198 +1651,14 @@ func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetIssuesTimeseriesParams is parameters of GetIssuesTimeseries operation.
-type GetIssuesTimeseriesParams struct {
-	ProjectID   OptUint
-	Interval    string
-	Granularity string
-}
-
-func unpackGetIssuesTimeseriesParams(packed middleware.Parameters) (params GetIssuesTimeseriesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "project_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ProjectID = v.(OptUint)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "interval",
-			In:   "query",
-		}
-		params.Interval = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "granularity",
-			In:   "query",
-		}
-		params.Granularity = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetIssuesTimeseriesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetIssuesTimeseriesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: project_id.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "project_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotProjectIDVal uint
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToUint(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotProjectIDVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.ProjectID.SetTo(paramsDotProjectIDVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "project_id",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: interval.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "interval",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Interval = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    0,
-					MaxLengthSet: false,
-					Email:        false,
-					Hostname:     false,
-					Regex:        regexMap["^\\d+(m|h|d)$"],
-				}).Validate(string(params.Interval)); err != nil {
-					return errors.Wrap(err, "string")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "interval",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: granularity.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "granularity",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Granularity = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    0,
-					MaxLengthSet: false,
-					Email:        false,
-					Hostname:     false,
-					Regex:        regexMap["^\\d+(m|h|d)$"],
-				}).Validate(string(params.Granularity)); err != nil {
-					return errors.Wrap(err, "string")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "granularity",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetNotificationRuleParams is parameters of GetNotificationRule operation.
-type GetNotificationRuleParams struct {
+// DeleteProjectIssueCommentParams is parameters of DeleteProjectIssueComment operation.
+type DeleteProjectIssueCommentParams struct {
 	ProjectID uint
-	SettingID uint
-	RuleID    uint
+	IssueID   uint
+	CommentID uint
 }
 
-func unpackGetNotificationRuleParams(packed middleware.Parameters) (params GetNotificationRuleParams) {
+func unpackDeleteProjectIssueCommentParams(packed middleware.Parameters) (params DeleteProjectIssueCommentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -1616,22 +1668,22 @@ func unpackGetNotificationRuleParams(packed middleware.Parameters) (params GetNo
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
+			Name: "issue_id",
 			In:   "path",
 		}
-		params.SettingID = packed[key].(uint)
+		params.IssueID = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "rule_id",
+			Name: "comment_id",
 			In:   "path",
 		}
-		params.RuleID = packed[key].(uint)
+		params.CommentID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.Request) (params GetNotificationRuleParams, _ error) {
+func decodeDeleteProjectIssueCommentParams(args [3]string, argsEscaped bool, r *http.Request) (params DeleteProjectIssueCommentParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -1677,7 +1729,7 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
-	// Decode path: setting_id.
+	// Decode path: issue_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -1689,7 +1741,7 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "issue_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1706,7 +1758,7 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.SettingID = c
+				params.IssueID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1717,12 +1769,12 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "issue_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: rule_id.
+	// Decode path: comment_id.
 	if err := func() error {
 		param := args[2]
 		if argsEscaped {
@@ -1734,7 +1786,7 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "rule_id",
+				Param:   "comment_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1751,7 +1803,7 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.RuleID = c
+				params.CommentID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1762,7 +1814,7 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "rule_id",
+			Name: "comment_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1770,32 +1822,24 @@ func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetNotificationSettingParams is parameters of GetNotificationSetting operation.
-type GetNotificationSettingParams struct {
-	ProjectID uint
-	SettingID uint
+// DeleteTeamParams is parameters of DeleteTeam operation.
+type DeleteTeamParams struct {
+	TeamID uint
 }
 
-func unpackGetNotificationSettingParams(packed middleware.Parameters) (params GetNotificationSettingParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "project_id",
-			In:   "path",
-		}
-		params.ProjectID = packed[key].(uint)
-	}
+func unpackDeleteTeamParams(packed middleware.Parameters) (params DeleteTeamParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
+			Name: "team_id",
 			In:   "path",
 		}
-		params.SettingID = packed[key].(uint)
+		params.TeamID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetNotificationSettingParams(args [2]string, argsEscaped bool, r *http.Request) (params GetNotificationSettingParams, _ error) {
-	// Decode path: project_id.
+func decodeDeleteTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTeamParams, _ error) {
+	// Decode path: team_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1807,52 +1851,7 @@ func decodeGetNotificationSettingParams(args [2]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "project_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUint(val)
-				if err != nil {
-					return err
-				}
-
-				params.ProjectID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "project_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode path: setting_id.
-	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "team_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1869,7 +1868,7 @@ func decodeGetNotificationSettingParams(args [2]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.SettingID = c
+				params.TeamID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1880,7 +1879,7 @@ func decodeGetNotificationSettingParams(args [2]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "team_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1888,24 +1887,24 @@ func decodeGetNotificationSettingParams(args [2]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// GetProjectParams is parameters of GetProject operation.
-type GetProjectParams struct {
-	ProjectID uint
+// DeleteUserParams is parameters of DeleteUser operation.
+type DeleteUserParams struct {
+	UserID uint
 }
 
-func unpackGetProjectParams(packed middleware.Parameters) (params GetProjectParams) {
+func unpackDeleteUserParams(packed middleware.Parameters) (params DeleteUserParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "project_id",
+			Name: "user_id",
 			In:   "path",
 		}
-		params.ProjectID = packed[key].(uint)
+		params.UserID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectParams, _ error) {
-	// Decode path: project_id.
+func decodeDeleteUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserParams, _ error) {
+	// Decode path: user_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1917,7 +1916,7 @@ func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "project_id",
+				Param:   "user_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1934,7 +1933,7 @@ func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.ProjectID = c
+				params.UserID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1945,7 +1944,7 @@ func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "project_id",
+			Name: "user_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1953,15 +1952,13 @@ func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// GetProjectIssueEventsTimeseriesParams is parameters of GetProjectIssueEventsTimeseries operation.
-type GetProjectIssueEventsTimeseriesParams struct {
-	ProjectID   uint
-	IssueID     uint
-	Interval    string
-	Granularity string
+// DownloadAttachmentParams is parameters of DownloadAttachment operation.
+type DownloadAttachmentParams struct {
+	ProjectID    uint
+	AttachmentID uint
 }
 
-func unpackGetProjectIssueEventsTimeseriesParams(packed middleware.Parameters) (params GetProjectIssueEventsTimeseriesParams) {
+func unpackDownloadAttachmentParams(packed middleware.Parameters) (params DownloadAttachmentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -1971,30 +1968,15 @@ func unpackGetProjectIssueEventsTimeseriesParams(packed middleware.Parameters) (
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "issue_id",
+			Name: "attachment_id",
 			In:   "path",
 		}
-		params.IssueID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "interval",
-			In:   "query",
-		}
-		params.Interval = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "granularity",
-			In:   "query",
-		}
-		params.Granularity = packed[key].(string)
+		params.AttachmentID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueEventsTimeseriesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeDownloadAttachmentParams(args [2]string, argsEscaped bool, r *http.Request) (params DownloadAttachmentParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -2040,7 +2022,7 @@ func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped boo
 			Err:  err,
 		}
 	}
-	// Decode path: issue_id.
+	// Decode path: attachment_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -2052,7 +2034,7 @@ func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped boo
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "issue_id",
+				Param:   "attachment_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2069,7 +2051,7 @@ func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped boo
 					return err
 				}
 
-				params.IssueID = c
+				params.AttachmentID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2080,11 +2062,102 @@ func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped boo
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "issue_id",
+			Name: "attachment_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetEventsTimeseriesParams is parameters of GetEventsTimeseries operation.
+type GetEventsTimeseriesParams struct {
+	ProjectID   OptUint
+	Interval    string
+	Granularity string
+	// Only events of the environment are counted.
+	Environment OptString
+}
+
+func unpackGetEventsTimeseriesParams(packed middleware.Parameters) (params GetEventsTimeseriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ProjectID = v.(OptUint)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		params.Granularity = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "environment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Environment = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetEventsTimeseriesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetEventsTimeseriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: project_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "project_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProjectIDVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProjectIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ProjectID.SetTo(paramsDotProjectIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: interval.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -2189,51 +2262,98 @@ func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped boo
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: environment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GetProjectIssueTimeseriesParams is parameters of GetProjectIssueTimeseries operation.
-type GetProjectIssueTimeseriesParams struct {
-	ProjectID   uint
-	IssueID     uint
-	Interval    string
-	Granularity string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEnvironmentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackGetProjectIssueTimeseriesParams(packed middleware.Parameters) (params GetProjectIssueTimeseriesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "project_id",
-			In:   "path",
-		}
-		params.ProjectID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "issue_id",
-			In:   "path",
-		}
-		params.IssueID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "interval",
-			In:   "query",
-		}
-		params.Interval = packed[key].(string)
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEnvironmentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Environment.SetTo(paramsDotEnvironmentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Environment.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "environment",
+			In:   "query",
+			Err:  err,
+		}
 	}
+	return params, nil
+}
+
+// GetIssueParams is parameters of GetIssue operation.
+type GetIssueParams struct {
+	ProjectID uint
+	IssueID   uint
+}
+
+func unpackGetIssueParams(packed middleware.Parameters) (params GetIssueParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "granularity",
-			In:   "query",
+			Name: "project_id",
+			In:   "path",
 		}
-		params.Granularity = packed[key].(string)
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetProjectIssueTimeseriesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueTimeseriesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetIssueParams(args [2]string, argsEscaped bool, r *http.Request) (params GetIssueParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -2324,6 +2444,86 @@ func decodeGetProjectIssueTimeseriesParams(args [2]string, argsEscaped bool, r *
 			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetIssuesTimeseriesParams is parameters of GetIssuesTimeseries operation.
+type GetIssuesTimeseriesParams struct {
+	ProjectID   OptUint
+	Interval    string
+	Granularity string
+}
+
+func unpackGetIssuesTimeseriesParams(packed middleware.Parameters) (params GetIssuesTimeseriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ProjectID = v.(OptUint)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		params.Granularity = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetIssuesTimeseriesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetIssuesTimeseriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: project_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "project_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProjectIDVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProjectIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ProjectID.SetTo(paramsDotProjectIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: interval.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -2431,13 +2631,13 @@ func decodeGetProjectIssueTimeseriesParams(args [2]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetProjectReleaseAnalyticsDetailsParams is parameters of GetProjectReleaseAnalyticsDetails operation.
-type GetProjectReleaseAnalyticsDetailsParams struct {
+// GetMonitorParams is parameters of GetMonitor operation.
+type GetMonitorParams struct {
 	ProjectID uint
-	Version   string
+	MonitorID uint
 }
 
-func unpackGetProjectReleaseAnalyticsDetailsParams(packed middleware.Parameters) (params GetProjectReleaseAnalyticsDetailsParams) {
+func unpackGetMonitorParams(packed middleware.Parameters) (params GetMonitorParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -2447,15 +2647,15 @@ func unpackGetProjectReleaseAnalyticsDetailsParams(packed middleware.Parameters)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "version",
+			Name: "monitor_id",
 			In:   "path",
 		}
-		params.Version = packed[key].(string)
+		params.MonitorID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectReleaseAnalyticsDetailsParams, _ error) {
+func decodeGetMonitorParams(args [2]string, argsEscaped bool, r *http.Request) (params GetMonitorParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -2501,7 +2701,7 @@ func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped b
 			Err:  err,
 		}
 	}
-	// Decode path: version.
+	// Decode path: monitor_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -2513,7 +2713,7 @@ func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped b
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "version",
+				Param:   "monitor_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2525,12 +2725,12 @@ func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped b
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Version = c
+				params.MonitorID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2541,7 +2741,7 @@ func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped b
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "version",
+			Name: "monitor_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2549,17 +2749,14 @@ func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped b
 	return params, nil
 }
 
-// GetProjectReleaseErrorsTimeseriesParams is parameters of GetProjectReleaseErrorsTimeseries operation.
-type GetProjectReleaseErrorsTimeseriesParams struct {
-	ProjectID   uint
-	Release     string
-	Level       OptIssueLevel
-	GroupBy     OptGetProjectReleaseErrorsTimeseriesGroupBy
-	Interval    string
-	Granularity string
+// GetNotificationRuleParams is parameters of GetNotificationRule operation.
+type GetNotificationRuleParams struct {
+	ProjectID uint
+	SettingID uint
+	RuleID    uint
 }
 
-func unpackGetProjectReleaseErrorsTimeseriesParams(packed middleware.Parameters) (params GetProjectReleaseErrorsTimeseriesParams) {
+func unpackGetNotificationRuleParams(packed middleware.Parameters) (params GetNotificationRuleParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -2569,48 +2766,22 @@ func unpackGetProjectReleaseErrorsTimeseriesParams(packed middleware.Parameters)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "release",
-			In:   "query",
-		}
-		params.Release = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "level",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Level = v.(OptIssueLevel)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "group_by",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.GroupBy = v.(OptGetProjectReleaseErrorsTimeseriesGroupBy)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "interval",
-			In:   "query",
+			Name: "setting_id",
+			In:   "path",
 		}
-		params.Interval = packed[key].(string)
+		params.SettingID = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "granularity",
-			In:   "query",
+			Name: "rule_id",
+			In:   "path",
 		}
-		params.Granularity = packed[key].(string)
+		params.RuleID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetProjectReleaseErrorsTimeseriesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectReleaseErrorsTimeseriesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetNotificationRuleParams(args [3]string, argsEscaped bool, r *http.Request) (params GetNotificationRuleParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -2656,29 +2827,38 @@ func decodeGetProjectReleaseErrorsTimeseriesParams(args [1]string, argsEscaped b
 			Err:  err,
 		}
 	}
-	// Decode query: release.
+	// Decode path: setting_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "release",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "setting_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Release = c
+				params.SettingID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -2687,78 +2867,5643 @@ func decodeGetProjectReleaseErrorsTimeseriesParams(args [1]string, argsEscaped b
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "release",
-			In:   "query",
+			Name: "setting_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: level.
+	// Decode path: rule_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "level",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLevelVal IssueLevel
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "rule_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.RuleID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "rule_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetNotificationSettingParams is parameters of GetNotificationSetting operation.
+type GetNotificationSettingParams struct {
+	ProjectID uint
+	SettingID uint
+}
+
+func unpackGetNotificationSettingParams(packed middleware.Parameters) (params GetNotificationSettingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "setting_id",
+			In:   "path",
+		}
+		params.SettingID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetNotificationSettingParams(args [2]string, argsEscaped bool, r *http.Request) (params GetNotificationSettingParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: setting_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "setting_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.SettingID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "setting_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectParams is parameters of GetProject operation.
+type GetProjectParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectParams(packed middleware.Parameters) (params GetProjectParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectDataScrubbingParams is parameters of GetProjectDataScrubbing operation.
+type GetProjectDataScrubbingParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectDataScrubbingParams(packed middleware.Parameters) (params GetProjectDataScrubbingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectDataScrubbingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectDataScrubbingParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectEventParams is parameters of GetProjectEvent operation.
+type GetProjectEventParams struct {
+	ProjectID uint
+	EventID   string
+}
+
+func unpackGetProjectEventParams(packed middleware.Parameters) (params GetProjectEventParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "event_id",
+			In:   "path",
+		}
+		params.EventID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetProjectEventParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectEventParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: event_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "event_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.EventID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "event_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectGroupingConfigParams is parameters of GetProjectGroupingConfig operation.
+type GetProjectGroupingConfigParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectGroupingConfigParams(packed middleware.Parameters) (params GetProjectGroupingConfigParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectGroupingConfigParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectGroupingConfigParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectInboundFiltersParams is parameters of GetProjectInboundFilters operation.
+type GetProjectInboundFiltersParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectInboundFiltersParams(packed middleware.Parameters) (params GetProjectInboundFiltersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectInboundFiltersParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectInboundFiltersParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectIssueEventsTimeseriesParams is parameters of GetProjectIssueEventsTimeseries operation.
+type GetProjectIssueEventsTimeseriesParams struct {
+	ProjectID   uint
+	IssueID     uint
+	Interval    string
+	Granularity string
+	// Only events of the environment are counted.
+	Environment OptString
+}
+
+func unpackGetProjectIssueEventsTimeseriesParams(packed middleware.Parameters) (params GetProjectIssueEventsTimeseriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		params.Granularity = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "environment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Environment = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetProjectIssueEventsTimeseriesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueEventsTimeseriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Interval = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Interval)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "interval",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: granularity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Granularity = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Granularity)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "granularity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: environment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEnvironmentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEnvironmentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Environment.SetTo(paramsDotEnvironmentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Environment.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "environment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectIssueLatestEventParams is parameters of GetProjectIssueLatestEvent operation.
+type GetProjectIssueLatestEventParams struct {
+	ProjectID uint
+	IssueID   uint
+}
+
+func unpackGetProjectIssueLatestEventParams(packed middleware.Parameters) (params GetProjectIssueLatestEventParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectIssueLatestEventParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueLatestEventParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectIssueOldestEventParams is parameters of GetProjectIssueOldestEvent operation.
+type GetProjectIssueOldestEventParams struct {
+	ProjectID uint
+	IssueID   uint
+}
+
+func unpackGetProjectIssueOldestEventParams(packed middleware.Parameters) (params GetProjectIssueOldestEventParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectIssueOldestEventParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueOldestEventParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectIssueTagsParams is parameters of GetProjectIssueTags operation.
+type GetProjectIssueTagsParams struct {
+	ProjectID uint
+	IssueID   uint
+	// Number of the top values of every tag.
+	Limit OptUint
+}
+
+func unpackGetProjectIssueTagsParams(packed middleware.Parameters) (params GetProjectIssueTagsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeGetProjectIssueTagsParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueTagsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := uint(10)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectIssueTimeseriesParams is parameters of GetProjectIssueTimeseries operation.
+type GetProjectIssueTimeseriesParams struct {
+	ProjectID   uint
+	IssueID     uint
+	Interval    string
+	Granularity string
+}
+
+func unpackGetProjectIssueTimeseriesParams(packed middleware.Parameters) (params GetProjectIssueTimeseriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		params.Granularity = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetProjectIssueTimeseriesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectIssueTimeseriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Interval = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Interval)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "interval",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: granularity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Granularity = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Granularity)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "granularity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectOutcomesParams is parameters of GetProjectOutcomes operation.
+type GetProjectOutcomesParams struct {
+	ProjectID   uint
+	Interval    string
+	Granularity string
+}
+
+func unpackGetProjectOutcomesParams(packed middleware.Parameters) (params GetProjectOutcomesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		params.Granularity = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetProjectOutcomesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectOutcomesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Interval = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Interval)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "interval",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: granularity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Granularity = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Granularity)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "granularity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectRateLimitsParams is parameters of GetProjectRateLimits operation.
+type GetProjectRateLimitsParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectRateLimitsParams(packed middleware.Parameters) (params GetProjectRateLimitsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectRateLimitsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectRateLimitsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectReleaseAnalyticsDetailsParams is parameters of GetProjectReleaseAnalyticsDetails operation.
+type GetProjectReleaseAnalyticsDetailsParams struct {
+	ProjectID uint
+	Version   string
+}
+
+func unpackGetProjectReleaseAnalyticsDetailsParams(packed middleware.Parameters) (params GetProjectReleaseAnalyticsDetailsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "path",
+		}
+		params.Version = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetProjectReleaseAnalyticsDetailsParams(args [2]string, argsEscaped bool, r *http.Request) (params GetProjectReleaseAnalyticsDetailsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: version.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "version",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Version = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectReleaseErrorsTimeseriesParams is parameters of GetProjectReleaseErrorsTimeseries operation.
+type GetProjectReleaseErrorsTimeseriesParams struct {
+	ProjectID   uint
+	Release     string
+	Level       OptIssueLevel
+	GroupBy     OptGetProjectReleaseErrorsTimeseriesGroupBy
+	Interval    string
+	Granularity string
+	// Only events of the environment are counted.
+	Environment OptString
+}
+
+func unpackGetProjectReleaseErrorsTimeseriesParams(packed middleware.Parameters) (params GetProjectReleaseErrorsTimeseriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "release",
+			In:   "query",
+		}
+		params.Release = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "level",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Level = v.(OptIssueLevel)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "group_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.GroupBy = v.(OptGetProjectReleaseErrorsTimeseriesGroupBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		params.Granularity = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "environment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Environment = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetProjectReleaseErrorsTimeseriesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectReleaseErrorsTimeseriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: release.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "release",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Release = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "release",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: level.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "level",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLevelVal IssueLevel
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLevelVal = IssueLevel(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Level.SetTo(paramsDotLevelVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Level.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "level",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: group_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "group_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGroupByVal GetProjectReleaseErrorsTimeseriesGroupBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGroupByVal = GetProjectReleaseErrorsTimeseriesGroupBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.GroupBy.SetTo(paramsDotGroupByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.GroupBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "group_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Interval = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Interval)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "interval",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: granularity.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "granularity",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Granularity = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Granularity)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "granularity",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: environment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEnvironmentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEnvironmentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Environment.SetTo(paramsDotEnvironmentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Environment.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "environment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectReleaseSegmentsParams is parameters of GetProjectReleaseSegments operation.
+type GetProjectReleaseSegmentsParams struct {
+	ProjectID uint
+	Release   string
+	Segment   GetProjectReleaseSegmentsSegment
+	// Only events of the environment are counted.
+	Environment OptString
+}
+
+func unpackGetProjectReleaseSegmentsParams(packed middleware.Parameters) (params GetProjectReleaseSegmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "release",
+			In:   "query",
+		}
+		params.Release = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "segment",
+			In:   "query",
+		}
+		params.Segment = packed[key].(GetProjectReleaseSegmentsSegment)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "environment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Environment = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetProjectReleaseSegmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectReleaseSegmentsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: release.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "release",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Release = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "release",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: segment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "segment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Segment = GetProjectReleaseSegmentsSegment(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Segment.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "segment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: environment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEnvironmentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEnvironmentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Environment.SetTo(paramsDotEnvironmentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Environment.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "environment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectReleasesAnalyticsParams is parameters of GetProjectReleasesAnalytics operation.
+type GetProjectReleasesAnalyticsParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectReleasesAnalyticsParams(packed middleware.Parameters) (params GetProjectReleasesAnalyticsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectReleasesAnalyticsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectReleasesAnalyticsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectStatsParams is parameters of GetProjectStats operation.
+type GetProjectStatsParams struct {
+	ProjectID uint
+	Period    GetProjectStatsPeriod
+}
+
+func unpackGetProjectStatsParams(packed middleware.Parameters) (params GetProjectStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "period",
+			In:   "query",
+		}
+		params.Period = packed[key].(GetProjectStatsPeriod)
+	}
+	return params
+}
+
+func decodeGetProjectStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectStatsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: period.
+	{
+		val := GetProjectStatsPeriod("7d")
+		params.Period = val
+	}
+	// Decode query: period.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "period",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Period = GetProjectStatsPeriod(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Period.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "period",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetProjectTeamParams is parameters of GetProjectTeam operation.
+type GetProjectTeamParams struct {
+	ProjectID uint
+}
+
+func unpackGetProjectTeamParams(packed middleware.Parameters) (params GetProjectTeamParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetProjectTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectTeamParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetRecentIssuesParams is parameters of GetRecentIssues operation.
+type GetRecentIssuesParams struct {
+	Limit uint
+}
+
+func unpackGetRecentIssuesParams(packed middleware.Parameters) (params GetRecentIssuesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		params.Limit = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetRecentIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentIssuesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := uint(5)
+		params.Limit = val
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.Limit = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTeamParams is parameters of GetTeam operation.
+type GetTeamParams struct {
+	TeamID uint
+}
+
+func unpackGetTeamParams(packed middleware.Parameters) (params GetTeamParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "team_id",
+			In:   "path",
+		}
+		params.TeamID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeGetTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTeamParams, _ error) {
+	// Decode path: team_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "team_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.TeamID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "team_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTraceParams is parameters of GetTrace operation.
+type GetTraceParams struct {
+	ProjectID uint
+	TraceID   string
+}
+
+func unpackGetTraceParams(packed middleware.Parameters) (params GetTraceParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "trace_id",
+			In:   "path",
+		}
+		params.TraceID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTraceParams(args [2]string, argsEscaped bool, r *http.Request) (params GetTraceParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: trace_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "trace_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TraceID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "trace_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserNotificationsParams is parameters of GetUserNotifications operation.
+type GetUserNotificationsParams struct {
+	Limit  OptUint
+	Offset OptUint
+}
+
+func unpackGetUserNotificationsParams(packed middleware.Parameters) (params GetUserNotificationsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptUint)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeGetUserNotificationsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserNotificationsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := uint(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        false,
+							Min:           0,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := uint(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListEventAttachmentsParams is parameters of ListEventAttachments operation.
+type ListEventAttachmentsParams struct {
+	ProjectID uint
+	EventID   string
+}
+
+func unpackListEventAttachmentsParams(packed middleware.Parameters) (params ListEventAttachmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "event_id",
+			In:   "path",
+		}
+		params.EventID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeListEventAttachmentsParams(args [2]string, argsEscaped bool, r *http.Request) (params ListEventAttachmentsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: event_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "event_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.EventID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "event_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListGroupingRulesParams is parameters of ListGroupingRules operation.
+type ListGroupingRulesParams struct {
+	ProjectID uint
+}
+
+func unpackListGroupingRulesParams(packed middleware.Parameters) (params ListGroupingRulesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListGroupingRulesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListGroupingRulesParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListIssuesParams is parameters of ListIssues operation.
+type ListIssuesParams struct {
+	// Search query, e.g. `release:1.2.* environment:production user.email:*@acme.com tags[customer]:42`.
+	// Terms are separated by spaces and must all match, `key:[a,b]` matches any of the values, `!`
+	// negates a term, `*` matches any characters and other words search the event message. Issues with
+	// events matching the query in the last 14 days are listed.
+	Query OptString
+	// Only issues with events of the environment in the last 14 days are listed.
+	Environment OptString
+	Level       OptIssueLevel
+	Status      OptIssueStatus
+	// Only issues assigned to the current user, to one of their teams or unassigned issues are listed.
+	Assigned  OptIssueAssignedFilter
+	ProjectID OptUint
+	PerPage   uint
+	Page      uint
+	SortBy    OptIssueSortColumn
+	SortOrder OptSortOrder
+}
+
+func unpackListIssuesParams(packed middleware.Parameters) (params ListIssuesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "query",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Query = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "environment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Environment = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "level",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Level = v.(OptIssueLevel)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptIssueStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "assigned",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Assigned = v.(OptIssueAssignedFilter)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ProjectID = v.(OptUint)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "per_page",
+			In:   "query",
+		}
+		params.PerPage = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		params.Page = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortBy = v.(OptIssueSortColumn)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortOrder = v.(OptSortOrder)
+		}
+	}
+	return params
+}
+
+func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListIssuesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: query.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQueryVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQueryVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Query.SetTo(paramsDotQueryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Query.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    2048,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "query",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: environment.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "environment",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEnvironmentVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEnvironmentVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Environment.SetTo(paramsDotEnvironmentVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Environment.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "environment",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: level.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "level",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLevelVal IssueLevel
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLevelVal = IssueLevel(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Level.SetTo(paramsDotLevelVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Level.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "level",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal IssueStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = IssueStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: assigned.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "assigned",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAssignedVal IssueAssignedFilter
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAssignedVal = IssueAssignedFilter(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Assigned.SetTo(paramsDotAssignedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Assigned.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "assigned",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: project_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "project_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProjectIDVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProjectIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ProjectID.SetTo(paramsDotProjectIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: per_page.
+	{
+		val := uint(20)
+		params.PerPage = val
+	}
+	// Decode query: per_page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.PerPage = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "per_page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := uint(1)
+		params.Page = val
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.Page = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort_by.
+	{
+		val := IssueSortColumn("total_events")
+		params.SortBy.SetTo(val)
+	}
+	// Decode query: sort_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortByVal IssueSortColumn
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortByVal = IssueSortColumn(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortBy.SetTo(paramsDotSortByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort_order.
+	{
+		val := SortOrder("desc")
+		params.SortOrder.SetTo(val)
+	}
+	// Decode query: sort_order.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortOrderVal SortOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortOrderVal = SortOrder(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortOrder.SetTo(paramsDotSortOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortOrder.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_order",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMonitorsParams is parameters of ListMonitors operation.
+type ListMonitorsParams struct {
+	ProjectID uint
+}
+
+func unpackListMonitorsParams(packed middleware.Parameters) (params ListMonitorsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListMonitorsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListMonitorsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListNotificationRulesParams is parameters of ListNotificationRules operation.
+type ListNotificationRulesParams struct {
+	ProjectID uint
+	SettingID uint
+}
+
+func unpackListNotificationRulesParams(packed middleware.Parameters) (params ListNotificationRulesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "setting_id",
+			In:   "path",
+		}
+		params.SettingID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http.Request) (params ListNotificationRulesParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: setting_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "setting_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.SettingID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "setting_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListNotificationSettingsParams is parameters of ListNotificationSettings operation.
+type ListNotificationSettingsParams struct {
+	ProjectID uint
+}
+
+func unpackListNotificationSettingsParams(packed middleware.Parameters) (params ListNotificationSettingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListNotificationSettingsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListNotificationSettingsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectEnvironmentsParams is parameters of ListProjectEnvironments operation.
+type ListProjectEnvironmentsParams struct {
+	ProjectID uint
+}
+
+func unpackListProjectEnvironmentsParams(packed middleware.Parameters) (params ListProjectEnvironmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListProjectEnvironmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListProjectEnvironmentsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectIssueActivityParams is parameters of ListProjectIssueActivity operation.
+type ListProjectIssueActivityParams struct {
+	ProjectID uint
+	IssueID   uint
+	Page      OptUint
+	PerPage   OptUint
+}
+
+func unpackListProjectIssueActivityParams(packed middleware.Parameters) (params ListProjectIssueActivityParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptUint)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "per_page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PerPage = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeListProjectIssueActivityParams(args [2]string, argsEscaped bool, r *http.Request) (params ListProjectIssueActivityParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := uint(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: per_page.
+	{
+		val := uint(20)
+		params.PerPage.SetTo(val)
+	}
+	// Decode query: per_page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPerPageVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPerPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PerPage.SetTo(paramsDotPerPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PerPage.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "per_page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectIssueCommentsParams is parameters of ListProjectIssueComments operation.
+type ListProjectIssueCommentsParams struct {
+	ProjectID uint
+	IssueID   uint
+}
+
+func unpackListProjectIssueCommentsParams(packed middleware.Parameters) (params ListProjectIssueCommentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListProjectIssueCommentsParams(args [2]string, argsEscaped bool, r *http.Request) (params ListProjectIssueCommentsParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectIssueEventsParams is parameters of ListProjectIssueEvents operation.
+type ListProjectIssueEventsParams struct {
+	ProjectID uint
+	IssueID   uint
+	// The next_cursor of the previous page.
+	Cursor OptString
+	Limit  OptUint
+}
+
+func unpackListProjectIssueEventsParams(packed middleware.Parameters) (params ListProjectIssueEventsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeListProjectIssueEventsParams(args [2]string, argsEscaped bool, r *http.Request) (params ListProjectIssueEventsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := uint(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectIssueTagEventsParams is parameters of ListProjectIssueTagEvents operation.
+type ListProjectIssueTagEventsParams struct {
+	ProjectID uint
+	IssueID   uint
+	Key       string
+	Value     string
+	// The next_cursor of the previous page.
+	Cursor OptString
+	Limit  OptUint
+}
+
+func unpackListProjectIssueTagEventsParams(packed middleware.Parameters) (params ListProjectIssueTagEventsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "issue_id",
+			In:   "path",
+		}
+		params.IssueID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "value",
+			In:   "query",
+		}
+		params.Value = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeListProjectIssueTagEventsParams(args [3]string, argsEscaped bool, r *http.Request) (params ListProjectIssueTagEventsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: issue_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "issue_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.IssueID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "issue_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: key.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Key = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    200,
+					MaxLengthSet: true,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Key)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "key",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: value.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "value",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Value = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    256,
+					MaxLengthSet: true,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Value)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "value",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := uint(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal uint
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectKeysParams is parameters of ListProjectKeys operation.
+type ListProjectKeysParams struct {
+	ProjectID uint
+}
+
+func unpackListProjectKeysParams(packed middleware.Parameters) (params ListProjectKeysParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeListProjectKeysParams(args [1]string, argsEscaped bool, r *http.Request) (params ListProjectKeysParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProjectTransactionsParams is parameters of ListProjectTransactions operation.
+type ListProjectTransactionsParams struct {
+	ProjectID   uint
+	Interval    string
+	Environment OptString
+	Release     OptString
+	Limit       OptUint
+}
+
+func unpackListProjectTransactionsParams(packed middleware.Parameters) (params ListProjectTransactionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "interval",
+			In:   "query",
+		}
+		params.Interval = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "environment",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Environment = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "release",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Release = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeListProjectTransactionsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListProjectTransactionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: interval.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "interval",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLevelVal = IssueLevel(c)
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Level.SetTo(paramsDotLevelVal)
+
+				params.Interval = c
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Level.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        regexMap["^\\d+(m|h|d)$"],
+				}).Validate(string(params.Interval)); err != nil {
+					return errors.Wrap(err, "string")
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "level",
+			Name: "interval",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: group_by.
+	// Decode query: environment.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "group_by",
+			Name:    "environment",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotGroupByVal GetProjectReleaseErrorsTimeseriesGroupBy
+				var paramsDotEnvironmentVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -2770,140 +8515,132 @@ func decodeGetProjectReleaseErrorsTimeseriesParams(args [1]string, argsEscaped b
 						return err
 					}
 
-					paramsDotGroupByVal = GetProjectReleaseErrorsTimeseriesGroupBy(c)
+					paramsDotEnvironmentVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.GroupBy.SetTo(paramsDotGroupByVal)
+				params.Environment.SetTo(paramsDotEnvironmentVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.GroupBy.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "group_by",
+			Name: "environment",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: interval.
+	// Decode query: release.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "interval",
+			Name:    "release",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotReleaseVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotReleaseVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Interval = c
+				params.Release.SetTo(paramsDotReleaseVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    0,
-					MaxLengthSet: false,
-					Email:        false,
-					Hostname:     false,
-					Regex:        regexMap["^\\d+(m|h|d)$"],
-				}).Validate(string(params.Interval)); err != nil {
-					return errors.Wrap(err, "string")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "interval",
+			Name: "release",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: granularity.
+	// Set default value for query: limit.
+	{
+		val := uint(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "granularity",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotLimitVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Granularity = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if err := (validate.String{
-					MinLength:    0,
-					MinLengthSet: false,
-					MaxLength:    0,
-					MaxLengthSet: false,
-					Email:        false,
-					Hostname:     false,
-					Regex:        regexMap["^\\d+(m|h|d)$"],
-				}).Validate(string(params.Granularity)); err != nil {
-					return errors.Wrap(err, "string")
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        false,
+							Min:           0,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "granularity",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
@@ -2911,41 +8648,24 @@ func decodeGetProjectReleaseErrorsTimeseriesParams(args [1]string, argsEscaped b
 	return params, nil
 }
 
-// GetProjectReleaseSegmentsParams is parameters of GetProjectReleaseSegments operation.
-type GetProjectReleaseSegmentsParams struct {
-	ProjectID uint
-	Release   string
-	Segment   GetProjectReleaseSegmentsSegment
+// ListUsersForTeamParams is parameters of ListUsersForTeam operation.
+type ListUsersForTeamParams struct {
+	TeamID uint
 }
 
-func unpackGetProjectReleaseSegmentsParams(packed middleware.Parameters) (params GetProjectReleaseSegmentsParams) {
+func unpackListUsersForTeamParams(packed middleware.Parameters) (params ListUsersForTeamParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "project_id",
+			Name: "team_id",
 			In:   "path",
 		}
-		params.ProjectID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "release",
-			In:   "query",
-		}
-		params.Release = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "segment",
-			In:   "query",
-		}
-		params.Segment = packed[key].(GetProjectReleaseSegmentsSegment)
+		params.TeamID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetProjectReleaseSegmentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectReleaseSegmentsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: project_id.
+func decodeListUsersForTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params ListUsersForTeamParams, _ error) {
+	// Decode path: team_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2957,7 +8677,7 @@ func decodeGetProjectReleaseSegmentsParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "project_id",
+				Param:   "team_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2969,50 +8689,14 @@ func decodeGetProjectReleaseSegmentsParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				c, err := conv.ToUint(val)
-				if err != nil {
-					return err
-				}
-
-				params.ProjectID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "project_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode query: release.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "release",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Release = c
+				params.TeamID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -3021,40 +8705,61 @@ func decodeGetProjectReleaseSegmentsParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "release",
-			In:   "query",
+			Name: "team_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: segment.
+	return params, nil
+}
+
+// MarkNotificationAsReadParams is parameters of MarkNotificationAsRead operation.
+type MarkNotificationAsReadParams struct {
+	NotificationID uint
+}
+
+func unpackMarkNotificationAsReadParams(packed middleware.Parameters) (params MarkNotificationAsReadParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "notification_id",
+			In:   "path",
+		}
+		params.NotificationID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeMarkNotificationAsReadParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkNotificationAsReadParams, _ error) {
+	// Decode path: notification_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "segment",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "notification_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Segment = GetProjectReleaseSegmentsSegment(c)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Segment.Validate(); err != nil {
-					return err
-				}
+				params.NotificationID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3065,20 +8770,20 @@ func decodeGetProjectReleaseSegmentsParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "segment",
-			In:   "query",
+			Name: "notification_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetProjectReleasesAnalyticsParams is parameters of GetProjectReleasesAnalytics operation.
-type GetProjectReleasesAnalyticsParams struct {
+// MergeIssuesParams is parameters of MergeIssues operation.
+type MergeIssuesParams struct {
 	ProjectID uint
 }
 
-func unpackGetProjectReleasesAnalyticsParams(packed middleware.Parameters) (params GetProjectReleasesAnalyticsParams) {
+func unpackMergeIssuesParams(packed middleware.Parameters) (params MergeIssuesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -3089,7 +8794,7 @@ func unpackGetProjectReleasesAnalyticsParams(packed middleware.Parameters) (para
 	return params
 }
 
-func decodeGetProjectReleasesAnalyticsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectReleasesAnalyticsParams, _ error) {
+func decodeMergeIssuesParams(args [1]string, argsEscaped bool, r *http.Request) (params MergeIssuesParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -3138,13 +8843,12 @@ func decodeGetProjectReleasesAnalyticsParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// GetProjectStatsParams is parameters of GetProjectStats operation.
-type GetProjectStatsParams struct {
+// PreviewGroupingRuleParams is parameters of PreviewGroupingRule operation.
+type PreviewGroupingRuleParams struct {
 	ProjectID uint
-	Period    GetProjectStatsPeriod
 }
 
-func unpackGetProjectStatsParams(packed middleware.Parameters) (params GetProjectStatsParams) {
+func unpackPreviewGroupingRuleParams(packed middleware.Parameters) (params PreviewGroupingRuleParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -3152,18 +8856,10 @@ func unpackGetProjectStatsParams(packed middleware.Parameters) (params GetProjec
 		}
 		params.ProjectID = packed[key].(uint)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "period",
-			In:   "query",
-		}
-		params.Period = packed[key].(GetProjectStatsPeriod)
-	}
 	return params
 }
 
-func decodeGetProjectStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectStatsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodePreviewGroupingRuleParams(args [1]string, argsEscaped bool, r *http.Request) (params PreviewGroupingRuleParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -3209,40 +8905,64 @@ func decodeGetProjectStatsParams(args [1]string, argsEscaped bool, r *http.Reque
 			Err:  err,
 		}
 	}
-	// Set default value for query: period.
+	return params, nil
+}
+
+// RemoveTeamMemberParams is parameters of RemoveTeamMember operation.
+type RemoveTeamMemberParams struct {
+	TeamID uint
+	UserID uint
+}
+
+func unpackRemoveTeamMemberParams(packed middleware.Parameters) (params RemoveTeamMemberParams) {
 	{
-		val := GetProjectStatsPeriod("7d")
-		params.Period = val
+		key := middleware.ParameterKey{
+			Name: "team_id",
+			In:   "path",
+		}
+		params.TeamID = packed[key].(uint)
 	}
-	// Decode query: period.
+	{
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "path",
+		}
+		params.UserID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Request) (params RemoveTeamMemberParams, _ error) {
+	// Decode path: team_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "period",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "team_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUint(val)
 				if err != nil {
 					return err
 				}
 
-				params.Period = GetProjectStatsPeriod(c)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Period.Validate(); err != nil {
-					return err
-				}
+				params.TeamID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3253,36 +8973,16 @@ func decodeGetProjectStatsParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "period",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetProjectTeamParams is parameters of GetProjectTeam operation.
-type GetProjectTeamParams struct {
-	ProjectID uint
-}
-
-func unpackGetProjectTeamParams(packed middleware.Parameters) (params GetProjectTeamParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "project_id",
+			Name: "team_id",
 			In:   "path",
+			Err:  err,
 		}
-		params.ProjectID = packed[key].(uint)
 	}
-	return params
-}
-
-func decodeGetProjectTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params GetProjectTeamParams, _ error) {
-	// Decode path: project_id.
+	// Decode path: user_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -3290,7 +8990,7 @@ func decodeGetProjectTeamParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "project_id",
+				Param:   "user_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3307,7 +9007,7 @@ func decodeGetProjectTeamParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.ProjectID = c
+				params.UserID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3318,7 +9018,7 @@ func decodeGetProjectTeamParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "project_id",
+			Name: "user_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -3326,39 +9026,50 @@ func decodeGetProjectTeamParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetRecentIssuesParams is parameters of GetRecentIssues operation.
-type GetRecentIssuesParams struct {
-	Limit uint
+// RevokeProjectKeyParams is parameters of RevokeProjectKey operation.
+type RevokeProjectKeyParams struct {
+	ProjectID uint
+	KeyID     uint
 }
 
-func unpackGetRecentIssuesParams(packed middleware.Parameters) (params GetRecentIssuesParams) {
+func unpackRevokeProjectKeyParams(packed middleware.Parameters) (params RevokeProjectKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
+			Name: "project_id",
+			In:   "path",
 		}
-		params.Limit = packed[key].(uint)
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "key_id",
+			In:   "path",
+		}
+		params.KeyID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetRecentIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentIssuesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := uint(5)
-		params.Limit = val
-	}
-	// Decode query: limit.
+func decodeRevokeProjectKeyParams(args [2]string, argsEscaped bool, r *http.Request) (params RevokeProjectKeyParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -3369,47 +9080,27 @@ func decodeGetRecentIssuesParams(args [0]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.Limit = c
+				params.ProjectID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
 			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetTeamParams is parameters of GetTeam operation.
-type GetTeamParams struct {
-	TeamID uint
-}
-
-func unpackGetTeamParams(packed middleware.Parameters) (params GetTeamParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "team_id",
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
 			In:   "path",
+			Err:  err,
 		}
-		params.TeamID = packed[key].(uint)
 	}
-	return params
-}
-
-func decodeGetTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTeamParams, _ error) {
-	// Decode path: team_id.
+	// Decode path: key_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -3417,7 +9108,7 @@ func decodeGetTeamParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "team_id",
+				Param:   "key_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3434,7 +9125,7 @@ func decodeGetTeamParams(args [1]string, argsEscaped bool, r *http.Request) (par
 					return err
 				}
 
-				params.TeamID = c
+				params.KeyID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3445,7 +9136,7 @@ func decodeGetTeamParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "team_id",
+			Name: "key_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -3453,242 +9144,254 @@ func decodeGetTeamParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// GetUserNotificationsParams is parameters of GetUserNotifications operation.
-type GetUserNotificationsParams struct {
-	Limit  OptUint
-	Offset OptUint
+// RotateProjectKeyParams is parameters of RotateProjectKey operation.
+type RotateProjectKeyParams struct {
+	ProjectID uint
+	KeyID     uint
 }
 
-func unpackGetUserNotificationsParams(packed middleware.Parameters) (params GetUserNotificationsParams) {
+func unpackRotateProjectKeyParams(packed middleware.Parameters) (params RotateProjectKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptUint)
+			Name: "project_id",
+			In:   "path",
 		}
+		params.ProjectID = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptUint)
+			Name: "key_id",
+			In:   "path",
 		}
+		params.KeyID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeGetUserNotificationsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserNotificationsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := uint(50)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+func decodeRotateProjectKeyParams(args [2]string, argsEscaped bool, r *http.Request) (params RotateProjectKeyParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal uint
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToUint(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        false,
-							Min:           0,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
 				}
+
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "project_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := uint(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode path: key_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal uint
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToUint(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUint(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.KeyID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "key_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// ListIssuesParams is parameters of ListIssues operation.
-type ListIssuesParams struct {
-	Level     OptIssueLevel
-	Status    OptIssueStatus
-	ProjectID OptUint
-	PerPage   uint
-	Page      uint
-	SortBy    OptIssueSortColumn
-	SortOrder OptSortOrder
+// SearchProjectEventsParams is parameters of SearchProjectEvents operation.
+type SearchProjectEventsParams struct {
+	ProjectID uint
+	// Search query, e.g. `release:1.2.* environment:production user.email:*@acme.com tags[customer]:42`.
+	// Terms are separated by spaces and must all match, `key:[a,b]` matches any of the values, `!`
+	// negates a term, `*` matches any characters and other words search the event message.
+	Query OptString
+	// Start of the searched time range, 14 days before time_to by default.
+	TimeFrom OptDateTime
+	// End of the searched time range, now by default.
+	TimeTo OptDateTime
+	// The next_cursor of the previous page.
+	Cursor OptString
+	Limit  OptUint
 }
 
-func unpackListIssuesParams(packed middleware.Parameters) (params ListIssuesParams) {
+func unpackSearchProjectEventsParams(packed middleware.Parameters) (params SearchProjectEventsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "level",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Level = v.(OptIssueLevel)
+			Name: "project_id",
+			In:   "path",
 		}
+		params.ProjectID = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "status",
+			Name: "query",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Status = v.(OptIssueStatus)
+			params.Query = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "project_id",
+			Name: "time_from",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.ProjectID = v.(OptUint)
+			params.TimeFrom = v.(OptDateTime)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "per_page",
+			Name: "time_to",
 			In:   "query",
 		}
-		params.PerPage = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "page",
-			In:   "query",
+		if v, ok := packed[key]; ok {
+			params.TimeTo = v.(OptDateTime)
 		}
-		params.Page = packed[key].(uint)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sort_by",
+			Name: "cursor",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SortBy = v.(OptIssueSortColumn)
+			params.Cursor = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sort_order",
+			Name: "limit",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SortOrder = v.(OptSortOrder)
+			params.Limit = v.(OptUint)
+		}
+	}
+	return params
+}
+
+func decodeSearchProjectEventsParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchProjectEventsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProjectID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "project_id",
+			In:   "path",
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListIssuesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: level.
+	// Decode query: query.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "level",
+			Name:    "query",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLevelVal IssueLevel
+				var paramsDotQueryVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -3700,21 +9403,29 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 						return err
 					}
 
-					paramsDotLevelVal = IssueLevel(c)
+					paramsDotQueryVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Level.SetTo(paramsDotLevelVal)
+				params.Query.SetTo(paramsDotQueryVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Level.Get(); ok {
+				if value, ok := params.Query.Get(); ok {
 					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    2048,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
 						}
 						return nil
 					}(); err != nil {
@@ -3729,95 +9440,121 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "level",
+			Name: "query",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: status.
+	// Decode query: time_from.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
+			Name:    "time_from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal IssueStatus
+				var paramsDotTimeFromVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotStatusVal = IssueStatus(c)
+					paramsDotTimeFromVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Status.SetTo(paramsDotStatusVal)
+				params.TimeFrom.SetTo(paramsDotTimeFromVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "time_from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: time_to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "time_to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTimeToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotTimeToVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.TimeTo.SetTo(paramsDotTimeToVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+			Name: "time_to",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: project_id.
+	// Decode query: cursor.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "project_id",
+			Name:    "cursor",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotProjectIDVal uint
+				var paramsDotCursorVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToUint(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotProjectIDVal = c
+					paramsDotCursorVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ProjectID.SetTo(paramsDotProjectIDVal)
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
 			}); err != nil {
 				return err
@@ -3826,26 +9563,128 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "project_id",
+			Name: "cursor",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: per_page.
+	// Set default value for query: limit.
 	{
-		val := uint(20)
-		params.PerPage = val
+		val := uint(50)
+		params.Limit.SetTo(val)
 	}
-	// Decode query: per_page.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "per_page",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal uint
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUint(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SendTestNotificationParams is parameters of sendTestNotification operation.
+type SendTestNotificationParams struct {
+	ProjectID uint
+	SettingID uint
+}
+
+func unpackSendTestNotificationParams(packed middleware.Parameters) (params SendTestNotificationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "setting_id",
+			In:   "path",
+		}
+		params.SettingID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeSendTestNotificationParams(args [2]string, argsEscaped bool, r *http.Request) (params SendTestNotificationParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -3856,9 +9695,9 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.PerPage = c
+				params.ProjectID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -3867,26 +9706,95 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "per_page",
-			In:   "query",
+			Name: "project_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
+	// Decode path: setting_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "setting_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
+				}
+
+				params.SettingID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "setting_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SetSuperuserStatusParams is parameters of SetSuperuserStatus operation.
+type SetSuperuserStatusParams struct {
+	UserID uint
+}
+
+func unpackSetSuperuserStatusParams(packed middleware.Parameters) (params SetSuperuserStatusParams) {
 	{
-		val := uint(1)
-		params.Page = val
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "path",
+		}
+		params.UserID = packed[key].(uint)
 	}
-	// Decode query: page.
+	return params
+}
+
+func decodeSetSuperuserStatusParams(args [1]string, argsEscaped bool, r *http.Request) (params SetSuperuserStatusParams, _ error) {
+	// Decode path: user_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -3897,9 +9805,9 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.Page = c
+				params.UserID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
@@ -3908,143 +9816,86 @@ func decodeListIssuesParams(args [0]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "user_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: sort_by.
-	{
-		val := IssueSortColumn("total_events")
-		params.SortBy.SetTo(val)
-	}
-	// Decode query: sort_by.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortByVal IssueSortColumn
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// SetUserActiveStatusParams is parameters of SetUserActiveStatus operation.
+type SetUserActiveStatusParams struct {
+	UserID uint
+}
 
-					paramsDotSortByVal = IssueSortColumn(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.SortBy.SetTo(paramsDotSortByVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.SortBy.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "sort_by",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: sort_order.
+func unpackSetUserActiveStatusParams(packed middleware.Parameters) (params SetUserActiveStatusParams) {
 	{
-		val := SortOrder("desc")
-		params.SortOrder.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "path",
+		}
+		params.UserID = packed[key].(uint)
 	}
-	// Decode query: sort_order.
+	return params
+}
+
+func decodeSetUserActiveStatusParams(args [1]string, argsEscaped bool, r *http.Request) (params SetUserActiveStatusParams, _ error) {
+	// Decode path: user_id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort_order",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortOrderVal SortOrder
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotSortOrderVal = SortOrder(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.SortOrder.SetTo(paramsDotSortOrderVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.SortOrder.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUint(val)
+				if err != nil {
+					return err
 				}
+
+				params.UserID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort_order",
-			In:   "query",
+			Name: "user_id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// ListNotificationRulesParams is parameters of ListNotificationRules operation.
-type ListNotificationRulesParams struct {
+// UnmergeIssueParams is parameters of UnmergeIssue operation.
+type UnmergeIssueParams struct {
 	ProjectID uint
-	SettingID uint
+	IssueID   uint
 }
 
-func unpackListNotificationRulesParams(packed middleware.Parameters) (params ListNotificationRulesParams) {
+func unpackUnmergeIssueParams(packed middleware.Parameters) (params UnmergeIssueParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -4054,15 +9905,15 @@ func unpackListNotificationRulesParams(packed middleware.Parameters) (params Lis
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
+			Name: "issue_id",
 			In:   "path",
 		}
-		params.SettingID = packed[key].(uint)
+		params.IssueID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http.Request) (params ListNotificationRulesParams, _ error) {
+func decodeUnmergeIssueParams(args [2]string, argsEscaped bool, r *http.Request) (params UnmergeIssueParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -4108,7 +9959,7 @@ func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http
 			Err:  err,
 		}
 	}
-	// Decode path: setting_id.
+	// Decode path: issue_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -4120,7 +9971,7 @@ func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "issue_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4137,7 +9988,7 @@ func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.SettingID = c
+				params.IssueID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4148,7 +9999,7 @@ func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "issue_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4156,12 +10007,13 @@ func decodeListNotificationRulesParams(args [2]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// ListNotificationSettingsParams is parameters of ListNotificationSettings operation.
-type ListNotificationSettingsParams struct {
+// UpdateGroupingRuleParams is parameters of UpdateGroupingRule operation.
+type UpdateGroupingRuleParams struct {
 	ProjectID uint
+	RuleID    uint
 }
 
-func unpackListNotificationSettingsParams(packed middleware.Parameters) (params ListNotificationSettingsParams) {
+func unpackUpdateGroupingRuleParams(packed middleware.Parameters) (params UpdateGroupingRuleParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -4169,10 +10021,17 @@ func unpackListNotificationSettingsParams(packed middleware.Parameters) (params
 		}
 		params.ProjectID = packed[key].(uint)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "rule_id",
+			In:   "path",
+		}
+		params.RuleID = packed[key].(uint)
+	}
 	return params
 }
 
-func decodeListNotificationSettingsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListNotificationSettingsParams, _ error) {
+func decodeUpdateGroupingRuleParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateGroupingRuleParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -4218,31 +10077,11 @@ func decodeListNotificationSettingsParams(args [1]string, argsEscaped bool, r *h
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ListUsersForTeamParams is parameters of ListUsersForTeam operation.
-type ListUsersForTeamParams struct {
-	TeamID uint
-}
-
-func unpackListUsersForTeamParams(packed middleware.Parameters) (params ListUsersForTeamParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "team_id",
-			In:   "path",
-		}
-		params.TeamID = packed[key].(uint)
-	}
-	return params
-}
-
-func decodeListUsersForTeamParams(args [1]string, argsEscaped bool, r *http.Request) (params ListUsersForTeamParams, _ error) {
-	// Decode path: team_id.
+	// Decode path: rule_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -4250,7 +10089,7 @@ func decodeListUsersForTeamParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "team_id",
+				Param:   "rule_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4267,7 +10106,7 @@ func decodeListUsersForTeamParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.TeamID = c
+				params.RuleID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4278,7 +10117,7 @@ func decodeListUsersForTeamParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "team_id",
+			Name: "rule_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4286,24 +10125,40 @@ func decodeListUsersForTeamParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// MarkNotificationAsReadParams is parameters of MarkNotificationAsRead operation.
-type MarkNotificationAsReadParams struct {
-	NotificationID uint
+// UpdateNotificationRuleParams is parameters of UpdateNotificationRule operation.
+type UpdateNotificationRuleParams struct {
+	ProjectID uint
+	SettingID uint
+	RuleID    uint
 }
 
-func unpackMarkNotificationAsReadParams(packed middleware.Parameters) (params MarkNotificationAsReadParams) {
+func unpackUpdateNotificationRuleParams(packed middleware.Parameters) (params UpdateNotificationRuleParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
 	{
 		key := middleware.ParameterKey{
-			Name: "notification_id",
+			Name: "setting_id",
 			In:   "path",
 		}
-		params.NotificationID = packed[key].(uint)
+		params.SettingID = packed[key].(uint)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "rule_id",
+			In:   "path",
+		}
+		params.RuleID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeMarkNotificationAsReadParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkNotificationAsReadParams, _ error) {
-	// Decode path: notification_id.
+func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *http.Request) (params UpdateNotificationRuleParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4315,7 +10170,7 @@ func decodeMarkNotificationAsReadParams(args [1]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "notification_id",
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4332,7 +10187,7 @@ func decodeMarkNotificationAsReadParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.NotificationID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4343,44 +10198,16 @@ func decodeMarkNotificationAsReadParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "notification_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// RemoveTeamMemberParams is parameters of RemoveTeamMember operation.
-type RemoveTeamMemberParams struct {
-	TeamID uint
-	UserID uint
-}
-
-func unpackRemoveTeamMemberParams(packed middleware.Parameters) (params RemoveTeamMemberParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "team_id",
-			In:   "path",
-		}
-		params.TeamID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "user_id",
-			In:   "path",
-		}
-		params.UserID = packed[key].(uint)
-	}
-	return params
-}
-
-func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Request) (params RemoveTeamMemberParams, _ error) {
-	// Decode path: team_id.
+	// Decode path: setting_id.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -4388,7 +10215,7 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "team_id",
+				Param:   "setting_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4405,7 +10232,7 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.TeamID = c
+				params.SettingID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4416,16 +10243,16 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "team_id",
+			Name: "setting_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: user_id.
+	// Decode path: rule_id.
 	if err := func() error {
-		param := args[1]
+		param := args[2]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[2])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -4433,7 +10260,7 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "user_id",
+				Param:   "rule_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4450,7 +10277,7 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.UserID = c
+				params.RuleID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4461,7 +10288,7 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "user_id",
+			Name: "rule_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4469,13 +10296,13 @@ func decodeRemoveTeamMemberParams(args [2]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// SendTestNotificationParams is parameters of sendTestNotification operation.
-type SendTestNotificationParams struct {
+// UpdateNotificationSettingParams is parameters of UpdateNotificationSetting operation.
+type UpdateNotificationSettingParams struct {
 	ProjectID uint
 	SettingID uint
 }
 
-func unpackSendTestNotificationParams(packed middleware.Parameters) (params SendTestNotificationParams) {
+func unpackUpdateNotificationSettingParams(packed middleware.Parameters) (params UpdateNotificationSettingParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -4493,7 +10320,7 @@ func unpackSendTestNotificationParams(packed middleware.Parameters) (params Send
 	return params
 }
 
-func decodeSendTestNotificationParams(args [2]string, argsEscaped bool, r *http.Request) (params SendTestNotificationParams, _ error) {
+func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateNotificationSettingParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -4587,24 +10414,24 @@ func decodeSendTestNotificationParams(args [2]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// SetSuperuserStatusParams is parameters of SetSuperuserStatus operation.
-type SetSuperuserStatusParams struct {
-	UserID uint
+// UpdateProjectParams is parameters of UpdateProject operation.
+type UpdateProjectParams struct {
+	ProjectID uint
 }
 
-func unpackSetSuperuserStatusParams(packed middleware.Parameters) (params SetSuperuserStatusParams) {
+func unpackUpdateProjectParams(packed middleware.Parameters) (params UpdateProjectParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "user_id",
+			Name: "project_id",
 			In:   "path",
 		}
-		params.UserID = packed[key].(uint)
+		params.ProjectID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeSetSuperuserStatusParams(args [1]string, argsEscaped bool, r *http.Request) (params SetSuperuserStatusParams, _ error) {
-	// Decode path: user_id.
+func decodeUpdateProjectParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateProjectParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4616,7 +10443,7 @@ func decodeSetSuperuserStatusParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "user_id",
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4633,7 +10460,7 @@ func decodeSetSuperuserStatusParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.UserID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4644,7 +10471,7 @@ func decodeSetSuperuserStatusParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "user_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4652,24 +10479,24 @@ func decodeSetSuperuserStatusParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// SetUserActiveStatusParams is parameters of SetUserActiveStatus operation.
-type SetUserActiveStatusParams struct {
-	UserID uint
+// UpdateProjectDataScrubbingParams is parameters of UpdateProjectDataScrubbing operation.
+type UpdateProjectDataScrubbingParams struct {
+	ProjectID uint
 }
 
-func unpackSetUserActiveStatusParams(packed middleware.Parameters) (params SetUserActiveStatusParams) {
+func unpackUpdateProjectDataScrubbingParams(packed middleware.Parameters) (params UpdateProjectDataScrubbingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "user_id",
+			Name: "project_id",
 			In:   "path",
 		}
-		params.UserID = packed[key].(uint)
+		params.ProjectID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeSetUserActiveStatusParams(args [1]string, argsEscaped bool, r *http.Request) (params SetUserActiveStatusParams, _ error) {
-	// Decode path: user_id.
+func decodeUpdateProjectDataScrubbingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateProjectDataScrubbingParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4681,7 +10508,7 @@ func decodeSetUserActiveStatusParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "user_id",
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4698,7 +10525,7 @@ func decodeSetUserActiveStatusParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.UserID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4709,7 +10536,7 @@ func decodeSetUserActiveStatusParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "user_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4717,14 +10544,13 @@ func decodeSetUserActiveStatusParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// UpdateNotificationRuleParams is parameters of UpdateNotificationRule operation.
-type UpdateNotificationRuleParams struct {
+// UpdateProjectEnvironmentParams is parameters of UpdateProjectEnvironment operation.
+type UpdateProjectEnvironmentParams struct {
 	ProjectID uint
-	SettingID uint
-	RuleID    uint
+	Name      string
 }
 
-func unpackUpdateNotificationRuleParams(packed middleware.Parameters) (params UpdateNotificationRuleParams) {
+func unpackUpdateProjectEnvironmentParams(packed middleware.Parameters) (params UpdateProjectEnvironmentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -4734,22 +10560,15 @@ func unpackUpdateNotificationRuleParams(packed middleware.Parameters) (params Up
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
-			In:   "path",
-		}
-		params.SettingID = packed[key].(uint)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "rule_id",
+			Name: "name",
 			In:   "path",
 		}
-		params.RuleID = packed[key].(uint)
+		params.Name = packed[key].(string)
 	}
 	return params
 }
 
-func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *http.Request) (params UpdateNotificationRuleParams, _ error) {
+func decodeUpdateProjectEnvironmentParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateProjectEnvironmentParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -4795,7 +10614,7 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Decode path: setting_id.
+	// Decode path: name.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -4807,7 +10626,88 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "name",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Name = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    0,
+					MinLengthSet: false,
+					MaxLength:    64,
+					MaxLengthSet: true,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Name)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateProjectGroupingConfigParams is parameters of UpdateProjectGroupingConfig operation.
+type UpdateProjectGroupingConfigParams struct {
+	ProjectID uint
+}
+
+func unpackUpdateProjectGroupingConfigParams(packed middleware.Parameters) (params UpdateProjectGroupingConfigParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeUpdateProjectGroupingConfigParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateProjectGroupingConfigParams, _ error) {
+	// Decode path: project_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4824,7 +10724,7 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.SettingID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4835,16 +10735,36 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: rule_id.
+	return params, nil
+}
+
+// UpdateProjectInboundFiltersParams is parameters of UpdateProjectInboundFilters operation.
+type UpdateProjectInboundFiltersParams struct {
+	ProjectID uint
+}
+
+func unpackUpdateProjectInboundFiltersParams(packed middleware.Parameters) (params UpdateProjectInboundFiltersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "project_id",
+			In:   "path",
+		}
+		params.ProjectID = packed[key].(uint)
+	}
+	return params
+}
+
+func decodeUpdateProjectInboundFiltersParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateProjectInboundFiltersParams, _ error) {
+	// Decode path: project_id.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -4852,7 +10772,7 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "rule_id",
+				Param:   "project_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4869,7 +10789,7 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.RuleID = c
+				params.ProjectID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4880,7 +10800,7 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "rule_id",
+			Name: "project_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4888,13 +10808,13 @@ func decodeUpdateNotificationRuleParams(args [3]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// UpdateNotificationSettingParams is parameters of UpdateNotificationSetting operation.
-type UpdateNotificationSettingParams struct {
+// UpdateProjectKeyParams is parameters of UpdateProjectKey operation.
+type UpdateProjectKeyParams struct {
 	ProjectID uint
-	SettingID uint
+	KeyID     uint
 }
 
-func unpackUpdateNotificationSettingParams(packed middleware.Parameters) (params UpdateNotificationSettingParams) {
+func unpackUpdateProjectKeyParams(packed middleware.Parameters) (params UpdateProjectKeyParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -4904,15 +10824,15 @@ func unpackUpdateNotificationSettingParams(packed middleware.Parameters) (params
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "setting_id",
+			Name: "key_id",
 			In:   "path",
 		}
-		params.SettingID = packed[key].(uint)
+		params.KeyID = packed[key].(uint)
 	}
 	return params
 }
 
-func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateNotificationSettingParams, _ error) {
+func decodeUpdateProjectKeyParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateProjectKeyParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -4958,7 +10878,7 @@ func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *
 			Err:  err,
 		}
 	}
-	// Decode path: setting_id.
+	// Decode path: key_id.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -4970,7 +10890,7 @@ func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "setting_id",
+				Param:   "key_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4987,7 +10907,7 @@ func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.SettingID = c
+				params.KeyID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4998,7 +10918,7 @@ func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "setting_id",
+			Name: "key_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -5006,12 +10926,12 @@ func decodeUpdateNotificationSettingParams(args [2]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// UpdateProjectParams is parameters of UpdateProject operation.
-type UpdateProjectParams struct {
+// UpdateProjectRateLimitsParams is parameters of UpdateProjectRateLimits operation.
+type UpdateProjectRateLimitsParams struct {
 	ProjectID uint
 }
 
-func unpackUpdateProjectParams(packed middleware.Parameters) (params UpdateProjectParams) {
+func unpackUpdateProjectRateLimitsParams(packed middleware.Parameters) (params UpdateProjectRateLimitsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "project_id",
@@ -5022,7 +10942,7 @@ func unpackUpdateProjectParams(packed middleware.Parameters) (params UpdateProje
 	return params
 }
 
-func decodeUpdateProjectParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateProjectParams, _ error) {
+func decodeUpdateProjectRateLimitsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateProjectRateLimitsParams, _ error) {
 	// Decode path: project_id.
 	if err := func() error {
 		param := args[0]
@@ -157,8 +157,8 @@ func (s *Server) decodeAddTeamMemberRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeChangeIssueStatusRequest(r *http.Request) (
-	req *ChangeIssueStatusReq,
+func (s *Server) decodeAssignIssueRequest(r *http.Request) (
+	req *IssueAssigneeRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -197,7 +197,70 @@ func (s *Server) decodeChangeIssueStatusRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request ChangeIssueStatusReq
+		var request IssueAssigneeRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeBulkUpdateIssuesRequest(r *http.Request) (
+	req *IssueBulkRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request IssueBulkRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -228,8 +291,8 @@ func (s *Server) decodeChangeIssueStatusRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeChangeTeamMemberRoleRequest(r *http.Request) (
-	req *ChangeTeamMemberRoleRequest,
+func (s *Server) decodeChangeIssueStatusRequest(r *http.Request) (
+	req *ChangeIssueStatusReq,
 	close func() error,
 	rerr error,
 ) {
@@ -268,7 +331,7 @@ func (s *Server) decodeChangeTeamMemberRoleRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request ChangeTeamMemberRoleRequest
+		var request ChangeIssueStatusReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -299,8 +362,8 @@ func (s *Server) decodeChangeTeamMemberRoleRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeCompareProjectReleasesAnalyticsRequest(r *http.Request) (
-	req *CompareProjectReleasesAnalyticsReq,
+func (s *Server) decodeChangeTeamMemberRoleRequest(r *http.Request) (
+	req *ChangeTeamMemberRoleRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -339,7 +402,7 @@ func (s *Server) decodeCompareProjectReleasesAnalyticsRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request CompareProjectReleasesAnalyticsReq
+		var request ChangeTeamMemberRoleRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -356,14 +419,22 @@ func (s *Server) decodeCompareProjectReleasesAnalyticsRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeConfirm2FARequest(r *http.Request) (
-	req *TwoFAConfirmRequest,
+func (s *Server) decodeCompareProjectReleasesAnalyticsRequest(r *http.Request) (
+	req *CompareProjectReleasesAnalyticsReq,
 	close func() error,
 	rerr error,
 ) {
@@ -402,7 +473,7 @@ func (s *Server) decodeConfirm2FARequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request TwoFAConfirmRequest
+		var request CompareProjectReleasesAnalyticsReq
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -425,8 +496,8 @@ func (s *Server) decodeConfirm2FARequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeCreateNotificationRuleRequest(r *http.Request) (
-	req *CreateNotificationRuleRequest,
+func (s *Server) decodeConfirm2FARequest(r *http.Request) (
+	req *TwoFAConfirmRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -465,7 +536,7 @@ func (s *Server) decodeCreateNotificationRuleRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request CreateNotificationRuleRequest
+		var request TwoFAConfirmRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -488,8 +559,8 @@ func (s *Server) decodeCreateNotificationRuleRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeCreateNotificationSettingRequest(r *http.Request) (
-	req *CreateNotificationSettingRequest,
+func (s *Server) decodeCreateGroupingRuleRequest(r *http.Request) (
+	req *GroupingRuleRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -528,7 +599,7 @@ func (s *Server) decodeCreateNotificationSettingRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request CreateNotificationSettingRequest
+		var request GroupingRuleRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -559,8 +630,8 @@ func (s *Server) decodeCreateNotificationSettingRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeCreateTeamRequest(r *http.Request) (
-	req *CreateTeamRequest,
+func (s *Server) decodeCreateNotificationRuleRequest(r *http.Request) (
+	req *CreateNotificationRuleRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -599,7 +670,7 @@ func (s *Server) decodeCreateTeamRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request CreateTeamRequest
+		var request CreateNotificationRuleRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -616,22 +687,14 @@ func (s *Server) decodeCreateTeamRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
-		if err := func() error {
-			if err := request.Validate(); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return req, close, errors.Wrap(err, "validate")
-		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeCreateUserRequest(r *http.Request) (
-	req *CreateUserRequest,
+func (s *Server) decodeCreateNotificationSettingRequest(r *http.Request) (
+	req *CreateNotificationSettingRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -670,7 +733,7 @@ func (s *Server) decodeCreateUserRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request CreateUserRequest
+		var request CreateNotificationSettingRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -701,8 +764,8 @@ func (s *Server) decodeCreateUserRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeDisable2FARequest(r *http.Request) (
-	req *TwoFADisableRequest,
+func (s *Server) decodeCreateProjectIssueCommentRequest(r *http.Request) (
+	req *CreateIssueCommentRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -741,7 +804,7 @@ func (s *Server) decodeDisable2FARequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request TwoFADisableRequest
+		var request CreateIssueCommentRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -758,14 +821,22 @@ func (s *Server) decodeDisable2FARequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeForgotPasswordRequest(r *http.Request) (
-	req *ForgotPasswordRequest,
+func (s *Server) decodeCreateProjectKeyRequest(r *http.Request) (
+	req *ProjectKeyRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -804,7 +875,7 @@ func (s *Server) decodeForgotPasswordRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request ForgotPasswordRequest
+		var request ProjectKeyRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -835,8 +906,8 @@ func (s *Server) decodeForgotPasswordRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeLoginRequest(r *http.Request) (
-	req *LoginRequest,
+func (s *Server) decodeCreateTeamRequest(r *http.Request) (
+	req *CreateTeamRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -875,7 +946,7 @@ func (s *Server) decodeLoginRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request LoginRequest
+		var request CreateTeamRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -892,14 +963,22 @@ func (s *Server) decodeLoginRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeRefreshTokenRequest(r *http.Request) (
-	req *RefreshTokenRequest,
+func (s *Server) decodeCreateUserRequest(r *http.Request) (
+	req *CreateUserRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -938,7 +1017,7 @@ func (s *Server) decodeRefreshTokenRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request RefreshTokenRequest
+		var request CreateUserRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -955,14 +1034,22 @@ func (s *Server) decodeRefreshTokenRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeReset2FARequest(r *http.Request) (
-	req *TwoFAResetRequest,
+func (s *Server) decodeDisable2FARequest(r *http.Request) (
+	req *TwoFADisableRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1001,7 +1088,7 @@ func (s *Server) decodeReset2FARequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request TwoFAResetRequest
+		var request TwoFADisableRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1024,8 +1111,8 @@ func (s *Server) decodeReset2FARequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeResetPasswordRequest(r *http.Request) (
-	req *ResetPasswordRequest,
+func (s *Server) decodeForgotPasswordRequest(r *http.Request) (
+	req *ForgotPasswordRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1064,7 +1151,7 @@ func (s *Server) decodeResetPasswordRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request ResetPasswordRequest
+		var request ForgotPasswordRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1095,8 +1182,8 @@ func (s *Server) decodeResetPasswordRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSetSuperuserStatusRequest(r *http.Request) (
-	req *SetSuperuserStatusRequest,
+func (s *Server) decodeLoginRequest(r *http.Request) (
+	req *LoginRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1135,7 +1222,7 @@ func (s *Server) decodeSetSuperuserStatusRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request SetSuperuserStatusRequest
+		var request LoginRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1158,8 +1245,8 @@ func (s *Server) decodeSetSuperuserStatusRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSetUserActiveStatusRequest(r *http.Request) (
-	req *SetUserActiveStatusRequest,
+func (s *Server) decodeMergeIssuesRequest(r *http.Request) (
+	req *MergeIssuesRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1198,7 +1285,7 @@ func (s *Server) decodeSetUserActiveStatusRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request SetUserActiveStatusRequest
+		var request MergeIssuesRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1215,14 +1302,22 @@ func (s *Server) decodeSetUserActiveStatusRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeUpdateNotificationRuleRequest(r *http.Request) (
-	req *UpdateNotificationRuleRequest,
+func (s *Server) decodePreviewGroupingRuleRequest(r *http.Request) (
+	req *GroupingRuleRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1261,7 +1356,7 @@ func (s *Server) decodeUpdateNotificationRuleRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UpdateNotificationRuleRequest
+		var request GroupingRuleRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1278,14 +1373,22 @@ func (s *Server) decodeUpdateNotificationRuleRequest(r *http.Request) (
 			}
 			return req, close, err
 		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeUpdateNotificationSettingRequest(r *http.Request) (
-	req *UpdateNotificationSettingRequest,
+func (s *Server) decodeRefreshTokenRequest(r *http.Request) (
+	req *RefreshTokenRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1324,7 +1427,7 @@ func (s *Server) decodeUpdateNotificationSettingRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UpdateNotificationSettingRequest
+		var request RefreshTokenRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
@@ -1347,8 +1450,8 @@ func (s *Server) decodeUpdateNotificationSettingRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeUpdateProjectRequest(r *http.Request) (
-	req *UpdateProjectRequest,
+func (s *Server) decodeReset2FARequest(r *http.Request) (
+	req *TwoFAResetRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -1387,7 +1490,953 @@ func (s *Server) decodeUpdateProjectRequest(r *http.Request) (
 
 		d := jx.DecodeBytes(buf)
 
-		var request UpdateProjectRequest
+		var request TwoFAResetRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeResetPasswordRequest(r *http.Request) (
+	req *ResetPasswordRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request ResetPasswordRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeSetSuperuserStatusRequest(r *http.Request) (
+	req *SetSuperuserStatusRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request SetSuperuserStatusRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeSetUserActiveStatusRequest(r *http.Request) (
+	req *SetUserActiveStatusRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request SetUserActiveStatusRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUnmergeIssueRequest(r *http.Request) (
+	req *UnmergeIssueRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UnmergeIssueRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateGroupingRuleRequest(r *http.Request) (
+	req *GroupingRuleRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request GroupingRuleRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateNotificationRuleRequest(r *http.Request) (
+	req *UpdateNotificationRuleRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateNotificationRuleRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateNotificationSettingRequest(r *http.Request) (
+	req *UpdateNotificationSettingRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateNotificationSettingRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectRequest(r *http.Request) (
+	req *UpdateProjectRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateProjectRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectDataScrubbingRequest(r *http.Request) (
+	req *UpdateDataScrubbingRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateDataScrubbingRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectEnvironmentRequest(r *http.Request) (
+	req *UpdateEnvironmentRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateEnvironmentRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectGroupingConfigRequest(r *http.Request) (
+	req *UpdateGroupingConfigRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateGroupingConfigRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectInboundFiltersRequest(r *http.Request) (
+	req *UpdateInboundFiltersRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateInboundFiltersRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectKeyRequest(r *http.Request) (
+	req *UpdateProjectKeyRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateProjectKeyRequest
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateProjectRateLimitsRequest(r *http.Request) (
+	req *UpdateProjectRateLimitsRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = multierr.Append(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = multierr.Append(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request UpdateProjectRateLimitsRequest
 		if err := func() error {
 			if err := request.Decode(d); err != nil {
 				return err
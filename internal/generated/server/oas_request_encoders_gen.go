@@ -39,6 +39,34 @@ func encodeAddTeamMemberRequest(
 	return nil
 }
 
+func encodeAssignIssueRequest(
+	req *IssueAssigneeRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeBulkUpdateIssuesRequest(
+	req *IssueBulkRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeChangeIssueStatusRequest(
 	req *ChangeIssueStatusReq,
 	r *http.Request,
@@ -95,6 +123,20 @@ func encodeConfirm2FARequest(
 	return nil
 }
 
+func encodeCreateGroupingRuleRequest(
+	req *GroupingRuleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateNotificationRuleRequest(
 	req *CreateNotificationRuleRequest,
 	r *http.Request,
@@ -123,6 +165,34 @@ func encodeCreateNotificationSettingRequest(
 	return nil
 }
 
+func encodeCreateProjectIssueCommentRequest(
+	req *CreateIssueCommentRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateProjectKeyRequest(
+	req *ProjectKeyRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateTeamRequest(
 	req *CreateTeamRequest,
 	r *http.Request,
@@ -193,6 +263,34 @@ func encodeLoginRequest(
 	return nil
 }
 
+func encodeMergeIssuesRequest(
+	req *MergeIssuesRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePreviewGroupingRuleRequest(
+	req *GroupingRuleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeRefreshTokenRequest(
 	req *RefreshTokenRequest,
 	r *http.Request,
@@ -263,6 +361,34 @@ func encodeSetUserActiveStatusRequest(
 	return nil
 }
 
+func encodeUnmergeIssueRequest(
+	req *UnmergeIssueRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateGroupingRuleRequest(
+	req *GroupingRuleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateNotificationRuleRequest(
 	req *UpdateNotificationRuleRequest,
 	r *http.Request,
@@ -305,6 +431,90 @@ func encodeUpdateProjectRequest(
 	return nil
 }
 
+func encodeUpdateProjectDataScrubbingRequest(
+	req *UpdateDataScrubbingRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateProjectEnvironmentRequest(
+	req *UpdateEnvironmentRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateProjectGroupingConfigRequest(
+	req *UpdateGroupingConfigRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateProjectInboundFiltersRequest(
+	req *UpdateInboundFiltersRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateProjectKeyRequest(
+	req *UpdateProjectKeyRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateProjectRateLimitsRequest(
+	req *UpdateProjectRateLimitsRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUserChangeMyPasswordRequest(
 	req *ChangeUserPasswordRequest,
 	r *http.Request,
@@ -528,9 +528,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'a': // Prefix: "analytics/"
+						case 'a': // Prefix: "a"
 							origElem := elem
-							if l := len("analytics/"); len(elem) >= l && elem[0:l] == "analytics/" {
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 								elem = elem[l:]
 							} else {
 								break
@@ -540,56 +540,90 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'r': // Prefix: "releases"
+							case 'n': // Prefix: "nalytics/"
 								origElem := elem
-								if l := len("releases"); len(elem) >= l && elem[0:l] == "releases" {
+								if l := len("nalytics/"); len(elem) >= l && elem[0:l] == "nalytics/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									switch r.Method {
-									case "GET":
-										s.handleGetProjectReleasesAnalyticsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/"
+								case 'r': // Prefix: "releases"
 									origElem := elem
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("releases"); len(elem) >= l && elem[0:l] == "releases" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										break
+										switch r.Method {
+										case "GET":
+											s.handleGetProjectReleasesAnalyticsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
 									}
 									switch elem[0] {
-									case 'c': // Prefix: "compare"
+									case '/': // Prefix: "/"
 										origElem := elem
-										if l := len("compare"); len(elem) >= l && elem[0:l] == "compare" {
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "compare"
+											origElem := elem
+											if l := len("compare"); len(elem) >= l && elem[0:l] == "compare" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleCompareProjectReleasesAnalyticsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+											elem = origElem
+										}
+										// Param: "version"
+										// Leaf parameter
+										args[1] = elem
+										elem = ""
+
 										if len(elem) == 0 {
 											// Leaf node.
 											switch r.Method {
-											case "POST":
-												s.handleCompareProjectReleasesAnalyticsRequest([1]string{
+											case "GET":
+												s.handleGetProjectReleaseAnalyticsDetailsRequest([2]string{
 													args[0],
+													args[1],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "POST")
+												s.notAllowed(w, r, "GET")
 											}
 
 											return
@@ -597,18 +631,22 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										elem = origElem
 									}
-									// Param: "version"
-									// Leaf parameter
-									args[1] = elem
-									elem = ""
+
+									elem = origElem
+								case 's': // Prefix: "segments"
+									origElem := elem
+									if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetProjectReleaseAnalyticsDetailsRequest([2]string{
+											s.handleGetProjectReleaseSegmentsRequest([1]string{
 												args[0],
-												args[1],
 											}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
@@ -618,114 +656,161 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									}
 
 									elem = origElem
-								}
+								case 't': // Prefix: "traffic"
+									origElem := elem
+									if l := len("traffic"); len(elem) >= l && elem[0:l] == "traffic" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								elem = origElem
-							case 's': // Prefix: "segments"
-								origElem := elem
-								if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetProjectReleaseErrorsTimeseriesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetProjectReleaseSegmentsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+										return
 									}
 
-									return
+									elem = origElem
 								}
 
 								elem = origElem
-							case 't': // Prefix: "traffic"
+							case 't': // Prefix: "ttachments/"
 								origElem := elem
-								if l := len("traffic"); len(elem) >= l && elem[0:l] == "traffic" {
+								if l := len("ttachments/"); len(elem) >= l && elem[0:l] == "ttachments/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "attachment_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
 								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetProjectReleaseErrorsTimeseriesRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/download"
+									origElem := elem
+									if l := len("/download"); len(elem) >= l && elem[0:l] == "/download" {
+										elem = elem[l:]
+									} else {
+										break
 									}
 
-									return
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleDownloadAttachmentRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
 								}
 
 								elem = origElem
 							}
 
 							elem = origElem
-						case 'i': // Prefix: "issues/"
+						case 'd': // Prefix: "data-scrubbing"
 							origElem := elem
-							if l := len("issues/"); len(elem) >= l && elem[0:l] == "issues/" {
+							if l := len("data-scrubbing"); len(elem) >= l && elem[0:l] == "data-scrubbing" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "issue_id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[1] = elem[:idx]
-							elem = elem[idx:]
-
 							if len(elem) == 0 {
+								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetIssueRequest([2]string{
+									s.handleGetProjectDataScrubbingRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleUpdateProjectDataScrubbingRequest([1]string{
 										args[0],
-										args[1],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "GET,PUT")
 								}
 
 								return
 							}
+
+							elem = origElem
+						case 'e': // Prefix: "e"
+							origElem := elem
+							if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'n': // Prefix: "nvironments"
 								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("nvironments"); len(elem) >= l && elem[0:l] == "nvironments" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									break
+									switch r.Method {
+									case "GET":
+										s.handleListProjectEnvironmentsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "change-status"
+								case '/': // Prefix: "/"
 									origElem := elem
-									if l := len("change-status"); len(elem) >= l && elem[0:l] == "change-status" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
+									// Param: "name"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
 										case "PUT":
-											s.handleChangeIssueStatusRequest([2]string{
+											s.handleUpdateProjectEnvironmentRequest([2]string{
 												args[0],
 												args[1],
 											}, elemIsEscaped, w, r)
@@ -737,19 +822,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									}
 
 									elem = origElem
-								case 'e': // Prefix: "events/timeseries"
+								}
+
+								elem = origElem
+							case 'v': // Prefix: "vents"
+								origElem := elem
+								if l := len("vents"); len(elem) >= l && elem[0:l] == "vents" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleSearchProjectEventsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 									origElem := elem
-									if l := len("events/timeseries"); len(elem) >= l && elem[0:l] == "events/timeseries" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
+									// Param: "event_id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetProjectIssueEventsTimeseriesRequest([2]string{
+											s.handleGetProjectEventRequest([2]string{
 												args[0],
 												args[1],
 											}, elemIsEscaped, w, r)
@@ -759,29 +876,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/attachments"
+										origElem := elem
+										if l := len("/attachments"); len(elem) >= l && elem[0:l] == "/attachments" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									elem = origElem
-								case 't': // Prefix: "timeseries"
-									origElem := elem
-									if l := len("timeseries"); len(elem) >= l && elem[0:l] == "timeseries" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleListEventAttachmentsRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetProjectIssueTimeseriesRequest([2]string{
-												args[0],
-												args[1],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
+											return
 										}
 
-										return
+										elem = origElem
 									}
 
 									elem = origElem
@@ -791,67 +910,65 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case 'n': // Prefix: "notification-settings"
+						case 'g': // Prefix: "grouping-"
 							origElem := elem
-							if l := len("notification-settings"); len(elem) >= l && elem[0:l] == "notification-settings" {
+							if l := len("grouping-"); len(elem) >= l && elem[0:l] == "grouping-" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleListNotificationSettingsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleCreateNotificationSettingRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET,POST")
-								}
-
-								return
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'c': // Prefix: "config"
 								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "setting_id"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[1] = elem[:idx]
-								elem = elem[idx:]
-
 								if len(elem) == 0 {
+									// Leaf node.
 									switch r.Method {
-									case "DELETE":
-										s.handleDeleteNotificationSettingRequest([2]string{
+									case "GET":
+										s.handleGetProjectGroupingConfigRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateProjectGroupingConfigRequest([1]string{
 											args[0],
-											args[1],
 										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "rules"
+								origElem := elem
+								if l := len("rules"); len(elem) >= l && elem[0:l] == "rules" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
 									case "GET":
-										s.handleGetNotificationSettingRequest([2]string{
+										s.handleListGroupingRulesRequest([1]string{
 											args[0],
-											args[1],
 										}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleUpdateNotificationSettingRequest([2]string{
+									case "POST":
+										s.handleCreateGroupingRuleRequest([1]string{
 											args[0],
-											args[1],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "DELETE,GET,PUT")
+										s.notAllowed(w, r, "GET,POST")
 									}
 
 									return
@@ -869,69 +986,249 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										break
 									}
 									switch elem[0] {
-									case 'r': // Prefix: "rules"
+									case 'p': // Prefix: "preview"
 										origElem := elem
-										if l := len("rules"); len(elem) >= l && elem[0:l] == "rules" {
+										if l := len("preview"); len(elem) >= l && elem[0:l] == "preview" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
+											// Leaf node.
 											switch r.Method {
-											case "GET":
-												s.handleListNotificationRulesRequest([2]string{
-													args[0],
-													args[1],
-												}, elemIsEscaped, w, r)
 											case "POST":
-												s.handleCreateNotificationRuleRequest([2]string{
+												s.handlePreviewGroupingRuleRequest([1]string{
 													args[0],
-													args[1],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "GET,POST")
+												s.notAllowed(w, r, "POST")
 											}
 
 											return
 										}
+
+										elem = origElem
+									}
+									// Param: "rule_id"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteGroupingRuleRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUpdateGroupingRuleRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,PUT")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'i': // Prefix: "i"
+							origElem := elem
+							if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'n': // Prefix: "nbound-filters"
+								origElem := elem
+								if l := len("nbound-filters"); len(elem) >= l && elem[0:l] == "nbound-filters" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetProjectInboundFiltersRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateProjectInboundFiltersRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 's': // Prefix: "ssues/"
+								origElem := elem
+								if l := len("ssues/"); len(elem) >= l && elem[0:l] == "ssues/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "bulk"
+									origElem := elem
+									if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleBulkUpdateIssuesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'm': // Prefix: "merge"
+									origElem := elem
+									if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleMergeIssuesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+								// Param: "issue_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetIssueRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "a"
+										origElem := elem
+										if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
 										switch elem[0] {
-										case '/': // Prefix: "/"
+										case 'c': // Prefix: "ctivity"
 											origElem := elem
-											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											if l := len("ctivity"); len(elem) >= l && elem[0:l] == "ctivity" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
-											// Param: "rule_id"
-											// Leaf parameter
-											args[2] = elem
-											elem = ""
-
 											if len(elem) == 0 {
 												// Leaf node.
 												switch r.Method {
-												case "DELETE":
-													s.handleDeleteNotificationRuleRequest([3]string{
-														args[0],
-														args[1],
-														args[2],
-													}, elemIsEscaped, w, r)
 												case "GET":
-													s.handleGetNotificationRuleRequest([3]string{
+													s.handleListProjectIssueActivityRequest([2]string{
 														args[0],
 														args[1],
-														args[2],
 													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+											elem = origElem
+										case 's': // Prefix: "ssignee"
+											origElem := elem
+											if l := len("ssignee"); len(elem) >= l && elem[0:l] == "ssignee" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
 												case "PUT":
-													s.handleUpdateNotificationRuleRequest([3]string{
+													s.handleAssignIssueRequest([2]string{
 														args[0],
 														args[1],
-														args[2],
 													}, elemIsEscaped, w, r)
 												default:
-													s.notAllowed(w, r, "DELETE,GET,PUT")
+													s.notAllowed(w, r, "PUT")
 												}
 
 												return
@@ -941,28 +1238,352 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										}
 
 										elem = origElem
-									case 't': // Prefix: "test"
+									case 'c': // Prefix: "c"
 										origElem := elem
-										if l := len("test"); len(elem) >= l && elem[0:l] == "test" {
+										if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "POST":
-												s.handleSendTestNotificationRequest([2]string{
-													args[0],
-													args[1],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "POST")
+											break
+										}
+										switch elem[0] {
+										case 'h': // Prefix: "hange-status"
+											origElem := elem
+											if l := len("hange-status"); len(elem) >= l && elem[0:l] == "hange-status" {
+												elem = elem[l:]
+											} else {
+												break
 											}
 
-											return
-										}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "PUT":
+													s.handleChangeIssueStatusRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "PUT")
+												}
+
+												return
+											}
+
+											elem = origElem
+										case 'o': // Prefix: "omments"
+											origElem := elem
+											if l := len("omments"); len(elem) >= l && elem[0:l] == "omments" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleListProjectIssueCommentsRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												case "POST":
+													s.handleCreateProjectIssueCommentRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET,POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "comment_id"
+												// Leaf parameter
+												args[2] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleDeleteProjectIssueCommentRequest([3]string{
+															args[0],
+															args[1],
+															args[2],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE")
+													}
+
+													return
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 'e': // Prefix: "events"
+										origElem := elem
+										if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleListProjectIssueEventsRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'l': // Prefix: "latest"
+												origElem := elem
+												if l := len("latest"); len(elem) >= l && elem[0:l] == "latest" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetProjectIssueLatestEventRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+												elem = origElem
+											case 'o': // Prefix: "oldest"
+												origElem := elem
+												if l := len("oldest"); len(elem) >= l && elem[0:l] == "oldest" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetProjectIssueOldestEventRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+												elem = origElem
+											case 't': // Prefix: "timeseries"
+												origElem := elem
+												if l := len("timeseries"); len(elem) >= l && elem[0:l] == "timeseries" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetProjectIssueEventsTimeseriesRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 't': // Prefix: "t"
+										origElem := elem
+										if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'a': // Prefix: "ags"
+											origElem := elem
+											if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleGetProjectIssueTagsRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "key"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[2] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/events"
+													origElem := elem
+													if l := len("/events"); len(elem) >= l && elem[0:l] == "/events" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "GET":
+															s.handleListProjectIssueTagEventsRequest([3]string{
+																args[0],
+																args[1],
+																args[2],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "GET")
+														}
+
+														return
+													}
+
+													elem = origElem
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										case 'i': // Prefix: "imeseries"
+											origElem := elem
+											if l := len("imeseries"); len(elem) >= l && elem[0:l] == "imeseries" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetProjectIssueTimeseriesRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 'u': // Prefix: "unmerge"
+										origElem := elem
+										if l := len("unmerge"); len(elem) >= l && elem[0:l] == "unmerge" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleUnmergeIssueRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
 
 										elem = origElem
 									}
@@ -974,42 +1595,109 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case 's': // Prefix: "stats"
+						case 'k': // Prefix: "keys"
 							origElem := elem
-							if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+							if l := len("keys"); len(elem) >= l && elem[0:l] == "keys" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetProjectStatsRequest([1]string{
+									s.handleListProjectKeysRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateProjectKeyRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "GET,POST")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "key_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleRevokeProjectKeyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateProjectKeyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PUT")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/rotate"
+									origElem := elem
+									if l := len("/rotate"); len(elem) >= l && elem[0:l] == "/rotate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRotateProjectKeyRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
 
 							elem = origElem
-						case 't': // Prefix: "team"
+						case 'm': // Prefix: "monitors"
 							origElem := elem
-							if l := len("team"); len(elem) >= l && elem[0:l] == "team" {
+							if l := len("monitors"); len(elem) >= l && elem[0:l] == "monitors" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetProjectTeamRequest([1]string{
+									s.handleListMonitorsRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -1018,248 +1706,303 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							elem = origElem
-						}
+								// Param: "monitor_id"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
 
-						elem = origElem
-					}
-
-					elem = origElem
-				}
-
-				elem = origElem
-			case 't': // Prefix: "teams"
-				origElem := elem
-				if l := len("teams"); len(elem) >= l && elem[0:l] == "teams" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleListTeamsRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreateTeamRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
-
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'c': // Prefix: "check-exists/"
-						origElem := elem
-						if l := len("check-exists/"); len(elem) >= l && elem[0:l] == "check-exists/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "team_name"
-						// Leaf parameter
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleCheckTeamExistsRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-
-						elem = origElem
-					}
-					// Param: "team_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						switch r.Method {
-						case "DELETE":
-							s.handleDeleteTeamRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleGetTeamRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET")
-						}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteMonitorRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetMonitorRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET")
+									}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/members"
-						origElem := elem
-						if l := len("/members"); len(elem) >= l && elem[0:l] == "/members" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									return
+								}
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "POST":
-								s.handleAddTeamMemberRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+								elem = origElem
 							}
 
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+							elem = origElem
+						case 'n': // Prefix: "notification-settings"
 							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("notification-settings"); len(elem) >= l && elem[0:l] == "notification-settings" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "user_id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[1] = elem[:idx]
-							elem = elem[idx:]
-
 							if len(elem) == 0 {
 								switch r.Method {
-								case "DELETE":
-									s.handleRemoveTeamMemberRequest([2]string{
+								case "GET":
+									s.handleListNotificationSettingsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateNotificationSettingRequest([1]string{
 										args[0],
-										args[1],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "DELETE")
+									s.notAllowed(w, r, "GET,POST")
 								}
 
 								return
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/role"
+							case '/': // Prefix: "/"
 								origElem := elem
-								if l := len("/role"); len(elem) >= l && elem[0:l] == "/role" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "setting_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
+									case "DELETE":
+										s.handleDeleteNotificationSettingRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetNotificationSettingRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
 									case "PUT":
-										s.handleChangeTeamMemberRoleRequest([2]string{
+										s.handleUpdateNotificationSettingRequest([2]string{
 											args[0],
 											args[1],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "PUT")
+										s.notAllowed(w, r, "DELETE,GET,PUT")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								elem = origElem
-							}
-
-							elem = origElem
-						}
-
-						elem = origElem
-					}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "rules"
+										origElem := elem
+										if l := len("rules"); len(elem) >= l && elem[0:l] == "rules" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					elem = origElem
-				}
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleListNotificationRulesRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleCreateNotificationRuleRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,POST")
+											}
 
-				elem = origElem
-			case 'u': // Prefix: "users"
-				origElem := elem
-				if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleListUsersRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreateUserRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
+											// Param: "rule_id"
+											// Leaf parameter
+											args[2] = elem
+											elem = ""
 
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleDeleteNotificationRuleRequest([3]string{
+														args[0],
+														args[1],
+														args[2],
+													}, elemIsEscaped, w, r)
+												case "GET":
+													s.handleGetNotificationRuleRequest([3]string{
+														args[0],
+														args[1],
+														args[2],
+													}, elemIsEscaped, w, r)
+												case "PUT":
+													s.handleUpdateNotificationRuleRequest([3]string{
+														args[0],
+														args[1],
+														args[2],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE,GET,PUT")
+												}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'm': // Prefix: "me"
-						origElem := elem
-						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+												return
+											}
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+											elem = origElem
+										}
+
+										elem = origElem
+									case 't': // Prefix: "test"
+										origElem := elem
+										if l := len("test"); len(elem) >= l && elem[0:l] == "test" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleSendTestNotificationRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
 							}
 
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+							elem = origElem
+						case 'o': // Prefix: "outcomes"
 							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("outcomes"); len(elem) >= l && elem[0:l] == "outcomes" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetProjectOutcomesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "rate-limits"
+							origElem := elem
+							if l := len("rate-limits"); len(elem) >= l && elem[0:l] == "rate-limits" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetProjectRateLimitsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleUpdateProjectRateLimitsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PUT")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 's': // Prefix: "stats"
+							origElem := elem
+							if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetProjectStatsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 't': // Prefix: "t"
+							origElem := elem
+							if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1269,9 +2012,32 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case '2': // Prefix: "2fa/"
+							case 'e': // Prefix: "eam"
 								origElem := elem
-								if l := len("2fa/"); len(elem) >= l && elem[0:l] == "2fa/" {
+								if l := len("eam"); len(elem) >= l && elem[0:l] == "eam" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetProjectTeamRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "ra"
+								origElem := elem
+								if l := len("ra"); len(elem) >= l && elem[0:l] == "ra" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1281,30 +2047,38 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "confirm"
+								case 'c': // Prefix: "ces/"
 									origElem := elem
-									if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+									if l := len("ces/"); len(elem) >= l && elem[0:l] == "ces/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
+									// Param: "trace_id"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleConfirm2FARequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetTraceRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
 
 									elem = origElem
-								case 'd': // Prefix: "disable"
+								case 'n': // Prefix: "nsactions"
 									origElem := elem
-									if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+									if l := len("nsactions"); len(elem) >= l && elem[0:l] == "nsactions" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1313,173 +2087,96 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleDisable2FARequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleListProjectTransactionsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
 
 									elem = origElem
-								case 'r': // Prefix: "reset"
-									origElem := elem
-									if l := len("reset"); len(elem) >= l && elem[0:l] == "reset" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+								}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "POST":
-											s.handleReset2FARequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
-										}
+								elem = origElem
+							}
 
-										return
-									}
+							elem = origElem
+						}
 
-									elem = origElem
-								case 's': // Prefix: "se"
-									origElem := elem
-									if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+						elem = origElem
+					}
 
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 'n': // Prefix: "nd_code"
-										origElem := elem
-										if l := len("nd_code"); len(elem) >= l && elem[0:l] == "nd_code" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+					elem = origElem
+				}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "POST":
-												s.handleSend2FACodeRequest([0]string{}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "POST")
-											}
-
-											return
-										}
-
-										elem = origElem
-									case 't': // Prefix: "tup"
-										origElem := elem
-										if l := len("tup"); len(elem) >= l && elem[0:l] == "tup" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "POST":
-												s.handleSetup2FARequest([0]string{}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "POST")
-											}
-
-											return
-										}
-
-										elem = origElem
-									}
-
-									elem = origElem
-								}
-
-								elem = origElem
-							case 'c': // Prefix: "change-password"
-								origElem := elem
-								if l := len("change-password"); len(elem) >= l && elem[0:l] == "change-password" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleUserChangeMyPasswordRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
-
-									return
-								}
+				elem = origElem
+			case 't': // Prefix: "teams"
+				origElem := elem
+				if l := len("teams"); len(elem) >= l && elem[0:l] == "teams" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-								elem = origElem
-							}
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleListTeamsRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateTeamRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
 
-							elem = origElem
-						}
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-						elem = origElem
-					case 't': // Prefix: "team/"
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "check-exists/"
 						origElem := elem
-						if l := len("team/"); len(elem) >= l && elem[0:l] == "team/" {
+						if l := len("check-exists/"); len(elem) >= l && elem[0:l] == "check-exists/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "team_id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+						// Param: "team_name"
+						// Leaf parameter
+						args[0] = elem
+						elem = ""
 
 						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/list"
-							origElem := elem
-							if l := len("/list"); len(elem) >= l && elem[0:l] == "/list" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleListUsersForTeamRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleCheckTeamExistsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
 							}
 
-							elem = origElem
+							return
 						}
 
 						elem = origElem
 					}
-					// Param: "user_id"
+					// Param: "team_id"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
 					if idx < 0 {
@@ -1491,71 +2188,96 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "DELETE":
-							s.handleDeleteUserRequest([1]string{
+							s.handleDeleteTeamRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetTeamRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE")
+							s.notAllowed(w, r, "DELETE,GET")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/members"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("/members"); len(elem) >= l && elem[0:l] == "/members" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "POST":
+								s.handleAddTeamMemberRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
 						}
 						switch elem[0] {
-						case 'a': // Prefix: "active"
+						case '/': // Prefix: "/"
 							origElem := elem
-							if l := len("active"); len(elem) >= l && elem[0:l] == "active" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "user_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
-								case "PUT":
-									s.handleSetUserActiveStatusRequest([1]string{
+								case "DELETE":
+									s.handleRemoveTeamMemberRequest([2]string{
 										args[0],
+										args[1],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "PUT")
+									s.notAllowed(w, r, "DELETE")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/role"
+								origElem := elem
+								if l := len("/role"); len(elem) >= l && elem[0:l] == "/role" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							elem = origElem
-						case 's': // Prefix: "superuser"
-							origElem := elem
-							if l := len("superuser"); len(elem) >= l && elem[0:l] == "superuser" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "PUT":
+										s.handleChangeTeamMemberRoleRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "PUT")
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "PUT":
-									s.handleSetSuperuserStatusRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "PUT")
+									return
 								}
 
-								return
+								elem = origElem
 							}
 
 							elem = origElem
@@ -1568,268 +2290,360 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				elem = origElem
-			case 'v': // Prefix: "versions"
+			case 'u': // Prefix: "users"
 				origElem := elem
-				if l := len("versions"); len(elem) >= l && elem[0:l] == "versions" {
+				if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
-						s.handleGetVersionsRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleListUsersRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateUserRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, "GET,POST")
 					}
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-				elem = origElem
-			}
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'm': // Prefix: "me"
+						origElem := elem
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			elem = origElem
-		}
-	}
-	s.notFound(w, r)
-}
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [3]string
-}
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '2': // Prefix: "2fa/"
+								origElem := elem
+								if l := len("2fa/"); len(elem) >= l && elem[0:l] == "2fa/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "confirm"
+									origElem := elem
+									if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleConfirm2FARequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+										return
+									}
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+									elem = origElem
+								case 'd': // Prefix: "disable"
+									origElem := elem
+									if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleDisable2FARequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+										return
+									}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+									elem = origElem
+								case 'r': // Prefix: "reset"
+									origElem := elem
+									if l := len("reset"); len(elem) >= l && elem[0:l] == "reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/api/v1/"
-			origElem := elem
-			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleReset2FARequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "auth/"
-				origElem := elem
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										return
+									}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '2': // Prefix: "2fa/verify"
-					origElem := elem
-					if l := len("2fa/verify"); len(elem) >= l && elem[0:l] == "2fa/verify" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									elem = origElem
+								case 's': // Prefix: "se"
+									origElem := elem
+									if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = Verify2FAOperation
-							r.summary = "Verify 2FA-code on login"
-							r.operationID = "Verify2FA"
-							r.pathPattern = "/api/v1/auth/2fa/verify"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "nd_code"
+										origElem := elem
+										if l := len("nd_code"); len(elem) >= l && elem[0:l] == "nd_code" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					elem = origElem
-				case 'f': // Prefix: "forgot-password"
-					origElem := elem
-					if l := len("forgot-password"); len(elem) >= l && elem[0:l] == "forgot-password" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleSend2FACodeRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = ForgotPasswordOperation
-							r.summary = "Request a password reset"
-							r.operationID = "ForgotPassword"
-							r.pathPattern = "/api/v1/auth/forgot-password"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+											return
+										}
 
-					elem = origElem
-				case 'l': // Prefix: "login"
-					origElem := elem
-					if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										elem = origElem
+									case 't': // Prefix: "tup"
+										origElem := elem
+										if l := len("tup"); len(elem) >= l && elem[0:l] == "tup" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = LoginOperation
-							r.summary = "Authenticate user and get access token"
-							r.operationID = "Login"
-							r.pathPattern = "/api/v1/auth/login"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleSetup2FARequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-					elem = origElem
-				case 'r': // Prefix: "re"
-					origElem := elem
-					if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+											return
+										}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'f': // Prefix: "fresh"
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'c': // Prefix: "change-password"
+								origElem := elem
+								if l := len("change-password"); len(elem) >= l && elem[0:l] == "change-password" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUserChangeMyPasswordRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 't': // Prefix: "team/"
 						origElem := elem
-						if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+						if l := len("team/"); len(elem) >= l && elem[0:l] == "team/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "team_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = RefreshTokenOperation
-								r.summary = "Refresh access token"
-								r.operationID = "RefreshToken"
-								r.pathPattern = "/api/v1/auth/refresh"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/list"
+							origElem := elem
+							if l := len("/list"); len(elem) >= l && elem[0:l] == "/list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListUsersForTeamRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
 								return
 							}
+
+							elem = origElem
 						}
 
 						elem = origElem
-					case 's': // Prefix: "set-password"
+					}
+					// Param: "user_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("set-password"); len(elem) >= l && elem[0:l] == "set-password" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = ResetPasswordOperation
-								r.summary = "Reset password using token"
-								r.operationID = "ResetPassword"
-								r.pathPattern = "/api/v1/auth/reset-password"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "active"
+							origElem := elem
+							if l := len("active"); len(elem) >= l && elem[0:l] == "active" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "PUT":
+									s.handleSetUserActiveStatusRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "PUT")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 's': // Prefix: "superuser"
+							origElem := elem
+							if l := len("superuser"); len(elem) >= l && elem[0:l] == "superuser" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "PUT":
+									s.handleSetSuperuserStatusRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "PUT")
+								}
+
 								return
 							}
+
+							elem = origElem
 						}
 
 						elem = origElem
@@ -1839,9 +2653,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'e': // Prefix: "events/timeseries"
+			case 'v': // Prefix: "versions"
 				origElem := elem
-				if l := len("events/timeseries"); len(elem) >= l && elem[0:l] == "events/timeseries" {
+				if l := len("versions"); len(elem) >= l && elem[0:l] == "versions" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1849,47 +2663,202 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				if len(elem) == 0 {
 					// Leaf node.
-					switch method {
+					switch r.Method {
 					case "GET":
-						r.name = GetEventsTimeseriesOperation
-						r.summary = "Get events timeseries"
-						r.operationID = "GetEventsTimeseries"
-						r.pathPattern = "/api/v1/events/timeseries"
-						r.args = args
-						r.count = 0
-						return r, true
+						s.handleGetVersionsRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						return
+						s.notAllowed(w, r, "GET")
 					}
+
+					return
 				}
 
 				elem = origElem
-			case 'i': // Prefix: "issues"
-				origElem := elem
-				if l := len("issues"); len(elem) >= l && elem[0:l] == "issues" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+			}
 
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = ListIssuesOperation
-						r.summary = "Get all issues across all projects"
-						r.operationID = "ListIssues"
-						r.pathPattern = "/api/v1/issues"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-					origElem := elem
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+			elem = origElem
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [3]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/api/v1/"
+			origElem := elem
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "auth/"
+				origElem := elem
+				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '2': // Prefix: "2fa/verify"
+					origElem := elem
+					if l := len("2fa/verify"); len(elem) >= l && elem[0:l] == "2fa/verify" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = Verify2FAOperation
+							r.summary = "Verify 2FA-code on login"
+							r.operationID = "Verify2FA"
+							r.pathPattern = "/api/v1/auth/2fa/verify"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'f': // Prefix: "forgot-password"
+					origElem := elem
+					if l := len("forgot-password"); len(elem) >= l && elem[0:l] == "forgot-password" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = ForgotPasswordOperation
+							r.summary = "Request a password reset"
+							r.operationID = "ForgotPassword"
+							r.pathPattern = "/api/v1/auth/forgot-password"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'l': // Prefix: "login"
+					origElem := elem
+					if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = LoginOperation
+							r.summary = "Authenticate user and get access token"
+							r.operationID = "Login"
+							r.pathPattern = "/api/v1/auth/login"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'r': // Prefix: "re"
+					origElem := elem
+					if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1899,9 +2868,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'r': // Prefix: "recent"
+					case 'f': // Prefix: "fresh"
 						origElem := elem
-						if l := len("recent"); len(elem) >= l && elem[0:l] == "recent" {
+						if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1910,11 +2879,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "GET":
-								r.name = GetRecentIssuesOperation
-								r.summary = "Get recent issues across all projects"
-								r.operationID = "GetRecentIssues"
-								r.pathPattern = "/api/v1/issues/recent"
+							case "POST":
+								r.name = RefreshTokenOperation
+								r.summary = "Refresh access token"
+								r.operationID = "RefreshToken"
+								r.pathPattern = "/api/v1/auth/refresh"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -1924,9 +2893,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					case 't': // Prefix: "timeseries"
+					case 's': // Prefix: "set-password"
 						origElem := elem
-						if l := len("timeseries"); len(elem) >= l && elem[0:l] == "timeseries" {
+						if l := len("set-password"); len(elem) >= l && elem[0:l] == "set-password" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1935,11 +2904,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "GET":
-								r.name = GetIssuesTimeseriesOperation
-								r.summary = "Get issues timeseries"
-								r.operationID = "GetIssuesTimeseries"
-								r.pathPattern = "/api/v1/issues/timeseries"
+							case "POST":
+								r.name = ResetPasswordOperation
+								r.summary = "Reset password using token"
+								r.operationID = "ResetPassword"
+								r.pathPattern = "/api/v1/auth/reset-password"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -1955,21 +2924,46 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'n': // Prefix: "notifications"
+			case 'e': // Prefix: "events/timeseries"
 				origElem := elem
-				if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
+				if l := len("events/timeseries"); len(elem) >= l && elem[0:l] == "events/timeseries" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
+					// Leaf node.
 					switch method {
 					case "GET":
-						r.name = GetUserNotificationsOperation
-						r.summary = "Get user notifications"
-						r.operationID = "GetUserNotifications"
-						r.pathPattern = "/api/v1/notifications"
+						r.name = GetEventsTimeseriesOperation
+						r.summary = "Get events timeseries"
+						r.operationID = "GetEventsTimeseries"
+						r.pathPattern = "/api/v1/events/timeseries"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+				elem = origElem
+			case 'i': // Prefix: "issues"
+				origElem := elem
+				if l := len("issues"); len(elem) >= l && elem[0:l] == "issues" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = ListIssuesOperation
+						r.summary = "Get all issues across all projects"
+						r.operationID = "ListIssues"
+						r.pathPattern = "/api/v1/issues"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -1990,9 +2984,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'r': // Prefix: "read-all"
+					case 'r': // Prefix: "recent"
 						origElem := elem
-						if l := len("read-all"); len(elem) >= l && elem[0:l] == "read-all" {
+						if l := len("recent"); len(elem) >= l && elem[0:l] == "recent" {
 							elem = elem[l:]
 						} else {
 							break
@@ -2001,9 +2995,100 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "PUT":
-								r.name = MarkAllNotificationsAsReadOperation
-								r.summary = "Mark all notifications as read"
+							case "GET":
+								r.name = GetRecentIssuesOperation
+								r.summary = "Get recent issues across all projects"
+								r.operationID = "GetRecentIssues"
+								r.pathPattern = "/api/v1/issues/recent"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 't': // Prefix: "timeseries"
+						origElem := elem
+						if l := len("timeseries"); len(elem) >= l && elem[0:l] == "timeseries" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetIssuesTimeseriesOperation
+								r.summary = "Get issues timeseries"
+								r.operationID = "GetIssuesTimeseries"
+								r.pathPattern = "/api/v1/issues/timeseries"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'n': // Prefix: "notifications"
+				origElem := elem
+				if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetUserNotificationsOperation
+						r.summary = "Get user notifications"
+						r.operationID = "GetUserNotifications"
+						r.pathPattern = "/api/v1/notifications"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "read-all"
+						origElem := elem
+						if l := len("read-all"); len(elem) >= l && elem[0:l] == "read-all" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "PUT":
+								r.name = MarkAllNotificationsAsReadOperation
+								r.summary = "Mark all notifications as read"
 								r.operationID = "MarkAllNotificationsAsRead"
 								r.pathPattern = "/api/v1/notifications/read-all"
 								r.args = args
@@ -2223,35 +3308,687 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'a': // Prefix: "analytics/"
+						case 'a': // Prefix: "a"
 							origElem := elem
-							if l := len("analytics/"); len(elem) >= l && elem[0:l] == "analytics/" {
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'r': // Prefix: "releases"
-								origElem := elem
-								if l := len("releases"); len(elem) >= l && elem[0:l] == "releases" {
-									elem = elem[l:]
-								} else {
-									break
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'n': // Prefix: "nalytics/"
+								origElem := elem
+								if l := len("nalytics/"); len(elem) >= l && elem[0:l] == "nalytics/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'r': // Prefix: "releases"
+									origElem := elem
+									if l := len("releases"); len(elem) >= l && elem[0:l] == "releases" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetProjectReleasesAnalyticsOperation
+											r.summary = "Get analytics summary for all releases in project"
+											r.operationID = "GetProjectReleasesAnalytics"
+											r.pathPattern = "/api/v1/projects/{project_id}/analytics/releases"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+										origElem := elem
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "compare"
+											origElem := elem
+											if l := len("compare"); len(elem) >= l && elem[0:l] == "compare" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = CompareProjectReleasesAnalyticsOperation
+													r.summary = "Compare two releases analytics"
+													r.operationID = "CompareProjectReleasesAnalytics"
+													r.pathPattern = "/api/v1/projects/{project_id}/analytics/releases/compare"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+										// Param: "version"
+										// Leaf parameter
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetProjectReleaseAnalyticsDetailsOperation
+												r.summary = "Get analytics details for a specific release"
+												r.operationID = "GetProjectReleaseAnalyticsDetails"
+												r.pathPattern = "/api/v1/projects/{project_id}/analytics/releases/{version}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 's': // Prefix: "segments"
+									origElem := elem
+									if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetProjectReleaseSegmentsOperation
+											r.summary = "Get user segments analytics for a release"
+											r.operationID = "GetProjectReleaseSegments"
+											r.pathPattern = "/api/v1/projects/{project_id}/analytics/segments"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 't': // Prefix: "traffic"
+									origElem := elem
+									if l := len("traffic"); len(elem) >= l && elem[0:l] == "traffic" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetProjectReleaseErrorsTimeseriesOperation
+											r.summary = "Get errors timeseries for a release"
+											r.operationID = "GetProjectReleaseErrorsTimeseries"
+											r.pathPattern = "/api/v1/projects/{project_id}/analytics/traffic"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 't': // Prefix: "ttachments/"
+								origElem := elem
+								if l := len("ttachments/"); len(elem) >= l && elem[0:l] == "ttachments/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "attachment_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/download"
+									origElem := elem
+									if l := len("/download"); len(elem) >= l && elem[0:l] == "/download" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = DownloadAttachmentOperation
+											r.summary = "Download attachment"
+											r.operationID = "DownloadAttachment"
+											r.pathPattern = "/api/v1/projects/{project_id}/attachments/{attachment_id}/download"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'd': // Prefix: "data-scrubbing"
+							origElem := elem
+							if l := len("data-scrubbing"); len(elem) >= l && elem[0:l] == "data-scrubbing" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetProjectDataScrubbingOperation
+									r.summary = "Get project data scrubbing"
+									r.operationID = "GetProjectDataScrubbing"
+									r.pathPattern = "/api/v1/projects/{project_id}/data-scrubbing"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = UpdateProjectDataScrubbingOperation
+									r.summary = "Update project data scrubbing"
+									r.operationID = "UpdateProjectDataScrubbing"
+									r.pathPattern = "/api/v1/projects/{project_id}/data-scrubbing"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'e': // Prefix: "e"
+							origElem := elem
+							if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'n': // Prefix: "nvironments"
+								origElem := elem
+								if l := len("nvironments"); len(elem) >= l && elem[0:l] == "nvironments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListProjectEnvironmentsOperation
+										r.summary = "List project environments"
+										r.operationID = "ListProjectEnvironments"
+										r.pathPattern = "/api/v1/projects/{project_id}/environments"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "name"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "PUT":
+											r.name = UpdateProjectEnvironmentOperation
+											r.summary = "Update project environment"
+											r.operationID = "UpdateProjectEnvironment"
+											r.pathPattern = "/api/v1/projects/{project_id}/environments/{name}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'v': // Prefix: "vents"
+								origElem := elem
+								if l := len("vents"); len(elem) >= l && elem[0:l] == "vents" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = SearchProjectEventsOperation
+										r.summary = "Search project events"
+										r.operationID = "SearchProjectEvents"
+										r.pathPattern = "/api/v1/projects/{project_id}/events"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "event_id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetProjectEventOperation
+											r.summary = "Get event"
+											r.operationID = "GetProjectEvent"
+											r.pathPattern = "/api/v1/projects/{project_id}/events/{event_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/attachments"
+										origElem := elem
+										if l := len("/attachments"); len(elem) >= l && elem[0:l] == "/attachments" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = ListEventAttachmentsOperation
+												r.summary = "List event attachments"
+												r.operationID = "ListEventAttachments"
+												r.pathPattern = "/api/v1/projects/{project_id}/events/{event_id}/attachments"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'g': // Prefix: "grouping-"
+							origElem := elem
+							if l := len("grouping-"); len(elem) >= l && elem[0:l] == "grouping-" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "config"
+								origElem := elem
+								if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetProjectGroupingConfigOperation
+										r.summary = "Get project grouping configuration"
+										r.operationID = "GetProjectGroupingConfig"
+										r.pathPattern = "/api/v1/projects/{project_id}/grouping-config"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = UpdateProjectGroupingConfigOperation
+										r.summary = "Switch project grouping strategy"
+										r.operationID = "UpdateProjectGroupingConfig"
+										r.pathPattern = "/api/v1/projects/{project_id}/grouping-config"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "rules"
+								origElem := elem
+								if l := len("rules"); len(elem) >= l && elem[0:l] == "rules" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListGroupingRulesOperation
+										r.summary = "List project grouping rules"
+										r.operationID = "ListGroupingRules"
+										r.pathPattern = "/api/v1/projects/{project_id}/grouping-rules"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateGroupingRuleOperation
+										r.summary = "Create a grouping rule"
+										r.operationID = "CreateGroupingRule"
+										r.pathPattern = "/api/v1/projects/{project_id}/grouping-rules"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'p': // Prefix: "preview"
+										origElem := elem
+										if l := len("preview"); len(elem) >= l && elem[0:l] == "preview" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = PreviewGroupingRuleOperation
+												r.summary = "Preview a grouping rule"
+												r.operationID = "PreviewGroupingRule"
+												r.pathPattern = "/api/v1/projects/{project_id}/grouping-rules/preview"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+									// Param: "rule_id"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteGroupingRuleOperation
+											r.summary = "Delete a grouping rule"
+											r.operationID = "DeleteGroupingRule"
+											r.pathPattern = "/api/v1/projects/{project_id}/grouping-rules/{rule_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PUT":
+											r.name = UpdateGroupingRuleOperation
+											r.summary = "Update a grouping rule"
+											r.operationID = "UpdateGroupingRule"
+											r.pathPattern = "/api/v1/projects/{project_id}/grouping-rules/{rule_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'i': // Prefix: "i"
+							origElem := elem
+							if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'n': // Prefix: "nbound-filters"
+								origElem := elem
+								if l := len("nbound-filters"); len(elem) >= l && elem[0:l] == "nbound-filters" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetProjectInboundFiltersOperation
+										r.summary = "Get project inbound filters"
+										r.operationID = "GetProjectInboundFilters"
+										r.pathPattern = "/api/v1/projects/{project_id}/inbound-filters"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = UpdateProjectInboundFiltersOperation
+										r.summary = "Update project inbound filters"
+										r.operationID = "UpdateProjectInboundFilters"
+										r.pathPattern = "/api/v1/projects/{project_id}/inbound-filters"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 's': // Prefix: "ssues/"
+								origElem := elem
+								if l := len("ssues/"); len(elem) >= l && elem[0:l] == "ssues/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "bulk"
+									origElem := elem
+									if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = BulkUpdateIssuesOperation
+											r.summary = "Change issues in bulk"
+											r.operationID = "BulkUpdateIssues"
+											r.pathPattern = "/api/v1/projects/{project_id}/issues/bulk"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'm': // Prefix: "merge"
+									origElem := elem
+									if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = MergeIssuesOperation
+											r.summary = "Merge issues"
+											r.operationID = "MergeIssues"
+											r.pathPattern = "/api/v1/projects/{project_id}/issues/merge"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "issue_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
 								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
 
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										r.name = GetProjectReleasesAnalyticsOperation
-										r.summary = "Get analytics summary for all releases in project"
-										r.operationID = "GetProjectReleasesAnalytics"
-										r.pathPattern = "/api/v1/projects/{project_id}/analytics/releases"
+										r.name = GetIssueOperation
+										r.summary = "Get details of a specific issue"
+										r.operationID = "GetIssue"
+										r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}"
 										r.args = args
-										r.count = 1
+										r.count = 2
 										return r, true
 									default:
 										return
@@ -2270,136 +4007,465 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										break
 									}
 									switch elem[0] {
-									case 'c': // Prefix: "compare"
+									case 'a': // Prefix: "a"
 										origElem := elem
-										if l := len("compare"); len(elem) >= l && elem[0:l] == "compare" {
+										if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "ctivity"
+											origElem := elem
+											if l := len("ctivity"); len(elem) >= l && elem[0:l] == "ctivity" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = ListProjectIssueActivityOperation
+													r.summary = "List issue activity"
+													r.operationID = "ListProjectIssueActivity"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/activity"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										case 's': // Prefix: "ssignee"
+											origElem := elem
+											if l := len("ssignee"); len(elem) >= l && elem[0:l] == "ssignee" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "PUT":
+													r.name = AssignIssueOperation
+													r.summary = "Assign issue to a user or a team"
+													r.operationID = "AssignIssue"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/assignee"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 'c': // Prefix: "c"
+										origElem := elem
+										if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'h': // Prefix: "hange-status"
+											origElem := elem
+											if l := len("hange-status"); len(elem) >= l && elem[0:l] == "hange-status" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "PUT":
+													r.name = ChangeIssueStatusOperation
+													r.summary = "Change issue status"
+													r.operationID = "changeIssueStatus"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/change-status"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										case 'o': // Prefix: "omments"
+											origElem := elem
+											if l := len("omments"); len(elem) >= l && elem[0:l] == "omments" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = ListProjectIssueCommentsOperation
+													r.summary = "List issue comments"
+													r.operationID = "ListProjectIssueComments"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/comments"
+													r.args = args
+													r.count = 2
+													return r, true
+												case "POST":
+													r.name = CreateProjectIssueCommentOperation
+													r.summary = "Add issue comment"
+													r.operationID = "CreateProjectIssueComment"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/comments"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "comment_id"
+												// Leaf parameter
+												args[2] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "DELETE":
+														r.name = DeleteProjectIssueCommentOperation
+														r.summary = "Delete issue comment"
+														r.operationID = "DeleteProjectIssueComment"
+														r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}"
+														r.args = args
+														r.count = 3
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 'e': // Prefix: "events"
+										origElem := elem
+										if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
-											// Leaf node.
 											switch method {
-											case "POST":
-												r.name = CompareProjectReleasesAnalyticsOperation
-												r.summary = "Compare two releases analytics"
-												r.operationID = "CompareProjectReleasesAnalytics"
-												r.pathPattern = "/api/v1/projects/{project_id}/analytics/releases/compare"
+											case "GET":
+												r.name = ListProjectIssueEventsOperation
+												r.summary = "List issue events"
+												r.operationID = "ListProjectIssueEvents"
+												r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/events"
 												r.args = args
-												r.count = 1
+												r.count = 2
 												return r, true
 											default:
 												return
 											}
 										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'l': // Prefix: "latest"
+												origElem := elem
+												if l := len("latest"); len(elem) >= l && elem[0:l] == "latest" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetProjectIssueLatestEventOperation
+														r.summary = "Get the latest issue event"
+														r.operationID = "GetProjectIssueLatestEvent"
+														r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/events/latest"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											case 'o': // Prefix: "oldest"
+												origElem := elem
+												if l := len("oldest"); len(elem) >= l && elem[0:l] == "oldest" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetProjectIssueOldestEventOperation
+														r.summary = "Get the oldest issue event"
+														r.operationID = "GetProjectIssueOldestEvent"
+														r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/events/oldest"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											case 't': // Prefix: "timeseries"
+												origElem := elem
+												if l := len("timeseries"); len(elem) >= l && elem[0:l] == "timeseries" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetProjectIssueEventsTimeseriesOperation
+														r.summary = "Get timeseries of events for a specific issue inside a project"
+														r.operationID = "GetProjectIssueEventsTimeseries"
+														r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
 
 										elem = origElem
-									}
-									// Param: "version"
-									// Leaf parameter
-									args[1] = elem
-									elem = ""
+									case 't': // Prefix: "t"
+										origElem := elem
+										if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetProjectReleaseAnalyticsDetailsOperation
-											r.summary = "Get analytics details for a specific release"
-											r.operationID = "GetProjectReleaseAnalyticsDetails"
-											r.pathPattern = "/api/v1/projects/{project_id}/analytics/releases/{version}"
-											r.args = args
-											r.count = 2
-											return r, true
-										default:
-											return
+										if len(elem) == 0 {
+											break
 										}
-									}
+										switch elem[0] {
+										case 'a': // Prefix: "ags"
+											origElem := elem
+											if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-									elem = origElem
-								}
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = GetProjectIssueTagsOperation
+													r.summary = "Get tags of issue events"
+													r.operationID = "GetProjectIssueTags"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/tags"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-								elem = origElem
-							case 's': // Prefix: "segments"
-								origElem := elem
-								if l := len("segments"); len(elem) >= l && elem[0:l] == "segments" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												// Param: "key"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[2] = elem[:idx]
+												elem = elem[idx:]
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetProjectReleaseSegmentsOperation
-										r.summary = "Get user segments analytics for a release"
-										r.operationID = "GetProjectReleaseSegments"
-										r.pathPattern = "/api/v1/projects/{project_id}/analytics/segments"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/events"
+													origElem := elem
+													if l := len("/events"); len(elem) >= l && elem[0:l] == "/events" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "GET":
+															r.name = ListProjectIssueTagEventsOperation
+															r.summary = "List issue events with a tag value"
+															r.operationID = "ListProjectIssueTagEvents"
+															r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events"
+															r.args = args
+															r.count = 3
+															return r, true
+														default:
+															return
+														}
+													}
+
+													elem = origElem
+												}
 
-								elem = origElem
-							case 't': // Prefix: "traffic"
-								origElem := elem
-								if l := len("traffic"); len(elem) >= l && elem[0:l] == "traffic" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												elem = origElem
+											}
+
+											elem = origElem
+										case 'i': // Prefix: "imeseries"
+											origElem := elem
+											if l := len("imeseries"); len(elem) >= l && elem[0:l] == "imeseries" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetProjectIssueTimeseriesOperation
+													r.summary = "Get timeseries for a specific issue inside a project"
+													r.operationID = "GetProjectIssueTimeseries"
+													r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/timeseries"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 'u': // Prefix: "unmerge"
+										origElem := elem
+										if l := len("unmerge"); len(elem) >= l && elem[0:l] == "unmerge" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = UnmergeIssueOperation
+												r.summary = "Unmerge issue"
+												r.operationID = "UnmergeIssue"
+												r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/unmerge"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetProjectReleaseErrorsTimeseriesOperation
-										r.summary = "Get errors timeseries for a release"
-										r.operationID = "GetProjectReleaseErrorsTimeseries"
-										r.pathPattern = "/api/v1/projects/{project_id}/analytics/traffic"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
+										elem = origElem
 									}
+
+									elem = origElem
 								}
 
 								elem = origElem
 							}
 
 							elem = origElem
-						case 'i': // Prefix: "issues/"
+						case 'k': // Prefix: "keys"
 							origElem := elem
-							if l := len("issues/"); len(elem) >= l && elem[0:l] == "issues/" {
+							if l := len("keys"); len(elem) >= l && elem[0:l] == "keys" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "issue_id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[1] = elem[:idx]
-							elem = elem[idx:]
-
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = GetIssueOperation
-									r.summary = "Get details of a specific issue"
-									r.operationID = "GetIssue"
-									r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}"
+									r.name = ListProjectKeysOperation
+									r.summary = "List project client keys"
+									r.operationID = "ListProjectKeys"
+									r.pathPattern = "/api/v1/projects/{project_id}/keys"
 									r.args = args
-									r.count = 2
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = CreateProjectKeyOperation
+									r.summary = "Create a project client key"
+									r.operationID = "CreateProjectKey"
+									r.pathPattern = "/api/v1/projects/{project_id}/keys"
+									r.args = args
+									r.count = 1
 									return r, true
 								default:
 									return
@@ -2414,13 +4480,41 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 
+								// Param: "key_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
 								if len(elem) == 0 {
-									break
+									switch method {
+									case "DELETE":
+										r.name = RevokeProjectKeyOperation
+										r.summary = "Revoke a project client key"
+										r.operationID = "RevokeProjectKey"
+										r.pathPattern = "/api/v1/projects/{project_id}/keys/{key_id}"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "PUT":
+										r.name = UpdateProjectKeyOperation
+										r.summary = "Update a project client key"
+										r.operationID = "UpdateProjectKey"
+										r.pathPattern = "/api/v1/projects/{project_id}/keys/{key_id}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "change-status"
+								case '/': // Prefix: "/rotate"
 									origElem := elem
-									if l := len("change-status"); len(elem) >= l && elem[0:l] == "change-status" {
+									if l := len("/rotate"); len(elem) >= l && elem[0:l] == "/rotate" {
 										elem = elem[l:]
 									} else {
 										break
@@ -2429,11 +4523,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "PUT":
-											r.name = ChangeIssueStatusOperation
-											r.summary = "Change issue status"
-											r.operationID = "changeIssueStatus"
-											r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/change-status"
+										case "POST":
+											r.name = RotateProjectKeyOperation
+											r.summary = "Rotate a project client key"
+											r.operationID = "RotateProjectKey"
+											r.pathPattern = "/api/v1/projects/{project_id}/keys/{key_id}/rotate"
 											r.args = args
 											r.count = 2
 											return r, true
@@ -2443,56 +4537,70 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 
 									elem = origElem
-								case 'e': // Prefix: "events/timeseries"
-									origElem := elem
-									if l := len("events/timeseries"); len(elem) >= l && elem[0:l] == "events/timeseries" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+								}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetProjectIssueEventsTimeseriesOperation
-											r.summary = "Get timeseries of events for a specific issue inside a project"
-											r.operationID = "GetProjectIssueEventsTimeseries"
-											r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries"
-											r.args = args
-											r.count = 2
-											return r, true
-										default:
-											return
-										}
-									}
+								elem = origElem
+							}
 
-									elem = origElem
-								case 't': // Prefix: "timeseries"
-									origElem := elem
-									if l := len("timeseries"); len(elem) >= l && elem[0:l] == "timeseries" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							elem = origElem
+						case 'm': // Prefix: "monitors"
+							origElem := elem
+							if l := len("monitors"); len(elem) >= l && elem[0:l] == "monitors" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetProjectIssueTimeseriesOperation
-											r.summary = "Get timeseries for a specific issue inside a project"
-											r.operationID = "GetProjectIssueTimeseries"
-											r.pathPattern = "/api/v1/projects/{project_id}/issues/{issue_id}/timeseries"
-											r.args = args
-											r.count = 2
-											return r, true
-										default:
-											return
-										}
-									}
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListMonitorsOperation
+									r.summary = "List project cron monitors"
+									r.operationID = "ListMonitors"
+									r.pathPattern = "/api/v1/projects/{project_id}/monitors"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-									elem = origElem
+								// Param: "monitor_id"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = DeleteMonitorOperation
+										r.summary = "Delete cron monitor"
+										r.operationID = "DeleteMonitor"
+										r.pathPattern = "/api/v1/projects/{project_id}/monitors/{monitor_id}"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "GET":
+										r.name = GetMonitorOperation
+										r.summary = "Get cron monitor with recent check-ins"
+										r.operationID = "GetMonitor"
+										r.pathPattern = "/api/v1/projects/{project_id}/monitors/{monitor_id}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
 								}
 
 								elem = origElem
@@ -2704,9 +4812,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case 's': // Prefix: "stats"
+						case 'o': // Prefix: "outcomes"
 							origElem := elem
-							if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+							if l := len("outcomes"); len(elem) >= l && elem[0:l] == "outcomes" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2716,10 +4824,43 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetProjectStatsOperation
-									r.summary = "Get project statistics"
-									r.operationID = "GetProjectStats"
-									r.pathPattern = "/api/v1/projects/{project_id}/stats"
+									r.name = GetProjectOutcomesOperation
+									r.summary = "Get outcomes of the data received by a project"
+									r.operationID = "GetProjectOutcomes"
+									r.pathPattern = "/api/v1/projects/{project_id}/outcomes"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "rate-limits"
+							origElem := elem
+							if l := len("rate-limits"); len(elem) >= l && elem[0:l] == "rate-limits" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetProjectRateLimitsOperation
+									r.summary = "Get project rate limits"
+									r.operationID = "GetProjectRateLimits"
+									r.pathPattern = "/api/v1/projects/{project_id}/rate-limits"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = UpdateProjectRateLimitsOperation
+									r.summary = "Update project rate limits"
+									r.operationID = "UpdateProjectRateLimits"
+									r.pathPattern = "/api/v1/projects/{project_id}/rate-limits"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -2729,9 +4870,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							elem = origElem
-						case 't': // Prefix: "team"
+						case 's': // Prefix: "stats"
 							origElem := elem
-							if l := len("team"); len(elem) >= l && elem[0:l] == "team" {
+							if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2741,10 +4882,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetProjectTeamOperation
-									r.summary = "Get project team"
-									r.operationID = "GetProjectTeam"
-									r.pathPattern = "/api/v1/projects/{project_id}/team"
+									r.name = GetProjectStatsOperation
+									r.summary = "Get project statistics"
+									r.operationID = "GetProjectStats"
+									r.pathPattern = "/api/v1/projects/{project_id}/stats"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -2753,6 +4894,116 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
+							elem = origElem
+						case 't': // Prefix: "t"
+							origElem := elem
+							if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "eam"
+								origElem := elem
+								if l := len("eam"); len(elem) >= l && elem[0:l] == "eam" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetProjectTeamOperation
+										r.summary = "Get project team"
+										r.operationID = "GetProjectTeam"
+										r.pathPattern = "/api/v1/projects/{project_id}/team"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "ra"
+								origElem := elem
+								if l := len("ra"); len(elem) >= l && elem[0:l] == "ra" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "ces/"
+									origElem := elem
+									if l := len("ces/"); len(elem) >= l && elem[0:l] == "ces/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "trace_id"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetTraceOperation
+											r.summary = "Get trace"
+											r.operationID = "GetTrace"
+											r.pathPattern = "/api/v1/projects/{project_id}/traces/{trace_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'n': // Prefix: "nsactions"
+									origElem := elem
+									if l := len("nsactions"); len(elem) >= l && elem[0:l] == "nsactions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = ListProjectTransactionsOperation
+											r.summary = "List project transactions"
+											r.operationID = "ListProjectTransactions"
+											r.pathPattern = "/api/v1/projects/{project_id}/transactions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							}
+
 							elem = origElem
 						}
 
@@ -4,6 +4,7 @@ package api
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-faster/errors"
@@ -141,6 +142,106 @@ type ArchiveProjectNoContent struct{}
 
 func (*ArchiveProjectNoContent) archiveProjectRes() {}
 
+// AssignIssueNoContent is response for AssignIssue operation.
+type AssignIssueNoContent struct{}
+
+func (*AssignIssueNoContent) assignIssueRes() {}
+
+// Ref: #/components/schemas/Attachment
+type Attachment struct {
+	ID        uint   `json:"id"`
+	ProjectID uint   `json:"project_id"`
+	EventID   string `json:"event_id"`
+	// File name sent by the SDK.
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	// Attachment type (event.attachment, event.minidump, event.view_hierarchy).
+	Type string `json:"type"`
+	// Size in bytes.
+	Size      uint      `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Attachment) GetID() uint {
+	return s.ID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *Attachment) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetEventID returns the value of EventID.
+func (s *Attachment) GetEventID() string {
+	return s.EventID
+}
+
+// GetName returns the value of Name.
+func (s *Attachment) GetName() string {
+	return s.Name
+}
+
+// GetContentType returns the value of ContentType.
+func (s *Attachment) GetContentType() string {
+	return s.ContentType
+}
+
+// GetType returns the value of Type.
+func (s *Attachment) GetType() string {
+	return s.Type
+}
+
+// GetSize returns the value of Size.
+func (s *Attachment) GetSize() uint {
+	return s.Size
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Attachment) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Attachment) SetID(val uint) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *Attachment) SetProjectID(val uint) {
+	s.ProjectID = val
+}
+
+// SetEventID sets the value of EventID.
+func (s *Attachment) SetEventID(val string) {
+	s.EventID = val
+}
+
+// SetName sets the value of Name.
+func (s *Attachment) SetName(val string) {
+	s.Name = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *Attachment) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetType sets the value of Type.
+func (s *Attachment) SetType(val string) {
+	s.Type = val
+}
+
+// SetSize sets the value of Size.
+func (s *Attachment) SetSize(val uint) {
+	s.Size = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Attachment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
 type BearerAuth struct {
 	Token string
 }
@@ -155,13 +256,80 @@ func (s *BearerAuth) SetToken(val string) {
 	s.Token = val
 }
 
+// Items of a data category accepted per minute, unlimited when not set.
+// Ref: #/components/schemas/CategoryRateLimits
+type CategoryRateLimits struct {
+	Error       OptUint `json:"error"`
+	Transaction OptUint `json:"transaction"`
+	Attachment  OptUint `json:"attachment"`
+	Session     OptUint `json:"session"`
+	Monitor     OptUint `json:"monitor"`
+}
+
+// GetError returns the value of Error.
+func (s *CategoryRateLimits) GetError() OptUint {
+	return s.Error
+}
+
+// GetTransaction returns the value of Transaction.
+func (s *CategoryRateLimits) GetTransaction() OptUint {
+	return s.Transaction
+}
+
+// GetAttachment returns the value of Attachment.
+func (s *CategoryRateLimits) GetAttachment() OptUint {
+	return s.Attachment
+}
+
+// GetSession returns the value of Session.
+func (s *CategoryRateLimits) GetSession() OptUint {
+	return s.Session
+}
+
+// GetMonitor returns the value of Monitor.
+func (s *CategoryRateLimits) GetMonitor() OptUint {
+	return s.Monitor
+}
+
+// SetError sets the value of Error.
+func (s *CategoryRateLimits) SetError(val OptUint) {
+	s.Error = val
+}
+
+// SetTransaction sets the value of Transaction.
+func (s *CategoryRateLimits) SetTransaction(val OptUint) {
+	s.Transaction = val
+}
+
+// SetAttachment sets the value of Attachment.
+func (s *CategoryRateLimits) SetAttachment(val OptUint) {
+	s.Attachment = val
+}
+
+// SetSession sets the value of Session.
+func (s *CategoryRateLimits) SetSession(val OptUint) {
+	s.Session = val
+}
+
+// SetMonitor sets the value of Monitor.
+func (s *CategoryRateLimits) SetMonitor(val OptUint) {
+	s.Monitor = val
+}
+
 // ChangeIssueStatusNoContent is response for ChangeIssueStatus operation.
 type ChangeIssueStatusNoContent struct{}
 
 func (*ChangeIssueStatusNoContent) changeIssueStatusRes() {}
 
 type ChangeIssueStatusReq struct {
-	Status IssueStatus `json:"status"`
+	Status           IssueStatus              `json:"status"`
+	IgnoreConditions OptIssueIgnoreConditions `json:"ignore_conditions"`
+	// Version of the release fixing the issue, events of older releases don't reactivate it.
+	// Only allowed with the resolved status.
+	ResolvedInRelease OptString `json:"resolved_in_release"`
+	// Resolve in the release after the latest one, events of the current and older releases
+	// don't reactivate the issue. Only allowed with the resolved status.
+	ResolvedInNextRelease OptBool `json:"resolved_in_next_release"`
 }
 
 // GetStatus returns the value of Status.
@@ -169,11 +337,41 @@ func (s *ChangeIssueStatusReq) GetStatus() IssueStatus {
 	return s.Status
 }
 
+// GetIgnoreConditions returns the value of IgnoreConditions.
+func (s *ChangeIssueStatusReq) GetIgnoreConditions() OptIssueIgnoreConditions {
+	return s.IgnoreConditions
+}
+
+// GetResolvedInRelease returns the value of ResolvedInRelease.
+func (s *ChangeIssueStatusReq) GetResolvedInRelease() OptString {
+	return s.ResolvedInRelease
+}
+
+// GetResolvedInNextRelease returns the value of ResolvedInNextRelease.
+func (s *ChangeIssueStatusReq) GetResolvedInNextRelease() OptBool {
+	return s.ResolvedInNextRelease
+}
+
 // SetStatus sets the value of Status.
 func (s *ChangeIssueStatusReq) SetStatus(val IssueStatus) {
 	s.Status = val
 }
 
+// SetIgnoreConditions sets the value of IgnoreConditions.
+func (s *ChangeIssueStatusReq) SetIgnoreConditions(val OptIssueIgnoreConditions) {
+	s.IgnoreConditions = val
+}
+
+// SetResolvedInRelease sets the value of ResolvedInRelease.
+func (s *ChangeIssueStatusReq) SetResolvedInRelease(val OptString) {
+	s.ResolvedInRelease = val
+}
+
+// SetResolvedInNextRelease sets the value of ResolvedInNextRelease.
+func (s *ChangeIssueStatusReq) SetResolvedInNextRelease(val OptBool) {
+	s.ResolvedInNextRelease = val
+}
+
 // ChangeTeamMemberRoleOK is response for ChangeTeamMemberRole operation.
 type ChangeTeamMemberRoleOK struct{}
 
@@ -392,6 +590,33 @@ type Confirm2FANoContent struct{}
 
 func (*Confirm2FANoContent) confirm2FARes() {}
 
+// Ref: #/components/schemas/CreateIssueCommentRequest
+type CreateIssueCommentRequest struct {
+	Body string `json:"body"`
+	// Comment to reply to.
+	ParentID OptUint `json:"parent_id"`
+}
+
+// GetBody returns the value of Body.
+func (s *CreateIssueCommentRequest) GetBody() string {
+	return s.Body
+}
+
+// GetParentID returns the value of ParentID.
+func (s *CreateIssueCommentRequest) GetParentID() OptUint {
+	return s.ParentID
+}
+
+// SetBody sets the value of Body.
+func (s *CreateIssueCommentRequest) SetBody(val string) {
+	s.Body = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *CreateIssueCommentRequest) SetParentID(val OptUint) {
+	s.ParentID = val
+}
+
 // Ref: #/components/schemas/CreateNotificationRuleRequest
 type CreateNotificationRuleRequest struct {
 	// Level of event to trigger notification (error, warning, info, etc.).
@@ -402,6 +627,8 @@ type CreateNotificationRuleRequest struct {
 	IsNewError OptNilBool `json:"is_new_error"`
 	// Trigger only for regressions (resolved -> unresolved).
 	IsRegression OptNilBool `json:"is_regression"`
+	// Environment of events to trigger notification, all environments when not set.
+	Environment OptNilString `json:"environment"`
 }
 
 // GetEventLevel returns the value of EventLevel.
@@ -424,6 +651,11 @@ func (s *CreateNotificationRuleRequest) GetIsRegression() OptNilBool {
 	return s.IsRegression
 }
 
+// GetEnvironment returns the value of Environment.
+func (s *CreateNotificationRuleRequest) GetEnvironment() OptNilString {
+	return s.Environment
+}
+
 // SetEventLevel sets the value of EventLevel.
 func (s *CreateNotificationRuleRequest) SetEventLevel(val OptNilString) {
 	s.EventLevel = val
@@ -444,12 +676,18 @@ func (s *CreateNotificationRuleRequest) SetIsRegression(val OptNilBool) {
 	s.IsRegression = val
 }
 
+// SetEnvironment sets the value of Environment.
+func (s *CreateNotificationRuleRequest) SetEnvironment(val OptNilString) {
+	s.Environment = val
+}
+
 // Ref: #/components/schemas/CreateNotificationSettingRequest
 type CreateNotificationSettingRequest struct {
 	Type NotificationChannelType `json:"type"`
 	// Configuration for the notification channel (JSONB in database).
-	Config  string  `json:"config"`
-	Enabled OptBool `json:"enabled"`
+	Config          string  `json:"config"`
+	Enabled         OptBool `json:"enabled"`
+	RouteToAssignee OptBool `json:"route_to_assignee"`
 }
 
 // GetType returns the value of Type.
@@ -467,6 +705,11 @@ func (s *CreateNotificationSettingRequest) GetEnabled() OptBool {
 	return s.Enabled
 }
 
+// GetRouteToAssignee returns the value of RouteToAssignee.
+func (s *CreateNotificationSettingRequest) GetRouteToAssignee() OptBool {
+	return s.RouteToAssignee
+}
+
 // SetType sets the value of Type.
 func (s *CreateNotificationSettingRequest) SetType(val NotificationChannelType) {
 	s.Type = val
@@ -482,6 +725,11 @@ func (s *CreateNotificationSettingRequest) SetEnabled(val OptBool) {
 	s.Enabled = val
 }
 
+// SetRouteToAssignee sets the value of RouteToAssignee.
+func (s *CreateNotificationSettingRequest) SetRouteToAssignee(val OptBool) {
+	s.RouteToAssignee = val
+}
+
 // Ref: #/components/schemas/CreateTeamRequest
 type CreateTeamRequest struct {
 	Name string `json:"name"`
@@ -579,6 +827,130 @@ func (s *CreateUserResponse) SetUser(val User) {
 
 func (*CreateUserResponse) createUserRes() {}
 
+// Ref: #/components/schemas/DataScrubbing
+type DataScrubbing struct {
+	ProjectID uint `json:"project_id"`
+	// Removes values of well-known sensitive keys and credit card numbers.
+	DefaultScrubbers bool `json:"default_scrubbers"`
+	// Additional field names, values of keys containing them are removed.
+	SensitiveFields []string `json:"sensitive_fields"`
+	// Regular expressions, matching parts of string values are removed.
+	Patterns []string `json:"patterns"`
+	// Zeroes the last octet of IPv4 and the last 80 bits of IPv6 client addresses.
+	AnonymizeIP bool `json:"anonymize_ip"`
+	// Never stores the request cookies.
+	DropRequestCookies bool `json:"drop_request_cookies"`
+	// Never stores the request body.
+	DropRequestData bool `json:"drop_request_data"`
+	// Parts of the keys removed by the default scrubbers.
+	DefaultSensitiveFields []string `json:"default_sensitive_fields"`
+	// Not set until the data scrubbing is saved.
+	UpdatedAt OptNilDateTime `json:"updated_at"`
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *DataScrubbing) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetDefaultScrubbers returns the value of DefaultScrubbers.
+func (s *DataScrubbing) GetDefaultScrubbers() bool {
+	return s.DefaultScrubbers
+}
+
+// GetSensitiveFields returns the value of SensitiveFields.
+func (s *DataScrubbing) GetSensitiveFields() []string {
+	return s.SensitiveFields
+}
+
+// GetPatterns returns the value of Patterns.
+func (s *DataScrubbing) GetPatterns() []string {
+	return s.Patterns
+}
+
+// GetAnonymizeIP returns the value of AnonymizeIP.
+func (s *DataScrubbing) GetAnonymizeIP() bool {
+	return s.AnonymizeIP
+}
+
+// GetDropRequestCookies returns the value of DropRequestCookies.
+func (s *DataScrubbing) GetDropRequestCookies() bool {
+	return s.DropRequestCookies
+}
+
+// GetDropRequestData returns the value of DropRequestData.
+func (s *DataScrubbing) GetDropRequestData() bool {
+	return s.DropRequestData
+}
+
+// GetDefaultSensitiveFields returns the value of DefaultSensitiveFields.
+func (s *DataScrubbing) GetDefaultSensitiveFields() []string {
+	return s.DefaultSensitiveFields
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DataScrubbing) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *DataScrubbing) SetProjectID(val uint) {
+	s.ProjectID = val
+}
+
+// SetDefaultScrubbers sets the value of DefaultScrubbers.
+func (s *DataScrubbing) SetDefaultScrubbers(val bool) {
+	s.DefaultScrubbers = val
+}
+
+// SetSensitiveFields sets the value of SensitiveFields.
+func (s *DataScrubbing) SetSensitiveFields(val []string) {
+	s.SensitiveFields = val
+}
+
+// SetPatterns sets the value of Patterns.
+func (s *DataScrubbing) SetPatterns(val []string) {
+	s.Patterns = val
+}
+
+// SetAnonymizeIP sets the value of AnonymizeIP.
+func (s *DataScrubbing) SetAnonymizeIP(val bool) {
+	s.AnonymizeIP = val
+}
+
+// SetDropRequestCookies sets the value of DropRequestCookies.
+func (s *DataScrubbing) SetDropRequestCookies(val bool) {
+	s.DropRequestCookies = val
+}
+
+// SetDropRequestData sets the value of DropRequestData.
+func (s *DataScrubbing) SetDropRequestData(val bool) {
+	s.DropRequestData = val
+}
+
+// SetDefaultSensitiveFields sets the value of DefaultSensitiveFields.
+func (s *DataScrubbing) SetDefaultSensitiveFields(val []string) {
+	s.DefaultSensitiveFields = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DataScrubbing) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*DataScrubbing) getProjectDataScrubbingRes()    {}
+func (*DataScrubbing) updateProjectDataScrubbingRes() {}
+
+// DeleteGroupingRuleNoContent is response for DeleteGroupingRule operation.
+type DeleteGroupingRuleNoContent struct{}
+
+func (*DeleteGroupingRuleNoContent) deleteGroupingRuleRes() {}
+
+// DeleteMonitorNoContent is response for DeleteMonitor operation.
+type DeleteMonitorNoContent struct{}
+
+func (*DeleteMonitorNoContent) deleteMonitorRes() {}
+
 // DeleteNotificationRuleNoContent is response for DeleteNotificationRule operation.
 type DeleteNotificationRuleNoContent struct{}
 
@@ -589,6 +961,11 @@ type DeleteNotificationSettingNoContent struct{}
 
 func (*DeleteNotificationSettingNoContent) deleteNotificationSettingRes() {}
 
+// DeleteProjectIssueCommentNoContent is response for DeleteProjectIssueComment operation.
+type DeleteProjectIssueCommentNoContent struct{}
+
+func (*DeleteProjectIssueCommentNoContent) deleteProjectIssueCommentRes() {}
+
 // DeleteTeamNoContent is response for DeleteTeam operation.
 type DeleteTeamNoContent struct{}
 
@@ -604,6 +981,97 @@ type Disable2FANoContent struct{}
 
 func (*Disable2FANoContent) disable2FARes() {}
 
+type DownloadAttachmentOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadAttachmentOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// DownloadAttachmentOKHeaders wraps DownloadAttachmentOK with response headers.
+type DownloadAttachmentOKHeaders struct {
+	ContentDisposition string
+	Response           DownloadAttachmentOK
+}
+
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *DownloadAttachmentOKHeaders) GetContentDisposition() string {
+	return s.ContentDisposition
+}
+
+// GetResponse returns the value of Response.
+func (s *DownloadAttachmentOKHeaders) GetResponse() DownloadAttachmentOK {
+	return s.Response
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *DownloadAttachmentOKHeaders) SetContentDisposition(val string) {
+	s.ContentDisposition = val
+}
+
+// SetResponse sets the value of Response.
+func (s *DownloadAttachmentOKHeaders) SetResponse(val DownloadAttachmentOK) {
+	s.Response = val
+}
+
+func (*DownloadAttachmentOKHeaders) downloadAttachmentRes() {}
+
+// Ref: #/components/schemas/Environment
+type Environment struct {
+	Name string `json:"name"`
+	// Hidden environments are still ingested but hidden from the environment selectors.
+	Hidden    bool      `json:"hidden"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// GetName returns the value of Name.
+func (s *Environment) GetName() string {
+	return s.Name
+}
+
+// GetHidden returns the value of Hidden.
+func (s *Environment) GetHidden() bool {
+	return s.Hidden
+}
+
+// GetFirstSeen returns the value of FirstSeen.
+func (s *Environment) GetFirstSeen() time.Time {
+	return s.FirstSeen
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *Environment) GetLastSeen() time.Time {
+	return s.LastSeen
+}
+
+// SetName sets the value of Name.
+func (s *Environment) SetName(val string) {
+	s.Name = val
+}
+
+// SetHidden sets the value of Hidden.
+func (s *Environment) SetHidden(val bool) {
+	s.Hidden = val
+}
+
+// SetFirstSeen sets the value of FirstSeen.
+func (s *Environment) SetFirstSeen(val time.Time) {
+	s.FirstSeen = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *Environment) SetLastSeen(val time.Time) {
+	s.LastSeen = val
+}
+
 // Ref: #/components/schemas/Error
 type Error struct {
 	Error ErrorError `json:"error"`
@@ -688,28 +1156,49 @@ func (s *ErrorBadRequest) SetError(val ErrorBadRequestError) {
 	s.Error = val
 }
 
-func (*ErrorBadRequest) addProjectRes()                {}
-func (*ErrorBadRequest) addTeamMemberRes()             {}
-func (*ErrorBadRequest) changeTeamMemberRoleRes()      {}
-func (*ErrorBadRequest) confirm2FARes()                {}
-func (*ErrorBadRequest) createNotificationRuleRes()    {}
-func (*ErrorBadRequest) createNotificationSettingRes() {}
-func (*ErrorBadRequest) createTeamRes()                {}
-func (*ErrorBadRequest) createUserRes()                {}
-func (*ErrorBadRequest) deleteTeamRes()                {}
-func (*ErrorBadRequest) deleteUserRes()                {}
-func (*ErrorBadRequest) disable2FARes()                {}
-func (*ErrorBadRequest) forgotPasswordRes()            {}
-func (*ErrorBadRequest) reset2FARes()                  {}
-func (*ErrorBadRequest) resetPasswordRes()             {}
-func (*ErrorBadRequest) send2FACodeRes()               {}
-func (*ErrorBadRequest) setSuperuserStatusRes()        {}
-func (*ErrorBadRequest) setUserActiveStatusRes()       {}
-func (*ErrorBadRequest) updateNotificationRuleRes()    {}
-func (*ErrorBadRequest) updateNotificationSettingRes() {}
-func (*ErrorBadRequest) updateProjectRes()             {}
-func (*ErrorBadRequest) userChangeMyPasswordRes()      {}
-func (*ErrorBadRequest) verify2FARes()                 {}
+func (*ErrorBadRequest) addProjectRes()                  {}
+func (*ErrorBadRequest) addTeamMemberRes()               {}
+func (*ErrorBadRequest) assignIssueRes()                 {}
+func (*ErrorBadRequest) bulkUpdateIssuesRes()            {}
+func (*ErrorBadRequest) changeIssueStatusRes()           {}
+func (*ErrorBadRequest) changeTeamMemberRoleRes()        {}
+func (*ErrorBadRequest) confirm2FARes()                  {}
+func (*ErrorBadRequest) createGroupingRuleRes()          {}
+func (*ErrorBadRequest) createNotificationRuleRes()      {}
+func (*ErrorBadRequest) createNotificationSettingRes()   {}
+func (*ErrorBadRequest) createProjectIssueCommentRes()   {}
+func (*ErrorBadRequest) createProjectKeyRes()            {}
+func (*ErrorBadRequest) createTeamRes()                  {}
+func (*ErrorBadRequest) createUserRes()                  {}
+func (*ErrorBadRequest) deleteTeamRes()                  {}
+func (*ErrorBadRequest) deleteUserRes()                  {}
+func (*ErrorBadRequest) disable2FARes()                  {}
+func (*ErrorBadRequest) forgotPasswordRes()              {}
+func (*ErrorBadRequest) getProjectOutcomesRes()          {}
+func (*ErrorBadRequest) listIssuesRes()                  {}
+func (*ErrorBadRequest) listProjectIssueEventsRes()      {}
+func (*ErrorBadRequest) listProjectIssueTagEventsRes()   {}
+func (*ErrorBadRequest) mergeIssuesRes()                 {}
+func (*ErrorBadRequest) previewGroupingRuleRes()         {}
+func (*ErrorBadRequest) reset2FARes()                    {}
+func (*ErrorBadRequest) resetPasswordRes()               {}
+func (*ErrorBadRequest) searchProjectEventsRes()         {}
+func (*ErrorBadRequest) send2FACodeRes()                 {}
+func (*ErrorBadRequest) setSuperuserStatusRes()          {}
+func (*ErrorBadRequest) setUserActiveStatusRes()         {}
+func (*ErrorBadRequest) unmergeIssueRes()                {}
+func (*ErrorBadRequest) updateGroupingRuleRes()          {}
+func (*ErrorBadRequest) updateNotificationRuleRes()      {}
+func (*ErrorBadRequest) updateNotificationSettingRes()   {}
+func (*ErrorBadRequest) updateProjectDataScrubbingRes()  {}
+func (*ErrorBadRequest) updateProjectEnvironmentRes()    {}
+func (*ErrorBadRequest) updateProjectGroupingConfigRes() {}
+func (*ErrorBadRequest) updateProjectInboundFiltersRes() {}
+func (*ErrorBadRequest) updateProjectKeyRes()            {}
+func (*ErrorBadRequest) updateProjectRateLimitsRes()     {}
+func (*ErrorBadRequest) updateProjectRes()               {}
+func (*ErrorBadRequest) userChangeMyPasswordRes()        {}
+func (*ErrorBadRequest) verify2FARes()                   {}
 
 type ErrorBadRequestError struct {
 	Message OptString `json:"message"`
@@ -725,6 +1214,39 @@ func (s *ErrorBadRequestError) SetMessage(val OptString) {
 	s.Message = val
 }
 
+// Merged schema.
+// Ref: #/components/schemas/ErrorConflict
+type ErrorConflict struct {
+	Error ErrorConflictError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *ErrorConflict) GetError() ErrorConflictError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *ErrorConflict) SetError(val ErrorConflictError) {
+	s.Error = val
+}
+
+func (*ErrorConflict) revokeProjectKeyRes() {}
+func (*ErrorConflict) updateProjectKeyRes() {}
+
+type ErrorConflictError struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorConflictError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorConflictError) SetMessage(val OptString) {
+	s.Message = val
+}
+
 type ErrorError struct {
 	Message OptString `json:"message"`
 }
@@ -758,27 +1280,46 @@ func (s *ErrorInternalServerError) SetError(val ErrorInternalServerErrorError) {
 func (*ErrorInternalServerError) addProjectRes()                        {}
 func (*ErrorInternalServerError) addTeamMemberRes()                     {}
 func (*ErrorInternalServerError) archiveProjectRes()                    {}
+func (*ErrorInternalServerError) assignIssueRes()                       {}
+func (*ErrorInternalServerError) bulkUpdateIssuesRes()                  {}
 func (*ErrorInternalServerError) changeIssueStatusRes()                 {}
 func (*ErrorInternalServerError) changeTeamMemberRoleRes()              {}
 func (*ErrorInternalServerError) checkTeamExistsRes()                   {}
 func (*ErrorInternalServerError) compareProjectReleasesAnalyticsRes()   {}
+func (*ErrorInternalServerError) createGroupingRuleRes()                {}
 func (*ErrorInternalServerError) createNotificationRuleRes()            {}
 func (*ErrorInternalServerError) createNotificationSettingRes()         {}
+func (*ErrorInternalServerError) createProjectIssueCommentRes()         {}
+func (*ErrorInternalServerError) createProjectKeyRes()                  {}
 func (*ErrorInternalServerError) createTeamRes()                        {}
 func (*ErrorInternalServerError) createUserRes()                        {}
+func (*ErrorInternalServerError) deleteGroupingRuleRes()                {}
+func (*ErrorInternalServerError) deleteMonitorRes()                     {}
 func (*ErrorInternalServerError) deleteNotificationRuleRes()            {}
 func (*ErrorInternalServerError) deleteNotificationSettingRes()         {}
+func (*ErrorInternalServerError) deleteProjectIssueCommentRes()         {}
 func (*ErrorInternalServerError) deleteTeamRes()                        {}
 func (*ErrorInternalServerError) deleteUserRes()                        {}
+func (*ErrorInternalServerError) downloadAttachmentRes()                {}
 func (*ErrorInternalServerError) forgotPasswordRes()                    {}
 func (*ErrorInternalServerError) getCurrentUserRes()                    {}
 func (*ErrorInternalServerError) getEventsTimeseriesRes()               {}
 func (*ErrorInternalServerError) getIssueRes()                          {}
 func (*ErrorInternalServerError) getIssuesTimeseriesRes()               {}
+func (*ErrorInternalServerError) getMonitorRes()                        {}
 func (*ErrorInternalServerError) getNotificationRuleRes()               {}
 func (*ErrorInternalServerError) getNotificationSettingRes()            {}
+func (*ErrorInternalServerError) getProjectDataScrubbingRes()           {}
+func (*ErrorInternalServerError) getProjectEventRes()                   {}
+func (*ErrorInternalServerError) getProjectGroupingConfigRes()          {}
+func (*ErrorInternalServerError) getProjectInboundFiltersRes()          {}
 func (*ErrorInternalServerError) getProjectIssueEventsTimeseriesRes()   {}
+func (*ErrorInternalServerError) getProjectIssueLatestEventRes()        {}
+func (*ErrorInternalServerError) getProjectIssueOldestEventRes()        {}
+func (*ErrorInternalServerError) getProjectIssueTagsRes()               {}
 func (*ErrorInternalServerError) getProjectIssueTimeseriesRes()         {}
+func (*ErrorInternalServerError) getProjectOutcomesRes()                {}
+func (*ErrorInternalServerError) getProjectRateLimitsRes()              {}
 func (*ErrorInternalServerError) getProjectReleaseAnalyticsDetailsRes() {}
 func (*ErrorInternalServerError) getProjectReleaseErrorsTimeseriesRes() {}
 func (*ErrorInternalServerError) getProjectReleaseSegmentsRes()         {}
@@ -788,24 +1329,48 @@ func (*ErrorInternalServerError) getProjectStatsRes()                   {}
 func (*ErrorInternalServerError) getProjectTeamRes()                    {}
 func (*ErrorInternalServerError) getRecentIssuesRes()                   {}
 func (*ErrorInternalServerError) getTeamRes()                           {}
+func (*ErrorInternalServerError) getTraceRes()                          {}
 func (*ErrorInternalServerError) getVersionsRes()                       {}
+func (*ErrorInternalServerError) listEventAttachmentsRes()              {}
+func (*ErrorInternalServerError) listGroupingRulesRes()                 {}
 func (*ErrorInternalServerError) listIssuesRes()                        {}
+func (*ErrorInternalServerError) listMonitorsRes()                      {}
 func (*ErrorInternalServerError) listNotificationRulesRes()             {}
 func (*ErrorInternalServerError) listNotificationSettingsRes()          {}
+func (*ErrorInternalServerError) listProjectEnvironmentsRes()           {}
+func (*ErrorInternalServerError) listProjectIssueActivityRes()          {}
+func (*ErrorInternalServerError) listProjectIssueCommentsRes()          {}
+func (*ErrorInternalServerError) listProjectIssueEventsRes()            {}
+func (*ErrorInternalServerError) listProjectIssueTagEventsRes()         {}
+func (*ErrorInternalServerError) listProjectKeysRes()                   {}
+func (*ErrorInternalServerError) listProjectTransactionsRes()           {}
 func (*ErrorInternalServerError) listProjectsRes()                      {}
 func (*ErrorInternalServerError) listTeamsRes()                         {}
 func (*ErrorInternalServerError) listUsersForTeamRes()                  {}
 func (*ErrorInternalServerError) listUsersRes()                         {}
 func (*ErrorInternalServerError) loginRes()                             {}
+func (*ErrorInternalServerError) mergeIssuesRes()                       {}
+func (*ErrorInternalServerError) previewGroupingRuleRes()               {}
 func (*ErrorInternalServerError) recentProjectsListRes()                {}
 func (*ErrorInternalServerError) refreshTokenRes()                      {}
 func (*ErrorInternalServerError) removeTeamMemberRes()                  {}
 func (*ErrorInternalServerError) resetPasswordRes()                     {}
+func (*ErrorInternalServerError) revokeProjectKeyRes()                  {}
+func (*ErrorInternalServerError) rotateProjectKeyRes()                  {}
+func (*ErrorInternalServerError) searchProjectEventsRes()               {}
 func (*ErrorInternalServerError) sendTestNotificationRes()              {}
 func (*ErrorInternalServerError) setSuperuserStatusRes()                {}
 func (*ErrorInternalServerError) setUserActiveStatusRes()               {}
+func (*ErrorInternalServerError) unmergeIssueRes()                      {}
+func (*ErrorInternalServerError) updateGroupingRuleRes()                {}
 func (*ErrorInternalServerError) updateNotificationRuleRes()            {}
 func (*ErrorInternalServerError) updateNotificationSettingRes()         {}
+func (*ErrorInternalServerError) updateProjectDataScrubbingRes()        {}
+func (*ErrorInternalServerError) updateProjectEnvironmentRes()          {}
+func (*ErrorInternalServerError) updateProjectGroupingConfigRes()       {}
+func (*ErrorInternalServerError) updateProjectInboundFiltersRes()       {}
+func (*ErrorInternalServerError) updateProjectKeyRes()                  {}
+func (*ErrorInternalServerError) updateProjectRateLimitsRes()           {}
 func (*ErrorInternalServerError) updateProjectRes()                     {}
 func (*ErrorInternalServerError) userChangeMyPasswordRes()              {}
 
@@ -874,22 +1439,41 @@ func (s *ErrorNotFound) SetError(val ErrorNotFoundError) {
 func (*ErrorNotFound) addProjectRes()                        {}
 func (*ErrorNotFound) addTeamMemberRes()                     {}
 func (*ErrorNotFound) archiveProjectRes()                    {}
+func (*ErrorNotFound) assignIssueRes()                       {}
+func (*ErrorNotFound) bulkUpdateIssuesRes()                  {}
 func (*ErrorNotFound) changeIssueStatusRes()                 {}
 func (*ErrorNotFound) changeTeamMemberRoleRes()              {}
 func (*ErrorNotFound) compareProjectReleasesAnalyticsRes()   {}
+func (*ErrorNotFound) createGroupingRuleRes()                {}
 func (*ErrorNotFound) createNotificationRuleRes()            {}
 func (*ErrorNotFound) createNotificationSettingRes()         {}
+func (*ErrorNotFound) createProjectIssueCommentRes()         {}
+func (*ErrorNotFound) createProjectKeyRes()                  {}
+func (*ErrorNotFound) deleteGroupingRuleRes()                {}
+func (*ErrorNotFound) deleteMonitorRes()                     {}
 func (*ErrorNotFound) deleteNotificationRuleRes()            {}
 func (*ErrorNotFound) deleteNotificationSettingRes()         {}
+func (*ErrorNotFound) deleteProjectIssueCommentRes()         {}
 func (*ErrorNotFound) deleteTeamRes()                        {}
 func (*ErrorNotFound) deleteUserRes()                        {}
+func (*ErrorNotFound) downloadAttachmentRes()                {}
 func (*ErrorNotFound) getEventsTimeseriesRes()               {}
 func (*ErrorNotFound) getIssueRes()                          {}
 func (*ErrorNotFound) getIssuesTimeseriesRes()               {}
+func (*ErrorNotFound) getMonitorRes()                        {}
 func (*ErrorNotFound) getNotificationRuleRes()               {}
 func (*ErrorNotFound) getNotificationSettingRes()            {}
+func (*ErrorNotFound) getProjectDataScrubbingRes()           {}
+func (*ErrorNotFound) getProjectEventRes()                   {}
+func (*ErrorNotFound) getProjectGroupingConfigRes()          {}
+func (*ErrorNotFound) getProjectInboundFiltersRes()          {}
 func (*ErrorNotFound) getProjectIssueEventsTimeseriesRes()   {}
+func (*ErrorNotFound) getProjectIssueLatestEventRes()        {}
+func (*ErrorNotFound) getProjectIssueOldestEventRes()        {}
+func (*ErrorNotFound) getProjectIssueTagsRes()               {}
 func (*ErrorNotFound) getProjectIssueTimeseriesRes()         {}
+func (*ErrorNotFound) getProjectOutcomesRes()                {}
+func (*ErrorNotFound) getProjectRateLimitsRes()              {}
 func (*ErrorNotFound) getProjectReleaseAnalyticsDetailsRes() {}
 func (*ErrorNotFound) getProjectReleaseErrorsTimeseriesRes() {}
 func (*ErrorNotFound) getProjectReleaseSegmentsRes()         {}
@@ -898,16 +1482,40 @@ func (*ErrorNotFound) getProjectRes()                        {}
 func (*ErrorNotFound) getProjectStatsRes()                   {}
 func (*ErrorNotFound) getProjectTeamRes()                    {}
 func (*ErrorNotFound) getTeamRes()                           {}
+func (*ErrorNotFound) getTraceRes()                          {}
+func (*ErrorNotFound) listEventAttachmentsRes()              {}
+func (*ErrorNotFound) listGroupingRulesRes()                 {}
+func (*ErrorNotFound) listMonitorsRes()                      {}
 func (*ErrorNotFound) listNotificationRulesRes()             {}
 func (*ErrorNotFound) listNotificationSettingsRes()          {}
+func (*ErrorNotFound) listProjectEnvironmentsRes()           {}
+func (*ErrorNotFound) listProjectIssueActivityRes()          {}
+func (*ErrorNotFound) listProjectIssueCommentsRes()          {}
+func (*ErrorNotFound) listProjectIssueEventsRes()            {}
+func (*ErrorNotFound) listProjectIssueTagEventsRes()         {}
+func (*ErrorNotFound) listProjectKeysRes()                   {}
+func (*ErrorNotFound) listProjectTransactionsRes()           {}
 func (*ErrorNotFound) listUsersForTeamRes()                  {}
 func (*ErrorNotFound) listUsersRes()                         {}
 func (*ErrorNotFound) markNotificationAsReadRes()            {}
+func (*ErrorNotFound) mergeIssuesRes()                       {}
+func (*ErrorNotFound) previewGroupingRuleRes()               {}
 func (*ErrorNotFound) removeTeamMemberRes()                  {}
+func (*ErrorNotFound) revokeProjectKeyRes()                  {}
+func (*ErrorNotFound) rotateProjectKeyRes()                  {}
+func (*ErrorNotFound) searchProjectEventsRes()               {}
 func (*ErrorNotFound) setSuperuserStatusRes()                {}
 func (*ErrorNotFound) setUserActiveStatusRes()               {}
+func (*ErrorNotFound) unmergeIssueRes()                      {}
+func (*ErrorNotFound) updateGroupingRuleRes()                {}
 func (*ErrorNotFound) updateNotificationRuleRes()            {}
 func (*ErrorNotFound) updateNotificationSettingRes()         {}
+func (*ErrorNotFound) updateProjectDataScrubbingRes()        {}
+func (*ErrorNotFound) updateProjectEnvironmentRes()          {}
+func (*ErrorNotFound) updateProjectGroupingConfigRes()       {}
+func (*ErrorNotFound) updateProjectInboundFiltersRes()       {}
+func (*ErrorNotFound) updateProjectKeyRes()                  {}
+func (*ErrorNotFound) updateProjectRateLimitsRes()           {}
 func (*ErrorNotFound) updateProjectRes()                     {}
 
 type ErrorNotFoundError struct {
@@ -940,32 +1548,74 @@ func (s *ErrorPermissionDenied) SetError(val ErrorPermissionDeniedError) {
 	s.Error = val
 }
 
-func (*ErrorPermissionDenied) addTeamMemberRes()             {}
-func (*ErrorPermissionDenied) archiveProjectRes()            {}
-func (*ErrorPermissionDenied) changeTeamMemberRoleRes()      {}
-func (*ErrorPermissionDenied) createNotificationRuleRes()    {}
-func (*ErrorPermissionDenied) createNotificationSettingRes() {}
-func (*ErrorPermissionDenied) createUserRes()                {}
-func (*ErrorPermissionDenied) deleteNotificationRuleRes()    {}
-func (*ErrorPermissionDenied) deleteNotificationSettingRes() {}
-func (*ErrorPermissionDenied) deleteTeamRes()                {}
-func (*ErrorPermissionDenied) deleteUserRes()                {}
-func (*ErrorPermissionDenied) forgotPasswordRes()            {}
-func (*ErrorPermissionDenied) getNotificationRuleRes()       {}
-func (*ErrorPermissionDenied) getNotificationSettingRes()    {}
-func (*ErrorPermissionDenied) getProjectRes()                {}
-func (*ErrorPermissionDenied) getProjectTeamRes()            {}
-func (*ErrorPermissionDenied) listNotificationRulesRes()     {}
-func (*ErrorPermissionDenied) listNotificationSettingsRes()  {}
-func (*ErrorPermissionDenied) listUsersForTeamRes()          {}
-func (*ErrorPermissionDenied) listUsersRes()                 {}
-func (*ErrorPermissionDenied) removeTeamMemberRes()          {}
-func (*ErrorPermissionDenied) setSuperuserStatusRes()        {}
-func (*ErrorPermissionDenied) setUserActiveStatusRes()       {}
-func (*ErrorPermissionDenied) updateNotificationRuleRes()    {}
-func (*ErrorPermissionDenied) updateNotificationSettingRes() {}
-func (*ErrorPermissionDenied) updateProjectRes()             {}
-func (*ErrorPermissionDenied) userChangeMyPasswordRes()      {}
+func (*ErrorPermissionDenied) addTeamMemberRes()               {}
+func (*ErrorPermissionDenied) archiveProjectRes()              {}
+func (*ErrorPermissionDenied) bulkUpdateIssuesRes()            {}
+func (*ErrorPermissionDenied) changeTeamMemberRoleRes()        {}
+func (*ErrorPermissionDenied) createGroupingRuleRes()          {}
+func (*ErrorPermissionDenied) createNotificationRuleRes()      {}
+func (*ErrorPermissionDenied) createNotificationSettingRes()   {}
+func (*ErrorPermissionDenied) createProjectIssueCommentRes()   {}
+func (*ErrorPermissionDenied) createProjectKeyRes()            {}
+func (*ErrorPermissionDenied) createUserRes()                  {}
+func (*ErrorPermissionDenied) deleteGroupingRuleRes()          {}
+func (*ErrorPermissionDenied) deleteMonitorRes()               {}
+func (*ErrorPermissionDenied) deleteNotificationRuleRes()      {}
+func (*ErrorPermissionDenied) deleteNotificationSettingRes()   {}
+func (*ErrorPermissionDenied) deleteProjectIssueCommentRes()   {}
+func (*ErrorPermissionDenied) deleteTeamRes()                  {}
+func (*ErrorPermissionDenied) deleteUserRes()                  {}
+func (*ErrorPermissionDenied) downloadAttachmentRes()          {}
+func (*ErrorPermissionDenied) forgotPasswordRes()              {}
+func (*ErrorPermissionDenied) getMonitorRes()                  {}
+func (*ErrorPermissionDenied) getNotificationRuleRes()         {}
+func (*ErrorPermissionDenied) getNotificationSettingRes()      {}
+func (*ErrorPermissionDenied) getProjectDataScrubbingRes()     {}
+func (*ErrorPermissionDenied) getProjectEventRes()             {}
+func (*ErrorPermissionDenied) getProjectGroupingConfigRes()    {}
+func (*ErrorPermissionDenied) getProjectInboundFiltersRes()    {}
+func (*ErrorPermissionDenied) getProjectIssueLatestEventRes()  {}
+func (*ErrorPermissionDenied) getProjectIssueOldestEventRes()  {}
+func (*ErrorPermissionDenied) getProjectIssueTagsRes()         {}
+func (*ErrorPermissionDenied) getProjectOutcomesRes()          {}
+func (*ErrorPermissionDenied) getProjectRateLimitsRes()        {}
+func (*ErrorPermissionDenied) getProjectRes()                  {}
+func (*ErrorPermissionDenied) getProjectTeamRes()              {}
+func (*ErrorPermissionDenied) getTraceRes()                    {}
+func (*ErrorPermissionDenied) listEventAttachmentsRes()        {}
+func (*ErrorPermissionDenied) listGroupingRulesRes()           {}
+func (*ErrorPermissionDenied) listMonitorsRes()                {}
+func (*ErrorPermissionDenied) listNotificationRulesRes()       {}
+func (*ErrorPermissionDenied) listNotificationSettingsRes()    {}
+func (*ErrorPermissionDenied) listProjectEnvironmentsRes()     {}
+func (*ErrorPermissionDenied) listProjectIssueActivityRes()    {}
+func (*ErrorPermissionDenied) listProjectIssueCommentsRes()    {}
+func (*ErrorPermissionDenied) listProjectIssueEventsRes()      {}
+func (*ErrorPermissionDenied) listProjectIssueTagEventsRes()   {}
+func (*ErrorPermissionDenied) listProjectKeysRes()             {}
+func (*ErrorPermissionDenied) listProjectTransactionsRes()     {}
+func (*ErrorPermissionDenied) listUsersForTeamRes()            {}
+func (*ErrorPermissionDenied) listUsersRes()                   {}
+func (*ErrorPermissionDenied) mergeIssuesRes()                 {}
+func (*ErrorPermissionDenied) previewGroupingRuleRes()         {}
+func (*ErrorPermissionDenied) removeTeamMemberRes()            {}
+func (*ErrorPermissionDenied) revokeProjectKeyRes()            {}
+func (*ErrorPermissionDenied) rotateProjectKeyRes()            {}
+func (*ErrorPermissionDenied) searchProjectEventsRes()         {}
+func (*ErrorPermissionDenied) setSuperuserStatusRes()          {}
+func (*ErrorPermissionDenied) setUserActiveStatusRes()         {}
+func (*ErrorPermissionDenied) unmergeIssueRes()                {}
+func (*ErrorPermissionDenied) updateGroupingRuleRes()          {}
+func (*ErrorPermissionDenied) updateNotificationRuleRes()      {}
+func (*ErrorPermissionDenied) updateNotificationSettingRes()   {}
+func (*ErrorPermissionDenied) updateProjectDataScrubbingRes()  {}
+func (*ErrorPermissionDenied) updateProjectEnvironmentRes()    {}
+func (*ErrorPermissionDenied) updateProjectGroupingConfigRes() {}
+func (*ErrorPermissionDenied) updateProjectInboundFiltersRes() {}
+func (*ErrorPermissionDenied) updateProjectKeyRes()            {}
+func (*ErrorPermissionDenied) updateProjectRateLimitsRes()     {}
+func (*ErrorPermissionDenied) updateProjectRes()               {}
+func (*ErrorPermissionDenied) userChangeMyPasswordRes()        {}
 
 type ErrorPermissionDeniedError struct {
 	Message OptString `json:"message"`
@@ -1059,28 +1709,47 @@ func (s *ErrorUnauthorized) SetError(val ErrorUnauthorizedError) {
 func (*ErrorUnauthorized) addProjectRes()                        {}
 func (*ErrorUnauthorized) addTeamMemberRes()                     {}
 func (*ErrorUnauthorized) archiveProjectRes()                    {}
+func (*ErrorUnauthorized) assignIssueRes()                       {}
+func (*ErrorUnauthorized) bulkUpdateIssuesRes()                  {}
 func (*ErrorUnauthorized) changeIssueStatusRes()                 {}
 func (*ErrorUnauthorized) changeTeamMemberRoleRes()              {}
 func (*ErrorUnauthorized) checkTeamExistsRes()                   {}
 func (*ErrorUnauthorized) compareProjectReleasesAnalyticsRes()   {}
 func (*ErrorUnauthorized) confirm2FARes()                        {}
+func (*ErrorUnauthorized) createGroupingRuleRes()                {}
 func (*ErrorUnauthorized) createNotificationRuleRes()            {}
 func (*ErrorUnauthorized) createNotificationSettingRes()         {}
+func (*ErrorUnauthorized) createProjectIssueCommentRes()         {}
+func (*ErrorUnauthorized) createProjectKeyRes()                  {}
 func (*ErrorUnauthorized) createTeamRes()                        {}
 func (*ErrorUnauthorized) createUserRes()                        {}
+func (*ErrorUnauthorized) deleteGroupingRuleRes()                {}
+func (*ErrorUnauthorized) deleteMonitorRes()                     {}
 func (*ErrorUnauthorized) deleteNotificationRuleRes()            {}
 func (*ErrorUnauthorized) deleteNotificationSettingRes()         {}
+func (*ErrorUnauthorized) deleteProjectIssueCommentRes()         {}
 func (*ErrorUnauthorized) deleteTeamRes()                        {}
 func (*ErrorUnauthorized) deleteUserRes()                        {}
 func (*ErrorUnauthorized) disable2FARes()                        {}
+func (*ErrorUnauthorized) downloadAttachmentRes()                {}
 func (*ErrorUnauthorized) getCurrentUserRes()                    {}
 func (*ErrorUnauthorized) getEventsTimeseriesRes()               {}
 func (*ErrorUnauthorized) getIssueRes()                          {}
 func (*ErrorUnauthorized) getIssuesTimeseriesRes()               {}
+func (*ErrorUnauthorized) getMonitorRes()                        {}
 func (*ErrorUnauthorized) getNotificationRuleRes()               {}
 func (*ErrorUnauthorized) getNotificationSettingRes()            {}
+func (*ErrorUnauthorized) getProjectDataScrubbingRes()           {}
+func (*ErrorUnauthorized) getProjectEventRes()                   {}
+func (*ErrorUnauthorized) getProjectGroupingConfigRes()          {}
+func (*ErrorUnauthorized) getProjectInboundFiltersRes()          {}
 func (*ErrorUnauthorized) getProjectIssueEventsTimeseriesRes()   {}
+func (*ErrorUnauthorized) getProjectIssueLatestEventRes()        {}
+func (*ErrorUnauthorized) getProjectIssueOldestEventRes()        {}
+func (*ErrorUnauthorized) getProjectIssueTagsRes()               {}
 func (*ErrorUnauthorized) getProjectIssueTimeseriesRes()         {}
+func (*ErrorUnauthorized) getProjectOutcomesRes()                {}
+func (*ErrorUnauthorized) getProjectRateLimitsRes()              {}
 func (*ErrorUnauthorized) getProjectReleaseAnalyticsDetailsRes() {}
 func (*ErrorUnauthorized) getProjectReleaseErrorsTimeseriesRes() {}
 func (*ErrorUnauthorized) getProjectReleaseSegmentsRes()         {}
@@ -1090,28 +1759,52 @@ func (*ErrorUnauthorized) getProjectStatsRes()                   {}
 func (*ErrorUnauthorized) getProjectTeamRes()                    {}
 func (*ErrorUnauthorized) getRecentIssuesRes()                   {}
 func (*ErrorUnauthorized) getTeamRes()                           {}
+func (*ErrorUnauthorized) getTraceRes()                          {}
 func (*ErrorUnauthorized) getUnreadNotificationsCountRes()       {}
 func (*ErrorUnauthorized) getUserNotificationsRes()              {}
+func (*ErrorUnauthorized) listEventAttachmentsRes()              {}
+func (*ErrorUnauthorized) listGroupingRulesRes()                 {}
 func (*ErrorUnauthorized) listIssuesRes()                        {}
+func (*ErrorUnauthorized) listMonitorsRes()                      {}
 func (*ErrorUnauthorized) listNotificationRulesRes()             {}
 func (*ErrorUnauthorized) listNotificationSettingsRes()          {}
+func (*ErrorUnauthorized) listProjectEnvironmentsRes()           {}
+func (*ErrorUnauthorized) listProjectIssueActivityRes()          {}
+func (*ErrorUnauthorized) listProjectIssueCommentsRes()          {}
+func (*ErrorUnauthorized) listProjectIssueEventsRes()            {}
+func (*ErrorUnauthorized) listProjectIssueTagEventsRes()         {}
+func (*ErrorUnauthorized) listProjectKeysRes()                   {}
+func (*ErrorUnauthorized) listProjectTransactionsRes()           {}
 func (*ErrorUnauthorized) listProjectsRes()                      {}
 func (*ErrorUnauthorized) listTeamsRes()                         {}
 func (*ErrorUnauthorized) listUsersForTeamRes()                  {}
 func (*ErrorUnauthorized) listUsersRes()                         {}
 func (*ErrorUnauthorized) markAllNotificationsAsReadRes()        {}
 func (*ErrorUnauthorized) markNotificationAsReadRes()            {}
+func (*ErrorUnauthorized) mergeIssuesRes()                       {}
+func (*ErrorUnauthorized) previewGroupingRuleRes()               {}
 func (*ErrorUnauthorized) recentProjectsListRes()                {}
 func (*ErrorUnauthorized) refreshTokenRes()                      {}
 func (*ErrorUnauthorized) removeTeamMemberRes()                  {}
 func (*ErrorUnauthorized) reset2FARes()                          {}
 func (*ErrorUnauthorized) resetPasswordRes()                     {}
+func (*ErrorUnauthorized) revokeProjectKeyRes()                  {}
+func (*ErrorUnauthorized) rotateProjectKeyRes()                  {}
+func (*ErrorUnauthorized) searchProjectEventsRes()               {}
 func (*ErrorUnauthorized) send2FACodeRes()                       {}
 func (*ErrorUnauthorized) setSuperuserStatusRes()                {}
 func (*ErrorUnauthorized) setUserActiveStatusRes()               {}
 func (*ErrorUnauthorized) setup2FARes()                          {}
+func (*ErrorUnauthorized) unmergeIssueRes()                      {}
+func (*ErrorUnauthorized) updateGroupingRuleRes()                {}
 func (*ErrorUnauthorized) updateNotificationRuleRes()            {}
 func (*ErrorUnauthorized) updateNotificationSettingRes()         {}
+func (*ErrorUnauthorized) updateProjectDataScrubbingRes()        {}
+func (*ErrorUnauthorized) updateProjectEnvironmentRes()          {}
+func (*ErrorUnauthorized) updateProjectGroupingConfigRes()       {}
+func (*ErrorUnauthorized) updateProjectInboundFiltersRes()       {}
+func (*ErrorUnauthorized) updateProjectKeyRes()                  {}
+func (*ErrorUnauthorized) updateProjectRateLimitsRes()           {}
 func (*ErrorUnauthorized) updateProjectRes()                     {}
 func (*ErrorUnauthorized) userChangeMyPasswordRes()              {}
 func (*ErrorUnauthorized) verify2FARes()                         {}
@@ -1130,10 +1823,299 @@ func (s *ErrorUnauthorizedError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-// ForgotPasswordNoContent is response for ForgotPassword operation.
-type ForgotPasswordNoContent struct{}
-
-func (*ForgotPasswordNoContent) forgotPasswordRes() {}
+// Ref: #/components/schemas/EventBreadcrumb
+type EventBreadcrumb struct {
+	Timestamp OptDateTime            `json:"timestamp"`
+	Type      OptString              `json:"type"`
+	Category  OptString              `json:"category"`
+	Level     OptString              `json:"level"`
+	Message   OptString              `json:"message"`
+	Data      OptEventBreadcrumbData `json:"data"`
+}
+
+// GetTimestamp returns the value of Timestamp.
+func (s *EventBreadcrumb) GetTimestamp() OptDateTime {
+	return s.Timestamp
+}
+
+// GetType returns the value of Type.
+func (s *EventBreadcrumb) GetType() OptString {
+	return s.Type
+}
+
+// GetCategory returns the value of Category.
+func (s *EventBreadcrumb) GetCategory() OptString {
+	return s.Category
+}
+
+// GetLevel returns the value of Level.
+func (s *EventBreadcrumb) GetLevel() OptString {
+	return s.Level
+}
+
+// GetMessage returns the value of Message.
+func (s *EventBreadcrumb) GetMessage() OptString {
+	return s.Message
+}
+
+// GetData returns the value of Data.
+func (s *EventBreadcrumb) GetData() OptEventBreadcrumbData {
+	return s.Data
+}
+
+// SetTimestamp sets the value of Timestamp.
+func (s *EventBreadcrumb) SetTimestamp(val OptDateTime) {
+	s.Timestamp = val
+}
+
+// SetType sets the value of Type.
+func (s *EventBreadcrumb) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetCategory sets the value of Category.
+func (s *EventBreadcrumb) SetCategory(val OptString) {
+	s.Category = val
+}
+
+// SetLevel sets the value of Level.
+func (s *EventBreadcrumb) SetLevel(val OptString) {
+	s.Level = val
+}
+
+// SetMessage sets the value of Message.
+func (s *EventBreadcrumb) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetData sets the value of Data.
+func (s *EventBreadcrumb) SetData(val OptEventBreadcrumbData) {
+	s.Data = val
+}
+
+type EventBreadcrumbData map[string]jx.Raw
+
+func (s *EventBreadcrumbData) init() EventBreadcrumbData {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/EventDetailsResponse
+type EventDetailsResponse struct {
+	Event IssueEvent `json:"event"`
+	// Issue of the event, not set when the issue is deleted.
+	IssueID OptUint `json:"issue_id"`
+	// Older event of the issue, not set for the oldest event.
+	PreviousEventID OptString `json:"previous_event_id"`
+	// Newer event of the issue, not set for the latest event.
+	NextEventID OptString                    `json:"next_event_id"`
+	Breadcrumbs []EventBreadcrumb            `json:"breadcrumbs"`
+	Contexts    EventDetailsResponseContexts `json:"contexts"`
+	Extra       EventDetailsResponseExtra    `json:"extra"`
+	Sdk         OptEventSDK                  `json:"sdk"`
+	// Full exception chain.
+	Exceptions []EventException `json:"exceptions"`
+}
+
+// GetEvent returns the value of Event.
+func (s *EventDetailsResponse) GetEvent() IssueEvent {
+	return s.Event
+}
+
+// GetIssueID returns the value of IssueID.
+func (s *EventDetailsResponse) GetIssueID() OptUint {
+	return s.IssueID
+}
+
+// GetPreviousEventID returns the value of PreviousEventID.
+func (s *EventDetailsResponse) GetPreviousEventID() OptString {
+	return s.PreviousEventID
+}
+
+// GetNextEventID returns the value of NextEventID.
+func (s *EventDetailsResponse) GetNextEventID() OptString {
+	return s.NextEventID
+}
+
+// GetBreadcrumbs returns the value of Breadcrumbs.
+func (s *EventDetailsResponse) GetBreadcrumbs() []EventBreadcrumb {
+	return s.Breadcrumbs
+}
+
+// GetContexts returns the value of Contexts.
+func (s *EventDetailsResponse) GetContexts() EventDetailsResponseContexts {
+	return s.Contexts
+}
+
+// GetExtra returns the value of Extra.
+func (s *EventDetailsResponse) GetExtra() EventDetailsResponseExtra {
+	return s.Extra
+}
+
+// GetSdk returns the value of Sdk.
+func (s *EventDetailsResponse) GetSdk() OptEventSDK {
+	return s.Sdk
+}
+
+// GetExceptions returns the value of Exceptions.
+func (s *EventDetailsResponse) GetExceptions() []EventException {
+	return s.Exceptions
+}
+
+// SetEvent sets the value of Event.
+func (s *EventDetailsResponse) SetEvent(val IssueEvent) {
+	s.Event = val
+}
+
+// SetIssueID sets the value of IssueID.
+func (s *EventDetailsResponse) SetIssueID(val OptUint) {
+	s.IssueID = val
+}
+
+// SetPreviousEventID sets the value of PreviousEventID.
+func (s *EventDetailsResponse) SetPreviousEventID(val OptString) {
+	s.PreviousEventID = val
+}
+
+// SetNextEventID sets the value of NextEventID.
+func (s *EventDetailsResponse) SetNextEventID(val OptString) {
+	s.NextEventID = val
+}
+
+// SetBreadcrumbs sets the value of Breadcrumbs.
+func (s *EventDetailsResponse) SetBreadcrumbs(val []EventBreadcrumb) {
+	s.Breadcrumbs = val
+}
+
+// SetContexts sets the value of Contexts.
+func (s *EventDetailsResponse) SetContexts(val EventDetailsResponseContexts) {
+	s.Contexts = val
+}
+
+// SetExtra sets the value of Extra.
+func (s *EventDetailsResponse) SetExtra(val EventDetailsResponseExtra) {
+	s.Extra = val
+}
+
+// SetSdk sets the value of Sdk.
+func (s *EventDetailsResponse) SetSdk(val OptEventSDK) {
+	s.Sdk = val
+}
+
+// SetExceptions sets the value of Exceptions.
+func (s *EventDetailsResponse) SetExceptions(val []EventException) {
+	s.Exceptions = val
+}
+
+func (*EventDetailsResponse) getProjectEventRes()            {}
+func (*EventDetailsResponse) getProjectIssueLatestEventRes() {}
+func (*EventDetailsResponse) getProjectIssueOldestEventRes() {}
+
+type EventDetailsResponseContexts map[string]jx.Raw
+
+func (s *EventDetailsResponseContexts) init() EventDetailsResponseContexts {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type EventDetailsResponseExtra map[string]jx.Raw
+
+func (s *EventDetailsResponseExtra) init() EventDetailsResponseExtra {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/EventException
+type EventException struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	// Stacktrace JSON as sent by the SDK.
+	Stacktrace OptString `json:"stacktrace"`
+}
+
+// GetType returns the value of Type.
+func (s *EventException) GetType() string {
+	return s.Type
+}
+
+// GetValue returns the value of Value.
+func (s *EventException) GetValue() string {
+	return s.Value
+}
+
+// GetStacktrace returns the value of Stacktrace.
+func (s *EventException) GetStacktrace() OptString {
+	return s.Stacktrace
+}
+
+// SetType sets the value of Type.
+func (s *EventException) SetType(val string) {
+	s.Type = val
+}
+
+// SetValue sets the value of Value.
+func (s *EventException) SetValue(val string) {
+	s.Value = val
+}
+
+// SetStacktrace sets the value of Stacktrace.
+func (s *EventException) SetStacktrace(val OptString) {
+	s.Stacktrace = val
+}
+
+// Ref: #/components/schemas/EventSDK
+type EventSDK struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Integrations []string `json:"integrations"`
+}
+
+// GetName returns the value of Name.
+func (s *EventSDK) GetName() string {
+	return s.Name
+}
+
+// GetVersion returns the value of Version.
+func (s *EventSDK) GetVersion() string {
+	return s.Version
+}
+
+// GetIntegrations returns the value of Integrations.
+func (s *EventSDK) GetIntegrations() []string {
+	return s.Integrations
+}
+
+// SetName sets the value of Name.
+func (s *EventSDK) SetName(val string) {
+	s.Name = val
+}
+
+// SetVersion sets the value of Version.
+func (s *EventSDK) SetVersion(val string) {
+	s.Version = val
+}
+
+// SetIntegrations sets the value of Integrations.
+func (s *EventSDK) SetIntegrations(val []string) {
+	s.Integrations = val
+}
+
+// ForgotPasswordNoContent is response for ForgotPassword operation.
+type ForgotPasswordNoContent struct{}
+
+func (*ForgotPasswordNoContent) forgotPasswordRes() {}
 
 // Ref: #/components/schemas/ForgotPasswordRequest
 type ForgotPasswordRequest struct {
@@ -1361,735 +2343,905 @@ func (s *GetProjectStatsPeriod) UnmarshalText(data []byte) error {
 	}
 }
 
-// Ref: #/components/schemas/Issue
-type Issue struct {
-	ID          uint        `json:"id"`
-	ProjectID   uint        `json:"project_id"`
-	Source      IssueSource `json:"source"`
-	Status      IssueStatus `json:"status"`
-	ProjectName string      `json:"project_name"`
-	Title       string      `json:"title"`
-	Message     string      `json:"message"`
-	Level       IssueLevel  `json:"level"`
-	Platform    string      `json:"platform"`
-	Count       uint        `json:"count"`
-	FirstSeen   time.Time   `json:"first_seen"`
-	LastSeen    time.Time   `json:"last_seen"`
-	ResolvedAt  OptDateTime `json:"resolved_at"`
-	ResolvedBy  OptString   `json:"resolved_by"`
+// Ref: #/components/schemas/GroupingConfigResponse
+type GroupingConfigResponse struct {
+	Strategy            GroupingStrategy   `json:"strategy"`
+	AvailableStrategies []GroupingStrategy `json:"available_strategies"`
+}
+
+// GetStrategy returns the value of Strategy.
+func (s *GroupingConfigResponse) GetStrategy() GroupingStrategy {
+	return s.Strategy
+}
+
+// GetAvailableStrategies returns the value of AvailableStrategies.
+func (s *GroupingConfigResponse) GetAvailableStrategies() []GroupingStrategy {
+	return s.AvailableStrategies
+}
+
+// SetStrategy sets the value of Strategy.
+func (s *GroupingConfigResponse) SetStrategy(val GroupingStrategy) {
+	s.Strategy = val
+}
+
+// SetAvailableStrategies sets the value of AvailableStrategies.
+func (s *GroupingConfigResponse) SetAvailableStrategies(val []GroupingStrategy) {
+	s.AvailableStrategies = val
+}
+
+func (*GroupingConfigResponse) getProjectGroupingConfigRes()    {}
+func (*GroupingConfigResponse) updateProjectGroupingConfigRes() {}
+
+// Ref: #/components/schemas/GroupingRule
+type GroupingRule struct {
+	ID         uint             `json:"id"`
+	ProjectID  uint             `json:"project_id"`
+	Type       GroupingRuleType `json:"type"`
+	Expression string           `json:"expression"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
 }
 
 // GetID returns the value of ID.
-func (s *Issue) GetID() uint {
+func (s *GroupingRule) GetID() uint {
 	return s.ID
 }
 
 // GetProjectID returns the value of ProjectID.
-func (s *Issue) GetProjectID() uint {
+func (s *GroupingRule) GetProjectID() uint {
 	return s.ProjectID
 }
 
-// GetSource returns the value of Source.
-func (s *Issue) GetSource() IssueSource {
-	return s.Source
+// GetType returns the value of Type.
+func (s *GroupingRule) GetType() GroupingRuleType {
+	return s.Type
 }
 
-// GetStatus returns the value of Status.
-func (s *Issue) GetStatus() IssueStatus {
-	return s.Status
+// GetExpression returns the value of Expression.
+func (s *GroupingRule) GetExpression() string {
+	return s.Expression
 }
 
-// GetProjectName returns the value of ProjectName.
-func (s *Issue) GetProjectName() string {
-	return s.ProjectName
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GroupingRule) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetTitle returns the value of Title.
-func (s *Issue) GetTitle() string {
-	return s.Title
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GroupingRule) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// GetMessage returns the value of Message.
-func (s *Issue) GetMessage() string {
-	return s.Message
+// SetID sets the value of ID.
+func (s *GroupingRule) SetID(val uint) {
+	s.ID = val
 }
 
-// GetLevel returns the value of Level.
-func (s *Issue) GetLevel() IssueLevel {
-	return s.Level
+// SetProjectID sets the value of ProjectID.
+func (s *GroupingRule) SetProjectID(val uint) {
+	s.ProjectID = val
 }
 
-// GetPlatform returns the value of Platform.
-func (s *Issue) GetPlatform() string {
-	return s.Platform
+// SetType sets the value of Type.
+func (s *GroupingRule) SetType(val GroupingRuleType) {
+	s.Type = val
 }
 
-// GetCount returns the value of Count.
-func (s *Issue) GetCount() uint {
-	return s.Count
+// SetExpression sets the value of Expression.
+func (s *GroupingRule) SetExpression(val string) {
+	s.Expression = val
 }
 
-// GetFirstSeen returns the value of FirstSeen.
-func (s *Issue) GetFirstSeen() time.Time {
-	return s.FirstSeen
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GroupingRule) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetLastSeen returns the value of LastSeen.
-func (s *Issue) GetLastSeen() time.Time {
-	return s.LastSeen
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GroupingRule) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-// GetResolvedAt returns the value of ResolvedAt.
-func (s *Issue) GetResolvedAt() OptDateTime {
-	return s.ResolvedAt
+func (*GroupingRule) createGroupingRuleRes() {}
+func (*GroupingRule) updateGroupingRuleRes() {}
+
+// Ref: #/components/schemas/GroupingRulePreviewIssue
+type GroupingRulePreviewIssue struct {
+	IssueID OptNilUint `json:"issue_id"`
+	Title   OptString  `json:"title"`
+	// Current fingerprint of the matched events.
+	Fingerprint string `json:"fingerprint"`
+	// Fingerprints the matched events would get with the rule.
+	NewFingerprints []string `json:"new_fingerprints"`
+	MatchedEvents   uint     `json:"matched_events"`
 }
 
-// GetResolvedBy returns the value of ResolvedBy.
-func (s *Issue) GetResolvedBy() OptString {
-	return s.ResolvedBy
+// GetIssueID returns the value of IssueID.
+func (s *GroupingRulePreviewIssue) GetIssueID() OptNilUint {
+	return s.IssueID
 }
 
-// SetID sets the value of ID.
-func (s *Issue) SetID(val uint) {
-	s.ID = val
+// GetTitle returns the value of Title.
+func (s *GroupingRulePreviewIssue) GetTitle() OptString {
+	return s.Title
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *Issue) SetProjectID(val uint) {
-	s.ProjectID = val
+// GetFingerprint returns the value of Fingerprint.
+func (s *GroupingRulePreviewIssue) GetFingerprint() string {
+	return s.Fingerprint
 }
 
-// SetSource sets the value of Source.
-func (s *Issue) SetSource(val IssueSource) {
-	s.Source = val
+// GetNewFingerprints returns the value of NewFingerprints.
+func (s *GroupingRulePreviewIssue) GetNewFingerprints() []string {
+	return s.NewFingerprints
 }
 
-// SetStatus sets the value of Status.
-func (s *Issue) SetStatus(val IssueStatus) {
-	s.Status = val
+// GetMatchedEvents returns the value of MatchedEvents.
+func (s *GroupingRulePreviewIssue) GetMatchedEvents() uint {
+	return s.MatchedEvents
 }
 
-// SetProjectName sets the value of ProjectName.
-func (s *Issue) SetProjectName(val string) {
-	s.ProjectName = val
+// SetIssueID sets the value of IssueID.
+func (s *GroupingRulePreviewIssue) SetIssueID(val OptNilUint) {
+	s.IssueID = val
 }
 
 // SetTitle sets the value of Title.
-func (s *Issue) SetTitle(val string) {
+func (s *GroupingRulePreviewIssue) SetTitle(val OptString) {
 	s.Title = val
 }
 
-// SetMessage sets the value of Message.
-func (s *Issue) SetMessage(val string) {
-	s.Message = val
+// SetFingerprint sets the value of Fingerprint.
+func (s *GroupingRulePreviewIssue) SetFingerprint(val string) {
+	s.Fingerprint = val
 }
 
-// SetLevel sets the value of Level.
-func (s *Issue) SetLevel(val IssueLevel) {
-	s.Level = val
+// SetNewFingerprints sets the value of NewFingerprints.
+func (s *GroupingRulePreviewIssue) SetNewFingerprints(val []string) {
+	s.NewFingerprints = val
 }
 
-// SetPlatform sets the value of Platform.
-func (s *Issue) SetPlatform(val string) {
-	s.Platform = val
+// SetMatchedEvents sets the value of MatchedEvents.
+func (s *GroupingRulePreviewIssue) SetMatchedEvents(val uint) {
+	s.MatchedEvents = val
 }
 
-// SetCount sets the value of Count.
-func (s *Issue) SetCount(val uint) {
-	s.Count = val
+// Ref: #/components/schemas/GroupingRulePreviewResponse
+type GroupingRulePreviewResponse struct {
+	ScannedEvents uint                       `json:"scanned_events"`
+	MatchedEvents uint                       `json:"matched_events"`
+	Issues        []GroupingRulePreviewIssue `json:"issues"`
 }
 
-// SetFirstSeen sets the value of FirstSeen.
-func (s *Issue) SetFirstSeen(val time.Time) {
-	s.FirstSeen = val
+// GetScannedEvents returns the value of ScannedEvents.
+func (s *GroupingRulePreviewResponse) GetScannedEvents() uint {
+	return s.ScannedEvents
 }
 
-// SetLastSeen sets the value of LastSeen.
-func (s *Issue) SetLastSeen(val time.Time) {
-	s.LastSeen = val
+// GetMatchedEvents returns the value of MatchedEvents.
+func (s *GroupingRulePreviewResponse) GetMatchedEvents() uint {
+	return s.MatchedEvents
 }
 
-// SetResolvedAt sets the value of ResolvedAt.
-func (s *Issue) SetResolvedAt(val OptDateTime) {
-	s.ResolvedAt = val
+// GetIssues returns the value of Issues.
+func (s *GroupingRulePreviewResponse) GetIssues() []GroupingRulePreviewIssue {
+	return s.Issues
 }
 
-// SetResolvedBy sets the value of ResolvedBy.
-func (s *Issue) SetResolvedBy(val OptString) {
-	s.ResolvedBy = val
+// SetScannedEvents sets the value of ScannedEvents.
+func (s *GroupingRulePreviewResponse) SetScannedEvents(val uint) {
+	s.ScannedEvents = val
 }
 
-// Full representation of domain.Event.
-// Ref: #/components/schemas/IssueEvent
-type IssueEvent struct {
-	// Unique identifier of the event (domain.Event.ID).
-	EventID string `json:"event_id"`
-	// Time when the event occurred.
-	Timestamp time.Time `json:"timestamp"`
-	// Hash used for grouping events.
-	GroupHash OptString `json:"group_hash"`
-	// Identifier of the project that owns the event.
-	ProjectID uint        `json:"project_id"`
-	Level     IssueLevel  `json:"level"`
-	Source    IssueSource `json:"source"`
-	// Platform that sent the event.
-	Platform string `json:"platform"`
-	// Short textual description of the event.
-	Message string `json:"message"`
-	// Raw JSON payload as received from the client.
-	Payload OptIssueEventPayload `json:"payload"`
-	// Key-value tags attached to the event.
-	Tags OptIssueEventTags `json:"tags"`
-	// Hostname of the server where the event happened.
-	ServerName OptString `json:"server_name"`
-	// Runtime environment (production, staging, etc.).
-	Environment OptString `json:"environment"`
-	// Application release version.
-	Release OptString `json:"release"`
-	// Exception class/type.
-	ExceptionType OptNilString `json:"exception_type"`
-	// Exception message.
-	ExceptionValue OptNilString `json:"exception_value"`
-	// Exception stacktrace.
-	ExceptionStacktrace OptNilString `json:"exception_stacktrace"`
-	// Full request URL.
-	RequestURL OptNilString `json:"request_url"`
-	// HTTP method.
-	RequestMethod OptNilString `json:"request_method"`
-	// Raw query string.
-	RequestQuery OptNilString `json:"request_query"`
-	// HTTP headers.
-	RequestHeaders OptNilIssueEventRequestHeaders `json:"request_headers"`
-	// Request body (as sent).
-	RequestData OptNilString `json:"request_data"`
-	// Cookies string.
-	RequestCookies OptNilString `json:"request_cookies"`
-	// Client IP address.
-	RequestIP OptNilString `json:"request_ip"`
-	// User-Agent header.
-	UserAgent OptNilString `json:"user_agent"`
-	// User identifier.
-	UserID OptNilString `json:"user_id"`
-	// User email address.
-	UserEmail OptNilString `json:"user_email"`
-	// Runtime name (e.g., node, python).
-	RuntimeName OptNilString `json:"runtime_name"`
-	// Runtime version.
-	RuntimeVersion OptNilString `json:"runtime_version"`
-	// Operating system name.
-	OsName OptNilString `json:"os_name"`
-	// Operating system version.
-	OsVersion OptNilString `json:"os_version"`
-	// Browser name.
-	BrowserName OptNilString `json:"browser_name"`
-	// Browser version.
-	BrowserVersion OptNilString `json:"browser_version"`
-	DeviceArch     OptNilString `json:"device_arch"`
+// SetMatchedEvents sets the value of MatchedEvents.
+func (s *GroupingRulePreviewResponse) SetMatchedEvents(val uint) {
+	s.MatchedEvents = val
 }
 
-// GetEventID returns the value of EventID.
-func (s *IssueEvent) GetEventID() string {
-	return s.EventID
+// SetIssues sets the value of Issues.
+func (s *GroupingRulePreviewResponse) SetIssues(val []GroupingRulePreviewIssue) {
+	s.Issues = val
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *IssueEvent) GetTimestamp() time.Time {
-	return s.Timestamp
-}
+func (*GroupingRulePreviewResponse) previewGroupingRuleRes() {}
 
-// GetGroupHash returns the value of GroupHash.
-func (s *IssueEvent) GetGroupHash() OptString {
-	return s.GroupHash
+// Ref: #/components/schemas/GroupingRuleRequest
+type GroupingRuleRequest struct {
+	Type       GroupingRuleType `json:"type"`
+	Expression string           `json:"expression"`
 }
 
-// GetProjectID returns the value of ProjectID.
-func (s *IssueEvent) GetProjectID() uint {
-	return s.ProjectID
+// GetType returns the value of Type.
+func (s *GroupingRuleRequest) GetType() GroupingRuleType {
+	return s.Type
 }
 
-// GetLevel returns the value of Level.
-func (s *IssueEvent) GetLevel() IssueLevel {
-	return s.Level
+// GetExpression returns the value of Expression.
+func (s *GroupingRuleRequest) GetExpression() string {
+	return s.Expression
 }
 
-// GetSource returns the value of Source.
-func (s *IssueEvent) GetSource() IssueSource {
-	return s.Source
+// SetType sets the value of Type.
+func (s *GroupingRuleRequest) SetType(val GroupingRuleType) {
+	s.Type = val
 }
 
-// GetPlatform returns the value of Platform.
-func (s *IssueEvent) GetPlatform() string {
-	return s.Platform
+// SetExpression sets the value of Expression.
+func (s *GroupingRuleRequest) SetExpression(val string) {
+	s.Expression = val
 }
 
-// GetMessage returns the value of Message.
-func (s *IssueEvent) GetMessage() string {
-	return s.Message
-}
+// Ref: #/components/schemas/GroupingRuleType
+type GroupingRuleType string
 
-// GetPayload returns the value of Payload.
-func (s *IssueEvent) GetPayload() OptIssueEventPayload {
-	return s.Payload
-}
+const (
+	GroupingRuleTypeFingerprint GroupingRuleType = "fingerprint"
+	GroupingRuleTypeStacktrace  GroupingRuleType = "stacktrace"
+)
 
-// GetTags returns the value of Tags.
-func (s *IssueEvent) GetTags() OptIssueEventTags {
-	return s.Tags
+// AllValues returns all GroupingRuleType values.
+func (GroupingRuleType) AllValues() []GroupingRuleType {
+	return []GroupingRuleType{
+		GroupingRuleTypeFingerprint,
+		GroupingRuleTypeStacktrace,
+	}
 }
 
-// GetServerName returns the value of ServerName.
-func (s *IssueEvent) GetServerName() OptString {
-	return s.ServerName
+// MarshalText implements encoding.TextMarshaler.
+func (s GroupingRuleType) MarshalText() ([]byte, error) {
+	switch s {
+	case GroupingRuleTypeFingerprint:
+		return []byte(s), nil
+	case GroupingRuleTypeStacktrace:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetEnvironment returns the value of Environment.
-func (s *IssueEvent) GetEnvironment() OptString {
-	return s.Environment
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GroupingRuleType) UnmarshalText(data []byte) error {
+	switch GroupingRuleType(data) {
+	case GroupingRuleTypeFingerprint:
+		*s = GroupingRuleTypeFingerprint
+		return nil
+	case GroupingRuleTypeStacktrace:
+		*s = GroupingRuleTypeStacktrace
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetRelease returns the value of Release.
-func (s *IssueEvent) GetRelease() OptString {
-	return s.Release
-}
+// Ref: #/components/schemas/GroupingStrategy
+type GroupingStrategy string
 
-// GetExceptionType returns the value of ExceptionType.
-func (s *IssueEvent) GetExceptionType() OptNilString {
-	return s.ExceptionType
-}
+const (
+	GroupingStrategyLegacy       GroupingStrategy = "legacy"
+	GroupingStrategyStacktraceV1 GroupingStrategy = "stacktrace:v1"
+)
 
-// GetExceptionValue returns the value of ExceptionValue.
-func (s *IssueEvent) GetExceptionValue() OptNilString {
-	return s.ExceptionValue
+// AllValues returns all GroupingStrategy values.
+func (GroupingStrategy) AllValues() []GroupingStrategy {
+	return []GroupingStrategy{
+		GroupingStrategyLegacy,
+		GroupingStrategyStacktraceV1,
+	}
 }
 
-// GetExceptionStacktrace returns the value of ExceptionStacktrace.
-func (s *IssueEvent) GetExceptionStacktrace() OptNilString {
-	return s.ExceptionStacktrace
+// MarshalText implements encoding.TextMarshaler.
+func (s GroupingStrategy) MarshalText() ([]byte, error) {
+	switch s {
+	case GroupingStrategyLegacy:
+		return []byte(s), nil
+	case GroupingStrategyStacktraceV1:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetRequestURL returns the value of RequestURL.
-func (s *IssueEvent) GetRequestURL() OptNilString {
-	return s.RequestURL
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GroupingStrategy) UnmarshalText(data []byte) error {
+	switch GroupingStrategy(data) {
+	case GroupingStrategyLegacy:
+		*s = GroupingStrategyLegacy
+		return nil
+	case GroupingStrategyStacktraceV1:
+		*s = GroupingStrategyStacktraceV1
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetRequestMethod returns the value of RequestMethod.
-func (s *IssueEvent) GetRequestMethod() OptNilString {
-	return s.RequestMethod
+// Ref: #/components/schemas/InboundFilters
+type InboundFilters struct {
+	ProjectID uint `json:"project_id"`
+	// Origins browser events are accepted from, e.g. "https://*.example.com". Empty allows all.
+	AllowedOrigins       []string `json:"allowed_origins"`
+	FilterWebCrawlers    bool     `json:"filter_web_crawlers"`
+	FilterLegacyBrowsers bool     `json:"filter_legacy_browsers"`
+	FilterLocalhost      bool     `json:"filter_localhost"`
+	// IP addresses and CIDR ranges of the clients to drop data from.
+	BlockedIPRanges []string `json:"blocked_ip_ranges"`
+	// Glob patterns of the error messages to drop.
+	ErrorMessages []string `json:"error_messages"`
+	// Glob patterns of the releases to drop.
+	Releases []string `json:"releases"`
+	// Not set until the filters are saved.
+	UpdatedAt OptNilDateTime `json:"updated_at"`
 }
 
-// GetRequestQuery returns the value of RequestQuery.
-func (s *IssueEvent) GetRequestQuery() OptNilString {
-	return s.RequestQuery
+// GetProjectID returns the value of ProjectID.
+func (s *InboundFilters) GetProjectID() uint {
+	return s.ProjectID
 }
 
-// GetRequestHeaders returns the value of RequestHeaders.
-func (s *IssueEvent) GetRequestHeaders() OptNilIssueEventRequestHeaders {
-	return s.RequestHeaders
+// GetAllowedOrigins returns the value of AllowedOrigins.
+func (s *InboundFilters) GetAllowedOrigins() []string {
+	return s.AllowedOrigins
 }
 
-// GetRequestData returns the value of RequestData.
-func (s *IssueEvent) GetRequestData() OptNilString {
-	return s.RequestData
+// GetFilterWebCrawlers returns the value of FilterWebCrawlers.
+func (s *InboundFilters) GetFilterWebCrawlers() bool {
+	return s.FilterWebCrawlers
 }
 
-// GetRequestCookies returns the value of RequestCookies.
-func (s *IssueEvent) GetRequestCookies() OptNilString {
-	return s.RequestCookies
+// GetFilterLegacyBrowsers returns the value of FilterLegacyBrowsers.
+func (s *InboundFilters) GetFilterLegacyBrowsers() bool {
+	return s.FilterLegacyBrowsers
 }
 
-// GetRequestIP returns the value of RequestIP.
-func (s *IssueEvent) GetRequestIP() OptNilString {
-	return s.RequestIP
+// GetFilterLocalhost returns the value of FilterLocalhost.
+func (s *InboundFilters) GetFilterLocalhost() bool {
+	return s.FilterLocalhost
 }
 
-// GetUserAgent returns the value of UserAgent.
-func (s *IssueEvent) GetUserAgent() OptNilString {
-	return s.UserAgent
+// GetBlockedIPRanges returns the value of BlockedIPRanges.
+func (s *InboundFilters) GetBlockedIPRanges() []string {
+	return s.BlockedIPRanges
 }
 
-// GetUserID returns the value of UserID.
-func (s *IssueEvent) GetUserID() OptNilString {
-	return s.UserID
+// GetErrorMessages returns the value of ErrorMessages.
+func (s *InboundFilters) GetErrorMessages() []string {
+	return s.ErrorMessages
 }
 
-// GetUserEmail returns the value of UserEmail.
-func (s *IssueEvent) GetUserEmail() OptNilString {
-	return s.UserEmail
+// GetReleases returns the value of Releases.
+func (s *InboundFilters) GetReleases() []string {
+	return s.Releases
 }
 
-// GetRuntimeName returns the value of RuntimeName.
-func (s *IssueEvent) GetRuntimeName() OptNilString {
-	return s.RuntimeName
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *InboundFilters) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
 }
 
-// GetRuntimeVersion returns the value of RuntimeVersion.
-func (s *IssueEvent) GetRuntimeVersion() OptNilString {
-	return s.RuntimeVersion
+// SetProjectID sets the value of ProjectID.
+func (s *InboundFilters) SetProjectID(val uint) {
+	s.ProjectID = val
 }
 
-// GetOsName returns the value of OsName.
-func (s *IssueEvent) GetOsName() OptNilString {
-	return s.OsName
+// SetAllowedOrigins sets the value of AllowedOrigins.
+func (s *InboundFilters) SetAllowedOrigins(val []string) {
+	s.AllowedOrigins = val
 }
 
-// GetOsVersion returns the value of OsVersion.
-func (s *IssueEvent) GetOsVersion() OptNilString {
-	return s.OsVersion
+// SetFilterWebCrawlers sets the value of FilterWebCrawlers.
+func (s *InboundFilters) SetFilterWebCrawlers(val bool) {
+	s.FilterWebCrawlers = val
 }
 
-// GetBrowserName returns the value of BrowserName.
-func (s *IssueEvent) GetBrowserName() OptNilString {
-	return s.BrowserName
+// SetFilterLegacyBrowsers sets the value of FilterLegacyBrowsers.
+func (s *InboundFilters) SetFilterLegacyBrowsers(val bool) {
+	s.FilterLegacyBrowsers = val
 }
 
-// GetBrowserVersion returns the value of BrowserVersion.
-func (s *IssueEvent) GetBrowserVersion() OptNilString {
-	return s.BrowserVersion
+// SetFilterLocalhost sets the value of FilterLocalhost.
+func (s *InboundFilters) SetFilterLocalhost(val bool) {
+	s.FilterLocalhost = val
 }
 
-// GetDeviceArch returns the value of DeviceArch.
-func (s *IssueEvent) GetDeviceArch() OptNilString {
-	return s.DeviceArch
+// SetBlockedIPRanges sets the value of BlockedIPRanges.
+func (s *InboundFilters) SetBlockedIPRanges(val []string) {
+	s.BlockedIPRanges = val
 }
 
-// SetEventID sets the value of EventID.
-func (s *IssueEvent) SetEventID(val string) {
-	s.EventID = val
+// SetErrorMessages sets the value of ErrorMessages.
+func (s *InboundFilters) SetErrorMessages(val []string) {
+	s.ErrorMessages = val
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *IssueEvent) SetTimestamp(val time.Time) {
-	s.Timestamp = val
+// SetReleases sets the value of Releases.
+func (s *InboundFilters) SetReleases(val []string) {
+	s.Releases = val
 }
 
-// SetGroupHash sets the value of GroupHash.
-func (s *IssueEvent) SetGroupHash(val OptString) {
-	s.GroupHash = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *InboundFilters) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *IssueEvent) SetProjectID(val uint) {
-	s.ProjectID = val
+func (*InboundFilters) getProjectInboundFiltersRes()    {}
+func (*InboundFilters) updateProjectInboundFiltersRes() {}
+
+// Ref: #/components/schemas/Issue
+type Issue struct {
+	ID             uint        `json:"id"`
+	ProjectID      uint        `json:"project_id"`
+	Source         IssueSource `json:"source"`
+	Status         IssueStatus `json:"status"`
+	ProjectName    string      `json:"project_name"`
+	Title          string      `json:"title"`
+	Message        string      `json:"message"`
+	Level          IssueLevel  `json:"level"`
+	Platform       string      `json:"platform"`
+	Count          uint        `json:"count"`
+	FirstSeen      time.Time   `json:"first_seen"`
+	LastSeen       time.Time   `json:"last_seen"`
+	ResolvedAt     OptDateTime `json:"resolved_at"`
+	ResolvedBy     OptString   `json:"resolved_by"`
+	AssignedUserID OptUint     `json:"assigned_user_id"`
+	AssignedTeamID OptUint     `json:"assigned_team_id"`
+	// Username of the assigned user or name of the assigned team.
+	AssigneeName OptString `json:"assignee_name"`
+	// The ignored issue came back because one of its ignore conditions was met.
+	Escalating OptBool `json:"escalating"`
+	// Release the issue is resolved in, events of older releases don't reactivate it.
+	// For the next release it's the latest release at the resolve time.
+	ResolvedInRelease OptString `json:"resolved_in_release"`
+	// The issue is resolved in the release after resolved_in_release.
+	ResolvedInNextRelease OptBool `json:"resolved_in_next_release"`
 }
 
-// SetLevel sets the value of Level.
-func (s *IssueEvent) SetLevel(val IssueLevel) {
-	s.Level = val
+// GetID returns the value of ID.
+func (s *Issue) GetID() uint {
+	return s.ID
 }
 
-// SetSource sets the value of Source.
-func (s *IssueEvent) SetSource(val IssueSource) {
-	s.Source = val
+// GetProjectID returns the value of ProjectID.
+func (s *Issue) GetProjectID() uint {
+	return s.ProjectID
 }
 
-// SetPlatform sets the value of Platform.
-func (s *IssueEvent) SetPlatform(val string) {
-	s.Platform = val
+// GetSource returns the value of Source.
+func (s *Issue) GetSource() IssueSource {
+	return s.Source
 }
 
-// SetMessage sets the value of Message.
-func (s *IssueEvent) SetMessage(val string) {
-	s.Message = val
+// GetStatus returns the value of Status.
+func (s *Issue) GetStatus() IssueStatus {
+	return s.Status
 }
 
-// SetPayload sets the value of Payload.
-func (s *IssueEvent) SetPayload(val OptIssueEventPayload) {
-	s.Payload = val
+// GetProjectName returns the value of ProjectName.
+func (s *Issue) GetProjectName() string {
+	return s.ProjectName
 }
 
-// SetTags sets the value of Tags.
-func (s *IssueEvent) SetTags(val OptIssueEventTags) {
-	s.Tags = val
+// GetTitle returns the value of Title.
+func (s *Issue) GetTitle() string {
+	return s.Title
 }
 
-// SetServerName sets the value of ServerName.
-func (s *IssueEvent) SetServerName(val OptString) {
-	s.ServerName = val
+// GetMessage returns the value of Message.
+func (s *Issue) GetMessage() string {
+	return s.Message
 }
 
-// SetEnvironment sets the value of Environment.
-func (s *IssueEvent) SetEnvironment(val OptString) {
-	s.Environment = val
+// GetLevel returns the value of Level.
+func (s *Issue) GetLevel() IssueLevel {
+	return s.Level
 }
 
-// SetRelease sets the value of Release.
-func (s *IssueEvent) SetRelease(val OptString) {
-	s.Release = val
+// GetPlatform returns the value of Platform.
+func (s *Issue) GetPlatform() string {
+	return s.Platform
 }
 
-// SetExceptionType sets the value of ExceptionType.
-func (s *IssueEvent) SetExceptionType(val OptNilString) {
-	s.ExceptionType = val
+// GetCount returns the value of Count.
+func (s *Issue) GetCount() uint {
+	return s.Count
 }
 
-// SetExceptionValue sets the value of ExceptionValue.
-func (s *IssueEvent) SetExceptionValue(val OptNilString) {
-	s.ExceptionValue = val
+// GetFirstSeen returns the value of FirstSeen.
+func (s *Issue) GetFirstSeen() time.Time {
+	return s.FirstSeen
 }
 
-// SetExceptionStacktrace sets the value of ExceptionStacktrace.
-func (s *IssueEvent) SetExceptionStacktrace(val OptNilString) {
-	s.ExceptionStacktrace = val
+// GetLastSeen returns the value of LastSeen.
+func (s *Issue) GetLastSeen() time.Time {
+	return s.LastSeen
 }
 
-// SetRequestURL sets the value of RequestURL.
-func (s *IssueEvent) SetRequestURL(val OptNilString) {
-	s.RequestURL = val
+// GetResolvedAt returns the value of ResolvedAt.
+func (s *Issue) GetResolvedAt() OptDateTime {
+	return s.ResolvedAt
 }
 
-// SetRequestMethod sets the value of RequestMethod.
-func (s *IssueEvent) SetRequestMethod(val OptNilString) {
-	s.RequestMethod = val
+// GetResolvedBy returns the value of ResolvedBy.
+func (s *Issue) GetResolvedBy() OptString {
+	return s.ResolvedBy
 }
 
-// SetRequestQuery sets the value of RequestQuery.
-func (s *IssueEvent) SetRequestQuery(val OptNilString) {
-	s.RequestQuery = val
+// GetAssignedUserID returns the value of AssignedUserID.
+func (s *Issue) GetAssignedUserID() OptUint {
+	return s.AssignedUserID
 }
 
-// SetRequestHeaders sets the value of RequestHeaders.
-func (s *IssueEvent) SetRequestHeaders(val OptNilIssueEventRequestHeaders) {
-	s.RequestHeaders = val
+// GetAssignedTeamID returns the value of AssignedTeamID.
+func (s *Issue) GetAssignedTeamID() OptUint {
+	return s.AssignedTeamID
 }
 
-// SetRequestData sets the value of RequestData.
-func (s *IssueEvent) SetRequestData(val OptNilString) {
-	s.RequestData = val
+// GetAssigneeName returns the value of AssigneeName.
+func (s *Issue) GetAssigneeName() OptString {
+	return s.AssigneeName
 }
 
-// SetRequestCookies sets the value of RequestCookies.
-func (s *IssueEvent) SetRequestCookies(val OptNilString) {
-	s.RequestCookies = val
+// GetEscalating returns the value of Escalating.
+func (s *Issue) GetEscalating() OptBool {
+	return s.Escalating
 }
 
-// SetRequestIP sets the value of RequestIP.
-func (s *IssueEvent) SetRequestIP(val OptNilString) {
-	s.RequestIP = val
+// GetResolvedInRelease returns the value of ResolvedInRelease.
+func (s *Issue) GetResolvedInRelease() OptString {
+	return s.ResolvedInRelease
 }
 
-// SetUserAgent sets the value of UserAgent.
-func (s *IssueEvent) SetUserAgent(val OptNilString) {
-	s.UserAgent = val
+// GetResolvedInNextRelease returns the value of ResolvedInNextRelease.
+func (s *Issue) GetResolvedInNextRelease() OptBool {
+	return s.ResolvedInNextRelease
 }
 
-// SetUserID sets the value of UserID.
-func (s *IssueEvent) SetUserID(val OptNilString) {
-	s.UserID = val
+// SetID sets the value of ID.
+func (s *Issue) SetID(val uint) {
+	s.ID = val
 }
 
-// SetUserEmail sets the value of UserEmail.
-func (s *IssueEvent) SetUserEmail(val OptNilString) {
-	s.UserEmail = val
+// SetProjectID sets the value of ProjectID.
+func (s *Issue) SetProjectID(val uint) {
+	s.ProjectID = val
 }
 
-// SetRuntimeName sets the value of RuntimeName.
-func (s *IssueEvent) SetRuntimeName(val OptNilString) {
-	s.RuntimeName = val
+// SetSource sets the value of Source.
+func (s *Issue) SetSource(val IssueSource) {
+	s.Source = val
 }
 
-// SetRuntimeVersion sets the value of RuntimeVersion.
-func (s *IssueEvent) SetRuntimeVersion(val OptNilString) {
-	s.RuntimeVersion = val
+// SetStatus sets the value of Status.
+func (s *Issue) SetStatus(val IssueStatus) {
+	s.Status = val
 }
 
-// SetOsName sets the value of OsName.
-func (s *IssueEvent) SetOsName(val OptNilString) {
-	s.OsName = val
+// SetProjectName sets the value of ProjectName.
+func (s *Issue) SetProjectName(val string) {
+	s.ProjectName = val
 }
 
-// SetOsVersion sets the value of OsVersion.
-func (s *IssueEvent) SetOsVersion(val OptNilString) {
-	s.OsVersion = val
+// SetTitle sets the value of Title.
+func (s *Issue) SetTitle(val string) {
+	s.Title = val
 }
 
-// SetBrowserName sets the value of BrowserName.
-func (s *IssueEvent) SetBrowserName(val OptNilString) {
-	s.BrowserName = val
+// SetMessage sets the value of Message.
+func (s *Issue) SetMessage(val string) {
+	s.Message = val
 }
 
-// SetBrowserVersion sets the value of BrowserVersion.
-func (s *IssueEvent) SetBrowserVersion(val OptNilString) {
-	s.BrowserVersion = val
+// SetLevel sets the value of Level.
+func (s *Issue) SetLevel(val IssueLevel) {
+	s.Level = val
 }
 
-// SetDeviceArch sets the value of DeviceArch.
-func (s *IssueEvent) SetDeviceArch(val OptNilString) {
-	s.DeviceArch = val
+// SetPlatform sets the value of Platform.
+func (s *Issue) SetPlatform(val string) {
+	s.Platform = val
 }
 
-// Raw JSON payload as received from the client.
-type IssueEventPayload map[string]jx.Raw
+// SetCount sets the value of Count.
+func (s *Issue) SetCount(val uint) {
+	s.Count = val
+}
 
-func (s *IssueEventPayload) init() IssueEventPayload {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// SetFirstSeen sets the value of FirstSeen.
+func (s *Issue) SetFirstSeen(val time.Time) {
+	s.FirstSeen = val
 }
 
-// HTTP headers.
-type IssueEventRequestHeaders map[string]string
+// SetLastSeen sets the value of LastSeen.
+func (s *Issue) SetLastSeen(val time.Time) {
+	s.LastSeen = val
+}
 
-func (s *IssueEventRequestHeaders) init() IssueEventRequestHeaders {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+// SetResolvedAt sets the value of ResolvedAt.
+func (s *Issue) SetResolvedAt(val OptDateTime) {
+	s.ResolvedAt = val
 }
 
-// Key-value tags attached to the event.
-type IssueEventTags map[string]string
+// SetResolvedBy sets the value of ResolvedBy.
+func (s *Issue) SetResolvedBy(val OptString) {
+	s.ResolvedBy = val
+}
 
-func (s *IssueEventTags) init() IssueEventTags {
-	m := *s
-	if m == nil {
-		m = map[string]string{}
-		*s = m
-	}
-	return m
+// SetAssignedUserID sets the value of AssignedUserID.
+func (s *Issue) SetAssignedUserID(val OptUint) {
+	s.AssignedUserID = val
 }
 
-// Issue level.
-// Ref: #/components/schemas/IssueLevel
-type IssueLevel string
+// SetAssignedTeamID sets the value of AssignedTeamID.
+func (s *Issue) SetAssignedTeamID(val OptUint) {
+	s.AssignedTeamID = val
+}
 
-const (
-	IssueLevelFatal     IssueLevel = "fatal"
-	IssueLevelException IssueLevel = "exception"
-	IssueLevelError     IssueLevel = "error"
-	IssueLevelWarning   IssueLevel = "warning"
-	IssueLevelInfo      IssueLevel = "info"
-	IssueLevelDebug     IssueLevel = "debug"
-)
+// SetAssigneeName sets the value of AssigneeName.
+func (s *Issue) SetAssigneeName(val OptString) {
+	s.AssigneeName = val
+}
 
-// AllValues returns all IssueLevel values.
-func (IssueLevel) AllValues() []IssueLevel {
-	return []IssueLevel{
-		IssueLevelFatal,
-		IssueLevelException,
-		IssueLevelError,
-		IssueLevelWarning,
-		IssueLevelInfo,
-		IssueLevelDebug,
-	}
+// SetEscalating sets the value of Escalating.
+func (s *Issue) SetEscalating(val OptBool) {
+	s.Escalating = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s IssueLevel) MarshalText() ([]byte, error) {
-	switch s {
-	case IssueLevelFatal:
-		return []byte(s), nil
-	case IssueLevelException:
-		return []byte(s), nil
-	case IssueLevelError:
-		return []byte(s), nil
-	case IssueLevelWarning:
-		return []byte(s), nil
-	case IssueLevelInfo:
-		return []byte(s), nil
-	case IssueLevelDebug:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetResolvedInRelease sets the value of ResolvedInRelease.
+func (s *Issue) SetResolvedInRelease(val OptString) {
+	s.ResolvedInRelease = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *IssueLevel) UnmarshalText(data []byte) error {
-	switch IssueLevel(data) {
-	case IssueLevelFatal:
-		*s = IssueLevelFatal
-		return nil
-	case IssueLevelException:
-		*s = IssueLevelException
-		return nil
-	case IssueLevelError:
-		*s = IssueLevelError
-		return nil
-	case IssueLevelWarning:
-		*s = IssueLevelWarning
-		return nil
-	case IssueLevelInfo:
-		*s = IssueLevelInfo
-		return nil
-	case IssueLevelDebug:
-		*s = IssueLevelDebug
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetResolvedInNextRelease sets the value of ResolvedInNextRelease.
+func (s *Issue) SetResolvedInNextRelease(val OptBool) {
+	s.ResolvedInNextRelease = val
 }
 
-// Ref: #/components/schemas/IssueResponse
-type IssueResponse struct {
-	Source IssueSource  `json:"source"`
-	Issue  Issue        `json:"issue"`
-	Events []IssueEvent `json:"events"`
+// Ref: #/components/schemas/IssueActivity
+type IssueActivity struct {
+	ID   uint              `json:"id"`
+	Type IssueActivityType `json:"type"`
+	// User who did it, empty for activities of the system.
+	UserID         OptUint        `json:"user_id"`
+	Username       OptString      `json:"username"`
+	CreatedAt      time.Time      `json:"created_at"`
+	Status         OptIssueStatus `json:"status"`
+	AssignedUserID OptUint        `json:"assigned_user_id"`
+	AssignedTeamID OptUint        `json:"assigned_team_id"`
+	AssigneeName   OptString      `json:"assignee_name"`
+	// Issues merged into this issue.
+	IssueIds []uint `json:"issue_ids"`
+	// Fingerprints unmerged from this issue.
+	Fingerprints []string `json:"fingerprints"`
+	CommentID    OptUint  `json:"comment_id"`
+	// Empty when the comment was deleted.
+	CommentBody OptString `json:"comment_body"`
 }
 
-// GetSource returns the value of Source.
-func (s *IssueResponse) GetSource() IssueSource {
-	return s.Source
+// GetID returns the value of ID.
+func (s *IssueActivity) GetID() uint {
+	return s.ID
 }
 
-// GetIssue returns the value of Issue.
-func (s *IssueResponse) GetIssue() Issue {
-	return s.Issue
+// GetType returns the value of Type.
+func (s *IssueActivity) GetType() IssueActivityType {
+	return s.Type
 }
 
-// GetEvents returns the value of Events.
-func (s *IssueResponse) GetEvents() []IssueEvent {
-	return s.Events
+// GetUserID returns the value of UserID.
+func (s *IssueActivity) GetUserID() OptUint {
+	return s.UserID
 }
 
-// SetSource sets the value of Source.
-func (s *IssueResponse) SetSource(val IssueSource) {
-	s.Source = val
+// GetUsername returns the value of Username.
+func (s *IssueActivity) GetUsername() OptString {
+	return s.Username
 }
 
-// SetIssue sets the value of Issue.
-func (s *IssueResponse) SetIssue(val Issue) {
-	s.Issue = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *IssueActivity) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetEvents sets the value of Events.
-func (s *IssueResponse) SetEvents(val []IssueEvent) {
-	s.Events = val
+// GetStatus returns the value of Status.
+func (s *IssueActivity) GetStatus() OptIssueStatus {
+	return s.Status
 }
 
-func (*IssueResponse) getIssueRes() {}
+// GetAssignedUserID returns the value of AssignedUserID.
+func (s *IssueActivity) GetAssignedUserID() OptUint {
+	return s.AssignedUserID
+}
 
-// Column to sort issues by.
-// Ref: #/components/schemas/IssueSortColumn
-type IssueSortColumn string
+// GetAssignedTeamID returns the value of AssignedTeamID.
+func (s *IssueActivity) GetAssignedTeamID() OptUint {
+	return s.AssignedTeamID
+}
+
+// GetAssigneeName returns the value of AssigneeName.
+func (s *IssueActivity) GetAssigneeName() OptString {
+	return s.AssigneeName
+}
+
+// GetIssueIds returns the value of IssueIds.
+func (s *IssueActivity) GetIssueIds() []uint {
+	return s.IssueIds
+}
+
+// GetFingerprints returns the value of Fingerprints.
+func (s *IssueActivity) GetFingerprints() []string {
+	return s.Fingerprints
+}
+
+// GetCommentID returns the value of CommentID.
+func (s *IssueActivity) GetCommentID() OptUint {
+	return s.CommentID
+}
+
+// GetCommentBody returns the value of CommentBody.
+func (s *IssueActivity) GetCommentBody() OptString {
+	return s.CommentBody
+}
+
+// SetID sets the value of ID.
+func (s *IssueActivity) SetID(val uint) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *IssueActivity) SetType(val IssueActivityType) {
+	s.Type = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *IssueActivity) SetUserID(val OptUint) {
+	s.UserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *IssueActivity) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *IssueActivity) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetStatus sets the value of Status.
+func (s *IssueActivity) SetStatus(val OptIssueStatus) {
+	s.Status = val
+}
+
+// SetAssignedUserID sets the value of AssignedUserID.
+func (s *IssueActivity) SetAssignedUserID(val OptUint) {
+	s.AssignedUserID = val
+}
+
+// SetAssignedTeamID sets the value of AssignedTeamID.
+func (s *IssueActivity) SetAssignedTeamID(val OptUint) {
+	s.AssignedTeamID = val
+}
+
+// SetAssigneeName sets the value of AssigneeName.
+func (s *IssueActivity) SetAssigneeName(val OptString) {
+	s.AssigneeName = val
+}
+
+// SetIssueIds sets the value of IssueIds.
+func (s *IssueActivity) SetIssueIds(val []uint) {
+	s.IssueIds = val
+}
+
+// SetFingerprints sets the value of Fingerprints.
+func (s *IssueActivity) SetFingerprints(val []string) {
+	s.Fingerprints = val
+}
+
+// SetCommentID sets the value of CommentID.
+func (s *IssueActivity) SetCommentID(val OptUint) {
+	s.CommentID = val
+}
+
+// SetCommentBody sets the value of CommentBody.
+func (s *IssueActivity) SetCommentBody(val OptString) {
+	s.CommentBody = val
+}
+
+// Ref: #/components/schemas/IssueActivityListResponse
+type IssueActivityListResponse struct {
+	Activities []IssueActivity `json:"activities"`
+	Total      uint            `json:"total"`
+	Page       uint            `json:"page"`
+	PerPage    uint            `json:"per_page"`
+}
+
+// GetActivities returns the value of Activities.
+func (s *IssueActivityListResponse) GetActivities() []IssueActivity {
+	return s.Activities
+}
+
+// GetTotal returns the value of Total.
+func (s *IssueActivityListResponse) GetTotal() uint {
+	return s.Total
+}
+
+// GetPage returns the value of Page.
+func (s *IssueActivityListResponse) GetPage() uint {
+	return s.Page
+}
+
+// GetPerPage returns the value of PerPage.
+func (s *IssueActivityListResponse) GetPerPage() uint {
+	return s.PerPage
+}
+
+// SetActivities sets the value of Activities.
+func (s *IssueActivityListResponse) SetActivities(val []IssueActivity) {
+	s.Activities = val
+}
+
+// SetTotal sets the value of Total.
+func (s *IssueActivityListResponse) SetTotal(val uint) {
+	s.Total = val
+}
+
+// SetPage sets the value of Page.
+func (s *IssueActivityListResponse) SetPage(val uint) {
+	s.Page = val
+}
+
+// SetPerPage sets the value of PerPage.
+func (s *IssueActivityListResponse) SetPerPage(val uint) {
+	s.PerPage = val
+}
+
+func (*IssueActivityListResponse) listProjectIssueActivityRes() {}
+
+// Ref: #/components/schemas/IssueActivityType
+type IssueActivityType string
 
 const (
-	IssueSortColumnTotalEvents IssueSortColumn = "total_events"
-	IssueSortColumnFirstSeen   IssueSortColumn = "first_seen"
-	IssueSortColumnLastSeen    IssueSortColumn = "last_seen"
+	IssueActivityTypeFirstSeen     IssueActivityType = "first_seen"
+	IssueActivityTypeRegression    IssueActivityType = "regression"
+	IssueActivityTypeStatusChanged IssueActivityType = "status_changed"
+	IssueActivityTypeAssigned      IssueActivityType = "assigned"
+	IssueActivityTypeMerged        IssueActivityType = "merged"
+	IssueActivityTypeUnmerged      IssueActivityType = "unmerged"
+	IssueActivityTypeCommented     IssueActivityType = "commented"
+	IssueActivityTypeEscalating    IssueActivityType = "escalating"
 )
 
-// AllValues returns all IssueSortColumn values.
-func (IssueSortColumn) AllValues() []IssueSortColumn {
-	return []IssueSortColumn{
-		IssueSortColumnTotalEvents,
-		IssueSortColumnFirstSeen,
-		IssueSortColumnLastSeen,
+// AllValues returns all IssueActivityType values.
+func (IssueActivityType) AllValues() []IssueActivityType {
+	return []IssueActivityType{
+		IssueActivityTypeFirstSeen,
+		IssueActivityTypeRegression,
+		IssueActivityTypeStatusChanged,
+		IssueActivityTypeAssigned,
+		IssueActivityTypeMerged,
+		IssueActivityTypeUnmerged,
+		IssueActivityTypeCommented,
+		IssueActivityTypeEscalating,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s IssueSortColumn) MarshalText() ([]byte, error) {
+func (s IssueActivityType) MarshalText() ([]byte, error) {
 	switch s {
-	case IssueSortColumnTotalEvents:
+	case IssueActivityTypeFirstSeen:
 		return []byte(s), nil
-	case IssueSortColumnFirstSeen:
+	case IssueActivityTypeRegression:
 		return []byte(s), nil
-	case IssueSortColumnLastSeen:
+	case IssueActivityTypeStatusChanged:
+		return []byte(s), nil
+	case IssueActivityTypeAssigned:
+		return []byte(s), nil
+	case IssueActivityTypeMerged:
+		return []byte(s), nil
+	case IssueActivityTypeUnmerged:
+		return []byte(s), nil
+	case IssueActivityTypeCommented:
+		return []byte(s), nil
+	case IssueActivityTypeEscalating:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -2097,45 +3249,63 @@ func (s IssueSortColumn) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *IssueSortColumn) UnmarshalText(data []byte) error {
-	switch IssueSortColumn(data) {
-	case IssueSortColumnTotalEvents:
-		*s = IssueSortColumnTotalEvents
+func (s *IssueActivityType) UnmarshalText(data []byte) error {
+	switch IssueActivityType(data) {
+	case IssueActivityTypeFirstSeen:
+		*s = IssueActivityTypeFirstSeen
 		return nil
-	case IssueSortColumnFirstSeen:
-		*s = IssueSortColumnFirstSeen
+	case IssueActivityTypeRegression:
+		*s = IssueActivityTypeRegression
 		return nil
-	case IssueSortColumnLastSeen:
-		*s = IssueSortColumnLastSeen
+	case IssueActivityTypeStatusChanged:
+		*s = IssueActivityTypeStatusChanged
+		return nil
+	case IssueActivityTypeAssigned:
+		*s = IssueActivityTypeAssigned
+		return nil
+	case IssueActivityTypeMerged:
+		*s = IssueActivityTypeMerged
+		return nil
+	case IssueActivityTypeUnmerged:
+		*s = IssueActivityTypeUnmerged
+		return nil
+	case IssueActivityTypeCommented:
+		*s = IssueActivityTypeCommented
+		return nil
+	case IssueActivityTypeEscalating:
+		*s = IssueActivityTypeEscalating
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Identifies where the issue comes from.
-// Ref: #/components/schemas/IssueSource
-type IssueSource string
+// Ref: #/components/schemas/IssueAssignedFilter
+type IssueAssignedFilter string
 
 const (
-	IssueSourceEvent     IssueSource = "event"
-	IssueSourceException IssueSource = "exception"
+	IssueAssignedFilterMe         IssueAssignedFilter = "me"
+	IssueAssignedFilterMyTeams    IssueAssignedFilter = "my_teams"
+	IssueAssignedFilterUnassigned IssueAssignedFilter = "unassigned"
 )
 
-// AllValues returns all IssueSource values.
-func (IssueSource) AllValues() []IssueSource {
-	return []IssueSource{
-		IssueSourceEvent,
-		IssueSourceException,
+// AllValues returns all IssueAssignedFilter values.
+func (IssueAssignedFilter) AllValues() []IssueAssignedFilter {
+	return []IssueAssignedFilter{
+		IssueAssignedFilterMe,
+		IssueAssignedFilterMyTeams,
+		IssueAssignedFilterUnassigned,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s IssueSource) MarshalText() ([]byte, error) {
+func (s IssueAssignedFilter) MarshalText() ([]byte, error) {
 	switch s {
-	case IssueSourceEvent:
+	case IssueAssignedFilterMe:
 		return []byte(s), nil
-	case IssueSourceException:
+	case IssueAssignedFilterMyTeams:
+		return []byte(s), nil
+	case IssueAssignedFilterUnassigned:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -2143,46 +3313,87 @@ func (s IssueSource) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *IssueSource) UnmarshalText(data []byte) error {
-	switch IssueSource(data) {
-	case IssueSourceEvent:
-		*s = IssueSourceEvent
+func (s *IssueAssignedFilter) UnmarshalText(data []byte) error {
+	switch IssueAssignedFilter(data) {
+	case IssueAssignedFilterMe:
+		*s = IssueAssignedFilterMe
 		return nil
-	case IssueSourceException:
-		*s = IssueSourceException
+	case IssueAssignedFilterMyTeams:
+		*s = IssueAssignedFilterMyTeams
+		return nil
+	case IssueAssignedFilterUnassigned:
+		*s = IssueAssignedFilterUnassigned
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Resolved or not.
-// Ref: #/components/schemas/IssueStatus
-type IssueStatus string
+// Either a user or a team may be assigned. Both empty unassigns the issue.
+// Ref: #/components/schemas/IssueAssigneeRequest
+type IssueAssigneeRequest struct {
+	UserID OptNilUint `json:"user_id"`
+	TeamID OptNilUint `json:"team_id"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *IssueAssigneeRequest) GetUserID() OptNilUint {
+	return s.UserID
+}
+
+// GetTeamID returns the value of TeamID.
+func (s *IssueAssigneeRequest) GetTeamID() OptNilUint {
+	return s.TeamID
+}
+
+// SetUserID sets the value of UserID.
+func (s *IssueAssigneeRequest) SetUserID(val OptNilUint) {
+	s.UserID = val
+}
+
+// SetTeamID sets the value of TeamID.
+func (s *IssueAssigneeRequest) SetTeamID(val OptNilUint) {
+	s.TeamID = val
+}
+
+// Ref: #/components/schemas/IssueBulkAction
+type IssueBulkAction string
 
 const (
-	IssueStatusResolved   IssueStatus = "resolved"
-	IssueStatusUnresolved IssueStatus = "unresolved"
-	IssueStatusIgnored    IssueStatus = "ignored"
+	IssueBulkActionResolve   IssueBulkAction = "resolve"
+	IssueBulkActionIgnore    IssueBulkAction = "ignore"
+	IssueBulkActionUnresolve IssueBulkAction = "unresolve"
+	IssueBulkActionAssign    IssueBulkAction = "assign"
+	IssueBulkActionMerge     IssueBulkAction = "merge"
+	IssueBulkActionDelete    IssueBulkAction = "delete"
 )
 
-// AllValues returns all IssueStatus values.
-func (IssueStatus) AllValues() []IssueStatus {
-	return []IssueStatus{
-		IssueStatusResolved,
-		IssueStatusUnresolved,
-		IssueStatusIgnored,
+// AllValues returns all IssueBulkAction values.
+func (IssueBulkAction) AllValues() []IssueBulkAction {
+	return []IssueBulkAction{
+		IssueBulkActionResolve,
+		IssueBulkActionIgnore,
+		IssueBulkActionUnresolve,
+		IssueBulkActionAssign,
+		IssueBulkActionMerge,
+		IssueBulkActionDelete,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s IssueStatus) MarshalText() ([]byte, error) {
+func (s IssueBulkAction) MarshalText() ([]byte, error) {
 	switch s {
-	case IssueStatusResolved:
+	case IssueBulkActionResolve:
 		return []byte(s), nil
-	case IssueStatusUnresolved:
+	case IssueBulkActionIgnore:
 		return []byte(s), nil
-	case IssueStatusIgnored:
+	case IssueBulkActionUnresolve:
+		return []byte(s), nil
+	case IssueBulkActionAssign:
+		return []byte(s), nil
+	case IssueBulkActionMerge:
+		return []byte(s), nil
+	case IssueBulkActionDelete:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -2190,2642 +3401,6304 @@ func (s IssueStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *IssueStatus) UnmarshalText(data []byte) error {
-	switch IssueStatus(data) {
-	case IssueStatusResolved:
-		*s = IssueStatusResolved
+func (s *IssueBulkAction) UnmarshalText(data []byte) error {
+	switch IssueBulkAction(data) {
+	case IssueBulkActionResolve:
+		*s = IssueBulkActionResolve
 		return nil
-	case IssueStatusUnresolved:
-		*s = IssueStatusUnresolved
+	case IssueBulkActionIgnore:
+		*s = IssueBulkActionIgnore
 		return nil
-	case IssueStatusIgnored:
-		*s = IssueStatusIgnored
+	case IssueBulkActionUnresolve:
+		*s = IssueBulkActionUnresolve
+		return nil
+	case IssueBulkActionAssign:
+		*s = IssueBulkActionAssign
+		return nil
+	case IssueBulkActionMerge:
+		*s = IssueBulkActionMerge
+		return nil
+	case IssueBulkActionDelete:
+		*s = IssueBulkActionDelete
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/IssueSummary
-type IssueSummary struct {
-	ID        uint       `json:"id"`
-	ProjectID uint       `json:"project_id"`
-	Title     string     `json:"title"`
-	Level     IssueLevel `json:"level"`
-	Count     uint       `json:"count"`
-	LastSeen  time.Time  `json:"last_seen"`
-}
-
-// GetID returns the value of ID.
-func (s *IssueSummary) GetID() uint {
-	return s.ID
+// Issues of the project matching all the set fields, like in the issues list.
+// Ref: #/components/schemas/IssueBulkFilter
+type IssueBulkFilter struct {
+	// Search query, see the query parameter of the issues list.
+	Query       OptString              `json:"query"`
+	Environment OptString              `json:"environment"`
+	Level       OptIssueLevel          `json:"level"`
+	Status      OptIssueStatus         `json:"status"`
+	Assigned    OptIssueAssignedFilter `json:"assigned"`
 }
 
-// GetProjectID returns the value of ProjectID.
-func (s *IssueSummary) GetProjectID() uint {
-	return s.ProjectID
+// GetQuery returns the value of Query.
+func (s *IssueBulkFilter) GetQuery() OptString {
+	return s.Query
 }
 
-// GetTitle returns the value of Title.
-func (s *IssueSummary) GetTitle() string {
-	return s.Title
+// GetEnvironment returns the value of Environment.
+func (s *IssueBulkFilter) GetEnvironment() OptString {
+	return s.Environment
 }
 
 // GetLevel returns the value of Level.
-func (s *IssueSummary) GetLevel() IssueLevel {
+func (s *IssueBulkFilter) GetLevel() OptIssueLevel {
 	return s.Level
 }
 
-// GetCount returns the value of Count.
-func (s *IssueSummary) GetCount() uint {
-	return s.Count
+// GetStatus returns the value of Status.
+func (s *IssueBulkFilter) GetStatus() OptIssueStatus {
+	return s.Status
 }
 
-// GetLastSeen returns the value of LastSeen.
-func (s *IssueSummary) GetLastSeen() time.Time {
-	return s.LastSeen
+// GetAssigned returns the value of Assigned.
+func (s *IssueBulkFilter) GetAssigned() OptIssueAssignedFilter {
+	return s.Assigned
 }
 
-// SetID sets the value of ID.
-func (s *IssueSummary) SetID(val uint) {
-	s.ID = val
+// SetQuery sets the value of Query.
+func (s *IssueBulkFilter) SetQuery(val OptString) {
+	s.Query = val
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *IssueSummary) SetProjectID(val uint) {
-	s.ProjectID = val
-}
-
-// SetTitle sets the value of Title.
-func (s *IssueSummary) SetTitle(val string) {
-	s.Title = val
+// SetEnvironment sets the value of Environment.
+func (s *IssueBulkFilter) SetEnvironment(val OptString) {
+	s.Environment = val
 }
 
 // SetLevel sets the value of Level.
-func (s *IssueSummary) SetLevel(val IssueLevel) {
+func (s *IssueBulkFilter) SetLevel(val OptIssueLevel) {
 	s.Level = val
 }
 
-// SetCount sets the value of Count.
-func (s *IssueSummary) SetCount(val uint) {
-	s.Count = val
+// SetStatus sets the value of Status.
+func (s *IssueBulkFilter) SetStatus(val OptIssueStatus) {
+	s.Status = val
 }
 
-// SetLastSeen sets the value of LastSeen.
-func (s *IssueSummary) SetLastSeen(val time.Time) {
-	s.LastSeen = val
+// SetAssigned sets the value of Assigned.
+func (s *IssueBulkFilter) SetAssigned(val OptIssueAssignedFilter) {
+	s.Assigned = val
 }
 
-// Ref: #/components/schemas/ListIssueSummariesResponse
-type ListIssueSummariesResponse struct {
-	Issues []IssueSummary `json:"issues"`
+// Either issue_ids or filter selects the issues, at most 1000 of them are changed at once.
+// The delete action by filter needs a filter with at least one condition and confirm set.
+// Ref: #/components/schemas/IssueBulkRequest
+type IssueBulkRequest struct {
+	Action           IssueBulkAction          `json:"action"`
+	IssueIds         []uint                   `json:"issue_ids"`
+	Filter           OptIssueBulkFilter       `json:"filter"`
+	IgnoreConditions OptIssueIgnoreConditions `json:"ignore_conditions"`
+	// Release the issues are resolved in, only allowed for the resolve action.
+	ResolvedInRelease OptString `json:"resolved_in_release"`
+	// Resolve the issues in the next release, only allowed for the resolve action.
+	ResolvedInNextRelease OptBool                 `json:"resolved_in_next_release"`
+	Assignee              OptIssueAssigneeRequest `json:"assignee"`
+	// Issue the others are merged into, required for the merge action.
+	PrimaryIssueID OptUint `json:"primary_issue_id"`
+	// Confirms deleting the issues matching the filter, required for the delete action by filter.
+	Confirm OptBool `json:"confirm"`
 }
 
-// GetIssues returns the value of Issues.
-func (s *ListIssueSummariesResponse) GetIssues() []IssueSummary {
-	return s.Issues
+// GetAction returns the value of Action.
+func (s *IssueBulkRequest) GetAction() IssueBulkAction {
+	return s.Action
 }
 
-// SetIssues sets the value of Issues.
-func (s *ListIssueSummariesResponse) SetIssues(val []IssueSummary) {
-	s.Issues = val
+// GetIssueIds returns the value of IssueIds.
+func (s *IssueBulkRequest) GetIssueIds() []uint {
+	return s.IssueIds
 }
 
-func (*ListIssueSummariesResponse) getRecentIssuesRes() {}
-
-// Ref: #/components/schemas/ListIssuesResponse
-type ListIssuesResponse struct {
-	Issues  []Issue `json:"issues"`
-	Total   uint    `json:"total"`
-	Page    uint    `json:"page"`
-	PerPage uint    `json:"per_page"`
+// GetFilter returns the value of Filter.
+func (s *IssueBulkRequest) GetFilter() OptIssueBulkFilter {
+	return s.Filter
 }
 
-// GetIssues returns the value of Issues.
-func (s *ListIssuesResponse) GetIssues() []Issue {
-	return s.Issues
+// GetIgnoreConditions returns the value of IgnoreConditions.
+func (s *IssueBulkRequest) GetIgnoreConditions() OptIssueIgnoreConditions {
+	return s.IgnoreConditions
 }
 
-// GetTotal returns the value of Total.
-func (s *ListIssuesResponse) GetTotal() uint {
-	return s.Total
+// GetResolvedInRelease returns the value of ResolvedInRelease.
+func (s *IssueBulkRequest) GetResolvedInRelease() OptString {
+	return s.ResolvedInRelease
 }
 
-// GetPage returns the value of Page.
-func (s *ListIssuesResponse) GetPage() uint {
-	return s.Page
+// GetResolvedInNextRelease returns the value of ResolvedInNextRelease.
+func (s *IssueBulkRequest) GetResolvedInNextRelease() OptBool {
+	return s.ResolvedInNextRelease
 }
 
-// GetPerPage returns the value of PerPage.
-func (s *ListIssuesResponse) GetPerPage() uint {
-	return s.PerPage
+// GetAssignee returns the value of Assignee.
+func (s *IssueBulkRequest) GetAssignee() OptIssueAssigneeRequest {
+	return s.Assignee
 }
 
-// SetIssues sets the value of Issues.
-func (s *ListIssuesResponse) SetIssues(val []Issue) {
-	s.Issues = val
+// GetPrimaryIssueID returns the value of PrimaryIssueID.
+func (s *IssueBulkRequest) GetPrimaryIssueID() OptUint {
+	return s.PrimaryIssueID
 }
 
-// SetTotal sets the value of Total.
-func (s *ListIssuesResponse) SetTotal(val uint) {
-	s.Total = val
+// GetConfirm returns the value of Confirm.
+func (s *IssueBulkRequest) GetConfirm() OptBool {
+	return s.Confirm
 }
 
-// SetPage sets the value of Page.
-func (s *ListIssuesResponse) SetPage(val uint) {
-	s.Page = val
+// SetAction sets the value of Action.
+func (s *IssueBulkRequest) SetAction(val IssueBulkAction) {
+	s.Action = val
 }
 
-// SetPerPage sets the value of PerPage.
-func (s *ListIssuesResponse) SetPerPage(val uint) {
-	s.PerPage = val
+// SetIssueIds sets the value of IssueIds.
+func (s *IssueBulkRequest) SetIssueIds(val []uint) {
+	s.IssueIds = val
 }
 
-func (*ListIssuesResponse) listIssuesRes() {}
-
-// Ref: #/components/schemas/ListNotificationRulesResponse
-type ListNotificationRulesResponse struct {
-	NotificationRules []NotificationRule `json:"notification_rules"`
+// SetFilter sets the value of Filter.
+func (s *IssueBulkRequest) SetFilter(val OptIssueBulkFilter) {
+	s.Filter = val
 }
 
-// GetNotificationRules returns the value of NotificationRules.
-func (s *ListNotificationRulesResponse) GetNotificationRules() []NotificationRule {
-	return s.NotificationRules
+// SetIgnoreConditions sets the value of IgnoreConditions.
+func (s *IssueBulkRequest) SetIgnoreConditions(val OptIssueIgnoreConditions) {
+	s.IgnoreConditions = val
 }
 
-// SetNotificationRules sets the value of NotificationRules.
-func (s *ListNotificationRulesResponse) SetNotificationRules(val []NotificationRule) {
-	s.NotificationRules = val
+// SetResolvedInRelease sets the value of ResolvedInRelease.
+func (s *IssueBulkRequest) SetResolvedInRelease(val OptString) {
+	s.ResolvedInRelease = val
 }
 
-func (*ListNotificationRulesResponse) listNotificationRulesRes() {}
-
-// Ref: #/components/schemas/ListNotificationSettingsResponse
-type ListNotificationSettingsResponse struct {
-	NotificationSettings []NotificationSetting `json:"notification_settings"`
+// SetResolvedInNextRelease sets the value of ResolvedInNextRelease.
+func (s *IssueBulkRequest) SetResolvedInNextRelease(val OptBool) {
+	s.ResolvedInNextRelease = val
 }
 
-// GetNotificationSettings returns the value of NotificationSettings.
-func (s *ListNotificationSettingsResponse) GetNotificationSettings() []NotificationSetting {
-	return s.NotificationSettings
+// SetAssignee sets the value of Assignee.
+func (s *IssueBulkRequest) SetAssignee(val OptIssueAssigneeRequest) {
+	s.Assignee = val
 }
 
-// SetNotificationSettings sets the value of NotificationSettings.
-func (s *ListNotificationSettingsResponse) SetNotificationSettings(val []NotificationSetting) {
-	s.NotificationSettings = val
+// SetPrimaryIssueID sets the value of PrimaryIssueID.
+func (s *IssueBulkRequest) SetPrimaryIssueID(val OptUint) {
+	s.PrimaryIssueID = val
 }
 
-func (*ListNotificationSettingsResponse) listNotificationSettingsRes() {}
-
-type ListProjectsResponse []Project
+// SetConfirm sets the value of Confirm.
+func (s *IssueBulkRequest) SetConfirm(val OptBool) {
+	s.Confirm = val
+}
 
-func (*ListProjectsResponse) listProjectsRes()       {}
-func (*ListProjectsResponse) recentProjectsListRes() {}
+// Ref: #/components/schemas/IssueBulkResponse
+type IssueBulkResponse struct {
+	Changed []uint `json:"changed"`
+	// Issues already in the requested state.
+	Unchanged []uint `json:"unchanged"`
+	// Issues the user can't manage.
+	Denied   []uint `json:"denied"`
+	NotFound []uint `json:"not_found"`
+	// More issues match the filter than were changed, the request can be repeated for them.
+	HasMore bool `json:"has_more"`
+}
 
-type ListTeamsResponse []Team
+// GetChanged returns the value of Changed.
+func (s *IssueBulkResponse) GetChanged() []uint {
+	return s.Changed
+}
 
-func (*ListTeamsResponse) listTeamsRes() {}
+// GetUnchanged returns the value of Unchanged.
+func (s *IssueBulkResponse) GetUnchanged() []uint {
+	return s.Unchanged
+}
 
-type ListUsersResponse []User
+// GetDenied returns the value of Denied.
+func (s *IssueBulkResponse) GetDenied() []uint {
+	return s.Denied
+}
 
-func (*ListUsersResponse) listUsersForTeamRes() {}
-func (*ListUsersResponse) listUsersRes()        {}
+// GetNotFound returns the value of NotFound.
+func (s *IssueBulkResponse) GetNotFound() []uint {
+	return s.NotFound
+}
 
-// Ref: #/components/schemas/LoginRequest
-type LoginRequest struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+// GetHasMore returns the value of HasMore.
+func (s *IssueBulkResponse) GetHasMore() bool {
+	return s.HasMore
 }
 
-// GetUsername returns the value of Username.
-func (s *LoginRequest) GetUsername() string {
-	return s.Username
+// SetChanged sets the value of Changed.
+func (s *IssueBulkResponse) SetChanged(val []uint) {
+	s.Changed = val
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginRequest) GetPassword() string {
-	return s.Password
+// SetUnchanged sets the value of Unchanged.
+func (s *IssueBulkResponse) SetUnchanged(val []uint) {
+	s.Unchanged = val
 }
 
-// SetUsername sets the value of Username.
-func (s *LoginRequest) SetUsername(val string) {
-	s.Username = val
+// SetDenied sets the value of Denied.
+func (s *IssueBulkResponse) SetDenied(val []uint) {
+	s.Denied = val
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginRequest) SetPassword(val string) {
-	s.Password = val
+// SetNotFound sets the value of NotFound.
+func (s *IssueBulkResponse) SetNotFound(val []uint) {
+	s.NotFound = val
 }
 
-// Ref: #/components/schemas/LoginResponse
-type LoginResponse struct {
-	AccessToken   string `json:"access_token"`
-	RefreshToken  string `json:"refresh_token"`
-	ExpiresIn     int    `json:"expires_in"`
-	IsTmpPassword bool   `json:"is_tmp_password"`
+// SetHasMore sets the value of HasMore.
+func (s *IssueBulkResponse) SetHasMore(val bool) {
+	s.HasMore = val
 }
 
-// GetAccessToken returns the value of AccessToken.
-func (s *LoginResponse) GetAccessToken() string {
-	return s.AccessToken
+func (*IssueBulkResponse) bulkUpdateIssuesRes() {}
+
+// Ref: #/components/schemas/IssueComment
+type IssueComment struct {
+	ID      uint `json:"id"`
+	IssueID uint `json:"issue_id"`
+	// Comment this comment replies to.
+	ParentID  OptUint   `json:"parent_id"`
+	UserID    OptUint   `json:"user_id"`
+	Username  OptString `json:"username"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *LoginResponse) GetRefreshToken() string {
-	return s.RefreshToken
+// GetID returns the value of ID.
+func (s *IssueComment) GetID() uint {
+	return s.ID
 }
 
-// GetExpiresIn returns the value of ExpiresIn.
-func (s *LoginResponse) GetExpiresIn() int {
-	return s.ExpiresIn
+// GetIssueID returns the value of IssueID.
+func (s *IssueComment) GetIssueID() uint {
+	return s.IssueID
 }
 
-// GetIsTmpPassword returns the value of IsTmpPassword.
-func (s *LoginResponse) GetIsTmpPassword() bool {
-	return s.IsTmpPassword
+// GetParentID returns the value of ParentID.
+func (s *IssueComment) GetParentID() OptUint {
+	return s.ParentID
 }
 
-// SetAccessToken sets the value of AccessToken.
-func (s *LoginResponse) SetAccessToken(val string) {
-	s.AccessToken = val
+// GetUserID returns the value of UserID.
+func (s *IssueComment) GetUserID() OptUint {
+	return s.UserID
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *LoginResponse) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// GetUsername returns the value of Username.
+func (s *IssueComment) GetUsername() OptString {
+	return s.Username
 }
 
-// SetExpiresIn sets the value of ExpiresIn.
-func (s *LoginResponse) SetExpiresIn(val int) {
-	s.ExpiresIn = val
+// GetBody returns the value of Body.
+func (s *IssueComment) GetBody() string {
+	return s.Body
 }
 
-// SetIsTmpPassword sets the value of IsTmpPassword.
-func (s *LoginResponse) SetIsTmpPassword(val bool) {
-	s.IsTmpPassword = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *IssueComment) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-func (*LoginResponse) loginRes() {}
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *IssueComment) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
 
-// MarkAllNotificationsAsReadNoContent is response for MarkAllNotificationsAsRead operation.
-type MarkAllNotificationsAsReadNoContent struct{}
+// SetID sets the value of ID.
+func (s *IssueComment) SetID(val uint) {
+	s.ID = val
+}
 
-func (*MarkAllNotificationsAsReadNoContent) markAllNotificationsAsReadRes() {}
+// SetIssueID sets the value of IssueID.
+func (s *IssueComment) SetIssueID(val uint) {
+	s.IssueID = val
+}
 
-// MarkNotificationAsReadNoContent is response for MarkNotificationAsRead operation.
-type MarkNotificationAsReadNoContent struct{}
+// SetParentID sets the value of ParentID.
+func (s *IssueComment) SetParentID(val OptUint) {
+	s.ParentID = val
+}
 
-func (*MarkNotificationAsReadNoContent) markNotificationAsReadRes() {}
+// SetUserID sets the value of UserID.
+func (s *IssueComment) SetUserID(val OptUint) {
+	s.UserID = val
+}
 
-// Type of notification channel (email, mattermost, slack, etc.).
-// Ref: #/components/schemas/NotificationChannelType
-type NotificationChannelType string
+// SetUsername sets the value of Username.
+func (s *IssueComment) SetUsername(val OptString) {
+	s.Username = val
+}
 
-const (
-	NotificationChannelTypeEmail      NotificationChannelType = "email"
-	NotificationChannelTypeTelegram   NotificationChannelType = "telegram"
-	NotificationChannelTypeSlack      NotificationChannelType = "slack"
-	NotificationChannelTypeMattermost NotificationChannelType = "mattermost"
-	NotificationChannelTypeWebhook    NotificationChannelType = "webhook"
-	NotificationChannelTypePachca     NotificationChannelType = "pachca"
-)
+// SetBody sets the value of Body.
+func (s *IssueComment) SetBody(val string) {
+	s.Body = val
+}
 
-// AllValues returns all NotificationChannelType values.
-func (NotificationChannelType) AllValues() []NotificationChannelType {
-	return []NotificationChannelType{
-		NotificationChannelTypeEmail,
-		NotificationChannelTypeTelegram,
-		NotificationChannelTypeSlack,
-		NotificationChannelTypeMattermost,
-		NotificationChannelTypeWebhook,
-		NotificationChannelTypePachca,
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *IssueComment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s NotificationChannelType) MarshalText() ([]byte, error) {
-	switch s {
-	case NotificationChannelTypeEmail:
-		return []byte(s), nil
-	case NotificationChannelTypeTelegram:
-		return []byte(s), nil
-	case NotificationChannelTypeSlack:
-		return []byte(s), nil
-	case NotificationChannelTypeMattermost:
-		return []byte(s), nil
-	case NotificationChannelTypeWebhook:
-		return []byte(s), nil
-	case NotificationChannelTypePachca:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *IssueComment) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *NotificationChannelType) UnmarshalText(data []byte) error {
-	switch NotificationChannelType(data) {
-	case NotificationChannelTypeEmail:
-		*s = NotificationChannelTypeEmail
-		return nil
-	case NotificationChannelTypeTelegram:
-		*s = NotificationChannelTypeTelegram
-		return nil
-	case NotificationChannelTypeSlack:
-		*s = NotificationChannelTypeSlack
-		return nil
-	case NotificationChannelTypeMattermost:
-		*s = NotificationChannelTypeMattermost
-		return nil
-	case NotificationChannelTypeWebhook:
-		*s = NotificationChannelTypeWebhook
-		return nil
-	case NotificationChannelTypePachca:
-		*s = NotificationChannelTypePachca
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+func (*IssueComment) createProjectIssueCommentRes() {}
+
+// Ref: #/components/schemas/IssueCommentsResponse
+type IssueCommentsResponse struct {
+	Comments []IssueComment `json:"comments"`
 }
 
-// Ref: #/components/schemas/NotificationRule
-type NotificationRule struct {
-	ID                    uint `json:"id"`
-	NotificationSettingID uint `json:"notification_setting_id"`
-	// Level of event to trigger notification (error, warning, info, etc.).
-	EventLevel OptNilString `json:"event_level"`
-	// Specific error fingerprint to trigger notification.
-	Fingerprint OptNilString `json:"fingerprint"`
-	// Trigger only for new errors.
-	IsNewError OptNilBool `json:"is_new_error"`
-	// Trigger only for regressions (resolved -> unresolved).
-	IsRegression OptNilBool `json:"is_regression"`
-	CreatedAt    time.Time  `json:"created_at"`
+// GetComments returns the value of Comments.
+func (s *IssueCommentsResponse) GetComments() []IssueComment {
+	return s.Comments
 }
 
-// GetID returns the value of ID.
-func (s *NotificationRule) GetID() uint {
-	return s.ID
+// SetComments sets the value of Comments.
+func (s *IssueCommentsResponse) SetComments(val []IssueComment) {
+	s.Comments = val
 }
 
-// GetNotificationSettingID returns the value of NotificationSettingID.
-func (s *NotificationRule) GetNotificationSettingID() uint {
-	return s.NotificationSettingID
-}
+func (*IssueCommentsResponse) listProjectIssueCommentsRes() {}
 
-// GetEventLevel returns the value of EventLevel.
-func (s *NotificationRule) GetEventLevel() OptNilString {
-	return s.EventLevel
+// Full representation of domain.Event.
+// Ref: #/components/schemas/IssueEvent
+type IssueEvent struct {
+	// Unique identifier of the event (domain.Event.ID).
+	EventID string `json:"event_id"`
+	// Time when the event occurred.
+	Timestamp time.Time `json:"timestamp"`
+	// Hash used for grouping events.
+	GroupHash OptString `json:"group_hash"`
+	// Identifier of the project that owns the event.
+	ProjectID uint        `json:"project_id"`
+	Level     IssueLevel  `json:"level"`
+	Source    IssueSource `json:"source"`
+	// Platform that sent the event.
+	Platform string `json:"platform"`
+	// Short textual description of the event.
+	Message string `json:"message"`
+	// Raw JSON payload as received from the client.
+	Payload OptIssueEventPayload `json:"payload"`
+	// Key-value tags attached to the event.
+	Tags OptIssueEventTags `json:"tags"`
+	// Hostname of the server where the event happened.
+	ServerName OptString `json:"server_name"`
+	// Runtime environment (production, staging, etc.).
+	Environment OptString `json:"environment"`
+	// Application release version.
+	Release OptString `json:"release"`
+	// Exception class/type.
+	ExceptionType OptNilString `json:"exception_type"`
+	// Exception message.
+	ExceptionValue OptNilString `json:"exception_value"`
+	// Exception stacktrace.
+	ExceptionStacktrace OptNilString `json:"exception_stacktrace"`
+	// Full request URL.
+	RequestURL OptNilString `json:"request_url"`
+	// HTTP method.
+	RequestMethod OptNilString `json:"request_method"`
+	// Raw query string.
+	RequestQuery OptNilString `json:"request_query"`
+	// HTTP headers.
+	RequestHeaders OptNilIssueEventRequestHeaders `json:"request_headers"`
+	// Request body (as sent).
+	RequestData OptNilString `json:"request_data"`
+	// Cookies string.
+	RequestCookies OptNilString `json:"request_cookies"`
+	// Client IP address.
+	RequestIP OptNilString `json:"request_ip"`
+	// User-Agent header.
+	UserAgent OptNilString `json:"user_agent"`
+	// User identifier.
+	UserID OptNilString `json:"user_id"`
+	// User email address.
+	UserEmail OptNilString `json:"user_email"`
+	// Runtime name (e.g., node, python).
+	RuntimeName OptNilString `json:"runtime_name"`
+	// Runtime version.
+	RuntimeVersion OptNilString `json:"runtime_version"`
+	// Operating system name.
+	OsName OptNilString `json:"os_name"`
+	// Operating system version.
+	OsVersion OptNilString `json:"os_version"`
+	// Browser name.
+	BrowserName OptNilString `json:"browser_name"`
+	// Browser version.
+	BrowserVersion OptNilString `json:"browser_version"`
+	DeviceArch     OptNilString `json:"device_arch"`
+	// Attachments linked to the event.
+	Attachments []Attachment `json:"attachments"`
 }
 
-// GetFingerprint returns the value of Fingerprint.
-func (s *NotificationRule) GetFingerprint() OptNilString {
-	return s.Fingerprint
+// GetEventID returns the value of EventID.
+func (s *IssueEvent) GetEventID() string {
+	return s.EventID
 }
 
-// GetIsNewError returns the value of IsNewError.
-func (s *NotificationRule) GetIsNewError() OptNilBool {
-	return s.IsNewError
+// GetTimestamp returns the value of Timestamp.
+func (s *IssueEvent) GetTimestamp() time.Time {
+	return s.Timestamp
 }
 
-// GetIsRegression returns the value of IsRegression.
-func (s *NotificationRule) GetIsRegression() OptNilBool {
-	return s.IsRegression
+// GetGroupHash returns the value of GroupHash.
+func (s *IssueEvent) GetGroupHash() OptString {
+	return s.GroupHash
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *NotificationRule) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetProjectID returns the value of ProjectID.
+func (s *IssueEvent) GetProjectID() uint {
+	return s.ProjectID
 }
 
-// SetID sets the value of ID.
-func (s *NotificationRule) SetID(val uint) {
-	s.ID = val
+// GetLevel returns the value of Level.
+func (s *IssueEvent) GetLevel() IssueLevel {
+	return s.Level
 }
 
-// SetNotificationSettingID sets the value of NotificationSettingID.
-func (s *NotificationRule) SetNotificationSettingID(val uint) {
-	s.NotificationSettingID = val
+// GetSource returns the value of Source.
+func (s *IssueEvent) GetSource() IssueSource {
+	return s.Source
 }
 
-// SetEventLevel sets the value of EventLevel.
-func (s *NotificationRule) SetEventLevel(val OptNilString) {
-	s.EventLevel = val
+// GetPlatform returns the value of Platform.
+func (s *IssueEvent) GetPlatform() string {
+	return s.Platform
 }
 
-// SetFingerprint sets the value of Fingerprint.
-func (s *NotificationRule) SetFingerprint(val OptNilString) {
-	s.Fingerprint = val
+// GetMessage returns the value of Message.
+func (s *IssueEvent) GetMessage() string {
+	return s.Message
 }
 
-// SetIsNewError sets the value of IsNewError.
-func (s *NotificationRule) SetIsNewError(val OptNilBool) {
-	s.IsNewError = val
+// GetPayload returns the value of Payload.
+func (s *IssueEvent) GetPayload() OptIssueEventPayload {
+	return s.Payload
 }
 
-// SetIsRegression sets the value of IsRegression.
-func (s *NotificationRule) SetIsRegression(val OptNilBool) {
-	s.IsRegression = val
+// GetTags returns the value of Tags.
+func (s *IssueEvent) GetTags() OptIssueEventTags {
+	return s.Tags
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *NotificationRule) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// GetServerName returns the value of ServerName.
+func (s *IssueEvent) GetServerName() OptString {
+	return s.ServerName
 }
 
-func (*NotificationRule) createNotificationRuleRes() {}
-func (*NotificationRule) getNotificationRuleRes()    {}
-func (*NotificationRule) updateNotificationRuleRes() {}
-
-// Ref: #/components/schemas/NotificationSetting
-type NotificationSetting struct {
-	ID        uint `json:"id"`
-	ProjectID uint `json:"project_id"`
-	// Type of notification channel (email, mattermost, slack, etc.).
-	Type string `json:"type"`
-	// Configuration for the notification channel (JSONB in database).
-	Config    string    `json:"config"`
-	Enabled   bool      `json:"enabled"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+// GetEnvironment returns the value of Environment.
+func (s *IssueEvent) GetEnvironment() OptString {
+	return s.Environment
 }
 
-// GetID returns the value of ID.
-func (s *NotificationSetting) GetID() uint {
-	return s.ID
+// GetRelease returns the value of Release.
+func (s *IssueEvent) GetRelease() OptString {
+	return s.Release
 }
 
-// GetProjectID returns the value of ProjectID.
-func (s *NotificationSetting) GetProjectID() uint {
-	return s.ProjectID
+// GetExceptionType returns the value of ExceptionType.
+func (s *IssueEvent) GetExceptionType() OptNilString {
+	return s.ExceptionType
 }
 
-// GetType returns the value of Type.
-func (s *NotificationSetting) GetType() string {
-	return s.Type
+// GetExceptionValue returns the value of ExceptionValue.
+func (s *IssueEvent) GetExceptionValue() OptNilString {
+	return s.ExceptionValue
 }
 
-// GetConfig returns the value of Config.
-func (s *NotificationSetting) GetConfig() string {
-	return s.Config
+// GetExceptionStacktrace returns the value of ExceptionStacktrace.
+func (s *IssueEvent) GetExceptionStacktrace() OptNilString {
+	return s.ExceptionStacktrace
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *NotificationSetting) GetEnabled() bool {
-	return s.Enabled
+// GetRequestURL returns the value of RequestURL.
+func (s *IssueEvent) GetRequestURL() OptNilString {
+	return s.RequestURL
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *NotificationSetting) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetRequestMethod returns the value of RequestMethod.
+func (s *IssueEvent) GetRequestMethod() OptNilString {
+	return s.RequestMethod
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *NotificationSetting) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// GetRequestQuery returns the value of RequestQuery.
+func (s *IssueEvent) GetRequestQuery() OptNilString {
+	return s.RequestQuery
 }
 
-// SetID sets the value of ID.
-func (s *NotificationSetting) SetID(val uint) {
-	s.ID = val
+// GetRequestHeaders returns the value of RequestHeaders.
+func (s *IssueEvent) GetRequestHeaders() OptNilIssueEventRequestHeaders {
+	return s.RequestHeaders
 }
 
-// SetProjectID sets the value of ProjectID.
-func (s *NotificationSetting) SetProjectID(val uint) {
-	s.ProjectID = val
+// GetRequestData returns the value of RequestData.
+func (s *IssueEvent) GetRequestData() OptNilString {
+	return s.RequestData
 }
 
-// SetType sets the value of Type.
-func (s *NotificationSetting) SetType(val string) {
-	s.Type = val
+// GetRequestCookies returns the value of RequestCookies.
+func (s *IssueEvent) GetRequestCookies() OptNilString {
+	return s.RequestCookies
 }
 
-// SetConfig sets the value of Config.
-func (s *NotificationSetting) SetConfig(val string) {
-	s.Config = val
+// GetRequestIP returns the value of RequestIP.
+func (s *IssueEvent) GetRequestIP() OptNilString {
+	return s.RequestIP
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *NotificationSetting) SetEnabled(val bool) {
-	s.Enabled = val
+// GetUserAgent returns the value of UserAgent.
+func (s *IssueEvent) GetUserAgent() OptNilString {
+	return s.UserAgent
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *NotificationSetting) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// GetUserID returns the value of UserID.
+func (s *IssueEvent) GetUserID() OptNilString {
+	return s.UserID
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *NotificationSetting) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// GetUserEmail returns the value of UserEmail.
+func (s *IssueEvent) GetUserEmail() OptNilString {
+	return s.UserEmail
 }
 
-func (*NotificationSetting) createNotificationSettingRes() {}
-func (*NotificationSetting) getNotificationSettingRes()    {}
-func (*NotificationSetting) updateNotificationSettingRes() {}
+// GetRuntimeName returns the value of RuntimeName.
+func (s *IssueEvent) GetRuntimeName() OptNilString {
+	return s.RuntimeName
+}
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetRuntimeVersion returns the value of RuntimeVersion.
+func (s *IssueEvent) GetRuntimeVersion() OptNilString {
+	return s.RuntimeVersion
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetOsName returns the value of OsName.
+func (s *IssueEvent) GetOsName() OptNilString {
+	return s.OsName
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetOsVersion returns the value of OsVersion.
+func (s *IssueEvent) GetOsVersion() OptNilString {
+	return s.OsVersion
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetBrowserName returns the value of BrowserName.
+func (s *IssueEvent) GetBrowserName() OptNilString {
+	return s.BrowserName
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetBrowserVersion returns the value of BrowserVersion.
+func (s *IssueEvent) GetBrowserVersion() OptNilString {
+	return s.BrowserVersion
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetDeviceArch returns the value of DeviceArch.
+func (s *IssueEvent) GetDeviceArch() OptNilString {
+	return s.DeviceArch
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// GetAttachments returns the value of Attachments.
+func (s *IssueEvent) GetAttachments() []Attachment {
+	return s.Attachments
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetEventID sets the value of EventID.
+func (s *IssueEvent) SetEventID(val string) {
+	s.EventID = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetTimestamp sets the value of Timestamp.
+func (s *IssueEvent) SetTimestamp(val time.Time) {
+	s.Timestamp = val
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetGroupHash sets the value of GroupHash.
+func (s *IssueEvent) SetGroupHash(val OptString) {
+	s.GroupHash = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetProjectID sets the value of ProjectID.
+func (s *IssueEvent) SetProjectID(val uint) {
+	s.ProjectID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLevel sets the value of Level.
+func (s *IssueEvent) SetLevel(val IssueLevel) {
+	s.Level = val
 }
 
-// NewOptFloat32 returns new OptFloat32 with value set to v.
-func NewOptFloat32(v float32) OptFloat32 {
-	return OptFloat32{
-		Value: v,
-		Set:   true,
-	}
+// SetSource sets the value of Source.
+func (s *IssueEvent) SetSource(val IssueSource) {
+	s.Source = val
 }
 
-// OptFloat32 is optional float32.
-type OptFloat32 struct {
-	Value float32
-	Set   bool
+// SetPlatform sets the value of Platform.
+func (s *IssueEvent) SetPlatform(val string) {
+	s.Platform = val
 }
 
-// IsSet returns true if OptFloat32 was set.
-func (o OptFloat32) IsSet() bool { return o.Set }
+// SetMessage sets the value of Message.
+func (s *IssueEvent) SetMessage(val string) {
+	s.Message = val
+}
 
-// Reset unsets value.
-func (o *OptFloat32) Reset() {
-	var v float32
-	o.Value = v
-	o.Set = false
+// SetPayload sets the value of Payload.
+func (s *IssueEvent) SetPayload(val OptIssueEventPayload) {
+	s.Payload = val
 }
 
-// SetTo sets value to v.
-func (o *OptFloat32) SetTo(v float32) {
-	o.Set = true
-	o.Value = v
+// SetTags sets the value of Tags.
+func (s *IssueEvent) SetTags(val OptIssueEventTags) {
+	s.Tags = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFloat32) Get() (v float32, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetServerName sets the value of ServerName.
+func (s *IssueEvent) SetServerName(val OptString) {
+	s.ServerName = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFloat32) Or(d float32) float32 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetEnvironment sets the value of Environment.
+func (s *IssueEvent) SetEnvironment(val OptString) {
+	s.Environment = val
 }
 
-// NewOptGetProjectReleaseErrorsTimeseriesGroupBy returns new OptGetProjectReleaseErrorsTimeseriesGroupBy with value set to v.
-func NewOptGetProjectReleaseErrorsTimeseriesGroupBy(v GetProjectReleaseErrorsTimeseriesGroupBy) OptGetProjectReleaseErrorsTimeseriesGroupBy {
-	return OptGetProjectReleaseErrorsTimeseriesGroupBy{
-		Value: v,
-		Set:   true,
-	}
+// SetRelease sets the value of Release.
+func (s *IssueEvent) SetRelease(val OptString) {
+	s.Release = val
 }
 
-// OptGetProjectReleaseErrorsTimeseriesGroupBy is optional GetProjectReleaseErrorsTimeseriesGroupBy.
-type OptGetProjectReleaseErrorsTimeseriesGroupBy struct {
-	Value GetProjectReleaseErrorsTimeseriesGroupBy
-	Set   bool
+// SetExceptionType sets the value of ExceptionType.
+func (s *IssueEvent) SetExceptionType(val OptNilString) {
+	s.ExceptionType = val
 }
 
-// IsSet returns true if OptGetProjectReleaseErrorsTimeseriesGroupBy was set.
-func (o OptGetProjectReleaseErrorsTimeseriesGroupBy) IsSet() bool { return o.Set }
+// SetExceptionValue sets the value of ExceptionValue.
+func (s *IssueEvent) SetExceptionValue(val OptNilString) {
+	s.ExceptionValue = val
+}
 
-// Reset unsets value.
-func (o *OptGetProjectReleaseErrorsTimeseriesGroupBy) Reset() {
-	var v GetProjectReleaseErrorsTimeseriesGroupBy
-	o.Value = v
-	o.Set = false
+// SetExceptionStacktrace sets the value of ExceptionStacktrace.
+func (s *IssueEvent) SetExceptionStacktrace(val OptNilString) {
+	s.ExceptionStacktrace = val
 }
 
-// SetTo sets value to v.
-func (o *OptGetProjectReleaseErrorsTimeseriesGroupBy) SetTo(v GetProjectReleaseErrorsTimeseriesGroupBy) {
-	o.Set = true
-	o.Value = v
+// SetRequestURL sets the value of RequestURL.
+func (s *IssueEvent) SetRequestURL(val OptNilString) {
+	s.RequestURL = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetProjectReleaseErrorsTimeseriesGroupBy) Get() (v GetProjectReleaseErrorsTimeseriesGroupBy, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRequestMethod sets the value of RequestMethod.
+func (s *IssueEvent) SetRequestMethod(val OptNilString) {
+	s.RequestMethod = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetProjectReleaseErrorsTimeseriesGroupBy) Or(d GetProjectReleaseErrorsTimeseriesGroupBy) GetProjectReleaseErrorsTimeseriesGroupBy {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetRequestQuery sets the value of RequestQuery.
+func (s *IssueEvent) SetRequestQuery(val OptNilString) {
+	s.RequestQuery = val
 }
 
-// NewOptIssueEventPayload returns new OptIssueEventPayload with value set to v.
-func NewOptIssueEventPayload(v IssueEventPayload) OptIssueEventPayload {
-	return OptIssueEventPayload{
-		Value: v,
-		Set:   true,
-	}
+// SetRequestHeaders sets the value of RequestHeaders.
+func (s *IssueEvent) SetRequestHeaders(val OptNilIssueEventRequestHeaders) {
+	s.RequestHeaders = val
 }
 
-// OptIssueEventPayload is optional IssueEventPayload.
-type OptIssueEventPayload struct {
-	Value IssueEventPayload
-	Set   bool
+// SetRequestData sets the value of RequestData.
+func (s *IssueEvent) SetRequestData(val OptNilString) {
+	s.RequestData = val
 }
 
-// IsSet returns true if OptIssueEventPayload was set.
-func (o OptIssueEventPayload) IsSet() bool { return o.Set }
+// SetRequestCookies sets the value of RequestCookies.
+func (s *IssueEvent) SetRequestCookies(val OptNilString) {
+	s.RequestCookies = val
+}
 
-// Reset unsets value.
-func (o *OptIssueEventPayload) Reset() {
-	var v IssueEventPayload
-	o.Value = v
-	o.Set = false
+// SetRequestIP sets the value of RequestIP.
+func (s *IssueEvent) SetRequestIP(val OptNilString) {
+	s.RequestIP = val
 }
 
-// SetTo sets value to v.
-func (o *OptIssueEventPayload) SetTo(v IssueEventPayload) {
-	o.Set = true
-	o.Value = v
+// SetUserAgent sets the value of UserAgent.
+func (s *IssueEvent) SetUserAgent(val OptNilString) {
+	s.UserAgent = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptIssueEventPayload) Get() (v IssueEventPayload, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetUserID sets the value of UserID.
+func (s *IssueEvent) SetUserID(val OptNilString) {
+	s.UserID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptIssueEventPayload) Or(d IssueEventPayload) IssueEventPayload {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetUserEmail sets the value of UserEmail.
+func (s *IssueEvent) SetUserEmail(val OptNilString) {
+	s.UserEmail = val
 }
 
-// NewOptIssueEventTags returns new OptIssueEventTags with value set to v.
-func NewOptIssueEventTags(v IssueEventTags) OptIssueEventTags {
-	return OptIssueEventTags{
-		Value: v,
-		Set:   true,
-	}
+// SetRuntimeName sets the value of RuntimeName.
+func (s *IssueEvent) SetRuntimeName(val OptNilString) {
+	s.RuntimeName = val
 }
 
-// OptIssueEventTags is optional IssueEventTags.
-type OptIssueEventTags struct {
-	Value IssueEventTags
-	Set   bool
+// SetRuntimeVersion sets the value of RuntimeVersion.
+func (s *IssueEvent) SetRuntimeVersion(val OptNilString) {
+	s.RuntimeVersion = val
 }
 
-// IsSet returns true if OptIssueEventTags was set.
-func (o OptIssueEventTags) IsSet() bool { return o.Set }
+// SetOsName sets the value of OsName.
+func (s *IssueEvent) SetOsName(val OptNilString) {
+	s.OsName = val
+}
 
-// Reset unsets value.
-func (o *OptIssueEventTags) Reset() {
-	var v IssueEventTags
-	o.Value = v
-	o.Set = false
+// SetOsVersion sets the value of OsVersion.
+func (s *IssueEvent) SetOsVersion(val OptNilString) {
+	s.OsVersion = val
 }
 
-// SetTo sets value to v.
-func (o *OptIssueEventTags) SetTo(v IssueEventTags) {
-	o.Set = true
-	o.Value = v
+// SetBrowserName sets the value of BrowserName.
+func (s *IssueEvent) SetBrowserName(val OptNilString) {
+	s.BrowserName = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptIssueEventTags) Get() (v IssueEventTags, ok bool) {
-	if !o.Set {
-		return v, false
+// SetBrowserVersion sets the value of BrowserVersion.
+func (s *IssueEvent) SetBrowserVersion(val OptNilString) {
+	s.BrowserVersion = val
+}
+
+// SetDeviceArch sets the value of DeviceArch.
+func (s *IssueEvent) SetDeviceArch(val OptNilString) {
+	s.DeviceArch = val
+}
+
+// SetAttachments sets the value of Attachments.
+func (s *IssueEvent) SetAttachments(val []Attachment) {
+	s.Attachments = val
+}
+
+// Raw JSON payload as received from the client.
+type IssueEventPayload map[string]jx.Raw
+
+func (s *IssueEventPayload) init() IssueEventPayload {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptIssueEventTags) Or(d IssueEventTags) IssueEventTags {
-	if v, ok := o.Get(); ok {
-		return v
+// HTTP headers.
+type IssueEventRequestHeaders map[string]string
+
+func (s *IssueEventRequestHeaders) init() IssueEventRequestHeaders {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
 	}
-	return d
+	return m
 }
 
-// NewOptIssueLevel returns new OptIssueLevel with value set to v.
-func NewOptIssueLevel(v IssueLevel) OptIssueLevel {
-	return OptIssueLevel{
-		Value: v,
-		Set:   true,
+// Key-value tags attached to the event.
+type IssueEventTags map[string]string
+
+func (s *IssueEventTags) init() IssueEventTags {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
 	}
+	return m
 }
 
-// OptIssueLevel is optional IssueLevel.
-type OptIssueLevel struct {
-	Value IssueLevel
-	Set   bool
+// Conditions ending ignoring an issue, the issue is unignored and marked as escalating
+// when any of them is met. Only allowed with the ignored status.
+// Ref: #/components/schemas/IssueIgnoreConditions
+type IssueIgnoreConditions struct {
+	// Ignore until the date.
+	Until OptDateTime `json:"until"`
+	// Ignore for the number of hours, converted to until.
+	ForHours OptUint `json:"for_hours"`
+	// Ignore until the issue occurs this many more times.
+	Count OptUint `json:"count"`
+	// Ignore until the issue affects this many more users.
+	UserCount OptUint `json:"user_count"`
+	// Ignore until the issue occurs more than this many times per hour.
+	RatePerHour OptUint `json:"rate_per_hour"`
 }
 
-// IsSet returns true if OptIssueLevel was set.
-func (o OptIssueLevel) IsSet() bool { return o.Set }
+// GetUntil returns the value of Until.
+func (s *IssueIgnoreConditions) GetUntil() OptDateTime {
+	return s.Until
+}
 
-// Reset unsets value.
-func (o *OptIssueLevel) Reset() {
-	var v IssueLevel
-	o.Value = v
-	o.Set = false
+// GetForHours returns the value of ForHours.
+func (s *IssueIgnoreConditions) GetForHours() OptUint {
+	return s.ForHours
 }
 
-// SetTo sets value to v.
-func (o *OptIssueLevel) SetTo(v IssueLevel) {
-	o.Set = true
-	o.Value = v
+// GetCount returns the value of Count.
+func (s *IssueIgnoreConditions) GetCount() OptUint {
+	return s.Count
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptIssueLevel) Get() (v IssueLevel, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetUserCount returns the value of UserCount.
+func (s *IssueIgnoreConditions) GetUserCount() OptUint {
+	return s.UserCount
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptIssueLevel) Or(d IssueLevel) IssueLevel {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetRatePerHour returns the value of RatePerHour.
+func (s *IssueIgnoreConditions) GetRatePerHour() OptUint {
+	return s.RatePerHour
 }
 
-// NewOptIssueSortColumn returns new OptIssueSortColumn with value set to v.
-func NewOptIssueSortColumn(v IssueSortColumn) OptIssueSortColumn {
-	return OptIssueSortColumn{
-		Value: v,
-		Set:   true,
-	}
+// SetUntil sets the value of Until.
+func (s *IssueIgnoreConditions) SetUntil(val OptDateTime) {
+	s.Until = val
 }
 
-// OptIssueSortColumn is optional IssueSortColumn.
-type OptIssueSortColumn struct {
-	Value IssueSortColumn
-	Set   bool
+// SetForHours sets the value of ForHours.
+func (s *IssueIgnoreConditions) SetForHours(val OptUint) {
+	s.ForHours = val
 }
 
-// IsSet returns true if OptIssueSortColumn was set.
-func (o OptIssueSortColumn) IsSet() bool { return o.Set }
+// SetCount sets the value of Count.
+func (s *IssueIgnoreConditions) SetCount(val OptUint) {
+	s.Count = val
+}
 
-// Reset unsets value.
-func (o *OptIssueSortColumn) Reset() {
-	var v IssueSortColumn
-	o.Value = v
-	o.Set = false
+// SetUserCount sets the value of UserCount.
+func (s *IssueIgnoreConditions) SetUserCount(val OptUint) {
+	s.UserCount = val
 }
 
-// SetTo sets value to v.
-func (o *OptIssueSortColumn) SetTo(v IssueSortColumn) {
-	o.Set = true
-	o.Value = v
+// SetRatePerHour sets the value of RatePerHour.
+func (s *IssueIgnoreConditions) SetRatePerHour(val OptUint) {
+	s.RatePerHour = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptIssueSortColumn) Get() (v IssueSortColumn, ok bool) {
-	if !o.Set {
-		return v, false
+// Issue level.
+// Ref: #/components/schemas/IssueLevel
+type IssueLevel string
+
+const (
+	IssueLevelFatal     IssueLevel = "fatal"
+	IssueLevelException IssueLevel = "exception"
+	IssueLevelError     IssueLevel = "error"
+	IssueLevelWarning   IssueLevel = "warning"
+	IssueLevelInfo      IssueLevel = "info"
+	IssueLevelDebug     IssueLevel = "debug"
+)
+
+// AllValues returns all IssueLevel values.
+func (IssueLevel) AllValues() []IssueLevel {
+	return []IssueLevel{
+		IssueLevelFatal,
+		IssueLevelException,
+		IssueLevelError,
+		IssueLevelWarning,
+		IssueLevelInfo,
+		IssueLevelDebug,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptIssueSortColumn) Or(d IssueSortColumn) IssueSortColumn {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s IssueLevel) MarshalText() ([]byte, error) {
+	switch s {
+	case IssueLevelFatal:
+		return []byte(s), nil
+	case IssueLevelException:
+		return []byte(s), nil
+	case IssueLevelError:
+		return []byte(s), nil
+	case IssueLevelWarning:
+		return []byte(s), nil
+	case IssueLevelInfo:
+		return []byte(s), nil
+	case IssueLevelDebug:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptIssueStatus returns new OptIssueStatus with value set to v.
-func NewOptIssueStatus(v IssueStatus) OptIssueStatus {
-	return OptIssueStatus{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IssueLevel) UnmarshalText(data []byte) error {
+	switch IssueLevel(data) {
+	case IssueLevelFatal:
+		*s = IssueLevelFatal
+		return nil
+	case IssueLevelException:
+		*s = IssueLevelException
+		return nil
+	case IssueLevelError:
+		*s = IssueLevelError
+		return nil
+	case IssueLevelWarning:
+		*s = IssueLevelWarning
+		return nil
+	case IssueLevelInfo:
+		*s = IssueLevelInfo
+		return nil
+	case IssueLevelDebug:
+		*s = IssueLevelDebug
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptIssueStatus is optional IssueStatus.
-type OptIssueStatus struct {
-	Value IssueStatus
-	Set   bool
+// Ref: #/components/schemas/IssueResponse
+type IssueResponse struct {
+	Source IssueSource  `json:"source"`
+	Issue  Issue        `json:"issue"`
+	Events []IssueEvent `json:"events"`
+	// Fingerprints of issues merged into this issue.
+	MergedFingerprints []string                 `json:"merged_fingerprints"`
+	IgnoreConditions   OptIssueIgnoreConditions `json:"ignore_conditions"`
 }
 
-// IsSet returns true if OptIssueStatus was set.
-func (o OptIssueStatus) IsSet() bool { return o.Set }
+// GetSource returns the value of Source.
+func (s *IssueResponse) GetSource() IssueSource {
+	return s.Source
+}
 
-// Reset unsets value.
-func (o *OptIssueStatus) Reset() {
-	var v IssueStatus
-	o.Value = v
-	o.Set = false
+// GetIssue returns the value of Issue.
+func (s *IssueResponse) GetIssue() Issue {
+	return s.Issue
 }
 
-// SetTo sets value to v.
-func (o *OptIssueStatus) SetTo(v IssueStatus) {
-	o.Set = true
-	o.Value = v
+// GetEvents returns the value of Events.
+func (s *IssueResponse) GetEvents() []IssueEvent {
+	return s.Events
+}
+
+// GetMergedFingerprints returns the value of MergedFingerprints.
+func (s *IssueResponse) GetMergedFingerprints() []string {
+	return s.MergedFingerprints
+}
+
+// GetIgnoreConditions returns the value of IgnoreConditions.
+func (s *IssueResponse) GetIgnoreConditions() OptIssueIgnoreConditions {
+	return s.IgnoreConditions
+}
+
+// SetSource sets the value of Source.
+func (s *IssueResponse) SetSource(val IssueSource) {
+	s.Source = val
+}
+
+// SetIssue sets the value of Issue.
+func (s *IssueResponse) SetIssue(val Issue) {
+	s.Issue = val
+}
+
+// SetEvents sets the value of Events.
+func (s *IssueResponse) SetEvents(val []IssueEvent) {
+	s.Events = val
+}
+
+// SetMergedFingerprints sets the value of MergedFingerprints.
+func (s *IssueResponse) SetMergedFingerprints(val []string) {
+	s.MergedFingerprints = val
+}
+
+// SetIgnoreConditions sets the value of IgnoreConditions.
+func (s *IssueResponse) SetIgnoreConditions(val OptIssueIgnoreConditions) {
+	s.IgnoreConditions = val
+}
+
+func (*IssueResponse) getIssueRes()    {}
+func (*IssueResponse) mergeIssuesRes() {}
+
+// Column to sort issues by.
+// Ref: #/components/schemas/IssueSortColumn
+type IssueSortColumn string
+
+const (
+	IssueSortColumnTotalEvents IssueSortColumn = "total_events"
+	IssueSortColumnFirstSeen   IssueSortColumn = "first_seen"
+	IssueSortColumnLastSeen    IssueSortColumn = "last_seen"
+)
+
+// AllValues returns all IssueSortColumn values.
+func (IssueSortColumn) AllValues() []IssueSortColumn {
+	return []IssueSortColumn{
+		IssueSortColumnTotalEvents,
+		IssueSortColumnFirstSeen,
+		IssueSortColumnLastSeen,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s IssueSortColumn) MarshalText() ([]byte, error) {
+	switch s {
+	case IssueSortColumnTotalEvents:
+		return []byte(s), nil
+	case IssueSortColumnFirstSeen:
+		return []byte(s), nil
+	case IssueSortColumnLastSeen:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IssueSortColumn) UnmarshalText(data []byte) error {
+	switch IssueSortColumn(data) {
+	case IssueSortColumnTotalEvents:
+		*s = IssueSortColumnTotalEvents
+		return nil
+	case IssueSortColumnFirstSeen:
+		*s = IssueSortColumnFirstSeen
+		return nil
+	case IssueSortColumnLastSeen:
+		*s = IssueSortColumnLastSeen
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Identifies where the issue comes from.
+// Ref: #/components/schemas/IssueSource
+type IssueSource string
+
+const (
+	IssueSourceEvent     IssueSource = "event"
+	IssueSourceException IssueSource = "exception"
+	IssueSourceMonitor   IssueSource = "monitor"
+	IssueSourceSpike     IssueSource = "spike"
+)
+
+// AllValues returns all IssueSource values.
+func (IssueSource) AllValues() []IssueSource {
+	return []IssueSource{
+		IssueSourceEvent,
+		IssueSourceException,
+		IssueSourceMonitor,
+		IssueSourceSpike,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s IssueSource) MarshalText() ([]byte, error) {
+	switch s {
+	case IssueSourceEvent:
+		return []byte(s), nil
+	case IssueSourceException:
+		return []byte(s), nil
+	case IssueSourceMonitor:
+		return []byte(s), nil
+	case IssueSourceSpike:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IssueSource) UnmarshalText(data []byte) error {
+	switch IssueSource(data) {
+	case IssueSourceEvent:
+		*s = IssueSourceEvent
+		return nil
+	case IssueSourceException:
+		*s = IssueSourceException
+		return nil
+	case IssueSourceMonitor:
+		*s = IssueSourceMonitor
+		return nil
+	case IssueSourceSpike:
+		*s = IssueSourceSpike
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Resolved or not.
+// Ref: #/components/schemas/IssueStatus
+type IssueStatus string
+
+const (
+	IssueStatusResolved   IssueStatus = "resolved"
+	IssueStatusUnresolved IssueStatus = "unresolved"
+	IssueStatusIgnored    IssueStatus = "ignored"
+)
+
+// AllValues returns all IssueStatus values.
+func (IssueStatus) AllValues() []IssueStatus {
+	return []IssueStatus{
+		IssueStatusResolved,
+		IssueStatusUnresolved,
+		IssueStatusIgnored,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s IssueStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case IssueStatusResolved:
+		return []byte(s), nil
+	case IssueStatusUnresolved:
+		return []byte(s), nil
+	case IssueStatusIgnored:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *IssueStatus) UnmarshalText(data []byte) error {
+	switch IssueStatus(data) {
+	case IssueStatusResolved:
+		*s = IssueStatusResolved
+		return nil
+	case IssueStatusUnresolved:
+		*s = IssueStatusUnresolved
+		return nil
+	case IssueStatusIgnored:
+		*s = IssueStatusIgnored
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/IssueSummary
+type IssueSummary struct {
+	ID        uint       `json:"id"`
+	ProjectID uint       `json:"project_id"`
+	Title     string     `json:"title"`
+	Level     IssueLevel `json:"level"`
+	Count     uint       `json:"count"`
+	LastSeen  time.Time  `json:"last_seen"`
+}
+
+// GetID returns the value of ID.
+func (s *IssueSummary) GetID() uint {
+	return s.ID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *IssueSummary) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetTitle returns the value of Title.
+func (s *IssueSummary) GetTitle() string {
+	return s.Title
+}
+
+// GetLevel returns the value of Level.
+func (s *IssueSummary) GetLevel() IssueLevel {
+	return s.Level
+}
+
+// GetCount returns the value of Count.
+func (s *IssueSummary) GetCount() uint {
+	return s.Count
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *IssueSummary) GetLastSeen() time.Time {
+	return s.LastSeen
+}
+
+// SetID sets the value of ID.
+func (s *IssueSummary) SetID(val uint) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *IssueSummary) SetProjectID(val uint) {
+	s.ProjectID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *IssueSummary) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetLevel sets the value of Level.
+func (s *IssueSummary) SetLevel(val IssueLevel) {
+	s.Level = val
+}
+
+// SetCount sets the value of Count.
+func (s *IssueSummary) SetCount(val uint) {
+	s.Count = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *IssueSummary) SetLastSeen(val time.Time) {
+	s.LastSeen = val
+}
+
+// Ref: #/components/schemas/IssueTag
+type IssueTag struct {
+	Key string `json:"key"`
+	// Number of the issue events having the tag.
+	TotalEvents  uint            `json:"total_events"`
+	UniqueValues uint            `json:"unique_values"`
+	TopValues    []IssueTagValue `json:"top_values"`
+}
+
+// GetKey returns the value of Key.
+func (s *IssueTag) GetKey() string {
+	return s.Key
+}
+
+// GetTotalEvents returns the value of TotalEvents.
+func (s *IssueTag) GetTotalEvents() uint {
+	return s.TotalEvents
+}
+
+// GetUniqueValues returns the value of UniqueValues.
+func (s *IssueTag) GetUniqueValues() uint {
+	return s.UniqueValues
+}
+
+// GetTopValues returns the value of TopValues.
+func (s *IssueTag) GetTopValues() []IssueTagValue {
+	return s.TopValues
+}
+
+// SetKey sets the value of Key.
+func (s *IssueTag) SetKey(val string) {
+	s.Key = val
+}
+
+// SetTotalEvents sets the value of TotalEvents.
+func (s *IssueTag) SetTotalEvents(val uint) {
+	s.TotalEvents = val
+}
+
+// SetUniqueValues sets the value of UniqueValues.
+func (s *IssueTag) SetUniqueValues(val uint) {
+	s.UniqueValues = val
+}
+
+// SetTopValues sets the value of TopValues.
+func (s *IssueTag) SetTopValues(val []IssueTagValue) {
+	s.TopValues = val
+}
+
+// Ref: #/components/schemas/IssueTagValue
+type IssueTagValue struct {
+	Value string `json:"value"`
+	Count uint   `json:"count"`
+	// Share of the events having the value among the events having the tag.
+	Percentage float64   `json:"percentage"`
+	FirstSeen  time.Time `json:"first_seen"`
+	LastSeen   time.Time `json:"last_seen"`
+}
+
+// GetValue returns the value of Value.
+func (s *IssueTagValue) GetValue() string {
+	return s.Value
+}
+
+// GetCount returns the value of Count.
+func (s *IssueTagValue) GetCount() uint {
+	return s.Count
+}
+
+// GetPercentage returns the value of Percentage.
+func (s *IssueTagValue) GetPercentage() float64 {
+	return s.Percentage
+}
+
+// GetFirstSeen returns the value of FirstSeen.
+func (s *IssueTagValue) GetFirstSeen() time.Time {
+	return s.FirstSeen
+}
+
+// GetLastSeen returns the value of LastSeen.
+func (s *IssueTagValue) GetLastSeen() time.Time {
+	return s.LastSeen
+}
+
+// SetValue sets the value of Value.
+func (s *IssueTagValue) SetValue(val string) {
+	s.Value = val
+}
+
+// SetCount sets the value of Count.
+func (s *IssueTagValue) SetCount(val uint) {
+	s.Count = val
+}
+
+// SetPercentage sets the value of Percentage.
+func (s *IssueTagValue) SetPercentage(val float64) {
+	s.Percentage = val
+}
+
+// SetFirstSeen sets the value of FirstSeen.
+func (s *IssueTagValue) SetFirstSeen(val time.Time) {
+	s.FirstSeen = val
+}
+
+// SetLastSeen sets the value of LastSeen.
+func (s *IssueTagValue) SetLastSeen(val time.Time) {
+	s.LastSeen = val
+}
+
+// Ref: #/components/schemas/IssueTagsResponse
+type IssueTagsResponse struct {
+	Tags []IssueTag `json:"tags"`
+}
+
+// GetTags returns the value of Tags.
+func (s *IssueTagsResponse) GetTags() []IssueTag {
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *IssueTagsResponse) SetTags(val []IssueTag) {
+	s.Tags = val
+}
+
+func (*IssueTagsResponse) getProjectIssueTagsRes() {}
+
+// Ref: #/components/schemas/ListEnvironmentsResponse
+type ListEnvironmentsResponse struct {
+	Environments []Environment `json:"environments"`
+}
+
+// GetEnvironments returns the value of Environments.
+func (s *ListEnvironmentsResponse) GetEnvironments() []Environment {
+	return s.Environments
+}
+
+// SetEnvironments sets the value of Environments.
+func (s *ListEnvironmentsResponse) SetEnvironments(val []Environment) {
+	s.Environments = val
+}
+
+func (*ListEnvironmentsResponse) listProjectEnvironmentsRes() {}
+
+// Ref: #/components/schemas/ListEventAttachmentsResponse
+type ListEventAttachmentsResponse struct {
+	Items []Attachment `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *ListEventAttachmentsResponse) GetItems() []Attachment {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *ListEventAttachmentsResponse) SetItems(val []Attachment) {
+	s.Items = val
+}
+
+func (*ListEventAttachmentsResponse) listEventAttachmentsRes() {}
+
+// Ref: #/components/schemas/ListGroupingRulesResponse
+type ListGroupingRulesResponse struct {
+	GroupingRules []GroupingRule `json:"grouping_rules"`
+}
+
+// GetGroupingRules returns the value of GroupingRules.
+func (s *ListGroupingRulesResponse) GetGroupingRules() []GroupingRule {
+	return s.GroupingRules
+}
+
+// SetGroupingRules sets the value of GroupingRules.
+func (s *ListGroupingRulesResponse) SetGroupingRules(val []GroupingRule) {
+	s.GroupingRules = val
+}
+
+func (*ListGroupingRulesResponse) listGroupingRulesRes() {}
+
+// Ref: #/components/schemas/ListIssueSummariesResponse
+type ListIssueSummariesResponse struct {
+	Issues []IssueSummary `json:"issues"`
+}
+
+// GetIssues returns the value of Issues.
+func (s *ListIssueSummariesResponse) GetIssues() []IssueSummary {
+	return s.Issues
+}
+
+// SetIssues sets the value of Issues.
+func (s *ListIssueSummariesResponse) SetIssues(val []IssueSummary) {
+	s.Issues = val
+}
+
+func (*ListIssueSummariesResponse) getRecentIssuesRes() {}
+
+// Ref: #/components/schemas/ListIssuesResponse
+type ListIssuesResponse struct {
+	Issues  []Issue `json:"issues"`
+	Total   uint    `json:"total"`
+	Page    uint    `json:"page"`
+	PerPage uint    `json:"per_page"`
+	// The search query matched more issues than are searched, the issues of the rest are missing.
+	Truncated bool `json:"truncated"`
+}
+
+// GetIssues returns the value of Issues.
+func (s *ListIssuesResponse) GetIssues() []Issue {
+	return s.Issues
+}
+
+// GetTotal returns the value of Total.
+func (s *ListIssuesResponse) GetTotal() uint {
+	return s.Total
+}
+
+// GetPage returns the value of Page.
+func (s *ListIssuesResponse) GetPage() uint {
+	return s.Page
+}
+
+// GetPerPage returns the value of PerPage.
+func (s *ListIssuesResponse) GetPerPage() uint {
+	return s.PerPage
+}
+
+// GetTruncated returns the value of Truncated.
+func (s *ListIssuesResponse) GetTruncated() bool {
+	return s.Truncated
+}
+
+// SetIssues sets the value of Issues.
+func (s *ListIssuesResponse) SetIssues(val []Issue) {
+	s.Issues = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ListIssuesResponse) SetTotal(val uint) {
+	s.Total = val
+}
+
+// SetPage sets the value of Page.
+func (s *ListIssuesResponse) SetPage(val uint) {
+	s.Page = val
+}
+
+// SetPerPage sets the value of PerPage.
+func (s *ListIssuesResponse) SetPerPage(val uint) {
+	s.PerPage = val
+}
+
+// SetTruncated sets the value of Truncated.
+func (s *ListIssuesResponse) SetTruncated(val bool) {
+	s.Truncated = val
+}
+
+func (*ListIssuesResponse) listIssuesRes() {}
+
+// Ref: #/components/schemas/ListMonitorsResponse
+type ListMonitorsResponse struct {
+	Items []Monitor `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *ListMonitorsResponse) GetItems() []Monitor {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *ListMonitorsResponse) SetItems(val []Monitor) {
+	s.Items = val
+}
+
+func (*ListMonitorsResponse) listMonitorsRes() {}
+
+// Ref: #/components/schemas/ListNotificationRulesResponse
+type ListNotificationRulesResponse struct {
+	NotificationRules []NotificationRule `json:"notification_rules"`
+}
+
+// GetNotificationRules returns the value of NotificationRules.
+func (s *ListNotificationRulesResponse) GetNotificationRules() []NotificationRule {
+	return s.NotificationRules
+}
+
+// SetNotificationRules sets the value of NotificationRules.
+func (s *ListNotificationRulesResponse) SetNotificationRules(val []NotificationRule) {
+	s.NotificationRules = val
+}
+
+func (*ListNotificationRulesResponse) listNotificationRulesRes() {}
+
+// Ref: #/components/schemas/ListNotificationSettingsResponse
+type ListNotificationSettingsResponse struct {
+	NotificationSettings []NotificationSetting `json:"notification_settings"`
+}
+
+// GetNotificationSettings returns the value of NotificationSettings.
+func (s *ListNotificationSettingsResponse) GetNotificationSettings() []NotificationSetting {
+	return s.NotificationSettings
+}
+
+// SetNotificationSettings sets the value of NotificationSettings.
+func (s *ListNotificationSettingsResponse) SetNotificationSettings(val []NotificationSetting) {
+	s.NotificationSettings = val
+}
+
+func (*ListNotificationSettingsResponse) listNotificationSettingsRes() {}
+
+// Ref: #/components/schemas/ListProjectKeysResponse
+type ListProjectKeysResponse struct {
+	Keys []ProjectKey `json:"keys"`
+}
+
+// GetKeys returns the value of Keys.
+func (s *ListProjectKeysResponse) GetKeys() []ProjectKey {
+	return s.Keys
+}
+
+// SetKeys sets the value of Keys.
+func (s *ListProjectKeysResponse) SetKeys(val []ProjectKey) {
+	s.Keys = val
+}
+
+func (*ListProjectKeysResponse) listProjectKeysRes() {}
+
+type ListProjectsResponse []Project
+
+func (*ListProjectsResponse) listProjectsRes()       {}
+func (*ListProjectsResponse) recentProjectsListRes() {}
+
+type ListTeamsResponse []Team
+
+func (*ListTeamsResponse) listTeamsRes() {}
+
+// Ref: #/components/schemas/ListTransactionsResponse
+type ListTransactionsResponse struct {
+	Transactions []TransactionSummary `json:"transactions"`
+}
+
+// GetTransactions returns the value of Transactions.
+func (s *ListTransactionsResponse) GetTransactions() []TransactionSummary {
+	return s.Transactions
+}
+
+// SetTransactions sets the value of Transactions.
+func (s *ListTransactionsResponse) SetTransactions(val []TransactionSummary) {
+	s.Transactions = val
+}
+
+func (*ListTransactionsResponse) listProjectTransactionsRes() {}
+
+type ListUsersResponse []User
+
+func (*ListUsersResponse) listUsersForTeamRes() {}
+func (*ListUsersResponse) listUsersRes()        {}
+
+// Ref: #/components/schemas/LoginRequest
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// GetUsername returns the value of Username.
+func (s *LoginRequest) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginRequest) GetPassword() string {
+	return s.Password
+}
+
+// SetUsername sets the value of Username.
+func (s *LoginRequest) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginRequest) SetPassword(val string) {
+	s.Password = val
+}
+
+// Ref: #/components/schemas/LoginResponse
+type LoginResponse struct {
+	AccessToken   string `json:"access_token"`
+	RefreshToken  string `json:"refresh_token"`
+	ExpiresIn     int    `json:"expires_in"`
+	IsTmpPassword bool   `json:"is_tmp_password"`
+}
+
+// GetAccessToken returns the value of AccessToken.
+func (s *LoginResponse) GetAccessToken() string {
+	return s.AccessToken
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *LoginResponse) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *LoginResponse) GetExpiresIn() int {
+	return s.ExpiresIn
+}
+
+// GetIsTmpPassword returns the value of IsTmpPassword.
+func (s *LoginResponse) GetIsTmpPassword() bool {
+	return s.IsTmpPassword
+}
+
+// SetAccessToken sets the value of AccessToken.
+func (s *LoginResponse) SetAccessToken(val string) {
+	s.AccessToken = val
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *LoginResponse) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *LoginResponse) SetExpiresIn(val int) {
+	s.ExpiresIn = val
+}
+
+// SetIsTmpPassword sets the value of IsTmpPassword.
+func (s *LoginResponse) SetIsTmpPassword(val bool) {
+	s.IsTmpPassword = val
+}
+
+func (*LoginResponse) loginRes() {}
+
+// MarkAllNotificationsAsReadNoContent is response for MarkAllNotificationsAsRead operation.
+type MarkAllNotificationsAsReadNoContent struct{}
+
+func (*MarkAllNotificationsAsReadNoContent) markAllNotificationsAsReadRes() {}
+
+// MarkNotificationAsReadNoContent is response for MarkNotificationAsRead operation.
+type MarkNotificationAsReadNoContent struct{}
+
+func (*MarkNotificationAsReadNoContent) markNotificationAsReadRes() {}
+
+// Ref: #/components/schemas/MergeIssuesRequest
+type MergeIssuesRequest struct {
+	PrimaryIssueID uint   `json:"primary_issue_id"`
+	IssueIds       []uint `json:"issue_ids"`
+}
+
+// GetPrimaryIssueID returns the value of PrimaryIssueID.
+func (s *MergeIssuesRequest) GetPrimaryIssueID() uint {
+	return s.PrimaryIssueID
+}
+
+// GetIssueIds returns the value of IssueIds.
+func (s *MergeIssuesRequest) GetIssueIds() []uint {
+	return s.IssueIds
+}
+
+// SetPrimaryIssueID sets the value of PrimaryIssueID.
+func (s *MergeIssuesRequest) SetPrimaryIssueID(val uint) {
+	s.PrimaryIssueID = val
+}
+
+// SetIssueIds sets the value of IssueIds.
+func (s *MergeIssuesRequest) SetIssueIds(val []uint) {
+	s.IssueIds = val
+}
+
+// Ref: #/components/schemas/Monitor
+type Monitor struct {
+	ID           uint                `json:"id"`
+	ProjectID    uint                `json:"project_id"`
+	Slug         string              `json:"slug"`
+	ScheduleType MonitorScheduleType `json:"schedule_type"`
+	// Crontab expression or interval like "30 minute".
+	Schedule string `json:"schedule"`
+	Timezone string `json:"timezone"`
+	// Minutes a check-in may be late before it's considered missed.
+	CheckinMargin uint `json:"checkin_margin"`
+	// Minutes an in progress check-in may run before it's considered timed out.
+	MaxRuntime    uint           `json:"max_runtime"`
+	Status        MonitorStatus  `json:"status"`
+	LastCheckInAt OptNilDateTime `json:"last_check_in_at"`
+	NextCheckInAt OptNilDateTime `json:"next_check_in_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Monitor) GetID() uint {
+	return s.ID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *Monitor) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetSlug returns the value of Slug.
+func (s *Monitor) GetSlug() string {
+	return s.Slug
+}
+
+// GetScheduleType returns the value of ScheduleType.
+func (s *Monitor) GetScheduleType() MonitorScheduleType {
+	return s.ScheduleType
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *Monitor) GetSchedule() string {
+	return s.Schedule
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *Monitor) GetTimezone() string {
+	return s.Timezone
+}
+
+// GetCheckinMargin returns the value of CheckinMargin.
+func (s *Monitor) GetCheckinMargin() uint {
+	return s.CheckinMargin
+}
+
+// GetMaxRuntime returns the value of MaxRuntime.
+func (s *Monitor) GetMaxRuntime() uint {
+	return s.MaxRuntime
+}
+
+// GetStatus returns the value of Status.
+func (s *Monitor) GetStatus() MonitorStatus {
+	return s.Status
+}
+
+// GetLastCheckInAt returns the value of LastCheckInAt.
+func (s *Monitor) GetLastCheckInAt() OptNilDateTime {
+	return s.LastCheckInAt
+}
+
+// GetNextCheckInAt returns the value of NextCheckInAt.
+func (s *Monitor) GetNextCheckInAt() OptNilDateTime {
+	return s.NextCheckInAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Monitor) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Monitor) SetID(val uint) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *Monitor) SetProjectID(val uint) {
+	s.ProjectID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *Monitor) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetScheduleType sets the value of ScheduleType.
+func (s *Monitor) SetScheduleType(val MonitorScheduleType) {
+	s.ScheduleType = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *Monitor) SetSchedule(val string) {
+	s.Schedule = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *Monitor) SetTimezone(val string) {
+	s.Timezone = val
+}
+
+// SetCheckinMargin sets the value of CheckinMargin.
+func (s *Monitor) SetCheckinMargin(val uint) {
+	s.CheckinMargin = val
+}
+
+// SetMaxRuntime sets the value of MaxRuntime.
+func (s *Monitor) SetMaxRuntime(val uint) {
+	s.MaxRuntime = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Monitor) SetStatus(val MonitorStatus) {
+	s.Status = val
+}
+
+// SetLastCheckInAt sets the value of LastCheckInAt.
+func (s *Monitor) SetLastCheckInAt(val OptNilDateTime) {
+	s.LastCheckInAt = val
+}
+
+// SetNextCheckInAt sets the value of NextCheckInAt.
+func (s *Monitor) SetNextCheckInAt(val OptNilDateTime) {
+	s.NextCheckInAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Monitor) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/MonitorCheckIn
+type MonitorCheckIn struct {
+	ID        uint                 `json:"id"`
+	CheckInID string               `json:"check_in_id"`
+	Status    MonitorCheckInStatus `json:"status"`
+	// Job duration in seconds.
+	Duration    OptNilFloat64  `json:"duration"`
+	Environment string         `json:"environment"`
+	ExpectedAt  OptNilDateTime `json:"expected_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *MonitorCheckIn) GetID() uint {
+	return s.ID
+}
+
+// GetCheckInID returns the value of CheckInID.
+func (s *MonitorCheckIn) GetCheckInID() string {
+	return s.CheckInID
+}
+
+// GetStatus returns the value of Status.
+func (s *MonitorCheckIn) GetStatus() MonitorCheckInStatus {
+	return s.Status
+}
+
+// GetDuration returns the value of Duration.
+func (s *MonitorCheckIn) GetDuration() OptNilFloat64 {
+	return s.Duration
+}
+
+// GetEnvironment returns the value of Environment.
+func (s *MonitorCheckIn) GetEnvironment() string {
+	return s.Environment
+}
+
+// GetExpectedAt returns the value of ExpectedAt.
+func (s *MonitorCheckIn) GetExpectedAt() OptNilDateTime {
+	return s.ExpectedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *MonitorCheckIn) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *MonitorCheckIn) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *MonitorCheckIn) SetID(val uint) {
+	s.ID = val
+}
+
+// SetCheckInID sets the value of CheckInID.
+func (s *MonitorCheckIn) SetCheckInID(val string) {
+	s.CheckInID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *MonitorCheckIn) SetStatus(val MonitorCheckInStatus) {
+	s.Status = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *MonitorCheckIn) SetDuration(val OptNilFloat64) {
+	s.Duration = val
+}
+
+// SetEnvironment sets the value of Environment.
+func (s *MonitorCheckIn) SetEnvironment(val string) {
+	s.Environment = val
+}
+
+// SetExpectedAt sets the value of ExpectedAt.
+func (s *MonitorCheckIn) SetExpectedAt(val OptNilDateTime) {
+	s.ExpectedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *MonitorCheckIn) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *MonitorCheckIn) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+type MonitorCheckInStatus string
+
+const (
+	MonitorCheckInStatusInProgress MonitorCheckInStatus = "in_progress"
+	MonitorCheckInStatusOk         MonitorCheckInStatus = "ok"
+	MonitorCheckInStatusError      MonitorCheckInStatus = "error"
+	MonitorCheckInStatusMissed     MonitorCheckInStatus = "missed"
+	MonitorCheckInStatusTimeout    MonitorCheckInStatus = "timeout"
+)
+
+// AllValues returns all MonitorCheckInStatus values.
+func (MonitorCheckInStatus) AllValues() []MonitorCheckInStatus {
+	return []MonitorCheckInStatus{
+		MonitorCheckInStatusInProgress,
+		MonitorCheckInStatusOk,
+		MonitorCheckInStatusError,
+		MonitorCheckInStatusMissed,
+		MonitorCheckInStatusTimeout,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MonitorCheckInStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case MonitorCheckInStatusInProgress:
+		return []byte(s), nil
+	case MonitorCheckInStatusOk:
+		return []byte(s), nil
+	case MonitorCheckInStatusError:
+		return []byte(s), nil
+	case MonitorCheckInStatusMissed:
+		return []byte(s), nil
+	case MonitorCheckInStatusTimeout:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MonitorCheckInStatus) UnmarshalText(data []byte) error {
+	switch MonitorCheckInStatus(data) {
+	case MonitorCheckInStatusInProgress:
+		*s = MonitorCheckInStatusInProgress
+		return nil
+	case MonitorCheckInStatusOk:
+		*s = MonitorCheckInStatusOk
+		return nil
+	case MonitorCheckInStatusError:
+		*s = MonitorCheckInStatusError
+		return nil
+	case MonitorCheckInStatusMissed:
+		*s = MonitorCheckInStatusMissed
+		return nil
+	case MonitorCheckInStatusTimeout:
+		*s = MonitorCheckInStatusTimeout
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type MonitorScheduleType string
+
+const (
+	MonitorScheduleTypeCrontab  MonitorScheduleType = "crontab"
+	MonitorScheduleTypeInterval MonitorScheduleType = "interval"
+)
+
+// AllValues returns all MonitorScheduleType values.
+func (MonitorScheduleType) AllValues() []MonitorScheduleType {
+	return []MonitorScheduleType{
+		MonitorScheduleTypeCrontab,
+		MonitorScheduleTypeInterval,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MonitorScheduleType) MarshalText() ([]byte, error) {
+	switch s {
+	case MonitorScheduleTypeCrontab:
+		return []byte(s), nil
+	case MonitorScheduleTypeInterval:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MonitorScheduleType) UnmarshalText(data []byte) error {
+	switch MonitorScheduleType(data) {
+	case MonitorScheduleTypeCrontab:
+		*s = MonitorScheduleTypeCrontab
+		return nil
+	case MonitorScheduleTypeInterval:
+		*s = MonitorScheduleTypeInterval
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type MonitorStatus string
+
+const (
+	MonitorStatusOk      MonitorStatus = "ok"
+	MonitorStatusError   MonitorStatus = "error"
+	MonitorStatusMissed  MonitorStatus = "missed"
+	MonitorStatusTimeout MonitorStatus = "timeout"
+)
+
+// AllValues returns all MonitorStatus values.
+func (MonitorStatus) AllValues() []MonitorStatus {
+	return []MonitorStatus{
+		MonitorStatusOk,
+		MonitorStatusError,
+		MonitorStatusMissed,
+		MonitorStatusTimeout,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MonitorStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case MonitorStatusOk:
+		return []byte(s), nil
+	case MonitorStatusError:
+		return []byte(s), nil
+	case MonitorStatusMissed:
+		return []byte(s), nil
+	case MonitorStatusTimeout:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MonitorStatus) UnmarshalText(data []byte) error {
+	switch MonitorStatus(data) {
+	case MonitorStatusOk:
+		*s = MonitorStatusOk
+		return nil
+	case MonitorStatusError:
+		*s = MonitorStatusError
+		return nil
+	case MonitorStatusMissed:
+		*s = MonitorStatusMissed
+		return nil
+	case MonitorStatusTimeout:
+		*s = MonitorStatusTimeout
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/MonitorWithCheckIns
+type MonitorWithCheckIns struct {
+	Monitor Monitor `json:"monitor"`
+	// Recent check-ins, newest first.
+	CheckIns []MonitorCheckIn `json:"check_ins"`
+}
+
+// GetMonitor returns the value of Monitor.
+func (s *MonitorWithCheckIns) GetMonitor() Monitor {
+	return s.Monitor
+}
+
+// GetCheckIns returns the value of CheckIns.
+func (s *MonitorWithCheckIns) GetCheckIns() []MonitorCheckIn {
+	return s.CheckIns
+}
+
+// SetMonitor sets the value of Monitor.
+func (s *MonitorWithCheckIns) SetMonitor(val Monitor) {
+	s.Monitor = val
+}
+
+// SetCheckIns sets the value of CheckIns.
+func (s *MonitorWithCheckIns) SetCheckIns(val []MonitorCheckIn) {
+	s.CheckIns = val
+}
+
+func (*MonitorWithCheckIns) getMonitorRes() {}
+
+// Type of notification channel (email, mattermost, slack, etc.).
+// Ref: #/components/schemas/NotificationChannelType
+type NotificationChannelType string
+
+const (
+	NotificationChannelTypeEmail      NotificationChannelType = "email"
+	NotificationChannelTypeTelegram   NotificationChannelType = "telegram"
+	NotificationChannelTypeSlack      NotificationChannelType = "slack"
+	NotificationChannelTypeMattermost NotificationChannelType = "mattermost"
+	NotificationChannelTypeWebhook    NotificationChannelType = "webhook"
+	NotificationChannelTypePachca     NotificationChannelType = "pachca"
+)
+
+// AllValues returns all NotificationChannelType values.
+func (NotificationChannelType) AllValues() []NotificationChannelType {
+	return []NotificationChannelType{
+		NotificationChannelTypeEmail,
+		NotificationChannelTypeTelegram,
+		NotificationChannelTypeSlack,
+		NotificationChannelTypeMattermost,
+		NotificationChannelTypeWebhook,
+		NotificationChannelTypePachca,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationChannelType) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationChannelTypeEmail:
+		return []byte(s), nil
+	case NotificationChannelTypeTelegram:
+		return []byte(s), nil
+	case NotificationChannelTypeSlack:
+		return []byte(s), nil
+	case NotificationChannelTypeMattermost:
+		return []byte(s), nil
+	case NotificationChannelTypeWebhook:
+		return []byte(s), nil
+	case NotificationChannelTypePachca:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationChannelType) UnmarshalText(data []byte) error {
+	switch NotificationChannelType(data) {
+	case NotificationChannelTypeEmail:
+		*s = NotificationChannelTypeEmail
+		return nil
+	case NotificationChannelTypeTelegram:
+		*s = NotificationChannelTypeTelegram
+		return nil
+	case NotificationChannelTypeSlack:
+		*s = NotificationChannelTypeSlack
+		return nil
+	case NotificationChannelTypeMattermost:
+		*s = NotificationChannelTypeMattermost
+		return nil
+	case NotificationChannelTypeWebhook:
+		*s = NotificationChannelTypeWebhook
+		return nil
+	case NotificationChannelTypePachca:
+		*s = NotificationChannelTypePachca
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NotificationRule
+type NotificationRule struct {
+	ID                    uint `json:"id"`
+	NotificationSettingID uint `json:"notification_setting_id"`
+	// Level of event to trigger notification (error, warning, info, etc.).
+	EventLevel OptNilString `json:"event_level"`
+	// Specific error fingerprint to trigger notification.
+	Fingerprint OptNilString `json:"fingerprint"`
+	// Trigger only for new errors.
+	IsNewError OptNilBool `json:"is_new_error"`
+	// Trigger only for regressions (resolved -> unresolved).
+	IsRegression OptNilBool `json:"is_regression"`
+	// Environment of events to trigger notification, all environments when not set.
+	Environment OptNilString `json:"environment"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationRule) GetID() uint {
+	return s.ID
+}
+
+// GetNotificationSettingID returns the value of NotificationSettingID.
+func (s *NotificationRule) GetNotificationSettingID() uint {
+	return s.NotificationSettingID
+}
+
+// GetEventLevel returns the value of EventLevel.
+func (s *NotificationRule) GetEventLevel() OptNilString {
+	return s.EventLevel
+}
+
+// GetFingerprint returns the value of Fingerprint.
+func (s *NotificationRule) GetFingerprint() OptNilString {
+	return s.Fingerprint
+}
+
+// GetIsNewError returns the value of IsNewError.
+func (s *NotificationRule) GetIsNewError() OptNilBool {
+	return s.IsNewError
+}
+
+// GetIsRegression returns the value of IsRegression.
+func (s *NotificationRule) GetIsRegression() OptNilBool {
+	return s.IsRegression
+}
+
+// GetEnvironment returns the value of Environment.
+func (s *NotificationRule) GetEnvironment() OptNilString {
+	return s.Environment
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NotificationRule) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *NotificationRule) SetID(val uint) {
+	s.ID = val
+}
+
+// SetNotificationSettingID sets the value of NotificationSettingID.
+func (s *NotificationRule) SetNotificationSettingID(val uint) {
+	s.NotificationSettingID = val
+}
+
+// SetEventLevel sets the value of EventLevel.
+func (s *NotificationRule) SetEventLevel(val OptNilString) {
+	s.EventLevel = val
+}
+
+// SetFingerprint sets the value of Fingerprint.
+func (s *NotificationRule) SetFingerprint(val OptNilString) {
+	s.Fingerprint = val
+}
+
+// SetIsNewError sets the value of IsNewError.
+func (s *NotificationRule) SetIsNewError(val OptNilBool) {
+	s.IsNewError = val
+}
+
+// SetIsRegression sets the value of IsRegression.
+func (s *NotificationRule) SetIsRegression(val OptNilBool) {
+	s.IsRegression = val
+}
+
+// SetEnvironment sets the value of Environment.
+func (s *NotificationRule) SetEnvironment(val OptNilString) {
+	s.Environment = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NotificationRule) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*NotificationRule) createNotificationRuleRes() {}
+func (*NotificationRule) getNotificationRuleRes()    {}
+func (*NotificationRule) updateNotificationRuleRes() {}
+
+// Ref: #/components/schemas/NotificationSetting
+type NotificationSetting struct {
+	ID        uint `json:"id"`
+	ProjectID uint `json:"project_id"`
+	// Type of notification channel (email, mattermost, slack, etc.).
+	Type string `json:"type"`
+	// Configuration for the notification channel (JSONB in database).
+	Config  string `json:"config"`
+	Enabled bool   `json:"enabled"`
+	// Alerts about assigned issues are emailed to the assignee instead of this channel.
+	RouteToAssignee bool      `json:"route_to_assignee"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *NotificationSetting) GetID() uint {
+	return s.ID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *NotificationSetting) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetType returns the value of Type.
+func (s *NotificationSetting) GetType() string {
+	return s.Type
+}
+
+// GetConfig returns the value of Config.
+func (s *NotificationSetting) GetConfig() string {
+	return s.Config
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *NotificationSetting) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetRouteToAssignee returns the value of RouteToAssignee.
+func (s *NotificationSetting) GetRouteToAssignee() bool {
+	return s.RouteToAssignee
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NotificationSetting) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *NotificationSetting) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *NotificationSetting) SetID(val uint) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *NotificationSetting) SetProjectID(val uint) {
+	s.ProjectID = val
+}
+
+// SetType sets the value of Type.
+func (s *NotificationSetting) SetType(val string) {
+	s.Type = val
+}
+
+// SetConfig sets the value of Config.
+func (s *NotificationSetting) SetConfig(val string) {
+	s.Config = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *NotificationSetting) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetRouteToAssignee sets the value of RouteToAssignee.
+func (s *NotificationSetting) SetRouteToAssignee(val bool) {
+	s.RouteToAssignee = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NotificationSetting) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *NotificationSetting) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*NotificationSetting) createNotificationSettingRes() {}
+func (*NotificationSetting) getNotificationSettingRes()    {}
+func (*NotificationSetting) updateNotificationSettingRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCategoryRateLimits returns new OptCategoryRateLimits with value set to v.
+func NewOptCategoryRateLimits(v CategoryRateLimits) OptCategoryRateLimits {
+	return OptCategoryRateLimits{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCategoryRateLimits is optional CategoryRateLimits.
+type OptCategoryRateLimits struct {
+	Value CategoryRateLimits
+	Set   bool
+}
+
+// IsSet returns true if OptCategoryRateLimits was set.
+func (o OptCategoryRateLimits) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCategoryRateLimits) Reset() {
+	var v CategoryRateLimits
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCategoryRateLimits) SetTo(v CategoryRateLimits) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCategoryRateLimits) Get() (v CategoryRateLimits, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCategoryRateLimits) Or(d CategoryRateLimits) CategoryRateLimits {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventBreadcrumbData returns new OptEventBreadcrumbData with value set to v.
+func NewOptEventBreadcrumbData(v EventBreadcrumbData) OptEventBreadcrumbData {
+	return OptEventBreadcrumbData{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventBreadcrumbData is optional EventBreadcrumbData.
+type OptEventBreadcrumbData struct {
+	Value EventBreadcrumbData
+	Set   bool
+}
+
+// IsSet returns true if OptEventBreadcrumbData was set.
+func (o OptEventBreadcrumbData) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventBreadcrumbData) Reset() {
+	var v EventBreadcrumbData
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventBreadcrumbData) SetTo(v EventBreadcrumbData) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventBreadcrumbData) Get() (v EventBreadcrumbData, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventBreadcrumbData) Or(d EventBreadcrumbData) EventBreadcrumbData {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventSDK returns new OptEventSDK with value set to v.
+func NewOptEventSDK(v EventSDK) OptEventSDK {
+	return OptEventSDK{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventSDK is optional EventSDK.
+type OptEventSDK struct {
+	Value EventSDK
+	Set   bool
+}
+
+// IsSet returns true if OptEventSDK was set.
+func (o OptEventSDK) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventSDK) Reset() {
+	var v EventSDK
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventSDK) SetTo(v EventSDK) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventSDK) Get() (v EventSDK, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventSDK) Or(d EventSDK) EventSDK {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat32 returns new OptFloat32 with value set to v.
+func NewOptFloat32(v float32) OptFloat32 {
+	return OptFloat32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat32 is optional float32.
+type OptFloat32 struct {
+	Value float32
+	Set   bool
+}
+
+// IsSet returns true if OptFloat32 was set.
+func (o OptFloat32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat32) Reset() {
+	var v float32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat32) SetTo(v float32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat32) Get() (v float32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat32) Or(d float32) float32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetProjectReleaseErrorsTimeseriesGroupBy returns new OptGetProjectReleaseErrorsTimeseriesGroupBy with value set to v.
+func NewOptGetProjectReleaseErrorsTimeseriesGroupBy(v GetProjectReleaseErrorsTimeseriesGroupBy) OptGetProjectReleaseErrorsTimeseriesGroupBy {
+	return OptGetProjectReleaseErrorsTimeseriesGroupBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetProjectReleaseErrorsTimeseriesGroupBy is optional GetProjectReleaseErrorsTimeseriesGroupBy.
+type OptGetProjectReleaseErrorsTimeseriesGroupBy struct {
+	Value GetProjectReleaseErrorsTimeseriesGroupBy
+	Set   bool
+}
+
+// IsSet returns true if OptGetProjectReleaseErrorsTimeseriesGroupBy was set.
+func (o OptGetProjectReleaseErrorsTimeseriesGroupBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetProjectReleaseErrorsTimeseriesGroupBy) Reset() {
+	var v GetProjectReleaseErrorsTimeseriesGroupBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetProjectReleaseErrorsTimeseriesGroupBy) SetTo(v GetProjectReleaseErrorsTimeseriesGroupBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetProjectReleaseErrorsTimeseriesGroupBy) Get() (v GetProjectReleaseErrorsTimeseriesGroupBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetProjectReleaseErrorsTimeseriesGroupBy) Or(d GetProjectReleaseErrorsTimeseriesGroupBy) GetProjectReleaseErrorsTimeseriesGroupBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueAssignedFilter returns new OptIssueAssignedFilter with value set to v.
+func NewOptIssueAssignedFilter(v IssueAssignedFilter) OptIssueAssignedFilter {
+	return OptIssueAssignedFilter{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueAssignedFilter is optional IssueAssignedFilter.
+type OptIssueAssignedFilter struct {
+	Value IssueAssignedFilter
+	Set   bool
+}
+
+// IsSet returns true if OptIssueAssignedFilter was set.
+func (o OptIssueAssignedFilter) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueAssignedFilter) Reset() {
+	var v IssueAssignedFilter
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueAssignedFilter) SetTo(v IssueAssignedFilter) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueAssignedFilter) Get() (v IssueAssignedFilter, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueAssignedFilter) Or(d IssueAssignedFilter) IssueAssignedFilter {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueAssigneeRequest returns new OptIssueAssigneeRequest with value set to v.
+func NewOptIssueAssigneeRequest(v IssueAssigneeRequest) OptIssueAssigneeRequest {
+	return OptIssueAssigneeRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueAssigneeRequest is optional IssueAssigneeRequest.
+type OptIssueAssigneeRequest struct {
+	Value IssueAssigneeRequest
+	Set   bool
+}
+
+// IsSet returns true if OptIssueAssigneeRequest was set.
+func (o OptIssueAssigneeRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueAssigneeRequest) Reset() {
+	var v IssueAssigneeRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueAssigneeRequest) SetTo(v IssueAssigneeRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueAssigneeRequest) Get() (v IssueAssigneeRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueAssigneeRequest) Or(d IssueAssigneeRequest) IssueAssigneeRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueBulkFilter returns new OptIssueBulkFilter with value set to v.
+func NewOptIssueBulkFilter(v IssueBulkFilter) OptIssueBulkFilter {
+	return OptIssueBulkFilter{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueBulkFilter is optional IssueBulkFilter.
+type OptIssueBulkFilter struct {
+	Value IssueBulkFilter
+	Set   bool
+}
+
+// IsSet returns true if OptIssueBulkFilter was set.
+func (o OptIssueBulkFilter) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueBulkFilter) Reset() {
+	var v IssueBulkFilter
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueBulkFilter) SetTo(v IssueBulkFilter) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueBulkFilter) Get() (v IssueBulkFilter, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueBulkFilter) Or(d IssueBulkFilter) IssueBulkFilter {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueEventPayload returns new OptIssueEventPayload with value set to v.
+func NewOptIssueEventPayload(v IssueEventPayload) OptIssueEventPayload {
+	return OptIssueEventPayload{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueEventPayload is optional IssueEventPayload.
+type OptIssueEventPayload struct {
+	Value IssueEventPayload
+	Set   bool
+}
+
+// IsSet returns true if OptIssueEventPayload was set.
+func (o OptIssueEventPayload) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueEventPayload) Reset() {
+	var v IssueEventPayload
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueEventPayload) SetTo(v IssueEventPayload) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueEventPayload) Get() (v IssueEventPayload, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueEventPayload) Or(d IssueEventPayload) IssueEventPayload {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueEventTags returns new OptIssueEventTags with value set to v.
+func NewOptIssueEventTags(v IssueEventTags) OptIssueEventTags {
+	return OptIssueEventTags{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueEventTags is optional IssueEventTags.
+type OptIssueEventTags struct {
+	Value IssueEventTags
+	Set   bool
+}
+
+// IsSet returns true if OptIssueEventTags was set.
+func (o OptIssueEventTags) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueEventTags) Reset() {
+	var v IssueEventTags
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueEventTags) SetTo(v IssueEventTags) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueEventTags) Get() (v IssueEventTags, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueEventTags) Or(d IssueEventTags) IssueEventTags {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueIgnoreConditions returns new OptIssueIgnoreConditions with value set to v.
+func NewOptIssueIgnoreConditions(v IssueIgnoreConditions) OptIssueIgnoreConditions {
+	return OptIssueIgnoreConditions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueIgnoreConditions is optional IssueIgnoreConditions.
+type OptIssueIgnoreConditions struct {
+	Value IssueIgnoreConditions
+	Set   bool
+}
+
+// IsSet returns true if OptIssueIgnoreConditions was set.
+func (o OptIssueIgnoreConditions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueIgnoreConditions) Reset() {
+	var v IssueIgnoreConditions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueIgnoreConditions) SetTo(v IssueIgnoreConditions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueIgnoreConditions) Get() (v IssueIgnoreConditions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueIgnoreConditions) Or(d IssueIgnoreConditions) IssueIgnoreConditions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueLevel returns new OptIssueLevel with value set to v.
+func NewOptIssueLevel(v IssueLevel) OptIssueLevel {
+	return OptIssueLevel{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueLevel is optional IssueLevel.
+type OptIssueLevel struct {
+	Value IssueLevel
+	Set   bool
+}
+
+// IsSet returns true if OptIssueLevel was set.
+func (o OptIssueLevel) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueLevel) Reset() {
+	var v IssueLevel
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueLevel) SetTo(v IssueLevel) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueLevel) Get() (v IssueLevel, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueLevel) Or(d IssueLevel) IssueLevel {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueSortColumn returns new OptIssueSortColumn with value set to v.
+func NewOptIssueSortColumn(v IssueSortColumn) OptIssueSortColumn {
+	return OptIssueSortColumn{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueSortColumn is optional IssueSortColumn.
+type OptIssueSortColumn struct {
+	Value IssueSortColumn
+	Set   bool
+}
+
+// IsSet returns true if OptIssueSortColumn was set.
+func (o OptIssueSortColumn) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueSortColumn) Reset() {
+	var v IssueSortColumn
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueSortColumn) SetTo(v IssueSortColumn) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueSortColumn) Get() (v IssueSortColumn, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueSortColumn) Or(d IssueSortColumn) IssueSortColumn {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueStatus returns new OptIssueStatus with value set to v.
+func NewOptIssueStatus(v IssueStatus) OptIssueStatus {
+	return OptIssueStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueStatus is optional IssueStatus.
+type OptIssueStatus struct {
+	Value IssueStatus
+	Set   bool
+}
+
+// IsSet returns true if OptIssueStatus was set.
+func (o OptIssueStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueStatus) Reset() {
+	var v IssueStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueStatus) SetTo(v IssueStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueStatus) Get() (v IssueStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueStatus) Or(d IssueStatus) IssueStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBool returns new OptNilBool with value set to v.
+func NewOptNilBool(v bool) OptNilBool {
+	return OptNilBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBool is optional nullable bool.
+type OptNilBool struct {
+	Value bool
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBool was set.
+func (o OptNilBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBool) SetTo(v bool) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBool) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBool) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v bool
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBool) Get() (v bool, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilIssueEventRequestHeaders returns new OptNilIssueEventRequestHeaders with value set to v.
+func NewOptNilIssueEventRequestHeaders(v IssueEventRequestHeaders) OptNilIssueEventRequestHeaders {
+	return OptNilIssueEventRequestHeaders{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilIssueEventRequestHeaders is optional nullable IssueEventRequestHeaders.
+type OptNilIssueEventRequestHeaders struct {
+	Value IssueEventRequestHeaders
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilIssueEventRequestHeaders was set.
+func (o OptNilIssueEventRequestHeaders) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilIssueEventRequestHeaders) Reset() {
+	var v IssueEventRequestHeaders
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilIssueEventRequestHeaders) SetTo(v IssueEventRequestHeaders) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilIssueEventRequestHeaders) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilIssueEventRequestHeaders) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v IssueEventRequestHeaders
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilIssueEventRequestHeaders) Get() (v IssueEventRequestHeaders, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilIssueEventRequestHeaders) Or(d IssueEventRequestHeaders) IssueEventRequestHeaders {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUint returns new OptNilUint with value set to v.
+func NewOptNilUint(v uint) OptNilUint {
+	return OptNilUint{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUint is optional nullable uint.
+type OptNilUint struct {
+	Value uint
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUint was set.
+func (o OptNilUint) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUint) Reset() {
+	var v uint
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUint) SetTo(v uint) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilUint) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilUint) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uint
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUint) Get() (v uint, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUint) Or(d uint) uint {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReleaseAnalyticsDetailsSegmentsBrowserName returns new OptReleaseAnalyticsDetailsSegmentsBrowserName with value set to v.
+func NewOptReleaseAnalyticsDetailsSegmentsBrowserName(v ReleaseAnalyticsDetailsSegmentsBrowserName) OptReleaseAnalyticsDetailsSegmentsBrowserName {
+	return OptReleaseAnalyticsDetailsSegmentsBrowserName{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReleaseAnalyticsDetailsSegmentsBrowserName is optional ReleaseAnalyticsDetailsSegmentsBrowserName.
+type OptReleaseAnalyticsDetailsSegmentsBrowserName struct {
+	Value ReleaseAnalyticsDetailsSegmentsBrowserName
+	Set   bool
+}
+
+// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsBrowserName was set.
+func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReleaseAnalyticsDetailsSegmentsBrowserName) Reset() {
+	var v ReleaseAnalyticsDetailsSegmentsBrowserName
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReleaseAnalyticsDetailsSegmentsBrowserName) SetTo(v ReleaseAnalyticsDetailsSegmentsBrowserName) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) Get() (v ReleaseAnalyticsDetailsSegmentsBrowserName, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) Or(d ReleaseAnalyticsDetailsSegmentsBrowserName) ReleaseAnalyticsDetailsSegmentsBrowserName {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReleaseAnalyticsDetailsSegmentsDeviceArch returns new OptReleaseAnalyticsDetailsSegmentsDeviceArch with value set to v.
+func NewOptReleaseAnalyticsDetailsSegmentsDeviceArch(v ReleaseAnalyticsDetailsSegmentsDeviceArch) OptReleaseAnalyticsDetailsSegmentsDeviceArch {
+	return OptReleaseAnalyticsDetailsSegmentsDeviceArch{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReleaseAnalyticsDetailsSegmentsDeviceArch is optional ReleaseAnalyticsDetailsSegmentsDeviceArch.
+type OptReleaseAnalyticsDetailsSegmentsDeviceArch struct {
+	Value ReleaseAnalyticsDetailsSegmentsDeviceArch
+	Set   bool
+}
+
+// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsDeviceArch was set.
+func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReleaseAnalyticsDetailsSegmentsDeviceArch) Reset() {
+	var v ReleaseAnalyticsDetailsSegmentsDeviceArch
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReleaseAnalyticsDetailsSegmentsDeviceArch) SetTo(v ReleaseAnalyticsDetailsSegmentsDeviceArch) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) Get() (v ReleaseAnalyticsDetailsSegmentsDeviceArch, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) Or(d ReleaseAnalyticsDetailsSegmentsDeviceArch) ReleaseAnalyticsDetailsSegmentsDeviceArch {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReleaseAnalyticsDetailsSegmentsOsName returns new OptReleaseAnalyticsDetailsSegmentsOsName with value set to v.
+func NewOptReleaseAnalyticsDetailsSegmentsOsName(v ReleaseAnalyticsDetailsSegmentsOsName) OptReleaseAnalyticsDetailsSegmentsOsName {
+	return OptReleaseAnalyticsDetailsSegmentsOsName{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReleaseAnalyticsDetailsSegmentsOsName is optional ReleaseAnalyticsDetailsSegmentsOsName.
+type OptReleaseAnalyticsDetailsSegmentsOsName struct {
+	Value ReleaseAnalyticsDetailsSegmentsOsName
+	Set   bool
+}
+
+// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsOsName was set.
+func (o OptReleaseAnalyticsDetailsSegmentsOsName) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReleaseAnalyticsDetailsSegmentsOsName) Reset() {
+	var v ReleaseAnalyticsDetailsSegmentsOsName
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReleaseAnalyticsDetailsSegmentsOsName) SetTo(v ReleaseAnalyticsDetailsSegmentsOsName) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReleaseAnalyticsDetailsSegmentsOsName) Get() (v ReleaseAnalyticsDetailsSegmentsOsName, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReleaseAnalyticsDetailsSegmentsOsName) Or(d ReleaseAnalyticsDetailsSegmentsOsName) ReleaseAnalyticsDetailsSegmentsOsName {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReleaseAnalyticsDetailsSegmentsPlatform returns new OptReleaseAnalyticsDetailsSegmentsPlatform with value set to v.
+func NewOptReleaseAnalyticsDetailsSegmentsPlatform(v ReleaseAnalyticsDetailsSegmentsPlatform) OptReleaseAnalyticsDetailsSegmentsPlatform {
+	return OptReleaseAnalyticsDetailsSegmentsPlatform{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReleaseAnalyticsDetailsSegmentsPlatform is optional ReleaseAnalyticsDetailsSegmentsPlatform.
+type OptReleaseAnalyticsDetailsSegmentsPlatform struct {
+	Value ReleaseAnalyticsDetailsSegmentsPlatform
+	Set   bool
+}
+
+// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsPlatform was set.
+func (o OptReleaseAnalyticsDetailsSegmentsPlatform) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReleaseAnalyticsDetailsSegmentsPlatform) Reset() {
+	var v ReleaseAnalyticsDetailsSegmentsPlatform
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReleaseAnalyticsDetailsSegmentsPlatform) SetTo(v ReleaseAnalyticsDetailsSegmentsPlatform) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReleaseAnalyticsDetailsSegmentsPlatform) Get() (v ReleaseAnalyticsDetailsSegmentsPlatform, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReleaseAnalyticsDetailsSegmentsPlatform) Or(d ReleaseAnalyticsDetailsSegmentsPlatform) ReleaseAnalyticsDetailsSegmentsPlatform {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReleaseAnalyticsDetailsSegmentsRuntimeName returns new OptReleaseAnalyticsDetailsSegmentsRuntimeName with value set to v.
+func NewOptReleaseAnalyticsDetailsSegmentsRuntimeName(v ReleaseAnalyticsDetailsSegmentsRuntimeName) OptReleaseAnalyticsDetailsSegmentsRuntimeName {
+	return OptReleaseAnalyticsDetailsSegmentsRuntimeName{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReleaseAnalyticsDetailsSegmentsRuntimeName is optional ReleaseAnalyticsDetailsSegmentsRuntimeName.
+type OptReleaseAnalyticsDetailsSegmentsRuntimeName struct {
+	Value ReleaseAnalyticsDetailsSegmentsRuntimeName
+	Set   bool
+}
+
+// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsRuntimeName was set.
+func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReleaseAnalyticsDetailsSegmentsRuntimeName) Reset() {
+	var v ReleaseAnalyticsDetailsSegmentsRuntimeName
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReleaseAnalyticsDetailsSegmentsRuntimeName) SetTo(v ReleaseAnalyticsDetailsSegmentsRuntimeName) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) Get() (v ReleaseAnalyticsDetailsSegmentsRuntimeName, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) Or(d ReleaseAnalyticsDetailsSegmentsRuntimeName) ReleaseAnalyticsDetailsSegmentsRuntimeName {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSortOrder returns new OptSortOrder with value set to v.
+func NewOptSortOrder(v SortOrder) OptSortOrder {
+	return OptSortOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSortOrder is optional SortOrder.
+type OptSortOrder struct {
+	Value SortOrder
+	Set   bool
+}
+
+// IsSet returns true if OptSortOrder was set.
+func (o OptSortOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSortOrder) Reset() {
+	var v SortOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSortOrder) SetTo(v SortOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSortOrder) Get() (v SortOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSortOrder) Or(d SortOrder) SortOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTraceSpanTags returns new OptTraceSpanTags with value set to v.
+func NewOptTraceSpanTags(v TraceSpanTags) OptTraceSpanTags {
+	return OptTraceSpanTags{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTraceSpanTags is optional TraceSpanTags.
+type OptTraceSpanTags struct {
+	Value TraceSpanTags
+	Set   bool
+}
+
+// IsSet returns true if OptTraceSpanTags was set.
+func (o OptTraceSpanTags) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTraceSpanTags) Reset() {
+	var v TraceSpanTags
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTraceSpanTags) SetTo(v TraceSpanTags) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTraceSpanTags) Get() (v TraceSpanTags, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTraceSpanTags) Or(d TraceSpanTags) TraceSpanTags {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUint returns new OptUint with value set to v.
+func NewOptUint(v uint) OptUint {
+	return OptUint{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUint is optional uint.
+type OptUint struct {
+	Value uint
+	Set   bool
+}
+
+// IsSet returns true if OptUint was set.
+func (o OptUint) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUint) Reset() {
+	var v uint
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUint) SetTo(v uint) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUint) Get() (v uint, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUint) Or(d uint) uint {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Merged schema.
+// Ref: #/components/schemas/OutcomeBucket
+type OutcomeBucket struct {
+	Accepted    uint      `json:"accepted"`
+	Filtered    uint      `json:"filtered"`
+	RateLimited uint      `json:"rate_limited"`
+	Dropped     uint      `json:"dropped"`
+	Bucket      time.Time `json:"bucket"`
+}
+
+// GetAccepted returns the value of Accepted.
+func (s *OutcomeBucket) GetAccepted() uint {
+	return s.Accepted
+}
+
+// GetFiltered returns the value of Filtered.
+func (s *OutcomeBucket) GetFiltered() uint {
+	return s.Filtered
+}
+
+// GetRateLimited returns the value of RateLimited.
+func (s *OutcomeBucket) GetRateLimited() uint {
+	return s.RateLimited
+}
+
+// GetDropped returns the value of Dropped.
+func (s *OutcomeBucket) GetDropped() uint {
+	return s.Dropped
+}
+
+// GetBucket returns the value of Bucket.
+func (s *OutcomeBucket) GetBucket() time.Time {
+	return s.Bucket
+}
+
+// SetAccepted sets the value of Accepted.
+func (s *OutcomeBucket) SetAccepted(val uint) {
+	s.Accepted = val
+}
+
+// SetFiltered sets the value of Filtered.
+func (s *OutcomeBucket) SetFiltered(val uint) {
+	s.Filtered = val
+}
+
+// SetRateLimited sets the value of RateLimited.
+func (s *OutcomeBucket) SetRateLimited(val uint) {
+	s.RateLimited = val
+}
+
+// SetDropped sets the value of Dropped.
+func (s *OutcomeBucket) SetDropped(val uint) {
+	s.Dropped = val
+}
+
+// SetBucket sets the value of Bucket.
+func (s *OutcomeBucket) SetBucket(val time.Time) {
+	s.Bucket = val
+}
+
+// Ref: #/components/schemas/OutcomeItem
+type OutcomeItem struct {
+	Outcome  OutcomeItemOutcome `json:"outcome"`
+	Reason   string             `json:"reason"`
+	Category string             `json:"category"`
+	Group    OutcomeItemGroup   `json:"group"`
+	Quantity uint               `json:"quantity"`
+}
+
+// GetOutcome returns the value of Outcome.
+func (s *OutcomeItem) GetOutcome() OutcomeItemOutcome {
+	return s.Outcome
+}
+
+// GetReason returns the value of Reason.
+func (s *OutcomeItem) GetReason() string {
+	return s.Reason
+}
+
+// GetCategory returns the value of Category.
+func (s *OutcomeItem) GetCategory() string {
+	return s.Category
+}
+
+// GetGroup returns the value of Group.
+func (s *OutcomeItem) GetGroup() OutcomeItemGroup {
+	return s.Group
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *OutcomeItem) GetQuantity() uint {
+	return s.Quantity
+}
+
+// SetOutcome sets the value of Outcome.
+func (s *OutcomeItem) SetOutcome(val OutcomeItemOutcome) {
+	s.Outcome = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OutcomeItem) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetCategory sets the value of Category.
+func (s *OutcomeItem) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetGroup sets the value of Group.
+func (s *OutcomeItem) SetGroup(val OutcomeItemGroup) {
+	s.Group = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *OutcomeItem) SetQuantity(val uint) {
+	s.Quantity = val
+}
+
+type OutcomeItemGroup string
+
+const (
+	OutcomeItemGroupAccepted    OutcomeItemGroup = "accepted"
+	OutcomeItemGroupFiltered    OutcomeItemGroup = "filtered"
+	OutcomeItemGroupRateLimited OutcomeItemGroup = "rate_limited"
+	OutcomeItemGroupDropped     OutcomeItemGroup = "dropped"
+)
+
+// AllValues returns all OutcomeItemGroup values.
+func (OutcomeItemGroup) AllValues() []OutcomeItemGroup {
+	return []OutcomeItemGroup{
+		OutcomeItemGroupAccepted,
+		OutcomeItemGroupFiltered,
+		OutcomeItemGroupRateLimited,
+		OutcomeItemGroupDropped,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OutcomeItemGroup) MarshalText() ([]byte, error) {
+	switch s {
+	case OutcomeItemGroupAccepted:
+		return []byte(s), nil
+	case OutcomeItemGroupFiltered:
+		return []byte(s), nil
+	case OutcomeItemGroupRateLimited:
+		return []byte(s), nil
+	case OutcomeItemGroupDropped:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OutcomeItemGroup) UnmarshalText(data []byte) error {
+	switch OutcomeItemGroup(data) {
+	case OutcomeItemGroupAccepted:
+		*s = OutcomeItemGroupAccepted
+		return nil
+	case OutcomeItemGroupFiltered:
+		*s = OutcomeItemGroupFiltered
+		return nil
+	case OutcomeItemGroupRateLimited:
+		*s = OutcomeItemGroupRateLimited
+		return nil
+	case OutcomeItemGroupDropped:
+		*s = OutcomeItemGroupDropped
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type OutcomeItemOutcome string
+
+const (
+	OutcomeItemOutcomeAccepted      OutcomeItemOutcome = "accepted"
+	OutcomeItemOutcomeFiltered      OutcomeItemOutcome = "filtered"
+	OutcomeItemOutcomeRateLimited   OutcomeItemOutcome = "rate_limited"
+	OutcomeItemOutcomeInvalid       OutcomeItemOutcome = "invalid"
+	OutcomeItemOutcomeClientDiscard OutcomeItemOutcome = "client_discard"
+)
+
+// AllValues returns all OutcomeItemOutcome values.
+func (OutcomeItemOutcome) AllValues() []OutcomeItemOutcome {
+	return []OutcomeItemOutcome{
+		OutcomeItemOutcomeAccepted,
+		OutcomeItemOutcomeFiltered,
+		OutcomeItemOutcomeRateLimited,
+		OutcomeItemOutcomeInvalid,
+		OutcomeItemOutcomeClientDiscard,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OutcomeItemOutcome) MarshalText() ([]byte, error) {
+	switch s {
+	case OutcomeItemOutcomeAccepted:
+		return []byte(s), nil
+	case OutcomeItemOutcomeFiltered:
+		return []byte(s), nil
+	case OutcomeItemOutcomeRateLimited:
+		return []byte(s), nil
+	case OutcomeItemOutcomeInvalid:
+		return []byte(s), nil
+	case OutcomeItemOutcomeClientDiscard:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OutcomeItemOutcome) UnmarshalText(data []byte) error {
+	switch OutcomeItemOutcome(data) {
+	case OutcomeItemOutcomeAccepted:
+		*s = OutcomeItemOutcomeAccepted
+		return nil
+	case OutcomeItemOutcomeFiltered:
+		*s = OutcomeItemOutcomeFiltered
+		return nil
+	case OutcomeItemOutcomeRateLimited:
+		*s = OutcomeItemOutcomeRateLimited
+		return nil
+	case OutcomeItemOutcomeInvalid:
+		*s = OutcomeItemOutcomeInvalid
+		return nil
+	case OutcomeItemOutcomeClientDiscard:
+		*s = OutcomeItemOutcomeClientDiscard
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/OutcomeTotals
+type OutcomeTotals struct {
+	Accepted    uint `json:"accepted"`
+	Filtered    uint `json:"filtered"`
+	RateLimited uint `json:"rate_limited"`
+	Dropped     uint `json:"dropped"`
+}
+
+// GetAccepted returns the value of Accepted.
+func (s *OutcomeTotals) GetAccepted() uint {
+	return s.Accepted
+}
+
+// GetFiltered returns the value of Filtered.
+func (s *OutcomeTotals) GetFiltered() uint {
+	return s.Filtered
+}
+
+// GetRateLimited returns the value of RateLimited.
+func (s *OutcomeTotals) GetRateLimited() uint {
+	return s.RateLimited
+}
+
+// GetDropped returns the value of Dropped.
+func (s *OutcomeTotals) GetDropped() uint {
+	return s.Dropped
+}
+
+// SetAccepted sets the value of Accepted.
+func (s *OutcomeTotals) SetAccepted(val uint) {
+	s.Accepted = val
+}
+
+// SetFiltered sets the value of Filtered.
+func (s *OutcomeTotals) SetFiltered(val uint) {
+	s.Filtered = val
+}
+
+// SetRateLimited sets the value of RateLimited.
+func (s *OutcomeTotals) SetRateLimited(val uint) {
+	s.RateLimited = val
+}
+
+// SetDropped sets the value of Dropped.
+func (s *OutcomeTotals) SetDropped(val uint) {
+	s.Dropped = val
+}
+
+// Ref: #/components/schemas/Period
+type Period struct {
+	Interval    string `json:"interval"`
+	Granularity string `json:"granularity"`
+}
+
+// GetInterval returns the value of Interval.
+func (s *Period) GetInterval() string {
+	return s.Interval
+}
+
+// GetGranularity returns the value of Granularity.
+func (s *Period) GetGranularity() string {
+	return s.Granularity
+}
+
+// SetInterval sets the value of Interval.
+func (s *Period) SetInterval(val string) {
+	s.Interval = val
+}
+
+// SetGranularity sets the value of Granularity.
+func (s *Period) SetGranularity(val string) {
+	s.Granularity = val
+}
+
+// Ref: #/components/schemas/Project
+type Project struct {
+	ID          uint         `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	TeamID      OptNilUint   `json:"team_id"`
+	TeamName    OptNilString `json:"team_name"`
+	PublicKey   string       `json:"public_key"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Project) GetID() uint {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Project) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *Project) GetDescription() string {
+	return s.Description
+}
+
+// GetTeamID returns the value of TeamID.
+func (s *Project) GetTeamID() OptNilUint {
+	return s.TeamID
+}
+
+// GetTeamName returns the value of TeamName.
+func (s *Project) GetTeamName() OptNilString {
+	return s.TeamName
+}
+
+// GetPublicKey returns the value of PublicKey.
+func (s *Project) GetPublicKey() string {
+	return s.PublicKey
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Project) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Project) SetID(val uint) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Project) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Project) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetTeamID sets the value of TeamID.
+func (s *Project) SetTeamID(val OptNilUint) {
+	s.TeamID = val
+}
+
+// SetTeamName sets the value of TeamName.
+func (s *Project) SetTeamName(val OptNilString) {
+	s.TeamName = val
+}
+
+// SetPublicKey sets the value of PublicKey.
+func (s *Project) SetPublicKey(val string) {
+	s.PublicKey = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Project) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/ProjectKey
+type ProjectKey struct {
+	ID        uint   `json:"id"`
+	ProjectID uint   `json:"project_id"`
+	Label     string `json:"label"`
+	PublicKey string `json:"public_key"`
+	// Secret key of legacy DSNs, modern SDKs send the public key only.
+	SecretKey string `json:"secret_key"`
+	IsActive  bool   `json:"is_active"`
+	// Events per minute, unlimited when not set.
+	RateLimit          OptNilUint         `json:"rate_limit"`
+	CategoryRateLimits CategoryRateLimits `json:"category_rate_limits"`
+	CreatedAt          time.Time          `json:"created_at"`
+	LastUsedAt         OptNilDateTime     `json:"last_used_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ProjectKey) GetID() uint {
+	return s.ID
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *ProjectKey) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetLabel returns the value of Label.
+func (s *ProjectKey) GetLabel() string {
+	return s.Label
+}
+
+// GetPublicKey returns the value of PublicKey.
+func (s *ProjectKey) GetPublicKey() string {
+	return s.PublicKey
+}
+
+// GetSecretKey returns the value of SecretKey.
+func (s *ProjectKey) GetSecretKey() string {
+	return s.SecretKey
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *ProjectKey) GetIsActive() bool {
+	return s.IsActive
+}
+
+// GetRateLimit returns the value of RateLimit.
+func (s *ProjectKey) GetRateLimit() OptNilUint {
+	return s.RateLimit
+}
+
+// GetCategoryRateLimits returns the value of CategoryRateLimits.
+func (s *ProjectKey) GetCategoryRateLimits() CategoryRateLimits {
+	return s.CategoryRateLimits
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ProjectKey) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *ProjectKey) GetLastUsedAt() OptNilDateTime {
+	return s.LastUsedAt
+}
+
+// SetID sets the value of ID.
+func (s *ProjectKey) SetID(val uint) {
+	s.ID = val
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *ProjectKey) SetProjectID(val uint) {
+	s.ProjectID = val
+}
+
+// SetLabel sets the value of Label.
+func (s *ProjectKey) SetLabel(val string) {
+	s.Label = val
+}
+
+// SetPublicKey sets the value of PublicKey.
+func (s *ProjectKey) SetPublicKey(val string) {
+	s.PublicKey = val
+}
+
+// SetSecretKey sets the value of SecretKey.
+func (s *ProjectKey) SetSecretKey(val string) {
+	s.SecretKey = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *ProjectKey) SetIsActive(val bool) {
+	s.IsActive = val
+}
+
+// SetRateLimit sets the value of RateLimit.
+func (s *ProjectKey) SetRateLimit(val OptNilUint) {
+	s.RateLimit = val
+}
+
+// SetCategoryRateLimits sets the value of CategoryRateLimits.
+func (s *ProjectKey) SetCategoryRateLimits(val CategoryRateLimits) {
+	s.CategoryRateLimits = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ProjectKey) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *ProjectKey) SetLastUsedAt(val OptNilDateTime) {
+	s.LastUsedAt = val
+}
+
+func (*ProjectKey) createProjectKeyRes() {}
+func (*ProjectKey) rotateProjectKeyRes() {}
+func (*ProjectKey) updateProjectKeyRes() {}
+
+// Ref: #/components/schemas/ProjectKeyRequest
+type ProjectKeyRequest struct {
+	Label string `json:"label"`
+	// Events per minute, unlimited when not set.
+	RateLimit          OptNilUint            `json:"rate_limit"`
+	CategoryRateLimits OptCategoryRateLimits `json:"category_rate_limits"`
+}
+
+// GetLabel returns the value of Label.
+func (s *ProjectKeyRequest) GetLabel() string {
+	return s.Label
+}
+
+// GetRateLimit returns the value of RateLimit.
+func (s *ProjectKeyRequest) GetRateLimit() OptNilUint {
+	return s.RateLimit
+}
+
+// GetCategoryRateLimits returns the value of CategoryRateLimits.
+func (s *ProjectKeyRequest) GetCategoryRateLimits() OptCategoryRateLimits {
+	return s.CategoryRateLimits
+}
+
+// SetLabel sets the value of Label.
+func (s *ProjectKeyRequest) SetLabel(val string) {
+	s.Label = val
+}
+
+// SetRateLimit sets the value of RateLimit.
+func (s *ProjectKeyRequest) SetRateLimit(val OptNilUint) {
+	s.RateLimit = val
+}
+
+// SetCategoryRateLimits sets the value of CategoryRateLimits.
+func (s *ProjectKeyRequest) SetCategoryRateLimits(val OptCategoryRateLimits) {
+	s.CategoryRateLimits = val
+}
+
+// Ref: #/components/schemas/ProjectOutcomesResponse
+type ProjectOutcomesResponse struct {
+	Period     Period          `json:"period"`
+	Totals     OutcomeTotals   `json:"totals"`
+	Items      []OutcomeItem   `json:"items"`
+	Timeseries []OutcomeBucket `json:"timeseries"`
+}
+
+// GetPeriod returns the value of Period.
+func (s *ProjectOutcomesResponse) GetPeriod() Period {
+	return s.Period
+}
+
+// GetTotals returns the value of Totals.
+func (s *ProjectOutcomesResponse) GetTotals() OutcomeTotals {
+	return s.Totals
+}
+
+// GetItems returns the value of Items.
+func (s *ProjectOutcomesResponse) GetItems() []OutcomeItem {
+	return s.Items
+}
+
+// GetTimeseries returns the value of Timeseries.
+func (s *ProjectOutcomesResponse) GetTimeseries() []OutcomeBucket {
+	return s.Timeseries
+}
+
+// SetPeriod sets the value of Period.
+func (s *ProjectOutcomesResponse) SetPeriod(val Period) {
+	s.Period = val
+}
+
+// SetTotals sets the value of Totals.
+func (s *ProjectOutcomesResponse) SetTotals(val OutcomeTotals) {
+	s.Totals = val
+}
+
+// SetItems sets the value of Items.
+func (s *ProjectOutcomesResponse) SetItems(val []OutcomeItem) {
+	s.Items = val
+}
+
+// SetTimeseries sets the value of Timeseries.
+func (s *ProjectOutcomesResponse) SetTimeseries(val []OutcomeBucket) {
+	s.Timeseries = val
+}
+
+func (*ProjectOutcomesResponse) getProjectOutcomesRes() {}
+
+// Ref: #/components/schemas/ProjectRateLimits
+type ProjectRateLimits struct {
+	ProjectID uint `json:"project_id"`
+	// Events per minute, unlimited when not set.
+	RateLimit          OptNilUint         `json:"rate_limit"`
+	CategoryRateLimits CategoryRateLimits `json:"category_rate_limits"`
+	// Not set until the limits are saved.
+	UpdatedAt OptNilDateTime `json:"updated_at"`
+}
+
+// GetProjectID returns the value of ProjectID.
+func (s *ProjectRateLimits) GetProjectID() uint {
+	return s.ProjectID
+}
+
+// GetRateLimit returns the value of RateLimit.
+func (s *ProjectRateLimits) GetRateLimit() OptNilUint {
+	return s.RateLimit
+}
+
+// GetCategoryRateLimits returns the value of CategoryRateLimits.
+func (s *ProjectRateLimits) GetCategoryRateLimits() CategoryRateLimits {
+	return s.CategoryRateLimits
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ProjectRateLimits) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetProjectID sets the value of ProjectID.
+func (s *ProjectRateLimits) SetProjectID(val uint) {
+	s.ProjectID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptIssueStatus) Get() (v IssueStatus, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRateLimit sets the value of RateLimit.
+func (s *ProjectRateLimits) SetRateLimit(val OptNilUint) {
+	s.RateLimit = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptIssueStatus) Or(d IssueStatus) IssueStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCategoryRateLimits sets the value of CategoryRateLimits.
+func (s *ProjectRateLimits) SetCategoryRateLimits(val CategoryRateLimits) {
+	s.CategoryRateLimits = val
 }
 
-// NewOptNilBool returns new OptNilBool with value set to v.
-func NewOptNilBool(v bool) OptNilBool {
-	return OptNilBool{
-		Value: v,
-		Set:   true,
-	}
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ProjectRateLimits) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
 }
 
-// OptNilBool is optional nullable bool.
-type OptNilBool struct {
-	Value bool
-	Set   bool
-	Null  bool
-}
+func (*ProjectRateLimits) getProjectRateLimitsRes()    {}
+func (*ProjectRateLimits) updateProjectRateLimitsRes() {}
 
-// IsSet returns true if OptNilBool was set.
-func (o OptNilBool) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/ProjectResponse
+type ProjectResponse struct {
+	Project Project `json:"project"`
+}
 
-// Reset unsets value.
-func (o *OptNilBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetProject returns the value of Project.
+func (s *ProjectResponse) GetProject() Project {
+	return s.Project
 }
 
-// SetTo sets value to v.
-func (o *OptNilBool) SetTo(v bool) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetProject sets the value of Project.
+func (s *ProjectResponse) SetProject(val Project) {
+	s.Project = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilBool) IsNull() bool { return o.Null }
+func (*ProjectResponse) getProjectRes()    {}
+func (*ProjectResponse) updateProjectRes() {}
 
-// SetNull sets value to null.
-func (o *OptNilBool) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v bool
-	o.Value = v
+// Ref: #/components/schemas/ProjectSpike
+type ProjectSpike struct {
+	ID          uint      `json:"id"`
+	StartedAt   time.Time `json:"started_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	BaselineRps uint64    `json:"baseline_rps"`
+	PeakRps     uint64    `json:"peak_rps"`
+	CapRps      uint64    `json:"cap_rps"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilBool) Get() (v bool, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetID returns the value of ID.
+func (s *ProjectSpike) GetID() uint {
+	return s.ID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetStartedAt returns the value of StartedAt.
+func (s *ProjectSpike) GetStartedAt() time.Time {
+	return s.StartedAt
 }
 
-// NewOptNilIssueEventRequestHeaders returns new OptNilIssueEventRequestHeaders with value set to v.
-func NewOptNilIssueEventRequestHeaders(v IssueEventRequestHeaders) OptNilIssueEventRequestHeaders {
-	return OptNilIssueEventRequestHeaders{
-		Value: v,
-		Set:   true,
-	}
+// GetEndsAt returns the value of EndsAt.
+func (s *ProjectSpike) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// OptNilIssueEventRequestHeaders is optional nullable IssueEventRequestHeaders.
-type OptNilIssueEventRequestHeaders struct {
-	Value IssueEventRequestHeaders
-	Set   bool
-	Null  bool
+// GetBaselineRps returns the value of BaselineRps.
+func (s *ProjectSpike) GetBaselineRps() uint64 {
+	return s.BaselineRps
 }
 
-// IsSet returns true if OptNilIssueEventRequestHeaders was set.
-func (o OptNilIssueEventRequestHeaders) IsSet() bool { return o.Set }
+// GetPeakRps returns the value of PeakRps.
+func (s *ProjectSpike) GetPeakRps() uint64 {
+	return s.PeakRps
+}
 
-// Reset unsets value.
-func (o *OptNilIssueEventRequestHeaders) Reset() {
-	var v IssueEventRequestHeaders
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetCapRps returns the value of CapRps.
+func (s *ProjectSpike) GetCapRps() uint64 {
+	return s.CapRps
 }
 
-// SetTo sets value to v.
-func (o *OptNilIssueEventRequestHeaders) SetTo(v IssueEventRequestHeaders) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetID sets the value of ID.
+func (s *ProjectSpike) SetID(val uint) {
+	s.ID = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilIssueEventRequestHeaders) IsNull() bool { return o.Null }
+// SetStartedAt sets the value of StartedAt.
+func (s *ProjectSpike) SetStartedAt(val time.Time) {
+	s.StartedAt = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilIssueEventRequestHeaders) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v IssueEventRequestHeaders
-	o.Value = v
+// SetEndsAt sets the value of EndsAt.
+func (s *ProjectSpike) SetEndsAt(val time.Time) {
+	s.EndsAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilIssueEventRequestHeaders) Get() (v IssueEventRequestHeaders, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetBaselineRps sets the value of BaselineRps.
+func (s *ProjectSpike) SetBaselineRps(val uint64) {
+	s.BaselineRps = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilIssueEventRequestHeaders) Or(d IssueEventRequestHeaders) IssueEventRequestHeaders {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPeakRps sets the value of PeakRps.
+func (s *ProjectSpike) SetPeakRps(val uint64) {
+	s.PeakRps = val
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// SetCapRps sets the value of CapRps.
+func (s *ProjectSpike) SetCapRps(val uint64) {
+	s.CapRps = val
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// Ref: #/components/schemas/ProjectStatsResponse
+type ProjectStatsResponse struct {
+	TotalIssues        uint                              `json:"total_issues"`
+	IssuesByLevel      ProjectStatsResponseIssuesByLevel `json:"issues_by_level"`
+	MostFrequentIssues []IssueSummary                    `json:"most_frequent_issues"`
+	// Spikes capped by the spike protection within the period.
+	Spikes []ProjectSpike `json:"spikes"`
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetTotalIssues returns the value of TotalIssues.
+func (s *ProjectStatsResponse) GetTotalIssues() uint {
+	return s.TotalIssues
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetIssuesByLevel returns the value of IssuesByLevel.
+func (s *ProjectStatsResponse) GetIssuesByLevel() ProjectStatsResponseIssuesByLevel {
+	return s.IssuesByLevel
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetMostFrequentIssues returns the value of MostFrequentIssues.
+func (s *ProjectStatsResponse) GetMostFrequentIssues() []IssueSummary {
+	return s.MostFrequentIssues
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// GetSpikes returns the value of Spikes.
+func (s *ProjectStatsResponse) GetSpikes() []ProjectSpike {
+	return s.Spikes
+}
 
-// SetNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// SetTotalIssues sets the value of TotalIssues.
+func (s *ProjectStatsResponse) SetTotalIssues(val uint) {
+	s.TotalIssues = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetIssuesByLevel sets the value of IssuesByLevel.
+func (s *ProjectStatsResponse) SetIssuesByLevel(val ProjectStatsResponseIssuesByLevel) {
+	s.IssuesByLevel = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMostFrequentIssues sets the value of MostFrequentIssues.
+func (s *ProjectStatsResponse) SetMostFrequentIssues(val []IssueSummary) {
+	s.MostFrequentIssues = val
 }
 
-// NewOptNilUint returns new OptNilUint with value set to v.
-func NewOptNilUint(v uint) OptNilUint {
-	return OptNilUint{
-		Value: v,
-		Set:   true,
-	}
+// SetSpikes sets the value of Spikes.
+func (s *ProjectStatsResponse) SetSpikes(val []ProjectSpike) {
+	s.Spikes = val
 }
 
-// OptNilUint is optional nullable uint.
-type OptNilUint struct {
-	Value uint
-	Set   bool
-	Null  bool
+func (*ProjectStatsResponse) getProjectStatsRes() {}
+
+type ProjectStatsResponseIssuesByLevel struct {
+	Fatal     uint `json:"fatal"`
+	Exception uint `json:"exception"`
+	Error     uint `json:"error"`
+	Warning   uint `json:"warning"`
+	Info      uint `json:"info"`
+	Debug     uint `json:"debug"`
 }
 
-// IsSet returns true if OptNilUint was set.
-func (o OptNilUint) IsSet() bool { return o.Set }
+// GetFatal returns the value of Fatal.
+func (s *ProjectStatsResponseIssuesByLevel) GetFatal() uint {
+	return s.Fatal
+}
 
-// Reset unsets value.
-func (o *OptNilUint) Reset() {
-	var v uint
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetException returns the value of Exception.
+func (s *ProjectStatsResponseIssuesByLevel) GetException() uint {
+	return s.Exception
 }
 
-// SetTo sets value to v.
-func (o *OptNilUint) SetTo(v uint) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetError returns the value of Error.
+func (s *ProjectStatsResponseIssuesByLevel) GetError() uint {
+	return s.Error
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilUint) IsNull() bool { return o.Null }
+// GetWarning returns the value of Warning.
+func (s *ProjectStatsResponseIssuesByLevel) GetWarning() uint {
+	return s.Warning
+}
 
-// SetNull sets value to null.
-func (o *OptNilUint) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v uint
-	o.Value = v
+// GetInfo returns the value of Info.
+func (s *ProjectStatsResponseIssuesByLevel) GetInfo() uint {
+	return s.Info
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilUint) Get() (v uint, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetDebug returns the value of Debug.
+func (s *ProjectStatsResponseIssuesByLevel) GetDebug() uint {
+	return s.Debug
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilUint) Or(d uint) uint {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetFatal sets the value of Fatal.
+func (s *ProjectStatsResponseIssuesByLevel) SetFatal(val uint) {
+	s.Fatal = val
 }
 
-// NewOptReleaseAnalyticsDetailsSegmentsBrowserName returns new OptReleaseAnalyticsDetailsSegmentsBrowserName with value set to v.
-func NewOptReleaseAnalyticsDetailsSegmentsBrowserName(v ReleaseAnalyticsDetailsSegmentsBrowserName) OptReleaseAnalyticsDetailsSegmentsBrowserName {
-	return OptReleaseAnalyticsDetailsSegmentsBrowserName{
-		Value: v,
-		Set:   true,
-	}
+// SetException sets the value of Exception.
+func (s *ProjectStatsResponseIssuesByLevel) SetException(val uint) {
+	s.Exception = val
 }
 
-// OptReleaseAnalyticsDetailsSegmentsBrowserName is optional ReleaseAnalyticsDetailsSegmentsBrowserName.
-type OptReleaseAnalyticsDetailsSegmentsBrowserName struct {
-	Value ReleaseAnalyticsDetailsSegmentsBrowserName
-	Set   bool
+// SetError sets the value of Error.
+func (s *ProjectStatsResponseIssuesByLevel) SetError(val uint) {
+	s.Error = val
 }
 
-// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsBrowserName was set.
-func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptReleaseAnalyticsDetailsSegmentsBrowserName) Reset() {
-	var v ReleaseAnalyticsDetailsSegmentsBrowserName
-	o.Value = v
-	o.Set = false
+// SetWarning sets the value of Warning.
+func (s *ProjectStatsResponseIssuesByLevel) SetWarning(val uint) {
+	s.Warning = val
 }
 
-// SetTo sets value to v.
-func (o *OptReleaseAnalyticsDetailsSegmentsBrowserName) SetTo(v ReleaseAnalyticsDetailsSegmentsBrowserName) {
-	o.Set = true
-	o.Value = v
+// SetInfo sets the value of Info.
+func (s *ProjectStatsResponseIssuesByLevel) SetInfo(val uint) {
+	s.Info = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) Get() (v ReleaseAnalyticsDetailsSegmentsBrowserName, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetDebug sets the value of Debug.
+func (s *ProjectStatsResponseIssuesByLevel) SetDebug(val uint) {
+	s.Debug = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptReleaseAnalyticsDetailsSegmentsBrowserName) Or(d ReleaseAnalyticsDetailsSegmentsBrowserName) ReleaseAnalyticsDetailsSegmentsBrowserName {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/RefreshTokenRequest
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
-// NewOptReleaseAnalyticsDetailsSegmentsDeviceArch returns new OptReleaseAnalyticsDetailsSegmentsDeviceArch with value set to v.
-func NewOptReleaseAnalyticsDetailsSegmentsDeviceArch(v ReleaseAnalyticsDetailsSegmentsDeviceArch) OptReleaseAnalyticsDetailsSegmentsDeviceArch {
-	return OptReleaseAnalyticsDetailsSegmentsDeviceArch{
-		Value: v,
-		Set:   true,
-	}
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokenRequest) GetRefreshToken() string {
+	return s.RefreshToken
 }
 
-// OptReleaseAnalyticsDetailsSegmentsDeviceArch is optional ReleaseAnalyticsDetailsSegmentsDeviceArch.
-type OptReleaseAnalyticsDetailsSegmentsDeviceArch struct {
-	Value ReleaseAnalyticsDetailsSegmentsDeviceArch
-	Set   bool
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokenRequest) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
-// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsDeviceArch was set.
-func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/RefreshTokenResponse
+type RefreshTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
 
-// Reset unsets value.
-func (o *OptReleaseAnalyticsDetailsSegmentsDeviceArch) Reset() {
-	var v ReleaseAnalyticsDetailsSegmentsDeviceArch
-	o.Value = v
-	o.Set = false
+// GetAccessToken returns the value of AccessToken.
+func (s *RefreshTokenResponse) GetAccessToken() string {
+	return s.AccessToken
 }
 
-// SetTo sets value to v.
-func (o *OptReleaseAnalyticsDetailsSegmentsDeviceArch) SetTo(v ReleaseAnalyticsDetailsSegmentsDeviceArch) {
-	o.Set = true
-	o.Value = v
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshTokenResponse) GetRefreshToken() string {
+	return s.RefreshToken
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) Get() (v ReleaseAnalyticsDetailsSegmentsDeviceArch, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *RefreshTokenResponse) GetExpiresIn() int {
+	return s.ExpiresIn
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptReleaseAnalyticsDetailsSegmentsDeviceArch) Or(d ReleaseAnalyticsDetailsSegmentsDeviceArch) ReleaseAnalyticsDetailsSegmentsDeviceArch {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetAccessToken sets the value of AccessToken.
+func (s *RefreshTokenResponse) SetAccessToken(val string) {
+	s.AccessToken = val
 }
 
-// NewOptReleaseAnalyticsDetailsSegmentsOsName returns new OptReleaseAnalyticsDetailsSegmentsOsName with value set to v.
-func NewOptReleaseAnalyticsDetailsSegmentsOsName(v ReleaseAnalyticsDetailsSegmentsOsName) OptReleaseAnalyticsDetailsSegmentsOsName {
-	return OptReleaseAnalyticsDetailsSegmentsOsName{
-		Value: v,
-		Set:   true,
-	}
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshTokenResponse) SetRefreshToken(val string) {
+	s.RefreshToken = val
 }
 
-// OptReleaseAnalyticsDetailsSegmentsOsName is optional ReleaseAnalyticsDetailsSegmentsOsName.
-type OptReleaseAnalyticsDetailsSegmentsOsName struct {
-	Value ReleaseAnalyticsDetailsSegmentsOsName
-	Set   bool
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *RefreshTokenResponse) SetExpiresIn(val int) {
+	s.ExpiresIn = val
 }
 
-// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsOsName was set.
-func (o OptReleaseAnalyticsDetailsSegmentsOsName) IsSet() bool { return o.Set }
+func (*RefreshTokenResponse) refreshTokenRes() {}
 
-// Reset unsets value.
-func (o *OptReleaseAnalyticsDetailsSegmentsOsName) Reset() {
-	var v ReleaseAnalyticsDetailsSegmentsOsName
-	o.Value = v
-	o.Set = false
+// Ref: #/components/schemas/ReleaseAnalyticsDetails
+type ReleaseAnalyticsDetails struct {
+	Version              string                                      `json:"version"`
+	CreatedAt            time.Time                                   `json:"created_at"`
+	Stats                ReleaseAnalyticsSummary                     `json:"stats"`
+	TopIssues            []IssueSummary                              `json:"top_issues"`
+	SeverityDistribution ReleaseAnalyticsDetailsSeverityDistribution `json:"severity_distribution"`
+	FixTime              ReleaseAnalyticsDetailsFixTime              `json:"fix_time"`
+	Segments             ReleaseAnalyticsDetailsSegments             `json:"segments"`
 }
 
-// SetTo sets value to v.
-func (o *OptReleaseAnalyticsDetailsSegmentsOsName) SetTo(v ReleaseAnalyticsDetailsSegmentsOsName) {
-	o.Set = true
-	o.Value = v
+// GetVersion returns the value of Version.
+func (s *ReleaseAnalyticsDetails) GetVersion() string {
+	return s.Version
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptReleaseAnalyticsDetailsSegmentsOsName) Get() (v ReleaseAnalyticsDetailsSegmentsOsName, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReleaseAnalyticsDetails) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptReleaseAnalyticsDetailsSegmentsOsName) Or(d ReleaseAnalyticsDetailsSegmentsOsName) ReleaseAnalyticsDetailsSegmentsOsName {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetStats returns the value of Stats.
+func (s *ReleaseAnalyticsDetails) GetStats() ReleaseAnalyticsSummary {
+	return s.Stats
 }
 
-// NewOptReleaseAnalyticsDetailsSegmentsPlatform returns new OptReleaseAnalyticsDetailsSegmentsPlatform with value set to v.
-func NewOptReleaseAnalyticsDetailsSegmentsPlatform(v ReleaseAnalyticsDetailsSegmentsPlatform) OptReleaseAnalyticsDetailsSegmentsPlatform {
-	return OptReleaseAnalyticsDetailsSegmentsPlatform{
-		Value: v,
-		Set:   true,
-	}
+// GetTopIssues returns the value of TopIssues.
+func (s *ReleaseAnalyticsDetails) GetTopIssues() []IssueSummary {
+	return s.TopIssues
 }
 
-// OptReleaseAnalyticsDetailsSegmentsPlatform is optional ReleaseAnalyticsDetailsSegmentsPlatform.
-type OptReleaseAnalyticsDetailsSegmentsPlatform struct {
-	Value ReleaseAnalyticsDetailsSegmentsPlatform
-	Set   bool
+// GetSeverityDistribution returns the value of SeverityDistribution.
+func (s *ReleaseAnalyticsDetails) GetSeverityDistribution() ReleaseAnalyticsDetailsSeverityDistribution {
+	return s.SeverityDistribution
 }
 
-// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsPlatform was set.
-func (o OptReleaseAnalyticsDetailsSegmentsPlatform) IsSet() bool { return o.Set }
+// GetFixTime returns the value of FixTime.
+func (s *ReleaseAnalyticsDetails) GetFixTime() ReleaseAnalyticsDetailsFixTime {
+	return s.FixTime
+}
 
-// Reset unsets value.
-func (o *OptReleaseAnalyticsDetailsSegmentsPlatform) Reset() {
-	var v ReleaseAnalyticsDetailsSegmentsPlatform
-	o.Value = v
-	o.Set = false
+// GetSegments returns the value of Segments.
+func (s *ReleaseAnalyticsDetails) GetSegments() ReleaseAnalyticsDetailsSegments {
+	return s.Segments
 }
 
-// SetTo sets value to v.
-func (o *OptReleaseAnalyticsDetailsSegmentsPlatform) SetTo(v ReleaseAnalyticsDetailsSegmentsPlatform) {
-	o.Set = true
-	o.Value = v
+// SetVersion sets the value of Version.
+func (s *ReleaseAnalyticsDetails) SetVersion(val string) {
+	s.Version = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptReleaseAnalyticsDetailsSegmentsPlatform) Get() (v ReleaseAnalyticsDetailsSegmentsPlatform, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReleaseAnalyticsDetails) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptReleaseAnalyticsDetailsSegmentsPlatform) Or(d ReleaseAnalyticsDetailsSegmentsPlatform) ReleaseAnalyticsDetailsSegmentsPlatform {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetStats sets the value of Stats.
+func (s *ReleaseAnalyticsDetails) SetStats(val ReleaseAnalyticsSummary) {
+	s.Stats = val
 }
 
-// NewOptReleaseAnalyticsDetailsSegmentsRuntimeName returns new OptReleaseAnalyticsDetailsSegmentsRuntimeName with value set to v.
-func NewOptReleaseAnalyticsDetailsSegmentsRuntimeName(v ReleaseAnalyticsDetailsSegmentsRuntimeName) OptReleaseAnalyticsDetailsSegmentsRuntimeName {
-	return OptReleaseAnalyticsDetailsSegmentsRuntimeName{
-		Value: v,
-		Set:   true,
-	}
+// SetTopIssues sets the value of TopIssues.
+func (s *ReleaseAnalyticsDetails) SetTopIssues(val []IssueSummary) {
+	s.TopIssues = val
+}
+
+// SetSeverityDistribution sets the value of SeverityDistribution.
+func (s *ReleaseAnalyticsDetails) SetSeverityDistribution(val ReleaseAnalyticsDetailsSeverityDistribution) {
+	s.SeverityDistribution = val
 }
 
-// OptReleaseAnalyticsDetailsSegmentsRuntimeName is optional ReleaseAnalyticsDetailsSegmentsRuntimeName.
-type OptReleaseAnalyticsDetailsSegmentsRuntimeName struct {
-	Value ReleaseAnalyticsDetailsSegmentsRuntimeName
-	Set   bool
+// SetFixTime sets the value of FixTime.
+func (s *ReleaseAnalyticsDetails) SetFixTime(val ReleaseAnalyticsDetailsFixTime) {
+	s.FixTime = val
 }
 
-// IsSet returns true if OptReleaseAnalyticsDetailsSegmentsRuntimeName was set.
-func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) IsSet() bool { return o.Set }
+// SetSegments sets the value of Segments.
+func (s *ReleaseAnalyticsDetails) SetSegments(val ReleaseAnalyticsDetailsSegments) {
+	s.Segments = val
+}
 
-// Reset unsets value.
-func (o *OptReleaseAnalyticsDetailsSegmentsRuntimeName) Reset() {
-	var v ReleaseAnalyticsDetailsSegmentsRuntimeName
-	o.Value = v
-	o.Set = false
+func (*ReleaseAnalyticsDetails) getProjectReleaseAnalyticsDetailsRes() {}
+
+type ReleaseAnalyticsDetailsFixTime struct {
+	Avg    OptFloat32 `json:"avg"`
+	Median OptFloat32 `json:"median"`
+	P95    OptFloat32 `json:"p95"`
 }
 
-// SetTo sets value to v.
-func (o *OptReleaseAnalyticsDetailsSegmentsRuntimeName) SetTo(v ReleaseAnalyticsDetailsSegmentsRuntimeName) {
-	o.Set = true
-	o.Value = v
+// GetAvg returns the value of Avg.
+func (s *ReleaseAnalyticsDetailsFixTime) GetAvg() OptFloat32 {
+	return s.Avg
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) Get() (v ReleaseAnalyticsDetailsSegmentsRuntimeName, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMedian returns the value of Median.
+func (s *ReleaseAnalyticsDetailsFixTime) GetMedian() OptFloat32 {
+	return s.Median
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptReleaseAnalyticsDetailsSegmentsRuntimeName) Or(d ReleaseAnalyticsDetailsSegmentsRuntimeName) ReleaseAnalyticsDetailsSegmentsRuntimeName {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetP95 returns the value of P95.
+func (s *ReleaseAnalyticsDetailsFixTime) GetP95() OptFloat32 {
+	return s.P95
 }
 
-// NewOptSortOrder returns new OptSortOrder with value set to v.
-func NewOptSortOrder(v SortOrder) OptSortOrder {
-	return OptSortOrder{
-		Value: v,
-		Set:   true,
-	}
+// SetAvg sets the value of Avg.
+func (s *ReleaseAnalyticsDetailsFixTime) SetAvg(val OptFloat32) {
+	s.Avg = val
 }
 
-// OptSortOrder is optional SortOrder.
-type OptSortOrder struct {
-	Value SortOrder
-	Set   bool
+// SetMedian sets the value of Median.
+func (s *ReleaseAnalyticsDetailsFixTime) SetMedian(val OptFloat32) {
+	s.Median = val
 }
 
-// IsSet returns true if OptSortOrder was set.
-func (o OptSortOrder) IsSet() bool { return o.Set }
+// SetP95 sets the value of P95.
+func (s *ReleaseAnalyticsDetailsFixTime) SetP95(val OptFloat32) {
+	s.P95 = val
+}
 
-// Reset unsets value.
-func (o *OptSortOrder) Reset() {
-	var v SortOrder
-	o.Value = v
-	o.Set = false
+type ReleaseAnalyticsDetailsSegments struct {
+	Platform    OptReleaseAnalyticsDetailsSegmentsPlatform    `json:"platform"`
+	BrowserName OptReleaseAnalyticsDetailsSegmentsBrowserName `json:"browser_name"`
+	OsName      OptReleaseAnalyticsDetailsSegmentsOsName      `json:"os_name"`
+	DeviceArch  OptReleaseAnalyticsDetailsSegmentsDeviceArch  `json:"device_arch"`
+	RuntimeName OptReleaseAnalyticsDetailsSegmentsRuntimeName `json:"runtime_name"`
 }
 
-// SetTo sets value to v.
-func (o *OptSortOrder) SetTo(v SortOrder) {
-	o.Set = true
-	o.Value = v
+// GetPlatform returns the value of Platform.
+func (s *ReleaseAnalyticsDetailsSegments) GetPlatform() OptReleaseAnalyticsDetailsSegmentsPlatform {
+	return s.Platform
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptSortOrder) Get() (v SortOrder, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetBrowserName returns the value of BrowserName.
+func (s *ReleaseAnalyticsDetailsSegments) GetBrowserName() OptReleaseAnalyticsDetailsSegmentsBrowserName {
+	return s.BrowserName
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptSortOrder) Or(d SortOrder) SortOrder {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetOsName returns the value of OsName.
+func (s *ReleaseAnalyticsDetailsSegments) GetOsName() OptReleaseAnalyticsDetailsSegmentsOsName {
+	return s.OsName
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetDeviceArch returns the value of DeviceArch.
+func (s *ReleaseAnalyticsDetailsSegments) GetDeviceArch() OptReleaseAnalyticsDetailsSegmentsDeviceArch {
+	return s.DeviceArch
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetRuntimeName returns the value of RuntimeName.
+func (s *ReleaseAnalyticsDetailsSegments) GetRuntimeName() OptReleaseAnalyticsDetailsSegmentsRuntimeName {
+	return s.RuntimeName
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetPlatform sets the value of Platform.
+func (s *ReleaseAnalyticsDetailsSegments) SetPlatform(val OptReleaseAnalyticsDetailsSegmentsPlatform) {
+	s.Platform = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetBrowserName sets the value of BrowserName.
+func (s *ReleaseAnalyticsDetailsSegments) SetBrowserName(val OptReleaseAnalyticsDetailsSegmentsBrowserName) {
+	s.BrowserName = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetOsName sets the value of OsName.
+func (s *ReleaseAnalyticsDetailsSegments) SetOsName(val OptReleaseAnalyticsDetailsSegmentsOsName) {
+	s.OsName = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetDeviceArch sets the value of DeviceArch.
+func (s *ReleaseAnalyticsDetailsSegments) SetDeviceArch(val OptReleaseAnalyticsDetailsSegmentsDeviceArch) {
+	s.DeviceArch = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetRuntimeName sets the value of RuntimeName.
+func (s *ReleaseAnalyticsDetailsSegments) SetRuntimeName(val OptReleaseAnalyticsDetailsSegmentsRuntimeName) {
+	s.RuntimeName = val
 }
 
-// NewOptUint returns new OptUint with value set to v.
-func NewOptUint(v uint) OptUint {
-	return OptUint{
-		Value: v,
-		Set:   true,
+type ReleaseAnalyticsDetailsSegmentsBrowserName map[string]uint
+
+func (s *ReleaseAnalyticsDetailsSegmentsBrowserName) init() ReleaseAnalyticsDetailsSegmentsBrowserName {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
 	}
+	return m
 }
 
-// OptUint is optional uint.
-type OptUint struct {
-	Value uint
-	Set   bool
+type ReleaseAnalyticsDetailsSegmentsDeviceArch map[string]uint
+
+func (s *ReleaseAnalyticsDetailsSegmentsDeviceArch) init() ReleaseAnalyticsDetailsSegmentsDeviceArch {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
+	}
+	return m
 }
 
-// IsSet returns true if OptUint was set.
-func (o OptUint) IsSet() bool { return o.Set }
+type ReleaseAnalyticsDetailsSegmentsOsName map[string]uint
 
-// Reset unsets value.
-func (o *OptUint) Reset() {
-	var v uint
-	o.Value = v
-	o.Set = false
+func (s *ReleaseAnalyticsDetailsSegmentsOsName) init() ReleaseAnalyticsDetailsSegmentsOsName {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
+	}
+	return m
 }
 
-// SetTo sets value to v.
-func (o *OptUint) SetTo(v uint) {
-	o.Set = true
-	o.Value = v
-}
+type ReleaseAnalyticsDetailsSegmentsPlatform map[string]uint
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUint) Get() (v uint, ok bool) {
-	if !o.Set {
-		return v, false
+func (s *ReleaseAnalyticsDetailsSegmentsPlatform) init() ReleaseAnalyticsDetailsSegmentsPlatform {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUint) Or(d uint) uint {
-	if v, ok := o.Get(); ok {
-		return v
+type ReleaseAnalyticsDetailsSegmentsRuntimeName map[string]uint
+
+func (s *ReleaseAnalyticsDetailsSegmentsRuntimeName) init() ReleaseAnalyticsDetailsSegmentsRuntimeName {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
 	}
-	return d
+	return m
 }
 
-// Ref: #/components/schemas/Period
-type Period struct {
-	Interval    string `json:"interval"`
-	Granularity string `json:"granularity"`
-}
+type ReleaseAnalyticsDetailsSeverityDistribution map[string]uint
 
-// GetInterval returns the value of Interval.
-func (s *Period) GetInterval() string {
-	return s.Interval
+func (s *ReleaseAnalyticsDetailsSeverityDistribution) init() ReleaseAnalyticsDetailsSeverityDistribution {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
+	}
+	return m
 }
-
-// GetGranularity returns the value of Granularity.
-func (s *Period) GetGranularity() string {
-	return s.Granularity
+
+// Ref: #/components/schemas/ReleaseAnalyticsSummary
+type ReleaseAnalyticsSummary struct {
+	Version                string    `json:"version"`
+	CreatedAt              time.Time `json:"created_at"`
+	KnownIssuesTotal       uint      `json:"known_issues_total"`
+	NewIssuesTotal         uint      `json:"new_issues_total"`
+	RegressionsTotal       uint      `json:"regressions_total"`
+	ResolvedInVersionTotal uint      `json:"resolved_in_version_total"`
+	UsersAffected          uint      `json:"users_affected"`
+	SessionsTotal          OptUint   `json:"sessions_total"`
+	SessionsErrored        OptUint   `json:"sessions_errored"`
+	// Crashed and abnormal sessions.
+	SessionsCrashed OptUint `json:"sessions_crashed"`
+	UsersTotal      OptUint `json:"users_total"`
+	// Share of sessions without a crash, from 0 to 1. Absent for releases without sessions.
+	CrashFreeSessions OptFloat64 `json:"crash_free_sessions"`
+	// Share of users who did not experience a crash, from 0 to 1. Absent for releases without sessions.
+	CrashFreeUsers OptFloat64 `json:"crash_free_users"`
+	// Share of the project sessions started within the last 24 hours, from 0 to 1.
+	Adoption OptFloat64 `json:"adoption"`
+	// Average duration of exited sessions in seconds.
+	AvgSessionDuration OptFloat32 `json:"avg_session_duration"`
 }
 
-// SetInterval sets the value of Interval.
-func (s *Period) SetInterval(val string) {
-	s.Interval = val
+// GetVersion returns the value of Version.
+func (s *ReleaseAnalyticsSummary) GetVersion() string {
+	return s.Version
 }
 
-// SetGranularity sets the value of Granularity.
-func (s *Period) SetGranularity(val string) {
-	s.Granularity = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReleaseAnalyticsSummary) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Ref: #/components/schemas/Project
-type Project struct {
-	ID          uint         `json:"id"`
-	Name        string       `json:"name"`
-	Description string       `json:"description"`
-	TeamID      OptNilUint   `json:"team_id"`
-	TeamName    OptNilString `json:"team_name"`
-	PublicKey   string       `json:"public_key"`
-	CreatedAt   time.Time    `json:"created_at"`
+// GetKnownIssuesTotal returns the value of KnownIssuesTotal.
+func (s *ReleaseAnalyticsSummary) GetKnownIssuesTotal() uint {
+	return s.KnownIssuesTotal
 }
 
-// GetID returns the value of ID.
-func (s *Project) GetID() uint {
-	return s.ID
+// GetNewIssuesTotal returns the value of NewIssuesTotal.
+func (s *ReleaseAnalyticsSummary) GetNewIssuesTotal() uint {
+	return s.NewIssuesTotal
 }
 
-// GetName returns the value of Name.
-func (s *Project) GetName() string {
-	return s.Name
+// GetRegressionsTotal returns the value of RegressionsTotal.
+func (s *ReleaseAnalyticsSummary) GetRegressionsTotal() uint {
+	return s.RegressionsTotal
 }
 
-// GetDescription returns the value of Description.
-func (s *Project) GetDescription() string {
-	return s.Description
+// GetResolvedInVersionTotal returns the value of ResolvedInVersionTotal.
+func (s *ReleaseAnalyticsSummary) GetResolvedInVersionTotal() uint {
+	return s.ResolvedInVersionTotal
 }
 
-// GetTeamID returns the value of TeamID.
-func (s *Project) GetTeamID() OptNilUint {
-	return s.TeamID
+// GetUsersAffected returns the value of UsersAffected.
+func (s *ReleaseAnalyticsSummary) GetUsersAffected() uint {
+	return s.UsersAffected
 }
 
-// GetTeamName returns the value of TeamName.
-func (s *Project) GetTeamName() OptNilString {
-	return s.TeamName
+// GetSessionsTotal returns the value of SessionsTotal.
+func (s *ReleaseAnalyticsSummary) GetSessionsTotal() OptUint {
+	return s.SessionsTotal
 }
 
-// GetPublicKey returns the value of PublicKey.
-func (s *Project) GetPublicKey() string {
-	return s.PublicKey
+// GetSessionsErrored returns the value of SessionsErrored.
+func (s *ReleaseAnalyticsSummary) GetSessionsErrored() OptUint {
+	return s.SessionsErrored
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Project) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetSessionsCrashed returns the value of SessionsCrashed.
+func (s *ReleaseAnalyticsSummary) GetSessionsCrashed() OptUint {
+	return s.SessionsCrashed
 }
 
-// SetID sets the value of ID.
-func (s *Project) SetID(val uint) {
-	s.ID = val
+// GetUsersTotal returns the value of UsersTotal.
+func (s *ReleaseAnalyticsSummary) GetUsersTotal() OptUint {
+	return s.UsersTotal
 }
 
-// SetName sets the value of Name.
-func (s *Project) SetName(val string) {
-	s.Name = val
+// GetCrashFreeSessions returns the value of CrashFreeSessions.
+func (s *ReleaseAnalyticsSummary) GetCrashFreeSessions() OptFloat64 {
+	return s.CrashFreeSessions
 }
 
-// SetDescription sets the value of Description.
-func (s *Project) SetDescription(val string) {
-	s.Description = val
+// GetCrashFreeUsers returns the value of CrashFreeUsers.
+func (s *ReleaseAnalyticsSummary) GetCrashFreeUsers() OptFloat64 {
+	return s.CrashFreeUsers
 }
 
-// SetTeamID sets the value of TeamID.
-func (s *Project) SetTeamID(val OptNilUint) {
-	s.TeamID = val
+// GetAdoption returns the value of Adoption.
+func (s *ReleaseAnalyticsSummary) GetAdoption() OptFloat64 {
+	return s.Adoption
 }
 
-// SetTeamName sets the value of TeamName.
-func (s *Project) SetTeamName(val OptNilString) {
-	s.TeamName = val
+// GetAvgSessionDuration returns the value of AvgSessionDuration.
+func (s *ReleaseAnalyticsSummary) GetAvgSessionDuration() OptFloat32 {
+	return s.AvgSessionDuration
 }
 
-// SetPublicKey sets the value of PublicKey.
-func (s *Project) SetPublicKey(val string) {
-	s.PublicKey = val
+// SetVersion sets the value of Version.
+func (s *ReleaseAnalyticsSummary) SetVersion(val string) {
+	s.Version = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *Project) SetCreatedAt(val time.Time) {
+func (s *ReleaseAnalyticsSummary) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-// Ref: #/components/schemas/ProjectResponse
-type ProjectResponse struct {
-	Project Project `json:"project"`
+// SetKnownIssuesTotal sets the value of KnownIssuesTotal.
+func (s *ReleaseAnalyticsSummary) SetKnownIssuesTotal(val uint) {
+	s.KnownIssuesTotal = val
 }
 
-// GetProject returns the value of Project.
-func (s *ProjectResponse) GetProject() Project {
-	return s.Project
+// SetNewIssuesTotal sets the value of NewIssuesTotal.
+func (s *ReleaseAnalyticsSummary) SetNewIssuesTotal(val uint) {
+	s.NewIssuesTotal = val
 }
 
-// SetProject sets the value of Project.
-func (s *ProjectResponse) SetProject(val Project) {
-	s.Project = val
+// SetRegressionsTotal sets the value of RegressionsTotal.
+func (s *ReleaseAnalyticsSummary) SetRegressionsTotal(val uint) {
+	s.RegressionsTotal = val
 }
 
-func (*ProjectResponse) getProjectRes()    {}
-func (*ProjectResponse) updateProjectRes() {}
-
-// Ref: #/components/schemas/ProjectStatsResponse
-type ProjectStatsResponse struct {
-	TotalIssues        uint                              `json:"total_issues"`
-	IssuesByLevel      ProjectStatsResponseIssuesByLevel `json:"issues_by_level"`
-	MostFrequentIssues []IssueSummary                    `json:"most_frequent_issues"`
+// SetResolvedInVersionTotal sets the value of ResolvedInVersionTotal.
+func (s *ReleaseAnalyticsSummary) SetResolvedInVersionTotal(val uint) {
+	s.ResolvedInVersionTotal = val
 }
 
-// GetTotalIssues returns the value of TotalIssues.
-func (s *ProjectStatsResponse) GetTotalIssues() uint {
-	return s.TotalIssues
+// SetUsersAffected sets the value of UsersAffected.
+func (s *ReleaseAnalyticsSummary) SetUsersAffected(val uint) {
+	s.UsersAffected = val
 }
 
-// GetIssuesByLevel returns the value of IssuesByLevel.
-func (s *ProjectStatsResponse) GetIssuesByLevel() ProjectStatsResponseIssuesByLevel {
-	return s.IssuesByLevel
+// SetSessionsTotal sets the value of SessionsTotal.
+func (s *ReleaseAnalyticsSummary) SetSessionsTotal(val OptUint) {
+	s.SessionsTotal = val
 }
 
-// GetMostFrequentIssues returns the value of MostFrequentIssues.
-func (s *ProjectStatsResponse) GetMostFrequentIssues() []IssueSummary {
-	return s.MostFrequentIssues
+// SetSessionsErrored sets the value of SessionsErrored.
+func (s *ReleaseAnalyticsSummary) SetSessionsErrored(val OptUint) {
+	s.SessionsErrored = val
 }
 
-// SetTotalIssues sets the value of TotalIssues.
-func (s *ProjectStatsResponse) SetTotalIssues(val uint) {
-	s.TotalIssues = val
+// SetSessionsCrashed sets the value of SessionsCrashed.
+func (s *ReleaseAnalyticsSummary) SetSessionsCrashed(val OptUint) {
+	s.SessionsCrashed = val
 }
 
-// SetIssuesByLevel sets the value of IssuesByLevel.
-func (s *ProjectStatsResponse) SetIssuesByLevel(val ProjectStatsResponseIssuesByLevel) {
-	s.IssuesByLevel = val
+// SetUsersTotal sets the value of UsersTotal.
+func (s *ReleaseAnalyticsSummary) SetUsersTotal(val OptUint) {
+	s.UsersTotal = val
 }
 
-// SetMostFrequentIssues sets the value of MostFrequentIssues.
-func (s *ProjectStatsResponse) SetMostFrequentIssues(val []IssueSummary) {
-	s.MostFrequentIssues = val
+// SetCrashFreeSessions sets the value of CrashFreeSessions.
+func (s *ReleaseAnalyticsSummary) SetCrashFreeSessions(val OptFloat64) {
+	s.CrashFreeSessions = val
 }
 
-func (*ProjectStatsResponse) getProjectStatsRes() {}
+// SetCrashFreeUsers sets the value of CrashFreeUsers.
+func (s *ReleaseAnalyticsSummary) SetCrashFreeUsers(val OptFloat64) {
+	s.CrashFreeUsers = val
+}
 
-type ProjectStatsResponseIssuesByLevel struct {
-	Fatal     uint `json:"fatal"`
-	Exception uint `json:"exception"`
-	Error     uint `json:"error"`
-	Warning   uint `json:"warning"`
-	Info      uint `json:"info"`
-	Debug     uint `json:"debug"`
+// SetAdoption sets the value of Adoption.
+func (s *ReleaseAnalyticsSummary) SetAdoption(val OptFloat64) {
+	s.Adoption = val
 }
 
-// GetFatal returns the value of Fatal.
-func (s *ProjectStatsResponseIssuesByLevel) GetFatal() uint {
-	return s.Fatal
+// SetAvgSessionDuration sets the value of AvgSessionDuration.
+func (s *ReleaseAnalyticsSummary) SetAvgSessionDuration(val OptFloat32) {
+	s.AvgSessionDuration = val
 }
 
-// GetException returns the value of Exception.
-func (s *ProjectStatsResponseIssuesByLevel) GetException() uint {
-	return s.Exception
+// Ref: #/components/schemas/ReleaseComparison
+type ReleaseComparison struct {
+	Base   ReleaseAnalyticsSummary `json:"base"`
+	Target ReleaseAnalyticsSummary `json:"target"`
+	Delta  ReleaseComparisonDelta  `json:"delta"`
 }
 
-// GetError returns the value of Error.
-func (s *ProjectStatsResponseIssuesByLevel) GetError() uint {
-	return s.Error
+// GetBase returns the value of Base.
+func (s *ReleaseComparison) GetBase() ReleaseAnalyticsSummary {
+	return s.Base
 }
 
-// GetWarning returns the value of Warning.
-func (s *ProjectStatsResponseIssuesByLevel) GetWarning() uint {
-	return s.Warning
+// GetTarget returns the value of Target.
+func (s *ReleaseComparison) GetTarget() ReleaseAnalyticsSummary {
+	return s.Target
 }
 
-// GetInfo returns the value of Info.
-func (s *ProjectStatsResponseIssuesByLevel) GetInfo() uint {
-	return s.Info
+// GetDelta returns the value of Delta.
+func (s *ReleaseComparison) GetDelta() ReleaseComparisonDelta {
+	return s.Delta
 }
 
-// GetDebug returns the value of Debug.
-func (s *ProjectStatsResponseIssuesByLevel) GetDebug() uint {
-	return s.Debug
+// SetBase sets the value of Base.
+func (s *ReleaseComparison) SetBase(val ReleaseAnalyticsSummary) {
+	s.Base = val
 }
 
-// SetFatal sets the value of Fatal.
-func (s *ProjectStatsResponseIssuesByLevel) SetFatal(val uint) {
-	s.Fatal = val
+// SetTarget sets the value of Target.
+func (s *ReleaseComparison) SetTarget(val ReleaseAnalyticsSummary) {
+	s.Target = val
 }
 
-// SetException sets the value of Exception.
-func (s *ProjectStatsResponseIssuesByLevel) SetException(val uint) {
-	s.Exception = val
+// SetDelta sets the value of Delta.
+func (s *ReleaseComparison) SetDelta(val ReleaseComparisonDelta) {
+	s.Delta = val
 }
 
-// SetError sets the value of Error.
-func (s *ProjectStatsResponseIssuesByLevel) SetError(val uint) {
-	s.Error = val
+func (*ReleaseComparison) compareProjectReleasesAnalyticsRes() {}
+
+type ReleaseComparisonDelta struct {
+	KnownIssuesTotal       OptUint `json:"known_issues_total"`
+	NewIssuesTotal         OptUint `json:"new_issues_total"`
+	RegressionsTotal       OptUint `json:"regressions_total"`
+	ResolvedInVersionTotal OptUint `json:"resolved_in_version_total"`
+	UsersAffected          OptUint `json:"users_affected"`
+	SessionsTotal          OptUint `json:"sessions_total"`
+	// Target minus base, present when both releases have sessions.
+	CrashFreeSessions OptFloat64 `json:"crash_free_sessions"`
+	// Target minus base, present when both releases have sessions.
+	CrashFreeUsers OptFloat64 `json:"crash_free_users"`
+	// Target minus base, present when both releases have sessions.
+	Adoption OptFloat64 `json:"adoption"`
 }
 
-// SetWarning sets the value of Warning.
-func (s *ProjectStatsResponseIssuesByLevel) SetWarning(val uint) {
-	s.Warning = val
+// GetKnownIssuesTotal returns the value of KnownIssuesTotal.
+func (s *ReleaseComparisonDelta) GetKnownIssuesTotal() OptUint {
+	return s.KnownIssuesTotal
 }
 
-// SetInfo sets the value of Info.
-func (s *ProjectStatsResponseIssuesByLevel) SetInfo(val uint) {
-	s.Info = val
+// GetNewIssuesTotal returns the value of NewIssuesTotal.
+func (s *ReleaseComparisonDelta) GetNewIssuesTotal() OptUint {
+	return s.NewIssuesTotal
 }
 
-// SetDebug sets the value of Debug.
-func (s *ProjectStatsResponseIssuesByLevel) SetDebug(val uint) {
-	s.Debug = val
+// GetRegressionsTotal returns the value of RegressionsTotal.
+func (s *ReleaseComparisonDelta) GetRegressionsTotal() OptUint {
+	return s.RegressionsTotal
 }
 
-// Ref: #/components/schemas/RefreshTokenRequest
-type RefreshTokenRequest struct {
-	RefreshToken string `json:"refresh_token"`
+// GetResolvedInVersionTotal returns the value of ResolvedInVersionTotal.
+func (s *ReleaseComparisonDelta) GetResolvedInVersionTotal() OptUint {
+	return s.ResolvedInVersionTotal
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *RefreshTokenRequest) GetRefreshToken() string {
-	return s.RefreshToken
+// GetUsersAffected returns the value of UsersAffected.
+func (s *ReleaseComparisonDelta) GetUsersAffected() OptUint {
+	return s.UsersAffected
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *RefreshTokenRequest) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// GetSessionsTotal returns the value of SessionsTotal.
+func (s *ReleaseComparisonDelta) GetSessionsTotal() OptUint {
+	return s.SessionsTotal
 }
 
-// Ref: #/components/schemas/RefreshTokenResponse
-type RefreshTokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	ExpiresIn    int    `json:"expires_in"`
+// GetCrashFreeSessions returns the value of CrashFreeSessions.
+func (s *ReleaseComparisonDelta) GetCrashFreeSessions() OptFloat64 {
+	return s.CrashFreeSessions
 }
 
-// GetAccessToken returns the value of AccessToken.
-func (s *RefreshTokenResponse) GetAccessToken() string {
-	return s.AccessToken
+// GetCrashFreeUsers returns the value of CrashFreeUsers.
+func (s *ReleaseComparisonDelta) GetCrashFreeUsers() OptFloat64 {
+	return s.CrashFreeUsers
 }
 
-// GetRefreshToken returns the value of RefreshToken.
-func (s *RefreshTokenResponse) GetRefreshToken() string {
-	return s.RefreshToken
+// GetAdoption returns the value of Adoption.
+func (s *ReleaseComparisonDelta) GetAdoption() OptFloat64 {
+	return s.Adoption
 }
 
-// GetExpiresIn returns the value of ExpiresIn.
-func (s *RefreshTokenResponse) GetExpiresIn() int {
-	return s.ExpiresIn
+// SetKnownIssuesTotal sets the value of KnownIssuesTotal.
+func (s *ReleaseComparisonDelta) SetKnownIssuesTotal(val OptUint) {
+	s.KnownIssuesTotal = val
 }
 
-// SetAccessToken sets the value of AccessToken.
-func (s *RefreshTokenResponse) SetAccessToken(val string) {
-	s.AccessToken = val
+// SetNewIssuesTotal sets the value of NewIssuesTotal.
+func (s *ReleaseComparisonDelta) SetNewIssuesTotal(val OptUint) {
+	s.NewIssuesTotal = val
 }
 
-// SetRefreshToken sets the value of RefreshToken.
-func (s *RefreshTokenResponse) SetRefreshToken(val string) {
-	s.RefreshToken = val
+// SetRegressionsTotal sets the value of RegressionsTotal.
+func (s *ReleaseComparisonDelta) SetRegressionsTotal(val OptUint) {
+	s.RegressionsTotal = val
 }
 
-// SetExpiresIn sets the value of ExpiresIn.
-func (s *RefreshTokenResponse) SetExpiresIn(val int) {
-	s.ExpiresIn = val
+// SetResolvedInVersionTotal sets the value of ResolvedInVersionTotal.
+func (s *ReleaseComparisonDelta) SetResolvedInVersionTotal(val OptUint) {
+	s.ResolvedInVersionTotal = val
 }
 
-func (*RefreshTokenResponse) refreshTokenRes() {}
+// SetUsersAffected sets the value of UsersAffected.
+func (s *ReleaseComparisonDelta) SetUsersAffected(val OptUint) {
+	s.UsersAffected = val
+}
 
-// Ref: #/components/schemas/ReleaseAnalyticsDetails
-type ReleaseAnalyticsDetails struct {
-	Version              string                                      `json:"version"`
-	CreatedAt            time.Time                                   `json:"created_at"`
-	Stats                ReleaseAnalyticsSummary                     `json:"stats"`
-	TopIssues            []IssueSummary                              `json:"top_issues"`
-	SeverityDistribution ReleaseAnalyticsDetailsSeverityDistribution `json:"severity_distribution"`
-	FixTime              ReleaseAnalyticsDetailsFixTime              `json:"fix_time"`
-	Segments             ReleaseAnalyticsDetailsSegments             `json:"segments"`
+// SetSessionsTotal sets the value of SessionsTotal.
+func (s *ReleaseComparisonDelta) SetSessionsTotal(val OptUint) {
+	s.SessionsTotal = val
 }
 
-// GetVersion returns the value of Version.
-func (s *ReleaseAnalyticsDetails) GetVersion() string {
-	return s.Version
+// SetCrashFreeSessions sets the value of CrashFreeSessions.
+func (s *ReleaseComparisonDelta) SetCrashFreeSessions(val OptFloat64) {
+	s.CrashFreeSessions = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *ReleaseAnalyticsDetails) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetCrashFreeUsers sets the value of CrashFreeUsers.
+func (s *ReleaseComparisonDelta) SetCrashFreeUsers(val OptFloat64) {
+	s.CrashFreeUsers = val
 }
 
-// GetStats returns the value of Stats.
-func (s *ReleaseAnalyticsDetails) GetStats() ReleaseAnalyticsSummary {
-	return s.Stats
+// SetAdoption sets the value of Adoption.
+func (s *ReleaseComparisonDelta) SetAdoption(val OptFloat64) {
+	s.Adoption = val
 }
 
-// GetTopIssues returns the value of TopIssues.
-func (s *ReleaseAnalyticsDetails) GetTopIssues() []IssueSummary {
-	return s.TopIssues
+// Ref: #/components/schemas/ReleaseSegmentsResponse
+type ReleaseSegmentsResponse struct {
+	Segment string                        `json:"segment"`
+	Values  ReleaseSegmentsResponseValues `json:"values"`
 }
 
-// GetSeverityDistribution returns the value of SeverityDistribution.
-func (s *ReleaseAnalyticsDetails) GetSeverityDistribution() ReleaseAnalyticsDetailsSeverityDistribution {
-	return s.SeverityDistribution
+// GetSegment returns the value of Segment.
+func (s *ReleaseSegmentsResponse) GetSegment() string {
+	return s.Segment
 }
 
-// GetFixTime returns the value of FixTime.
-func (s *ReleaseAnalyticsDetails) GetFixTime() ReleaseAnalyticsDetailsFixTime {
-	return s.FixTime
+// GetValues returns the value of Values.
+func (s *ReleaseSegmentsResponse) GetValues() ReleaseSegmentsResponseValues {
+	return s.Values
 }
 
-// GetSegments returns the value of Segments.
-func (s *ReleaseAnalyticsDetails) GetSegments() ReleaseAnalyticsDetailsSegments {
-	return s.Segments
+// SetSegment sets the value of Segment.
+func (s *ReleaseSegmentsResponse) SetSegment(val string) {
+	s.Segment = val
 }
 
-// SetVersion sets the value of Version.
-func (s *ReleaseAnalyticsDetails) SetVersion(val string) {
-	s.Version = val
+// SetValues sets the value of Values.
+func (s *ReleaseSegmentsResponse) SetValues(val ReleaseSegmentsResponseValues) {
+	s.Values = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *ReleaseAnalyticsDetails) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+func (*ReleaseSegmentsResponse) getProjectReleaseSegmentsRes() {}
+
+type ReleaseSegmentsResponseValues map[string]uint
+
+func (s *ReleaseSegmentsResponseValues) init() ReleaseSegmentsResponseValues {
+	m := *s
+	if m == nil {
+		m = map[string]uint{}
+		*s = m
+	}
+	return m
 }
 
-// SetStats sets the value of Stats.
-func (s *ReleaseAnalyticsDetails) SetStats(val ReleaseAnalyticsSummary) {
-	s.Stats = val
+// RemoveTeamMemberNoContent is response for RemoveTeamMember operation.
+type RemoveTeamMemberNoContent struct{}
+
+func (*RemoveTeamMemberNoContent) removeTeamMemberRes() {}
+
+// ResetPasswordNoContent is response for ResetPassword operation.
+type ResetPasswordNoContent struct{}
+
+func (*ResetPasswordNoContent) resetPasswordRes() {}
+
+// Ref: #/components/schemas/ResetPasswordRequest
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
 }
 
-// SetTopIssues sets the value of TopIssues.
-func (s *ReleaseAnalyticsDetails) SetTopIssues(val []IssueSummary) {
-	s.TopIssues = val
+// GetToken returns the value of Token.
+func (s *ResetPasswordRequest) GetToken() string {
+	return s.Token
 }
 
-// SetSeverityDistribution sets the value of SeverityDistribution.
-func (s *ReleaseAnalyticsDetails) SetSeverityDistribution(val ReleaseAnalyticsDetailsSeverityDistribution) {
-	s.SeverityDistribution = val
+// GetNewPassword returns the value of NewPassword.
+func (s *ResetPasswordRequest) GetNewPassword() string {
+	return s.NewPassword
 }
 
-// SetFixTime sets the value of FixTime.
-func (s *ReleaseAnalyticsDetails) SetFixTime(val ReleaseAnalyticsDetailsFixTime) {
-	s.FixTime = val
+// SetToken sets the value of Token.
+func (s *ResetPasswordRequest) SetToken(val string) {
+	s.Token = val
 }
 
-// SetSegments sets the value of Segments.
-func (s *ReleaseAnalyticsDetails) SetSegments(val ReleaseAnalyticsDetailsSegments) {
-	s.Segments = val
+// SetNewPassword sets the value of NewPassword.
+func (s *ResetPasswordRequest) SetNewPassword(val string) {
+	s.NewPassword = val
 }
 
-func (*ReleaseAnalyticsDetails) getProjectReleaseAnalyticsDetailsRes() {}
+// RevokeProjectKeyNoContent is response for RevokeProjectKey operation.
+type RevokeProjectKeyNoContent struct{}
 
-type ReleaseAnalyticsDetailsFixTime struct {
-	Avg    OptFloat32 `json:"avg"`
-	Median OptFloat32 `json:"median"`
-	P95    OptFloat32 `json:"p95"`
+func (*RevokeProjectKeyNoContent) revokeProjectKeyRes() {}
+
+// Ref: #/components/schemas/SearchEventsResponse
+type SearchEventsResponse struct {
+	Events []IssueEvent `json:"events"`
+	// Cursor of the next page, not set on the last page.
+	NextCursor OptString `json:"next_cursor"`
 }
 
-// GetAvg returns the value of Avg.
-func (s *ReleaseAnalyticsDetailsFixTime) GetAvg() OptFloat32 {
-	return s.Avg
+// GetEvents returns the value of Events.
+func (s *SearchEventsResponse) GetEvents() []IssueEvent {
+	return s.Events
 }
 
-// GetMedian returns the value of Median.
-func (s *ReleaseAnalyticsDetailsFixTime) GetMedian() OptFloat32 {
-	return s.Median
+// GetNextCursor returns the value of NextCursor.
+func (s *SearchEventsResponse) GetNextCursor() OptString {
+	return s.NextCursor
 }
 
-// GetP95 returns the value of P95.
-func (s *ReleaseAnalyticsDetailsFixTime) GetP95() OptFloat32 {
-	return s.P95
+// SetEvents sets the value of Events.
+func (s *SearchEventsResponse) SetEvents(val []IssueEvent) {
+	s.Events = val
 }
 
-// SetAvg sets the value of Avg.
-func (s *ReleaseAnalyticsDetailsFixTime) SetAvg(val OptFloat32) {
-	s.Avg = val
+// SetNextCursor sets the value of NextCursor.
+func (s *SearchEventsResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
 }
 
-// SetMedian sets the value of Median.
-func (s *ReleaseAnalyticsDetailsFixTime) SetMedian(val OptFloat32) {
-	s.Median = val
+func (*SearchEventsResponse) listProjectIssueEventsRes()    {}
+func (*SearchEventsResponse) listProjectIssueTagEventsRes() {}
+func (*SearchEventsResponse) searchProjectEventsRes()       {}
+
+// Send2FACodeNoContent is response for Send2FACode operation.
+type Send2FACodeNoContent struct{}
+
+func (*Send2FACodeNoContent) send2FACodeRes() {}
+
+// SendTestNotificationNoContent is response for SendTestNotification operation.
+type SendTestNotificationNoContent struct{}
+
+func (*SendTestNotificationNoContent) sendTestNotificationRes() {}
+
+// Ref: #/components/schemas/SetSuperuserStatusRequest
+type SetSuperuserStatusRequest struct {
+	IsSuperuser bool `json:"is_superuser"`
 }
 
-// SetP95 sets the value of P95.
-func (s *ReleaseAnalyticsDetailsFixTime) SetP95(val OptFloat32) {
-	s.P95 = val
+// GetIsSuperuser returns the value of IsSuperuser.
+func (s *SetSuperuserStatusRequest) GetIsSuperuser() bool {
+	return s.IsSuperuser
 }
 
-type ReleaseAnalyticsDetailsSegments struct {
-	Platform    OptReleaseAnalyticsDetailsSegmentsPlatform    `json:"platform"`
-	BrowserName OptReleaseAnalyticsDetailsSegmentsBrowserName `json:"browser_name"`
-	OsName      OptReleaseAnalyticsDetailsSegmentsOsName      `json:"os_name"`
-	DeviceArch  OptReleaseAnalyticsDetailsSegmentsDeviceArch  `json:"device_arch"`
-	RuntimeName OptReleaseAnalyticsDetailsSegmentsRuntimeName `json:"runtime_name"`
+// SetIsSuperuser sets the value of IsSuperuser.
+func (s *SetSuperuserStatusRequest) SetIsSuperuser(val bool) {
+	s.IsSuperuser = val
 }
 
-// GetPlatform returns the value of Platform.
-func (s *ReleaseAnalyticsDetailsSegments) GetPlatform() OptReleaseAnalyticsDetailsSegmentsPlatform {
-	return s.Platform
+// Ref: #/components/schemas/SetUserActiveStatusRequest
+type SetUserActiveStatusRequest struct {
+	IsActive bool `json:"is_active"`
 }
 
-// GetBrowserName returns the value of BrowserName.
-func (s *ReleaseAnalyticsDetailsSegments) GetBrowserName() OptReleaseAnalyticsDetailsSegmentsBrowserName {
-	return s.BrowserName
+// GetIsActive returns the value of IsActive.
+func (s *SetUserActiveStatusRequest) GetIsActive() bool {
+	return s.IsActive
 }
 
-// GetOsName returns the value of OsName.
-func (s *ReleaseAnalyticsDetailsSegments) GetOsName() OptReleaseAnalyticsDetailsSegmentsOsName {
-	return s.OsName
+// SetIsActive sets the value of IsActive.
+func (s *SetUserActiveStatusRequest) SetIsActive(val bool) {
+	s.IsActive = val
 }
 
-// GetDeviceArch returns the value of DeviceArch.
-func (s *ReleaseAnalyticsDetailsSegments) GetDeviceArch() OptReleaseAnalyticsDetailsSegmentsDeviceArch {
-	return s.DeviceArch
+// Sort order (ascending or descending).
+// Ref: #/components/schemas/SortOrder
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// AllValues returns all SortOrder values.
+func (SortOrder) AllValues() []SortOrder {
+	return []SortOrder{
+		SortOrderAsc,
+		SortOrderDesc,
+	}
 }
 
-// GetRuntimeName returns the value of RuntimeName.
-func (s *ReleaseAnalyticsDetailsSegments) GetRuntimeName() OptReleaseAnalyticsDetailsSegmentsRuntimeName {
-	return s.RuntimeName
+// MarshalText implements encoding.TextMarshaler.
+func (s SortOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case SortOrderAsc:
+		return []byte(s), nil
+	case SortOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetPlatform sets the value of Platform.
-func (s *ReleaseAnalyticsDetailsSegments) SetPlatform(val OptReleaseAnalyticsDetailsSegmentsPlatform) {
-	s.Platform = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SortOrder) UnmarshalText(data []byte) error {
+	switch SortOrder(data) {
+	case SortOrderAsc:
+		*s = SortOrderAsc
+		return nil
+	case SortOrderDesc:
+		*s = SortOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetBrowserName sets the value of BrowserName.
-func (s *ReleaseAnalyticsDetailsSegments) SetBrowserName(val OptReleaseAnalyticsDetailsSegmentsBrowserName) {
-	s.BrowserName = val
+// Ref: #/components/schemas/Team
+type Team struct {
+	ID        uint         `json:"id"`
+	Name      string       `json:"name"`
+	CreatedAt time.Time    `json:"created_at"`
+	Members   []TeamMember `json:"members"`
 }
 
-// SetOsName sets the value of OsName.
-func (s *ReleaseAnalyticsDetailsSegments) SetOsName(val OptReleaseAnalyticsDetailsSegmentsOsName) {
-	s.OsName = val
+// GetID returns the value of ID.
+func (s *Team) GetID() uint {
+	return s.ID
 }
 
-// SetDeviceArch sets the value of DeviceArch.
-func (s *ReleaseAnalyticsDetailsSegments) SetDeviceArch(val OptReleaseAnalyticsDetailsSegmentsDeviceArch) {
-	s.DeviceArch = val
+// GetName returns the value of Name.
+func (s *Team) GetName() string {
+	return s.Name
 }
 
-// SetRuntimeName sets the value of RuntimeName.
-func (s *ReleaseAnalyticsDetailsSegments) SetRuntimeName(val OptReleaseAnalyticsDetailsSegmentsRuntimeName) {
-	s.RuntimeName = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Team) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-type ReleaseAnalyticsDetailsSegmentsBrowserName map[string]uint
-
-func (s *ReleaseAnalyticsDetailsSegmentsBrowserName) init() ReleaseAnalyticsDetailsSegmentsBrowserName {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// GetMembers returns the value of Members.
+func (s *Team) GetMembers() []TeamMember {
+	return s.Members
 }
 
-type ReleaseAnalyticsDetailsSegmentsDeviceArch map[string]uint
-
-func (s *ReleaseAnalyticsDetailsSegmentsDeviceArch) init() ReleaseAnalyticsDetailsSegmentsDeviceArch {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// SetID sets the value of ID.
+func (s *Team) SetID(val uint) {
+	s.ID = val
 }
 
-type ReleaseAnalyticsDetailsSegmentsOsName map[string]uint
-
-func (s *ReleaseAnalyticsDetailsSegmentsOsName) init() ReleaseAnalyticsDetailsSegmentsOsName {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// SetName sets the value of Name.
+func (s *Team) SetName(val string) {
+	s.Name = val
 }
 
-type ReleaseAnalyticsDetailsSegmentsPlatform map[string]uint
-
-func (s *ReleaseAnalyticsDetailsSegmentsPlatform) init() ReleaseAnalyticsDetailsSegmentsPlatform {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Team) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-type ReleaseAnalyticsDetailsSegmentsRuntimeName map[string]uint
-
-func (s *ReleaseAnalyticsDetailsSegmentsRuntimeName) init() ReleaseAnalyticsDetailsSegmentsRuntimeName {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// SetMembers sets the value of Members.
+func (s *Team) SetMembers(val []TeamMember) {
+	s.Members = val
 }
 
-type ReleaseAnalyticsDetailsSeverityDistribution map[string]uint
+func (*Team) getTeamRes() {}
 
-func (s *ReleaseAnalyticsDetailsSeverityDistribution) init() ReleaseAnalyticsDetailsSeverityDistribution {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// Ref: #/components/schemas/TeamExistsResponse
+type TeamExistsResponse struct {
+	Exists bool `json:"exists"`
 }
 
-// Ref: #/components/schemas/ReleaseAnalyticsSummary
-type ReleaseAnalyticsSummary struct {
-	Version                string    `json:"version"`
-	CreatedAt              time.Time `json:"created_at"`
-	KnownIssuesTotal       uint      `json:"known_issues_total"`
-	NewIssuesTotal         uint      `json:"new_issues_total"`
-	RegressionsTotal       uint      `json:"regressions_total"`
-	ResolvedInVersionTotal uint      `json:"resolved_in_version_total"`
-	UsersAffected          uint      `json:"users_affected"`
+// GetExists returns the value of Exists.
+func (s *TeamExistsResponse) GetExists() bool {
+	return s.Exists
 }
 
-// GetVersion returns the value of Version.
-func (s *ReleaseAnalyticsSummary) GetVersion() string {
-	return s.Version
+// SetExists sets the value of Exists.
+func (s *TeamExistsResponse) SetExists(val bool) {
+	s.Exists = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *ReleaseAnalyticsSummary) GetCreatedAt() time.Time {
-	return s.CreatedAt
-}
+func (*TeamExistsResponse) checkTeamExistsRes() {}
 
-// GetKnownIssuesTotal returns the value of KnownIssuesTotal.
-func (s *ReleaseAnalyticsSummary) GetKnownIssuesTotal() uint {
-	return s.KnownIssuesTotal
+// Ref: #/components/schemas/TeamMember
+type TeamMember struct {
+	UserID uint           `json:"user_id"`
+	Role   TeamMemberRole `json:"role"`
 }
 
-// GetNewIssuesTotal returns the value of NewIssuesTotal.
-func (s *ReleaseAnalyticsSummary) GetNewIssuesTotal() uint {
-	return s.NewIssuesTotal
+// GetUserID returns the value of UserID.
+func (s *TeamMember) GetUserID() uint {
+	return s.UserID
 }
 
-// GetRegressionsTotal returns the value of RegressionsTotal.
-func (s *ReleaseAnalyticsSummary) GetRegressionsTotal() uint {
-	return s.RegressionsTotal
+// GetRole returns the value of Role.
+func (s *TeamMember) GetRole() TeamMemberRole {
+	return s.Role
 }
 
-// GetResolvedInVersionTotal returns the value of ResolvedInVersionTotal.
-func (s *ReleaseAnalyticsSummary) GetResolvedInVersionTotal() uint {
-	return s.ResolvedInVersionTotal
+// SetUserID sets the value of UserID.
+func (s *TeamMember) SetUserID(val uint) {
+	s.UserID = val
 }
 
-// GetUsersAffected returns the value of UsersAffected.
-func (s *ReleaseAnalyticsSummary) GetUsersAffected() uint {
-	return s.UsersAffected
+// SetRole sets the value of Role.
+func (s *TeamMember) SetRole(val TeamMemberRole) {
+	s.Role = val
 }
 
-// SetVersion sets the value of Version.
-func (s *ReleaseAnalyticsSummary) SetVersion(val string) {
-	s.Version = val
-}
+type TeamMemberRole string
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *ReleaseAnalyticsSummary) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+const (
+	TeamMemberRoleOwner  TeamMemberRole = "owner"
+	TeamMemberRoleAdmin  TeamMemberRole = "admin"
+	TeamMemberRoleMember TeamMemberRole = "member"
+)
+
+// AllValues returns all TeamMemberRole values.
+func (TeamMemberRole) AllValues() []TeamMemberRole {
+	return []TeamMemberRole{
+		TeamMemberRoleOwner,
+		TeamMemberRoleAdmin,
+		TeamMemberRoleMember,
+	}
 }
 
-// SetKnownIssuesTotal sets the value of KnownIssuesTotal.
-func (s *ReleaseAnalyticsSummary) SetKnownIssuesTotal(val uint) {
-	s.KnownIssuesTotal = val
+// MarshalText implements encoding.TextMarshaler.
+func (s TeamMemberRole) MarshalText() ([]byte, error) {
+	switch s {
+	case TeamMemberRoleOwner:
+		return []byte(s), nil
+	case TeamMemberRoleAdmin:
+		return []byte(s), nil
+	case TeamMemberRoleMember:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetNewIssuesTotal sets the value of NewIssuesTotal.
-func (s *ReleaseAnalyticsSummary) SetNewIssuesTotal(val uint) {
-	s.NewIssuesTotal = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TeamMemberRole) UnmarshalText(data []byte) error {
+	switch TeamMemberRole(data) {
+	case TeamMemberRoleOwner:
+		*s = TeamMemberRoleOwner
+		return nil
+	case TeamMemberRoleAdmin:
+		*s = TeamMemberRoleAdmin
+		return nil
+	case TeamMemberRoleMember:
+		*s = TeamMemberRoleMember
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetRegressionsTotal sets the value of RegressionsTotal.
-func (s *ReleaseAnalyticsSummary) SetRegressionsTotal(val uint) {
-	s.RegressionsTotal = val
+// Ref: #/components/schemas/TeamResponse
+type TeamResponse struct {
+	Team Team `json:"team"`
 }
 
-// SetResolvedInVersionTotal sets the value of ResolvedInVersionTotal.
-func (s *ReleaseAnalyticsSummary) SetResolvedInVersionTotal(val uint) {
-	s.ResolvedInVersionTotal = val
+// GetTeam returns the value of Team.
+func (s *TeamResponse) GetTeam() Team {
+	return s.Team
 }
 
-// SetUsersAffected sets the value of UsersAffected.
-func (s *ReleaseAnalyticsSummary) SetUsersAffected(val uint) {
-	s.UsersAffected = val
+// SetTeam sets the value of Team.
+func (s *TeamResponse) SetTeam(val Team) {
+	s.Team = val
 }
 
-// Ref: #/components/schemas/ReleaseComparison
-type ReleaseComparison struct {
-	Base   ReleaseAnalyticsSummary `json:"base"`
-	Target ReleaseAnalyticsSummary `json:"target"`
-	Delta  ReleaseComparisonDelta  `json:"delta"`
+func (*TeamResponse) getProjectTeamRes() {}
+
+// Ref: #/components/schemas/TimeseriesData
+type TimeseriesData struct {
+	Period      Period `json:"period"`
+	Name        string `json:"name"`
+	Occurrences []uint `json:"occurrences"`
 }
 
-// GetBase returns the value of Base.
-func (s *ReleaseComparison) GetBase() ReleaseAnalyticsSummary {
-	return s.Base
+// GetPeriod returns the value of Period.
+func (s *TimeseriesData) GetPeriod() Period {
+	return s.Period
 }
 
-// GetTarget returns the value of Target.
-func (s *ReleaseComparison) GetTarget() ReleaseAnalyticsSummary {
-	return s.Target
+// GetName returns the value of Name.
+func (s *TimeseriesData) GetName() string {
+	return s.Name
 }
 
-// GetDelta returns the value of Delta.
-func (s *ReleaseComparison) GetDelta() ReleaseComparisonDelta {
-	return s.Delta
+// GetOccurrences returns the value of Occurrences.
+func (s *TimeseriesData) GetOccurrences() []uint {
+	return s.Occurrences
 }
 
-// SetBase sets the value of Base.
-func (s *ReleaseComparison) SetBase(val ReleaseAnalyticsSummary) {
-	s.Base = val
+// SetPeriod sets the value of Period.
+func (s *TimeseriesData) SetPeriod(val Period) {
+	s.Period = val
 }
 
-// SetTarget sets the value of Target.
-func (s *ReleaseComparison) SetTarget(val ReleaseAnalyticsSummary) {
-	s.Target = val
+// SetName sets the value of Name.
+func (s *TimeseriesData) SetName(val string) {
+	s.Name = val
 }
 
-// SetDelta sets the value of Delta.
-func (s *ReleaseComparison) SetDelta(val ReleaseComparisonDelta) {
-	s.Delta = val
+// SetOccurrences sets the value of Occurrences.
+func (s *TimeseriesData) SetOccurrences(val []uint) {
+	s.Occurrences = val
 }
 
-func (*ReleaseComparison) compareProjectReleasesAnalyticsRes() {}
+type TimeseriesResponse []TimeseriesData
 
-type ReleaseComparisonDelta struct {
-	KnownIssuesTotal       OptUint `json:"known_issues_total"`
-	NewIssuesTotal         OptUint `json:"new_issues_total"`
-	RegressionsTotal       OptUint `json:"regressions_total"`
-	ResolvedInVersionTotal OptUint `json:"resolved_in_version_total"`
-	UsersAffected          OptUint `json:"users_affected"`
-}
+func (*TimeseriesResponse) getEventsTimeseriesRes()               {}
+func (*TimeseriesResponse) getIssuesTimeseriesRes()               {}
+func (*TimeseriesResponse) getProjectIssueEventsTimeseriesRes()   {}
+func (*TimeseriesResponse) getProjectIssueTimeseriesRes()         {}
+func (*TimeseriesResponse) getProjectReleaseErrorsTimeseriesRes() {}
 
-// GetKnownIssuesTotal returns the value of KnownIssuesTotal.
-func (s *ReleaseComparisonDelta) GetKnownIssuesTotal() OptUint {
-	return s.KnownIssuesTotal
+// Ref: #/components/schemas/TraceResponse
+type TraceResponse struct {
+	TraceID        string             `json:"trace_id"`
+	StartTimestamp time.Time          `json:"start_timestamp"`
+	Timestamp      time.Time          `json:"timestamp"`
+	DurationMs     float64            `json:"duration_ms"`
+	Transactions   []TraceTransaction `json:"transactions"`
+	// Spans ordered as a waterfall, every span follows its parent.
+	Spans []TraceSpan `json:"spans"`
 }
 
-// GetNewIssuesTotal returns the value of NewIssuesTotal.
-func (s *ReleaseComparisonDelta) GetNewIssuesTotal() OptUint {
-	return s.NewIssuesTotal
+// GetTraceID returns the value of TraceID.
+func (s *TraceResponse) GetTraceID() string {
+	return s.TraceID
 }
 
-// GetRegressionsTotal returns the value of RegressionsTotal.
-func (s *ReleaseComparisonDelta) GetRegressionsTotal() OptUint {
-	return s.RegressionsTotal
+// GetStartTimestamp returns the value of StartTimestamp.
+func (s *TraceResponse) GetStartTimestamp() time.Time {
+	return s.StartTimestamp
 }
 
-// GetResolvedInVersionTotal returns the value of ResolvedInVersionTotal.
-func (s *ReleaseComparisonDelta) GetResolvedInVersionTotal() OptUint {
-	return s.ResolvedInVersionTotal
+// GetTimestamp returns the value of Timestamp.
+func (s *TraceResponse) GetTimestamp() time.Time {
+	return s.Timestamp
 }
 
-// GetUsersAffected returns the value of UsersAffected.
-func (s *ReleaseComparisonDelta) GetUsersAffected() OptUint {
-	return s.UsersAffected
+// GetDurationMs returns the value of DurationMs.
+func (s *TraceResponse) GetDurationMs() float64 {
+	return s.DurationMs
 }
 
-// SetKnownIssuesTotal sets the value of KnownIssuesTotal.
-func (s *ReleaseComparisonDelta) SetKnownIssuesTotal(val OptUint) {
-	s.KnownIssuesTotal = val
+// GetTransactions returns the value of Transactions.
+func (s *TraceResponse) GetTransactions() []TraceTransaction {
+	return s.Transactions
 }
 
-// SetNewIssuesTotal sets the value of NewIssuesTotal.
-func (s *ReleaseComparisonDelta) SetNewIssuesTotal(val OptUint) {
-	s.NewIssuesTotal = val
+// GetSpans returns the value of Spans.
+func (s *TraceResponse) GetSpans() []TraceSpan {
+	return s.Spans
 }
 
-// SetRegressionsTotal sets the value of RegressionsTotal.
-func (s *ReleaseComparisonDelta) SetRegressionsTotal(val OptUint) {
-	s.RegressionsTotal = val
+// SetTraceID sets the value of TraceID.
+func (s *TraceResponse) SetTraceID(val string) {
+	s.TraceID = val
 }
 
-// SetResolvedInVersionTotal sets the value of ResolvedInVersionTotal.
-func (s *ReleaseComparisonDelta) SetResolvedInVersionTotal(val OptUint) {
-	s.ResolvedInVersionTotal = val
+// SetStartTimestamp sets the value of StartTimestamp.
+func (s *TraceResponse) SetStartTimestamp(val time.Time) {
+	s.StartTimestamp = val
 }
 
-// SetUsersAffected sets the value of UsersAffected.
-func (s *ReleaseComparisonDelta) SetUsersAffected(val OptUint) {
-	s.UsersAffected = val
+// SetTimestamp sets the value of Timestamp.
+func (s *TraceResponse) SetTimestamp(val time.Time) {
+	s.Timestamp = val
 }
 
-// Ref: #/components/schemas/ReleaseSegmentsResponse
-type ReleaseSegmentsResponse struct {
-	Segment string                        `json:"segment"`
-	Values  ReleaseSegmentsResponseValues `json:"values"`
+// SetDurationMs sets the value of DurationMs.
+func (s *TraceResponse) SetDurationMs(val float64) {
+	s.DurationMs = val
 }
 
-// GetSegment returns the value of Segment.
-func (s *ReleaseSegmentsResponse) GetSegment() string {
-	return s.Segment
+// SetTransactions sets the value of Transactions.
+func (s *TraceResponse) SetTransactions(val []TraceTransaction) {
+	s.Transactions = val
 }
 
-// GetValues returns the value of Values.
-func (s *ReleaseSegmentsResponse) GetValues() ReleaseSegmentsResponseValues {
-	return s.Values
+// SetSpans sets the value of Spans.
+func (s *TraceResponse) SetSpans(val []TraceSpan) {
+	s.Spans = val
 }
 
-// SetSegment sets the value of Segment.
-func (s *ReleaseSegmentsResponse) SetSegment(val string) {
-	s.Segment = val
-}
+func (*TraceResponse) getTraceRes() {}
 
-// SetValues sets the value of Values.
-func (s *ReleaseSegmentsResponse) SetValues(val ReleaseSegmentsResponseValues) {
-	s.Values = val
+// Ref: #/components/schemas/TraceSpan
+type TraceSpan struct {
+	SpanID          string           `json:"span_id"`
+	ParentSpanID    OptString        `json:"parent_span_id"`
+	TransactionID   string           `json:"transaction_id"`
+	TransactionName OptString        `json:"transaction_name"`
+	Op              string           `json:"op"`
+	Description     string           `json:"description"`
+	Status          string           `json:"status"`
+	Tags            OptTraceSpanTags `json:"tags"`
+	StartTimestamp  time.Time        `json:"start_timestamp"`
+	Timestamp       time.Time        `json:"timestamp"`
+	DurationMs      float64          `json:"duration_ms"`
+	// Offset from the trace start.
+	OffsetMs float64 `json:"offset_ms"`
+	// Nesting level in the waterfall.
+	Depth uint `json:"depth"`
 }
 
-func (*ReleaseSegmentsResponse) getProjectReleaseSegmentsRes() {}
+// GetSpanID returns the value of SpanID.
+func (s *TraceSpan) GetSpanID() string {
+	return s.SpanID
+}
 
-type ReleaseSegmentsResponseValues map[string]uint
+// GetParentSpanID returns the value of ParentSpanID.
+func (s *TraceSpan) GetParentSpanID() OptString {
+	return s.ParentSpanID
+}
 
-func (s *ReleaseSegmentsResponseValues) init() ReleaseSegmentsResponseValues {
-	m := *s
-	if m == nil {
-		m = map[string]uint{}
-		*s = m
-	}
-	return m
+// GetTransactionID returns the value of TransactionID.
+func (s *TraceSpan) GetTransactionID() string {
+	return s.TransactionID
 }
 
-// RemoveTeamMemberNoContent is response for RemoveTeamMember operation.
-type RemoveTeamMemberNoContent struct{}
+// GetTransactionName returns the value of TransactionName.
+func (s *TraceSpan) GetTransactionName() OptString {
+	return s.TransactionName
+}
 
-func (*RemoveTeamMemberNoContent) removeTeamMemberRes() {}
+// GetOp returns the value of Op.
+func (s *TraceSpan) GetOp() string {
+	return s.Op
+}
 
-// ResetPasswordNoContent is response for ResetPassword operation.
-type ResetPasswordNoContent struct{}
+// GetDescription returns the value of Description.
+func (s *TraceSpan) GetDescription() string {
+	return s.Description
+}
 
-func (*ResetPasswordNoContent) resetPasswordRes() {}
+// GetStatus returns the value of Status.
+func (s *TraceSpan) GetStatus() string {
+	return s.Status
+}
 
-// Ref: #/components/schemas/ResetPasswordRequest
-type ResetPasswordRequest struct {
-	Token       string `json:"token"`
-	NewPassword string `json:"new_password"`
+// GetTags returns the value of Tags.
+func (s *TraceSpan) GetTags() OptTraceSpanTags {
+	return s.Tags
 }
 
-// GetToken returns the value of Token.
-func (s *ResetPasswordRequest) GetToken() string {
-	return s.Token
+// GetStartTimestamp returns the value of StartTimestamp.
+func (s *TraceSpan) GetStartTimestamp() time.Time {
+	return s.StartTimestamp
 }
 
-// GetNewPassword returns the value of NewPassword.
-func (s *ResetPasswordRequest) GetNewPassword() string {
-	return s.NewPassword
+// GetTimestamp returns the value of Timestamp.
+func (s *TraceSpan) GetTimestamp() time.Time {
+	return s.Timestamp
 }
 
-// SetToken sets the value of Token.
-func (s *ResetPasswordRequest) SetToken(val string) {
-	s.Token = val
+// GetDurationMs returns the value of DurationMs.
+func (s *TraceSpan) GetDurationMs() float64 {
+	return s.DurationMs
 }
 
-// SetNewPassword sets the value of NewPassword.
-func (s *ResetPasswordRequest) SetNewPassword(val string) {
-	s.NewPassword = val
+// GetOffsetMs returns the value of OffsetMs.
+func (s *TraceSpan) GetOffsetMs() float64 {
+	return s.OffsetMs
 }
 
-// Send2FACodeNoContent is response for Send2FACode operation.
-type Send2FACodeNoContent struct{}
+// GetDepth returns the value of Depth.
+func (s *TraceSpan) GetDepth() uint {
+	return s.Depth
+}
 
-func (*Send2FACodeNoContent) send2FACodeRes() {}
+// SetSpanID sets the value of SpanID.
+func (s *TraceSpan) SetSpanID(val string) {
+	s.SpanID = val
+}
 
-// SendTestNotificationNoContent is response for SendTestNotification operation.
-type SendTestNotificationNoContent struct{}
+// SetParentSpanID sets the value of ParentSpanID.
+func (s *TraceSpan) SetParentSpanID(val OptString) {
+	s.ParentSpanID = val
+}
 
-func (*SendTestNotificationNoContent) sendTestNotificationRes() {}
+// SetTransactionID sets the value of TransactionID.
+func (s *TraceSpan) SetTransactionID(val string) {
+	s.TransactionID = val
+}
 
-// Ref: #/components/schemas/SetSuperuserStatusRequest
-type SetSuperuserStatusRequest struct {
-	IsSuperuser bool `json:"is_superuser"`
+// SetTransactionName sets the value of TransactionName.
+func (s *TraceSpan) SetTransactionName(val OptString) {
+	s.TransactionName = val
 }
 
-// GetIsSuperuser returns the value of IsSuperuser.
-func (s *SetSuperuserStatusRequest) GetIsSuperuser() bool {
-	return s.IsSuperuser
+// SetOp sets the value of Op.
+func (s *TraceSpan) SetOp(val string) {
+	s.Op = val
 }
 
-// SetIsSuperuser sets the value of IsSuperuser.
-func (s *SetSuperuserStatusRequest) SetIsSuperuser(val bool) {
-	s.IsSuperuser = val
+// SetDescription sets the value of Description.
+func (s *TraceSpan) SetDescription(val string) {
+	s.Description = val
 }
 
-// Ref: #/components/schemas/SetUserActiveStatusRequest
-type SetUserActiveStatusRequest struct {
-	IsActive bool `json:"is_active"`
+// SetStatus sets the value of Status.
+func (s *TraceSpan) SetStatus(val string) {
+	s.Status = val
 }
 
-// GetIsActive returns the value of IsActive.
-func (s *SetUserActiveStatusRequest) GetIsActive() bool {
-	return s.IsActive
+// SetTags sets the value of Tags.
+func (s *TraceSpan) SetTags(val OptTraceSpanTags) {
+	s.Tags = val
 }
 
-// SetIsActive sets the value of IsActive.
-func (s *SetUserActiveStatusRequest) SetIsActive(val bool) {
-	s.IsActive = val
+// SetStartTimestamp sets the value of StartTimestamp.
+func (s *TraceSpan) SetStartTimestamp(val time.Time) {
+	s.StartTimestamp = val
 }
 
-// Sort order (ascending or descending).
-// Ref: #/components/schemas/SortOrder
-type SortOrder string
+// SetTimestamp sets the value of Timestamp.
+func (s *TraceSpan) SetTimestamp(val time.Time) {
+	s.Timestamp = val
+}
 
-const (
-	SortOrderAsc  SortOrder = "asc"
-	SortOrderDesc SortOrder = "desc"
-)
+// SetDurationMs sets the value of DurationMs.
+func (s *TraceSpan) SetDurationMs(val float64) {
+	s.DurationMs = val
+}
 
-// AllValues returns all SortOrder values.
-func (SortOrder) AllValues() []SortOrder {
-	return []SortOrder{
-		SortOrderAsc,
-		SortOrderDesc,
-	}
+// SetOffsetMs sets the value of OffsetMs.
+func (s *TraceSpan) SetOffsetMs(val float64) {
+	s.OffsetMs = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s SortOrder) MarshalText() ([]byte, error) {
-	switch s {
-	case SortOrderAsc:
-		return []byte(s), nil
-	case SortOrderDesc:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetDepth sets the value of Depth.
+func (s *TraceSpan) SetDepth(val uint) {
+	s.Depth = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *SortOrder) UnmarshalText(data []byte) error {
-	switch SortOrder(data) {
-	case SortOrderAsc:
-		*s = SortOrderAsc
-		return nil
-	case SortOrderDesc:
-		*s = SortOrderDesc
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
+type TraceSpanTags map[string]string
+
+func (s *TraceSpanTags) init() TraceSpanTags {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
 	}
+	return m
 }
 
-// Ref: #/components/schemas/Team
-type Team struct {
-	ID        uint         `json:"id"`
-	Name      string       `json:"name"`
-	CreatedAt time.Time    `json:"created_at"`
-	Members   []TeamMember `json:"members"`
+// Ref: #/components/schemas/TraceTransaction
+type TraceTransaction struct {
+	EventID        string    `json:"event_id"`
+	Name           string    `json:"name"`
+	Op             string    `json:"op"`
+	Status         string    `json:"status"`
+	Platform       OptString `json:"platform"`
+	Environment    OptString `json:"environment"`
+	Release        OptString `json:"release"`
+	SpanID         string    `json:"span_id"`
+	ParentSpanID   OptString `json:"parent_span_id"`
+	StartTimestamp time.Time `json:"start_timestamp"`
+	Timestamp      time.Time `json:"timestamp"`
+	DurationMs     float64   `json:"duration_ms"`
 }
 
-// GetID returns the value of ID.
-func (s *Team) GetID() uint {
-	return s.ID
+// GetEventID returns the value of EventID.
+func (s *TraceTransaction) GetEventID() string {
+	return s.EventID
 }
 
 // GetName returns the value of Name.
-func (s *Team) GetName() string {
+func (s *TraceTransaction) GetName() string {
 	return s.Name
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Team) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetOp returns the value of Op.
+func (s *TraceTransaction) GetOp() string {
+	return s.Op
 }
 
-// GetMembers returns the value of Members.
-func (s *Team) GetMembers() []TeamMember {
-	return s.Members
+// GetStatus returns the value of Status.
+func (s *TraceTransaction) GetStatus() string {
+	return s.Status
 }
 
-// SetID sets the value of ID.
-func (s *Team) SetID(val uint) {
-	s.ID = val
+// GetPlatform returns the value of Platform.
+func (s *TraceTransaction) GetPlatform() OptString {
+	return s.Platform
 }
 
-// SetName sets the value of Name.
-func (s *Team) SetName(val string) {
-	s.Name = val
+// GetEnvironment returns the value of Environment.
+func (s *TraceTransaction) GetEnvironment() OptString {
+	return s.Environment
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Team) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// GetRelease returns the value of Release.
+func (s *TraceTransaction) GetRelease() OptString {
+	return s.Release
 }
 
-// SetMembers sets the value of Members.
-func (s *Team) SetMembers(val []TeamMember) {
-	s.Members = val
+// GetSpanID returns the value of SpanID.
+func (s *TraceTransaction) GetSpanID() string {
+	return s.SpanID
 }
 
-func (*Team) getTeamRes() {}
-
-// Ref: #/components/schemas/TeamExistsResponse
-type TeamExistsResponse struct {
-	Exists bool `json:"exists"`
+// GetParentSpanID returns the value of ParentSpanID.
+func (s *TraceTransaction) GetParentSpanID() OptString {
+	return s.ParentSpanID
 }
 
-// GetExists returns the value of Exists.
-func (s *TeamExistsResponse) GetExists() bool {
-	return s.Exists
+// GetStartTimestamp returns the value of StartTimestamp.
+func (s *TraceTransaction) GetStartTimestamp() time.Time {
+	return s.StartTimestamp
 }
 
-// SetExists sets the value of Exists.
-func (s *TeamExistsResponse) SetExists(val bool) {
-	s.Exists = val
+// GetTimestamp returns the value of Timestamp.
+func (s *TraceTransaction) GetTimestamp() time.Time {
+	return s.Timestamp
 }
 
-func (*TeamExistsResponse) checkTeamExistsRes() {}
+// GetDurationMs returns the value of DurationMs.
+func (s *TraceTransaction) GetDurationMs() float64 {
+	return s.DurationMs
+}
 
-// Ref: #/components/schemas/TeamMember
-type TeamMember struct {
-	UserID uint           `json:"user_id"`
-	Role   TeamMemberRole `json:"role"`
+// SetEventID sets the value of EventID.
+func (s *TraceTransaction) SetEventID(val string) {
+	s.EventID = val
+}
+
+// SetName sets the value of Name.
+func (s *TraceTransaction) SetName(val string) {
+	s.Name = val
 }
 
-// GetUserID returns the value of UserID.
-func (s *TeamMember) GetUserID() uint {
-	return s.UserID
+// SetOp sets the value of Op.
+func (s *TraceTransaction) SetOp(val string) {
+	s.Op = val
 }
 
-// GetRole returns the value of Role.
-func (s *TeamMember) GetRole() TeamMemberRole {
-	return s.Role
+// SetStatus sets the value of Status.
+func (s *TraceTransaction) SetStatus(val string) {
+	s.Status = val
 }
 
-// SetUserID sets the value of UserID.
-func (s *TeamMember) SetUserID(val uint) {
-	s.UserID = val
+// SetPlatform sets the value of Platform.
+func (s *TraceTransaction) SetPlatform(val OptString) {
+	s.Platform = val
 }
 
-// SetRole sets the value of Role.
-func (s *TeamMember) SetRole(val TeamMemberRole) {
-	s.Role = val
+// SetEnvironment sets the value of Environment.
+func (s *TraceTransaction) SetEnvironment(val OptString) {
+	s.Environment = val
 }
 
-type TeamMemberRole string
+// SetRelease sets the value of Release.
+func (s *TraceTransaction) SetRelease(val OptString) {
+	s.Release = val
+}
 
-const (
-	TeamMemberRoleOwner  TeamMemberRole = "owner"
-	TeamMemberRoleAdmin  TeamMemberRole = "admin"
-	TeamMemberRoleMember TeamMemberRole = "member"
-)
+// SetSpanID sets the value of SpanID.
+func (s *TraceTransaction) SetSpanID(val string) {
+	s.SpanID = val
+}
 
-// AllValues returns all TeamMemberRole values.
-func (TeamMemberRole) AllValues() []TeamMemberRole {
-	return []TeamMemberRole{
-		TeamMemberRoleOwner,
-		TeamMemberRoleAdmin,
-		TeamMemberRoleMember,
-	}
+// SetParentSpanID sets the value of ParentSpanID.
+func (s *TraceTransaction) SetParentSpanID(val OptString) {
+	s.ParentSpanID = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s TeamMemberRole) MarshalText() ([]byte, error) {
-	switch s {
-	case TeamMemberRoleOwner:
-		return []byte(s), nil
-	case TeamMemberRoleAdmin:
-		return []byte(s), nil
-	case TeamMemberRoleMember:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetStartTimestamp sets the value of StartTimestamp.
+func (s *TraceTransaction) SetStartTimestamp(val time.Time) {
+	s.StartTimestamp = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *TeamMemberRole) UnmarshalText(data []byte) error {
-	switch TeamMemberRole(data) {
-	case TeamMemberRoleOwner:
-		*s = TeamMemberRoleOwner
-		return nil
-	case TeamMemberRoleAdmin:
-		*s = TeamMemberRoleAdmin
-		return nil
-	case TeamMemberRoleMember:
-		*s = TeamMemberRoleMember
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetTimestamp sets the value of Timestamp.
+func (s *TraceTransaction) SetTimestamp(val time.Time) {
+	s.Timestamp = val
 }
 
-// Ref: #/components/schemas/TeamResponse
-type TeamResponse struct {
-	Team Team `json:"team"`
+// SetDurationMs sets the value of DurationMs.
+func (s *TraceTransaction) SetDurationMs(val float64) {
+	s.DurationMs = val
 }
 
-// GetTeam returns the value of Team.
-func (s *TeamResponse) GetTeam() Team {
-	return s.Team
+// Ref: #/components/schemas/TransactionSummary
+type TransactionSummary struct {
+	Name  string `json:"name"`
+	Count uint   `json:"count"`
+	// Transactions per minute.
+	Throughput float64 `json:"throughput"`
+	// Share of failed transactions, from 0 to 1.
+	FailureRate float64 `json:"failure_rate"`
+	P50Ms       float64 `json:"p50_ms"`
+	P95Ms       float64 `json:"p95_ms"`
+	P99Ms       float64 `json:"p99_ms"`
 }
 
-// SetTeam sets the value of Team.
-func (s *TeamResponse) SetTeam(val Team) {
-	s.Team = val
+// GetName returns the value of Name.
+func (s *TransactionSummary) GetName() string {
+	return s.Name
 }
 
-func (*TeamResponse) getProjectTeamRes() {}
+// GetCount returns the value of Count.
+func (s *TransactionSummary) GetCount() uint {
+	return s.Count
+}
 
-// Ref: #/components/schemas/TimeseriesData
-type TimeseriesData struct {
-	Period      Period `json:"period"`
-	Name        string `json:"name"`
-	Occurrences []uint `json:"occurrences"`
+// GetThroughput returns the value of Throughput.
+func (s *TransactionSummary) GetThroughput() float64 {
+	return s.Throughput
 }
 
-// GetPeriod returns the value of Period.
-func (s *TimeseriesData) GetPeriod() Period {
-	return s.Period
+// GetFailureRate returns the value of FailureRate.
+func (s *TransactionSummary) GetFailureRate() float64 {
+	return s.FailureRate
 }
 
-// GetName returns the value of Name.
-func (s *TimeseriesData) GetName() string {
-	return s.Name
+// GetP50Ms returns the value of P50Ms.
+func (s *TransactionSummary) GetP50Ms() float64 {
+	return s.P50Ms
 }
 
-// GetOccurrences returns the value of Occurrences.
-func (s *TimeseriesData) GetOccurrences() []uint {
-	return s.Occurrences
+// GetP95Ms returns the value of P95Ms.
+func (s *TransactionSummary) GetP95Ms() float64 {
+	return s.P95Ms
 }
 
-// SetPeriod sets the value of Period.
-func (s *TimeseriesData) SetPeriod(val Period) {
-	s.Period = val
+// GetP99Ms returns the value of P99Ms.
+func (s *TransactionSummary) GetP99Ms() float64 {
+	return s.P99Ms
 }
 
 // SetName sets the value of Name.
-func (s *TimeseriesData) SetName(val string) {
+func (s *TransactionSummary) SetName(val string) {
 	s.Name = val
 }
 
-// SetOccurrences sets the value of Occurrences.
-func (s *TimeseriesData) SetOccurrences(val []uint) {
-	s.Occurrences = val
+// SetCount sets the value of Count.
+func (s *TransactionSummary) SetCount(val uint) {
+	s.Count = val
 }
 
-type TimeseriesResponse []TimeseriesData
+// SetThroughput sets the value of Throughput.
+func (s *TransactionSummary) SetThroughput(val float64) {
+	s.Throughput = val
+}
 
-func (*TimeseriesResponse) getEventsTimeseriesRes()               {}
-func (*TimeseriesResponse) getIssuesTimeseriesRes()               {}
-func (*TimeseriesResponse) getProjectIssueEventsTimeseriesRes()   {}
-func (*TimeseriesResponse) getProjectIssueTimeseriesRes()         {}
-func (*TimeseriesResponse) getProjectReleaseErrorsTimeseriesRes() {}
+// SetFailureRate sets the value of FailureRate.
+func (s *TransactionSummary) SetFailureRate(val float64) {
+	s.FailureRate = val
+}
+
+// SetP50Ms sets the value of P50Ms.
+func (s *TransactionSummary) SetP50Ms(val float64) {
+	s.P50Ms = val
+}
+
+// SetP95Ms sets the value of P95Ms.
+func (s *TransactionSummary) SetP95Ms(val float64) {
+	s.P95Ms = val
+}
+
+// SetP99Ms sets the value of P99Ms.
+func (s *TransactionSummary) SetP99Ms(val float64) {
+	s.P99Ms = val
+}
 
 // Ref: #/components/schemas/TwoFAConfirmRequest
 type TwoFAConfirmRequest struct {
@@ -4978,6 +9851,38 @@ func (s *TwoFAVerifyResponse) SetExpiresIn(val int) {
 
 func (*TwoFAVerifyResponse) verify2FARes() {}
 
+// Ref: #/components/schemas/UnmergeIssueRequest
+type UnmergeIssueRequest struct {
+	Fingerprints []string `json:"fingerprints"`
+}
+
+// GetFingerprints returns the value of Fingerprints.
+func (s *UnmergeIssueRequest) GetFingerprints() []string {
+	return s.Fingerprints
+}
+
+// SetFingerprints sets the value of Fingerprints.
+func (s *UnmergeIssueRequest) SetFingerprints(val []string) {
+	s.Fingerprints = val
+}
+
+// Ref: #/components/schemas/UnmergeIssueResponse
+type UnmergeIssueResponse struct {
+	Issues []Issue `json:"issues"`
+}
+
+// GetIssues returns the value of Issues.
+func (s *UnmergeIssueResponse) GetIssues() []Issue {
+	return s.Issues
+}
+
+// SetIssues sets the value of Issues.
+func (s *UnmergeIssueResponse) SetIssues(val []Issue) {
+	s.Issues = val
+}
+
+func (*UnmergeIssueResponse) unmergeIssueRes() {}
+
 // Ref: #/components/schemas/UnreadCountResponse
 type UnreadCountResponse struct {
 	Count uint `json:"count"`
@@ -4995,6 +9900,197 @@ func (s *UnreadCountResponse) SetCount(val uint) {
 
 func (*UnreadCountResponse) getUnreadNotificationsCountRes() {}
 
+// Ref: #/components/schemas/UpdateDataScrubbingRequest
+type UpdateDataScrubbingRequest struct {
+	// Removes values of well-known sensitive keys and credit card numbers.
+	DefaultScrubbers bool `json:"default_scrubbers"`
+	// Additional field names, values of keys containing them are removed.
+	SensitiveFields []string `json:"sensitive_fields"`
+	// Regular expressions, matching parts of string values are removed.
+	Patterns []string `json:"patterns"`
+	// Zeroes the last octet of IPv4 and the last 80 bits of IPv6 client addresses.
+	AnonymizeIP bool `json:"anonymize_ip"`
+	// Never stores the request cookies.
+	DropRequestCookies bool `json:"drop_request_cookies"`
+	// Never stores the request body.
+	DropRequestData bool `json:"drop_request_data"`
+}
+
+// GetDefaultScrubbers returns the value of DefaultScrubbers.
+func (s *UpdateDataScrubbingRequest) GetDefaultScrubbers() bool {
+	return s.DefaultScrubbers
+}
+
+// GetSensitiveFields returns the value of SensitiveFields.
+func (s *UpdateDataScrubbingRequest) GetSensitiveFields() []string {
+	return s.SensitiveFields
+}
+
+// GetPatterns returns the value of Patterns.
+func (s *UpdateDataScrubbingRequest) GetPatterns() []string {
+	return s.Patterns
+}
+
+// GetAnonymizeIP returns the value of AnonymizeIP.
+func (s *UpdateDataScrubbingRequest) GetAnonymizeIP() bool {
+	return s.AnonymizeIP
+}
+
+// GetDropRequestCookies returns the value of DropRequestCookies.
+func (s *UpdateDataScrubbingRequest) GetDropRequestCookies() bool {
+	return s.DropRequestCookies
+}
+
+// GetDropRequestData returns the value of DropRequestData.
+func (s *UpdateDataScrubbingRequest) GetDropRequestData() bool {
+	return s.DropRequestData
+}
+
+// SetDefaultScrubbers sets the value of DefaultScrubbers.
+func (s *UpdateDataScrubbingRequest) SetDefaultScrubbers(val bool) {
+	s.DefaultScrubbers = val
+}
+
+// SetSensitiveFields sets the value of SensitiveFields.
+func (s *UpdateDataScrubbingRequest) SetSensitiveFields(val []string) {
+	s.SensitiveFields = val
+}
+
+// SetPatterns sets the value of Patterns.
+func (s *UpdateDataScrubbingRequest) SetPatterns(val []string) {
+	s.Patterns = val
+}
+
+// SetAnonymizeIP sets the value of AnonymizeIP.
+func (s *UpdateDataScrubbingRequest) SetAnonymizeIP(val bool) {
+	s.AnonymizeIP = val
+}
+
+// SetDropRequestCookies sets the value of DropRequestCookies.
+func (s *UpdateDataScrubbingRequest) SetDropRequestCookies(val bool) {
+	s.DropRequestCookies = val
+}
+
+// SetDropRequestData sets the value of DropRequestData.
+func (s *UpdateDataScrubbingRequest) SetDropRequestData(val bool) {
+	s.DropRequestData = val
+}
+
+// Ref: #/components/schemas/UpdateEnvironmentRequest
+type UpdateEnvironmentRequest struct {
+	Hidden bool `json:"hidden"`
+}
+
+// GetHidden returns the value of Hidden.
+func (s *UpdateEnvironmentRequest) GetHidden() bool {
+	return s.Hidden
+}
+
+// SetHidden sets the value of Hidden.
+func (s *UpdateEnvironmentRequest) SetHidden(val bool) {
+	s.Hidden = val
+}
+
+// Ref: #/components/schemas/UpdateGroupingConfigRequest
+type UpdateGroupingConfigRequest struct {
+	Strategy GroupingStrategy `json:"strategy"`
+}
+
+// GetStrategy returns the value of Strategy.
+func (s *UpdateGroupingConfigRequest) GetStrategy() GroupingStrategy {
+	return s.Strategy
+}
+
+// SetStrategy sets the value of Strategy.
+func (s *UpdateGroupingConfigRequest) SetStrategy(val GroupingStrategy) {
+	s.Strategy = val
+}
+
+// Ref: #/components/schemas/UpdateInboundFiltersRequest
+type UpdateInboundFiltersRequest struct {
+	// Origins browser events are accepted from, e.g. "https://*.example.com". Empty allows all.
+	AllowedOrigins       []string `json:"allowed_origins"`
+	FilterWebCrawlers    bool     `json:"filter_web_crawlers"`
+	FilterLegacyBrowsers bool     `json:"filter_legacy_browsers"`
+	FilterLocalhost      bool     `json:"filter_localhost"`
+	// IP addresses and CIDR ranges of the clients to drop data from.
+	BlockedIPRanges []string `json:"blocked_ip_ranges"`
+	// Glob patterns of the error messages to drop.
+	ErrorMessages []string `json:"error_messages"`
+	// Glob patterns of the releases to drop.
+	Releases []string `json:"releases"`
+}
+
+// GetAllowedOrigins returns the value of AllowedOrigins.
+func (s *UpdateInboundFiltersRequest) GetAllowedOrigins() []string {
+	return s.AllowedOrigins
+}
+
+// GetFilterWebCrawlers returns the value of FilterWebCrawlers.
+func (s *UpdateInboundFiltersRequest) GetFilterWebCrawlers() bool {
+	return s.FilterWebCrawlers
+}
+
+// GetFilterLegacyBrowsers returns the value of FilterLegacyBrowsers.
+func (s *UpdateInboundFiltersRequest) GetFilterLegacyBrowsers() bool {
+	return s.FilterLegacyBrowsers
+}
+
+// GetFilterLocalhost returns the value of FilterLocalhost.
+func (s *UpdateInboundFiltersRequest) GetFilterLocalhost() bool {
+	return s.FilterLocalhost
+}
+
+// GetBlockedIPRanges returns the value of BlockedIPRanges.
+func (s *UpdateInboundFiltersRequest) GetBlockedIPRanges() []string {
+	return s.BlockedIPRanges
+}
+
+// GetErrorMessages returns the value of ErrorMessages.
+func (s *UpdateInboundFiltersRequest) GetErrorMessages() []string {
+	return s.ErrorMessages
+}
+
+// GetReleases returns the value of Releases.
+func (s *UpdateInboundFiltersRequest) GetReleases() []string {
+	return s.Releases
+}
+
+// SetAllowedOrigins sets the value of AllowedOrigins.
+func (s *UpdateInboundFiltersRequest) SetAllowedOrigins(val []string) {
+	s.AllowedOrigins = val
+}
+
+// SetFilterWebCrawlers sets the value of FilterWebCrawlers.
+func (s *UpdateInboundFiltersRequest) SetFilterWebCrawlers(val bool) {
+	s.FilterWebCrawlers = val
+}
+
+// SetFilterLegacyBrowsers sets the value of FilterLegacyBrowsers.
+func (s *UpdateInboundFiltersRequest) SetFilterLegacyBrowsers(val bool) {
+	s.FilterLegacyBrowsers = val
+}
+
+// SetFilterLocalhost sets the value of FilterLocalhost.
+func (s *UpdateInboundFiltersRequest) SetFilterLocalhost(val bool) {
+	s.FilterLocalhost = val
+}
+
+// SetBlockedIPRanges sets the value of BlockedIPRanges.
+func (s *UpdateInboundFiltersRequest) SetBlockedIPRanges(val []string) {
+	s.BlockedIPRanges = val
+}
+
+// SetErrorMessages sets the value of ErrorMessages.
+func (s *UpdateInboundFiltersRequest) SetErrorMessages(val []string) {
+	s.ErrorMessages = val
+}
+
+// SetReleases sets the value of Releases.
+func (s *UpdateInboundFiltersRequest) SetReleases(val []string) {
+	s.Releases = val
+}
+
 // Ref: #/components/schemas/UpdateNotificationRuleRequest
 type UpdateNotificationRuleRequest struct {
 	// Level of event to trigger notification (error, warning, info, etc.).
@@ -5005,6 +10101,8 @@ type UpdateNotificationRuleRequest struct {
 	IsNewError OptNilBool `json:"is_new_error"`
 	// Trigger only for regressions (resolved -> unresolved).
 	IsRegression OptNilBool `json:"is_regression"`
+	// Environment of events to trigger notification, all environments when not set.
+	Environment OptNilString `json:"environment"`
 }
 
 // GetEventLevel returns the value of EventLevel.
@@ -5027,6 +10125,11 @@ func (s *UpdateNotificationRuleRequest) GetIsRegression() OptNilBool {
 	return s.IsRegression
 }
 
+// GetEnvironment returns the value of Environment.
+func (s *UpdateNotificationRuleRequest) GetEnvironment() OptNilString {
+	return s.Environment
+}
+
 // SetEventLevel sets the value of EventLevel.
 func (s *UpdateNotificationRuleRequest) SetEventLevel(val OptNilString) {
 	s.EventLevel = val
@@ -5047,13 +10150,19 @@ func (s *UpdateNotificationRuleRequest) SetIsRegression(val OptNilBool) {
 	s.IsRegression = val
 }
 
+// SetEnvironment sets the value of Environment.
+func (s *UpdateNotificationRuleRequest) SetEnvironment(val OptNilString) {
+	s.Environment = val
+}
+
 // Ref: #/components/schemas/UpdateNotificationSettingRequest
 type UpdateNotificationSettingRequest struct {
 	// Type of notification channel (email, mattermost, slack, etc.).
 	Type OptString `json:"type"`
 	// Configuration for the notification channel (JSONB in database).
-	Config  OptString `json:"config"`
-	Enabled OptBool   `json:"enabled"`
+	Config          OptString `json:"config"`
+	Enabled         OptBool   `json:"enabled"`
+	RouteToAssignee OptBool   `json:"route_to_assignee"`
 }
 
 // GetType returns the value of Type.
@@ -5071,6 +10180,11 @@ func (s *UpdateNotificationSettingRequest) GetEnabled() OptBool {
 	return s.Enabled
 }
 
+// GetRouteToAssignee returns the value of RouteToAssignee.
+func (s *UpdateNotificationSettingRequest) GetRouteToAssignee() OptBool {
+	return s.RouteToAssignee
+}
+
 // SetType sets the value of Type.
 func (s *UpdateNotificationSettingRequest) SetType(val OptString) {
 	s.Type = val
@@ -5086,6 +10200,92 @@ func (s *UpdateNotificationSettingRequest) SetEnabled(val OptBool) {
 	s.Enabled = val
 }
 
+// SetRouteToAssignee sets the value of RouteToAssignee.
+func (s *UpdateNotificationSettingRequest) SetRouteToAssignee(val OptBool) {
+	s.RouteToAssignee = val
+}
+
+// UpdateProjectEnvironmentNoContent is response for UpdateProjectEnvironment operation.
+type UpdateProjectEnvironmentNoContent struct{}
+
+func (*UpdateProjectEnvironmentNoContent) updateProjectEnvironmentRes() {}
+
+// Ref: #/components/schemas/UpdateProjectKeyRequest
+type UpdateProjectKeyRequest struct {
+	Label    string `json:"label"`
+	IsActive bool   `json:"is_active"`
+	// Events per minute, unlimited when not set.
+	RateLimit          OptNilUint            `json:"rate_limit"`
+	CategoryRateLimits OptCategoryRateLimits `json:"category_rate_limits"`
+}
+
+// GetLabel returns the value of Label.
+func (s *UpdateProjectKeyRequest) GetLabel() string {
+	return s.Label
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *UpdateProjectKeyRequest) GetIsActive() bool {
+	return s.IsActive
+}
+
+// GetRateLimit returns the value of RateLimit.
+func (s *UpdateProjectKeyRequest) GetRateLimit() OptNilUint {
+	return s.RateLimit
+}
+
+// GetCategoryRateLimits returns the value of CategoryRateLimits.
+func (s *UpdateProjectKeyRequest) GetCategoryRateLimits() OptCategoryRateLimits {
+	return s.CategoryRateLimits
+}
+
+// SetLabel sets the value of Label.
+func (s *UpdateProjectKeyRequest) SetLabel(val string) {
+	s.Label = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *UpdateProjectKeyRequest) SetIsActive(val bool) {
+	s.IsActive = val
+}
+
+// SetRateLimit sets the value of RateLimit.
+func (s *UpdateProjectKeyRequest) SetRateLimit(val OptNilUint) {
+	s.RateLimit = val
+}
+
+// SetCategoryRateLimits sets the value of CategoryRateLimits.
+func (s *UpdateProjectKeyRequest) SetCategoryRateLimits(val OptCategoryRateLimits) {
+	s.CategoryRateLimits = val
+}
+
+// Ref: #/components/schemas/UpdateProjectRateLimitsRequest
+type UpdateProjectRateLimitsRequest struct {
+	// Events per minute, unlimited when not set.
+	RateLimit          OptNilUint            `json:"rate_limit"`
+	CategoryRateLimits OptCategoryRateLimits `json:"category_rate_limits"`
+}
+
+// GetRateLimit returns the value of RateLimit.
+func (s *UpdateProjectRateLimitsRequest) GetRateLimit() OptNilUint {
+	return s.RateLimit
+}
+
+// GetCategoryRateLimits returns the value of CategoryRateLimits.
+func (s *UpdateProjectRateLimitsRequest) GetCategoryRateLimits() OptCategoryRateLimits {
+	return s.CategoryRateLimits
+}
+
+// SetRateLimit sets the value of RateLimit.
+func (s *UpdateProjectRateLimitsRequest) SetRateLimit(val OptNilUint) {
+	s.RateLimit = val
+}
+
+// SetCategoryRateLimits sets the value of CategoryRateLimits.
+func (s *UpdateProjectRateLimitsRequest) SetCategoryRateLimits(val OptCategoryRateLimits) {
+	s.CategoryRateLimits = val
+}
+
 // Ref: #/components/schemas/UpdateProjectRequest
 type UpdateProjectRequest struct {
 	Name        string `json:"name"`
@@ -5345,6 +10545,9 @@ const (
 	UserNotificationTypeTeamRemoved     UserNotificationType = "team_removed"
 	UserNotificationTypeRoleChanged     UserNotificationType = "role_changed"
 	UserNotificationTypeIssueRegression UserNotificationType = "issue_regression"
+	UserNotificationTypeSpikeProtection UserNotificationType = "spike_protection"
+	UserNotificationTypeIssueAssigned   UserNotificationType = "issue_assigned"
+	UserNotificationTypeIssueMention    UserNotificationType = "issue_mention"
 )
 
 // AllValues returns all UserNotificationType values.
@@ -5354,6 +10557,9 @@ func (UserNotificationType) AllValues() []UserNotificationType {
 		UserNotificationTypeTeamRemoved,
 		UserNotificationTypeRoleChanged,
 		UserNotificationTypeIssueRegression,
+		UserNotificationTypeSpikeProtection,
+		UserNotificationTypeIssueAssigned,
+		UserNotificationTypeIssueMention,
 	}
 }
 
@@ -5368,6 +10574,12 @@ func (s UserNotificationType) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case UserNotificationTypeIssueRegression:
 		return []byte(s), nil
+	case UserNotificationTypeSpikeProtection:
+		return []byte(s), nil
+	case UserNotificationTypeIssueAssigned:
+		return []byte(s), nil
+	case UserNotificationTypeIssueMention:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -5388,6 +10600,15 @@ func (s *UserNotificationType) UnmarshalText(data []byte) error {
 	case UserNotificationTypeIssueRegression:
 		*s = UserNotificationTypeIssueRegression
 		return nil
+	case UserNotificationTypeSpikeProtection:
+		*s = UserNotificationTypeSpikeProtection
+		return nil
+	case UserNotificationTypeIssueAssigned:
+		*s = UserNotificationTypeIssueAssigned
+		return nil
+	case UserNotificationTypeIssueMention:
+		*s = UserNotificationTypeIssueMention
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
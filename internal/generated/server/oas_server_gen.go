@@ -26,6 +26,22 @@ type Handler interface {
 	//
 	// DELETE /api/v1/projects/{project_id}
 	ArchiveProject(ctx context.Context, params ArchiveProjectParams) (ArchiveProjectRes, error)
+	// AssignIssue implements AssignIssue operation.
+	//
+	// Assign issue to a user or a team.
+	//
+	// PUT /api/v1/projects/{project_id}/issues/{issue_id}/assignee
+	AssignIssue(ctx context.Context, req *IssueAssigneeRequest, params AssignIssueParams) (AssignIssueRes, error)
+	// BulkUpdateIssues implements BulkUpdateIssues operation.
+	//
+	// Resolves, ignores, unresolves, assigns, merges or deletes the issues given by IDs or matching a
+	// filter
+	// in one transaction. Issues the user can't manage, missing issues and issues already in the
+	// requested
+	// state are reported and left as they are.
+	//
+	// POST /api/v1/projects/{project_id}/issues/bulk
+	BulkUpdateIssues(ctx context.Context, req *IssueBulkRequest, params BulkUpdateIssuesParams) (BulkUpdateIssuesRes, error)
 	// ChangeIssueStatus implements changeIssueStatus operation.
 	//
 	// Change issue status.
@@ -56,6 +72,12 @@ type Handler interface {
 	//
 	// POST /api/v1/users/me/2fa/confirm
 	Confirm2FA(ctx context.Context, req *TwoFAConfirmRequest) (Confirm2FARes, error)
+	// CreateGroupingRule implements CreateGroupingRule operation.
+	//
+	// Applies to new events only, existing issues keep their fingerprints.
+	//
+	// POST /api/v1/projects/{project_id}/grouping-rules
+	CreateGroupingRule(ctx context.Context, req *GroupingRuleRequest, params CreateGroupingRuleParams) (CreateGroupingRuleRes, error)
 	// CreateNotificationRule implements CreateNotificationRule operation.
 	//
 	// Create a new notification rule.
@@ -68,6 +90,18 @@ type Handler interface {
 	//
 	// POST /api/v1/projects/{project_id}/notification-settings
 	CreateNotificationSetting(ctx context.Context, req *CreateNotificationSettingRequest, params CreateNotificationSettingParams) (CreateNotificationSettingRes, error)
+	// CreateProjectIssueComment implements CreateProjectIssueComment operation.
+	//
+	// Users with access to the project mentioned with @username are notified.
+	//
+	// POST /api/v1/projects/{project_id}/issues/{issue_id}/comments
+	CreateProjectIssueComment(ctx context.Context, req *CreateIssueCommentRequest, params CreateProjectIssueCommentParams) (CreateProjectIssueCommentRes, error)
+	// CreateProjectKey implements CreateProjectKey operation.
+	//
+	// Create a project client key.
+	//
+	// POST /api/v1/projects/{project_id}/keys
+	CreateProjectKey(ctx context.Context, req *ProjectKeyRequest, params CreateProjectKeyParams) (CreateProjectKeyRes, error)
 	// CreateTeam implements CreateTeam operation.
 	//
 	// Create a new team.
@@ -80,6 +114,18 @@ type Handler interface {
 	//
 	// POST /api/v1/users
 	CreateUser(ctx context.Context, req *CreateUserRequest) (CreateUserRes, error)
+	// DeleteGroupingRule implements DeleteGroupingRule operation.
+	//
+	// Delete a grouping rule.
+	//
+	// DELETE /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+	DeleteGroupingRule(ctx context.Context, params DeleteGroupingRuleParams) (DeleteGroupingRuleRes, error)
+	// DeleteMonitor implements DeleteMonitor operation.
+	//
+	// Delete cron monitor.
+	//
+	// DELETE /api/v1/projects/{project_id}/monitors/{monitor_id}
+	DeleteMonitor(ctx context.Context, params DeleteMonitorParams) (DeleteMonitorRes, error)
 	// DeleteNotificationRule implements DeleteNotificationRule operation.
 	//
 	// Delete a notification rule.
@@ -92,6 +138,12 @@ type Handler interface {
 	//
 	// DELETE /api/v1/projects/{project_id}/notification-settings/{setting_id}
 	DeleteNotificationSetting(ctx context.Context, params DeleteNotificationSettingParams) (DeleteNotificationSettingRes, error)
+	// DeleteProjectIssueComment implements DeleteProjectIssueComment operation.
+	//
+	// Delete issue comment.
+	//
+	// DELETE /api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}
+	DeleteProjectIssueComment(ctx context.Context, params DeleteProjectIssueCommentParams) (DeleteProjectIssueCommentRes, error)
 	// DeleteTeam implements DeleteTeam operation.
 	//
 	// Delete a team.
@@ -110,6 +162,12 @@ type Handler interface {
 	//
 	// POST /api/v1/users/me/2fa/disable
 	Disable2FA(ctx context.Context, req *TwoFADisableRequest) (Disable2FARes, error)
+	// DownloadAttachment implements DownloadAttachment operation.
+	//
+	// Download attachment.
+	//
+	// GET /api/v1/projects/{project_id}/attachments/{attachment_id}/download
+	DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (DownloadAttachmentRes, error)
 	// ForgotPassword implements ForgotPassword operation.
 	//
 	// Request a password reset.
@@ -140,6 +198,12 @@ type Handler interface {
 	//
 	// GET /api/v1/issues/timeseries
 	GetIssuesTimeseries(ctx context.Context, params GetIssuesTimeseriesParams) (GetIssuesTimeseriesRes, error)
+	// GetMonitor implements GetMonitor operation.
+	//
+	// Get cron monitor with recent check-ins.
+	//
+	// GET /api/v1/projects/{project_id}/monitors/{monitor_id}
+	GetMonitor(ctx context.Context, params GetMonitorParams) (GetMonitorRes, error)
 	// GetNotificationRule implements GetNotificationRule operation.
 	//
 	// Get a specific notification rule.
@@ -158,18 +222,72 @@ type Handler interface {
 	//
 	// GET /api/v1/projects/{project_id}
 	GetProject(ctx context.Context, params GetProjectParams) (GetProjectRes, error)
+	// GetProjectDataScrubbing implements GetProjectDataScrubbing operation.
+	//
+	// Get project data scrubbing.
+	//
+	// GET /api/v1/projects/{project_id}/data-scrubbing
+	GetProjectDataScrubbing(ctx context.Context, params GetProjectDataScrubbingParams) (GetProjectDataScrubbingRes, error)
+	// GetProjectEvent implements GetProjectEvent operation.
+	//
+	// Get event.
+	//
+	// GET /api/v1/projects/{project_id}/events/{event_id}
+	GetProjectEvent(ctx context.Context, params GetProjectEventParams) (GetProjectEventRes, error)
+	// GetProjectGroupingConfig implements GetProjectGroupingConfig operation.
+	//
+	// Get project grouping configuration.
+	//
+	// GET /api/v1/projects/{project_id}/grouping-config
+	GetProjectGroupingConfig(ctx context.Context, params GetProjectGroupingConfigParams) (GetProjectGroupingConfigRes, error)
+	// GetProjectInboundFilters implements GetProjectInboundFilters operation.
+	//
+	// Get project inbound filters.
+	//
+	// GET /api/v1/projects/{project_id}/inbound-filters
+	GetProjectInboundFilters(ctx context.Context, params GetProjectInboundFiltersParams) (GetProjectInboundFiltersRes, error)
 	// GetProjectIssueEventsTimeseries implements GetProjectIssueEventsTimeseries operation.
 	//
 	// Get timeseries of events for a specific issue inside a project.
 	//
 	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/timeseries
 	GetProjectIssueEventsTimeseries(ctx context.Context, params GetProjectIssueEventsTimeseriesParams) (GetProjectIssueEventsTimeseriesRes, error)
+	// GetProjectIssueLatestEvent implements GetProjectIssueLatestEvent operation.
+	//
+	// Get the latest issue event.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/latest
+	GetProjectIssueLatestEvent(ctx context.Context, params GetProjectIssueLatestEventParams) (GetProjectIssueLatestEventRes, error)
+	// GetProjectIssueOldestEvent implements GetProjectIssueOldestEvent operation.
+	//
+	// Get the oldest issue event.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/oldest
+	GetProjectIssueOldestEvent(ctx context.Context, params GetProjectIssueOldestEventParams) (GetProjectIssueOldestEventRes, error)
+	// GetProjectIssueTags implements GetProjectIssueTags operation.
+	//
+	// Get tags of issue events.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags
+	GetProjectIssueTags(ctx context.Context, params GetProjectIssueTagsParams) (GetProjectIssueTagsRes, error)
 	// GetProjectIssueTimeseries implements GetProjectIssueTimeseries operation.
 	//
 	// Get timeseries for a specific issue inside a project.
 	//
 	// GET /api/v1/projects/{project_id}/issues/{issue_id}/timeseries
 	GetProjectIssueTimeseries(ctx context.Context, params GetProjectIssueTimeseriesParams) (GetProjectIssueTimeseriesRes, error)
+	// GetProjectOutcomes implements GetProjectOutcomes operation.
+	//
+	// Outcomes are stored hourly, a granularity finer than 1h is rounded up.
+	//
+	// GET /api/v1/projects/{project_id}/outcomes
+	GetProjectOutcomes(ctx context.Context, params GetProjectOutcomesParams) (GetProjectOutcomesRes, error)
+	// GetProjectRateLimits implements GetProjectRateLimits operation.
+	//
+	// Get project rate limits.
+	//
+	// GET /api/v1/projects/{project_id}/rate-limits
+	GetProjectRateLimits(ctx context.Context, params GetProjectRateLimitsParams) (GetProjectRateLimitsRes, error)
 	// GetProjectReleaseAnalyticsDetails implements GetProjectReleaseAnalyticsDetails operation.
 	//
 	// Get analytics details for a specific release.
@@ -218,6 +336,12 @@ type Handler interface {
 	//
 	// GET /api/v1/teams/{team_id}
 	GetTeam(ctx context.Context, params GetTeamParams) (GetTeamRes, error)
+	// GetTrace implements GetTrace operation.
+	//
+	// Get trace.
+	//
+	// GET /api/v1/projects/{project_id}/traces/{trace_id}
+	GetTrace(ctx context.Context, params GetTraceParams) (GetTraceRes, error)
 	// GetUnreadNotificationsCount implements GetUnreadNotificationsCount operation.
 	//
 	// Get unread notifications count.
@@ -237,12 +361,30 @@ type Handler interface {
 	//
 	// GET /api/v1/versions
 	GetVersions(ctx context.Context) (GetVersionsRes, error)
+	// ListEventAttachments implements ListEventAttachments operation.
+	//
+	// List event attachments.
+	//
+	// GET /api/v1/projects/{project_id}/events/{event_id}/attachments
+	ListEventAttachments(ctx context.Context, params ListEventAttachmentsParams) (ListEventAttachmentsRes, error)
+	// ListGroupingRules implements ListGroupingRules operation.
+	//
+	// List project grouping rules.
+	//
+	// GET /api/v1/projects/{project_id}/grouping-rules
+	ListGroupingRules(ctx context.Context, params ListGroupingRulesParams) (ListGroupingRulesRes, error)
 	// ListIssues implements ListIssues operation.
 	//
 	// Get all issues across all projects.
 	//
 	// GET /api/v1/issues
 	ListIssues(ctx context.Context, params ListIssuesParams) (ListIssuesRes, error)
+	// ListMonitors implements ListMonitors operation.
+	//
+	// List project cron monitors.
+	//
+	// GET /api/v1/projects/{project_id}/monitors
+	ListMonitors(ctx context.Context, params ListMonitorsParams) (ListMonitorsRes, error)
 	// ListNotificationRules implements ListNotificationRules operation.
 	//
 	// List all notification rules for notification settings of project.
@@ -255,6 +397,48 @@ type Handler interface {
 	//
 	// GET /api/v1/projects/{project_id}/notification-settings
 	ListNotificationSettings(ctx context.Context, params ListNotificationSettingsParams) (ListNotificationSettingsRes, error)
+	// ListProjectEnvironments implements ListProjectEnvironments operation.
+	//
+	// Environments are discovered from the ingested events.
+	//
+	// GET /api/v1/projects/{project_id}/environments
+	ListProjectEnvironments(ctx context.Context, params ListProjectEnvironmentsParams) (ListProjectEnvironmentsRes, error)
+	// ListProjectIssueActivity implements ListProjectIssueActivity operation.
+	//
+	// First seen, regressions, status and assignment changes, merges and comments of the issue.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/activity
+	ListProjectIssueActivity(ctx context.Context, params ListProjectIssueActivityParams) (ListProjectIssueActivityRes, error)
+	// ListProjectIssueComments implements ListProjectIssueComments operation.
+	//
+	// List issue comments.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/comments
+	ListProjectIssueComments(ctx context.Context, params ListProjectIssueCommentsParams) (ListProjectIssueCommentsRes, error)
+	// ListProjectIssueEvents implements ListProjectIssueEvents operation.
+	//
+	// List issue events.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/events
+	ListProjectIssueEvents(ctx context.Context, params ListProjectIssueEventsParams) (ListProjectIssueEventsRes, error)
+	// ListProjectIssueTagEvents implements ListProjectIssueTagEvents operation.
+	//
+	// List issue events with a tag value.
+	//
+	// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events
+	ListProjectIssueTagEvents(ctx context.Context, params ListProjectIssueTagEventsParams) (ListProjectIssueTagEventsRes, error)
+	// ListProjectKeys implements ListProjectKeys operation.
+	//
+	// List project client keys.
+	//
+	// GET /api/v1/projects/{project_id}/keys
+	ListProjectKeys(ctx context.Context, params ListProjectKeysParams) (ListProjectKeysRes, error)
+	// ListProjectTransactions implements ListProjectTransactions operation.
+	//
+	// List project transactions.
+	//
+	// GET /api/v1/projects/{project_id}/transactions
+	ListProjectTransactions(ctx context.Context, params ListProjectTransactionsParams) (ListProjectTransactionsRes, error)
 	// ListProjects implements ListProjects operation.
 	//
 	// Get projects list.
@@ -297,6 +481,19 @@ type Handler interface {
 	//
 	// PUT /api/v1/notifications/{notification_id}/read
 	MarkNotificationAsRead(ctx context.Context, params MarkNotificationAsReadParams) (MarkNotificationAsReadRes, error)
+	// MergeIssues implements MergeIssues operation.
+	//
+	// Merges the given issues into the primary issue. Events of merged issues are grouped into the
+	// primary issue.
+	//
+	// POST /api/v1/projects/{project_id}/issues/merge
+	MergeIssues(ctx context.Context, req *MergeIssuesRequest, params MergeIssuesParams) (MergeIssuesRes, error)
+	// PreviewGroupingRule implements PreviewGroupingRule operation.
+	//
+	// Evaluates the rule against the events of the last 24 hours without saving it.
+	//
+	// POST /api/v1/projects/{project_id}/grouping-rules/preview
+	PreviewGroupingRule(ctx context.Context, req *GroupingRuleRequest, params PreviewGroupingRuleParams) (PreviewGroupingRuleRes, error)
 	// RecentProjectsList implements RecentProjectsList operation.
 	//
 	// Get recent projects list.
@@ -334,6 +531,25 @@ type Handler interface {
 	//
 	// POST /api/v1/auth/reset-password
 	ResetPassword(ctx context.Context, req *ResetPasswordRequest) (ResetPasswordRes, error)
+	// RevokeProjectKey implements RevokeProjectKey operation.
+	//
+	// Revoke a project client key.
+	//
+	// DELETE /api/v1/projects/{project_id}/keys/{key_id}
+	RevokeProjectKey(ctx context.Context, params RevokeProjectKeyParams) (RevokeProjectKeyRes, error)
+	// RotateProjectKey implements RotateProjectKey operation.
+	//
+	// Issues a new key with the label and the rate limit of the given one. The old key keeps working
+	// until it is revoked.
+	//
+	// POST /api/v1/projects/{project_id}/keys/{key_id}/rotate
+	RotateProjectKey(ctx context.Context, params RotateProjectKeyParams) (RotateProjectKeyRes, error)
+	// SearchProjectEvents implements SearchProjectEvents operation.
+	//
+	// Search project events.
+	//
+	// GET /api/v1/projects/{project_id}/events
+	SearchProjectEvents(ctx context.Context, params SearchProjectEventsParams) (SearchProjectEventsRes, error)
 	// Send2FACode implements send2FACode operation.
 	//
 	// Send 2FA email code for disable/reset.
@@ -364,6 +580,18 @@ type Handler interface {
 	//
 	// POST /api/v1/users/me/2fa/setup
 	Setup2FA(ctx context.Context) (Setup2FARes, error)
+	// UnmergeIssue implements UnmergeIssue operation.
+	//
+	// Splits merged fingerprints out of the issue into separate issues.
+	//
+	// POST /api/v1/projects/{project_id}/issues/{issue_id}/unmerge
+	UnmergeIssue(ctx context.Context, req *UnmergeIssueRequest, params UnmergeIssueParams) (UnmergeIssueRes, error)
+	// UpdateGroupingRule implements UpdateGroupingRule operation.
+	//
+	// Update a grouping rule.
+	//
+	// PUT /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+	UpdateGroupingRule(ctx context.Context, req *GroupingRuleRequest, params UpdateGroupingRuleParams) (UpdateGroupingRuleRes, error)
 	// UpdateNotificationRule implements UpdateNotificationRule operation.
 	//
 	// Update a notification rule.
@@ -382,6 +610,45 @@ type Handler interface {
 	//
 	// PUT /api/v1/projects/{project_id}
 	UpdateProject(ctx context.Context, req *UpdateProjectRequest, params UpdateProjectParams) (UpdateProjectRes, error)
+	// UpdateProjectDataScrubbing implements UpdateProjectDataScrubbing operation.
+	//
+	// Sensitive data is removed from events before they are stored, changes are applied to new events
+	// within a minute.
+	//
+	// PUT /api/v1/projects/{project_id}/data-scrubbing
+	UpdateProjectDataScrubbing(ctx context.Context, req *UpdateDataScrubbingRequest, params UpdateProjectDataScrubbingParams) (UpdateProjectDataScrubbingRes, error)
+	// UpdateProjectEnvironment implements UpdateProjectEnvironment operation.
+	//
+	// Update project environment.
+	//
+	// PUT /api/v1/projects/{project_id}/environments/{name}
+	UpdateProjectEnvironment(ctx context.Context, req *UpdateEnvironmentRequest, params UpdateProjectEnvironmentParams) (UpdateProjectEnvironmentRes, error)
+	// UpdateProjectGroupingConfig implements UpdateProjectGroupingConfig operation.
+	//
+	// Applies to new events only, existing issues keep their fingerprints.
+	//
+	// PUT /api/v1/projects/{project_id}/grouping-config
+	UpdateProjectGroupingConfig(ctx context.Context, req *UpdateGroupingConfigRequest, params UpdateProjectGroupingConfigParams) (UpdateProjectGroupingConfigRes, error)
+	// UpdateProjectInboundFilters implements UpdateProjectInboundFilters operation.
+	//
+	// Filters are applied by the ingest servers before data is queued, dropped data is counted in the
+	// project outcomes.
+	//
+	// PUT /api/v1/projects/{project_id}/inbound-filters
+	UpdateProjectInboundFilters(ctx context.Context, req *UpdateInboundFiltersRequest, params UpdateProjectInboundFiltersParams) (UpdateProjectInboundFiltersRes, error)
+	// UpdateProjectKey implements UpdateProjectKey operation.
+	//
+	// Disabled keys are rejected by the ingest servers until they are enabled again.
+	//
+	// PUT /api/v1/projects/{project_id}/keys/{key_id}
+	UpdateProjectKey(ctx context.Context, req *UpdateProjectKeyRequest, params UpdateProjectKeyParams) (UpdateProjectKeyRes, error)
+	// UpdateProjectRateLimits implements UpdateProjectRateLimits operation.
+	//
+	// The limits are shared by all client keys of the project, data over the limits is rejected by the
+	// ingest servers.
+	//
+	// PUT /api/v1/projects/{project_id}/rate-limits
+	UpdateProjectRateLimits(ctx context.Context, req *UpdateProjectRateLimitsRequest, params UpdateProjectRateLimitsParams) (UpdateProjectRateLimitsRes, error)
 	// UserChangeMyPassword implements userChangeMyPassword operation.
 	//
 	// Change my password.
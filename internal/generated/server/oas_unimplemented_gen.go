@@ -40,6 +40,28 @@ func (UnimplementedHandler) ArchiveProject(ctx context.Context, params ArchivePr
 	return r, ht.ErrNotImplemented
 }
 
+// AssignIssue implements AssignIssue operation.
+//
+// Assign issue to a user or a team.
+//
+// PUT /api/v1/projects/{project_id}/issues/{issue_id}/assignee
+func (UnimplementedHandler) AssignIssue(ctx context.Context, req *IssueAssigneeRequest, params AssignIssueParams) (r AssignIssueRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BulkUpdateIssues implements BulkUpdateIssues operation.
+//
+// Resolves, ignores, unresolves, assigns, merges or deletes the issues given by IDs or matching a
+// filter
+// in one transaction. Issues the user can't manage, missing issues and issues already in the
+// requested
+// state are reported and left as they are.
+//
+// POST /api/v1/projects/{project_id}/issues/bulk
+func (UnimplementedHandler) BulkUpdateIssues(ctx context.Context, req *IssueBulkRequest, params BulkUpdateIssuesParams) (r BulkUpdateIssuesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ChangeIssueStatus implements changeIssueStatus operation.
 //
 // Change issue status.
@@ -85,6 +107,15 @@ func (UnimplementedHandler) Confirm2FA(ctx context.Context, req *TwoFAConfirmReq
 	return r, ht.ErrNotImplemented
 }
 
+// CreateGroupingRule implements CreateGroupingRule operation.
+//
+// Applies to new events only, existing issues keep their fingerprints.
+//
+// POST /api/v1/projects/{project_id}/grouping-rules
+func (UnimplementedHandler) CreateGroupingRule(ctx context.Context, req *GroupingRuleRequest, params CreateGroupingRuleParams) (r CreateGroupingRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateNotificationRule implements CreateNotificationRule operation.
 //
 // Create a new notification rule.
@@ -103,6 +134,24 @@ func (UnimplementedHandler) CreateNotificationSetting(ctx context.Context, req *
 	return r, ht.ErrNotImplemented
 }
 
+// CreateProjectIssueComment implements CreateProjectIssueComment operation.
+//
+// Users with access to the project mentioned with @username are notified.
+//
+// POST /api/v1/projects/{project_id}/issues/{issue_id}/comments
+func (UnimplementedHandler) CreateProjectIssueComment(ctx context.Context, req *CreateIssueCommentRequest, params CreateProjectIssueCommentParams) (r CreateProjectIssueCommentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateProjectKey implements CreateProjectKey operation.
+//
+// Create a project client key.
+//
+// POST /api/v1/projects/{project_id}/keys
+func (UnimplementedHandler) CreateProjectKey(ctx context.Context, req *ProjectKeyRequest, params CreateProjectKeyParams) (r CreateProjectKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateTeam implements CreateTeam operation.
 //
 // Create a new team.
@@ -121,6 +170,24 @@ func (UnimplementedHandler) CreateUser(ctx context.Context, req *CreateUserReque
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteGroupingRule implements DeleteGroupingRule operation.
+//
+// Delete a grouping rule.
+//
+// DELETE /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+func (UnimplementedHandler) DeleteGroupingRule(ctx context.Context, params DeleteGroupingRuleParams) (r DeleteGroupingRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteMonitor implements DeleteMonitor operation.
+//
+// Delete cron monitor.
+//
+// DELETE /api/v1/projects/{project_id}/monitors/{monitor_id}
+func (UnimplementedHandler) DeleteMonitor(ctx context.Context, params DeleteMonitorParams) (r DeleteMonitorRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteNotificationRule implements DeleteNotificationRule operation.
 //
 // Delete a notification rule.
@@ -139,6 +206,15 @@ func (UnimplementedHandler) DeleteNotificationSetting(ctx context.Context, param
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteProjectIssueComment implements DeleteProjectIssueComment operation.
+//
+// Delete issue comment.
+//
+// DELETE /api/v1/projects/{project_id}/issues/{issue_id}/comments/{comment_id}
+func (UnimplementedHandler) DeleteProjectIssueComment(ctx context.Context, params DeleteProjectIssueCommentParams) (r DeleteProjectIssueCommentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteTeam implements DeleteTeam operation.
 //
 // Delete a team.
@@ -166,6 +242,15 @@ func (UnimplementedHandler) Disable2FA(ctx context.Context, req *TwoFADisableReq
 	return r, ht.ErrNotImplemented
 }
 
+// DownloadAttachment implements DownloadAttachment operation.
+//
+// Download attachment.
+//
+// GET /api/v1/projects/{project_id}/attachments/{attachment_id}/download
+func (UnimplementedHandler) DownloadAttachment(ctx context.Context, params DownloadAttachmentParams) (r DownloadAttachmentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ForgotPassword implements ForgotPassword operation.
 //
 // Request a password reset.
@@ -211,6 +296,15 @@ func (UnimplementedHandler) GetIssuesTimeseries(ctx context.Context, params GetI
 	return r, ht.ErrNotImplemented
 }
 
+// GetMonitor implements GetMonitor operation.
+//
+// Get cron monitor with recent check-ins.
+//
+// GET /api/v1/projects/{project_id}/monitors/{monitor_id}
+func (UnimplementedHandler) GetMonitor(ctx context.Context, params GetMonitorParams) (r GetMonitorRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetNotificationRule implements GetNotificationRule operation.
 //
 // Get a specific notification rule.
@@ -238,6 +332,42 @@ func (UnimplementedHandler) GetProject(ctx context.Context, params GetProjectPar
 	return r, ht.ErrNotImplemented
 }
 
+// GetProjectDataScrubbing implements GetProjectDataScrubbing operation.
+//
+// Get project data scrubbing.
+//
+// GET /api/v1/projects/{project_id}/data-scrubbing
+func (UnimplementedHandler) GetProjectDataScrubbing(ctx context.Context, params GetProjectDataScrubbingParams) (r GetProjectDataScrubbingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectEvent implements GetProjectEvent operation.
+//
+// Get event.
+//
+// GET /api/v1/projects/{project_id}/events/{event_id}
+func (UnimplementedHandler) GetProjectEvent(ctx context.Context, params GetProjectEventParams) (r GetProjectEventRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectGroupingConfig implements GetProjectGroupingConfig operation.
+//
+// Get project grouping configuration.
+//
+// GET /api/v1/projects/{project_id}/grouping-config
+func (UnimplementedHandler) GetProjectGroupingConfig(ctx context.Context, params GetProjectGroupingConfigParams) (r GetProjectGroupingConfigRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectInboundFilters implements GetProjectInboundFilters operation.
+//
+// Get project inbound filters.
+//
+// GET /api/v1/projects/{project_id}/inbound-filters
+func (UnimplementedHandler) GetProjectInboundFilters(ctx context.Context, params GetProjectInboundFiltersParams) (r GetProjectInboundFiltersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetProjectIssueEventsTimeseries implements GetProjectIssueEventsTimeseries operation.
 //
 // Get timeseries of events for a specific issue inside a project.
@@ -247,6 +377,33 @@ func (UnimplementedHandler) GetProjectIssueEventsTimeseries(ctx context.Context,
 	return r, ht.ErrNotImplemented
 }
 
+// GetProjectIssueLatestEvent implements GetProjectIssueLatestEvent operation.
+//
+// Get the latest issue event.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/latest
+func (UnimplementedHandler) GetProjectIssueLatestEvent(ctx context.Context, params GetProjectIssueLatestEventParams) (r GetProjectIssueLatestEventRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectIssueOldestEvent implements GetProjectIssueOldestEvent operation.
+//
+// Get the oldest issue event.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events/oldest
+func (UnimplementedHandler) GetProjectIssueOldestEvent(ctx context.Context, params GetProjectIssueOldestEventParams) (r GetProjectIssueOldestEventRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectIssueTags implements GetProjectIssueTags operation.
+//
+// Get tags of issue events.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags
+func (UnimplementedHandler) GetProjectIssueTags(ctx context.Context, params GetProjectIssueTagsParams) (r GetProjectIssueTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetProjectIssueTimeseries implements GetProjectIssueTimeseries operation.
 //
 // Get timeseries for a specific issue inside a project.
@@ -256,6 +413,24 @@ func (UnimplementedHandler) GetProjectIssueTimeseries(ctx context.Context, param
 	return r, ht.ErrNotImplemented
 }
 
+// GetProjectOutcomes implements GetProjectOutcomes operation.
+//
+// Outcomes are stored hourly, a granularity finer than 1h is rounded up.
+//
+// GET /api/v1/projects/{project_id}/outcomes
+func (UnimplementedHandler) GetProjectOutcomes(ctx context.Context, params GetProjectOutcomesParams) (r GetProjectOutcomesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetProjectRateLimits implements GetProjectRateLimits operation.
+//
+// Get project rate limits.
+//
+// GET /api/v1/projects/{project_id}/rate-limits
+func (UnimplementedHandler) GetProjectRateLimits(ctx context.Context, params GetProjectRateLimitsParams) (r GetProjectRateLimitsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetProjectReleaseAnalyticsDetails implements GetProjectReleaseAnalyticsDetails operation.
 //
 // Get analytics details for a specific release.
@@ -328,6 +503,15 @@ func (UnimplementedHandler) GetTeam(ctx context.Context, params GetTeamParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// GetTrace implements GetTrace operation.
+//
+// Get trace.
+//
+// GET /api/v1/projects/{project_id}/traces/{trace_id}
+func (UnimplementedHandler) GetTrace(ctx context.Context, params GetTraceParams) (r GetTraceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUnreadNotificationsCount implements GetUnreadNotificationsCount operation.
 //
 // Get unread notifications count.
@@ -356,6 +540,24 @@ func (UnimplementedHandler) GetVersions(ctx context.Context) (r GetVersionsRes,
 	return r, ht.ErrNotImplemented
 }
 
+// ListEventAttachments implements ListEventAttachments operation.
+//
+// List event attachments.
+//
+// GET /api/v1/projects/{project_id}/events/{event_id}/attachments
+func (UnimplementedHandler) ListEventAttachments(ctx context.Context, params ListEventAttachmentsParams) (r ListEventAttachmentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListGroupingRules implements ListGroupingRules operation.
+//
+// List project grouping rules.
+//
+// GET /api/v1/projects/{project_id}/grouping-rules
+func (UnimplementedHandler) ListGroupingRules(ctx context.Context, params ListGroupingRulesParams) (r ListGroupingRulesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListIssues implements ListIssues operation.
 //
 // Get all issues across all projects.
@@ -365,6 +567,15 @@ func (UnimplementedHandler) ListIssues(ctx context.Context, params ListIssuesPar
 	return r, ht.ErrNotImplemented
 }
 
+// ListMonitors implements ListMonitors operation.
+//
+// List project cron monitors.
+//
+// GET /api/v1/projects/{project_id}/monitors
+func (UnimplementedHandler) ListMonitors(ctx context.Context, params ListMonitorsParams) (r ListMonitorsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListNotificationRules implements ListNotificationRules operation.
 //
 // List all notification rules for notification settings of project.
@@ -383,6 +594,69 @@ func (UnimplementedHandler) ListNotificationSettings(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// ListProjectEnvironments implements ListProjectEnvironments operation.
+//
+// Environments are discovered from the ingested events.
+//
+// GET /api/v1/projects/{project_id}/environments
+func (UnimplementedHandler) ListProjectEnvironments(ctx context.Context, params ListProjectEnvironmentsParams) (r ListProjectEnvironmentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectIssueActivity implements ListProjectIssueActivity operation.
+//
+// First seen, regressions, status and assignment changes, merges and comments of the issue.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/activity
+func (UnimplementedHandler) ListProjectIssueActivity(ctx context.Context, params ListProjectIssueActivityParams) (r ListProjectIssueActivityRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectIssueComments implements ListProjectIssueComments operation.
+//
+// List issue comments.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/comments
+func (UnimplementedHandler) ListProjectIssueComments(ctx context.Context, params ListProjectIssueCommentsParams) (r ListProjectIssueCommentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectIssueEvents implements ListProjectIssueEvents operation.
+//
+// List issue events.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/events
+func (UnimplementedHandler) ListProjectIssueEvents(ctx context.Context, params ListProjectIssueEventsParams) (r ListProjectIssueEventsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectIssueTagEvents implements ListProjectIssueTagEvents operation.
+//
+// List issue events with a tag value.
+//
+// GET /api/v1/projects/{project_id}/issues/{issue_id}/tags/{key}/events
+func (UnimplementedHandler) ListProjectIssueTagEvents(ctx context.Context, params ListProjectIssueTagEventsParams) (r ListProjectIssueTagEventsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectKeys implements ListProjectKeys operation.
+//
+// List project client keys.
+//
+// GET /api/v1/projects/{project_id}/keys
+func (UnimplementedHandler) ListProjectKeys(ctx context.Context, params ListProjectKeysParams) (r ListProjectKeysRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListProjectTransactions implements ListProjectTransactions operation.
+//
+// List project transactions.
+//
+// GET /api/v1/projects/{project_id}/transactions
+func (UnimplementedHandler) ListProjectTransactions(ctx context.Context, params ListProjectTransactionsParams) (r ListProjectTransactionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListProjects implements ListProjects operation.
 //
 // Get projects list.
@@ -446,6 +720,25 @@ func (UnimplementedHandler) MarkNotificationAsRead(ctx context.Context, params M
 	return r, ht.ErrNotImplemented
 }
 
+// MergeIssues implements MergeIssues operation.
+//
+// Merges the given issues into the primary issue. Events of merged issues are grouped into the
+// primary issue.
+//
+// POST /api/v1/projects/{project_id}/issues/merge
+func (UnimplementedHandler) MergeIssues(ctx context.Context, req *MergeIssuesRequest, params MergeIssuesParams) (r MergeIssuesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PreviewGroupingRule implements PreviewGroupingRule operation.
+//
+// Evaluates the rule against the events of the last 24 hours without saving it.
+//
+// POST /api/v1/projects/{project_id}/grouping-rules/preview
+func (UnimplementedHandler) PreviewGroupingRule(ctx context.Context, req *GroupingRuleRequest, params PreviewGroupingRuleParams) (r PreviewGroupingRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RecentProjectsList implements RecentProjectsList operation.
 //
 // Get recent projects list.
@@ -498,6 +791,34 @@ func (UnimplementedHandler) ResetPassword(ctx context.Context, req *ResetPasswor
 	return r, ht.ErrNotImplemented
 }
 
+// RevokeProjectKey implements RevokeProjectKey operation.
+//
+// Revoke a project client key.
+//
+// DELETE /api/v1/projects/{project_id}/keys/{key_id}
+func (UnimplementedHandler) RevokeProjectKey(ctx context.Context, params RevokeProjectKeyParams) (r RevokeProjectKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RotateProjectKey implements RotateProjectKey operation.
+//
+// Issues a new key with the label and the rate limit of the given one. The old key keeps working
+// until it is revoked.
+//
+// POST /api/v1/projects/{project_id}/keys/{key_id}/rotate
+func (UnimplementedHandler) RotateProjectKey(ctx context.Context, params RotateProjectKeyParams) (r RotateProjectKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchProjectEvents implements SearchProjectEvents operation.
+//
+// Search project events.
+//
+// GET /api/v1/projects/{project_id}/events
+func (UnimplementedHandler) SearchProjectEvents(ctx context.Context, params SearchProjectEventsParams) (r SearchProjectEventsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Send2FACode implements send2FACode operation.
 //
 // Send 2FA email code for disable/reset.
@@ -543,6 +864,24 @@ func (UnimplementedHandler) Setup2FA(ctx context.Context) (r Setup2FARes, _ erro
 	return r, ht.ErrNotImplemented
 }
 
+// UnmergeIssue implements UnmergeIssue operation.
+//
+// Splits merged fingerprints out of the issue into separate issues.
+//
+// POST /api/v1/projects/{project_id}/issues/{issue_id}/unmerge
+func (UnimplementedHandler) UnmergeIssue(ctx context.Context, req *UnmergeIssueRequest, params UnmergeIssueParams) (r UnmergeIssueRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateGroupingRule implements UpdateGroupingRule operation.
+//
+// Update a grouping rule.
+//
+// PUT /api/v1/projects/{project_id}/grouping-rules/{rule_id}
+func (UnimplementedHandler) UpdateGroupingRule(ctx context.Context, req *GroupingRuleRequest, params UpdateGroupingRuleParams) (r UpdateGroupingRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateNotificationRule implements UpdateNotificationRule operation.
 //
 // Update a notification rule.
@@ -570,6 +909,63 @@ func (UnimplementedHandler) UpdateProject(ctx context.Context, req *UpdateProjec
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateProjectDataScrubbing implements UpdateProjectDataScrubbing operation.
+//
+// Sensitive data is removed from events before they are stored, changes are applied to new events
+// within a minute.
+//
+// PUT /api/v1/projects/{project_id}/data-scrubbing
+func (UnimplementedHandler) UpdateProjectDataScrubbing(ctx context.Context, req *UpdateDataScrubbingRequest, params UpdateProjectDataScrubbingParams) (r UpdateProjectDataScrubbingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProjectEnvironment implements UpdateProjectEnvironment operation.
+//
+// Update project environment.
+//
+// PUT /api/v1/projects/{project_id}/environments/{name}
+func (UnimplementedHandler) UpdateProjectEnvironment(ctx context.Context, req *UpdateEnvironmentRequest, params UpdateProjectEnvironmentParams) (r UpdateProjectEnvironmentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProjectGroupingConfig implements UpdateProjectGroupingConfig operation.
+//
+// Applies to new events only, existing issues keep their fingerprints.
+//
+// PUT /api/v1/projects/{project_id}/grouping-config
+func (UnimplementedHandler) UpdateProjectGroupingConfig(ctx context.Context, req *UpdateGroupingConfigRequest, params UpdateProjectGroupingConfigParams) (r UpdateProjectGroupingConfigRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProjectInboundFilters implements UpdateProjectInboundFilters operation.
+//
+// Filters are applied by the ingest servers before data is queued, dropped data is counted in the
+// project outcomes.
+//
+// PUT /api/v1/projects/{project_id}/inbound-filters
+func (UnimplementedHandler) UpdateProjectInboundFilters(ctx context.Context, req *UpdateInboundFiltersRequest, params UpdateProjectInboundFiltersParams) (r UpdateProjectInboundFiltersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProjectKey implements UpdateProjectKey operation.
+//
+// Disabled keys are rejected by the ingest servers until they are enabled again.
+//
+// PUT /api/v1/projects/{project_id}/keys/{key_id}
+func (UnimplementedHandler) UpdateProjectKey(ctx context.Context, req *UpdateProjectKeyRequest, params UpdateProjectKeyParams) (r UpdateProjectKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateProjectRateLimits implements UpdateProjectRateLimits operation.
+//
+// The limits are shared by all client keys of the project, data over the limits is rejected by the
+// ingest servers.
+//
+// PUT /api/v1/projects/{project_id}/rate-limits
+func (UnimplementedHandler) UpdateProjectRateLimits(ctx context.Context, req *UpdateProjectRateLimitsRequest, params UpdateProjectRateLimitsParams) (r UpdateProjectRateLimitsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UserChangeMyPassword implements userChangeMyPassword operation.
 //
 // Change my password.
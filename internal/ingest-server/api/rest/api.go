@@ -7,6 +7,7 @@ import (
 
 	"github.com/ogen-go/ogen/ogenerrors"
 
+	"github.com/rom8726/warden/internal/domain"
 	generatedapi "github.com/rom8726/warden/internal/generated/ingestserver"
 	"github.com/rom8726/warden/internal/ingest-server/contract"
 )
@@ -16,15 +17,24 @@ var _ generatedapi.Handler = (*RestAPI)(nil)
 type RestAPI struct {
 	envelopeUseCase   contract.EnvelopeUseCase
 	storeEventUseCase contract.StoreEventUseCase
+	checkInUseCase    contract.CheckInUseCase
+	projectsUseCase   contract.ProjectsUseCase
+	outcomes          contract.OutcomeRecorder
 }
 
 func New(
 	envelopeUseCase contract.EnvelopeUseCase,
 	storeEventUseCase contract.StoreEventUseCase,
+	checkInUseCase contract.CheckInUseCase,
+	projectsUseCase contract.ProjectsUseCase,
+	outcomes contract.OutcomeRecorder,
 ) *RestAPI {
 	return &RestAPI{
 		envelopeUseCase:   envelopeUseCase,
 		storeEventUseCase: storeEventUseCase,
+		checkInUseCase:    checkInUseCase,
+		projectsUseCase:   projectsUseCase,
+		outcomes:          outcomes,
 	}
 }
 
@@ -33,7 +43,14 @@ func (r *RestAPI) NewError(_ context.Context, err error) *generatedapi.ErrorStat
 	errMessage := err.Error()
 
 	var secError *ogenerrors.SecurityError
-	if errors.As(err, &secError) {
+	switch {
+	case errors.Is(err, domain.ErrProjectKeyRateLimited):
+		code = http.StatusTooManyRequests
+		errMessage = domain.ErrProjectKeyRateLimited.Error()
+	case errors.Is(err, domain.ErrRateLimited):
+		code = http.StatusTooManyRequests
+		errMessage = domain.ErrRateLimited.Error()
+	case errors.As(err, &secError):
 		code = http.StatusUnauthorized
 		errMessage = "unauthorized"
 	}
@@ -0,0 +1,79 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	generatedapi "github.com/rom8726/warden/internal/generated/ingestserver"
+)
+
+// CronCheckIn accepts a check-in over plain HTTP, the project key is a part of the URL.
+func (r *RestAPI) CronCheckIn(
+	ctx context.Context,
+	req generatedapi.OptCronCheckInRequest,
+	params generatedapi.CronCheckInParams,
+) (generatedapi.CronCheckInRes, error) {
+	projectID := wardencontext.ProjectID(ctx)
+
+	if _, err := r.projectsUseCase.AuthenticateKey(ctx, projectID, params.SentryKey, ""); err != nil {
+		if errors.Is(err, domain.ErrInvalidProjectKey) {
+			return &generatedapi.ErrorUnauthorized{Error: generatedapi.ErrorUnauthorizedError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		}
+
+		if errors.Is(err, domain.ErrProjectKeyRateLimited) {
+			r.outcomes.Record(projectID, domain.OutcomeRateLimited, domain.OutcomeReasonKeyRateLimit,
+				domain.DataCategoryMonitor, 1)
+		}
+
+		return nil, err
+	}
+
+	data := map[string]any{
+		"monitor_slug": params.MonitorSlug,
+		"status":       string(params.Status),
+	}
+	if checkInID, ok := params.CheckInID.Get(); ok {
+		data["check_in_id"] = checkInID
+	}
+	if duration, ok := params.Duration.Get(); ok {
+		data["duration"] = duration
+	}
+	if environment, ok := params.Environment.Get(); ok {
+		data["environment"] = environment
+	}
+
+	if monitorConfigRaw, ok := req.Value.MonitorConfig.Get(); ok {
+		monitorConfig := make(map[string]any, len(monitorConfigRaw))
+		for key, value := range monitorConfigRaw {
+			var parsed any
+			if err := json.Unmarshal(value, &parsed); err != nil {
+				return nil, fmt.Errorf("unmarshal monitor config: %w", err)
+			}
+			monitorConfig[key] = parsed
+		}
+		data["monitor_config"] = monitorConfig
+	}
+
+	checkInID, err := r.checkInUseCase.CheckIn(ctx, projectID, data)
+	if err != nil {
+		slog.Error("cron check-in failed", "error", err)
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidCheckIn), errors.Is(err, domain.ErrInvalidMonitorConfig):
+			return &generatedapi.ErrorBadRequest{Error: generatedapi.ErrorBadRequestError{
+				Message: generatedapi.NewOptString(err.Error()),
+			}}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &generatedapi.CronCheckInResponse{ID: checkInID}, nil
+}
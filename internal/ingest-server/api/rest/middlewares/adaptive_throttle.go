@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -31,6 +32,7 @@ type AdaptiveThrottleConfig struct {
 	RPSWindow            time.Duration
 	StatsRefreshInterval time.Duration
 	RateLimit            uint64
+	SpikeProtection      SpikeProtectionConfig
 }
 
 // DefaultAdaptiveThrottleConfig returns the default configuration for the adaptive throttle middleware.
@@ -39,6 +41,13 @@ func DefaultAdaptiveThrottleConfig() *AdaptiveThrottleConfig {
 		RPSWindow:            time.Minute,
 		StatsRefreshInterval: time.Second,
 		RateLimit:            100,
+		SpikeProtection: SpikeProtectionConfig{
+			Enabled:         false,
+			Multiplier:      10,
+			MinRPS:          20,
+			Duration:        10 * time.Minute,
+			BaselineWindows: 30,
+		},
 	}
 }
 
@@ -62,6 +71,8 @@ func AdaptiveThrottle(
 	ctx context.Context,
 	redisClient *redis.Client,
 	projectsRepo contract.ProjectsRepository,
+	outcomes contract.OutcomeRecorder,
+	spikes contract.SpikesUseCase,
 	config *AdaptiveThrottleConfig,
 ) AdaptiveThrottleResult {
 	if config == nil {
@@ -76,11 +87,15 @@ func AdaptiveThrottle(
 	// Create the RPS stats cache and muted fingerprints cache
 	cache := NewRPSStatsCache(config.RateLimit)
 
+	// Create the spike protector fed by the worker
+	spikeProtector := NewSpikeProtector(redisClient, spikes, config.SpikeProtection)
+
 	// Create and start the worker
 	worker := NewRPSStatsWorker(
 		redisClient,
 		cache,
 		projectsRepo,
+		spikeProtector,
 		config.StatsRefreshInterval,
 		config.RPSWindow,
 	)
@@ -94,11 +109,32 @@ func AdaptiveThrottle(
 
 			// Check if we're over the RPS limit
 			if isOverRPS(projectID, cache) {
+				outcomes.Record(projectID, domain.OutcomeRateLimited, domain.OutcomeReasonProjectRateLimit,
+					requestDataCategory(req.URL.Path), 1)
+				wardencontext.ReportRateLimit(ctx, domain.ActiveRateLimit{
+					RetryAfter: timeUntilNextBatch(time.Now(), config.RPSWindow),
+					Scope:      domain.RateLimitScopeProject,
+					Reason:     domain.OutcomeReasonProjectRateLimit,
+				})
 				respond429(writer, "Global rate limit hit")
 
 				return
 			}
 
+			// Check if the project is capped by the spike protection
+			if isOverSpikeCap(projectID, cache, spikeProtector) {
+				outcomes.Record(projectID, domain.OutcomeRateLimited, domain.OutcomeReasonSpikeProtection,
+					requestDataCategory(req.URL.Path), 1)
+				wardencontext.ReportRateLimit(ctx, domain.ActiveRateLimit{
+					RetryAfter: timeUntilNextBatch(time.Now(), config.RPSWindow),
+					Scope:      domain.RateLimitScopeProject,
+					Reason:     domain.OutcomeReasonSpikeProtection,
+				})
+				respond429(writer, "Spike protection rate limit hit")
+
+				return
+			}
+
 			// Increment the counter in Redis
 			err := incrementRPSCounter(ctx, redisClient, projectID, config.RPSWindow)
 			if err != nil {
@@ -130,6 +166,39 @@ func isOverRPS(
 	return rps > cache.rateLimit
 }
 
+// isOverSpikeCap checks if the project is capped by the spike protection and over its cap.
+func isOverSpikeCap(
+	projectID domain.ProjectID,
+	cache *RPSStatsCache,
+	spikeProtector *SpikeProtector,
+) bool {
+	capRPS, ok := spikeProtector.Cap(projectID)
+	if !ok {
+		return false
+	}
+
+	rps, ok := cache.GetRPS(projectID)
+
+	return ok && rps > capRPS
+}
+
+// requestDataCategory returns the data category of an ingest request by its path.
+func requestDataCategory(path string) domain.DataCategory {
+	parts := strings.Split(path, "/")
+	if len(parts) < 4 {
+		return domain.DataCategoryEnvelope
+	}
+
+	switch parts[3] {
+	case "store":
+		return domain.DataCategoryError
+	case "cron":
+		return domain.DataCategoryMonitor
+	default:
+		return domain.DataCategoryEnvelope
+	}
+}
+
 // respond429 responds with a 429 Too Many Requests status code.
 func respond429(w http.ResponseWriter, message string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -155,6 +224,11 @@ func batchToTime(timeBatch int64, rpsWindow time.Duration) time.Time {
 	return time.Unix(timeBatch*int64(rpsWindow.Seconds()), 0)
 }
 
+// timeUntilNextBatch returns the time until the RPS counters are started over.
+func timeUntilNextBatch(now time.Time, rpsWindow time.Duration) time.Duration {
+	return batchToTime(calculateTimeBatch(now, rpsWindow)+1, rpsWindow).Sub(now)
+}
+
 func timeLeftInBatch(timeBatch int64, rpsWindow time.Duration) time.Duration {
 	return time.Since(batchToTime(timeBatch, rpsWindow))
 }
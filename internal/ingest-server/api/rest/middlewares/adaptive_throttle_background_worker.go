@@ -19,6 +19,7 @@ type RPSStatsWorker struct {
 	redisClient  *redis.Client
 	cache        *RPSStatsCache
 	projectsRepo contract.ProjectsRepository
+	spikes       *SpikeProtector
 	interval     time.Duration
 	rpsWindow    time.Duration
 }
@@ -28,6 +29,7 @@ func NewRPSStatsWorker(
 	redisClient *redis.Client,
 	cache *RPSStatsCache,
 	projectsRepo contract.ProjectsRepository,
+	spikes *SpikeProtector,
 	interval time.Duration,
 	rpsWindow time.Duration,
 ) *RPSStatsWorker {
@@ -35,6 +37,7 @@ func NewRPSStatsWorker(
 		redisClient:  redisClient,
 		cache:        cache,
 		projectsRepo: projectsRepo,
+		spikes:       spikes,
 		interval:     interval,
 		rpsWindow:    rpsWindow,
 	}
@@ -120,6 +123,7 @@ func (w *RPSStatsWorker) calculateProjectRPS(ctx context.Context, projectID doma
 
 		prevRPS := CalculateRPS(prevCnt, w.rpsWindow)
 		w.cache.SetPrevBatchRPS(projectID, prevRPS)
+		w.spikes.ObserveWindow(projectID, prevRPS)
 	}
 
 	// Update the current batch
@@ -153,6 +157,9 @@ func (w *RPSStatsWorker) calculateProjectRPS(ctx context.Context, projectID doma
 
 	// Update the cache
 	w.cache.SetRPS(projectID, rps)
+
+	// Cap the project if the RPS is far over its baseline
+	w.spikes.Check(ctx, projectID, rps)
 }
 
 // logRPSStats logs the current RPS values for all projects.
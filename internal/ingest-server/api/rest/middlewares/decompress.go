@@ -0,0 +1,203 @@
+package middlewares
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/ingest-server/contract"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+var (
+	errUnsupportedEncoding    = errors.New("unsupported content encoding")
+	errBodyTooLarge           = errors.New("decompressed body is too large")
+	errCompressedBodyTooLarge = errors.New("compressed body is too large")
+)
+
+// Decompress is a middleware that transparently decompresses gzip, deflate, br and zstd encoded
+// bodies of the store and envelope endpoints. Bodies over maxSize bytes before or after decompressing
+// are rejected.
+func Decompress(maxSize int64, outcomes contract.OutcomeRecorder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			encodings := contentEncodings(req.Header.Get("Content-Encoding"))
+			if len(encodings) == 0 || !isIngestBodyPath(req.URL.Path) {
+				next.ServeHTTP(writer, req)
+
+				return
+			}
+
+			projectID := wardencontext.ProjectID(req.Context())
+			category := requestDataCategory(req.URL.Path)
+
+			// The compressed body is limited too, it's buffered before decompressing
+			compressed, err := io.ReadAll(http.MaxBytesReader(writer, req.Body, maxSize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					metrics.RequestDecompressionRejected.WithLabelValues("too_large").Inc()
+					outcomes.Record(projectID, domain.OutcomeInvalid, domain.OutcomeReasonTooLarge, category, 1)
+					respondDecompressError(writer, http.StatusRequestEntityTooLarge, errCompressedBodyTooLarge.Error())
+
+					return
+				}
+
+				slog.Error("Error reading compressed body", "error", err)
+				respondDecompressError(writer, http.StatusBadRequest, "Failed to read request body")
+
+				return
+			}
+
+			data, err := decompressBody(compressed, encodings, maxSize)
+			if err != nil {
+				switch {
+				case errors.Is(err, errUnsupportedEncoding):
+					metrics.RequestDecompressionRejected.WithLabelValues("unsupported_encoding").Inc()
+					respondDecompressError(writer, http.StatusUnsupportedMediaType, err.Error())
+				case errors.Is(err, errBodyTooLarge):
+					metrics.RequestDecompressionRejected.WithLabelValues("too_large").Inc()
+					outcomes.Record(projectID, domain.OutcomeInvalid, domain.OutcomeReasonTooLarge, category, 1)
+					respondDecompressError(writer, http.StatusRequestEntityTooLarge, err.Error())
+				default:
+					slog.Error("Error decompressing body", "encoding", encodings, "error", err)
+					metrics.RequestDecompressionRejected.WithLabelValues("invalid_body").Inc()
+					outcomes.Record(projectID, domain.OutcomeInvalid, domain.OutcomeReasonInvalidPayload, category, 1)
+					respondDecompressError(writer, http.StatusBadRequest, "Failed to decompress request body")
+				}
+
+				return
+			}
+
+			if len(compressed) > 0 {
+				metrics.RequestCompressionRatio.WithLabelValues(strings.Join(encodings, ",")).
+					Observe(float64(len(data)) / float64(len(compressed)))
+			}
+
+			req.Body = io.NopCloser(bytes.NewReader(data))
+			req.ContentLength = int64(len(data))
+			req.Header.Set("Content-Length", strconv.Itoa(len(data)))
+			req.Header.Del("Content-Encoding")
+
+			next.ServeHTTP(writer, req)
+		})
+	}
+}
+
+// contentEncodings returns the encodings of the Content-Encoding header in the order they were applied.
+func contentEncodings(header string) []string {
+	var encodings []string
+	for _, encoding := range strings.Split(header, ",") {
+		encoding = strings.ToLower(strings.TrimSpace(encoding))
+		if encoding == "" || encoding == "identity" {
+			continue
+		}
+
+		encodings = append(encodings, encoding)
+	}
+
+	return encodings
+}
+
+func isIngestBodyPath(path string) bool {
+	parts := strings.Split(path, "/")
+
+	return len(parts) >= 4 && parts[1] == "api" && (parts[3] == "store" || parts[3] == "envelope")
+}
+
+// decompressBody removes the encodings in the reverse order they were applied.
+func decompressBody(data []byte, encodings []string, maxSize int64) ([]byte, error) {
+	for i := len(encodings) - 1; i >= 0; i-- {
+		reader, err := newDecompressReader(encodings[i], bytes.NewReader(data), maxSize)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err = io.ReadAll(io.LimitReader(reader, maxSize+1))
+		_ = reader.Close()
+		if errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+			return nil, errBodyTooLarge
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decompress %s: %w", encodings[i], err)
+		}
+
+		if int64(len(data)) > maxSize {
+			return nil, errBodyTooLarge
+		}
+	}
+
+	return data, nil
+}
+
+func newDecompressReader(encoding string, body io.Reader, maxSize int64) (io.ReadCloser, error) {
+	switch encoding {
+	case "gzip", "x-gzip":
+		return gzip.NewReader(body)
+	case "deflate":
+		return newDeflateReader(body)
+	case "br":
+		return io.NopCloser(brotli.NewReader(body)), nil
+	case "zstd":
+		// The decoder memory and window are bounded by the body limit, so a small frame
+		// can't make the decoder allocate more than the decompressed body may take
+		decoder, err := zstd.NewReader(body,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(maxSize)),
+			zstd.WithDecoderMaxWindow(zstdMaxWindow(maxSize)),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return decoder.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("%w %q", errUnsupportedEncoding, encoding)
+	}
+}
+
+// zstdMaxWindow returns the largest window a zstd frame of a body limited to maxSize may use.
+func zstdMaxWindow(maxSize int64) uint64 {
+	return uint64(min(max(maxSize, zstd.MinWindowSize), zstd.MaxWindowSize))
+}
+
+// newDeflateReader reads zlib wrapped deflate data as HTTP requires,
+// raw deflate data sent by some clients is accepted too.
+func newDeflateReader(body io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(body)
+
+	header, err := buffered.Peek(2)
+	if err == nil && isZlibHeader(header) {
+		return zlib.NewReader(buffered)
+	}
+
+	return flate.NewReader(buffered), nil
+}
+
+func isZlibHeader(header []byte) bool {
+	return header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0
+}
+
+func respondDecompressError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	resp := map[string]string{"error": message}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.Error("Error encoding response", "error", err)
+	}
+}
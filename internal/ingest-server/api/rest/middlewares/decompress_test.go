@@ -0,0 +1,232 @@
+package middlewares
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/ingest-server/contract"
+)
+
+const testEnvelope = `{"event_id":"9ec79c33ec9942ab8353589fcb2e04dc"}
+{"type":"event","length":17}
+{"message":"boom"}
+`
+
+func compress(t *testing.T, encoding string, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+
+	switch encoding {
+	case "gzip":
+		writer = gzip.NewWriter(&buf)
+	case "deflate":
+		writer = zlib.NewWriter(&buf)
+	case "raw-deflate":
+		flateWriter, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		require.NoError(t, err)
+		writer = flateWriter
+	case "br":
+		writer = brotli.NewWriter(&buf)
+	case "zstd":
+		zstdWriter, err := zstd.NewWriter(&buf)
+		require.NoError(t, err)
+		writer = zstdWriter
+	default:
+		t.Fatalf("unknown encoding %q", encoding)
+	}
+
+	_, err := writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func TestDecompress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		encoding       string
+		body           []byte
+		maxSize        int64
+		expectedStatus int
+		expectedBody   string
+		expectOutcome  string
+	}{
+		{
+			name:           "gzip envelope",
+			path:           "/api/1/envelope/",
+			encoding:       "gzip",
+			body:           compress(t, "gzip", []byte(testEnvelope)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "zlib deflate store",
+			path:           "/api/1/store/",
+			encoding:       "deflate",
+			body:           compress(t, "deflate", []byte(testEnvelope)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "raw deflate",
+			path:           "/api/1/store/",
+			encoding:       "deflate",
+			body:           compress(t, "raw-deflate", []byte(testEnvelope)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "brotli",
+			path:           "/api/1/envelope/",
+			encoding:       "br",
+			body:           compress(t, "br", []byte(testEnvelope)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "zstd",
+			path:           "/api/1/envelope/",
+			encoding:       "zstd",
+			body:           compress(t, "zstd", []byte(testEnvelope)),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "chained encodings",
+			path:           "/api/1/envelope/",
+			encoding:       "gzip, br",
+			body:           compress(t, "br", compress(t, "gzip", []byte(testEnvelope))),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "identity passes through",
+			path:           "/api/1/envelope/",
+			encoding:       "identity",
+			body:           []byte(testEnvelope),
+			expectedStatus: http.StatusOK,
+			expectedBody:   testEnvelope,
+		},
+		{
+			name:           "other paths are not decompressed",
+			path:           "/api/1/cron/nightly/key/",
+			encoding:       "gzip",
+			body:           []byte("not compressed"),
+			expectedStatus: http.StatusOK,
+			expectedBody:   "not compressed",
+		},
+		{
+			name:           "unsupported encoding",
+			path:           "/api/1/envelope/",
+			encoding:       "compress",
+			body:           []byte(testEnvelope),
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:           "decompressed body over the limit",
+			path:           "/api/1/envelope/",
+			encoding:       "gzip",
+			body:           compress(t, "gzip", bytes.Repeat([]byte("a"), 1024)),
+			maxSize:        100,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectOutcome:  domain.OutcomeReasonTooLarge,
+		},
+		{
+			name:           "zstd frame over the decoder memory limit",
+			path:           "/api/1/envelope/",
+			encoding:       "zstd",
+			body:           compress(t, "zstd", bytes.Repeat([]byte("a"), 64*1024)),
+			maxSize:        2048,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectOutcome:  domain.OutcomeReasonTooLarge,
+		},
+		{
+			name:           "compressed body over the limit",
+			path:           "/api/1/envelope/",
+			encoding:       "gzip",
+			body:           bytes.Repeat([]byte("a"), 1024),
+			maxSize:        100,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectOutcome:  domain.OutcomeReasonTooLarge,
+		},
+		{
+			name:           "corrupted body",
+			path:           "/api/1/envelope/",
+			encoding:       "gzip",
+			body:           []byte("not gzip"),
+			expectedStatus: http.StatusBadRequest,
+			expectOutcome:  domain.OutcomeReasonInvalidPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			outcomes := mockcontract.NewMockOutcomeRecorder(t)
+			if tt.expectOutcome != "" {
+				outcomes.EXPECT().
+					Record(domain.ProjectID(1), domain.OutcomeInvalid, tt.expectOutcome, domain.DataCategoryEnvelope, uint(1)).
+					Return()
+			}
+
+			maxSize := tt.maxSize
+			if maxSize == 0 {
+				maxSize = 1 << 20
+			}
+
+			var receivedBody string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				receivedBody = string(body)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(tt.body))
+			req.Header.Set("Content-Encoding", tt.encoding)
+			rec := httptest.NewRecorder()
+
+			WithProjectID(Decompress(maxSize, outcomes)(next)).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				require.Equal(t, tt.expectedBody, receivedBody)
+			}
+		})
+	}
+}
+
+func TestZstdMaxWindow(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, uint64(zstd.MinWindowSize), zstdMaxWindow(100))
+	require.Equal(t, uint64(1<<20), zstdMaxWindow(1<<20))
+	require.Equal(t, uint64(zstd.MaxWindowSize), zstdMaxWindow(1<<40))
+}
+
+func TestContentEncodings(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"gzip", "br"}, contentEncodings("GZIP, identity,br"))
+	require.Empty(t, contentEncodings(""))
+	require.Empty(t, contentEncodings(strings.Repeat(" ,", 3)))
+}
@@ -0,0 +1,131 @@
+package middlewares
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/ingest-server/contract"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+// InboundFilters is a middleware that applies the project allowed origins and the request level
+// inbound filters (web crawlers, legacy browsers and blocked IP ranges) to the store and envelope
+// endpoints. Filtered requests are answered with 200 as SDKs must not retry them.
+// The forwarding headers are read only from the trusted proxies.
+func InboundFilters(
+	projects contract.ProjectsUseCase,
+	outcomes contract.OutcomeRecorder,
+	trustedProxies []netip.Prefix,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			if !isIngestBodyPath(req.URL.Path) {
+				next.ServeHTTP(writer, req)
+
+				return
+			}
+
+			ctx := req.Context()
+			projectID := wardencontext.ProjectID(ctx)
+
+			filters, err := projects.InboundFilters(ctx, projectID)
+			if err != nil {
+				// Don't drop data when the filters can't be loaded
+				slog.Error("Failed to get inbound filters", "error", err, "project_id", projectID)
+				next.ServeHTTP(writer, req)
+
+				return
+			}
+
+			category := requestDataCategory(req.URL.Path)
+			origin := req.Header.Get("Origin")
+
+			if !filters.OriginAllowed(origin, req.Header.Get("Referer")) {
+				metrics.InboundFilterRejections.WithLabelValues(domain.OutcomeReasonCORS).Inc()
+				outcomes.Record(projectID, domain.OutcomeInvalid, domain.OutcomeReasonCORS, category, 1)
+				respondInboundFilterError(writer, http.StatusForbidden, "Origin is not allowed")
+
+				return
+			}
+
+			if origin != "" && filters.HasAllowedOrigins() {
+				writer.Header().Set("Access-Control-Allow-Origin", origin)
+				writer.Header().Add("Vary", "Origin")
+			}
+
+			if reason, filtered := filters.FilterRequest(req.Header.Get("User-Agent"), clientIP(req, trustedProxies)); filtered {
+				metrics.InboundFilterRejections.WithLabelValues(string(reason)).Inc()
+				outcomes.Record(projectID, domain.OutcomeFiltered, string(reason), category, 1)
+				respondInboundFilterError(writer, http.StatusOK, "Event filtered by "+string(reason)+" filter")
+
+				return
+			}
+
+			next.ServeHTTP(writer, req)
+		})
+	}
+}
+
+// clientIP returns the address of the client. The peer address is used unless it's a trusted proxy,
+// then X-Forwarded-For is walked from the right skipping trusted proxies, X-Real-IP is the fallback.
+func clientIP(req *http.Request, trustedProxies []netip.Prefix) string {
+	peer, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		peer = req.RemoteAddr
+	}
+
+	if !isTrustedProxy(peer, trustedProxies) {
+		return peer
+	}
+
+	if forwarded := req.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if hop != "" && (i == 0 || !isTrustedProxy(hop, trustedProxies)) {
+				return hop
+			}
+		}
+	}
+
+	if realIP := strings.TrimSpace(req.Header.Get("X-Real-IP")); realIP != "" {
+		return realIP
+	}
+
+	return peer
+}
+
+func isTrustedProxy(value string, trustedProxies []netip.Prefix) bool {
+	if len(trustedProxies) == 0 {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func respondInboundFilterError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	resp := map[string]string{"error": message}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.Error("Error encoding response", "error", err)
+	}
+}
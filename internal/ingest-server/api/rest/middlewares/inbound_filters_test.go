@@ -0,0 +1,208 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/common/inboundfilters"
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/ingest-server/contract"
+)
+
+func TestInboundFilters(t *testing.T) {
+	t.Parallel()
+
+	filters, err := inboundfilters.Compile(domain.InboundFilters{
+		AllowedOrigins:  []string{"https://*.example.com"},
+		WebCrawlers:     true,
+		BlockedIPRanges: []string{"10.0.0.0/8"},
+	})
+	require.NoError(t, err)
+
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("172.16.0.0/12")}
+
+	tests := []struct {
+		name           string
+		path           string
+		remoteAddr     string
+		headers        map[string]string
+		expectedStatus int
+		expectNext     bool
+		expectOutcome  domain.Outcome
+		expectReason   string
+		expectOrigin   string
+	}{
+		{
+			name:           "allowed origin",
+			path:           "/api/1/envelope/",
+			headers:        map[string]string{"Origin": "https://app.example.com"},
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+			expectOrigin:   "https://app.example.com",
+		},
+		{
+			name:           "not allowed origin",
+			path:           "/api/1/envelope/",
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusForbidden,
+			expectOutcome:  domain.OutcomeInvalid,
+			expectReason:   domain.OutcomeReasonCORS,
+		},
+		{
+			name:           "web crawler",
+			path:           "/api/1/store/",
+			headers:        map[string]string{"User-Agent": "Googlebot/2.1 (+http://www.google.com/bot.html)"},
+			expectedStatus: http.StatusOK,
+			expectOutcome:  domain.OutcomeFiltered,
+			expectReason:   string(domain.InboundFilterWebCrawlers),
+		},
+		{
+			name:           "blocked forwarded IP",
+			path:           "/api/1/envelope/",
+			remoteAddr:     "172.16.0.2:4321",
+			headers:        map[string]string{"X-Forwarded-For": "192.168.0.1, 10.1.1.1"},
+			expectedStatus: http.StatusOK,
+			expectOutcome:  domain.OutcomeFiltered,
+			expectReason:   string(domain.InboundFilterIPAddress),
+		},
+		{
+			name:           "forwarded IP of an untrusted peer is ignored",
+			path:           "/api/1/envelope/",
+			headers:        map[string]string{"X-Forwarded-For": "10.1.1.1"},
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+		{
+			name:           "blocked peer can't spoof the forwarded IP",
+			path:           "/api/1/envelope/",
+			remoteAddr:     "10.2.2.2:4321",
+			headers:        map[string]string{"X-Forwarded-For": "192.168.0.1"},
+			expectedStatus: http.StatusOK,
+			expectOutcome:  domain.OutcomeFiltered,
+			expectReason:   string(domain.InboundFilterIPAddress),
+		},
+		{
+			name:           "other paths are not filtered",
+			path:           "/api/1/cron/nightly/key/",
+			headers:        map[string]string{"Origin": "https://evil.com"},
+			expectedStatus: http.StatusOK,
+			expectNext:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			projects := mockcontract.NewMockProjectsUseCase(t)
+			projects.EXPECT().InboundFilters(mock.Anything, domain.ProjectID(1)).Return(filters, nil).Maybe()
+
+			outcomes := mockcontract.NewMockOutcomeRecorder(t)
+			if tt.expectOutcome != "" {
+				outcomes.EXPECT().
+					Record(domain.ProjectID(1), tt.expectOutcome, tt.expectReason, mock.Anything, uint(1)).
+					Return()
+			}
+
+			nextCalled := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				nextCalled = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, http.NoBody)
+			if tt.remoteAddr != "" {
+				req.RemoteAddr = tt.remoteAddr
+			}
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+
+			WithProjectID(InboundFilters(projects, outcomes, trustedProxies)(next)).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectedStatus, rec.Code)
+			require.Equal(t, tt.expectNext, nextCalled)
+			require.Equal(t, tt.expectOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	t.Parallel()
+
+	trustedProxies := []netip.Prefix{
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.0.2.10/32"),
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		realIP     string
+		forwarded  string
+		proxies    []netip.Prefix
+		expected   string
+	}{
+		{name: "peer address", remoteAddr: "192.0.2.1:1234", expected: "192.0.2.1"},
+		{
+			name:       "headers are ignored without trusted proxies",
+			remoteAddr: "172.16.0.2:1234",
+			realIP:     "192.0.2.2",
+			forwarded:  "192.0.2.3",
+			expected:   "172.16.0.2",
+		},
+		{
+			name:       "headers of an untrusted peer are ignored",
+			remoteAddr: "192.0.2.1:1234",
+			realIP:     "192.0.2.2",
+			forwarded:  "192.0.2.3",
+			proxies:    trustedProxies,
+			expected:   "192.0.2.1",
+		},
+		{
+			name:       "real IP of a trusted proxy",
+			remoteAddr: "172.16.0.2:1234",
+			realIP:     "192.0.2.2",
+			proxies:    trustedProxies,
+			expected:   "192.0.2.2",
+		},
+		{
+			name:       "rightmost untrusted forwarded address",
+			remoteAddr: "172.16.0.2:1234",
+			realIP:     "192.0.2.2",
+			forwarded:  " 10.0.0.1 , 192.0.2.3, 192.0.2.10",
+			proxies:    trustedProxies,
+			expected:   "192.0.2.3",
+		},
+		{
+			name:       "leftmost forwarded address when all are trusted",
+			remoteAddr: "172.16.0.2:1234",
+			forwarded:  "172.16.0.5, 192.0.2.10",
+			proxies:    trustedProxies,
+			expected:   "172.16.0.5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/1/envelope/", http.NoBody)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+
+			require.Equal(t, tt.expected, clientIP(req, tt.proxies))
+		})
+	}
+}
@@ -15,7 +15,7 @@ func WithProjectID(next http.Handler) http.Handler {
 		parts := strings.Split(vars, "/")
 
 		if len(parts) >= 5 {
-			if !(parts[1] == "api" && (parts[3] == "envelope" || parts[3] == "store")) {
+			if !(parts[1] == "api" && (parts[3] == "envelope" || parts[3] == "store" || parts[3] == "cron")) {
 				next.ServeHTTP(writer, request)
 
 				return
@@ -53,6 +53,13 @@ func TestWithProjectID(t *testing.T) {
 			checkContext:   true,
 			expectedID:     456,
 		},
+		{
+			name:           "Valid cron path sets project ID",
+			path:           "/api/789/cron/nightly/key/",
+			expectedStatus: http.StatusOK,
+			checkContext:   true,
+			expectedID:     789,
+		},
 	}
 
 	for _, tt := range tests {
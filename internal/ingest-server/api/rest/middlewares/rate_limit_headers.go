@@ -0,0 +1,88 @@
+package middlewares
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// RateLimitHeaders is a middleware that tells SDKs to back off from sending rate limited data
+// with the Retry-After and X-Sentry-Rate-Limits headers. The exceeded limits are reported to the request
+// context by the throttling, the key authentication and the quota checks.
+func RateLimitHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		ctx := wardencontext.WithRateLimitReport(req.Context())
+		rateLimitWriter := &rateLimitHeadersWriter{ResponseWriter: writer, req: req.WithContext(ctx)}
+
+		next.ServeHTTP(rateLimitWriter, rateLimitWriter.req)
+	})
+}
+
+type rateLimitHeadersWriter struct {
+	http.ResponseWriter
+	req         *http.Request
+	wroteHeader bool
+}
+
+func (w *rateLimitHeadersWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		setRateLimitHeaders(w.Header(), statusCode, wardencontext.ReportedRateLimits(w.req.Context()))
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *rateLimitHeadersWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+func setRateLimitHeaders(header http.Header, statusCode int, limits []domain.ActiveRateLimit) {
+	if len(limits) == 0 {
+		return
+	}
+
+	value, retryAfter := formatSentryRateLimits(limits)
+	header.Set("X-Sentry-Rate-Limits", value)
+	header.Set("Access-Control-Expose-Headers", "X-Sentry-Rate-Limits, Retry-After")
+
+	if statusCode == http.StatusTooManyRequests {
+		header.Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+}
+
+// formatSentryRateLimits formats the limits as `retry_after:categories:scope:reason_code` quotas
+// separated by commas, the categories are separated by semicolons and empty for all data.
+// It returns the longest retry after in seconds too.
+func formatSentryRateLimits(limits []domain.ActiveRateLimit) (string, int) {
+	quotas := make([]string, 0, len(limits))
+	maxRetryAfter := 0
+
+	for _, limit := range limits {
+		retryAfter := retryAfterSeconds(limit.RetryAfter)
+		maxRetryAfter = max(maxRetryAfter, retryAfter)
+
+		categories := make([]string, 0, len(limit.Categories))
+		for _, category := range limit.Categories {
+			categories = append(categories, string(category))
+		}
+
+		quotas = append(quotas, strconv.Itoa(retryAfter)+":"+strings.Join(categories, ";")+":"+
+			string(limit.Scope)+":"+limit.Reason)
+	}
+
+	return strings.Join(quotas, ", "), maxRetryAfter
+}
+
+func retryAfterSeconds(retryAfter time.Duration) int {
+	return max(1, int(math.Ceil(retryAfter.Seconds())))
+}
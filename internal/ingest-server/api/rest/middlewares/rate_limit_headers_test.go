@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		limits             []domain.ActiveRateLimit
+		status             int
+		expectedRateLimits string
+		expectedRetryAfter string
+	}{
+		{
+			name:   "no limits",
+			status: http.StatusOK,
+		},
+		{
+			name: "whole request limited",
+			limits: []domain.ActiveRateLimit{{
+				RetryAfter: 1500 * time.Millisecond,
+				Scope:      domain.RateLimitScopeProject,
+				Reason:     domain.OutcomeReasonProjectRateLimit,
+			}},
+			status:             http.StatusTooManyRequests,
+			expectedRateLimits: "2::project:project_rate_limit",
+			expectedRetryAfter: "2",
+		},
+		{
+			name: "categories limited in an accepted request",
+			limits: []domain.ActiveRateLimit{
+				{
+					RetryAfter: 30 * time.Second,
+					Categories: []domain.DataCategory{domain.DataCategoryTransaction, domain.DataCategoryAttachment},
+					Scope:      domain.RateLimitScopeKey,
+					Reason:     domain.OutcomeReasonKeyRateLimit,
+				},
+				{
+					RetryAfter: 0,
+					Categories: []domain.DataCategory{domain.DataCategorySession},
+					Scope:      domain.RateLimitScopeProject,
+					Reason:     domain.OutcomeReasonProjectRateLimit,
+				},
+			},
+			status:             http.StatusOK,
+			expectedRateLimits: "30:transaction;attachment:key:key_rate_limit, 1:session:project:project_rate_limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for _, limit := range tt.limits {
+					wardencontext.ReportRateLimit(r.Context(), limit)
+				}
+
+				w.WriteHeader(tt.status)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/1/envelope/", nil)
+			rec := httptest.NewRecorder()
+
+			RateLimitHeaders(next).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code)
+			require.Equal(t, tt.expectedRateLimits, rec.Header().Get("X-Sentry-Rate-Limits"))
+			require.Equal(t, tt.expectedRetryAfter, rec.Header().Get("Retry-After"))
+		})
+	}
+}
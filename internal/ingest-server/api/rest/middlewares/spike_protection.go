@@ -0,0 +1,224 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/ingest-server/contract"
+)
+
+// SpikeProtectionConfig holds configuration for the spike protection.
+type SpikeProtectionConfig struct {
+	Enabled bool
+	// Multiplier of the baseline RPS over which the project is capped
+	Multiplier float64
+	// RPS under which the project is never capped
+	MinRPS uint64
+	// How long the project stays capped
+	Duration time.Duration
+	// Number of RPS windows the baseline is averaged over, no spikes are detected until they are observed
+	BaselineWindows uint
+}
+
+type spikeBaseline struct {
+	rps     float64
+	windows uint
+}
+
+type spikeCap struct {
+	capRPS uint64
+	endsAt time.Time
+}
+
+// SpikeProtector detects sudden floods of project ingest by comparing the current RPS
+// against a rolling baseline and caps the project for a while.
+// Caps are shared by all ingest servers through Redis, the server which started the cap records the spike.
+type SpikeProtector struct {
+	redisClient *redis.Client
+	spikes      contract.SpikesUseCase
+	config      SpikeProtectionConfig
+
+	mu        sync.RWMutex
+	baselines map[domain.ProjectID]spikeBaseline
+	caps      map[domain.ProjectID]spikeCap
+}
+
+// NewSpikeProtector creates a new SpikeProtector.
+func NewSpikeProtector(
+	redisClient *redis.Client,
+	spikes contract.SpikesUseCase,
+	config SpikeProtectionConfig,
+) *SpikeProtector {
+	return &SpikeProtector{
+		redisClient: redisClient,
+		spikes:      spikes,
+		config:      config,
+		baselines:   make(map[domain.ProjectID]spikeBaseline),
+		caps:        make(map[domain.ProjectID]spikeCap),
+	}
+}
+
+// ObserveWindow updates the baseline of the project with the RPS of a finished window.
+// Windows of capped projects are not observed, the flood must not become the new normal.
+func (p *SpikeProtector) ObserveWindow(projectID domain.ProjectID, rps uint64) {
+	if !p.config.Enabled {
+		return
+	}
+
+	if _, capped := p.Cap(projectID); capped {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	baseline, ok := p.baselines[projectID]
+	if !ok {
+		p.baselines[projectID] = spikeBaseline{rps: float64(rps), windows: 1}
+
+		return
+	}
+
+	// Exponentially weighted moving average over the configured number of windows
+	alpha := 2 / (float64(p.config.BaselineWindows) + 1)
+	baseline.rps = alpha*float64(rps) + (1-alpha)*baseline.rps
+	baseline.windows++
+	p.baselines[projectID] = baseline
+}
+
+// Cap returns the RPS the project is capped at while it is capped.
+func (p *SpikeProtector) Cap(projectID domain.ProjectID) (uint64, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	projectCap, ok := p.caps[projectID]
+	if !ok || !time.Now().Before(projectCap.endsAt) {
+		return 0, false
+	}
+
+	return projectCap.capRPS, true
+}
+
+// Check caps the project when its current RPS is over the threshold of its baseline.
+func (p *SpikeProtector) Check(ctx context.Context, projectID domain.ProjectID, rps uint64) {
+	if !p.config.Enabled || rps <= p.config.MinRPS {
+		return
+	}
+
+	if _, capped := p.Cap(projectID); capped {
+		return
+	}
+
+	key := generateSpikeKey(projectID)
+	now := time.Now()
+
+	// The project may have been capped by another ingest server
+	value, err := p.redisClient.Get(ctx, key).Result()
+	switch {
+	case err == nil:
+		if projectCap, ok := parseSpikeCap(value); ok {
+			p.setCap(projectID, projectCap)
+		}
+
+		return
+	case !errors.Is(err, redis.Nil):
+		slog.Error("Error getting project spike cap", "project_id", projectID, "error", err)
+
+		return
+	}
+
+	baselineRPS, ok := p.baselineRPS(projectID)
+	if !ok {
+		return
+	}
+
+	capRPS := max(uint64(math.Ceil(baselineRPS*p.config.Multiplier)), p.config.MinRPS)
+	if rps <= capRPS {
+		return
+	}
+
+	projectCap := spikeCap{capRPS: capRPS, endsAt: now.Add(p.config.Duration)}
+
+	started, err := p.redisClient.SetNX(ctx, key, formatSpikeCap(projectCap), p.config.Duration).Result()
+	if err != nil {
+		slog.Error("Error setting project spike cap", "project_id", projectID, "error", err)
+
+		return
+	}
+
+	if !started {
+		// Another ingest server has just capped the project, its cap is picked up on the next check
+		return
+	}
+
+	p.setCap(projectID, projectCap)
+
+	err = p.spikes.RecordSpike(ctx, domain.ProjectSpikeDTO{
+		ProjectID:   projectID,
+		StartedAt:   now,
+		EndsAt:      projectCap.endsAt,
+		BaselineRPS: uint64(math.Round(baselineRPS)),
+		PeakRPS:     rps,
+		CapRPS:      capRPS,
+	})
+	if err != nil {
+		slog.Error("Error recording project spike", "project_id", projectID, "error", err)
+	}
+}
+
+// baselineRPS returns the baseline RPS of the project once enough windows are observed.
+func (p *SpikeProtector) baselineRPS(projectID domain.ProjectID) (float64, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	baseline, ok := p.baselines[projectID]
+	if !ok || baseline.windows < p.config.BaselineWindows {
+		return 0, false
+	}
+
+	return baseline.rps, true
+}
+
+func (p *SpikeProtector) setCap(projectID domain.ProjectID, projectCap spikeCap) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.caps[projectID] = projectCap
+}
+
+// generateSpikeKey creates a Redis key for the spike cap of a project.
+func generateSpikeKey(projectID domain.ProjectID) string {
+	return "spike:project:" + strconv.FormatUint(uint64(projectID), 10)
+}
+
+func formatSpikeCap(projectCap spikeCap) string {
+	return strconv.FormatUint(projectCap.capRPS, 10) + ":" + strconv.FormatInt(projectCap.endsAt.Unix(), 10)
+}
+
+func parseSpikeCap(value string) (spikeCap, bool) {
+	capValue, endsAtValue, ok := strings.Cut(value, ":")
+	if !ok {
+		return spikeCap{}, false
+	}
+
+	capRPS, err := strconv.ParseUint(capValue, 10, 64)
+	if err != nil {
+		return spikeCap{}, false
+	}
+
+	endsAt, err := strconv.ParseInt(endsAtValue, 10, 64)
+	if err != nil {
+		return spikeCap{}, false
+	}
+
+	return spikeCap{capRPS: capRPS, endsAt: time.Unix(endsAt, 0)}, true
+}
@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestSpikeProtector_Baseline(t *testing.T) {
+	t.Parallel()
+
+	protector := NewSpikeProtector(nil, nil, SpikeProtectionConfig{
+		Enabled:         true,
+		Multiplier:      10,
+		MinRPS:          20,
+		Duration:        time.Minute,
+		BaselineWindows: 3,
+	})
+	projectID := domain.ProjectID(1)
+
+	protector.ObserveWindow(projectID, 10)
+	protector.ObserveWindow(projectID, 10)
+	_, ok := protector.baselineRPS(projectID)
+	require.False(t, ok, "baseline is not ready until enough windows are observed")
+
+	protector.ObserveWindow(projectID, 30)
+	baseline, ok := protector.baselineRPS(projectID)
+	require.True(t, ok)
+	require.InDelta(t, 20, baseline, 0.001)
+
+	// Windows of a capped project do not move the baseline
+	protector.setCap(projectID, spikeCap{capRPS: 200, endsAt: time.Now().Add(time.Minute)})
+	protector.ObserveWindow(projectID, 5000)
+	baseline, _ = protector.baselineRPS(projectID)
+	require.InDelta(t, 20, baseline, 0.001)
+
+	capRPS, ok := protector.Cap(projectID)
+	require.True(t, ok)
+	require.Equal(t, uint64(200), capRPS)
+}
+
+func TestSpikeProtector_ExpiredCap(t *testing.T) {
+	t.Parallel()
+
+	protector := NewSpikeProtector(nil, nil, SpikeProtectionConfig{Enabled: true})
+	protector.setCap(1, spikeCap{capRPS: 100, endsAt: time.Now().Add(-time.Second)})
+
+	_, ok := protector.Cap(1)
+	require.False(t, ok)
+}
+
+func TestSpikeCapFormat(t *testing.T) {
+	t.Parallel()
+
+	projectCap := spikeCap{capRPS: 120, endsAt: time.Unix(1735732800, 0)}
+
+	parsed, ok := parseSpikeCap(formatSpikeCap(projectCap))
+	require.True(t, ok)
+	require.Equal(t, projectCap.capRPS, parsed.capRPS)
+	require.True(t, projectCap.endsAt.Equal(parsed.endsAt))
+
+	_, ok = parseSpikeCap("garbage")
+	require.False(t, ok)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
 	generatedapi "github.com/rom8726/warden/internal/generated/ingestserver"
 	"github.com/rom8726/warden/internal/ingest-server/contract"
 )
@@ -14,46 +15,96 @@ var _ generatedapi.SecurityHandler = (*SecurityHandler)(nil)
 
 type SecurityHandler struct {
 	projectService contract.ProjectsUseCase
+	outcomes       contract.OutcomeRecorder
 }
 
 func NewSecurityHandler(
 	projectService contract.ProjectsUseCase,
+	outcomes contract.OutcomeRecorder,
 ) *SecurityHandler {
 	return &SecurityHandler{
 		projectService: projectService,
+		outcomes:       outcomes,
 	}
 }
 
+// HandleSentryAuth authenticates the X-Sentry-Auth header, the secret key of legacy DSNs is checked when sent.
 func (r *SecurityHandler) HandleSentryAuth(
 	ctx context.Context,
-	_ generatedapi.OperationName,
+	operationName generatedapi.OperationName,
 	tokenHolder generatedapi.SentryAuth,
 ) (context.Context, error) {
-	sentryKey := parseSentryKeyFromAuth(tokenHolder.APIKey)
+	sentryKey, sentrySecret := parseSentryAuth(tokenHolder.APIKey)
+
+	return r.authenticate(ctx, operationName, sentryKey, sentrySecret)
+}
+
+// HandleSentryKey authenticates the sentry_key query parameter sent by browser and CSP clients.
+func (r *SecurityHandler) HandleSentryKey(
+	ctx context.Context,
+	operationName generatedapi.OperationName,
+	tokenHolder generatedapi.SentryKey,
+) (context.Context, error) {
+	if _, ok := wardencontext.ProjectKey(ctx); ok {
+		// Already authenticated by the header
+		return ctx, nil
+	}
+
+	return r.authenticate(ctx, operationName, tokenHolder.APIKey, "")
+}
+
+func (r *SecurityHandler) authenticate(
+	ctx context.Context,
+	operationName generatedapi.OperationName,
+	sentryKey string,
+	sentrySecret string,
+) (context.Context, error) {
 	projectID := wardencontext.ProjectID(ctx)
 
-	allowed, err := r.projectService.ValidateProjectKey(ctx, projectID, sentryKey)
+	key, err := r.projectService.AuthenticateKey(ctx, projectID, sentryKey, sentrySecret)
 	if err != nil {
+		if errors.Is(err, domain.ErrProjectKeyRateLimited) {
+			r.outcomes.Record(projectID, domain.OutcomeRateLimited, domain.OutcomeReasonKeyRateLimit,
+				operationDataCategory(operationName), 1)
+		}
+
 		return nil, err
 	}
 
-	if !allowed {
-		return nil, errors.New("invalid or unauthorized key")
+	// The key is kept for the quotas checked once the request items are known
+	return wardencontext.WithProjectKey(ctx, key), nil
+}
+
+// parseSentryAuth returns the public and the secret keys of the Sentry auth header,
+// e.g. `Sentry sentry_version=7, sentry_key=<public>, sentry_secret=<secret>`.
+func parseSentryAuth(auth string) (sentryKey, sentrySecret string) {
+	auth = strings.TrimSpace(auth)
+	if len(auth) > len("Sentry ") && strings.EqualFold(auth[:len("Sentry ")], "Sentry ") {
+		auth = auth[len("Sentry "):]
 	}
 
-	return ctx, nil
-}
+	for _, part := range strings.Split(auth, ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			continue
+		}
 
-func parseSentryKeyFromAuth(auth string) string {
-	parts := strings.Split(auth, ",")
-	for _, part := range parts {
-		if strings.Contains(part, "sentry_key=") {
-			kv := strings.Split(strings.TrimSpace(part), "=")
-			if len(kv) >= 2 {
-				return strings.TrimSpace(strings.Trim(kv[1], `"`))
-			}
+		value = strings.TrimSpace(strings.Trim(strings.TrimSpace(value), `"`))
+		switch strings.TrimSpace(name) {
+		case "sentry_key":
+			sentryKey = value
+		case "sentry_secret":
+			sentrySecret = value
 		}
 	}
 
-	return ""
+	return sentryKey, sentrySecret
+}
+
+func operationDataCategory(operationName generatedapi.OperationName) domain.DataCategory {
+	if operationName == generatedapi.StoreEventOperation {
+		return domain.DataCategoryError
+	}
+
+	return domain.DataCategoryEnvelope
 }
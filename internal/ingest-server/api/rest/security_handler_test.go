@@ -29,16 +29,16 @@ func TestSecurityHandler_HandleSentryAuth(t *testing.T) {
 		}
 
 		mockProjectService.EXPECT().
-			ValidateProjectKey(mock.Anything, domain.ProjectID(123), "valid_key").
-			Return(true, nil)
+			AuthenticateKey(mock.Anything, domain.ProjectID(123), "valid_key", "").
+			Return(domain.ProjectKey{ID: 1}, nil)
 
 		resultCtx, err := handler.HandleSentryAuth(ctx, generatedapi.StoreEventOperation, tokenHolder)
 
 		require.NoError(t, err)
-		assert.Equal(t, ctx, resultCtx)
+		assert.Equal(t, domain.ProjectID(123), wardencontext.ProjectID(resultCtx))
 	})
 
-	t.Run("receive envelope operation with valid key", func(t *testing.T) {
+	t.Run("receive envelope operation with valid key and secret", func(t *testing.T) {
 		mockProjectService := mockcontract.NewMockProjectsUseCase(t)
 
 		handler := &SecurityHandler{
@@ -47,17 +47,17 @@ func TestSecurityHandler_HandleSentryAuth(t *testing.T) {
 
 		ctx := wardencontext.WithProjectID(context.Background(), 456)
 		tokenHolder := generatedapi.SentryAuth{
-			APIKey: `sentry_key="valid_key"`,
+			APIKey: `Sentry sentry_version=7, sentry_key=valid_key, sentry_secret=secret`,
 		}
 
 		mockProjectService.EXPECT().
-			ValidateProjectKey(mock.Anything, domain.ProjectID(456), "valid_key").
-			Return(true, nil)
+			AuthenticateKey(mock.Anything, domain.ProjectID(456), "valid_key", "secret").
+			Return(domain.ProjectKey{ID: 1}, nil)
 
 		resultCtx, err := handler.HandleSentryAuth(ctx, generatedapi.ReceiveEnvelopeOperation, tokenHolder)
 
 		require.NoError(t, err)
-		assert.Equal(t, ctx, resultCtx)
+		assert.NotNil(t, resultCtx)
 	})
 
 	t.Run("invalid key", func(t *testing.T) {
@@ -73,8 +73,8 @@ func TestSecurityHandler_HandleSentryAuth(t *testing.T) {
 		}
 
 		mockProjectService.EXPECT().
-			ValidateProjectKey(mock.Anything, domain.ProjectID(123), "invalid_key").
-			Return(false, nil)
+			AuthenticateKey(mock.Anything, domain.ProjectID(123), "invalid_key", "").
+			Return(domain.ProjectKey{}, domain.ErrInvalidProjectKey)
 
 		resultCtx, err := handler.HandleSentryAuth(ctx, generatedapi.StoreEventOperation, tokenHolder)
 
@@ -83,6 +83,34 @@ func TestSecurityHandler_HandleSentryAuth(t *testing.T) {
 		assert.Equal(t, "invalid or unauthorized key", err.Error())
 	})
 
+	t.Run("key over its rate limit", func(t *testing.T) {
+		mockProjectService := mockcontract.NewMockProjectsUseCase(t)
+		mockOutcomes := mockcontract.NewMockOutcomeRecorder(t)
+
+		handler := &SecurityHandler{
+			projectService: mockProjectService,
+			outcomes:       mockOutcomes,
+		}
+
+		ctx := wardencontext.WithProjectID(context.Background(), 123)
+		tokenHolder := generatedapi.SentryAuth{
+			APIKey: `sentry_key="valid_key"`,
+		}
+
+		mockProjectService.EXPECT().
+			AuthenticateKey(mock.Anything, domain.ProjectID(123), "valid_key", "").
+			Return(domain.ProjectKey{}, domain.ErrProjectKeyRateLimited)
+		mockOutcomes.EXPECT().
+			Record(domain.ProjectID(123), domain.OutcomeRateLimited, domain.OutcomeReasonKeyRateLimit,
+				domain.DataCategoryEnvelope, uint(1)).
+			Return()
+
+		resultCtx, err := handler.HandleSentryAuth(ctx, generatedapi.ReceiveEnvelopeOperation, tokenHolder)
+
+		require.ErrorIs(t, err, domain.ErrProjectKeyRateLimited)
+		assert.Nil(t, resultCtx)
+	})
+
 	t.Run("validation error", func(t *testing.T) {
 		mockProjectService := mockcontract.NewMockProjectsUseCase(t)
 
@@ -97,8 +125,8 @@ func TestSecurityHandler_HandleSentryAuth(t *testing.T) {
 
 		expectedErr := errors.New("validation error")
 		mockProjectService.EXPECT().
-			ValidateProjectKey(mock.Anything, domain.ProjectID(123), "valid_key").
-			Return(false, expectedErr)
+			AuthenticateKey(mock.Anything, domain.ProjectID(123), "valid_key", "").
+			Return(domain.ProjectKey{}, expectedErr)
 
 		resultCtx, err := handler.HandleSentryAuth(ctx, generatedapi.StoreEventOperation, tokenHolder)
 
@@ -108,34 +136,77 @@ func TestSecurityHandler_HandleSentryAuth(t *testing.T) {
 	})
 }
 
-func TestParseSentryKeyFromAuth(t *testing.T) {
-	t.Run("valid sentry key", func(t *testing.T) {
-		auth := `sentry_key="test_key_123"`
-		result := parseSentryKeyFromAuth(auth)
-		assert.Equal(t, "test_key_123", result)
-	})
+func TestSecurityHandler_HandleSentryKey(t *testing.T) {
+	t.Run("query string key", func(t *testing.T) {
+		mockProjectService := mockcontract.NewMockProjectsUseCase(t)
 
-	t.Run("multiple parts with sentry key", func(t *testing.T) {
-		auth := `sentry_key="test_key",other_param="value"`
-		result := parseSentryKeyFromAuth(auth)
-		assert.Equal(t, "test_key", result)
-	})
+		handler := &SecurityHandler{
+			projectService: mockProjectService,
+		}
 
-	t.Run("no sentry key", func(t *testing.T) {
-		auth := `other_param="value"`
-		result := parseSentryKeyFromAuth(auth)
-		assert.Equal(t, "", result)
-	})
+		ctx := wardencontext.WithProjectID(context.Background(), 123)
+
+		mockProjectService.EXPECT().
+			AuthenticateKey(mock.Anything, domain.ProjectID(123), "valid_key", "").
+			Return(domain.ProjectKey{ID: 1}, nil)
+
+		resultCtx, err := handler.HandleSentryKey(ctx, generatedapi.StoreEventOperation,
+			generatedapi.SentryKey{APIKey: "valid_key"})
 
-	t.Run("empty auth", func(t *testing.T) {
-		auth := ""
-		result := parseSentryKeyFromAuth(auth)
-		assert.Equal(t, "", result)
+		require.NoError(t, err)
+		assert.NotNil(t, resultCtx)
 	})
 
-	t.Run("sentry key with spaces", func(t *testing.T) {
-		auth := `sentry_key=" test_key "`
-		result := parseSentryKeyFromAuth(auth)
-		assert.Equal(t, "test_key", result)
+	t.Run("already authenticated by the header", func(t *testing.T) {
+		mockProjectService := mockcontract.NewMockProjectsUseCase(t)
+
+		handler := &SecurityHandler{
+			projectService: mockProjectService,
+		}
+
+		ctx := wardencontext.WithProjectID(context.Background(), 123)
+
+		mockProjectService.EXPECT().
+			AuthenticateKey(mock.Anything, domain.ProjectID(123), "header_key", "").
+			Return(domain.ProjectKey{ID: 1}, nil).Once()
+
+		ctx, err := handler.HandleSentryAuth(ctx, generatedapi.ReceiveEnvelopeOperation,
+			generatedapi.SentryAuth{APIKey: `sentry_key=header_key`})
+		require.NoError(t, err)
+
+		resultCtx, err := handler.HandleSentryKey(ctx, generatedapi.ReceiveEnvelopeOperation,
+			generatedapi.SentryKey{APIKey: "query_key"})
+
+		require.NoError(t, err)
+		assert.Equal(t, ctx, resultCtx)
 	})
 }
+
+func TestParseSentryAuth(t *testing.T) {
+	tests := []struct {
+		name           string
+		auth           string
+		expectedKey    string
+		expectedSecret string
+	}{
+		{name: "valid sentry key", auth: `sentry_key="test_key_123"`, expectedKey: "test_key_123"},
+		{name: "multiple parts with sentry key", auth: `sentry_key="test_key",other_param="value"`, expectedKey: "test_key"},
+		{name: "no sentry key", auth: `other_param="value"`},
+		{name: "empty auth", auth: ""},
+		{name: "sentry key with spaces", auth: `sentry_key=" test_key "`, expectedKey: "test_key"},
+		{
+			name:           "sentry prefix with secret",
+			auth:           `Sentry sentry_version=7, sentry_client=raven-go/1.0, sentry_key=public, sentry_secret=secret`,
+			expectedKey:    "public",
+			expectedSecret: "secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, secret := parseSentryAuth(tt.auth)
+			assert.Equal(t, tt.expectedKey, key)
+			assert.Equal(t, tt.expectedSecret, secret)
+		})
+	}
+}
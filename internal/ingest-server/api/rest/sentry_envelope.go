@@ -17,6 +17,9 @@ func (r *RestAPI) ReceiveEnvelope(
 	_ generatedapi.ReceiveEnvelopeParams,
 ) (generatedapi.ReceiveEnvelopeRes, error) {
 	err := r.envelopeUseCase.ReceiveEnvelope(ctx, wardencontext.ProjectID(ctx), req.Data)
+	if errors.Is(err, domain.ErrRateLimited) {
+		return &generatedapi.ReceiveEnvelopeOK{}, err
+	}
 	if err != nil {
 		slog.Error("receive envelope failed", "error", err)
 
@@ -3,10 +3,12 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 
 	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
 	generatedapi "github.com/rom8726/warden/internal/generated/ingestserver"
 	"github.com/rom8726/warden/pkg/metrics"
 )
@@ -30,6 +32,9 @@ func (r *RestAPI) StoreEvent(
 	}
 
 	eventID, err := r.storeEventUseCase.StoreEvent(ctx, wardencontext.ProjectID(ctx), eventData)
+	if errors.Is(err, domain.ErrRateLimited) {
+		return nil, err
+	}
 	if err != nil {
 		slog.Error("store event failed", "error", err)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"net/netip"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -14,17 +15,33 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	commonconfig "github.com/rom8726/warden/internal/common/config"
+	commoninboundfilters "github.com/rom8726/warden/internal/common/inboundfilters"
+	"github.com/rom8726/warden/internal/common/outcomes"
 	"github.com/rom8726/warden/internal/common/techserver"
+	"github.com/rom8726/warden/internal/domain"
 	generatedserver "github.com/rom8726/warden/internal/generated/ingestserver"
 	"github.com/rom8726/warden/internal/ingest-server/api/rest"
 	"github.com/rom8726/warden/internal/ingest-server/api/rest/middlewares"
 	"github.com/rom8726/warden/internal/ingest-server/config"
 	"github.com/rom8726/warden/internal/ingest-server/contract"
 	"github.com/rom8726/warden/internal/ingest-server/services/envelopequeueproducer"
+	"github.com/rom8726/warden/internal/ingest-server/services/projectslistener"
+	"github.com/rom8726/warden/internal/ingest-server/services/ratelimiter"
+	checkinusecase "github.com/rom8726/warden/internal/ingest-server/usecases/checkin"
 	envelopeusecase "github.com/rom8726/warden/internal/ingest-server/usecases/envelope"
 	projectsusecase "github.com/rom8726/warden/internal/ingest-server/usecases/projects"
+	spikesusecase "github.com/rom8726/warden/internal/ingest-server/usecases/spikes"
 	storeeventusecase "github.com/rom8726/warden/internal/ingest-server/usecases/storeevent"
+	"github.com/rom8726/warden/internal/repository/inboundfilters"
+	"github.com/rom8726/warden/internal/repository/issues"
+	"github.com/rom8726/warden/internal/repository/notificationsqueue"
+	"github.com/rom8726/warden/internal/repository/projectkeys"
+	"github.com/rom8726/warden/internal/repository/projectratelimits"
 	"github.com/rom8726/warden/internal/repository/projects"
+	"github.com/rom8726/warden/internal/repository/projectspikes"
+	"github.com/rom8726/warden/internal/repository/teams"
+	"github.com/rom8726/warden/internal/repository/usernotifications"
+	"github.com/rom8726/warden/internal/repository/users"
 	"github.com/rom8726/warden/internal/services/storeeventqueueproducer"
 	"github.com/rom8726/warden/pkg/db"
 	"github.com/rom8726/warden/pkg/httpserver"
@@ -142,14 +159,37 @@ func (app *App) registerComponents() {
 
 	// Register repositories
 	app.registerComponent(projects.New).Arg(app.PostgresPool)
+	app.registerComponent(projectkeys.New).Arg(app.PostgresPool)
+	app.registerComponent(inboundfilters.New).Arg(app.PostgresPool)
+	app.registerComponent(projectratelimits.New).Arg(app.PostgresPool)
+	app.registerComponent(projectspikes.New).Arg(app.PostgresPool)
+	app.registerComponent(teams.New).Arg(app.PostgresPool)
+	app.registerComponent(users.New).Arg(app.PostgresPool)
+	app.registerComponent(issues.New).Arg(app.PostgresPool)
+	app.registerComponent(notificationsqueue.New).Arg(app.PostgresPool)
+	app.registerComponent(usernotifications.New).Arg(app.PostgresPool)
+
+	// Register outcomes recorder
+	outcomesProducer := kafka.NewTopicProducer(app.KafkaAsyncProducer, domain.OutcomesKafkaTopic)
+	app.registerComponent(outcomes.NewRecorder).Arg(outcomesProducer)
 
 	// Register use cases
 	app.registerComponent(projectsusecase.New)
 	app.registerComponent(envelopeusecase.New)
 	app.registerComponent(storeeventusecase.New)
+	app.registerComponent(checkinusecase.New)
+	app.registerComponent(spikesusecase.New)
 
 	// Register services
 	app.registerComponent(envelopequeueproducer.New)
+	app.registerComponent(ratelimiter.New).Arg(app.RedisClient)
+	app.registerComponent(projectslistener.New).Arg(app.PostgresPool)
+
+	// Nothing depends on the listener, resolve it to be started with the app
+	var projectsListener *projectslistener.Service
+	if err := app.container.Resolve(&projectsListener); err != nil {
+		panic(err)
+	}
 
 	var topicProducerCreator *kafka.TopicProducerCreator
 	if err := app.container.Resolve(&topicProducerCreator); err != nil {
@@ -190,6 +230,13 @@ func (app *App) newAPIServer(ctx context.Context) (*httpserver.Server, error) {
 		RPSWindow:            app.Config.RateLimit.RPSWindow,
 		StatsRefreshInterval: app.Config.RateLimit.StatsRefreshInterval,
 		RateLimit:            app.Config.RateLimit.RateLimit,
+		SpikeProtection: middlewares.SpikeProtectionConfig{
+			Enabled:         app.Config.SpikeProtection.Enabled,
+			Multiplier:      app.Config.SpikeProtection.Multiplier,
+			MinRPS:          app.Config.SpikeProtection.MinRPS,
+			Duration:        app.Config.SpikeProtection.Duration,
+			BaselineWindows: app.Config.SpikeProtection.BaselineWindows,
+		},
 	}
 
 	// Get the project repository from the DI container
@@ -198,15 +245,60 @@ func (app *App) newAPIServer(ctx context.Context) (*httpserver.Server, error) {
 		return nil, fmt.Errorf("resolve projects repository: %w", err)
 	}
 
+	var outcomeRecorder contract.OutcomeRecorder
+	if err := app.container.Resolve(&outcomeRecorder); err != nil {
+		return nil, fmt.Errorf("resolve outcome recorder: %w", err)
+	}
+
+	var projectsUseCase contract.ProjectsUseCase
+	if err := app.container.Resolve(&projectsUseCase); err != nil {
+		return nil, fmt.Errorf("resolve projects use case: %w", err)
+	}
+
+	var spikesUseCase contract.SpikesUseCase
+	if err := app.container.Resolve(&spikesUseCase); err != nil {
+		return nil, fmt.Errorf("resolve spikes use case: %w", err)
+	}
+
 	// Create the adaptive throttle middleware
-	adaptiveThrottleResult := middlewares.AdaptiveThrottle(ctx, app.RedisClient, projectsRepo, adaptiveThrottleConfig)
+	adaptiveThrottleResult := middlewares.AdaptiveThrottle(
+		ctx,
+		app.RedisClient,
+		projectsRepo,
+		outcomeRecorder,
+		spikesUseCase,
+		adaptiveThrottleConfig,
+	)
+
+	trustedProxies := make([]netip.Prefix, 0, len(app.Config.InboundFilters.TrustedProxies))
+	for _, value := range app.Config.InboundFilters.TrustedProxies {
+		prefix, err := commoninboundfilters.ParseIPRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse trusted proxy %q: %w", value, err)
+		}
+
+		trustedProxies = append(trustedProxies, prefix)
+	}
+
+	// Create the allowed origins and inbound filters middleware
+	inboundFilters := middlewares.InboundFilters(projectsUseCase, outcomeRecorder, trustedProxies)
+
+	// Create the request body decompression middleware
+	decompress := middlewares.Decompress(app.Config.Decompression.MaxSize, outcomeRecorder)
 
 	// Middleware chain:
-	// CORS → ProjectID → AdaptiveThrottle → API implementation
+	// CORS → ProjectID → RateLimitHeaders → InboundFilters → AdaptiveThrottle → Decompress →
+	// API implementation
 	handler := pkgmiddlewares.CORSMdw(
 		middlewares.WithProjectID(
-			adaptiveThrottleResult.Handler(
-				genServer,
+			middlewares.RateLimitHeaders(
+				inboundFilters(
+					adaptiveThrottleResult.Handler(
+						decompress(
+							genServer,
+						),
+					),
+				),
 			),
 		),
 	)
@@ -15,13 +15,16 @@ const (
 )
 
 type Config struct {
-	Logger     commonconfig.Logger   `envconfig:"LOGGER"`
-	APIServer  commonconfig.Server   `envconfig:"API_SERVER"`
-	TechServer commonconfig.Server   `envconfig:"TECH_SERVER"`
-	Postgres   commonconfig.Postgres `envconfig:"POSTGRES"`
-	Kafka      commonconfig.Kafka    `envconfig:"KAFKA"`
-	Redis      commonconfig.Redis    `envconfig:"REDIS"`
-	RateLimit  RateLimit             `envconfig:"RATE_LIMIT"`
+	Logger          commonconfig.Logger   `envconfig:"LOGGER"`
+	APIServer       commonconfig.Server   `envconfig:"API_SERVER"`
+	TechServer      commonconfig.Server   `envconfig:"TECH_SERVER"`
+	Postgres        commonconfig.Postgres `envconfig:"POSTGRES"`
+	Kafka           commonconfig.Kafka    `envconfig:"KAFKA"`
+	Redis           commonconfig.Redis    `envconfig:"REDIS"`
+	RateLimit       RateLimit             `envconfig:"RATE_LIMIT"`
+	SpikeProtection SpikeProtection       `envconfig:"SPIKE_PROTECTION"`
+	Decompression   Decompression         `envconfig:"DECOMPRESSION"`
+	InboundFilters  InboundFilters        `envconfig:"INBOUND_FILTERS"`
 }
 
 type RateLimit struct {
@@ -33,6 +36,28 @@ type RateLimit struct {
 	RateLimit uint64 `default:"100" envconfig:"RATE_LIMIT"`
 }
 
+type SpikeProtection struct {
+	Enabled bool `default:"false" envconfig:"ENABLED"`
+	// A project is capped when its RPS is over its baseline RPS multiplied by the multiplier
+	Multiplier float64 `default:"10" envconfig:"MULTIPLIER"`
+	// RPS under which a project is never capped
+	MinRPS uint64 `default:"20" envconfig:"MIN_RPS"`
+	// How long a project stays capped
+	Duration time.Duration `default:"10m" envconfig:"DURATION"`
+	// Number of RPS windows the baseline is averaged over
+	BaselineWindows uint `default:"30" envconfig:"BASELINE_WINDOWS"`
+}
+
+type Decompression struct {
+	// Maximum size of a decompressed request body in bytes, it protects against zip bombs
+	MaxSize int64 `default:"209715200" envconfig:"MAX_SIZE"` // 200 MB
+}
+
+type InboundFilters struct {
+	// Addresses and CIDR ranges of the reverse proxies, X-Forwarded-For and X-Real-IP are trusted only from them
+	TrustedProxies []string `envconfig:"TRUSTED_PROXIES"`
+}
+
 func New(filePath string) (*Config, error) {
 	cfg := &Config{}
 
@@ -2,8 +2,11 @@ package contract
 
 import (
 	"context"
+	"encoding/json"
 	"io"
+	"time"
 
+	"github.com/rom8726/warden/internal/common/inboundfilters"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/pkg/kafka"
 )
@@ -20,12 +23,58 @@ type StoreEventUseCase interface {
 	) (domain.EventID, error)
 }
 
+// CheckInUseCase accepts check-ins of cron monitors sent over plain HTTP.
+type CheckInUseCase interface {
+	CheckIn(ctx context.Context, projectID domain.ProjectID, data map[string]any) (string, error)
+}
+
 type ProjectsUseCase interface {
-	ValidateProjectKey(
+	// AuthenticateKey checks the client key of the project, the secret key is verified when given.
+	// Fails with domain.ErrInvalidProjectKey for unknown and disabled keys
+	// and with domain.ErrProjectKeyRateLimited for keys over their rate limit.
+	AuthenticateKey(
 		ctx context.Context,
 		projectID domain.ProjectID,
-		key string,
-	) (bool, error)
+		publicKey string,
+		secretKey string,
+	) (domain.ProjectKey, error)
+	// InboundFilters returns the compiled inbound filters of the project.
+	InboundFilters(ctx context.Context, projectID domain.ProjectID) (*inboundfilters.Filters, error)
+	// CheckQuotas counts the items of a request by category against the key and project rate limits,
+	// it returns the outcome reasons of the categories over their quotas.
+	CheckQuotas(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		key domain.ProjectKey,
+		quantities map[domain.DataCategory]uint,
+	) map[domain.DataCategory]string
+}
+
+// ProjectsCache drops cached client keys, inbound filters and rate limits once they are changed.
+type ProjectsCache interface {
+	InvalidateKey(projectID domain.ProjectID, publicKey string)
+	InvalidateFilters(projectID domain.ProjectID)
+	InvalidateRateLimits(projectID domain.ProjectID)
+	InvalidateAll()
+}
+
+// RateLimiter enforces per-minute quotas of projects and keys shared by all ingest servers.
+type RateLimiter interface {
+	// Allow counts the quantity of the category, the empty category is all data of the scope.
+	// It returns the time until the quota is reset when the limit is exceeded.
+	Allow(
+		ctx context.Context,
+		scope domain.RateLimitScope,
+		scopeID uint,
+		category domain.DataCategory,
+		limit uint,
+		quantity uint,
+	) (bool, time.Duration, error)
+}
+
+// SpikesUseCase records the spikes capped by the spike protection and alerts about them.
+type SpikesUseCase interface {
+	RecordSpike(ctx context.Context, spike domain.ProjectSpikeDTO) error
 }
 
 type EnvelopProducer interface {
@@ -42,8 +91,70 @@ type EventProducer interface {
 }
 
 type ProjectsRepository interface {
-	ValidateProjectKey(ctx context.Context, projectID domain.ProjectID, key string) (bool, error)
 	GetProjectIDs(ctx context.Context) ([]domain.ProjectID, error)
+	GetByID(ctx context.Context, id domain.ProjectID) (domain.Project, error)
+}
+
+type ProjectKeysRepository interface {
+	GetActive(ctx context.Context, projectID domain.ProjectID, publicKey string) (domain.ProjectKey, error)
+	TouchLastUsed(ctx context.Context, id domain.ProjectKeyID, usedAt time.Time) error
+}
+
+type InboundFiltersRepository interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error)
+}
+
+type ProjectRateLimitsRepository interface {
+	Get(ctx context.Context, projectID domain.ProjectID) (domain.ProjectRateLimits, error)
+}
+
+type ProjectSpikesRepository interface {
+	Create(ctx context.Context, spikeDTO domain.ProjectSpikeDTO) (domain.ProjectSpike, error)
+	GetLast(ctx context.Context, projectID domain.ProjectID) (domain.ProjectSpike, error)
+	Extend(ctx context.Context, id domain.ProjectSpikeID, endsAt time.Time, peakRPS uint64) error
+}
+
+type TeamsRepository interface {
+	GetMembers(ctx context.Context, teamID domain.TeamID) ([]domain.TeamMember, error)
+}
+
+type UsersRepository interface {
+	List(ctx context.Context) ([]domain.User, error)
+}
+
+type IssuesRepository interface {
+	UpsertIssue(ctx context.Context, issue domain.IssueDTO) (domain.IssueUpsertResult, error)
+}
+
+type NotificationsQueueRepository interface {
+	AddNotification(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		issueID domain.IssueID,
+		level domain.IssueLevel,
+		isNew, wasReactivated bool,
+		environment string,
+	) error
+}
+
+type UserNotificationsRepository interface {
+	Create(
+		ctx context.Context,
+		userID domain.UserID,
+		notificationType domain.UserNotificationType,
+		content json.RawMessage,
+	) (domain.UserNotification, error)
+}
+
+// OutcomeRecorder accounts data dropped before reaching the processing pipeline.
+type OutcomeRecorder interface {
+	Record(
+		projectID domain.ProjectID,
+		outcome domain.Outcome,
+		reason string,
+		category domain.DataCategory,
+		quantity uint,
+	)
 }
 
 type TopicProducerCreator interface {
@@ -0,0 +1,152 @@
+package projectslistener
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/ingest-server/contract"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Service listens to the project key, inbound filter and rate limit change notifications sent
+// by the database and drops the changed entries from the cache of the ingest server.
+type Service struct {
+	pool  *pgxpool.Pool
+	cache contract.ProjectsCache
+
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+func New(pool *pgxpool.Pool, cache contract.ProjectsCache) *Service {
+	return &Service{
+		pool:    pool,
+		cache:   cache,
+		stopped: make(chan struct{}),
+	}
+}
+
+func (s *Service) Start(context.Context) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	go func() {
+		defer close(s.stopped)
+
+		delay := minReconnectDelay
+		for {
+			err := s.listen(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+
+			slog.Error("Projects listener disconnected", "error", err, "retry_in", delay)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			delay = min(delay*2, maxReconnectDelay)
+		}
+	}()
+
+	return nil
+}
+
+func (s *Service) Stop(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+		<-s.stopped
+	}
+
+	return nil
+}
+
+func (s *Service) listen(ctx context.Context) error {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	channels := []string{
+		domain.ProjectKeysChangedChannel,
+		domain.ProjectFiltersChangedChannel,
+		domain.ProjectRateLimitsChangedChannel,
+	}
+	for _, channel := range channels {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return err
+		}
+	}
+
+	// Notifications sent while disconnected are lost, start over with an empty cache.
+	s.cache.InvalidateAll()
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.handle(notification.Channel, notification.Payload)
+	}
+}
+
+func (s *Service) handle(channel, payload string) {
+	switch channel {
+	case domain.ProjectKeysChangedChannel:
+		projectID, publicKey, err := parseKeyPayload(payload)
+		if err != nil {
+			slog.Error("Invalid project key notification", "payload", payload, "error", err)
+
+			return
+		}
+
+		slog.Debug("Project key changed", "project_id", projectID)
+		s.cache.InvalidateKey(projectID, publicKey)
+	case domain.ProjectFiltersChangedChannel, domain.ProjectRateLimitsChangedChannel:
+		projectID, err := strconv.ParseUint(payload, 10, 64)
+		if err != nil {
+			slog.Error("Invalid project notification", "channel", channel, "payload", payload, "error", err)
+
+			return
+		}
+
+		slog.Debug("Project settings changed", "channel", channel, "project_id", projectID)
+		if channel == domain.ProjectFiltersChangedChannel {
+			s.cache.InvalidateFilters(domain.ProjectID(projectID))
+		} else {
+			s.cache.InvalidateRateLimits(domain.ProjectID(projectID))
+		}
+	}
+}
+
+// parseKeyPayload parses the "<project_id>:<public_key>" notification payload.
+func parseKeyPayload(payload string) (domain.ProjectID, string, error) {
+	rawProjectID, publicKey, found := strings.Cut(payload, ":")
+	if !found || publicKey == "" {
+		return 0, "", errors.New("unexpected payload format")
+	}
+
+	projectID, err := strconv.ParseUint(rawProjectID, 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return domain.ProjectID(projectID), publicKey, nil
+}
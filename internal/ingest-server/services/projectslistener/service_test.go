@@ -0,0 +1,23 @@
+package projectslistener
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+func TestParseKeyPayload(t *testing.T) {
+	t.Parallel()
+
+	projectID, publicKey, err := parseKeyPayload("42:0123abcd")
+	require.NoError(t, err)
+	require.Equal(t, domain.ProjectID(42), projectID)
+	require.Equal(t, "0123abcd", publicKey)
+
+	for _, payload := range []string{"", "42", "42:", "abc:0123abcd"} {
+		_, _, err := parseKeyPayload(payload)
+		require.Error(t, err, payload)
+	}
+}
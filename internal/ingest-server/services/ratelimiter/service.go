@@ -0,0 +1,68 @@
+package ratelimiter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const window = time.Minute
+
+// incrScript increments a counter by the quantity and sets its TTL if it's the first increment.
+var incrScript = redis.NewScript(`
+	local current = redis.call("INCRBY", KEYS[1], tonumber(ARGV[1]))
+	if current == tonumber(ARGV[1]) then
+		redis.call("EXPIRE", KEYS[1], tonumber(ARGV[2]))
+	end
+	return current
+`)
+
+// Service counts the data of projects and keys in fixed one minute windows,
+// the counters are kept in Redis to be shared by all ingest servers.
+type Service struct {
+	redisClient *redis.Client
+}
+
+func New(redisClient *redis.Client) *Service {
+	return &Service{
+		redisClient: redisClient,
+	}
+}
+
+func (s *Service) Allow(
+	ctx context.Context,
+	scope domain.RateLimitScope,
+	scopeID uint,
+	category domain.DataCategory,
+	limit uint,
+	quantity uint,
+) (bool, time.Duration, error) {
+	now := time.Now()
+	batch := now.Unix() / int64(window.Seconds())
+
+	categoryKey := string(category)
+	if categoryKey == "" {
+		categoryKey = "all"
+	}
+
+	counterKey := "ratelimit:" + string(scope) + ":" + strconv.FormatUint(uint64(scopeID), 10) + ":" +
+		categoryKey + ":" + strconv.FormatInt(batch, 10)
+
+	count, err := incrScript.Run(ctx, s.redisClient, []string{counterKey}, quantity, int(window.Seconds()*2)).Int64()
+	if err != nil {
+		return false, 0, fmt.Errorf("increment rate limit counter: %w", err)
+	}
+
+	if count <= int64(limit) {
+		return true, 0, nil
+	}
+
+	windowEnd := time.Unix((batch+1)*int64(window.Seconds()), 0)
+
+	return false, windowEnd.Sub(now), nil
+}
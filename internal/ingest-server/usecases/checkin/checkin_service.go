@@ -0,0 +1,70 @@
+package checkin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	eventcommon "github.com/rom8726/warden/internal/common/event"
+	"github.com/rom8726/warden/internal/common/monitors"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/ingest-server/contract"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+type CheckInService struct {
+	envelopProducer contract.EnvelopProducer
+}
+
+func New(envelopProducer contract.EnvelopProducer) *CheckInService {
+	return &CheckInService{
+		envelopProducer: envelopProducer,
+	}
+}
+
+// CheckIn validates the check-in and sends it to Kafka as a check_in envelope item,
+// so it's processed the same way as check-ins sent by SDKs. It returns the check-in id.
+func (s *CheckInService) CheckIn(ctx context.Context, projectID domain.ProjectID, data map[string]any) (string, error) {
+	start := time.Now()
+
+	checkIn, err := eventcommon.ParseCheckIn(data)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidCheckIn, err)
+	}
+
+	if checkIn.MonitorConfig != nil {
+		if err := monitors.ValidateConfig(*checkIn.MonitorConfig); err != nil {
+			return "", err
+		}
+	}
+
+	// The generated id is returned to finish the in progress check-in later
+	data["check_in_id"] = checkIn.ID
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("marshal check-in: %w", err)
+	}
+
+	itemHeader, err := json.Marshal(map[string]any{"type": "check_in", "length": len(payload)})
+	if err != nil {
+		return "", fmt.Errorf("marshal item header: %w", err)
+	}
+
+	envelope := make([]byte, 0, len(itemHeader)+len(payload)+4)
+	envelope = append(envelope, "{}\n"...)
+	envelope = append(envelope, itemHeader...)
+	envelope = append(envelope, '\n')
+	envelope = append(envelope, payload...)
+
+	if err := s.envelopProducer.SendEnvelope(ctx, projectID, envelope); err != nil {
+		metrics.EnvelopeProcessingErrors.WithLabelValues("kafka_send_failed").Inc()
+
+		return "", fmt.Errorf("failed to send check-in to Kafka: %w", err)
+	}
+
+	metrics.EnvelopeProcessingDuration.WithLabelValues("check_in").Observe(time.Since(start).Seconds())
+
+	return checkIn.ID, nil
+}
@@ -0,0 +1,59 @@
+package checkin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/ingest-server/contract"
+)
+
+func TestCheckIn_Success(t *testing.T) {
+	t.Parallel()
+
+	mockProducer := mockcontract.NewMockEnvelopProducer(t)
+	mockProducer.EXPECT().
+		SendEnvelope(mock.Anything, domain.ProjectID(1), mock.MatchedBy(func(data []byte) bool {
+			lines := bytes.Split(data, []byte("\n"))
+			if len(lines) != 3 {
+				return false
+			}
+
+			var header map[string]any
+			if err := json.Unmarshal(lines[1], &header); err != nil {
+				return false
+			}
+
+			return header["type"] == "check_in" && int(header["length"].(float64)) == len(lines[2])
+		})).
+		Return(nil)
+
+	service := New(mockProducer)
+
+	id, err := service.CheckIn(context.Background(), 1, map[string]any{
+		"monitor_slug": "nightly",
+		"status":       "in_progress",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+}
+
+func TestCheckIn_InvalidMonitorConfig(t *testing.T) {
+	t.Parallel()
+
+	service := New(mockcontract.NewMockEnvelopProducer(t))
+
+	_, err := service.CheckIn(context.Background(), 1, map[string]any{
+		"monitor_slug": "nightly",
+		"status":       "ok",
+		"monitor_config": map[string]any{
+			"schedule": map[string]any{"type": "crontab", "value": "not a crontab"},
+		},
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidMonitorConfig)
+}
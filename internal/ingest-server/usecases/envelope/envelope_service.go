@@ -15,13 +15,19 @@ import (
 
 type EnvelopeService struct {
 	envelopProducer contract.EnvelopProducer
+	projects        contract.ProjectsUseCase
+	outcomes        contract.OutcomeRecorder
 }
 
 func New(
 	envelopProducer contract.EnvelopProducer,
+	projects contract.ProjectsUseCase,
+	outcomes contract.OutcomeRecorder,
 ) *EnvelopeService {
 	return &EnvelopeService{
 		envelopProducer: envelopProducer,
+		projects:        projects,
+		outcomes:        outcomes,
 	}
 }
 
@@ -42,7 +48,20 @@ func (s *EnvelopeService) ReceiveEnvelope(ctx context.Context, projectID domain.
 		return errors.New("empty envelope")
 	}
 
-	// Send an envelope to Kafka (Fire-and-Forget) - no need to parse header here
+	// Malformed envelopes are sent as is to be accounted by the consumer
+	if header, items, err := parseEnvelope(dataBytes); err == nil && len(items) > 0 {
+		dataBytes, err = s.applyProjectSettings(ctx, projectID, dataBytes, header, items)
+		if errors.Is(err, errEnvelopeFiltered) {
+			slog.Debug("Envelope filtered", "project_id", projectID)
+
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Send an envelope to Kafka (Fire-and-Forget)
 	if err := s.envelopProducer.SendEnvelope(ctx, projectID, dataBytes); err != nil {
 		slog.Error("Failed to send envelope to Kafka", "error", err)
 		metrics.EnvelopeProcessingErrors.WithLabelValues("kafka_send_failed").Inc()
@@ -62,3 +81,37 @@ func (s *EnvelopeService) ReceiveEnvelope(ctx context.Context, projectID domain.
 
 	return nil
 }
+
+// applyProjectSettings drops the items rejected by the inbound filters and rate limits of the project.
+// It fails with errEnvelopeFiltered when the envelope was filtered out
+// and with domain.ErrRateLimited when nothing is left under the quotas.
+func (s *EnvelopeService) applyProjectSettings(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	data []byte,
+	header []byte,
+	items []envelopeItem,
+) ([]byte, error) {
+	kept := items
+
+	filters, err := s.projects.InboundFilters(ctx, projectID)
+	if err != nil {
+		slog.Error("Failed to get inbound filters", "error", err, "project_id", projectID)
+	} else if filters.HasEventFilters() {
+		kept = s.applyEventFilters(projectID, filters, header, kept)
+		if len(kept) == 0 {
+			return nil, errEnvelopeFiltered
+		}
+	}
+
+	kept = s.applyQuotas(ctx, projectID, kept)
+	if len(kept) == 0 {
+		return nil, domain.ErrRateLimited
+	}
+
+	if len(kept) == len(items) {
+		return data, nil
+	}
+
+	return buildEnvelope(header, kept), nil
+}
@@ -9,10 +9,47 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/rom8726/warden/internal/common/inboundfilters"
 	"github.com/rom8726/warden/internal/domain"
 	mockcontract "github.com/rom8726/warden/test_mocks/internal_/ingest-server/contract"
 )
 
+func projectFilters(t *testing.T, filters domain.InboundFilters) *mockcontract.MockProjectsUseCase {
+	t.Helper()
+
+	compiled, err := inboundfilters.Compile(filters)
+	require.NoError(t, err)
+
+	projects := mockcontract.NewMockProjectsUseCase(t)
+	projects.EXPECT().InboundFilters(mock.Anything, mock.Anything).Return(compiled, nil).Maybe()
+	projects.EXPECT().CheckQuotas(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return projects
+}
+
+func projectQuotas(
+	t *testing.T,
+	quantities map[domain.DataCategory]uint,
+	limited map[domain.DataCategory]string,
+) *mockcontract.MockProjectsUseCase {
+	t.Helper()
+
+	compiled, err := inboundfilters.Compile(domain.InboundFilters{})
+	require.NoError(t, err)
+
+	projects := mockcontract.NewMockProjectsUseCase(t)
+	projects.EXPECT().InboundFilters(mock.Anything, mock.Anything).Return(compiled, nil)
+	projects.EXPECT().CheckQuotas(mock.Anything, domain.ProjectID(1), domain.ProjectKey{}, quantities).Return(limited)
+
+	return projects
+}
+
+func noFilters(t *testing.T) *mockcontract.MockProjectsUseCase {
+	t.Helper()
+
+	return projectFilters(t, domain.InboundFilters{})
+}
+
 func TestReceiveEnvelope_Success(t *testing.T) {
 	t.Parallel()
 
@@ -24,7 +61,7 @@ func TestReceiveEnvelope_Success(t *testing.T) {
 		Return(nil)
 
 	// Create a new EnvelopeService
-	service := New(mockEnvelopProducer)
+	service := New(mockEnvelopProducer, noFilters(t), mockcontract.NewMockOutcomeRecorder(t))
 
 	// Create a reader with valid envelope data
 	envelopeData := `{"version": "1.0"}
@@ -55,7 +92,7 @@ func TestReceiveEnvelope_ProducerError(t *testing.T) {
 		Return(expectedError)
 
 	// Create a new EnvelopeService
-	service := New(mockEnvelopProducer)
+	service := New(mockEnvelopProducer, noFilters(t), mockcontract.NewMockOutcomeRecorder(t))
 
 	// Create a reader with valid envelope data
 	envelopeData := `{"version": "1.0"}
@@ -81,7 +118,7 @@ func TestReceiveEnvelope_ReadError(t *testing.T) {
 	mockEnvelopProducer := mockcontract.NewMockEnvelopProducer(t)
 
 	// Create a new EnvelopeService
-	service := New(mockEnvelopProducer)
+	service := New(mockEnvelopProducer, noFilters(t), mockcontract.NewMockOutcomeRecorder(t))
 
 	// Create a reader that will cause an error (closed reader)
 	reader := strings.NewReader("")
@@ -99,3 +136,198 @@ func TestReceiveEnvelope_ReadError(t *testing.T) {
 	// Verify the mock was not called
 	mockEnvelopProducer.AssertNotCalled(t, "SendEnvelope")
 }
+
+func TestReceiveEnvelope_FilteredEvent(t *testing.T) {
+	t.Parallel()
+
+	mockEnvelopProducer := mockcontract.NewMockEnvelopProducer(t)
+	outcomes := mockcontract.NewMockOutcomeRecorder(t)
+	projects := projectFilters(t, domain.InboundFilters{ErrorMessages: []string{"*ResizeObserver*"}})
+
+	// The event and its attachment are dropped, the session is kept
+	outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeFiltered, "error_message",
+		domain.DataCategoryError, uint(1)).Return().Once()
+	outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeFiltered, "error_message",
+		domain.DataCategoryAttachment, uint(1)).Return().Once()
+	mockEnvelopProducer.EXPECT().SendEnvelope(mock.Anything, domain.ProjectID(1), []byte(`{"event_id":"1"}
+{"type":"session","length":13}
+{"sid":"abc"}
+`)).Return(nil)
+
+	envelopeData := `{"event_id":"1"}
+{"type":"event","length":40}
+{"message":"ResizeObserver loop failed"}
+{"type":"attachment","length":5,"filename":"a.txt"}
+hello
+{"type":"session","length":13}
+{"sid":"abc"}
+`
+
+	service := New(mockEnvelopProducer, projects, outcomes)
+	err := service.ReceiveEnvelope(context.Background(), 1, strings.NewReader(envelopeData))
+	require.NoError(t, err)
+}
+
+func TestReceiveEnvelope_FilteredItemOfMixedEnvelope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		filters      domain.InboundFilters
+		reason       string
+		envelope     string
+		expectedSent string
+		expectedDrop []domain.DataCategory
+	}{
+		{
+			name:    "filtered event drops its attachment and user report",
+			filters: domain.InboundFilters{ErrorMessages: []string{"*ResizeObserver*"}},
+			reason:  "error_message",
+			envelope: `{"event_id":"9ec79c33-ec99-42ab-8353-589fcb2e04dc"}
+{"type":"event","length":86}
+{"event_id":"9ec79c33ec9942ab8353589fcb2e04dc","message":"ResizeObserver loop failed"}
+{"type":"attachment","length":5,"filename":"a.txt"}
+hello
+{"type":"user_report","length":58}
+{"event_id":"9ec79c33ec9942ab8353589fcb2e04dc","name":"a"}
+{"type":"transaction","length":44}
+{"event_id":"b2","transaction":"GET /users"}
+{"type":"user_report","length":28}
+{"event_id":"b2","name":"b"}
+`,
+			expectedSent: `{"event_id":"9ec79c33-ec99-42ab-8353-589fcb2e04dc"}
+{"type":"transaction","length":44}
+{"event_id":"b2","transaction":"GET /users"}
+{"type":"user_report","length":28}
+{"event_id":"b2","name":"b"}
+`,
+			expectedDrop: []domain.DataCategory{domain.DataCategoryError, domain.DataCategoryAttachment},
+		},
+		{
+			name:    "filtered transaction keeps the event with its attachment",
+			filters: domain.InboundFilters{Releases: []string{"*-dev"}},
+			reason:  "release_version",
+			envelope: `{"event_id":"a1"}
+{"type":"event","length":35}
+{"event_id":"a1","release":"1.2.0"}
+{"type":"transaction","length":39}
+{"event_id":"b2","release":"1.2.0-dev"}
+{"type":"attachment","length":5,"filename":"a.txt"}
+hello
+`,
+			expectedSent: `{"event_id":"a1"}
+{"type":"event","length":35}
+{"event_id":"a1","release":"1.2.0"}
+{"type":"attachment","length":5,"filename":"a.txt"}
+hello
+`,
+			expectedDrop: []domain.DataCategory{domain.DataCategoryTransaction},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockEnvelopProducer := mockcontract.NewMockEnvelopProducer(t)
+			outcomes := mockcontract.NewMockOutcomeRecorder(t)
+			projects := projectFilters(t, tt.filters)
+
+			for _, category := range tt.expectedDrop {
+				outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeFiltered, tt.reason, category, uint(1)).
+					Return().Once()
+			}
+			mockEnvelopProducer.EXPECT().SendEnvelope(mock.Anything, domain.ProjectID(1), []byte(tt.expectedSent)).
+				Return(nil)
+
+			service := New(mockEnvelopProducer, projects, outcomes)
+			err := service.ReceiveEnvelope(context.Background(), 1, strings.NewReader(tt.envelope))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReceiveEnvelope_FilteredEnvelopeNotSent(t *testing.T) {
+	t.Parallel()
+
+	mockEnvelopProducer := mockcontract.NewMockEnvelopProducer(t)
+	outcomes := mockcontract.NewMockOutcomeRecorder(t)
+	projects := projectFilters(t, domain.InboundFilters{Releases: []string{"*-dev"}})
+
+	outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeFiltered, "release_version",
+		domain.DataCategoryTransaction, uint(1)).Return().Once()
+
+	envelopeData := `{"event_id":"1"}
+{"type":"transaction"}
+{"release":"1.2.0-dev"}
+`
+
+	service := New(mockEnvelopProducer, projects, outcomes)
+	err := service.ReceiveEnvelope(context.Background(), 1, strings.NewReader(envelopeData))
+	require.NoError(t, err)
+	mockEnvelopProducer.AssertNotCalled(t, "SendEnvelope")
+}
+
+func TestReceiveEnvelope_RateLimitedItems(t *testing.T) {
+	t.Parallel()
+
+	mockEnvelopProducer := mockcontract.NewMockEnvelopProducer(t)
+	outcomes := mockcontract.NewMockOutcomeRecorder(t)
+	projects := projectQuotas(t,
+		map[domain.DataCategory]uint{
+			domain.DataCategoryError:      1,
+			domain.DataCategoryAttachment: 1,
+			domain.DataCategorySession:    1,
+		},
+		map[domain.DataCategory]string{domain.DataCategoryError: domain.OutcomeReasonKeyRateLimit},
+	)
+
+	// The event is over the quota, its attachment and user report are dropped with it
+	outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeRateLimited, domain.OutcomeReasonKeyRateLimit,
+		domain.DataCategoryError, uint(1)).Return().Once()
+	outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeRateLimited, domain.OutcomeReasonKeyRateLimit,
+		domain.DataCategoryAttachment, uint(1)).Return().Once()
+	mockEnvelopProducer.EXPECT().SendEnvelope(mock.Anything, domain.ProjectID(1), []byte(`{"event_id":"1"}
+{"type":"session","length":13}
+{"sid":"abc"}
+`)).Return(nil)
+
+	envelopeData := `{"event_id":"1"}
+{"type":"event","length":18}
+{"message":"boom"}
+{"type":"attachment","length":5,"filename":"a.txt"}
+hello
+{"type":"user_report","length":2}
+{}
+{"type":"session","length":13}
+{"sid":"abc"}
+`
+
+	service := New(mockEnvelopProducer, projects, outcomes)
+	err := service.ReceiveEnvelope(context.Background(), 1, strings.NewReader(envelopeData))
+	require.NoError(t, err)
+}
+
+func TestReceiveEnvelope_RateLimitedEnvelope(t *testing.T) {
+	t.Parallel()
+
+	mockEnvelopProducer := mockcontract.NewMockEnvelopProducer(t)
+	outcomes := mockcontract.NewMockOutcomeRecorder(t)
+	projects := projectQuotas(t,
+		map[domain.DataCategory]uint{domain.DataCategoryTransaction: 1},
+		map[domain.DataCategory]string{domain.DataCategoryTransaction: domain.OutcomeReasonProjectRateLimit},
+	)
+
+	outcomes.EXPECT().Record(domain.ProjectID(1), domain.OutcomeRateLimited, domain.OutcomeReasonProjectRateLimit,
+		domain.DataCategoryTransaction, uint(1)).Return().Once()
+
+	envelopeData := `{"event_id":"1"}
+{"type":"transaction"}
+{"transaction":"GET /"}
+`
+
+	service := New(mockEnvelopProducer, projects, outcomes)
+	err := service.ReceiveEnvelope(context.Background(), 1, strings.NewReader(envelopeData))
+	require.ErrorIs(t, err, domain.ErrRateLimited)
+	mockEnvelopProducer.AssertNotCalled(t, "SendEnvelope")
+}
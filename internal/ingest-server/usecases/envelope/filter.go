@@ -0,0 +1,182 @@
+package envelope
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/rom8726/warden/internal/common/inboundfilters"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+var (
+	errMalformedEnvelope = errors.New("malformed envelope")
+	errEnvelopeFiltered  = errors.New("envelope filtered")
+)
+
+type envelopeItem struct {
+	header   []byte
+	itemType string
+	payload  []byte
+}
+
+// itemCategories are the outcome categories of the items dropped by the inbound filters,
+// user reports are dropped without an outcome.
+var itemCategories = map[string]domain.DataCategory{
+	"event":       domain.DataCategoryError,
+	"transaction": domain.DataCategoryTransaction,
+	"attachment":  domain.DataCategoryAttachment,
+	"user_report": "",
+}
+
+// applyEventFilters drops the filtered events and transactions from the envelope items together with
+// the attachments and user reports linked to them, the other items are kept. Attachments belong to
+// the event of the envelope header, user reports to the event of their payload.
+func (s *EnvelopeService) applyEventFilters(
+	projectID domain.ProjectID,
+	filters *inboundfilters.Filters,
+	header []byte,
+	items []envelopeItem,
+) []envelopeItem {
+	envelopeEventID := payloadEventID(header)
+
+	filtered := make(map[string]domain.InboundFilterReason)
+	dropped := make([]bool, len(items))
+	for i, item := range items {
+		if item.itemType != "event" && item.itemType != "transaction" {
+			continue
+		}
+
+		var payload map[string]any
+		if err := json.Unmarshal(item.payload, &payload); err != nil {
+			continue
+		}
+
+		reason, isFiltered := filters.FilterEvent(payload)
+		if !isFiltered {
+			continue
+		}
+
+		eventID := payloadEventID(item.payload)
+		if eventID == "" {
+			eventID = envelopeEventID
+		}
+
+		filtered[eventID] = reason
+		dropped[i] = true
+		s.recordFiltered(projectID, reason, item.itemType)
+	}
+
+	if len(filtered) == 0 {
+		return items
+	}
+
+	kept := make([]envelopeItem, 0, len(items))
+	for i, item := range items {
+		if dropped[i] {
+			continue
+		}
+
+		if item.itemType == "attachment" || item.itemType == "user_report" {
+			linkedEventID := envelopeEventID
+			if item.itemType == "user_report" {
+				if eventID := payloadEventID(item.payload); eventID != "" {
+					linkedEventID = eventID
+				}
+			}
+
+			if reason, ok := filtered[linkedEventID]; ok {
+				s.recordFiltered(projectID, reason, item.itemType)
+
+				continue
+			}
+		}
+
+		kept = append(kept, item)
+	}
+
+	return kept
+}
+
+func (s *EnvelopeService) recordFiltered(
+	projectID domain.ProjectID,
+	reason domain.InboundFilterReason,
+	itemType string,
+) {
+	category := itemCategories[itemType]
+	if category == "" {
+		return
+	}
+
+	metrics.InboundFilterRejections.WithLabelValues(string(reason)).Inc()
+	s.outcomes.Record(projectID, domain.OutcomeFiltered, string(reason), category, 1)
+}
+
+// payloadEventID returns the event ID of the envelope header or item payload, normalized
+// as SDKs send it with or without dashes.
+func payloadEventID(data []byte) string {
+	var payload struct {
+		EventID string `json:"event_id"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return ""
+	}
+
+	return strings.ReplaceAll(strings.ToLower(payload.EventID), "-", "")
+}
+
+// parseEnvelope splits the envelope into its header and items. Item payloads are read by the length
+// of the item header, or up to the end of the line when there is no length.
+func parseEnvelope(data []byte) ([]byte, []envelopeItem, error) {
+	header, rest, found := bytes.Cut(data, []byte("\n"))
+	if !found && len(bytes.TrimSpace(header)) == 0 {
+		return nil, nil, errMalformedEnvelope
+	}
+
+	var items []envelopeItem
+	for len(bytes.TrimSpace(rest)) > 0 {
+		var itemHeader []byte
+		itemHeader, rest, _ = bytes.Cut(rest, []byte("\n"))
+
+		var meta struct {
+			Type   string `json:"type"`
+			Length *int   `json:"length"`
+		}
+		if err := json.Unmarshal(itemHeader, &meta); err != nil || meta.Type == "" {
+			return nil, nil, errMalformedEnvelope
+		}
+
+		var payload []byte
+		if meta.Length != nil {
+			if *meta.Length < 0 || *meta.Length > len(rest) {
+				return nil, nil, errMalformedEnvelope
+			}
+
+			payload, rest = rest[:*meta.Length], rest[*meta.Length:]
+			rest = bytes.TrimPrefix(rest, []byte("\n"))
+		} else {
+			payload, rest, _ = bytes.Cut(rest, []byte("\n"))
+		}
+
+		items = append(items, envelopeItem{header: itemHeader, itemType: meta.Type, payload: payload})
+	}
+
+	return header, items, nil
+}
+
+func buildEnvelope(header []byte, items []envelopeItem) []byte {
+	var buf bytes.Buffer
+	buf.Write(header)
+	buf.WriteByte('\n')
+
+	for _, item := range items {
+		buf.Write(item.header)
+		buf.WriteByte('\n')
+		buf.Write(item.payload)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,66 @@
+package envelope
+
+import (
+	"context"
+
+	wardencontext "github.com/rom8726/warden/internal/context"
+	"github.com/rom8726/warden/internal/domain"
+)
+
+// quotaCategories are the rate limit categories of the envelope item types.
+var quotaCategories = map[string]domain.DataCategory{
+	"event":       domain.DataCategoryError,
+	"transaction": domain.DataCategoryTransaction,
+	"attachment":  domain.DataCategoryAttachment,
+	"session":     domain.DataCategorySession,
+	"sessions":    domain.DataCategorySession,
+	"check_in":    domain.DataCategoryMonitor,
+}
+
+// applyQuotas drops the envelope items of the categories over the key or project rate limits.
+// Attachments and user reports are dropped together with a rate limited event.
+func (s *EnvelopeService) applyQuotas(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	items []envelopeItem,
+) []envelopeItem {
+	quantities := make(map[domain.DataCategory]uint)
+	for _, item := range items {
+		if category, ok := quotaCategories[item.itemType]; ok {
+			quantities[category]++
+		}
+	}
+
+	if len(quantities) == 0 {
+		return items
+	}
+
+	key, _ := wardencontext.ProjectKey(ctx)
+	limited := s.projects.CheckQuotas(ctx, projectID, key, quantities)
+	if len(limited) == 0 {
+		return items
+	}
+
+	errorReason, errorLimited := limited[domain.DataCategoryError]
+
+	kept := make([]envelopeItem, 0, len(items))
+	for _, item := range items {
+		category := quotaCategories[item.itemType]
+		reason, dropped := limited[category]
+		if !dropped && errorLimited && (item.itemType == "attachment" || item.itemType == "user_report") {
+			reason, dropped = errorReason, true
+		}
+
+		if !dropped {
+			kept = append(kept, item)
+
+			continue
+		}
+
+		if category != "" {
+			s.outcomes.Record(projectID, domain.OutcomeRateLimited, reason, category, 1)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,59 @@
+package projects
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type projectCacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// projectCache keeps per-project settings for the TTL, changes are applied by dropping the entries.
+type projectCache[V any] struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[domain.ProjectID]projectCacheEntry[V]
+}
+
+func newProjectCache[V any](ttl time.Duration) *projectCache[V] {
+	return &projectCache[V]{
+		ttl:     ttl,
+		entries: make(map[domain.ProjectID]projectCacheEntry[V]),
+	}
+}
+
+func (c *projectCache[V]) get(projectID domain.ProjectID, now time.Time) (V, bool) {
+	c.mu.RLock()
+	entry, found := c.entries[projectID]
+	c.mu.RUnlock()
+
+	if !found || !now.Before(entry.expiresAt) {
+		var zero V
+
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+func (c *projectCache[V]) set(projectID domain.ProjectID, value V, now time.Time) {
+	c.mu.Lock()
+	c.entries[projectID] = projectCacheEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+}
+
+func (c *projectCache[V]) delete(projectID domain.ProjectID) {
+	c.mu.Lock()
+	delete(c.entries, projectID)
+	c.mu.Unlock()
+}
+
+func (c *projectCache[V]) clear() {
+	c.mu.Lock()
+	c.entries = make(map[domain.ProjectID]projectCacheEntry[V])
+	c.mu.Unlock()
+}
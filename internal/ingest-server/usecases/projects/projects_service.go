@@ -2,54 +2,296 @@ package projects
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
 	"sync"
+	"time"
 
+	"github.com/rom8726/warden/internal/common/inboundfilters"
+	wardencontext "github.com/rom8726/warden/internal/context"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/ingest-server/contract"
 )
 
+const (
+	// keyCacheTTL bounds staleness of cached keys if a change notification is missed.
+	keyCacheTTL = 5 * time.Minute
+	// settingsCacheTTL bounds staleness of cached inbound filters and rate limits
+	// if a change notification is missed.
+	settingsCacheTTL = 5 * time.Minute
+	// lastUsedInterval limits the last used timestamp updates to one per key and interval.
+	lastUsedInterval = time.Minute
+)
+
+type cachedKey struct {
+	key       domain.ProjectKey
+	expiresAt time.Time
+	touchedAt time.Time
+}
+
 type ProjectService struct {
-	projectRepo contract.ProjectsRepository
-	cacheMu     sync.RWMutex
-	keyCache    map[ProjectCacheKey]struct{}
+	keysRepo        contract.ProjectKeysRepository
+	filtersRepo     contract.InboundFiltersRepository
+	rateLimitsRepo  contract.ProjectRateLimitsRepository
+	rateLimiter     contract.RateLimiter
+	cacheMu         sync.RWMutex
+	keyCache        map[ProjectCacheKey]*cachedKey
+	filtersCache    *projectCache[*inboundfilters.Filters]
+	rateLimitsCache *projectCache[domain.RateLimits]
 }
 
 func New(
-	projectRepo contract.ProjectsRepository,
+	keysRepo contract.ProjectKeysRepository,
+	filtersRepo contract.InboundFiltersRepository,
+	rateLimitsRepo contract.ProjectRateLimitsRepository,
+	rateLimiter contract.RateLimiter,
 ) *ProjectService {
 	return &ProjectService{
-		projectRepo: projectRepo,
-		keyCache:    make(map[ProjectCacheKey]struct{}),
+		keysRepo:        keysRepo,
+		filtersRepo:     filtersRepo,
+		rateLimitsRepo:  rateLimitsRepo,
+		rateLimiter:     rateLimiter,
+		keyCache:        make(map[ProjectCacheKey]*cachedKey),
+		filtersCache:    newProjectCache[*inboundfilters.Filters](settingsCacheTTL),
+		rateLimitsCache: newProjectCache[domain.RateLimits](settingsCacheTTL),
 	}
 }
 
-func (s *ProjectService) ValidateProjectKey(
+func (s *ProjectService) AuthenticateKey(
 	ctx context.Context,
 	projectID domain.ProjectID,
-	key string,
-) (bool, error) {
+	publicKey string,
+	secretKey string,
+) (domain.ProjectKey, error) {
+	if publicKey == "" {
+		return domain.ProjectKey{}, domain.ErrInvalidProjectKey
+	}
+
 	cacheKey := ProjectCacheKey{
 		ProjectID: projectID,
-		Key:       key,
+		Key:       publicKey,
+	}
+
+	key, err := s.getKey(ctx, cacheKey)
+	if err != nil {
+		return domain.ProjectKey{}, err
+	}
+
+	if secretKey != "" && subtle.ConstantTimeCompare([]byte(secretKey), []byte(key.SecretKey)) != 1 {
+		return domain.ProjectKey{}, domain.ErrInvalidProjectKey
+	}
+
+	if key.RateLimits.EventsPerMinute != nil &&
+		!s.allow(ctx, domain.RateLimitScopeKey, key.ID.Uint(), "", *key.RateLimits.EventsPerMinute, 1) {
+		return domain.ProjectKey{}, domain.ErrProjectKeyRateLimited
+	}
+
+	s.touch(ctx, cacheKey, key.ID)
+
+	return key, nil
+}
+
+// InboundFilters returns the cached inbound filters of the project,
+// unknown projects have no filters and are rejected by the key authentication.
+func (s *ProjectService) InboundFilters(
+	ctx context.Context,
+	projectID domain.ProjectID,
+) (*inboundfilters.Filters, error) {
+	now := time.Now()
+	if filters, found := s.filtersCache.get(projectID, now); found {
+		return filters, nil
+	}
+
+	filters, err := s.filtersRepo.Get(ctx, projectID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		return nil, fmt.Errorf("get inbound filters: %w", err)
 	}
 
+	compiled, err := inboundfilters.Compile(filters)
+	if err != nil {
+		// Filters are validated on save, don't drop data if the stored ones are broken anyway
+		slog.Error("Failed to compile inbound filters", "error", err, "project_id", projectID)
+
+		compiled, _ = inboundfilters.Compile(domain.InboundFilters{})
+	}
+
+	s.filtersCache.set(projectID, compiled, now)
+
+	return compiled, nil
+}
+
+// CheckQuotas counts the items of a request against the rate limits of the key and the project.
+// It returns the outcome reasons of the categories over their quotas, the request is counted
+// as a whole against the project events per minute. The exceeded limits are reported to the context.
+func (s *ProjectService) CheckQuotas(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	key domain.ProjectKey,
+	quantities map[domain.DataCategory]uint,
+) map[domain.DataCategory]string {
+	limited := make(map[domain.DataCategory]string)
+
+	projectLimits := s.projectRateLimits(ctx, projectID)
+	if projectLimits.EventsPerMinute != nil &&
+		!s.allow(ctx, domain.RateLimitScopeProject, projectID.Uint(), "", *projectLimits.EventsPerMinute, 1) {
+		for category := range quantities {
+			limited[category] = domain.OutcomeReasonProjectRateLimit
+		}
+
+		return limited
+	}
+
+	for category, quantity := range quantities {
+		if quantity == 0 {
+			continue
+		}
+
+		if limit, ok := key.RateLimits.Categories[category]; ok &&
+			!s.allow(ctx, domain.RateLimitScopeKey, key.ID.Uint(), category, limit, quantity) {
+			limited[category] = domain.OutcomeReasonKeyRateLimit
+
+			continue
+		}
+
+		if limit, ok := projectLimits.Categories[category]; ok &&
+			!s.allow(ctx, domain.RateLimitScopeProject, projectID.Uint(), category, limit, quantity) {
+			limited[category] = domain.OutcomeReasonProjectRateLimit
+		}
+	}
+
+	return limited
+}
+
+// InvalidateKey drops the cached key, so the next request loads it from the database.
+func (s *ProjectService) InvalidateKey(projectID domain.ProjectID, publicKey string) {
+	s.cacheMu.Lock()
+	delete(s.keyCache, ProjectCacheKey{ProjectID: projectID, Key: publicKey})
+	s.cacheMu.Unlock()
+}
+
+// InvalidateFilters drops the cached inbound filters of the project.
+func (s *ProjectService) InvalidateFilters(projectID domain.ProjectID) {
+	s.filtersCache.delete(projectID)
+}
+
+// InvalidateRateLimits drops the cached rate limits of the project.
+func (s *ProjectService) InvalidateRateLimits(projectID domain.ProjectID) {
+	s.rateLimitsCache.delete(projectID)
+}
+
+// InvalidateAll drops all cached keys, inbound filters and rate limits.
+func (s *ProjectService) InvalidateAll() {
+	s.cacheMu.Lock()
+	s.keyCache = make(map[ProjectCacheKey]*cachedKey)
+	s.cacheMu.Unlock()
+
+	s.filtersCache.clear()
+	s.rateLimitsCache.clear()
+}
+
+// projectRateLimits returns the cached rate limits of the project, the project is unlimited
+// when they can't be loaded.
+func (s *ProjectService) projectRateLimits(ctx context.Context, projectID domain.ProjectID) domain.RateLimits {
+	now := time.Now()
+	if limits, found := s.rateLimitsCache.get(projectID, now); found {
+		return limits
+	}
+
+	limits, err := s.rateLimitsRepo.Get(ctx, projectID)
+	if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+		slog.Error("Failed to get project rate limits", "error", err, "project_id", projectID)
+
+		return domain.RateLimits{}
+	}
+
+	s.rateLimitsCache.set(projectID, limits.RateLimits, now)
+
+	return limits.RateLimits
+}
+
+// allow counts the quantity against the limit and reports the exceeded limit to the context.
+// Data is not dropped when the limiter is unavailable.
+func (s *ProjectService) allow(
+	ctx context.Context,
+	scope domain.RateLimitScope,
+	scopeID uint,
+	category domain.DataCategory,
+	limit uint,
+	quantity uint,
+) bool {
+	allowed, retryAfter, err := s.rateLimiter.Allow(ctx, scope, scopeID, category, limit, quantity)
+	if err != nil {
+		slog.Error("Failed to check rate limit", "error", err, "scope", scope, "id", scopeID, "category", category)
+
+		return true
+	}
+
+	if allowed {
+		return true
+	}
+
+	activeLimit := domain.ActiveRateLimit{
+		RetryAfter: retryAfter,
+		Scope:      scope,
+		Reason:     string(scope) + "_rate_limit",
+	}
+	if category != "" {
+		activeLimit.Categories = []domain.DataCategory{category}
+	}
+	wardencontext.ReportRateLimit(ctx, activeLimit)
+
+	return false
+}
+
+// getKey returns the active key from the cache or the database, invalid keys are not cached.
+func (s *ProjectService) getKey(ctx context.Context, cacheKey ProjectCacheKey) (domain.ProjectKey, error) {
+	now := time.Now()
+
 	s.cacheMu.RLock()
-	_, found := s.keyCache[cacheKey]
+	cached, found := s.keyCache[cacheKey]
 	s.cacheMu.RUnlock()
-	if found {
-		return true, nil
+	if found && now.Before(cached.expiresAt) {
+		return cached.key, nil
 	}
 
-	allowed, err := s.projectRepo.ValidateProjectKey(ctx, projectID, key)
+	key, err := s.keysRepo.GetActive(ctx, cacheKey.ProjectID, cacheKey.Key)
 	if err != nil {
-		return false, err
+		if errors.Is(err, domain.ErrEntityNotFound) {
+			return domain.ProjectKey{}, domain.ErrInvalidProjectKey
+		}
+
+		return domain.ProjectKey{}, fmt.Errorf("get project key: %w", err)
 	}
 
-	if allowed {
-		s.cacheMu.Lock()
-		s.keyCache[cacheKey] = struct{}{}
-		s.cacheMu.Unlock()
+	s.cacheMu.Lock()
+	entry := &cachedKey{key: key, expiresAt: now.Add(keyCacheTTL)}
+	if found {
+		entry.touchedAt = cached.touchedAt
+	}
+	s.keyCache[cacheKey] = entry
+	s.cacheMu.Unlock()
+
+	return key, nil
+}
+
+func (s *ProjectService) touch(ctx context.Context, cacheKey ProjectCacheKey, id domain.ProjectKeyID) {
+	now := time.Now()
+
+	s.cacheMu.Lock()
+	cached, found := s.keyCache[cacheKey]
+	shouldTouch := found && now.Sub(cached.touchedAt) >= lastUsedInterval
+	if shouldTouch {
+		cached.touchedAt = now
 	}
+	s.cacheMu.Unlock()
 
-	return allowed, nil
+	if !shouldTouch {
+		return
+	}
+
+	if err := s.keysRepo.TouchLastUsed(ctx, id, now); err != nil {
+		slog.Error("Failed to update project key last used time", "error", err, "key_id", id)
+	}
 }
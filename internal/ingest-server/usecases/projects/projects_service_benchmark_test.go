@@ -3,48 +3,50 @@ package projects
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/ingest-server/contract"
 )
 
-// mockProjectsRepository is a simple mock for benchmarking
-type mockProjectsRepository struct {
+// mockProjectKeysRepository is a simple mock for benchmarking
+type mockProjectKeysRepository struct {
 	callCount int
 }
 
-func (m *mockProjectsRepository) GetProjectIDs(ctx context.Context) ([]domain.ProjectID, error) {
-	return nil, nil
-}
-
-func (m *mockProjectsRepository) ValidateProjectKey(ctx context.Context, projectID domain.ProjectID, key string) (bool, error) {
+func (m *mockProjectKeysRepository) GetActive(
+	_ context.Context,
+	projectID domain.ProjectID,
+	publicKey string,
+) (domain.ProjectKey, error) {
 	m.callCount++
 	// Simulate successful validation for benchmarking
-	return true, nil
+	return domain.ProjectKey{ID: 1, ProjectID: projectID, PublicKey: publicKey, IsActive: true}, nil
+}
+
+func (m *mockProjectKeysRepository) TouchLastUsed(context.Context, domain.ProjectKeyID, time.Time) error {
+	return nil
 }
 
 // ProjectServiceWithoutCache is a version without caching for benchmarking
 type ProjectServiceWithoutCache struct {
-	projectRepo contract.ProjectsRepository
+	keysRepo contract.ProjectKeysRepository
 }
 
-func (s *ProjectServiceWithoutCache) ValidateProjectKey(
+func (s *ProjectServiceWithoutCache) AuthenticateKey(
 	ctx context.Context,
 	projectID domain.ProjectID,
-	key string,
-) (bool, error) {
-	return s.projectRepo.ValidateProjectKey(ctx, projectID, key)
+	publicKey string,
+) (domain.ProjectKey, error) {
+	return s.keysRepo.GetActive(ctx, projectID, publicKey)
 }
 
-func BenchmarkValidateProjectKey_WithCache(b *testing.B) {
+func BenchmarkAuthenticateKey_WithCache(b *testing.B) {
 	// Create a mock repository
-	mockRepo := &mockProjectsRepository{}
+	mockRepo := &mockProjectKeysRepository{}
 
 	// Create service with cache
-	service := &ProjectService{
-		projectRepo: mockRepo,
-		keyCache:    make(map[ProjectCacheKey]struct{}),
-	}
+	service := New(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	projectID := domain.ProjectID(1)
@@ -52,20 +54,20 @@ func BenchmarkValidateProjectKey_WithCache(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _ = service.ValidateProjectKey(ctx, projectID, key)
+		_, _ = service.AuthenticateKey(ctx, projectID, key, "")
 	}
 
 	// Print cache hit ratio
 	b.ReportMetric(float64(mockRepo.callCount), "repo_calls")
 }
 
-func BenchmarkValidateProjectKey_WithoutCache(b *testing.B) {
+func BenchmarkAuthenticateKey_WithoutCache(b *testing.B) {
 	// Create a mock repository
-	mockRepo := &mockProjectsRepository{}
+	mockRepo := &mockProjectKeysRepository{}
 
 	// Create service without cache
 	service := &ProjectServiceWithoutCache{
-		projectRepo: mockRepo,
+		keysRepo: mockRepo,
 	}
 
 	ctx := context.Background()
@@ -74,7 +76,7 @@ func BenchmarkValidateProjectKey_WithoutCache(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, _ = service.ValidateProjectKey(ctx, projectID, key)
+		_, _ = service.AuthenticateKey(ctx, projectID, key)
 	}
 
 	// Print cache hit ratio
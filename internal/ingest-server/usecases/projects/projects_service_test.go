@@ -4,68 +4,115 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	wardencontext "github.com/rom8726/warden/internal/context"
 	"github.com/rom8726/warden/internal/domain"
 	mockcontract "github.com/rom8726/warden/test_mocks/internal_/ingest-server/contract"
 )
 
-func TestValidateProjectKey(t *testing.T) {
+func TestAuthenticateKey(t *testing.T) {
 	t.Parallel()
 
+	rateLimit := uint(10)
+	activeKey := domain.ProjectKey{
+		ID:        1,
+		ProjectID: 1,
+		PublicKey: "valid-key",
+		SecretKey: "secret",
+		IsActive:  true,
+	}
+	limitedKey := activeKey
+	limitedKey.RateLimits = domain.RateLimits{EventsPerMinute: &rateLimit}
+
 	tests := []struct {
-		name           string
-		setupMocks     func(mockProjectRepo *mockcontract.MockProjectsRepository)
-		projectID      domain.ProjectID
-		key            string
-		expectedResult bool
-		expectedError  bool
-		errorContains  string
+		name       string
+		setupMocks func(
+			keysRepo *mockcontract.MockProjectKeysRepository,
+			rateLimiter *mockcontract.MockRateLimiter,
+		)
+		publicKey     string
+		secretKey     string
+		expectedKey   domain.ProjectKey
+		expectedError error
+		errorContains string
 	}{
 		{
 			name: "Valid key",
-			setupMocks: func(mockProjectRepo *mockcontract.MockProjectsRepository) {
-				mockProjectRepo.EXPECT().ValidateProjectKey(
-					mock.Anything,
-					domain.ProjectID(1),
-					"valid-key",
-				).Return(true, nil)
-			},
-			projectID:      domain.ProjectID(1),
-			key:            "valid-key",
-			expectedResult: true,
-			expectedError:  false,
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, _ *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "valid-key").Return(activeKey, nil)
+				keysRepo.EXPECT().TouchLastUsed(mock.Anything, domain.ProjectKeyID(1), mock.Anything).Return(nil)
+			},
+			publicKey:   "valid-key",
+			expectedKey: activeKey,
 		},
 		{
-			name: "Invalid key",
-			setupMocks: func(mockProjectRepo *mockcontract.MockProjectsRepository) {
-				mockProjectRepo.EXPECT().ValidateProjectKey(
-					mock.Anything,
-					domain.ProjectID(1),
-					"invalid-key",
-				).Return(false, nil)
-			},
-			projectID:      domain.ProjectID(1),
-			key:            "invalid-key",
-			expectedResult: false,
-			expectedError:  false,
+			name: "Valid key and secret",
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, _ *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "valid-key").Return(activeKey, nil)
+				keysRepo.EXPECT().TouchLastUsed(mock.Anything, domain.ProjectKeyID(1), mock.Anything).Return(nil)
+			},
+			publicKey:   "valid-key",
+			secretKey:   "secret",
+			expectedKey: activeKey,
 		},
 		{
-			name: "Error validating key",
-			setupMocks: func(mockProjectRepo *mockcontract.MockProjectsRepository) {
-				mockProjectRepo.EXPECT().ValidateProjectKey(
-					mock.Anything,
-					domain.ProjectID(1),
-					"error-key",
-				).Return(false, errors.New("database error"))
-			},
-			projectID:      domain.ProjectID(1),
-			key:            "error-key",
-			expectedResult: false,
-			expectedError:  true,
-			errorContains:  "database error",
+			name: "Wrong secret",
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, _ *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "valid-key").Return(activeKey, nil)
+			},
+			publicKey:     "valid-key",
+			secretKey:     "wrong",
+			expectedError: domain.ErrInvalidProjectKey,
+		},
+		{
+			name: "Unknown or disabled key",
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, _ *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "invalid-key").
+					Return(domain.ProjectKey{}, domain.ErrEntityNotFound)
+			},
+			publicKey:     "invalid-key",
+			expectedError: domain.ErrInvalidProjectKey,
+		},
+		{
+			name:          "Empty key",
+			setupMocks:    func(*mockcontract.MockProjectKeysRepository, *mockcontract.MockRateLimiter) {},
+			expectedError: domain.ErrInvalidProjectKey,
+		},
+		{
+			name: "Key over its rate limit",
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, rateLimiter *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "valid-key").Return(limitedKey, nil)
+				rateLimiter.EXPECT().
+					Allow(mock.Anything, domain.RateLimitScopeKey, uint(1), domain.DataCategory(""), rateLimit, uint(1)).
+					Return(false, 30*time.Second, nil)
+			},
+			publicKey:     "valid-key",
+			expectedError: domain.ErrProjectKeyRateLimited,
+		},
+		{
+			name: "Rate limiter error lets the request through",
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, rateLimiter *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "valid-key").Return(limitedKey, nil)
+				keysRepo.EXPECT().TouchLastUsed(mock.Anything, domain.ProjectKeyID(1), mock.Anything).Return(nil)
+				rateLimiter.EXPECT().
+					Allow(mock.Anything, domain.RateLimitScopeKey, uint(1), domain.DataCategory(""), rateLimit, uint(1)).
+					Return(false, 0, errors.New("redis error"))
+			},
+			publicKey:   "valid-key",
+			expectedKey: limitedKey,
+		},
+		{
+			name: "Error getting key",
+			setupMocks: func(keysRepo *mockcontract.MockProjectKeysRepository, _ *mockcontract.MockRateLimiter) {
+				keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "error-key").
+					Return(domain.ProjectKey{}, errors.New("database error"))
+			},
+			publicKey:     "error-key",
+			errorContains: "database error",
 		},
 	}
 
@@ -73,96 +120,243 @@ func TestValidateProjectKey(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			// Create mocks
-			mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+			keysRepo := mockcontract.NewMockProjectKeysRepository(t)
+			rateLimiter := mockcontract.NewMockRateLimiter(t)
+			tt.setupMocks(keysRepo, rateLimiter)
 
-			// Setup mocks
-			tt.setupMocks(mockProjectRepo)
+			service := New(keysRepo, nil, nil, rateLimiter)
 
-			// Create service
-			service := New(mockProjectRepo)
+			key, err := service.AuthenticateKey(context.Background(), domain.ProjectID(1), tt.publicKey, tt.secretKey)
 
-			// Call method
-			result, err := service.ValidateProjectKey(context.Background(), tt.projectID, tt.key)
-
-			// Check result
-			if tt.expectedError {
-				require.Error(t, err)
-				if tt.errorContains != "" {
-					require.Contains(t, err.Error(), tt.errorContains)
-				}
-			} else {
+			switch {
+			case tt.expectedError != nil:
+				require.ErrorIs(t, err, tt.expectedError)
+			case tt.errorContains != "":
+				require.ErrorContains(t, err, tt.errorContains)
+			default:
 				require.NoError(t, err)
-				require.Equal(t, tt.expectedResult, result)
+				require.Equal(t, tt.expectedKey, key)
 			}
 		})
 	}
 }
 
-func TestValidateProjectKey_Caching(t *testing.T) {
+func TestAuthenticateKey_Caching(t *testing.T) {
 	t.Parallel()
 
-	// Create mocks
-	mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+	keysRepo := mockcontract.NewMockProjectKeysRepository(t)
+	key := domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "valid-key", IsActive: true}
+
+	// Expect only one load and one last used update for the repeated requests
+	keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "valid-key").Return(key, nil).Once()
+	keysRepo.EXPECT().TouchLastUsed(mock.Anything, domain.ProjectKeyID(1), mock.Anything).Return(nil).Once()
 
-	// Setup mocks - expect only one call for successful validation
-	mockProjectRepo.EXPECT().ValidateProjectKey(
-		mock.Anything,
-		domain.ProjectID(1),
-		"valid-key",
-	).Return(true, nil).Once()
+	service := New(keysRepo, nil, nil, mockcontract.NewMockRateLimiter(t))
 
-	// Create service
-	service := New(mockProjectRepo)
+	for range 3 {
+		_, err := service.AuthenticateKey(context.Background(), domain.ProjectID(1), "valid-key", "")
+		require.NoError(t, err)
+	}
+}
+
+func TestAuthenticateKey_InvalidateKey(t *testing.T) {
+	t.Parallel()
 
-	ctx := context.Background()
-	projectID := domain.ProjectID(1)
-	key := "valid-key"
+	keysRepo := mockcontract.NewMockProjectKeysRepository(t)
+	key := domain.ProjectKey{ID: 1, ProjectID: 1, PublicKey: "revoked-key", IsActive: true}
 
-	// First call - should hit repository
-	result1, err := service.ValidateProjectKey(ctx, projectID, key)
-	require.NoError(t, err)
-	require.True(t, result1)
+	keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "revoked-key").Return(key, nil).Once()
+	keysRepo.EXPECT().TouchLastUsed(mock.Anything, domain.ProjectKeyID(1), mock.Anything).Return(nil).Once()
+
+	service := New(keysRepo, nil, nil, mockcontract.NewMockRateLimiter(t))
 
-	// Second call - should hit cache, not repository
-	result2, err := service.ValidateProjectKey(ctx, projectID, key)
+	_, err := service.AuthenticateKey(context.Background(), domain.ProjectID(1), "revoked-key", "")
 	require.NoError(t, err)
-	require.True(t, result2)
 
-	// Verify that repository was called only once
-	mockProjectRepo.AssertExpectations(t)
+	// The key is revoked, the next request must not be served from the cache
+	service.InvalidateKey(domain.ProjectID(1), "revoked-key")
+	keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "revoked-key").
+		Return(domain.ProjectKey{}, domain.ErrEntityNotFound).Once()
+
+	_, err = service.AuthenticateKey(context.Background(), domain.ProjectID(1), "revoked-key", "")
+	require.ErrorIs(t, err, domain.ErrInvalidProjectKey)
 }
 
-func TestValidateProjectKey_InvalidKeyNotCached(t *testing.T) {
+func TestAuthenticateKey_InvalidKeyNotCached(t *testing.T) {
 	t.Parallel()
 
-	// Create mocks
-	mockProjectRepo := mockcontract.NewMockProjectsRepository(t)
+	keysRepo := mockcontract.NewMockProjectKeysRepository(t)
 
-	// Setup mocks - expect two calls for invalid key (not cached)
-	mockProjectRepo.EXPECT().ValidateProjectKey(
-		mock.Anything,
-		domain.ProjectID(1),
-		"invalid-key",
-	).Return(false, nil).Times(2)
+	// Expect two calls for invalid key (not cached)
+	keysRepo.EXPECT().GetActive(mock.Anything, domain.ProjectID(1), "invalid-key").
+		Return(domain.ProjectKey{}, domain.ErrEntityNotFound).Times(2)
 
-	// Create service
-	service := New(mockProjectRepo)
+	service := New(keysRepo, nil, nil, mockcontract.NewMockRateLimiter(t))
 
-	ctx := context.Background()
-	projectID := domain.ProjectID(1)
-	key := "invalid-key"
+	for range 2 {
+		_, err := service.AuthenticateKey(context.Background(), domain.ProjectID(1), "invalid-key", "")
+		require.ErrorIs(t, err, domain.ErrInvalidProjectKey)
+	}
+}
 
-	// First call - should hit repository
-	result1, err := service.ValidateProjectKey(ctx, projectID, key)
-	require.NoError(t, err)
-	require.False(t, result1)
+func TestInboundFilters_Cached(t *testing.T) {
+	t.Parallel()
 
-	// Second call - should hit repository again (not cached)
-	result2, err := service.ValidateProjectKey(ctx, projectID, key)
+	filtersRepo := mockcontract.NewMockInboundFiltersRepository(t)
+	filtersRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).
+		Return(domain.InboundFilters{ProjectID: 1, Releases: []string{"*-dev"}}, nil).Once()
+
+	service := New(mockcontract.NewMockProjectKeysRepository(t), filtersRepo, nil, mockcontract.NewMockRateLimiter(t))
+
+	for range 2 {
+		filters, err := service.InboundFilters(context.Background(), domain.ProjectID(1))
+		require.NoError(t, err)
+		require.True(t, filters.HasEventFilters())
+	}
+
+	// The filters are cleared, the next request loads them again
+	service.InvalidateFilters(domain.ProjectID(1))
+	filtersRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).
+		Return(domain.InboundFilters{}, domain.ErrEntityNotFound).Once()
+
+	filters, err := service.InboundFilters(context.Background(), domain.ProjectID(1))
 	require.NoError(t, err)
-	require.False(t, result2)
+	require.False(t, filters.HasEventFilters())
+}
+
+func TestCheckQuotas(t *testing.T) {
+	t.Parallel()
+
+	projectLimit := uint(100)
+	key := domain.ProjectKey{
+		ID:        2,
+		ProjectID: 1,
+		RateLimits: domain.RateLimits{
+			Categories: map[domain.DataCategory]uint{domain.DataCategoryAttachment: 5},
+		},
+	}
+	quantities := map[domain.DataCategory]uint{
+		domain.DataCategoryError:      1,
+		domain.DataCategoryAttachment: 2,
+	}
+
+	tests := []struct {
+		name            string
+		projectLimits   domain.RateLimits
+		setupMocks      func(rateLimiter *mockcontract.MockRateLimiter)
+		expectedLimited map[domain.DataCategory]string
+		expectedReport  []domain.ActiveRateLimit
+	}{
+		{
+			name: "Under all quotas",
+			setupMocks: func(rateLimiter *mockcontract.MockRateLimiter) {
+				rateLimiter.EXPECT().Allow(mock.Anything, domain.RateLimitScopeKey, uint(2),
+					domain.DataCategoryAttachment, uint(5), uint(2)).Return(true, 0, nil)
+			},
+			expectedLimited: map[domain.DataCategory]string{},
+		},
+		{
+			name: "Key category quota exceeded",
+			setupMocks: func(rateLimiter *mockcontract.MockRateLimiter) {
+				rateLimiter.EXPECT().Allow(mock.Anything, domain.RateLimitScopeKey, uint(2),
+					domain.DataCategoryAttachment, uint(5), uint(2)).Return(false, 20*time.Second, nil)
+			},
+			expectedLimited: map[domain.DataCategory]string{
+				domain.DataCategoryAttachment: domain.OutcomeReasonKeyRateLimit,
+			},
+			expectedReport: []domain.ActiveRateLimit{{
+				RetryAfter: 20 * time.Second,
+				Categories: []domain.DataCategory{domain.DataCategoryAttachment},
+				Scope:      domain.RateLimitScopeKey,
+				Reason:     domain.OutcomeReasonKeyRateLimit,
+			}},
+		},
+		{
+			name:          "Project events per minute exceeded",
+			projectLimits: domain.RateLimits{EventsPerMinute: &projectLimit},
+			setupMocks: func(rateLimiter *mockcontract.MockRateLimiter) {
+				rateLimiter.EXPECT().Allow(mock.Anything, domain.RateLimitScopeProject, uint(1),
+					domain.DataCategory(""), projectLimit, uint(1)).Return(false, 10*time.Second, nil)
+			},
+			expectedLimited: map[domain.DataCategory]string{
+				domain.DataCategoryError:      domain.OutcomeReasonProjectRateLimit,
+				domain.DataCategoryAttachment: domain.OutcomeReasonProjectRateLimit,
+			},
+			expectedReport: []domain.ActiveRateLimit{{
+				RetryAfter: 10 * time.Second,
+				Scope:      domain.RateLimitScopeProject,
+				Reason:     domain.OutcomeReasonProjectRateLimit,
+			}},
+		},
+		{
+			name: "Project category quota exceeded",
+			projectLimits: domain.RateLimits{
+				Categories: map[domain.DataCategory]uint{domain.DataCategoryError: 50},
+			},
+			setupMocks: func(rateLimiter *mockcontract.MockRateLimiter) {
+				rateLimiter.EXPECT().Allow(mock.Anything, domain.RateLimitScopeKey, uint(2),
+					domain.DataCategoryAttachment, uint(5), uint(2)).Return(true, 0, nil)
+				rateLimiter.EXPECT().Allow(mock.Anything, domain.RateLimitScopeProject, uint(1),
+					domain.DataCategoryError, uint(50), uint(1)).Return(false, 5*time.Second, nil)
+			},
+			expectedLimited: map[domain.DataCategory]string{
+				domain.DataCategoryError: domain.OutcomeReasonProjectRateLimit,
+			},
+			expectedReport: []domain.ActiveRateLimit{{
+				RetryAfter: 5 * time.Second,
+				Categories: []domain.DataCategory{domain.DataCategoryError},
+				Scope:      domain.RateLimitScopeProject,
+				Reason:     domain.OutcomeReasonProjectRateLimit,
+			}},
+		},
+		{
+			name: "Rate limiter error lets the data through",
+			setupMocks: func(rateLimiter *mockcontract.MockRateLimiter) {
+				rateLimiter.EXPECT().Allow(mock.Anything, domain.RateLimitScopeKey, uint(2),
+					domain.DataCategoryAttachment, uint(5), uint(2)).Return(false, 0, errors.New("redis error"))
+			},
+			expectedLimited: map[domain.DataCategory]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rateLimitsRepo := mockcontract.NewMockProjectRateLimitsRepository(t)
+			rateLimitsRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).
+				Return(domain.ProjectRateLimits{ProjectID: 1, RateLimits: tt.projectLimits}, nil)
+			rateLimiter := mockcontract.NewMockRateLimiter(t)
+			tt.setupMocks(rateLimiter)
+
+			service := New(mockcontract.NewMockProjectKeysRepository(t), nil, rateLimitsRepo, rateLimiter)
+			ctx := wardencontext.WithRateLimitReport(context.Background())
+
+			limited := service.CheckQuotas(ctx, domain.ProjectID(1), key, quantities)
+			require.Equal(t, tt.expectedLimited, limited)
+			require.Equal(t, tt.expectedReport, wardencontext.ReportedRateLimits(ctx))
+		})
+	}
+}
+
+func TestCheckQuotas_ProjectLimitsCached(t *testing.T) {
+	t.Parallel()
+
+	rateLimitsRepo := mockcontract.NewMockProjectRateLimitsRepository(t)
+	rateLimitsRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).
+		Return(domain.ProjectRateLimits{ProjectID: 1}, nil).Once()
+
+	service := New(mockcontract.NewMockProjectKeysRepository(t), nil, rateLimitsRepo, mockcontract.NewMockRateLimiter(t))
+	quantities := map[domain.DataCategory]uint{domain.DataCategoryTransaction: 1}
+
+	for range 2 {
+		require.Empty(t, service.CheckQuotas(context.Background(), domain.ProjectID(1), domain.ProjectKey{}, quantities))
+	}
+
+	// The limits are changed, the next request loads them again
+	service.InvalidateRateLimits(domain.ProjectID(1))
+	rateLimitsRepo.EXPECT().Get(mock.Anything, domain.ProjectID(1)).
+		Return(domain.ProjectRateLimits{}, domain.ErrEntityNotFound).Once()
 
-	// Verify that repository was called twice
-	mockProjectRepo.AssertExpectations(t)
+	require.Empty(t, service.CheckQuotas(context.Background(), domain.ProjectID(1), domain.ProjectKey{}, quantities))
 }
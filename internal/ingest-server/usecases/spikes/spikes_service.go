@@ -0,0 +1,193 @@
+package spikes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/ingest-server/contract"
+	"github.com/rom8726/warden/pkg/db"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+// SpikesService records the spikes of project ingest capped by the spike protection.
+// New spikes are reported as an issue of the project, notified through its notification channels,
+// and the owners and admins of the project team get a user notification about them.
+type SpikesService struct {
+	txManager              db.TxManager
+	spikesRepo             contract.ProjectSpikesRepository
+	projectsRepo           contract.ProjectsRepository
+	teamsRepo              contract.TeamsRepository
+	usersRepo              contract.UsersRepository
+	issuesRepo             contract.IssuesRepository
+	notificationsQueueRepo contract.NotificationsQueueRepository
+	userNotificationsRepo  contract.UserNotificationsRepository
+}
+
+func New(
+	txManager db.TxManager,
+	spikesRepo contract.ProjectSpikesRepository,
+	projectsRepo contract.ProjectsRepository,
+	teamsRepo contract.TeamsRepository,
+	usersRepo contract.UsersRepository,
+	issuesRepo contract.IssuesRepository,
+	notificationsQueueRepo contract.NotificationsQueueRepository,
+	userNotificationsRepo contract.UserNotificationsRepository,
+) *SpikesService {
+	return &SpikesService{
+		txManager:              txManager,
+		spikesRepo:             spikesRepo,
+		projectsRepo:           projectsRepo,
+		teamsRepo:              teamsRepo,
+		usersRepo:              usersRepo,
+		issuesRepo:             issuesRepo,
+		notificationsQueueRepo: notificationsQueueRepo,
+		userNotificationsRepo:  userNotificationsRepo,
+	}
+}
+
+// RecordSpike saves the spike window. A spike starting right after the previous one of the project ended
+// is the same flood going on, the previous window is extended and nobody is notified again.
+func (s *SpikesService) RecordSpike(ctx context.Context, spike domain.ProjectSpikeDTO) error {
+	metrics.SpikesDetected.WithLabelValues(spike.ProjectID.String()).Inc()
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		last, err := s.spikesRepo.GetLast(ctx, spike.ProjectID)
+		if err != nil && !errors.Is(err, domain.ErrEntityNotFound) {
+			return fmt.Errorf("get last project spike: %w", err)
+		}
+
+		if err == nil && spike.StartedAt.Sub(last.EndsAt) < spike.EndsAt.Sub(spike.StartedAt) {
+			if err := s.spikesRepo.Extend(ctx, last.ID, spike.EndsAt, spike.PeakRPS); err != nil {
+				return fmt.Errorf("extend project spike: %w", err)
+			}
+
+			slog.Info("project spike extended", "project_id", spike.ProjectID, "spike_id", last.ID,
+				"ends_at", spike.EndsAt)
+
+			return nil
+		}
+
+		created, err := s.spikesRepo.Create(ctx, spike)
+		if err != nil {
+			return fmt.Errorf("create project spike: %w", err)
+		}
+
+		slog.Warn("project spike detected", "project_id", spike.ProjectID, "spike_id", created.ID,
+			"baseline_rps", spike.BaselineRPS, "peak_rps", spike.PeakRPS, "cap_rps", spike.CapRPS)
+
+		if err := s.raiseIncident(ctx, created); err != nil {
+			return err
+		}
+
+		return s.notifyOwners(ctx, created)
+	})
+}
+
+// raiseIncident reports the spike as an issue of the project, so it's notified like other issues.
+func (s *SpikesService) raiseIncident(ctx context.Context, spike domain.ProjectSpike) error {
+	issue := spike.IncidentIssue()
+
+	upsertRes, err := s.issuesRepo.UpsertIssue(ctx, issue)
+	if err != nil {
+		return fmt.Errorf("upsert issue: %w", err)
+	}
+
+	if upsertRes.IsNew || upsertRes.WasReactivated {
+		err := s.notificationsQueueRepo.AddNotification(
+			ctx,
+			spike.ProjectID,
+			upsertRes.ID,
+			issue.Level,
+			upsertRes.IsNew, upsertRes.WasReactivated,
+			"",
+		)
+		if err != nil {
+			return fmt.Errorf("add notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// notifyOwners creates user notifications for the owners and admins of the project team,
+// or for the superusers when the project has no team. They are delivered by email by the user notificator.
+func (s *SpikesService) notifyOwners(ctx context.Context, spike domain.ProjectSpike) error {
+	project, err := s.projectsRepo.GetByID(ctx, spike.ProjectID)
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+
+	userIDs, err := s.recipients(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	if len(userIDs) == 0 {
+		slog.Warn("nobody to notify about the project spike", "project_id", project.ID, "spike_id", spike.ID)
+
+		return nil
+	}
+
+	content, err := json.Marshal(domain.UserNotificationContent{
+		SpikeProtection: &domain.SpikeProtectionContent{
+			ProjectID:   project.ID.Uint(),
+			ProjectName: project.Name,
+			BaselineRPS: spike.BaselineRPS,
+			PeakRPS:     spike.PeakRPS,
+			CapRPS:      spike.CapRPS,
+			StartedAt:   spike.StartedAt.Format(time.RFC3339),
+			EndsAt:      spike.EndsAt.Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal notification content: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		_, err := s.userNotificationsRepo.Create(ctx, userID, domain.UserNotificationTypeSpikeProtection, content)
+		if err != nil {
+			return fmt.Errorf("create user notification for user %d: %w", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// recipients returns the owners and admins of the project team, or the active superusers
+// when the project has no team.
+func (s *SpikesService) recipients(ctx context.Context, project domain.Project) ([]domain.UserID, error) {
+	var userIDs []domain.UserID
+
+	if project.TeamID == nil {
+		users, err := s.usersRepo.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list users: %w", err)
+		}
+
+		for _, user := range users {
+			if user.IsSuperuser && user.IsActive {
+				userIDs = append(userIDs, user.ID)
+			}
+		}
+
+		return userIDs, nil
+	}
+
+	members, err := s.teamsRepo.GetMembers(ctx, *project.TeamID)
+	if err != nil {
+		return nil, fmt.Errorf("get team members: %w", err)
+	}
+
+	for _, member := range members {
+		if member.Role == domain.RoleOwner || member.Role == domain.RoleAdmin {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+
+	return userIDs, nil
+}
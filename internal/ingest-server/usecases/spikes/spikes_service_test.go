@@ -0,0 +1,232 @@
+package spikes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/ingest-server/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+// serviceMocks holds the mocked dependencies of the service.
+type serviceMocks struct {
+	spikesRepo             *mockcontract.MockProjectSpikesRepository
+	projectsRepo           *mockcontract.MockProjectsRepository
+	teamsRepo              *mockcontract.MockTeamsRepository
+	usersRepo              *mockcontract.MockUsersRepository
+	issuesRepo             *mockcontract.MockIssuesRepository
+	notificationsQueueRepo *mockcontract.MockNotificationsQueueRepository
+	userNotificationsRepo  *mockcontract.MockUserNotificationsRepository
+}
+
+func TestRecordSpike(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	teamID := domain.TeamID(3)
+	newSpikeDTO := domain.ProjectSpikeDTO{
+		ProjectID:   1,
+		StartedAt:   startedAt,
+		EndsAt:      startedAt.Add(10 * time.Minute),
+		BaselineRPS: 5,
+		PeakRPS:     500,
+		CapRPS:      50,
+	}
+	personalSpikeDTO := domain.ProjectSpikeDTO{ProjectID: 2, StartedAt: startedAt, EndsAt: startedAt.Add(time.Minute)}
+	isSpikeIssue := func(projectID domain.ProjectID) any {
+		return mock.MatchedBy(func(issue domain.IssueDTO) bool {
+			return issue.ProjectID == projectID && issue.Source == domain.SourceSpike &&
+				issue.Fingerprint == "spike_protection"
+		})
+	}
+
+	tests := []struct {
+		name          string
+		spikeDTO      domain.ProjectSpikeDTO
+		setupMocks    func(m serviceMocks)
+		expectedError string
+	}{
+		{
+			name:     "Notifies team owners and admins",
+			spikeDTO: newSpikeDTO,
+			setupMocks: func(m serviceMocks) {
+				m.spikesRepo.EXPECT().GetLast(mock.Anything, domain.ProjectID(1)).
+					Return(domain.ProjectSpike{}, domain.ErrEntityNotFound)
+				m.spikesRepo.EXPECT().Create(mock.Anything, newSpikeDTO).
+					Return(domain.ProjectSpike{
+						ID:          7,
+						ProjectID:   1,
+						StartedAt:   startedAt,
+						EndsAt:      startedAt.Add(10 * time.Minute),
+						BaselineRPS: 5,
+						PeakRPS:     500,
+						CapRPS:      50,
+					}, nil)
+				m.issuesRepo.EXPECT().UpsertIssue(mock.Anything, isSpikeIssue(1)).
+					Return(domain.IssueUpsertResult{ID: 100, IsNew: true}, nil)
+				m.notificationsQueueRepo.EXPECT().
+					AddNotification(mock.Anything, domain.ProjectID(1), domain.IssueID(100),
+						domain.IssueLevelWarning, true, false, "").
+					Return(nil)
+				m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, Name: "backend", TeamID: &teamID}, nil)
+				m.teamsRepo.EXPECT().GetMembers(mock.Anything, teamID).
+					Return([]domain.TeamMember{
+						{TeamID: teamID, UserID: 10, Role: domain.RoleOwner},
+						{TeamID: teamID, UserID: 11, Role: domain.RoleAdmin},
+						{TeamID: teamID, UserID: 12, Role: domain.RoleMember},
+					}, nil)
+
+				isSpikeContent := mock.MatchedBy(func(content json.RawMessage) bool {
+					var notification domain.UserNotificationContent
+					if err := json.Unmarshal(content, &notification); err != nil || notification.SpikeProtection == nil {
+						return false
+					}
+
+					return notification.SpikeProtection.ProjectName == "backend" &&
+						notification.SpikeProtection.CapRPS == 50 &&
+						notification.SpikeProtection.StartedAt == "2025-01-01T12:00:00Z"
+				})
+				for _, userID := range []domain.UserID{10, 11} {
+					m.userNotificationsRepo.EXPECT().
+						Create(mock.Anything, userID, domain.UserNotificationTypeSpikeProtection, isSpikeContent).
+						Return(domain.UserNotification{}, nil)
+				}
+			},
+		},
+		{
+			name: "Extends ongoing spike",
+			spikeDTO: domain.ProjectSpikeDTO{
+				ProjectID: 1,
+				StartedAt: startedAt,
+				EndsAt:    startedAt.Add(10 * time.Minute),
+				PeakRPS:   800,
+				CapRPS:    50,
+			},
+			setupMocks: func(m serviceMocks) {
+				m.spikesRepo.EXPECT().GetLast(mock.Anything, domain.ProjectID(1)).
+					Return(domain.ProjectSpike{ID: 7, ProjectID: 1, EndsAt: startedAt.Add(-5 * time.Second)}, nil)
+				m.spikesRepo.EXPECT().
+					Extend(mock.Anything, domain.ProjectSpikeID(7), startedAt.Add(10*time.Minute), uint64(800)).
+					Return(nil)
+			},
+		},
+		{
+			name:     "Spike issue still unresolved is not notified again",
+			spikeDTO: newSpikeDTO,
+			setupMocks: func(m serviceMocks) {
+				m.spikesRepo.EXPECT().GetLast(mock.Anything, domain.ProjectID(1)).
+					Return(domain.ProjectSpike{ID: 1, EndsAt: startedAt.Add(-time.Hour)}, nil)
+				m.spikesRepo.EXPECT().Create(mock.Anything, newSpikeDTO).
+					Return(domain.ProjectSpike{ID: 8, ProjectID: 1}, nil)
+				m.issuesRepo.EXPECT().UpsertIssue(mock.Anything, isSpikeIssue(1)).
+					Return(domain.IssueUpsertResult{ID: 100}, nil)
+				m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(1)).
+					Return(domain.Project{ID: 1, Name: "backend", TeamID: &teamID}, nil)
+				m.teamsRepo.EXPECT().GetMembers(mock.Anything, teamID).
+					Return([]domain.TeamMember{{TeamID: teamID, UserID: 10, Role: domain.RoleOwner}}, nil)
+				m.userNotificationsRepo.EXPECT().
+					Create(mock.Anything, domain.UserID(10), domain.UserNotificationTypeSpikeProtection, mock.Anything).
+					Return(domain.UserNotification{}, nil)
+			},
+		},
+		{
+			name:     "Project without team notifies superusers",
+			spikeDTO: personalSpikeDTO,
+			setupMocks: func(m serviceMocks) {
+				m.spikesRepo.EXPECT().GetLast(mock.Anything, domain.ProjectID(2)).
+					Return(domain.ProjectSpike{ID: 1, EndsAt: startedAt.Add(-time.Hour)}, nil)
+				m.spikesRepo.EXPECT().Create(mock.Anything, personalSpikeDTO).
+					Return(domain.ProjectSpike{ID: 2, ProjectID: 2}, nil)
+				m.issuesRepo.EXPECT().UpsertIssue(mock.Anything, isSpikeIssue(2)).
+					Return(domain.IssueUpsertResult{ID: 101, WasReactivated: true}, nil)
+				m.notificationsQueueRepo.EXPECT().
+					AddNotification(mock.Anything, domain.ProjectID(2), domain.IssueID(101),
+						domain.IssueLevelWarning, false, true, "").
+					Return(nil)
+				m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(2)).
+					Return(domain.Project{ID: 2, Name: "personal"}, nil)
+				m.usersRepo.EXPECT().List(mock.Anything).
+					Return([]domain.User{
+						{ID: 1, IsSuperuser: true, IsActive: true},
+						{ID: 2, IsSuperuser: true},
+						{ID: 3, IsActive: true},
+					}, nil)
+				m.userNotificationsRepo.EXPECT().
+					Create(mock.Anything, domain.UserID(1), domain.UserNotificationTypeSpikeProtection, mock.Anything).
+					Return(domain.UserNotification{}, nil)
+			},
+		},
+		{
+			name:     "Nobody to notify",
+			spikeDTO: personalSpikeDTO,
+			setupMocks: func(m serviceMocks) {
+				m.spikesRepo.EXPECT().GetLast(mock.Anything, domain.ProjectID(2)).
+					Return(domain.ProjectSpike{}, domain.ErrEntityNotFound)
+				m.spikesRepo.EXPECT().Create(mock.Anything, personalSpikeDTO).
+					Return(domain.ProjectSpike{ID: 2, ProjectID: 2}, nil)
+				m.issuesRepo.EXPECT().UpsertIssue(mock.Anything, isSpikeIssue(2)).
+					Return(domain.IssueUpsertResult{ID: 101}, nil)
+				m.projectsRepo.EXPECT().GetByID(mock.Anything, domain.ProjectID(2)).
+					Return(domain.Project{ID: 2, Name: "personal"}, nil)
+				m.usersRepo.EXPECT().List(mock.Anything).
+					Return([]domain.User{{ID: 3, IsActive: true}}, nil)
+			},
+		},
+		{
+			name:     "Upsert issue error",
+			spikeDTO: newSpikeDTO,
+			setupMocks: func(m serviceMocks) {
+				m.spikesRepo.EXPECT().GetLast(mock.Anything, domain.ProjectID(1)).
+					Return(domain.ProjectSpike{}, domain.ErrEntityNotFound)
+				m.spikesRepo.EXPECT().Create(mock.Anything, newSpikeDTO).
+					Return(domain.ProjectSpike{ID: 7, ProjectID: 1}, nil)
+				m.issuesRepo.EXPECT().UpsertIssue(mock.Anything, isSpikeIssue(1)).
+					Return(domain.IssueUpsertResult{}, errors.New("database error"))
+			},
+			expectedError: "upsert issue: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockTxManager := mockdb.NewMockTxManager(t)
+			m := serviceMocks{
+				spikesRepo:             mockcontract.NewMockProjectSpikesRepository(t),
+				projectsRepo:           mockcontract.NewMockProjectsRepository(t),
+				teamsRepo:              mockcontract.NewMockTeamsRepository(t),
+				usersRepo:              mockcontract.NewMockUsersRepository(t),
+				issuesRepo:             mockcontract.NewMockIssuesRepository(t),
+				notificationsQueueRepo: mockcontract.NewMockNotificationsQueueRepository(t),
+				userNotificationsRepo:  mockcontract.NewMockUserNotificationsRepository(t),
+			}
+
+			mockTxManager.EXPECT().ReadCommitted(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(m)
+
+			service := New(mockTxManager, m.spikesRepo, m.projectsRepo, m.teamsRepo, m.usersRepo,
+				m.issuesRepo, m.notificationsQueueRepo, m.userNotificationsRepo)
+
+			err := service.RecordSpike(context.Background(), tt.spikeDTO)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
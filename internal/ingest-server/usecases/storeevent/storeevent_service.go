@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"time"
 
+	wardencontext "github.com/rom8726/warden/internal/context"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/ingest-server/contract"
 	"github.com/rom8726/warden/pkg/metrics"
@@ -14,11 +15,19 @@ import (
 
 type StoreEventService struct {
 	storeEventProducer contract.EventProducer
+	projects           contract.ProjectsUseCase
+	outcomes           contract.OutcomeRecorder
 }
 
-func New(storeEventProducer contract.EventProducer) *StoreEventService {
+func New(
+	storeEventProducer contract.EventProducer,
+	projects contract.ProjectsUseCase,
+	outcomes contract.OutcomeRecorder,
+) *StoreEventService {
 	return &StoreEventService{
 		storeEventProducer: storeEventProducer,
+		projects:           projects,
+		outcomes:           outcomes,
 	}
 }
 
@@ -43,6 +52,27 @@ func (s *StoreEventService) StoreEvent(
 		return "", fmt.Errorf("extract event_id: %w", err)
 	}
 
+	// Filtered events are acknowledged as SDKs must not retry them
+	filters, err := s.projects.InboundFilters(ctx, projectID)
+	if err != nil {
+		slog.Error("Failed to get inbound filters", "error", err, "project_id", projectID)
+	} else if reason, filtered := filters.FilterEvent(req); filtered {
+		metrics.InboundFilterRejections.WithLabelValues(string(reason)).Inc()
+		s.outcomes.Record(projectID, domain.OutcomeFiltered, string(reason), domain.DataCategoryError, 1)
+		slog.Debug("Store event filtered", "project_id", projectID, "event_id", eventID, "reason", reason)
+
+		return eventID, nil
+	}
+
+	key, _ := wardencontext.ProjectKey(ctx)
+	limited := s.projects.CheckQuotas(ctx, projectID, key, map[domain.DataCategory]uint{domain.DataCategoryError: 1})
+	if reason, ok := limited[domain.DataCategoryError]; ok {
+		s.outcomes.Record(projectID, domain.OutcomeRateLimited, reason, domain.DataCategoryError, 1)
+		slog.Debug("Store event rate limited", "project_id", projectID, "event_id", eventID, "reason", reason)
+
+		return "", domain.ErrRateLimited
+	}
+
 	// Send store event to Kafka (Fire-and-Forget)
 	if err := s.storeEventProducer.SendStoreEvent(ctx, projectID, eventID, req); err != nil {
 		slog.Error("Failed to send store event to Kafka", "error", err, "project_id", projectID, "event_id", eventID)
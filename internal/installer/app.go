@@ -436,6 +436,7 @@ func (a *App) createDirectories() error {
 		"/opt/warden",
 		"/opt/warden/nginx/ssl",
 		"/opt/warden/secrets",
+		"/opt/warden/attachments",
 	}
 
 	// Create each directory
@@ -17,3 +17,13 @@ type Channel interface {
 		isRegress bool,
 	) error
 }
+
+// AssigneeChannel delivers issue alerts directly to the issue assignee.
+type AssigneeChannel interface {
+	SendToAssignee(
+		ctx context.Context,
+		issue *domain.Issue,
+		project *domain.Project,
+		isRegress bool,
+	) error
+}
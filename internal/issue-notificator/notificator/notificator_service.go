@@ -36,6 +36,7 @@ type Service struct {
 	txManager db.TxManager
 
 	channelsMap          map[domain.NotificationType]Channel
+	assigneeChannel      AssigneeChannel
 	notificationsUseCase contract.NotificationsUseCase
 	issuesRepo           contract.IssuesRepository
 	projectsRepo         contract.ProjectsRepository
@@ -52,6 +53,7 @@ type Service struct {
 
 func New(
 	channels []Channel,
+	assigneeChannel AssigneeChannel,
 	txManager db.TxManager,
 	notificationsUseCase contract.NotificationsUseCase,
 	issuesRepo contract.IssuesRepository,
@@ -72,6 +74,7 @@ func New(
 
 	return &Service{
 		channelsMap:          channelsMap,
+		assigneeChannel:      assigneeChannel,
 		txManager:            txManager,
 		notificationsUseCase: notificationsUseCase,
 		issuesRepo:           issuesRepo,
@@ -264,24 +267,38 @@ func (s *Service) checkAndNotify(
 		return true, "no settings", nil
 	}
 
+	sentToAssignee := false
 	for _, setting := range settings {
 		channel := s.channelsMap[setting.Type]
 		if channel == nil {
 			continue
 		}
 
+		// Alerts of assigned issues go to the assignee instead of the channel, once per notification
+		routeToAssignee := setting.RouteToAssignee && issue.Assignee.IsSet()
+		if routeToAssignee && sentToAssignee {
+			continue
+		}
+
 		err := resilience.WithCircuitBreakerAndRetry(
 			ctx,
 			s.circuitBreaker,
 			func(ctx context.Context) error {
+				if routeToAssignee {
+					return s.assigneeChannel.SendToAssignee(ctx, &issue, &project, notification.WasReactivated)
+				}
+
 				return channel.Send(ctx, &issue, &project, setting.Config, notification.WasReactivated)
 			},
 			resilience.DefaultRetryOptions()...,
 		)
+		if routeToAssignee {
+			sentToAssignee = err == nil
+		}
 
 		if err != nil {
 			slog.Error("send notification failed",
-				"error", err, "channel", channel.Type())
+				"error", err, "channel", channel.Type(), "route_to_assignee", routeToAssignee)
 
 			err = s.notificationsUseCase.MarkNotificationAsFailed(ctx, notification.ID, err.Error())
 			if err != nil {
@@ -336,6 +353,10 @@ func filterSettings(notification *domain.NotificationWithSettings) []domain.Noti
 				continue
 			}
 
+			if rule.Environment != nil && *rule.Environment != notification.Environment {
+				continue
+			}
+
 			sendForNew := rule.IsNewError != nil && *rule.IsNewError && notification.IsNew
 			sendForRegress := rule.IsRegression != nil && *rule.IsRegression && notification.WasReactivated
 
@@ -478,6 +478,7 @@ func TestProcessOutbox(t *testing.T) {
 
 			svc := New(
 				[]Channel{mockEmailChannel},
+				mocknotificator.NewMockAssigneeChannel(t),
 				mockTxManager,
 				mockNotificationsUseCase,
 				mockIssuesRepo,
@@ -594,6 +595,7 @@ func TestProcessOutboxParallel(t *testing.T) {
 
 			svc := New(
 				[]Channel{mockEmailChannel},
+				mocknotificator.NewMockAssigneeChannel(t),
 				mockTxManager,
 				mockNotificationsUseCase,
 				mockIssuesRepo,
@@ -611,3 +613,153 @@ func TestProcessOutboxParallel(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterSettings_Environment(t *testing.T) {
+	t.Parallel()
+
+	production := "production"
+	settings := []domain.NotificationSetting{
+		{
+			ID:      domain.NotificationSettingID(1),
+			Enabled: true,
+			Rules: []domain.NotificationRule{
+				{IsNewError: boolPtr(true), Environment: &production},
+			},
+		},
+		{
+			ID:      domain.NotificationSettingID(2),
+			Enabled: true,
+			Rules: []domain.NotificationRule{
+				{IsNewError: boolPtr(true)},
+			},
+		},
+		{
+			ID:      domain.NotificationSettingID(3),
+			Enabled: true,
+			Rules: []domain.NotificationRule{
+				{IsRegression: boolPtr(true), Environment: &production},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		environment    string
+		wasReactivated bool
+		expectedIDs    []domain.NotificationSettingID
+	}{
+		{name: "matching environment", environment: "production", expectedIDs: []domain.NotificationSettingID{1, 2}},
+		{name: "other environment", environment: "staging", expectedIDs: []domain.NotificationSettingID{2}},
+		{name: "no environment", environment: "", expectedIDs: []domain.NotificationSettingID{2}},
+		{
+			// Escalating ignored issues are reported like regressions, with the environment of their latest event
+			name:           "escalation in matching environment",
+			environment:    "production",
+			wasReactivated: true,
+			expectedIDs:    []domain.NotificationSettingID{3},
+		},
+		{name: "escalation in other environment", environment: "staging", wasReactivated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			notification := &domain.NotificationWithSettings{
+				Notification: domain.Notification{
+					Level:          domain.IssueLevelError,
+					IsNew:          !tt.wasReactivated,
+					WasReactivated: tt.wasReactivated,
+					Environment:    tt.environment,
+				},
+				Settings: settings,
+			}
+
+			var ids []domain.NotificationSettingID
+			for _, setting := range filterSettings(notification) {
+				ids = append(ids, setting.ID)
+			}
+
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestCheckAndNotify_RouteToAssignee(t *testing.T) {
+	t.Parallel()
+
+	rules := []domain.NotificationRule{{IsNewError: boolPtr(true)}}
+	notification := &domain.NotificationWithSettings{
+		Notification: domain.Notification{
+			ID:      domain.NotificationID(1),
+			IssueID: domain.IssueID(1000),
+			Level:   domain.IssueLevelError,
+			IsNew:   true,
+		},
+		Settings: []domain.NotificationSetting{
+			{ID: 1, Type: domain.NotificationTypeEmail, Enabled: true, RouteToAssignee: true, Rules: rules},
+			{ID: 2, Type: domain.NotificationTypeMattermost, Enabled: true, RouteToAssignee: true, Rules: rules},
+		},
+	}
+	assigneeID := domain.UserID(7)
+
+	tests := []struct {
+		name     string
+		assignee domain.IssueAssignee
+	}{
+		{name: "assigned issue is sent to the assignee once", assignee: domain.IssueAssignee{UserID: &assigneeID}},
+		{name: "unassigned issue is sent to the channels"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockNotificationsUseCase := mockcontract.NewMockNotificationsUseCase(t)
+			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
+			mockProjectsRepo := mockcontract.NewMockProjectsRepository(t)
+			mockAssigneeChannel := mocknotificator.NewMockAssigneeChannel(t)
+			mockEmailChannel := newMockChannel(domain.NotificationTypeEmail)
+			mockMattermostChannel := newMockChannel(domain.NotificationTypeMattermost)
+
+			issue := domain.Issue{ID: 1000, ProjectID: 100, Assignee: tt.assignee}
+			mockIssuesRepo.EXPECT().GetByID(mock.Anything, issue.ID).Return(issue, nil)
+			mockProjectsRepo.EXPECT().GetByID(mock.Anything, issue.ProjectID).Return(domain.Project{ID: 100}, nil)
+
+			if tt.assignee.IsSet() {
+				mockAssigneeChannel.EXPECT().
+					SendToAssignee(mock.Anything, &issue, mock.Anything, false).
+					Return(nil).Once()
+				mockNotificationsUseCase.EXPECT().
+					MarkNotificationAsSent(mock.Anything, notification.ID).
+					Return(nil).Once()
+			} else {
+				mockEmailChannel.EXPECT().
+					Send(mock.Anything, &issue, mock.Anything, mock.Anything, false).
+					Return(nil).Once()
+				mockMattermostChannel.EXPECT().
+					Send(mock.Anything, &issue, mock.Anything, mock.Anything, false).
+					Return(nil).Once()
+				mockNotificationsUseCase.EXPECT().
+					MarkNotificationAsSent(mock.Anything, notification.ID).
+					Return(nil).Twice()
+			}
+
+			svc := New(
+				[]Channel{mockEmailChannel, mockMattermostChannel},
+				mockAssigneeChannel,
+				mockdb.NewMockTxManager(t),
+				mockNotificationsUseCase,
+				mockIssuesRepo,
+				mockProjectsRepo,
+				1,
+			)
+
+			skipped, _, err := svc.checkAndNotify(context.Background(), notification)
+			assert.NoError(t, err)
+			assert.False(t, skipped)
+			mockEmailChannel.AssertExpectations(t)
+			mockMattermostChannel.AssertExpectations(t)
+		})
+	}
+}
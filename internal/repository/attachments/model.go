@@ -0,0 +1,42 @@
+package attachments
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type attachmentModel struct {
+	ID          uint      `db:"id"`
+	ProjectID   uint      `db:"project_id"`
+	EventID     string    `db:"event_id"`
+	Name        string    `db:"name"`
+	ContentType string    `db:"content_type"`
+	Type        string    `db:"attachment_type"`
+	Size        uint      `db:"size"`
+	BlobKey     string    `db:"blob_key"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (m *attachmentModel) toDomain() domain.Attachment {
+	return domain.Attachment{
+		ID:          domain.AttachmentID(m.ID),
+		ProjectID:   domain.ProjectID(m.ProjectID),
+		EventID:     domain.EventID(m.EventID),
+		Name:        m.Name,
+		ContentType: m.ContentType,
+		Type:        m.Type,
+		Size:        m.Size,
+		BlobKey:     m.BlobKey,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func toDomainList(models []attachmentModel) []domain.Attachment {
+	attachments := make([]domain.Attachment, 0, len(models))
+	for i := range models {
+		attachments = append(attachments, models[i].toDomain())
+	}
+
+	return attachments
+}
@@ -0,0 +1,168 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, attachmentDTO domain.AttachmentDTO) (domain.Attachment, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO attachments (project_id, event_id, name, content_type, attachment_type, size, blob_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		attachmentDTO.ProjectID,
+		attachmentDTO.EventID,
+		attachmentDTO.Name,
+		attachmentDTO.ContentType,
+		attachmentDTO.Type,
+		attachmentDTO.Size,
+		attachmentDTO.BlobKey,
+	)
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("insert attachment: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[attachmentModel])
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("collect attachment: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) GetByID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.AttachmentID,
+) (domain.Attachment, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM attachments WHERE id = $1 AND project_id = $2 LIMIT 1`
+
+	rows, err := executor.Query(ctx, query, id, projectID)
+	if err != nil {
+		return domain.Attachment{}, fmt.Errorf("query attachment by ID: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[attachmentModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Attachment{}, domain.ErrEntityNotFound
+		}
+
+		return domain.Attachment{}, fmt.Errorf("collect attachment: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) ListByEventIDs(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	eventIDs []domain.EventID,
+) ([]domain.Attachment, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM attachments WHERE project_id = $1 AND event_id = ANY($2) ORDER BY id`
+
+	ids := make([]string, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := executor.Query(ctx, query, projectID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query attachments by events: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[attachmentModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect attachments: %w", err)
+	}
+
+	return toDomainList(models), nil
+}
+
+// TotalSizeByProject returns the size of all stored project attachments in bytes.
+func (r *Repository) TotalSizeByProject(ctx context.Context, projectID domain.ProjectID) (uint, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT COALESCE(SUM(size), 0) FROM attachments WHERE project_id = $1`
+
+	var total int64
+	if err := executor.QueryRow(ctx, query, projectID).Scan(&total); err != nil {
+		return 0, fmt.Errorf("query attachments size: %w", err)
+	}
+
+	return uint(total), nil //nolint:gosec // it's ok
+}
+
+func (r *Repository) ListOld(ctx context.Context, maxAge time.Duration, limit uint) ([]domain.Attachment, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT * FROM attachments
+WHERE created_at < (NOW() - $1::interval)
+ORDER BY id
+LIMIT $2`
+
+	rows, err := executor.Query(ctx, query, maxAge, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query old attachments: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[attachmentModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect attachments: %w", err)
+	}
+
+	return toDomainList(models), nil
+}
+
+func (r *Repository) DeleteByIDs(ctx context.Context, ids []domain.AttachmentID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM attachments WHERE id = ANY($1)`
+
+	_, err := executor.Exec(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("delete attachments: %w", err)
+	}
+
+	return nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -0,0 +1,36 @@
+package datascrubbing
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type dataScrubbingModel struct {
+	ProjectID          uint       `db:"project_id"`
+	DefaultScrubbers   bool       `db:"default_scrubbers"`
+	SensitiveFields    []string   `db:"sensitive_fields"`
+	Patterns           []string   `db:"patterns"`
+	AnonymizeIP        bool       `db:"anonymize_ip"`
+	DropRequestCookies bool       `db:"drop_request_cookies"`
+	DropRequestData    bool       `db:"drop_request_data"`
+	UpdatedAt          *time.Time `db:"updated_at"`
+}
+
+func (m *dataScrubbingModel) toDomain() domain.DataScrubbing {
+	scrubbing := domain.DataScrubbing{
+		ProjectID:          domain.ProjectID(m.ProjectID),
+		DefaultScrubbers:   m.DefaultScrubbers,
+		SensitiveFields:    m.SensitiveFields,
+		Patterns:           m.Patterns,
+		AnonymizeIP:        m.AnonymizeIP,
+		DropRequestCookies: m.DropRequestCookies,
+		DropRequestData:    m.DropRequestData,
+	}
+
+	if m.UpdatedAt != nil {
+		scrubbing.UpdatedAt = *m.UpdatedAt
+	}
+
+	return scrubbing
+}
@@ -0,0 +1,115 @@
+package datascrubbing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+// Get returns the data scrubbing of the project, projects without saved settings use the default scrubbers.
+func (r *Repository) Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT p.id AS project_id,
+       COALESCE(s.default_scrubbers, TRUE) AS default_scrubbers,
+       COALESCE(s.sensitive_fields, '{}') AS sensitive_fields,
+       COALESCE(s.patterns, '{}') AS patterns,
+       COALESCE(s.anonymize_ip, FALSE) AS anonymize_ip,
+       COALESCE(s.drop_request_cookies, FALSE) AS drop_request_cookies,
+       COALESCE(s.drop_request_data, FALSE) AS drop_request_data,
+       s.updated_at
+FROM projects p
+LEFT JOIN project_data_scrubbing s ON s.project_id = p.id
+WHERE p.id = $1 AND p.archived_at IS NULL`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return domain.DataScrubbing{}, fmt.Errorf("query data scrubbing: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dataScrubbingModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DataScrubbing{}, domain.ErrEntityNotFound
+		}
+
+		return domain.DataScrubbing{}, fmt.Errorf("collect data scrubbing: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) Upsert(ctx context.Context, scrubbing domain.DataScrubbing) (domain.DataScrubbing, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO project_data_scrubbing (project_id, default_scrubbers, sensitive_fields, patterns, anonymize_ip,
+                                    drop_request_cookies, drop_request_data, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+ON CONFLICT (project_id) DO UPDATE
+SET default_scrubbers = EXCLUDED.default_scrubbers,
+    sensitive_fields = EXCLUDED.sensitive_fields,
+    patterns = EXCLUDED.patterns,
+    anonymize_ip = EXCLUDED.anonymize_ip,
+    drop_request_cookies = EXCLUDED.drop_request_cookies,
+    drop_request_data = EXCLUDED.drop_request_data,
+    updated_at = EXCLUDED.updated_at
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		scrubbing.ProjectID,
+		scrubbing.DefaultScrubbers,
+		nonNil(scrubbing.SensitiveFields),
+		nonNil(scrubbing.Patterns),
+		scrubbing.AnonymizeIP,
+		scrubbing.DropRequestCookies,
+		scrubbing.DropRequestData,
+	)
+	if err != nil {
+		return domain.DataScrubbing{}, fmt.Errorf("upsert data scrubbing: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dataScrubbingModel])
+	if err != nil {
+		return domain.DataScrubbing{}, fmt.Errorf("collect data scrubbing: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
+
+// nonNil stores empty lists instead of NULLs.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,25 @@
+package environments
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type environmentModel struct {
+	ProjectID uint      `db:"project_id"`
+	Name      string    `db:"name"`
+	Hidden    bool      `db:"hidden"`
+	FirstSeen time.Time `db:"first_seen"`
+	LastSeen  time.Time `db:"last_seen"`
+}
+
+func (m *environmentModel) toDomain() domain.Environment {
+	return domain.Environment{
+		ProjectID: domain.ProjectID(m.ProjectID),
+		Name:      m.Name,
+		Hidden:    m.Hidden,
+		FirstSeen: m.FirstSeen,
+		LastSeen:  m.LastSeen,
+	}
+}
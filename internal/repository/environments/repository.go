@@ -0,0 +1,92 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+// Touch registers the environment of the project seen at the given time.
+func (r *Repository) Touch(ctx context.Context, projectID domain.ProjectID, name string, seenAt time.Time) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO project_environments (project_id, name, first_seen, last_seen)
+VALUES ($1, $2, $3, $3)
+ON CONFLICT (project_id, name) DO UPDATE
+SET first_seen = LEAST(project_environments.first_seen, EXCLUDED.first_seen),
+    last_seen = GREATEST(project_environments.last_seen, EXCLUDED.last_seen)`
+
+	if _, err := executor.Exec(ctx, query, projectID, name, seenAt); err != nil {
+		return fmt.Errorf("upsert project environment: %w", err)
+	}
+
+	return nil
+}
+
+// ListByProject returns the environments of the project ordered by name.
+func (r *Repository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Environment, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM project_environments WHERE project_id = $1 ORDER BY name`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("query project environments: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[environmentModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect project environments: %w", err)
+	}
+
+	environments := make([]domain.Environment, 0, len(models))
+	for i := range models {
+		environments = append(environments, models[i].toDomain())
+	}
+
+	return environments, nil
+}
+
+func (r *Repository) SetHidden(ctx context.Context, projectID domain.ProjectID, name string, hidden bool) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE project_environments SET hidden = $3 WHERE project_id = $1 AND name = $2`
+
+	tag, err := executor.Exec(ctx, query, projectID, name, hidden)
+	if err != nil {
+		return fmt.Errorf("update project environment: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -94,12 +94,6 @@ func (m *eventModel) toDomain() (domain.Event, error) {
 		release = *m.Release
 	}
 
-	//payload, err := gzip64ToJsonRawMessage(m.RawData)
-	//if err != nil {
-	//	panic(err)
-	//}
-	var payload json.RawMessage
-
 	return domain.Event{
 		Timestamp:     m.Timestamp,
 		GroupHash:     m.GroupHash,
@@ -110,7 +104,6 @@ func (m *eventModel) toDomain() (domain.Event, error) {
 		Platform:      m.Platform,
 		Release:       release,
 		Message:       m.Message,
-		Payload:       payload,
 		Tags:          m.Tags,
 		ServerName:    m.ServerName,
 		Environment:   m.Environment,
@@ -141,6 +134,15 @@ func (m *eventModel) toDomain() (domain.Event, error) {
 	}, nil
 }
 
+// payload decodes the raw event payload, it is left out of toDomain to keep event lists light.
+func (m *eventModel) payload() (json.RawMessage, error) {
+	if m.RawData == "" {
+		return nil, nil
+	}
+
+	return gzip64ToJsonRawMessage(m.RawData)
+}
+
 func fromDomain(event *domain.Event) (eventModel, error) {
 	var stacktrace *string
 	if event.ExceptionStacktrace != nil {
@@ -157,11 +159,15 @@ func fromDomain(event *domain.Event) (eventModel, error) {
 		release = &event.Release
 	}
 
-	//rawData, err := jsonRawMessageToGzip64(event.Payload)
-	//if err != nil {
-	//	return eventModel{}, err
-	//}
 	var rawData string
+	if len(event.Payload) != 0 {
+		rawDataStr, err := jsonRawMessageToGzip64(event.Payload)
+		if err != nil {
+			return eventModel{}, err
+		}
+
+		rawData = rawDataStr
+	}
 
 	return eventModel{
 		EventID:        string(event.ID),
@@ -10,6 +10,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 
+	"github.com/rom8726/warden/internal/common/searchquery"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/infra"
 	"github.com/rom8726/warden/pkg/kafka"
@@ -53,25 +54,26 @@ func (r *Repository) StoreWithFingerprints(ctx context.Context, event *domain.Ev
 	}
 
 	if event.GroupHash == "" {
-		event.GroupHash = event.FullFingerprint()
+		event.GroupHash = event.GroupingFingerprint()
 	}
 
 	return r.Store(ctx, event)
 }
 
+// FetchForIssue returns the latest events of an issue, merged issues have several fingerprints.
 func (r *Repository) FetchForIssue(
 	ctx context.Context,
 	projectID domain.ProjectID,
-	fingerprint string,
+	fingerprints []string,
 	limit uint,
 ) ([]domain.Event, error) {
 	query := `
 SELECT *
 FROM events
-WHERE project_id = ? AND group_hash = ?
+WHERE project_id = ? AND has(?, group_hash)
 ORDER BY timestamp DESC
 LIMIT ?`
-	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, fingerprint, limit)
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, fingerprints, limit)
 	if err != nil {
 		return nil, fmt.Errorf("query events: %w", err)
 	}
@@ -147,6 +149,9 @@ func (r *Repository) Timeseries(
 	if filter.Release != nil {
 		qb = qb.Where(sq.Eq{"release": *filter.Release})
 	}
+	if filter.Environment != nil {
+		qb = qb.Where(sq.Eq{"environment": *filter.Environment})
+	}
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -210,12 +215,12 @@ func (r *Repository) Timeseries(
 //nolint:gocyclo // need refactoring
 func (r *Repository) IssueTimeseries(
 	ctx context.Context,
-	fingerprint string,
+	fingerprints []string,
 	filter *domain.IssueEventsTimeseriesFilter,
 ) ([]domain.Timeseries, error) {
 	// --- 0. Валидация -------------------------------------------------------
-	if fingerprint == "" {
-		return nil, errors.New("fingerprint is empty")
+	if len(fingerprints) == 0 {
+		return nil, errors.New("fingerprints are empty")
 	}
 	if filter == nil {
 		return nil, errors.New("filter is nil")
@@ -248,7 +253,7 @@ func (r *Repository) IssueTimeseries(
 			"count() AS cnt",
 		).
 		From("events").
-		Where(sq.Eq{"group_hash": fingerprint}).
+		Where(sq.Eq{"group_hash": fingerprints}).
 		Where(sq.GtOrEq{"timestamp": start}).
 		Where(sq.Lt{"timestamp": end}).
 		GroupBy("group_key", "bucket").
@@ -260,6 +265,9 @@ func (r *Repository) IssueTimeseries(
 	if len(filter.Levels) > 0 {
 		qb = qb.Where(sq.Eq{"level": filter.Levels})
 	}
+	if filter.Environment != nil {
+		qb = qb.Where(sq.Eq{"environment": *filter.Environment})
+	}
 
 	query, args, err := qb.ToSql()
 	if err != nil {
@@ -401,6 +409,148 @@ LIMIT ?`
 	return events, nil
 }
 
+// FetchRecent returns the latest project events received since the given time.
+func (r *Repository) FetchRecent(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	since time.Time,
+	limit uint,
+) ([]domain.Event, error) {
+	const query = `
+SELECT * FROM events
+WHERE project_id = ? AND timestamp >= ?
+ORDER BY timestamp DESC
+LIMIT ?`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query recent events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.Event
+	for rows.Next() {
+		var evModel eventModel
+		if err := rows.ScanStruct(&evModel); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		event, err := evModel.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("convert event model to domain: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return events, nil
+}
+
+// FingerprintStats summarizes the stored events of the fingerprints.
+func (r *Repository) FingerprintStats(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+) (domain.FingerprintStats, error) {
+	const query = `
+SELECT
+  count() AS total,
+  min(timestamp) AS first_seen,
+  max(timestamp) AS last_seen,
+  argMin(ifNull(release, ''), timestamp) AS first_release,
+  groupUniqArrayIf(assumeNotNull(release), isNotNull(release)) AS releases
+FROM events
+WHERE project_id = ? AND has(?, group_hash)`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, fingerprints)
+	if err != nil {
+		return domain.FingerprintStats{}, fmt.Errorf("query fingerprint stats: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		stats domain.FingerprintStats
+		total uint64
+	)
+	if rows.Next() {
+		if err := rows.Scan(&total, &stats.FirstSeen, &stats.LastSeen, &stats.FirstRelease, &stats.Releases); err != nil {
+			return domain.FingerprintStats{}, fmt.Errorf("scan row: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return domain.FingerprintStats{}, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	stats.TotalEvents = uint(total)
+
+	return stats, nil
+}
+
+// IssueEventStats counts the users affected by the issue events since usersSince
+// and the issue events since eventsSince.
+func (r *Repository) IssueEventStats(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+	usersSince time.Time,
+	eventsSince time.Time,
+) (users, events uint, err error) {
+	const query = `
+SELECT
+  uniqExactIf(coalesce(user_id, user_email, request_ip), timestamp >= ?) AS users,
+  countIf(timestamp >= ?) AS events
+FROM events
+WHERE project_id = ? AND has(?, group_hash) AND timestamp >= least(?, ?)`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query,
+		usersSince, eventsSince, projectID, fingerprints, usersSince, eventsSince)
+	if err != nil {
+		return 0, 0, fmt.Errorf("query issue event stats: %w", err)
+	}
+	defer rows.Close()
+
+	var usersCount, eventsCount uint64
+	if rows.Next() {
+		if err := rows.Scan(&usersCount, &eventsCount); err != nil {
+			return 0, 0, fmt.Errorf("scan row: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return uint(usersCount), uint(eventsCount), nil
+}
+
+// LatestEnvironment returns the environment of the latest event of an issue, empty when it has no events.
+func (r *Repository) LatestEnvironment(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+) (string, error) {
+	const query = `
+SELECT environment
+FROM events
+WHERE project_id = ? AND has(?, group_hash)
+ORDER BY timestamp DESC
+LIMIT 1`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, fingerprints)
+	if err != nil {
+		return "", fmt.Errorf("query latest environment: %w", err)
+	}
+	defer rows.Close()
+
+	var environment string
+	if rows.Next() {
+		if err := rows.Scan(&environment); err != nil {
+			return "", fmt.Errorf("scan row: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return environment, nil
+}
+
 func (r *Repository) TopIssuesByRelease(
 	ctx context.Context,
 	projectID domain.ProjectID,
@@ -436,19 +586,22 @@ LIMIT ?`
 	return issues, nil
 }
 
+// AggregateBySegment counts the events of the release by the segment,
+// the empty environment counts the events of all environments.
 func (r *Repository) AggregateBySegment(
 	ctx context.Context,
 	projectID domain.ProjectID,
 	release string,
+	environment string,
 	segment domain.SegmentName,
 ) (map[string]uint, error) {
 	query := fmt.Sprintf(`
 SELECT %s, count() as cnt
 FROM events
-WHERE project_id = ? AND release = ?
+WHERE project_id = ? AND release = ? AND (? = '' OR environment = ?)
 GROUP BY %s
 ORDER BY cnt DESC`, segment, segment)
-	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, release)
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, release, environment, environment)
 	if err != nil {
 		return nil, fmt.Errorf("aggregate by segment: %w", err)
 	}
@@ -469,3 +622,339 @@ ORDER BY cnt DESC`, segment, segment)
 
 	return result, nil
 }
+
+// Search returns a page of the project events matching the search query, newest first.
+func (r *Repository) Search(ctx context.Context, filter *domain.EventSearchFilter) (domain.EventSearchPage, error) {
+	condition, args, err := searchquery.ClickHouseCondition(filter.Query)
+	if err != nil {
+		return domain.EventSearchPage{}, fmt.Errorf("build search condition: %w", err)
+	}
+
+	query := `
+SELECT *
+FROM events
+WHERE project_id = ? AND timestamp >= ? AND timestamp <= ?`
+	queryArgs := []any{filter.ProjectID, filter.TimeFrom, filter.TimeTo}
+
+	if len(filter.GroupHashes) > 0 {
+		query += ` AND has(?, group_hash)`
+		queryArgs = append(queryArgs, filter.GroupHashes)
+	}
+
+	if filter.Cursor != nil {
+		query += ` AND (timestamp, event_id) < (?, ?)`
+		queryArgs = append(queryArgs, filter.Cursor.Timestamp, string(filter.Cursor.EventID))
+	}
+
+	// One more event is fetched to know whether there is the next page
+	query += `
+  AND ` + condition + `
+ORDER BY timestamp DESC, event_id DESC
+LIMIT ?`
+	queryArgs = append(queryArgs, args...)
+	queryArgs = append(queryArgs, filter.Limit+1)
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, queryArgs...)
+	if err != nil {
+		return domain.EventSearchPage{}, fmt.Errorf("search events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.Event
+	for rows.Next() {
+		var evModel eventModel
+		if err := rows.ScanStruct(&evModel); err != nil {
+			return domain.EventSearchPage{}, fmt.Errorf("scan row: %w", err)
+		}
+		event, err := evModel.toDomain()
+		if err != nil {
+			return domain.EventSearchPage{}, fmt.Errorf("convert event model to domain: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.EventSearchPage{}, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	page := domain.EventSearchPage{Events: events}
+	if uint(len(events)) > filter.Limit {
+		page.Events = events[:filter.Limit]
+		last := page.Events[len(page.Events)-1]
+		page.NextCursor = &domain.EventCursor{Timestamp: last.Timestamp, EventID: last.ID}
+	}
+
+	return page, nil
+}
+
+// IssueTags returns the tags of the issue events with their most frequent values,
+// the tags of the most events first.
+func (r *Repository) IssueTags(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+	keysLimit uint,
+	valuesLimit uint,
+) ([]domain.IssueTag, error) {
+	const keysQuery = `
+SELECT tag_key, count() AS total, uniqExact(tag_value) AS unique_values
+FROM events
+ARRAY JOIN mapKeys(tags) AS tag_key, mapValues(tags) AS tag_value
+WHERE project_id = ? AND has(?, group_hash)
+GROUP BY tag_key
+ORDER BY total DESC, tag_key
+LIMIT ?`
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, keysQuery, projectID, fingerprints, keysLimit)
+	if err != nil {
+		return nil, fmt.Errorf("query issue tags: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		tags    []domain.IssueTag
+		keys    []string
+		indexes = make(map[string]int)
+	)
+	for rows.Next() {
+		var (
+			key          string
+			total        uint64
+			uniqueValues uint64
+		)
+		if err := rows.Scan(&key, &total, &uniqueValues); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		indexes[key] = len(tags)
+		keys = append(keys, key)
+		tags = append(tags, domain.IssueTag{
+			Key:          key,
+			TotalEvents:  uint(total),
+			UniqueValues: uint(uniqueValues),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	const valuesQuery = `
+SELECT tag_key, tag_value, count() AS cnt, min(timestamp) AS first_seen, max(timestamp) AS last_seen
+FROM events
+ARRAY JOIN mapKeys(tags) AS tag_key, mapValues(tags) AS tag_value
+WHERE project_id = ? AND has(?, group_hash) AND has(?, tag_key)
+GROUP BY tag_key, tag_value
+ORDER BY tag_key, cnt DESC, tag_value
+LIMIT ? BY tag_key`
+
+	valueRows, err := r.clickHouseClient.QueryWithRetries(ctx, valuesQuery, projectID, fingerprints, keys, valuesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("query issue tag values: %w", err)
+	}
+	defer valueRows.Close()
+
+	for valueRows.Next() {
+		var (
+			key   string
+			value domain.IssueTagValue
+			count uint64
+		)
+		if err := valueRows.Scan(&key, &value.Value, &count, &value.FirstSeen, &value.LastSeen); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		value.Count = uint(count)
+
+		if i, ok := indexes[key]; ok {
+			tags[i].TopValues = append(tags[i].TopValues, value)
+		}
+	}
+	if err := valueRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return tags, nil
+}
+
+// GetByID returns the project event with its payload.
+func (r *Repository) GetByID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	eventID domain.EventID,
+) (domain.Event, error) {
+	query := `
+SELECT *
+FROM events
+WHERE project_id = ? AND event_id = ?
+LIMIT 1`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, string(eventID))
+	if err != nil {
+		return domain.Event{}, fmt.Errorf("query event: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return domain.Event{}, fmt.Errorf("iterate rows: %w", err)
+		}
+
+		return domain.Event{}, domain.ErrEntityNotFound
+	}
+
+	var evModel eventModel
+	if err := rows.ScanStruct(&evModel); err != nil {
+		return domain.Event{}, fmt.Errorf("scan row: %w", err)
+	}
+
+	event, err := evModel.toDomain()
+	if err != nil {
+		return domain.Event{}, fmt.Errorf("convert event model to domain: %w", err)
+	}
+
+	event.Payload, err = evModel.payload()
+	if err != nil {
+		return domain.Event{}, fmt.Errorf("decode event payload: %w", err)
+	}
+
+	return event, nil
+}
+
+// IssueEventNeighbours returns the IDs of the issue events right before and after the cursor,
+// an ID is empty when there is no such event.
+func (r *Repository) IssueEventNeighbours(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+	cursor domain.EventCursor,
+) (prev, next domain.EventID, err error) {
+	prev, err = r.issueEventID(ctx, projectID, fingerprints,
+		`(timestamp, event_id) < (?, ?)`, "DESC", cursor.Timestamp, string(cursor.EventID))
+	if err != nil {
+		return "", "", fmt.Errorf("get previous event: %w", err)
+	}
+
+	next, err = r.issueEventID(ctx, projectID, fingerprints,
+		`(timestamp, event_id) > (?, ?)`, "ASC", cursor.Timestamp, string(cursor.EventID))
+	if err != nil {
+		return "", "", fmt.Errorf("get next event: %w", err)
+	}
+
+	return prev, next, nil
+}
+
+// IssueEdgeEventID returns the ID of the oldest or the latest event of the issue.
+func (r *Repository) IssueEdgeEventID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+	oldest bool,
+) (domain.EventID, error) {
+	order := "DESC"
+	if oldest {
+		order = "ASC"
+	}
+
+	eventID, err := r.issueEventID(ctx, projectID, fingerprints, "1", order)
+	if err != nil {
+		return "", err
+	}
+
+	if eventID == "" {
+		return "", domain.ErrEntityNotFound
+	}
+
+	return eventID, nil
+}
+
+// issueEventID returns the ID of the first issue event matching the condition in the order,
+// empty when there is none.
+func (r *Repository) issueEventID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprints []string,
+	condition string,
+	order string,
+	args ...any,
+) (domain.EventID, error) {
+	query := `
+SELECT event_id
+FROM events
+WHERE project_id = ? AND has(?, group_hash) AND ` + condition + `
+ORDER BY timestamp ` + order + `, event_id ` + order + `
+LIMIT 1`
+	queryArgs := append([]any{projectID, fingerprints}, args...)
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, queryArgs...)
+	if err != nil {
+		return "", fmt.Errorf("query event id: %w", err)
+	}
+	defer rows.Close()
+
+	var eventID string
+	if rows.Next() {
+		if err := rows.Scan(&eventID); err != nil {
+			return "", fmt.Errorf("scan row: %w", err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return domain.EventID(eventID), nil
+}
+
+// SearchGroups returns the event groups of the projects with events matching the search query.
+func (r *Repository) SearchGroups(
+	ctx context.Context,
+	projectIDs []domain.ProjectID,
+	searchQuery domain.SearchQuery,
+	timeFrom time.Time,
+	timeTo time.Time,
+	limit uint,
+) ([]domain.EventGroup, error) {
+	condition, args, err := searchquery.ClickHouseCondition(searchQuery)
+	if err != nil {
+		return nil, fmt.Errorf("build search condition: %w", err)
+	}
+
+	ids := make([]uint32, 0, len(projectIDs))
+	for _, id := range projectIDs {
+		ids = append(ids, uint32(id)) //nolint:gosec // project IDs fit UInt32 of the events table
+	}
+
+	query := `
+SELECT DISTINCT project_id, group_hash
+FROM events
+WHERE has(?, project_id) AND timestamp >= ? AND timestamp <= ?
+  AND ` + condition + `
+LIMIT ?`
+	queryArgs := append([]any{ids, timeFrom, timeTo}, args...)
+	queryArgs = append(queryArgs, limit)
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("search event groups: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []domain.EventGroup
+	for rows.Next() {
+		var (
+			projectID uint32
+			groupHash string
+		)
+		if err := rows.Scan(&projectID, &groupHash); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		groups = append(groups, domain.EventGroup{ProjectID: domain.ProjectID(projectID), GroupHash: groupHash})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,27 @@
+package groupingrules
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type groupingRuleModel struct {
+	ID         uint      `db:"id"`
+	ProjectID  uint      `db:"project_id"`
+	Type       string    `db:"type"`
+	Expression string    `db:"expression"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+func (m *groupingRuleModel) toDomain() domain.GroupingRule {
+	return domain.GroupingRule{
+		ID:         domain.GroupingRuleID(m.ID),
+		ProjectID:  domain.ProjectID(m.ProjectID),
+		Type:       domain.GroupingRuleType(m.Type),
+		Expression: m.Expression,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
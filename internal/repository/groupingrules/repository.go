@@ -0,0 +1,156 @@
+package groupingrules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, ruleDTO domain.GroupingRuleDTO) (domain.GroupingRule, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO grouping_rules (project_id, type, expression)
+VALUES ($1, $2, $3)
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query, ruleDTO.ProjectID, ruleDTO.Type, ruleDTO.Expression)
+	if err != nil {
+		return domain.GroupingRule{}, fmt.Errorf("insert grouping rule: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[groupingRuleModel])
+	if err != nil {
+		return domain.GroupingRule{}, fmt.Errorf("collect grouping rule: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) GetByID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.GroupingRuleID,
+) (domain.GroupingRule, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM grouping_rules WHERE id = $1 AND project_id = $2 LIMIT 1`
+
+	rows, err := executor.Query(ctx, query, id, projectID)
+	if err != nil {
+		return domain.GroupingRule{}, fmt.Errorf("query grouping rule by ID: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[groupingRuleModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GroupingRule{}, domain.ErrEntityNotFound
+		}
+
+		return domain.GroupingRule{}, fmt.Errorf("collect grouping rule: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// ListByProject returns project rules in the order they are applied.
+func (r *Repository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM grouping_rules WHERE project_id = $1 ORDER BY id`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("query grouping rules by project: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[groupingRuleModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect grouping rules: %w", err)
+	}
+
+	rules := make([]domain.GroupingRule, 0, len(models))
+	for i := range models {
+		rules = append(rules, models[i].toDomain())
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) Update(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.GroupingRuleID,
+	ruleType domain.GroupingRuleType,
+	expression string,
+) (domain.GroupingRule, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE grouping_rules
+	SET type = $1, expression = $2, updated_at = NOW()
+WHERE id = $3 AND project_id = $4
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query, ruleType, expression, id, projectID)
+	if err != nil {
+		return domain.GroupingRule{}, fmt.Errorf("update grouping rule: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[groupingRuleModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.GroupingRule{}, domain.ErrEntityNotFound
+		}
+
+		return domain.GroupingRule{}, fmt.Errorf("collect grouping rule: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) Delete(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM grouping_rules WHERE id = $1 AND project_id = $2`
+
+	result, err := executor.Exec(ctx, query, id, projectID)
+	if err != nil {
+		return fmt.Errorf("delete grouping rule: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	tx := db.TxFromContext(ctx)
+	if tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -0,0 +1,38 @@
+package inboundfilters
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type inboundFiltersModel struct {
+	ProjectID       uint       `db:"project_id"`
+	AllowedOrigins  []string   `db:"allowed_origins"`
+	WebCrawlers     bool       `db:"filter_web_crawlers"`
+	LegacyBrowsers  bool       `db:"filter_legacy_browsers"`
+	Localhost       bool       `db:"filter_localhost"`
+	BlockedIPRanges []string   `db:"blocked_ip_ranges"`
+	ErrorMessages   []string   `db:"error_messages"`
+	Releases        []string   `db:"releases"`
+	UpdatedAt       *time.Time `db:"updated_at"`
+}
+
+func (m *inboundFiltersModel) toDomain() domain.InboundFilters {
+	filters := domain.InboundFilters{
+		ProjectID:       domain.ProjectID(m.ProjectID),
+		AllowedOrigins:  m.AllowedOrigins,
+		WebCrawlers:     m.WebCrawlers,
+		LegacyBrowsers:  m.LegacyBrowsers,
+		Localhost:       m.Localhost,
+		BlockedIPRanges: m.BlockedIPRanges,
+		ErrorMessages:   m.ErrorMessages,
+		Releases:        m.Releases,
+	}
+
+	if m.UpdatedAt != nil {
+		filters.UpdatedAt = *m.UpdatedAt
+	}
+
+	return filters
+}
@@ -0,0 +1,118 @@
+package inboundfilters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+// Get returns the inbound filters of the project, projects without saved filters have none.
+func (r *Repository) Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT p.id AS project_id,
+       COALESCE(f.allowed_origins, '{}') AS allowed_origins,
+       COALESCE(f.filter_web_crawlers, FALSE) AS filter_web_crawlers,
+       COALESCE(f.filter_legacy_browsers, FALSE) AS filter_legacy_browsers,
+       COALESCE(f.filter_localhost, FALSE) AS filter_localhost,
+       COALESCE(f.blocked_ip_ranges, '{}') AS blocked_ip_ranges,
+       COALESCE(f.error_messages, '{}') AS error_messages,
+       COALESCE(f.releases, '{}') AS releases,
+       f.updated_at
+FROM projects p
+LEFT JOIN project_inbound_filters f ON f.project_id = p.id
+WHERE p.id = $1 AND p.archived_at IS NULL`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return domain.InboundFilters{}, fmt.Errorf("query inbound filters: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[inboundFiltersModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.InboundFilters{}, domain.ErrEntityNotFound
+		}
+
+		return domain.InboundFilters{}, fmt.Errorf("collect inbound filters: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) Upsert(ctx context.Context, filters domain.InboundFilters) (domain.InboundFilters, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO project_inbound_filters (project_id, allowed_origins, filter_web_crawlers, filter_legacy_browsers,
+                                     filter_localhost, blocked_ip_ranges, error_messages, releases, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+ON CONFLICT (project_id) DO UPDATE
+SET allowed_origins = EXCLUDED.allowed_origins,
+    filter_web_crawlers = EXCLUDED.filter_web_crawlers,
+    filter_legacy_browsers = EXCLUDED.filter_legacy_browsers,
+    filter_localhost = EXCLUDED.filter_localhost,
+    blocked_ip_ranges = EXCLUDED.blocked_ip_ranges,
+    error_messages = EXCLUDED.error_messages,
+    releases = EXCLUDED.releases,
+    updated_at = EXCLUDED.updated_at
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		filters.ProjectID,
+		nonNil(filters.AllowedOrigins),
+		filters.WebCrawlers,
+		filters.LegacyBrowsers,
+		filters.Localhost,
+		nonNil(filters.BlockedIPRanges),
+		nonNil(filters.ErrorMessages),
+		nonNil(filters.Releases),
+	)
+	if err != nil {
+		return domain.InboundFilters{}, fmt.Errorf("upsert inbound filters: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[inboundFiltersModel])
+	if err != nil {
+		return domain.InboundFilters{}, fmt.Errorf("collect inbound filters: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
+
+// nonNil stores empty lists instead of NULLs.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,55 @@
+package issueactivities
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type activityModel struct {
+	ID           uint            `db:"id"`
+	IssueID      uint            `db:"issue_id"`
+	Type         string          `db:"type"`
+	UserID       *uint           `db:"user_id"`
+	Username     *string         `db:"username"`
+	Data         json.RawMessage `db:"data"`
+	CreatedAt    time.Time       `db:"created_at"`
+	AssigneeName *string         `db:"assignee_name"`
+	CommentBody  *string         `db:"comment_body"`
+}
+
+func (m *activityModel) toDomain() (domain.IssueActivity, error) {
+	var data domain.IssueActivityData
+	if len(m.Data) > 0 {
+		if err := json.Unmarshal(m.Data, &data); err != nil {
+			return domain.IssueActivity{}, err
+		}
+	}
+
+	if m.AssigneeName != nil {
+		data.AssigneeName = *m.AssigneeName
+	}
+	data.CommentBody = m.CommentBody
+
+	var userID *domain.UserID
+	if m.UserID != nil {
+		id := domain.UserID(*m.UserID)
+		userID = &id
+	}
+
+	var username string
+	if m.Username != nil {
+		username = *m.Username
+	}
+
+	return domain.IssueActivity{
+		ID:        domain.IssueActivityID(m.ID),
+		IssueID:   domain.IssueID(m.IssueID),
+		Type:      domain.IssueActivityType(m.Type),
+		UserID:    userID,
+		Username:  username,
+		Data:      data,
+		CreatedAt: m.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package issueactivities
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, activity domain.IssueActivityDTO) error {
+	executor := r.getExecutor(ctx)
+
+	data, err := json.Marshal(activity.Data)
+	if err != nil {
+		return fmt.Errorf("marshal activity data: %w", err)
+	}
+
+	const query = `INSERT INTO issue_activities (issue_id, type, user_id, data) VALUES ($1, $2, $3, $4)`
+
+	_, err = executor.Exec(ctx, query, activity.IssueID, activity.Type, activity.UserID, data)
+	if err != nil {
+		return fmt.Errorf("insert issue activity: %w", err)
+	}
+
+	return nil
+}
+
+// ListByIssue returns a page of the issue activities, newest first, with the total number of them.
+func (r *Repository) ListByIssue(
+	ctx context.Context,
+	filter *domain.IssueActivitiesFilter,
+) ([]domain.IssueActivity, uint64, error) {
+	executor := r.getExecutor(ctx)
+
+	var total uint64
+	const countQuery = `SELECT COUNT(*) FROM issue_activities WHERE issue_id = $1`
+	if err := executor.QueryRow(ctx, countQuery, filter.IssueID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count issue activities: %w", err)
+	}
+
+	const query = `
+SELECT
+    a.id,
+    a.issue_id,
+    a.type,
+    a.user_id,
+    actor.username AS username,
+    a.data,
+    a.created_at,
+    COALESCE(assignee_user.username, assignee_team.name) AS assignee_name,
+    c.body AS comment_body
+FROM issue_activities a
+LEFT JOIN users actor ON actor.id = a.user_id
+LEFT JOIN users assignee_user ON assignee_user.id = (a.data->>'assigned_user_id')::int
+LEFT JOIN teams assignee_team ON assignee_team.id = (a.data->>'assigned_team_id')::int
+LEFT JOIN issue_comments c ON c.id = (a.data->>'comment_id')::bigint
+WHERE a.issue_id = $1
+ORDER BY a.created_at DESC, a.id DESC
+LIMIT $2 OFFSET $3`
+
+	offset := (max(filter.PageNum, 1) - 1) * filter.PerPage
+	rows, err := executor.Query(ctx, query, filter.IssueID, filter.PerPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query issue activities: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[activityModel])
+	if err != nil {
+		return nil, 0, fmt.Errorf("collect issue activities: %w", err)
+	}
+
+	activities := make([]domain.IssueActivity, 0, len(models))
+	for i := range models {
+		activity, err := models[i].toDomain()
+		if err != nil {
+			return nil, 0, fmt.Errorf("decode issue activity %d: %w", models[i].ID, err)
+		}
+
+		activities = append(activities, activity)
+	}
+
+	return activities, total, nil
+}
+
+// MoveToIssue moves the activities of merged issues to the primary one, their first seen entries are dropped
+// together with the merged issues.
+func (r *Repository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE issue_activities SET issue_id = $1 WHERE issue_id = ANY($2) AND type <> $3`
+
+	_, err := executor.Exec(ctx, query, toIssueID, fromIssueIDs, domain.IssueActivityFirstSeen)
+	if err != nil {
+		return fmt.Errorf("move issue activities: %w", err)
+	}
+
+	return nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -0,0 +1,48 @@
+package issuecomments
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type commentModel struct {
+	ID        uint      `db:"id"`
+	IssueID   uint      `db:"issue_id"`
+	ParentID  *uint     `db:"parent_id"`
+	UserID    *uint     `db:"user_id"`
+	Username  *string   `db:"username"`
+	Body      string    `db:"body"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (m *commentModel) toDomain() domain.IssueComment {
+	var parentID *domain.IssueCommentID
+	if m.ParentID != nil {
+		id := domain.IssueCommentID(*m.ParentID)
+		parentID = &id
+	}
+
+	var userID *domain.UserID
+	if m.UserID != nil {
+		id := domain.UserID(*m.UserID)
+		userID = &id
+	}
+
+	var username string
+	if m.Username != nil {
+		username = *m.Username
+	}
+
+	return domain.IssueComment{
+		ID:        domain.IssueCommentID(m.ID),
+		IssueID:   domain.IssueID(m.IssueID),
+		ParentID:  parentID,
+		UserID:    userID,
+		Username:  username,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package issuecomments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+const selectComments = `
+SELECT c.id, c.issue_id, c.parent_id, c.user_id, u.username, c.body, c.created_at, c.updated_at
+FROM issue_comments c
+LEFT JOIN users u ON u.id = c.user_id`
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, comment domain.IssueCommentDTO) (domain.IssueComment, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+WITH inserted AS (
+    INSERT INTO issue_comments (issue_id, parent_id, user_id, body)
+    VALUES ($1, $2, $3, $4)
+    RETURNING *
+)
+SELECT c.id, c.issue_id, c.parent_id, c.user_id, u.username, c.body, c.created_at, c.updated_at
+FROM inserted c
+LEFT JOIN users u ON u.id = c.user_id`
+
+	rows, err := executor.Query(ctx, query, comment.IssueID, comment.ParentID, comment.UserID, comment.Body)
+	if err != nil {
+		return domain.IssueComment{}, fmt.Errorf("insert issue comment: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[commentModel])
+	if err != nil {
+		return domain.IssueComment{}, fmt.Errorf("collect issue comment: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) GetByID(ctx context.Context, id domain.IssueCommentID) (domain.IssueComment, error) {
+	executor := r.getExecutor(ctx)
+
+	rows, err := executor.Query(ctx, selectComments+` WHERE c.id = $1`, id)
+	if err != nil {
+		return domain.IssueComment{}, fmt.Errorf("query issue comment: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[commentModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.IssueComment{}, domain.ErrEntityNotFound
+		}
+
+		return domain.IssueComment{}, fmt.Errorf("collect issue comment: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// ListByIssue returns all comments of the issue in the order they were written.
+func (r *Repository) ListByIssue(ctx context.Context, issueID domain.IssueID) ([]domain.IssueComment, error) {
+	executor := r.getExecutor(ctx)
+
+	rows, err := executor.Query(ctx, selectComments+` WHERE c.issue_id = $1 ORDER BY c.created_at, c.id`, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("query issue comments: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[commentModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect issue comments: %w", err)
+	}
+
+	comments := make([]domain.IssueComment, 0, len(models))
+	for i := range models {
+		comments = append(comments, models[i].toDomain())
+	}
+
+	return comments, nil
+}
+
+// Delete deletes the comment with all replies to it.
+func (r *Repository) Delete(ctx context.Context, id domain.IssueCommentID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM issue_comments WHERE id = $1`
+
+	tag, err := executor.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("delete issue comment: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE issue_comments SET issue_id = $1 WHERE issue_id = ANY($2)`
+
+	_, err := executor.Exec(ctx, query, toIssueID, fromIssueIDs)
+	if err != nil {
+		return fmt.Errorf("move issue comments: %w", err)
+	}
+
+	return nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -0,0 +1,131 @@
+package issuefingerprints
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+// Repository stores fingerprints merged into another issue.
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprint string,
+	issueID domain.IssueID,
+) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO issue_fingerprints (project_id, fingerprint, issue_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (project_id, fingerprint) DO UPDATE SET issue_id = EXCLUDED.issue_id, merged_at = NOW()`
+
+	_, err := executor.Exec(ctx, query, projectID, fingerprint, issueID)
+	if err != nil {
+		return fmt.Errorf("insert issue fingerprint: %w", err)
+	}
+
+	return nil
+}
+
+// Reassign moves fingerprints merged into the given issues to another issue.
+func (r *Repository) Reassign(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE issue_fingerprints SET issue_id = $1, merged_at = NOW() WHERE issue_id = ANY($2)`
+
+	_, err := executor.Exec(ctx, query, toIssueID, fromIssueIDs)
+	if err != nil {
+		return fmt.Errorf("reassign issue fingerprints: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListByIssue(ctx context.Context, issueID domain.IssueID) ([]string, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT fingerprint FROM issue_fingerprints WHERE issue_id = $1 ORDER BY merged_at, fingerprint`
+
+	rows, err := executor.Query(ctx, query, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("query issue fingerprints: %w", err)
+	}
+	defer rows.Close()
+
+	fingerprints, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("collect issue fingerprints: %w", err)
+	}
+
+	return fingerprints, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, issueID domain.IssueID, fingerprint string) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM issue_fingerprints WHERE issue_id = $1 AND fingerprint = $2`
+
+	tag, err := executor.Exec(ctx, query, issueID, fingerprint)
+	if err != nil {
+		return fmt.Errorf("delete issue fingerprint: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// GetIssueFingerprint returns the fingerprint of the issue a merged fingerprint belongs to.
+func (r *Repository) GetIssueFingerprint(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	fingerprint string,
+) (string, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT i.fingerprint
+FROM issue_fingerprints f
+JOIN issues i ON i.id = f.issue_id
+WHERE f.project_id = $1 AND f.fingerprint = $2`
+
+	var issueFingerprint string
+	err := executor.QueryRow(ctx, query, projectID, fingerprint).Scan(&issueFingerprint)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrEntityNotFound
+		}
+
+		return "", fmt.Errorf("query issue fingerprint: %w", err)
+	}
+
+	return issueFingerprint, nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
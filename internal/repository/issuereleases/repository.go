@@ -39,6 +39,41 @@ ON CONFLICT (issue_id, release_id) DO NOTHING
 	return nil
 }
 
+// MoveToIssue copies the releases of the given issues to another issue.
+// Rows of the source issues are removed together with the issues.
+func (r *Repository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO issue_releases (issue_id, release_id, first_seen_in)
+SELECT $1, release_id, bool_or(first_seen_in)
+FROM issue_releases
+WHERE issue_id = ANY($2)
+GROUP BY release_id
+ON CONFLICT (issue_id, release_id) DO UPDATE
+SET first_seen_in = issue_releases.first_seen_in OR EXCLUDED.first_seen_in
+`
+	_, err := executor.Exec(ctx, query, toIssueID, fromIssueIDs)
+	if err != nil {
+		return fmt.Errorf("move issue_releases: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the given releases of the issue.
+func (r *Repository) Delete(ctx context.Context, issueID domain.IssueID, releaseIDs []domain.ReleaseID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM issue_releases WHERE issue_id = $1 AND release_id = ANY($2)`
+	_, err := executor.Exec(ctx, query, issueID, releaseIDs)
+	if err != nil {
+		return fmt.Errorf("delete issue_releases: %w", err)
+	}
+
+	return nil
+}
+
 //nolint:ireturn // it's ok here
 func (r *Repository) getExecutor(ctx context.Context) db.Tx {
 	if tx := db.TxFromContext(ctx); tx != nil {
@@ -21,6 +21,13 @@ type issueModel struct {
 	CreatedAt          time.Time  `db:"created_at"           json:"created_at"`
 	UpdatedAt          time.Time  `db:"updated_at"           json:"updated_at"`
 	LastNotificationAt *time.Time `db:"last_notification_at" json:"last_notification_at"`
+	AssignedUserID     *uint      `db:"assigned_user_id"     json:"assigned_user_id"`
+	AssignedTeamID     *uint      `db:"assigned_team_id"     json:"assigned_team_id"`
+	Escalating         bool       `db:"escalating"           json:"escalating"`
+	// Release bound of the resolution, set together with resolved_in_release_at
+	ResolvedInRelease     *string    `db:"resolved_in_release"      json:"resolved_in_release"`
+	ResolvedInNextRelease bool       `db:"resolved_in_next_release" json:"resolved_in_next_release"`
+	ResolvedInReleaseAt   *time.Time `db:"resolved_in_release_at"   json:"resolved_in_release_at"`
 }
 
 func (m *issueModel) toDomain() domain.Issue {
@@ -39,9 +46,39 @@ func (m *issueModel) toDomain() domain.Issue {
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
 		LastNotificationAt: m.LastNotificationAt,
+		Assignee:           makeAssignee(m.AssignedUserID, m.AssignedTeamID),
+		Escalating:         m.Escalating,
+		ResolvedInRelease:  makeResolvedInRelease(m.ResolvedInRelease, m.ResolvedInNextRelease, m.ResolvedInReleaseAt),
 	}
 }
 
+func makeResolvedInRelease(version *string, nextRelease bool, at *time.Time) *domain.IssueResolvedInRelease {
+	if at == nil {
+		return nil
+	}
+
+	resolvedInRelease := &domain.IssueResolvedInRelease{NextRelease: nextRelease}
+	if version != nil {
+		resolvedInRelease.Version = *version
+	}
+
+	return resolvedInRelease
+}
+
+func makeAssignee(userID, teamID *uint) domain.IssueAssignee {
+	var assignee domain.IssueAssignee
+	if userID != nil {
+		id := domain.UserID(*userID)
+		assignee.UserID = &id
+	}
+	if teamID != nil {
+		id := domain.TeamID(*teamID)
+		assignee.TeamID = &id
+	}
+
+	return assignee
+}
+
 type issueExtendedModel struct {
 	issueModel
 	ProjectName        string     `db:"project_name"         json:"project_name"`
@@ -86,10 +86,24 @@ func (r *Repository) UpsertIssue(ctx context.Context, issue domain.IssueDTO) (do
 
 	query := `
 WITH old AS (
-  SELECT status
+  SELECT status, resolved_in_release, resolved_in_next_release, resolved_in_release_at
   FROM issues
   WHERE project_id = $1 AND fingerprint = $2
 ),
+-- Events of releases older than the fix release don't reactivate an issue resolved in a release.
+-- Only semver releases are comparable, events without a release or of other releases reactivate it.
+outdated AS (
+  SELECT TRUE
+  FROM old
+  JOIN releases er ON er.id = $9
+  WHERE old.status = 'resolved' AND old.resolved_in_release_at IS NOT NULL
+    AND er.version_key IS NOT NULL AND release_version_key(old.resolved_in_release) IS NOT NULL
+    AND CASE
+      WHEN old.resolved_in_next_release
+        THEN er.version_key <= release_version_key(old.resolved_in_release) COLLATE "C"
+      ELSE er.version_key < release_version_key(old.resolved_in_release) COLLATE "C"
+    END
+),
 upserted AS (
   INSERT INTO issues (
     project_id, fingerprint, source, status,
@@ -102,15 +116,29 @@ upserted AS (
     last_seen = GREATEST(issues.last_seen, EXCLUDED.last_seen),
     status = CASE
                WHEN issues.status = 'ignored' THEN 'ignored'
+               WHEN EXISTS (SELECT 1 FROM outdated) THEN issues.status
                ELSE EXCLUDED.status
              END,
+    resolved_in_release_at = CASE WHEN EXISTS (SELECT 1 FROM outdated) THEN issues.resolved_in_release_at END,
     total_events = issues.total_events + 1
   RETURNING id, first_seen, last_seen
+),
+-- New and reactivated issues show up in the issue activity feed
+activity AS (
+  INSERT INTO issue_activities (issue_id, type, created_at)
+  SELECT
+    upserted.id,
+    CASE WHEN upserted.first_seen = upserted.last_seen THEN 'first_seen' ELSE 'regression' END,
+    upserted.last_seen
+  FROM upserted
+  LEFT JOIN old ON TRUE
+  WHERE upserted.first_seen = upserted.last_seen
+     OR (old.status = 'resolved' AND NOT EXISTS (SELECT 1 FROM outdated))
 )
 SELECT
   upserted.id,
   (first_seen = last_seen) AS is_new,
-  COALESCE(old.status = 'resolved', false) AS was_reactivated
+  COALESCE(old.status = 'resolved', false) AND NOT EXISTS (SELECT 1 FROM outdated) AS was_reactivated
 FROM upserted
 LEFT JOIN old ON TRUE;`
 
@@ -123,6 +151,7 @@ LEFT JOIN old ON TRUE;`
 		issue.Level,
 		issue.Platform,
 		time.Now(),
+		issue.ReleaseID,
 	).Scan(&res.ID, &res.IsNew, &res.WasReactivated)
 
 	var wasReactivated bool
@@ -178,6 +207,13 @@ func (r *Repository) ListExtended(
 			"r.resolved_by",
 			"r.resolved_at",
 			"resolver.username AS resolved_by_username",
+			"issues.assigned_user_id",
+			"issues.assigned_team_id",
+			"COALESCE(assignee_user.username, assignee_team.name) AS assignee_name",
+			"issues.escalating",
+			"issues.resolved_in_release",
+			"issues.resolved_in_next_release",
+			"issues.resolved_in_release_at",
 		).
 		From("issues").
 		LeftJoin("projects ON issues.project_id = projects.id").
@@ -189,6 +225,8 @@ func (r *Repository) ListExtended(
 			LIMIT 1
 		) r ON true`).
 		LeftJoin("users resolver ON resolver.id = r.resolved_by").
+		LeftJoin("users assignee_user ON assignee_user.id = issues.assigned_user_id").
+		LeftJoin("teams assignee_team ON assignee_team.id = issues.assigned_team_id").
 		LeftJoin("team_members tm ON tm.team_id = projects.team_id AND tm.user_id = ?", uid).
 		Where("projects.archived_at IS NULL")
 
@@ -223,6 +261,12 @@ func (r *Repository) ListExtended(
 		baseCount = baseCount.Where("issues.status = ?", *filter.Status)
 	}
 
+	if filter.Assigned != nil {
+		assignedCond := assignedCondition(*filter.Assigned, uid)
+		baseSelect = baseSelect.Where(assignedCond)
+		baseCount = baseCount.Where(assignedCond)
+	}
+
 	if !filter.TimeFrom.IsZero() {
 		baseSelect = baseSelect.Where("issues.last_seen >= ?", filter.TimeFrom)
 		baseCount = baseCount.Where("issues.last_seen >= ?", filter.TimeFrom)
@@ -233,6 +277,12 @@ func (r *Repository) ListExtended(
 		baseCount = baseCount.Where("issues.last_seen <= ?", filter.TimeTo)
 	}
 
+	if len(filter.EventGroups) > 0 {
+		groupsCond := eventGroupsCondition(filter.EventGroups)
+		baseSelect = baseSelect.Where(groupsCond)
+		baseCount = baseCount.Where(groupsCond)
+	}
+
 	// Build the order by clause based on the filter
 	orderByBuilder := baseSelect.OrderBy(
 		`CASE level 
@@ -300,6 +350,10 @@ func (r *Repository) ListExtended(
 		var resolvedBy pgtype.Int4
 		var resolvedAt pgtype.Timestamptz
 		var resolvedByUsername pgtype.Text
+		var assignee assigneeColumns
+		var resolvedInRelease *string
+		var resolvedInNextRelease bool
+		var resolvedInReleaseAt *time.Time
 
 		if err := rows.Scan(
 			&is.ID,
@@ -319,10 +373,20 @@ func (r *Repository) ListExtended(
 			&resolvedBy,
 			&resolvedAt,
 			&resolvedByUsername,
+			&assignee.userID,
+			&assignee.teamID,
+			&assignee.name,
+			&is.Escalating,
+			&resolvedInRelease,
+			&resolvedInNextRelease,
+			&resolvedInReleaseAt,
 		); err != nil {
 			return nil, 0, err
 		}
 
+		is.Assignee = assignee.toDomain()
+		is.ResolvedInRelease = makeResolvedInRelease(resolvedInRelease, resolvedInNextRelease, resolvedInReleaseAt)
+
 		if resolvedBy.Valid {
 			uid := domain.UserID(resolvedBy.Int32) //nolint:gosec //it's ok here
 			is.ResolvedBy = &uid
@@ -370,9 +434,15 @@ WITH recent AS (
         last_seen,
         total_events,
         issues.created_at,
-        issues.updated_at
+        issues.updated_at,
+        issues.assigned_user_id,
+        issues.assigned_team_id,
+        COALESCE(assignee_user.username, assignee_team.name) AS assignee_name,
+        issues.escalating
     FROM issues
     LEFT JOIN projects ON projects.id = issues.project_id
+    LEFT JOIN users assignee_user ON assignee_user.id = issues.assigned_user_id
+    LEFT JOIN teams assignee_team ON assignee_team.id = issues.assigned_team_id
     LEFT JOIN team_members tm ON tm.team_id = projects.team_id AND tm.user_id = $1
     WHERE status = 'unresolved' AND (tm.user_id IS NOT NULL OR projects.team_id IS NULL) AND projects.archived_at IS NULL
     ORDER BY last_seen DESC
@@ -406,6 +476,7 @@ LIMIT $2;`
 	issues := make([]domain.IssueExtended, 0, limit)
 	for rows.Next() {
 		var is domain.IssueExtended
+		var assignee assigneeColumns
 		if err := rows.Scan(
 			&is.ID,
 			&is.ProjectID,
@@ -421,9 +492,14 @@ LIMIT $2;`
 			&is.TotalEvents,
 			&is.CreatedAt,
 			&is.UpdatedAt,
+			&assignee.userID,
+			&assignee.teamID,
+			&assignee.name,
+			&is.Escalating,
 		); err != nil {
 			return nil, err
 		}
+		is.Assignee = assignee.toDomain()
 		issues = append(issues, is)
 	}
 
@@ -754,9 +830,14 @@ func (r *Repository) MostFrequent(
 	return issues, nil
 }
 
+// UpdateStatus sets the issue status, a manually changed issue is no longer escalating
+// and no longer bound to a release.
 func (r *Repository) UpdateStatus(ctx context.Context, issueID domain.IssueID, status domain.IssueStatus) error {
 	executor := r.getExecutor(ctx)
-	query := "UPDATE issues SET status = $1, updated_at = NOW() WHERE id = $2"
+	query := `
+UPDATE issues
+SET status = $1, escalating = FALSE, resolved_in_release_at = NULL, updated_at = NOW()
+WHERE id = $2`
 
 	_, err := executor.Exec(ctx, query, status, issueID)
 	if err != nil {
@@ -766,6 +847,165 @@ func (r *Repository) UpdateStatus(ctx context.Context, issueID domain.IssueID, s
 	return nil
 }
 
+// SetResolvedInRelease bounds the resolution of the issue to the release.
+func (r *Repository) SetResolvedInRelease(
+	ctx context.Context,
+	issueID domain.IssueID,
+	release domain.IssueResolvedInRelease,
+) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE issues
+SET resolved_in_release = NULLIF($1, ''),
+    resolved_in_next_release = $2,
+    resolved_in_release_at = NOW(),
+    updated_at = NOW()
+WHERE id = $3`
+
+	_, err := executor.Exec(ctx, query, release.Version, release.NextRelease, issueID)
+	if err != nil {
+		return fmt.Errorf("set resolved in release: %w", err)
+	}
+
+	return nil
+}
+
+// Escalate unignores the ignored issue and marks it as escalating,
+// it returns false when the issue is no longer ignored.
+func (r *Repository) Escalate(ctx context.Context, issueID domain.IssueID) (bool, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE issues
+SET status = 'unresolved', escalating = TRUE, updated_at = NOW()
+WHERE id = $1 AND status = 'ignored'`
+
+	tag, err := executor.Exec(ctx, query, issueID)
+	if err != nil {
+		return false, fmt.Errorf("escalate issue: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateAssignee sets the user or the team owning the issue, an empty assignee unassigns it.
+func (r *Repository) UpdateAssignee(ctx context.Context, issueID domain.IssueID, assignee domain.IssueAssignee) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE issues
+SET assigned_user_id = $1, assigned_team_id = $2, updated_at = NOW()
+WHERE id = $3`
+
+	tag, err := executor.Exec(ctx, query, assignee.UserID, assignee.TeamID, issueID)
+	if err != nil {
+		return fmt.Errorf("update issue assignee: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// MergeInto adds the counters of the given issues to the primary issue and deletes them.
+func (r *Repository) MergeInto(ctx context.Context, primaryID domain.IssueID, issueIDs []domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const updateQuery = `
+UPDATE issues AS p
+SET total_events = p.total_events + m.total_events,
+    first_seen = LEAST(p.first_seen, m.first_seen),
+    last_seen = GREATEST(p.last_seen, m.last_seen),
+    updated_at = NOW()
+FROM (
+  SELECT SUM(total_events) AS total_events, MIN(first_seen) AS first_seen, MAX(last_seen) AS last_seen
+  FROM issues
+  WHERE id = ANY($2)
+) AS m
+WHERE p.id = $1 AND m.total_events IS NOT NULL`
+
+	_, err := executor.Exec(ctx, updateQuery, primaryID, issueIDs)
+	if err != nil {
+		return fmt.Errorf("update primary issue: %w", err)
+	}
+
+	const deleteQuery = `DELETE FROM issues WHERE id = ANY($1)`
+
+	_, err = executor.Exec(ctx, deleteQuery, issueIDs)
+	if err != nil {
+		return fmt.Errorf("delete merged issues: %w", err)
+	}
+
+	return nil
+}
+
+// Create inserts an issue with the given counters, used to split a fingerprint out of a merged issue.
+func (r *Repository) Create(ctx context.Context, issue domain.Issue) (domain.IssueID, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO issues (
+  project_id, fingerprint, source, status,
+  title, level, platform,
+  first_seen, last_seen, total_events
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id`
+
+	var id uint
+	err := executor.QueryRow(ctx, query,
+		issue.ProjectID,
+		issue.Fingerprint,
+		issue.Source,
+		issue.Status,
+		issue.Title,
+		issue.Level,
+		issue.Platform,
+		issue.FirstSeen,
+		issue.LastSeen,
+		issue.TotalEvents,
+	).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("insert issue: %w", err)
+	}
+
+	return domain.IssueID(id), nil
+}
+
+func (r *Repository) SubtractEvents(ctx context.Context, issueID domain.IssueID, count uint) error {
+	executor := r.getExecutor(ctx)
+	const query = `
+UPDATE issues
+SET total_events = GREATEST(total_events - $1, 0), updated_at = NOW()
+WHERE id = $2`
+
+	_, err := executor.Exec(ctx, query, count, issueID)
+	if err != nil {
+		return fmt.Errorf("exec update: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSeen sets the timestamps of the first and the last events of the issue.
+func (r *Repository) UpdateSeen(ctx context.Context, issueID domain.IssueID, firstSeen, lastSeen time.Time) error {
+	executor := r.getExecutor(ctx)
+	const query = `
+UPDATE issues
+SET first_seen = $1, last_seen = $2, updated_at = NOW()
+WHERE id = $3`
+
+	_, err := executor.Exec(ctx, query, firstSeen, lastSeen, issueID)
+	if err != nil {
+		return fmt.Errorf("exec update: %w", err)
+	}
+
+	return nil
+}
+
 func (r *Repository) MarkAsNotified(ctx context.Context, issueID domain.IssueID) error {
 	executor := r.getExecutor(ctx)
 	const query = "UPDATE issues SET last_notification_at = NOW(), updated_at = NOW() WHERE id = $1"
@@ -963,6 +1203,20 @@ WHERE i.project_id = $1
 	return result, nil
 }
 
+// Delete deletes the issues, their resolution history has to be deleted first.
+func (r *Repository) Delete(ctx context.Context, issueIDs []domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM issues WHERE id = ANY($1)`
+
+	_, err := executor.Exec(ctx, query, issueIDs)
+	if err != nil {
+		return fmt.Errorf("delete issues: %w", err)
+	}
+
+	return nil
+}
+
 func (r *Repository) DeleteOld(ctx context.Context, maxAge time.Duration, limit uint) (uint, error) {
 	executor := r.getExecutor(ctx)
 	const query = `
@@ -990,3 +1244,56 @@ func (r *Repository) getExecutor(ctx context.Context) db.Tx {
 
 	return r.db
 }
+
+// eventGroupsCondition matches the issues of the event groups, including the issues they are merged into.
+func eventGroupsCondition(groups []domain.EventGroup) sq.Sqlizer {
+	projectIDs := make([]int64, 0, len(groups))
+	fingerprints := make([]string, 0, len(groups))
+	for _, group := range groups {
+		projectIDs = append(projectIDs, int64(group.ProjectID)) //nolint:gosec // it's ok
+		fingerprints = append(fingerprints, group.GroupHash)
+	}
+
+	return sq.Expr(`(
+		(issues.project_id, issues.fingerprint) IN (SELECT * FROM unnest(?::int[], ?::text[]))
+		OR issues.id IN (
+			SELECT issue_fingerprints.issue_id
+			FROM issue_fingerprints
+			WHERE (issue_fingerprints.project_id, issue_fingerprints.fingerprint)
+				IN (SELECT * FROM unnest(?::int[], ?::text[]))
+		)
+	)`, projectIDs, fingerprints, projectIDs, fingerprints)
+}
+
+// assigneeColumns scans the assignee columns of the issue list queries.
+type assigneeColumns struct {
+	userID pgtype.Int4
+	teamID pgtype.Int4
+	name   pgtype.Text
+}
+
+func (c *assigneeColumns) toDomain() domain.IssueAssignee {
+	var assignee domain.IssueAssignee
+	if c.userID.Valid {
+		id := domain.UserID(c.userID.Int32) //nolint:gosec //it's ok here
+		assignee.UserID = &id
+	}
+	if c.teamID.Valid {
+		id := domain.TeamID(c.teamID.Int32) //nolint:gosec //it's ok here
+		assignee.TeamID = &id
+	}
+	assignee.Name = c.name.String
+
+	return assignee
+}
+
+func assignedCondition(assigned domain.IssueAssignedFilter, uid domain.UserID) sq.Sqlizer {
+	switch assigned {
+	case domain.IssueAssignedToMe:
+		return sq.Eq{"issues.assigned_user_id": uid}
+	case domain.IssueAssignedToMyTeams:
+		return sq.Expr("issues.assigned_team_id IN (SELECT team_id FROM team_members WHERE user_id = ?)", uid)
+	default:
+		return sq.Expr("issues.assigned_user_id IS NULL AND issues.assigned_team_id IS NULL")
+	}
+}
@@ -0,0 +1,140 @@
+//go:build integration
+
+package issues_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/repository/issues"
+	"github.com/rom8726/warden/tests/runner"
+)
+
+func TestRepository_UpsertIssue_ResolvedInRelease(t *testing.T) {
+	pool := runner.StartMigratedPostgres(t, "../../../migrations/postgresql")
+	repo := issues.New(pool)
+	ctx := context.Background()
+
+	_, err := pool.Exec(ctx, `INSERT INTO projects (id, name, public_key) VALUES (1, 'Project1', 'key-1')`)
+	require.NoError(t, err)
+
+	// Releases are seen oldest first, the release of events without one is the oldest
+	releaseIDs := make(map[string]domain.ReleaseID)
+	for i, version := range []string{"unknown", "build-42", "1.3.0", "1.4.0", "build-43", "1.5.0"} {
+		var id domain.ReleaseID
+		err := pool.QueryRow(ctx, `
+INSERT INTO releases (project_id, version, created_at)
+VALUES (1, $1, NOW() - make_interval(days => 30 - $2::int))
+RETURNING id`, version, i).Scan(&id)
+		require.NoError(t, err)
+
+		releaseIDs[version] = id
+	}
+
+	tests := []struct {
+		name              string
+		resolvedInRelease string
+		nextRelease       bool
+		eventRelease      string
+		reactivated       bool
+	}{
+		{
+			name:              "older release",
+			resolvedInRelease: "1.4.0",
+			eventRelease:      "1.3.0",
+			reactivated:       false,
+		},
+		{
+			name:              "fix release",
+			resolvedInRelease: "1.4.0",
+			eventRelease:      "1.4.0",
+			reactivated:       true,
+		},
+		{
+			name:              "next release, current release",
+			resolvedInRelease: "1.4.0",
+			nextRelease:       true,
+			eventRelease:      "1.4.0",
+			reactivated:       false,
+		},
+		{
+			name:              "next release, newer release",
+			resolvedInRelease: "1.4.0",
+			nextRelease:       true,
+			eventRelease:      "1.5.0",
+			reactivated:       true,
+		},
+		{
+			name:              "event without release",
+			resolvedInRelease: "1.4.0",
+			eventRelease:      "unknown",
+			reactivated:       true,
+		},
+		{
+			name:              "next release, event without release",
+			resolvedInRelease: "1.4.0",
+			nextRelease:       true,
+			eventRelease:      "unknown",
+			reactivated:       true,
+		},
+		{
+			name:              "event of non-semver release",
+			resolvedInRelease: "1.4.0",
+			eventRelease:      "build-42",
+			reactivated:       true,
+		},
+		{
+			name:              "non-semver fix release",
+			resolvedInRelease: "build-43",
+			eventRelease:      "build-42",
+			reactivated:       true,
+		},
+		{
+			name:              "next release, non-semver latest release",
+			resolvedInRelease: "build-43",
+			nextRelease:       true,
+			eventRelease:      "build-42",
+			reactivated:       true,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fingerprint := fmt.Sprintf("fingerprint-%d", i)
+			_, err := pool.Exec(ctx, `
+INSERT INTO issues (
+    project_id, fingerprint, status, title, level,
+    resolved_in_release, resolved_in_next_release, resolved_in_release_at
+)
+VALUES (1, $1, 'resolved', 'boom', 'error', $2, $3, NOW())`,
+				fingerprint, tt.resolvedInRelease, tt.nextRelease)
+			require.NoError(t, err)
+
+			result, err := repo.UpsertIssue(ctx, domain.IssueDTO{
+				ProjectID:   1,
+				Fingerprint: fingerprint,
+				Source:      domain.SourceEvent,
+				Status:      domain.IssueStatusUnresolved,
+				Title:       "boom",
+				Level:       domain.IssueLevelError,
+				ReleaseID:   releaseIDs[tt.eventRelease],
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.reactivated, result.WasReactivated)
+
+			var status string
+			err = pool.QueryRow(ctx, `SELECT status::text FROM issues WHERE id = $1`, result.ID).Scan(&status)
+			require.NoError(t, err)
+
+			expectedStatus := domain.IssueStatusResolved
+			if tt.reactivated {
+				expectedStatus = domain.IssueStatusUnresolved
+			}
+			require.Equal(t, string(expectedStatus), status)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package issuesnoozes
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type snoozeModel struct {
+	IssueID      uint       `db:"issue_id"`
+	ProjectID    uint       `db:"project_id"`
+	Level        string     `db:"level"`
+	Fingerprints []string   `db:"fingerprints"`
+	Until        *time.Time `db:"until"`
+	Count        *uint      `db:"count"`
+	UserCount    *uint      `db:"user_count"`
+	RatePerHour  *uint      `db:"rate_per_hour"`
+	EventsBase   uint       `db:"events_base"`
+	TotalEvents  uint       `db:"total_events"`
+	CreatedBy    *uint      `db:"created_by"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+func (m *snoozeModel) toDomain() domain.IssueSnooze {
+	var createdBy *domain.UserID
+	if m.CreatedBy != nil {
+		id := domain.UserID(*m.CreatedBy)
+		createdBy = &id
+	}
+
+	return domain.IssueSnooze{
+		IssueID:      domain.IssueID(m.IssueID),
+		ProjectID:    domain.ProjectID(m.ProjectID),
+		Level:        domain.IssueLevel(m.Level),
+		Fingerprints: m.Fingerprints,
+		Conditions: domain.IssueIgnoreConditions{
+			Until:       m.Until,
+			Count:       m.Count,
+			UserCount:   m.UserCount,
+			RatePerHour: m.RatePerHour,
+		},
+		EventsBase:  m.EventsBase,
+		TotalEvents: m.TotalEvents,
+		CreatedBy:   createdBy,
+		CreatedAt:   m.CreatedAt,
+	}
+}
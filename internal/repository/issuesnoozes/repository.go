@@ -0,0 +1,158 @@
+package issuesnoozes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+const selectSnoozes = `
+SELECT
+    s.issue_id, i.project_id, i.level,
+    ARRAY[i.fingerprint] || ARRAY(
+        SELECT fingerprint FROM issue_fingerprints WHERE issue_fingerprints.issue_id = i.id
+    ) AS fingerprints,
+    s.until, s.count, s.user_count, s.rate_per_hour,
+    s.events_base, i.total_events, s.created_by, s.created_at
+FROM issue_snoozes s
+JOIN issues i ON i.id = s.issue_id`
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+// Upsert stores the ignore conditions of the issue, the count condition starts from its current total events.
+func (r *Repository) Upsert(
+	ctx context.Context,
+	issueID domain.IssueID,
+	conditions domain.IssueIgnoreConditions,
+	createdBy *domain.UserID,
+) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO issue_snoozes (issue_id, until, count, user_count, rate_per_hour, events_base, created_by)
+SELECT id, $2, $3, $4, $5, total_events, $6
+FROM issues
+WHERE id = $1
+ON CONFLICT (issue_id) DO UPDATE SET
+    until = EXCLUDED.until,
+    count = EXCLUDED.count,
+    user_count = EXCLUDED.user_count,
+    rate_per_hour = EXCLUDED.rate_per_hour,
+    events_base = EXCLUDED.events_base,
+    created_by = EXCLUDED.created_by,
+    created_at = NOW()`
+
+	tag, err := executor.Exec(ctx, query,
+		issueID,
+		conditions.Until,
+		conditions.Count,
+		conditions.UserCount,
+		conditions.RatePerHour,
+		createdBy,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert issue snooze: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) GetByIssue(ctx context.Context, issueID domain.IssueID) (domain.IssueSnooze, error) {
+	executor := r.getExecutor(ctx)
+
+	rows, err := executor.Query(ctx, selectSnoozes+` WHERE s.issue_id = $1`, issueID)
+	if err != nil {
+		return domain.IssueSnooze{}, fmt.Errorf("query issue snooze: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[snoozeModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.IssueSnooze{}, domain.ErrEntityNotFound
+		}
+
+		return domain.IssueSnooze{}, fmt.Errorf("collect issue snooze: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// ListActive returns a page of the snoozes of the issues which are still ignored, oldest first.
+// The page starts after the cursor, the first page is returned for a nil cursor.
+func (r *Repository) ListActive(
+	ctx context.Context,
+	after *domain.IssueSnoozeCursor,
+	limit uint,
+) ([]domain.IssueSnooze, error) {
+	executor := r.getExecutor(ctx)
+
+	query := selectSnoozes + `
+WHERE i.status = 'ignored'`
+	args := []any{limit}
+
+	if after != nil {
+		query += ` AND (s.created_at, s.issue_id) > ($2, $3)`
+		args = append(args, after.CreatedAt, after.IssueID)
+	}
+
+	query += `
+ORDER BY s.created_at, s.issue_id
+LIMIT $1`
+
+	rows, err := executor.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query active issue snoozes: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[snoozeModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect issue snoozes: %w", err)
+	}
+
+	snoozes := make([]domain.IssueSnooze, 0, len(models))
+	for i := range models {
+		snoozes = append(snoozes, models[i].toDomain())
+	}
+
+	return snoozes, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, issueID domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	_, err := executor.Exec(ctx, `DELETE FROM issue_snoozes WHERE issue_id = $1`, issueID)
+	if err != nil {
+		return fmt.Errorf("delete issue snooze: %w", err)
+	}
+
+	return nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
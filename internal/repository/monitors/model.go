@@ -0,0 +1,87 @@
+package monitors
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type monitorModel struct {
+	ID            uint       `db:"id"`
+	ProjectID     uint       `db:"project_id"`
+	Slug          string     `db:"slug"`
+	ScheduleType  string     `db:"schedule_type"`
+	Schedule      string     `db:"schedule"`
+	Timezone      string     `db:"timezone"`
+	CheckInMargin int64      `db:"checkin_margin"` // minutes
+	MaxRuntime    int64      `db:"max_runtime"`    // minutes
+	Status        string     `db:"status"`
+	LastCheckInAt *time.Time `db:"last_check_in_at"`
+	NextCheckInAt *time.Time `db:"next_check_in_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+func (m *monitorModel) toDomain() domain.Monitor {
+	return domain.Monitor{
+		ID:            domain.MonitorID(m.ID),
+		ProjectID:     domain.ProjectID(m.ProjectID),
+		Slug:          m.Slug,
+		ScheduleType:  domain.MonitorScheduleType(m.ScheduleType),
+		Schedule:      m.Schedule,
+		Timezone:      m.Timezone,
+		CheckInMargin: time.Duration(m.CheckInMargin) * time.Minute,
+		MaxRuntime:    time.Duration(m.MaxRuntime) * time.Minute,
+		Status:        domain.MonitorStatus(m.Status),
+		LastCheckInAt: m.LastCheckInAt,
+		NextCheckInAt: m.NextCheckInAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+type checkInModel struct {
+	ID          uint       `db:"id"`
+	MonitorID   uint       `db:"monitor_id"`
+	CheckInID   string     `db:"check_in_id"`
+	Status      string     `db:"status"`
+	DurationMs  *int64     `db:"duration_ms"`
+	Environment string     `db:"environment"`
+	ExpectedAt  *time.Time `db:"expected_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+func (m *checkInModel) toDomain() domain.MonitorCheckIn {
+	var duration *time.Duration
+	if m.DurationMs != nil {
+		value := time.Duration(*m.DurationMs) * time.Millisecond
+		duration = &value
+	}
+
+	return domain.MonitorCheckIn{
+		ID:          domain.MonitorCheckInID(m.ID),
+		MonitorID:   domain.MonitorID(m.MonitorID),
+		CheckInID:   m.CheckInID,
+		Status:      domain.CheckInStatus(m.Status),
+		Duration:    duration,
+		Environment: m.Environment,
+		ExpectedAt:  m.ExpectedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func durationToMs(duration *time.Duration) *int64 {
+	if duration == nil {
+		return nil
+	}
+
+	value := duration.Milliseconds()
+
+	return &value
+}
+
+func minutes(duration time.Duration) int64 {
+	return int64(duration / time.Minute)
+}
@@ -0,0 +1,381 @@
+package monitors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+// Repository stores cron monitors and their check-ins.
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+// Upsert creates the monitor or updates the schedule of the existing one.
+func (r *Repository) Upsert(ctx context.Context, monitorDTO domain.MonitorDTO) (domain.Monitor, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO monitors (project_id, slug, schedule_type, schedule, timezone, checkin_margin, max_runtime)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (project_id, slug) DO UPDATE SET
+    schedule_type = EXCLUDED.schedule_type,
+    schedule = EXCLUDED.schedule,
+    timezone = EXCLUDED.timezone,
+    checkin_margin = EXCLUDED.checkin_margin,
+    max_runtime = EXCLUDED.max_runtime,
+    updated_at = NOW()
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		monitorDTO.ProjectID,
+		monitorDTO.Slug,
+		monitorDTO.ScheduleType,
+		monitorDTO.Schedule,
+		monitorDTO.Timezone,
+		minutes(monitorDTO.CheckInMargin),
+		minutes(monitorDTO.MaxRuntime),
+	)
+	if err != nil {
+		return domain.Monitor{}, fmt.Errorf("upsert monitor: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[monitorModel])
+	if err != nil {
+		return domain.Monitor{}, fmt.Errorf("collect monitor: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) GetBySlug(ctx context.Context, projectID domain.ProjectID, slug string) (domain.Monitor, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM monitors WHERE project_id = $1 AND slug = $2 LIMIT 1`
+
+	return r.getOne(ctx, executor, query, projectID, slug)
+}
+
+func (r *Repository) GetByID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.MonitorID,
+) (domain.Monitor, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM monitors WHERE id = $1 AND project_id = $2 LIMIT 1`
+
+	return r.getOne(ctx, executor, query, id, projectID)
+}
+
+func (r *Repository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Monitor, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM monitors WHERE project_id = $1 ORDER BY slug`
+
+	return r.list(ctx, executor, query, projectID)
+}
+
+// ListOverdue returns monitors whose next check-in didn't arrive within the check-in margin.
+func (r *Repository) ListOverdue(ctx context.Context, now time.Time, limit uint) ([]domain.Monitor, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT * FROM monitors
+WHERE next_check_in_at + checkin_margin * INTERVAL '1 minute' < $1
+ORDER BY next_check_in_at
+LIMIT $2`
+
+	return r.list(ctx, executor, query, now, limit)
+}
+
+// UpdateState sets the monitor status and the time of the next expected check-in,
+// the last check-in time is kept when it's nil.
+func (r *Repository) UpdateState(
+	ctx context.Context,
+	id domain.MonitorID,
+	status domain.MonitorStatus,
+	lastCheckInAt *time.Time,
+	nextCheckInAt time.Time,
+) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE monitors
+SET status = $2,
+    last_check_in_at = COALESCE($3, last_check_in_at),
+    next_check_in_at = $4,
+    updated_at = NOW()
+WHERE id = $1`
+
+	_, err := executor.Exec(ctx, query, id, status, lastCheckInAt, nextCheckInAt)
+	if err != nil {
+		return fmt.Errorf("update monitor state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM monitors WHERE id = $1 AND project_id = $2`
+
+	tag, err := executor.Exec(ctx, query, id, projectID)
+	if err != nil {
+		return fmt.Errorf("delete monitor: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// UpsertCheckIn creates the check-in or finishes the in progress one with the same check-in id.
+// Finished check-ins are not changed.
+func (r *Repository) UpsertCheckIn(
+	ctx context.Context,
+	checkInDTO domain.MonitorCheckInDTO,
+) (domain.MonitorCheckIn, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO monitor_check_ins (monitor_id, check_in_id, status, duration_ms, environment, expected_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (monitor_id, check_in_id) DO UPDATE SET
+    status = EXCLUDED.status,
+    duration_ms = COALESCE(EXCLUDED.duration_ms, monitor_check_ins.duration_ms),
+    updated_at = NOW()
+WHERE monitor_check_ins.status = 'in_progress'
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		checkInDTO.MonitorID,
+		checkInDTO.CheckInID,
+		checkInDTO.Status,
+		durationToMs(checkInDTO.Duration),
+		checkInDTO.Environment,
+		checkInDTO.ExpectedAt,
+	)
+	if err != nil {
+		return domain.MonitorCheckIn{}, fmt.Errorf("upsert check-in: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[checkInModel])
+	if err == nil {
+		return model.toDomain(), nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return domain.MonitorCheckIn{}, fmt.Errorf("collect check-in: %w", err)
+	}
+
+	// The check-in is already finished
+	const selectQuery = `SELECT * FROM monitor_check_ins WHERE monitor_id = $1 AND check_in_id = $2`
+
+	rows, err = executor.Query(ctx, selectQuery, checkInDTO.MonitorID, checkInDTO.CheckInID)
+	if err != nil {
+		return domain.MonitorCheckIn{}, fmt.Errorf("query check-in: %w", err)
+	}
+	defer rows.Close()
+
+	model, err = pgx.CollectOneRow(rows, pgx.RowToStructByName[checkInModel])
+	if err != nil {
+		return domain.MonitorCheckIn{}, fmt.Errorf("collect check-in: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) ListCheckIns(
+	ctx context.Context,
+	monitorID domain.MonitorID,
+	limit uint,
+) ([]domain.MonitorCheckIn, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT * FROM monitor_check_ins
+WHERE monitor_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2`
+
+	rows, err := executor.Query(ctx, query, monitorID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query check-ins: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[checkInModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect check-ins: %w", err)
+	}
+
+	checkIns := make([]domain.MonitorCheckIn, 0, len(models))
+	for i := range models {
+		checkIns = append(checkIns, models[i].toDomain())
+	}
+
+	return checkIns, nil
+}
+
+// ListTimedOutCheckIns returns in progress check-ins running longer than the max runtime of their monitor.
+func (r *Repository) ListTimedOutCheckIns(
+	ctx context.Context,
+	now time.Time,
+	limit uint,
+) ([]domain.TimedOutCheckIn, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT c.id, c.monitor_id, c.check_in_id, c.status, c.duration_ms, c.environment, c.expected_at,
+       c.created_at, c.updated_at,
+       m.project_id, m.slug, m.schedule_type, m.schedule, m.timezone, m.checkin_margin, m.max_runtime,
+       m.status AS monitor_status, m.last_check_in_at, m.next_check_in_at,
+       m.created_at AS monitor_created_at, m.updated_at AS monitor_updated_at
+FROM monitor_check_ins c
+JOIN monitors m ON m.id = c.monitor_id
+WHERE c.status = 'in_progress' AND c.created_at + m.max_runtime * INTERVAL '1 minute' < $1
+ORDER BY c.created_at
+LIMIT $2`
+
+	rows, err := executor.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query timed out check-ins: %w", err)
+	}
+	defer rows.Close()
+
+	type timedOutModel struct {
+		checkInModel
+		ProjectID        uint       `db:"project_id"`
+		Slug             string     `db:"slug"`
+		ScheduleType     string     `db:"schedule_type"`
+		Schedule         string     `db:"schedule"`
+		Timezone         string     `db:"timezone"`
+		CheckInMargin    int64      `db:"checkin_margin"`
+		MaxRuntime       int64      `db:"max_runtime"`
+		MonitorStatus    string     `db:"monitor_status"`
+		LastCheckInAt    *time.Time `db:"last_check_in_at"`
+		NextCheckInAt    *time.Time `db:"next_check_in_at"`
+		MonitorCreatedAt time.Time  `db:"monitor_created_at"`
+		MonitorUpdatedAt time.Time  `db:"monitor_updated_at"`
+	}
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[timedOutModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect timed out check-ins: %w", err)
+	}
+
+	result := make([]domain.TimedOutCheckIn, 0, len(models))
+	for i := range models {
+		model := models[i]
+		monitor := monitorModel{
+			ID:            model.MonitorID,
+			ProjectID:     model.ProjectID,
+			Slug:          model.Slug,
+			ScheduleType:  model.ScheduleType,
+			Schedule:      model.Schedule,
+			Timezone:      model.Timezone,
+			CheckInMargin: model.CheckInMargin,
+			MaxRuntime:    model.MaxRuntime,
+			Status:        model.MonitorStatus,
+			LastCheckInAt: model.LastCheckInAt,
+			NextCheckInAt: model.NextCheckInAt,
+			CreatedAt:     model.MonitorCreatedAt,
+			UpdatedAt:     model.MonitorUpdatedAt,
+		}
+
+		result = append(result, domain.TimedOutCheckIn{
+			CheckIn: model.checkInModel.toDomain(),
+			Monitor: monitor.toDomain(),
+		})
+	}
+
+	return result, nil
+}
+
+// SetCheckInStatus changes the status of an in progress check-in, it reports false if the check-in was finished.
+func (r *Repository) SetCheckInStatus(
+	ctx context.Context,
+	id domain.MonitorCheckInID,
+	status domain.CheckInStatus,
+) (bool, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE monitor_check_ins
+SET status = $2, updated_at = NOW()
+WHERE id = $1 AND status = 'in_progress'`
+
+	tag, err := executor.Exec(ctx, query, id, status)
+	if err != nil {
+		return false, fmt.Errorf("update check-in status: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *Repository) getOne(ctx context.Context, executor db.Tx, query string, args ...any) (domain.Monitor, error) {
+	rows, err := executor.Query(ctx, query, args...)
+	if err != nil {
+		return domain.Monitor{}, fmt.Errorf("query monitor: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[monitorModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Monitor{}, domain.ErrEntityNotFound
+		}
+
+		return domain.Monitor{}, fmt.Errorf("collect monitor: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) list(ctx context.Context, executor db.Tx, query string, args ...any) ([]domain.Monitor, error) {
+	rows, err := executor.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query monitors: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[monitorModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect monitors: %w", err)
+	}
+
+	monitors := make([]domain.Monitor, 0, len(models))
+	for i := range models {
+		monitors = append(monitors, models[i].toDomain())
+	}
+
+	return monitors, nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -8,13 +8,14 @@ import (
 )
 
 type notificationSettingModel struct {
-	ID        uint            `db:"id"`
-	ProjectID uint            `db:"project_id"`
-	Type      string          `db:"type"`
-	Config    json.RawMessage `db:"config"`
-	Enabled   bool            `db:"enabled"`
-	CreatedAt time.Time       `db:"created_at"`
-	UpdatedAt time.Time       `db:"updated_at"`
+	ID              uint            `db:"id"`
+	ProjectID       uint            `db:"project_id"`
+	Type            string          `db:"type"`
+	Config          json.RawMessage `db:"config"`
+	Enabled         bool            `db:"enabled"`
+	CreatedAt       time.Time       `db:"created_at"`
+	UpdatedAt       time.Time       `db:"updated_at"`
+	RouteToAssignee bool            `db:"route_to_assignee"`
 }
 
 type notificationRuleModel struct {
@@ -24,19 +25,21 @@ type notificationRuleModel struct {
 	Fingerprint         *string   `db:"fingerprint"`
 	IsNewError          *bool     `db:"is_new_error"`
 	IsRegression        *bool     `db:"is_regression"`
+	Environment         *string   `db:"environment"`
 	CreatedAt           time.Time `db:"created_at"`
 }
 
 func (m *notificationSettingModel) toDomain() domain.NotificationSetting {
 	return domain.NotificationSetting{
-		ID:        domain.NotificationSettingID(m.ID),
-		ProjectID: domain.ProjectID(m.ProjectID),
-		Type:      domain.NotificationType(m.Type),
-		Config:    m.Config,
-		Enabled:   m.Enabled,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
-		Rules:     []domain.NotificationRule{}, // Will be populated separately
+		ID:              domain.NotificationSettingID(m.ID),
+		ProjectID:       domain.ProjectID(m.ProjectID),
+		Type:            domain.NotificationType(m.Type),
+		Config:          m.Config,
+		Enabled:         m.Enabled,
+		RouteToAssignee: m.RouteToAssignee,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+		Rules:           []domain.NotificationRule{}, // Will be populated separately
 	}
 }
 
@@ -48,19 +51,21 @@ func (m *notificationRuleModel) toDomain() domain.NotificationRule {
 		Fingerprint:         m.Fingerprint,
 		IsNewError:          m.IsNewError,
 		IsRegression:        m.IsRegression,
+		Environment:         m.Environment,
 		CreatedAt:           m.CreatedAt,
 	}
 }
 
 func settingFromDomain(setting domain.NotificationSetting) notificationSettingModel {
 	return notificationSettingModel{
-		ID:        uint(setting.ID),
-		ProjectID: uint(setting.ProjectID),
-		Type:      string(setting.Type),
-		Config:    setting.Config,
-		Enabled:   setting.Enabled,
-		CreatedAt: setting.CreatedAt,
-		UpdatedAt: setting.UpdatedAt,
+		ID:              uint(setting.ID),
+		ProjectID:       uint(setting.ProjectID),
+		Type:            string(setting.Type),
+		Config:          setting.Config,
+		Enabled:         setting.Enabled,
+		RouteToAssignee: setting.RouteToAssignee,
+		CreatedAt:       setting.CreatedAt,
+		UpdatedAt:       setting.UpdatedAt,
 	}
 }
 
@@ -72,6 +77,7 @@ func ruleFromDomain(rule domain.NotificationRule) notificationRuleModel {
 		Fingerprint:         rule.Fingerprint,
 		IsNewError:          rule.IsNewError,
 		IsRegression:        rule.IsRegression,
+		Environment:         rule.Environment,
 		CreatedAt:           rule.CreatedAt,
 	}
 }
@@ -84,12 +90,13 @@ func settingFromDTO(dto domain.NotificationSettingDTO) notificationSettingModel
 	}
 
 	return notificationSettingModel{
-		ProjectID: uint(dto.ProjectID),
-		Type:      string(dto.Type),
-		Config:    dto.Config,
-		Enabled:   dto.Enabled,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ProjectID:       uint(dto.ProjectID),
+		Type:            string(dto.Type),
+		Config:          dto.Config,
+		Enabled:         dto.Enabled,
+		RouteToAssignee: dto.RouteToAssignee,
+		CreatedAt:       now,
+		UpdatedAt:       now,
 	}
 }
 
@@ -100,6 +107,7 @@ func ruleFromDTO(dto domain.NotificationRuleDTO) notificationRuleModel {
 		Fingerprint:         dto.Fingerprint,
 		IsNewError:          dto.IsNewError,
 		IsRegression:        dto.IsRegression,
+		Environment:         dto.Environment,
 		CreatedAt:           time.Now(),
 	}
 }
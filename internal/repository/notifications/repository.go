@@ -32,8 +32,8 @@ func (r *Repository) CreateSetting(
 	model := settingFromDTO(settingDTO)
 
 	const query = `
-INSERT INTO notification_settings (project_id, type, config, enabled, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO notification_settings (project_id, type, config, enabled, route_to_assignee, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *`
 
 	rows, err := executor.Query(ctx, query,
@@ -41,6 +41,7 @@ RETURNING *`
 		model.Type,
 		model.Config,
 		model.Enabled,
+		model.RouteToAssignee,
 		model.CreatedAt,
 		model.UpdatedAt,
 	)
@@ -100,14 +101,15 @@ func (r *Repository) UpdateSetting(ctx context.Context, setting domain.Notificat
 
 	const query = `
 UPDATE notification_settings
-SET project_id = $1, type = $2, config = $3, enabled = $4, updated_at = $5
-WHERE id = $6`
+SET project_id = $1, type = $2, config = $3, enabled = $4, route_to_assignee = $5, updated_at = $6
+WHERE id = $7`
 
 	_, err := executor.Exec(ctx, query,
 		model.ProjectID,
 		model.Type,
 		model.Config,
 		model.Enabled,
+		model.RouteToAssignee,
 		model.UpdatedAt,
 		model.ID,
 	)
@@ -234,8 +236,8 @@ func (r *Repository) CreateRule(
 
 	const query = `
 INSERT INTO notification_rules 
-(notification_setting_id, event_level, fingerprint, is_new_error, is_regression, created_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+(notification_setting_id, event_level, fingerprint, is_new_error, is_regression, environment, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *`
 
 	rows, err := executor.Query(ctx, query,
@@ -244,6 +246,7 @@ RETURNING *`
 		model.Fingerprint,
 		model.IsNewError,
 		model.IsRegression,
+		model.Environment,
 		model.CreatedAt,
 	)
 	if err != nil {
@@ -289,8 +292,9 @@ func (r *Repository) UpdateRule(ctx context.Context, rule domain.NotificationRul
 
 	const query = `
 UPDATE notification_rules
-SET notification_setting_id = $1, event_level = $2, fingerprint = $3, is_new_error = $4, is_regression = $5
-WHERE id = $6`
+SET notification_setting_id = $1, event_level = $2, fingerprint = $3, is_new_error = $4, is_regression = $5,
+    environment = $6
+WHERE id = $7`
 
 	_, err := executor.Exec(ctx, query,
 		model.NotificationSetting,
@@ -298,6 +302,7 @@ WHERE id = $6`
 		model.Fingerprint,
 		model.IsNewError,
 		model.IsRegression,
+		model.Environment,
 		model.ID,
 	)
 	if err != nil {
@@ -13,6 +13,7 @@ type notificationModel struct {
 	Level          string     `db:"level"`
 	IsNew          bool       `db:"is_new"`
 	WasReactivated bool       `db:"was_reactivated"`
+	Environment    *string    `db:"environment"`
 	SentAt         *time.Time `db:"sent_at"`
 	Status         string     `db:"status"`
 	FailReason     *string    `db:"fail_reason"`
@@ -28,9 +29,18 @@ func (m *notificationModel) toDomain() domain.Notification {
 		Level:          domain.IssueLevel(m.Level),
 		IsNew:          m.IsNew,
 		WasReactivated: m.WasReactivated,
+		Environment:    m.environment(),
 		SentAt:         m.SentAt,
 		Status:         domain.NotificationStatus(m.Status),
 		FailReason:     m.FailReason,
 		CreatedAt:      m.CreatedAt,
 	}
 }
+
+func (m *notificationModel) environment() string {
+	if m.Environment == nil {
+		return ""
+	}
+
+	return *m.Environment
+}
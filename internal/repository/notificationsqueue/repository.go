@@ -29,11 +29,14 @@ func (r *Repository) AddNotification(
 	issueID domain.IssueID,
 	level domain.IssueLevel,
 	isNew, wasReactivated bool,
+	environment string,
 ) error {
 	executor := r.getExecutor(ctx)
 	const query = `
-INSERT INTO notifications_queue (project_id, issue_id, is_new, was_reactivated, status, level, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())`
+INSERT INTO notifications_queue (
+	project_id, issue_id, is_new, was_reactivated, status, level, environment, created_at, updated_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), NOW(), NOW())`
 	_, err := executor.Exec(
 		ctx,
 		query,
@@ -43,6 +46,7 @@ VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())`
 		wasReactivated,
 		domain.NotificationStatusPending,
 		level,
+		environment,
 	)
 	if err != nil {
 		return fmt.Errorf("insert notification: %w", err)
@@ -0,0 +1,25 @@
+package outcomes
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type outcomeStatModel struct {
+	Bucket   time.Time `ch:"bucket"`
+	Outcome  string    `ch:"outcome"`
+	Reason   string    `ch:"reason"`
+	Category string    `ch:"category"`
+	Quantity uint64    `ch:"quantity"`
+}
+
+func (m *outcomeStatModel) toDomain() domain.OutcomeStat {
+	return domain.OutcomeStat{
+		Bucket:   m.Bucket,
+		Outcome:  domain.Outcome(m.Outcome),
+		Reason:   m.Reason,
+		Category: domain.DataCategory(m.Category),
+		Quantity: uint(m.Quantity),
+	}
+}
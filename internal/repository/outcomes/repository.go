@@ -0,0 +1,94 @@
+//nolint:errcheck // for clickhouse rows
+package outcomes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/infra"
+)
+
+type Repository struct {
+	clickHouseClient infra.ClickHouseConn
+}
+
+func New(clickHouseClient infra.ClickHouseConn) *Repository {
+	return &Repository{
+		clickHouseClient: clickHouseClient,
+	}
+}
+
+// Stats returns outcome quantities of the project within the period grouped by the period granularity.
+func (r *Repository) Stats(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	period domain.Period,
+) ([]domain.OutcomeStat, error) {
+	bucketExpr, err := bucketExpression(period.Granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().UTC().Add(-period.Interval).Truncate(time.Hour)
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Question)
+
+	query, args, err := sb.
+		Select(
+			bucketExpr+" AS bucket",
+			"outcome",
+			"reason",
+			"category",
+			"sum(quantity) AS quantity",
+		).
+		From("outcomes").
+		Where(sq.Eq{"project_id": projectID}).
+		Where(sq.GtOrEq{"bucket": since}).
+		GroupBy("bucket", "outcome", "reason", "category").
+		OrderBy("bucket").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build outcomes query: %w", err)
+	}
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query outcomes: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.OutcomeStat
+	for rows.Next() {
+		var model outcomeStatModel
+		if err := rows.ScanStruct(&model); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		result = append(result, model.toDomain())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
+
+// bucketExpression aligns hourly outcome buckets to the granularity.
+func bucketExpression(gran time.Duration) (string, error) {
+	const day = 24 * time.Hour
+
+	switch {
+	case gran <= 0:
+		return "", fmt.Errorf("invalid granularity %s", gran)
+	case gran%day == 0:
+		return fmt.Sprintf("toStartOfInterval(bucket, INTERVAL %d DAY)", int64(gran/day)), nil
+	case gran%time.Hour == 0:
+		return fmt.Sprintf("toStartOfInterval(bucket, INTERVAL %d HOUR)", int64(gran/time.Hour)), nil
+	}
+
+	return "", fmt.Errorf("unsupported granularity %s (must be multiple of 1h or 1d)", gran)
+}
@@ -0,0 +1,46 @@
+package projectkeys
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type projectKeyModel struct {
+	ID                 uint                         `db:"id"`
+	ProjectID          uint                         `db:"project_id"`
+	Label              string                       `db:"label"`
+	PublicKey          string                       `db:"public_key"`
+	SecretKey          string                       `db:"secret_key"`
+	IsActive           bool                         `db:"is_active"`
+	RateLimit          *uint                        `db:"rate_limit"`
+	CategoryRateLimits map[domain.DataCategory]uint `db:"category_rate_limits"`
+	CreatedAt          time.Time                    `db:"created_at"`
+	LastUsedAt         *time.Time                   `db:"last_used_at"`
+}
+
+func (m *projectKeyModel) toDomain() domain.ProjectKey {
+	return domain.ProjectKey{
+		ID:        domain.ProjectKeyID(m.ID),
+		ProjectID: domain.ProjectID(m.ProjectID),
+		Label:     m.Label,
+		PublicKey: m.PublicKey,
+		SecretKey: m.SecretKey,
+		IsActive:  m.IsActive,
+		RateLimits: domain.RateLimits{
+			EventsPerMinute: m.RateLimit,
+			Categories:      m.CategoryRateLimits,
+		},
+		CreatedAt:  m.CreatedAt,
+		LastUsedAt: m.LastUsedAt,
+	}
+}
+
+// categoriesParam stores keys without category quotas as an empty JSON object.
+func categoriesParam(categories map[domain.DataCategory]uint) map[domain.DataCategory]uint {
+	if categories == nil {
+		return map[domain.DataCategory]uint{}
+	}
+
+	return categories
+}
@@ -0,0 +1,217 @@
+package projectkeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, keyDTO domain.ProjectKeyDTO) (domain.ProjectKey, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO project_keys (project_id, label, public_key, secret_key, rate_limit, category_rate_limits)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		keyDTO.ProjectID,
+		keyDTO.Label,
+		keyDTO.PublicKey,
+		keyDTO.SecretKey,
+		keyDTO.RateLimits.EventsPerMinute,
+		categoriesParam(keyDTO.RateLimits.Categories),
+	)
+	if err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("insert project key: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectKeyModel])
+	if err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("collect project key: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) GetByID(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.ProjectKeyID,
+) (domain.ProjectKey, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM project_keys WHERE id = $1 AND project_id = $2 LIMIT 1`
+
+	rows, err := executor.Query(ctx, query, id, projectID)
+	if err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("query project key by ID: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectKeyModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProjectKey{}, domain.ErrEntityNotFound
+		}
+
+		return domain.ProjectKey{}, fmt.Errorf("collect project key: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// GetActive returns the enabled key of a not archived project.
+func (r *Repository) GetActive(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	publicKey string,
+) (domain.ProjectKey, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT k.* FROM project_keys k
+	JOIN projects p ON p.id = k.project_id
+WHERE k.project_id = $1 AND k.public_key = $2 AND k.is_active AND p.archived_at IS NULL
+LIMIT 1`
+
+	rows, err := executor.Query(ctx, query, projectID, publicKey)
+	if err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("query active project key: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectKeyModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProjectKey{}, domain.ErrEntityNotFound
+		}
+
+		return domain.ProjectKey{}, fmt.Errorf("collect project key: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// ListByProject returns project keys in the creation order.
+func (r *Repository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.ProjectKey, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM project_keys WHERE project_id = $1 ORDER BY id`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("query project keys by project: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[projectKeyModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect project keys: %w", err)
+	}
+
+	keys := make([]domain.ProjectKey, 0, len(models))
+	for i := range models {
+		keys = append(keys, models[i].toDomain())
+	}
+
+	return keys, nil
+}
+
+func (r *Repository) Update(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	id domain.ProjectKeyID,
+	label string,
+	isActive bool,
+	rateLimits domain.RateLimits,
+) (domain.ProjectKey, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE project_keys
+	SET label = $1, is_active = $2, rate_limit = $3, category_rate_limits = $4
+WHERE id = $5 AND project_id = $6
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		label,
+		isActive,
+		rateLimits.EventsPerMinute,
+		categoriesParam(rateLimits.Categories),
+		id,
+		projectID,
+	)
+	if err != nil {
+		return domain.ProjectKey{}, fmt.Errorf("update project key: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectKeyModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProjectKey{}, domain.ErrEntityNotFound
+		}
+
+		return domain.ProjectKey{}, fmt.Errorf("collect project key: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) TouchLastUsed(ctx context.Context, id domain.ProjectKeyID, usedAt time.Time) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE project_keys SET last_used_at = $1 WHERE id = $2`
+
+	_, err := executor.Exec(ctx, query, usedAt, id)
+	if err != nil {
+		return fmt.Errorf("update project key last used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Delete(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM project_keys WHERE id = $1 AND project_id = $2`
+
+	result, err := executor.Exec(ctx, query, id, projectID)
+	if err != nil {
+		return fmt.Errorf("delete project key: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -0,0 +1,30 @@
+package projectratelimits
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type projectRateLimitsModel struct {
+	ProjectID          uint                         `db:"project_id"`
+	RateLimit          *uint                        `db:"rate_limit"`
+	CategoryRateLimits map[domain.DataCategory]uint `db:"category_rate_limits"`
+	UpdatedAt          *time.Time                   `db:"updated_at"`
+}
+
+func (m *projectRateLimitsModel) toDomain() domain.ProjectRateLimits {
+	limits := domain.ProjectRateLimits{
+		ProjectID: domain.ProjectID(m.ProjectID),
+		RateLimits: domain.RateLimits{
+			EventsPerMinute: m.RateLimit,
+			Categories:      m.CategoryRateLimits,
+		},
+	}
+
+	if m.UpdatedAt != nil {
+		limits.UpdatedAt = *m.UpdatedAt
+	}
+
+	return limits
+}
@@ -0,0 +1,98 @@
+package projectratelimits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+// Get returns the rate limits of the project, projects without saved limits are unlimited.
+func (r *Repository) Get(ctx context.Context, projectID domain.ProjectID) (domain.ProjectRateLimits, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT p.id AS project_id,
+       l.rate_limit,
+       COALESCE(l.category_rate_limits, '{}') AS category_rate_limits,
+       l.updated_at
+FROM projects p
+LEFT JOIN project_rate_limits l ON l.project_id = p.id
+WHERE p.id = $1 AND p.archived_at IS NULL`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return domain.ProjectRateLimits{}, fmt.Errorf("query project rate limits: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectRateLimitsModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProjectRateLimits{}, domain.ErrEntityNotFound
+		}
+
+		return domain.ProjectRateLimits{}, fmt.Errorf("collect project rate limits: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+func (r *Repository) Upsert(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	limits domain.RateLimits,
+) (domain.ProjectRateLimits, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO project_rate_limits (project_id, rate_limit, category_rate_limits, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (project_id) DO UPDATE
+SET rate_limit = EXCLUDED.rate_limit,
+    category_rate_limits = EXCLUDED.category_rate_limits,
+    updated_at = EXCLUDED.updated_at
+RETURNING *`
+
+	categories := limits.Categories
+	if categories == nil {
+		categories = map[domain.DataCategory]uint{}
+	}
+
+	rows, err := executor.Query(ctx, query, projectID, limits.EventsPerMinute, categories)
+	if err != nil {
+		return domain.ProjectRateLimits{}, fmt.Errorf("upsert project rate limits: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectRateLimitsModel])
+	if err != nil {
+		return domain.ProjectRateLimits{}, fmt.Errorf("collect project rate limits: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -15,6 +15,8 @@ type projectModel struct {
 	TeamID      *uint          `db:"team_id"`
 	CreatedAt   time.Time      `db:"created_at"`
 	ArchivedAt  *time.Time     `db:"archived_at"`
+
+	GroupingStrategy string `db:"grouping_strategy"`
 }
 
 func (m *projectModel) toDomain() domain.Project {
@@ -32,5 +34,7 @@ func (m *projectModel) toDomain() domain.Project {
 		TeamID:      teamIDRef,
 		CreatedAt:   m.CreatedAt,
 		ArchivedAt:  m.ArchivedAt,
+
+		GroupingStrategy: domain.GroupingStrategy(m.GroupingStrategy),
 	}
 }
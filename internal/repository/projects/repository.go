@@ -69,13 +69,19 @@ func (r *Repository) GetByPublicKey(ctx context.Context, publicKey string) (doma
 	return project.toDomain(), nil
 }
 
+// Create creates the project along with its default client key.
 func (r *Repository) Create(ctx context.Context, project *domain.ProjectDTO) (domain.ProjectID, error) {
 	executor := r.getExecutor(ctx)
 
 	const query = `
-INSERT INTO projects (name, description, public_key, team_id, created_at)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id`
+WITH project AS (
+	INSERT INTO projects (name, description, public_key, team_id, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id, public_key, created_at
+)
+INSERT INTO project_keys (project_id, label, public_key, secret_key, created_at)
+SELECT id, $6, public_key, $7, created_at FROM project
+RETURNING project_id`
 
 	var id uint
 	err := executor.QueryRow(ctx, query,
@@ -84,6 +90,8 @@ RETURNING id`
 		project.PublicKey,
 		project.TeamID,
 		time.Now(),
+		domain.DefaultProjectKeyLabel,
+		project.SecretKey,
 	).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("insert project: %w", err)
@@ -92,25 +100,6 @@ RETURNING id`
 	return domain.ProjectID(id), nil
 }
 
-func (r *Repository) ValidateProjectKey(ctx context.Context, projectID domain.ProjectID, key string) (bool, error) {
-	executor := r.getExecutor(ctx)
-
-	const query = `SELECT 1 FROM projects WHERE id = $1 AND public_key = $2 AND archived_at IS NULL LIMIT 1`
-
-	rows, err := executor.Query(ctx, query, projectID, key)
-	if err != nil {
-		return false, fmt.Errorf("validate project key: %w", err)
-	}
-	defer rows.Close()
-
-	// Check if any rows were returned
-	if !rows.Next() {
-		return false, nil
-	}
-
-	return true, nil
-}
-
 func (r *Repository) List(ctx context.Context) ([]domain.ProjectExtended, error) {
 	executor := r.getExecutor(ctx)
 
@@ -123,6 +112,7 @@ SELECT
     p.created_at,
     p.team_id,
     p.archived_at,
+    p.grouping_strategy,
     tm.name AS team_name
 FROM projects p
 LEFT JOIN teams tm ON p.team_id = tm.id
@@ -189,6 +179,7 @@ SELECT
     p.public_key,
     p.created_at,
     p.archived_at,
+    p.grouping_strategy,
     t.id AS team_id,
     t.name AS team_name
 FROM projects p
@@ -247,6 +238,45 @@ WHERE id = $3`
 	return nil
 }
 
+// UpdatePublicKey changes the key shown as the project DSN.
+func (r *Repository) UpdatePublicKey(ctx context.Context, id domain.ProjectID, publicKey string) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE projects SET public_key = $1 WHERE id = $2`
+
+	result, err := executor.Exec(ctx, query, publicKey, id)
+	if err != nil {
+		return fmt.Errorf("failed to update project public key: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateGroupingStrategy(
+	ctx context.Context,
+	id domain.ProjectID,
+	strategy domain.GroupingStrategy,
+) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE projects SET grouping_strategy = $1 WHERE id = $2`
+
+	result, err := executor.Exec(ctx, query, strategy, id)
+	if err != nil {
+		return fmt.Errorf("failed to update project grouping strategy: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrEntityNotFound
+	}
+
+	return nil
+}
+
 func (r *Repository) Archive(ctx context.Context, id domain.ProjectID) error {
 	executor := r.getExecutor(ctx)
 
@@ -0,0 +1,30 @@
+package projectspikes
+
+import (
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+type projectSpikeModel struct {
+	ID          uint      `db:"id"`
+	ProjectID   uint      `db:"project_id"`
+	StartedAt   time.Time `db:"started_at"`
+	EndsAt      time.Time `db:"ends_at"`
+	BaselineRPS uint64    `db:"baseline_rps"`
+	PeakRPS     uint64    `db:"peak_rps"`
+	CapRPS      uint64    `db:"cap_rps"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (m *projectSpikeModel) toDomain() domain.ProjectSpike {
+	return domain.ProjectSpike{
+		ID:          domain.ProjectSpikeID(m.ID),
+		ProjectID:   domain.ProjectID(m.ProjectID),
+		StartedAt:   m.StartedAt,
+		EndsAt:      m.EndsAt,
+		BaselineRPS: m.BaselineRPS,
+		PeakRPS:     m.PeakRPS,
+		CapRPS:      m.CapRPS,
+	}
+}
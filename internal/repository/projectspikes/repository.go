@@ -0,0 +1,135 @@
+package projectspikes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+type Repository struct {
+	db db.Tx
+}
+
+func New(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: pool,
+	}
+}
+
+func (r *Repository) Create(ctx context.Context, spikeDTO domain.ProjectSpikeDTO) (domain.ProjectSpike, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+INSERT INTO project_spikes (project_id, started_at, ends_at, baseline_rps, peak_rps, cap_rps)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *`
+
+	rows, err := executor.Query(ctx, query,
+		spikeDTO.ProjectID,
+		spikeDTO.StartedAt,
+		spikeDTO.EndsAt,
+		spikeDTO.BaselineRPS,
+		spikeDTO.PeakRPS,
+		spikeDTO.CapRPS,
+	)
+	if err != nil {
+		return domain.ProjectSpike{}, fmt.Errorf("insert project spike: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectSpikeModel])
+	if err != nil {
+		return domain.ProjectSpike{}, fmt.Errorf("collect project spike: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// GetLast returns the latest spike of the project.
+func (r *Repository) GetLast(ctx context.Context, projectID domain.ProjectID) (domain.ProjectSpike, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM project_spikes WHERE project_id = $1 ORDER BY ends_at DESC LIMIT 1`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return domain.ProjectSpike{}, fmt.Errorf("query last project spike: %w", err)
+	}
+	defer rows.Close()
+
+	model, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[projectSpikeModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProjectSpike{}, domain.ErrEntityNotFound
+		}
+
+		return domain.ProjectSpike{}, fmt.Errorf("collect project spike: %w", err)
+	}
+
+	return model.toDomain(), nil
+}
+
+// Extend moves the end of a spike still going on and raises its peak.
+func (r *Repository) Extend(ctx context.Context, id domain.ProjectSpikeID, endsAt time.Time, peakRPS uint64) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+UPDATE project_spikes
+SET ends_at = GREATEST(ends_at, $2),
+    peak_rps = GREATEST(peak_rps, $3)
+WHERE id = $1`
+
+	if _, err := executor.Exec(ctx, query, id, endsAt, peakRPS); err != nil {
+		return fmt.Errorf("update project spike: %w", err)
+	}
+
+	return nil
+}
+
+// ListByProject returns the spikes of the project going on after the given time, the latest first.
+func (r *Repository) ListByProject(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	since time.Time,
+) ([]domain.ProjectSpike, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT * FROM project_spikes
+WHERE project_id = $1 AND ends_at >= $2
+ORDER BY started_at DESC`
+
+	rows, err := executor.Query(ctx, query, projectID, since)
+	if err != nil {
+		return nil, fmt.Errorf("query project spikes: %w", err)
+	}
+	defer rows.Close()
+
+	models, err := pgx.CollectRows(rows, pgx.RowToStructByName[projectSpikeModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect project spikes: %w", err)
+	}
+
+	spikes := make([]domain.ProjectSpike, 0, len(models))
+	for i := range models {
+		spikes = append(spikes, models[i].toDomain())
+	}
+
+	return spikes, nil
+}
+
+//nolint:ireturn // it's ok here
+func (r *Repository) getExecutor(ctx context.Context) db.Tx {
+	if tx := db.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return r.db
+}
@@ -15,6 +15,7 @@ type releaseModel struct {
 	ReleasedAt  time.Time      `db:"released_at"`
 	CreatedAt   time.Time      `db:"created_at"`
 	UpdatedAt   time.Time      `db:"updated_at"`
+	VersionKey  sql.NullString `db:"version_key"`
 }
 
 func (m *releaseModel) toDomain() domain.Release {
@@ -98,6 +98,35 @@ func (r *Repository) ListByProject(ctx context.Context, projectID domain.Project
 	return releases, nil
 }
 
+// GetLatest returns the latest release of the project in the semver order, the releases
+// not following semver only count when the project has no semver releases.
+func (r *Repository) GetLatest(ctx context.Context, projectID domain.ProjectID) (domain.Release, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `
+SELECT * FROM releases
+WHERE project_id = $1 AND version <> ''
+ORDER BY version_key COLLATE "C" DESC NULLS LAST, created_at DESC, id DESC
+LIMIT 1`
+
+	rows, err := executor.Query(ctx, query, projectID)
+	if err != nil {
+		return domain.Release{}, fmt.Errorf("query latest release: %w", err)
+	}
+	defer rows.Close()
+
+	release, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[releaseModel])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Release{}, domain.ErrEntityNotFound
+		}
+
+		return domain.Release{}, fmt.Errorf("collect release: %w", err)
+	}
+
+	return release.toDomain(), nil
+}
+
 func (r *Repository) Create(ctx context.Context, release domain.ReleaseDTO) (domain.ReleaseID, error) {
 	executor := r.getExecutor(ctx)
 
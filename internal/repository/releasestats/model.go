@@ -27,6 +27,15 @@ type releaseStatsModel struct {
 
 	SeverityDistribution []byte `db:"severity_distribution"`
 	UsersAffected        uint   `db:"users_affected"`
+
+	SessionsTotal      uint     `db:"sessions_total"`
+	SessionsErrored    uint     `db:"sessions_errored"`
+	SessionsCrashed    uint     `db:"sessions_crashed"`
+	UsersTotal         uint     `db:"users_total"`
+	CrashFreeSessions  *float64 `db:"crash_free_sessions"`
+	CrashFreeUsers     *float64 `db:"crash_free_users"`
+	Adoption           *float64 `db:"adoption"`
+	AvgSessionDuration string   `db:"avg_session_duration"`
 }
 
 func (m *releaseStatsModel) toDomain() (domain.ReleaseStats, error) {
@@ -42,6 +51,10 @@ func (m *releaseStatsModel) toDomain() (domain.ReleaseStats, error) {
 	if err != nil {
 		return domain.ReleaseStats{}, err
 	}
+	avgSessionDurationRef, err := parseStringToDurationPtr(m.AvgSessionDuration)
+	if err != nil {
+		return domain.ReleaseStats{}, err
+	}
 
 	severity := make(map[string]uint)
 	_ = json.Unmarshal(m.SeverityDistribution, &severity)
@@ -62,6 +75,14 @@ func (m *releaseStatsModel) toDomain() (domain.ReleaseStats, error) {
 		P95FixTime:             p95FixRef,
 		SeverityDistribution:   severity,
 		UsersAffected:          m.UsersAffected,
+		SessionsTotal:          m.SessionsTotal,
+		SessionsErrored:        m.SessionsErrored,
+		SessionsCrashed:        m.SessionsCrashed,
+		UsersTotal:             m.UsersTotal,
+		CrashFreeSessions:      m.CrashFreeSessions,
+		CrashFreeUsers:         m.CrashFreeUsers,
+		Adoption:               m.Adoption,
+		AvgSessionDuration:     avgSessionDurationRef,
 	}, nil
 }
 
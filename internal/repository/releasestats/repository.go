@@ -89,15 +89,17 @@ INSERT INTO release_stats (
     known_issues_total, new_issues_total, regressions_total,
     resolved_in_version_total, fixed_new_in_version_total, fixed_old_in_version_total,
     avg_fix_time, median_fix_time, p95_fix_time,
-    severity_distribution, users_affected
-) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)`
+    severity_distribution, users_affected,
+    sessions_total, sessions_errored, sessions_crashed, users_total,
+    crash_free_sessions, crash_free_users, adoption, avg_session_duration
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23)`
 
 	severityJSON, err := json.Marshal(stats.SeverityDistribution)
 	if err != nil {
 		return fmt.Errorf("marshal severity distribution: %w", err)
 	}
 
-	var avgFixTime, medianFixTime, p95FixTime time.Duration
+	var avgFixTime, medianFixTime, p95FixTime, avgSessionDuration time.Duration
 	if stats.AvgFixTime != nil {
 		avgFixTime = *stats.AvgFixTime
 	}
@@ -107,6 +109,9 @@ INSERT INTO release_stats (
 	if stats.P95FixTime != nil {
 		p95FixTime = *stats.P95FixTime
 	}
+	if stats.AvgSessionDuration != nil {
+		avgSessionDuration = *stats.AvgSessionDuration
+	}
 
 	_, err = executor.Exec(ctx, query,
 		stats.ProjectID,
@@ -124,6 +129,14 @@ INSERT INTO release_stats (
 		p95FixTime.String(),
 		severityJSON,
 		stats.UsersAffected,
+		stats.SessionsTotal,
+		stats.SessionsErrored,
+		stats.SessionsCrashed,
+		stats.UsersTotal,
+		stats.CrashFreeSessions,
+		stats.CrashFreeUsers,
+		stats.Adoption,
+		avgSessionDuration.String(),
 	)
 	if err != nil {
 		return fmt.Errorf("insert release_stats: %w", err)
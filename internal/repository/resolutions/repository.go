@@ -185,6 +185,34 @@ ORDER BY resolved_at DESC`
 	return resolutions, nil
 }
 
+// MoveToIssue re-points the resolution history of the given issues to another issue.
+func (r *Repository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `UPDATE resolutions SET issue_id = $1, updated_at = NOW() WHERE issue_id = ANY($2)`
+
+	_, err := executor.Exec(ctx, query, toIssueID, fromIssueIDs)
+	if err != nil {
+		return fmt.Errorf("move resolutions: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByIssues deletes the resolution history of the given issues.
+func (r *Repository) DeleteByIssues(ctx context.Context, issueIDs []domain.IssueID) error {
+	executor := r.getExecutor(ctx)
+
+	const query = `DELETE FROM resolutions WHERE issue_id = ANY($1)`
+
+	_, err := executor.Exec(ctx, query, issueIDs)
+	if err != nil {
+		return fmt.Errorf("delete resolutions: %w", err)
+	}
+
+	return nil
+}
+
 //nolint:ireturn // it's ok here
 func (r *Repository) getExecutor(ctx context.Context) db.Tx {
 	if tx := db.TxFromContext(ctx); tx != nil {
@@ -0,0 +1,92 @@
+package sessions
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const timestampLayout = "2006-01-02 15:04:05.000"
+
+type sessionUpdateModel struct {
+	ProjectID   uint32    `json:"project_id"`
+	SessionID   string    `json:"session_id"`
+	DistinctID  string    `json:"distinct_id"`
+	Status      string    `json:"status"`
+	Init        uint8     `json:"init"`
+	Errors      uint32    `json:"errors"`
+	DurationMs  float64   `json:"duration_ms"`
+	Release     string    `json:"release"`
+	Environment string    `json:"environment"`
+	Started     time.Time `json:"-"`
+	Timestamp   time.Time `json:"-"`
+	Quantity    uint32    `json:"quantity"`
+}
+
+func (m sessionUpdateModel) MarshalJSON() ([]byte, error) {
+	type Alias sessionUpdateModel
+
+	return json.Marshal(&struct {
+		Started   string `json:"started"`
+		Timestamp string `json:"timestamp"`
+		Alias
+	}{
+		Started:   m.Started.UTC().Format(timestampLayout),
+		Timestamp: m.Timestamp.UTC().Format(timestampLayout),
+		Alias:     Alias(m),
+	})
+}
+
+func sessionUpdateFromDomain(update *domain.SessionUpdate) sessionUpdateModel {
+	var init uint8
+	if update.Init {
+		init = 1
+	}
+
+	return sessionUpdateModel{
+		ProjectID:   uint32(update.ProjectID),
+		SessionID:   update.SessionID,
+		DistinctID:  update.DistinctID,
+		Status:      update.Status,
+		Init:        init,
+		Errors:      uint32(update.Errors),
+		DurationMs:  float64(update.Duration) / float64(time.Millisecond),
+		Release:     update.Release,
+		Environment: update.Environment,
+		Started:     update.Started,
+		Timestamp:   update.Timestamp,
+		Quantity:    uint32(update.Quantity),
+	}
+}
+
+type releaseHealthModel struct {
+	Release         string  `ch:"release"`
+	Sessions        uint64  `ch:"sessions"`
+	SessionsErrored uint64  `ch:"sessions_errored"`
+	SessionsCrashed uint64  `ch:"sessions_crashed"`
+	Users           uint64  `ch:"users"`
+	UsersCrashed    uint64  `ch:"users_crashed"`
+	RecentSessions  uint64  `ch:"recent_sessions"`
+	DurationMsSum   float64 `ch:"duration_ms_sum"`
+	DurationCount   uint64  `ch:"duration_count"`
+}
+
+func (m *releaseHealthModel) toDomain() domain.ReleaseHealth {
+	health := domain.ReleaseHealth{
+		Release:         m.Release,
+		SessionsTotal:   uint(m.Sessions),
+		SessionsErrored: uint(m.SessionsErrored),
+		SessionsCrashed: uint(m.SessionsCrashed),
+		UsersTotal:      uint(m.Users),
+		UsersCrashed:    uint(m.UsersCrashed),
+		RecentSessions:  uint(m.RecentSessions),
+	}
+
+	if m.DurationCount > 0 {
+		avg := time.Duration(m.DurationMsSum / float64(m.DurationCount) * float64(time.Millisecond))
+		health.AvgDuration = &avg
+	}
+
+	return health
+}
@@ -0,0 +1,94 @@
+//nolint:errcheck // for clickhouse rows
+package sessions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/infra"
+	"github.com/rom8726/warden/pkg/kafka"
+)
+
+type Repository struct {
+	clickHouseClient infra.ClickHouseConn
+	producer         *kafka.TopicProducer
+}
+
+func New(clickHouseClient infra.ClickHouseConn, producer *kafka.TopicProducer) *Repository {
+	return &Repository{
+		clickHouseClient: clickHouseClient,
+		producer:         producer,
+	}
+}
+
+// Store sends session updates to ClickHouse where they are aggregated by release.
+func (r *Repository) Store(ctx context.Context, updates []domain.SessionUpdate) error {
+	for i := range updates {
+		data, err := json.Marshal(sessionUpdateFromDomain(&updates[i]))
+		if err != nil {
+			return fmt.Errorf("marshal session update: %w", err)
+		}
+
+		if err := r.producer.Produce(ctx, data); err != nil {
+			return fmt.Errorf("produce session update: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseHealth returns session counts of all project releases keyed by release.
+// Sessions started since adoptionSince are counted separately to calculate adoption.
+func (r *Repository) ReleaseHealth(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	adoptionSince time.Time,
+) (map[string]domain.ReleaseHealth, error) {
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Question)
+
+	query, args, err := sb.
+		Select(
+			"release",
+			"sum(sessions) AS sessions",
+			"sum(sessions_errored) AS sessions_errored",
+			"sum(sessions_crashed) AS sessions_crashed",
+			"uniqMerge(users) AS users",
+			"uniqMerge(users_crashed) AS users_crashed",
+		).
+		Column(sq.Expr("sumIf(sessions, bucket >= ?) AS recent_sessions", adoptionSince.UTC())).
+		Columns("sum(duration_ms_sum) AS duration_ms_sum", "sum(duration_count) AS duration_count").
+		From("session_aggregates").
+		Where(sq.Eq{"project_id": projectID}).
+		GroupBy("release").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build release health query: %w", err)
+	}
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query release health: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]domain.ReleaseHealth)
+	for rows.Next() {
+		var model releaseHealthModel
+		if err := rows.ScanStruct(&model); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		result[model.Release] = model.toDomain()
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,147 @@
+package transactions
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+)
+
+const timestampLayout = "2006-01-02 15:04:05.000"
+
+type transactionModel struct {
+	EventID        string            `ch:"event_id"        json:"event_id"`
+	ProjectID      uint32            `ch:"project_id"      json:"project_id"`
+	TraceID        string            `ch:"trace_id"        json:"trace_id"`
+	SpanID         string            `ch:"span_id"         json:"span_id"`
+	ParentSpanID   string            `ch:"parent_span_id"  json:"parent_span_id"`
+	Name           string            `ch:"name"            json:"name"`
+	Op             string            `ch:"op"              json:"op"`
+	Status         string            `ch:"status"          json:"status"`
+	Platform       string            `ch:"platform"        json:"platform"`
+	Environment    string            `ch:"environment"     json:"environment"`
+	Release        string            `ch:"release"         json:"release"`
+	Tags           map[string]string `ch:"tags"            json:"tags"`
+	StartTimestamp time.Time         `ch:"start_timestamp" json:"-"`
+	Timestamp      time.Time         `ch:"timestamp"       json:"-"`
+	DurationMs     float64           `ch:"duration_ms"     json:"duration_ms"`
+}
+
+func (m transactionModel) MarshalJSON() ([]byte, error) {
+	type Alias transactionModel
+
+	return json.Marshal(&struct {
+		StartTimestamp string `json:"start_timestamp"`
+		Timestamp      string `json:"timestamp"`
+		Alias
+	}{
+		StartTimestamp: m.StartTimestamp.UTC().Format(timestampLayout),
+		Timestamp:      m.Timestamp.UTC().Format(timestampLayout),
+		Alias:          Alias(m),
+	})
+}
+
+func transactionFromDomain(transaction *domain.Transaction) transactionModel {
+	return transactionModel{
+		EventID:        transaction.ID.String(),
+		ProjectID:      uint32(transaction.ProjectID),
+		TraceID:        transaction.TraceID,
+		SpanID:         transaction.SpanID,
+		ParentSpanID:   transaction.ParentSpanID,
+		Name:           transaction.Name,
+		Op:             transaction.Op,
+		Status:         transaction.Status,
+		Platform:       transaction.Platform,
+		Environment:    transaction.Environment,
+		Release:        transaction.Release,
+		Tags:           transaction.Tags,
+		StartTimestamp: transaction.StartTimestamp,
+		Timestamp:      transaction.Timestamp,
+		DurationMs:     durationMs(transaction.Duration()),
+	}
+}
+
+func (m *transactionModel) toDomain() domain.Transaction {
+	return domain.Transaction{
+		ID:             domain.EventID(m.EventID),
+		ProjectID:      domain.ProjectID(m.ProjectID),
+		TraceID:        m.TraceID,
+		SpanID:         m.SpanID,
+		ParentSpanID:   m.ParentSpanID,
+		Name:           m.Name,
+		Op:             m.Op,
+		Status:         m.Status,
+		Platform:       m.Platform,
+		Environment:    m.Environment,
+		Release:        m.Release,
+		Tags:           m.Tags,
+		StartTimestamp: m.StartTimestamp,
+		Timestamp:      m.Timestamp,
+	}
+}
+
+type spanModel struct {
+	ProjectID      uint32            `ch:"project_id"      json:"project_id"`
+	TransactionID  string            `ch:"transaction_id"  json:"transaction_id"`
+	TraceID        string            `ch:"trace_id"        json:"trace_id"`
+	SpanID         string            `ch:"span_id"         json:"span_id"`
+	ParentSpanID   string            `ch:"parent_span_id"  json:"parent_span_id"`
+	Op             string            `ch:"op"              json:"op"`
+	Description    string            `ch:"description"     json:"description"`
+	Status         string            `ch:"status"          json:"status"`
+	Tags           map[string]string `ch:"tags"            json:"tags"`
+	StartTimestamp time.Time         `ch:"start_timestamp" json:"-"`
+	Timestamp      time.Time         `ch:"timestamp"       json:"-"`
+	DurationMs     float64           `ch:"duration_ms"     json:"duration_ms"`
+}
+
+func (m spanModel) MarshalJSON() ([]byte, error) {
+	type Alias spanModel
+
+	return json.Marshal(&struct {
+		StartTimestamp string `json:"start_timestamp"`
+		Timestamp      string `json:"timestamp"`
+		Alias
+	}{
+		StartTimestamp: m.StartTimestamp.UTC().Format(timestampLayout),
+		Timestamp:      m.Timestamp.UTC().Format(timestampLayout),
+		Alias:          Alias(m),
+	})
+}
+
+func spanFromDomain(span *domain.Span) spanModel {
+	return spanModel{
+		ProjectID:      uint32(span.ProjectID),
+		TransactionID:  span.TransactionID.String(),
+		TraceID:        span.TraceID,
+		SpanID:         span.SpanID,
+		ParentSpanID:   span.ParentSpanID,
+		Op:             span.Op,
+		Description:    span.Description,
+		Status:         span.Status,
+		Tags:           span.Tags,
+		StartTimestamp: span.StartTimestamp,
+		Timestamp:      span.Timestamp,
+		DurationMs:     durationMs(span.Duration()),
+	}
+}
+
+func (m *spanModel) toDomain() domain.Span {
+	return domain.Span{
+		ProjectID:      domain.ProjectID(m.ProjectID),
+		TransactionID:  domain.EventID(m.TransactionID),
+		TraceID:        m.TraceID,
+		SpanID:         m.SpanID,
+		ParentSpanID:   m.ParentSpanID,
+		Op:             m.Op,
+		Description:    m.Description,
+		Status:         m.Status,
+		Tags:           m.Tags,
+		StartTimestamp: m.StartTimestamp,
+		Timestamp:      m.Timestamp,
+	}
+}
+
+func durationMs(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
@@ -0,0 +1,213 @@
+//nolint:errcheck // for clickhouse rows
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/infra"
+	"github.com/rom8726/warden/pkg/kafka"
+)
+
+const maxTraceSpans = 10000
+
+// Producers are Kafka producers of the transactions and spans ClickHouse topics.
+type Producers struct {
+	Transactions kafka.DataProducer
+	Spans        kafka.DataProducer
+}
+
+type Repository struct {
+	clickHouseClient     infra.ClickHouseConn
+	transactionsProducer kafka.DataProducer
+	spansProducer        kafka.DataProducer
+}
+
+func New(clickHouseClient infra.ClickHouseConn, producers *Producers) *Repository {
+	return &Repository{
+		clickHouseClient:     clickHouseClient,
+		transactionsProducer: producers.Transactions,
+		spansProducer:        producers.Spans,
+	}
+}
+
+// Store sends the transaction and its spans to ClickHouse.
+func (r *Repository) Store(ctx context.Context, transaction *domain.Transaction) error {
+	data, err := json.Marshal(transactionFromDomain(transaction))
+	if err != nil {
+		return fmt.Errorf("marshal transaction: %w", err)
+	}
+
+	if err := r.transactionsProducer.Produce(ctx, data); err != nil {
+		return fmt.Errorf("produce transaction: %w", err)
+	}
+
+	for i := range transaction.Spans {
+		data, err := json.Marshal(spanFromDomain(&transaction.Spans[i]))
+		if err != nil {
+			return fmt.Errorf("marshal span: %w", err)
+		}
+
+		if err := r.spansProducer.Produce(ctx, data); err != nil {
+			return fmt.Errorf("produce span: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Stats returns latency percentiles, throughput and failure rate per transaction name.
+func (r *Repository) Stats(
+	ctx context.Context,
+	filter *domain.TransactionsFilter,
+) ([]domain.TransactionStats, error) {
+	if filter == nil {
+		return nil, errors.New("filter is nil")
+	}
+	if filter.Interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Question)
+
+	qb := sb.
+		Select("name", "count() AS cnt").
+		Column(sq.Expr("countIf(status NOT IN (?, ?, ?, '')) AS failed",
+			domain.SpanStatusOK, domain.SpanStatusCancelled, domain.SpanStatusUnknown)).
+		Column("quantiles(0.5, 0.95, 0.99)(duration_ms) AS durations").
+		From("transactions").
+		Where(sq.Eq{"project_id": filter.ProjectID}).
+		Where(sq.GtOrEq{"timestamp": time.Now().Add(-filter.Interval)}).
+		GroupBy("name").
+		OrderBy("cnt DESC", "name")
+
+	if filter.Environment != nil {
+		qb = qb.Where(sq.Eq{"environment": *filter.Environment})
+	}
+	if filter.Release != nil {
+		qb = qb.Where(sq.Eq{"release": *filter.Release})
+	}
+	if filter.Limit > 0 {
+		qb = qb.Limit(uint64(filter.Limit))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build transactions stats query: %w", err)
+	}
+
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query transactions stats: %w", err)
+	}
+	defer rows.Close()
+
+	minutes := filter.Interval.Minutes()
+
+	var result []domain.TransactionStats
+	for rows.Next() {
+		var (
+			name      string
+			cnt       uint64
+			failed    uint64
+			durations []float64
+		)
+		if err := rows.Scan(&name, &cnt, &failed, &durations); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+
+		stats := domain.TransactionStats{
+			Name:       name,
+			Count:      uint(cnt),
+			Throughput: float64(cnt) / minutes,
+		}
+		if cnt > 0 {
+			stats.FailureRate = float64(failed) / float64(cnt)
+		}
+		if len(durations) == 3 {
+			stats.P50 = msToDuration(durations[0])
+			stats.P95 = msToDuration(durations[1])
+			stats.P99 = msToDuration(durations[2])
+		}
+
+		result = append(result, stats)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return result, nil
+}
+
+// TraceTransactions returns transactions of the trace ordered by start time.
+func (r *Repository) TraceTransactions(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	traceID string,
+) ([]domain.Transaction, error) {
+	const query = `
+SELECT * FROM transactions
+WHERE project_id = ? AND trace_id = ?
+ORDER BY start_timestamp`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, traceID)
+	if err != nil {
+		return nil, fmt.Errorf("query trace transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []domain.Transaction
+	for rows.Next() {
+		var model transactionModel
+		if err := rows.ScanStruct(&model); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		transactions = append(transactions, model.toDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// TraceSpans returns spans of the trace ordered by start time.
+func (r *Repository) TraceSpans(
+	ctx context.Context,
+	projectID domain.ProjectID,
+	traceID string,
+) ([]domain.Span, error) {
+	const query = `
+SELECT * FROM spans
+WHERE project_id = ? AND trace_id = ?
+ORDER BY start_timestamp
+LIMIT ?`
+	rows, err := r.clickHouseClient.QueryWithRetries(ctx, query, projectID, traceID, maxTraceSpans)
+	if err != nil {
+		return nil, fmt.Errorf("query trace spans: %w", err)
+	}
+	defer rows.Close()
+
+	var spans []domain.Span
+	for rows.Next() {
+		var model spanModel
+		if err := rows.ScanStruct(&model); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		spans = append(spans, model.toDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate rows: %w", err)
+	}
+
+	return spans, nil
+}
+
+func msToDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -165,6 +165,30 @@ func (r *Repository) FetchByIDs(ctx context.Context, ids []domain.UserID) ([]dom
 	return users, nil
 }
 
+func (r *Repository) FetchByUsernames(ctx context.Context, usernames []string) ([]domain.User, error) {
+	executor := r.getExecutor(ctx)
+
+	const query = `SELECT * FROM users WHERE username = ANY($1)`
+	rows, err := executor.Query(ctx, query, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("query users by usernames: %w", err)
+	}
+	defer rows.Close()
+
+	listModels, err := pgx.CollectRows(rows, pgx.RowToStructByName[userModel])
+	if err != nil {
+		return nil, fmt.Errorf("collect users: %w", err)
+	}
+
+	users := make([]domain.User, 0, len(listModels))
+	for i := range listModels {
+		model := listModels[i]
+		users = append(users, model.toDomain())
+	}
+
+	return users, nil
+}
+
 func (r *Repository) Update(ctx context.Context, user *domain.User) error {
 	executor := r.getExecutor(ctx)
 
@@ -14,12 +14,17 @@ import (
 	"github.com/rom8726/warden/internal/common/techserver"
 	"github.com/rom8726/warden/internal/domain"
 	"github.com/rom8726/warden/internal/infra"
+	"github.com/rom8726/warden/internal/repository/attachments"
 	"github.com/rom8726/warden/internal/repository/events"
+	"github.com/rom8726/warden/internal/repository/issueactivities"
 	"github.com/rom8726/warden/internal/repository/issues"
+	"github.com/rom8726/warden/internal/repository/issuesnoozes"
+	"github.com/rom8726/warden/internal/repository/monitors"
 	"github.com/rom8726/warden/internal/repository/notificationsqueue"
 	"github.com/rom8726/warden/internal/repository/projects"
 	"github.com/rom8726/warden/internal/repository/releases"
 	"github.com/rom8726/warden/internal/repository/releasestats"
+	"github.com/rom8726/warden/internal/repository/sessions"
 	"github.com/rom8726/warden/internal/repository/teams"
 	"github.com/rom8726/warden/internal/repository/usernotifications"
 	"github.com/rom8726/warden/internal/repository/users"
@@ -29,6 +34,7 @@ import (
 	"github.com/rom8726/warden/internal/scheduler/usecases/analytics"
 	usernotificationsusecase "github.com/rom8726/warden/internal/scheduler/usecases/usernotifications"
 	"github.com/rom8726/warden/internal/services/notification-channels/email"
+	"github.com/rom8726/warden/pkg/blobstore"
 	"github.com/rom8726/warden/pkg/db"
 	"github.com/rom8726/warden/pkg/kafka"
 )
@@ -114,6 +120,7 @@ func (app *App) registerComponents() {
 	})
 
 	eventsProducer := kafka.NewTopicProducer(kafka.NewNopKafkaProducer(), domain.EventsKafkaTopic)
+	sessionsProducer := kafka.NewTopicProducer(kafka.NewNopKafkaProducer(), domain.SessionsKafkaTopic)
 
 	// Register repositories
 	app.registerComponent(issues.New).Arg(app.PostgresPool)
@@ -122,9 +129,17 @@ func (app *App) registerComponents() {
 	app.registerComponent(releases.New).Arg(app.PostgresPool)
 	app.registerComponent(releasestats.New).Arg(app.PostgresPool)
 	app.registerComponent(events.New).Arg(app.PostgresPool).Arg(eventsProducer)
+	app.registerComponent(sessions.New).Arg(sessionsProducer)
 	app.registerComponent(projects.New).Arg(app.PostgresPool)
 	app.registerComponent(teams.New).Arg(app.PostgresPool)
 	app.registerComponent(users.New).Arg(app.PostgresPool)
+	app.registerComponent(attachments.New).Arg(app.PostgresPool)
+	app.registerComponent(monitors.New).Arg(app.PostgresPool)
+	app.registerComponent(issuesnoozes.New).Arg(app.PostgresPool)
+	app.registerComponent(issueactivities.New).Arg(app.PostgresPool)
+	app.registerComponent(func() (blobstore.Store, error) {
+		return commonconfig.NewBlobStore(&app.Config.Attachments)
+	})
 
 	// Register use cases
 	app.registerComponent(analytics.New)
@@ -150,6 +165,9 @@ func (app *App) registerComponents() {
 	app.registerComponent(jobs.NewNotificationsQueueCleaner)
 	app.registerComponent(jobs.NewUserNotificationsCleaner)
 	app.registerComponent(jobs.NewIssuesCleanerJob)
+	app.registerComponent(jobs.NewAttachmentsCleanerJob).Arg(app.Config.Attachments.Retention)
+	app.registerComponent(jobs.NewMonitorsCheckerJob)
+	app.registerComponent(jobs.NewIssueSnoozesCheckerJob)
 
 	// Resolve background scheduler
 	var schedulerSrv *scheduler.Scheduler
@@ -204,6 +222,30 @@ func (app *App) registerComponents() {
 	if err := schedulerSrv.Register(issuesCleanerJob, &scheduler.CronIssuesCleaner{}); err != nil {
 		panic(err)
 	}
+
+	var attachmentsCleanerJob *jobs.AttachmentsCleanerJob
+	if err := app.container.Resolve(&attachmentsCleanerJob); err != nil {
+		panic(err)
+	}
+	if err := schedulerSrv.Register(attachmentsCleanerJob, &scheduler.CronAttachmentsCleaner{}); err != nil {
+		panic(err)
+	}
+
+	var monitorsCheckerJob *jobs.MonitorsCheckerJob
+	if err := app.container.Resolve(&monitorsCheckerJob); err != nil {
+		panic(err)
+	}
+	if err := schedulerSrv.Register(monitorsCheckerJob, &scheduler.CronMonitorsChecker{}); err != nil {
+		panic(err)
+	}
+
+	var issueSnoozesCheckerJob *jobs.IssueSnoozesCheckerJob
+	if err := app.container.Resolve(&issueSnoozesCheckerJob); err != nil {
+		panic(err)
+	}
+	if err := schedulerSrv.Register(issueSnoozesCheckerJob, &scheduler.CronIssueSnoozesChecker{}); err != nil {
+		panic(err)
+	}
 }
 
 func (app *App) registerComponent(constructor any) *di.Provider {
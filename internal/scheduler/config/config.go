@@ -14,12 +14,13 @@ const (
 )
 
 type Config struct {
-	Logger      commonconfig.Logger     `envconfig:"LOGGER"`
-	TechServer  commonconfig.Server     `envconfig:"TECH_SERVER"`
-	Postgres    commonconfig.Postgres   `envconfig:"POSTGRES"`
-	ClickHouse  commonconfig.ClickHouse `envconfig:"CLICKHOUSE"`
-	Mailer      commonconfig.Mailer     `envconfig:"MAILER"`
-	FrontendURL string                  `default:"https://warden.your-domain" envconfig:"FRONTEND_URL"`
+	Logger      commonconfig.Logger      `envconfig:"LOGGER"`
+	TechServer  commonconfig.Server      `envconfig:"TECH_SERVER"`
+	Postgres    commonconfig.Postgres    `envconfig:"POSTGRES"`
+	ClickHouse  commonconfig.ClickHouse  `envconfig:"CLICKHOUSE"`
+	Mailer      commonconfig.Mailer      `envconfig:"MAILER"`
+	Attachments commonconfig.Attachments `envconfig:"ATTACHMENTS"`
+	FrontendURL string                   `default:"https://warden.your-domain" envconfig:"FRONTEND_URL"`
 }
 
 func New(filePath string) (*Config, error) {
@@ -19,6 +19,17 @@ type IssuesRepository interface {
 		release string,
 	) (map[string]time.Duration, error)
 	DeleteOld(ctx context.Context, maxAge time.Duration, limit uint) (uint, error)
+	UpsertIssue(ctx context.Context, issue domain.IssueDTO) (domain.IssueUpsertResult, error)
+	Escalate(ctx context.Context, issueID domain.IssueID) (bool, error)
+}
+
+type IssueSnoozesRepository interface {
+	ListActive(ctx context.Context, after *domain.IssueSnoozeCursor, limit uint) ([]domain.IssueSnooze, error)
+	Delete(ctx context.Context, issueID domain.IssueID) error
+}
+
+type IssueActivitiesRepository interface {
+	Create(ctx context.Context, activity domain.IssueActivityDTO) error
 }
 
 type NotificationsQueueRepository interface {
@@ -28,6 +39,7 @@ type NotificationsQueueRepository interface {
 		issueID domain.IssueID,
 		level domain.IssueLevel,
 		isNew, wasReactivated bool,
+		environment string,
 	) error
 	DeleteOld(ctx context.Context, maxAge time.Duration, limit uint) (uint, error)
 }
@@ -49,8 +61,48 @@ type EventRepository interface {
 		ctx context.Context,
 		projectID domain.ProjectID,
 		release string,
+		environment string,
 		segment domain.SegmentName,
 	) (map[string]uint, error)
+	IssueEventStats(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		fingerprints []string,
+		usersSince time.Time,
+		eventsSince time.Time,
+	) (users, events uint, err error)
+	LatestEnvironment(ctx context.Context, projectID domain.ProjectID, fingerprints []string) (string, error)
+}
+
+type SessionsRepository interface {
+	ReleaseHealth(
+		ctx context.Context,
+		projectID domain.ProjectID,
+		adoptionSince time.Time,
+	) (map[string]domain.ReleaseHealth, error)
+}
+
+type AttachmentsRepository interface {
+	ListOld(ctx context.Context, maxAge time.Duration, limit uint) ([]domain.Attachment, error)
+	DeleteByIDs(ctx context.Context, ids []domain.AttachmentID) error
+}
+
+type BlobStore interface {
+	Delete(ctx context.Context, key string) error
+}
+
+type MonitorsRepository interface {
+	ListOverdue(ctx context.Context, now time.Time, limit uint) ([]domain.Monitor, error)
+	ListTimedOutCheckIns(ctx context.Context, now time.Time, limit uint) ([]domain.TimedOutCheckIn, error)
+	UpsertCheckIn(ctx context.Context, checkInDTO domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error)
+	SetCheckInStatus(ctx context.Context, id domain.MonitorCheckInID, status domain.CheckInStatus) (bool, error)
+	UpdateState(
+		ctx context.Context,
+		id domain.MonitorID,
+		status domain.MonitorStatus,
+		lastCheckInAt *time.Time,
+		nextCheckInAt time.Time,
+	) error
 }
 
 type ProjectsRepository interface {
@@ -37,3 +37,15 @@ func (*CronUserNotificationsCleaner) Schedule() string {
 type CronIssuesCleaner struct{}
 
 func (*CronIssuesCleaner) Schedule() string { return "0 0 4 * * *" }
+
+type CronAttachmentsCleaner struct{}
+
+func (*CronAttachmentsCleaner) Schedule() string { return "0 30 4 * * *" }
+
+type CronMonitorsChecker struct{}
+
+func (*CronMonitorsChecker) Schedule() string { return "0 * * * * *" } // Every minute
+
+type CronIssueSnoozesChecker struct{}
+
+func (*CronIssueSnoozesChecker) Schedule() string { return "0 * * * * *" } // Every minute
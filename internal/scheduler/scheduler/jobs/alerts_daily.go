@@ -75,6 +75,7 @@ func (a *AlertsDailyJob) Run(ctx context.Context) error {
 				issue.Level,
 				isNew,
 				!isNew,
+				"",
 			)
 			if err != nil {
 				slog.Error("add notification to queue failed",
@@ -146,6 +146,7 @@ func TestAlertsDailyJob_Run(t *testing.T) {
 							issue.Level,
 							isNew,
 							!isNew,
+							"",
 						).Return(tc.addNotifErr)
 					}
 				}
@@ -0,0 +1,94 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/scheduler/contract"
+	"github.com/rom8726/warden/internal/scheduler/scheduler"
+)
+
+const (
+	attachmentsCleanerBatchSize  = 500
+	attachmentsCleanerMaxBatches = 20
+)
+
+var _ scheduler.Job = (*AttachmentsCleanerJob)(nil)
+
+// AttachmentsCleanerJob removes attachments older than the retention period from the blob store and the database.
+type AttachmentsCleanerJob struct {
+	repo      contract.AttachmentsRepository
+	blobStore contract.BlobStore
+	retention time.Duration
+}
+
+func NewAttachmentsCleanerJob(
+	retention time.Duration,
+	repo contract.AttachmentsRepository,
+	blobStore contract.BlobStore,
+) *AttachmentsCleanerJob {
+	return &AttachmentsCleanerJob{
+		repo:      repo,
+		blobStore: blobStore,
+		retention: retention,
+	}
+}
+
+func (n *AttachmentsCleanerJob) Name() string {
+	return "attachments_cleaner"
+}
+
+func (n *AttachmentsCleanerJob) Run(ctx context.Context) error {
+	start := time.Now()
+	slog.Info("run attachments cleaner job", "job", n.Name())
+
+	var cleaned int
+	for range attachmentsCleanerMaxBatches {
+		attachments, err := n.repo.ListOld(ctx, n.retention, attachmentsCleanerBatchSize)
+		if err != nil {
+			slog.Error("list old attachments failed", "error", err, "job", n.Name())
+
+			return fmt.Errorf("list old attachments: %w", err)
+		}
+
+		if len(attachments) == 0 {
+			break
+		}
+
+		// Rows are kept for blobs failed to be deleted, they are retried on the next run
+		ids := make([]domain.AttachmentID, 0, len(attachments))
+		for _, attachment := range attachments {
+			if err := n.blobStore.Delete(ctx, attachment.BlobKey); err != nil {
+				slog.Error("delete attachment blob failed", "error", err, "job", n.Name(), "key", attachment.BlobKey)
+
+				continue
+			}
+
+			ids = append(ids, attachment.ID)
+		}
+
+		if len(ids) == 0 {
+			break
+		}
+
+		if err := n.repo.DeleteByIDs(ctx, ids); err != nil {
+			slog.Error("delete old attachments failed", "error", err, "job", n.Name())
+
+			return fmt.Errorf("delete old attachments: %w", err)
+		}
+
+		cleaned += len(ids)
+
+		if len(attachments) < attachmentsCleanerBatchSize {
+			break
+		}
+	}
+
+	slog.Info("DONE run attachments cleaner job", "duration",
+		time.Since(start), "job", n.Name(), "cleaned", cleaned)
+
+	return nil
+}
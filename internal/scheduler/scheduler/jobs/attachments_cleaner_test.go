@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/scheduler/contract"
+)
+
+func TestAttachmentsCleanerJob_Run(t *testing.T) {
+	const retention = 24 * time.Hour
+
+	t.Run("deletes blobs and rows", func(t *testing.T) {
+		repo := mockcontract.NewMockAttachmentsRepository(t)
+		blobStore := mockcontract.NewMockBlobStore(t)
+		job := NewAttachmentsCleanerJob(retention, repo, blobStore)
+
+		repo.EXPECT().ListOld(mock.Anything, retention, uint(attachmentsCleanerBatchSize)).Return([]domain.Attachment{
+			{ID: 1, BlobKey: "1/evt-1/a"},
+			{ID: 2, BlobKey: "1/evt-1/b"},
+		}, nil)
+		blobStore.EXPECT().Delete(mock.Anything, "1/evt-1/a").Return(nil)
+		// A failed blob keeps its row to be retried on the next run
+		blobStore.EXPECT().Delete(mock.Anything, "1/evt-1/b").Return(errors.New("s3 unavailable"))
+		repo.EXPECT().DeleteByIDs(mock.Anything, []domain.AttachmentID{1}).Return(nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("nothing to clean", func(t *testing.T) {
+		repo := mockcontract.NewMockAttachmentsRepository(t)
+		job := NewAttachmentsCleanerJob(retention, repo, mockcontract.NewMockBlobStore(t))
+
+		repo.EXPECT().ListOld(mock.Anything, retention, mock.Anything).Return(nil, nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		repo := mockcontract.NewMockAttachmentsRepository(t)
+		job := NewAttachmentsCleanerJob(retention, repo, mockcontract.NewMockBlobStore(t))
+
+		repo.EXPECT().ListOld(mock.Anything, retention, mock.Anything).Return(nil, errors.New("db error"))
+
+		require.ErrorContains(t, job.Run(context.Background()), "db error")
+	})
+}
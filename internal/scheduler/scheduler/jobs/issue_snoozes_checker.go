@@ -0,0 +1,208 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/scheduler/contract"
+	"github.com/rom8726/warden/internal/scheduler/scheduler"
+	"github.com/rom8726/warden/pkg/db"
+)
+
+const issueSnoozesCheckerBatchSize = 500
+
+var _ scheduler.Job = (*IssueSnoozesCheckerJob)(nil)
+
+// IssueSnoozesCheckerJob unignores the ignored issues whose ignore conditions are met,
+// marks them as escalating and queues notifications about them.
+type IssueSnoozesCheckerJob struct {
+	txManager              db.TxManager
+	issueSnoozesRepo       contract.IssueSnoozesRepository
+	issuesRepo             contract.IssuesRepository
+	eventRepo              contract.EventRepository
+	issueActivitiesRepo    contract.IssueActivitiesRepository
+	notificationsQueueRepo contract.NotificationsQueueRepository
+	now                    func() time.Time
+	batchSize              uint
+}
+
+func NewIssueSnoozesCheckerJob(
+	txManager db.TxManager,
+	issueSnoozesRepo contract.IssueSnoozesRepository,
+	issuesRepo contract.IssuesRepository,
+	eventRepo contract.EventRepository,
+	issueActivitiesRepo contract.IssueActivitiesRepository,
+	notificationsQueueRepo contract.NotificationsQueueRepository,
+) *IssueSnoozesCheckerJob {
+	return &IssueSnoozesCheckerJob{
+		txManager:              txManager,
+		issueSnoozesRepo:       issueSnoozesRepo,
+		issuesRepo:             issuesRepo,
+		eventRepo:              eventRepo,
+		issueActivitiesRepo:    issueActivitiesRepo,
+		notificationsQueueRepo: notificationsQueueRepo,
+		now:                    time.Now,
+		batchSize:              issueSnoozesCheckerBatchSize,
+	}
+}
+
+func (n *IssueSnoozesCheckerJob) Name() string {
+	return "issue_snoozes_checker"
+}
+
+func (n *IssueSnoozesCheckerJob) Run(ctx context.Context) error {
+	start := time.Now()
+	now := n.now().UTC()
+
+	var (
+		cursor             *domain.IssueSnoozeCursor
+		checked, escalated int
+	)
+	for {
+		snoozes, err := n.issueSnoozesRepo.ListActive(ctx, cursor, n.batchSize)
+		if err != nil {
+			slog.Error("list issue snoozes failed", "error", err, "job", n.Name())
+
+			return fmt.Errorf("list issue snoozes: %w", err)
+		}
+
+		for i := range snoozes {
+			if n.check(ctx, snoozes[i], now) {
+				escalated++
+			}
+		}
+		checked += len(snoozes)
+
+		if uint(len(snoozes)) < n.batchSize {
+			break
+		}
+
+		last := snoozes[len(snoozes)-1]
+		cursor = &domain.IssueSnoozeCursor{CreatedAt: last.CreatedAt, IssueID: last.IssueID}
+	}
+
+	slog.Debug("DONE run issue snoozes checker job", "duration", time.Since(start), "job", n.Name(),
+		"checked", checked, "escalated", escalated)
+
+	return nil
+}
+
+// check escalates the issue when its ignore conditions are met and reports whether it was escalated.
+// A failure of one issue doesn't stop checking the others, it's retried on the next run.
+func (n *IssueSnoozesCheckerJob) check(ctx context.Context, snooze domain.IssueSnooze, now time.Time) bool {
+	met, err := n.conditionsMet(ctx, snooze, now)
+	if err != nil {
+		slog.Error("check ignore conditions failed", "error", err, "job", n.Name(), "issue_id", snooze.IssueID)
+
+		return false
+	}
+
+	if !met {
+		return false
+	}
+
+	if err := n.escalate(ctx, snooze); err != nil {
+		slog.Error("escalate issue failed", "error", err, "job", n.Name(), "issue_id", snooze.IssueID)
+
+		return false
+	}
+
+	return true
+}
+
+// conditionsMet reports whether any of the ignore conditions is met, the events are only queried
+// for the user count and the rate conditions.
+func (n *IssueSnoozesCheckerJob) conditionsMet(
+	ctx context.Context,
+	snooze domain.IssueSnooze,
+	now time.Time,
+) (bool, error) {
+	conditions := snooze.Conditions
+
+	if conditions.Until != nil && !now.Before(*conditions.Until) {
+		return true, nil
+	}
+
+	if conditions.Count != nil && snooze.TotalEvents >= snooze.EventsBase+*conditions.Count {
+		return true, nil
+	}
+
+	if conditions.UserCount == nil && conditions.RatePerHour == nil {
+		return false, nil
+	}
+
+	users, events, err := n.eventRepo.IssueEventStats(
+		ctx,
+		snooze.ProjectID,
+		snooze.Fingerprints,
+		snooze.CreatedAt,
+		now.Add(-time.Hour),
+	)
+	if err != nil {
+		return false, fmt.Errorf("get issue event stats: %w", err)
+	}
+
+	if conditions.UserCount != nil && users >= *conditions.UserCount {
+		return true, nil
+	}
+
+	return conditions.RatePerHour != nil && events > *conditions.RatePerHour, nil
+}
+
+func (n *IssueSnoozesCheckerJob) escalate(ctx context.Context, snooze domain.IssueSnooze) error {
+	// The notification is sent for the environment of the latest event, rules scoped to it apply
+	var environment string
+	if domain.IsNotifiableLevel(snooze.Level) {
+		var err error
+		environment, err = n.eventRepo.LatestEnvironment(ctx, snooze.ProjectID, snooze.Fingerprints)
+		if err != nil {
+			return fmt.Errorf("get latest environment: %w", err)
+		}
+	}
+
+	return n.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		escalated, err := n.issuesRepo.Escalate(ctx, snooze.IssueID)
+		if err != nil {
+			return fmt.Errorf("escalate issue: %w", err)
+		}
+
+		// The status has been changed in the meantime
+		if !escalated {
+			return nil
+		}
+
+		if err := n.issueSnoozesRepo.Delete(ctx, snooze.IssueID); err != nil {
+			return fmt.Errorf("delete issue snooze: %w", err)
+		}
+
+		err = n.issueActivitiesRepo.Create(ctx, domain.IssueActivityDTO{
+			IssueID: snooze.IssueID,
+			Type:    domain.IssueActivityEscalating,
+		})
+		if err != nil {
+			return fmt.Errorf("add issue activity: %w", err)
+		}
+
+		if !domain.IsNotifiableLevel(snooze.Level) {
+			return nil
+		}
+
+		// Escalating issues are reported like regressions
+		err = n.notificationsQueueRepo.AddNotification(
+			ctx,
+			snooze.ProjectID,
+			snooze.IssueID,
+			snooze.Level,
+			false, true,
+			environment,
+		)
+		if err != nil {
+			return fmt.Errorf("add notification: %w", err)
+		}
+
+		return nil
+	})
+}
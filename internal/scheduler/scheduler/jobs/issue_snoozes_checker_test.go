@@ -0,0 +1,221 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/scheduler/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+func TestIssueSnoozesCheckerJob_Run(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	ignoredAt := now.Add(-24 * time.Hour)
+	uintPtr := func(v uint) *uint { return &v }
+	snooze := func(conditions domain.IssueIgnoreConditions) domain.IssueSnooze {
+		return domain.IssueSnooze{
+			IssueID:      10,
+			ProjectID:    1,
+			Level:        domain.IssueLevelError,
+			Fingerprints: []string{"fp-1", "fp-2"},
+			Conditions:   conditions,
+			EventsBase:   50,
+			TotalEvents:  120,
+			CreatedAt:    ignoredAt,
+		}
+	}
+
+	type jobMocks struct {
+		snoozesRepo            *mockcontract.MockIssueSnoozesRepository
+		issuesRepo             *mockcontract.MockIssuesRepository
+		eventRepo              *mockcontract.MockEventRepository
+		activitiesRepo         *mockcontract.MockIssueActivitiesRepository
+		notificationsQueueRepo *mockcontract.MockNotificationsQueueRepository
+	}
+
+	newJob := func(t *testing.T) (*IssueSnoozesCheckerJob, jobMocks) {
+		t.Helper()
+
+		txManager := mockdb.NewMockTxManager(t)
+		txManager.EXPECT().ReadCommitted(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+		m := jobMocks{
+			snoozesRepo:            mockcontract.NewMockIssueSnoozesRepository(t),
+			issuesRepo:             mockcontract.NewMockIssuesRepository(t),
+			eventRepo:              mockcontract.NewMockEventRepository(t),
+			activitiesRepo:         mockcontract.NewMockIssueActivitiesRepository(t),
+			notificationsQueueRepo: mockcontract.NewMockNotificationsQueueRepository(t),
+		}
+
+		job := NewIssueSnoozesCheckerJob(
+			txManager,
+			m.snoozesRepo,
+			m.issuesRepo,
+			m.eventRepo,
+			m.activitiesRepo,
+			m.notificationsQueueRepo,
+		)
+		job.now = func() time.Time { return now }
+
+		return job, m
+	}
+
+	expectEscalation := func(m jobMocks) {
+		m.eventRepo.EXPECT().LatestEnvironment(mock.Anything, domain.ProjectID(1), []string{"fp-1", "fp-2"}).
+			Return("production", nil)
+		m.issuesRepo.EXPECT().Escalate(mock.Anything, domain.IssueID(10)).Return(true, nil)
+		m.snoozesRepo.EXPECT().Delete(mock.Anything, domain.IssueID(10)).Return(nil)
+		m.activitiesRepo.EXPECT().Create(mock.Anything, domain.IssueActivityDTO{
+			IssueID: 10,
+			Type:    domain.IssueActivityEscalating,
+		}).Return(nil)
+		m.notificationsQueueRepo.EXPECT().
+			AddNotification(mock.Anything, domain.ProjectID(1), domain.IssueID(10), domain.IssueLevelError, false, true,
+				"production").
+			Return(nil)
+	}
+
+	t.Run("until passed", func(t *testing.T) {
+		job, m := newJob(t)
+		until := now.Add(-time.Minute)
+
+		m.snoozesRepo.EXPECT().
+			ListActive(mock.Anything, (*domain.IssueSnoozeCursor)(nil), uint(issueSnoozesCheckerBatchSize)).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{Until: &until})}, nil)
+		expectEscalation(m)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("count reached", func(t *testing.T) {
+		job, m := newJob(t)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{Count: uintPtr(70)})}, nil)
+		expectEscalation(m)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("rate exceeded", func(t *testing.T) {
+		job, m := newJob(t)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{
+				Count:       uintPtr(100),
+				UserCount:   uintPtr(10),
+				RatePerHour: uintPtr(30),
+			})}, nil)
+		m.eventRepo.EXPECT().
+			IssueEventStats(mock.Anything, domain.ProjectID(1), []string{"fp-1", "fp-2"}, ignoredAt, now.Add(-time.Hour)).
+			Return(uint(3), uint(31), nil)
+		expectEscalation(m)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("no condition met", func(t *testing.T) {
+		job, m := newJob(t)
+		until := now.Add(time.Hour)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{
+				Until:       &until,
+				Count:       uintPtr(100),
+				UserCount:   uintPtr(10),
+				RatePerHour: uintPtr(30),
+			})}, nil)
+		m.eventRepo.EXPECT().IssueEventStats(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(uint(9), uint(30), nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("issue status changed in the meantime", func(t *testing.T) {
+		job, m := newJob(t)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{Count: uintPtr(1)})}, nil)
+		m.eventRepo.EXPECT().LatestEnvironment(mock.Anything, mock.Anything, mock.Anything).Return("production", nil)
+		m.issuesRepo.EXPECT().Escalate(mock.Anything, domain.IssueID(10)).Return(false, nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("latest environment error skips the issue", func(t *testing.T) {
+		job, m := newJob(t)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{Count: uintPtr(1)})}, nil)
+		m.eventRepo.EXPECT().LatestEnvironment(mock.Anything, mock.Anything, mock.Anything).
+			Return("", errors.New("clickhouse down"))
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("pages through the snoozes", func(t *testing.T) {
+		job, m := newJob(t)
+		job.batchSize = 2
+		later, passed := now.Add(time.Hour), now.Add(-time.Minute)
+		page := func(ids ...domain.IssueID) []domain.IssueSnooze {
+			snoozes := make([]domain.IssueSnooze, 0, len(ids))
+			for _, id := range ids {
+				// Only the issue 10 of the second page is due
+				until := later
+				if id == 10 {
+					until = passed
+				}
+
+				item := snooze(domain.IssueIgnoreConditions{Until: &until})
+				item.IssueID = id
+				item.CreatedAt = ignoredAt.Add(time.Duration(id) * time.Minute)
+				snoozes = append(snoozes, item)
+			}
+
+			return snoozes
+		}
+		cursor := func(id domain.IssueID) *domain.IssueSnoozeCursor {
+			return &domain.IssueSnoozeCursor{CreatedAt: ignoredAt.Add(time.Duration(id) * time.Minute), IssueID: id}
+		}
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, (*domain.IssueSnoozeCursor)(nil), uint(2)).
+			Return(page(1, 2), nil)
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, cursor(2), uint(2)).Return(page(3, 10), nil)
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, cursor(10), uint(2)).Return(nil, nil)
+		expectEscalation(m)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("list error fails the run", func(t *testing.T) {
+		job, m := newJob(t)
+		job.batchSize = 1
+		until := now.Add(time.Hour)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, (*domain.IssueSnoozeCursor)(nil), uint(1)).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{Until: &until})}, nil)
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.AnythingOfType("*domain.IssueSnoozeCursor"), uint(1)).
+			Return(nil, errors.New("postgres down"))
+
+		require.Error(t, job.Run(context.Background()))
+	})
+
+	t.Run("events stats error skips the issue", func(t *testing.T) {
+		job, m := newJob(t)
+
+		m.snoozesRepo.EXPECT().ListActive(mock.Anything, mock.Anything, mock.Anything).
+			Return([]domain.IssueSnooze{snooze(domain.IssueIgnoreConditions{UserCount: uintPtr(10)})}, nil)
+		m.eventRepo.EXPECT().IssueEventStats(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(uint(0), uint(0), errors.New("clickhouse down"))
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+}
@@ -0,0 +1,189 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/rom8726/warden/internal/common/monitors"
+	"github.com/rom8726/warden/internal/domain"
+	"github.com/rom8726/warden/internal/scheduler/contract"
+	"github.com/rom8726/warden/internal/scheduler/scheduler"
+	"github.com/rom8726/warden/pkg/db"
+	"github.com/rom8726/warden/pkg/metrics"
+)
+
+const monitorsCheckerBatchSize = 500
+
+var _ scheduler.Job = (*MonitorsCheckerJob)(nil)
+
+// MonitorsCheckerJob detects missed and timed out check-ins of cron monitors and raises incidents for them.
+type MonitorsCheckerJob struct {
+	txManager              db.TxManager
+	monitorsRepo           contract.MonitorsRepository
+	issuesRepo             contract.IssuesRepository
+	notificationsQueueRepo contract.NotificationsQueueRepository
+	now                    func() time.Time
+}
+
+func NewMonitorsCheckerJob(
+	txManager db.TxManager,
+	monitorsRepo contract.MonitorsRepository,
+	issuesRepo contract.IssuesRepository,
+	notificationsQueueRepo contract.NotificationsQueueRepository,
+) *MonitorsCheckerJob {
+	return &MonitorsCheckerJob{
+		txManager:              txManager,
+		monitorsRepo:           monitorsRepo,
+		issuesRepo:             issuesRepo,
+		notificationsQueueRepo: notificationsQueueRepo,
+		now:                    time.Now,
+	}
+}
+
+func (n *MonitorsCheckerJob) Name() string {
+	return "monitors_checker"
+}
+
+func (n *MonitorsCheckerJob) Run(ctx context.Context) error {
+	start := time.Now()
+	now := n.now().UTC()
+
+	overdue, err := n.monitorsRepo.ListOverdue(ctx, now, monitorsCheckerBatchSize)
+	if err != nil {
+		slog.Error("list overdue monitors failed", "error", err, "job", n.Name())
+
+		return fmt.Errorf("list overdue monitors: %w", err)
+	}
+
+	// A failure of one monitor doesn't stop checking the others, it's retried on the next run
+	var missed int
+	for _, monitor := range overdue {
+		if err := n.markMissed(ctx, monitor, now); err != nil {
+			slog.Error("mark check-in missed failed", "error", err, "job", n.Name(), "monitor", monitor.Slug)
+
+			continue
+		}
+
+		missed++
+	}
+
+	timedOut, err := n.monitorsRepo.ListTimedOutCheckIns(ctx, now, monitorsCheckerBatchSize)
+	if err != nil {
+		slog.Error("list timed out check-ins failed", "error", err, "job", n.Name())
+
+		return fmt.Errorf("list timed out check-ins: %w", err)
+	}
+
+	var timeouts int
+	for _, item := range timedOut {
+		if err := n.markTimedOut(ctx, item, now); err != nil {
+			slog.Error("mark check-in timed out failed", "error", err, "job", n.Name(), "monitor", item.Monitor.Slug)
+
+			continue
+		}
+
+		timeouts++
+	}
+
+	slog.Debug("DONE run monitors checker job", "duration", time.Since(start), "job", n.Name(),
+		"missed", missed, "timeouts", timeouts)
+
+	return nil
+}
+
+// markMissed records a missed check-in for the overdue monitor and moves the expected check-in forward.
+func (n *MonitorsCheckerJob) markMissed(ctx context.Context, monitor domain.Monitor, now time.Time) error {
+	nextCheckInAt, err := monitors.NextCheckIn(monitor, now)
+	if err != nil {
+		return fmt.Errorf("next check-in: %w", err)
+	}
+
+	return n.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, err := n.monitorsRepo.UpsertCheckIn(ctx, domain.MonitorCheckInDTO{
+			MonitorID:  monitor.ID,
+			CheckInID:  uuid.NewString(),
+			Status:     domain.CheckInStatusMissed,
+			ExpectedAt: monitor.NextCheckInAt,
+		})
+		if err != nil {
+			return fmt.Errorf("create missed check-in: %w", err)
+		}
+
+		err = n.monitorsRepo.UpdateState(ctx, monitor.ID, domain.MonitorStatusMissed, nil, nextCheckInAt)
+		if err != nil {
+			return fmt.Errorf("update monitor state: %w", err)
+		}
+
+		return n.raiseIncident(ctx, monitor, domain.CheckInStatusMissed)
+	})
+}
+
+// markTimedOut finishes the in progress check-in running longer than the max runtime of the monitor.
+func (n *MonitorsCheckerJob) markTimedOut(ctx context.Context, item domain.TimedOutCheckIn, now time.Time) error {
+	monitor := item.Monitor
+
+	var nextCheckInAt time.Time
+	if monitor.NextCheckInAt != nil {
+		nextCheckInAt = *monitor.NextCheckInAt
+	} else {
+		var err error
+		nextCheckInAt, err = monitors.NextCheckIn(monitor, now)
+		if err != nil {
+			return fmt.Errorf("next check-in: %w", err)
+		}
+	}
+
+	return n.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		updated, err := n.monitorsRepo.SetCheckInStatus(ctx, item.CheckIn.ID, domain.CheckInStatusTimeout)
+		if err != nil {
+			return fmt.Errorf("set check-in status: %w", err)
+		}
+
+		// The job has reported its result in the meantime
+		if !updated {
+			return nil
+		}
+
+		err = n.monitorsRepo.UpdateState(ctx, monitor.ID, domain.MonitorStatusTimeout, nil, nextCheckInAt)
+		if err != nil {
+			return fmt.Errorf("update monitor state: %w", err)
+		}
+
+		return n.raiseIncident(ctx, monitor, domain.CheckInStatusTimeout)
+	})
+}
+
+func (n *MonitorsCheckerJob) raiseIncident(
+	ctx context.Context,
+	monitor domain.Monitor,
+	status domain.CheckInStatus,
+) error {
+	issue := monitor.IncidentIssue(status)
+
+	upsertRes, err := n.issuesRepo.UpsertIssue(ctx, issue)
+	if err != nil {
+		return fmt.Errorf("upsert issue: %w", err)
+	}
+
+	metrics.MonitorIncidents.WithLabelValues(monitor.ProjectID.String(), string(status)).Inc()
+
+	if upsertRes.IsNew || upsertRes.WasReactivated {
+		err := n.notificationsQueueRepo.AddNotification(
+			ctx,
+			monitor.ProjectID,
+			upsertRes.ID,
+			issue.Level,
+			upsertRes.IsNew, upsertRes.WasReactivated,
+			"",
+		)
+		if err != nil {
+			return fmt.Errorf("add notification: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/warden/internal/domain"
+	mockcontract "github.com/rom8726/warden/test_mocks/internal_/scheduler/contract"
+	mockdb "github.com/rom8726/warden/test_mocks/pkg/db"
+)
+
+func TestMonitorsCheckerJob_Run(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC)
+	expectedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	monitor := domain.Monitor{
+		ID:            1,
+		ProjectID:     1,
+		Slug:          "nightly",
+		ScheduleType:  domain.MonitorScheduleCrontab,
+		Schedule:      "0 * * * *",
+		Timezone:      "UTC",
+		CheckInMargin: time.Minute,
+		MaxRuntime:    30 * time.Minute,
+		NextCheckInAt: &expectedAt,
+	}
+
+	newJob := func(t *testing.T) (
+		*MonitorsCheckerJob,
+		*mockcontract.MockMonitorsRepository,
+		*mockcontract.MockIssuesRepository,
+		*mockcontract.MockNotificationsQueueRepository,
+	) {
+		t.Helper()
+
+		txManager := mockdb.NewMockTxManager(t)
+		txManager.EXPECT().ReadCommitted(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).Maybe()
+		monitorsRepo := mockcontract.NewMockMonitorsRepository(t)
+		issuesRepo := mockcontract.NewMockIssuesRepository(t)
+		notificationsQueueRepo := mockcontract.NewMockNotificationsQueueRepository(t)
+
+		job := NewMonitorsCheckerJob(txManager, monitorsRepo, issuesRepo, notificationsQueueRepo)
+		job.now = func() time.Time { return now }
+
+		return job, monitorsRepo, issuesRepo, notificationsQueueRepo
+	}
+
+	t.Run("missed check-in raises an incident", func(t *testing.T) {
+		job, monitorsRepo, issuesRepo, notificationsQueueRepo := newJob(t)
+
+		monitorsRepo.EXPECT().ListOverdue(mock.Anything, now, uint(monitorsCheckerBatchSize)).
+			Return([]domain.Monitor{monitor}, nil)
+		monitorsRepo.EXPECT().
+			UpsertCheckIn(mock.Anything, mock.MatchedBy(func(dto domain.MonitorCheckInDTO) bool {
+				return dto.Status == domain.CheckInStatusMissed && dto.ExpectedAt.Equal(expectedAt)
+			})).
+			Return(domain.MonitorCheckIn{}, nil)
+		monitorsRepo.EXPECT().
+			UpdateState(mock.Anything, domain.MonitorID(1), domain.MonitorStatusMissed, (*time.Time)(nil),
+				time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)).
+			Return(nil)
+		issuesRepo.EXPECT().
+			UpsertIssue(mock.Anything, mock.MatchedBy(func(issue domain.IssueDTO) bool {
+				return issue.Fingerprint == "monitor:nightly:missed"
+			})).
+			Return(domain.IssueUpsertResult{ID: 10, WasReactivated: true}, nil)
+		notificationsQueueRepo.EXPECT().
+			AddNotification(mock.Anything, domain.ProjectID(1), domain.IssueID(10), domain.IssueLevelError, false, true, "").
+			Return(nil)
+		monitorsRepo.EXPECT().ListTimedOutCheckIns(mock.Anything, now, mock.Anything).Return(nil, nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("finished check-in is not timed out", func(t *testing.T) {
+		job, monitorsRepo, _, _ := newJob(t)
+
+		monitorsRepo.EXPECT().ListOverdue(mock.Anything, now, mock.Anything).Return(nil, nil)
+		monitorsRepo.EXPECT().ListTimedOutCheckIns(mock.Anything, now, mock.Anything).
+			Return([]domain.TimedOutCheckIn{{CheckIn: domain.MonitorCheckIn{ID: 5}, Monitor: monitor}}, nil)
+		monitorsRepo.EXPECT().SetCheckInStatus(mock.Anything, domain.MonitorCheckInID(5), domain.CheckInStatusTimeout).
+			Return(false, nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("timed out check-in raises an incident", func(t *testing.T) {
+		job, monitorsRepo, issuesRepo, _ := newJob(t)
+
+		monitorsRepo.EXPECT().ListOverdue(mock.Anything, now, mock.Anything).Return(nil, nil)
+		monitorsRepo.EXPECT().ListTimedOutCheckIns(mock.Anything, now, mock.Anything).
+			Return([]domain.TimedOutCheckIn{{CheckIn: domain.MonitorCheckIn{ID: 5}, Monitor: monitor}}, nil)
+		monitorsRepo.EXPECT().SetCheckInStatus(mock.Anything, domain.MonitorCheckInID(5), domain.CheckInStatusTimeout).
+			Return(true, nil)
+		monitorsRepo.EXPECT().
+			UpdateState(mock.Anything, domain.MonitorID(1), domain.MonitorStatusTimeout, (*time.Time)(nil), expectedAt).
+			Return(nil)
+		issuesRepo.EXPECT().UpsertIssue(mock.Anything, mock.Anything).Return(domain.IssueUpsertResult{ID: 11}, nil)
+
+		require.NoError(t, job.Run(context.Background()))
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		job, monitorsRepo, _, _ := newJob(t)
+
+		monitorsRepo.EXPECT().ListOverdue(mock.Anything, now, mock.Anything).Return(nil, errors.New("db error"))
+
+		require.ErrorContains(t, job.Run(context.Background()), "db error")
+	})
+}
@@ -11,12 +11,16 @@ import (
 	"github.com/rom8726/warden/internal/scheduler/contract"
 )
 
+// adoptionWindow is the period release adoption is calculated over.
+const adoptionWindow = 24 * time.Hour
+
 type AnalyticsService struct {
 	releaseRepo      contract.ReleaseRepository
 	releaseStatsRepo contract.ReleaseStatsRepository
 	eventRepo        contract.EventRepository
 	projectsRepo     contract.ProjectsRepository
 	issuesRepo       contract.IssuesRepository
+	sessionsRepo     contract.SessionsRepository
 }
 
 func New(
@@ -25,6 +29,7 @@ func New(
 	eventRepo contract.EventRepository,
 	projectsRepo contract.ProjectsRepository,
 	issuesRepo contract.IssuesRepository,
+	sessionsRepo contract.SessionsRepository,
 ) *AnalyticsService {
 	return &AnalyticsService{
 		releaseRepo:      releaseRepo,
@@ -32,6 +37,7 @@ func New(
 		eventRepo:        eventRepo,
 		projectsRepo:     projectsRepo,
 		issuesRepo:       issuesRepo,
+		sessionsRepo:     sessionsRepo,
 	}
 }
 
@@ -49,9 +55,21 @@ func (s *AnalyticsService) RecalculateReleaseStatsForAllProjects(ctx context.Con
 
 			continue
 		}
+
+		// Release health is optional, stats are still calculated without sessions
+		health, err := s.sessionsRepo.ReleaseHealth(ctx, project.ID, time.Now().Add(-adoptionWindow))
+		if err != nil {
+			slog.Warn("analytics_stats: failed to get release health", "project", project.ID, "err", err)
+		}
+
+		var recentSessionsTotal uint
+		for _, h := range health {
+			recentSessionsTotal += h.RecentSessions
+		}
+
 		for _, rel := range releases {
 			// --- Known issues ---
-			totalIssues, err := s.eventRepo.AggregateBySegment(ctx, project.ID, rel.Version, "group_hash")
+			totalIssues, err := s.eventRepo.AggregateBySegment(ctx, project.ID, rel.Version, "", "group_hash")
 			if err != nil {
 				slog.Warn("analytics_stats: failed to count total issues", "release", rel.ID, "err", err)
 
@@ -124,7 +142,7 @@ func (s *AnalyticsService) RecalculateReleaseStatsForAllProjects(ctx context.Con
 			}
 
 			// --- Users affected ---
-			usersAffectedAgg, err := s.eventRepo.AggregateBySegment(ctx, project.ID, rel.Version, "user_id")
+			usersAffectedAgg, err := s.eventRepo.AggregateBySegment(ctx, project.ID, rel.Version, "", "user_id")
 			if err != nil {
 				slog.Warn("analytics_stats: failed to count users affected", "release", rel.ID, "err", err)
 
@@ -133,7 +151,7 @@ func (s *AnalyticsService) RecalculateReleaseStatsForAllProjects(ctx context.Con
 			usersAffected := uint(len(usersAffectedAgg))
 
 			// --- Severity distribution ---
-			severityAgg, err := s.eventRepo.AggregateBySegment(ctx, project.ID, rel.Version, "level")
+			severityAgg, err := s.eventRepo.AggregateBySegment(ctx, project.ID, rel.Version, "", "level")
 			if err != nil {
 				slog.Warn("analytics_stats: failed to aggregate severity", "release", rel.ID, "err", err)
 
@@ -166,6 +184,10 @@ func (s *AnalyticsService) RecalculateReleaseStatsForAllProjects(ctx context.Con
 				SeverityDistribution:   severityAgg,
 				UsersAffected:          usersAffected,
 			}
+			if releaseHealth, ok := health[rel.Version]; ok {
+				applyReleaseHealth(&stats, releaseHealth, recentSessionsTotal)
+			}
+
 			err = s.releaseStatsRepo.Create(ctx, stats)
 			if err != nil {
 				slog.Warn("analytics_stats: failed to save stats", "release", rel.ID, "err", err)
@@ -175,3 +197,18 @@ func (s *AnalyticsService) RecalculateReleaseStatsForAllProjects(ctx context.Con
 
 	return nil
 }
+
+func applyReleaseHealth(stats *domain.ReleaseStats, health domain.ReleaseHealth, recentSessionsTotal uint) {
+	stats.SessionsTotal = health.SessionsTotal
+	stats.SessionsErrored = health.SessionsErrored
+	stats.SessionsCrashed = health.SessionsCrashed
+	stats.UsersTotal = health.UsersTotal
+	stats.CrashFreeSessions = health.CrashFreeSessions()
+	stats.CrashFreeUsers = health.CrashFreeUsers()
+	stats.AvgSessionDuration = health.AvgDuration
+
+	if recentSessionsTotal > 0 {
+		adoption := float64(health.RecentSessions) / float64(recentSessionsTotal)
+		stats.Adoption = &adoption
+	}
+}
@@ -24,6 +24,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 			mockEventRepo *mockcontract.MockEventRepository,
 			mockIssuesRepo *mockcontract.MockIssuesRepository,
 			mockReleaseStatsRepo *mockcontract.MockReleaseStatsRepository,
+			mockSessionsRepo *mockcontract.MockSessionsRepository,
 		)
 		expectedError bool
 		errorContains string
@@ -36,6 +37,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 				mockEventRepo *mockcontract.MockEventRepository,
 				mockIssuesRepo *mockcontract.MockIssuesRepository,
 				mockReleaseStatsRepo *mockcontract.MockReleaseStatsRepository,
+				mockSessionsRepo *mockcontract.MockSessionsRepository,
 			) {
 				projects := []domain.ProjectExtended{
 					{Project: domain.Project{ID: 1, Name: "Project 1"}},
@@ -53,12 +55,29 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 				// Mock releases for project 1
 				mockReleaseRepo.EXPECT().ListByProject(mock.Anything, domain.ProjectID(1)).Return(releases, nil)
 
+				// Mock release health, only 1.0.0 has sessions
+				mockSessionsRepo.EXPECT().ReleaseHealth(mock.Anything, domain.ProjectID(1), mock.Anything).
+					Return(map[string]domain.ReleaseHealth{
+						"1.0.0": {
+							Release:         "1.0.0",
+							SessionsTotal:   200,
+							SessionsCrashed: 10,
+							UsersTotal:      50,
+							UsersCrashed:    5,
+							RecentSessions:  30,
+						},
+						"0.9.0": {Release: "0.9.0", SessionsTotal: 1000, RecentSessions: 90},
+					}, nil)
+				mockSessionsRepo.EXPECT().ReleaseHealth(mock.Anything, domain.ProjectID(2), mock.Anything).
+					Return(nil, errors.New("clickhouse error"))
+
 				// Mock total issues aggregation
 				totalIssues := map[string]uint{"issue1": 5, "issue2": 3}
 				mockEventRepo.EXPECT().AggregateBySegment(
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentName("group_hash"),
 				).Return(totalIssues, nil)
 
@@ -92,6 +111,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentName("user_id"),
 				).Return(usersAffected, nil)
 
@@ -101,6 +121,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.0.0",
+					"",
 					domain.SegmentName("level"),
 				).Return(severityDist, nil)
 
@@ -125,7 +146,13 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 							stats.ResolvedInVersionTotal == 1 &&
 							stats.FixedNewInVersionTotal == 1 &&
 							stats.FixedOldInVersionTotal == 0 &&
-							stats.UsersAffected == 2
+							stats.UsersAffected == 2 &&
+							stats.SessionsTotal == 200 &&
+							stats.SessionsCrashed == 10 &&
+							stats.UsersTotal == 50 &&
+							*stats.CrashFreeSessions == 0.95 &&
+							*stats.CrashFreeUsers == 0.9 &&
+							*stats.Adoption == 0.25
 					}),
 				).Return(nil)
 
@@ -137,6 +164,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.1.0",
+					"",
 					domain.SegmentName("group_hash"),
 				).Return(map[string]uint{"issue3": 2}, nil)
 
@@ -162,6 +190,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.1.0",
+					"",
 					domain.SegmentName("user_id"),
 				).Return(map[string]uint{"user3": 1}, nil)
 
@@ -169,6 +198,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 					mock.Anything,
 					domain.ProjectID(1),
 					"1.1.0",
+					"",
 					domain.SegmentName("level"),
 				).Return(map[string]uint{"error": 2}, nil)
 
@@ -190,7 +220,10 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 							stats.ResolvedInVersionTotal == 1 &&
 							stats.FixedNewInVersionTotal == 1 &&
 							stats.FixedOldInVersionTotal == 0 &&
-							stats.UsersAffected == 1
+							stats.UsersAffected == 1 &&
+							stats.SessionsTotal == 0 &&
+							stats.CrashFreeSessions == nil &&
+							stats.Adoption == nil
 					}),
 				).Return(nil)
 			},
@@ -204,6 +237,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 				mockEventRepo *mockcontract.MockEventRepository,
 				mockIssuesRepo *mockcontract.MockIssuesRepository,
 				mockReleaseStatsRepo *mockcontract.MockReleaseStatsRepository,
+				mockSessionsRepo *mockcontract.MockSessionsRepository,
 			) {
 				mockProjectsRepo.EXPECT().List(mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -218,6 +252,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 				mockEventRepo *mockcontract.MockEventRepository,
 				mockIssuesRepo *mockcontract.MockIssuesRepository,
 				mockReleaseStatsRepo *mockcontract.MockReleaseStatsRepository,
+				mockSessionsRepo *mockcontract.MockSessionsRepository,
 			) {
 				projects := []domain.ProjectExtended{
 					{Project: domain.Project{ID: 1, Name: "Project 1"}},
@@ -240,9 +275,17 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 			mockEventRepo := mockcontract.NewMockEventRepository(t)
 			mockProjectsRepo := mockcontract.NewMockProjectsRepository(t)
 			mockIssuesRepo := mockcontract.NewMockIssuesRepository(t)
+			mockSessionsRepo := mockcontract.NewMockSessionsRepository(t)
 
 			// Setup mocks
-			tt.setupMocks(mockProjectsRepo, mockReleaseRepo, mockEventRepo, mockIssuesRepo, mockReleaseStatsRepo)
+			tt.setupMocks(
+				mockProjectsRepo,
+				mockReleaseRepo,
+				mockEventRepo,
+				mockIssuesRepo,
+				mockReleaseStatsRepo,
+				mockSessionsRepo,
+			)
 
 			// Create service
 			service := New(
@@ -251,6 +294,7 @@ func TestRecalculateReleaseStatsForAllProjects(t *testing.T) {
 				mockEventRepo,
 				mockProjectsRepo,
 				mockIssuesRepo,
+				mockSessionsRepo,
 			)
 
 			// Call method
@@ -120,6 +120,38 @@ func (s *Service) Send(
 	return s.SendEmail(ctx, emails, subject, body)
 }
 
+// SendToAssignee emails the issue alert to the assigned user or the members of the assigned team.
+func (s *Service) SendToAssignee(
+	ctx context.Context,
+	issue *domain.Issue,
+	project *domain.Project,
+	isRegress bool,
+) error {
+	emails, err := s.getAssigneeEmails(ctx, issue.Assignee)
+	if err != nil {
+		return fmt.Errorf("get assignee emails: %w", err)
+	}
+
+	if len(emails) == 0 {
+		return nil
+	}
+
+	newOrRegress := "new"
+	if isRegress {
+		newOrRegress = "regress"
+	}
+
+	subject := fmt.Sprintf("[%s][%s][assigned] Issue #%d from project %q: %s",
+		issue.Level, newOrRegress, issue.ID, project.Name, issue.Title)
+
+	body, err := renderSingleIssueEmailBody(issue, project, s.cfg.BaseURL, isRegress)
+	if err != nil {
+		return fmt.Errorf("render body: %w", err)
+	}
+
+	return s.SendEmail(ctx, emails, subject, body)
+}
+
 func (s *Service) SendResetPasswordEmail(ctx context.Context, email, token string) error {
 	slog.Debug("sending reset password email", "base_url", s.cfg.BaseURL)
 
@@ -423,6 +455,38 @@ func (s *Service) getMembersEmails(ctx context.Context, project *domain.Project)
 	return emails, nil
 }
 
+func (s *Service) getAssigneeEmails(ctx context.Context, assignee domain.IssueAssignee) ([]string, error) {
+	var userIDs []domain.UserID
+	switch {
+	case assignee.UserID != nil:
+		userIDs = []domain.UserID{*assignee.UserID}
+	case assignee.TeamID != nil:
+		members, err := s.teamsRepo.GetMembers(ctx, *assignee.TeamID)
+		if err != nil {
+			return nil, fmt.Errorf("get members: %w", err)
+		}
+
+		userIDs = make([]domain.UserID, 0, len(members))
+		for _, member := range members {
+			userIDs = append(userIDs, member.UserID)
+		}
+	default:
+		return nil, nil
+	}
+
+	users, err := s.usersRepo.FetchByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("fetch users: %w", err)
+	}
+
+	emails := make([]string, 0, len(users))
+	for _, user := range users {
+		emails = append(emails, user.Email)
+	}
+
+	return emails, nil
+}
+
 func renderSingleIssueEmailBody(
 	issue *domain.Issue,
 	project *domain.Project,
@@ -657,6 +721,12 @@ func (s *Service) SendUserNotificationEmail(
 		subject = "Warden: Your team role has been changed"
 	case domain.UserNotificationTypeIssueRegression:
 		subject = "Warden: Issue regression detected in project"
+	case domain.UserNotificationTypeSpikeProtection:
+		subject = "Warden: Spike protection activated for project"
+	case domain.UserNotificationTypeIssueAssigned:
+		subject = "Warden: Issue assigned to you"
+	case domain.UserNotificationTypeIssueMention:
+		subject = "Warden: You were mentioned in an issue comment"
 	}
 
 	var body bytes.Buffer
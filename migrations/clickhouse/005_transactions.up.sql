@@ -0,0 +1,22 @@
+CREATE TABLE transactions (
+    event_id String,
+    project_id UInt32,
+    trace_id String,
+    span_id String,
+    parent_span_id String,
+    name String,                    -- transaction name, e.g. "GET /users/{id}"
+    op LowCardinality(String),      -- http.server/task/...
+    status LowCardinality(String),  -- span status: ok/internal_error/...
+    platform LowCardinality(String),
+    environment String,
+    release LowCardinality(String),
+    tags Map(LowCardinality(String), String) DEFAULT map(),
+    start_timestamp DateTime64(3),
+    timestamp DateTime64(3),
+    duration_ms Float64,
+    INDEX idx_trace_id trace_id TYPE bloom_filter GRANULARITY 4
+) ENGINE = MergeTree()
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (project_id, name, timestamp)
+TTL toDateTime(timestamp) + INTERVAL 1 MONTH
+SETTINGS index_granularity = 8192;
@@ -0,0 +1,18 @@
+CREATE TABLE spans (
+    project_id UInt32,
+    transaction_id String,
+    trace_id String,
+    span_id String,
+    parent_span_id String,
+    op LowCardinality(String),      -- db.sql.query/http.client/...
+    description String,
+    status LowCardinality(String),
+    tags Map(LowCardinality(String), String) DEFAULT map(),
+    start_timestamp DateTime64(3),
+    timestamp DateTime64(3),
+    duration_ms Float64
+) ENGINE = MergeTree()
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (project_id, trace_id, start_timestamp)
+TTL toDateTime(timestamp) + INTERVAL 1 MONTH
+SETTINGS index_granularity = 8192;
@@ -0,0 +1,21 @@
+CREATE TABLE kafka_transactions (
+    event_id String,
+    project_id UInt32,
+    trace_id String,
+    span_id String,
+    parent_span_id String,
+    name String,
+    op LowCardinality(String),
+    status LowCardinality(String),
+    platform LowCardinality(String),
+    environment String,
+    release LowCardinality(String),
+    tags Map(LowCardinality(String), String),
+    start_timestamp DateTime64(3),
+    timestamp DateTime64(3),
+    duration_ms Float64
+) ENGINE = Kafka()
+SETTINGS kafka_broker_list = 'warden-kafka:9092',
+         kafka_topic_list = 'clickhouse.transactions',
+         kafka_group_name = 'clickhouse_transactions_group',
+         kafka_format = 'JSONEachRow';
@@ -0,0 +1,18 @@
+CREATE TABLE kafka_spans (
+    project_id UInt32,
+    transaction_id String,
+    trace_id String,
+    span_id String,
+    parent_span_id String,
+    op LowCardinality(String),
+    description String,
+    status LowCardinality(String),
+    tags Map(LowCardinality(String), String),
+    start_timestamp DateTime64(3),
+    timestamp DateTime64(3),
+    duration_ms Float64
+) ENGINE = Kafka()
+SETTINGS kafka_broker_list = 'warden-kafka:9092',
+         kafka_topic_list = 'clickhouse.spans',
+         kafka_group_name = 'clickhouse_spans_group',
+         kafka_format = 'JSONEachRow';
@@ -0,0 +1,2 @@
+-- Drop materialized view
+DROP VIEW IF EXISTS mv_transactions;
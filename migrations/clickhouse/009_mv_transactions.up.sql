@@ -0,0 +1,19 @@
+-- Materialized view to transfer data from Kafka to the transactions table
+CREATE MATERIALIZED VIEW mv_transactions TO transactions AS
+SELECT
+    event_id,
+    project_id,
+    trace_id,
+    span_id,
+    parent_span_id,
+    name,
+    op,
+    status,
+    platform,
+    environment,
+    release,
+    tags,
+    start_timestamp,
+    timestamp,
+    duration_ms
+FROM kafka_transactions;
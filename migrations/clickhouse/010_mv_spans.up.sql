@@ -0,0 +1,16 @@
+-- Materialized view to transfer data from Kafka to the spans table
+CREATE MATERIALIZED VIEW mv_spans TO spans AS
+SELECT
+    project_id,
+    transaction_id,
+    trace_id,
+    span_id,
+    parent_span_id,
+    op,
+    description,
+    status,
+    tags,
+    start_timestamp,
+    timestamp,
+    duration_ms
+FROM kafka_spans;
@@ -0,0 +1,18 @@
+-- Hourly release health aggregates. Users are counted by the session distinct id.
+CREATE TABLE session_aggregates (
+    project_id UInt32,
+    release LowCardinality(String),
+    environment String,
+    bucket DateTime,
+    sessions SimpleAggregateFunction(sum, UInt64),
+    sessions_errored SimpleAggregateFunction(sum, UInt64),
+    sessions_crashed SimpleAggregateFunction(sum, UInt64),  -- crashed and abnormal
+    users AggregateFunction(uniq, Nullable(String)),
+    users_crashed AggregateFunction(uniq, Nullable(String)),
+    duration_ms_sum SimpleAggregateFunction(sum, Float64),  -- of exited sessions
+    duration_count SimpleAggregateFunction(sum, UInt64)
+) ENGINE = AggregatingMergeTree()
+PARTITION BY toYYYYMM(bucket)
+ORDER BY (project_id, release, environment, bucket)
+TTL bucket + INTERVAL 3 MONTH
+SETTINGS index_granularity = 8192;
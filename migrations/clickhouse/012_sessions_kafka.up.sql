@@ -0,0 +1,18 @@
+CREATE TABLE kafka_sessions (
+    project_id UInt32,
+    session_id String,
+    distinct_id String,
+    status LowCardinality(String),
+    init UInt8,
+    errors UInt32,
+    duration_ms Float64,
+    release LowCardinality(String),
+    environment String,
+    started DateTime64(3),
+    timestamp DateTime64(3),
+    quantity UInt32
+) ENGINE = Kafka()
+SETTINGS kafka_broker_list = 'warden-kafka:9092',
+         kafka_topic_list = 'clickhouse.sessions',
+         kafka_group_name = 'clickhouse_sessions_group',
+         kafka_format = 'JSONEachRow';
@@ -0,0 +1,17 @@
+-- Materialized view to aggregate session updates from Kafka into the session_aggregates table.
+-- A session is counted by its init update and classified by its final status.
+CREATE MATERIALIZED VIEW mv_sessions TO session_aggregates AS
+SELECT
+    project_id,
+    release,
+    environment,
+    toStartOfHour(started) AS bucket,
+    toUInt64(sumIf(quantity, init = 1)) AS sessions,
+    toUInt64(sumIf(quantity, status = 'errored' OR (status = 'exited' AND errors > 0))) AS sessions_errored,
+    toUInt64(sumIf(quantity, status IN ('crashed', 'abnormal'))) AS sessions_crashed,
+    uniqState(if(init = 1 AND distinct_id != '', distinct_id, NULL)) AS users,
+    uniqState(if(status IN ('crashed', 'abnormal') AND distinct_id != '', distinct_id, NULL)) AS users_crashed,
+    sumIf(duration_ms, status = 'exited' AND duration_ms > 0) AS duration_ms_sum,
+    toUInt64(countIf(status = 'exited' AND duration_ms > 0)) AS duration_count
+FROM kafka_sessions
+GROUP BY project_id, release, environment, bucket;
@@ -0,0 +1,14 @@
+-- Hourly outcomes of the received data: accepted, filtered, rate limited or dropped by Warden and discarded by SDKs.
+-- Outcomes of envelopes which project can't be read are counted for project 0.
+CREATE TABLE outcomes (
+    project_id UInt32,
+    outcome LowCardinality(String),
+    reason LowCardinality(String),
+    category LowCardinality(String),
+    bucket DateTime,
+    quantity UInt64
+) ENGINE = SummingMergeTree(quantity)
+PARTITION BY toYYYYMM(bucket)
+ORDER BY (project_id, bucket, outcome, reason, category)
+TTL bucket + INTERVAL 3 MONTH
+SETTINGS index_granularity = 8192;
@@ -0,0 +1,2 @@
+-- Drop Kafka engine table
+DROP TABLE IF EXISTS kafka_outcomes;
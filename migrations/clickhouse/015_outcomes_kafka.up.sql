@@ -0,0 +1,12 @@
+CREATE TABLE kafka_outcomes (
+    project_id UInt32,
+    outcome LowCardinality(String),
+    reason LowCardinality(String),
+    category LowCardinality(String),
+    timestamp DateTime64(3),
+    quantity UInt64
+) ENGINE = Kafka()
+SETTINGS kafka_broker_list = 'warden-kafka:9092',
+         kafka_topic_list = 'clickhouse.outcomes',
+         kafka_group_name = 'clickhouse_outcomes_group',
+         kafka_format = 'JSONEachRow';
@@ -0,0 +1,11 @@
+-- Materialized view to aggregate outcomes from Kafka into the outcomes table.
+CREATE MATERIALIZED VIEW mv_outcomes TO outcomes AS
+SELECT
+    project_id,
+    outcome,
+    reason,
+    category,
+    toStartOfHour(timestamp) AS bucket,
+    sum(quantity) AS quantity
+FROM kafka_outcomes
+GROUP BY project_id, outcome, reason, category, bucket;
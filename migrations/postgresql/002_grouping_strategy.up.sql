@@ -0,0 +1,2 @@
+-- Versioned grouping strategy, existing projects keep the legacy fingerprints
+ALTER TABLE projects ADD COLUMN grouping_strategy VARCHAR(32) NOT NULL DEFAULT 'legacy';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS grouping_rules;
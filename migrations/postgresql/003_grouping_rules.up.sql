@@ -0,0 +1,11 @@
+-- Server-side grouping rules (fingerprint rules and stack trace rules)
+CREATE TABLE IF NOT EXISTS grouping_rules (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL, -- fingerprint, stacktrace
+    expression TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_grouping_rules_project_id ON grouping_rules(project_id);
@@ -0,0 +1,11 @@
+-- Fingerprints merged into another issue. New events with these fingerprints are routed to issue_id.
+CREATE TABLE IF NOT EXISTS issue_fingerprints (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL,
+    issue_id BIGINT NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    merged_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (project_id, fingerprint)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_fingerprints_issue_id ON issue_fingerprints(issue_id);
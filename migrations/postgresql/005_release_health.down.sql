@@ -0,0 +1,9 @@
+ALTER TABLE release_stats
+    DROP COLUMN IF EXISTS sessions_total,
+    DROP COLUMN IF EXISTS sessions_errored,
+    DROP COLUMN IF EXISTS sessions_crashed,
+    DROP COLUMN IF EXISTS users_total,
+    DROP COLUMN IF EXISTS crash_free_sessions,
+    DROP COLUMN IF EXISTS crash_free_users,
+    DROP COLUMN IF EXISTS adoption,
+    DROP COLUMN IF EXISTS avg_session_duration;
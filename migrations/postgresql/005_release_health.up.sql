@@ -0,0 +1,10 @@
+-- Release health from sessions, rates are from 0 to 1 and stay NULL for releases without sessions.
+ALTER TABLE release_stats
+    ADD COLUMN IF NOT EXISTS sessions_total INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS sessions_errored INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS sessions_crashed INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS users_total INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS crash_free_sessions DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS crash_free_users DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS adoption DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS avg_session_duration TEXT NOT NULL DEFAULT '0s';
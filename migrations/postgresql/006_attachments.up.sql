@@ -0,0 +1,15 @@
+-- Event attachments, the content is kept in the blob store under blob_key.
+CREATE TABLE IF NOT EXISTS attachments (
+    id BIGSERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    attachment_type VARCHAR(64) NOT NULL,
+    size BIGINT NOT NULL,
+    blob_key TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_project_event ON attachments(project_id, event_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_created_at ON attachments(created_at);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS monitor_check_ins;
+DROP TABLE IF EXISTS monitors;
+
+-- Enum values can't be dropped, monitor issues are removed instead.
+DELETE FROM issues WHERE source = 'monitor';
@@ -0,0 +1,42 @@
+-- Monitor incidents are reported as issues.
+ALTER TYPE issue_source ADD VALUE IF NOT EXISTS 'monitor';
+
+-- Cron monitors, created or updated by check-ins with a monitor config.
+CREATE TABLE IF NOT EXISTS monitors (
+    id BIGSERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    slug VARCHAR(128) NOT NULL,
+    schedule_type VARCHAR(16) NOT NULL,
+    schedule VARCHAR(128) NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    checkin_margin INTEGER NOT NULL DEFAULT 1, -- minutes
+    max_runtime INTEGER NOT NULL DEFAULT 30, -- minutes
+    status VARCHAR(16) NOT NULL DEFAULT 'ok',
+    last_check_in_at TIMESTAMPTZ,
+    next_check_in_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (project_id, slug)
+);
+
+CREATE INDEX IF NOT EXISTS idx_monitors_next_check_in_at ON monitors(next_check_in_at);
+
+CREATE TABLE IF NOT EXISTS monitor_check_ins (
+    id BIGSERIAL PRIMARY KEY,
+    monitor_id BIGINT NOT NULL REFERENCES monitors(id) ON DELETE CASCADE,
+    check_in_id VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    duration_ms BIGINT,
+    environment TEXT NOT NULL DEFAULT '',
+    expected_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (monitor_id, check_in_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_monitor_check_ins_monitor_created ON monitor_check_ins(monitor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_monitor_check_ins_in_progress
+    ON monitor_check_ins(created_at)
+    WHERE status = 'in_progress';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS project_keys;
+DROP FUNCTION IF EXISTS notify_project_key_changed();
@@ -0,0 +1,42 @@
+-- Client keys (DSNs) of projects, a project may have several keys to rotate them without downtime.
+CREATE TABLE IF NOT EXISTS project_keys (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    label VARCHAR(255) NOT NULL,
+    public_key VARCHAR(255) NOT NULL UNIQUE,
+    secret_key VARCHAR(255) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    rate_limit INTEGER, -- events per minute, NULL is unlimited
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_keys_project_id ON project_keys(project_id);
+
+-- Existing project keys become the default keys of the projects.
+INSERT INTO project_keys (project_id, label, public_key, secret_key, created_at)
+SELECT id, 'Default', public_key, encode(sha256(random()::text::bytea), 'hex'), created_at
+FROM projects
+ON CONFLICT (public_key) DO NOTHING;
+
+-- Notify the ingest servers to drop cached keys once a key is changed or revoked.
+CREATE OR REPLACE FUNCTION notify_project_key_changed()
+    RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('project_keys_changed', OLD.project_id || ':' || OLD.public_key);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER project_key_updated
+    AFTER UPDATE ON project_keys
+    FOR EACH ROW
+    WHEN (OLD.is_active IS DISTINCT FROM NEW.is_active
+        OR OLD.rate_limit IS DISTINCT FROM NEW.rate_limit
+        OR OLD.secret_key IS DISTINCT FROM NEW.secret_key)
+EXECUTE FUNCTION notify_project_key_changed();
+
+CREATE TRIGGER project_key_deleted
+    AFTER DELETE ON project_keys
+    FOR EACH ROW
+EXECUTE FUNCTION notify_project_key_changed();
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS project_inbound_filters;
+DROP FUNCTION IF EXISTS notify_project_filters_changed();
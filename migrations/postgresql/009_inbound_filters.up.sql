@@ -0,0 +1,26 @@
+-- Per-project allowed origins and inbound filters, a project without a row has no filters.
+CREATE TABLE IF NOT EXISTS project_inbound_filters (
+    project_id INTEGER PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    allowed_origins TEXT[] NOT NULL DEFAULT '{}',
+    filter_web_crawlers BOOLEAN NOT NULL DEFAULT FALSE,
+    filter_legacy_browsers BOOLEAN NOT NULL DEFAULT FALSE,
+    filter_localhost BOOLEAN NOT NULL DEFAULT FALSE,
+    blocked_ip_ranges TEXT[] NOT NULL DEFAULT '{}',
+    error_messages TEXT[] NOT NULL DEFAULT '{}',
+    releases TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Notify the ingest servers to drop cached filters of the project.
+CREATE OR REPLACE FUNCTION notify_project_filters_changed()
+    RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('project_filters_changed', COALESCE(NEW.project_id, OLD.project_id)::text);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER project_inbound_filters_changed
+    AFTER INSERT OR UPDATE OR DELETE ON project_inbound_filters
+    FOR EACH ROW
+EXECUTE FUNCTION notify_project_filters_changed();
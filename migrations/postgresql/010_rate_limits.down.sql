@@ -0,0 +1,14 @@
+DROP TABLE IF EXISTS project_rate_limits;
+DROP FUNCTION IF EXISTS notify_project_rate_limits_changed();
+
+DROP TRIGGER IF EXISTS project_key_updated ON project_keys;
+
+CREATE TRIGGER project_key_updated
+    AFTER UPDATE ON project_keys
+    FOR EACH ROW
+    WHEN (OLD.is_active IS DISTINCT FROM NEW.is_active
+        OR OLD.rate_limit IS DISTINCT FROM NEW.rate_limit
+        OR OLD.secret_key IS DISTINCT FROM NEW.secret_key)
+EXECUTE FUNCTION notify_project_key_changed();
+
+ALTER TABLE project_keys DROP COLUMN IF EXISTS category_rate_limits;
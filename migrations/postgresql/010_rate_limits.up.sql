@@ -0,0 +1,35 @@
+-- Per-category quotas of client keys, e.g. {"error": 100, "transaction": 1000} items per minute.
+ALTER TABLE project_keys ADD COLUMN category_rate_limits JSONB NOT NULL DEFAULT '{}';
+
+DROP TRIGGER IF EXISTS project_key_updated ON project_keys;
+
+CREATE TRIGGER project_key_updated
+    AFTER UPDATE ON project_keys
+    FOR EACH ROW
+    WHEN (OLD.is_active IS DISTINCT FROM NEW.is_active
+        OR OLD.rate_limit IS DISTINCT FROM NEW.rate_limit
+        OR OLD.category_rate_limits IS DISTINCT FROM NEW.category_rate_limits
+        OR OLD.secret_key IS DISTINCT FROM NEW.secret_key)
+EXECUTE FUNCTION notify_project_key_changed();
+
+-- Per-project rate limits, a project without a row is limited by the ingest servers config only.
+CREATE TABLE IF NOT EXISTS project_rate_limits (
+    project_id INTEGER PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    rate_limit INTEGER,
+    category_rate_limits JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Notify the ingest servers to drop cached rate limits of the project.
+CREATE OR REPLACE FUNCTION notify_project_rate_limits_changed()
+    RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('project_rate_limits_changed', COALESCE(NEW.project_id, OLD.project_id)::text);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER project_rate_limits_changed
+    AFTER INSERT OR UPDATE OR DELETE ON project_rate_limits
+    FOR EACH ROW
+EXECUTE FUNCTION notify_project_rate_limits_changed();
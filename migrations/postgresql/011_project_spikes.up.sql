@@ -0,0 +1,13 @@
+-- Spikes of project ingest capped by the spike protection of the ingest servers.
+CREATE TABLE IF NOT EXISTS project_spikes (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    started_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    baseline_rps BIGINT NOT NULL,
+    peak_rps BIGINT NOT NULL,
+    cap_rps BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_spikes_project_id_ends_at ON project_spikes (project_id, ends_at DESC);
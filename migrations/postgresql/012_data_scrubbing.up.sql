@@ -0,0 +1,11 @@
+-- Per-project data scrubbing, projects without a row use the default scrubbers only.
+CREATE TABLE IF NOT EXISTS project_data_scrubbing (
+    project_id INTEGER PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    default_scrubbers BOOLEAN NOT NULL DEFAULT TRUE,
+    sensitive_fields TEXT[] NOT NULL DEFAULT '{}',
+    patterns TEXT[] NOT NULL DEFAULT '{}',
+    anonymize_ip BOOLEAN NOT NULL DEFAULT FALSE,
+    drop_request_cookies BOOLEAN NOT NULL DEFAULT FALSE,
+    drop_request_data BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+ALTER TABLE notifications_queue DROP COLUMN IF EXISTS environment;
+ALTER TABLE notification_rules DROP COLUMN IF EXISTS environment;
+DROP TABLE IF EXISTS project_environments;
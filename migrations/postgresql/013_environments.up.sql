@@ -0,0 +1,14 @@
+-- Environments of project events, discovered from the ingested events.
+CREATE TABLE IF NOT EXISTS project_environments (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    first_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, name)
+);
+
+-- Notification rules limited to an environment, NULL matches all environments.
+ALTER TABLE notification_rules ADD COLUMN IF NOT EXISTS environment TEXT;
+
+ALTER TABLE notifications_queue ADD COLUMN IF NOT EXISTS environment TEXT;
@@ -0,0 +1,7 @@
+ALTER TABLE notification_settings DROP COLUMN IF EXISTS route_to_assignee;
+
+DROP INDEX IF EXISTS idx_issues_assigned_team_id;
+DROP INDEX IF EXISTS idx_issues_assigned_user_id;
+ALTER TABLE issues DROP CONSTRAINT IF EXISTS issues_single_assignee;
+ALTER TABLE issues DROP COLUMN IF EXISTS assigned_team_id;
+ALTER TABLE issues DROP COLUMN IF EXISTS assigned_user_id;
@@ -0,0 +1,11 @@
+-- Owner of an issue, either a user or a team.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS assigned_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS assigned_team_id INTEGER REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE issues ADD CONSTRAINT issues_single_assignee
+    CHECK (assigned_user_id IS NULL OR assigned_team_id IS NULL);
+
+CREATE INDEX IF NOT EXISTS idx_issues_assigned_user_id ON issues(assigned_user_id) WHERE assigned_user_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_issues_assigned_team_id ON issues(assigned_team_id) WHERE assigned_team_id IS NOT NULL;
+
+-- Alerts of assigned issues are emailed to the assignee instead of the channel.
+ALTER TABLE notification_settings ADD COLUMN IF NOT EXISTS route_to_assignee BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS issue_activities;
+DROP TABLE IF EXISTS issue_comments;
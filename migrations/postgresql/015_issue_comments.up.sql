@@ -0,0 +1,31 @@
+-- Threaded comments on issues.
+CREATE TABLE IF NOT EXISTS issue_comments (
+    id BIGSERIAL PRIMARY KEY,
+    issue_id BIGINT NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    parent_id BIGINT REFERENCES issue_comments(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_comments_issue_id ON issue_comments(issue_id, created_at);
+
+-- Activity feed of issues: first seen, regressions, status and assignment changes, merges and comments.
+CREATE TABLE IF NOT EXISTS issue_activities (
+    id BIGSERIAL PRIMARY KEY,
+    issue_id BIGINT NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_activities_issue_id ON issue_activities(issue_id, created_at DESC, id DESC);
+
+-- The history known before the feed existed.
+INSERT INTO issue_activities (issue_id, type, created_at)
+SELECT id, 'first_seen', first_seen FROM issues;
+
+INSERT INTO issue_activities (issue_id, type, user_id, data, created_at)
+SELECT issue_id, 'status_changed', resolved_by, jsonb_build_object('status', status), created_at FROM resolutions;
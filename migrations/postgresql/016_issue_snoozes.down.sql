@@ -0,0 +1,3 @@
+ALTER TABLE issues DROP COLUMN IF EXISTS escalating;
+
+DROP TABLE IF EXISTS issue_snoozes;
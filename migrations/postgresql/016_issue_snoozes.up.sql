@@ -0,0 +1,15 @@
+-- Conditions ending an ignored issue, checked by the scheduler.
+CREATE TABLE IF NOT EXISTS issue_snoozes (
+    issue_id      BIGINT PRIMARY KEY REFERENCES issues(id) ON DELETE CASCADE,
+    until         TIMESTAMPTZ,
+    count         INTEGER,
+    user_count    INTEGER,
+    rate_per_hour INTEGER,
+    -- Total events of the issue when it was ignored, the count condition is relative to it
+    events_base   INTEGER NOT NULL DEFAULT 0,
+    created_by    INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Set when an ignored issue comes back because one of its conditions is met.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS escalating BOOLEAN NOT NULL DEFAULT FALSE;
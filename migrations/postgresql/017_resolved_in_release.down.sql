@@ -0,0 +1,8 @@
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS resolved_in_release_at,
+    DROP COLUMN IF EXISTS resolved_in_next_release,
+    DROP COLUMN IF EXISTS resolved_in_release;
+
+ALTER TABLE releases DROP COLUMN IF EXISTS version_key;
+
+DROP FUNCTION IF EXISTS release_version_key(TEXT);
@@ -0,0 +1,50 @@
+-- Sortable key of a semver release version, NULL for versions not following semver.
+-- A package prefix (pkg@1.2.3), a leading "v" and build metadata don't take part in the ordering.
+CREATE OR REPLACE FUNCTION release_version_key(release_version TEXT)
+    RETURNS TEXT AS $$
+DECLARE
+    parts  TEXT[];
+    ident  TEXT;
+    result TEXT;
+BEGIN
+    parts := regexp_match(
+        split_part(regexp_replace(release_version, '^.*@', ''), '+', 1),
+        '^[vV]?(\d{1,10})(?:\.(\d{1,10}))?(?:\.(\d{1,10}))?(?:-([0-9A-Za-z.-]+))?$'
+    );
+    IF parts IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    result := lpad(parts[1], 10, '0') || '.' ||
+              lpad(COALESCE(parts[2], '0'), 10, '0') || '.' ||
+              lpad(COALESCE(parts[3], '0'), 10, '0');
+
+    -- A release sorts after all of its pre-releases
+    IF parts[4] IS NULL THEN
+        RETURN result || '~';
+    END IF;
+
+    -- Numeric identifiers sort before alphanumeric ones, a shorter set of identifiers sorts first
+    result := result || '-';
+    FOREACH ident IN ARRAY string_to_array(parts[4], '.') LOOP
+        IF ident ~ '^\d{1,10}$' THEN
+            result := result || '0' || lpad(ident, 10, '0') || '!';
+        ELSE
+            result := result || '1' || ident || '!';
+        END IF;
+    END LOOP;
+
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE STRICT;
+
+-- Compared with COLLATE "C"
+ALTER TABLE releases
+    ADD COLUMN IF NOT EXISTS version_key TEXT GENERATED ALWAYS AS (release_version_key(version)) STORED;
+
+-- Events of releases older than the fix release don't reactivate an issue resolved in a release.
+-- For the next release the latest release at the resolve time is stored.
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS resolved_in_release      TEXT,
+    ADD COLUMN IF NOT EXISTS resolved_in_next_release BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS resolved_in_release_at   TIMESTAMPTZ;
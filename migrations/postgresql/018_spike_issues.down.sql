@@ -0,0 +1,2 @@
+-- Enum values can't be dropped, spike issues are removed instead.
+DELETE FROM issues WHERE source = 'spike';
@@ -0,0 +1,2 @@
+-- Spikes capped by the spike protection are reported as issues.
+ALTER TYPE issue_source ADD VALUE IF NOT EXISTS 'spike';
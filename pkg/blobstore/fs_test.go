@@ -0,0 +1,42 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFS(t.TempDir())
+	require.NoError(t, err)
+
+	const key = "1/event-1/blob-1"
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("minidump"), 8))
+
+	reader, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "minidump", string(data))
+
+	require.NoError(t, store.Delete(ctx, key))
+	require.NoError(t, store.Delete(ctx, key))
+
+	_, err = store.Get(ctx, key)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFSStore_InvalidKey(t *testing.T) {
+	store, err := NewFS(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "1/../../secret", "1//blob"} {
+		require.Error(t, store.Put(context.Background(), key, strings.NewReader("x"), 1), key)
+	}
+}
@@ -14,3 +14,14 @@ func TxFromContext(ctx context.Context) Tx {
 
 	return nil
 }
+
+// RepeatableReadOrJoin runs fn in the transaction of the context when there is one, so a caller
+// can compose several operations in its own transaction, and in a new repeatable read transaction
+// otherwise. The joined transaction keeps the isolation level of the caller.
+func RepeatableReadOrJoin(ctx context.Context, txManager TxManager, fn func(ctx context.Context) error) error {
+	if TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	return txManager.RepeatableRead(ctx, fn)
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,3 +34,47 @@ func TestTxFromContext(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+type stubTxManager struct {
+	tx    Tx
+	calls int
+}
+
+func (m *stubTxManager) ReadCommitted(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.RepeatableRead(ctx, fn)
+}
+
+func (m *stubTxManager) RepeatableRead(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+
+	return fn(context.WithValue(ctx, txKey{}, m.tx))
+}
+
+func TestRepeatableReadOrJoin(t *testing.T) {
+	t.Run("without transaction in context", func(t *testing.T) {
+		txManager := &stubTxManager{tx: &mockTx{}}
+
+		err := RepeatableReadOrJoin(context.Background(), txManager, func(ctx context.Context) error {
+			assert.Equal(t, txManager.tx, TxFromContext(ctx))
+
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, txManager.calls)
+	})
+
+	t.Run("joins transaction in context", func(t *testing.T) {
+		outerTx := &mockTx{}
+		txCtx := context.WithValue(context.Background(), txKey{}, outerTx)
+		txManager := &stubTxManager{tx: &mockTx{}}
+		expectedErr := errors.New("nested error")
+
+		err := RepeatableReadOrJoin(txCtx, txManager, func(ctx context.Context) error {
+			assert.Same(t, outerTx, TxFromContext(ctx))
+
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Zero(t, txManager.calls)
+	})
+}
@@ -0,0 +1,50 @@
+package fingerprinter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Placeholders substituted for dynamic values by NormalizeMessage.
+const (
+	PlaceholderUUID   = "<uuid>"
+	PlaceholderHex    = "<hex>"
+	PlaceholderString = "<str>"
+	PlaceholderNumber = "<num>"
+)
+
+var (
+	uuidRe    = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	hexAddrRe = regexp.MustCompile(`(?i)\b0x[0-9a-f]+\b`)
+	hexHashRe = regexp.MustCompile(`(?i)\b[0-9a-f]{16,}\b`)
+	quotedRe  = regexp.MustCompile("(^|[^\\w])(\"[^\"]*\"|'[^']*'|`[^`]*`)")
+	// Numbers, and digit runs glued to identifiers like "user123" or "order_7". A single digit after
+	// a letter is kept, it's usually a part of the name, e.g. "v2", "http2" or "utf8".
+	numberRe = regexp.MustCompile(`([a-zA-Z])\d{2,}(?:\.\d+)?|(_)\d+(?:\.\d+)?|\b\d+(?:\.\d+)?`)
+	spacesRe = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeMessage replaces values that usually differ between occurrences of the same error
+// (UUIDs, hex addresses and hashes, quoted strings, numbers) with stable placeholders,
+// so that "user 123 not found" and "user 456 not found", or "user123" and "user456", produce the same fingerprint.
+func NormalizeMessage(msg string) string {
+	msg = uuidRe.ReplaceAllString(msg, PlaceholderUUID)
+	msg = hexAddrRe.ReplaceAllString(msg, PlaceholderHex)
+	msg = hexHashRe.ReplaceAllStringFunc(msg, func(s string) string {
+		// Long words made only of letters a-f are not hashes.
+		if !strings.ContainsAny(s, "0123456789") {
+			return s
+		}
+
+		return PlaceholderHex
+	})
+	msg = quotedRe.ReplaceAllString(msg, "${1}"+PlaceholderString)
+	msg = numberRe.ReplaceAllString(msg, "${1}${2}"+PlaceholderNumber)
+
+	return NormalizeWhitespace(msg)
+}
+
+// NormalizeWhitespace collapses whitespace runs into a single space and trims the result.
+func NormalizeWhitespace(s string) string {
+	return strings.TrimSpace(spacesRe.ReplaceAllString(s, " "))
+}
@@ -0,0 +1,82 @@
+package fingerprinter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{
+			name: "numbers",
+			msg:  "user 123 not found",
+			want: "user <num> not found",
+		},
+		{
+			name: "decimal number",
+			msg:  "took 1.25s, limit 1s",
+			want: "took <num>s, limit <num>s",
+		},
+		{
+			name: "uuid",
+			msg:  "order 3f2504e0-4f89-11d3-9a0c-0305e82c3301 is locked",
+			want: "order <uuid> is locked",
+		},
+		{
+			name: "hex address",
+			msg:  "invalid memory address 0xc000123abc",
+			want: "invalid memory address <hex>",
+		},
+		{
+			name: "hash",
+			msg:  "object 9fceb02d0ae598e95dc970b74767f19372d61af8 missing",
+			want: "object <hex> missing",
+		},
+		{
+			name: "quoted strings",
+			msg:  `key "session:abc" not found in 'cache'`,
+			want: "key <str> not found in <str>",
+		},
+		{
+			name: "apostrophe in words is kept",
+			msg:  "can't connect, don't retry",
+			want: "can't connect, don't retry",
+		},
+		{
+			name: "numbers glued to letters",
+			msg:  "user123 not found for order42",
+			want: "user<num> not found for order<num>",
+		},
+		{
+			name: "numbers after underscores",
+			msg:  "lock job_7 held by worker_15",
+			want: "lock job_<num> held by worker_<num>",
+		},
+		{
+			name: "single digits of names are kept",
+			msg:  "handler v2 failed over http2 for utf8 input",
+			want: "handler v2 failed over http2 for utf8 input",
+		},
+		{
+			name: "decimal number glued to letters",
+			msg:  "cpu98.5 over limit",
+			want: "cpu<num> over limit",
+		},
+		{
+			name: "whitespace",
+			msg:  "  connection\n\treset  ",
+			want: "connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeMessage(tt.msg))
+		})
+	}
+}
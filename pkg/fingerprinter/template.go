@@ -0,0 +1,79 @@
+package fingerprinter
+
+import (
+	"strings"
+)
+
+// Variables supported inside SDK-supplied fingerprint templates, e.g. "{{ default }}".
+const (
+	VarDefault     = "default"
+	VarType        = "type"
+	VarFunction    = "function"
+	VarModule      = "module"
+	VarTransaction = "transaction"
+)
+
+// variableAliases maps alternative Sentry variable names to canonical ones.
+var variableAliases = map[string]string{
+	"error.type":     VarType,
+	"stack.function": VarFunction,
+	"stack.module":   VarModule,
+}
+
+// ParseVariable reports whether the fingerprint component is a template variable
+// like "{{ default }}" and returns its canonical lowercase name.
+func ParseVariable(component string) (string, bool) {
+	component = strings.TrimSpace(component)
+	if !strings.HasPrefix(component, "{{") || !strings.HasSuffix(component, "}}") {
+		return "", false
+	}
+
+	name := strings.ToLower(strings.TrimSpace(component[2 : len(component)-2]))
+	if name == "" {
+		return "", false
+	}
+
+	if canonical, ok := variableAliases[name]; ok {
+		name = canonical
+	}
+
+	return name, true
+}
+
+// IsSupportedVariable reports whether the canonical variable name is one of the Var* constants.
+func IsSupportedVariable(name string) bool {
+	switch name {
+	case VarDefault, VarType, VarFunction, VarModule, VarTransaction:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExpandTemplate replaces template variables in fingerprint components with values
+// returned by resolve. Components that are not variables, or variables unknown
+// to resolve, are kept as is. The second result is true if every component
+// is the "{{ default }}" variable.
+func ExpandTemplate(components []string, resolve func(name string) (string, bool)) ([]string, bool) {
+	result := make([]string, 0, len(components))
+	onlyDefault := len(components) > 0
+
+	for _, component := range components {
+		name, isVar := ParseVariable(component)
+		if !isVar || name != VarDefault {
+			onlyDefault = false
+		}
+
+		if isVar {
+			if value, ok := resolve(name); ok {
+				result = append(result, value)
+
+				continue
+			}
+		}
+
+		result = append(result, component)
+	}
+
+	return result, onlyDefault
+}
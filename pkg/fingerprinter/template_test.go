@@ -0,0 +1,65 @@
+package fingerprinter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVariable(t *testing.T) {
+	tests := []struct {
+		component string
+		name      string
+		ok        bool
+	}{
+		{component: "{{ default }}", name: VarDefault, ok: true},
+		{component: "{{default}}", name: VarDefault, ok: true},
+		{component: "{{ Type }}", name: VarType, ok: true},
+		{component: "{{ error.type }}", name: VarType, ok: true},
+		{component: "{{ stack.function }}", name: VarFunction, ok: true},
+		{component: "{{ stack.module }}", name: VarModule, ok: true},
+		{component: "{{ transaction }}", name: VarTransaction, ok: true},
+		{component: "{{ }}", ok: false},
+		{component: "database-error", ok: false},
+		{component: "{{ default", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component, func(t *testing.T) {
+			name, ok := ParseVariable(tt.component)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.name, name)
+		})
+	}
+}
+
+func TestExpandTemplate(t *testing.T) {
+	resolve := func(name string) (string, bool) {
+		switch name {
+		case VarDefault:
+			return "hash", true
+		case VarType:
+			return "TimeoutError", true
+		default:
+			return "", false
+		}
+	}
+
+	t.Run("mixed components", func(t *testing.T) {
+		parts, onlyDefault := ExpandTemplate([]string{"{{ default }}", "{{ type }}", "db", "{{ unknown }}"}, resolve)
+		assert.Equal(t, []string{"hash", "TimeoutError", "db", "{{ unknown }}"}, parts)
+		assert.False(t, onlyDefault)
+	})
+
+	t.Run("only default", func(t *testing.T) {
+		parts, onlyDefault := ExpandTemplate([]string{"{{ default }}"}, resolve)
+		assert.Equal(t, []string{"hash"}, parts)
+		assert.True(t, onlyDefault)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		parts, onlyDefault := ExpandTemplate(nil, resolve)
+		assert.Empty(t, parts)
+		assert.False(t, onlyDefault)
+	})
+}
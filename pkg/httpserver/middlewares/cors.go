@@ -8,7 +8,7 @@ func CORSMdw(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
 		writer.Header().Set("Access-Control-Allow-Origin", "*")
 		writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Encoding, Authorization")
 
 		if req.Method == http.MethodOptions {
 			writer.WriteHeader(http.StatusNoContent)
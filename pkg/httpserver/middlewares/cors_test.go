@@ -65,7 +65,7 @@ func TestCORSMdw(t *testing.T) {
 			// Check CORS headers
 			require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
 			require.Equal(t, "GET, POST, PUT, PATCH, DELETE, OPTIONS", rec.Header().Get("Access-Control-Allow-Methods"))
-			require.Equal(t, "Content-Type, Authorization", rec.Header().Get("Access-Control-Allow-Headers"))
+			require.Equal(t, "Content-Type, Content-Encoding, Authorization", rec.Header().Get("Access-Control-Allow-Headers"))
 		})
 	}
 }
@@ -0,0 +1,39 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/IBM/sarama"
+)
+
+// SyncProducer sends messages waiting for them to be acknowledged,
+// it is used when a message must not be lost silently.
+type SyncProducer struct {
+	producer sarama.SyncProducer
+}
+
+func NewSyncProducer(client sarama.Client) (*SyncProducer, error) {
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyncProducer{producer: producer}, nil
+}
+
+func (p *SyncProducer) Produce(ctx context.Context, topic string, data []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(data),
+	})
+
+	return err
+}
+
+func (p *SyncProducer) Close() error {
+	return p.producer.Close()
+}
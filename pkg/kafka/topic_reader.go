@@ -0,0 +1,151 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// Message is a message read from a topic partition.
+type Message struct {
+	Partition int32
+	Offset    int64
+	Value     []byte
+}
+
+// TopicReader reads the messages of a topic which are not committed by a consumer group yet,
+// it stops at the newest message instead of waiting for new ones.
+type TopicReader struct {
+	client  sarama.Client
+	topic   string
+	groupID string
+}
+
+func NewTopicReader(client sarama.Client, topic, groupID string) (*TopicReader, error) {
+	if topic == "" {
+		return nil, errEmptyTopic
+	}
+	if groupID == "" {
+		return nil, errEmptyGroupID
+	}
+
+	return &TopicReader{
+		client:  client,
+		topic:   topic,
+		groupID: groupID,
+	}, nil
+}
+
+// Pending calls fn for every message not committed yet without committing them.
+func (r *TopicReader) Pending(ctx context.Context, fn func(Message) error) error {
+	return r.read(ctx, fn, false)
+}
+
+// Consume calls fn for every message not committed yet and commits the messages handled without an error.
+func (r *TopicReader) Consume(ctx context.Context, fn func(Message) error) error {
+	return r.read(ctx, fn, true)
+}
+
+func (r *TopicReader) read(ctx context.Context, fn func(Message) error, commit bool) error {
+	partitions, err := r.client.Partitions(r.topic)
+	if err != nil {
+		return fmt.Errorf("get partitions: %w", err)
+	}
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(r.groupID, r.client)
+	if err != nil {
+		return fmt.Errorf("create offset manager: %w", err)
+	}
+	defer offsetManager.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(r.client)
+	if err != nil {
+		return fmt.Errorf("create consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	for _, partition := range partitions {
+		err := r.readPartition(ctx, consumer, offsetManager, partition, fn, commit)
+		if commit {
+			offsetManager.Commit()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TopicReader) readPartition(
+	ctx context.Context,
+	consumer sarama.Consumer,
+	offsetManager sarama.OffsetManager,
+	partition int32,
+	fn func(Message) error,
+	commit bool,
+) error {
+	partitionOffsets, err := offsetManager.ManagePartition(r.topic, partition)
+	if err != nil {
+		return fmt.Errorf("manage partition %d: %w", partition, err)
+	}
+	defer partitionOffsets.Close()
+
+	oldest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return fmt.Errorf("get oldest offset of partition %d: %w", partition, err)
+	}
+
+	newest, err := r.client.GetOffset(r.topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return fmt.Errorf("get newest offset of partition %d: %w", partition, err)
+	}
+
+	// Messages removed by the retention can't be read anymore
+	start, _ := partitionOffsets.NextOffset()
+	if start < oldest {
+		start = oldest
+	}
+
+	if start >= newest {
+		return nil
+	}
+
+	partitionConsumer, err := consumer.ConsumePartition(r.topic, partition, start)
+	if err != nil {
+		return fmt.Errorf("consume partition %d: %w", partition, err)
+	}
+	defer partitionConsumer.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case consumerErr, ok := <-partitionConsumer.Errors():
+			if !ok {
+				return errors.New("partition consumer closed")
+			}
+
+			return fmt.Errorf("read partition %d: %w", partition, consumerErr)
+		case msg, ok := <-partitionConsumer.Messages():
+			if !ok {
+				return errors.New("partition consumer closed")
+			}
+
+			if err := fn(Message{Partition: partition, Offset: msg.Offset, Value: msg.Value}); err != nil {
+				return err
+			}
+
+			if commit {
+				partitionOffsets.MarkOffset(msg.Offset+1, "")
+			}
+
+			if msg.Offset >= newest-1 {
+				return nil
+			}
+		}
+	}
+}
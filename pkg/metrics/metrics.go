@@ -234,4 +234,140 @@ var (
 		},
 		[]string{"error_type"},
 	)
+
+	// TransactionsReceived counts the number of transactions received.
+	TransactionsReceived = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_transactions_received_total",
+			Help: "The total number of transactions received",
+		},
+		[]string{"project_id"},
+	)
+
+	// TransactionsProcessed counts the number of transactions processed successfully.
+	TransactionsProcessed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_transactions_processed_total",
+			Help: "The total number of transactions processed successfully",
+		},
+		[]string{"project_id"},
+	)
+
+	// SessionsReceived counts the number of session updates and session aggregates received.
+	SessionsReceived = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_sessions_received_total",
+			Help: "The total number of session updates and session aggregates received",
+		},
+		[]string{"project_id"},
+	)
+
+	// SessionsProcessed counts the number of session updates and session aggregates processed successfully.
+	SessionsProcessed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_sessions_processed_total",
+			Help: "The total number of session updates and session aggregates processed successfully",
+		},
+		[]string{"project_id"},
+	)
+
+	// AttachmentsReceived counts the number of event attachments received.
+	AttachmentsReceived = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_attachments_received_total",
+			Help: "The total number of event attachments received",
+		},
+		[]string{"project_id"},
+	)
+
+	// AttachmentsRejected counts the number of event attachments dropped by size limits.
+	AttachmentsRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_attachments_rejected_total",
+			Help: "The total number of event attachments dropped by size limits",
+		},
+		[]string{"project_id", "reason"},
+	)
+
+	// CheckInsReceived counts the number of cron monitor check-ins received.
+	CheckInsReceived = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_check_ins_received_total",
+			Help: "The total number of cron monitor check-ins received",
+		},
+		[]string{"project_id"},
+	)
+
+	// CheckInsProcessed counts the number of cron monitor check-ins processed successfully.
+	CheckInsProcessed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_check_ins_processed_total",
+			Help: "The total number of cron monitor check-ins processed successfully",
+		},
+		[]string{"project_id"},
+	)
+
+	// MonitorIncidents counts the number of failed, missed and timed out check-ins.
+	MonitorIncidents = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_monitor_incidents_total",
+			Help: "The total number of failed, missed and timed out cron monitor check-ins",
+		},
+		[]string{"project_id", "status"},
+	)
+
+	// ProcessingRetries counts the number of retries of queue messages failed to be processed.
+	ProcessingRetries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_processing_retries_total",
+			Help: "The total number of retries of queue messages failed to be processed",
+		},
+		[]string{"source"},
+	)
+
+	// DeadLetterMessages counts the number of queue messages published to the dead-letter topic.
+	DeadLetterMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_dead_letter_messages_total",
+			Help: "The total number of queue messages published to the dead-letter topic",
+		},
+		[]string{"source"},
+	)
+
+	// RequestCompressionRatio tracks the ratio of decompressed to compressed ingest request body sizes.
+	RequestCompressionRatio = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "warden_request_compression_ratio",
+			Help:    "The ratio of decompressed to compressed ingest request body sizes",
+			Buckets: []float64{1, 1.5, 2, 3, 5, 10, 20, 50, 100},
+		},
+		[]string{"encoding"},
+	)
+
+	// RequestDecompressionRejected counts the number of compressed ingest requests rejected.
+	RequestDecompressionRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_request_decompression_rejected_total",
+			Help: "The total number of compressed ingest requests rejected",
+		},
+		[]string{"reason"},
+	)
+
+	// InboundFilterRejections counts the number of requests and items dropped by the inbound filters.
+	InboundFilterRejections = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_inbound_filter_rejections_total",
+			Help: "The total number of requests and items dropped by the inbound filters",
+		},
+		[]string{"reason"},
+	)
+
+	// SpikesDetected counts the number of spikes of project ingest capped by the spike protection.
+	SpikesDetected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "warden_spikes_detected_total",
+			Help: "The total number of spikes of project ingest capped by the spike protection",
+		},
+		[]string{"project_id"},
+	)
 )
@@ -124,6 +124,7 @@ create_directories() {
         "$INSTALL_DIR"
         "$INSTALL_DIR/nginx/ssl"
         "$INSTALL_DIR/secrets"
+        "$INSTALL_DIR/attachments"
     )
     
     for dir in "${directories[@]}"; do
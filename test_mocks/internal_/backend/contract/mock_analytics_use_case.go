@@ -84,9 +84,9 @@ func (_c *MockAnalyticsUseCase_CompareReleases_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// GetErrorsByTime provides a mock function with given fields: ctx, projectID, release, period, granularity, levels, groupBy
-func (_m *MockAnalyticsUseCase) GetErrorsByTime(ctx context.Context, projectID domain.ProjectID, release string, period time.Duration, granularity time.Duration, levels []domain.IssueLevel, groupBy domain.EventTimeseriesGroup) ([]domain.Timeseries, error) {
-	ret := _m.Called(ctx, projectID, release, period, granularity, levels, groupBy)
+// GetErrorsByTime provides a mock function with given fields: ctx, projectID, release, period, granularity, levels, environment, groupBy
+func (_m *MockAnalyticsUseCase) GetErrorsByTime(ctx context.Context, projectID domain.ProjectID, release string, period time.Duration, granularity time.Duration, levels []domain.IssueLevel, environment *string, groupBy domain.EventTimeseriesGroup) ([]domain.Timeseries, error) {
+	ret := _m.Called(ctx, projectID, release, period, granularity, levels, environment, groupBy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetErrorsByTime")
@@ -94,19 +94,19 @@ func (_m *MockAnalyticsUseCase) GetErrorsByTime(ctx context.Context, projectID d
 
 	var r0 []domain.Timeseries
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, domain.EventTimeseriesGroup) ([]domain.Timeseries, error)); ok {
-		return rf(ctx, projectID, release, period, granularity, levels, groupBy)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, *string, domain.EventTimeseriesGroup) ([]domain.Timeseries, error)); ok {
+		return rf(ctx, projectID, release, period, granularity, levels, environment, groupBy)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, domain.EventTimeseriesGroup) []domain.Timeseries); ok {
-		r0 = rf(ctx, projectID, release, period, granularity, levels, groupBy)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, *string, domain.EventTimeseriesGroup) []domain.Timeseries); ok {
+		r0 = rf(ctx, projectID, release, period, granularity, levels, environment, groupBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Timeseries)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, domain.EventTimeseriesGroup) error); ok {
-		r1 = rf(ctx, projectID, release, period, granularity, levels, groupBy)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, *string, domain.EventTimeseriesGroup) error); ok {
+		r1 = rf(ctx, projectID, release, period, granularity, levels, environment, groupBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -126,14 +126,15 @@ type MockAnalyticsUseCase_GetErrorsByTime_Call struct {
 //   - period time.Duration
 //   - granularity time.Duration
 //   - levels []domain.IssueLevel
+//   - environment *string
 //   - groupBy domain.EventTimeseriesGroup
-func (_e *MockAnalyticsUseCase_Expecter) GetErrorsByTime(ctx interface{}, projectID interface{}, release interface{}, period interface{}, granularity interface{}, levels interface{}, groupBy interface{}) *MockAnalyticsUseCase_GetErrorsByTime_Call {
-	return &MockAnalyticsUseCase_GetErrorsByTime_Call{Call: _e.mock.On("GetErrorsByTime", ctx, projectID, release, period, granularity, levels, groupBy)}
+func (_e *MockAnalyticsUseCase_Expecter) GetErrorsByTime(ctx interface{}, projectID interface{}, release interface{}, period interface{}, granularity interface{}, levels interface{}, environment interface{}, groupBy interface{}) *MockAnalyticsUseCase_GetErrorsByTime_Call {
+	return &MockAnalyticsUseCase_GetErrorsByTime_Call{Call: _e.mock.On("GetErrorsByTime", ctx, projectID, release, period, granularity, levels, environment, groupBy)}
 }
 
-func (_c *MockAnalyticsUseCase_GetErrorsByTime_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, period time.Duration, granularity time.Duration, levels []domain.IssueLevel, groupBy domain.EventTimeseriesGroup)) *MockAnalyticsUseCase_GetErrorsByTime_Call {
+func (_c *MockAnalyticsUseCase_GetErrorsByTime_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, period time.Duration, granularity time.Duration, levels []domain.IssueLevel, environment *string, groupBy domain.EventTimeseriesGroup)) *MockAnalyticsUseCase_GetErrorsByTime_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(time.Duration), args[4].(time.Duration), args[5].([]domain.IssueLevel), args[6].(domain.EventTimeseriesGroup))
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(time.Duration), args[4].(time.Duration), args[5].([]domain.IssueLevel), args[6].(*string), args[7].(domain.EventTimeseriesGroup))
 	})
 	return _c
 }
@@ -143,7 +144,7 @@ func (_c *MockAnalyticsUseCase_GetErrorsByTime_Call) Return(_a0 []domain.Timeser
 	return _c
 }
 
-func (_c *MockAnalyticsUseCase_GetErrorsByTime_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, domain.EventTimeseriesGroup) ([]domain.Timeseries, error)) *MockAnalyticsUseCase_GetErrorsByTime_Call {
+func (_c *MockAnalyticsUseCase_GetErrorsByTime_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, time.Duration, time.Duration, []domain.IssueLevel, *string, domain.EventTimeseriesGroup) ([]domain.Timeseries, error)) *MockAnalyticsUseCase_GetErrorsByTime_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -207,9 +208,9 @@ func (_c *MockAnalyticsUseCase_GetReleaseDetails_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// GetUserSegments provides a mock function with given fields: ctx, projectID, release
-func (_m *MockAnalyticsUseCase) GetUserSegments(ctx context.Context, projectID domain.ProjectID, release string) (domain.UserSegmentsAnalytics, error) {
-	ret := _m.Called(ctx, projectID, release)
+// GetUserSegments provides a mock function with given fields: ctx, projectID, release, environment
+func (_m *MockAnalyticsUseCase) GetUserSegments(ctx context.Context, projectID domain.ProjectID, release string, environment string) (domain.UserSegmentsAnalytics, error) {
+	ret := _m.Called(ctx, projectID, release, environment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserSegments")
@@ -217,17 +218,17 @@ func (_m *MockAnalyticsUseCase) GetUserSegments(ctx context.Context, projectID d
 
 	var r0 domain.UserSegmentsAnalytics
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (domain.UserSegmentsAnalytics, error)); ok {
-		return rf(ctx, projectID, release)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string) (domain.UserSegmentsAnalytics, error)); ok {
+		return rf(ctx, projectID, release, environment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) domain.UserSegmentsAnalytics); ok {
-		r0 = rf(ctx, projectID, release)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string) domain.UserSegmentsAnalytics); ok {
+		r0 = rf(ctx, projectID, release, environment)
 	} else {
 		r0 = ret.Get(0).(domain.UserSegmentsAnalytics)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
-		r1 = rf(ctx, projectID, release)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, string) error); ok {
+		r1 = rf(ctx, projectID, release, environment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -244,13 +245,14 @@ type MockAnalyticsUseCase_GetUserSegments_Call struct {
 //   - ctx context.Context
 //   - projectID domain.ProjectID
 //   - release string
-func (_e *MockAnalyticsUseCase_Expecter) GetUserSegments(ctx interface{}, projectID interface{}, release interface{}) *MockAnalyticsUseCase_GetUserSegments_Call {
-	return &MockAnalyticsUseCase_GetUserSegments_Call{Call: _e.mock.On("GetUserSegments", ctx, projectID, release)}
+//   - environment string
+func (_e *MockAnalyticsUseCase_Expecter) GetUserSegments(ctx interface{}, projectID interface{}, release interface{}, environment interface{}) *MockAnalyticsUseCase_GetUserSegments_Call {
+	return &MockAnalyticsUseCase_GetUserSegments_Call{Call: _e.mock.On("GetUserSegments", ctx, projectID, release, environment)}
 }
 
-func (_c *MockAnalyticsUseCase_GetUserSegments_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string)) *MockAnalyticsUseCase_GetUserSegments_Call {
+func (_c *MockAnalyticsUseCase_GetUserSegments_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, environment string)) *MockAnalyticsUseCase_GetUserSegments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -260,7 +262,7 @@ func (_c *MockAnalyticsUseCase_GetUserSegments_Call) Return(_a0 domain.UserSegme
 	return _c
 }
 
-func (_c *MockAnalyticsUseCase_GetUserSegments_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (domain.UserSegmentsAnalytics, error)) *MockAnalyticsUseCase_GetUserSegments_Call {
+func (_c *MockAnalyticsUseCase_GetUserSegments_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, string) (domain.UserSegmentsAnalytics, error)) *MockAnalyticsUseCase_GetUserSegments_Call {
 	_c.Call.Return(run)
 	return _c
 }
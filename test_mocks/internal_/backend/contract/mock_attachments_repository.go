@@ -0,0 +1,156 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentsRepository is an autogenerated mock type for the AttachmentsRepository type
+type MockAttachmentsRepository struct {
+	mock.Mock
+}
+
+type MockAttachmentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsRepository) EXPECT() *MockAttachmentsRepository_Expecter {
+	return &MockAttachmentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetByID provides a mock function with given fields: ctx, projectID, id
+func (_m *MockAttachmentsRepository) GetByID(ctx context.Context, projectID domain.ProjectID, id domain.AttachmentID) (domain.Attachment, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.AttachmentID) (domain.Attachment, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.AttachmentID) domain.Attachment); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.AttachmentID) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAttachmentsRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.AttachmentID
+func (_e *MockAttachmentsRepository_Expecter) GetByID(ctx interface{}, projectID interface{}, id interface{}) *MockAttachmentsRepository_GetByID_Call {
+	return &MockAttachmentsRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, projectID, id)}
+}
+
+func (_c *MockAttachmentsRepository_GetByID_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.AttachmentID)) *MockAttachmentsRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_GetByID_Call) Return(_a0 domain.Attachment, _a1 error) *MockAttachmentsRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_GetByID_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.AttachmentID) (domain.Attachment, error)) *MockAttachmentsRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEventIDs provides a mock function with given fields: ctx, projectID, eventIDs
+func (_m *MockAttachmentsRepository) ListByEventIDs(ctx context.Context, projectID domain.ProjectID, eventIDs []domain.EventID) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, projectID, eventIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEventIDs")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []domain.EventID) ([]domain.Attachment, error)); ok {
+		return rf(ctx, projectID, eventIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []domain.EventID) []domain.Attachment); ok {
+		r0 = rf(ctx, projectID, eventIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []domain.EventID) error); ok {
+		r1 = rf(ctx, projectID, eventIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsRepository_ListByEventIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEventIDs'
+type MockAttachmentsRepository_ListByEventIDs_Call struct {
+	*mock.Call
+}
+
+// ListByEventIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - eventIDs []domain.EventID
+func (_e *MockAttachmentsRepository_Expecter) ListByEventIDs(ctx interface{}, projectID interface{}, eventIDs interface{}) *MockAttachmentsRepository_ListByEventIDs_Call {
+	return &MockAttachmentsRepository_ListByEventIDs_Call{Call: _e.mock.On("ListByEventIDs", ctx, projectID, eventIDs)}
+}
+
+func (_c *MockAttachmentsRepository_ListByEventIDs_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, eventIDs []domain.EventID)) *MockAttachmentsRepository_ListByEventIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]domain.EventID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_ListByEventIDs_Call) Return(_a0 []domain.Attachment, _a1 error) *MockAttachmentsRepository_ListByEventIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_ListByEventIDs_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []domain.EventID) ([]domain.Attachment, error)) *MockAttachmentsRepository_ListByEventIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentsRepository creates a new instance of MockAttachmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsRepository {
+	mock := &MockAttachmentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,167 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentsUseCase is an autogenerated mock type for the AttachmentsUseCase type
+type MockAttachmentsUseCase struct {
+	mock.Mock
+}
+
+type MockAttachmentsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsUseCase) EXPECT() *MockAttachmentsUseCase_Expecter {
+	return &MockAttachmentsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ListByEvent provides a mock function with given fields: ctx, projectID, eventID
+func (_m *MockAttachmentsUseCase) ListByEvent(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, projectID, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEvent")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.EventID) ([]domain.Attachment, error)); ok {
+		return rf(ctx, projectID, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.EventID) []domain.Attachment); ok {
+		r0 = rf(ctx, projectID, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.EventID) error); ok {
+		r1 = rf(ctx, projectID, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsUseCase_ListByEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEvent'
+type MockAttachmentsUseCase_ListByEvent_Call struct {
+	*mock.Call
+}
+
+// ListByEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - eventID domain.EventID
+func (_e *MockAttachmentsUseCase_Expecter) ListByEvent(ctx interface{}, projectID interface{}, eventID interface{}) *MockAttachmentsUseCase_ListByEvent_Call {
+	return &MockAttachmentsUseCase_ListByEvent_Call{Call: _e.mock.On("ListByEvent", ctx, projectID, eventID)}
+}
+
+func (_c *MockAttachmentsUseCase_ListByEvent_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID)) *MockAttachmentsUseCase_ListByEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.EventID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsUseCase_ListByEvent_Call) Return(_a0 []domain.Attachment, _a1 error) *MockAttachmentsUseCase_ListByEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsUseCase_ListByEvent_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.EventID) ([]domain.Attachment, error)) *MockAttachmentsUseCase_ListByEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, projectID, id
+func (_m *MockAttachmentsUseCase) Open(ctx context.Context, projectID domain.ProjectID, id domain.AttachmentID) (domain.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 domain.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.AttachmentID) (domain.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.AttachmentID) domain.Attachment); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.AttachmentID) io.ReadCloser); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.ProjectID, domain.AttachmentID) error); ok {
+		r2 = rf(ctx, projectID, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAttachmentsUseCase_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockAttachmentsUseCase_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.AttachmentID
+func (_e *MockAttachmentsUseCase_Expecter) Open(ctx interface{}, projectID interface{}, id interface{}) *MockAttachmentsUseCase_Open_Call {
+	return &MockAttachmentsUseCase_Open_Call{Call: _e.mock.On("Open", ctx, projectID, id)}
+}
+
+func (_c *MockAttachmentsUseCase_Open_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.AttachmentID)) *MockAttachmentsUseCase_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsUseCase_Open_Call) Return(_a0 domain.Attachment, _a1 io.ReadCloser, _a2 error) *MockAttachmentsUseCase_Open_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAttachmentsUseCase_Open_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.AttachmentID) (domain.Attachment, io.ReadCloser, error)) *MockAttachmentsUseCase_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentsUseCase creates a new instance of MockAttachmentsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsUseCase {
+	mock := &MockAttachmentsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDataScrubbingUseCase is an autogenerated mock type for the DataScrubbingUseCase type
+type MockDataScrubbingUseCase struct {
+	mock.Mock
+}
+
+type MockDataScrubbingUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDataScrubbingUseCase) EXPECT() *MockDataScrubbingUseCase_Expecter {
+	return &MockDataScrubbingUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, projectID
+func (_m *MockDataScrubbingUseCase) Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.DataScrubbing
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.DataScrubbing, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.DataScrubbing); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.DataScrubbing)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataScrubbingUseCase_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockDataScrubbingUseCase_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockDataScrubbingUseCase_Expecter) Get(ctx interface{}, projectID interface{}) *MockDataScrubbingUseCase_Get_Call {
+	return &MockDataScrubbingUseCase_Get_Call{Call: _e.mock.On("Get", ctx, projectID)}
+}
+
+func (_c *MockDataScrubbingUseCase_Get_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockDataScrubbingUseCase_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockDataScrubbingUseCase_Get_Call) Return(_a0 domain.DataScrubbing, _a1 error) *MockDataScrubbingUseCase_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataScrubbingUseCase_Get_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.DataScrubbing, error)) *MockDataScrubbingUseCase_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, scrubbing
+func (_m *MockDataScrubbingUseCase) Update(ctx context.Context, scrubbing domain.DataScrubbing) (domain.DataScrubbing, error) {
+	ret := _m.Called(ctx, scrubbing)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.DataScrubbing
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DataScrubbing) (domain.DataScrubbing, error)); ok {
+		return rf(ctx, scrubbing)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DataScrubbing) domain.DataScrubbing); ok {
+		r0 = rf(ctx, scrubbing)
+	} else {
+		r0 = ret.Get(0).(domain.DataScrubbing)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DataScrubbing) error); ok {
+		r1 = rf(ctx, scrubbing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataScrubbingUseCase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockDataScrubbingUseCase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scrubbing domain.DataScrubbing
+func (_e *MockDataScrubbingUseCase_Expecter) Update(ctx interface{}, scrubbing interface{}) *MockDataScrubbingUseCase_Update_Call {
+	return &MockDataScrubbingUseCase_Update_Call{Call: _e.mock.On("Update", ctx, scrubbing)}
+}
+
+func (_c *MockDataScrubbingUseCase_Update_Call) Run(run func(ctx context.Context, scrubbing domain.DataScrubbing)) *MockDataScrubbingUseCase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DataScrubbing))
+	})
+	return _c
+}
+
+func (_c *MockDataScrubbingUseCase_Update_Call) Return(_a0 domain.DataScrubbing, _a1 error) *MockDataScrubbingUseCase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataScrubbingUseCase_Update_Call) RunAndReturn(run func(context.Context, domain.DataScrubbing) (domain.DataScrubbing, error)) *MockDataScrubbingUseCase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDataScrubbingUseCase creates a new instance of MockDataScrubbingUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDataScrubbingUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDataScrubbingUseCase {
+	mock := &MockDataScrubbingUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEnvironmentsRepository is an autogenerated mock type for the EnvironmentsRepository type
+type MockEnvironmentsRepository struct {
+	mock.Mock
+}
+
+type MockEnvironmentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEnvironmentsRepository) EXPECT() *MockEnvironmentsRepository_Expecter {
+	return &MockEnvironmentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockEnvironmentsRepository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Environment, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProject")
+	}
+
+	var r0 []domain.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.Environment, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.Environment); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEnvironmentsRepository_ListByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProject'
+type MockEnvironmentsRepository_ListByProject_Call struct {
+	*mock.Call
+}
+
+// ListByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockEnvironmentsRepository_Expecter) ListByProject(ctx interface{}, projectID interface{}) *MockEnvironmentsRepository_ListByProject_Call {
+	return &MockEnvironmentsRepository_ListByProject_Call{Call: _e.mock.On("ListByProject", ctx, projectID)}
+}
+
+func (_c *MockEnvironmentsRepository_ListByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockEnvironmentsRepository_ListByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockEnvironmentsRepository_ListByProject_Call) Return(_a0 []domain.Environment, _a1 error) *MockEnvironmentsRepository_ListByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEnvironmentsRepository_ListByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.Environment, error)) *MockEnvironmentsRepository_ListByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHidden provides a mock function with given fields: ctx, projectID, name, hidden
+func (_m *MockEnvironmentsRepository) SetHidden(ctx context.Context, projectID domain.ProjectID, name string, hidden bool) error {
+	ret := _m.Called(ctx, projectID, name, hidden)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHidden")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, bool) error); ok {
+		r0 = rf(ctx, projectID, name, hidden)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEnvironmentsRepository_SetHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHidden'
+type MockEnvironmentsRepository_SetHidden_Call struct {
+	*mock.Call
+}
+
+// SetHidden is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - name string
+//   - hidden bool
+func (_e *MockEnvironmentsRepository_Expecter) SetHidden(ctx interface{}, projectID interface{}, name interface{}, hidden interface{}) *MockEnvironmentsRepository_SetHidden_Call {
+	return &MockEnvironmentsRepository_SetHidden_Call{Call: _e.mock.On("SetHidden", ctx, projectID, name, hidden)}
+}
+
+func (_c *MockEnvironmentsRepository_SetHidden_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, name string, hidden bool)) *MockEnvironmentsRepository_SetHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockEnvironmentsRepository_SetHidden_Call) Return(_a0 error) *MockEnvironmentsRepository_SetHidden_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEnvironmentsRepository_SetHidden_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, bool) error) *MockEnvironmentsRepository_SetHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEnvironmentsRepository creates a new instance of MockEnvironmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEnvironmentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEnvironmentsRepository {
+	mock := &MockEnvironmentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
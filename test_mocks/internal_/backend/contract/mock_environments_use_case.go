@@ -0,0 +1,146 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEnvironmentsUseCase is an autogenerated mock type for the EnvironmentsUseCase type
+type MockEnvironmentsUseCase struct {
+	mock.Mock
+}
+
+type MockEnvironmentsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEnvironmentsUseCase) EXPECT() *MockEnvironmentsUseCase_Expecter {
+	return &MockEnvironmentsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx, projectID
+func (_m *MockEnvironmentsUseCase) List(ctx context.Context, projectID domain.ProjectID) ([]domain.Environment, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Environment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.Environment, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.Environment); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Environment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEnvironmentsUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockEnvironmentsUseCase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockEnvironmentsUseCase_Expecter) List(ctx interface{}, projectID interface{}) *MockEnvironmentsUseCase_List_Call {
+	return &MockEnvironmentsUseCase_List_Call{Call: _e.mock.On("List", ctx, projectID)}
+}
+
+func (_c *MockEnvironmentsUseCase_List_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockEnvironmentsUseCase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockEnvironmentsUseCase_List_Call) Return(_a0 []domain.Environment, _a1 error) *MockEnvironmentsUseCase_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEnvironmentsUseCase_List_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.Environment, error)) *MockEnvironmentsUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHidden provides a mock function with given fields: ctx, projectID, name, hidden
+func (_m *MockEnvironmentsUseCase) SetHidden(ctx context.Context, projectID domain.ProjectID, name string, hidden bool) error {
+	ret := _m.Called(ctx, projectID, name, hidden)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHidden")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, bool) error); ok {
+		r0 = rf(ctx, projectID, name, hidden)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEnvironmentsUseCase_SetHidden_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHidden'
+type MockEnvironmentsUseCase_SetHidden_Call struct {
+	*mock.Call
+}
+
+// SetHidden is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - name string
+//   - hidden bool
+func (_e *MockEnvironmentsUseCase_Expecter) SetHidden(ctx interface{}, projectID interface{}, name interface{}, hidden interface{}) *MockEnvironmentsUseCase_SetHidden_Call {
+	return &MockEnvironmentsUseCase_SetHidden_Call{Call: _e.mock.On("SetHidden", ctx, projectID, name, hidden)}
+}
+
+func (_c *MockEnvironmentsUseCase_SetHidden_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, name string, hidden bool)) *MockEnvironmentsUseCase_SetHidden_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockEnvironmentsUseCase_SetHidden_Call) Return(_a0 error) *MockEnvironmentsUseCase_SetHidden_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEnvironmentsUseCase_SetHidden_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, bool) error) *MockEnvironmentsUseCase_SetHidden_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEnvironmentsUseCase creates a new instance of MockEnvironmentsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEnvironmentsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEnvironmentsUseCase {
+	mock := &MockEnvironmentsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
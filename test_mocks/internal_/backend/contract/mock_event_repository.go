@@ -8,6 +8,8 @@ import (
 	domain "github.com/rom8726/warden/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockEventRepository is an autogenerated mock type for the EventRepository type
@@ -23,9 +25,9 @@ func (_m *MockEventRepository) EXPECT() *MockEventRepository_Expecter {
 	return &MockEventRepository_Expecter{mock: &_m.Mock}
 }
 
-// AggregateBySegment provides a mock function with given fields: ctx, projectID, release, segment
-func (_m *MockEventRepository) AggregateBySegment(ctx context.Context, projectID domain.ProjectID, release string, segment domain.SegmentName) (map[string]uint, error) {
-	ret := _m.Called(ctx, projectID, release, segment)
+// AggregateBySegment provides a mock function with given fields: ctx, projectID, release, environment, segment
+func (_m *MockEventRepository) AggregateBySegment(ctx context.Context, projectID domain.ProjectID, release string, environment string, segment domain.SegmentName) (map[string]uint, error) {
+	ret := _m.Called(ctx, projectID, release, environment, segment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AggregateBySegment")
@@ -33,19 +35,19 @@ func (_m *MockEventRepository) AggregateBySegment(ctx context.Context, projectID
 
 	var r0 map[string]uint
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.SegmentName) (map[string]uint, error)); ok {
-		return rf(ctx, projectID, release, segment)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string, domain.SegmentName) (map[string]uint, error)); ok {
+		return rf(ctx, projectID, release, environment, segment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.SegmentName) map[string]uint); ok {
-		r0 = rf(ctx, projectID, release, segment)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string, domain.SegmentName) map[string]uint); ok {
+		r0 = rf(ctx, projectID, release, environment, segment)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]uint)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, domain.SegmentName) error); ok {
-		r1 = rf(ctx, projectID, release, segment)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, string, domain.SegmentName) error); ok {
+		r1 = rf(ctx, projectID, release, environment, segment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,14 +64,15 @@ type MockEventRepository_AggregateBySegment_Call struct {
 //   - ctx context.Context
 //   - projectID domain.ProjectID
 //   - release string
+//   - environment string
 //   - segment domain.SegmentName
-func (_e *MockEventRepository_Expecter) AggregateBySegment(ctx interface{}, projectID interface{}, release interface{}, segment interface{}) *MockEventRepository_AggregateBySegment_Call {
-	return &MockEventRepository_AggregateBySegment_Call{Call: _e.mock.On("AggregateBySegment", ctx, projectID, release, segment)}
+func (_e *MockEventRepository_Expecter) AggregateBySegment(ctx interface{}, projectID interface{}, release interface{}, environment interface{}, segment interface{}) *MockEventRepository_AggregateBySegment_Call {
+	return &MockEventRepository_AggregateBySegment_Call{Call: _e.mock.On("AggregateBySegment", ctx, projectID, release, environment, segment)}
 }
 
-func (_c *MockEventRepository_AggregateBySegment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, segment domain.SegmentName)) *MockEventRepository_AggregateBySegment_Call {
+func (_c *MockEventRepository_AggregateBySegment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, environment string, segment domain.SegmentName)) *MockEventRepository_AggregateBySegment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(domain.SegmentName))
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(string), args[4].(domain.SegmentName))
 	})
 	return _c
 }
@@ -79,7 +82,7 @@ func (_c *MockEventRepository_AggregateBySegment_Call) Return(_a0 map[string]uin
 	return _c
 }
 
-func (_c *MockEventRepository_AggregateBySegment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, domain.SegmentName) (map[string]uint, error)) *MockEventRepository_AggregateBySegment_Call {
+func (_c *MockEventRepository_AggregateBySegment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, string, domain.SegmentName) (map[string]uint, error)) *MockEventRepository_AggregateBySegment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -145,9 +148,9 @@ func (_c *MockEventRepository_EventsByRelease_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// FetchForIssue provides a mock function with given fields: ctx, projectID, groupHash, limit
-func (_m *MockEventRepository) FetchForIssue(ctx context.Context, projectID domain.ProjectID, groupHash string, limit uint) ([]domain.Event, error) {
-	ret := _m.Called(ctx, projectID, groupHash, limit)
+// FetchForIssue provides a mock function with given fields: ctx, projectID, groupHashes, limit
+func (_m *MockEventRepository) FetchForIssue(ctx context.Context, projectID domain.ProjectID, groupHashes []string, limit uint) ([]domain.Event, error) {
+	ret := _m.Called(ctx, projectID, groupHashes, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchForIssue")
@@ -155,19 +158,19 @@ func (_m *MockEventRepository) FetchForIssue(ctx context.Context, projectID doma
 
 	var r0 []domain.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, uint) ([]domain.Event, error)); ok {
-		return rf(ctx, projectID, groupHash, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, uint) ([]domain.Event, error)); ok {
+		return rf(ctx, projectID, groupHashes, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, uint) []domain.Event); ok {
-		r0 = rf(ctx, projectID, groupHash, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, uint) []domain.Event); ok {
+		r0 = rf(ctx, projectID, groupHashes, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, uint) error); ok {
-		r1 = rf(ctx, projectID, groupHash, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string, uint) error); ok {
+		r1 = rf(ctx, projectID, groupHashes, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -183,15 +186,15 @@ type MockEventRepository_FetchForIssue_Call struct {
 // FetchForIssue is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID domain.ProjectID
-//   - groupHash string
+//   - groupHashes []string
 //   - limit uint
-func (_e *MockEventRepository_Expecter) FetchForIssue(ctx interface{}, projectID interface{}, groupHash interface{}, limit interface{}) *MockEventRepository_FetchForIssue_Call {
-	return &MockEventRepository_FetchForIssue_Call{Call: _e.mock.On("FetchForIssue", ctx, projectID, groupHash, limit)}
+func (_e *MockEventRepository_Expecter) FetchForIssue(ctx interface{}, projectID interface{}, groupHashes interface{}, limit interface{}) *MockEventRepository_FetchForIssue_Call {
+	return &MockEventRepository_FetchForIssue_Call{Call: _e.mock.On("FetchForIssue", ctx, projectID, groupHashes, limit)}
 }
 
-func (_c *MockEventRepository_FetchForIssue_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, groupHash string, limit uint)) *MockEventRepository_FetchForIssue_Call {
+func (_c *MockEventRepository_FetchForIssue_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, groupHashes []string, limit uint)) *MockEventRepository_FetchForIssue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(uint))
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string), args[3].(uint))
 	})
 	return _c
 }
@@ -201,14 +204,378 @@ func (_c *MockEventRepository_FetchForIssue_Call) Return(_a0 []domain.Event, _a1
 	return _c
 }
 
-func (_c *MockEventRepository_FetchForIssue_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, uint) ([]domain.Event, error)) *MockEventRepository_FetchForIssue_Call {
+func (_c *MockEventRepository_FetchForIssue_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string, uint) ([]domain.Event, error)) *MockEventRepository_FetchForIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchRecent provides a mock function with given fields: ctx, projectID, since, limit
+func (_m *MockEventRepository) FetchRecent(ctx context.Context, projectID domain.ProjectID, since time.Time, limit uint) ([]domain.Event, error) {
+	ret := _m.Called(ctx, projectID, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRecent")
+	}
+
+	var r0 []domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, time.Time, uint) ([]domain.Event, error)); ok {
+		return rf(ctx, projectID, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, time.Time, uint) []domain.Event); ok {
+		r0 = rf(ctx, projectID, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, time.Time, uint) error); ok {
+		r1 = rf(ctx, projectID, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_FetchRecent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchRecent'
+type MockEventRepository_FetchRecent_Call struct {
+	*mock.Call
+}
+
+// FetchRecent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - since time.Time
+//   - limit uint
+func (_e *MockEventRepository_Expecter) FetchRecent(ctx interface{}, projectID interface{}, since interface{}, limit interface{}) *MockEventRepository_FetchRecent_Call {
+	return &MockEventRepository_FetchRecent_Call{Call: _e.mock.On("FetchRecent", ctx, projectID, since, limit)}
+}
+
+func (_c *MockEventRepository_FetchRecent_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, since time.Time, limit uint)) *MockEventRepository_FetchRecent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(time.Time), args[3].(uint))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_FetchRecent_Call) Return(_a0 []domain.Event, _a1 error) *MockEventRepository_FetchRecent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_FetchRecent_Call) RunAndReturn(run func(context.Context, domain.ProjectID, time.Time, uint) ([]domain.Event, error)) *MockEventRepository_FetchRecent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FingerprintStats provides a mock function with given fields: ctx, projectID, fingerprints
+func (_m *MockEventRepository) FingerprintStats(ctx context.Context, projectID domain.ProjectID, fingerprints []string) (domain.FingerprintStats, error) {
+	ret := _m.Called(ctx, projectID, fingerprints)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FingerprintStats")
+	}
+
+	var r0 domain.FingerprintStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string) (domain.FingerprintStats, error)); ok {
+		return rf(ctx, projectID, fingerprints)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string) domain.FingerprintStats); ok {
+		r0 = rf(ctx, projectID, fingerprints)
+	} else {
+		r0 = ret.Get(0).(domain.FingerprintStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string) error); ok {
+		r1 = rf(ctx, projectID, fingerprints)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_FingerprintStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FingerprintStats'
+type MockEventRepository_FingerprintStats_Call struct {
+	*mock.Call
+}
+
+// FingerprintStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprints []string
+func (_e *MockEventRepository_Expecter) FingerprintStats(ctx interface{}, projectID interface{}, fingerprints interface{}) *MockEventRepository_FingerprintStats_Call {
+	return &MockEventRepository_FingerprintStats_Call{Call: _e.mock.On("FingerprintStats", ctx, projectID, fingerprints)}
+}
+
+func (_c *MockEventRepository_FingerprintStats_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprints []string)) *MockEventRepository_FingerprintStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_FingerprintStats_Call) Return(_a0 domain.FingerprintStats, _a1 error) *MockEventRepository_FingerprintStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_FingerprintStats_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string) (domain.FingerprintStats, error)) *MockEventRepository_FingerprintStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, projectID, eventID
+func (_m *MockEventRepository) GetByID(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID) (domain.Event, error) {
+	ret := _m.Called(ctx, projectID, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.EventID) (domain.Event, error)); ok {
+		return rf(ctx, projectID, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.EventID) domain.Event); ok {
+		r0 = rf(ctx, projectID, eventID)
+	} else {
+		r0 = ret.Get(0).(domain.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.EventID) error); ok {
+		r1 = rf(ctx, projectID, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockEventRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - eventID domain.EventID
+func (_e *MockEventRepository_Expecter) GetByID(ctx interface{}, projectID interface{}, eventID interface{}) *MockEventRepository_GetByID_Call {
+	return &MockEventRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, projectID, eventID)}
+}
+
+func (_c *MockEventRepository_GetByID_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID)) *MockEventRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.EventID))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_GetByID_Call) Return(_a0 domain.Event, _a1 error) *MockEventRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_GetByID_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.EventID) (domain.Event, error)) *MockEventRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueEdgeEventID provides a mock function with given fields: ctx, projectID, fingerprints, oldest
+func (_m *MockEventRepository) IssueEdgeEventID(ctx context.Context, projectID domain.ProjectID, fingerprints []string, oldest bool) (domain.EventID, error) {
+	ret := _m.Called(ctx, projectID, fingerprints, oldest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueEdgeEventID")
+	}
+
+	var r0 domain.EventID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, bool) (domain.EventID, error)); ok {
+		return rf(ctx, projectID, fingerprints, oldest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, bool) domain.EventID); ok {
+		r0 = rf(ctx, projectID, fingerprints, oldest)
+	} else {
+		r0 = ret.Get(0).(domain.EventID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string, bool) error); ok {
+		r1 = rf(ctx, projectID, fingerprints, oldest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_IssueEdgeEventID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueEdgeEventID'
+type MockEventRepository_IssueEdgeEventID_Call struct {
+	*mock.Call
+}
+
+// IssueEdgeEventID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprints []string
+//   - oldest bool
+func (_e *MockEventRepository_Expecter) IssueEdgeEventID(ctx interface{}, projectID interface{}, fingerprints interface{}, oldest interface{}) *MockEventRepository_IssueEdgeEventID_Call {
+	return &MockEventRepository_IssueEdgeEventID_Call{Call: _e.mock.On("IssueEdgeEventID", ctx, projectID, fingerprints, oldest)}
+}
+
+func (_c *MockEventRepository_IssueEdgeEventID_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprints []string, oldest bool)) *MockEventRepository_IssueEdgeEventID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_IssueEdgeEventID_Call) Return(_a0 domain.EventID, _a1 error) *MockEventRepository_IssueEdgeEventID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_IssueEdgeEventID_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string, bool) (domain.EventID, error)) *MockEventRepository_IssueEdgeEventID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueEventNeighbours provides a mock function with given fields: ctx, projectID, fingerprints, cursor
+func (_m *MockEventRepository) IssueEventNeighbours(ctx context.Context, projectID domain.ProjectID, fingerprints []string, cursor domain.EventCursor) (domain.EventID, domain.EventID, error) {
+	ret := _m.Called(ctx, projectID, fingerprints, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueEventNeighbours")
+	}
+
+	var r0 domain.EventID
+	var r1 domain.EventID
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, domain.EventCursor) (domain.EventID, domain.EventID, error)); ok {
+		return rf(ctx, projectID, fingerprints, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, domain.EventCursor) domain.EventID); ok {
+		r0 = rf(ctx, projectID, fingerprints, cursor)
+	} else {
+		r0 = ret.Get(0).(domain.EventID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string, domain.EventCursor) domain.EventID); ok {
+		r1 = rf(ctx, projectID, fingerprints, cursor)
+	} else {
+		r1 = ret.Get(1).(domain.EventID)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.ProjectID, []string, domain.EventCursor) error); ok {
+		r2 = rf(ctx, projectID, fingerprints, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockEventRepository_IssueEventNeighbours_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueEventNeighbours'
+type MockEventRepository_IssueEventNeighbours_Call struct {
+	*mock.Call
+}
+
+// IssueEventNeighbours is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprints []string
+//   - cursor domain.EventCursor
+func (_e *MockEventRepository_Expecter) IssueEventNeighbours(ctx interface{}, projectID interface{}, fingerprints interface{}, cursor interface{}) *MockEventRepository_IssueEventNeighbours_Call {
+	return &MockEventRepository_IssueEventNeighbours_Call{Call: _e.mock.On("IssueEventNeighbours", ctx, projectID, fingerprints, cursor)}
+}
+
+func (_c *MockEventRepository_IssueEventNeighbours_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprints []string, cursor domain.EventCursor)) *MockEventRepository_IssueEventNeighbours_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string), args[3].(domain.EventCursor))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_IssueEventNeighbours_Call) Return(prev domain.EventID, next domain.EventID, err error) *MockEventRepository_IssueEventNeighbours_Call {
+	_c.Call.Return(prev, next, err)
+	return _c
+}
+
+func (_c *MockEventRepository_IssueEventNeighbours_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string, domain.EventCursor) (domain.EventID, domain.EventID, error)) *MockEventRepository_IssueEventNeighbours_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueTags provides a mock function with given fields: ctx, projectID, fingerprints, keysLimit, valuesLimit
+func (_m *MockEventRepository) IssueTags(ctx context.Context, projectID domain.ProjectID, fingerprints []string, keysLimit uint, valuesLimit uint) ([]domain.IssueTag, error) {
+	ret := _m.Called(ctx, projectID, fingerprints, keysLimit, valuesLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueTags")
+	}
+
+	var r0 []domain.IssueTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, uint, uint) ([]domain.IssueTag, error)); ok {
+		return rf(ctx, projectID, fingerprints, keysLimit, valuesLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, uint, uint) []domain.IssueTag); ok {
+		r0 = rf(ctx, projectID, fingerprints, keysLimit, valuesLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string, uint, uint) error); ok {
+		r1 = rf(ctx, projectID, fingerprints, keysLimit, valuesLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_IssueTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueTags'
+type MockEventRepository_IssueTags_Call struct {
+	*mock.Call
+}
+
+// IssueTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprints []string
+//   - keysLimit uint
+//   - valuesLimit uint
+func (_e *MockEventRepository_Expecter) IssueTags(ctx interface{}, projectID interface{}, fingerprints interface{}, keysLimit interface{}, valuesLimit interface{}) *MockEventRepository_IssueTags_Call {
+	return &MockEventRepository_IssueTags_Call{Call: _e.mock.On("IssueTags", ctx, projectID, fingerprints, keysLimit, valuesLimit)}
+}
+
+func (_c *MockEventRepository_IssueTags_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprints []string, keysLimit uint, valuesLimit uint)) *MockEventRepository_IssueTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string), args[3].(uint), args[4].(uint))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_IssueTags_Call) Return(_a0 []domain.IssueTag, _a1 error) *MockEventRepository_IssueTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_IssueTags_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string, uint, uint) ([]domain.IssueTag, error)) *MockEventRepository_IssueTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IssueTimeseries provides a mock function with given fields: ctx, fingerprint, filter
-func (_m *MockEventRepository) IssueTimeseries(ctx context.Context, fingerprint string, filter *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error) {
-	ret := _m.Called(ctx, fingerprint, filter)
+// IssueTimeseries provides a mock function with given fields: ctx, fingerprints, filter
+func (_m *MockEventRepository) IssueTimeseries(ctx context.Context, fingerprints []string, filter *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error) {
+	ret := _m.Called(ctx, fingerprints, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for IssueTimeseries")
@@ -216,19 +583,19 @@ func (_m *MockEventRepository) IssueTimeseries(ctx context.Context, fingerprint
 
 	var r0 []domain.Timeseries
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error)); ok {
-		return rf(ctx, fingerprint, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error)); ok {
+		return rf(ctx, fingerprints, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.IssueEventsTimeseriesFilter) []domain.Timeseries); ok {
-		r0 = rf(ctx, fingerprint, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *domain.IssueEventsTimeseriesFilter) []domain.Timeseries); ok {
+		r0 = rf(ctx, fingerprints, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Timeseries)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.IssueEventsTimeseriesFilter) error); ok {
-		r1 = rf(ctx, fingerprint, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, *domain.IssueEventsTimeseriesFilter) error); ok {
+		r1 = rf(ctx, fingerprints, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -243,15 +610,15 @@ type MockEventRepository_IssueTimeseries_Call struct {
 
 // IssueTimeseries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fingerprint string
+//   - fingerprints []string
 //   - filter *domain.IssueEventsTimeseriesFilter
-func (_e *MockEventRepository_Expecter) IssueTimeseries(ctx interface{}, fingerprint interface{}, filter interface{}) *MockEventRepository_IssueTimeseries_Call {
-	return &MockEventRepository_IssueTimeseries_Call{Call: _e.mock.On("IssueTimeseries", ctx, fingerprint, filter)}
+func (_e *MockEventRepository_Expecter) IssueTimeseries(ctx interface{}, fingerprints interface{}, filter interface{}) *MockEventRepository_IssueTimeseries_Call {
+	return &MockEventRepository_IssueTimeseries_Call{Call: _e.mock.On("IssueTimeseries", ctx, fingerprints, filter)}
 }
 
-func (_c *MockEventRepository_IssueTimeseries_Call) Run(run func(ctx context.Context, fingerprint string, filter *domain.IssueEventsTimeseriesFilter)) *MockEventRepository_IssueTimeseries_Call {
+func (_c *MockEventRepository_IssueTimeseries_Call) Run(run func(ctx context.Context, fingerprints []string, filter *domain.IssueEventsTimeseriesFilter)) *MockEventRepository_IssueTimeseries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*domain.IssueEventsTimeseriesFilter))
+		run(args[0].(context.Context), args[1].([]string), args[2].(*domain.IssueEventsTimeseriesFilter))
 	})
 	return _c
 }
@@ -261,7 +628,127 @@ func (_c *MockEventRepository_IssueTimeseries_Call) Return(_a0 []domain.Timeseri
 	return _c
 }
 
-func (_c *MockEventRepository_IssueTimeseries_Call) RunAndReturn(run func(context.Context, string, *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error)) *MockEventRepository_IssueTimeseries_Call {
+func (_c *MockEventRepository_IssueTimeseries_Call) RunAndReturn(run func(context.Context, []string, *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error)) *MockEventRepository_IssueTimeseries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *MockEventRepository) Search(ctx context.Context, filter *domain.EventSearchFilter) (domain.EventSearchPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 domain.EventSearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EventSearchFilter) (domain.EventSearchPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EventSearchFilter) domain.EventSearchPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.EventSearchPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.EventSearchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockEventRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.EventSearchFilter
+func (_e *MockEventRepository_Expecter) Search(ctx interface{}, filter interface{}) *MockEventRepository_Search_Call {
+	return &MockEventRepository_Search_Call{Call: _e.mock.On("Search", ctx, filter)}
+}
+
+func (_c *MockEventRepository_Search_Call) Run(run func(ctx context.Context, filter *domain.EventSearchFilter)) *MockEventRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.EventSearchFilter))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_Search_Call) Return(_a0 domain.EventSearchPage, _a1 error) *MockEventRepository_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_Search_Call) RunAndReturn(run func(context.Context, *domain.EventSearchFilter) (domain.EventSearchPage, error)) *MockEventRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchGroups provides a mock function with given fields: ctx, projectIDs, query, timeFrom, timeTo, limit
+func (_m *MockEventRepository) SearchGroups(ctx context.Context, projectIDs []domain.ProjectID, query domain.SearchQuery, timeFrom time.Time, timeTo time.Time, limit uint) ([]domain.EventGroup, error) {
+	ret := _m.Called(ctx, projectIDs, query, timeFrom, timeTo, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchGroups")
+	}
+
+	var r0 []domain.EventGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.ProjectID, domain.SearchQuery, time.Time, time.Time, uint) ([]domain.EventGroup, error)); ok {
+		return rf(ctx, projectIDs, query, timeFrom, timeTo, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.ProjectID, domain.SearchQuery, time.Time, time.Time, uint) []domain.EventGroup); ok {
+		r0 = rf(ctx, projectIDs, query, timeFrom, timeTo, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EventGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.ProjectID, domain.SearchQuery, time.Time, time.Time, uint) error); ok {
+		r1 = rf(ctx, projectIDs, query, timeFrom, timeTo, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_SearchGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchGroups'
+type MockEventRepository_SearchGroups_Call struct {
+	*mock.Call
+}
+
+// SearchGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectIDs []domain.ProjectID
+//   - query domain.SearchQuery
+//   - timeFrom time.Time
+//   - timeTo time.Time
+//   - limit uint
+func (_e *MockEventRepository_Expecter) SearchGroups(ctx interface{}, projectIDs interface{}, query interface{}, timeFrom interface{}, timeTo interface{}, limit interface{}) *MockEventRepository_SearchGroups_Call {
+	return &MockEventRepository_SearchGroups_Call{Call: _e.mock.On("SearchGroups", ctx, projectIDs, query, timeFrom, timeTo, limit)}
+}
+
+func (_c *MockEventRepository_SearchGroups_Call) Run(run func(ctx context.Context, projectIDs []domain.ProjectID, query domain.SearchQuery, timeFrom time.Time, timeTo time.Time, limit uint)) *MockEventRepository_SearchGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.ProjectID), args[2].(domain.SearchQuery), args[3].(time.Time), args[4].(time.Time), args[5].(uint))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_SearchGroups_Call) Return(_a0 []domain.EventGroup, _a1 error) *MockEventRepository_SearchGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_SearchGroups_Call) RunAndReturn(run func(context.Context, []domain.ProjectID, domain.SearchQuery, time.Time, time.Time, uint) ([]domain.EventGroup, error)) *MockEventRepository_SearchGroups_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -23,6 +23,296 @@ func (_m *MockEventUseCase) EXPECT() *MockEventUseCase_Expecter {
 	return &MockEventUseCase_Expecter{mock: &_m.Mock}
 }
 
+// GetEvent provides a mock function with given fields: ctx, projectID, eventID
+func (_m *MockEventUseCase) GetEvent(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID) (domain.EventDetails, error) {
+	ret := _m.Called(ctx, projectID, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvent")
+	}
+
+	var r0 domain.EventDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.EventID) (domain.EventDetails, error)); ok {
+		return rf(ctx, projectID, eventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.EventID) domain.EventDetails); ok {
+		r0 = rf(ctx, projectID, eventID)
+	} else {
+		r0 = ret.Get(0).(domain.EventDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.EventID) error); ok {
+		r1 = rf(ctx, projectID, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventUseCase_GetEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvent'
+type MockEventUseCase_GetEvent_Call struct {
+	*mock.Call
+}
+
+// GetEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - eventID domain.EventID
+func (_e *MockEventUseCase_Expecter) GetEvent(ctx interface{}, projectID interface{}, eventID interface{}) *MockEventUseCase_GetEvent_Call {
+	return &MockEventUseCase_GetEvent_Call{Call: _e.mock.On("GetEvent", ctx, projectID, eventID)}
+}
+
+func (_c *MockEventUseCase_GetEvent_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, eventID domain.EventID)) *MockEventUseCase_GetEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.EventID))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_GetEvent_Call) Return(_a0 domain.EventDetails, _a1 error) *MockEventUseCase_GetEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventUseCase_GetEvent_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.EventID) (domain.EventDetails, error)) *MockEventUseCase_GetEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueEdgeEvent provides a mock function with given fields: ctx, projectID, issueID, oldest
+func (_m *MockEventUseCase) IssueEdgeEvent(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, oldest bool) (domain.EventDetails, error) {
+	ret := _m.Called(ctx, projectID, issueID, oldest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueEdgeEvent")
+	}
+
+	var r0 domain.EventDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, bool) (domain.EventDetails, error)); ok {
+		return rf(ctx, projectID, issueID, oldest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, bool) domain.EventDetails); ok {
+		r0 = rf(ctx, projectID, issueID, oldest)
+	} else {
+		r0 = ret.Get(0).(domain.EventDetails)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.IssueID, bool) error); ok {
+		r1 = rf(ctx, projectID, issueID, oldest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventUseCase_IssueEdgeEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueEdgeEvent'
+type MockEventUseCase_IssueEdgeEvent_Call struct {
+	*mock.Call
+}
+
+// IssueEdgeEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - issueID domain.IssueID
+//   - oldest bool
+func (_e *MockEventUseCase_Expecter) IssueEdgeEvent(ctx interface{}, projectID interface{}, issueID interface{}, oldest interface{}) *MockEventUseCase_IssueEdgeEvent_Call {
+	return &MockEventUseCase_IssueEdgeEvent_Call{Call: _e.mock.On("IssueEdgeEvent", ctx, projectID, issueID, oldest)}
+}
+
+func (_c *MockEventUseCase_IssueEdgeEvent_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, oldest bool)) *MockEventUseCase_IssueEdgeEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueEdgeEvent_Call) Return(_a0 domain.EventDetails, _a1 error) *MockEventUseCase_IssueEdgeEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueEdgeEvent_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID, bool) (domain.EventDetails, error)) *MockEventUseCase_IssueEdgeEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueEvents provides a mock function with given fields: ctx, filter
+func (_m *MockEventUseCase) IssueEvents(ctx context.Context, filter *domain.IssueEventsFilter) (domain.EventSearchPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueEvents")
+	}
+
+	var r0 domain.EventSearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueEventsFilter) (domain.EventSearchPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueEventsFilter) domain.EventSearchPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.EventSearchPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.IssueEventsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventUseCase_IssueEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueEvents'
+type MockEventUseCase_IssueEvents_Call struct {
+	*mock.Call
+}
+
+// IssueEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.IssueEventsFilter
+func (_e *MockEventUseCase_Expecter) IssueEvents(ctx interface{}, filter interface{}) *MockEventUseCase_IssueEvents_Call {
+	return &MockEventUseCase_IssueEvents_Call{Call: _e.mock.On("IssueEvents", ctx, filter)}
+}
+
+func (_c *MockEventUseCase_IssueEvents_Call) Run(run func(ctx context.Context, filter *domain.IssueEventsFilter)) *MockEventUseCase_IssueEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.IssueEventsFilter))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueEvents_Call) Return(_a0 domain.EventSearchPage, _a1 error) *MockEventUseCase_IssueEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueEvents_Call) RunAndReturn(run func(context.Context, *domain.IssueEventsFilter) (domain.EventSearchPage, error)) *MockEventUseCase_IssueEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueTagEvents provides a mock function with given fields: ctx, filter
+func (_m *MockEventUseCase) IssueTagEvents(ctx context.Context, filter *domain.IssueTagEventsFilter) (domain.EventSearchPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueTagEvents")
+	}
+
+	var r0 domain.EventSearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueTagEventsFilter) (domain.EventSearchPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueTagEventsFilter) domain.EventSearchPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.EventSearchPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.IssueTagEventsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventUseCase_IssueTagEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueTagEvents'
+type MockEventUseCase_IssueTagEvents_Call struct {
+	*mock.Call
+}
+
+// IssueTagEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.IssueTagEventsFilter
+func (_e *MockEventUseCase_Expecter) IssueTagEvents(ctx interface{}, filter interface{}) *MockEventUseCase_IssueTagEvents_Call {
+	return &MockEventUseCase_IssueTagEvents_Call{Call: _e.mock.On("IssueTagEvents", ctx, filter)}
+}
+
+func (_c *MockEventUseCase_IssueTagEvents_Call) Run(run func(ctx context.Context, filter *domain.IssueTagEventsFilter)) *MockEventUseCase_IssueTagEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.IssueTagEventsFilter))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueTagEvents_Call) Return(_a0 domain.EventSearchPage, _a1 error) *MockEventUseCase_IssueTagEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueTagEvents_Call) RunAndReturn(run func(context.Context, *domain.IssueTagEventsFilter) (domain.EventSearchPage, error)) *MockEventUseCase_IssueTagEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueTags provides a mock function with given fields: ctx, filter
+func (_m *MockEventUseCase) IssueTags(ctx context.Context, filter *domain.IssueTagsFilter) ([]domain.IssueTag, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueTags")
+	}
+
+	var r0 []domain.IssueTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueTagsFilter) ([]domain.IssueTag, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueTagsFilter) []domain.IssueTag); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.IssueTagsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventUseCase_IssueTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueTags'
+type MockEventUseCase_IssueTags_Call struct {
+	*mock.Call
+}
+
+// IssueTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.IssueTagsFilter
+func (_e *MockEventUseCase_Expecter) IssueTags(ctx interface{}, filter interface{}) *MockEventUseCase_IssueTags_Call {
+	return &MockEventUseCase_IssueTags_Call{Call: _e.mock.On("IssueTags", ctx, filter)}
+}
+
+func (_c *MockEventUseCase_IssueTags_Call) Run(run func(ctx context.Context, filter *domain.IssueTagsFilter)) *MockEventUseCase_IssueTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.IssueTagsFilter))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueTags_Call) Return(_a0 []domain.IssueTag, _a1 error) *MockEventUseCase_IssueTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventUseCase_IssueTags_Call) RunAndReturn(run func(context.Context, *domain.IssueTagsFilter) ([]domain.IssueTag, error)) *MockEventUseCase_IssueTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IssueTimeseries provides a mock function with given fields: ctx, filter
 func (_m *MockEventUseCase) IssueTimeseries(ctx context.Context, filter *domain.IssueEventsTimeseriesFilter) ([]domain.Timeseries, error) {
 	ret := _m.Called(ctx, filter)
@@ -82,6 +372,63 @@ func (_c *MockEventUseCase_IssueTimeseries_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// Search provides a mock function with given fields: ctx, filter
+func (_m *MockEventUseCase) Search(ctx context.Context, filter *domain.EventSearchFilter) (domain.EventSearchPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 domain.EventSearchPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EventSearchFilter) (domain.EventSearchPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EventSearchFilter) domain.EventSearchPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(domain.EventSearchPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.EventSearchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventUseCase_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockEventUseCase_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.EventSearchFilter
+func (_e *MockEventUseCase_Expecter) Search(ctx interface{}, filter interface{}) *MockEventUseCase_Search_Call {
+	return &MockEventUseCase_Search_Call{Call: _e.mock.On("Search", ctx, filter)}
+}
+
+func (_c *MockEventUseCase_Search_Call) Run(run func(ctx context.Context, filter *domain.EventSearchFilter)) *MockEventUseCase_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.EventSearchFilter))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_Search_Call) Return(_a0 domain.EventSearchPage, _a1 error) *MockEventUseCase_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventUseCase_Search_Call) RunAndReturn(run func(context.Context, *domain.EventSearchFilter) (domain.EventSearchPage, error)) *MockEventUseCase_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Timeseries provides a mock function with given fields: ctx, filter
 func (_m *MockEventUseCase) Timeseries(ctx context.Context, filter *domain.EventTimeseriesFilter) ([]domain.Timeseries, error) {
 	ret := _m.Called(ctx, filter)
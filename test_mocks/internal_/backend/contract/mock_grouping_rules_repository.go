@@ -0,0 +1,320 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGroupingRulesRepository is an autogenerated mock type for the GroupingRulesRepository type
+type MockGroupingRulesRepository struct {
+	mock.Mock
+}
+
+type MockGroupingRulesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupingRulesRepository) EXPECT() *MockGroupingRulesRepository_Expecter {
+	return &MockGroupingRulesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, ruleDTO
+func (_m *MockGroupingRulesRepository) Create(ctx context.Context, ruleDTO domain.GroupingRuleDTO) (domain.GroupingRule, error) {
+	ret := _m.Called(ctx, ruleDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GroupingRuleDTO) (domain.GroupingRule, error)); ok {
+		return rf(ctx, ruleDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GroupingRuleDTO) domain.GroupingRule); ok {
+		r0 = rf(ctx, ruleDTO)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GroupingRuleDTO) error); ok {
+		r1 = rf(ctx, ruleDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockGroupingRulesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleDTO domain.GroupingRuleDTO
+func (_e *MockGroupingRulesRepository_Expecter) Create(ctx interface{}, ruleDTO interface{}) *MockGroupingRulesRepository_Create_Call {
+	return &MockGroupingRulesRepository_Create_Call{Call: _e.mock.On("Create", ctx, ruleDTO)}
+}
+
+func (_c *MockGroupingRulesRepository_Create_Call) Run(run func(ctx context.Context, ruleDTO domain.GroupingRuleDTO)) *MockGroupingRulesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GroupingRuleDTO))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_Create_Call) Return(_a0 domain.GroupingRule, _a1 error) *MockGroupingRulesRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_Create_Call) RunAndReturn(run func(context.Context, domain.GroupingRuleDTO) (domain.GroupingRule, error)) *MockGroupingRulesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, id
+func (_m *MockGroupingRulesRepository) Delete(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroupingRulesRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGroupingRulesRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.GroupingRuleID
+func (_e *MockGroupingRulesRepository_Expecter) Delete(ctx interface{}, projectID interface{}, id interface{}) *MockGroupingRulesRepository_Delete_Call {
+	return &MockGroupingRulesRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, projectID, id)}
+}
+
+func (_c *MockGroupingRulesRepository_Delete_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID)) *MockGroupingRulesRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingRuleID))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_Delete_Call) Return(_a0 error) *MockGroupingRulesRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingRuleID) error) *MockGroupingRulesRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, projectID, id
+func (_m *MockGroupingRulesRepository) GetByID(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) (domain.GroupingRule, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID) (domain.GroupingRule, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID) domain.GroupingRule); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.GroupingRuleID) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockGroupingRulesRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.GroupingRuleID
+func (_e *MockGroupingRulesRepository_Expecter) GetByID(ctx interface{}, projectID interface{}, id interface{}) *MockGroupingRulesRepository_GetByID_Call {
+	return &MockGroupingRulesRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, projectID, id)}
+}
+
+func (_c *MockGroupingRulesRepository_GetByID_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID)) *MockGroupingRulesRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingRuleID))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_GetByID_Call) Return(_a0 domain.GroupingRule, _a1 error) *MockGroupingRulesRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_GetByID_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingRuleID) (domain.GroupingRule, error)) *MockGroupingRulesRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockGroupingRulesRepository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProject")
+	}
+
+	var r0 []domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.GroupingRule, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.GroupingRule); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GroupingRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesRepository_ListByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProject'
+type MockGroupingRulesRepository_ListByProject_Call struct {
+	*mock.Call
+}
+
+// ListByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockGroupingRulesRepository_Expecter) ListByProject(ctx interface{}, projectID interface{}) *MockGroupingRulesRepository_ListByProject_Call {
+	return &MockGroupingRulesRepository_ListByProject_Call{Call: _e.mock.On("ListByProject", ctx, projectID)}
+}
+
+func (_c *MockGroupingRulesRepository_ListByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockGroupingRulesRepository_ListByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_ListByProject_Call) Return(_a0 []domain.GroupingRule, _a1 error) *MockGroupingRulesRepository_ListByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_ListByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.GroupingRule, error)) *MockGroupingRulesRepository_ListByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, projectID, id, ruleType, expression
+func (_m *MockGroupingRulesRepository) Update(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID, ruleType domain.GroupingRuleType, expression string) (domain.GroupingRule, error) {
+	ret := _m.Called(ctx, projectID, id, ruleType, expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) (domain.GroupingRule, error)); ok {
+		return rf(ctx, projectID, id, ruleType, expression)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) domain.GroupingRule); ok {
+		r0 = rf(ctx, projectID, id, ruleType, expression)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) error); ok {
+		r1 = rf(ctx, projectID, id, ruleType, expression)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockGroupingRulesRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.GroupingRuleID
+//   - ruleType domain.GroupingRuleType
+//   - expression string
+func (_e *MockGroupingRulesRepository_Expecter) Update(ctx interface{}, projectID interface{}, id interface{}, ruleType interface{}, expression interface{}) *MockGroupingRulesRepository_Update_Call {
+	return &MockGroupingRulesRepository_Update_Call{Call: _e.mock.On("Update", ctx, projectID, id, ruleType, expression)}
+}
+
+func (_c *MockGroupingRulesRepository_Update_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID, ruleType domain.GroupingRuleType, expression string)) *MockGroupingRulesRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingRuleID), args[3].(domain.GroupingRuleType), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_Update_Call) Return(_a0 domain.GroupingRule, _a1 error) *MockGroupingRulesRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_Update_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) (domain.GroupingRule, error)) *MockGroupingRulesRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGroupingRulesRepository creates a new instance of MockGroupingRulesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupingRulesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupingRulesRepository {
+	mock := &MockGroupingRulesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
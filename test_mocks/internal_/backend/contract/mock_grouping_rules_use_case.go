@@ -0,0 +1,321 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGroupingRulesUseCase is an autogenerated mock type for the GroupingRulesUseCase type
+type MockGroupingRulesUseCase struct {
+	mock.Mock
+}
+
+type MockGroupingRulesUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupingRulesUseCase) EXPECT() *MockGroupingRulesUseCase_Expecter {
+	return &MockGroupingRulesUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, ruleDTO
+func (_m *MockGroupingRulesUseCase) Create(ctx context.Context, ruleDTO domain.GroupingRuleDTO) (domain.GroupingRule, error) {
+	ret := _m.Called(ctx, ruleDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GroupingRuleDTO) (domain.GroupingRule, error)); ok {
+		return rf(ctx, ruleDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GroupingRuleDTO) domain.GroupingRule); ok {
+		r0 = rf(ctx, ruleDTO)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GroupingRuleDTO) error); ok {
+		r1 = rf(ctx, ruleDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesUseCase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockGroupingRulesUseCase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleDTO domain.GroupingRuleDTO
+func (_e *MockGroupingRulesUseCase_Expecter) Create(ctx interface{}, ruleDTO interface{}) *MockGroupingRulesUseCase_Create_Call {
+	return &MockGroupingRulesUseCase_Create_Call{Call: _e.mock.On("Create", ctx, ruleDTO)}
+}
+
+func (_c *MockGroupingRulesUseCase_Create_Call) Run(run func(ctx context.Context, ruleDTO domain.GroupingRuleDTO)) *MockGroupingRulesUseCase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GroupingRuleDTO))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Create_Call) Return(_a0 domain.GroupingRule, _a1 error) *MockGroupingRulesUseCase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Create_Call) RunAndReturn(run func(context.Context, domain.GroupingRuleDTO) (domain.GroupingRule, error)) *MockGroupingRulesUseCase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, id
+func (_m *MockGroupingRulesUseCase) Delete(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGroupingRulesUseCase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockGroupingRulesUseCase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.GroupingRuleID
+func (_e *MockGroupingRulesUseCase_Expecter) Delete(ctx interface{}, projectID interface{}, id interface{}) *MockGroupingRulesUseCase_Delete_Call {
+	return &MockGroupingRulesUseCase_Delete_Call{Call: _e.mock.On("Delete", ctx, projectID, id)}
+}
+
+func (_c *MockGroupingRulesUseCase_Delete_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID)) *MockGroupingRulesUseCase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingRuleID))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Delete_Call) Return(_a0 error) *MockGroupingRulesUseCase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Delete_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingRuleID) error) *MockGroupingRulesUseCase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, projectID
+func (_m *MockGroupingRulesUseCase) List(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.GroupingRule, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.GroupingRule); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GroupingRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockGroupingRulesUseCase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockGroupingRulesUseCase_Expecter) List(ctx interface{}, projectID interface{}) *MockGroupingRulesUseCase_List_Call {
+	return &MockGroupingRulesUseCase_List_Call{Call: _e.mock.On("List", ctx, projectID)}
+}
+
+func (_c *MockGroupingRulesUseCase_List_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockGroupingRulesUseCase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_List_Call) Return(_a0 []domain.GroupingRule, _a1 error) *MockGroupingRulesUseCase_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_List_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.GroupingRule, error)) *MockGroupingRulesUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preview provides a mock function with given fields: ctx, projectID, ruleType, expression
+func (_m *MockGroupingRulesUseCase) Preview(ctx context.Context, projectID domain.ProjectID, ruleType domain.GroupingRuleType, expression string) (domain.GroupingRulePreview, error) {
+	ret := _m.Called(ctx, projectID, ruleType, expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preview")
+	}
+
+	var r0 domain.GroupingRulePreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleType, string) (domain.GroupingRulePreview, error)); ok {
+		return rf(ctx, projectID, ruleType, expression)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleType, string) domain.GroupingRulePreview); ok {
+		r0 = rf(ctx, projectID, ruleType, expression)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingRulePreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.GroupingRuleType, string) error); ok {
+		r1 = rf(ctx, projectID, ruleType, expression)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesUseCase_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type MockGroupingRulesUseCase_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - ruleType domain.GroupingRuleType
+//   - expression string
+func (_e *MockGroupingRulesUseCase_Expecter) Preview(ctx interface{}, projectID interface{}, ruleType interface{}, expression interface{}) *MockGroupingRulesUseCase_Preview_Call {
+	return &MockGroupingRulesUseCase_Preview_Call{Call: _e.mock.On("Preview", ctx, projectID, ruleType, expression)}
+}
+
+func (_c *MockGroupingRulesUseCase_Preview_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, ruleType domain.GroupingRuleType, expression string)) *MockGroupingRulesUseCase_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingRuleType), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Preview_Call) Return(_a0 domain.GroupingRulePreview, _a1 error) *MockGroupingRulesUseCase_Preview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Preview_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingRuleType, string) (domain.GroupingRulePreview, error)) *MockGroupingRulesUseCase_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, projectID, id, ruleType, expression
+func (_m *MockGroupingRulesUseCase) Update(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID, ruleType domain.GroupingRuleType, expression string) (domain.GroupingRule, error) {
+	ret := _m.Called(ctx, projectID, id, ruleType, expression)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) (domain.GroupingRule, error)); ok {
+		return rf(ctx, projectID, id, ruleType, expression)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) domain.GroupingRule); ok {
+		r0 = rf(ctx, projectID, id, ruleType, expression)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) error); ok {
+		r1 = rf(ctx, projectID, id, ruleType, expression)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesUseCase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockGroupingRulesUseCase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.GroupingRuleID
+//   - ruleType domain.GroupingRuleType
+//   - expression string
+func (_e *MockGroupingRulesUseCase_Expecter) Update(ctx interface{}, projectID interface{}, id interface{}, ruleType interface{}, expression interface{}) *MockGroupingRulesUseCase_Update_Call {
+	return &MockGroupingRulesUseCase_Update_Call{Call: _e.mock.On("Update", ctx, projectID, id, ruleType, expression)}
+}
+
+func (_c *MockGroupingRulesUseCase_Update_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.GroupingRuleID, ruleType domain.GroupingRuleType, expression string)) *MockGroupingRulesUseCase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingRuleID), args[3].(domain.GroupingRuleType), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Update_Call) Return(_a0 domain.GroupingRule, _a1 error) *MockGroupingRulesUseCase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesUseCase_Update_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingRuleID, domain.GroupingRuleType, string) (domain.GroupingRule, error)) *MockGroupingRulesUseCase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGroupingRulesUseCase creates a new instance of MockGroupingRulesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupingRulesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupingRulesUseCase {
+	mock := &MockGroupingRulesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
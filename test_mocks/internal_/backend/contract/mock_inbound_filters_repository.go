@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockInboundFiltersRepository is an autogenerated mock type for the InboundFiltersRepository type
+type MockInboundFiltersRepository struct {
+	mock.Mock
+}
+
+type MockInboundFiltersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInboundFiltersRepository) EXPECT() *MockInboundFiltersRepository_Expecter {
+	return &MockInboundFiltersRepository_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, projectID
+func (_m *MockInboundFiltersRepository) Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.InboundFilters
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.InboundFilters, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.InboundFilters); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.InboundFilters)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInboundFiltersRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockInboundFiltersRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockInboundFiltersRepository_Expecter) Get(ctx interface{}, projectID interface{}) *MockInboundFiltersRepository_Get_Call {
+	return &MockInboundFiltersRepository_Get_Call{Call: _e.mock.On("Get", ctx, projectID)}
+}
+
+func (_c *MockInboundFiltersRepository_Get_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockInboundFiltersRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockInboundFiltersRepository_Get_Call) Return(_a0 domain.InboundFilters, _a1 error) *MockInboundFiltersRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInboundFiltersRepository_Get_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.InboundFilters, error)) *MockInboundFiltersRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, filters
+func (_m *MockInboundFiltersRepository) Upsert(ctx context.Context, filters domain.InboundFilters) (domain.InboundFilters, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 domain.InboundFilters
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InboundFilters) (domain.InboundFilters, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InboundFilters) domain.InboundFilters); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(domain.InboundFilters)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.InboundFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInboundFiltersRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockInboundFiltersRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters domain.InboundFilters
+func (_e *MockInboundFiltersRepository_Expecter) Upsert(ctx interface{}, filters interface{}) *MockInboundFiltersRepository_Upsert_Call {
+	return &MockInboundFiltersRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, filters)}
+}
+
+func (_c *MockInboundFiltersRepository_Upsert_Call) Run(run func(ctx context.Context, filters domain.InboundFilters)) *MockInboundFiltersRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InboundFilters))
+	})
+	return _c
+}
+
+func (_c *MockInboundFiltersRepository_Upsert_Call) Return(_a0 domain.InboundFilters, _a1 error) *MockInboundFiltersRepository_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInboundFiltersRepository_Upsert_Call) RunAndReturn(run func(context.Context, domain.InboundFilters) (domain.InboundFilters, error)) *MockInboundFiltersRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInboundFiltersRepository creates a new instance of MockInboundFiltersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInboundFiltersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInboundFiltersRepository {
+	mock := &MockInboundFiltersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockInboundFiltersUseCase is an autogenerated mock type for the InboundFiltersUseCase type
+type MockInboundFiltersUseCase struct {
+	mock.Mock
+}
+
+type MockInboundFiltersUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockInboundFiltersUseCase) EXPECT() *MockInboundFiltersUseCase_Expecter {
+	return &MockInboundFiltersUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, projectID
+func (_m *MockInboundFiltersUseCase) Get(ctx context.Context, projectID domain.ProjectID) (domain.InboundFilters, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.InboundFilters
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.InboundFilters, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.InboundFilters); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.InboundFilters)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInboundFiltersUseCase_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockInboundFiltersUseCase_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockInboundFiltersUseCase_Expecter) Get(ctx interface{}, projectID interface{}) *MockInboundFiltersUseCase_Get_Call {
+	return &MockInboundFiltersUseCase_Get_Call{Call: _e.mock.On("Get", ctx, projectID)}
+}
+
+func (_c *MockInboundFiltersUseCase_Get_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockInboundFiltersUseCase_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockInboundFiltersUseCase_Get_Call) Return(_a0 domain.InboundFilters, _a1 error) *MockInboundFiltersUseCase_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInboundFiltersUseCase_Get_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.InboundFilters, error)) *MockInboundFiltersUseCase_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, filters
+func (_m *MockInboundFiltersUseCase) Update(ctx context.Context, filters domain.InboundFilters) (domain.InboundFilters, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.InboundFilters
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InboundFilters) (domain.InboundFilters, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.InboundFilters) domain.InboundFilters); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(domain.InboundFilters)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.InboundFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockInboundFiltersUseCase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockInboundFiltersUseCase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters domain.InboundFilters
+func (_e *MockInboundFiltersUseCase_Expecter) Update(ctx interface{}, filters interface{}) *MockInboundFiltersUseCase_Update_Call {
+	return &MockInboundFiltersUseCase_Update_Call{Call: _e.mock.On("Update", ctx, filters)}
+}
+
+func (_c *MockInboundFiltersUseCase_Update_Call) Run(run func(ctx context.Context, filters domain.InboundFilters)) *MockInboundFiltersUseCase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.InboundFilters))
+	})
+	return _c
+}
+
+func (_c *MockInboundFiltersUseCase_Update_Call) Return(_a0 domain.InboundFilters, _a1 error) *MockInboundFiltersUseCase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockInboundFiltersUseCase_Update_Call) RunAndReturn(run func(context.Context, domain.InboundFilters) (domain.InboundFilters, error)) *MockInboundFiltersUseCase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockInboundFiltersUseCase creates a new instance of MockInboundFiltersUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockInboundFiltersUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockInboundFiltersUseCase {
+	mock := &MockInboundFiltersUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueActivitiesRepository is an autogenerated mock type for the IssueActivitiesRepository type
+type MockIssueActivitiesRepository struct {
+	mock.Mock
+}
+
+type MockIssueActivitiesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueActivitiesRepository) EXPECT() *MockIssueActivitiesRepository_Expecter {
+	return &MockIssueActivitiesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, activity
+func (_m *MockIssueActivitiesRepository) Create(ctx context.Context, activity domain.IssueActivityDTO) error {
+	ret := _m.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueActivityDTO) error); ok {
+		r0 = rf(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueActivitiesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIssueActivitiesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activity domain.IssueActivityDTO
+func (_e *MockIssueActivitiesRepository_Expecter) Create(ctx interface{}, activity interface{}) *MockIssueActivitiesRepository_Create_Call {
+	return &MockIssueActivitiesRepository_Create_Call{Call: _e.mock.On("Create", ctx, activity)}
+}
+
+func (_c *MockIssueActivitiesRepository_Create_Call) Run(run func(ctx context.Context, activity domain.IssueActivityDTO)) *MockIssueActivitiesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueActivityDTO))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_Create_Call) Return(_a0 error) *MockIssueActivitiesRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_Create_Call) RunAndReturn(run func(context.Context, domain.IssueActivityDTO) error) *MockIssueActivitiesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIssue provides a mock function with given fields: ctx, filter
+func (_m *MockIssueActivitiesRepository) ListByIssue(ctx context.Context, filter *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIssue")
+	}
+
+	var r0 []domain.IssueActivity
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueActivitiesFilter) []domain.IssueActivity); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.IssueActivitiesFilter) uint64); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *domain.IssueActivitiesFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIssueActivitiesRepository_ListByIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIssue'
+type MockIssueActivitiesRepository_ListByIssue_Call struct {
+	*mock.Call
+}
+
+// ListByIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.IssueActivitiesFilter
+func (_e *MockIssueActivitiesRepository_Expecter) ListByIssue(ctx interface{}, filter interface{}) *MockIssueActivitiesRepository_ListByIssue_Call {
+	return &MockIssueActivitiesRepository_ListByIssue_Call{Call: _e.mock.On("ListByIssue", ctx, filter)}
+}
+
+func (_c *MockIssueActivitiesRepository_ListByIssue_Call) Run(run func(ctx context.Context, filter *domain.IssueActivitiesFilter)) *MockIssueActivitiesRepository_ListByIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.IssueActivitiesFilter))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_ListByIssue_Call) Return(_a0 []domain.IssueActivity, _a1 uint64, _a2 error) *MockIssueActivitiesRepository_ListByIssue_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_ListByIssue_Call) RunAndReturn(run func(context.Context, *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error)) *MockIssueActivitiesRepository_ListByIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToIssue provides a mock function with given fields: ctx, fromIssueIDs, toIssueID
+func (_m *MockIssueActivitiesRepository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	ret := _m.Called(ctx, fromIssueIDs, toIssueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToIssue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID, domain.IssueID) error); ok {
+		r0 = rf(ctx, fromIssueIDs, toIssueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueActivitiesRepository_MoveToIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToIssue'
+type MockIssueActivitiesRepository_MoveToIssue_Call struct {
+	*mock.Call
+}
+
+// MoveToIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromIssueIDs []domain.IssueID
+//   - toIssueID domain.IssueID
+func (_e *MockIssueActivitiesRepository_Expecter) MoveToIssue(ctx interface{}, fromIssueIDs interface{}, toIssueID interface{}) *MockIssueActivitiesRepository_MoveToIssue_Call {
+	return &MockIssueActivitiesRepository_MoveToIssue_Call{Call: _e.mock.On("MoveToIssue", ctx, fromIssueIDs, toIssueID)}
+}
+
+func (_c *MockIssueActivitiesRepository_MoveToIssue_Call) Run(run func(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID)) *MockIssueActivitiesRepository_MoveToIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID), args[2].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_MoveToIssue_Call) Return(_a0 error) *MockIssueActivitiesRepository_MoveToIssue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_MoveToIssue_Call) RunAndReturn(run func(context.Context, []domain.IssueID, domain.IssueID) error) *MockIssueActivitiesRepository_MoveToIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueActivitiesRepository creates a new instance of MockIssueActivitiesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueActivitiesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueActivitiesRepository {
+	mock := &MockIssueActivitiesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
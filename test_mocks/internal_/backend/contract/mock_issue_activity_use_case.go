@@ -0,0 +1,272 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueActivityUseCase is an autogenerated mock type for the IssueActivityUseCase type
+type MockIssueActivityUseCase struct {
+	mock.Mock
+}
+
+type MockIssueActivityUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueActivityUseCase) EXPECT() *MockIssueActivityUseCase_Expecter {
+	return &MockIssueActivityUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: ctx, projectID, comment
+func (_m *MockIssueActivityUseCase) CreateComment(ctx context.Context, projectID domain.ProjectID, comment domain.IssueCommentDTO) (domain.IssueComment, error) {
+	ret := _m.Called(ctx, projectID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 domain.IssueComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueCommentDTO) (domain.IssueComment, error)); ok {
+		return rf(ctx, projectID, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueCommentDTO) domain.IssueComment); ok {
+		r0 = rf(ctx, projectID, comment)
+	} else {
+		r0 = ret.Get(0).(domain.IssueComment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.IssueCommentDTO) error); ok {
+		r1 = rf(ctx, projectID, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueActivityUseCase_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockIssueActivityUseCase_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - comment domain.IssueCommentDTO
+func (_e *MockIssueActivityUseCase_Expecter) CreateComment(ctx interface{}, projectID interface{}, comment interface{}) *MockIssueActivityUseCase_CreateComment_Call {
+	return &MockIssueActivityUseCase_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, projectID, comment)}
+}
+
+func (_c *MockIssueActivityUseCase_CreateComment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, comment domain.IssueCommentDTO)) *MockIssueActivityUseCase_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueCommentDTO))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_CreateComment_Call) Return(_a0 domain.IssueComment, _a1 error) *MockIssueActivityUseCase_CreateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_CreateComment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueCommentDTO) (domain.IssueComment, error)) *MockIssueActivityUseCase_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, projectID, issueID, id
+func (_m *MockIssueActivityUseCase) DeleteComment(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, id domain.IssueCommentID) error {
+	ret := _m.Called(ctx, projectID, issueID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, domain.IssueCommentID) error); ok {
+		r0 = rf(ctx, projectID, issueID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueActivityUseCase_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockIssueActivityUseCase_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - issueID domain.IssueID
+//   - id domain.IssueCommentID
+func (_e *MockIssueActivityUseCase_Expecter) DeleteComment(ctx interface{}, projectID interface{}, issueID interface{}, id interface{}) *MockIssueActivityUseCase_DeleteComment_Call {
+	return &MockIssueActivityUseCase_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, projectID, issueID, id)}
+}
+
+func (_c *MockIssueActivityUseCase_DeleteComment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, id domain.IssueCommentID)) *MockIssueActivityUseCase_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID), args[3].(domain.IssueCommentID))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_DeleteComment_Call) Return(_a0 error) *MockIssueActivityUseCase_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_DeleteComment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID, domain.IssueCommentID) error) *MockIssueActivityUseCase_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActivities provides a mock function with given fields: ctx, projectID, filter
+func (_m *MockIssueActivityUseCase) ListActivities(ctx context.Context, projectID domain.ProjectID, filter *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error) {
+	ret := _m.Called(ctx, projectID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActivities")
+	}
+
+	var r0 []domain.IssueActivity
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error)); ok {
+		return rf(ctx, projectID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, *domain.IssueActivitiesFilter) []domain.IssueActivity); ok {
+		r0 = rf(ctx, projectID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, *domain.IssueActivitiesFilter) uint64); ok {
+		r1 = rf(ctx, projectID, filter)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.ProjectID, *domain.IssueActivitiesFilter) error); ok {
+		r2 = rf(ctx, projectID, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockIssueActivityUseCase_ListActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActivities'
+type MockIssueActivityUseCase_ListActivities_Call struct {
+	*mock.Call
+}
+
+// ListActivities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - filter *domain.IssueActivitiesFilter
+func (_e *MockIssueActivityUseCase_Expecter) ListActivities(ctx interface{}, projectID interface{}, filter interface{}) *MockIssueActivityUseCase_ListActivities_Call {
+	return &MockIssueActivityUseCase_ListActivities_Call{Call: _e.mock.On("ListActivities", ctx, projectID, filter)}
+}
+
+func (_c *MockIssueActivityUseCase_ListActivities_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, filter *domain.IssueActivitiesFilter)) *MockIssueActivityUseCase_ListActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(*domain.IssueActivitiesFilter))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_ListActivities_Call) Return(_a0 []domain.IssueActivity, _a1 uint64, _a2 error) *MockIssueActivityUseCase_ListActivities_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_ListActivities_Call) RunAndReturn(run func(context.Context, domain.ProjectID, *domain.IssueActivitiesFilter) ([]domain.IssueActivity, uint64, error)) *MockIssueActivityUseCase_ListActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: ctx, projectID, issueID
+func (_m *MockIssueActivityUseCase) ListComments(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID) ([]domain.IssueComment, error) {
+	ret := _m.Called(ctx, projectID, issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []domain.IssueComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID) ([]domain.IssueComment, error)); ok {
+		return rf(ctx, projectID, issueID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID) []domain.IssueComment); ok {
+		r0 = rf(ctx, projectID, issueID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.IssueID) error); ok {
+		r1 = rf(ctx, projectID, issueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueActivityUseCase_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockIssueActivityUseCase_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - issueID domain.IssueID
+func (_e *MockIssueActivityUseCase_Expecter) ListComments(ctx interface{}, projectID interface{}, issueID interface{}) *MockIssueActivityUseCase_ListComments_Call {
+	return &MockIssueActivityUseCase_ListComments_Call{Call: _e.mock.On("ListComments", ctx, projectID, issueID)}
+}
+
+func (_c *MockIssueActivityUseCase_ListComments_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID)) *MockIssueActivityUseCase_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_ListComments_Call) Return(_a0 []domain.IssueComment, _a1 error) *MockIssueActivityUseCase_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueActivityUseCase_ListComments_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID) ([]domain.IssueComment, error)) *MockIssueActivityUseCase_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueActivityUseCase creates a new instance of MockIssueActivityUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueActivityUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueActivityUseCase {
+	mock := &MockIssueActivityUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
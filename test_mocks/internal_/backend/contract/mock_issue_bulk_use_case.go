@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueBulkUseCase is an autogenerated mock type for the IssueBulkUseCase type
+type MockIssueBulkUseCase struct {
+	mock.Mock
+}
+
+type MockIssueBulkUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueBulkUseCase) EXPECT() *MockIssueBulkUseCase_Expecter {
+	return &MockIssueBulkUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: ctx, operation
+func (_m *MockIssueBulkUseCase) Apply(ctx context.Context, operation domain.IssueBulkOperation) (domain.IssueBulkResult, error) {
+	ret := _m.Called(ctx, operation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 domain.IssueBulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueBulkOperation) (domain.IssueBulkResult, error)); ok {
+		return rf(ctx, operation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueBulkOperation) domain.IssueBulkResult); ok {
+		r0 = rf(ctx, operation)
+	} else {
+		r0 = ret.Get(0).(domain.IssueBulkResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IssueBulkOperation) error); ok {
+		r1 = rf(ctx, operation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueBulkUseCase_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type MockIssueBulkUseCase_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - operation domain.IssueBulkOperation
+func (_e *MockIssueBulkUseCase_Expecter) Apply(ctx interface{}, operation interface{}) *MockIssueBulkUseCase_Apply_Call {
+	return &MockIssueBulkUseCase_Apply_Call{Call: _e.mock.On("Apply", ctx, operation)}
+}
+
+func (_c *MockIssueBulkUseCase_Apply_Call) Run(run func(ctx context.Context, operation domain.IssueBulkOperation)) *MockIssueBulkUseCase_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueBulkOperation))
+	})
+	return _c
+}
+
+func (_c *MockIssueBulkUseCase_Apply_Call) Return(_a0 domain.IssueBulkResult, _a1 error) *MockIssueBulkUseCase_Apply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueBulkUseCase_Apply_Call) RunAndReturn(run func(context.Context, domain.IssueBulkOperation) (domain.IssueBulkResult, error)) *MockIssueBulkUseCase_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueBulkUseCase creates a new instance of MockIssueBulkUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueBulkUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueBulkUseCase {
+	mock := &MockIssueBulkUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,306 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueCommentsRepository is an autogenerated mock type for the IssueCommentsRepository type
+type MockIssueCommentsRepository struct {
+	mock.Mock
+}
+
+type MockIssueCommentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueCommentsRepository) EXPECT() *MockIssueCommentsRepository_Expecter {
+	return &MockIssueCommentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, comment
+func (_m *MockIssueCommentsRepository) Create(ctx context.Context, comment domain.IssueCommentDTO) (domain.IssueComment, error) {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.IssueComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueCommentDTO) (domain.IssueComment, error)); ok {
+		return rf(ctx, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueCommentDTO) domain.IssueComment); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(domain.IssueComment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IssueCommentDTO) error); ok {
+		r1 = rf(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueCommentsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIssueCommentsRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment domain.IssueCommentDTO
+func (_e *MockIssueCommentsRepository_Expecter) Create(ctx interface{}, comment interface{}) *MockIssueCommentsRepository_Create_Call {
+	return &MockIssueCommentsRepository_Create_Call{Call: _e.mock.On("Create", ctx, comment)}
+}
+
+func (_c *MockIssueCommentsRepository_Create_Call) Run(run func(ctx context.Context, comment domain.IssueCommentDTO)) *MockIssueCommentsRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueCommentDTO))
+	})
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_Create_Call) Return(_a0 domain.IssueComment, _a1 error) *MockIssueCommentsRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_Create_Call) RunAndReturn(run func(context.Context, domain.IssueCommentDTO) (domain.IssueComment, error)) *MockIssueCommentsRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIssueCommentsRepository) Delete(ctx context.Context, id domain.IssueCommentID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueCommentID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueCommentsRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIssueCommentsRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.IssueCommentID
+func (_e *MockIssueCommentsRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockIssueCommentsRepository_Delete_Call {
+	return &MockIssueCommentsRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockIssueCommentsRepository_Delete_Call) Run(run func(ctx context.Context, id domain.IssueCommentID)) *MockIssueCommentsRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueCommentID))
+	})
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_Delete_Call) Return(_a0 error) *MockIssueCommentsRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.IssueCommentID) error) *MockIssueCommentsRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIssueCommentsRepository) GetByID(ctx context.Context, id domain.IssueCommentID) (domain.IssueComment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.IssueComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueCommentID) (domain.IssueComment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueCommentID) domain.IssueComment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.IssueComment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IssueCommentID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueCommentsRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIssueCommentsRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.IssueCommentID
+func (_e *MockIssueCommentsRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockIssueCommentsRepository_GetByID_Call {
+	return &MockIssueCommentsRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockIssueCommentsRepository_GetByID_Call) Run(run func(ctx context.Context, id domain.IssueCommentID)) *MockIssueCommentsRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueCommentID))
+	})
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_GetByID_Call) Return(_a0 domain.IssueComment, _a1 error) *MockIssueCommentsRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_GetByID_Call) RunAndReturn(run func(context.Context, domain.IssueCommentID) (domain.IssueComment, error)) *MockIssueCommentsRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIssue provides a mock function with given fields: ctx, issueID
+func (_m *MockIssueCommentsRepository) ListByIssue(ctx context.Context, issueID domain.IssueID) ([]domain.IssueComment, error) {
+	ret := _m.Called(ctx, issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIssue")
+	}
+
+	var r0 []domain.IssueComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID) ([]domain.IssueComment, error)); ok {
+		return rf(ctx, issueID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID) []domain.IssueComment); ok {
+		r0 = rf(ctx, issueID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IssueID) error); ok {
+		r1 = rf(ctx, issueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueCommentsRepository_ListByIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIssue'
+type MockIssueCommentsRepository_ListByIssue_Call struct {
+	*mock.Call
+}
+
+// ListByIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+func (_e *MockIssueCommentsRepository_Expecter) ListByIssue(ctx interface{}, issueID interface{}) *MockIssueCommentsRepository_ListByIssue_Call {
+	return &MockIssueCommentsRepository_ListByIssue_Call{Call: _e.mock.On("ListByIssue", ctx, issueID)}
+}
+
+func (_c *MockIssueCommentsRepository_ListByIssue_Call) Run(run func(ctx context.Context, issueID domain.IssueID)) *MockIssueCommentsRepository_ListByIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_ListByIssue_Call) Return(_a0 []domain.IssueComment, _a1 error) *MockIssueCommentsRepository_ListByIssue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_ListByIssue_Call) RunAndReturn(run func(context.Context, domain.IssueID) ([]domain.IssueComment, error)) *MockIssueCommentsRepository_ListByIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToIssue provides a mock function with given fields: ctx, fromIssueIDs, toIssueID
+func (_m *MockIssueCommentsRepository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	ret := _m.Called(ctx, fromIssueIDs, toIssueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToIssue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID, domain.IssueID) error); ok {
+		r0 = rf(ctx, fromIssueIDs, toIssueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueCommentsRepository_MoveToIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToIssue'
+type MockIssueCommentsRepository_MoveToIssue_Call struct {
+	*mock.Call
+}
+
+// MoveToIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromIssueIDs []domain.IssueID
+//   - toIssueID domain.IssueID
+func (_e *MockIssueCommentsRepository_Expecter) MoveToIssue(ctx interface{}, fromIssueIDs interface{}, toIssueID interface{}) *MockIssueCommentsRepository_MoveToIssue_Call {
+	return &MockIssueCommentsRepository_MoveToIssue_Call{Call: _e.mock.On("MoveToIssue", ctx, fromIssueIDs, toIssueID)}
+}
+
+func (_c *MockIssueCommentsRepository_MoveToIssue_Call) Run(run func(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID)) *MockIssueCommentsRepository_MoveToIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID), args[2].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_MoveToIssue_Call) Return(_a0 error) *MockIssueCommentsRepository_MoveToIssue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueCommentsRepository_MoveToIssue_Call) RunAndReturn(run func(context.Context, []domain.IssueID, domain.IssueID) error) *MockIssueCommentsRepository_MoveToIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueCommentsRepository creates a new instance of MockIssueCommentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueCommentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueCommentsRepository {
+	mock := &MockIssueCommentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
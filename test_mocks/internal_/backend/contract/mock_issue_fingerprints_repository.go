@@ -0,0 +1,300 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueFingerprintsRepository is an autogenerated mock type for the IssueFingerprintsRepository type
+type MockIssueFingerprintsRepository struct {
+	mock.Mock
+}
+
+type MockIssueFingerprintsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueFingerprintsRepository) EXPECT() *MockIssueFingerprintsRepository_Expecter {
+	return &MockIssueFingerprintsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, projectID, fingerprint, issueID
+func (_m *MockIssueFingerprintsRepository) Create(ctx context.Context, projectID domain.ProjectID, fingerprint string, issueID domain.IssueID) error {
+	ret := _m.Called(ctx, projectID, fingerprint, issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.IssueID) error); ok {
+		r0 = rf(ctx, projectID, fingerprint, issueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueFingerprintsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIssueFingerprintsRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprint string
+//   - issueID domain.IssueID
+func (_e *MockIssueFingerprintsRepository_Expecter) Create(ctx interface{}, projectID interface{}, fingerprint interface{}, issueID interface{}) *MockIssueFingerprintsRepository_Create_Call {
+	return &MockIssueFingerprintsRepository_Create_Call{Call: _e.mock.On("Create", ctx, projectID, fingerprint, issueID)}
+}
+
+func (_c *MockIssueFingerprintsRepository_Create_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprint string, issueID domain.IssueID)) *MockIssueFingerprintsRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_Create_Call) Return(_a0 error) *MockIssueFingerprintsRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_Create_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, domain.IssueID) error) *MockIssueFingerprintsRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, issueID, fingerprint
+func (_m *MockIssueFingerprintsRepository) Delete(ctx context.Context, issueID domain.IssueID, fingerprint string) error {
+	ret := _m.Called(ctx, issueID, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, string) error); ok {
+		r0 = rf(ctx, issueID, fingerprint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueFingerprintsRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIssueFingerprintsRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - fingerprint string
+func (_e *MockIssueFingerprintsRepository_Expecter) Delete(ctx interface{}, issueID interface{}, fingerprint interface{}) *MockIssueFingerprintsRepository_Delete_Call {
+	return &MockIssueFingerprintsRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, issueID, fingerprint)}
+}
+
+func (_c *MockIssueFingerprintsRepository_Delete_Call) Run(run func(ctx context.Context, issueID domain.IssueID, fingerprint string)) *MockIssueFingerprintsRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_Delete_Call) Return(_a0 error) *MockIssueFingerprintsRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.IssueID, string) error) *MockIssueFingerprintsRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIssueFingerprint provides a mock function with given fields: ctx, projectID, fingerprint
+func (_m *MockIssueFingerprintsRepository) GetIssueFingerprint(ctx context.Context, projectID domain.ProjectID, fingerprint string) (string, error) {
+	ret := _m.Called(ctx, projectID, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssueFingerprint")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (string, error)); ok {
+		return rf(ctx, projectID, fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) string); ok {
+		r0 = rf(ctx, projectID, fingerprint)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, fingerprint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueFingerprintsRepository_GetIssueFingerprint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIssueFingerprint'
+type MockIssueFingerprintsRepository_GetIssueFingerprint_Call struct {
+	*mock.Call
+}
+
+// GetIssueFingerprint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprint string
+func (_e *MockIssueFingerprintsRepository_Expecter) GetIssueFingerprint(ctx interface{}, projectID interface{}, fingerprint interface{}) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	return &MockIssueFingerprintsRepository_GetIssueFingerprint_Call{Call: _e.mock.On("GetIssueFingerprint", ctx, projectID, fingerprint)}
+}
+
+func (_c *MockIssueFingerprintsRepository_GetIssueFingerprint_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprint string)) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_GetIssueFingerprint_Call) Return(_a0 string, _a1 error) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_GetIssueFingerprint_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (string, error)) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIssue provides a mock function with given fields: ctx, issueID
+func (_m *MockIssueFingerprintsRepository) ListByIssue(ctx context.Context, issueID domain.IssueID) ([]string, error) {
+	ret := _m.Called(ctx, issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIssue")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID) ([]string, error)); ok {
+		return rf(ctx, issueID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID) []string); ok {
+		r0 = rf(ctx, issueID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IssueID) error); ok {
+		r1 = rf(ctx, issueID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueFingerprintsRepository_ListByIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIssue'
+type MockIssueFingerprintsRepository_ListByIssue_Call struct {
+	*mock.Call
+}
+
+// ListByIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+func (_e *MockIssueFingerprintsRepository_Expecter) ListByIssue(ctx interface{}, issueID interface{}) *MockIssueFingerprintsRepository_ListByIssue_Call {
+	return &MockIssueFingerprintsRepository_ListByIssue_Call{Call: _e.mock.On("ListByIssue", ctx, issueID)}
+}
+
+func (_c *MockIssueFingerprintsRepository_ListByIssue_Call) Run(run func(ctx context.Context, issueID domain.IssueID)) *MockIssueFingerprintsRepository_ListByIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_ListByIssue_Call) Return(_a0 []string, _a1 error) *MockIssueFingerprintsRepository_ListByIssue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_ListByIssue_Call) RunAndReturn(run func(context.Context, domain.IssueID) ([]string, error)) *MockIssueFingerprintsRepository_ListByIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reassign provides a mock function with given fields: ctx, fromIssueIDs, toIssueID
+func (_m *MockIssueFingerprintsRepository) Reassign(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	ret := _m.Called(ctx, fromIssueIDs, toIssueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reassign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID, domain.IssueID) error); ok {
+		r0 = rf(ctx, fromIssueIDs, toIssueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueFingerprintsRepository_Reassign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reassign'
+type MockIssueFingerprintsRepository_Reassign_Call struct {
+	*mock.Call
+}
+
+// Reassign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromIssueIDs []domain.IssueID
+//   - toIssueID domain.IssueID
+func (_e *MockIssueFingerprintsRepository_Expecter) Reassign(ctx interface{}, fromIssueIDs interface{}, toIssueID interface{}) *MockIssueFingerprintsRepository_Reassign_Call {
+	return &MockIssueFingerprintsRepository_Reassign_Call{Call: _e.mock.On("Reassign", ctx, fromIssueIDs, toIssueID)}
+}
+
+func (_c *MockIssueFingerprintsRepository_Reassign_Call) Run(run func(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID)) *MockIssueFingerprintsRepository_Reassign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID), args[2].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_Reassign_Call) Return(_a0 error) *MockIssueFingerprintsRepository_Reassign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_Reassign_Call) RunAndReturn(run func(context.Context, []domain.IssueID, domain.IssueID) error) *MockIssueFingerprintsRepository_Reassign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueFingerprintsRepository creates a new instance of MockIssueFingerprintsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueFingerprintsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueFingerprintsRepository {
+	mock := &MockIssueFingerprintsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,183 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueReleasesRepository is an autogenerated mock type for the IssueReleasesRepository type
+type MockIssueReleasesRepository struct {
+	mock.Mock
+}
+
+type MockIssueReleasesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueReleasesRepository) EXPECT() *MockIssueReleasesRepository_Expecter {
+	return &MockIssueReleasesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, issueID, releaseID, firstSeenIn
+func (_m *MockIssueReleasesRepository) Create(ctx context.Context, issueID domain.IssueID, releaseID domain.ReleaseID, firstSeenIn bool) error {
+	ret := _m.Called(ctx, issueID, releaseID, firstSeenIn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, domain.ReleaseID, bool) error); ok {
+		r0 = rf(ctx, issueID, releaseID, firstSeenIn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueReleasesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIssueReleasesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - releaseID domain.ReleaseID
+//   - firstSeenIn bool
+func (_e *MockIssueReleasesRepository_Expecter) Create(ctx interface{}, issueID interface{}, releaseID interface{}, firstSeenIn interface{}) *MockIssueReleasesRepository_Create_Call {
+	return &MockIssueReleasesRepository_Create_Call{Call: _e.mock.On("Create", ctx, issueID, releaseID, firstSeenIn)}
+}
+
+func (_c *MockIssueReleasesRepository_Create_Call) Run(run func(ctx context.Context, issueID domain.IssueID, releaseID domain.ReleaseID, firstSeenIn bool)) *MockIssueReleasesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(domain.ReleaseID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockIssueReleasesRepository_Create_Call) Return(_a0 error) *MockIssueReleasesRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueReleasesRepository_Create_Call) RunAndReturn(run func(context.Context, domain.IssueID, domain.ReleaseID, bool) error) *MockIssueReleasesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, issueID, releaseIDs
+func (_m *MockIssueReleasesRepository) Delete(ctx context.Context, issueID domain.IssueID, releaseIDs []domain.ReleaseID) error {
+	ret := _m.Called(ctx, issueID, releaseIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, []domain.ReleaseID) error); ok {
+		r0 = rf(ctx, issueID, releaseIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueReleasesRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIssueReleasesRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - releaseIDs []domain.ReleaseID
+func (_e *MockIssueReleasesRepository_Expecter) Delete(ctx interface{}, issueID interface{}, releaseIDs interface{}) *MockIssueReleasesRepository_Delete_Call {
+	return &MockIssueReleasesRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, issueID, releaseIDs)}
+}
+
+func (_c *MockIssueReleasesRepository_Delete_Call) Run(run func(ctx context.Context, issueID domain.IssueID, releaseIDs []domain.ReleaseID)) *MockIssueReleasesRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].([]domain.ReleaseID))
+	})
+	return _c
+}
+
+func (_c *MockIssueReleasesRepository_Delete_Call) Return(_a0 error) *MockIssueReleasesRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueReleasesRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.IssueID, []domain.ReleaseID) error) *MockIssueReleasesRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToIssue provides a mock function with given fields: ctx, fromIssueIDs, toIssueID
+func (_m *MockIssueReleasesRepository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	ret := _m.Called(ctx, fromIssueIDs, toIssueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToIssue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID, domain.IssueID) error); ok {
+		r0 = rf(ctx, fromIssueIDs, toIssueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueReleasesRepository_MoveToIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToIssue'
+type MockIssueReleasesRepository_MoveToIssue_Call struct {
+	*mock.Call
+}
+
+// MoveToIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromIssueIDs []domain.IssueID
+//   - toIssueID domain.IssueID
+func (_e *MockIssueReleasesRepository_Expecter) MoveToIssue(ctx interface{}, fromIssueIDs interface{}, toIssueID interface{}) *MockIssueReleasesRepository_MoveToIssue_Call {
+	return &MockIssueReleasesRepository_MoveToIssue_Call{Call: _e.mock.On("MoveToIssue", ctx, fromIssueIDs, toIssueID)}
+}
+
+func (_c *MockIssueReleasesRepository_MoveToIssue_Call) Run(run func(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID)) *MockIssueReleasesRepository_MoveToIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID), args[2].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueReleasesRepository_MoveToIssue_Call) Return(_a0 error) *MockIssueReleasesRepository_MoveToIssue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueReleasesRepository_MoveToIssue_Call) RunAndReturn(run func(context.Context, []domain.IssueID, domain.IssueID) error) *MockIssueReleasesRepository_MoveToIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueReleasesRepository creates a new instance of MockIssueReleasesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueReleasesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueReleasesRepository {
+	mock := &MockIssueReleasesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,6 +23,54 @@ func (_m *MockIssueUseCase) EXPECT() *MockIssueUseCase_Expecter {
 	return &MockIssueUseCase_Expecter{mock: &_m.Mock}
 }
 
+// Assign provides a mock function with given fields: ctx, id, assignee
+func (_m *MockIssueUseCase) Assign(ctx context.Context, id domain.IssueID, assignee domain.IssueAssignee) error {
+	ret := _m.Called(ctx, id, assignee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, domain.IssueAssignee) error); ok {
+		r0 = rf(ctx, id, assignee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueUseCase_Assign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assign'
+type MockIssueUseCase_Assign_Call struct {
+	*mock.Call
+}
+
+// Assign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.IssueID
+//   - assignee domain.IssueAssignee
+func (_e *MockIssueUseCase_Expecter) Assign(ctx interface{}, id interface{}, assignee interface{}) *MockIssueUseCase_Assign_Call {
+	return &MockIssueUseCase_Assign_Call{Call: _e.mock.On("Assign", ctx, id, assignee)}
+}
+
+func (_c *MockIssueUseCase_Assign_Call) Run(run func(ctx context.Context, id domain.IssueID, assignee domain.IssueAssignee)) *MockIssueUseCase_Assign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(domain.IssueAssignee))
+	})
+	return _c
+}
+
+func (_c *MockIssueUseCase_Assign_Call) Return(_a0 error) *MockIssueUseCase_Assign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueUseCase_Assign_Call) RunAndReturn(run func(context.Context, domain.IssueID, domain.IssueAssignee) error) *MockIssueUseCase_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangeStatus provides a mock function with given fields: ctx, id, status
 func (_m *MockIssueUseCase) ChangeStatus(ctx context.Context, id domain.IssueID, status domain.IssueStatus) error {
 	ret := _m.Called(ctx, id, status)
@@ -128,8 +176,56 @@ func (_c *MockIssueUseCase_GetByIDWithChildren_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// Ignore provides a mock function with given fields: ctx, id, conditions
+func (_m *MockIssueUseCase) Ignore(ctx context.Context, id domain.IssueID, conditions domain.IssueIgnoreConditions) error {
+	ret := _m.Called(ctx, id, conditions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ignore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, domain.IssueIgnoreConditions) error); ok {
+		r0 = rf(ctx, id, conditions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueUseCase_Ignore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ignore'
+type MockIssueUseCase_Ignore_Call struct {
+	*mock.Call
+}
+
+// Ignore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.IssueID
+//   - conditions domain.IssueIgnoreConditions
+func (_e *MockIssueUseCase_Expecter) Ignore(ctx interface{}, id interface{}, conditions interface{}) *MockIssueUseCase_Ignore_Call {
+	return &MockIssueUseCase_Ignore_Call{Call: _e.mock.On("Ignore", ctx, id, conditions)}
+}
+
+func (_c *MockIssueUseCase_Ignore_Call) Run(run func(ctx context.Context, id domain.IssueID, conditions domain.IssueIgnoreConditions)) *MockIssueUseCase_Ignore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(domain.IssueIgnoreConditions))
+	})
+	return _c
+}
+
+func (_c *MockIssueUseCase_Ignore_Call) Return(_a0 error) *MockIssueUseCase_Ignore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueUseCase_Ignore_Call) RunAndReturn(run func(context.Context, domain.IssueID, domain.IssueIgnoreConditions) error) *MockIssueUseCase_Ignore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, filter
-func (_m *MockIssueUseCase) List(ctx context.Context, filter *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, error) {
+func (_m *MockIssueUseCase) List(ctx context.Context, filter *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, bool, error) {
 	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
@@ -138,8 +234,9 @@ func (_m *MockIssueUseCase) List(ctx context.Context, filter *domain.ListIssuesF
 
 	var r0 []domain.IssueExtended
 	var r1 uint64
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, error)); ok {
+	var r2 bool
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, bool, error)); ok {
 		return rf(ctx, filter)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListIssuesFilter) []domain.IssueExtended); ok {
@@ -156,13 +253,19 @@ func (_m *MockIssueUseCase) List(ctx context.Context, filter *domain.ListIssuesF
 		r1 = ret.Get(1).(uint64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, *domain.ListIssuesFilter) error); ok {
+	if rf, ok := ret.Get(2).(func(context.Context, *domain.ListIssuesFilter) bool); ok {
 		r2 = rf(ctx, filter)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(bool)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(3).(func(context.Context, *domain.ListIssuesFilter) error); ok {
+		r3 = rf(ctx, filter)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
 }
 
 // MockIssueUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -184,12 +287,61 @@ func (_c *MockIssueUseCase_List_Call) Run(run func(ctx context.Context, filter *
 	return _c
 }
 
-func (_c *MockIssueUseCase_List_Call) Return(_a0 []domain.IssueExtended, _a1 uint64, _a2 error) *MockIssueUseCase_List_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockIssueUseCase_List_Call) Return(issues []domain.IssueExtended, total uint64, truncated bool, err error) *MockIssueUseCase_List_Call {
+	_c.Call.Return(issues, total, truncated, err)
+	return _c
+}
+
+func (_c *MockIssueUseCase_List_Call) RunAndReturn(run func(context.Context, *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, bool, error)) *MockIssueUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: ctx, projectID, primaryID, issueIDs
+func (_m *MockIssueUseCase) Merge(ctx context.Context, projectID domain.ProjectID, primaryID domain.IssueID, issueIDs []domain.IssueID) error {
+	ret := _m.Called(ctx, projectID, primaryID, issueIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, []domain.IssueID) error); ok {
+		r0 = rf(ctx, projectID, primaryID, issueIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueUseCase_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockIssueUseCase_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - primaryID domain.IssueID
+//   - issueIDs []domain.IssueID
+func (_e *MockIssueUseCase_Expecter) Merge(ctx interface{}, projectID interface{}, primaryID interface{}, issueIDs interface{}) *MockIssueUseCase_Merge_Call {
+	return &MockIssueUseCase_Merge_Call{Call: _e.mock.On("Merge", ctx, projectID, primaryID, issueIDs)}
+}
+
+func (_c *MockIssueUseCase_Merge_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, primaryID domain.IssueID, issueIDs []domain.IssueID)) *MockIssueUseCase_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID), args[3].([]domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueUseCase_Merge_Call) Return(_a0 error) *MockIssueUseCase_Merge_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockIssueUseCase_List_Call) RunAndReturn(run func(context.Context, *domain.ListIssuesFilter) ([]domain.IssueExtended, uint64, error)) *MockIssueUseCase_List_Call {
+func (_c *MockIssueUseCase_Merge_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID, []domain.IssueID) error) *MockIssueUseCase_Merge_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -253,6 +405,54 @@ func (_c *MockIssueUseCase_RecentIssues_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ResolveInRelease provides a mock function with given fields: ctx, id, release
+func (_m *MockIssueUseCase) ResolveInRelease(ctx context.Context, id domain.IssueID, release domain.IssueResolvedInRelease) error {
+	ret := _m.Called(ctx, id, release)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveInRelease")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, domain.IssueResolvedInRelease) error); ok {
+		r0 = rf(ctx, id, release)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueUseCase_ResolveInRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveInRelease'
+type MockIssueUseCase_ResolveInRelease_Call struct {
+	*mock.Call
+}
+
+// ResolveInRelease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.IssueID
+//   - release domain.IssueResolvedInRelease
+func (_e *MockIssueUseCase_Expecter) ResolveInRelease(ctx interface{}, id interface{}, release interface{}) *MockIssueUseCase_ResolveInRelease_Call {
+	return &MockIssueUseCase_ResolveInRelease_Call{Call: _e.mock.On("ResolveInRelease", ctx, id, release)}
+}
+
+func (_c *MockIssueUseCase_ResolveInRelease_Call) Run(run func(ctx context.Context, id domain.IssueID, release domain.IssueResolvedInRelease)) *MockIssueUseCase_ResolveInRelease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(domain.IssueResolvedInRelease))
+	})
+	return _c
+}
+
+func (_c *MockIssueUseCase_ResolveInRelease_Call) Return(_a0 error) *MockIssueUseCase_ResolveInRelease_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueUseCase_ResolveInRelease_Call) RunAndReturn(run func(context.Context, domain.IssueID, domain.IssueResolvedInRelease) error) *MockIssueUseCase_ResolveInRelease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Timeseries provides a mock function with given fields: ctx, filter
 func (_m *MockIssueUseCase) Timeseries(ctx context.Context, filter *domain.IssueTimeseriesFilter) ([]domain.Timeseries, error) {
 	ret := _m.Called(ctx, filter)
@@ -312,6 +512,67 @@ func (_c *MockIssueUseCase_Timeseries_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// Unmerge provides a mock function with given fields: ctx, projectID, id, fingerprints
+func (_m *MockIssueUseCase) Unmerge(ctx context.Context, projectID domain.ProjectID, id domain.IssueID, fingerprints []string) ([]domain.IssueExtended, error) {
+	ret := _m.Called(ctx, projectID, id, fingerprints)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmerge")
+	}
+
+	var r0 []domain.IssueExtended
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, []string) ([]domain.IssueExtended, error)); ok {
+		return rf(ctx, projectID, id, fingerprints)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, []string) []domain.IssueExtended); ok {
+		r0 = rf(ctx, projectID, id, fingerprints)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueExtended)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.IssueID, []string) error); ok {
+		r1 = rf(ctx, projectID, id, fingerprints)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueUseCase_Unmerge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmerge'
+type MockIssueUseCase_Unmerge_Call struct {
+	*mock.Call
+}
+
+// Unmerge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.IssueID
+//   - fingerprints []string
+func (_e *MockIssueUseCase_Expecter) Unmerge(ctx interface{}, projectID interface{}, id interface{}, fingerprints interface{}) *MockIssueUseCase_Unmerge_Call {
+	return &MockIssueUseCase_Unmerge_Call{Call: _e.mock.On("Unmerge", ctx, projectID, id, fingerprints)}
+}
+
+func (_c *MockIssueUseCase_Unmerge_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.IssueID, fingerprints []string)) *MockIssueUseCase_Unmerge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockIssueUseCase_Unmerge_Call) Return(_a0 []domain.IssueExtended, _a1 error) *MockIssueUseCase_Unmerge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueUseCase_Unmerge_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID, []string) ([]domain.IssueExtended, error)) *MockIssueUseCase_Unmerge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockIssueUseCase creates a new instance of MockIssueUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIssueUseCase(t interface {
@@ -85,6 +85,110 @@ func (_c *MockIssuesRepository_CountForLevels_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// Create provides a mock function with given fields: ctx, issue
+func (_m *MockIssuesRepository) Create(ctx context.Context, issue domain.Issue) (domain.IssueID, error) {
+	ret := _m.Called(ctx, issue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.IssueID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Issue) (domain.IssueID, error)); ok {
+		return rf(ctx, issue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Issue) domain.IssueID); ok {
+		r0 = rf(ctx, issue)
+	} else {
+		r0 = ret.Get(0).(domain.IssueID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Issue) error); ok {
+		r1 = rf(ctx, issue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssuesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIssuesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issue domain.Issue
+func (_e *MockIssuesRepository_Expecter) Create(ctx interface{}, issue interface{}) *MockIssuesRepository_Create_Call {
+	return &MockIssuesRepository_Create_Call{Call: _e.mock.On("Create", ctx, issue)}
+}
+
+func (_c *MockIssuesRepository_Create_Call) Run(run func(ctx context.Context, issue domain.Issue)) *MockIssuesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Issue))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_Create_Call) Return(_a0 domain.IssueID, _a1 error) *MockIssuesRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssuesRepository_Create_Call) RunAndReturn(run func(context.Context, domain.Issue) (domain.IssueID, error)) *MockIssuesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, issueIDs
+func (_m *MockIssuesRepository) Delete(ctx context.Context, issueIDs []domain.IssueID) error {
+	ret := _m.Called(ctx, issueIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID) error); ok {
+		r0 = rf(ctx, issueIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssuesRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIssuesRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueIDs []domain.IssueID
+func (_e *MockIssuesRepository_Expecter) Delete(ctx interface{}, issueIDs interface{}) *MockIssuesRepository_Delete_Call {
+	return &MockIssuesRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, issueIDs)}
+}
+
+func (_c *MockIssuesRepository_Delete_Call) Run(run func(ctx context.Context, issueIDs []domain.IssueID)) *MockIssuesRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_Delete_Call) Return(_a0 error) *MockIssuesRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssuesRepository_Delete_Call) RunAndReturn(run func(context.Context, []domain.IssueID) error) *MockIssuesRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *MockIssuesRepository) GetByID(ctx context.Context, id domain.IssueID) (domain.Issue, error) {
 	ret := _m.Called(ctx, id)
@@ -314,6 +418,54 @@ func (_c *MockIssuesRepository_MarkAsNotified_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// MergeInto provides a mock function with given fields: ctx, primaryID, issueIDs
+func (_m *MockIssuesRepository) MergeInto(ctx context.Context, primaryID domain.IssueID, issueIDs []domain.IssueID) error {
+	ret := _m.Called(ctx, primaryID, issueIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeInto")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, []domain.IssueID) error); ok {
+		r0 = rf(ctx, primaryID, issueIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssuesRepository_MergeInto_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeInto'
+type MockIssuesRepository_MergeInto_Call struct {
+	*mock.Call
+}
+
+// MergeInto is a helper method to define mock.On call
+//   - ctx context.Context
+//   - primaryID domain.IssueID
+//   - issueIDs []domain.IssueID
+func (_e *MockIssuesRepository_Expecter) MergeInto(ctx interface{}, primaryID interface{}, issueIDs interface{}) *MockIssuesRepository_MergeInto_Call {
+	return &MockIssuesRepository_MergeInto_Call{Call: _e.mock.On("MergeInto", ctx, primaryID, issueIDs)}
+}
+
+func (_c *MockIssuesRepository_MergeInto_Call) Run(run func(ctx context.Context, primaryID domain.IssueID, issueIDs []domain.IssueID)) *MockIssuesRepository_MergeInto_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].([]domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_MergeInto_Call) Return(_a0 error) *MockIssuesRepository_MergeInto_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssuesRepository_MergeInto_Call) RunAndReturn(run func(context.Context, domain.IssueID, []domain.IssueID) error) *MockIssuesRepository_MergeInto_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MostFrequent provides a mock function with given fields: ctx, projectID, period, limit
 func (_m *MockIssuesRepository) MostFrequent(ctx context.Context, projectID domain.ProjectID, period time.Duration, limit uint) ([]domain.IssueExtended, error) {
 	ret := _m.Called(ctx, projectID, period, limit)
@@ -434,6 +586,102 @@ func (_c *MockIssuesRepository_RecentIssues_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// SetResolvedInRelease provides a mock function with given fields: ctx, issueID, release
+func (_m *MockIssuesRepository) SetResolvedInRelease(ctx context.Context, issueID domain.IssueID, release domain.IssueResolvedInRelease) error {
+	ret := _m.Called(ctx, issueID, release)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetResolvedInRelease")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, domain.IssueResolvedInRelease) error); ok {
+		r0 = rf(ctx, issueID, release)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssuesRepository_SetResolvedInRelease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetResolvedInRelease'
+type MockIssuesRepository_SetResolvedInRelease_Call struct {
+	*mock.Call
+}
+
+// SetResolvedInRelease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - release domain.IssueResolvedInRelease
+func (_e *MockIssuesRepository_Expecter) SetResolvedInRelease(ctx interface{}, issueID interface{}, release interface{}) *MockIssuesRepository_SetResolvedInRelease_Call {
+	return &MockIssuesRepository_SetResolvedInRelease_Call{Call: _e.mock.On("SetResolvedInRelease", ctx, issueID, release)}
+}
+
+func (_c *MockIssuesRepository_SetResolvedInRelease_Call) Run(run func(ctx context.Context, issueID domain.IssueID, release domain.IssueResolvedInRelease)) *MockIssuesRepository_SetResolvedInRelease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(domain.IssueResolvedInRelease))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_SetResolvedInRelease_Call) Return(_a0 error) *MockIssuesRepository_SetResolvedInRelease_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssuesRepository_SetResolvedInRelease_Call) RunAndReturn(run func(context.Context, domain.IssueID, domain.IssueResolvedInRelease) error) *MockIssuesRepository_SetResolvedInRelease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubtractEvents provides a mock function with given fields: ctx, issueID, count
+func (_m *MockIssuesRepository) SubtractEvents(ctx context.Context, issueID domain.IssueID, count uint) error {
+	ret := _m.Called(ctx, issueID, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubtractEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, uint) error); ok {
+		r0 = rf(ctx, issueID, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssuesRepository_SubtractEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubtractEvents'
+type MockIssuesRepository_SubtractEvents_Call struct {
+	*mock.Call
+}
+
+// SubtractEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - count uint
+func (_e *MockIssuesRepository_Expecter) SubtractEvents(ctx interface{}, issueID interface{}, count interface{}) *MockIssuesRepository_SubtractEvents_Call {
+	return &MockIssuesRepository_SubtractEvents_Call{Call: _e.mock.On("SubtractEvents", ctx, issueID, count)}
+}
+
+func (_c *MockIssuesRepository_SubtractEvents_Call) Run(run func(ctx context.Context, issueID domain.IssueID, count uint)) *MockIssuesRepository_SubtractEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_SubtractEvents_Call) Return(_a0 error) *MockIssuesRepository_SubtractEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssuesRepository_SubtractEvents_Call) RunAndReturn(run func(context.Context, domain.IssueID, uint) error) *MockIssuesRepository_SubtractEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Timeseries provides a mock function with given fields: ctx, filter
 func (_m *MockIssuesRepository) Timeseries(ctx context.Context, filter *domain.IssueTimeseriesFilter) ([]domain.Timeseries, error) {
 	ret := _m.Called(ctx, filter)
@@ -493,6 +741,103 @@ func (_c *MockIssuesRepository_Timeseries_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpdateAssignee provides a mock function with given fields: ctx, issueID, assignee
+func (_m *MockIssuesRepository) UpdateAssignee(ctx context.Context, issueID domain.IssueID, assignee domain.IssueAssignee) error {
+	ret := _m.Called(ctx, issueID, assignee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, domain.IssueAssignee) error); ok {
+		r0 = rf(ctx, issueID, assignee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssuesRepository_UpdateAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAssignee'
+type MockIssuesRepository_UpdateAssignee_Call struct {
+	*mock.Call
+}
+
+// UpdateAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - assignee domain.IssueAssignee
+func (_e *MockIssuesRepository_Expecter) UpdateAssignee(ctx interface{}, issueID interface{}, assignee interface{}) *MockIssuesRepository_UpdateAssignee_Call {
+	return &MockIssuesRepository_UpdateAssignee_Call{Call: _e.mock.On("UpdateAssignee", ctx, issueID, assignee)}
+}
+
+func (_c *MockIssuesRepository_UpdateAssignee_Call) Run(run func(ctx context.Context, issueID domain.IssueID, assignee domain.IssueAssignee)) *MockIssuesRepository_UpdateAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(domain.IssueAssignee))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_UpdateAssignee_Call) Return(_a0 error) *MockIssuesRepository_UpdateAssignee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssuesRepository_UpdateAssignee_Call) RunAndReturn(run func(context.Context, domain.IssueID, domain.IssueAssignee) error) *MockIssuesRepository_UpdateAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSeen provides a mock function with given fields: ctx, issueID, firstSeen, lastSeen
+func (_m *MockIssuesRepository) UpdateSeen(ctx context.Context, issueID domain.IssueID, firstSeen time.Time, lastSeen time.Time) error {
+	ret := _m.Called(ctx, issueID, firstSeen, lastSeen)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, issueID, firstSeen, lastSeen)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssuesRepository_UpdateSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeen'
+type MockIssuesRepository_UpdateSeen_Call struct {
+	*mock.Call
+}
+
+// UpdateSeen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+//   - firstSeen time.Time
+//   - lastSeen time.Time
+func (_e *MockIssuesRepository_Expecter) UpdateSeen(ctx interface{}, issueID interface{}, firstSeen interface{}, lastSeen interface{}) *MockIssuesRepository_UpdateSeen_Call {
+	return &MockIssuesRepository_UpdateSeen_Call{Call: _e.mock.On("UpdateSeen", ctx, issueID, firstSeen, lastSeen)}
+}
+
+func (_c *MockIssuesRepository_UpdateSeen_Call) Run(run func(ctx context.Context, issueID domain.IssueID, firstSeen time.Time, lastSeen time.Time)) *MockIssuesRepository_UpdateSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockIssuesRepository_UpdateSeen_Call) Return(_a0 error) *MockIssuesRepository_UpdateSeen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssuesRepository_UpdateSeen_Call) RunAndReturn(run func(context.Context, domain.IssueID, time.Time, time.Time) error) *MockIssuesRepository_UpdateSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatus provides a mock function with given fields: ctx, issueID, status
 func (_m *MockIssuesRepository) UpdateStatus(ctx context.Context, issueID domain.IssueID, status domain.IssueStatus) error {
 	ret := _m.Called(ctx, issueID, status)
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMonitorsRepository is an autogenerated mock type for the MonitorsRepository type
+type MockMonitorsRepository struct {
+	mock.Mock
+}
+
+type MockMonitorsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorsRepository) EXPECT() *MockMonitorsRepository_Expecter {
+	return &MockMonitorsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, id
+func (_m *MockMonitorsRepository) Delete(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.MonitorID) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorsRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMonitorsRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.MonitorID
+func (_e *MockMonitorsRepository_Expecter) Delete(ctx interface{}, projectID interface{}, id interface{}) *MockMonitorsRepository_Delete_Call {
+	return &MockMonitorsRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, projectID, id)}
+}
+
+func (_c *MockMonitorsRepository_Delete_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID)) *MockMonitorsRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.MonitorID))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_Delete_Call) Return(_a0 error) *MockMonitorsRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.MonitorID) error) *MockMonitorsRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, projectID, id
+func (_m *MockMonitorsRepository) GetByID(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) (domain.Monitor, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.Monitor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.MonitorID) (domain.Monitor, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.MonitorID) domain.Monitor); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.Monitor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.MonitorID) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockMonitorsRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.MonitorID
+func (_e *MockMonitorsRepository_Expecter) GetByID(ctx interface{}, projectID interface{}, id interface{}) *MockMonitorsRepository_GetByID_Call {
+	return &MockMonitorsRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, projectID, id)}
+}
+
+func (_c *MockMonitorsRepository_GetByID_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID)) *MockMonitorsRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.MonitorID))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_GetByID_Call) Return(_a0 domain.Monitor, _a1 error) *MockMonitorsRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_GetByID_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.MonitorID) (domain.Monitor, error)) *MockMonitorsRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockMonitorsRepository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Monitor, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProject")
+	}
+
+	var r0 []domain.Monitor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.Monitor, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.Monitor); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Monitor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_ListByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProject'
+type MockMonitorsRepository_ListByProject_Call struct {
+	*mock.Call
+}
+
+// ListByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockMonitorsRepository_Expecter) ListByProject(ctx interface{}, projectID interface{}) *MockMonitorsRepository_ListByProject_Call {
+	return &MockMonitorsRepository_ListByProject_Call{Call: _e.mock.On("ListByProject", ctx, projectID)}
+}
+
+func (_c *MockMonitorsRepository_ListByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockMonitorsRepository_ListByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListByProject_Call) Return(_a0 []domain.Monitor, _a1 error) *MockMonitorsRepository_ListByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.Monitor, error)) *MockMonitorsRepository_ListByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCheckIns provides a mock function with given fields: ctx, monitorID, limit
+func (_m *MockMonitorsRepository) ListCheckIns(ctx context.Context, monitorID domain.MonitorID, limit uint) ([]domain.MonitorCheckIn, error) {
+	ret := _m.Called(ctx, monitorID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCheckIns")
+	}
+
+	var r0 []domain.MonitorCheckIn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorID, uint) ([]domain.MonitorCheckIn, error)); ok {
+		return rf(ctx, monitorID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorID, uint) []domain.MonitorCheckIn); ok {
+		r0 = rf(ctx, monitorID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MonitorCheckIn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MonitorID, uint) error); ok {
+		r1 = rf(ctx, monitorID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_ListCheckIns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCheckIns'
+type MockMonitorsRepository_ListCheckIns_Call struct {
+	*mock.Call
+}
+
+// ListCheckIns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorID domain.MonitorID
+//   - limit uint
+func (_e *MockMonitorsRepository_Expecter) ListCheckIns(ctx interface{}, monitorID interface{}, limit interface{}) *MockMonitorsRepository_ListCheckIns_Call {
+	return &MockMonitorsRepository_ListCheckIns_Call{Call: _e.mock.On("ListCheckIns", ctx, monitorID, limit)}
+}
+
+func (_c *MockMonitorsRepository_ListCheckIns_Call) Run(run func(ctx context.Context, monitorID domain.MonitorID, limit uint)) *MockMonitorsRepository_ListCheckIns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorID), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListCheckIns_Call) Return(_a0 []domain.MonitorCheckIn, _a1 error) *MockMonitorsRepository_ListCheckIns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListCheckIns_Call) RunAndReturn(run func(context.Context, domain.MonitorID, uint) ([]domain.MonitorCheckIn, error)) *MockMonitorsRepository_ListCheckIns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorsRepository creates a new instance of MockMonitorsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorsRepository {
+	mock := &MockMonitorsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
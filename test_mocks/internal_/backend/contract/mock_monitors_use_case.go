@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMonitorsUseCase is an autogenerated mock type for the MonitorsUseCase type
+type MockMonitorsUseCase struct {
+	mock.Mock
+}
+
+type MockMonitorsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorsUseCase) EXPECT() *MockMonitorsUseCase_Expecter {
+	return &MockMonitorsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, id
+func (_m *MockMonitorsUseCase) Delete(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.MonitorID) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorsUseCase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMonitorsUseCase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.MonitorID
+func (_e *MockMonitorsUseCase_Expecter) Delete(ctx interface{}, projectID interface{}, id interface{}) *MockMonitorsUseCase_Delete_Call {
+	return &MockMonitorsUseCase_Delete_Call{Call: _e.mock.On("Delete", ctx, projectID, id)}
+}
+
+func (_c *MockMonitorsUseCase_Delete_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID)) *MockMonitorsUseCase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.MonitorID))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsUseCase_Delete_Call) Return(_a0 error) *MockMonitorsUseCase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorsUseCase_Delete_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.MonitorID) error) *MockMonitorsUseCase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithCheckIns provides a mock function with given fields: ctx, projectID, id
+func (_m *MockMonitorsUseCase) GetWithCheckIns(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID) (domain.MonitorWithCheckIns, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithCheckIns")
+	}
+
+	var r0 domain.MonitorWithCheckIns
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.MonitorID) (domain.MonitorWithCheckIns, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.MonitorID) domain.MonitorWithCheckIns); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.MonitorWithCheckIns)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.MonitorID) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsUseCase_GetWithCheckIns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithCheckIns'
+type MockMonitorsUseCase_GetWithCheckIns_Call struct {
+	*mock.Call
+}
+
+// GetWithCheckIns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.MonitorID
+func (_e *MockMonitorsUseCase_Expecter) GetWithCheckIns(ctx interface{}, projectID interface{}, id interface{}) *MockMonitorsUseCase_GetWithCheckIns_Call {
+	return &MockMonitorsUseCase_GetWithCheckIns_Call{Call: _e.mock.On("GetWithCheckIns", ctx, projectID, id)}
+}
+
+func (_c *MockMonitorsUseCase_GetWithCheckIns_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.MonitorID)) *MockMonitorsUseCase_GetWithCheckIns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.MonitorID))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsUseCase_GetWithCheckIns_Call) Return(_a0 domain.MonitorWithCheckIns, _a1 error) *MockMonitorsUseCase_GetWithCheckIns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsUseCase_GetWithCheckIns_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.MonitorID) (domain.MonitorWithCheckIns, error)) *MockMonitorsUseCase_GetWithCheckIns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, projectID
+func (_m *MockMonitorsUseCase) List(ctx context.Context, projectID domain.ProjectID) ([]domain.Monitor, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.Monitor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.Monitor, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.Monitor); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Monitor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockMonitorsUseCase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockMonitorsUseCase_Expecter) List(ctx interface{}, projectID interface{}) *MockMonitorsUseCase_List_Call {
+	return &MockMonitorsUseCase_List_Call{Call: _e.mock.On("List", ctx, projectID)}
+}
+
+func (_c *MockMonitorsUseCase_List_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockMonitorsUseCase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsUseCase_List_Call) Return(_a0 []domain.Monitor, _a1 error) *MockMonitorsUseCase_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsUseCase_List_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.Monitor, error)) *MockMonitorsUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorsUseCase creates a new instance of MockMonitorsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorsUseCase {
+	mock := &MockMonitorsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOutcomesRepository is an autogenerated mock type for the OutcomesRepository type
+type MockOutcomesRepository struct {
+	mock.Mock
+}
+
+type MockOutcomesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutcomesRepository) EXPECT() *MockOutcomesRepository_Expecter {
+	return &MockOutcomesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Stats provides a mock function with given fields: ctx, projectID, period
+func (_m *MockOutcomesRepository) Stats(ctx context.Context, projectID domain.ProjectID, period domain.Period) ([]domain.OutcomeStat, error) {
+	ret := _m.Called(ctx, projectID, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 []domain.OutcomeStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.Period) ([]domain.OutcomeStat, error)); ok {
+		return rf(ctx, projectID, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.Period) []domain.OutcomeStat); ok {
+		r0 = rf(ctx, projectID, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutcomeStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.Period) error); ok {
+		r1 = rf(ctx, projectID, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOutcomesRepository_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockOutcomesRepository_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - period domain.Period
+func (_e *MockOutcomesRepository_Expecter) Stats(ctx interface{}, projectID interface{}, period interface{}) *MockOutcomesRepository_Stats_Call {
+	return &MockOutcomesRepository_Stats_Call{Call: _e.mock.On("Stats", ctx, projectID, period)}
+}
+
+func (_c *MockOutcomesRepository_Stats_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, period domain.Period)) *MockOutcomesRepository_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.Period))
+	})
+	return _c
+}
+
+func (_c *MockOutcomesRepository_Stats_Call) Return(_a0 []domain.OutcomeStat, _a1 error) *MockOutcomesRepository_Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOutcomesRepository_Stats_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.Period) ([]domain.OutcomeStat, error)) *MockOutcomesRepository_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOutcomesRepository creates a new instance of MockOutcomesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutcomesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutcomesRepository {
+	mock := &MockOutcomesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
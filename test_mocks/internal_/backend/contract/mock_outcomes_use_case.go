@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOutcomesUseCase is an autogenerated mock type for the OutcomesUseCase type
+type MockOutcomesUseCase struct {
+	mock.Mock
+}
+
+type MockOutcomesUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutcomesUseCase) EXPECT() *MockOutcomesUseCase_Expecter {
+	return &MockOutcomesUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ProjectOutcomes provides a mock function with given fields: ctx, projectID, period
+func (_m *MockOutcomesUseCase) ProjectOutcomes(ctx context.Context, projectID domain.ProjectID, period domain.Period) (domain.ProjectOutcomes, error) {
+	ret := _m.Called(ctx, projectID, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProjectOutcomes")
+	}
+
+	var r0 domain.ProjectOutcomes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.Period) (domain.ProjectOutcomes, error)); ok {
+		return rf(ctx, projectID, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.Period) domain.ProjectOutcomes); ok {
+		r0 = rf(ctx, projectID, period)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectOutcomes)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.Period) error); ok {
+		r1 = rf(ctx, projectID, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOutcomesUseCase_ProjectOutcomes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectOutcomes'
+type MockOutcomesUseCase_ProjectOutcomes_Call struct {
+	*mock.Call
+}
+
+// ProjectOutcomes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - period domain.Period
+func (_e *MockOutcomesUseCase_Expecter) ProjectOutcomes(ctx interface{}, projectID interface{}, period interface{}) *MockOutcomesUseCase_ProjectOutcomes_Call {
+	return &MockOutcomesUseCase_ProjectOutcomes_Call{Call: _e.mock.On("ProjectOutcomes", ctx, projectID, period)}
+}
+
+func (_c *MockOutcomesUseCase_ProjectOutcomes_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, period domain.Period)) *MockOutcomesUseCase_ProjectOutcomes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.Period))
+	})
+	return _c
+}
+
+func (_c *MockOutcomesUseCase_ProjectOutcomes_Call) Return(_a0 domain.ProjectOutcomes, _a1 error) *MockOutcomesUseCase_ProjectOutcomes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOutcomesUseCase_ProjectOutcomes_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.Period) (domain.ProjectOutcomes, error)) *MockOutcomesUseCase_ProjectOutcomes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOutcomesUseCase creates a new instance of MockOutcomesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutcomesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutcomesUseCase {
+	mock := &MockOutcomesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,321 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProjectKeysRepository is an autogenerated mock type for the ProjectKeysRepository type
+type MockProjectKeysRepository struct {
+	mock.Mock
+}
+
+type MockProjectKeysRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectKeysRepository) EXPECT() *MockProjectKeysRepository_Expecter {
+	return &MockProjectKeysRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, keyDTO
+func (_m *MockProjectKeysRepository) Create(ctx context.Context, keyDTO domain.ProjectKeyDTO) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, keyDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectKeyDTO) (domain.ProjectKey, error)); ok {
+		return rf(ctx, keyDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectKeyDTO) domain.ProjectKey); ok {
+		r0 = rf(ctx, keyDTO)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectKeyDTO) error); ok {
+		r1 = rf(ctx, keyDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectKeysRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyDTO domain.ProjectKeyDTO
+func (_e *MockProjectKeysRepository_Expecter) Create(ctx interface{}, keyDTO interface{}) *MockProjectKeysRepository_Create_Call {
+	return &MockProjectKeysRepository_Create_Call{Call: _e.mock.On("Create", ctx, keyDTO)}
+}
+
+func (_c *MockProjectKeysRepository_Create_Call) Run(run func(ctx context.Context, keyDTO domain.ProjectKeyDTO)) *MockProjectKeysRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectKeyDTO))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_Create_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_Create_Call) RunAndReturn(run func(context.Context, domain.ProjectKeyDTO) (domain.ProjectKey, error)) *MockProjectKeysRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, projectID, id
+func (_m *MockProjectKeysRepository) Delete(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectKeysRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProjectKeysRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.ProjectKeyID
+func (_e *MockProjectKeysRepository_Expecter) Delete(ctx interface{}, projectID interface{}, id interface{}) *MockProjectKeysRepository_Delete_Call {
+	return &MockProjectKeysRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, projectID, id)}
+}
+
+func (_c *MockProjectKeysRepository_Delete_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID)) *MockProjectKeysRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKeyID))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_Delete_Call) Return(_a0 error) *MockProjectKeysRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKeyID) error) *MockProjectKeysRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, projectID, id
+func (_m *MockProjectKeysRepository) GetByID(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockProjectKeysRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.ProjectKeyID
+func (_e *MockProjectKeysRepository_Expecter) GetByID(ctx interface{}, projectID interface{}, id interface{}) *MockProjectKeysRepository_GetByID_Call {
+	return &MockProjectKeysRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, projectID, id)}
+}
+
+func (_c *MockProjectKeysRepository_GetByID_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID)) *MockProjectKeysRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKeyID))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_GetByID_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_GetByID_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKeyID) (domain.ProjectKey, error)) *MockProjectKeysRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectKeysRepository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProject")
+	}
+
+	var r0 []domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProjectKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysRepository_ListByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProject'
+type MockProjectKeysRepository_ListByProject_Call struct {
+	*mock.Call
+}
+
+// ListByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectKeysRepository_Expecter) ListByProject(ctx interface{}, projectID interface{}) *MockProjectKeysRepository_ListByProject_Call {
+	return &MockProjectKeysRepository_ListByProject_Call{Call: _e.mock.On("ListByProject", ctx, projectID)}
+}
+
+func (_c *MockProjectKeysRepository_ListByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectKeysRepository_ListByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_ListByProject_Call) Return(_a0 []domain.ProjectKey, _a1 error) *MockProjectKeysRepository_ListByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_ListByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.ProjectKey, error)) *MockProjectKeysRepository_ListByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, projectID, id, label, isActive, rateLimits
+func (_m *MockProjectKeysRepository) Update(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID, label string, isActive bool, rateLimits domain.RateLimits) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, id, label, isActive, rateLimits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, id, label, isActive, rateLimits)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, id, label, isActive, rateLimits)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) error); ok {
+		r1 = rf(ctx, projectID, id, label, isActive, rateLimits)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProjectKeysRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.ProjectKeyID
+//   - label string
+//   - isActive bool
+//   - rateLimits domain.RateLimits
+func (_e *MockProjectKeysRepository_Expecter) Update(ctx interface{}, projectID interface{}, id interface{}, label interface{}, isActive interface{}, rateLimits interface{}) *MockProjectKeysRepository_Update_Call {
+	return &MockProjectKeysRepository_Update_Call{Call: _e.mock.On("Update", ctx, projectID, id, label, isActive, rateLimits)}
+}
+
+func (_c *MockProjectKeysRepository_Update_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID, label string, isActive bool, rateLimits domain.RateLimits)) *MockProjectKeysRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKeyID), args[3].(string), args[4].(bool), args[5].(domain.RateLimits))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_Update_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_Update_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) (domain.ProjectKey, error)) *MockProjectKeysRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectKeysRepository creates a new instance of MockProjectKeysRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectKeysRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectKeysRepository {
+	mock := &MockProjectKeysRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
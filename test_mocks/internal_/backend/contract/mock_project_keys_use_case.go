@@ -0,0 +1,323 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProjectKeysUseCase is an autogenerated mock type for the ProjectKeysUseCase type
+type MockProjectKeysUseCase struct {
+	mock.Mock
+}
+
+type MockProjectKeysUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectKeysUseCase) EXPECT() *MockProjectKeysUseCase_Expecter {
+	return &MockProjectKeysUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, projectID, label, rateLimits
+func (_m *MockProjectKeysUseCase) Create(ctx context.Context, projectID domain.ProjectID, label string, rateLimits domain.RateLimits) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, label, rateLimits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.RateLimits) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, label, rateLimits)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.RateLimits) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, label, rateLimits)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, domain.RateLimits) error); ok {
+		r1 = rf(ctx, projectID, label, rateLimits)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysUseCase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectKeysUseCase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - label string
+//   - rateLimits domain.RateLimits
+func (_e *MockProjectKeysUseCase_Expecter) Create(ctx interface{}, projectID interface{}, label interface{}, rateLimits interface{}) *MockProjectKeysUseCase_Create_Call {
+	return &MockProjectKeysUseCase_Create_Call{Call: _e.mock.On("Create", ctx, projectID, label, rateLimits)}
+}
+
+func (_c *MockProjectKeysUseCase_Create_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, label string, rateLimits domain.RateLimits)) *MockProjectKeysUseCase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(domain.RateLimits))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Create_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysUseCase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Create_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, domain.RateLimits) (domain.ProjectKey, error)) *MockProjectKeysUseCase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectKeysUseCase) List(ctx context.Context, projectID domain.ProjectID) ([]domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProjectKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysUseCase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProjectKeysUseCase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectKeysUseCase_Expecter) List(ctx interface{}, projectID interface{}) *MockProjectKeysUseCase_List_Call {
+	return &MockProjectKeysUseCase_List_Call{Call: _e.mock.On("List", ctx, projectID)}
+}
+
+func (_c *MockProjectKeysUseCase_List_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectKeysUseCase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_List_Call) Return(_a0 []domain.ProjectKey, _a1 error) *MockProjectKeysUseCase_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_List_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.ProjectKey, error)) *MockProjectKeysUseCase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, projectID, id
+func (_m *MockProjectKeysUseCase) Revoke(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) error {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) error); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectKeysUseCase_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockProjectKeysUseCase_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.ProjectKeyID
+func (_e *MockProjectKeysUseCase_Expecter) Revoke(ctx interface{}, projectID interface{}, id interface{}) *MockProjectKeysUseCase_Revoke_Call {
+	return &MockProjectKeysUseCase_Revoke_Call{Call: _e.mock.On("Revoke", ctx, projectID, id)}
+}
+
+func (_c *MockProjectKeysUseCase_Revoke_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID)) *MockProjectKeysUseCase_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKeyID))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Revoke_Call) Return(_a0 error) *MockProjectKeysUseCase_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Revoke_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKeyID) error) *MockProjectKeysUseCase_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields: ctx, projectID, id
+func (_m *MockProjectKeysUseCase) Rotate(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, id)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.ProjectKeyID) error); ok {
+		r1 = rf(ctx, projectID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysUseCase_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockProjectKeysUseCase_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.ProjectKeyID
+func (_e *MockProjectKeysUseCase_Expecter) Rotate(ctx interface{}, projectID interface{}, id interface{}) *MockProjectKeysUseCase_Rotate_Call {
+	return &MockProjectKeysUseCase_Rotate_Call{Call: _e.mock.On("Rotate", ctx, projectID, id)}
+}
+
+func (_c *MockProjectKeysUseCase_Rotate_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID)) *MockProjectKeysUseCase_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKeyID))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Rotate_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysUseCase_Rotate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Rotate_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKeyID) (domain.ProjectKey, error)) *MockProjectKeysUseCase_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, projectID, id, label, isActive, rateLimits
+func (_m *MockProjectKeysUseCase) Update(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID, label string, isActive bool, rateLimits domain.RateLimits) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, id, label, isActive, rateLimits)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, id, label, isActive, rateLimits)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, id, label, isActive, rateLimits)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) error); ok {
+		r1 = rf(ctx, projectID, id, label, isActive, rateLimits)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysUseCase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProjectKeysUseCase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - id domain.ProjectKeyID
+//   - label string
+//   - isActive bool
+//   - rateLimits domain.RateLimits
+func (_e *MockProjectKeysUseCase_Expecter) Update(ctx interface{}, projectID interface{}, id interface{}, label interface{}, isActive interface{}, rateLimits interface{}) *MockProjectKeysUseCase_Update_Call {
+	return &MockProjectKeysUseCase_Update_Call{Call: _e.mock.On("Update", ctx, projectID, id, label, isActive, rateLimits)}
+}
+
+func (_c *MockProjectKeysUseCase_Update_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, id domain.ProjectKeyID, label string, isActive bool, rateLimits domain.RateLimits)) *MockProjectKeysUseCase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKeyID), args[3].(string), args[4].(bool), args[5].(domain.RateLimits))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Update_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysUseCase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysUseCase_Update_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKeyID, string, bool, domain.RateLimits) (domain.ProjectKey, error)) *MockProjectKeysUseCase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectKeysUseCase creates a new instance of MockProjectKeysUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectKeysUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectKeysUseCase {
+	mock := &MockProjectKeysUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockProjectSpikesRepository is an autogenerated mock type for the ProjectSpikesRepository type
+type MockProjectSpikesRepository struct {
+	mock.Mock
+}
+
+type MockProjectSpikesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSpikesRepository) EXPECT() *MockProjectSpikesRepository_Expecter {
+	return &MockProjectSpikesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListByProject provides a mock function with given fields: ctx, projectID, since
+func (_m *MockProjectSpikesRepository) ListByProject(ctx context.Context, projectID domain.ProjectID, since time.Time) ([]domain.ProjectSpike, error) {
+	ret := _m.Called(ctx, projectID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProject")
+	}
+
+	var r0 []domain.ProjectSpike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, time.Time) ([]domain.ProjectSpike, error)); ok {
+		return rf(ctx, projectID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, time.Time) []domain.ProjectSpike); ok {
+		r0 = rf(ctx, projectID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProjectSpike)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, time.Time) error); ok {
+		r1 = rf(ctx, projectID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectSpikesRepository_ListByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProject'
+type MockProjectSpikesRepository_ListByProject_Call struct {
+	*mock.Call
+}
+
+// ListByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - since time.Time
+func (_e *MockProjectSpikesRepository_Expecter) ListByProject(ctx interface{}, projectID interface{}, since interface{}) *MockProjectSpikesRepository_ListByProject_Call {
+	return &MockProjectSpikesRepository_ListByProject_Call{Call: _e.mock.On("ListByProject", ctx, projectID, since)}
+}
+
+func (_c *MockProjectSpikesRepository_ListByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, since time.Time)) *MockProjectSpikesRepository_ListByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_ListByProject_Call) Return(_a0 []domain.ProjectSpike, _a1 error) *MockProjectSpikesRepository_ListByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_ListByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID, time.Time) ([]domain.ProjectSpike, error)) *MockProjectSpikesRepository_ListByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSpikesRepository creates a new instance of MockProjectSpikesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSpikesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSpikesRepository {
+	mock := &MockProjectSpikesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
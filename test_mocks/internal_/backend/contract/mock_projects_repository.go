@@ -351,6 +351,102 @@ func (_c *MockProjectsRepository_Update_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UpdateGroupingStrategy provides a mock function with given fields: ctx, id, strategy
+func (_m *MockProjectsRepository) UpdateGroupingStrategy(ctx context.Context, id domain.ProjectID, strategy domain.GroupingStrategy) error {
+	ret := _m.Called(ctx, id, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroupingStrategy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingStrategy) error); ok {
+		r0 = rf(ctx, id, strategy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectsRepository_UpdateGroupingStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroupingStrategy'
+type MockProjectsRepository_UpdateGroupingStrategy_Call struct {
+	*mock.Call
+}
+
+// UpdateGroupingStrategy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.ProjectID
+//   - strategy domain.GroupingStrategy
+func (_e *MockProjectsRepository_Expecter) UpdateGroupingStrategy(ctx interface{}, id interface{}, strategy interface{}) *MockProjectsRepository_UpdateGroupingStrategy_Call {
+	return &MockProjectsRepository_UpdateGroupingStrategy_Call{Call: _e.mock.On("UpdateGroupingStrategy", ctx, id, strategy)}
+}
+
+func (_c *MockProjectsRepository_UpdateGroupingStrategy_Call) Run(run func(ctx context.Context, id domain.ProjectID, strategy domain.GroupingStrategy)) *MockProjectsRepository_UpdateGroupingStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingStrategy))
+	})
+	return _c
+}
+
+func (_c *MockProjectsRepository_UpdateGroupingStrategy_Call) Return(_a0 error) *MockProjectsRepository_UpdateGroupingStrategy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectsRepository_UpdateGroupingStrategy_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingStrategy) error) *MockProjectsRepository_UpdateGroupingStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePublicKey provides a mock function with given fields: ctx, id, publicKey
+func (_m *MockProjectsRepository) UpdatePublicKey(ctx context.Context, id domain.ProjectID, publicKey string) error {
+	ret := _m.Called(ctx, id, publicKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePublicKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) error); ok {
+		r0 = rf(ctx, id, publicKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectsRepository_UpdatePublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePublicKey'
+type MockProjectsRepository_UpdatePublicKey_Call struct {
+	*mock.Call
+}
+
+// UpdatePublicKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.ProjectID
+//   - publicKey string
+func (_e *MockProjectsRepository_Expecter) UpdatePublicKey(ctx interface{}, id interface{}, publicKey interface{}) *MockProjectsRepository_UpdatePublicKey_Call {
+	return &MockProjectsRepository_UpdatePublicKey_Call{Call: _e.mock.On("UpdatePublicKey", ctx, id, publicKey)}
+}
+
+func (_c *MockProjectsRepository_UpdatePublicKey_Call) Run(run func(ctx context.Context, id domain.ProjectID, publicKey string)) *MockProjectsRepository_UpdatePublicKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProjectsRepository_UpdatePublicKey_Call) Return(_a0 error) *MockProjectsRepository_UpdatePublicKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectsRepository_UpdatePublicKey_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) error) *MockProjectsRepository_UpdatePublicKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProjectsRepository creates a new instance of MockProjectsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProjectsRepository(t interface {
@@ -189,6 +189,63 @@ func (_c *MockProjectsUseCase_GeneralStats_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetGroupingStrategy provides a mock function with given fields: ctx, id
+func (_m *MockProjectsUseCase) GetGroupingStrategy(ctx context.Context, id domain.ProjectID) (domain.GroupingStrategy, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupingStrategy")
+	}
+
+	var r0 domain.GroupingStrategy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.GroupingStrategy, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.GroupingStrategy); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingStrategy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectsUseCase_GetGroupingStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupingStrategy'
+type MockProjectsUseCase_GetGroupingStrategy_Call struct {
+	*mock.Call
+}
+
+// GetGroupingStrategy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.ProjectID
+func (_e *MockProjectsUseCase_Expecter) GetGroupingStrategy(ctx interface{}, id interface{}) *MockProjectsUseCase_GetGroupingStrategy_Call {
+	return &MockProjectsUseCase_GetGroupingStrategy_Call{Call: _e.mock.On("GetGroupingStrategy", ctx, id)}
+}
+
+func (_c *MockProjectsUseCase_GetGroupingStrategy_Call) Run(run func(ctx context.Context, id domain.ProjectID)) *MockProjectsUseCase_GetGroupingStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectsUseCase_GetGroupingStrategy_Call) Return(_a0 domain.GroupingStrategy, _a1 error) *MockProjectsUseCase_GetGroupingStrategy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectsUseCase_GetGroupingStrategy_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.GroupingStrategy, error)) *MockProjectsUseCase_GetGroupingStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProjectExtended provides a mock function with given fields: ctx, id
 func (_m *MockProjectsUseCase) GetProjectExtended(ctx context.Context, id domain.ProjectID) (domain.ProjectExtended, error) {
 	ret := _m.Called(ctx, id)
@@ -422,6 +479,54 @@ func (_c *MockProjectsUseCase_RecentProjects_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateGroupingStrategy provides a mock function with given fields: ctx, id, strategy
+func (_m *MockProjectsUseCase) UpdateGroupingStrategy(ctx context.Context, id domain.ProjectID, strategy domain.GroupingStrategy) error {
+	ret := _m.Called(ctx, id, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroupingStrategy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.GroupingStrategy) error); ok {
+		r0 = rf(ctx, id, strategy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectsUseCase_UpdateGroupingStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroupingStrategy'
+type MockProjectsUseCase_UpdateGroupingStrategy_Call struct {
+	*mock.Call
+}
+
+// UpdateGroupingStrategy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.ProjectID
+//   - strategy domain.GroupingStrategy
+func (_e *MockProjectsUseCase_Expecter) UpdateGroupingStrategy(ctx interface{}, id interface{}, strategy interface{}) *MockProjectsUseCase_UpdateGroupingStrategy_Call {
+	return &MockProjectsUseCase_UpdateGroupingStrategy_Call{Call: _e.mock.On("UpdateGroupingStrategy", ctx, id, strategy)}
+}
+
+func (_c *MockProjectsUseCase_UpdateGroupingStrategy_Call) Run(run func(ctx context.Context, id domain.ProjectID, strategy domain.GroupingStrategy)) *MockProjectsUseCase_UpdateGroupingStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.GroupingStrategy))
+	})
+	return _c
+}
+
+func (_c *MockProjectsUseCase_UpdateGroupingStrategy_Call) Return(_a0 error) *MockProjectsUseCase_UpdateGroupingStrategy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectsUseCase_UpdateGroupingStrategy_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.GroupingStrategy) error) *MockProjectsUseCase_UpdateGroupingStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateInfo provides a mock function with given fields: ctx, id, name, description
 func (_m *MockProjectsUseCase) UpdateInfo(ctx context.Context, id domain.ProjectID, name string, description string) (domain.ProjectExtended, error) {
 	ret := _m.Called(ctx, id, name, description)
@@ -195,6 +195,63 @@ func (_c *MockReleaseRepository_GetByProjectAndVersion_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetLatest provides a mock function with given fields: ctx, projectID
+func (_m *MockReleaseRepository) GetLatest(ctx context.Context, projectID domain.ProjectID) (domain.Release, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatest")
+	}
+
+	var r0 domain.Release
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.Release, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.Release); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.Release)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReleaseRepository_GetLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatest'
+type MockReleaseRepository_GetLatest_Call struct {
+	*mock.Call
+}
+
+// GetLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockReleaseRepository_Expecter) GetLatest(ctx interface{}, projectID interface{}) *MockReleaseRepository_GetLatest_Call {
+	return &MockReleaseRepository_GetLatest_Call{Call: _e.mock.On("GetLatest", ctx, projectID)}
+}
+
+func (_c *MockReleaseRepository_GetLatest_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockReleaseRepository_GetLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockReleaseRepository_GetLatest_Call) Return(_a0 domain.Release, _a1 error) *MockReleaseRepository_GetLatest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReleaseRepository_GetLatest_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.Release, error)) *MockReleaseRepository_GetLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByProject provides a mock function with given fields: ctx, projectID
 func (_m *MockReleaseRepository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.Release, error) {
 	ret := _m.Called(ctx, projectID)
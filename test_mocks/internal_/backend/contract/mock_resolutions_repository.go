@@ -80,6 +80,53 @@ func (_c *MockResolutionsRepository_Create_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DeleteByIssues provides a mock function with given fields: ctx, issueIDs
+func (_m *MockResolutionsRepository) DeleteByIssues(ctx context.Context, issueIDs []domain.IssueID) error {
+	ret := _m.Called(ctx, issueIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIssues")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID) error); ok {
+		r0 = rf(ctx, issueIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockResolutionsRepository_DeleteByIssues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByIssues'
+type MockResolutionsRepository_DeleteByIssues_Call struct {
+	*mock.Call
+}
+
+// DeleteByIssues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueIDs []domain.IssueID
+func (_e *MockResolutionsRepository_Expecter) DeleteByIssues(ctx interface{}, issueIDs interface{}) *MockResolutionsRepository_DeleteByIssues_Call {
+	return &MockResolutionsRepository_DeleteByIssues_Call{Call: _e.mock.On("DeleteByIssues", ctx, issueIDs)}
+}
+
+func (_c *MockResolutionsRepository_DeleteByIssues_Call) Run(run func(ctx context.Context, issueIDs []domain.IssueID)) *MockResolutionsRepository_DeleteByIssues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockResolutionsRepository_DeleteByIssues_Call) Return(_a0 error) *MockResolutionsRepository_DeleteByIssues_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResolutionsRepository_DeleteByIssues_Call) RunAndReturn(run func(context.Context, []domain.IssueID) error) *MockResolutionsRepository_DeleteByIssues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByIssueID provides a mock function with given fields: ctx, issueID
 func (_m *MockResolutionsRepository) GetByIssueID(ctx context.Context, issueID domain.IssueID) ([]domain.Resolution, error) {
 	ret := _m.Called(ctx, issueID)
@@ -139,6 +186,54 @@ func (_c *MockResolutionsRepository_GetByIssueID_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// MoveToIssue provides a mock function with given fields: ctx, fromIssueIDs, toIssueID
+func (_m *MockResolutionsRepository) MoveToIssue(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID) error {
+	ret := _m.Called(ctx, fromIssueIDs, toIssueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToIssue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.IssueID, domain.IssueID) error); ok {
+		r0 = rf(ctx, fromIssueIDs, toIssueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockResolutionsRepository_MoveToIssue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToIssue'
+type MockResolutionsRepository_MoveToIssue_Call struct {
+	*mock.Call
+}
+
+// MoveToIssue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromIssueIDs []domain.IssueID
+//   - toIssueID domain.IssueID
+func (_e *MockResolutionsRepository_Expecter) MoveToIssue(ctx interface{}, fromIssueIDs interface{}, toIssueID interface{}) *MockResolutionsRepository_MoveToIssue_Call {
+	return &MockResolutionsRepository_MoveToIssue_Call{Call: _e.mock.On("MoveToIssue", ctx, fromIssueIDs, toIssueID)}
+}
+
+func (_c *MockResolutionsRepository_MoveToIssue_Call) Run(run func(ctx context.Context, fromIssueIDs []domain.IssueID, toIssueID domain.IssueID)) *MockResolutionsRepository_MoveToIssue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.IssueID), args[2].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockResolutionsRepository_MoveToIssue_Call) Return(_a0 error) *MockResolutionsRepository_MoveToIssue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResolutionsRepository_MoveToIssue_Call) RunAndReturn(run func(context.Context, []domain.IssueID, domain.IssueID) error) *MockResolutionsRepository_MoveToIssue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockResolutionsRepository creates a new instance of MockResolutionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockResolutionsRepository(t interface {
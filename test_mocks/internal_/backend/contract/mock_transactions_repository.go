@@ -0,0 +1,217 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransactionsRepository is an autogenerated mock type for the TransactionsRepository type
+type MockTransactionsRepository struct {
+	mock.Mock
+}
+
+type MockTransactionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransactionsRepository) EXPECT() *MockTransactionsRepository_Expecter {
+	return &MockTransactionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Stats provides a mock function with given fields: ctx, filter
+func (_m *MockTransactionsRepository) Stats(ctx context.Context, filter *domain.TransactionsFilter) ([]domain.TransactionStats, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 []domain.TransactionStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TransactionsFilter) ([]domain.TransactionStats, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TransactionsFilter) []domain.TransactionStats); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TransactionStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.TransactionsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionsRepository_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockTransactionsRepository_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.TransactionsFilter
+func (_e *MockTransactionsRepository_Expecter) Stats(ctx interface{}, filter interface{}) *MockTransactionsRepository_Stats_Call {
+	return &MockTransactionsRepository_Stats_Call{Call: _e.mock.On("Stats", ctx, filter)}
+}
+
+func (_c *MockTransactionsRepository_Stats_Call) Run(run func(ctx context.Context, filter *domain.TransactionsFilter)) *MockTransactionsRepository_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.TransactionsFilter))
+	})
+	return _c
+}
+
+func (_c *MockTransactionsRepository_Stats_Call) Return(_a0 []domain.TransactionStats, _a1 error) *MockTransactionsRepository_Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionsRepository_Stats_Call) RunAndReturn(run func(context.Context, *domain.TransactionsFilter) ([]domain.TransactionStats, error)) *MockTransactionsRepository_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TraceSpans provides a mock function with given fields: ctx, projectID, traceID
+func (_m *MockTransactionsRepository) TraceSpans(ctx context.Context, projectID domain.ProjectID, traceID string) ([]domain.Span, error) {
+	ret := _m.Called(ctx, projectID, traceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TraceSpans")
+	}
+
+	var r0 []domain.Span
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) ([]domain.Span, error)); ok {
+		return rf(ctx, projectID, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) []domain.Span); ok {
+		r0 = rf(ctx, projectID, traceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Span)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, traceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionsRepository_TraceSpans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TraceSpans'
+type MockTransactionsRepository_TraceSpans_Call struct {
+	*mock.Call
+}
+
+// TraceSpans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - traceID string
+func (_e *MockTransactionsRepository_Expecter) TraceSpans(ctx interface{}, projectID interface{}, traceID interface{}) *MockTransactionsRepository_TraceSpans_Call {
+	return &MockTransactionsRepository_TraceSpans_Call{Call: _e.mock.On("TraceSpans", ctx, projectID, traceID)}
+}
+
+func (_c *MockTransactionsRepository_TraceSpans_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, traceID string)) *MockTransactionsRepository_TraceSpans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionsRepository_TraceSpans_Call) Return(_a0 []domain.Span, _a1 error) *MockTransactionsRepository_TraceSpans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionsRepository_TraceSpans_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) ([]domain.Span, error)) *MockTransactionsRepository_TraceSpans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TraceTransactions provides a mock function with given fields: ctx, projectID, traceID
+func (_m *MockTransactionsRepository) TraceTransactions(ctx context.Context, projectID domain.ProjectID, traceID string) ([]domain.Transaction, error) {
+	ret := _m.Called(ctx, projectID, traceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TraceTransactions")
+	}
+
+	var r0 []domain.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) ([]domain.Transaction, error)); ok {
+		return rf(ctx, projectID, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) []domain.Transaction); ok {
+		r0 = rf(ctx, projectID, traceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, traceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionsRepository_TraceTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TraceTransactions'
+type MockTransactionsRepository_TraceTransactions_Call struct {
+	*mock.Call
+}
+
+// TraceTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - traceID string
+func (_e *MockTransactionsRepository_Expecter) TraceTransactions(ctx interface{}, projectID interface{}, traceID interface{}) *MockTransactionsRepository_TraceTransactions_Call {
+	return &MockTransactionsRepository_TraceTransactions_Call{Call: _e.mock.On("TraceTransactions", ctx, projectID, traceID)}
+}
+
+func (_c *MockTransactionsRepository_TraceTransactions_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, traceID string)) *MockTransactionsRepository_TraceTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionsRepository_TraceTransactions_Call) Return(_a0 []domain.Transaction, _a1 error) *MockTransactionsRepository_TraceTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionsRepository_TraceTransactions_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) ([]domain.Transaction, error)) *MockTransactionsRepository_TraceTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransactionsRepository creates a new instance of MockTransactionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransactionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransactionsRepository {
+	mock := &MockTransactionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
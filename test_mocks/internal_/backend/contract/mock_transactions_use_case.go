@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransactionsUseCase is an autogenerated mock type for the TransactionsUseCase type
+type MockTransactionsUseCase struct {
+	mock.Mock
+}
+
+type MockTransactionsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransactionsUseCase) EXPECT() *MockTransactionsUseCase_Expecter {
+	return &MockTransactionsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetTrace provides a mock function with given fields: ctx, projectID, traceID
+func (_m *MockTransactionsUseCase) GetTrace(ctx context.Context, projectID domain.ProjectID, traceID string) (domain.Trace, error) {
+	ret := _m.Called(ctx, projectID, traceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrace")
+	}
+
+	var r0 domain.Trace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (domain.Trace, error)); ok {
+		return rf(ctx, projectID, traceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) domain.Trace); ok {
+		r0 = rf(ctx, projectID, traceID)
+	} else {
+		r0 = ret.Get(0).(domain.Trace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, traceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionsUseCase_GetTrace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrace'
+type MockTransactionsUseCase_GetTrace_Call struct {
+	*mock.Call
+}
+
+// GetTrace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - traceID string
+func (_e *MockTransactionsUseCase_Expecter) GetTrace(ctx interface{}, projectID interface{}, traceID interface{}) *MockTransactionsUseCase_GetTrace_Call {
+	return &MockTransactionsUseCase_GetTrace_Call{Call: _e.mock.On("GetTrace", ctx, projectID, traceID)}
+}
+
+func (_c *MockTransactionsUseCase_GetTrace_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, traceID string)) *MockTransactionsUseCase_GetTrace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTransactionsUseCase_GetTrace_Call) Return(_a0 domain.Trace, _a1 error) *MockTransactionsUseCase_GetTrace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionsUseCase_GetTrace_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (domain.Trace, error)) *MockTransactionsUseCase_GetTrace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with given fields: ctx, filter
+func (_m *MockTransactionsUseCase) Stats(ctx context.Context, filter *domain.TransactionsFilter) ([]domain.TransactionStats, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 []domain.TransactionStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TransactionsFilter) ([]domain.TransactionStats, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.TransactionsFilter) []domain.TransactionStats); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TransactionStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.TransactionsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionsUseCase_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type MockTransactionsUseCase_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *domain.TransactionsFilter
+func (_e *MockTransactionsUseCase_Expecter) Stats(ctx interface{}, filter interface{}) *MockTransactionsUseCase_Stats_Call {
+	return &MockTransactionsUseCase_Stats_Call{Call: _e.mock.On("Stats", ctx, filter)}
+}
+
+func (_c *MockTransactionsUseCase_Stats_Call) Run(run func(ctx context.Context, filter *domain.TransactionsFilter)) *MockTransactionsUseCase_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.TransactionsFilter))
+	})
+	return _c
+}
+
+func (_c *MockTransactionsUseCase_Stats_Call) Return(_a0 []domain.TransactionStats, _a1 error) *MockTransactionsUseCase_Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionsUseCase_Stats_Call) RunAndReturn(run func(context.Context, *domain.TransactionsFilter) ([]domain.TransactionStats, error)) *MockTransactionsUseCase_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransactionsUseCase creates a new instance of MockTransactionsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransactionsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransactionsUseCase {
+	mock := &MockTransactionsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -245,6 +245,65 @@ func (_c *MockUsersRepository_FetchByIDs_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// FetchByUsernames provides a mock function with given fields: ctx, usernames
+func (_m *MockUsersRepository) FetchByUsernames(ctx context.Context, usernames []string) ([]domain.User, error) {
+	ret := _m.Called(ctx, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchByUsernames")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]domain.User, error)); ok {
+		return rf(ctx, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []domain.User); ok {
+		r0 = rf(ctx, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsersRepository_FetchByUsernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchByUsernames'
+type MockUsersRepository_FetchByUsernames_Call struct {
+	*mock.Call
+}
+
+// FetchByUsernames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usernames []string
+func (_e *MockUsersRepository_Expecter) FetchByUsernames(ctx interface{}, usernames interface{}) *MockUsersRepository_FetchByUsernames_Call {
+	return &MockUsersRepository_FetchByUsernames_Call{Call: _e.mock.On("FetchByUsernames", ctx, usernames)}
+}
+
+func (_c *MockUsersRepository_FetchByUsernames_Call) Run(run func(ctx context.Context, usernames []string)) *MockUsersRepository_FetchByUsernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_FetchByUsernames_Call) Return(_a0 []domain.User, _a1 error) *MockUsersRepository_FetchByUsernames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsersRepository_FetchByUsernames_Call) RunAndReturn(run func(context.Context, []string) ([]domain.User, error)) *MockUsersRepository_FetchByUsernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEmail provides a mock function with given fields: ctx, email
 func (_m *MockUsersRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
 	ret := _m.Called(ctx, email)
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentUseCase is an autogenerated mock type for the AttachmentUseCase type
+type MockAttachmentUseCase struct {
+	mock.Mock
+}
+
+type MockAttachmentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentUseCase) EXPECT() *MockAttachmentUseCase_Expecter {
+	return &MockAttachmentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// StoreAttachment provides a mock function with given fields: ctx, attachmentDTO, data
+func (_m *MockAttachmentUseCase) StoreAttachment(ctx context.Context, attachmentDTO domain.AttachmentDTO, data []byte) (domain.Attachment, error) {
+	ret := _m.Called(ctx, attachmentDTO, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreAttachment")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AttachmentDTO, []byte) (domain.Attachment, error)); ok {
+		return rf(ctx, attachmentDTO, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AttachmentDTO, []byte) domain.Attachment); ok {
+		r0 = rf(ctx, attachmentDTO, data)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AttachmentDTO, []byte) error); ok {
+		r1 = rf(ctx, attachmentDTO, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentUseCase_StoreAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreAttachment'
+type MockAttachmentUseCase_StoreAttachment_Call struct {
+	*mock.Call
+}
+
+// StoreAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachmentDTO domain.AttachmentDTO
+//   - data []byte
+func (_e *MockAttachmentUseCase_Expecter) StoreAttachment(ctx interface{}, attachmentDTO interface{}, data interface{}) *MockAttachmentUseCase_StoreAttachment_Call {
+	return &MockAttachmentUseCase_StoreAttachment_Call{Call: _e.mock.On("StoreAttachment", ctx, attachmentDTO, data)}
+}
+
+func (_c *MockAttachmentUseCase_StoreAttachment_Call) Run(run func(ctx context.Context, attachmentDTO domain.AttachmentDTO, data []byte)) *MockAttachmentUseCase_StoreAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AttachmentDTO), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUseCase_StoreAttachment_Call) Return(_a0 domain.Attachment, _a1 error) *MockAttachmentUseCase_StoreAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentUseCase_StoreAttachment_Call) RunAndReturn(run func(context.Context, domain.AttachmentDTO, []byte) (domain.Attachment, error)) *MockAttachmentUseCase_StoreAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentUseCase creates a new instance of MockAttachmentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentUseCase {
+	mock := &MockAttachmentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
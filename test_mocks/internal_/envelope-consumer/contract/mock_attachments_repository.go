@@ -0,0 +1,152 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentsRepository is an autogenerated mock type for the AttachmentsRepository type
+type MockAttachmentsRepository struct {
+	mock.Mock
+}
+
+type MockAttachmentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsRepository) EXPECT() *MockAttachmentsRepository_Expecter {
+	return &MockAttachmentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, attachmentDTO
+func (_m *MockAttachmentsRepository) Create(ctx context.Context, attachmentDTO domain.AttachmentDTO) (domain.Attachment, error) {
+	ret := _m.Called(ctx, attachmentDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AttachmentDTO) (domain.Attachment, error)); ok {
+		return rf(ctx, attachmentDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AttachmentDTO) domain.Attachment); ok {
+		r0 = rf(ctx, attachmentDTO)
+	} else {
+		r0 = ret.Get(0).(domain.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.AttachmentDTO) error); ok {
+		r1 = rf(ctx, attachmentDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAttachmentsRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachmentDTO domain.AttachmentDTO
+func (_e *MockAttachmentsRepository_Expecter) Create(ctx interface{}, attachmentDTO interface{}) *MockAttachmentsRepository_Create_Call {
+	return &MockAttachmentsRepository_Create_Call{Call: _e.mock.On("Create", ctx, attachmentDTO)}
+}
+
+func (_c *MockAttachmentsRepository_Create_Call) Run(run func(ctx context.Context, attachmentDTO domain.AttachmentDTO)) *MockAttachmentsRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.AttachmentDTO))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_Create_Call) Return(_a0 domain.Attachment, _a1 error) *MockAttachmentsRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_Create_Call) RunAndReturn(run func(context.Context, domain.AttachmentDTO) (domain.Attachment, error)) *MockAttachmentsRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TotalSizeByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockAttachmentsRepository) TotalSizeByProject(ctx context.Context, projectID domain.ProjectID) (uint, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalSizeByProject")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (uint, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) uint); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsRepository_TotalSizeByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalSizeByProject'
+type MockAttachmentsRepository_TotalSizeByProject_Call struct {
+	*mock.Call
+}
+
+// TotalSizeByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockAttachmentsRepository_Expecter) TotalSizeByProject(ctx interface{}, projectID interface{}) *MockAttachmentsRepository_TotalSizeByProject_Call {
+	return &MockAttachmentsRepository_TotalSizeByProject_Call{Call: _e.mock.On("TotalSizeByProject", ctx, projectID)}
+}
+
+func (_c *MockAttachmentsRepository_TotalSizeByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockAttachmentsRepository_TotalSizeByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_TotalSizeByProject_Call) Return(_a0 uint, _a1 error) *MockAttachmentsRepository_TotalSizeByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_TotalSizeByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (uint, error)) *MockAttachmentsRepository_TotalSizeByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentsRepository creates a new instance of MockAttachmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsRepository {
+	mock := &MockAttachmentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
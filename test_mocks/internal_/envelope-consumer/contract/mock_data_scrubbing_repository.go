@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDataScrubbingRepository is an autogenerated mock type for the DataScrubbingRepository type
+type MockDataScrubbingRepository struct {
+	mock.Mock
+}
+
+type MockDataScrubbingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDataScrubbingRepository) EXPECT() *MockDataScrubbingRepository_Expecter {
+	return &MockDataScrubbingRepository_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, projectID
+func (_m *MockDataScrubbingRepository) Get(ctx context.Context, projectID domain.ProjectID) (domain.DataScrubbing, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.DataScrubbing
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.DataScrubbing, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.DataScrubbing); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.DataScrubbing)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataScrubbingRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockDataScrubbingRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockDataScrubbingRepository_Expecter) Get(ctx interface{}, projectID interface{}) *MockDataScrubbingRepository_Get_Call {
+	return &MockDataScrubbingRepository_Get_Call{Call: _e.mock.On("Get", ctx, projectID)}
+}
+
+func (_c *MockDataScrubbingRepository_Get_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockDataScrubbingRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockDataScrubbingRepository_Get_Call) Return(_a0 domain.DataScrubbing, _a1 error) *MockDataScrubbingRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataScrubbingRepository_Get_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.DataScrubbing, error)) *MockDataScrubbingRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDataScrubbingRepository creates a new instance of MockDataScrubbingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDataScrubbingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDataScrubbingRepository {
+	mock := &MockDataScrubbingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDeadLetterService is an autogenerated mock type for the DeadLetterService type
+type MockDeadLetterService struct {
+	mock.Mock
+}
+
+type MockDeadLetterService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeadLetterService) EXPECT() *MockDeadLetterService_Expecter {
+	return &MockDeadLetterService_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function with given fields: ctx, source, projectID, payload, cause, attempts
+func (_m *MockDeadLetterService) Publish(ctx context.Context, source domain.DeadLetterSource, projectID domain.ProjectID, payload []byte, cause error, attempts uint) error {
+	ret := _m.Called(ctx, source, projectID, payload, cause, attempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeadLetterSource, domain.ProjectID, []byte, error, uint) error); ok {
+		r0 = rf(ctx, source, projectID, payload, cause, attempts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDeadLetterService_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockDeadLetterService_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source domain.DeadLetterSource
+//   - projectID domain.ProjectID
+//   - payload []byte
+//   - cause error
+//   - attempts uint
+func (_e *MockDeadLetterService_Expecter) Publish(ctx interface{}, source interface{}, projectID interface{}, payload interface{}, cause interface{}, attempts interface{}) *MockDeadLetterService_Publish_Call {
+	return &MockDeadLetterService_Publish_Call{Call: _e.mock.On("Publish", ctx, source, projectID, payload, cause, attempts)}
+}
+
+func (_c *MockDeadLetterService_Publish_Call) Run(run func(ctx context.Context, source domain.DeadLetterSource, projectID domain.ProjectID, payload []byte, cause error, attempts uint)) *MockDeadLetterService_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeadLetterSource), args[2].(domain.ProjectID), args[3].([]byte), args[4].(error), args[5].(uint))
+	})
+	return _c
+}
+
+func (_c *MockDeadLetterService_Publish_Call) Return(_a0 error) *MockDeadLetterService_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDeadLetterService_Publish_Call) RunAndReturn(run func(context.Context, domain.DeadLetterSource, domain.ProjectID, []byte, error, uint) error) *MockDeadLetterService_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: ctx, source, process, retryable
+func (_m *MockDeadLetterService) Retry(ctx context.Context, source domain.DeadLetterSource, process func(context.Context) error, retryable func(error) bool) (uint, error) {
+	ret := _m.Called(ctx, source, process, retryable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeadLetterSource, func(context.Context) error, func(error) bool) (uint, error)); ok {
+		return rf(ctx, source, process, retryable)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeadLetterSource, func(context.Context) error, func(error) bool) uint); ok {
+		r0 = rf(ctx, source, process, retryable)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DeadLetterSource, func(context.Context) error, func(error) bool) error); ok {
+		r1 = rf(ctx, source, process, retryable)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDeadLetterService_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type MockDeadLetterService_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source domain.DeadLetterSource
+//   - process func(context.Context) error
+//   - retryable func(error) bool
+func (_e *MockDeadLetterService_Expecter) Retry(ctx interface{}, source interface{}, process interface{}, retryable interface{}) *MockDeadLetterService_Retry_Call {
+	return &MockDeadLetterService_Retry_Call{Call: _e.mock.On("Retry", ctx, source, process, retryable)}
+}
+
+func (_c *MockDeadLetterService_Retry_Call) Run(run func(ctx context.Context, source domain.DeadLetterSource, process func(context.Context) error, retryable func(error) bool)) *MockDeadLetterService_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeadLetterSource), args[2].(func(context.Context) error), args[3].(func(error) bool))
+	})
+	return _c
+}
+
+func (_c *MockDeadLetterService_Retry_Call) Return(_a0 uint, _a1 error) *MockDeadLetterService_Retry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDeadLetterService_Retry_Call) RunAndReturn(run func(context.Context, domain.DeadLetterSource, func(context.Context) error, func(error) bool) (uint, error)) *MockDeadLetterService_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeadLetterService creates a new instance of MockDeadLetterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeadLetterService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeadLetterService {
+	mock := &MockDeadLetterService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
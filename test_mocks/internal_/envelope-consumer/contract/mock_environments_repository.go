@@ -0,0 +1,89 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockEnvironmentsRepository is an autogenerated mock type for the EnvironmentsRepository type
+type MockEnvironmentsRepository struct {
+	mock.Mock
+}
+
+type MockEnvironmentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEnvironmentsRepository) EXPECT() *MockEnvironmentsRepository_Expecter {
+	return &MockEnvironmentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Touch provides a mock function with given fields: ctx, projectID, name, seenAt
+func (_m *MockEnvironmentsRepository) Touch(ctx context.Context, projectID domain.ProjectID, name string, seenAt time.Time) error {
+	ret := _m.Called(ctx, projectID, name, seenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Touch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, time.Time) error); ok {
+		r0 = rf(ctx, projectID, name, seenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEnvironmentsRepository_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type MockEnvironmentsRepository_Touch_Call struct {
+	*mock.Call
+}
+
+// Touch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - name string
+//   - seenAt time.Time
+func (_e *MockEnvironmentsRepository_Expecter) Touch(ctx interface{}, projectID interface{}, name interface{}, seenAt interface{}) *MockEnvironmentsRepository_Touch_Call {
+	return &MockEnvironmentsRepository_Touch_Call{Call: _e.mock.On("Touch", ctx, projectID, name, seenAt)}
+}
+
+func (_c *MockEnvironmentsRepository_Touch_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, name string, seenAt time.Time)) *MockEnvironmentsRepository_Touch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEnvironmentsRepository_Touch_Call) Return(_a0 error) *MockEnvironmentsRepository_Touch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEnvironmentsRepository_Touch_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, time.Time) error) *MockEnvironmentsRepository_Touch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEnvironmentsRepository creates a new instance of MockEnvironmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEnvironmentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEnvironmentsRepository {
+	mock := &MockEnvironmentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
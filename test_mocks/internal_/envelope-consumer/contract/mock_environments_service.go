@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEnvironmentsService is an autogenerated mock type for the EnvironmentsService type
+type MockEnvironmentsService struct {
+	mock.Mock
+}
+
+type MockEnvironmentsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEnvironmentsService) EXPECT() *MockEnvironmentsService_Expecter {
+	return &MockEnvironmentsService_Expecter{mock: &_m.Mock}
+}
+
+// Touch provides a mock function with given fields: ctx, projectID, name
+func (_m *MockEnvironmentsService) Touch(ctx context.Context, projectID domain.ProjectID, name string) error {
+	ret := _m.Called(ctx, projectID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Touch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) error); ok {
+		r0 = rf(ctx, projectID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEnvironmentsService_Touch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Touch'
+type MockEnvironmentsService_Touch_Call struct {
+	*mock.Call
+}
+
+// Touch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - name string
+func (_e *MockEnvironmentsService_Expecter) Touch(ctx interface{}, projectID interface{}, name interface{}) *MockEnvironmentsService_Touch_Call {
+	return &MockEnvironmentsService_Touch_Call{Call: _e.mock.On("Touch", ctx, projectID, name)}
+}
+
+func (_c *MockEnvironmentsService_Touch_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, name string)) *MockEnvironmentsService_Touch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockEnvironmentsService_Touch_Call) Return(_a0 error) *MockEnvironmentsService_Touch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEnvironmentsService_Touch_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) error) *MockEnvironmentsService_Touch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEnvironmentsService creates a new instance of MockEnvironmentsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEnvironmentsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEnvironmentsService {
+	mock := &MockEnvironmentsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
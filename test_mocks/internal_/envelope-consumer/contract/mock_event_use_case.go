@@ -81,6 +81,53 @@ func (_c *MockEventUseCase_ProcessEvent_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// StoreEvent provides a mock function with given fields: ctx, event
+func (_m *MockEventUseCase) StoreEvent(ctx context.Context, event *domain.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEventUseCase_StoreEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreEvent'
+type MockEventUseCase_StoreEvent_Call struct {
+	*mock.Call
+}
+
+// StoreEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.Event
+func (_e *MockEventUseCase_Expecter) StoreEvent(ctx interface{}, event interface{}) *MockEventUseCase_StoreEvent_Call {
+	return &MockEventUseCase_StoreEvent_Call{Call: _e.mock.On("StoreEvent", ctx, event)}
+}
+
+func (_c *MockEventUseCase_StoreEvent_Call) Run(run func(ctx context.Context, event *domain.Event)) *MockEventUseCase_StoreEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Event))
+	})
+	return _c
+}
+
+func (_c *MockEventUseCase_StoreEvent_Call) Return(_a0 error) *MockEventUseCase_StoreEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEventUseCase_StoreEvent_Call) RunAndReturn(run func(context.Context, *domain.Event) error) *MockEventUseCase_StoreEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockEventUseCase creates a new instance of MockEventUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockEventUseCase(t interface {
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGroupingRulesRepository is an autogenerated mock type for the GroupingRulesRepository type
+type MockGroupingRulesRepository struct {
+	mock.Mock
+}
+
+type MockGroupingRulesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGroupingRulesRepository) EXPECT() *MockGroupingRulesRepository_Expecter {
+	return &MockGroupingRulesRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockGroupingRulesRepository) ListByProject(ctx context.Context, projectID domain.ProjectID) ([]domain.GroupingRule, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProject")
+	}
+
+	var r0 []domain.GroupingRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) ([]domain.GroupingRule, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) []domain.GroupingRule); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GroupingRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGroupingRulesRepository_ListByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProject'
+type MockGroupingRulesRepository_ListByProject_Call struct {
+	*mock.Call
+}
+
+// ListByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockGroupingRulesRepository_Expecter) ListByProject(ctx interface{}, projectID interface{}) *MockGroupingRulesRepository_ListByProject_Call {
+	return &MockGroupingRulesRepository_ListByProject_Call{Call: _e.mock.On("ListByProject", ctx, projectID)}
+}
+
+func (_c *MockGroupingRulesRepository_ListByProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockGroupingRulesRepository_ListByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_ListByProject_Call) Return(_a0 []domain.GroupingRule, _a1 error) *MockGroupingRulesRepository_ListByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGroupingRulesRepository_ListByProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) ([]domain.GroupingRule, error)) *MockGroupingRulesRepository_ListByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGroupingRulesRepository creates a new instance of MockGroupingRulesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGroupingRulesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGroupingRulesRepository {
+	mock := &MockGroupingRulesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
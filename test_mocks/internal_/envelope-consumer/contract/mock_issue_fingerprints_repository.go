@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueFingerprintsRepository is an autogenerated mock type for the IssueFingerprintsRepository type
+type MockIssueFingerprintsRepository struct {
+	mock.Mock
+}
+
+type MockIssueFingerprintsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueFingerprintsRepository) EXPECT() *MockIssueFingerprintsRepository_Expecter {
+	return &MockIssueFingerprintsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetIssueFingerprint provides a mock function with given fields: ctx, projectID, fingerprint
+func (_m *MockIssueFingerprintsRepository) GetIssueFingerprint(ctx context.Context, projectID domain.ProjectID, fingerprint string) (string, error) {
+	ret := _m.Called(ctx, projectID, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIssueFingerprint")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (string, error)); ok {
+		return rf(ctx, projectID, fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) string); ok {
+		r0 = rf(ctx, projectID, fingerprint)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, fingerprint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueFingerprintsRepository_GetIssueFingerprint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIssueFingerprint'
+type MockIssueFingerprintsRepository_GetIssueFingerprint_Call struct {
+	*mock.Call
+}
+
+// GetIssueFingerprint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprint string
+func (_e *MockIssueFingerprintsRepository_Expecter) GetIssueFingerprint(ctx interface{}, projectID interface{}, fingerprint interface{}) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	return &MockIssueFingerprintsRepository_GetIssueFingerprint_Call{Call: _e.mock.On("GetIssueFingerprint", ctx, projectID, fingerprint)}
+}
+
+func (_c *MockIssueFingerprintsRepository_GetIssueFingerprint_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprint string)) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_GetIssueFingerprint_Call) Return(_a0 string, _a1 error) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueFingerprintsRepository_GetIssueFingerprint_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (string, error)) *MockIssueFingerprintsRepository_GetIssueFingerprint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueFingerprintsRepository creates a new instance of MockIssueFingerprintsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueFingerprintsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueFingerprintsRepository {
+	mock := &MockIssueFingerprintsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
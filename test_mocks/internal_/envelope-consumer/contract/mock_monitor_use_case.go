@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMonitorUseCase is an autogenerated mock type for the MonitorUseCase type
+type MockMonitorUseCase struct {
+	mock.Mock
+}
+
+type MockMonitorUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorUseCase) EXPECT() *MockMonitorUseCase_Expecter {
+	return &MockMonitorUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ProcessCheckIn provides a mock function with given fields: ctx, projectID, data
+func (_m *MockMonitorUseCase) ProcessCheckIn(ctx context.Context, projectID domain.ProjectID, data map[string]interface{}) error {
+	ret := _m.Called(ctx, projectID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessCheckIn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) error); ok {
+		r0 = rf(ctx, projectID, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorUseCase_ProcessCheckIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessCheckIn'
+type MockMonitorUseCase_ProcessCheckIn_Call struct {
+	*mock.Call
+}
+
+// ProcessCheckIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - data map[string]interface{}
+func (_e *MockMonitorUseCase_Expecter) ProcessCheckIn(ctx interface{}, projectID interface{}, data interface{}) *MockMonitorUseCase_ProcessCheckIn_Call {
+	return &MockMonitorUseCase_ProcessCheckIn_Call{Call: _e.mock.On("ProcessCheckIn", ctx, projectID, data)}
+}
+
+func (_c *MockMonitorUseCase_ProcessCheckIn_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, data map[string]interface{})) *MockMonitorUseCase_ProcessCheckIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockMonitorUseCase_ProcessCheckIn_Call) Return(_a0 error) *MockMonitorUseCase_ProcessCheckIn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorUseCase_ProcessCheckIn_Call) RunAndReturn(run func(context.Context, domain.ProjectID, map[string]interface{}) error) *MockMonitorUseCase_ProcessCheckIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorUseCase creates a new instance of MockMonitorUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorUseCase {
+	mock := &MockMonitorUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
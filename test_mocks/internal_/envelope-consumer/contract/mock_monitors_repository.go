@@ -0,0 +1,262 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMonitorsRepository is an autogenerated mock type for the MonitorsRepository type
+type MockMonitorsRepository struct {
+	mock.Mock
+}
+
+type MockMonitorsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorsRepository) EXPECT() *MockMonitorsRepository_Expecter {
+	return &MockMonitorsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetBySlug provides a mock function with given fields: ctx, projectID, slug
+func (_m *MockMonitorsRepository) GetBySlug(ctx context.Context, projectID domain.ProjectID, slug string) (domain.Monitor, error) {
+	ret := _m.Called(ctx, projectID, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 domain.Monitor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (domain.Monitor, error)); ok {
+		return rf(ctx, projectID, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) domain.Monitor); ok {
+		r0 = rf(ctx, projectID, slug)
+	} else {
+		r0 = ret.Get(0).(domain.Monitor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_GetBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySlug'
+type MockMonitorsRepository_GetBySlug_Call struct {
+	*mock.Call
+}
+
+// GetBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - slug string
+func (_e *MockMonitorsRepository_Expecter) GetBySlug(ctx interface{}, projectID interface{}, slug interface{}) *MockMonitorsRepository_GetBySlug_Call {
+	return &MockMonitorsRepository_GetBySlug_Call{Call: _e.mock.On("GetBySlug", ctx, projectID, slug)}
+}
+
+func (_c *MockMonitorsRepository_GetBySlug_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, slug string)) *MockMonitorsRepository_GetBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_GetBySlug_Call) Return(_a0 domain.Monitor, _a1 error) *MockMonitorsRepository_GetBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_GetBySlug_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (domain.Monitor, error)) *MockMonitorsRepository_GetBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateState provides a mock function with given fields: ctx, id, status, lastCheckInAt, nextCheckInAt
+func (_m *MockMonitorsRepository) UpdateState(ctx context.Context, id domain.MonitorID, status domain.MonitorStatus, lastCheckInAt *time.Time, nextCheckInAt time.Time) error {
+	ret := _m.Called(ctx, id, status, lastCheckInAt, nextCheckInAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorID, domain.MonitorStatus, *time.Time, time.Time) error); ok {
+		r0 = rf(ctx, id, status, lastCheckInAt, nextCheckInAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorsRepository_UpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateState'
+type MockMonitorsRepository_UpdateState_Call struct {
+	*mock.Call
+}
+
+// UpdateState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.MonitorID
+//   - status domain.MonitorStatus
+//   - lastCheckInAt *time.Time
+//   - nextCheckInAt time.Time
+func (_e *MockMonitorsRepository_Expecter) UpdateState(ctx interface{}, id interface{}, status interface{}, lastCheckInAt interface{}, nextCheckInAt interface{}) *MockMonitorsRepository_UpdateState_Call {
+	return &MockMonitorsRepository_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, id, status, lastCheckInAt, nextCheckInAt)}
+}
+
+func (_c *MockMonitorsRepository_UpdateState_Call) Run(run func(ctx context.Context, id domain.MonitorID, status domain.MonitorStatus, lastCheckInAt *time.Time, nextCheckInAt time.Time)) *MockMonitorsRepository_UpdateState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorID), args[2].(domain.MonitorStatus), args[3].(*time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpdateState_Call) Return(_a0 error) *MockMonitorsRepository_UpdateState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpdateState_Call) RunAndReturn(run func(context.Context, domain.MonitorID, domain.MonitorStatus, *time.Time, time.Time) error) *MockMonitorsRepository_UpdateState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, monitorDTO
+func (_m *MockMonitorsRepository) Upsert(ctx context.Context, monitorDTO domain.MonitorDTO) (domain.Monitor, error) {
+	ret := _m.Called(ctx, monitorDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 domain.Monitor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorDTO) (domain.Monitor, error)); ok {
+		return rf(ctx, monitorDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorDTO) domain.Monitor); ok {
+		r0 = rf(ctx, monitorDTO)
+	} else {
+		r0 = ret.Get(0).(domain.Monitor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MonitorDTO) error); ok {
+		r1 = rf(ctx, monitorDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockMonitorsRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - monitorDTO domain.MonitorDTO
+func (_e *MockMonitorsRepository_Expecter) Upsert(ctx interface{}, monitorDTO interface{}) *MockMonitorsRepository_Upsert_Call {
+	return &MockMonitorsRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, monitorDTO)}
+}
+
+func (_c *MockMonitorsRepository_Upsert_Call) Run(run func(ctx context.Context, monitorDTO domain.MonitorDTO)) *MockMonitorsRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorDTO))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_Upsert_Call) Return(_a0 domain.Monitor, _a1 error) *MockMonitorsRepository_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_Upsert_Call) RunAndReturn(run func(context.Context, domain.MonitorDTO) (domain.Monitor, error)) *MockMonitorsRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertCheckIn provides a mock function with given fields: ctx, checkInDTO
+func (_m *MockMonitorsRepository) UpsertCheckIn(ctx context.Context, checkInDTO domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error) {
+	ret := _m.Called(ctx, checkInDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCheckIn")
+	}
+
+	var r0 domain.MonitorCheckIn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error)); ok {
+		return rf(ctx, checkInDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorCheckInDTO) domain.MonitorCheckIn); ok {
+		r0 = rf(ctx, checkInDTO)
+	} else {
+		r0 = ret.Get(0).(domain.MonitorCheckIn)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MonitorCheckInDTO) error); ok {
+		r1 = rf(ctx, checkInDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_UpsertCheckIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCheckIn'
+type MockMonitorsRepository_UpsertCheckIn_Call struct {
+	*mock.Call
+}
+
+// UpsertCheckIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - checkInDTO domain.MonitorCheckInDTO
+func (_e *MockMonitorsRepository_Expecter) UpsertCheckIn(ctx interface{}, checkInDTO interface{}) *MockMonitorsRepository_UpsertCheckIn_Call {
+	return &MockMonitorsRepository_UpsertCheckIn_Call{Call: _e.mock.On("UpsertCheckIn", ctx, checkInDTO)}
+}
+
+func (_c *MockMonitorsRepository_UpsertCheckIn_Call) Run(run func(ctx context.Context, checkInDTO domain.MonitorCheckInDTO)) *MockMonitorsRepository_UpsertCheckIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorCheckInDTO))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpsertCheckIn_Call) Return(_a0 domain.MonitorCheckIn, _a1 error) *MockMonitorsRepository_UpsertCheckIn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpsertCheckIn_Call) RunAndReturn(run func(context.Context, domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error)) *MockMonitorsRepository_UpsertCheckIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorsRepository creates a new instance of MockMonitorsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorsRepository {
+	mock := &MockMonitorsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,17 +23,17 @@ func (_m *MockNotificationsQueueRepository) EXPECT() *MockNotificationsQueueRepo
 	return &MockNotificationsQueueRepository_Expecter{mock: &_m.Mock}
 }
 
-// AddNotification provides a mock function with given fields: ctx, projectID, issueID, level, isNew, wasReactivated
-func (_m *MockNotificationsQueueRepository) AddNotification(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, level domain.IssueLevel, isNew bool, wasReactivated bool) error {
-	ret := _m.Called(ctx, projectID, issueID, level, isNew, wasReactivated)
+// AddNotification provides a mock function with given fields: ctx, projectID, issueID, level, isNew, wasReactivated, environment
+func (_m *MockNotificationsQueueRepository) AddNotification(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, level domain.IssueLevel, isNew bool, wasReactivated bool, environment string) error {
+	ret := _m.Called(ctx, projectID, issueID, level, isNew, wasReactivated, environment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddNotification")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, domain.IssueLevel, bool, bool) error); ok {
-		r0 = rf(ctx, projectID, issueID, level, isNew, wasReactivated)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.IssueID, domain.IssueLevel, bool, bool, string) error); ok {
+		r0 = rf(ctx, projectID, issueID, level, isNew, wasReactivated, environment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -53,13 +53,14 @@ type MockNotificationsQueueRepository_AddNotification_Call struct {
 //   - level domain.IssueLevel
 //   - isNew bool
 //   - wasReactivated bool
-func (_e *MockNotificationsQueueRepository_Expecter) AddNotification(ctx interface{}, projectID interface{}, issueID interface{}, level interface{}, isNew interface{}, wasReactivated interface{}) *MockNotificationsQueueRepository_AddNotification_Call {
-	return &MockNotificationsQueueRepository_AddNotification_Call{Call: _e.mock.On("AddNotification", ctx, projectID, issueID, level, isNew, wasReactivated)}
+//   - environment string
+func (_e *MockNotificationsQueueRepository_Expecter) AddNotification(ctx interface{}, projectID interface{}, issueID interface{}, level interface{}, isNew interface{}, wasReactivated interface{}, environment interface{}) *MockNotificationsQueueRepository_AddNotification_Call {
+	return &MockNotificationsQueueRepository_AddNotification_Call{Call: _e.mock.On("AddNotification", ctx, projectID, issueID, level, isNew, wasReactivated, environment)}
 }
 
-func (_c *MockNotificationsQueueRepository_AddNotification_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, level domain.IssueLevel, isNew bool, wasReactivated bool)) *MockNotificationsQueueRepository_AddNotification_Call {
+func (_c *MockNotificationsQueueRepository_AddNotification_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, issueID domain.IssueID, level domain.IssueLevel, isNew bool, wasReactivated bool, environment string)) *MockNotificationsQueueRepository_AddNotification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID), args[3].(domain.IssueLevel), args[4].(bool), args[5].(bool))
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.IssueID), args[3].(domain.IssueLevel), args[4].(bool), args[5].(bool), args[6].(string))
 	})
 	return _c
 }
@@ -69,7 +70,7 @@ func (_c *MockNotificationsQueueRepository_AddNotification_Call) Return(_a0 erro
 	return _c
 }
 
-func (_c *MockNotificationsQueueRepository_AddNotification_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID, domain.IssueLevel, bool, bool) error) *MockNotificationsQueueRepository_AddNotification_Call {
+func (_c *MockNotificationsQueueRepository_AddNotification_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.IssueID, domain.IssueLevel, bool, bool, string) error) *MockNotificationsQueueRepository_AddNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,72 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	domain "github.com/rom8726/warden/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOutcomeRecorder is an autogenerated mock type for the OutcomeRecorder type
+type MockOutcomeRecorder struct {
+	mock.Mock
+}
+
+type MockOutcomeRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutcomeRecorder) EXPECT() *MockOutcomeRecorder_Expecter {
+	return &MockOutcomeRecorder_Expecter{mock: &_m.Mock}
+}
+
+// Record provides a mock function with given fields: projectID, outcome, reason, category, quantity
+func (_m *MockOutcomeRecorder) Record(projectID domain.ProjectID, outcome domain.Outcome, reason string, category domain.DataCategory, quantity uint) {
+	_m.Called(projectID, outcome, reason, category, quantity)
+}
+
+// MockOutcomeRecorder_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockOutcomeRecorder_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - projectID domain.ProjectID
+//   - outcome domain.Outcome
+//   - reason string
+//   - category domain.DataCategory
+//   - quantity uint
+func (_e *MockOutcomeRecorder_Expecter) Record(projectID interface{}, outcome interface{}, reason interface{}, category interface{}, quantity interface{}) *MockOutcomeRecorder_Record_Call {
+	return &MockOutcomeRecorder_Record_Call{Call: _e.mock.On("Record", projectID, outcome, reason, category, quantity)}
+}
+
+func (_c *MockOutcomeRecorder_Record_Call) Run(run func(projectID domain.ProjectID, outcome domain.Outcome, reason string, category domain.DataCategory, quantity uint)) *MockOutcomeRecorder_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.ProjectID), args[1].(domain.Outcome), args[2].(string), args[3].(domain.DataCategory), args[4].(uint))
+	})
+	return _c
+}
+
+func (_c *MockOutcomeRecorder_Record_Call) Return() *MockOutcomeRecorder_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockOutcomeRecorder_Record_Call) RunAndReturn(run func(domain.ProjectID, domain.Outcome, string, domain.DataCategory, uint)) *MockOutcomeRecorder_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockOutcomeRecorder creates a new instance of MockOutcomeRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutcomeRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutcomeRecorder {
+	mock := &MockOutcomeRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
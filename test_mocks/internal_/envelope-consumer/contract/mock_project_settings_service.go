@@ -0,0 +1,213 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	datascrubbing "github.com/rom8726/warden/internal/common/datascrubbing"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProjectSettingsService is an autogenerated mock type for the ProjectSettingsService type
+type MockProjectSettingsService struct {
+	mock.Mock
+}
+
+type MockProjectSettingsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSettingsService) EXPECT() *MockProjectSettingsService_Expecter {
+	return &MockProjectSettingsService_Expecter{mock: &_m.Mock}
+}
+
+// DataScrubber provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectSettingsService) DataScrubber(ctx context.Context, projectID domain.ProjectID) (*datascrubbing.Scrubber, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DataScrubber")
+	}
+
+	var r0 *datascrubbing.Scrubber
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (*datascrubbing.Scrubber, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) *datascrubbing.Scrubber); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datascrubbing.Scrubber)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectSettingsService_DataScrubber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DataScrubber'
+type MockProjectSettingsService_DataScrubber_Call struct {
+	*mock.Call
+}
+
+// DataScrubber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectSettingsService_Expecter) DataScrubber(ctx interface{}, projectID interface{}) *MockProjectSettingsService_DataScrubber_Call {
+	return &MockProjectSettingsService_DataScrubber_Call{Call: _e.mock.On("DataScrubber", ctx, projectID)}
+}
+
+func (_c *MockProjectSettingsService_DataScrubber_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectSettingsService_DataScrubber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectSettingsService_DataScrubber_Call) Return(_a0 *datascrubbing.Scrubber, _a1 error) *MockProjectSettingsService_DataScrubber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectSettingsService_DataScrubber_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (*datascrubbing.Scrubber, error)) *MockProjectSettingsService_DataScrubber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProject provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectSettingsService) GetProject(ctx context.Context, projectID domain.ProjectID) (domain.Project, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 domain.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.Project, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.Project); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectSettingsService_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type MockProjectSettingsService_GetProject_Call struct {
+	*mock.Call
+}
+
+// GetProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectSettingsService_Expecter) GetProject(ctx interface{}, projectID interface{}) *MockProjectSettingsService_GetProject_Call {
+	return &MockProjectSettingsService_GetProject_Call{Call: _e.mock.On("GetProject", ctx, projectID)}
+}
+
+func (_c *MockProjectSettingsService_GetProject_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectSettingsService_GetProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectSettingsService_GetProject_Call) Return(_a0 domain.Project, _a1 error) *MockProjectSettingsService_GetProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectSettingsService_GetProject_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.Project, error)) *MockProjectSettingsService_GetProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupingConfig provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectSettingsService) GroupingConfig(ctx context.Context, projectID domain.ProjectID) (domain.GroupingConfig, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupingConfig")
+	}
+
+	var r0 domain.GroupingConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.GroupingConfig, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.GroupingConfig); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.GroupingConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectSettingsService_GroupingConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupingConfig'
+type MockProjectSettingsService_GroupingConfig_Call struct {
+	*mock.Call
+}
+
+// GroupingConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectSettingsService_Expecter) GroupingConfig(ctx interface{}, projectID interface{}) *MockProjectSettingsService_GroupingConfig_Call {
+	return &MockProjectSettingsService_GroupingConfig_Call{Call: _e.mock.On("GroupingConfig", ctx, projectID)}
+}
+
+func (_c *MockProjectSettingsService_GroupingConfig_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectSettingsService_GroupingConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectSettingsService_GroupingConfig_Call) Return(_a0 domain.GroupingConfig, _a1 error) *MockProjectSettingsService_GroupingConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectSettingsService_GroupingConfig_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.GroupingConfig, error)) *MockProjectSettingsService_GroupingConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSettingsService creates a new instance of MockProjectSettingsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSettingsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSettingsService {
+	mock := &MockProjectSettingsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
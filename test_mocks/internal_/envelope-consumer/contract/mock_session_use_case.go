@@ -0,0 +1,134 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSessionUseCase is an autogenerated mock type for the SessionUseCase type
+type MockSessionUseCase struct {
+	mock.Mock
+}
+
+type MockSessionUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionUseCase) EXPECT() *MockSessionUseCase_Expecter {
+	return &MockSessionUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ProcessSession provides a mock function with given fields: ctx, projectID, data
+func (_m *MockSessionUseCase) ProcessSession(ctx context.Context, projectID domain.ProjectID, data map[string]interface{}) error {
+	ret := _m.Called(ctx, projectID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) error); ok {
+		r0 = rf(ctx, projectID, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionUseCase_ProcessSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessSession'
+type MockSessionUseCase_ProcessSession_Call struct {
+	*mock.Call
+}
+
+// ProcessSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - data map[string]interface{}
+func (_e *MockSessionUseCase_Expecter) ProcessSession(ctx interface{}, projectID interface{}, data interface{}) *MockSessionUseCase_ProcessSession_Call {
+	return &MockSessionUseCase_ProcessSession_Call{Call: _e.mock.On("ProcessSession", ctx, projectID, data)}
+}
+
+func (_c *MockSessionUseCase_ProcessSession_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, data map[string]interface{})) *MockSessionUseCase_ProcessSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockSessionUseCase_ProcessSession_Call) Return(_a0 error) *MockSessionUseCase_ProcessSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionUseCase_ProcessSession_Call) RunAndReturn(run func(context.Context, domain.ProjectID, map[string]interface{}) error) *MockSessionUseCase_ProcessSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessSessionAggregates provides a mock function with given fields: ctx, projectID, data
+func (_m *MockSessionUseCase) ProcessSessionAggregates(ctx context.Context, projectID domain.ProjectID, data map[string]interface{}) error {
+	ret := _m.Called(ctx, projectID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessSessionAggregates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) error); ok {
+		r0 = rf(ctx, projectID, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionUseCase_ProcessSessionAggregates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessSessionAggregates'
+type MockSessionUseCase_ProcessSessionAggregates_Call struct {
+	*mock.Call
+}
+
+// ProcessSessionAggregates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - data map[string]interface{}
+func (_e *MockSessionUseCase_Expecter) ProcessSessionAggregates(ctx interface{}, projectID interface{}, data interface{}) *MockSessionUseCase_ProcessSessionAggregates_Call {
+	return &MockSessionUseCase_ProcessSessionAggregates_Call{Call: _e.mock.On("ProcessSessionAggregates", ctx, projectID, data)}
+}
+
+func (_c *MockSessionUseCase_ProcessSessionAggregates_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, data map[string]interface{})) *MockSessionUseCase_ProcessSessionAggregates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockSessionUseCase_ProcessSessionAggregates_Call) Return(_a0 error) *MockSessionUseCase_ProcessSessionAggregates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionUseCase_ProcessSessionAggregates_Call) RunAndReturn(run func(context.Context, domain.ProjectID, map[string]interface{}) error) *MockSessionUseCase_ProcessSessionAggregates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionUseCase creates a new instance of MockSessionUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionUseCase {
+	mock := &MockSessionUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSessionsRepository is an autogenerated mock type for the SessionsRepository type
+type MockSessionsRepository struct {
+	mock.Mock
+}
+
+type MockSessionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionsRepository) EXPECT() *MockSessionsRepository_Expecter {
+	return &MockSessionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Store provides a mock function with given fields: ctx, updates
+func (_m *MockSessionsRepository) Store(ctx context.Context, updates []domain.SessionUpdate) error {
+	ret := _m.Called(ctx, updates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.SessionUpdate) error); ok {
+		r0 = rf(ctx, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionsRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MockSessionsRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - updates []domain.SessionUpdate
+func (_e *MockSessionsRepository_Expecter) Store(ctx interface{}, updates interface{}) *MockSessionsRepository_Store_Call {
+	return &MockSessionsRepository_Store_Call{Call: _e.mock.On("Store", ctx, updates)}
+}
+
+func (_c *MockSessionsRepository_Store_Call) Run(run func(ctx context.Context, updates []domain.SessionUpdate)) *MockSessionsRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.SessionUpdate))
+	})
+	return _c
+}
+
+func (_c *MockSessionsRepository_Store_Call) Return(_a0 error) *MockSessionsRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionsRepository_Store_Call) RunAndReturn(run func(context.Context, []domain.SessionUpdate) error) *MockSessionsRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionsRepository creates a new instance of MockSessionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionsRepository {
+	mock := &MockSessionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransactionUseCase is an autogenerated mock type for the TransactionUseCase type
+type MockTransactionUseCase struct {
+	mock.Mock
+}
+
+type MockTransactionUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransactionUseCase) EXPECT() *MockTransactionUseCase_Expecter {
+	return &MockTransactionUseCase_Expecter{mock: &_m.Mock}
+}
+
+// ProcessTransaction provides a mock function with given fields: ctx, projectID, data
+func (_m *MockTransactionUseCase) ProcessTransaction(ctx context.Context, projectID domain.ProjectID, data map[string]interface{}) (domain.EventID, error) {
+	ret := _m.Called(ctx, projectID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessTransaction")
+	}
+
+	var r0 domain.EventID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) (domain.EventID, error)); ok {
+		return rf(ctx, projectID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) domain.EventID); ok {
+		r0 = rf(ctx, projectID, data)
+	} else {
+		r0 = ret.Get(0).(domain.EventID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, map[string]interface{}) error); ok {
+		r1 = rf(ctx, projectID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTransactionUseCase_ProcessTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessTransaction'
+type MockTransactionUseCase_ProcessTransaction_Call struct {
+	*mock.Call
+}
+
+// ProcessTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - data map[string]interface{}
+func (_e *MockTransactionUseCase_Expecter) ProcessTransaction(ctx interface{}, projectID interface{}, data interface{}) *MockTransactionUseCase_ProcessTransaction_Call {
+	return &MockTransactionUseCase_ProcessTransaction_Call{Call: _e.mock.On("ProcessTransaction", ctx, projectID, data)}
+}
+
+func (_c *MockTransactionUseCase_ProcessTransaction_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, data map[string]interface{})) *MockTransactionUseCase_ProcessTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockTransactionUseCase_ProcessTransaction_Call) Return(_a0 domain.EventID, _a1 error) *MockTransactionUseCase_ProcessTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTransactionUseCase_ProcessTransaction_Call) RunAndReturn(run func(context.Context, domain.ProjectID, map[string]interface{}) (domain.EventID, error)) *MockTransactionUseCase_ProcessTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransactionUseCase creates a new instance of MockTransactionUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransactionUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransactionUseCase {
+	mock := &MockTransactionUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
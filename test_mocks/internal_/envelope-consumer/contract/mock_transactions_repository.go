@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransactionsRepository is an autogenerated mock type for the TransactionsRepository type
+type MockTransactionsRepository struct {
+	mock.Mock
+}
+
+type MockTransactionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTransactionsRepository) EXPECT() *MockTransactionsRepository_Expecter {
+	return &MockTransactionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Store provides a mock function with given fields: ctx, transaction
+func (_m *MockTransactionsRepository) Store(ctx context.Context, transaction *domain.Transaction) error {
+	ret := _m.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Store")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Transaction) error); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTransactionsRepository_Store_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Store'
+type MockTransactionsRepository_Store_Call struct {
+	*mock.Call
+}
+
+// Store is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction *domain.Transaction
+func (_e *MockTransactionsRepository_Expecter) Store(ctx interface{}, transaction interface{}) *MockTransactionsRepository_Store_Call {
+	return &MockTransactionsRepository_Store_Call{Call: _e.mock.On("Store", ctx, transaction)}
+}
+
+func (_c *MockTransactionsRepository_Store_Call) Run(run func(ctx context.Context, transaction *domain.Transaction)) *MockTransactionsRepository_Store_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Transaction))
+	})
+	return _c
+}
+
+func (_c *MockTransactionsRepository_Store_Call) Return(_a0 error) *MockTransactionsRepository_Store_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTransactionsRepository_Store_Call) RunAndReturn(run func(context.Context, *domain.Transaction) error) *MockTransactionsRepository_Store_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTransactionsRepository creates a new instance of MockTransactionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransactionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransactionsRepository {
+	mock := &MockTransactionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
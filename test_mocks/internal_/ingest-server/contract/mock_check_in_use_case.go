@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCheckInUseCase is an autogenerated mock type for the CheckInUseCase type
+type MockCheckInUseCase struct {
+	mock.Mock
+}
+
+type MockCheckInUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCheckInUseCase) EXPECT() *MockCheckInUseCase_Expecter {
+	return &MockCheckInUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CheckIn provides a mock function with given fields: ctx, projectID, data
+func (_m *MockCheckInUseCase) CheckIn(ctx context.Context, projectID domain.ProjectID, data map[string]interface{}) (string, error) {
+	ret := _m.Called(ctx, projectID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIn")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) (string, error)); ok {
+		return rf(ctx, projectID, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, map[string]interface{}) string); ok {
+		r0 = rf(ctx, projectID, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, map[string]interface{}) error); ok {
+		r1 = rf(ctx, projectID, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCheckInUseCase_CheckIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIn'
+type MockCheckInUseCase_CheckIn_Call struct {
+	*mock.Call
+}
+
+// CheckIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - data map[string]interface{}
+func (_e *MockCheckInUseCase_Expecter) CheckIn(ctx interface{}, projectID interface{}, data interface{}) *MockCheckInUseCase_CheckIn_Call {
+	return &MockCheckInUseCase_CheckIn_Call{Call: _e.mock.On("CheckIn", ctx, projectID, data)}
+}
+
+func (_c *MockCheckInUseCase_CheckIn_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, data map[string]interface{})) *MockCheckInUseCase_CheckIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockCheckInUseCase_CheckIn_Call) Return(_a0 string, _a1 error) *MockCheckInUseCase_CheckIn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCheckInUseCase_CheckIn_Call) RunAndReturn(run func(context.Context, domain.ProjectID, map[string]interface{}) (string, error)) *MockCheckInUseCase_CheckIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCheckInUseCase creates a new instance of MockCheckInUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCheckInUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCheckInUseCase {
+	mock := &MockCheckInUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
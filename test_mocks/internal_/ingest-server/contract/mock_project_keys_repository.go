@@ -0,0 +1,146 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockProjectKeysRepository is an autogenerated mock type for the ProjectKeysRepository type
+type MockProjectKeysRepository struct {
+	mock.Mock
+}
+
+type MockProjectKeysRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectKeysRepository) EXPECT() *MockProjectKeysRepository_Expecter {
+	return &MockProjectKeysRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetActive provides a mock function with given fields: ctx, projectID, publicKey
+func (_m *MockProjectKeysRepository) GetActive(ctx context.Context, projectID domain.ProjectID, publicKey string) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, publicKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActive")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, publicKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, publicKey)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
+		r1 = rf(ctx, projectID, publicKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectKeysRepository_GetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActive'
+type MockProjectKeysRepository_GetActive_Call struct {
+	*mock.Call
+}
+
+// GetActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - publicKey string
+func (_e *MockProjectKeysRepository_Expecter) GetActive(ctx interface{}, projectID interface{}, publicKey interface{}) *MockProjectKeysRepository_GetActive_Call {
+	return &MockProjectKeysRepository_GetActive_Call{Call: _e.mock.On("GetActive", ctx, projectID, publicKey)}
+}
+
+func (_c *MockProjectKeysRepository_GetActive_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, publicKey string)) *MockProjectKeysRepository_GetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_GetActive_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectKeysRepository_GetActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_GetActive_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (domain.ProjectKey, error)) *MockProjectKeysRepository_GetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchLastUsed provides a mock function with given fields: ctx, id, usedAt
+func (_m *MockProjectKeysRepository) TouchLastUsed(ctx context.Context, id domain.ProjectKeyID, usedAt time.Time) error {
+	ret := _m.Called(ctx, id, usedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectKeyID, time.Time) error); ok {
+		r0 = rf(ctx, id, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectKeysRepository_TouchLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchLastUsed'
+type MockProjectKeysRepository_TouchLastUsed_Call struct {
+	*mock.Call
+}
+
+// TouchLastUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.ProjectKeyID
+//   - usedAt time.Time
+func (_e *MockProjectKeysRepository_Expecter) TouchLastUsed(ctx interface{}, id interface{}, usedAt interface{}) *MockProjectKeysRepository_TouchLastUsed_Call {
+	return &MockProjectKeysRepository_TouchLastUsed_Call{Call: _e.mock.On("TouchLastUsed", ctx, id, usedAt)}
+}
+
+func (_c *MockProjectKeysRepository_TouchLastUsed_Call) Run(run func(ctx context.Context, id domain.ProjectKeyID, usedAt time.Time)) *MockProjectKeysRepository_TouchLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectKeyID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_TouchLastUsed_Call) Return(_a0 error) *MockProjectKeysRepository_TouchLastUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectKeysRepository_TouchLastUsed_Call) RunAndReturn(run func(context.Context, domain.ProjectKeyID, time.Time) error) *MockProjectKeysRepository_TouchLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectKeysRepository creates a new instance of MockProjectKeysRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectKeysRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectKeysRepository {
+	mock := &MockProjectKeysRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProjectRateLimitsRepository is an autogenerated mock type for the ProjectRateLimitsRepository type
+type MockProjectRateLimitsRepository struct {
+	mock.Mock
+}
+
+type MockProjectRateLimitsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectRateLimitsRepository) EXPECT() *MockProjectRateLimitsRepository_Expecter {
+	return &MockProjectRateLimitsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectRateLimitsRepository) Get(ctx context.Context, projectID domain.ProjectID) (domain.ProjectRateLimits, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.ProjectRateLimits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.ProjectRateLimits, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.ProjectRateLimits); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectRateLimits)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRateLimitsRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockProjectRateLimitsRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectRateLimitsRepository_Expecter) Get(ctx interface{}, projectID interface{}) *MockProjectRateLimitsRepository_Get_Call {
+	return &MockProjectRateLimitsRepository_Get_Call{Call: _e.mock.On("Get", ctx, projectID)}
+}
+
+func (_c *MockProjectRateLimitsRepository_Get_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectRateLimitsRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectRateLimitsRepository_Get_Call) Return(_a0 domain.ProjectRateLimits, _a1 error) *MockProjectRateLimitsRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRateLimitsRepository_Get_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.ProjectRateLimits, error)) *MockProjectRateLimitsRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectRateLimitsRepository creates a new instance of MockProjectRateLimitsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectRateLimitsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectRateLimitsRepository {
+	mock := &MockProjectRateLimitsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
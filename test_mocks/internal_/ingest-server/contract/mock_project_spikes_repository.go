@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockProjectSpikesRepository is an autogenerated mock type for the ProjectSpikesRepository type
+type MockProjectSpikesRepository struct {
+	mock.Mock
+}
+
+type MockProjectSpikesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectSpikesRepository) EXPECT() *MockProjectSpikesRepository_Expecter {
+	return &MockProjectSpikesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, spikeDTO
+func (_m *MockProjectSpikesRepository) Create(ctx context.Context, spikeDTO domain.ProjectSpikeDTO) (domain.ProjectSpike, error) {
+	ret := _m.Called(ctx, spikeDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.ProjectSpike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectSpikeDTO) (domain.ProjectSpike, error)); ok {
+		return rf(ctx, spikeDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectSpikeDTO) domain.ProjectSpike); ok {
+		r0 = rf(ctx, spikeDTO)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectSpike)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectSpikeDTO) error); ok {
+		r1 = rf(ctx, spikeDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectSpikesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectSpikesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spikeDTO domain.ProjectSpikeDTO
+func (_e *MockProjectSpikesRepository_Expecter) Create(ctx interface{}, spikeDTO interface{}) *MockProjectSpikesRepository_Create_Call {
+	return &MockProjectSpikesRepository_Create_Call{Call: _e.mock.On("Create", ctx, spikeDTO)}
+}
+
+func (_c *MockProjectSpikesRepository_Create_Call) Run(run func(ctx context.Context, spikeDTO domain.ProjectSpikeDTO)) *MockProjectSpikesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectSpikeDTO))
+	})
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_Create_Call) Return(_a0 domain.ProjectSpike, _a1 error) *MockProjectSpikesRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_Create_Call) RunAndReturn(run func(context.Context, domain.ProjectSpikeDTO) (domain.ProjectSpike, error)) *MockProjectSpikesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Extend provides a mock function with given fields: ctx, id, endsAt, peakRPS
+func (_m *MockProjectSpikesRepository) Extend(ctx context.Context, id domain.ProjectSpikeID, endsAt time.Time, peakRPS uint64) error {
+	ret := _m.Called(ctx, id, endsAt, peakRPS)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Extend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectSpikeID, time.Time, uint64) error); ok {
+		r0 = rf(ctx, id, endsAt, peakRPS)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectSpikesRepository_Extend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Extend'
+type MockProjectSpikesRepository_Extend_Call struct {
+	*mock.Call
+}
+
+// Extend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.ProjectSpikeID
+//   - endsAt time.Time
+//   - peakRPS uint64
+func (_e *MockProjectSpikesRepository_Expecter) Extend(ctx interface{}, id interface{}, endsAt interface{}, peakRPS interface{}) *MockProjectSpikesRepository_Extend_Call {
+	return &MockProjectSpikesRepository_Extend_Call{Call: _e.mock.On("Extend", ctx, id, endsAt, peakRPS)}
+}
+
+func (_c *MockProjectSpikesRepository_Extend_Call) Run(run func(ctx context.Context, id domain.ProjectSpikeID, endsAt time.Time, peakRPS uint64)) *MockProjectSpikesRepository_Extend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectSpikeID), args[2].(time.Time), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_Extend_Call) Return(_a0 error) *MockProjectSpikesRepository_Extend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_Extend_Call) RunAndReturn(run func(context.Context, domain.ProjectSpikeID, time.Time, uint64) error) *MockProjectSpikesRepository_Extend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLast provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectSpikesRepository) GetLast(ctx context.Context, projectID domain.ProjectID) (domain.ProjectSpike, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLast")
+	}
+
+	var r0 domain.ProjectSpike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (domain.ProjectSpike, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) domain.ProjectSpike); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectSpike)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectSpikesRepository_GetLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLast'
+type MockProjectSpikesRepository_GetLast_Call struct {
+	*mock.Call
+}
+
+// GetLast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+func (_e *MockProjectSpikesRepository_Expecter) GetLast(ctx interface{}, projectID interface{}) *MockProjectSpikesRepository_GetLast_Call {
+	return &MockProjectSpikesRepository_GetLast_Call{Call: _e.mock.On("GetLast", ctx, projectID)}
+}
+
+func (_c *MockProjectSpikesRepository_GetLast_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectSpikesRepository_GetLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_GetLast_Call) Return(_a0 domain.ProjectSpike, _a1 error) *MockProjectSpikesRepository_GetLast_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectSpikesRepository_GetLast_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (domain.ProjectSpike, error)) *MockProjectSpikesRepository_GetLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectSpikesRepository creates a new instance of MockProjectSpikesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectSpikesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectSpikesRepository {
+	mock := &MockProjectSpikesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,167 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	domain "github.com/rom8726/warden/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProjectsCache is an autogenerated mock type for the ProjectsCache type
+type MockProjectsCache struct {
+	mock.Mock
+}
+
+type MockProjectsCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectsCache) EXPECT() *MockProjectsCache_Expecter {
+	return &MockProjectsCache_Expecter{mock: &_m.Mock}
+}
+
+// InvalidateAll provides a mock function with no fields
+func (_m *MockProjectsCache) InvalidateAll() {
+	_m.Called()
+}
+
+// MockProjectsCache_InvalidateAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateAll'
+type MockProjectsCache_InvalidateAll_Call struct {
+	*mock.Call
+}
+
+// InvalidateAll is a helper method to define mock.On call
+func (_e *MockProjectsCache_Expecter) InvalidateAll() *MockProjectsCache_InvalidateAll_Call {
+	return &MockProjectsCache_InvalidateAll_Call{Call: _e.mock.On("InvalidateAll")}
+}
+
+func (_c *MockProjectsCache_InvalidateAll_Call) Run(run func()) *MockProjectsCache_InvalidateAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateAll_Call) Return() *MockProjectsCache_InvalidateAll_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateAll_Call) RunAndReturn(run func()) *MockProjectsCache_InvalidateAll_Call {
+	_c.Run(run)
+	return _c
+}
+
+// InvalidateFilters provides a mock function with given fields: projectID
+func (_m *MockProjectsCache) InvalidateFilters(projectID domain.ProjectID) {
+	_m.Called(projectID)
+}
+
+// MockProjectsCache_InvalidateFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateFilters'
+type MockProjectsCache_InvalidateFilters_Call struct {
+	*mock.Call
+}
+
+// InvalidateFilters is a helper method to define mock.On call
+//   - projectID domain.ProjectID
+func (_e *MockProjectsCache_Expecter) InvalidateFilters(projectID interface{}) *MockProjectsCache_InvalidateFilters_Call {
+	return &MockProjectsCache_InvalidateFilters_Call{Call: _e.mock.On("InvalidateFilters", projectID)}
+}
+
+func (_c *MockProjectsCache_InvalidateFilters_Call) Run(run func(projectID domain.ProjectID)) *MockProjectsCache_InvalidateFilters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateFilters_Call) Return() *MockProjectsCache_InvalidateFilters_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateFilters_Call) RunAndReturn(run func(domain.ProjectID)) *MockProjectsCache_InvalidateFilters_Call {
+	_c.Run(run)
+	return _c
+}
+
+// InvalidateKey provides a mock function with given fields: projectID, publicKey
+func (_m *MockProjectsCache) InvalidateKey(projectID domain.ProjectID, publicKey string) {
+	_m.Called(projectID, publicKey)
+}
+
+// MockProjectsCache_InvalidateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateKey'
+type MockProjectsCache_InvalidateKey_Call struct {
+	*mock.Call
+}
+
+// InvalidateKey is a helper method to define mock.On call
+//   - projectID domain.ProjectID
+//   - publicKey string
+func (_e *MockProjectsCache_Expecter) InvalidateKey(projectID interface{}, publicKey interface{}) *MockProjectsCache_InvalidateKey_Call {
+	return &MockProjectsCache_InvalidateKey_Call{Call: _e.mock.On("InvalidateKey", projectID, publicKey)}
+}
+
+func (_c *MockProjectsCache_InvalidateKey_Call) Run(run func(projectID domain.ProjectID, publicKey string)) *MockProjectsCache_InvalidateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.ProjectID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateKey_Call) Return() *MockProjectsCache_InvalidateKey_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateKey_Call) RunAndReturn(run func(domain.ProjectID, string)) *MockProjectsCache_InvalidateKey_Call {
+	_c.Run(run)
+	return _c
+}
+
+// InvalidateRateLimits provides a mock function with given fields: projectID
+func (_m *MockProjectsCache) InvalidateRateLimits(projectID domain.ProjectID) {
+	_m.Called(projectID)
+}
+
+// MockProjectsCache_InvalidateRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateRateLimits'
+type MockProjectsCache_InvalidateRateLimits_Call struct {
+	*mock.Call
+}
+
+// InvalidateRateLimits is a helper method to define mock.On call
+//   - projectID domain.ProjectID
+func (_e *MockProjectsCache_Expecter) InvalidateRateLimits(projectID interface{}) *MockProjectsCache_InvalidateRateLimits_Call {
+	return &MockProjectsCache_InvalidateRateLimits_Call{Call: _e.mock.On("InvalidateRateLimits", projectID)}
+}
+
+func (_c *MockProjectsCache_InvalidateRateLimits_Call) Run(run func(projectID domain.ProjectID)) *MockProjectsCache_InvalidateRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(domain.ProjectID))
+	})
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateRateLimits_Call) Return() *MockProjectsCache_InvalidateRateLimits_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProjectsCache_InvalidateRateLimits_Call) RunAndReturn(run func(domain.ProjectID)) *MockProjectsCache_InvalidateRateLimits_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockProjectsCache creates a new instance of MockProjectsCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectsCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectsCache {
+	mock := &MockProjectsCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/rom8726/warden/internal/domain"
 
+	inboundfilters "github.com/rom8726/warden/internal/common/inboundfilters"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -23,27 +25,139 @@ func (_m *MockProjectsUseCase) EXPECT() *MockProjectsUseCase_Expecter {
 	return &MockProjectsUseCase_Expecter{mock: &_m.Mock}
 }
 
-// ValidateProjectKey provides a mock function with given fields: ctx, projectID, key
-func (_m *MockProjectsUseCase) ValidateProjectKey(ctx context.Context, projectID domain.ProjectID, key string) (bool, error) {
-	ret := _m.Called(ctx, projectID, key)
+// AuthenticateKey provides a mock function with given fields: ctx, projectID, publicKey, secretKey
+func (_m *MockProjectsUseCase) AuthenticateKey(ctx context.Context, projectID domain.ProjectID, publicKey string, secretKey string) (domain.ProjectKey, error) {
+	ret := _m.Called(ctx, projectID, publicKey, secretKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateKey")
+	}
+
+	var r0 domain.ProjectKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string) (domain.ProjectKey, error)); ok {
+		return rf(ctx, projectID, publicKey, secretKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string) domain.ProjectKey); ok {
+		r0 = rf(ctx, projectID, publicKey, secretKey)
+	} else {
+		r0 = ret.Get(0).(domain.ProjectKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, string) error); ok {
+		r1 = rf(ctx, projectID, publicKey, secretKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectsUseCase_AuthenticateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateKey'
+type MockProjectsUseCase_AuthenticateKey_Call struct {
+	*mock.Call
+}
+
+// AuthenticateKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - publicKey string
+//   - secretKey string
+func (_e *MockProjectsUseCase_Expecter) AuthenticateKey(ctx interface{}, projectID interface{}, publicKey interface{}, secretKey interface{}) *MockProjectsUseCase_AuthenticateKey_Call {
+	return &MockProjectsUseCase_AuthenticateKey_Call{Call: _e.mock.On("AuthenticateKey", ctx, projectID, publicKey, secretKey)}
+}
+
+func (_c *MockProjectsUseCase_AuthenticateKey_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, publicKey string, secretKey string)) *MockProjectsUseCase_AuthenticateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockProjectsUseCase_AuthenticateKey_Call) Return(_a0 domain.ProjectKey, _a1 error) *MockProjectsUseCase_AuthenticateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectsUseCase_AuthenticateKey_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, string) (domain.ProjectKey, error)) *MockProjectsUseCase_AuthenticateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckQuotas provides a mock function with given fields: ctx, projectID, key, quantities
+func (_m *MockProjectsUseCase) CheckQuotas(ctx context.Context, projectID domain.ProjectID, key domain.ProjectKey, quantities map[domain.DataCategory]uint) map[domain.DataCategory]string {
+	ret := _m.Called(ctx, projectID, key, quantities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckQuotas")
+	}
+
+	var r0 map[domain.DataCategory]string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, domain.ProjectKey, map[domain.DataCategory]uint) map[domain.DataCategory]string); ok {
+		r0 = rf(ctx, projectID, key, quantities)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.DataCategory]string)
+		}
+	}
+
+	return r0
+}
+
+// MockProjectsUseCase_CheckQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckQuotas'
+type MockProjectsUseCase_CheckQuotas_Call struct {
+	*mock.Call
+}
+
+// CheckQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - key domain.ProjectKey
+//   - quantities map[domain.DataCategory]uint
+func (_e *MockProjectsUseCase_Expecter) CheckQuotas(ctx interface{}, projectID interface{}, key interface{}, quantities interface{}) *MockProjectsUseCase_CheckQuotas_Call {
+	return &MockProjectsUseCase_CheckQuotas_Call{Call: _e.mock.On("CheckQuotas", ctx, projectID, key, quantities)}
+}
+
+func (_c *MockProjectsUseCase_CheckQuotas_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, key domain.ProjectKey, quantities map[domain.DataCategory]uint)) *MockProjectsUseCase_CheckQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(domain.ProjectKey), args[3].(map[domain.DataCategory]uint))
+	})
+	return _c
+}
+
+func (_c *MockProjectsUseCase_CheckQuotas_Call) Return(_a0 map[domain.DataCategory]string) *MockProjectsUseCase_CheckQuotas_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectsUseCase_CheckQuotas_Call) RunAndReturn(run func(context.Context, domain.ProjectID, domain.ProjectKey, map[domain.DataCategory]uint) map[domain.DataCategory]string) *MockProjectsUseCase_CheckQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InboundFilters provides a mock function with given fields: ctx, projectID
+func (_m *MockProjectsUseCase) InboundFilters(ctx context.Context, projectID domain.ProjectID) (*inboundfilters.Filters, error) {
+	ret := _m.Called(ctx, projectID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ValidateProjectKey")
+		panic("no return value specified for InboundFilters")
 	}
 
-	var r0 bool
+	var r0 *inboundfilters.Filters
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) (bool, error)); ok {
-		return rf(ctx, projectID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) (*inboundfilters.Filters, error)); ok {
+		return rf(ctx, projectID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string) bool); ok {
-		r0 = rf(ctx, projectID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID) *inboundfilters.Filters); ok {
+		r0 = rf(ctx, projectID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*inboundfilters.Filters)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string) error); ok {
-		r1 = rf(ctx, projectID, key)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID) error); ok {
+		r1 = rf(ctx, projectID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -51,32 +165,31 @@ func (_m *MockProjectsUseCase) ValidateProjectKey(ctx context.Context, projectID
 	return r0, r1
 }
 
-// MockProjectsUseCase_ValidateProjectKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateProjectKey'
-type MockProjectsUseCase_ValidateProjectKey_Call struct {
+// MockProjectsUseCase_InboundFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InboundFilters'
+type MockProjectsUseCase_InboundFilters_Call struct {
 	*mock.Call
 }
 
-// ValidateProjectKey is a helper method to define mock.On call
+// InboundFilters is a helper method to define mock.On call
 //   - ctx context.Context
 //   - projectID domain.ProjectID
-//   - key string
-func (_e *MockProjectsUseCase_Expecter) ValidateProjectKey(ctx interface{}, projectID interface{}, key interface{}) *MockProjectsUseCase_ValidateProjectKey_Call {
-	return &MockProjectsUseCase_ValidateProjectKey_Call{Call: _e.mock.On("ValidateProjectKey", ctx, projectID, key)}
+func (_e *MockProjectsUseCase_Expecter) InboundFilters(ctx interface{}, projectID interface{}) *MockProjectsUseCase_InboundFilters_Call {
+	return &MockProjectsUseCase_InboundFilters_Call{Call: _e.mock.On("InboundFilters", ctx, projectID)}
 }
 
-func (_c *MockProjectsUseCase_ValidateProjectKey_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, key string)) *MockProjectsUseCase_ValidateProjectKey_Call {
+func (_c *MockProjectsUseCase_InboundFilters_Call) Run(run func(ctx context.Context, projectID domain.ProjectID)) *MockProjectsUseCase_InboundFilters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string))
+		run(args[0].(context.Context), args[1].(domain.ProjectID))
 	})
 	return _c
 }
 
-func (_c *MockProjectsUseCase_ValidateProjectKey_Call) Return(_a0 bool, _a1 error) *MockProjectsUseCase_ValidateProjectKey_Call {
+func (_c *MockProjectsUseCase_InboundFilters_Call) Return(_a0 *inboundfilters.Filters, _a1 error) *MockProjectsUseCase_InboundFilters_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProjectsUseCase_ValidateProjectKey_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string) (bool, error)) *MockProjectsUseCase_ValidateProjectKey_Call {
+func (_c *MockProjectsUseCase_InboundFilters_Call) RunAndReturn(run func(context.Context, domain.ProjectID) (*inboundfilters.Filters, error)) *MockProjectsUseCase_InboundFilters_Call {
 	_c.Call.Return(run)
 	return _c
 }
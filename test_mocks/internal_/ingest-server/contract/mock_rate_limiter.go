@@ -0,0 +1,108 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockRateLimiter is an autogenerated mock type for the RateLimiter type
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+type MockRateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimiter) EXPECT() *MockRateLimiter_Expecter {
+	return &MockRateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Allow provides a mock function with given fields: ctx, scope, scopeID, category, limit, quantity
+func (_m *MockRateLimiter) Allow(ctx context.Context, scope domain.RateLimitScope, scopeID uint, category domain.DataCategory, limit uint, quantity uint) (bool, time.Duration, error) {
+	ret := _m.Called(ctx, scope, scopeID, category, limit, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allow")
+	}
+
+	var r0 bool
+	var r1 time.Duration
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RateLimitScope, uint, domain.DataCategory, uint, uint) (bool, time.Duration, error)); ok {
+		return rf(ctx, scope, scopeID, category, limit, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RateLimitScope, uint, domain.DataCategory, uint, uint) bool); ok {
+		r0 = rf(ctx, scope, scopeID, category, limit, quantity)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RateLimitScope, uint, domain.DataCategory, uint, uint) time.Duration); ok {
+		r1 = rf(ctx, scope, scopeID, category, limit, quantity)
+	} else {
+		r1 = ret.Get(1).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.RateLimitScope, uint, domain.DataCategory, uint, uint) error); ok {
+		r2 = rf(ctx, scope, scopeID, category, limit, quantity)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRateLimiter_Allow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allow'
+type MockRateLimiter_Allow_Call struct {
+	*mock.Call
+}
+
+// Allow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope domain.RateLimitScope
+//   - scopeID uint
+//   - category domain.DataCategory
+//   - limit uint
+//   - quantity uint
+func (_e *MockRateLimiter_Expecter) Allow(ctx interface{}, scope interface{}, scopeID interface{}, category interface{}, limit interface{}, quantity interface{}) *MockRateLimiter_Allow_Call {
+	return &MockRateLimiter_Allow_Call{Call: _e.mock.On("Allow", ctx, scope, scopeID, category, limit, quantity)}
+}
+
+func (_c *MockRateLimiter_Allow_Call) Run(run func(ctx context.Context, scope domain.RateLimitScope, scopeID uint, category domain.DataCategory, limit uint, quantity uint)) *MockRateLimiter_Allow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RateLimitScope), args[2].(uint), args[3].(domain.DataCategory), args[4].(uint), args[5].(uint))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_Allow_Call) Return(_a0 bool, _a1 time.Duration, _a2 error) *MockRateLimiter_Allow_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRateLimiter_Allow_Call) RunAndReturn(run func(context.Context, domain.RateLimitScope, uint, domain.DataCategory, uint, uint) (bool, time.Duration, error)) *MockRateLimiter_Allow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRateLimiter creates a new instance of MockRateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimiter {
+	mock := &MockRateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
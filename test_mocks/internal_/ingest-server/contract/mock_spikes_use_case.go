@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockSpikesUseCase is an autogenerated mock type for the SpikesUseCase type
+type MockSpikesUseCase struct {
+	mock.Mock
+}
+
+type MockSpikesUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSpikesUseCase) EXPECT() *MockSpikesUseCase_Expecter {
+	return &MockSpikesUseCase_Expecter{mock: &_m.Mock}
+}
+
+// RecordSpike provides a mock function with given fields: ctx, spike
+func (_m *MockSpikesUseCase) RecordSpike(ctx context.Context, spike domain.ProjectSpikeDTO) error {
+	ret := _m.Called(ctx, spike)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSpike")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectSpikeDTO) error); ok {
+		r0 = rf(ctx, spike)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSpikesUseCase_RecordSpike_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSpike'
+type MockSpikesUseCase_RecordSpike_Call struct {
+	*mock.Call
+}
+
+// RecordSpike is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spike domain.ProjectSpikeDTO
+func (_e *MockSpikesUseCase_Expecter) RecordSpike(ctx interface{}, spike interface{}) *MockSpikesUseCase_RecordSpike_Call {
+	return &MockSpikesUseCase_RecordSpike_Call{Call: _e.mock.On("RecordSpike", ctx, spike)}
+}
+
+func (_c *MockSpikesUseCase_RecordSpike_Call) Run(run func(ctx context.Context, spike domain.ProjectSpikeDTO)) *MockSpikesUseCase_RecordSpike_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectSpikeDTO))
+	})
+	return _c
+}
+
+func (_c *MockSpikesUseCase_RecordSpike_Call) Return(_a0 error) *MockSpikesUseCase_RecordSpike_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSpikesUseCase_RecordSpike_Call) RunAndReturn(run func(context.Context, domain.ProjectSpikeDTO) error) *MockSpikesUseCase_RecordSpike_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSpikesUseCase creates a new instance of MockSpikesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSpikesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSpikesUseCase {
+	mock := &MockSpikesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTeamsRepository is an autogenerated mock type for the TeamsRepository type
+type MockTeamsRepository struct {
+	mock.Mock
+}
+
+type MockTeamsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTeamsRepository) EXPECT() *MockTeamsRepository_Expecter {
+	return &MockTeamsRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetMembers provides a mock function with given fields: ctx, teamID
+func (_m *MockTeamsRepository) GetMembers(ctx context.Context, teamID domain.TeamID) ([]domain.TeamMember, error) {
+	ret := _m.Called(ctx, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 []domain.TeamMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamID) ([]domain.TeamMember, error)); ok {
+		return rf(ctx, teamID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TeamID) []domain.TeamMember); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TeamMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TeamID) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTeamsRepository_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type MockTeamsRepository_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamID domain.TeamID
+func (_e *MockTeamsRepository_Expecter) GetMembers(ctx interface{}, teamID interface{}) *MockTeamsRepository_GetMembers_Call {
+	return &MockTeamsRepository_GetMembers_Call{Call: _e.mock.On("GetMembers", ctx, teamID)}
+}
+
+func (_c *MockTeamsRepository_GetMembers_Call) Run(run func(ctx context.Context, teamID domain.TeamID)) *MockTeamsRepository_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.TeamID))
+	})
+	return _c
+}
+
+func (_c *MockTeamsRepository_GetMembers_Call) Return(_a0 []domain.TeamMember, _a1 error) *MockTeamsRepository_GetMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTeamsRepository_GetMembers_Call) RunAndReturn(run func(context.Context, domain.TeamID) ([]domain.TeamMember, error)) *MockTeamsRepository_GetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTeamsRepository creates a new instance of MockTeamsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTeamsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTeamsRepository {
+	mock := &MockTeamsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
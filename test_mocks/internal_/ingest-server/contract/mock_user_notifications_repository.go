@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	json "encoding/json"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUserNotificationsRepository is an autogenerated mock type for the UserNotificationsRepository type
+type MockUserNotificationsRepository struct {
+	mock.Mock
+}
+
+type MockUserNotificationsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserNotificationsRepository) EXPECT() *MockUserNotificationsRepository_Expecter {
+	return &MockUserNotificationsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, userID, notificationType, content
+func (_m *MockUserNotificationsRepository) Create(ctx context.Context, userID domain.UserID, notificationType domain.UserNotificationType, content json.RawMessage) (domain.UserNotification, error) {
+	ret := _m.Called(ctx, userID, notificationType, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.UserNotification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.UserNotificationType, json.RawMessage) (domain.UserNotification, error)); ok {
+		return rf(ctx, userID, notificationType, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UserID, domain.UserNotificationType, json.RawMessage) domain.UserNotification); ok {
+		r0 = rf(ctx, userID, notificationType, content)
+	} else {
+		r0 = ret.Get(0).(domain.UserNotification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UserID, domain.UserNotificationType, json.RawMessage) error); ok {
+		r1 = rf(ctx, userID, notificationType, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserNotificationsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserNotificationsRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID domain.UserID
+//   - notificationType domain.UserNotificationType
+//   - content json.RawMessage
+func (_e *MockUserNotificationsRepository_Expecter) Create(ctx interface{}, userID interface{}, notificationType interface{}, content interface{}) *MockUserNotificationsRepository_Create_Call {
+	return &MockUserNotificationsRepository_Create_Call{Call: _e.mock.On("Create", ctx, userID, notificationType, content)}
+}
+
+func (_c *MockUserNotificationsRepository_Create_Call) Run(run func(ctx context.Context, userID domain.UserID, notificationType domain.UserNotificationType, content json.RawMessage)) *MockUserNotificationsRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UserID), args[2].(domain.UserNotificationType), args[3].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockUserNotificationsRepository_Create_Call) Return(_a0 domain.UserNotification, _a1 error) *MockUserNotificationsRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserNotificationsRepository_Create_Call) RunAndReturn(run func(context.Context, domain.UserID, domain.UserNotificationType, json.RawMessage) (domain.UserNotification, error)) *MockUserNotificationsRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserNotificationsRepository creates a new instance of MockUserNotificationsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserNotificationsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserNotificationsRepository {
+	mock := &MockUserNotificationsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUsersRepository is an autogenerated mock type for the UsersRepository type
+type MockUsersRepository struct {
+	mock.Mock
+}
+
+type MockUsersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUsersRepository) EXPECT() *MockUsersRepository_Expecter {
+	return &MockUsersRepository_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *MockUsersRepository) List(ctx context.Context) ([]domain.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUsersRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUsersRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUsersRepository_Expecter) List(ctx interface{}) *MockUsersRepository_List_Call {
+	return &MockUsersRepository_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockUsersRepository_List_Call) Run(run func(ctx context.Context)) *MockUsersRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUsersRepository_List_Call) Return(_a0 []domain.User, _a1 error) *MockUsersRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUsersRepository_List_Call) RunAndReturn(run func(context.Context) ([]domain.User, error)) *MockUsersRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUsersRepository creates a new instance of MockUsersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUsersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUsersRepository {
+	mock := &MockUsersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
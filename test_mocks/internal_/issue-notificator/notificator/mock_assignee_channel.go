@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocknotificator
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAssigneeChannel is an autogenerated mock type for the AssigneeChannel type
+type MockAssigneeChannel struct {
+	mock.Mock
+}
+
+type MockAssigneeChannel_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssigneeChannel) EXPECT() *MockAssigneeChannel_Expecter {
+	return &MockAssigneeChannel_Expecter{mock: &_m.Mock}
+}
+
+// SendToAssignee provides a mock function with given fields: ctx, issue, project, isRegress
+func (_m *MockAssigneeChannel) SendToAssignee(ctx context.Context, issue *domain.Issue, project *domain.Project, isRegress bool) error {
+	ret := _m.Called(ctx, issue, project, isRegress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendToAssignee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Issue, *domain.Project, bool) error); ok {
+		r0 = rf(ctx, issue, project, isRegress)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAssigneeChannel_SendToAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendToAssignee'
+type MockAssigneeChannel_SendToAssignee_Call struct {
+	*mock.Call
+}
+
+// SendToAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issue *domain.Issue
+//   - project *domain.Project
+//   - isRegress bool
+func (_e *MockAssigneeChannel_Expecter) SendToAssignee(ctx interface{}, issue interface{}, project interface{}, isRegress interface{}) *MockAssigneeChannel_SendToAssignee_Call {
+	return &MockAssigneeChannel_SendToAssignee_Call{Call: _e.mock.On("SendToAssignee", ctx, issue, project, isRegress)}
+}
+
+func (_c *MockAssigneeChannel_SendToAssignee_Call) Run(run func(ctx context.Context, issue *domain.Issue, project *domain.Project, isRegress bool)) *MockAssigneeChannel_SendToAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Issue), args[2].(*domain.Project), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAssigneeChannel_SendToAssignee_Call) Return(_a0 error) *MockAssigneeChannel_SendToAssignee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAssigneeChannel_SendToAssignee_Call) RunAndReturn(run func(context.Context, *domain.Issue, *domain.Project, bool) error) *MockAssigneeChannel_SendToAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAssigneeChannel creates a new instance of MockAssigneeChannel. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssigneeChannel(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssigneeChannel {
+	mock := &MockAssigneeChannel{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,147 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAttachmentsRepository is an autogenerated mock type for the AttachmentsRepository type
+type MockAttachmentsRepository struct {
+	mock.Mock
+}
+
+type MockAttachmentsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentsRepository) EXPECT() *MockAttachmentsRepository_Expecter {
+	return &MockAttachmentsRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockAttachmentsRepository) DeleteByIDs(ctx context.Context, ids []domain.AttachmentID) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.AttachmentID) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentsRepository_DeleteByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByIDs'
+type MockAttachmentsRepository_DeleteByIDs_Call struct {
+	*mock.Call
+}
+
+// DeleteByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []domain.AttachmentID
+func (_e *MockAttachmentsRepository_Expecter) DeleteByIDs(ctx interface{}, ids interface{}) *MockAttachmentsRepository_DeleteByIDs_Call {
+	return &MockAttachmentsRepository_DeleteByIDs_Call{Call: _e.mock.On("DeleteByIDs", ctx, ids)}
+}
+
+func (_c *MockAttachmentsRepository_DeleteByIDs_Call) Run(run func(ctx context.Context, ids []domain.AttachmentID)) *MockAttachmentsRepository_DeleteByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]domain.AttachmentID))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_DeleteByIDs_Call) Return(_a0 error) *MockAttachmentsRepository_DeleteByIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_DeleteByIDs_Call) RunAndReturn(run func(context.Context, []domain.AttachmentID) error) *MockAttachmentsRepository_DeleteByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOld provides a mock function with given fields: ctx, maxAge, limit
+func (_m *MockAttachmentsRepository) ListOld(ctx context.Context, maxAge time.Duration, limit uint) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, maxAge, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOld")
+	}
+
+	var r0 []domain.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, uint) ([]domain.Attachment, error)); ok {
+		return rf(ctx, maxAge, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, uint) []domain.Attachment); ok {
+		r0 = rf(ctx, maxAge, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, uint) error); ok {
+		r1 = rf(ctx, maxAge, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentsRepository_ListOld_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOld'
+type MockAttachmentsRepository_ListOld_Call struct {
+	*mock.Call
+}
+
+// ListOld is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxAge time.Duration
+//   - limit uint
+func (_e *MockAttachmentsRepository_Expecter) ListOld(ctx interface{}, maxAge interface{}, limit interface{}) *MockAttachmentsRepository_ListOld_Call {
+	return &MockAttachmentsRepository_ListOld_Call{Call: _e.mock.On("ListOld", ctx, maxAge, limit)}
+}
+
+func (_c *MockAttachmentsRepository_ListOld_Call) Run(run func(ctx context.Context, maxAge time.Duration, limit uint)) *MockAttachmentsRepository_ListOld_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_ListOld_Call) Return(_a0 []domain.Attachment, _a1 error) *MockAttachmentsRepository_ListOld_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentsRepository_ListOld_Call) RunAndReturn(run func(context.Context, time.Duration, uint) ([]domain.Attachment, error)) *MockAttachmentsRepository_ListOld_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentsRepository creates a new instance of MockAttachmentsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentsRepository {
+	mock := &MockAttachmentsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,8 @@ import (
 	domain "github.com/rom8726/warden/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockEventRepository is an autogenerated mock type for the EventRepository type
@@ -23,9 +25,9 @@ func (_m *MockEventRepository) EXPECT() *MockEventRepository_Expecter {
 	return &MockEventRepository_Expecter{mock: &_m.Mock}
 }
 
-// AggregateBySegment provides a mock function with given fields: ctx, projectID, release, segment
-func (_m *MockEventRepository) AggregateBySegment(ctx context.Context, projectID domain.ProjectID, release string, segment domain.SegmentName) (map[string]uint, error) {
-	ret := _m.Called(ctx, projectID, release, segment)
+// AggregateBySegment provides a mock function with given fields: ctx, projectID, release, environment, segment
+func (_m *MockEventRepository) AggregateBySegment(ctx context.Context, projectID domain.ProjectID, release string, environment string, segment domain.SegmentName) (map[string]uint, error) {
+	ret := _m.Called(ctx, projectID, release, environment, segment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AggregateBySegment")
@@ -33,19 +35,19 @@ func (_m *MockEventRepository) AggregateBySegment(ctx context.Context, projectID
 
 	var r0 map[string]uint
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.SegmentName) (map[string]uint, error)); ok {
-		return rf(ctx, projectID, release, segment)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string, domain.SegmentName) (map[string]uint, error)); ok {
+		return rf(ctx, projectID, release, environment, segment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, domain.SegmentName) map[string]uint); ok {
-		r0 = rf(ctx, projectID, release, segment)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, string, string, domain.SegmentName) map[string]uint); ok {
+		r0 = rf(ctx, projectID, release, environment, segment)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]uint)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, domain.SegmentName) error); ok {
-		r1 = rf(ctx, projectID, release, segment)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, string, string, domain.SegmentName) error); ok {
+		r1 = rf(ctx, projectID, release, environment, segment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,14 +64,15 @@ type MockEventRepository_AggregateBySegment_Call struct {
 //   - ctx context.Context
 //   - projectID domain.ProjectID
 //   - release string
+//   - environment string
 //   - segment domain.SegmentName
-func (_e *MockEventRepository_Expecter) AggregateBySegment(ctx interface{}, projectID interface{}, release interface{}, segment interface{}) *MockEventRepository_AggregateBySegment_Call {
-	return &MockEventRepository_AggregateBySegment_Call{Call: _e.mock.On("AggregateBySegment", ctx, projectID, release, segment)}
+func (_e *MockEventRepository_Expecter) AggregateBySegment(ctx interface{}, projectID interface{}, release interface{}, environment interface{}, segment interface{}) *MockEventRepository_AggregateBySegment_Call {
+	return &MockEventRepository_AggregateBySegment_Call{Call: _e.mock.On("AggregateBySegment", ctx, projectID, release, environment, segment)}
 }
 
-func (_c *MockEventRepository_AggregateBySegment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, segment domain.SegmentName)) *MockEventRepository_AggregateBySegment_Call {
+func (_c *MockEventRepository_AggregateBySegment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, release string, environment string, segment domain.SegmentName)) *MockEventRepository_AggregateBySegment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(domain.SegmentName))
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(string), args[3].(string), args[4].(domain.SegmentName))
 	})
 	return _c
 }
@@ -79,7 +82,132 @@ func (_c *MockEventRepository_AggregateBySegment_Call) Return(_a0 map[string]uin
 	return _c
 }
 
-func (_c *MockEventRepository_AggregateBySegment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, domain.SegmentName) (map[string]uint, error)) *MockEventRepository_AggregateBySegment_Call {
+func (_c *MockEventRepository_AggregateBySegment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, string, string, domain.SegmentName) (map[string]uint, error)) *MockEventRepository_AggregateBySegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueEventStats provides a mock function with given fields: ctx, projectID, fingerprints, usersSince, eventsSince
+func (_m *MockEventRepository) IssueEventStats(ctx context.Context, projectID domain.ProjectID, fingerprints []string, usersSince time.Time, eventsSince time.Time) (uint, uint, error) {
+	ret := _m.Called(ctx, projectID, fingerprints, usersSince, eventsSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueEventStats")
+	}
+
+	var r0 uint
+	var r1 uint
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, time.Time, time.Time) (uint, uint, error)); ok {
+		return rf(ctx, projectID, fingerprints, usersSince, eventsSince)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string, time.Time, time.Time) uint); ok {
+		r0 = rf(ctx, projectID, fingerprints, usersSince, eventsSince)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string, time.Time, time.Time) uint); ok {
+		r1 = rf(ctx, projectID, fingerprints, usersSince, eventsSince)
+	} else {
+		r1 = ret.Get(1).(uint)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.ProjectID, []string, time.Time, time.Time) error); ok {
+		r2 = rf(ctx, projectID, fingerprints, usersSince, eventsSince)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockEventRepository_IssueEventStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueEventStats'
+type MockEventRepository_IssueEventStats_Call struct {
+	*mock.Call
+}
+
+// IssueEventStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprints []string
+//   - usersSince time.Time
+//   - eventsSince time.Time
+func (_e *MockEventRepository_Expecter) IssueEventStats(ctx interface{}, projectID interface{}, fingerprints interface{}, usersSince interface{}, eventsSince interface{}) *MockEventRepository_IssueEventStats_Call {
+	return &MockEventRepository_IssueEventStats_Call{Call: _e.mock.On("IssueEventStats", ctx, projectID, fingerprints, usersSince, eventsSince)}
+}
+
+func (_c *MockEventRepository_IssueEventStats_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprints []string, usersSince time.Time, eventsSince time.Time)) *MockEventRepository_IssueEventStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_IssueEventStats_Call) Return(users uint, events uint, err error) *MockEventRepository_IssueEventStats_Call {
+	_c.Call.Return(users, events, err)
+	return _c
+}
+
+func (_c *MockEventRepository_IssueEventStats_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string, time.Time, time.Time) (uint, uint, error)) *MockEventRepository_IssueEventStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestEnvironment provides a mock function with given fields: ctx, projectID, fingerprints
+func (_m *MockEventRepository) LatestEnvironment(ctx context.Context, projectID domain.ProjectID, fingerprints []string) (string, error) {
+	ret := _m.Called(ctx, projectID, fingerprints)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestEnvironment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string) (string, error)); ok {
+		return rf(ctx, projectID, fingerprints)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, []string) string); ok {
+		r0 = rf(ctx, projectID, fingerprints)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, []string) error); ok {
+		r1 = rf(ctx, projectID, fingerprints)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEventRepository_LatestEnvironment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestEnvironment'
+type MockEventRepository_LatestEnvironment_Call struct {
+	*mock.Call
+}
+
+// LatestEnvironment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - fingerprints []string
+func (_e *MockEventRepository_Expecter) LatestEnvironment(ctx interface{}, projectID interface{}, fingerprints interface{}) *MockEventRepository_LatestEnvironment_Call {
+	return &MockEventRepository_LatestEnvironment_Call{Call: _e.mock.On("LatestEnvironment", ctx, projectID, fingerprints)}
+}
+
+func (_c *MockEventRepository_LatestEnvironment_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, fingerprints []string)) *MockEventRepository_LatestEnvironment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockEventRepository_LatestEnvironment_Call) Return(_a0 string, _a1 error) *MockEventRepository_LatestEnvironment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEventRepository_LatestEnvironment_Call) RunAndReturn(run func(context.Context, domain.ProjectID, []string) (string, error)) *MockEventRepository_LatestEnvironment_Call {
 	_c.Call.Return(run)
 	return _c
 }
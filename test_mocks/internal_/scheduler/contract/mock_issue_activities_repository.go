@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueActivitiesRepository is an autogenerated mock type for the IssueActivitiesRepository type
+type MockIssueActivitiesRepository struct {
+	mock.Mock
+}
+
+type MockIssueActivitiesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueActivitiesRepository) EXPECT() *MockIssueActivitiesRepository_Expecter {
+	return &MockIssueActivitiesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, activity
+func (_m *MockIssueActivitiesRepository) Create(ctx context.Context, activity domain.IssueActivityDTO) error {
+	ret := _m.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueActivityDTO) error); ok {
+		r0 = rf(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueActivitiesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIssueActivitiesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activity domain.IssueActivityDTO
+func (_e *MockIssueActivitiesRepository_Expecter) Create(ctx interface{}, activity interface{}) *MockIssueActivitiesRepository_Create_Call {
+	return &MockIssueActivitiesRepository_Create_Call{Call: _e.mock.On("Create", ctx, activity)}
+}
+
+func (_c *MockIssueActivitiesRepository_Create_Call) Run(run func(ctx context.Context, activity domain.IssueActivityDTO)) *MockIssueActivitiesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueActivityDTO))
+	})
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_Create_Call) Return(_a0 error) *MockIssueActivitiesRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueActivitiesRepository_Create_Call) RunAndReturn(run func(context.Context, domain.IssueActivityDTO) error) *MockIssueActivitiesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueActivitiesRepository creates a new instance of MockIssueActivitiesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueActivitiesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueActivitiesRepository {
+	mock := &MockIssueActivitiesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIssueSnoozesRepository is an autogenerated mock type for the IssueSnoozesRepository type
+type MockIssueSnoozesRepository struct {
+	mock.Mock
+}
+
+type MockIssueSnoozesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIssueSnoozesRepository) EXPECT() *MockIssueSnoozesRepository_Expecter {
+	return &MockIssueSnoozesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, issueID
+func (_m *MockIssueSnoozesRepository) Delete(ctx context.Context, issueID domain.IssueID) error {
+	ret := _m.Called(ctx, issueID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IssueID) error); ok {
+		r0 = rf(ctx, issueID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIssueSnoozesRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIssueSnoozesRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - issueID domain.IssueID
+func (_e *MockIssueSnoozesRepository_Expecter) Delete(ctx interface{}, issueID interface{}) *MockIssueSnoozesRepository_Delete_Call {
+	return &MockIssueSnoozesRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, issueID)}
+}
+
+func (_c *MockIssueSnoozesRepository_Delete_Call) Run(run func(ctx context.Context, issueID domain.IssueID)) *MockIssueSnoozesRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IssueID))
+	})
+	return _c
+}
+
+func (_c *MockIssueSnoozesRepository_Delete_Call) Return(_a0 error) *MockIssueSnoozesRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIssueSnoozesRepository_Delete_Call) RunAndReturn(run func(context.Context, domain.IssueID) error) *MockIssueSnoozesRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActive provides a mock function with given fields: ctx, after, limit
+func (_m *MockIssueSnoozesRepository) ListActive(ctx context.Context, after *domain.IssueSnoozeCursor, limit uint) ([]domain.IssueSnooze, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActive")
+	}
+
+	var r0 []domain.IssueSnooze
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueSnoozeCursor, uint) ([]domain.IssueSnooze, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.IssueSnoozeCursor, uint) []domain.IssueSnooze); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.IssueSnooze)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.IssueSnoozeCursor, uint) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIssueSnoozesRepository_ListActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActive'
+type MockIssueSnoozesRepository_ListActive_Call struct {
+	*mock.Call
+}
+
+// ListActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after *domain.IssueSnoozeCursor
+//   - limit uint
+func (_e *MockIssueSnoozesRepository_Expecter) ListActive(ctx interface{}, after interface{}, limit interface{}) *MockIssueSnoozesRepository_ListActive_Call {
+	return &MockIssueSnoozesRepository_ListActive_Call{Call: _e.mock.On("ListActive", ctx, after, limit)}
+}
+
+func (_c *MockIssueSnoozesRepository_ListActive_Call) Run(run func(ctx context.Context, after *domain.IssueSnoozeCursor, limit uint)) *MockIssueSnoozesRepository_ListActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.IssueSnoozeCursor), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockIssueSnoozesRepository_ListActive_Call) Return(_a0 []domain.IssueSnooze, _a1 error) *MockIssueSnoozesRepository_ListActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIssueSnoozesRepository_ListActive_Call) RunAndReturn(run func(context.Context, *domain.IssueSnoozeCursor, uint) ([]domain.IssueSnooze, error)) *MockIssueSnoozesRepository_ListActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIssueSnoozesRepository creates a new instance of MockIssueSnoozesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIssueSnoozesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIssueSnoozesRepository {
+	mock := &MockIssueSnoozesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
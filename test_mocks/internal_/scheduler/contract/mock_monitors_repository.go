@@ -0,0 +1,325 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMonitorsRepository is an autogenerated mock type for the MonitorsRepository type
+type MockMonitorsRepository struct {
+	mock.Mock
+}
+
+type MockMonitorsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMonitorsRepository) EXPECT() *MockMonitorsRepository_Expecter {
+	return &MockMonitorsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListOverdue provides a mock function with given fields: ctx, now, limit
+func (_m *MockMonitorsRepository) ListOverdue(ctx context.Context, now time.Time, limit uint) ([]domain.Monitor, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOverdue")
+	}
+
+	var r0 []domain.Monitor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint) ([]domain.Monitor, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint) []domain.Monitor); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Monitor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_ListOverdue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOverdue'
+type MockMonitorsRepository_ListOverdue_Call struct {
+	*mock.Call
+}
+
+// ListOverdue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit uint
+func (_e *MockMonitorsRepository_Expecter) ListOverdue(ctx interface{}, now interface{}, limit interface{}) *MockMonitorsRepository_ListOverdue_Call {
+	return &MockMonitorsRepository_ListOverdue_Call{Call: _e.mock.On("ListOverdue", ctx, now, limit)}
+}
+
+func (_c *MockMonitorsRepository_ListOverdue_Call) Run(run func(ctx context.Context, now time.Time, limit uint)) *MockMonitorsRepository_ListOverdue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListOverdue_Call) Return(_a0 []domain.Monitor, _a1 error) *MockMonitorsRepository_ListOverdue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListOverdue_Call) RunAndReturn(run func(context.Context, time.Time, uint) ([]domain.Monitor, error)) *MockMonitorsRepository_ListOverdue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTimedOutCheckIns provides a mock function with given fields: ctx, now, limit
+func (_m *MockMonitorsRepository) ListTimedOutCheckIns(ctx context.Context, now time.Time, limit uint) ([]domain.TimedOutCheckIn, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTimedOutCheckIns")
+	}
+
+	var r0 []domain.TimedOutCheckIn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint) ([]domain.TimedOutCheckIn, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint) []domain.TimedOutCheckIn); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TimedOutCheckIn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_ListTimedOutCheckIns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTimedOutCheckIns'
+type MockMonitorsRepository_ListTimedOutCheckIns_Call struct {
+	*mock.Call
+}
+
+// ListTimedOutCheckIns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit uint
+func (_e *MockMonitorsRepository_Expecter) ListTimedOutCheckIns(ctx interface{}, now interface{}, limit interface{}) *MockMonitorsRepository_ListTimedOutCheckIns_Call {
+	return &MockMonitorsRepository_ListTimedOutCheckIns_Call{Call: _e.mock.On("ListTimedOutCheckIns", ctx, now, limit)}
+}
+
+func (_c *MockMonitorsRepository_ListTimedOutCheckIns_Call) Run(run func(ctx context.Context, now time.Time, limit uint)) *MockMonitorsRepository_ListTimedOutCheckIns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListTimedOutCheckIns_Call) Return(_a0 []domain.TimedOutCheckIn, _a1 error) *MockMonitorsRepository_ListTimedOutCheckIns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_ListTimedOutCheckIns_Call) RunAndReturn(run func(context.Context, time.Time, uint) ([]domain.TimedOutCheckIn, error)) *MockMonitorsRepository_ListTimedOutCheckIns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCheckInStatus provides a mock function with given fields: ctx, id, status
+func (_m *MockMonitorsRepository) SetCheckInStatus(ctx context.Context, id domain.MonitorCheckInID, status domain.CheckInStatus) (bool, error) {
+	ret := _m.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCheckInStatus")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorCheckInID, domain.CheckInStatus) (bool, error)); ok {
+		return rf(ctx, id, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorCheckInID, domain.CheckInStatus) bool); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MonitorCheckInID, domain.CheckInStatus) error); ok {
+		r1 = rf(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_SetCheckInStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCheckInStatus'
+type MockMonitorsRepository_SetCheckInStatus_Call struct {
+	*mock.Call
+}
+
+// SetCheckInStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.MonitorCheckInID
+//   - status domain.CheckInStatus
+func (_e *MockMonitorsRepository_Expecter) SetCheckInStatus(ctx interface{}, id interface{}, status interface{}) *MockMonitorsRepository_SetCheckInStatus_Call {
+	return &MockMonitorsRepository_SetCheckInStatus_Call{Call: _e.mock.On("SetCheckInStatus", ctx, id, status)}
+}
+
+func (_c *MockMonitorsRepository_SetCheckInStatus_Call) Run(run func(ctx context.Context, id domain.MonitorCheckInID, status domain.CheckInStatus)) *MockMonitorsRepository_SetCheckInStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorCheckInID), args[2].(domain.CheckInStatus))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_SetCheckInStatus_Call) Return(_a0 bool, _a1 error) *MockMonitorsRepository_SetCheckInStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_SetCheckInStatus_Call) RunAndReturn(run func(context.Context, domain.MonitorCheckInID, domain.CheckInStatus) (bool, error)) *MockMonitorsRepository_SetCheckInStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateState provides a mock function with given fields: ctx, id, status, lastCheckInAt, nextCheckInAt
+func (_m *MockMonitorsRepository) UpdateState(ctx context.Context, id domain.MonitorID, status domain.MonitorStatus, lastCheckInAt *time.Time, nextCheckInAt time.Time) error {
+	ret := _m.Called(ctx, id, status, lastCheckInAt, nextCheckInAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorID, domain.MonitorStatus, *time.Time, time.Time) error); ok {
+		r0 = rf(ctx, id, status, lastCheckInAt, nextCheckInAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMonitorsRepository_UpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateState'
+type MockMonitorsRepository_UpdateState_Call struct {
+	*mock.Call
+}
+
+// UpdateState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id domain.MonitorID
+//   - status domain.MonitorStatus
+//   - lastCheckInAt *time.Time
+//   - nextCheckInAt time.Time
+func (_e *MockMonitorsRepository_Expecter) UpdateState(ctx interface{}, id interface{}, status interface{}, lastCheckInAt interface{}, nextCheckInAt interface{}) *MockMonitorsRepository_UpdateState_Call {
+	return &MockMonitorsRepository_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, id, status, lastCheckInAt, nextCheckInAt)}
+}
+
+func (_c *MockMonitorsRepository_UpdateState_Call) Run(run func(ctx context.Context, id domain.MonitorID, status domain.MonitorStatus, lastCheckInAt *time.Time, nextCheckInAt time.Time)) *MockMonitorsRepository_UpdateState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorID), args[2].(domain.MonitorStatus), args[3].(*time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpdateState_Call) Return(_a0 error) *MockMonitorsRepository_UpdateState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpdateState_Call) RunAndReturn(run func(context.Context, domain.MonitorID, domain.MonitorStatus, *time.Time, time.Time) error) *MockMonitorsRepository_UpdateState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertCheckIn provides a mock function with given fields: ctx, checkInDTO
+func (_m *MockMonitorsRepository) UpsertCheckIn(ctx context.Context, checkInDTO domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error) {
+	ret := _m.Called(ctx, checkInDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCheckIn")
+	}
+
+	var r0 domain.MonitorCheckIn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error)); ok {
+		return rf(ctx, checkInDTO)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MonitorCheckInDTO) domain.MonitorCheckIn); ok {
+		r0 = rf(ctx, checkInDTO)
+	} else {
+		r0 = ret.Get(0).(domain.MonitorCheckIn)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MonitorCheckInDTO) error); ok {
+		r1 = rf(ctx, checkInDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMonitorsRepository_UpsertCheckIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCheckIn'
+type MockMonitorsRepository_UpsertCheckIn_Call struct {
+	*mock.Call
+}
+
+// UpsertCheckIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - checkInDTO domain.MonitorCheckInDTO
+func (_e *MockMonitorsRepository_Expecter) UpsertCheckIn(ctx interface{}, checkInDTO interface{}) *MockMonitorsRepository_UpsertCheckIn_Call {
+	return &MockMonitorsRepository_UpsertCheckIn_Call{Call: _e.mock.On("UpsertCheckIn", ctx, checkInDTO)}
+}
+
+func (_c *MockMonitorsRepository_UpsertCheckIn_Call) Run(run func(ctx context.Context, checkInDTO domain.MonitorCheckInDTO)) *MockMonitorsRepository_UpsertCheckIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MonitorCheckInDTO))
+	})
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpsertCheckIn_Call) Return(_a0 domain.MonitorCheckIn, _a1 error) *MockMonitorsRepository_UpsertCheckIn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMonitorsRepository_UpsertCheckIn_Call) RunAndReturn(run func(context.Context, domain.MonitorCheckInDTO) (domain.MonitorCheckIn, error)) *MockMonitorsRepository_UpsertCheckIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMonitorsRepository creates a new instance of MockMonitorsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMonitorsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMonitorsRepository {
+	mock := &MockMonitorsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
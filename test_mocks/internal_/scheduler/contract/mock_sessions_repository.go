@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mockcontract
+
+import (
+	context "context"
+
+	domain "github.com/rom8726/warden/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockSessionsRepository is an autogenerated mock type for the SessionsRepository type
+type MockSessionsRepository struct {
+	mock.Mock
+}
+
+type MockSessionsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionsRepository) EXPECT() *MockSessionsRepository_Expecter {
+	return &MockSessionsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ReleaseHealth provides a mock function with given fields: ctx, projectID, adoptionSince
+func (_m *MockSessionsRepository) ReleaseHealth(ctx context.Context, projectID domain.ProjectID, adoptionSince time.Time) (map[string]domain.ReleaseHealth, error) {
+	ret := _m.Called(ctx, projectID, adoptionSince)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseHealth")
+	}
+
+	var r0 map[string]domain.ReleaseHealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, time.Time) (map[string]domain.ReleaseHealth, error)); ok {
+		return rf(ctx, projectID, adoptionSince)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProjectID, time.Time) map[string]domain.ReleaseHealth); ok {
+		r0 = rf(ctx, projectID, adoptionSince)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]domain.ReleaseHealth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProjectID, time.Time) error); ok {
+		r1 = rf(ctx, projectID, adoptionSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionsRepository_ReleaseHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseHealth'
+type MockSessionsRepository_ReleaseHealth_Call struct {
+	*mock.Call
+}
+
+// ReleaseHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID domain.ProjectID
+//   - adoptionSince time.Time
+func (_e *MockSessionsRepository_Expecter) ReleaseHealth(ctx interface{}, projectID interface{}, adoptionSince interface{}) *MockSessionsRepository_ReleaseHealth_Call {
+	return &MockSessionsRepository_ReleaseHealth_Call{Call: _e.mock.On("ReleaseHealth", ctx, projectID, adoptionSince)}
+}
+
+func (_c *MockSessionsRepository_ReleaseHealth_Call) Run(run func(ctx context.Context, projectID domain.ProjectID, adoptionSince time.Time)) *MockSessionsRepository_ReleaseHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProjectID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSessionsRepository_ReleaseHealth_Call) Return(_a0 map[string]domain.ReleaseHealth, _a1 error) *MockSessionsRepository_ReleaseHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionsRepository_ReleaseHealth_Call) RunAndReturn(run func(context.Context, domain.ProjectID, time.Time) (map[string]domain.ReleaseHealth, error)) *MockSessionsRepository_ReleaseHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSessionsRepository creates a new instance of MockSessionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionsRepository {
+	mock := &MockSessionsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
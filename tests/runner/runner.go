@@ -16,6 +16,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pquerna/otp"
 	"github.com/pquerna/otp/totp"
 	"github.com/rom8726/pgfixtures"
@@ -197,6 +198,25 @@ func startPostgres(t *testing.T) (*postgres.PostgresContainer, func()) {
 	}
 }
 
+// StartMigratedPostgres starts Postgres with the Warden migrations applied for repository tests,
+// the returned pool and the container are closed at the end of the test.
+func StartMigratedPostgres(t *testing.T, migrationsDir string) *pgxpool.Pool {
+	t.Helper()
+
+	container, down := startPostgres(t)
+	t.Cleanup(down)
+
+	connStr, err := container.ConnectionString(t.Context(), "sslmode=disable")
+	require.NoError(t, err)
+	require.NoError(t, upPGMigrations(connStr, migrationsDir))
+
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
 // Redis --------------------------------------------------------------------.
 func startRedis(t *testing.T) (testcontainers.Container, func()) {
 	t.Helper()